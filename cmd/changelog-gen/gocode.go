@@ -0,0 +1,304 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"os"
+	"path/filepath"
+	"strconv"
+	"text/template"
+
+	"github.com/BlackMesaLTD/checkmk-api-spec/specdiff"
+)
+
+// ChangeRecord is one change of the generated changelog package
+type ChangeRecord struct {
+	Version     string
+	Previous    string
+	Kind        string // Name of the Kind constant
+	Severity    specdiff.Severity
+	Schema      string
+	Field       string
+	Path        string
+	Method      string
+	RenamedFrom string
+	Reason      string
+}
+
+// flattenReport lists the changes of a diff: endpoints first, then
+// operations, schemas and fields
+func flattenReport(version, prev string, report *specdiff.DiffReport) []ChangeRecord {
+	var records []ChangeRecord
+	add := func(r ChangeRecord) {
+		r.Version, r.Previous = version, prev
+		records = append(records, r)
+	}
+
+	if report.PathChanges != nil {
+		for _, kind := range []struct {
+			name    string
+			changes []*specdiff.PathChange
+			reason  string
+		}{
+			{"EndpointAdded", report.PathChanges.Added, "Path added"},
+			{"EndpointRemoved", report.PathChanges.Removed, "Path removed"},
+		} {
+			for _, p := range kind.changes {
+				methods := p.Methods
+				if len(methods) == 0 {
+					methods = []string{""}
+				}
+				for _, method := range methods {
+					add(ChangeRecord{Kind: kind.name, Severity: p.Severity, Path: p.Path, Method: method, Reason: kind.reason})
+				}
+			}
+		}
+	}
+
+	for _, c := range report.OperationChanges {
+		reason := c.ChangeReason
+		if c.Name != "" {
+			reason = c.Name + ": " + reason
+		}
+		add(ChangeRecord{Kind: "OperationChanged", Severity: c.Severity, Path: c.Path, Method: c.Method, Reason: reason})
+	}
+
+	for _, r := range report.SchemaRenames {
+		add(ChangeRecord{Kind: "SchemaRenamed", Severity: r.Severity, Schema: r.NewName, RenamedFrom: r.OldName, Reason: r.ChangeReason})
+	}
+	for _, name := range report.AddedSchemas {
+		add(ChangeRecord{Kind: "SchemaAdded", Severity: specdiff.SeverityMinor, Schema: name, Reason: "Schema added"})
+	}
+	// Diffs do not record how removed schemas were used, so their removal
+	// is breaking in an unknown direction
+	for _, name := range report.RemovedSchemas {
+		add(ChangeRecord{Kind: "SchemaRemoved", Severity: specdiff.SeverityBreaking, Schema: name, Reason: "Schema removed"})
+	}
+
+	for _, sc := range report.SchemaChanges {
+		for _, kind := range []struct {
+			name   string
+			fields []*specdiff.FieldDiff
+		}{
+			{"FieldAdded", sc.AddedFields},
+			{"FieldRemoved", sc.RemovedFields},
+			{"FieldChanged", sc.ChangedFields},
+		} {
+			for _, f := range kind.fields {
+				add(ChangeRecord{Kind: kind.name, Severity: f.Severity, Schema: sc.SchemaName, Field: f.Path, Reason: f.ChangeReason})
+			}
+		}
+		for _, r := range sc.RenamedFields {
+			add(ChangeRecord{Kind: "FieldRenamed", Severity: r.Severity, Schema: sc.SchemaName, Field: r.NewName, RenamedFrom: r.OldName, Reason: r.ChangeReason})
+		}
+	}
+
+	return records
+}
+
+// writeGoPackage generates the changelog package from the changes of all
+// diffs, oldest first
+func writeGoPackage(path string, records []ChangeRecord) error {
+	var buf bytes.Buffer
+	if err := changelogTemplate.Execute(&buf, struct {
+		Package        string
+		VersionPackage string
+		Changes        []ChangeRecord
+	}{
+		Package:        filepath.Base(filepath.Dir(path)),
+		VersionPackage: versionPackage,
+		Changes:        records,
+	}); err != nil {
+		return err
+	}
+
+	formatted, err := format.Source(buf.Bytes())
+	if err != nil {
+		return fmt.Errorf("formatting generated code: %w", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	return os.WriteFile(path, formatted, 0644)
+}
+
+// versionPackage is the import path of the version parser used by the
+// generated package
+const versionPackage = "github.com/BlackMesaLTD/checkmk-api-spec/cmkversion"
+
+// severityConstants names the Severity constant of each severity
+var severityConstants = map[specdiff.Severity]string{
+	specdiff.SeverityBreaking:       "SeverityBreaking",
+	specdiff.SeverityClientBreaking: "SeverityClientBreaking",
+	specdiff.SeverityServerBreaking: "SeverityServerBreaking",
+	specdiff.SeverityDeprecated:     "SeverityDeprecated",
+	specdiff.SeverityMinor:          "SeverityMinor",
+	specdiff.SeverityDocs:           "SeverityDocs",
+}
+
+var changelogTemplate = template.Must(template.New("changelog").Funcs(template.FuncMap{
+	"quote": strconv.Quote,
+	"severity": func(sev specdiff.Severity) string {
+		if name, ok := severityConstants[sev]; ok {
+			return name
+		}
+		return "Severity(" + strconv.Quote(string(sev)) + ")"
+	},
+}).Parse(`// Code generated by changelog-gen. DO NOT EDIT.
+//
+// Changes between consecutive CheckMK API baselines, from diffs/.
+// This package answers which release added, removed or changed an
+// endpoint or schema field.
+
+package {{.Package}}
+
+import (
+	"fmt"
+	"strings"
+
+	"{{.VersionPackage}}"
+)
+
+// Severity classifies a change by its impact on clients
+type Severity string
+
+const (
+	SeverityBreaking       Severity = "breaking"        // Breaking, direction unknown
+	SeverityClientBreaking Severity = "client-breaking" // Breaks existing clients
+	SeverityServerBreaking Severity = "server-breaking" // Breaks implementations of the old contract
+	SeverityDeprecated     Severity = "deprecated"
+	SeverityMinor          Severity = "minor"
+	SeverityDocs           Severity = "docs"
+)
+
+// IsBreaking reports whether the severity is one of the breaking levels
+func (s Severity) IsBreaking() bool {
+	return s == SeverityBreaking || s == SeverityClientBreaking || s == SeverityServerBreaking
+}
+
+// Kind is the kind of a change
+type Kind string
+
+const (
+	EndpointAdded    Kind = "endpoint_added"
+	EndpointRemoved  Kind = "endpoint_removed"
+	OperationChanged Kind = "operation_changed" // Parameters, request body or responses changed
+	SchemaAdded      Kind = "schema_added"
+	SchemaRemoved    Kind = "schema_removed"
+	SchemaRenamed    Kind = "schema_renamed"
+	FieldAdded       Kind = "field_added"
+	FieldRemoved     Kind = "field_removed"
+	FieldChanged     Kind = "field_changed"
+	FieldRenamed     Kind = "field_renamed"
+)
+
+// Change is a change introduced by a baseline
+type Change struct {
+	Version     string // Baseline introducing the change (e.g., "2.4.0p6")
+	Previous    string // Baseline before it
+	Kind        Kind
+	Severity    Severity
+	Schema      string // Schema of schema and field changes
+	Field       string // Field path of field changes (e.g., "attributes.alias")
+	Path        string // Path of endpoint and operation changes
+	Method      string // Method of endpoint and operation changes (e.g., "POST")
+	RenamedFrom string // Old schema or field name of renames
+	Reason      string
+}
+
+// String describes the change, e.g.
+// "2.4.0p6: HostConfig.alias: Field removed (client-breaking)"
+func (c Change) String() string {
+	subject := c.Schema
+	switch {
+	case c.Path != "":
+		subject = strings.TrimSpace(c.Method + " " + c.Path)
+	case c.Field != "":
+		subject += "." + c.Field
+	}
+	return fmt.Sprintf("%s: %s: %s (%s)", c.Version, subject, c.Reason, c.Severity)
+}
+
+// Changes lists all changes, oldest first
+var Changes = []Change{
+{{- range .Changes }}
+	{Version: {{quote .Version}}, Previous: {{quote .Previous}}, Kind: {{.Kind}}, Severity: {{severity .Severity}},
+	{{- if .Schema }} Schema: {{quote .Schema}},{{ end }}
+	{{- if .Field }} Field: {{quote .Field}},{{ end }}
+	{{- if .Path }} Path: {{quote .Path}},{{ end }}
+	{{- if .Method }} Method: {{quote .Method}},{{ end }}
+	{{- if .RenamedFrom }} RenamedFrom: {{quote .RenamedFrom}},{{ end }} Reason: {{quote .Reason}}},
+{{- end }}
+}
+
+// ChangesBetween returns the changes met when upgrading from one version to
+// another: those introduced after from, up to and including to, oldest
+// first. The versions need not be baselines.
+func ChangesBetween(from, to string) []Change {
+	var result []Change
+	for _, c := range Changes {
+		if cmkversion.CompareStrings(c.Version, from) > 0 && cmkversion.CompareStrings(c.Version, to) <= 0 {
+			result = append(result, c)
+		}
+	}
+	return result
+}
+
+// FieldHistory returns the changes of a schema field and the fields nested
+// below it, oldest first. Renames of the schema and the field are followed,
+// so the history continues under their old names.
+func FieldHistory(schema, field string) []Change {
+	var result []Change
+	for i := len(Changes) - 1; i >= 0; {
+		// Collect the changes of one baseline, then apply its renames for
+		// the older ones
+		version := Changes[i].Version
+		oldSchema, oldField := schema, field
+		for ; i >= 0 && Changes[i].Version == version; i-- {
+			c := Changes[i]
+			if c.Schema != schema {
+				continue
+			}
+			switch c.Kind {
+			case SchemaAdded, SchemaRemoved:
+				result = append(result, c)
+			case SchemaRenamed:
+				result = append(result, c)
+				oldSchema = c.RenamedFrom
+			case FieldRenamed:
+				if c.Field == field {
+					result = append(result, c)
+					oldField = c.RenamedFrom
+				}
+			case FieldAdded, FieldRemoved, FieldChanged:
+				if c.Field == field || strings.HasPrefix(c.Field, field+".") || strings.HasPrefix(c.Field, field+"[") {
+					result = append(result, c)
+				}
+			}
+		}
+		schema, field = oldSchema, oldField
+	}
+	reverse(result)
+	return result
+}
+
+// EndpointHistory returns the changes of an endpoint, oldest first. An
+// empty method matches all methods of the path.
+func EndpointHistory(path, method string) []Change {
+	var result []Change
+	for _, c := range Changes {
+		if c.Path == path && (method == "" || c.Method == "" || strings.EqualFold(c.Method, method)) {
+			result = append(result, c)
+		}
+	}
+	return result
+}
+
+func reverse(changes []Change) {
+	for i, j := 0, len(changes)-1; i < j; i, j = i+1, j-1 {
+		changes[i], changes[j] = changes[j], changes[i]
+	}
+}
+`))
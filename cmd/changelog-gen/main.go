@@ -0,0 +1,238 @@
+// Package main implements the changelog-gen tool, which rebuilds
+// changelog.json from the diffs between consecutive baselines.
+//
+// The diffs in diffs/<version>.json are the source of truth: changelog.json
+// only summarizes them, in the order of manifest.json's baselines of one
+// edition (-edition, raw by default), newest first. The output depends on
+// nothing but the diffs, so rebuilding an unchanged archive gives an
+// identical file.
+//
+// With -go-output, the changes of all diffs are also generated into a Go
+// package (generated/go/changelog) answering which release added, removed
+// or changed an endpoint or field.
+//
+// With -verify, the SHA-256 of the specs recorded in each diff is checked
+// against the spec files, so stale diffs are caught before publishing.
+//
+// Usage:
+//
+//	changelog-gen -manifest manifest.json -diffs diffs -output changelog.json
+//	changelog-gen -go-output generated/go/changelog/changelog.gen.go
+//	changelog-gen -verify
+package main
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+
+	"github.com/BlackMesaLTD/checkmk-api-spec/cmkversion"
+	"github.com/BlackMesaLTD/checkmk-api-spec/specdiff"
+)
+
+// ManifestInput matches the manifest.json structure
+type ManifestInput struct {
+	Baselines []string `json:"baselines"`
+	Mapping   map[string]struct {
+		Edition string `json:"edition"`
+	} `json:"mapping"`
+}
+
+// Changelog is the structure of changelog.json
+type Changelog struct {
+	TotalVersions int      `json:"total_versions"`
+	Changelog     []*Entry `json:"changelog"`
+}
+
+// Entry summarizes the diff introducing a baseline
+type Entry struct {
+	Version          string  `json:"version"`
+	Previous         *string `json:"previous"` // null for the first baseline
+	Diff             *Diff   `json:"diff"`
+	ProviderRelevant bool    `json:"provider_relevant"`
+}
+
+// Diff holds the counts of a diff report
+type Diff struct {
+	MaxSeverity      specdiff.Severity        `json:"max_severity"`
+	Note             string                   `json:"note,omitempty"`
+	Summary          *specdiff.Summary        `json:"summary,omitempty"`
+	CategorySummary  specdiff.CategorySummary `json:"category_summary,omitempty"`
+	PathChanges      *PathCounts              `json:"path_changes,omitempty"`
+	OperationChanges int                      `json:"operation_changes"`
+	SchemaChanges    int                      `json:"schema_changes"`
+}
+
+// PathCounts counts added and removed paths
+type PathCounts struct {
+	Added   int `json:"added"`
+	Removed int `json:"removed"`
+}
+
+func main() {
+	var (
+		manifestPath = flag.String("manifest", "manifest.json", "Path to manifest.json")
+		diffsDir     = flag.String("diffs", "diffs", "Directory containing <version>.json diffs")
+		output       = flag.String("output", "changelog.json", "Output changelog file")
+		goOutput     = flag.String("go-output", "", "Output file of the generated changelog package (optional)")
+		verify       = flag.Bool("verify", false, "Only check that the diffs match the specs they record")
+		edition      = flag.String("edition", string(cmkversion.EditionRaw), "CheckMK edition whose baselines to chain")
+	)
+	flag.Parse()
+
+	data, err := os.ReadFile(*manifestPath)
+	if err != nil {
+		log.Fatalf("Failed to read manifest: %v", err)
+	}
+	var manifest ManifestInput
+	if err := json.Unmarshal(data, &manifest); err != nil {
+		log.Fatalf("Failed to parse manifest: %v", err)
+	}
+
+	// Each edition has baselines of its own; manifests without editions are
+	// raw edition only
+	var baselines []string
+	for _, b := range manifest.Baselines {
+		e := manifest.Mapping[b].Edition
+		if e == *edition || (e == "" && *edition == string(cmkversion.EditionRaw)) {
+			baselines = append(baselines, b)
+		}
+	}
+	if len(baselines) == 0 {
+		log.Fatalf("Error: manifest has no baselines of edition %s", *edition)
+	}
+	cmkversion.Sort(baselines)
+
+	// Load the diff introducing each baseline after the first
+	reports := make(map[string]*specdiff.DiffReport, len(baselines))
+	var problems []string
+	for i := 1; i < len(baselines); i++ {
+		prev, version := baselines[i-1], baselines[i]
+		path := filepath.Join(*diffsDir, version+".json")
+		report, err := loadReport(path)
+		if err != nil {
+			problems = append(problems, err.Error())
+			continue
+		}
+		if report.OldVersion != prev || report.NewVersion != version {
+			problems = append(problems, fmt.Sprintf("%s compares %s → %s, expected %s → %s",
+				path, report.OldVersion, report.NewVersion, prev, version))
+			continue
+		}
+		if *verify {
+			problems = append(problems, verifySources(path, report)...)
+		}
+		reports[version] = report
+	}
+
+	if len(problems) > 0 {
+		for _, p := range problems {
+			log.Printf("Error: %s", p)
+		}
+		log.Fatalf("%d problem(s) found; regenerate the diffs with 'make diffs-all'", len(problems))
+	}
+	if *verify {
+		fmt.Printf("All %d diffs match their specs\n", len(reports))
+		return
+	}
+
+	changelog := &Changelog{TotalVersions: len(baselines)}
+	for i := len(baselines) - 1; i >= 0; i-- {
+		version := baselines[i]
+		if i == 0 {
+			changelog.Changelog = append(changelog.Changelog, &Entry{
+				Version: version,
+				Diff:    &Diff{MaxSeverity: "initial", Note: "Initial baseline version"},
+			})
+			continue
+		}
+		prev := baselines[i-1]
+		changelog.Changelog = append(changelog.Changelog, newEntry(version, prev, reports[version]))
+	}
+
+	out, err := json.MarshalIndent(changelog, "", "  ")
+	if err != nil {
+		log.Fatalf("Failed to marshal changelog: %v", err)
+	}
+	if err := os.WriteFile(*output, append(out, '\n'), 0644); err != nil {
+		log.Fatalf("Failed to write changelog: %v", err)
+	}
+	fmt.Printf("Changelog written to: %s (%d versions)\n", *output, len(baselines))
+
+	if *goOutput != "" {
+		var records []ChangeRecord
+		for i := 1; i < len(baselines); i++ {
+			records = append(records, flattenReport(baselines[i], baselines[i-1], reports[baselines[i]])...)
+		}
+		if err := writeGoPackage(*goOutput, records); err != nil {
+			log.Fatalf("Failed to generate changelog package: %v", err)
+		}
+		fmt.Printf("Changelog package written to: %s (%d changes)\n", *goOutput, len(records))
+	}
+}
+
+// loadReport reads a diff written by openapi-diff
+func loadReport(path string) (*specdiff.DiffReport, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading diff: %w", err)
+	}
+	var report specdiff.DiffReport
+	if err := json.Unmarshal(data, &report); err != nil {
+		return nil, fmt.Errorf("parsing %s: %w", path, err)
+	}
+	return &report, nil
+}
+
+// verifySources checks the recorded hashes of both specs of a diff against
+// the spec files
+func verifySources(path string, report *specdiff.DiffReport) []string {
+	var problems []string
+	for _, source := range []*specdiff.SpecSource{report.OldSpec, report.NewSpec} {
+		if source == nil || source.Path == "" {
+			problems = append(problems, fmt.Sprintf("%s records no spec sources", path))
+			break
+		}
+		data, err := os.ReadFile(source.Path)
+		if err != nil {
+			problems = append(problems, fmt.Sprintf("%s: %v", path, err))
+			continue
+		}
+		sum := sha256.Sum256(data)
+		if got := hex.EncodeToString(sum[:]); got != source.SHA256 {
+			problems = append(problems, fmt.Sprintf("%s was made from a different %s (sha256 %s, now %s)",
+				path, source.Path, source.SHA256, got))
+		}
+	}
+	return problems
+}
+
+// newEntry summarizes a diff. A change is relevant to the provider if it
+// adds, removes or breaks setup endpoints, or changes schemas incompatibly.
+func newEntry(version, prev string, report *specdiff.DiffReport) *Entry {
+	diff := &Diff{
+		MaxSeverity:      report.MaxSeverity,
+		Summary:          report.Summary,
+		CategorySummary:  report.CategorySummary,
+		OperationChanges: len(report.OperationChanges),
+		SchemaChanges:    len(report.SchemaChanges),
+	}
+	if report.PathChanges != nil {
+		diff.PathChanges = &PathCounts{Added: len(report.PathChanges.Added), Removed: len(report.PathChanges.Removed)}
+	}
+
+	relevant := false
+	if setup := report.CategorySummary[specdiff.CategorySetup]; setup != nil {
+		relevant = setup.PathsAdded > 0 || setup.PathsRemoved > 0 || setup.Breaking > 0
+	}
+	if s := report.Summary; s != nil {
+		relevant = relevant || s.BreakingChanges > 0 || s.SchemasAdded > 0 || s.SchemasRemoved > 0
+	}
+
+	return &Entry{Version: version, Previous: &prev, Diff: diff, ProviderRelevant: relevant}
+}
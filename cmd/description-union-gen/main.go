@@ -5,9 +5,16 @@
 // all field descriptions and enum values, tracking which versions support
 // which fields and values.
 //
+// Schemas and fields that openapi-diff reported as renamed keep their
+// history under the new name, read from the diffs directory.
+//
+// The union covers the baselines of one edition (-edition, raw by default).
+// Besides descriptions, it records the span of baselines having each
+// operation, field and enum value, for capability queries.
+//
 // Usage:
 //
-//	description-union-gen -manifest manifest.json -output generated/go/union/
+//	description-union-gen -manifest manifest.json -output generated/go/union/ -diffs diffs/
 package main
 
 import (
@@ -27,6 +34,8 @@ import (
 	"strconv"
 	"strings"
 	"text/template"
+
+	"github.com/BlackMesaLTD/checkmk-api-spec/cmkversion"
 )
 
 // ManifestInput matches the manifest.json structure
@@ -43,18 +52,41 @@ type BaselineInfo struct {
 	Path        string `json:"path"`
 	ImportAlias string `json:"import_alias"`
 	IsBaseline  bool   `json:"is_baseline"`
+	Edition     string `json:"edition"`
 }
 
 // VersionData holds parsed data from a single baseline
 type VersionData struct {
-	Version      string                       // e.g., "2.4.0p17"
-	Major        int                          // 2
-	Minor        int                          // 4
-	Patch        int                          // 0
-	P            int                          // 17
-	Descriptions map[string]map[string]string // schema -> field -> description
-	Types        map[string]map[string]string // schema -> field -> type
-	Enums        map[string][]string          // "SchemaField" -> enum values
+	Version      string // e.g., "2.4.0p17"
+	Parsed       cmkversion.Version
+	Descriptions map[string]map[string]string   // schema -> field -> description
+	Types        map[string]map[string]string   // schema -> field -> type
+	Fields       map[string][]string            // schema -> field names
+	Enums        map[string]map[string][]string // schema -> field -> enum values
+	Operations   []string                       // operation IDs
+	Renames      []FieldRename                  // Fields renamed since the previous baseline
+}
+
+// FieldRename links a field to the field it was renamed from
+type FieldRename struct {
+	OldSchema, OldField string
+	NewSchema, NewField string
+}
+
+// DiffRenames is the part of an openapi-diff report describing renames
+type DiffRenames struct {
+	SchemaRenames []struct {
+		OldName string `json:"old_name"`
+		NewName string `json:"new_name"`
+	} `json:"schema_renames"`
+	SchemaChanges []struct {
+		SchemaName    string `json:"schema_name"`
+		RenamedFrom   string `json:"renamed_from"`
+		RenamedFields []struct {
+			OldName string `json:"old_name"`
+			NewName string `json:"new_name"`
+		} `json:"renamed_fields"`
+	} `json:"schema_changes"`
 }
 
 // UnionField contains merged field metadata across all versions
@@ -67,6 +99,7 @@ type UnionField struct {
 	FirstSeen    string      // First baseline where field appeared
 	LastSeen     string      // Last baseline where field was present (empty = still present)
 	Descriptions []VersionedDescription // Track description changes
+	RenamedFrom  string      // "Schema.field" this field was renamed from, if any
 }
 
 // UnionEnum represents an enum value with version tracking
@@ -82,9 +115,19 @@ type VersionedDescription struct {
 	Description string
 }
 
+// Span is the range of baselines having an API element. An element that
+// was removed and added again spans the gap.
+type Span struct {
+	Since string // First baseline with the element
+	Until string // Last baseline with the element, empty if still present
+}
+
 // UnionData holds all merged data
 type UnionData struct {
-	Schemas map[string]map[string]*UnionField // schema -> field -> union data
+	Schemas    map[string]map[string]*UnionField     // schema -> field -> union data
+	Operations map[string]Span                       // operation ID -> span
+	Fields     map[string]map[string]Span            // schema -> field -> span
+	EnumValues map[string]map[string]map[string]Span // schema -> field -> value -> span
 }
 
 func main() {
@@ -92,6 +135,8 @@ func main() {
 		manifestPath = flag.String("manifest", "manifest.json", "Path to manifest.json")
 		outputDir    = flag.String("output", "generated/go/union", "Output directory")
 		generatedDir = flag.String("generated", "generated/go", "Generated packages directory")
+		diffsDir     = flag.String("diffs", "diffs", "Directory of openapi-diff reports, used to link renamed fields")
+		edition      = flag.String("edition", string(cmkversion.EditionRaw), "CheckMK edition whose baselines to merge")
 	)
 	flag.Parse()
 
@@ -106,11 +151,21 @@ func main() {
 		log.Fatalf("Failed to parse manifest: %v", err)
 	}
 
-	fmt.Printf("Processing %d baselines...\n", len(manifest.Baselines))
+	// Each edition has baselines of its own; manifests without editions are
+	// raw edition only
+	var baselines []string
+	for _, baseline := range manifest.Baselines {
+		e := manifest.Mapping[baseline].Edition
+		if e == *edition || (e == "" && *edition == string(cmkversion.EditionRaw)) {
+			baselines = append(baselines, baseline)
+		}
+	}
+
+	fmt.Printf("Processing %d %s baselines...\n", len(baselines), *edition)
 
 	// Parse all baselines
 	var versions []VersionData
-	for _, baseline := range manifest.Baselines {
+	for _, baseline := range baselines {
 		info := manifest.Mapping[baseline]
 		pkgPath := filepath.Join(*generatedDir, info.Path)
 
@@ -119,12 +174,16 @@ func main() {
 			log.Printf("Warning: failed to parse %s: %v", baseline, err)
 			continue
 		}
+		vd.Renames, err = loadRenames(filepath.Join(*diffsDir, baseline+".json"), vd)
+		if err != nil {
+			log.Printf("Warning: failed to read renames for %s: %v", baseline, err)
+		}
 		versions = append(versions, vd)
 	}
 
 	// Sort versions chronologically
 	sort.Slice(versions, func(i, j int) bool {
-		return compareVersions(versions[i], versions[j]) < 0
+		return versions[i].Parsed.Compare(versions[j].Parsed) < 0
 	})
 
 	fmt.Printf("Parsed %d baselines successfully\n", len(versions))
@@ -155,23 +214,17 @@ func main() {
 
 // parsePackage extracts metadata from a generated package
 func parsePackage(pkgPath, version string) (VersionData, error) {
+	parsed, err := cmkversion.Parse(version)
+	if err != nil {
+		return VersionData{}, err
+	}
 	vd := VersionData{
 		Version:      version,
+		Parsed:       parsed,
 		Descriptions: make(map[string]map[string]string),
 		Types:        make(map[string]map[string]string),
-		Enums:        make(map[string][]string),
-	}
-
-	// Parse version string: "2.4.0p17" -> Major=2, Minor=4, Patch=0, P=17
-	parts := strings.Split(version, "p")
-	if len(parts) == 2 {
-		vd.P, _ = strconv.Atoi(parts[1])
-		numParts := strings.Split(parts[0], ".")
-		if len(numParts) >= 3 {
-			vd.Major, _ = strconv.Atoi(numParts[0])
-			vd.Minor, _ = strconv.Atoi(numParts[1])
-			vd.Patch, _ = strconv.Atoi(numParts[2])
-		}
+		Fields:       make(map[string][]string),
+		Enums:        make(map[string]map[string][]string),
 	}
 
 	// Parse metadata.gen.go
@@ -187,9 +240,67 @@ func parsePackage(pkgPath, version string) (VersionData, error) {
 		log.Printf("Note: no enums for %s", version)
 	}
 
+	// Parse fields.gen.go and operations.gen.go
+	if err := parseFieldsFile(filepath.Join(pkgPath, "fields.gen.go"), &vd); err != nil {
+		return vd, fmt.Errorf("parsing fields: %w", err)
+	}
+	if err := parseOperationsFile(filepath.Join(pkgPath, "operations.gen.go"), &vd); err != nil {
+		return vd, fmt.Errorf("parsing operations: %w", err)
+	}
+
 	return vd, nil
 }
 
+// loadRenames reads the renamed schemas and fields from the diff report of
+// a baseline against its predecessor. Fields of a renamed schema are linked
+// by name; nested field renames are skipped, as only top-level fields are
+// tracked. A missing report means no renames.
+func loadRenames(path string, vd VersionData) ([]FieldRename, error) {
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var report DiffRenames
+	if err := json.Unmarshal(data, &report); err != nil {
+		return nil, err
+	}
+
+	var renames []FieldRename
+	renamedFields := make(map[string]bool) // "Schema.field" of new fields linked explicitly
+	for _, change := range report.SchemaChanges {
+		oldSchema := change.SchemaName
+		if change.RenamedFrom != "" {
+			oldSchema = change.RenamedFrom
+		}
+		for _, f := range change.RenamedFields {
+			if strings.ContainsAny(f.OldName+f.NewName, ".[") {
+				continue
+			}
+			renames = append(renames, FieldRename{oldSchema, f.OldName, change.SchemaName, f.NewName})
+			renamedFields[change.SchemaName+"."+f.NewName] = true
+		}
+	}
+	for _, r := range report.SchemaRenames {
+		for field := range vd.Descriptions[r.NewName] {
+			if !renamedFields[r.NewName+"."+field] {
+				renames = append(renames, FieldRename{r.OldName, field, r.NewName, field})
+			}
+		}
+	}
+
+	sort.Slice(renames, func(i, j int) bool {
+		if renames[i].NewSchema != renames[j].NewSchema {
+			return renames[i].NewSchema < renames[j].NewSchema
+		}
+		return renames[i].NewField < renames[j].NewField
+	})
+	return renames, nil
+}
+
 // parseMetadataFile extracts FieldDescriptions and FieldTypes from metadata.gen.go
 func parseMetadataFile(path string, vd *VersionData) error {
 	fset := token.NewFileSet()
@@ -274,88 +385,213 @@ func parseNestedStringMap(expr ast.Expr) map[string]map[string]string {
 	return result
 }
 
-// parseEnumsFile extracts enum values from enums.gen.go
+// parseEnumsFile extracts the enum values of each field from the
+// EnumValuesLookup map of enums.gen.go, resolving the Valid*Values
+// functions it calls to the values of their constants
 func parseEnumsFile(path string, vd *VersionData) error {
 	fset := token.NewFileSet()
-	node, err := parser.ParseFile(fset, path, nil, parser.ParseComments)
+	node, err := parser.ParseFile(fset, path, nil, 0)
 	if err != nil {
 		return err
 	}
 
-	// Look for Valid*Values functions
+	consts := make(map[string]string)  // constant -> value
+	funcs := make(map[string][]string) // Valid*Values function -> constants
+	var lookup *ast.CompositeLit       // EnumValuesLookup literal
 	for _, decl := range node.Decls {
-		funcDecl, ok := decl.(*ast.FuncDecl)
-		if !ok {
-			continue
-		}
-
-		name := funcDecl.Name.Name
-		if !strings.HasPrefix(name, "Valid") || !strings.HasSuffix(name, "Values") {
-			continue
-		}
-
-		// Extract the schema+field name: ValidHostCreateAttributeTagAgentValues -> HostCreateAttributeTagAgent
-		enumName := strings.TrimPrefix(name, "Valid")
-		enumName = strings.TrimSuffix(enumName, "Values")
-
-		// Parse the function body to extract return values
-		if funcDecl.Body != nil {
-			for _, stmt := range funcDecl.Body.List {
-				retStmt, ok := stmt.(*ast.ReturnStmt)
-				if !ok || len(retStmt.Results) == 0 {
+		switch decl := decl.(type) {
+		case *ast.GenDecl:
+			for _, spec := range decl.Specs {
+				valSpec, ok := spec.(*ast.ValueSpec)
+				if !ok || len(valSpec.Names) == 0 || len(valSpec.Values) == 0 {
 					continue
 				}
-
-				comp, ok := retStmt.Results[0].(*ast.CompositeLit)
+				name := valSpec.Names[0].Name
+				switch {
+				case decl.Tok == token.CONST:
+					if lit, ok := valSpec.Values[0].(*ast.BasicLit); ok && lit.Kind == token.STRING {
+						consts[name], _ = strconv.Unquote(lit.Value)
+					}
+				case name == "EnumValuesLookup":
+					lookup, _ = valSpec.Values[0].(*ast.CompositeLit)
+				}
+			}
+		case *ast.FuncDecl:
+			// func ValidXValues() []string { return []string{string(XA), ...} }
+			name := decl.Name.Name
+			if !strings.HasPrefix(name, "Valid") || !strings.HasSuffix(name, "Values") || decl.Body == nil {
+				continue
+			}
+			for _, stmt := range decl.Body.List {
+				ret, ok := stmt.(*ast.ReturnStmt)
+				if !ok || len(ret.Results) == 0 {
+					continue
+				}
+				comp, ok := ret.Results[0].(*ast.CompositeLit)
 				if !ok {
 					continue
 				}
-
-				var values []string
 				for _, elt := range comp.Elts {
-					call, ok := elt.(*ast.CallExpr)
-					if !ok {
-						continue
-					}
-					// string(ConstName) pattern
-					if len(call.Args) == 1 {
+					if call, ok := elt.(*ast.CallExpr); ok && len(call.Args) == 1 {
 						if ident, ok := call.Args[0].(*ast.Ident); ok {
-							// We need to look up the const value, but for simplicity
-							// we'll extract from the identifier name
-							values = append(values, ident.Name)
+							funcs[name] = append(funcs[name], ident.Name)
 						}
 					}
 				}
-				vd.Enums[enumName] = values
 			}
 		}
 	}
+	if lookup == nil {
+		return fmt.Errorf("no EnumValuesLookup in %s", path)
+	}
 
+	for schema, fields := range keyedElements(lookup) {
+		for field, expr := range keyedElements(fields) {
+			call, ok := expr.(*ast.CallExpr)
+			if !ok {
+				continue
+			}
+			ident, ok := call.Fun.(*ast.Ident)
+			if !ok {
+				continue
+			}
+			if vd.Enums[schema] == nil {
+				vd.Enums[schema] = make(map[string][]string)
+			}
+			for _, c := range funcs[ident.Name] {
+				if value, ok := consts[c]; ok {
+					vd.Enums[schema][field] = append(vd.Enums[schema][field], value)
+				}
+			}
+		}
+	}
 	return nil
 }
 
-// compareVersions compares two version data structs
-func compareVersions(a, b VersionData) int {
-	if a.Major != b.Major {
-		return a.Major - b.Major
+// parseFieldsFile extracts the field names of each schema from the
+// SchemaFieldNames map of fields.gen.go, whose entries refer to the
+// <Schema>FieldNames variables
+func parseFieldsFile(path string, vd *VersionData) error {
+	fset := token.NewFileSet()
+	node, err := parser.ParseFile(fset, path, nil, 0)
+	if err != nil {
+		return err
 	}
-	if a.Minor != b.Minor {
-		return a.Minor - b.Minor
+
+	lists := make(map[string][]string) // variable -> field names
+	var lookup *ast.CompositeLit
+	for _, decl := range node.Decls {
+		genDecl, ok := decl.(*ast.GenDecl)
+		if !ok || genDecl.Tok != token.VAR {
+			continue
+		}
+		for _, spec := range genDecl.Specs {
+			valSpec, ok := spec.(*ast.ValueSpec)
+			if !ok || len(valSpec.Names) == 0 || len(valSpec.Values) == 0 {
+				continue
+			}
+			comp, ok := valSpec.Values[0].(*ast.CompositeLit)
+			if !ok {
+				continue
+			}
+			if name := valSpec.Names[0].Name; name == "SchemaFieldNames" {
+				lookup = comp
+			} else {
+				lists[name] = stringLiterals(comp)
+			}
+		}
+	}
+	if lookup == nil {
+		return fmt.Errorf("no SchemaFieldNames in %s", path)
 	}
-	if a.Patch != b.Patch {
-		return a.Patch - b.Patch
+
+	for schema, expr := range keyedElements(lookup) {
+		switch expr := expr.(type) {
+		case *ast.Ident:
+			vd.Fields[schema] = lists[expr.Name]
+		case *ast.CompositeLit:
+			vd.Fields[schema] = stringLiterals(expr)
+		}
 	}
-	return a.P - b.P
+	return nil
+}
+
+// parseOperationsFile extracts the operation IDs, the keys of the
+// Operations map of operations.gen.go
+func parseOperationsFile(path string, vd *VersionData) error {
+	fset := token.NewFileSet()
+	node, err := parser.ParseFile(fset, path, nil, 0)
+	if err != nil {
+		return err
+	}
+
+	for _, decl := range node.Decls {
+		genDecl, ok := decl.(*ast.GenDecl)
+		if !ok || genDecl.Tok != token.VAR {
+			continue
+		}
+		for _, spec := range genDecl.Specs {
+			valSpec, ok := spec.(*ast.ValueSpec)
+			if !ok || len(valSpec.Names) == 0 || valSpec.Names[0].Name != "Operations" || len(valSpec.Values) == 0 {
+				continue
+			}
+			if comp, ok := valSpec.Values[0].(*ast.CompositeLit); ok {
+				for id := range keyedElements(comp) {
+					vd.Operations = append(vd.Operations, id)
+				}
+			}
+		}
+	}
+	sort.Strings(vd.Operations)
+	return nil
+}
+
+// keyedElements returns the values of a map literal by their string keys
+func keyedElements(expr ast.Expr) map[string]ast.Expr {
+	result := make(map[string]ast.Expr)
+	comp, ok := expr.(*ast.CompositeLit)
+	if !ok {
+		return result
+	}
+	for _, elt := range comp.Elts {
+		kv, ok := elt.(*ast.KeyValueExpr)
+		if !ok {
+			continue
+		}
+		keyLit, ok := kv.Key.(*ast.BasicLit)
+		if !ok || keyLit.Kind != token.STRING {
+			continue
+		}
+		key, _ := strconv.Unquote(keyLit.Value)
+		result[key] = kv.Value
+	}
+	return result
+}
+
+// stringLiterals returns the strings of a []string literal
+func stringLiterals(comp *ast.CompositeLit) []string {
+	var values []string
+	for _, elt := range comp.Elts {
+		if lit, ok := elt.(*ast.BasicLit); ok && lit.Kind == token.STRING {
+			value, _ := strconv.Unquote(lit.Value)
+			values = append(values, value)
+		}
+	}
+	return values
 }
 
 // buildUnion creates the union from all version data
 func buildUnion(versions []VersionData) *UnionData {
 	union := &UnionData{
-		Schemas: make(map[string]map[string]*UnionField),
+		Schemas:    make(map[string]map[string]*UnionField),
+		Operations: make(map[string]Span),
+		Fields:     make(map[string]map[string]Span),
+		EnumValues: make(map[string]map[string]map[string]Span),
 	}
 
 	for _, v := range versions {
-		minorVersion := fmt.Sprintf("%d.%d", v.Major, v.Minor)
+		minorVersion := v.Parsed.Minor()
+		applyRenames(union, v.Renames)
 
 		for schema, fields := range v.Descriptions {
 			if union.Schemas[schema] == nil {
@@ -404,7 +640,7 @@ func buildUnion(versions []VersionData) *UnionData {
 							if versions[i].Descriptions[schema] != nil &&
 							   versions[i].Descriptions[schema][fieldName] != "" {
 								uf.LastSeen = versions[i].Version
-								uf.MaxVersion = fmt.Sprintf("%d.%d", versions[i].Major, versions[i].Minor)
+								uf.MaxVersion = versions[i].Parsed.Minor()
 								break
 							}
 						}
@@ -414,9 +650,105 @@ func buildUnion(versions []VersionData) *UnionData {
 		}
 	}
 
+	buildSpans(union, versions)
 	return union
 }
 
+// buildSpans records the baselines having each operation, field and enum
+// value. Unlike descriptions, spans do not follow renames: a capability
+// exists under its current name only.
+func buildSpans(union *UnionData, versions []VersionData) {
+	if len(versions) == 0 {
+		return
+	}
+	latest := versions[len(versions)-1].Version
+
+	extend := func(span Span, seen bool, version string) Span {
+		if !seen {
+			span.Since = version
+		}
+		span.Until = version
+		return span
+	}
+	for _, v := range versions {
+		for _, id := range v.Operations {
+			span, seen := union.Operations[id]
+			union.Operations[id] = extend(span, seen, v.Version)
+		}
+		for schema, fields := range v.Fields {
+			if union.Fields[schema] == nil {
+				union.Fields[schema] = make(map[string]Span)
+			}
+			for _, field := range fields {
+				span, seen := union.Fields[schema][field]
+				union.Fields[schema][field] = extend(span, seen, v.Version)
+			}
+		}
+		for schema, fields := range v.Enums {
+			if union.EnumValues[schema] == nil {
+				union.EnumValues[schema] = make(map[string]map[string]Span)
+			}
+			for field, values := range fields {
+				if union.EnumValues[schema][field] == nil {
+					union.EnumValues[schema][field] = make(map[string]Span)
+				}
+				for _, value := range values {
+					span, seen := union.EnumValues[schema][field][value]
+					union.EnumValues[schema][field][value] = extend(span, seen, v.Version)
+				}
+			}
+		}
+	}
+
+	// Elements of the latest baseline are still present
+	current := func(span Span) Span {
+		if span.Until == latest {
+			span.Until = ""
+		}
+		return span
+	}
+	for id, span := range union.Operations {
+		union.Operations[id] = current(span)
+	}
+	for _, fields := range union.Fields {
+		for field, span := range fields {
+			fields[field] = current(span)
+		}
+	}
+	for _, fields := range union.EnumValues {
+		for _, values := range fields {
+			for value, span := range values {
+				values[value] = current(span)
+			}
+		}
+	}
+}
+
+// applyRenames carries the history of renamed fields over to their new
+// names before a version is merged. Renames are applied from a snapshot, as
+// numbered schemas often shift in chains (Error1 → Error2, Error2 → Error3).
+func applyRenames(union *UnionData, renames []FieldRename) {
+	previous := make(map[FieldRename]UnionField)
+	for _, r := range renames {
+		if uf := union.Schemas[r.OldSchema][r.OldField]; uf != nil {
+			previous[r] = *uf
+		}
+	}
+
+	for _, r := range renames {
+		uf, ok := previous[r]
+		if !ok {
+			continue
+		}
+		uf.RenamedFrom = r.OldSchema + "." + r.OldField
+		uf.Descriptions = append([]VersionedDescription(nil), uf.Descriptions...)
+		if union.Schemas[r.NewSchema] == nil {
+			union.Schemas[r.NewSchema] = make(map[string]*UnionField)
+		}
+		union.Schemas[r.NewSchema][r.NewField] = &uf
+	}
+}
+
 // generateUnionCode generates the union package
 func generateUnionCode(union *UnionData, outputDir string) error {
 	// Generate descriptions.gen.go
@@ -436,6 +768,22 @@ func generateUnionCode(union *UnionData, outputDir string) error {
 		return err
 	}
 
+	// Generate spans.gen.go
+	var buf bytes.Buffer
+	if err := spansTemplate.Execute(&buf, union); err != nil {
+		return err
+	}
+	formatted, err = format.Source(buf.Bytes())
+	if err != nil {
+		log.Printf("Warning: failed to format code: %v", err)
+		formatted = buf.Bytes()
+	}
+
+	path = filepath.Join(outputDir, "spans.gen.go")
+	if err := os.WriteFile(path, formatted, 0644); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -502,6 +850,7 @@ type UnionField struct {
 	Type        string // OpenAPI type
 	MinVersion  string // First minor version with this field (e.g., "2.2")
 	MaxVersion  string // Empty if still present, else last version (e.g., "2.2")
+	RenamedFrom string // "Schema.field" this field was renamed from, if any
 }
 
 // UnionDescriptions maps schema.field to merged metadata.
@@ -517,6 +866,9 @@ var UnionDescriptions = map[string]map[string]UnionField{
 			Type:        {{quote $uf.Type}},
 			MinVersion:  {{quote $uf.MinVersion}},
 			MaxVersion:  {{quote $uf.MaxVersion}},
+			{{- if $uf.RenamedFrom }}
+			RenamedFrom: {{quote $uf.RenamedFrom}},
+			{{- end }}
 		},
 	{{- end }}
 	},
@@ -571,6 +923,11 @@ func (f *UnionField) FormatMarkdown() string {
 		sb.WriteString(f.MaxVersion)
 		sb.WriteString("**")
 	}
+	if f.RenamedFrom != "" {
+		sb.WriteString("\n\n*Previously ")
+		sb.WriteString(f.RenamedFrom)
+		sb.WriteString("*")
+	}
 
 	return sb.String()
 }
@@ -597,3 +954,55 @@ func FieldNames(schemaName string) []string {
 	return names
 }
 `))
+
+var spansTemplate = template.Must(template.New("spans").Funcs(template.FuncMap{
+	"quote": strconv.Quote,
+}).Parse(`// Code generated by description-union-gen. DO NOT EDIT.
+//
+// Baselines having each operation, field and enum value across all CheckMK
+// API baseline versions. This package answers capability queries for
+// baselines that are not compiled in.
+
+package union
+
+// Span is the range of baselines having an API element. An element that
+// was removed and added again spans the gap.
+type Span struct {
+	Since string // First baseline with the element (e.g., "2.3.0p1")
+	Until string // Last baseline with the element, empty if still present
+}
+
+// OperationSpans maps operation IDs to the baselines having them.
+var OperationSpans = map[string]Span{
+{{- range $id, $span := .Operations}}
+	{{quote $id}}: { {{- quote $span.Since}}, {{quote $span.Until -}} },
+{{- end}}
+}
+
+// FieldSpans maps schema.field to the baselines having the field.
+var FieldSpans = map[string]map[string]Span{
+{{- range $schema, $fields := .Fields}}
+	{{quote $schema}}: {
+	{{- range $field, $span := $fields}}
+		{{quote $field}}: { {{- quote $span.Since}}, {{quote $span.Until -}} },
+	{{- end}}
+	},
+{{- end}}
+}
+
+// EnumValueSpans maps schema.field to the baselines accepting each of its
+// enum values.
+var EnumValueSpans = map[string]map[string]map[string]Span{
+{{- range $schema, $fields := .EnumValues}}
+	{{quote $schema}}: {
+	{{- range $field, $values := $fields}}
+		{{quote $field}}: {
+		{{- range $value, $span := $values}}
+			{{quote $value}}: { {{- quote $span.Since}}, {{quote $span.Until -}} },
+		{{- end}}
+		},
+	{{- end}}
+	},
+{{- end}}
+}
+`))
@@ -0,0 +1,275 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/BlackMesaLTD/checkmk-api-spec/specdiff"
+)
+
+// Sections of the guide, in the order they are listed for each tag
+const (
+	SectionRemovedEndpoints = "Removed endpoints"
+	SectionRequiredInputs   = "Newly required inputs"
+	SectionRewrites         = "Fields needing rewrites"
+	SectionEnumValues       = "Vanished enum values"
+	SectionDeprecations     = "Deprecations due for removal"
+)
+
+var sectionOrder = []string{SectionRemovedEndpoints, SectionRequiredInputs, SectionRewrites, SectionEnumValues, SectionDeprecations}
+
+// untagged groups changes of schemas no operation uses directly
+const untagged = "Other"
+
+// Guide is a migration guide between two versions
+type Guide struct {
+	From, To  string
+	Baselines []string // Baselines from the one of From to the one of To
+	Entries   []Entry
+}
+
+// Entry is a change a client has to act on
+type Entry struct {
+	Section string
+	Tag     string
+	Subject string // e.g. "DELETE /objects/host/{host_name}", "CreateHost.attributes.alias"
+	Reason  string
+	Since   string // Baseline that introduced the change, if found
+}
+
+// key identifies an entry across diffs
+func (e Entry) key() string {
+	return e.Section + "|" + e.Tag + "|" + e.Subject + "|" + e.Reason
+}
+
+// collectEntries picks the changes clients have to act on from a report:
+// breaking changes for clients and deprecations. Server-breaking and
+// compatible changes are left out. Schema changes are listed under the
+// tags of the operations using the schema.
+func collectEntries(report *specdiff.DiffReport, tags map[string][]string) []Entry {
+	var entries []Entry
+	add := func(section string, entryTags []string, subject, reason string) {
+		if len(entryTags) == 0 {
+			entryTags = []string{untagged}
+		}
+		for _, tag := range entryTags {
+			entries = append(entries, Entry{Section: section, Tag: tag, Subject: subject, Reason: reason})
+		}
+	}
+
+	for _, p := range report.PathChanges.Removed {
+		add(SectionRemovedEndpoints, p.Tags, strings.Join(p.Methods, ", ")+" "+p.Path, "Path removed")
+	}
+
+	for _, c := range report.OperationChanges {
+		subject := c.Method + " " + c.Path
+		if c.Name != "" {
+			subject += " (" + c.Name + ")"
+		}
+		switch {
+		case c.ChangeReason == "Method removed":
+			add(SectionRemovedEndpoints, c.Tags, subject, c.ChangeReason)
+		case c.Severity == specdiff.SeverityDeprecated:
+			add(SectionDeprecations, c.Tags, subject, c.ChangeReason)
+		case !affectsClients(c.Severity):
+		case strings.HasPrefix(c.ChangeReason, "Enum value removed"):
+			add(SectionEnumValues, c.Tags, subject, c.ChangeReason)
+		case requiresInput(c.ChangeReason):
+			add(SectionRequiredInputs, c.Tags, subject, c.ChangeReason)
+		default:
+			add(SectionRewrites, c.Tags, subject, c.ChangeReason)
+		}
+	}
+
+	for _, sc := range report.SchemaChanges {
+		schemaTags, ok := tags[sc.SchemaName]
+		if !ok {
+			// Changes to schemas only used through others are reported
+			// with nested paths under those
+			continue
+		}
+		for _, f := range sc.AddedFields {
+			if f.IsRequired && affectsClients(f.Severity) {
+				add(SectionRequiredInputs, schemaTags, sc.SchemaName+"."+f.Path, f.ChangeReason)
+			}
+		}
+		for _, f := range sc.RemovedFields {
+			if affectsClients(f.Severity) {
+				add(SectionRewrites, schemaTags, sc.SchemaName+"."+f.Path, f.ChangeReason)
+			}
+		}
+		for _, r := range sc.RenamedFields {
+			if affectsClients(r.Severity) {
+				add(SectionRewrites, schemaTags, sc.SchemaName+"."+r.OldName, r.ChangeReason)
+			}
+		}
+		for _, f := range sc.ChangedFields {
+			subject := sc.SchemaName + "." + f.Path
+			switch {
+			case f.Severity == specdiff.SeverityDeprecated:
+				add(SectionDeprecations, schemaTags, subject, f.ChangeReason)
+			case !affectsClients(f.Severity):
+			case strings.HasPrefix(f.ChangeReason, "Enum value removed"):
+				add(SectionEnumValues, schemaTags, subject, f.ChangeReason)
+			case f.IsRequired && !f.WasRequired:
+				add(SectionRequiredInputs, schemaTags, subject, f.ChangeReason)
+			default:
+				add(SectionRewrites, schemaTags, subject, f.ChangeReason)
+			}
+		}
+	}
+
+	return entries
+}
+
+// affectsClients reports whether a change breaks existing clients. Changes
+// to schemas of unknown direction are included to be safe.
+func affectsClients(sev specdiff.Severity) bool {
+	return sev == specdiff.SeverityClientBreaking || sev == specdiff.SeverityBreaking
+}
+
+// requiresInput reports whether an operation change makes clients send
+// something they did not have to send before
+func requiresInput(reason string) bool {
+	reason = strings.ToLower(reason)
+	return strings.HasPrefix(reason, "new required") || strings.HasPrefix(reason, "required") ||
+		strings.HasSuffix(reason, "to required")
+}
+
+// schemaTags maps each component schema an operation uses directly (in
+// parameters, request bodies or responses) to the tags of the operations
+// using it, in both specs
+func schemaTags(specs ...*specdiff.Spec) map[string][]string {
+	tagSets := make(map[string]map[string]bool)
+	for _, spec := range specs {
+		for _, pathItem := range spec.Paths {
+			item, _ := pathItem.(map[string]interface{})
+			for _, op := range item {
+				opMap, ok := op.(map[string]interface{})
+				if !ok {
+					continue
+				}
+				tags, _ := opMap["tags"].([]interface{})
+				var refs []string
+				for _, key := range []string{"parameters", "requestBody", "responses"} {
+					refs = append(refs, schemaRefs(opMap[key])...)
+				}
+				for _, ref := range refs {
+					if tagSets[ref] == nil {
+						tagSets[ref] = make(map[string]bool)
+					}
+					for _, t := range tags {
+						if tag, ok := t.(string); ok {
+							tagSets[ref][tag] = true
+						}
+					}
+				}
+			}
+		}
+	}
+
+	result := make(map[string][]string, len(tagSets))
+	for schema, set := range tagSets {
+		tags := make([]string, 0, len(set))
+		for tag := range set {
+			tags = append(tags, tag)
+		}
+		sort.Strings(tags)
+		result[schema] = tags
+	}
+	return result
+}
+
+// schemaRefs returns the component schemas referenced in a spec fragment
+func schemaRefs(v interface{}) []string {
+	var refs []string
+	switch node := v.(type) {
+	case map[string]interface{}:
+		if ref, ok := node["$ref"].(string); ok && strings.HasPrefix(ref, "#/components/schemas/") {
+			refs = append(refs, strings.TrimPrefix(ref, "#/components/schemas/"))
+		}
+		for _, child := range node {
+			refs = append(refs, schemaRefs(child)...)
+		}
+	case []interface{}:
+		for _, child := range node {
+			refs = append(refs, schemaRefs(child)...)
+		}
+	}
+	return refs
+}
+
+// Markdown renders the guide, one section per tag
+func (g *Guide) Markdown() []byte {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "# Migrating from CheckMK %s to %s\n\n", g.From, g.To)
+
+	if len(g.Baselines) < 2 {
+		fmt.Fprintf(&buf, "Both versions share the API of baseline %s; no changes are needed.\n", g.Baselines[0])
+		return buf.Bytes()
+	}
+	fmt.Fprintf(&buf, "Compared API baselines: %s.\n\n", strings.Join(g.Baselines, " → "))
+
+	if len(g.Entries) == 0 {
+		buf.WriteString("No changes affect existing clients.\n")
+		return buf.Bytes()
+	}
+
+	// Overview, counting a change of a shared schema once however many tags
+	// list it
+	counts := make(map[string]int)
+	counted := make(map[string]bool)
+	byTag := make(map[string][]Entry)
+	for _, e := range g.Entries {
+		if key := e.Section + "|" + e.Subject + "|" + e.Reason; !counted[key] {
+			counted[key] = true
+			counts[e.Section]++
+		}
+		byTag[e.Tag] = append(byTag[e.Tag], e)
+	}
+	buf.WriteString("| Change | Count |\n|--------|-------|\n")
+	for _, section := range sectionOrder {
+		fmt.Fprintf(&buf, "| %s | %d |\n", section, counts[section])
+	}
+	buf.WriteString("\nChanges to shared schemas are listed under every tag using them.\n")
+
+	tags := make([]string, 0, len(byTag))
+	for tag := range byTag {
+		tags = append(tags, tag)
+	}
+	sort.Slice(tags, func(i, j int) bool {
+		if (tags[i] == untagged) != (tags[j] == untagged) {
+			return tags[j] == untagged
+		}
+		return tags[i] < tags[j]
+	})
+
+	for _, tag := range tags {
+		fmt.Fprintf(&buf, "\n## %s\n", tag)
+		for _, section := range sectionOrder {
+			var entries []Entry
+			for _, e := range byTag[tag] {
+				if e.Section == section {
+					entries = append(entries, e)
+				}
+			}
+			if len(entries) == 0 {
+				continue
+			}
+			sort.SliceStable(entries, func(i, j int) bool { return entries[i].Subject < entries[j].Subject })
+
+			fmt.Fprintf(&buf, "\n### %s\n\n", section)
+			for _, e := range entries {
+				fmt.Fprintf(&buf, "- `%s`: %s", e.Subject, e.Reason)
+				if e.Since != "" {
+					fmt.Fprintf(&buf, " (since %s)", e.Since)
+				}
+				buf.WriteString("\n")
+			}
+		}
+	}
+
+	return buf.Bytes()
+}
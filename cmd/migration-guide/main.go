@@ -0,0 +1,221 @@
+// Package main implements the migration-guide tool, which explains what API
+// clients must change when moving between two CheckMK versions.
+//
+// Both versions are resolved to their baselines through manifest.json. The
+// baselines are compared directly, so changes that were reverted on the way
+// are left out, and every consecutive pair of baselines in between is
+// compared as well, to tell in which release each change happened. The
+// guide lists removed endpoints, fields needing rewrites, newly required
+// inputs, vanished enum values and deprecations, grouped by tag.
+//
+// Editions other than raw are given as a suffix of both versions
+// (2.3.0p41.cee); a guide never crosses editions.
+//
+// Usage:
+//
+//	migration-guide -from 2.2.0p44 -to 2.4.0p18 -output migration.md
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/BlackMesaLTD/checkmk-api-spec/cmkversion"
+	"github.com/BlackMesaLTD/checkmk-api-spec/specdiff"
+)
+
+// ManifestInput matches the manifest.json structure
+type ManifestInput struct {
+	Baselines []string                `json:"baselines"`
+	Mapping   map[string]BaselineInfo `json:"mapping"`
+}
+
+// BaselineInfo describes how a version maps to a baseline
+type BaselineInfo struct {
+	Spec     string `json:"spec"`
+	Edition  string `json:"edition"`
+	Baseline string `json:"baseline"`
+}
+
+func main() {
+	var (
+		from         = flag.String("from", "", "Version migrating from (e.g. 2.2.0p44)")
+		to           = flag.String("to", "", "Version migrating to (e.g. 2.4.0p18)")
+		manifestPath = flag.String("manifest", "manifest.json", "Path to manifest.json")
+		specsDir     = flag.String("specs", "specs", "Directory containing spec files")
+		output       = flag.String("output", "", "Output markdown file (optional, prints to stdout if not specified)")
+	)
+	flag.Parse()
+
+	if *from == "" || *to == "" {
+		log.Fatal("Error: both -from and -to flags are required")
+	}
+
+	data, err := os.ReadFile(*manifestPath)
+	if err != nil {
+		log.Fatalf("Failed to read manifest: %v", err)
+	}
+	var manifest ManifestInput
+	if err := json.Unmarshal(data, &manifest); err != nil {
+		log.Fatalf("Failed to parse manifest: %v", err)
+	}
+
+	edition, fromBaseline, toBaseline, err := resolveRange(&manifest, *from, *to)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	chain := baselineChain(&manifest, edition, fromBaseline, toBaseline)
+	log.Printf("Migrating %s → %s: baselines %s", *from, *to, strings.Join(chain, " → "))
+
+	specs := make(map[string]*specdiff.Spec, len(chain))
+	for _, baseline := range chain {
+		spec, err := specdiff.LoadSpec(filepath.Join(*specsDir, manifest.Mapping[baseline].Spec))
+		if err != nil {
+			log.Fatalf("Failed to load spec of %s: %v", baseline, err)
+		}
+		specs[baseline] = spec
+	}
+
+	guide := &Guide{From: *from, To: *to, Baselines: chain}
+	if len(chain) > 1 {
+		oldSpec, newSpec := specs[fromBaseline], specs[toBaseline]
+		report := specdiff.Compare(oldSpec, newSpec, specdiff.WithVersions(fromBaseline, toBaseline))
+		guide.Entries = collectEntries(report, schemaTags(oldSpec, newSpec))
+
+		attributeSince(guide.Entries, chain, specs)
+	}
+
+	markdown := guide.Markdown()
+	if *output != "" {
+		if err := os.WriteFile(*output, markdown, 0644); err != nil {
+			log.Fatalf("Failed to write output: %v", err)
+		}
+		fmt.Printf("Migration guide written to: %s\n", *output)
+	} else {
+		fmt.Print(string(markdown))
+	}
+}
+
+// resolveRange returns the edition and the baselines of the versions
+// migrated between. Migrations across editions and downgrades are rejected.
+func resolveRange(manifest *ManifestInput, from, to string) (cmkversion.Edition, string, string, error) {
+	fromEdition, err := editionOf(from)
+	if err != nil {
+		return "", "", "", err
+	}
+	toEdition, err := editionOf(to)
+	if err != nil {
+		return "", "", "", err
+	}
+	if fromEdition != toEdition {
+		return "", "", "", fmt.Errorf("%s and %s are of different editions; migrating between editions is not supported", from, to)
+	}
+
+	fromBaseline, err := resolveBaseline(manifest, from)
+	if err != nil {
+		return "", "", "", err
+	}
+	toBaseline, err := resolveBaseline(manifest, to)
+	if err != nil {
+		return "", "", "", err
+	}
+	if cmkversion.CompareStrings(fromBaseline, toBaseline) > 0 {
+		return "", "", "", fmt.Errorf("%s is newer than %s; downgrades are not supported", from, to)
+	}
+	return fromEdition, fromBaseline, toBaseline, nil
+}
+
+// attributeSince sets the baseline each entry was introduced by, found by
+// comparing every consecutive pair of baselines in the chain
+func attributeSince(entries []Entry, chain []string, specs map[string]*specdiff.Spec) {
+	since := make(map[string]string)
+	for i := 1; i < len(chain); i++ {
+		stepOld, stepNew := specs[chain[i-1]], specs[chain[i]]
+		step := specdiff.Compare(stepOld, stepNew, specdiff.WithVersions(chain[i-1], chain[i]))
+		for _, e := range collectEntries(step, schemaTags(stepOld, stepNew)) {
+			if _, seen := since[e.key()]; !seen {
+				since[e.key()] = chain[i]
+			}
+		}
+	}
+	for i := range entries {
+		entries[i].Since = since[entries[i].key()]
+	}
+}
+
+// editionOf returns the edition of a version, raw if it has no suffix
+func editionOf(version string) (cmkversion.Edition, error) {
+	v, err := cmkversion.Parse(version)
+	if err != nil {
+		return "", err
+	}
+	if v.Edition() == "" {
+		return cmkversion.EditionRaw, nil
+	}
+	return v.Edition(), nil
+}
+
+// entryEdition returns the edition of a manifest entry; entries without one
+// are raw edition
+func entryEdition(info BaselineInfo) cmkversion.Edition {
+	if info.Edition == "" {
+		return cmkversion.EditionRaw
+	}
+	return cmkversion.Edition(info.Edition)
+}
+
+// resolveBaseline returns the baseline of a version. A version missing from
+// the manifest has the API of the closest earlier version of its minor
+// release and edition.
+func resolveBaseline(manifest *ManifestInput, version string) (string, error) {
+	v, err := cmkversion.Parse(version)
+	if err != nil {
+		return "", err
+	}
+	edition, _ := editionOf(version)
+	// Raw edition versions are listed without suffix
+	if edition == cmkversion.EditionRaw {
+		v = v.WithEdition("")
+	}
+	if info, ok := manifest.Mapping[v.String()]; ok {
+		return info.Baseline, nil
+	}
+
+	closest := ""
+	for known, info := range manifest.Mapping {
+		k, err := cmkversion.Parse(known)
+		if err != nil || entryEdition(info) != edition || k.Branch() != v.Branch() || k.Compare(v) >= 0 {
+			continue
+		}
+		if closest == "" || cmkversion.CompareStrings(known, closest) > 0 {
+			closest = known
+		}
+	}
+	if closest == "" {
+		return "", fmt.Errorf("version %s is not covered by the manifest", version)
+	}
+	log.Printf("Note: %s is not in the manifest, using %s", version, closest)
+	return manifest.Mapping[closest].Baseline, nil
+}
+
+// baselineChain returns the baselines of an edition from one to another,
+// both included
+func baselineChain(manifest *ManifestInput, edition cmkversion.Edition, from, to string) []string {
+	var chain []string
+	for _, b := range manifest.Baselines {
+		if entryEdition(manifest.Mapping[b]) != edition {
+			continue
+		}
+		if cmkversion.CompareStrings(b, from) >= 0 && cmkversion.CompareStrings(b, to) <= 0 {
+			chain = append(chain, b)
+		}
+	}
+	cmkversion.Sort(chain)
+	return chain
+}
@@ -0,0 +1,296 @@
+package main
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/BlackMesaLTD/checkmk-api-spec/cmkversion"
+	"github.com/BlackMesaLTD/checkmk-api-spec/specdiff"
+)
+
+// testManifest has raw baselines 2.3.0p1, p5 and p9 and 2.4.0p1, and
+// enterprise baselines 2.3.0p1.cee and p5.cee
+func testManifest() *ManifestInput {
+	return &ManifestInput{
+		Baselines: []string{"2.3.0p1", "2.3.0p5", "2.3.0p9", "2.4.0p1", "2.3.0p1.cee", "2.3.0p5.cee"},
+		Mapping: map[string]BaselineInfo{
+			"2.3.0p1":     {Spec: "2.3.0/p1.yaml", Baseline: "2.3.0p1"},
+			"2.3.0p2":     {Spec: "2.3.0/p1.yaml", Baseline: "2.3.0p1"},
+			"2.3.0p5":     {Spec: "2.3.0/p5.yaml", Baseline: "2.3.0p5"},
+			"2.3.0p9":     {Spec: "2.3.0/p9.yaml", Baseline: "2.3.0p9"},
+			"2.4.0p1":     {Spec: "2.4.0/p1.yaml", Baseline: "2.4.0p1"},
+			"2.3.0p1.cee": {Spec: "2.3.0/p1.cee.yaml", Edition: "cee", Baseline: "2.3.0p1.cee"},
+			"2.3.0p5.cee": {Spec: "2.3.0/p5.cee.yaml", Edition: "cee", Baseline: "2.3.0p5.cee"},
+		},
+	}
+}
+
+func TestResolveBaseline(t *testing.T) {
+	tests := []struct {
+		name     string
+		version  string
+		baseline string // Empty if the version is not covered
+	}{
+		{"baseline", "2.3.0p5", "2.3.0p5"},
+		{"listed version", "2.3.0p2", "2.3.0p1"},
+		{"missing version resolves to the earlier baseline", "2.3.0p7", "2.3.0p5"},
+		{"missing version between listed ones", "2.3.0p3", "2.3.0p1"},
+		{"newer than all versions of the minor", "2.3.0p40", "2.3.0p9"},
+		{"edition baseline", "2.3.0p5.cee", "2.3.0p5.cee"},
+		{"missing edition version ignores raw versions", "2.3.0p12.cee", "2.3.0p5.cee"},
+		{"older than all versions of the minor", "2.4.0b1", ""},
+		{"unknown minor", "2.5.0p1", ""},
+		{"edition without versions", "2.3.0p5.cme", ""},
+	}
+
+	manifest := testManifest()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := resolveBaseline(manifest, tt.version)
+			if tt.baseline == "" {
+				if err == nil {
+					t.Errorf("resolveBaseline(%s) = %s, want an error", tt.version, got)
+				}
+				return
+			}
+			if err != nil || got != tt.baseline {
+				t.Errorf("resolveBaseline(%s) = %s, %v, want %s", tt.version, got, err, tt.baseline)
+			}
+		})
+	}
+}
+
+func TestResolveRange(t *testing.T) {
+	tests := []struct {
+		name      string
+		from, to  string
+		edition   cmkversion.Edition
+		baselines [2]string
+		err       string // Part of the error, empty if none
+	}{
+		{"upgrade", "2.3.0p2", "2.4.0p3", cmkversion.EditionRaw, [2]string{"2.3.0p1", "2.4.0p1"}, ""},
+		{"same baseline", "2.3.0p5", "2.3.0p7", cmkversion.EditionRaw, [2]string{"2.3.0p5", "2.3.0p5"}, ""},
+		{"edition upgrade", "2.3.0p1.cee", "2.3.0p6.cee", cmkversion.EditionEnterprise, [2]string{"2.3.0p1.cee", "2.3.0p5.cee"}, ""},
+		{"downgrade", "2.4.0p1", "2.3.0p9", "", [2]string{}, "downgrades are not supported"},
+		{"downgrade within a baseline's versions", "2.3.0p9", "2.3.0p6", "", [2]string{}, "downgrades are not supported"},
+		{"cross edition", "2.3.0p1", "2.3.0p5.cee", "", [2]string{}, "different editions"},
+		{"cross edition from an edition", "2.3.0p1.cee", "2.3.0p5", "", [2]string{}, "different editions"},
+		{"uncovered version", "2.3.0p1", "2.5.0p1", "", [2]string{}, "not covered"},
+		{"invalid version", "2.3", "2.3.0p1", "", [2]string{}, "2.3"},
+	}
+
+	manifest := testManifest()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			edition, from, to, err := resolveRange(manifest, tt.from, tt.to)
+			if tt.err != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.err) {
+					t.Errorf("resolveRange(%s, %s) = %v, want an error containing %q", tt.from, tt.to, err, tt.err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("resolveRange(%s, %s): %v", tt.from, tt.to, err)
+			}
+			if edition != tt.edition || [2]string{from, to} != tt.baselines {
+				t.Errorf("resolveRange(%s, %s) = %s, %s, %s, want %s, %v", tt.from, tt.to, edition, from, to, tt.edition, tt.baselines)
+			}
+		})
+	}
+}
+
+func TestBaselineChain(t *testing.T) {
+	manifest := testManifest()
+	tests := []struct {
+		edition  cmkversion.Edition
+		from, to string
+		want     []string
+	}{
+		{cmkversion.EditionRaw, "2.3.0p1", "2.4.0p1", []string{"2.3.0p1", "2.3.0p5", "2.3.0p9", "2.4.0p1"}},
+		{cmkversion.EditionRaw, "2.3.0p5", "2.3.0p9", []string{"2.3.0p5", "2.3.0p9"}},
+		{cmkversion.EditionRaw, "2.3.0p5", "2.3.0p5", []string{"2.3.0p5"}},
+		{cmkversion.EditionEnterprise, "2.3.0p1.cee", "2.3.0p5.cee", []string{"2.3.0p1.cee", "2.3.0p5.cee"}},
+	}
+	for _, tt := range tests {
+		if got := baselineChain(manifest, tt.edition, tt.from, tt.to); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("baselineChain(%s, %s, %s) = %v, want %v", tt.edition, tt.from, tt.to, got, tt.want)
+		}
+	}
+}
+
+func TestCollectEntries(t *testing.T) {
+	report := &specdiff.DiffReport{
+		PathChanges: &specdiff.PathChanges{
+			Removed: []*specdiff.PathChange{{Path: "/objects/widget", Methods: []string{"GET", "PUT"}, Tags: []string{"Widgets"}}},
+		},
+		OperationChanges: []*specdiff.OperationChange{
+			{Path: "/objects/host", Method: "DELETE", Severity: specdiff.SeverityClientBreaking, ChangeReason: "Method removed", Tags: []string{"Hosts"}},
+			{Path: "/objects/host", Method: "GET", Name: "query:sites", Severity: specdiff.SeverityDeprecated, ChangeReason: "Parameter marked as deprecated", Tags: []string{"Hosts"}},
+			{Path: "/objects/host", Method: "POST", Name: "query:mode", Severity: specdiff.SeverityClientBreaking, ChangeReason: "New required parameter", Tags: []string{"Hosts"}},
+			{Path: "/objects/host", Method: "POST", Name: "query:state", Severity: specdiff.SeverityClientBreaking, ChangeReason: "Enum value removed: down", Tags: []string{"Hosts"}},
+			{Path: "/objects/host", Method: "POST", Name: "query:limit", Severity: specdiff.SeverityClientBreaking, ChangeReason: "Type changed: string → integer", Tags: []string{"Hosts"}},
+			{Path: "/objects/host", Method: "POST", Name: "200", Severity: specdiff.SeverityServerBreaking, ChangeReason: "Response removed"},
+			{Path: "/objects/host", Method: "POST", Name: "query:page", Severity: specdiff.SeverityMinor, ChangeReason: "New optional parameter"},
+		},
+		SchemaChanges: []*specdiff.SchemaChange{
+			{
+				SchemaName:    "CreateHost",
+				AddedFields:   []*specdiff.FieldDiff{{Path: "folder", IsRequired: true, Severity: specdiff.SeverityClientBreaking, ChangeReason: "New required field"}, {Path: "labels", Severity: specdiff.SeverityMinor}},
+				RemovedFields: []*specdiff.FieldDiff{{Path: "alias", Severity: specdiff.SeverityClientBreaking, ChangeReason: "Field removed"}},
+				RenamedFields: []*specdiff.Rename{{OldName: "hostname", NewName: "host_name", Severity: specdiff.SeverityClientBreaking, ChangeReason: "Field renamed: hostname → host_name"}},
+				ChangedFields: []*specdiff.FieldDiff{
+					{Path: "site", IsRequired: true, Severity: specdiff.SeverityClientBreaking, ChangeReason: "Field became required"},
+					{Path: "tags", Severity: specdiff.SeverityClientBreaking, ChangeReason: "Enum value removed: ip-v4"},
+					{Path: "ipaddress", Severity: specdiff.SeverityDeprecated, ChangeReason: "Field marked as deprecated"},
+					{Path: "parents", Severity: specdiff.SeverityClientBreaking, ChangeReason: "Type changed: string → array"},
+				},
+			},
+			{
+				SchemaName:    "HostConfig",
+				RemovedFields: []*specdiff.FieldDiff{{Path: "is_cluster", Severity: specdiff.SeverityServerBreaking, ChangeReason: "Field removed"}},
+			},
+			{
+				// Used only through CreateHost, so left out
+				SchemaName:    "HostAttributes",
+				RemovedFields: []*specdiff.FieldDiff{{Path: "alias", Severity: specdiff.SeverityClientBreaking, ChangeReason: "Field removed"}},
+			},
+			{
+				SchemaName:    "Orphan",
+				RemovedFields: []*specdiff.FieldDiff{{Path: "x", Severity: specdiff.SeverityBreaking, ChangeReason: "Field removed"}},
+			},
+		},
+	}
+	tags := map[string][]string{
+		"CreateHost": {"Folders", "Hosts"},
+		"HostConfig": {"Hosts"},
+		"Orphan":     nil,
+	}
+
+	var got []string
+	for _, e := range collectEntries(report, tags) {
+		got = append(got, e.Section+" | "+e.Tag+" | "+e.Subject)
+	}
+	want := []string{
+		SectionRemovedEndpoints + " | Widgets | GET, PUT /objects/widget",
+		SectionRemovedEndpoints + " | Hosts | DELETE /objects/host",
+		SectionDeprecations + " | Hosts | GET /objects/host (query:sites)",
+		SectionRequiredInputs + " | Hosts | POST /objects/host (query:mode)",
+		SectionEnumValues + " | Hosts | POST /objects/host (query:state)",
+		SectionRewrites + " | Hosts | POST /objects/host (query:limit)",
+		SectionRequiredInputs + " | Folders | CreateHost.folder",
+		SectionRequiredInputs + " | Hosts | CreateHost.folder",
+		SectionRewrites + " | Folders | CreateHost.alias",
+		SectionRewrites + " | Hosts | CreateHost.alias",
+		SectionRewrites + " | Folders | CreateHost.hostname",
+		SectionRewrites + " | Hosts | CreateHost.hostname",
+		SectionRequiredInputs + " | Folders | CreateHost.site",
+		SectionRequiredInputs + " | Hosts | CreateHost.site",
+		SectionEnumValues + " | Folders | CreateHost.tags",
+		SectionEnumValues + " | Hosts | CreateHost.tags",
+		SectionDeprecations + " | Folders | CreateHost.ipaddress",
+		SectionDeprecations + " | Hosts | CreateHost.ipaddress",
+		SectionRewrites + " | Folders | CreateHost.parents",
+		SectionRewrites + " | Hosts | CreateHost.parents",
+		SectionRewrites + " | " + untagged + " | Orphan.x",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("collectEntries() =\n%s\nwant\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+}
+
+// sinceSpec returns a spec of a host API, with the alias field and the
+// folder endpoint if asked for
+func sinceSpec(t *testing.T, alias, folder bool) *specdiff.Spec {
+	t.Helper()
+	yaml := `
+paths:
+  /objects/host:
+    post:
+      tags: [Hosts]
+      requestBody:
+        content:
+          application/json:
+            schema:
+              $ref: '#/components/schemas/CreateHost'
+`
+	if folder {
+		yaml += `
+  /objects/folder:
+    get:
+      tags: [Folders]
+`
+	}
+	yaml += `
+components:
+  schemas:
+    CreateHost:
+      type: object
+      properties:
+        host_name:
+          type: string
+`
+	if alias {
+		yaml += `
+        alias:
+          type: string
+`
+	}
+	spec, err := specdiff.ParseSpec([]byte(yaml))
+	if err != nil {
+		t.Fatalf("ParseSpec: %v", err)
+	}
+	return spec
+}
+
+func TestAttributeSince(t *testing.T) {
+	// The alias field goes in p5, the folder endpoint in p9
+	chain := []string{"2.3.0p1", "2.3.0p5", "2.3.0p9"}
+	specs := map[string]*specdiff.Spec{
+		"2.3.0p1": sinceSpec(t, true, true),
+		"2.3.0p5": sinceSpec(t, false, true),
+		"2.3.0p9": sinceSpec(t, false, false),
+	}
+	report := specdiff.Compare(specs["2.3.0p1"], specs["2.3.0p9"], specdiff.WithVersions("2.3.0p1", "2.3.0p9"))
+	entries := collectEntries(report, schemaTags(specs["2.3.0p1"], specs["2.3.0p9"]))
+	attributeSince(entries, chain, specs)
+
+	got := make(map[string]string)
+	for _, e := range entries {
+		got[e.Subject] = e.Since
+	}
+	want := map[string]string{
+		"CreateHost.alias":    "2.3.0p5",
+		"GET /objects/folder": "2.3.0p9",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Since = %v, want %v", got, want)
+	}
+}
+
+func TestMarkdownOverview(t *testing.T) {
+	// A shared schema change listed under two tags is one change
+	shared := Entry{Section: SectionRewrites, Subject: "HostConditions.labels", Reason: "Type changed: string → array"}
+	hosts, rules := shared, shared
+	hosts.Tag, rules.Tag = "Hosts", "Rules"
+	g := &Guide{
+		From:      "2.3.0p1",
+		To:        "2.3.0p9",
+		Baselines: []string{"2.3.0p1", "2.3.0p9"},
+		Entries: []Entry{
+			hosts,
+			rules,
+			{Section: SectionRemovedEndpoints, Tag: "Hosts", Subject: "DELETE /objects/host", Reason: "Method removed"},
+		},
+	}
+
+	md := string(g.Markdown())
+	for _, row := range []string{"| Removed endpoints | 1 |", "| Fields needing rewrites | 1 |", "| Newly required inputs | 0 |"} {
+		if !strings.Contains(md, row) {
+			t.Errorf("overview lacks %q:\n%s", row, md)
+		}
+	}
+	if n := strings.Count(md, "HostConditions.labels"); n != 2 {
+		t.Errorf("shared change listed %d times, want under both tags", n)
+	}
+}
@@ -0,0 +1,84 @@
+// Package main implements the mock-server tool, a local CheckMK REST API
+// emulator driven by a baseline spec.
+//
+// Hosts, folders, users, groups, tags, rules and other object types are kept
+// in memory; requests are validated against the spec and ETag/If-Match is
+// enforced like on a real site.
+//
+// Usage:
+//
+//	mock-server -spec specs/2.4.0/p17.yaml -addr :5040
+//	mock-server -version 2.3.0p30 -user automation -secret test
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"log"
+	"net/http"
+	"os"
+	"path/filepath"
+
+	"github.com/BlackMesaLTD/checkmk-api-spec/mockserver"
+)
+
+// Manifest is the subset of manifest.json needed to resolve versions.
+type Manifest struct {
+	Mapping map[string]struct {
+		Spec string `json:"spec"`
+	} `json:"mapping"`
+}
+
+func main() {
+	var (
+		specPath     = flag.String("spec", "", "OpenAPI spec file to serve")
+		version      = flag.String("version", "", "CheckMK version to serve, resolved via the manifest (e.g., 2.4.0p17 or 2.4.0p17.cee)")
+		manifestPath = flag.String("manifest", "manifest.json", "manifest.json for -version")
+		specsDir     = flag.String("specs-dir", "specs", "Specs directory")
+		addr         = flag.String("addr", ":5040", "Listen address")
+		basePath     = flag.String("base-path", "", "API root path (default: from the spec's servers)")
+		edition      = flag.String("edition", "cre", "Reported CheckMK edition")
+		user         = flag.String("user", "", "Require this automation user")
+		secret       = flag.String("secret", "", "Secret of -user")
+	)
+	flag.Parse()
+
+	if *specPath == "" && *version == "" {
+		log.Fatal("Error: either -spec or -version is required")
+	}
+
+	if *specPath == "" {
+		data, err := os.ReadFile(*manifestPath)
+		if err != nil {
+			log.Fatalf("Failed to read manifest: %v", err)
+		}
+		var manifest Manifest
+		if err := json.Unmarshal(data, &manifest); err != nil {
+			log.Fatalf("Failed to parse manifest: %v", err)
+		}
+		entry, ok := manifest.Mapping[*version]
+		if !ok {
+			log.Fatalf("Error: version %s not found in manifest", *version)
+		}
+		*specPath = filepath.Join(*specsDir, entry.Spec)
+	}
+
+	opts := []mockserver.Option{mockserver.WithEdition(*edition)}
+	if *version != "" {
+		opts = append(opts, mockserver.WithVersion(*version))
+	}
+	if *basePath != "" {
+		opts = append(opts, mockserver.WithBasePath(*basePath))
+	}
+	if *user != "" {
+		opts = append(opts, mockserver.WithCredentials(*user, *secret))
+	}
+
+	srv, err := mockserver.Load(*specPath, opts...)
+	if err != nil {
+		log.Fatalf("Failed to load spec: %v", err)
+	}
+
+	log.Printf("Serving CheckMK %s from %s at http://%s%s", srv.Version, *specPath, *addr, srv.BasePath)
+	log.Fatal(http.ListenAndServe(*addr, srv))
+}
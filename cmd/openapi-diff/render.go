@@ -0,0 +1,441 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"html/template"
+	"sort"
+	"strings"
+
+	"github.com/BlackMesaLTD/checkmk-api-spec/specdiff"
+)
+
+// Output formats of the report
+const (
+	FormatJSON     = "json"
+	FormatMarkdown = "markdown"
+	FormatHTML     = "html"
+	FormatJUnit    = "junit"
+	FormatSARIF    = "sarif"
+)
+
+// groupSchemas is the group of schema changes, listed after the endpoint
+// categories
+const groupSchemas = "schemas"
+
+// groupOrder is the order in which groups are rendered; other categories
+// follow in alphabetical order, before the schemas
+var groupOrder = []string{string(specdiff.CategorySetup), string(specdiff.CategoryMonitoring), string(specdiff.CategoryInternal)}
+
+// reportEntry is a single change of a report, flattened for rendering
+type reportEntry struct {
+	Group    string // Endpoint category or "schemas"
+	Subject  string // e.g. "GET /domain-types/host_config/collections/all", "HostConfig.attributes.alias"
+	Severity specdiff.Severity
+	Reason   string
+	Detail   string // Old and new value, if any
+}
+
+// renderReport renders a report in the given format. path is the new spec,
+// which SARIF results point at.
+func renderReport(report *specdiff.DiffReport, format, path string) ([]byte, error) {
+	switch format {
+	case FormatJSON:
+		return json.MarshalIndent(report, "", "  ")
+	case FormatMarkdown:
+		return renderMarkdown(report), nil
+	case FormatHTML:
+		return renderHTML(report)
+	case FormatJUnit:
+		return renderJUnit(report)
+	case FormatSARIF:
+		return renderSARIF(report, path)
+	default:
+		return nil, fmt.Errorf("unknown format %q (want json, markdown, html, junit or sarif)", format)
+	}
+}
+
+// flattenReport lists every change of a report, sorted by group, then by
+// descending severity and subject
+func flattenReport(report *specdiff.DiffReport) []reportEntry {
+	var entries []reportEntry
+
+	if report.PathChanges != nil {
+		for _, p := range report.PathChanges.Added {
+			entries = append(entries, reportEntry{string(p.Category), p.Path, p.Severity, "Path added", strings.Join(p.Methods, ", ")})
+		}
+		for _, p := range report.PathChanges.Removed {
+			entries = append(entries, reportEntry{string(p.Category), p.Path, p.Severity, "Path removed", strings.Join(p.Methods, ", ")})
+		}
+	}
+
+	for _, c := range report.OperationChanges {
+		subject := strings.ToUpper(c.Method) + " " + c.Path
+		if c.Name != "" {
+			subject += " (" + c.Name + ")"
+		}
+		entries = append(entries, reportEntry{string(c.Category), subject, c.Severity, c.ChangeReason, formatChange(c.Old, c.New)})
+	}
+
+	for _, r := range report.SchemaRenames {
+		entries = append(entries, reportEntry{groupSchemas, r.NewName, r.Severity, r.ChangeReason, fmt.Sprintf("confidence %.2f", r.Confidence)})
+	}
+
+	for _, s := range report.SchemaChanges {
+		for _, fields := range [][]*specdiff.FieldDiff{s.AddedFields, s.RemovedFields, s.ChangedFields} {
+			for _, f := range fields {
+				detail := formatChange(f.OldValue, f.NewValue)
+				switch {
+				case detail != "":
+				case f.OldType == "" || f.NewType == "":
+					detail = f.OldType + f.NewType // Type of an added or removed field
+				case f.OldType != f.NewType:
+					detail = formatChange(f.OldType, f.NewType)
+				}
+				entries = append(entries, reportEntry{groupSchemas, s.SchemaName + "." + f.Path, f.Severity, f.ChangeReason, detail})
+			}
+		}
+		for _, r := range s.RenamedFields {
+			entries = append(entries, reportEntry{groupSchemas, s.SchemaName + "." + r.NewName, r.Severity, r.ChangeReason, fmt.Sprintf("confidence %.2f", r.Confidence)})
+		}
+	}
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		a, b := entries[i], entries[j]
+		if a.Group != b.Group {
+			return groupBefore(a.Group, b.Group)
+		}
+		if a.Severity != b.Severity {
+			return specdiff.SeverityOrder[a.Severity] > specdiff.SeverityOrder[b.Severity]
+		}
+		return a.Subject < b.Subject
+	})
+	return entries
+}
+
+// groupBefore reports whether group a is rendered before group b
+func groupBefore(a, b string) bool {
+	rank := func(g string) int {
+		if g == groupSchemas {
+			return len(groupOrder) + 1
+		}
+		for i, known := range groupOrder {
+			if g == known {
+				return i
+			}
+		}
+		return len(groupOrder)
+	}
+	if rank(a) != rank(b) {
+		return rank(a) < rank(b)
+	}
+	return a < b
+}
+
+// groupEntries splits sorted entries by group
+func groupEntries(entries []reportEntry) [][]reportEntry {
+	var groups [][]reportEntry
+	for _, e := range entries {
+		if len(groups) == 0 || groups[len(groups)-1][0].Group != e.Group {
+			groups = append(groups, nil)
+		}
+		groups[len(groups)-1] = append(groups[len(groups)-1], e)
+	}
+	return groups
+}
+
+func formatChange(old, new string) string {
+	switch {
+	case old == "" && new == "":
+		return ""
+	case old == "":
+		return new
+	case new == "":
+		return old + " → (none)"
+	default:
+		return old + " → " + new
+	}
+}
+
+// groupTitle returns the heading of a group, e.g. "Setup endpoints"
+func groupTitle(group string) string {
+	if group == groupSchemas {
+		return "Schemas"
+	}
+	title := strings.ReplaceAll(group, "_", " ")
+	return strings.ToUpper(title[:1]) + title[1:] + " endpoints"
+}
+
+// renderMarkdown renders a report as a PR comment. Breaking and deprecated
+// changes are listed in full; minor and docs changes are collapsed, as they
+// would otherwise bury the ones needing attention.
+func renderMarkdown(report *specdiff.DiffReport) []byte {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "## API changes %s → %s\n\n", report.OldVersion, report.NewVersion)
+	fmt.Fprintf(&buf, "**Max severity:** `%s`\n\n", report.MaxSeverity)
+
+	if s := report.Summary; s != nil {
+		buf.WriteString("| Severity | Changes |\n|----------|---------|\n")
+		fmt.Fprintf(&buf, "| client-breaking | %d |\n", s.ClientBreakingChanges)
+		fmt.Fprintf(&buf, "| server-breaking | %d |\n", s.ServerBreakingChanges)
+		fmt.Fprintf(&buf, "| breaking (direction unknown) | %d |\n", s.BreakingChanges-s.ClientBreakingChanges-s.ServerBreakingChanges)
+		fmt.Fprintf(&buf, "| deprecated | %d |\n", s.DeprecatedChanges)
+		fmt.Fprintf(&buf, "| minor | %d |\n", s.MinorChanges)
+		fmt.Fprintf(&buf, "| docs | %d |\n\n", s.DocsChanges)
+		fmt.Fprintf(&buf, "Schemas: %d → %d (%d added, %d removed, %d renamed, %d changed)\n\n",
+			s.TotalSchemasOld, s.TotalSchemasNew, s.SchemasAdded, s.SchemasRemoved, s.SchemasRenamed, s.SchemasWithChanges)
+	}
+
+	for _, group := range groupEntries(flattenReport(report)) {
+		var notable, other []reportEntry
+		for _, e := range group {
+			if specdiff.SeverityOrder[e.Severity] >= specdiff.SeverityOrder[specdiff.SeverityDeprecated] {
+				notable = append(notable, e)
+			} else {
+				other = append(other, e)
+			}
+		}
+
+		fmt.Fprintf(&buf, "### %s\n\n", groupTitle(group[0].Group))
+		if len(notable) > 0 {
+			writeMarkdownTable(&buf, notable)
+		}
+		if len(other) > 0 {
+			fmt.Fprintf(&buf, "<details>\n<summary>%d minor and docs changes</summary>\n\n", len(other))
+			writeMarkdownTable(&buf, other)
+			buf.WriteString("</details>\n\n")
+		}
+	}
+
+	return buf.Bytes()
+}
+
+func writeMarkdownTable(buf *bytes.Buffer, entries []reportEntry) {
+	buf.WriteString("| Severity | Change | Reason | Details |\n|----------|--------|--------|---------|\n")
+	for _, e := range entries {
+		fmt.Fprintf(buf, "| `%s` | `%s` | %s | %s |\n",
+			e.Severity, markdownCell(e.Subject), markdownCell(e.Reason), markdownCell(e.Detail))
+	}
+	buf.WriteString("\n")
+}
+
+// markdownCell keeps text from breaking out of a table cell
+func markdownCell(s string) string {
+	s = strings.ReplaceAll(s, "|", "\\|")
+	return strings.Join(strings.Fields(s), " ")
+}
+
+// renderHTML renders a report as a standalone page
+func renderHTML(report *specdiff.DiffReport) ([]byte, error) {
+	var buf bytes.Buffer
+	err := htmlTemplate.Execute(&buf, struct {
+		Report *specdiff.DiffReport
+		Groups [][]reportEntry
+	}{report, groupEntries(flattenReport(report))})
+	return buf.Bytes(), err
+}
+
+var htmlTemplate = template.Must(template.New("report").Funcs(template.FuncMap{
+	"title": groupTitle,
+}).Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<title>API changes {{.Report.OldVersion}} → {{.Report.NewVersion}}</title>
+<style>
+body { font-family: sans-serif; margin: 2em; }
+table { border-collapse: collapse; margin-bottom: 2em; }
+th, td { border: 1px solid #ccc; padding: 0.3em 0.6em; text-align: left; vertical-align: top; }
+code { font-size: 0.9em; }
+.breaking, .client-breaking, .server-breaking { background: #fdd; }
+.deprecated { background: #ffd; }
+.minor { background: #dfd; }
+</style>
+</head>
+<body>
+<h1>API changes {{.Report.OldVersion}} → {{.Report.NewVersion}}</h1>
+<p>Max severity: <code>{{.Report.MaxSeverity}}</code></p>
+{{- with .Report.Summary}}
+<table>
+<tr><th>Severity</th><th>Changes</th></tr>
+<tr class="client-breaking"><td>client-breaking</td><td>{{.ClientBreakingChanges}}</td></tr>
+<tr class="server-breaking"><td>server-breaking</td><td>{{.ServerBreakingChanges}}</td></tr>
+<tr class="breaking"><td>breaking (all)</td><td>{{.BreakingChanges}}</td></tr>
+<tr class="deprecated"><td>deprecated</td><td>{{.DeprecatedChanges}}</td></tr>
+<tr class="minor"><td>minor</td><td>{{.MinorChanges}}</td></tr>
+<tr class="docs"><td>docs</td><td>{{.DocsChanges}}</td></tr>
+</table>
+<p>Schemas: {{.TotalSchemasOld}} → {{.TotalSchemasNew}} ({{.SchemasAdded}} added, {{.SchemasRemoved}} removed, {{.SchemasRenamed}} renamed, {{.SchemasWithChanges}} changed)</p>
+{{- end}}
+{{- range .Groups}}
+<h2>{{title (index . 0).Group}}</h2>
+<table>
+<tr><th>Severity</th><th>Change</th><th>Reason</th><th>Details</th></tr>
+{{- range .}}
+<tr class="{{.Severity}}"><td>{{.Severity}}</td><td><code>{{.Subject}}</code></td><td>{{.Reason}}</td><td>{{.Detail}}</td></tr>
+{{- end}}
+</table>
+{{- end}}
+</body>
+</html>
+`))
+
+// JUnit XML, as read by CI systems. Every change is a test case and
+// breaking changes are failures, so they show up as failing checks.
+type junitTestSuites struct {
+	XMLName  xml.Name         `xml:"testsuites"`
+	Name     string           `xml:"name,attr"`
+	Tests    int              `xml:"tests,attr"`
+	Failures int              `xml:"failures,attr"`
+	Suites   []junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name     string          `xml:"name,attr"`
+	Tests    int             `xml:"tests,attr"`
+	Failures int             `xml:"failures,attr"`
+	Cases    []junitTestCase `xml:"testcase"`
+}
+
+type junitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"`
+	Failure   *junitFailure `xml:"failure,omitempty"`
+}
+
+type junitFailure struct {
+	Message string `xml:"message,attr"`
+	Type    string `xml:"type,attr"`
+	Text    string `xml:",chardata"`
+}
+
+func renderJUnit(report *specdiff.DiffReport) ([]byte, error) {
+	suites := junitTestSuites{Name: fmt.Sprintf("openapi-diff %s → %s", report.OldVersion, report.NewVersion)}
+	for _, group := range groupEntries(flattenReport(report)) {
+		suite := junitTestSuite{Name: group[0].Group}
+		for _, e := range group {
+			tc := junitTestCase{Name: e.Subject + ": " + e.Reason, ClassName: "openapi-diff." + e.Group}
+			if specdiff.IsBreaking(e.Severity) {
+				tc.Failure = &junitFailure{Message: e.Reason, Type: string(e.Severity), Text: e.Detail}
+				suite.Failures++
+			}
+			suite.Cases = append(suite.Cases, tc)
+			suite.Tests++
+		}
+		suites.Suites = append(suites.Suites, suite)
+		suites.Tests += suite.Tests
+		suites.Failures += suite.Failures
+	}
+
+	data, err := xml.MarshalIndent(suites, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(xml.Header), append(data, '\n')...), nil
+}
+
+// SARIF 2.1.0, as read by code scanning. Each severity is a rule; breaking
+// changes are errors, deprecations warnings and everything else notes.
+type sarifLog struct {
+	Schema  string     `json:"$schema"`
+	Version string     `json:"version"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool    sarifTool     `json:"tool"`
+	Results []sarifResult `json:"results"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name  string      `json:"name"`
+	Rules []sarifRule `json:"rules"`
+}
+
+type sarifRule struct {
+	ID               string       `json:"id"`
+	ShortDescription sarifMessage `json:"shortDescription"`
+}
+
+type sarifResult struct {
+	RuleID    string          `json:"ruleId"`
+	Level     string          `json:"level"`
+	Message   sarifMessage    `json:"message"`
+	Locations []sarifLocation `json:"locations"`
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+type sarifLocation struct {
+	PhysicalLocation sarifPhysicalLocation  `json:"physicalLocation"`
+	LogicalLocations []sarifLogicalLocation `json:"logicalLocations,omitempty"`
+}
+
+type sarifPhysicalLocation struct {
+	ArtifactLocation struct {
+		URI string `json:"uri"`
+	} `json:"artifactLocation"`
+	Region struct {
+		StartLine int `json:"startLine"`
+	} `json:"region"`
+}
+
+type sarifLogicalLocation struct {
+	FullyQualifiedName string `json:"fullyQualifiedName"`
+}
+
+// sarifRules describes each severity reported as a rule
+var sarifRules = []sarifRule{
+	{string(specdiff.SeverityClientBreaking), sarifMessage{"Change breaks existing API clients"}},
+	{string(specdiff.SeverityServerBreaking), sarifMessage{"Change breaks implementations of the old API contract"}},
+	{string(specdiff.SeverityBreaking), sarifMessage{"Breaking change to a schema no operation uses"}},
+	{string(specdiff.SeverityDeprecated), sarifMessage{"Field, parameter or operation deprecated"}},
+	{string(specdiff.SeverityMinor), sarifMessage{"Backwards compatible change"}},
+	{string(specdiff.SeverityDocs), sarifMessage{"Description changed"}},
+}
+
+func renderSARIF(report *specdiff.DiffReport, path string) ([]byte, error) {
+	run := sarifRun{
+		Tool:    sarifTool{Driver: sarifDriver{Name: "openapi-diff", Rules: sarifRules}},
+		Results: []sarifResult{},
+	}
+
+	for _, e := range flattenReport(report) {
+		level := "note"
+		if specdiff.IsBreaking(e.Severity) {
+			level = "error"
+		} else if e.Severity == specdiff.SeverityDeprecated {
+			level = "warning"
+		}
+
+		text := e.Subject + ": " + e.Reason
+		if e.Detail != "" {
+			text += " (" + e.Detail + ")"
+		}
+
+		loc := sarifLocation{LogicalLocations: []sarifLogicalLocation{{FullyQualifiedName: e.Subject}}}
+		loc.PhysicalLocation.ArtifactLocation.URI = path
+		loc.PhysicalLocation.Region.StartLine = 1
+
+		run.Results = append(run.Results, sarifResult{
+			RuleID:    string(e.Severity),
+			Level:     level,
+			Message:   sarifMessage{text},
+			Locations: []sarifLocation{loc},
+		})
+	}
+
+	return json.MarshalIndent(sarifLog{
+		Schema:  "https://json.schemastore.org/sarif-2.1.0.json",
+		Version: "2.1.0",
+		Runs:    []sarifRun{run},
+	}, "", "  ")
+}
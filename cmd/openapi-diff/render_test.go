@@ -0,0 +1,209 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/BlackMesaLTD/checkmk-api-spec/specdiff"
+)
+
+// testReport has a change in each group and severity class, one of them
+// with markup characters in its reason
+func testReport() *specdiff.DiffReport {
+	return &specdiff.DiffReport{
+		OldVersion:  "2.4.0p16",
+		NewVersion:  "2.4.0p17",
+		MaxSeverity: specdiff.SeverityClientBreaking,
+		PathChanges: &specdiff.PathChanges{
+			Added: []*specdiff.PathChange{{Path: "/objects/widget", Methods: []string{"GET"}, Severity: specdiff.SeverityMinor, Category: specdiff.CategorySetup}},
+		},
+		OperationChanges: []*specdiff.OperationChange{{
+			Path: "/domain-types/downtime/collections/host", Method: "POST", Location: specdiff.LocationParameter,
+			Name: "query:sites", Severity: specdiff.SeverityDeprecated, ChangeReason: "Parameter marked as deprecated",
+			Category: specdiff.CategoryMonitoring,
+		}},
+		SchemaChanges: []*specdiff.SchemaChange{{
+			SchemaName:  "CreateHost",
+			Usage:       specdiff.UsageRequest,
+			MaxSeverity: specdiff.SeverityClientBreaking,
+			ChangedFields: []*specdiff.FieldDiff{{
+				Path: "host_name", Severity: specdiff.SeverityClientBreaking,
+				OldValue: "none", NewValue: "^[a-z]+$",
+				ChangeReason: "pattern added: <a&b> | c",
+			}},
+		}},
+		Summary: &specdiff.Summary{
+			TotalSchemasOld: 10, TotalSchemasNew: 10, SchemasWithChanges: 1,
+			BreakingChanges: 1, ClientBreakingChanges: 1, DeprecatedChanges: 1, MinorChanges: 1,
+		},
+	}
+}
+
+func TestRenderMarkdown(t *testing.T) {
+	out := string(renderMarkdown(testReport()))
+	for _, want := range []string{
+		"## API changes 2.4.0p16 → 2.4.0p17",
+		"**Max severity:** `client-breaking`",
+		"### Setup endpoints",
+		"### Monitoring endpoints",
+		"### Schemas",
+		"| `client-breaking` | `CreateHost.host_name` | pattern added: <a&b> \\| c | none → ^[a-z]+$ |",
+		"<summary>1 minor and docs changes</summary>",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("markdown lacks %q:\n%s", want, out)
+		}
+	}
+	// Groups follow the category order, schemas last
+	if strings.Index(out, "### Setup") > strings.Index(out, "### Monitoring") || strings.Index(out, "### Monitoring") > strings.Index(out, "### Schemas") {
+		t.Errorf("groups out of order:\n%s", out)
+	}
+}
+
+func TestRenderHTML(t *testing.T) {
+	data, err := renderHTML(testReport())
+	if err != nil {
+		t.Fatal(err)
+	}
+	out := string(data)
+	if strings.Contains(out, "<a&b>") {
+		t.Error("HTML contains the unescaped change reason")
+	}
+	if !strings.Contains(out, "pattern added: &lt;a&amp;b&gt; | c") {
+		t.Errorf("HTML lacks the escaped change reason:\n%s", out)
+	}
+	if !strings.Contains(out, `<tr class="client-breaking"><td>client-breaking</td><td><code>CreateHost.host_name</code></td>`) {
+		t.Errorf("HTML lacks the schema change row:\n%s", out)
+	}
+}
+
+func TestRenderJUnit(t *testing.T) {
+	data, err := renderJUnit(testReport())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Well-formed XML throughout
+	dec := xml.NewDecoder(bytes.NewReader(data))
+	for {
+		if _, err := dec.Token(); err == io.EOF {
+			break
+		} else if err != nil {
+			t.Fatalf("invalid XML: %v\n%s", err, data)
+		}
+	}
+
+	var suites junitTestSuites
+	if err := xml.Unmarshal(data, &suites); err != nil {
+		t.Fatal(err)
+	}
+	if suites.Tests != 3 || suites.Failures != 1 || len(suites.Suites) != 3 {
+		t.Fatalf("testsuites = %d tests, %d failures, %d suites, want 3, 1, 3", suites.Tests, suites.Failures, len(suites.Suites))
+	}
+	schemas := suites.Suites[2]
+	if schemas.Name != "schemas" || schemas.Failures != 1 || len(schemas.Cases) != 1 {
+		t.Fatalf("schemas suite = %+v", schemas)
+	}
+	failure := schemas.Cases[0].Failure
+	if failure == nil || failure.Message != "pattern added: <a&b> | c" || failure.Type != "client-breaking" {
+		t.Errorf("failure = %+v, want the client-breaking pattern change", failure)
+	}
+	if suites.Suites[1].Cases[0].Failure != nil {
+		t.Error("deprecation reported as a failure")
+	}
+}
+
+func TestRenderSARIF(t *testing.T) {
+	data, err := renderSARIF(testReport(), "specs/2.4.0/p17.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Decode generically so the test checks the JSON names the schema
+	// requires, not those of the Go types
+	var log struct {
+		Schema  string `json:"$schema"`
+		Version string `json:"version"`
+		Runs    []struct {
+			Tool struct {
+				Driver struct {
+					Name  string `json:"name"`
+					Rules []struct {
+						ID               string `json:"id"`
+						ShortDescription struct {
+							Text string `json:"text"`
+						} `json:"shortDescription"`
+					} `json:"rules"`
+				} `json:"driver"`
+			} `json:"tool"`
+			Results []struct {
+				RuleID  string `json:"ruleId"`
+				Level   string `json:"level"`
+				Message struct {
+					Text string `json:"text"`
+				} `json:"message"`
+				Locations []struct {
+					PhysicalLocation struct {
+						ArtifactLocation struct {
+							URI string `json:"uri"`
+						} `json:"artifactLocation"`
+						Region struct {
+							StartLine int `json:"startLine"`
+						} `json:"region"`
+					} `json:"physicalLocation"`
+				} `json:"locations"`
+			} `json:"results"`
+		} `json:"runs"`
+	}
+	if err := json.Unmarshal(data, &log); err != nil {
+		t.Fatal(err)
+	}
+	if log.Version != "2.1.0" || !strings.Contains(log.Schema, "sarif-2.1.0") || len(log.Runs) != 1 {
+		t.Fatalf("log = %s %s with %d runs, want a single 2.1.0 run", log.Schema, log.Version, len(log.Runs))
+	}
+
+	run := log.Runs[0]
+	rules := make(map[string]bool)
+	for _, r := range run.Tool.Driver.Rules {
+		if r.ID == "" || r.ShortDescription.Text == "" || rules[r.ID] {
+			t.Errorf("rule %+v is unnamed, undescribed or duplicated", r)
+		}
+		rules[r.ID] = true
+	}
+
+	levels := make(map[string]string)
+	for _, r := range run.Results {
+		if !rules[r.RuleID] {
+			t.Errorf("result %q refers to an undefined rule %q", r.Message.Text, r.RuleID)
+		}
+		if len(r.Locations) != 1 || r.Locations[0].PhysicalLocation.ArtifactLocation.URI != "specs/2.4.0/p17.yaml" ||
+			r.Locations[0].PhysicalLocation.Region.StartLine < 1 {
+			t.Errorf("result %q has locations %+v", r.Message.Text, r.Locations)
+		}
+		levels[r.RuleID] = r.Level
+	}
+	want := map[string]string{"client-breaking": "error", "deprecated": "warning", "minor": "note"}
+	if len(levels) != len(want) {
+		t.Errorf("levels = %v, want %v", levels, want)
+	}
+	for rule, level := range want {
+		if levels[rule] != level {
+			t.Errorf("level of %s = %q, want %q", rule, levels[rule], level)
+		}
+	}
+}
+
+func TestRenderReportFormat(t *testing.T) {
+	for _, format := range []string{FormatJSON, FormatMarkdown, FormatHTML, FormatJUnit, FormatSARIF} {
+		if _, err := renderReport(testReport(), format, "p17.yaml"); err != nil {
+			t.Errorf("renderReport(%s): %v", format, err)
+		}
+	}
+	if _, err := renderReport(testReport(), "pdf", "p17.yaml"); err == nil {
+		t.Error("renderReport accepted an unknown format")
+	}
+}
@@ -17,6 +17,7 @@ import (
 	"sort"
 	"strings"
 
+	"github.com/BlackMesaLTD/checkmk-api-spec/cmkversion"
 	"gopkg.in/yaml.v3"
 )
 
@@ -624,7 +625,7 @@ func (g *Generator) generateMarkdownIndex(versions []VersionInfo) error {
 		vList := minorVersions[minor]
 		// Sort versions within minor (descending by patch)
 		sort.Slice(vList, func(i, j int) bool {
-			return vList[i].Version > vList[j].Version
+			return cmkversion.CompareStrings(vList[i].Version, vList[j].Version) > 0
 		})
 
 		sb.WriteString(fmt.Sprintf("### CheckMK %s.x\n\n", minor))
@@ -835,11 +836,11 @@ func extractVersionFromPath(path string) string {
 
 func extractMinorVersion(version string) string {
 	// "2.4.0p17" -> "2.4"
-	parts := strings.Split(version, ".")
-	if len(parts) >= 2 {
-		return parts[0] + "." + parts[1]
+	v, err := cmkversion.Parse(version)
+	if err != nil {
+		return version
 	}
-	return version
+	return v.Minor()
 }
 
 func extractSchemaName(ref string) string {
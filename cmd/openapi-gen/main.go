@@ -15,6 +15,7 @@ import (
 	"os"
 	"path/filepath"
 	"sort"
+	"strconv"
 	"strings"
 	"unicode"
 
@@ -25,13 +26,65 @@ import (
 type OpenAPISpec struct {
 	OpenAPI    string                 `yaml:"openapi"`
 	Info       map[string]interface{} `yaml:"info"`
-	Paths      map[string]interface{} `yaml:"paths"`
+	Paths      map[string]*PathItem   `yaml:"paths"`
 	Components *Components            `yaml:"components"`
 }
 
 // Components contains reusable schema definitions
 type Components struct {
-	Schemas map[string]*Schema `yaml:"schemas"`
+	Schemas    map[string]*Schema    `yaml:"schemas"`
+	Parameters map[string]*Parameter `yaml:"parameters"`
+}
+
+// PathItem holds the operations available on one API path
+type PathItem struct {
+	Get        *Operation   `yaml:"get"`
+	Put        *Operation   `yaml:"put"`
+	Post       *Operation   `yaml:"post"`
+	Delete     *Operation   `yaml:"delete"`
+	Patch      *Operation   `yaml:"patch"`
+	Parameters []*Parameter `yaml:"parameters"`
+}
+
+// Operation is a single API operation (one HTTP method on one path)
+type Operation struct {
+	OperationID string               `yaml:"operationId"`
+	Summary     string               `yaml:"summary"`
+	Description string               `yaml:"description"`
+	Tags        []string             `yaml:"tags"`
+	Deprecated  bool                 `yaml:"deprecated"`
+	Parameters  []*Parameter         `yaml:"parameters"`
+	RequestBody *RequestBody         `yaml:"requestBody"`
+	Responses   map[string]*Response `yaml:"responses"`
+}
+
+// Parameter is a path, query or header parameter of an operation
+type Parameter struct {
+	Ref         string                `yaml:"$ref"`
+	Name        string                `yaml:"name"`
+	In          string                `yaml:"in"`
+	Description string                `yaml:"description"`
+	Required    bool                  `yaml:"required"`
+	Schema      *Schema               `yaml:"schema"`
+	Content     map[string]*MediaType `yaml:"content"` // Set instead of Schema for JSON-encoded parameters
+}
+
+// RequestBody is the body accepted by an operation
+type RequestBody struct {
+	Required bool                  `yaml:"required"`
+	Content  map[string]*MediaType `yaml:"content"`
+}
+
+// Response is one declared response of an operation
+type Response struct {
+	Description string                 `yaml:"description"`
+	Content     map[string]*MediaType  `yaml:"content"`
+	Headers     map[string]interface{} `yaml:"headers"`
+}
+
+// MediaType describes the schema of a body for one content type
+type MediaType struct {
+	Schema *Schema `yaml:"schema"`
 }
 
 // Schema represents an OpenAPI schema definition
@@ -54,6 +107,8 @@ type Schema struct {
 	Maximum              *float64           `yaml:"maximum"`
 	MinLength            *int               `yaml:"minLength"`
 	MaxLength            *int               `yaml:"maxLength"`
+	MinItems             *int               `yaml:"minItems"`
+	MaxItems             *int               `yaml:"maxItems"`
 	Pattern              string             `yaml:"pattern"`
 	// Additional OpenAPI properties for enhanced metadata
 	ReadOnly   bool   `yaml:"readOnly"`
@@ -61,6 +116,35 @@ type Schema struct {
 	Deprecated bool   `yaml:"deprecated"`
 	Nullable   bool   `yaml:"nullable"`
 	Title      string `yaml:"title"`
+	// Discriminator selects the oneOf/anyOf member by a property value
+	Discriminator *Discriminator `yaml:"discriminator"`
+}
+
+// Discriminator is an OpenAPI discriminator object for polymorphic schemas
+type Discriminator struct {
+	PropertyName string            `yaml:"propertyName"`
+	Mapping      map[string]string `yaml:"mapping"`
+}
+
+// UnionVariant is one member of a discriminated union
+type UnionVariant struct {
+	TypeName string   // Go type of the member schema
+	Values   []string // Discriminator values selecting this member
+}
+
+// OperationInfo records what the client generator needs about an emitted operation
+type OperationInfo struct {
+	Name          string // Go name, e.g. "HostConfigShowHost"
+	Method        string
+	Path          string
+	Summary       string
+	Deprecated    bool
+	HasParams     bool
+	PathParams    []string // Wire names of the path parameters
+	IfMatch       bool     // Takes an If-Match header (optimistic concurrency)
+	RequiredQuery bool     // Has required query parameters
+	BodyType      string   // Go type of the request body; "interface{}" if untyped, empty if none
+	ResultType    string   // Go type of the success response body, empty if none
 }
 
 // FieldMetadata holds comprehensive metadata about a field
@@ -74,6 +158,16 @@ type FieldMetadata struct {
 	Deprecated  bool
 	Nullable    bool
 	Example     interface{}
+	// Constraints
+	Format    string
+	Pattern   string
+	Minimum   *float64
+	Maximum   *float64
+	MinLength *int
+	MaxLength *int
+	MinItems  *int
+	MaxItems  *int
+	Default   interface{}
 }
 
 // EnumInfo holds information about an enum type to be generated
@@ -91,6 +185,9 @@ type Generator struct {
 	outputDir       string
 	version         string
 	buildTag        string                        // Optional build tag (e.g., "checkmk_v2_4")
+	openapiImport   string                        // Import path of the shared operation descriptor package
+	clientImport    string                        // Import path of the shared HTTP client package
+	operations      []OperationInfo               // Operations emitted to operations.gen.go, in output order
 	schemasToGen    []string                      // Explicit list of schemas to generate (empty = all)
 	excludeFields   map[string]bool
 	enumsFound      map[string]*EnumInfo          // Track enums to generate
@@ -102,6 +199,11 @@ type Generator struct {
 	descriptions    map[string]map[string]string  // Track field descriptions per schema
 	fieldTypes      map[string]map[string]string  // Track field types per schema
 	generatedTypes  map[string]bool               // Track which types were generated
+	schemaNames     map[*Schema]string            // Component name by schema (resolveSchema inlines $ref targets)
+	structSchemas   map[*Schema]string            // Schemas emitted as named structs, by Go type name
+	inlineTypes     []*Schema                     // Synthesised types for inline object properties, in discovery order
+	resolved        map[*Schema]bool              // Schemas already resolved (guards against $ref cycles)
+	merged          map[*Schema]bool              // Schemas whose allOf has been flattened
 }
 
 func main() {
@@ -113,6 +215,8 @@ func main() {
 		schemas     = flag.String("schemas", "", "Comma-separated list of schemas to filter (default: all)")
 		buildTag    = flag.String("buildtag", "", "Build tag for conditional compilation (e.g., checkmk_v2_4)")
 		listSchemas = flag.Bool("list-schemas", false, "List all available schemas and exit")
+		openapiPkg  = flag.String("openapi-pkg", "github.com/BlackMesaLTD/checkmk-api-spec/generated/go/openapi", "Import path of the shared operation descriptor package")
+		clientPkg   = flag.String("client-pkg", "github.com/BlackMesaLTD/checkmk-api-spec/generated/go/client", "Import path of the shared HTTP client package")
 	)
 	flag.Parse()
 
@@ -120,25 +224,11 @@ func main() {
 		log.Fatal("Error: -spec flag is required")
 	}
 
-	gen := &Generator{
-		packageName: *packageName,
-		outputDir:   *outputDir,
-		version:     *version,
-		buildTag:    *buildTag,
-		excludeFields: map[string]bool{
-			"update_attributes": true,
-			"remove_attributes": true,
-		},
-		enumsFound:      make(map[string]*EnumInfo),
-		fieldsFound:     make(map[string][]string),
-		fieldsMeta:      make(map[string][]FieldMetadata),
-		requiredFound:   make(map[string][]string),
-		readOnlyFound:   make(map[string][]string),
-		deprecatedFound: make(map[string][]string),
-		descriptions:    make(map[string]map[string]string),
-		fieldTypes:      make(map[string]map[string]string),
-		generatedTypes:  make(map[string]bool),
-	}
+	gen := newGenerator(*packageName, *outputDir)
+	gen.version = *version
+	gen.buildTag = *buildTag
+	gen.openapiImport = *openapiPkg
+	gen.clientImport = *clientPkg
 
 	if err := gen.LoadSpec(*specPath); err != nil {
 		log.Fatalf("Failed to load spec: %v", err)
@@ -166,6 +256,31 @@ func main() {
 	fmt.Printf("Successfully generated types in %s\n", *outputDir)
 }
 
+// newGenerator returns a Generator writing package packageName to outputDir.
+func newGenerator(packageName, outputDir string) *Generator {
+	return &Generator{
+		packageName: packageName,
+		outputDir:   outputDir,
+		excludeFields: map[string]bool{
+			"update_attributes": true,
+			"remove_attributes": true,
+		},
+		enumsFound:      make(map[string]*EnumInfo),
+		fieldsFound:     make(map[string][]string),
+		fieldsMeta:      make(map[string][]FieldMetadata),
+		requiredFound:   make(map[string][]string),
+		readOnlyFound:   make(map[string][]string),
+		deprecatedFound: make(map[string][]string),
+		descriptions:    make(map[string]map[string]string),
+		fieldTypes:      make(map[string]map[string]string),
+		generatedTypes:  make(map[string]bool),
+		schemaNames:     make(map[*Schema]string),
+		structSchemas:   make(map[*Schema]string),
+		resolved:        make(map[*Schema]bool),
+		merged:          make(map[*Schema]bool),
+	}
+}
+
 func (g *Generator) LoadSpec(path string) error {
 	data, err := os.ReadFile(path)
 	if err != nil {
@@ -177,6 +292,12 @@ func (g *Generator) LoadSpec(path string) error {
 		return fmt.Errorf("parsing YAML: %w", err)
 	}
 
+	if g.spec.Components != nil {
+		for name, schema := range g.spec.Components.Schemas {
+			g.schemaNames[schema] = name
+		}
+	}
+
 	// Extract version from spec if not provided
 	if g.version == "" {
 		if info, ok := g.spec.Info["version"].(string); ok {
@@ -265,6 +386,21 @@ func (g *Generator) Generate() error {
 		return err
 	}
 
+	// Generate operations.gen.go (endpoint descriptors)
+	if err := g.generateOperationsFile(); err != nil {
+		return err
+	}
+
+	// Generate client.gen.go (typed methods per operation)
+	if err := g.generateClientFile(); err != nil {
+		return err
+	}
+
+	// Generate schemas.gen.go (schema definitions for runtime validation)
+	if err := g.generateSchemasFile(existingSchemas); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -274,9 +410,40 @@ func (g *Generator) generateTypesFile(schemas []string) error {
 	// Write header
 	g.writeHeader(&buf, "types.gen.go", "Type definitions for CheckMK REST API")
 
+	// Flatten allOf compositions first so composed schemas register as structs
+	for _, schemaName := range schemas {
+		g.mergeAllOf(g.spec.Components.Schemas[schemaName])
+	}
+
+	// Register schemas that other types can reference by name
+	hasUnions := false
+	for _, schemaName := range schemas {
+		schema := g.spec.Components.Schemas[schemaName]
+		if isStructSchema(schema) || isUnionSchema(schema) {
+			g.structSchemas[schema] = toGoTypeName(schemaName)
+		}
+		hasUnions = hasUnions || isUnionSchema(schema)
+	}
+
+	// Union types need JSON (un)marshalling helpers
+	if hasUnions {
+		buf.WriteString("import (\n")
+		buf.WriteString("\t\"encoding/json\"\n")
+		buf.WriteString("\t\"fmt\"\n")
+		buf.WriteString(")\n\n")
+	}
+
 	// Generate structs for each schema
 	for _, schemaName := range schemas {
 		schema := g.spec.Components.Schemas[schemaName]
+
+		if isUnionSchema(schema) {
+			g.generateUnion(&buf, toGoTypeName(schemaName), schema)
+			buf.WriteString("\n")
+			g.generatedTypes[schemaName] = true
+			continue
+		}
+
 		resolved := g.resolveSchema(schema)
 
 		if err := g.generateStruct(&buf, schemaName, resolved); err != nil {
@@ -288,6 +455,33 @@ func (g *Generator) generateTypesFile(schemas []string) error {
 		g.generatedTypes[schemaName] = true
 	}
 
+	// Generate synthesised types for inline object properties. Generating one
+	// can discover further inline objects, so iterate until the queue is drained.
+	for i := 0; i < len(g.inlineTypes); i++ {
+		schema := g.inlineTypes[i]
+		typeName := g.structSchemas[schema]
+
+		if isUnionSchema(schema) {
+			g.generateUnion(&buf, typeName, schema)
+			buf.WriteString("\n")
+			g.generatedTypes[typeName] = true
+			continue
+		}
+
+		resolved := g.resolveSchema(schema)
+
+		if err := g.generateStruct(&buf, typeName, resolved); err != nil {
+			return fmt.Errorf("generating struct %s: %w", typeName, err)
+		}
+		buf.WriteString("\n")
+
+		g.generatedTypes[typeName] = true
+	}
+
+	if hasUnions {
+		writeUnionHelpers(&buf)
+	}
+
 	// Write to file
 	outputPath := filepath.Join(g.outputDir, "types.gen.go")
 	if err := os.WriteFile(outputPath, []byte(buf.String()), 0644); err != nil {
@@ -600,7 +794,9 @@ func (g *Generator) generateMetadataFile() error {
 	var buf strings.Builder
 
 	// Write header
-	g.writeHeader(&buf, "metadata.gen.go", "Field metadata including descriptions and types")
+	g.writeHeader(&buf, "metadata.gen.go", "Field metadata including descriptions, types and constraints")
+
+	buf.WriteString(fmt.Sprintf("import \"%s\"\n\n", g.openapiImport))
 
 	// Sort schema names for consistent output
 	schemaNames := make([]string, 0, len(g.descriptions))
@@ -663,7 +859,47 @@ func (g *Generator) generateMetadataFile() error {
 	}
 	buf.WriteString("}\n\n")
 
+	// Generate field constraints map
+	constrainedSchemas := make([]string, 0, len(g.fieldsMeta))
+	for name := range g.fieldsMeta {
+		constrainedSchemas = append(constrainedSchemas, name)
+	}
+	sort.Strings(constrainedSchemas)
+
+	buf.WriteString("// FieldConstraints maps schema names to the validation keywords and\n")
+	buf.WriteString("// defaults of their fields. Fields without any are omitted.\n")
+	buf.WriteString("// Use GetFieldConstraints() for safe lookup.\n")
+	buf.WriteString("var FieldConstraints = map[string]map[string]*openapi.FieldConstraints{\n")
+	for _, schemaName := range constrainedSchemas {
+		var entries []string
+		for _, meta := range g.fieldsMeta[schemaName] {
+			if literal := constraintsLiteral(meta); literal != "" {
+				entries = append(entries, fmt.Sprintf("\t\t%q: {%s},\n", meta.Name, literal))
+			}
+		}
+		if len(entries) == 0 {
+			continue
+		}
+		sort.Strings(entries)
+
+		buf.WriteString(fmt.Sprintf("\t%q: {\n", schemaName))
+		for _, entry := range entries {
+			buf.WriteString(entry)
+		}
+		buf.WriteString("\t},\n")
+	}
+	buf.WriteString("}\n\n")
+
 	// Generate helper functions
+	buf.WriteString("// GetFieldConstraints returns the validation keywords and default of a field.\n")
+	buf.WriteString("// Returns nil if the field has none or is not found.\n")
+	buf.WriteString("func GetFieldConstraints(schemaName, fieldName string) *openapi.FieldConstraints {\n")
+	buf.WriteString("\tif fields, ok := FieldConstraints[schemaName]; ok {\n")
+	buf.WriteString("\t\treturn fields[fieldName]\n")
+	buf.WriteString("\t}\n")
+	buf.WriteString("\treturn nil\n")
+	buf.WriteString("}\n\n")
+
 	buf.WriteString("// GetFieldDescription returns the description for a field in a schema.\n")
 	buf.WriteString("// Returns empty string if not found.\n")
 	buf.WriteString("func GetFieldDescription(schemaName, fieldName string) string {\n")
@@ -782,6 +1018,19 @@ func (g *Generator) resolveSchema(schema *Schema) *Schema {
 		}
 	}
 
+	// Schemas are resolved in place, so each only needs visiting once. This
+	// also stops recursive schemas (e.g., a Node with []Node children) looping.
+	if g.resolved[schema] {
+		return schema
+	}
+	g.resolved[schema] = true
+
+	// Flatten allOf so inherited properties are generated. Wrappers around a
+	// named struct (allOf: [$ref]) stay references to that type.
+	if g.structTarget(schema) == nil {
+		g.mergeAllOf(schema)
+	}
+
 	// Recursively resolve properties
 	if schema.Properties != nil {
 		for key, prop := range schema.Properties {
@@ -797,6 +1046,148 @@ func (g *Generator) resolveSchema(schema *Schema) *Schema {
 	return schema
 }
 
+// mergeAllOf flattens an allOf composition into the schema in place. Members'
+// properties are merged in order with the schema's own properties taking
+// precedence, required sets are unioned, and unset keywords are inherited.
+func (g *Generator) mergeAllOf(schema *Schema) {
+	if schema == nil || len(schema.AllOf) == 0 || g.merged[schema] {
+		return
+	}
+	g.merged[schema] = true
+
+	members := schema.AllOf
+	schema.AllOf = nil
+
+	properties := make(map[string]*Schema)
+	var required []string
+	addRequired := func(names []string) {
+		for _, name := range names {
+			found := false
+			for _, existing := range required {
+				if existing == name {
+					found = true
+					break
+				}
+			}
+			if !found {
+				required = append(required, name)
+			}
+		}
+	}
+
+	for _, member := range members {
+		if member != nil && member.Ref != "" {
+			member = g.resolveRef(member.Ref)
+		}
+		if member == nil {
+			continue
+		}
+		g.mergeAllOf(member)
+
+		for name, prop := range member.Properties {
+			properties[name] = prop
+		}
+		addRequired(member.Required)
+		inheritKeywords(schema, member)
+	}
+
+	for name, prop := range schema.Properties {
+		properties[name] = prop
+	}
+	addRequired(schema.Required)
+
+	if len(properties) > 0 {
+		schema.Properties = properties
+	}
+	schema.Required = required
+}
+
+// inheritKeywords copies keywords from an allOf member that the composing
+// schema leaves unset.
+func inheritKeywords(schema, member *Schema) {
+	if schema.Type == "" {
+		schema.Type = member.Type
+	}
+	if schema.Format == "" {
+		schema.Format = member.Format
+	}
+	if schema.Items == nil {
+		schema.Items = member.Items
+	}
+	if schema.Description == "" {
+		schema.Description = member.Description
+	}
+	if schema.AdditionalProperties == nil {
+		schema.AdditionalProperties = member.AdditionalProperties
+	}
+	if len(schema.Enum) == 0 {
+		schema.Enum = member.Enum
+	}
+	if len(schema.OneOf) == 0 && len(schema.AnyOf) == 0 && schema.Discriminator == nil {
+		schema.OneOf = member.OneOf
+		schema.AnyOf = member.AnyOf
+		schema.Discriminator = member.Discriminator
+	}
+	if schema.Default == nil {
+		schema.Default = member.Default
+	}
+	if schema.Example == nil {
+		schema.Example = member.Example
+	}
+	if schema.Minimum == nil {
+		schema.Minimum = member.Minimum
+	}
+	if schema.Maximum == nil {
+		schema.Maximum = member.Maximum
+	}
+	if schema.MinLength == nil {
+		schema.MinLength = member.MinLength
+	}
+	if schema.MaxLength == nil {
+		schema.MaxLength = member.MaxLength
+	}
+	if schema.MinItems == nil {
+		schema.MinItems = member.MinItems
+	}
+	if schema.MaxItems == nil {
+		schema.MaxItems = member.MaxItems
+	}
+	if schema.Pattern == "" {
+		schema.Pattern = member.Pattern
+	}
+	schema.ReadOnly = schema.ReadOnly || member.ReadOnly
+	schema.WriteOnly = schema.WriteOnly || member.WriteOnly
+	schema.Deprecated = schema.Deprecated || member.Deprecated
+	schema.Nullable = schema.Nullable || member.Nullable
+}
+
+// constrainedSchema returns the schema whose validation keywords apply to a
+// property: the target of a $ref, or the property with the keywords of its
+// allOf members inherited. Properties are usually resolved in place already,
+// but wrappers around named structs keep their allOf.
+func (g *Generator) constrainedSchema(schema *Schema, seen map[*Schema]bool) *Schema {
+	if schema == nil || seen[schema] {
+		return &Schema{}
+	}
+	seen[schema] = true
+
+	if schema.Ref != "" {
+		if target := g.resolveRef(schema.Ref); target != nil {
+			return g.constrainedSchema(target, seen)
+		}
+	}
+	if len(schema.AllOf) == 0 {
+		return schema
+	}
+
+	merged := *schema
+	merged.AllOf = nil
+	for _, member := range schema.AllOf {
+		inheritKeywords(&merged, g.constrainedSchema(member, seen))
+	}
+	return &merged
+}
+
 func (g *Generator) resolveRef(ref string) *Schema {
 	// Parse reference like "#/components/schemas/Host"
 	parts := strings.Split(ref, "/")
@@ -870,6 +1261,14 @@ func (g *Generator) generateStruct(buf *strings.Builder, name string, schema *Sc
 			}
 		}
 
+		// Optional nested structs are pointers so omitempty can drop them;
+		// required ones only where needed to break a cycle.
+		if target := g.structTarget(prop); target != nil {
+			if !isRequired || g.embedsByValue(target, schema, make(map[*Schema]bool)) {
+				goType = "*" + goType
+			}
+		}
+
 		if isRequired {
 			requiredFields = append(requiredFields, propName)
 		} else {
@@ -896,12 +1295,14 @@ func (g *Generator) generateStruct(buf *strings.Builder, name string, schema *Sc
 		if openAPIType == "" && len(prop.Enum) > 0 {
 			openAPIType = "enum"
 		}
-		if openAPIType == "" && prop.Ref != "" {
+		if openAPIType == "" && (prop.Ref != "" || g.structTarget(prop) != nil) {
 			openAPIType = "object"
 		}
 		g.fieldTypes[name][propName] = openAPIType
 
-		// Collect comprehensive field metadata
+		// Collect comprehensive field metadata. Constraints may come from
+		// the target of a $ref or from allOf members.
+		constrained := g.constrainedSchema(prop, make(map[*Schema]bool))
 		meta := FieldMetadata{
 			Name:        propName,
 			Type:        openAPIType,
@@ -912,6 +1313,15 @@ func (g *Generator) generateStruct(buf *strings.Builder, name string, schema *Sc
 			Deprecated:  prop.Deprecated,
 			Nullable:    prop.Nullable,
 			Example:     prop.Example,
+			Format:      constrained.Format,
+			Pattern:     constrained.Pattern,
+			Minimum:     constrained.Minimum,
+			Maximum:     constrained.Maximum,
+			MinLength:   constrained.MinLength,
+			MaxLength:   constrained.MaxLength,
+			MinItems:    constrained.MinItems,
+			MaxItems:    constrained.MaxItems,
+			Default:     constrained.Default,
 		}
 		fieldMetadata = append(fieldMetadata, meta)
 
@@ -939,6 +1349,11 @@ func (g *Generator) schemaToGoType(schema *Schema, parentSchema, fieldName strin
 		return "interface{}"
 	}
 
+	// Reference named structs instead of flattening them to maps
+	if typeName := g.structTypeName(schema); typeName != "" {
+		return typeName
+	}
+
 	// Handle references
 	if schema.Ref != "" {
 		refSchema := g.resolveRef(schema.Ref)
@@ -960,13 +1375,21 @@ func (g *Generator) schemaToGoType(schema *Schema, parentSchema, fieldName strin
 		return "[]interface{}"
 	}
 
-	// Handle objects
+	// Handle objects: inline objects with known properties get a synthesised
+	// named type, free-form ones stay maps
 	if schema.Type == "object" {
+		if _, isComponent := g.schemaNames[schema]; !isComponent && isStructSchema(schema) {
+			return g.registerInlineType(parentSchema, fieldName, schema)
+		}
 		return "map[string]interface{}"
 	}
 
-	// Handle oneOf/anyOf
+	// Handle oneOf/anyOf: only discriminated unions can be decoded into a
+	// typed wrapper, anything else stays untyped
 	if len(schema.OneOf) > 0 || len(schema.AnyOf) > 0 {
+		if isUnionSchema(schema) {
+			return g.registerInlineType(parentSchema, fieldName, schema)
+		}
 		return "interface{}"
 	}
 
@@ -994,93 +1417,406 @@ func (g *Generator) schemaToGoType(schema *Schema, parentSchema, fieldName strin
 	}
 }
 
-func (g *Generator) registerEnum(parentSchema, fieldName string, schema *Schema) string {
-	// Create a meaningful enum type name
-	typeName := toGoTypeName(parentSchema) + toGoTypeName(fieldName)
+// isStructSchema reports whether a schema maps cleanly onto a named Go struct:
+// an object with fixed properties and no union or free-form extension.
+func isStructSchema(schema *Schema) bool {
+	if schema == nil || len(schema.Properties) == 0 {
+		return false
+	}
+	if schema.Type != "" && schema.Type != "object" {
+		return false
+	}
+	if len(schema.OneOf) > 0 || len(schema.AnyOf) > 0 {
+		return false
+	}
+	if allowed, ok := schema.AdditionalProperties.(bool); ok {
+		return !allowed
+	}
+	return schema.AdditionalProperties == nil
+}
 
-	// Convert enum values to strings
-	var values []string
-	for _, v := range schema.Enum {
-		if s, ok := v.(string); ok {
-			values = append(values, s)
+// isUnionSchema reports whether a schema is a discriminated oneOf/anyOf whose
+// members are all component references, which is emitted as a union type.
+func isUnionSchema(schema *Schema) bool {
+	if schema == nil || schema.Discriminator == nil || schema.Discriminator.PropertyName == "" {
+		return false
+	}
+	members := schema.OneOf
+	if len(members) == 0 {
+		members = schema.AnyOf
+	}
+	if len(members) == 0 {
+		return false
+	}
+	for _, member := range members {
+		if member == nil || member.Ref == "" {
+			return false
 		}
 	}
+	return true
+}
 
-	if len(values) == 0 {
-		return "string"
+// structTarget follows $ref and single-member allOf wrappers to a schema that
+// is emitted as a named struct. Returns nil if the schema is not one.
+func (g *Generator) structTarget(schema *Schema) *Schema {
+	seen := make(map[*Schema]bool)
+	for schema != nil && !seen[schema] {
+		seen[schema] = true
+		if _, ok := g.structSchemas[schema]; ok {
+			return schema
+		}
+		switch {
+		case schema.Ref != "":
+			schema = g.resolveRef(schema.Ref)
+		case len(schema.AllOf) == 1 && len(schema.Properties) == 0:
+			// CheckMK wraps references as allOf: [$ref] to attach a description
+			schema = schema.AllOf[0]
+		default:
+			return nil
+		}
 	}
+	return nil
+}
 
-	g.enumsFound[typeName] = &EnumInfo{
-		TypeName:    typeName,
-		Description: schema.Description,
-		Values:      values,
-		FieldName:   fieldName,
+// structTypeName returns the Go type name for a schema emitted as a named
+// struct, or an empty string.
+func (g *Generator) structTypeName(schema *Schema) string {
+	if target := g.structTarget(schema); target != nil {
+		return g.structSchemas[target]
 	}
-
-	return typeName
+	return ""
 }
 
-func (g *Generator) generateEnum(buf *strings.Builder, info *EnumInfo) {
-	// Type definition
-	if info.Description != "" {
-		writeDocComment(buf, info.TypeName, info.Description, "")
-	} else {
-		buf.WriteString(fmt.Sprintf("// %s represents valid values for the %s field.\n", info.TypeName, info.FieldName))
+// embedsByValue reports whether from reaches to through required (value)
+// struct fields, in which case the field must be a pointer to compile.
+func (g *Generator) embedsByValue(from, to *Schema, seen map[*Schema]bool) bool {
+	if from == to {
+		return true
 	}
-	buf.WriteString(fmt.Sprintf("type %s string\n\n", info.TypeName))
-
-	// Constants
-	buf.WriteString("const (\n")
-	for _, value := range info.Values {
-		constName := info.TypeName + toGoConstName(value)
-		buf.WriteString(fmt.Sprintf("\t// %s represents the %q value.\n", constName, value))
-		buf.WriteString(fmt.Sprintf("\t%s %s = %q\n", constName, info.TypeName, value))
+	if seen[from] {
+		return false
 	}
-	buf.WriteString(")\n\n")
+	seen[from] = true
 
-	// Valid values function
-	buf.WriteString(fmt.Sprintf("// Valid%sValues returns all valid values for %s.\n", info.TypeName, info.TypeName))
-	buf.WriteString(fmt.Sprintf("// Use with Terraform validators: stringvalidator.OneOf(Valid%sValues()...)\n", info.TypeName))
-	buf.WriteString(fmt.Sprintf("func Valid%sValues() []string {\n", info.TypeName))
-	buf.WriteString("\treturn []string{\n")
-	for _, value := range info.Values {
-		buf.WriteString(fmt.Sprintf("\t\tstring(%s%s),\n", info.TypeName, toGoConstName(value)))
+	for _, req := range from.Required {
+		if next := g.structTarget(from.Properties[req]); next != nil && g.embedsByValue(next, to, seen) {
+			return true
+		}
 	}
-	buf.WriteString("\t}\n")
-	buf.WriteString("}\n")
+	return false
 }
 
-func (g *Generator) generateMappingsFile() error {
-	var buf strings.Builder
+// registerInlineType queues a named type for an inline object property and
+// returns its name (e.g., "HostConfig" + "extensions" -> "HostConfigExtensions").
+func (g *Generator) registerInlineType(parentSchema, fieldName string, schema *Schema) string {
+	base := toGoTypeName(parentSchema) + toGoTypeName(fieldName)
+	typeName := base
+	for i := 2; g.typeNameTaken(typeName); i++ {
+		typeName = fmt.Sprintf("%s%d", base, i)
+	}
 
-	// Write header
-	g.writeHeader(&buf, "mappings.gen.go", "API to Terraform field mappings for import state")
+	g.structSchemas[schema] = typeName
+	g.inlineTypes = append(g.inlineTypes, schema)
+	return typeName
+}
 
-	// Define mappings for common response types
-	// These map API response paths to Terraform state field names
-	mappings := []struct {
-		TypeName    string
-		TFField     string
-		APIPath     []string
-		Description string
-	}{
-		// Host mappings
-		{"HostConfig", "host_name", []string{"id"}, "Host name from API id field"},
-		{"HostConfig", "folder", []string{"extensions", "folder"}, "Folder path from extensions"},
-		{"HostConfig", "attributes", []string{"extensions", "attributes"}, "Host attributes from extensions"},
+// typeNameTaken checks whether a Go type name is already used by a component
+// schema or a previously synthesised type.
+func (g *Generator) typeNameTaken(typeName string) bool {
+	for _, name := range g.schemaNames {
+		if toGoTypeName(name) == typeName {
+			return true
+		}
+	}
+	for _, schema := range g.inlineTypes {
+		if g.structSchemas[schema] == typeName {
+			return true
+		}
+	}
+	return false
+}
 
-		// Folder mappings
-		{"Folder", "name", []string{"id"}, "Folder ID/name"},
-		{"Folder", "title", []string{"title"}, "Folder title"},
-		{"Folder", "parent", []string{"extensions", "path"}, "Parent path from extensions"},
-		{"Folder", "attributes", []string{"extensions", "attributes"}, "Folder attributes from extensions"},
+// unionVariants lists the members of a discriminated union in spec order with
+// the discriminator values that select each of them.
+func (g *Generator) unionVariants(schema *Schema) []UnionVariant {
+	members := schema.OneOf
+	if len(members) == 0 {
+		members = schema.AnyOf
 	}
 
-	// Group mappings by type
-	typeMap := make(map[string][]struct {
-		TFField     string
-		APIPath     []string
-		Description string
+	var variants []UnionVariant
+	seen := make(map[string]bool)
+	for _, member := range members {
+		if seen[member.Ref] {
+			continue
+		}
+		seen[member.Ref] = true
+
+		parts := strings.Split(member.Ref, "/")
+		refName := parts[len(parts)-1]
+
+		var values []string
+		for value, ref := range schema.Discriminator.Mapping {
+			if ref == member.Ref || ref == refName {
+				values = append(values, value)
+			}
+		}
+		// Members the mapping leaves out are selected by their schema name,
+		// as with implicit OpenAPI mapping
+		if len(values) == 0 {
+			values = append(values, refName)
+		}
+		sort.Strings(values)
+
+		variants = append(variants, UnionVariant{
+			TypeName: toGoTypeName(refName),
+			Values:   values,
+		})
+	}
+	return variants
+}
+
+// generateUnion writes a discriminated union wrapper. The raw JSON is kept so
+// values round-trip unchanged; the discriminator selects the member type.
+func (g *Generator) generateUnion(buf *strings.Builder, typeName string, schema *Schema) {
+	variants := g.unionVariants(schema)
+	property := schema.Discriminator.PropertyName
+
+	memberNames := make([]string, 0, len(variants))
+	var allValues []string
+	for _, v := range variants {
+		memberNames = append(memberNames, v.TypeName)
+		allValues = append(allValues, v.Values...)
+	}
+
+	if schema.Description != "" {
+		writeDocComment(buf, typeName, schema.Description, "")
+		buf.WriteString(fmt.Sprintf("// It is a union of %s, selected by the %q property.\n", strings.Join(memberNames, ", "), property))
+	} else {
+		buf.WriteString(fmt.Sprintf("// %s is a union of %s, selected by the %q property.\n", typeName, strings.Join(memberNames, ", "), property))
+	}
+	buf.WriteString(fmt.Sprintf("type %s struct {\n", typeName))
+	buf.WriteString("\tunion json.RawMessage\n")
+	buf.WriteString("}\n\n")
+
+	// Discriminator accessor
+	buf.WriteString(fmt.Sprintf("// Discriminator returns the value of the %q property.\n", property))
+	buf.WriteString(fmt.Sprintf("func (u %s) Discriminator() (string, error) {\n", typeName))
+	buf.WriteString(fmt.Sprintf("\treturn unionDiscriminator(u.union, %q)\n", property))
+	buf.WriteString("}\n\n")
+
+	// Per-member accessors
+	for _, v := range variants {
+		buf.WriteString(fmt.Sprintf("// As%s returns the union data as a %s.\n", v.TypeName, v.TypeName))
+		buf.WriteString(fmt.Sprintf("func (u %s) As%s() (%s, error) {\n", typeName, v.TypeName, v.TypeName))
+		buf.WriteString(fmt.Sprintf("\tvar v %s\n", v.TypeName))
+		buf.WriteString("\terr := json.Unmarshal(u.union, &v)\n")
+		buf.WriteString("\treturn v, err\n")
+		buf.WriteString("}\n\n")
+
+		if len(v.Values) == 1 {
+			buf.WriteString(fmt.Sprintf("// From%s sets the union to v, with %q set to %q.\n", v.TypeName, property, v.Values[0]))
+		} else {
+			buf.WriteString(fmt.Sprintf("// From%s sets the union to v, whose %q must be one of %s.\n", v.TypeName, property, strings.Join(quoteAll(v.Values), ", ")))
+		}
+		buf.WriteString(fmt.Sprintf("func (u *%s) From%s(v %s) error {\n", typeName, v.TypeName, v.TypeName))
+		buf.WriteString(fmt.Sprintf("\tdata, err := unionFrom(v, %q, %s)\n", property, strings.Join(quoteAll(v.Values), ", ")))
+		buf.WriteString("\tif err != nil {\n")
+		buf.WriteString(fmt.Sprintf("\t\treturn fmt.Errorf(\"%s: %%w\", err)\n", typeName))
+		buf.WriteString("\t}\n")
+		buf.WriteString("\tu.union = data\n")
+		buf.WriteString("\treturn nil\n")
+		buf.WriteString("}\n\n")
+	}
+
+	// Decode by discriminator
+	buf.WriteString(fmt.Sprintf("// ValueByDiscriminator decodes the union into the member selected by %q.\n", property))
+	buf.WriteString(fmt.Sprintf("func (u %s) ValueByDiscriminator() (interface{}, error) {\n", typeName))
+	buf.WriteString("\tdiscriminator, err := u.Discriminator()\n")
+	buf.WriteString("\tif err != nil {\n")
+	buf.WriteString("\t\treturn nil, err\n")
+	buf.WriteString("\t}\n")
+	buf.WriteString("\tswitch discriminator {\n")
+	for _, v := range variants {
+		buf.WriteString(fmt.Sprintf("\tcase %s:\n", strings.Join(quoteAll(v.Values), ", ")))
+		buf.WriteString(fmt.Sprintf("\t\treturn u.As%s()\n", v.TypeName))
+	}
+	buf.WriteString("\t}\n")
+	buf.WriteString(fmt.Sprintf("\treturn nil, fmt.Errorf(\"%s: unknown %s %%q\", discriminator)\n", typeName, property))
+	buf.WriteString("}\n\n")
+
+	// JSON round-trip
+	buf.WriteString("// MarshalJSON implements json.Marshaler.\n")
+	buf.WriteString(fmt.Sprintf("func (u %s) MarshalJSON() ([]byte, error) {\n", typeName))
+	buf.WriteString("\tif len(u.union) == 0 {\n")
+	buf.WriteString("\t\treturn []byte(\"null\"), nil\n")
+	buf.WriteString("\t}\n")
+	buf.WriteString("\treturn u.union, nil\n")
+	buf.WriteString("}\n\n")
+
+	buf.WriteString(fmt.Sprintf("// UnmarshalJSON implements json.Unmarshaler, rejecting unknown %q values.\n", property))
+	buf.WriteString(fmt.Sprintf("func (u *%s) UnmarshalJSON(data []byte) error {\n", typeName))
+	buf.WriteString("\tif string(data) == \"null\" {\n")
+	buf.WriteString("\t\tu.union = nil\n")
+	buf.WriteString("\t\treturn nil\n")
+	buf.WriteString("\t}\n")
+	buf.WriteString(fmt.Sprintf("\tdiscriminator, err := unionDiscriminator(data, %q)\n", property))
+	buf.WriteString("\tif err != nil {\n")
+	buf.WriteString(fmt.Sprintf("\t\treturn fmt.Errorf(\"%s: %%w\", err)\n", typeName))
+	buf.WriteString("\t}\n")
+	buf.WriteString("\tswitch discriminator {\n")
+	buf.WriteString(fmt.Sprintf("\tcase %s:\n", strings.Join(quoteAll(allValues), ", ")))
+	buf.WriteString("\tdefault:\n")
+	buf.WriteString(fmt.Sprintf("\t\treturn fmt.Errorf(\"%s: unknown %s %%q\", discriminator)\n", typeName, property))
+	buf.WriteString("\t}\n")
+	buf.WriteString("\tu.union = append(json.RawMessage(nil), data...)\n")
+	buf.WriteString("\treturn nil\n")
+	buf.WriteString("}\n")
+}
+
+// writeUnionHelpers writes the package-level helpers shared by union types.
+func writeUnionHelpers(buf *strings.Builder) {
+	buf.WriteString("// unionDiscriminator reads a string discriminator property from a JSON object.\n")
+	buf.WriteString("func unionDiscriminator(data json.RawMessage, property string) (string, error) {\n")
+	buf.WriteString("\tvar fields map[string]json.RawMessage\n")
+	buf.WriteString("\tif err := json.Unmarshal(data, &fields); err != nil {\n")
+	buf.WriteString("\t\treturn \"\", err\n")
+	buf.WriteString("\t}\n")
+	buf.WriteString("\traw, ok := fields[property]\n")
+	buf.WriteString("\tif !ok {\n")
+	buf.WriteString("\t\treturn \"\", fmt.Errorf(\"missing discriminator %q\", property)\n")
+	buf.WriteString("\t}\n")
+	buf.WriteString("\tvar value string\n")
+	buf.WriteString("\tif err := json.Unmarshal(raw, &value); err != nil {\n")
+	buf.WriteString("\t\treturn \"\", fmt.Errorf(\"discriminator %q: %w\", property, err)\n")
+	buf.WriteString("\t}\n")
+	buf.WriteString("\treturn value, nil\n")
+	buf.WriteString("}\n\n")
+
+	buf.WriteString("// unionFrom encodes a union member, setting its discriminator property.\n")
+	buf.WriteString("// A member selected by several values must already carry one of them.\n")
+	buf.WriteString("func unionFrom(v interface{}, property string, values ...string) (json.RawMessage, error) {\n")
+	buf.WriteString("\tdata, err := json.Marshal(v)\n")
+	buf.WriteString("\tif err != nil {\n")
+	buf.WriteString("\t\treturn nil, err\n")
+	buf.WriteString("\t}\n")
+	buf.WriteString("\tvar fields map[string]json.RawMessage\n")
+	buf.WriteString("\tif err := json.Unmarshal(data, &fields); err != nil {\n")
+	buf.WriteString("\t\treturn nil, err\n")
+	buf.WriteString("\t}\n")
+	buf.WriteString("\tif len(values) == 1 {\n")
+	buf.WriteString("\t\tfields[property], _ = json.Marshal(values[0])\n")
+	buf.WriteString("\t\treturn json.Marshal(fields)\n")
+	buf.WriteString("\t}\n")
+	buf.WriteString("\tcurrent, err := unionDiscriminator(data, property)\n")
+	buf.WriteString("\tif err != nil {\n")
+	buf.WriteString("\t\treturn nil, err\n")
+	buf.WriteString("\t}\n")
+	buf.WriteString("\tfor _, value := range values {\n")
+	buf.WriteString("\t\tif current == value {\n")
+	buf.WriteString("\t\t\treturn data, nil\n")
+	buf.WriteString("\t\t}\n")
+	buf.WriteString("\t}\n")
+	buf.WriteString("\treturn nil, fmt.Errorf(\"discriminator %q must be one of %q, got %q\", property, values, current)\n")
+	buf.WriteString("}\n")
+}
+
+// quoteAll returns each string as a Go string literal.
+func quoteAll(values []string) []string {
+	quoted := make([]string, len(values))
+	for i, v := range values {
+		quoted[i] = fmt.Sprintf("%q", v)
+	}
+	return quoted
+}
+
+func (g *Generator) registerEnum(parentSchema, fieldName string, schema *Schema) string {
+	// Create a meaningful enum type name
+	typeName := toGoTypeName(parentSchema) + toGoTypeName(fieldName)
+
+	// Convert enum values to strings
+	var values []string
+	for _, v := range schema.Enum {
+		if s, ok := v.(string); ok {
+			values = append(values, s)
+		}
+	}
+
+	if len(values) == 0 {
+		return "string"
+	}
+
+	g.enumsFound[typeName] = &EnumInfo{
+		TypeName:    typeName,
+		Description: schema.Description,
+		Values:      values,
+		FieldName:   fieldName,
+	}
+
+	return typeName
+}
+
+func (g *Generator) generateEnum(buf *strings.Builder, info *EnumInfo) {
+	// Type definition
+	if info.Description != "" {
+		writeDocComment(buf, info.TypeName, info.Description, "")
+	} else {
+		buf.WriteString(fmt.Sprintf("// %s represents valid values for the %s field.\n", info.TypeName, info.FieldName))
+	}
+	buf.WriteString(fmt.Sprintf("type %s string\n\n", info.TypeName))
+
+	// Constants
+	buf.WriteString("const (\n")
+	for _, value := range info.Values {
+		constName := info.TypeName + toGoConstName(value)
+		buf.WriteString(fmt.Sprintf("\t// %s represents the %q value.\n", constName, value))
+		buf.WriteString(fmt.Sprintf("\t%s %s = %q\n", constName, info.TypeName, value))
+	}
+	buf.WriteString(")\n\n")
+
+	// Valid values function
+	buf.WriteString(fmt.Sprintf("// Valid%sValues returns all valid values for %s.\n", info.TypeName, info.TypeName))
+	buf.WriteString(fmt.Sprintf("// Use with Terraform validators: stringvalidator.OneOf(Valid%sValues()...)\n", info.TypeName))
+	buf.WriteString(fmt.Sprintf("func Valid%sValues() []string {\n", info.TypeName))
+	buf.WriteString("\treturn []string{\n")
+	for _, value := range info.Values {
+		buf.WriteString(fmt.Sprintf("\t\tstring(%s%s),\n", info.TypeName, toGoConstName(value)))
+	}
+	buf.WriteString("\t}\n")
+	buf.WriteString("}\n")
+}
+
+func (g *Generator) generateMappingsFile() error {
+	var buf strings.Builder
+
+	// Write header
+	g.writeHeader(&buf, "mappings.gen.go", "API to Terraform field mappings for import state")
+
+	// Define mappings for common response types
+	// These map API response paths to Terraform state field names
+	mappings := []struct {
+		TypeName    string
+		TFField     string
+		APIPath     []string
+		Description string
+	}{
+		// Host mappings
+		{"HostConfig", "host_name", []string{"id"}, "Host name from API id field"},
+		{"HostConfig", "folder", []string{"extensions", "folder"}, "Folder path from extensions"},
+		{"HostConfig", "attributes", []string{"extensions", "attributes"}, "Host attributes from extensions"},
+
+		// Folder mappings
+		{"Folder", "name", []string{"id"}, "Folder ID/name"},
+		{"Folder", "title", []string{"title"}, "Folder title"},
+		{"Folder", "parent", []string{"extensions", "path"}, "Parent path from extensions"},
+		{"Folder", "attributes", []string{"extensions", "attributes"}, "Folder attributes from extensions"},
+	}
+
+	// Group mappings by type
+	typeMap := make(map[string][]struct {
+		TFField     string
+		APIPath     []string
+		Description string
 	})
 
 	for _, m := range mappings {
@@ -1263,6 +1999,860 @@ func (g *Generator) generateRequestsFile() error {
 	return nil
 }
 
+// operationMethods lists the HTTP methods of a path item in output order.
+var operationMethods = []string{"GET", "PUT", "POST", "DELETE", "PATCH"}
+
+// operationsFor returns the operations of a path item keyed by HTTP method.
+func operationsFor(item *PathItem) map[string]*Operation {
+	return map[string]*Operation{
+		"GET":    item.Get,
+		"PUT":    item.Put,
+		"POST":   item.Post,
+		"DELETE": item.Delete,
+		"PATCH":  item.Patch,
+	}
+}
+
+// operationName derives a Go name from an operationId by dropping the module
+// prefix, so names stay stable when CheckMK moves endpoint modules
+// (e.g. "cmk.gui.plugins.openapi.endpoints.host_config.show_host" and
+// "cmk.gui.openapi.endpoints.host_config.show_host" -> "HostConfigShowHost").
+func operationName(operationID string) string {
+	skip := map[string]bool{"cmk": true, "gui": true, "cee": true, "plugins": true, "openapi": true, "_openapi": true, "endpoints": true}
+
+	var parts []string
+	for _, part := range strings.Split(operationID, ".") {
+		if !skip[part] {
+			parts = append(parts, part)
+		}
+	}
+	return toGoTypeName(strings.Join(parts, "."))
+}
+
+// resolveParameter follows a parameter $ref into components.parameters.
+func (g *Generator) resolveParameter(param *Parameter) *Parameter {
+	if param == nil || param.Ref == "" {
+		return param
+	}
+	if g.spec.Components == nil {
+		return nil
+	}
+	return g.spec.Components.Parameters[strings.TrimPrefix(param.Ref, "#/components/parameters/")]
+}
+
+// componentName follows $ref and single-member allOf wrappers to a component
+// schema and returns its name, or an empty string.
+func (g *Generator) componentName(schema *Schema) string {
+	for depth := 0; schema != nil && depth < 10; depth++ {
+		if name, ok := g.schemaNames[schema]; ok {
+			return name
+		}
+		switch {
+		case schema.Ref != "":
+			return strings.TrimPrefix(schema.Ref, "#/components/schemas/")
+		case len(schema.AllOf) == 1 && len(schema.Properties) == 0:
+			schema = schema.AllOf[0]
+		default:
+			return ""
+		}
+	}
+	return ""
+}
+
+// firstContent returns the first content type of a body in sorted order,
+// preferring application/json.
+func firstContent(content map[string]*MediaType) (string, *MediaType) {
+	if media, ok := content["application/json"]; ok {
+		return "application/json", media
+	}
+	var types []string
+	for ct := range content {
+		types = append(types, ct)
+	}
+	sort.Strings(types)
+	if len(types) == 0 {
+		return "", nil
+	}
+	return types[0], content[types[0]]
+}
+
+// paramGoType returns the Go type and tag options for a parameter struct field.
+func (g *Generator) paramGoType(param *Parameter) (goType, openapiType, opts string) {
+	if param.Schema == nil {
+		// JSON-encoded parameter (e.g., Livestatus query expressions)
+		_, media := firstContent(param.Content)
+		if media != nil {
+			if name := g.componentName(media.Schema); name != "" && g.generatedTypes[name] {
+				return "*" + toGoTypeName(name), "object", ",json"
+			}
+		}
+		return "interface{}", "object", ",json"
+	}
+
+	schema := param.Schema
+	if target := g.resolveRef(schema.Ref); schema.Ref != "" && target != nil {
+		schema = target
+	}
+
+	switch schema.Type {
+	case "integer":
+		if param.Required {
+			return "int", schema.Type, ""
+		}
+		return "*int", schema.Type, ""
+	case "number":
+		if param.Required {
+			return "float64", schema.Type, ""
+		}
+		return "*float64", schema.Type, ""
+	case "boolean":
+		// Always a pointer so an explicit false is sent
+		return "*bool", schema.Type, ""
+	case "array":
+		itemType := "string"
+		if schema.Items != nil {
+			switch schema.Items.Type {
+			case "integer":
+				itemType = "int"
+			case "number":
+				itemType = "float64"
+			case "boolean":
+				itemType = "bool"
+			}
+		}
+		return "[]" + itemType, schema.Type, ""
+	}
+	return "string", "string", ""
+}
+
+// bodyFactory returns a Go expression allocating the type of a body schema,
+// or "nil" if the schema is not a generated type.
+func (g *Generator) bodyFactory(schemaName string) string {
+	if schemaName == "" || !g.generatedTypes[schemaName] {
+		return "nil"
+	}
+	return fmt.Sprintf("func() interface{} { return new(%s) }", toGoTypeName(schemaName))
+}
+
+func (g *Generator) generateOperationsFile() error {
+	if len(g.spec.Paths) == 0 {
+		return nil
+	}
+
+	var buf strings.Builder
+
+	// Write header
+	g.writeHeader(&buf, "operations.gen.go", "Endpoint descriptors and parameter types for every API operation")
+
+	buf.WriteString(fmt.Sprintf("import \"%s\"\n\n", g.openapiImport))
+
+	var paths []string
+	for path := range g.spec.Paths {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	usedNames := make(map[string]bool)
+	var ids []string
+	opVars := make(map[string]string)
+	byTag := make(map[string][]string)
+
+	for _, path := range paths {
+		item := g.spec.Paths[path]
+		if item == nil {
+			continue
+		}
+		ops := operationsFor(item)
+
+		for _, method := range operationMethods {
+			op := ops[method]
+			if op == nil || op.OperationID == "" {
+				continue
+			}
+
+			// Prefer the short name; fall back to the full operationId on collision
+			name := operationName(op.OperationID)
+			if usedNames[name] || g.typeNameTaken(name+"Params") || g.typeNameTaken(name+"Operation") {
+				name = toGoTypeName(op.OperationID)
+			}
+			usedNames[name] = true
+
+			// Path-level parameters apply to every operation unless overridden
+			var params []*Parameter
+			seen := make(map[string]bool)
+			for _, p := range op.Parameters {
+				if p = g.resolveParameter(p); p != nil {
+					params = append(params, p)
+					seen[p.In+":"+p.Name] = true
+				}
+			}
+			for _, p := range item.Parameters {
+				if p = g.resolveParameter(p); p != nil && !seen[p.In+":"+p.Name] {
+					params = append(params, p)
+				}
+			}
+
+			hasParams := g.writeOperationParams(&buf, name, params)
+			g.writeOperation(&buf, name, method, path, op, params, hasParams)
+
+			ids = append(ids, op.OperationID)
+			opVars[op.OperationID] = name + "Operation"
+			for _, tag := range op.Tags {
+				byTag[tag] = append(byTag[tag], op.OperationID)
+			}
+		}
+	}
+
+	// Registry by operationId
+	sort.Strings(ids)
+	buf.WriteString("// Operations maps operationIds to their endpoint descriptors.\n")
+	buf.WriteString("var Operations = map[string]*openapi.Operation{\n")
+	for _, id := range ids {
+		buf.WriteString(fmt.Sprintf("\t%q: %s,\n", id, opVars[id]))
+	}
+	buf.WriteString("}\n\n")
+
+	// Registry by tag
+	var tags []string
+	for tag := range byTag {
+		tags = append(tags, tag)
+	}
+	sort.Strings(tags)
+	buf.WriteString("// OperationsByTag groups endpoint descriptors by tag, in path order.\n")
+	buf.WriteString("var OperationsByTag = map[string][]*openapi.Operation{\n")
+	for _, tag := range tags {
+		buf.WriteString(fmt.Sprintf("\t%q: {\n", tag))
+		for _, id := range byTag[tag] {
+			buf.WriteString(fmt.Sprintf("\t\t%s,\n", opVars[id]))
+		}
+		buf.WriteString("\t},\n")
+	}
+	buf.WriteString("}\n\n")
+
+	buf.WriteString("// GetOperation returns the descriptor for an operationId, or nil if unknown.\n")
+	buf.WriteString("func GetOperation(operationID string) *openapi.Operation {\n")
+	buf.WriteString("\treturn Operations[operationID]\n")
+	buf.WriteString("}\n")
+
+	// Write to file
+	outputPath := filepath.Join(g.outputDir, "operations.gen.go")
+	if err := os.WriteFile(outputPath, []byte(buf.String()), 0644); err != nil {
+		return fmt.Errorf("writing operations file: %w", err)
+	}
+
+	return nil
+}
+
+// writeOperationParams emits the typed parameter struct of an operation.
+// Content-Type and Accept are set by the transport and left out. Reports
+// whether a struct was written.
+func (g *Generator) writeOperationParams(buf *strings.Builder, name string, params []*Parameter) bool {
+	var fields strings.Builder
+	usedFields := make(map[string]bool)
+
+	for _, p := range params {
+		if p.In == "header" && (p.Name == "Content-Type" || p.Name == "Accept") {
+			continue
+		}
+		if p.In != "path" && p.In != "query" && p.In != "header" {
+			continue
+		}
+
+		fieldName := toGoFieldName(p.Name)
+		for i := 2; usedFields[fieldName]; i++ {
+			fieldName = fmt.Sprintf("%s%d", toGoFieldName(p.Name), i)
+		}
+		usedFields[fieldName] = true
+
+		goType, _, opts := g.paramGoType(p)
+		if p.Description != "" {
+			writeFieldDocComment(&fields, p.Description, nil)
+		}
+		fields.WriteString(fmt.Sprintf("\t%s %s `%s:\"%s%s\"`\n", fieldName, goType, p.In, p.Name, opts))
+	}
+
+	if fields.Len() == 0 {
+		return false
+	}
+
+	buf.WriteString(fmt.Sprintf("// %sParams holds the path, query and header parameters of %sOperation.\n", name, name))
+	buf.WriteString(fmt.Sprintf("type %sParams struct {\n", name))
+	buf.WriteString(fields.String())
+	buf.WriteString("}\n\n")
+	return true
+}
+
+// writeOperation emits the descriptor variable of an operation.
+func (g *Generator) writeOperation(buf *strings.Builder, name, method, path string, op *Operation, params []*Parameter, hasParams bool) {
+	if op.Summary != "" {
+		buf.WriteString(fmt.Sprintf("// %sOperation describes the %s operation (%s %s).\n", name, quoteSummary(op.Summary), method, path))
+	} else {
+		buf.WriteString(fmt.Sprintf("// %sOperation describes %s %s.\n", name, method, path))
+	}
+	if op.Deprecated {
+		buf.WriteString("//\n")
+		buf.WriteString("// Deprecated: this endpoint is deprecated by CheckMK.\n")
+	}
+	buf.WriteString(fmt.Sprintf("var %sOperation = &openapi.Operation{\n", name))
+	buf.WriteString(fmt.Sprintf("\tID: %q,\n", op.OperationID))
+	buf.WriteString(fmt.Sprintf("\tName: %q,\n", name))
+	buf.WriteString(fmt.Sprintf("\tMethod: %q,\n", method))
+	buf.WriteString(fmt.Sprintf("\tPath: %q,\n", path))
+	if op.Summary != "" {
+		buf.WriteString(fmt.Sprintf("\tSummary: %q,\n", strings.TrimSpace(op.Summary)))
+	}
+	if len(op.Tags) > 0 {
+		buf.WriteString(fmt.Sprintf("\tTags: []string{%s},\n", strings.Join(quoteAll(op.Tags), ", ")))
+	}
+	if op.Deprecated {
+		buf.WriteString("\tDeprecated: true,\n")
+	}
+
+	if len(params) > 0 {
+		buf.WriteString("\tParameters: []openapi.Parameter{\n")
+		for _, p := range params {
+			_, openapiType, _ := g.paramGoType(p)
+			buf.WriteString(fmt.Sprintf("\t\t{Name: %q, In: %q, Required: %t, Type: %q, Description: %q},\n",
+				p.Name, p.In, p.Required, openapiType, strings.Join(strings.Fields(p.Description), " ")))
+		}
+		buf.WriteString("\t},\n")
+	}
+
+	info := OperationInfo{
+		Name:       name,
+		Method:     method,
+		Path:       path,
+		Summary:    op.Summary,
+		Deprecated: op.Deprecated,
+		HasParams:  hasParams,
+	}
+	for _, p := range params {
+		switch {
+		case p.In == "path":
+			info.PathParams = append(info.PathParams, p.Name)
+		case p.In == "header" && p.Name == "If-Match":
+			info.IfMatch = true
+		case p.In == "query" && p.Required:
+			info.RequiredQuery = true
+		}
+	}
+
+	if op.RequestBody != nil {
+		contentType, media := firstContent(op.RequestBody.Content)
+		schemaName := ""
+		if media != nil {
+			schemaName = g.componentName(media.Schema)
+		}
+		info.BodyType = "interface{}"
+		if g.generatedTypes[schemaName] {
+			info.BodyType = "*" + toGoTypeName(schemaName)
+		}
+		buf.WriteString(fmt.Sprintf("\tRequestBody: &openapi.RequestBody{ContentType: %q, Schema: %q, Required: %t, New: %s},\n",
+			contentType, schemaName, op.RequestBody.Required, g.bodyFactory(schemaName)))
+	}
+
+	if len(op.Responses) > 0 {
+		var statuses []string
+		for status := range op.Responses {
+			statuses = append(statuses, status)
+		}
+		sort.Strings(statuses)
+
+		buf.WriteString("\tResponses: []openapi.Response{\n")
+		for _, status := range statuses {
+			resp := op.Responses[status]
+			if resp == nil {
+				continue
+			}
+			contentType, media := firstContent(resp.Content)
+			schemaName := ""
+			if media != nil {
+				schemaName = g.componentName(media.Schema)
+			}
+			var headers []string
+			for header := range resp.Headers {
+				headers = append(headers, header)
+			}
+			sort.Strings(headers)
+			if info.ResultType == "" && strings.HasPrefix(status, "2") && g.generatedTypes[schemaName] {
+				info.ResultType = toGoTypeName(schemaName)
+			}
+			headerList := ""
+			if len(headers) > 0 {
+				headerList = fmt.Sprintf(" Headers: []string{%s},", strings.Join(quoteAll(headers), ", "))
+			}
+			buf.WriteString(fmt.Sprintf("\t\t{Status: %q, Description: %q, ContentType: %q, Schema: %q,%s New: %s},\n",
+				status, strings.Join(strings.Fields(resp.Description), " "), contentType, schemaName, headerList, g.bodyFactory(schemaName)))
+		}
+		buf.WriteString("\t},\n")
+	}
+
+	if hasParams {
+		buf.WriteString(fmt.Sprintf("\tNewParams: func() interface{} { return new(%sParams) },\n", name))
+	}
+	buf.WriteString("}\n\n")
+
+	g.operations = append(g.operations, info)
+}
+
+// fetchOperation returns the GET operation that reads the object an If-Match
+// guarded operation modifies: the GET on the operation's path up to its last
+// path parameter (e.g. "/objects/host_config/{host_name}" for the move action).
+// Returns nil if there is none or it cannot be called with the same path values.
+func (g *Generator) fetchOperation(op OperationInfo) *OperationInfo {
+	if !op.IfMatch || !op.HasParams {
+		return nil
+	}
+	end := strings.LastIndex(op.Path, "}")
+	if end < 0 {
+		return nil
+	}
+	objectPath := op.Path[:end+1]
+
+	have := make(map[string]bool)
+	for _, name := range op.PathParams {
+		have[name] = true
+	}
+	for i := range g.operations {
+		fetch := &g.operations[i]
+		if fetch.Method != "GET" || fetch.Path != objectPath || fetch.RequiredQuery {
+			continue
+		}
+		for _, name := range fetch.PathParams {
+			if !have[name] {
+				return nil
+			}
+		}
+		// The body is built from the current object, so it must be typed
+		if op.BodyType != "" && fetch.ResultType == "" {
+			return nil
+		}
+		return fetch
+	}
+	return nil
+}
+
+// writeRetryMethod emits <Name>WithRetry, which reads the object with fetch,
+// sends op with the fresh ETag as If-Match and repeats on 412 Precondition Failed.
+func writeRetryMethod(buf *strings.Builder, op, fetch OperationInfo) {
+	args := []string{"ctx context.Context", fmt.Sprintf("params *%sParams", op.Name)}
+	if op.BodyType != "" {
+		args = append(args, fmt.Sprintf("mutate func(current *%s) (%s, error)", fetch.ResultType, op.BodyType))
+	}
+
+	buf.WriteString(fmt.Sprintf("// %sWithRetry reads the object with %s and calls\n", op.Name, fetch.Name))
+	buf.WriteString(fmt.Sprintf("// %s with its ETag as If-Match", op.Name))
+	if op.BodyType != "" {
+		buf.WriteString(", building the body with mutate")
+	}
+	buf.WriteString(".\n")
+	buf.WriteString("// On 412 Precondition Failed the cycle is repeated with a fresh read,\n")
+	buf.WriteString("// up to Client.UpdateAttempts times.\n")
+	if op.Deprecated {
+		buf.WriteString("//\n")
+		buf.WriteString("// Deprecated: this endpoint is deprecated by CheckMK.\n")
+	}
+
+	if op.ResultType != "" {
+		buf.WriteString(fmt.Sprintf("func (c *Client) %sWithRetry(%s) (*%s, *client.Response, error) {\n", op.Name, strings.Join(args, ", "), op.ResultType))
+		buf.WriteString(fmt.Sprintf("\tvar out *%s\n", op.ResultType))
+	} else {
+		buf.WriteString(fmt.Sprintf("func (c *Client) %sWithRetry(%s) (*client.Response, error) {\n", op.Name, strings.Join(args, ", ")))
+	}
+	buf.WriteString("\tvar resp *client.Response\n")
+	buf.WriteString("\terr := c.RetryPreconditionFailed(ctx, func(ctx context.Context) error {\n")
+
+	// Read the object with the path values of the update
+	fetchArgs := "ctx"
+	if fetch.HasParams {
+		var fields []string
+		for _, name := range fetch.PathParams {
+			fields = append(fields, fmt.Sprintf("%s: params.%s", toGoFieldName(name), toGoFieldName(name)))
+		}
+		fetchArgs += fmt.Sprintf(", &%sParams{%s}", fetch.Name, strings.Join(fields, ", "))
+	}
+	current := "_"
+	if op.BodyType != "" {
+		current = "current"
+	}
+	if fetch.ResultType != "" {
+		buf.WriteString(fmt.Sprintf("\t\t%s, fetched, err := c.%s(%s)\n", current, fetch.Name, fetchArgs))
+	} else {
+		buf.WriteString(fmt.Sprintf("\t\tfetched, err := c.%s(%s)\n", fetch.Name, fetchArgs))
+	}
+	buf.WriteString("\t\tif err != nil {\n")
+	buf.WriteString("\t\t\treturn err\n")
+	buf.WriteString("\t\t}\n")
+
+	callArgs := "ctx, &p"
+	if op.BodyType != "" {
+		buf.WriteString("\t\tbody, err := mutate(current)\n")
+		buf.WriteString("\t\tif err != nil {\n")
+		buf.WriteString("\t\t\treturn err\n")
+		buf.WriteString("\t\t}\n")
+		callArgs += ", body"
+	}
+	buf.WriteString("\t\tp := *params\n")
+	buf.WriteString("\t\tp.IfMatch = fetched.ETag()\n")
+	if op.ResultType != "" {
+		buf.WriteString(fmt.Sprintf("\t\tout, resp, err = c.%s(%s)\n", op.Name, callArgs))
+	} else {
+		buf.WriteString(fmt.Sprintf("\t\tresp, err = c.%s(%s)\n", op.Name, callArgs))
+	}
+	buf.WriteString("\t\treturn err\n")
+	buf.WriteString("\t})\n")
+	if op.ResultType != "" {
+		buf.WriteString("\treturn out, resp, err\n")
+	} else {
+		buf.WriteString("\treturn resp, err\n")
+	}
+	buf.WriteString("}\n\n")
+}
+
+func (g *Generator) generateClientFile() error {
+	if len(g.operations) == 0 {
+		return nil
+	}
+
+	var buf strings.Builder
+
+	// Write header
+	g.writeHeader(&buf, "client.gen.go", "Typed API client methods, one per operation")
+
+	buf.WriteString("import (\n")
+	buf.WriteString("\t\"context\"\n\n")
+	buf.WriteString(fmt.Sprintf("\t\"%s\"\n", g.clientImport))
+	buf.WriteString(")\n\n")
+
+	buf.WriteString("// Client calls the CheckMK REST API with the types of this package.\n")
+	buf.WriteString("type Client struct {\n")
+	buf.WriteString("\t*client.Client\n")
+	buf.WriteString("}\n\n")
+	buf.WriteString("// NewClient wraps a transport client with the operations of this baseline.\n")
+	buf.WriteString("func NewClient(c *client.Client) *Client {\n")
+	buf.WriteString("\treturn &Client{Client: c}\n")
+	buf.WriteString("}\n\n")
+
+	for _, op := range g.operations {
+		args := []string{"ctx context.Context"}
+		paramsArg, bodyArg := "nil", "nil"
+		if op.HasParams {
+			args = append(args, fmt.Sprintf("params *%sParams", op.Name))
+			paramsArg = "params"
+		}
+		if op.BodyType != "" {
+			args = append(args, "body "+op.BodyType)
+			bodyArg = "body"
+		}
+
+		if op.Summary != "" {
+			buf.WriteString(fmt.Sprintf("// %s calls the %s operation.\n", op.Name, quoteSummary(op.Summary)))
+		} else {
+			buf.WriteString(fmt.Sprintf("// %s calls %sOperation.\n", op.Name, op.Name))
+		}
+		if op.Deprecated {
+			buf.WriteString("//\n")
+			buf.WriteString("// Deprecated: this endpoint is deprecated by CheckMK.\n")
+		}
+
+		if op.ResultType == "" {
+			buf.WriteString(fmt.Sprintf("func (c *Client) %s(%s) (*client.Response, error) {\n", op.Name, strings.Join(args, ", ")))
+			buf.WriteString(fmt.Sprintf("\treturn c.Do(ctx, %sOperation, %s, %s, nil)\n", op.Name, paramsArg, bodyArg))
+			buf.WriteString("}\n\n")
+			continue
+		}
+
+		buf.WriteString(fmt.Sprintf("func (c *Client) %s(%s) (*%s, *client.Response, error) {\n", op.Name, strings.Join(args, ", "), op.ResultType))
+		buf.WriteString(fmt.Sprintf("\tout := new(%s)\n", op.ResultType))
+		buf.WriteString(fmt.Sprintf("\tresp, err := c.Do(ctx, %sOperation, %s, %s, out)\n", op.Name, paramsArg, bodyArg))
+		buf.WriteString("\tif err != nil {\n")
+		buf.WriteString("\t\treturn nil, resp, err\n")
+		buf.WriteString("\t}\n")
+		buf.WriteString("\treturn out, resp, nil\n")
+		buf.WriteString("}\n\n")
+	}
+
+	// Fetch-then-update helpers for operations guarded by If-Match
+	for _, op := range g.operations {
+		if fetch := g.fetchOperation(op); fetch != nil {
+			writeRetryMethod(&buf, op, *fetch)
+		}
+	}
+
+	// Write to file
+	outputPath := filepath.Join(g.outputDir, "client.gen.go")
+	if err := os.WriteFile(outputPath, []byte(buf.String()), 0644); err != nil {
+		return fmt.Errorf("writing client file: %w", err)
+	}
+
+	return nil
+}
+
+// constraintsLiteral returns the fields of an openapi.FieldConstraints literal
+// for a field, or an empty string if it has no constraints.
+func constraintsLiteral(meta FieldMetadata) string {
+	var fields []string
+	if meta.Format != "" {
+		fields = append(fields, "Format: "+strconv.Quote(meta.Format))
+	}
+	if meta.Pattern != "" {
+		fields = append(fields, "Pattern: "+strconv.Quote(meta.Pattern))
+	}
+	if meta.Minimum != nil {
+		fields = append(fields, "Minimum: openapi.Float("+strconv.FormatFloat(*meta.Minimum, 'g', -1, 64)+")")
+	}
+	if meta.Maximum != nil {
+		fields = append(fields, "Maximum: openapi.Float("+strconv.FormatFloat(*meta.Maximum, 'g', -1, 64)+")")
+	}
+	for _, c := range []struct {
+		name  string
+		value *int
+	}{{"MinLength", meta.MinLength}, {"MaxLength", meta.MaxLength}, {"MinItems", meta.MinItems}, {"MaxItems", meta.MaxItems}} {
+		if c.value != nil {
+			fields = append(fields, fmt.Sprintf("%s: openapi.Int(%d)", c.name, *c.value))
+		}
+	}
+	if meta.Default != nil {
+		fields = append(fields, "Default: "+jsonLiteral(meta.Default))
+	}
+	return strings.Join(fields, ", ")
+}
+
+// jsonLiteral renders a YAML value as the Go value encoding/json would decode
+// it to.
+func jsonLiteral(v interface{}) string {
+	switch v := v.(type) {
+	case nil:
+		return "nil"
+	case string:
+		return strconv.Quote(v)
+	case bool:
+		return strconv.FormatBool(v)
+	case int:
+		return fmt.Sprintf("float64(%d)", v)
+	case float64:
+		return "float64(" + strconv.FormatFloat(v, 'g', -1, 64) + ")"
+	case []interface{}:
+		items := make([]string, len(v))
+		for i, item := range v {
+			items[i] = jsonLiteral(item)
+		}
+		return "[]interface{}{" + strings.Join(items, ", ") + "}"
+	case map[string]interface{}:
+		keys := make([]string, 0, len(v))
+		for key := range v {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		entries := make([]string, len(keys))
+		for i, key := range keys {
+			entries[i] = fmt.Sprintf("%q: %s", key, jsonLiteral(v[key]))
+		}
+		return "map[string]interface{}{" + strings.Join(entries, ", ") + "}"
+	}
+	return strconv.Quote(fmt.Sprint(v))
+}
+
+func (g *Generator) generateSchemasFile(schemas []string) error {
+	var buf strings.Builder
+
+	// Write header
+	g.writeHeader(&buf, "schemas.gen.go", "Schema definitions for runtime validation")
+
+	buf.WriteString(fmt.Sprintf("import \"%s\"\n\n", g.openapiImport))
+
+	buf.WriteString("// Schemas maps component schema names to their definitions, with allOf\n")
+	buf.WriteString("// flattened and references to other components kept by name.\n")
+	buf.WriteString("// Use for validating request documents before sending them.\n")
+	buf.WriteString("var Schemas = map[string]*openapi.Schema{\n")
+	for _, name := range schemas {
+		buf.WriteString(fmt.Sprintf("\t%q: ", name))
+		g.writeSchemaLiteral(&buf, g.spec.Components.Schemas[name], "\t", true)
+		buf.WriteString(",\n")
+	}
+	buf.WriteString("}\n\n")
+
+	buf.WriteString("// GetSchema returns the definition of a component schema.\n")
+	buf.WriteString("// Returns nil if not found.\n")
+	buf.WriteString("func GetSchema(schemaName string) *openapi.Schema {\n")
+	buf.WriteString("\treturn Schemas[schemaName]\n")
+	buf.WriteString("}\n")
+
+	// Write to file
+	outputPath := filepath.Join(g.outputDir, "schemas.gen.go")
+	if err := os.WriteFile(outputPath, []byte(buf.String()), 0644); err != nil {
+		return fmt.Errorf("writing schemas file: %w", err)
+	}
+
+	return nil
+}
+
+// writeSchemaLiteral writes an openapi.Schema composite literal without its
+// type, for use as a map or slice element. Components other than the root are
+// written as references by name.
+func (g *Generator) writeSchemaLiteral(buf *strings.Builder, schema *Schema, indent string, root bool) {
+	if schema == nil {
+		buf.WriteString("{}")
+		return
+	}
+
+	// References: explicit $ref, a component inlined by resolveSchema, or an
+	// allOf: [$ref] wrapper attaching a description
+	ref := ""
+	switch {
+	case schema.Ref != "":
+		ref = strings.TrimPrefix(schema.Ref, "#/components/schemas/")
+	case !root && g.schemaNames[schema] != "":
+		ref = g.schemaNames[schema]
+	case len(schema.AllOf) == 1 && len(schema.Properties) == 0 && schema.AllOf[0] != nil && schema.AllOf[0].Ref != "":
+		ref = strings.TrimPrefix(schema.AllOf[0].Ref, "#/components/schemas/")
+	}
+	if ref != "" {
+		fields := []string{fmt.Sprintf("Ref: %q", ref)}
+		if schema.ReadOnly {
+			fields = append(fields, "ReadOnly: true")
+		}
+		if schema.Nullable {
+			fields = append(fields, "Nullable: true")
+		}
+		buf.WriteString("{" + strings.Join(fields, ", ") + "}")
+		return
+	}
+
+	g.mergeAllOf(schema)
+
+	inner := indent + "\t"
+	buf.WriteString("{\n")
+	field := func(name, value string) {
+		buf.WriteString(fmt.Sprintf("%s%s: %s,\n", inner, name, value))
+	}
+	nested := func(name string, s *Schema) {
+		buf.WriteString(fmt.Sprintf("%s%s: &openapi.Schema", inner, name))
+		g.writeSchemaLiteral(buf, s, inner, false)
+		buf.WriteString(",\n")
+	}
+	list := func(name string, members []*Schema) {
+		buf.WriteString(fmt.Sprintf("%s%s: []*openapi.Schema{\n", inner, name))
+		for _, member := range members {
+			buf.WriteString(inner + "\t")
+			g.writeSchemaLiteral(buf, member, inner+"\t", false)
+			buf.WriteString(",\n")
+		}
+		buf.WriteString(inner + "},\n")
+	}
+
+	if schema.Type != "" {
+		field("Type", strconv.Quote(schema.Type))
+	}
+	if schema.Format != "" {
+		field("Format", strconv.Quote(schema.Format))
+	}
+
+	if len(schema.Properties) > 0 {
+		var names []string
+		for name := range schema.Properties {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		buf.WriteString(fmt.Sprintf("%sProperties: map[string]*openapi.Schema{\n", inner))
+		for _, name := range names {
+			buf.WriteString(fmt.Sprintf("%s\t%q: ", inner, name))
+			g.writeSchemaLiteral(buf, schema.Properties[name], inner+"\t", false)
+			buf.WriteString(",\n")
+		}
+		buf.WriteString(inner + "},\n")
+	}
+	if len(schema.Required) > 0 {
+		field("Required", "[]string{"+strings.Join(quoteAll(schema.Required), ", ")+"}")
+	}
+
+	// Undeclared properties: a schema, true (anything), or free-form objects
+	// that declare no properties at all
+	switch additional := schema.AdditionalProperties.(type) {
+	case bool:
+		if additional {
+			field("AdditionalProperties", "&openapi.Schema{}")
+		}
+	case map[string]interface{}:
+		var additionalSchema Schema
+		if data, err := yaml.Marshal(additional); err == nil && yaml.Unmarshal(data, &additionalSchema) == nil {
+			nested("AdditionalProperties", &additionalSchema)
+		}
+	case nil:
+		if schema.Type == "object" && len(schema.Properties) == 0 && len(schema.OneOf) == 0 && len(schema.AnyOf) == 0 {
+			field("AdditionalProperties", "&openapi.Schema{}")
+		}
+	}
+
+	if schema.Items != nil {
+		nested("Items", schema.Items)
+	}
+
+	var enum []string
+	nullable := schema.Nullable
+	for _, value := range schema.Enum {
+		if value == nil {
+			nullable = true
+			continue
+		}
+		enum = append(enum, fmt.Sprint(value))
+	}
+	if len(enum) > 0 {
+		field("Enum", "[]string{"+strings.Join(quoteAll(enum), ", ")+"}")
+	}
+
+	if len(schema.OneOf) > 0 {
+		list("OneOf", schema.OneOf)
+	}
+	if len(schema.AnyOf) > 0 {
+		list("AnyOf", schema.AnyOf)
+	}
+	if d := schema.Discriminator; d != nil && d.PropertyName != "" {
+		field("Discriminator", strconv.Quote(d.PropertyName))
+		if len(d.Mapping) > 0 {
+			var values []string
+			for value := range d.Mapping {
+				values = append(values, value)
+			}
+			sort.Strings(values)
+			buf.WriteString(fmt.Sprintf("%sMapping: map[string]string{\n", inner))
+			for _, value := range values {
+				buf.WriteString(fmt.Sprintf("%s\t%q: %q,\n", inner, value, strings.TrimPrefix(d.Mapping[value], "#/components/schemas/")))
+			}
+			buf.WriteString(inner + "},\n")
+		}
+	}
+
+	if schema.Pattern != "" {
+		field("Pattern", strconv.Quote(schema.Pattern))
+	}
+	for _, c := range []struct {
+		name  string
+		value *int
+	}{{"MinLength", schema.MinLength}, {"MaxLength", schema.MaxLength}, {"MinItems", schema.MinItems}, {"MaxItems", schema.MaxItems}} {
+		if c.value != nil {
+			field(c.name, fmt.Sprintf("openapi.Int(%d)", *c.value))
+		}
+	}
+	if schema.Minimum != nil {
+		field("Minimum", "openapi.Float("+strconv.FormatFloat(*schema.Minimum, 'g', -1, 64)+")")
+	}
+	if schema.Maximum != nil {
+		field("Maximum", "openapi.Float("+strconv.FormatFloat(*schema.Maximum, 'g', -1, 64)+")")
+	}
+
+	if schema.ReadOnly {
+		field("ReadOnly", "true")
+	}
+	if nullable {
+		field("Nullable", "true")
+	}
+	if schema.Deprecated {
+		field("Deprecated", "true")
+	}
+	buf.WriteString(indent + "}")
+}
+
 // Helper functions
 
 func toGoTypeName(s string) string {
@@ -1343,6 +2933,13 @@ func writeFieldDocComment(buf *strings.Builder, description string, example inte
 	}
 }
 
+// quoteSummary quotes an operation summary for use inside a doc sentence.
+// Summaries are titles rather than sentences ("Show a host",
+// "X.509 PEM-encoded Certificate Signing Requests (CSRs)").
+func quoteSummary(summary string) string {
+	return `"` + strings.TrimSuffix(sanitizeComment(summary), ".") + `"`
+}
+
 func sanitizeComment(s string) string {
 	s = strings.ReplaceAll(s, "\n", " ")
 	s = strings.ReplaceAll(s, "\r", " ")
@@ -0,0 +1,159 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestUnionVariants(t *testing.T) {
+	ref := func(name string) *Schema { return &Schema{Ref: "#/components/schemas/" + name} }
+
+	tests := []struct {
+		name     string
+		mapping  map[string]string
+		expected []UnionVariant
+	}{
+		{
+			name:    "explicit mapping",
+			mapping: map[string]string{"host": "#/components/schemas/HostRule", "group": "#/components/schemas/GroupRule"},
+			expected: []UnionVariant{
+				{TypeName: "HostRule", Values: []string{"host"}},
+				{TypeName: "GroupRule", Values: []string{"group"}},
+			},
+		},
+		{
+			name: "implicit mapping",
+			expected: []UnionVariant{
+				{TypeName: "HostRule", Values: []string{"HostRule"}},
+				{TypeName: "GroupRule", Values: []string{"GroupRule"}},
+			},
+		},
+		{
+			name:    "member missing from mapping",
+			mapping: map[string]string{"host": "HostRule"},
+			expected: []UnionVariant{
+				{TypeName: "HostRule", Values: []string{"host"}},
+				{TypeName: "GroupRule", Values: []string{"GroupRule"}},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			schema := &Schema{
+				OneOf:         []*Schema{ref("HostRule"), ref("GroupRule"), ref("HostRule")},
+				Discriminator: &Discriminator{PropertyName: "type", Mapping: tt.mapping},
+			}
+			g := &Generator{}
+			if got := g.unionVariants(schema); !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("unionVariants() = %+v, want %+v", got, tt.expected)
+			}
+
+			var buf strings.Builder
+			g.generateUnion(&buf, "Rule", schema)
+			if strings.Contains(buf.String(), "case :") {
+				t.Errorf("generateUnion() wrote an empty case:\n%s", buf.String())
+			}
+		})
+	}
+}
+
+func TestWriteOperationDoc(t *testing.T) {
+	tests := []struct {
+		name     string
+		op       *Operation
+		expected string
+	}{
+		{"summary", &Operation{Summary: "Show a host.\n"}, `// ShowHostOperation describes the "Show a host" operation (GET /objects/host_config/{host_name}).`},
+		{"no summary", &Operation{}, "// ShowHostOperation describes GET /objects/host_config/{host_name}."},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf strings.Builder
+			g := &Generator{}
+			g.writeOperation(&buf, "ShowHost", "GET", "/objects/host_config/{host_name}", tt.op, nil, false)
+			if first, _, _ := strings.Cut(buf.String(), "\n"); first != tt.expected {
+				t.Errorf("doc = %q, want %q", first, tt.expected)
+			}
+		})
+	}
+}
+
+const constraintsSpec = `
+openapi: 3.0.2
+info:
+  version: "1.0"
+paths: {}
+components:
+  schemas:
+    HostName:
+      type: string
+      pattern: ^[-0-9a-zA-Z_.]+$
+      maxLength: 64
+    Port:
+      type: integer
+      minimum: 1
+      maximum: 65535
+    CreateHost:
+      type: object
+      properties:
+        inline:
+          type: string
+          minLength: 1
+        host_name:
+          $ref: '#/components/schemas/HostName'
+        port:
+          allOf:
+          - $ref: '#/components/schemas/Port'
+          default: 80
+        tags:
+          type: array
+          items:
+            type: string
+          maxItems: 3
+`
+
+func TestFieldConstraints(t *testing.T) {
+	dir := t.TempDir()
+	spec := filepath.Join(dir, "spec.yaml")
+	if err := os.WriteFile(spec, []byte(constraintsSpec), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	g := newGenerator("p1", dir)
+	if err := g.LoadSpec(spec); err != nil {
+		t.Fatal(err)
+	}
+
+	// Before resolution the keywords sit behind the $ref and allOf
+	props := g.spec.Components.Schemas["CreateHost"].Properties
+	if c := g.constrainedSchema(props["host_name"], make(map[*Schema]bool)); c.Pattern == "" || c.MaxLength == nil {
+		t.Errorf("constrainedSchema(host_name) = %+v, want pattern and maxLength", c)
+	}
+	if c := g.constrainedSchema(props["port"], make(map[*Schema]bool)); c.Minimum == nil || c.Maximum == nil || c.Default == nil {
+		t.Errorf("constrainedSchema(port) = %+v, want minimum, maximum and default", c)
+	}
+
+	if err := g.Generate(); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := map[string]string{
+		"inline":    "MinLength: openapi.Int(1)",
+		"host_name": `Pattern: "^[-0-9a-zA-Z_.]+$", MaxLength: openapi.Int(64)`,
+		"port":      "Minimum: openapi.Float(1), Maximum: openapi.Float(65535), Default: float64(80)",
+		"tags":      "MaxItems: openapi.Int(3)",
+	}
+	for _, meta := range g.fieldsMeta["CreateHost"] {
+		if got := constraintsLiteral(meta); got != expected[meta.Name] {
+			t.Errorf("constraints of %s = %s, want %s", meta.Name, got, expected[meta.Name])
+		}
+		delete(expected, meta.Name)
+	}
+	for name := range expected {
+		t.Errorf("no metadata for %s", name)
+	}
+}
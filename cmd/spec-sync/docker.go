@@ -9,16 +9,14 @@ import (
 	"os"
 	"os/exec"
 	"path/filepath"
-	"regexp"
-	"sort"
-	"strconv"
 	"strings"
 	"time"
+
+	"github.com/BlackMesaLTD/checkmk-api-spec/cmkversion"
 )
 
 const (
-	dockerHubAPI   = "https://hub.docker.com/v2/repositories/checkmk/check-mk-raw/tags"
-	dockerImage    = "checkmk/check-mk-raw"
+	dockerHubAPI   = "https://hub.docker.com/v2/repositories/%s/tags"
 	siteName       = "cmk"
 	containerPort  = "5000"
 	apiVersion     = "1.0"
@@ -26,17 +24,24 @@ const (
 	startupTimeout = 120 * time.Second
 )
 
-const (
-	// Minimum supported minor version (2.2+, as 2.0/2.1 used legacy Web API)
-	minMinorVersion = 2
-)
-
 var (
-	// Match any 2.x.x version with patch suffix (e.g., 2.5.0p1, 2.10.0p1)
-	versionTagRegex = regexp.MustCompile(`^2\.([0-9]+)\.[0-9]+p[0-9]+$`)
-	credentials     = []string{"cmkadmin:test123", "automation:test123"}
+	// Supported versions (2.2+, as 2.0/2.1 used legacy Web API)
+	supportedVersions = cmkversion.MustParseRange(">=2.2 <3")
+	credentials       = []string{"cmkadmin:test123", "automation:test123"}
 )
 
+// editionImages maps each edition to its Docker image. Raw and Cloud are
+// published on Docker Hub; Enterprise and MSP images are served by the
+// CheckMK registry, which needs customer credentials (docker login
+// registry.checkmk.com, plus CMK_REGISTRY_USERNAME and CMK_REGISTRY_PASSWORD
+// for listing tags).
+var editionImages = map[cmkversion.Edition]string{
+	cmkversion.EditionRaw:        "checkmk/check-mk-raw",
+	cmkversion.EditionCloud:      "checkmk/check-mk-cloud",
+	cmkversion.EditionEnterprise: "registry.checkmk.com/enterprise/check-mk-enterprise",
+	cmkversion.EditionMSP:        "registry.checkmk.com/managed/check-mk-managed",
+}
+
 // DockerHubResponse represents the Docker Hub API response
 type DockerHubResponse struct {
 	Results []struct {
@@ -45,10 +50,52 @@ type DockerHubResponse struct {
 	Next string `json:"next"`
 }
 
-// GetDockerHubVersions fetches all available versions from Docker Hub
-func GetDockerHubVersions() ([]string, error) {
+// RegistryTagsResponse represents the tag list of the Docker Registry API
+type RegistryTagsResponse struct {
+	Tags []string `json:"tags"`
+}
+
+// GetAvailableVersions fetches all available versions of an edition from
+// its registry, as manifest keys (2.4.0p17, 2.4.0p17.cee)
+func GetAvailableVersions(edition cmkversion.Edition) ([]string, error) {
+	image, ok := editionImages[edition]
+	if !ok {
+		return nil, fmt.Errorf("unknown edition %q", edition)
+	}
+
+	var tags []string
+	var err error
+	if strings.Count(image, "/") == 1 {
+		tags, err = getDockerHubTags(image)
+	} else {
+		tags, err = getRegistryTags(image)
+	}
+	if err != nil {
+		return nil, err
+	}
+
 	var allVersions []string
-	url := dockerHubAPI + "?page_size=100"
+	for _, tag := range tags {
+		// Only patch releases (e.g., 2.5.0p1, 2.10.0p1), no betas,
+		// dailies or aliases like "latest"
+		v, err := cmkversion.Parse(tag)
+		if err != nil || v.Kind() != cmkversion.Release || v.Patch() == 0 || v.Edition() != "" {
+			continue
+		}
+		if supportedVersions.Contains(v) {
+			allVersions = append(allVersions, versionKey(tag, edition))
+		}
+	}
+
+	// Sort versions
+	cmkversion.Sort(allVersions)
+	return allVersions, nil
+}
+
+// getDockerHubTags fetches all tags of a Docker Hub image
+func getDockerHubTags(image string) ([]string, error) {
+	var tags []string
+	url := fmt.Sprintf(dockerHubAPI, image) + "?page_size=100"
 
 	client := &http.Client{Timeout: 30 * time.Second}
 
@@ -70,26 +117,56 @@ func GetDockerHubVersions() ([]string, error) {
 		}
 
 		for _, result := range hubResp.Results {
-			if matches := versionTagRegex.FindStringSubmatch(result.Name); matches != nil {
-				// matches[1] is the minor version number
-				minor, _ := strconv.Atoi(matches[1])
-				if minor >= minMinorVersion {
-					allVersions = append(allVersions, result.Name)
-				}
-			}
+			tags = append(tags, result.Name)
 		}
 
 		url = hubResp.Next
 	}
 
-	// Sort versions
-	sortVersions(allVersions)
-	return allVersions, nil
+	return tags, nil
 }
 
-// FetchSpecFromDocker fetches the OpenAPI spec from a Docker container
+// getRegistryTags fetches all tags of an image in a Docker registry (e.g.
+// registry.checkmk.com/enterprise/check-mk-enterprise)
+func getRegistryTags(image string) ([]string, error) {
+	host, repository, _ := strings.Cut(image, "/")
+	username, password := os.Getenv("CMK_REGISTRY_USERNAME"), os.Getenv("CMK_REGISTRY_PASSWORD")
+	if username == "" || password == "" {
+		return nil, fmt.Errorf("listing tags of %s needs CMK_REGISTRY_USERNAME and CMK_REGISTRY_PASSWORD", host)
+	}
+
+	req, err := http.NewRequest("GET", fmt.Sprintf("https://%s/v2/%s/tags/list", host, repository), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.SetBasicAuth(username, password)
+
+	client := &http.Client{Timeout: 30 * time.Second}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch %s tags: %w", host, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to fetch %s tags: %s", host, resp.Status)
+	}
+
+	var tagsResp RegistryTagsResponse
+	if err := json.NewDecoder(resp.Body).Decode(&tagsResp); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+	return tagsResp.Tags, nil
+}
+
+// FetchSpecFromDocker fetches the OpenAPI spec of a version (a manifest
+// key such as 2.4.0p17.cee) from a Docker container of its edition
 func FetchSpecFromDocker(version string, specsDir string, verbose bool) ([]byte, error) {
-	image := fmt.Sprintf("%s:%s", dockerImage, version)
+	v, err := cmkversion.Parse(version)
+	if err != nil {
+		return nil, err
+	}
+	image := fmt.Sprintf("%s:%s", editionImages[keyEdition(version)], v.WithEdition(""))
 	containerName := fmt.Sprintf("spec-fetch-%s", strings.ReplaceAll(version, ".", "-"))
 
 	// Cleanup any existing container with same name
@@ -227,9 +304,10 @@ func SaveSpec(version string, specsDir string, data []byte) error {
 	return nil
 }
 
-// FindMissingVersions returns versions that exist on Docker Hub but not in manifest
-func FindMissingVersions(manifest *Manifest, minor string) ([]string, error) {
-	hubVersions, err := GetDockerHubVersions()
+// FindMissingVersions returns versions of an edition that exist in its
+// registry but not in manifest
+func FindMissingVersions(manifest *Manifest, minor string, edition cmkversion.Edition) ([]string, error) {
+	hubVersions, err := GetAvailableVersions(edition)
 	if err != nil {
 		return nil, err
 	}
@@ -237,7 +315,7 @@ func FindMissingVersions(manifest *Manifest, minor string) ([]string, error) {
 	var missing []string
 	for _, v := range hubVersions {
 		// Filter by minor if specified
-		if minor != "" && !strings.HasPrefix(v, minor) {
+		if minor != "" && !inMinor(v, minor) {
 			continue
 		}
 
@@ -247,9 +325,7 @@ func FindMissingVersions(manifest *Manifest, minor string) ([]string, error) {
 		}
 	}
 
-	sort.Slice(missing, func(i, j int) bool {
-		return compareVersions(missing[i], missing[j]) < 0
-	})
+	cmkversion.Sort(missing)
 
 	return missing, nil
 }
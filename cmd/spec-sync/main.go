@@ -1,16 +1,24 @@
 // Package main implements the spec-sync tool for managing OpenAPI spec baselines.
 //
 // This tool:
-//   - Queries Docker Hub for available CheckMK versions
-//   - Compares specs to detect API changes
+//   - Queries Docker Hub (or the CheckMK registry) for available CheckMK
+//     versions of an edition
+//   - Compares specs to detect API changes, with the same specdiff engine
+//     that openapi-diff publishes diffs with
 //   - Maintains a manifest mapping versions to their baseline specs
 //   - Only stores specs where the API actually changed
 //
+// Each edition has specs and baselines of its own: raw edition specs are
+// stored as specs/2.4.0/p17.yaml, those of other editions below a directory
+// named after the edition (specs/cee/2.4.0/p17.yaml), and their manifest
+// keys carry the edition suffix (2.4.0p17.cee).
+//
 // Usage:
 //
 //	spec-sync --bootstrap              # Build manifest from existing specs
 //	spec-sync                          # Sync new versions from Docker Hub
 //	spec-sync --minor 2.4              # Only process 2.4.x versions
+//	spec-sync --edition cee            # Sync the Enterprise edition
 //	spec-sync --dry-run                # Show what would be done
 package main
 
@@ -20,9 +28,10 @@ import (
 	"log"
 	"os"
 	"path/filepath"
-	"regexp"
-	"sort"
 	"strings"
+
+	"github.com/BlackMesaLTD/checkmk-api-spec/cmkversion"
+	"github.com/BlackMesaLTD/checkmk-api-spec/specdiff"
 )
 
 // Config holds the tool configuration
@@ -34,17 +43,16 @@ type Config struct {
 	DryRun       bool
 	Verbose      bool
 	Minor        string
+	Edition      cmkversion.Edition
 	Force        bool
 }
 
-var versionRegex = regexp.MustCompile(`^(\d+)\.(\d+)\.(\d+)p(\d+)$`)
-
 func main() {
 	cfg := parseFlags()
 
 	if cfg.Verbose {
-		log.Printf("Config: specs=%s manifest=%s bootstrap=%v cleanup=%v dry-run=%v minor=%s",
-			cfg.SpecsDir, cfg.ManifestPath, cfg.Bootstrap, cfg.Cleanup, cfg.DryRun, cfg.Minor)
+		log.Printf("Config: specs=%s manifest=%s bootstrap=%v cleanup=%v dry-run=%v minor=%s edition=%s",
+			cfg.SpecsDir, cfg.ManifestPath, cfg.Bootstrap, cfg.Cleanup, cfg.DryRun, cfg.Minor, cfg.Edition)
 	}
 
 	if cfg.Bootstrap {
@@ -72,10 +80,16 @@ func parseFlags() *Config {
 	flag.BoolVar(&cfg.DryRun, "dry-run", false, "Show what would be done without making changes")
 	flag.BoolVar(&cfg.Verbose, "v", false, "Verbose output")
 	flag.StringVar(&cfg.Minor, "minor", "", "Filter by minor version (e.g., 2.4)")
+	edition := flag.String("edition", string(cmkversion.EditionRaw), "CheckMK edition to sync: cre, cee, cce or cme")
 	flag.BoolVar(&cfg.Force, "force", false, "Re-check versions even if already in manifest")
 
 	flag.Parse()
 
+	cfg.Edition = cmkversion.Edition(*edition)
+	if !cfg.Edition.Valid() {
+		log.Fatalf("Unknown edition %q (want cre, cee, cce or cme)", *edition)
+	}
+
 	return cfg
 }
 
@@ -103,7 +117,7 @@ func runBootstrap(cfg *Config) error {
 	}
 
 	// Sort versions
-	sortVersions(versions)
+	cmkversion.Sort(versions)
 
 	// Load or create manifest
 	manifest, err := LoadManifest(cfg.ManifestPath)
@@ -252,6 +266,7 @@ func processMinorSeries(cfg *Config, manifest *Manifest, minor string, versions
 			log.Printf("  %s: BASELINE (first in series)", version)
 			manifest.Versions[version] = VersionEntry{
 				Spec:        relativeSpecPath(version),
+				Edition:     string(keyEdition(version)),
 				Baseline:    version,
 				Package:     versionToPackage(version),
 				IsBaseline:  true,
@@ -265,18 +280,19 @@ func processMinorSeries(cfg *Config, manifest *Manifest, minor string, versions
 		}
 
 		// Compare with current baseline
-		diff, err := CompareSpecs(currentBaselineSpec, specData)
+		diff, err := specdiff.CompareBytes(currentBaselineSpec, specData)
 		if err != nil {
 			log.Printf("  %s: failed to compare: %v", version, err)
 			continue
 		}
 
-		if SeverityOrder[diff.MaxSeverity] >= SeverityOrder[SeverityMinor] {
+		if specdiff.SeverityOrder[diff.MaxSeverity] >= specdiff.SeverityOrder[specdiff.SeverityMinor] {
 			// API changed - new baseline
-			log.Printf("  %s: BASELINE (API changed: %s, %d changes)",
-				version, diff.MaxSeverity, diff.TotalChanges)
+			log.Printf("  %s: BASELINE (API changed: %s, %d changes, %d nested)",
+				version, diff.MaxSeverity, diff.TotalChanges(), diff.NestedChanges())
 			manifest.Versions[version] = VersionEntry{
 				Spec:        relativeSpecPath(version),
+				Edition:     string(keyEdition(version)),
 				Baseline:    version,
 				Package:     versionToPackage(version),
 				IsBaseline:  true,
@@ -295,6 +311,7 @@ func processMinorSeries(cfg *Config, manifest *Manifest, minor string, versions
 			baselineEntry := manifest.Versions[currentBaseline]
 			manifest.Versions[version] = VersionEntry{
 				Spec:        relativeSpecPath(currentBaseline),
+				Edition:     string(keyEdition(version)),
 				Baseline:    currentBaseline,
 				Package:     baselineEntry.Package,
 				IsBaseline:  false,
@@ -326,10 +343,10 @@ func runSync(cfg *Config) error {
 	log.Printf("Loaded manifest with %d versions", len(manifest.Versions))
 
 	// Find missing versions
-	log.Println("Checking Docker Hub for new versions...")
-	missing, err := FindMissingVersions(manifest, cfg.Minor)
+	log.Printf("Checking %s for new %s versions...", editionImages[cfg.Edition], cfg.Edition)
+	missing, err := FindMissingVersions(manifest, cfg.Minor, cfg.Edition)
 	if err != nil {
-		return fmt.Errorf("failed to check Docker Hub: %w", err)
+		return fmt.Errorf("failed to check registry: %w", err)
 	}
 
 	if len(missing) == 0 {
@@ -384,7 +401,7 @@ func runSync(cfg *Config) error {
 				isBaseline = true
 				maxSeverity = "unknown"
 			} else {
-				diff, err := CompareSpecs(baselineData, specData)
+				diff, err := specdiff.CompareBytes(baselineData, specData)
 				if err != nil {
 					log.Printf("  Warning: comparison failed: %v", err)
 					failed++
@@ -393,9 +410,9 @@ func runSync(cfg *Config) error {
 
 				maxSeverity = string(diff.MaxSeverity)
 
-				if SeverityOrder[diff.MaxSeverity] >= SeverityOrder[SeverityMinor] {
+				if specdiff.SeverityOrder[diff.MaxSeverity] >= specdiff.SeverityOrder[specdiff.SeverityMinor] {
 					isBaseline = true
-					log.Printf("  BASELINE (API changed: %s, %d changes)", diff.MaxSeverity, diff.TotalChanges)
+					log.Printf("  BASELINE (API changed: %s, %d changes, %d nested)", diff.MaxSeverity, diff.TotalChanges(), diff.NestedChanges())
 				} else {
 					isBaseline = false
 					log.Printf("  Points to %s (no API changes, severity: %s)", latestBaseline, maxSeverity)
@@ -415,6 +432,7 @@ func runSync(cfg *Config) error {
 
 			manifest.Versions[version] = VersionEntry{
 				Spec:        relativeSpecPath(version),
+				Edition:     string(keyEdition(version)),
 				Baseline:    version,
 				Package:     versionToPackage(version),
 				IsBaseline:  true,
@@ -427,6 +445,7 @@ func runSync(cfg *Config) error {
 			baselineEntry := manifest.Versions[latestBaseline]
 			manifest.Versions[version] = VersionEntry{
 				Spec:        relativeSpecPath(latestBaseline),
+				Edition:     string(keyEdition(version)),
 				Baseline:    latestBaseline,
 				Package:     baselineEntry.Package,
 				IsBaseline:  false,
@@ -452,12 +471,13 @@ func runSync(cfg *Config) error {
 	return nil
 }
 
-// findLatestBaselineForMinor finds the latest baseline version for a minor series
+// findLatestBaselineForMinor finds the latest baseline version for a minor
+// series of an edition, as returned by extractMinor
 func findLatestBaselineForMinor(manifest *Manifest, minor string) string {
 	var latestBaseline string
 	for version, entry := range manifest.Versions {
-		if entry.IsBaseline && strings.HasPrefix(version, minor) {
-			if latestBaseline == "" || compareVersions(version, latestBaseline) > 0 {
+		if entry.IsBaseline && extractMinor(version) == minor {
+			if latestBaseline == "" || cmkversion.CompareStrings(version, latestBaseline) > 0 {
 				latestBaseline = version
 			}
 		}
@@ -496,14 +516,23 @@ func findExistingSpecs(specsDir string) ([]string, error) {
 
 // specPathToVersion converts a spec path to version string
 // specs/2.4.0/p17.yaml -> 2.4.0p17
+// specs/cee/2.4.0/p17.yaml -> 2.4.0p17.cee
 func specPathToVersion(specsDir, path string) string {
 	rel, err := filepath.Rel(specsDir, path)
 	if err != nil {
 		return ""
 	}
 
-	// Expected format: 2.4.0/p17.yaml
+	// Expected format: [<edition>/]2.4.0/p17.yaml
 	parts := strings.Split(rel, string(filepath.Separator))
+	edition := cmkversion.EditionRaw
+	if len(parts) == 3 {
+		edition = cmkversion.Edition(parts[0])
+		if !edition.Valid() || edition == cmkversion.EditionRaw {
+			return ""
+		}
+		parts = parts[1:]
+	}
 	if len(parts) != 2 {
 		return ""
 	}
@@ -515,132 +544,134 @@ func specPathToVersion(specsDir, path string) string {
 	file = strings.TrimSuffix(file, ".yaml")
 	file = strings.TrimSuffix(file, ".yml")
 
-	if !strings.HasPrefix(file, "p") {
+	if !strings.HasPrefix(file, "p") || !cmkversion.Valid(minor+file) {
 		return ""
 	}
 
-	return minor + file // 2.4.0p17
+	return versionKey(minor+file, edition) // 2.4.0p17
+}
+
+// versionKey returns the manifest key of a version in an edition. Raw
+// edition versions are unsuffixed, others carry their edition:
+// 2.4.0p17 (cre) -> 2.4.0p17, 2.4.0p17 (cee) -> 2.4.0p17.cee
+func versionKey(version string, edition cmkversion.Edition) string {
+	if edition == cmkversion.EditionRaw {
+		return version
+	}
+	return version + "." + string(edition)
+}
+
+// keyEdition returns the edition of a manifest key
+// 2.4.0p17 -> cre, 2.4.0p17.cee -> cee
+func keyEdition(key string) cmkversion.Edition {
+	v, err := cmkversion.Parse(key)
+	if err != nil || v.Edition() == "" {
+		return cmkversion.EditionRaw
+	}
+	return v.Edition()
+}
+
+// editionDir returns the directory prefix of an edition's specs and
+// packages: none for the raw edition, "cee/" for Enterprise
+func editionDir(v cmkversion.Version) string {
+	if e := v.Edition(); e != "" && e != cmkversion.EditionRaw {
+		return string(e) + "/"
+	}
+	return ""
 }
 
 // versionToSpecPath converts version to spec path
 // 2.4.0p17 -> specs/2.4.0/p17.yaml
+// 2.4.0p17.cee -> specs/cee/2.4.0/p17.yaml
 func versionToSpecPath(specsDir, version string) string {
-	parts := strings.SplitN(version, "p", 2)
-	if len(parts) != 2 {
+	rel := relativeSpecPath(version)
+	if rel == "" {
 		return ""
 	}
-	minor := parts[0]
-	patch := parts[1]
-	return filepath.Join(specsDir, minor, "p"+patch+".yaml")
+	return filepath.Join(specsDir, filepath.FromSlash(rel))
 }
 
 // relativeSpecPath returns the relative spec path for manifest
 // 2.4.0p17 -> 2.4.0/p17.yaml
 func relativeSpecPath(version string) string {
-	parts := strings.SplitN(version, "p", 2)
-	if len(parts) != 2 {
-		return version + ".yaml"
+	v, err := cmkversion.Parse(version)
+	if err != nil {
+		return ""
 	}
-	return parts[0] + "/p" + parts[1] + ".yaml"
+	return fmt.Sprintf("%s%s/p%d.yaml", editionDir(v), v.Branch(), v.Patch())
 }
 
 // versionToPackage converts version to Go package name
 // 2.4.0p17 -> p17
 func versionToPackage(version string) string {
-	parts := strings.SplitN(version, "p", 2)
-	if len(parts) == 2 {
-		return "p" + parts[1]
+	v, err := cmkversion.Parse(version)
+	if err != nil {
+		return "v" + strings.ReplaceAll(version, ".", "_")
 	}
-	return "v" + strings.ReplaceAll(version, ".", "_")
+	return fmt.Sprintf("p%d", v.Patch())
 }
 
 // versionToPath converts version to import path suffix
 // 2.4.0p17 -> v2_4_0/p17
+// 2.4.0p17.cee -> cee/v2_4_0/p17
 func versionToPath(version string) string {
-	parts := strings.SplitN(version, "p", 2)
-	if len(parts) == 2 {
-		minor := strings.ReplaceAll(parts[0], ".", "_")
-		return "v" + minor + "/p" + parts[1]
+	v, err := cmkversion.Parse(version)
+	if err != nil {
+		return "v" + strings.ReplaceAll(version, ".", "_")
 	}
-	return "v" + strings.ReplaceAll(version, ".", "_")
+	return fmt.Sprintf("%sv%s/p%d", editionDir(v), strings.ReplaceAll(v.Branch(), ".", "_"), v.Patch())
 }
 
 // versionToImportAlias converts version to import alias
 // 2.4.0p17 -> v2_4_0_p17 (includes the 0 for clarity)
+// 2.4.0p17.cee -> v2_4_0_p17_cee
 func versionToImportAlias(version string) string {
-	parts := strings.SplitN(version, "p", 2)
-	if len(parts) == 2 {
-		minor := strings.ReplaceAll(parts[0], ".", "_")
-		return "v" + minor + "_p" + parts[1]
+	v, err := cmkversion.Parse(version)
+	if err != nil {
+		return "v" + strings.ReplaceAll(version, ".", "_")
+	}
+	alias := fmt.Sprintf("v%s_p%d", strings.ReplaceAll(v.Branch(), ".", "_"), v.Patch())
+	if dir := editionDir(v); dir != "" {
+		alias += "_" + strings.TrimSuffix(dir, "/")
 	}
-	return "v" + strings.ReplaceAll(version, ".", "_")
+	return alias
 }
 
 // filterByMinor filters versions to only those matching the minor version
 func filterByMinor(versions []string, minor string) []string {
 	var filtered []string
 	for _, v := range versions {
-		if strings.HasPrefix(v, minor) {
+		if inMinor(v, minor) {
 			filtered = append(filtered, v)
 		}
 	}
 	return filtered
 }
 
-// groupByMinor groups versions by their minor version
+// inMinor reports whether a version belongs to a minor version series,
+// given as "2.4" or "2.4.0"
+func inMinor(version, minor string) bool {
+	v, err := cmkversion.Parse(version)
+	if err != nil {
+		return false
+	}
+	return v.Minor() == minor || v.Branch() == minor
+}
+
+// groupByMinor groups versions by their minor version series (e.g. 2.4.0,
+// or 2.4.0.cee for the Enterprise edition), as each edition has baselines
+// of its own
 func groupByMinor(versions []string) map[string][]string {
 	groups := make(map[string][]string)
 	for _, v := range versions {
-		parts := strings.SplitN(v, "p", 2)
-		if len(parts) == 2 {
-			minor := parts[0]
-			groups[minor] = append(groups[minor], v)
-		}
+		minor := extractMinor(v)
+		groups[minor] = append(groups[minor], v)
 	}
 
 	// Sort each group
 	for minor := range groups {
-		sortVersions(groups[minor])
+		cmkversion.Sort(groups[minor])
 	}
 
 	return groups
 }
-
-// sortVersions sorts version strings in semantic order
-func sortVersions(versions []string) {
-	sort.Slice(versions, func(i, j int) bool {
-		return compareVersions(versions[i], versions[j]) < 0
-	})
-}
-
-// compareVersions compares two version strings
-// Returns -1 if a < b, 0 if a == b, 1 if a > b
-func compareVersions(a, b string) int {
-	aParts := parseVersion(a)
-	bParts := parseVersion(b)
-
-	for i := 0; i < 4; i++ {
-		if aParts[i] < bParts[i] {
-			return -1
-		}
-		if aParts[i] > bParts[i] {
-			return 1
-		}
-	}
-	return 0
-}
-
-// parseVersion parses version string into [major, minor, patch, p-number]
-func parseVersion(v string) [4]int {
-	matches := versionRegex.FindStringSubmatch(v)
-	if len(matches) != 5 {
-		return [4]int{}
-	}
-
-	var parts [4]int
-	fmt.Sscanf(matches[1], "%d", &parts[0])
-	fmt.Sscanf(matches[2], "%d", &parts[1])
-	fmt.Sscanf(matches[3], "%d", &parts[2])
-	fmt.Sscanf(matches[4], "%d", &parts[3])
-	return parts
-}
@@ -5,12 +5,16 @@ import (
 	"fmt"
 	"log"
 	"os"
-	"sort"
 	"strings"
 	"time"
+
+	"github.com/BlackMesaLTD/checkmk-api-spec/cmkversion"
 )
 
-// Manifest tracks all known versions and their baseline mappings
+// Manifest tracks all known versions and their baseline mappings. Versions
+// of the raw edition are keyed by version (2.4.0p17), those of other
+// editions carry the edition suffix (2.4.0p17.cee); each edition has
+// baselines of its own.
 type Manifest struct {
 	Baselines   []string                `json:"baselines"`    // List of baseline versions
 	Mapping     map[string]VersionEntry `json:"mapping"`      // Version -> entry mapping
@@ -22,7 +26,8 @@ type Manifest struct {
 
 // VersionEntry maps a version to its baseline spec
 type VersionEntry struct {
-	Spec        string `json:"spec"`         // Relative path: "2.4.0/p1.yaml"
+	Spec        string `json:"spec"`         // Relative path: "2.4.0/p1.yaml", "cee/2.4.0/p1.yaml"
+	Edition     string `json:"edition"`      // CheckMK edition: "cre", "cee", "cce", "cme"
 	Baseline    string `json:"baseline"`     // Baseline version: "2.4.0p1"
 	Package     string `json:"package"`      // Go package name: "p1"
 	IsBaseline  bool   `json:"is_baseline"`  // True if this version IS a baseline
@@ -61,8 +66,12 @@ func LoadManifest(path string) (*Manifest, error) {
 		manifest.Versions = make(map[string]VersionEntry)
 	}
 
-	// Populate internal Versions map from Mapping for backwards compat
+	// Populate internal Versions map from Mapping for backwards compat.
+	// Manifests written before editions were tracked are raw edition only.
 	for k, v := range manifest.Mapping {
+		if v.Edition == "" {
+			v.Edition = string(keyEdition(k))
+		}
 		manifest.Versions[k] = v
 	}
 
@@ -135,7 +144,7 @@ func (m *Manifest) GetBaselines() []string {
 			baselines = append(baselines, version)
 		}
 	}
-	sortVersions(baselines)
+	cmkversion.Sort(baselines)
 	return baselines
 }
 
@@ -145,7 +154,7 @@ func (m *Manifest) GetVersions() []string {
 	for version := range m.Versions {
 		versions = append(versions, version)
 	}
-	sortVersions(versions)
+	cmkversion.Sort(versions)
 	return versions
 }
 
@@ -179,7 +188,7 @@ func (m *Manifest) PrintSummary() {
 	for minor := range byMinor {
 		minors = append(minors, minor)
 	}
-	sort.Strings(minors)
+	cmkversion.Sort(minors)
 
 	log.Printf("\n  By minor version:")
 	for _, minor := range minors {
@@ -210,11 +219,11 @@ func (m *Manifest) Print() {
 	for minor := range byMinor {
 		minors = append(minors, minor)
 	}
-	sort.Strings(minors)
+	cmkversion.Sort(minors)
 
 	for _, minor := range minors {
 		versions := byMinor[minor]
-		sortVersions(versions)
+		cmkversion.Sort(versions)
 		log.Printf("\n  %s series:", minor)
 		for _, v := range versions {
 			entry := m.Versions[v]
@@ -227,13 +236,13 @@ func (m *Manifest) Print() {
 	}
 }
 
-// extractMinor extracts the minor version from a full version
-// 2.4.0p17 -> 2.4.0
+// extractMinor extracts the minor version series from a full version,
+// keeping the edition suffix
+// 2.4.0p17 -> 2.4.0, 2.4.0p17.cee -> 2.4.0.cee
 func extractMinor(version string) string {
-	for i := len(version) - 1; i >= 0; i-- {
-		if version[i] == 'p' {
-			return version[:i]
-		}
+	v, err := cmkversion.Parse(version)
+	if err != nil {
+		return version
 	}
-	return version
+	return versionKey(v.Branch(), keyEdition(version))
 }
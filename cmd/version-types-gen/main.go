@@ -3,6 +3,9 @@
 //
 // This tool reads manifest.json and generates version_types.go which the
 // Terraform provider imports to resolve versions to the correct type package.
+// The baselines of each minor version register themselves from their own
+// file (version_types_v2_4_0.go) under the build tags of their packages, so
+// builds with -tags checkmk_v2_4_0 compile only the 2.4.0 baselines.
 //
 // Usage:
 //
@@ -17,9 +20,13 @@ import (
 	"go/format"
 	"log"
 	"os"
+	"path"
+	"path/filepath"
 	"sort"
 	"strings"
 	"text/template"
+
+	"github.com/BlackMesaLTD/checkmk-api-spec/cmkversion"
 )
 
 // BaselinesInput matches the manifest.json structure from spec-sync
@@ -32,16 +39,22 @@ type BaselinesInput struct {
 // BaselineInfo describes how a version maps to a baseline
 type BaselineInfo struct {
 	Baseline    string `json:"baseline"`
+	Edition     string `json:"edition"`       // CheckMK edition (e.g., "cre", "cee")
 	Package     string `json:"package"`       // Go package name (e.g., "p43")
 	Path        string `json:"path"`          // Import path suffix (e.g., "v2_2_0/p43")
 	ImportAlias string `json:"import_alias"`  // Import alias (e.g., "v2_2_p43")
 	IsBaseline  bool   `json:"is_baseline"`
 }
 
+// versionPackage is the import path of the version parser used by the
+// generated LookupBaselineEdition
+const versionPackage = "github.com/BlackMesaLTD/checkmk-api-spec/cmkversion"
+
 // TemplateData is passed to the code template
 type TemplateData struct {
 	Package         string
 	ModulePath      string
+	VersionPackage  string
 	Baselines       []string
 	BaselineImports []BaselineImport
 	VersionMapping  []VersionMapEntry
@@ -52,6 +65,17 @@ type TemplateData struct {
 type BaselineImport struct {
 	Alias   string
 	Package string
+	Minor   string // Minor version directory (e.g., "v2_4_0")
+	Version string // Version of the baseline (e.g., "2.4.0p17")
+}
+
+// MinorFile holds the baselines of a minor version, registered from their
+// own file under the build tags of their packages
+type MinorFile struct {
+	Package    string
+	ModulePath string
+	Minor      string // e.g., "v2_4_0"
+	Imports    []BaselineImport
 }
 
 // VersionMapEntry represents a version to baseline mapping
@@ -94,19 +118,40 @@ func main() {
 		log.Fatalf("Failed to generate code: %v", err)
 	}
 
-	// Format code
-	formatted, err := format.Source(code)
-	if err != nil {
-		log.Printf("Warning: failed to format code: %v", err)
-		formatted = code
-	}
-
 	// Write output
-	if err := os.WriteFile(*outputPath, formatted, 0644); err != nil {
+	if err := writeSource(*outputPath, code); err != nil {
 		log.Fatalf("Failed to write output: %v", err)
 	}
-
 	fmt.Printf("Generated %s\n", *outputPath)
+
+	// Write the registration file of each minor version, removing those of
+	// minor versions no longer in the manifest
+	minorFiles, err := generateMinorFiles(&baselines, *packageName, *modulePath)
+	if err != nil {
+		log.Fatalf("Failed to generate code: %v", err)
+	}
+	dir := filepath.Dir(*outputPath)
+	stale, _ := filepath.Glob(filepath.Join(dir, minorFilePrefix+"v*.go"))
+	for _, path := range stale {
+		if _, ok := minorFiles[filepath.Base(path)]; !ok && !strings.HasSuffix(path, "_test.go") {
+			if err := os.Remove(path); err != nil {
+				log.Fatalf("Failed to remove %s: %v", path, err)
+			}
+		}
+	}
+	names := make([]string, 0, len(minorFiles))
+	for name := range minorFiles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		path := filepath.Join(dir, name)
+		if err := writeSource(path, minorFiles[name]); err != nil {
+			log.Fatalf("Failed to write output: %v", err)
+		}
+		fmt.Printf("Generated %s\n", path)
+	}
+
 	fmt.Printf("  Package: %s\n", *packageName)
 	fmt.Printf("  Baselines: %d\n", len(baselines.Baselines))
 	fmt.Printf("  Total versions mapped: %d\n", len(baselines.Mapping))
@@ -114,42 +159,20 @@ func main() {
 
 func generateCode(baselines *BaselinesInput, packageName, modulePath string) ([]byte, error) {
 	tmplData := &TemplateData{
-		Package:    packageName,
-		ModulePath: modulePath,
-		Baselines:  baselines.Baselines,
+		Package:        packageName,
+		ModulePath:     modulePath,
+		VersionPackage: versionPackage,
+		Baselines:      baselines.Baselines,
 	}
 
-	// Build imports
-	seen := make(map[string]bool)
-	for _, baseline := range baselines.Baselines {
-		info := baselines.Mapping[baseline]
-		// Determine import path and alias
-		importPath := info.Path
-		importAlias := info.ImportAlias
-		// Fallback for backwards compatibility with old manifest format
-		if importPath == "" {
-			importPath = info.Package
-		}
-		if importAlias == "" {
-			importAlias = info.Package
-		}
-		if !seen[importPath] {
-			tmplData.BaselineImports = append(tmplData.BaselineImports, BaselineImport{
-				Alias:   importAlias,
-				Package: modulePath + "/" + importPath,
-			})
-			seen[importPath] = true
-		}
-	}
+	tmplData.BaselineImports = baselineImports(baselines, modulePath)
 
 	// Build version mapping (sorted)
 	versions := make([]string, 0, len(baselines.Mapping))
 	for v := range baselines.Mapping {
 		versions = append(versions, v)
 	}
-	sort.Slice(versions, func(i, j int) bool {
-		return compareVersions(versions[i], versions[j]) < 0
-	})
+	cmkversion.Sort(versions)
 
 	for _, v := range versions {
 		info := baselines.Mapping[v]
@@ -205,44 +228,85 @@ func generateCode(baselines *BaselinesInput, packageName, modulePath string) ([]
 	return buf.Bytes(), nil
 }
 
-func getMinorVersion(version string) string {
-	parts := strings.Split(version, ".")
-	if len(parts) >= 2 {
-		return parts[0] + "." + parts[1]
+// baselineImports returns the import of each baseline package
+func baselineImports(baselines *BaselinesInput, modulePath string) []BaselineImport {
+	var imports []BaselineImport
+	seen := make(map[string]bool)
+	for _, baseline := range baselines.Baselines {
+		info := baselines.Mapping[baseline]
+		// Determine import path and alias
+		importPath := info.Path
+		importAlias := info.ImportAlias
+		// Fallback for backwards compatibility with old manifest format
+		if importPath == "" {
+			importPath = info.Package
+		}
+		if importAlias == "" {
+			importAlias = info.Package
+		}
+		if !seen[importPath] {
+			// v2_4_0/p17, or cee/v2_4_0/p17 for other editions
+			minor := path.Base(path.Dir(importPath))
+			imports = append(imports, BaselineImport{
+				Alias:   importAlias,
+				Package: modulePath + "/" + importPath,
+				Minor:   minor,
+				Version: baseline,
+			})
+			seen[importPath] = true
+		}
 	}
-	return version
+	return imports
 }
 
-func compareVersions(a, b string) int {
-	partsA := parseVersion(a)
-	partsB := parseVersion(b)
+// minorFilePrefix starts the names of the per-minor registration files
+const minorFilePrefix = "version_types_"
 
-	for i := 0; i < len(partsA) && i < len(partsB); i++ {
-		if partsA[i] < partsB[i] {
-			return -1
-		}
-		if partsA[i] > partsB[i] {
-			return 1
+// generateMinorFiles generates the registration file of each minor version,
+// keyed by file name
+func generateMinorFiles(baselines *BaselinesInput, packageName, modulePath string) (map[string][]byte, error) {
+	byMinor := make(map[string]*MinorFile)
+	var minors []string
+	for _, imp := range baselineImports(baselines, modulePath) {
+		if byMinor[imp.Minor] == nil {
+			byMinor[imp.Minor] = &MinorFile{Package: packageName, ModulePath: modulePath, Minor: imp.Minor}
+			minors = append(minors, imp.Minor)
 		}
+		byMinor[imp.Minor].Imports = append(byMinor[imp.Minor].Imports, imp)
 	}
 
-	if len(partsA) < len(partsB) {
-		return -1
+	files := make(map[string][]byte, len(minors))
+	for _, minor := range minors {
+		var buf bytes.Buffer
+		if err := minorTemplate.Execute(&buf, byMinor[minor]); err != nil {
+			return nil, err
+		}
+		files[minorFilePrefix+minor+".go"] = buf.Bytes()
 	}
-	if len(partsA) > len(partsB) {
-		return 1
+	return files, nil
+}
+
+// writeSource formats and writes a generated Go file
+func writeSource(path string, code []byte) error {
+	formatted, err := format.Source(code)
+	if err != nil {
+		log.Printf("Warning: failed to format %s: %v", path, err)
+		formatted = code
 	}
-	return 0
+	return os.WriteFile(path, formatted, 0644)
 }
 
-func parseVersion(v string) []int {
-	v = strings.ReplaceAll(v, "p", ".")
-	parts := strings.Split(v, ".")
-	result := make([]int, len(parts))
-	for i, p := range parts {
-		fmt.Sscanf(p, "%d", &result[i])
+// getMinorVersion returns the minor version of a version, keeping the
+// edition suffix: "2.4" for 2.4.0p17, "2.4.cee" for 2.4.0p17.cee
+func getMinorVersion(version string) string {
+	v, err := cmkversion.Parse(version)
+	if err != nil {
+		return version
+	}
+	if e := v.Edition(); e != "" && e != cmkversion.EditionRaw {
+		return v.Minor() + "." + string(e)
 	}
-	return result
+	return v.Minor()
 }
 
 // toTitle converts a package name to a title case identifier
@@ -264,12 +328,16 @@ var codeTemplate = template.Must(template.New("code").Funcs(template.FuncMap{
 
 package {{.Package}}
 
-import "strings"
-{{if .BaselineImports}}
 import (
-{{range .BaselineImports}}	{{.Alias}} "{{.Package}}"
-{{end}})
-{{end}}
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"{{.VersionPackage}}"
+	"{{.ModulePath}}/openapi"
+	"{{.ModulePath}}/union"
+)
 
 // BaselinePackage represents a baseline type package identifier.
 type BaselinePackage string
@@ -294,11 +362,19 @@ type BaselineFuncs struct {
 	IsReadOnlyField     func(string, string) bool
 	IsRequiredField     func(string, string) bool
 	IsDeprecatedField   func(string, string) bool
+	GetFieldConstraints func(string, string) *openapi.FieldConstraints
 
 	// Enum validation
 	GetValidEnumValues func(string, string) []string
 	HasEnumConstraint  func(string, string) bool
 
+	// Schema definitions
+	Schemas map[string]*openapi.Schema
+
+	// Endpoint descriptors
+	Operations      map[string]*openapi.Operation
+	OperationsByTag map[string][]*openapi.Operation
+
 	// Host-specific (for backwards compatibility)
 	HostCreateAttributeFieldNames      []string
 	HostCreateAttributeCompareKeyFields []string
@@ -318,67 +394,182 @@ type BaselineFuncs struct {
 }
 
 // registry maps baseline packages to their function implementations.
-var registry = map[BaselinePackage]*BaselineFuncs{
-{{range .BaselineImports}}	Baseline{{.Alias | title}}: {
-		AllSchemaNames:              {{.Alias}}.AllSchemaNames,
-		GetSchemaFieldNames:         {{.Alias}}.GetSchemaFieldNames,
-		GetSchemaRequiredFieldNames: {{.Alias}}.GetSchemaRequiredFieldNames,
-		HasSchema:                   {{.Alias}}.HasSchema,
-		GetFieldDescription:         {{.Alias}}.GetFieldDescription,
-		GetFieldType:                {{.Alias}}.GetFieldType,
-		IsReadOnlyField:             {{.Alias}}.IsReadOnlyField,
-		IsRequiredField:             {{.Alias}}.IsRequiredField,
-		IsDeprecatedField:           {{.Alias}}.IsDeprecatedField,
-		GetValidEnumValues:          {{.Alias}}.GetValidEnumValues,
-		HasEnumConstraint:           {{.Alias}}.HasEnumConstraint,
-		HostCreateAttributeFieldNames:       {{.Alias}}.HostCreateAttributeFieldNames,
-		HostCreateAttributeCompareKeyFields: {{.Alias}}.HostCreateAttributeCompareKeyFields,
-		ValidHostCreateAttributeTagAgentValues: {{.Alias}}.ValidHostCreateAttributeTagAgentValues,
-		HostConfigFieldMappings:     {{.Alias}}.HostConfigFieldMappings,
-		ExtractHostConfigField:      {{.Alias}}.ExtractHostConfigField,
-		FolderCreateAttributeFieldNames:       {{.Alias}}.FolderCreateAttributeFieldNames,
-		FolderCreateAttributeCompareKeyFields: {{.Alias}}.FolderCreateAttributeCompareKeyFields,
-		FolderFieldMappings:         {{.Alias}}.FolderFieldMappings,
-		ExtractFolderField:          {{.Alias}}.ExtractFolderField,
-	},
+// Each minor version's file registers its baselines from init() when its
+// build tag is set.
+var registry = make(map[BaselinePackage]*BaselineFuncs)
+
+// knownBaselines maps every baseline in the manifest to the build tag of
+// its minor version, compiled in or not.
+var knownBaselines = map[BaselinePackage]string{
+{{range .BaselineImports}}	Baseline{{.Alias | title}}: "checkmk_{{.Minor}}",
 {{end}}}
 
+// baselineVersions maps every baseline to the version it was generated
+// from.
+var baselineVersions = map[BaselinePackage]string{
+{{range .BaselineImports}}	Baseline{{.Alias | title}}: "{{.Version}}",
+{{end}}}
+
+// packageBaselines maps the import path of every baseline package to its
+// baseline.
+var packageBaselines = map[string]BaselinePackage{
+{{range .BaselineImports}}	"{{.Package}}": Baseline{{.Alias | title}},
+{{end}}}
+
+// ErrUnknownVersion is returned for versions and baselines the manifest
+// does not know.
+var ErrUnknownVersion = errors.New("unknown CheckMK version")
+
+// ErrBaselineCompiledOut is returned for known baselines whose package was
+// left out of the build by its build tags.
+var ErrBaselineCompiledOut = errors.New("baseline compiled out")
+
 // VersionToBaseline maps CheckMK versions to their baseline package.
-// Use LookupBaseline() instead of accessing this directly.
+// Versions of editions other than raw carry the edition suffix
+// ("2.4.0p17.cee"). Use LookupBaseline() instead of accessing this directly.
 var VersionToBaseline = map[string]BaselinePackage{
 {{range .VersionMapping}}	"{{.Version}}": Baseline{{.Package | title}},
 {{end}}}
 
-// MinorToLatestBaseline maps minor versions to their latest baseline.
-// Used for unknown patch versions within a known minor.
+// MinorToLatestBaseline maps minor versions to their latest baseline, with
+// the edition suffix for editions other than raw ("2.4.cee"). Lookups of a
+// bare minor version ("2.4") resolve through it.
 var MinorToLatestBaseline = map[string]BaselinePackage{
 {{range .MinorBaselines}}	"{{.Minor}}": Baseline{{.Package | title}},
 {{end}}}
 
-// LookupBaseline returns the baseline package for a given CheckMK version.
-// Returns empty string if the version is unknown.
+// LookupBaseline returns the baseline package for a CheckMK version, ""
+// for unknown versions. Versions of editions other than raw carry the
+// edition as suffix ("2.4.0p17.cee"); a bare minor version ("2.4") yields
+// its latest baseline. Use LookupBaselineEdition to pass the
+// edition separately or to tell compiled out baselines apart.
 func LookupBaseline(version string) BaselinePackage {
-	if pkg, ok := VersionToBaseline[version]; ok {
-		return pkg
+	pkg, err := LookupBaselineEdition(version, "")
+	if err != nil && !errors.Is(err, ErrBaselineCompiledOut) {
+		return ""
+	}
+	return pkg
+}
+
+// LookupBaselineEdition returns the baseline package for a CheckMK version
+// of an edition. The edition may also come as suffix of the version
+// ("2.4.0p17.cee"); without either, the raw edition is assumed. Each edition
+// has baselines of its own, so a version of an edition without baselines
+// does not fall back to the raw edition.
+//
+// A version missing from VersionToBaseline has the API of the closest
+// earlier version of its release line and edition: 2.2.0p10 that of
+// 2.2.0p9, and patches and daily builds after the last known version that
+// of the latest baseline.
+// A bare minor version ("2.4", "2.4.cee") has the API of the latest baseline
+// of its release line, as listed in MinorToLatestBaseline.
+//
+// Unknown versions yield ErrUnknownVersion. A known baseline whose package
+// is compiled out is returned along with ErrBaselineCompiledOut.
+func LookupBaselineEdition(version string, edition cmkversion.Edition) (BaselinePackage, error) {
+	v, err := cmkversion.Parse(version)
+	if err != nil {
+		if pkg, ok := latestOfMinor(version, edition); ok {
+			if _, err := GetRegistry(pkg); err != nil {
+				return pkg, err
+			}
+			return pkg, nil
+		}
+		return "", fmt.Errorf("%w: %v", ErrUnknownVersion, err)
 	}
-	if pkg, ok := MinorToLatestBaseline[extractMinor(version)]; ok {
-		return pkg
+	switch {
+	case edition == "":
+		edition = v.Edition()
+	case v.Edition() != "" && v.Edition() != edition:
+		return "", fmt.Errorf("%w: %q is not of edition %s", ErrUnknownVersion, version, edition)
 	}
-	return ""
+	if edition == "" {
+		edition = cmkversion.EditionRaw
+	}
+	if !edition.Valid() {
+		return "", fmt.Errorf("%w: unknown edition %q", ErrUnknownVersion, edition)
+	}
+
+	pkg, ok := VersionToBaseline[baselineKey(v.WithEdition("").String(), edition)]
+	if !ok {
+		pkg, ok = closestBaseline(v, edition)
+	}
+	if !ok {
+		return "", fmt.Errorf("%w: %q (edition %s)", ErrUnknownVersion, version, edition)
+	}
+	if _, err := GetRegistry(pkg); err != nil {
+		return pkg, err
+	}
+	return pkg, nil
 }
 
-// GetRegistry returns the function registry for a baseline.
-// Returns nil if the baseline is unknown.
-func GetRegistry(pkg BaselinePackage) *BaselineFuncs {
-	return registry[pkg]
+// latestOfMinor returns the latest baseline of a bare minor version, which
+// may carry an edition suffix ("2.4.cee")
+func latestOfMinor(minor string, edition cmkversion.Edition) (BaselinePackage, bool) {
+	if i := strings.LastIndex(minor, "."); i >= 0 && cmkversion.Edition(minor[i+1:]).Valid() {
+		suffix := cmkversion.Edition(minor[i+1:])
+		if edition != "" && edition != suffix {
+			return "", false
+		}
+		minor, edition = minor[:i], suffix
+	}
+	if edition == "" {
+		edition = cmkversion.EditionRaw
+	}
+	pkg, ok := MinorToLatestBaseline[baselineKey(minor, edition)]
+	return pkg, ok
 }
 
-func extractMinor(version string) string {
-	parts := strings.Split(strings.ReplaceAll(version, "p", "."), ".")
-	if len(parts) >= 2 {
-		return parts[0] + "." + parts[1]
+// closestBaseline returns the baseline of the closest known version before
+// v in its release line and edition
+func closestBaseline(v cmkversion.Version, edition cmkversion.Edition) (BaselinePackage, bool) {
+	var closest cmkversion.Version
+	var pkg BaselinePackage
+	found := false
+	for known, p := range VersionToBaseline {
+		k := cmkversion.MustParse(known)
+		if e := k.Edition(); e != edition && (e != "" || edition != cmkversion.EditionRaw) {
+			continue
+		}
+		if k.Branch() != v.Branch() || k.Compare(v) >= 0 {
+			continue
+		}
+		if !found || k.Compare(closest) > 0 {
+			closest, pkg, found = k, p, true
+		}
 	}
-	return ""
+	return pkg, found
+}
+
+// baselineKey returns the key of a version or minor version in the
+// baseline maps: unsuffixed for the raw edition, else with the edition
+// suffix ("2.4.0p17.cee")
+func baselineKey(version string, edition cmkversion.Edition) string {
+	if edition == cmkversion.EditionRaw {
+		return version
+	}
+	return version + "." + string(edition)
+}
+
+// BaselineOfPackage returns the baseline of a baseline package by import
+// path, such as the reflect.Type.PkgPath of one of its schema types.
+func BaselineOfPackage(importPath string) (BaselinePackage, bool) {
+	pkg, ok := packageBaselines[importPath]
+	return pkg, ok
+}
+
+// GetRegistry returns the function registry for a baseline. It fails with
+// ErrUnknownVersion for unknown baselines and ErrBaselineCompiledOut for
+// baselines left out of the build.
+func GetRegistry(pkg BaselinePackage) (*BaselineFuncs, error) {
+	if r := registry[pkg]; r != nil {
+		return r, nil
+	}
+	tag, known := knownBaselines[pkg]
+	if !known {
+		return nil, fmt.Errorf("%w: baseline %q", ErrUnknownVersion, pkg)
+	}
+	return nil, fmt.Errorf("%w: %s needs build tag %s or checkmk_all", ErrBaselineCompiledOut, pkg, tag)
 }
 
 // Generic Schema Introspection
@@ -448,6 +639,13 @@ func IsDeprecatedField(pkg BaselinePackage, schemaName, fieldName string) bool {
 	return false
 }
 
+func GetFieldConstraints(pkg BaselinePackage, schemaName, fieldName string) *openapi.FieldConstraints {
+	if r := registry[pkg]; r != nil && r.GetFieldConstraints != nil {
+		return r.GetFieldConstraints(schemaName, fieldName)
+	}
+	return nil
+}
+
 // Enum Validation
 
 func GetValidEnumValues(pkg BaselinePackage, schemaName, fieldName string) []string {
@@ -464,6 +662,31 @@ func HasEnumConstraint(pkg BaselinePackage, schemaName, fieldName string) bool {
 	return false
 }
 
+// Schema Definitions
+
+func GetSchema(pkg BaselinePackage, schemaName string) *openapi.Schema {
+	if r := registry[pkg]; r != nil {
+		return r.Schemas[schemaName]
+	}
+	return nil
+}
+
+// Endpoint Descriptors
+
+func GetOperation(pkg BaselinePackage, operationID string) *openapi.Operation {
+	if r := registry[pkg]; r != nil {
+		return r.Operations[operationID]
+	}
+	return nil
+}
+
+func GetOperationsByTag(pkg BaselinePackage, tag string) []*openapi.Operation {
+	if r := registry[pkg]; r != nil {
+		return r.OperationsByTag[tag]
+	}
+	return nil
+}
+
 // Host-specific (backwards compatibility)
 
 func ValidHostTagAgentValues(pkg BaselinePackage) []string {
@@ -530,4 +753,167 @@ func ExtractFolderField(pkg BaselinePackage, response map[string]interface{}, tf
 	}
 	return nil
 }
+
+// Capabilities
+
+// Capability is an API element that some versions have and others lack:
+// an Operation, a Field or an EnumValue.
+type Capability interface {
+	String() string
+	// span returns the raw edition baselines having the element
+	span() (union.Span, bool)
+	// in reports whether a baseline has the element
+	in(r *BaselineFuncs) bool
+}
+
+// Operation is the capability of calling an endpoint, by operation ID
+// (e.g., "cmk.gui.openapi.endpoints.host_config.create_host").
+func Operation(id string) Capability {
+	return operation{id}
+}
+
+// Field is the capability of a schema having a field, by JSON name
+// (e.g., Field("HostCreateAttribute", "tag_agent")).
+func Field(schema, field string) Capability {
+	return schemaField{schema, field}
+}
+
+// EnumValue is the capability of an enum field accepting a value
+// (e.g., EnumValue("HostCreateAttribute", "tag_agent", "cmk-agent")).
+func EnumValue(schema, field, value string) Capability {
+	return enumValue{schema, field, value}
+}
+
+type operation struct{ id string }
+
+func (c operation) String() string { return "operation " + c.id }
+
+func (c operation) span() (union.Span, bool) {
+	s, ok := union.OperationSpans[c.id]
+	return s, ok
+}
+
+func (c operation) in(r *BaselineFuncs) bool { return r.Operations[c.id] != nil }
+
+type schemaField struct{ schema, field string }
+
+func (c schemaField) String() string { return "field " + c.schema + "." + c.field }
+
+func (c schemaField) span() (union.Span, bool) {
+	s, ok := union.FieldSpans[c.schema][c.field]
+	return s, ok
+}
+
+func (c schemaField) in(r *BaselineFuncs) bool {
+	return slices.Contains(r.GetSchemaFieldNames(c.schema), c.field)
+}
+
+type enumValue struct{ schema, field, value string }
+
+func (c enumValue) String() string {
+	return fmt.Sprintf("enum value %q of %s.%s", c.value, c.schema, c.field)
+}
+
+func (c enumValue) span() (union.Span, bool) {
+	s, ok := union.EnumValueSpans[c.schema][c.field][c.value]
+	return s, ok
+}
+
+func (c enumValue) in(r *BaselineFuncs) bool {
+	return slices.Contains(r.GetValidEnumValues(c.schema, c.field), c.value)
+}
+
+// Supports reports whether a CheckMK version has a capability, and the
+// first baseline that introduced it ("" if none did). Branch on features
+// with it instead of on version numbers:
+//
+//	ok, since, err := types.Supports("2.4.0p14", types.Field("HostCreateAttribute", "tag_agent"))
+//
+// The version's baseline, the closest at or below it in its edition (see
+// LookupBaselineEdition), answers when compiled in; otherwise the spans of
+// the union package do, which cover the raw edition. Other editions need
+// their baselines compiled in. Unknown versions yield ErrUnknownVersion.
+func Supports(version string, c Capability) (bool, string, error) {
+	pkg, err := LookupBaselineEdition(version, "")
+	if err != nil && !errors.Is(err, ErrBaselineCompiledOut) {
+		return false, "", err
+	}
+	baseline := cmkversion.MustParse(baselineVersions[pkg])
+
+	if e := baseline.Edition(); e != "" && e != cmkversion.EditionRaw {
+		if err != nil {
+			return false, "", err
+		}
+		return c.in(registry[pkg]), firstBaseline(e, c), nil
+	}
+
+	span, known := c.span()
+	if r := registry[pkg]; r != nil {
+		return c.in(r), span.Since, nil
+	}
+	supported := known && cmkversion.CompareStrings(baseline.String(), span.Since) >= 0 &&
+		(span.Until == "" || cmkversion.CompareStrings(baseline.String(), span.Until) <= 0)
+	return supported, span.Since, nil
+}
+
+// firstBaseline returns the earliest compiled in baseline of an edition
+// having a capability, "" if none has it
+func firstBaseline(edition cmkversion.Edition, c Capability) string {
+	first := ""
+	for pkg, r := range registry {
+		v := baselineVersions[pkg]
+		if cmkversion.MustParse(v).Edition() != edition || !c.in(r) {
+			continue
+		}
+		if first == "" || cmkversion.CompareStrings(v, first) < 0 {
+			first = v
+		}
+	}
+	return first
+}
+`))
+
+var minorTemplate = template.Must(template.New("minor").Funcs(template.FuncMap{
+	"title": toTitle,
+}).Parse(`//go:build checkmk_all || checkmk_{{.Minor}}
+
+// Code generated by version-types-gen. DO NOT EDIT.
+//
+// This file registers the {{.Minor}} baselines. It is compiled with the
+// build tags of their packages.
+
+package {{.Package}}
+
+import (
+{{range .Imports}}	{{.Alias}} "{{.Package}}"
+{{end}})
+
+func init() {
+{{range .Imports}}	registry[Baseline{{.Alias | title}}] = &BaselineFuncs{
+		AllSchemaNames:              {{.Alias}}.AllSchemaNames,
+		GetSchemaFieldNames:         {{.Alias}}.GetSchemaFieldNames,
+		GetSchemaRequiredFieldNames: {{.Alias}}.GetSchemaRequiredFieldNames,
+		HasSchema:                   {{.Alias}}.HasSchema,
+		GetFieldDescription:         {{.Alias}}.GetFieldDescription,
+		GetFieldType:                {{.Alias}}.GetFieldType,
+		IsReadOnlyField:             {{.Alias}}.IsReadOnlyField,
+		IsRequiredField:             {{.Alias}}.IsRequiredField,
+		IsDeprecatedField:           {{.Alias}}.IsDeprecatedField,
+		GetFieldConstraints:         {{.Alias}}.GetFieldConstraints,
+		GetValidEnumValues:          {{.Alias}}.GetValidEnumValues,
+		HasEnumConstraint:           {{.Alias}}.HasEnumConstraint,
+		Schemas:                     {{.Alias}}.Schemas,
+		Operations:                  {{.Alias}}.Operations,
+		OperationsByTag:             {{.Alias}}.OperationsByTag,
+		HostCreateAttributeFieldNames:       {{.Alias}}.HostCreateAttributeFieldNames,
+		HostCreateAttributeCompareKeyFields: {{.Alias}}.HostCreateAttributeCompareKeyFields,
+		ValidHostCreateAttributeTagAgentValues: {{.Alias}}.ValidHostCreateAttributeTagAgentValues,
+		HostConfigFieldMappings:     {{.Alias}}.HostConfigFieldMappings,
+		ExtractHostConfigField:      {{.Alias}}.ExtractHostConfigField,
+		FolderCreateAttributeFieldNames:       {{.Alias}}.FolderCreateAttributeFieldNames,
+		FolderCreateAttributeCompareKeyFields: {{.Alias}}.FolderCreateAttributeCompareKeyFields,
+		FolderFieldMappings:         {{.Alias}}.FolderFieldMappings,
+		ExtractFolderField:          {{.Alias}}.ExtractFolderField,
+	}
+{{end}}}
 `))
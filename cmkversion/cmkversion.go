@@ -0,0 +1,233 @@
+// Package cmkversion parses and orders CheckMK version strings.
+//
+// Releases ("2.4.0", "2.4.0p17"), betas ("2.5.0b3") and daily builds
+// ("2.5.0-2026.10.01") are understood, each with an optional edition suffix
+// (".cre", ".cee", ".cce", ".cme"). Versions of one release line order as
+// betas, then the release and its patches, then daily builds by date.
+//
+//	v, _ := cmkversion.Parse("2.4.0p17.cee")
+//	v.Minor()   // "2.4"
+//	v.Branch()  // "2.4.0"
+//	v.Edition() // cmkversion.EditionEnterprise
+//
+// Ranges match versions against constraints:
+//
+//	r := cmkversion.MustParseRange(">=2.3.0p5 <2.4")
+//	r.Contains(cmkversion.MustParse("2.3.0p41")) // true
+package cmkversion
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+)
+
+// Edition is a CheckMK edition, as used in package and image names
+type Edition string
+
+const (
+	EditionRaw        Edition = "cre" // Raw / Community
+	EditionEnterprise Edition = "cee"
+	EditionCloud      Edition = "cce"
+	EditionMSP        Edition = "cme" // Managed Services
+)
+
+// Editions lists the known editions
+var Editions = []Edition{EditionRaw, EditionEnterprise, EditionCloud, EditionMSP}
+
+// Valid reports whether the edition is one of the known editions
+func (e Edition) Valid() bool {
+	for _, known := range Editions {
+		if e == known {
+			return true
+		}
+	}
+	return false
+}
+
+// Kind is the kind of a build, in the order builds of one release line are
+// published
+type Kind int
+
+const (
+	Beta    Kind = iota // e.g. 2.5.0b3
+	Release             // e.g. 2.4.0 or 2.4.0p17
+	Daily               // e.g. 2.5.0-2026.10.01
+)
+
+// Version is a parsed CheckMK version
+type Version struct {
+	major, minor, sub int
+	kind              Kind
+	number            int    // Patch of a release, number of a beta
+	date              string // Date of a daily build, "YYYY.MM.DD"
+	edition           Edition
+}
+
+var versionRegex = regexp.MustCompile(`^(\d+)\.(\d+)\.(\d+)(?:p(\d+)|b(\d+)|-(\d{4}\.\d{2}\.\d{2}))?(?:\.(cre|cee|cce|cme))?$`)
+
+// Parse parses a version such as "2.4.0p17", "2.5.0b3", "2.5.0-2026.10.01"
+// or "2.4.0p17.cee"
+func Parse(s string) (Version, error) {
+	m := versionRegex.FindStringSubmatch(s)
+	if m == nil {
+		return Version{}, fmt.Errorf("invalid CheckMK version %q (want e.g. 2.4.0p17)", s)
+	}
+
+	v := Version{kind: Release, date: m[6], edition: Edition(m[7])}
+	for i, dst := range []*int{&v.major, &v.minor, &v.sub} {
+		n, err := strconv.Atoi(m[i+1])
+		if err != nil {
+			return Version{}, fmt.Errorf("invalid CheckMK version %q: %w", s, err)
+		}
+		*dst = n
+	}
+	switch {
+	case m[4] != "":
+		v.number, _ = strconv.Atoi(m[4])
+	case m[5] != "":
+		v.kind = Beta
+		v.number, _ = strconv.Atoi(m[5])
+	case m[6] != "":
+		v.kind = Daily
+	}
+	return v, nil
+}
+
+// MustParse is like Parse but panics on invalid versions
+func MustParse(s string) Version {
+	v, err := Parse(s)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Valid reports whether s is a CheckMK version
+func Valid(s string) bool {
+	return versionRegex.MatchString(s)
+}
+
+// String formats the version the way it was parsed
+func (v Version) String() string {
+	s := v.Branch()
+	switch v.kind {
+	case Beta:
+		s += "b" + strconv.Itoa(v.number)
+	case Daily:
+		s += "-" + v.date
+	default:
+		if v.number > 0 {
+			s += "p" + strconv.Itoa(v.number)
+		}
+	}
+	if v.edition != "" {
+		s += "." + string(v.edition)
+	}
+	return s
+}
+
+// Minor returns the minor version, e.g. "2.4" for 2.4.0p17
+func (v Version) Minor() string {
+	return fmt.Sprintf("%d.%d", v.major, v.minor)
+}
+
+// Branch returns the release line, e.g. "2.4.0" for 2.4.0p17. Specs and
+// baseline packages are grouped by it.
+func (v Version) Branch() string {
+	return fmt.Sprintf("%d.%d.%d", v.major, v.minor, v.sub)
+}
+
+// Kind returns the kind of build
+func (v Version) Kind() Kind { return v.kind }
+
+// Patch returns the patch level of a release, 0 for other builds
+func (v Version) Patch() int {
+	if v.kind != Release {
+		return 0
+	}
+	return v.number
+}
+
+// Beta returns the number of a beta, 0 for other builds
+func (v Version) Beta() int {
+	if v.kind != Beta {
+		return 0
+	}
+	return v.number
+}
+
+// Date returns the date of a daily build ("2026.10.01"), "" for other builds
+func (v Version) Date() string { return v.date }
+
+// Edition returns the edition suffix, "" if the version has none
+func (v Version) Edition() Edition { return v.edition }
+
+// WithEdition returns the version with another edition suffix; an empty
+// edition removes it
+func (v Version) WithEdition(e Edition) Version {
+	v.edition = e
+	return v
+}
+
+// Compare orders the version against another, returning -1, 0 or 1.
+// Editions are ignored.
+func (v Version) Compare(o Version) int {
+	for _, pair := range [][2]int{
+		{v.major, o.major}, {v.minor, o.minor}, {v.sub, o.sub},
+		{int(v.kind), int(o.kind)}, {v.number, o.number},
+	} {
+		if c := cmp(pair[0], pair[1]); c != 0 {
+			return c
+		}
+	}
+	switch {
+	case v.date < o.date:
+		return -1
+	case v.date > o.date:
+		return 1
+	}
+	return 0
+}
+
+// Compare orders two versions, returning -1, 0 or 1. Editions are ignored.
+func Compare(a, b Version) int {
+	return a.Compare(b)
+}
+
+// CompareStrings orders two version strings, returning -1, 0 or 1. Invalid
+// versions order before valid ones. Invalid versions, and versions differing
+// only in their edition suffix, order by string.
+func CompareStrings(a, b string) int {
+	va, errA := Parse(a)
+	vb, errB := Parse(b)
+	switch {
+	case errA == nil && errB == nil && va.Compare(vb) != 0:
+		return va.Compare(vb)
+	case errA == nil && errB != nil:
+		return 1
+	case errA != nil && errB == nil:
+		return -1
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+// Sort sorts version strings in ascending order
+func Sort(versions []string) {
+	sort.SliceStable(versions, func(i, j int) bool { return CompareStrings(versions[i], versions[j]) < 0 })
+}
+
+func cmp(a, b int) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,138 @@
+package cmkversion
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		input   string
+		kind    Kind
+		minor   string
+		branch  string
+		patch   int
+		beta    int
+		date    string
+		edition Edition
+	}{
+		{"2.4.0p17", Release, "2.4", "2.4.0", 17, 0, "", ""},
+		{"2.4.0", Release, "2.4", "2.4.0", 0, 0, "", ""},
+		{"2.5.0b3", Beta, "2.5", "2.5.0", 0, 3, "", ""},
+		{"2.5.0-2026.10.01", Daily, "2.5", "2.5.0", 0, 0, "2026.10.01", ""},
+		{"2.4.0p17.cee", Release, "2.4", "2.4.0", 17, 0, "", EditionEnterprise},
+		{"2.5.0b1.cce", Beta, "2.5", "2.5.0", 0, 1, "", EditionCloud},
+		{"2.5.0-2026.10.01.cme", Daily, "2.5", "2.5.0", 0, 0, "2026.10.01", EditionMSP},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			v, err := Parse(tt.input)
+			if err != nil {
+				t.Fatalf("Parse(%q) error: %v", tt.input, err)
+			}
+			if v.Kind() != tt.kind || v.Minor() != tt.minor || v.Branch() != tt.branch ||
+				v.Patch() != tt.patch || v.Beta() != tt.beta || v.Date() != tt.date || v.Edition() != tt.edition {
+				t.Errorf("Parse(%q) = kind %d, minor %s, branch %s, patch %d, beta %d, date %q, edition %q",
+					tt.input, v.Kind(), v.Minor(), v.Branch(), v.Patch(), v.Beta(), v.Date(), v.Edition())
+			}
+			if v.String() != tt.input {
+				t.Errorf("String() = %q, want %q", v.String(), tt.input)
+			}
+		})
+	}
+}
+
+func TestParseInvalid(t *testing.T) {
+	for _, input := range []string{"", "2.4", "2.4.0p", "v2.4.0p17", "2.4.0p17.cxx", "2.5.0-2026.1.1", "2.4.0P17", "latest"} {
+		if _, err := Parse(input); err == nil {
+			t.Errorf("Parse(%q) succeeded, want error", input)
+		}
+	}
+}
+
+func TestCompare(t *testing.T) {
+	// Ascending
+	ordered := []string{
+		"2.2.0p44",
+		"2.3.0b1",
+		"2.3.0b2",
+		"2.3.0",
+		"2.3.0p5",
+		"2.3.0p41",
+		"2.3.0-2024.01.15",
+		"2.4.0p9",
+		"2.4.0p10",
+		"2.5.0b3",
+		"2.5.0-2026.10.01",
+		"2.5.0-2026.10.02",
+		"10.0.0",
+	}
+	for i := 0; i < len(ordered); i++ {
+		for j := 0; j < len(ordered); j++ {
+			want := cmp(i, j)
+			if got := Compare(MustParse(ordered[i]), MustParse(ordered[j])); got != want {
+				t.Errorf("Compare(%s, %s) = %d, want %d", ordered[i], ordered[j], got, want)
+			}
+		}
+	}
+
+	if got := Compare(MustParse("2.4.0p17.cee"), MustParse("2.4.0p17")); got != 0 {
+		t.Errorf("Compare ignoring editions = %d, want 0", got)
+	}
+}
+
+func TestSort(t *testing.T) {
+	versions := []string{"2.4.0p10", "unknown", "2.4.0p9.cee", "2.4.0p9", "2.3.0p41", "2.5.0b1"}
+	Sort(versions)
+	want := "unknown 2.3.0p41 2.4.0p9 2.4.0p9.cee 2.4.0p10 2.5.0b1"
+	if got := strings.Join(versions, " "); got != want {
+		t.Errorf("Sort = %s, want %s", got, want)
+	}
+}
+
+func TestRange(t *testing.T) {
+	tests := []struct {
+		rng     string
+		version string
+		want    bool
+	}{
+		{">=2.3.0p5 <2.4", "2.3.0p5", true},
+		{">=2.3.0p5 <2.4", "2.3.0p41", true},
+		{">=2.3.0p5 <2.4", "2.3.0p4", false},
+		{">=2.3.0p5 <2.4", "2.4.0b1", false},
+		{">=2.3.0p5 <2.4", "2.4.0p1", false},
+		{"<=2.4", "2.4.0-2026.10.01", true},
+		{"<=2.4", "2.5.0b1", false},
+		{">2.3", "2.4.0b1", true},
+		{">2.3", "2.3.0p41", false},
+		{"=2.4", "2.4.0p17.cee", true},
+		{"2.4", "2.3.0p41", false},
+		{"2.4.0p17", "2.4.0p17", true},
+		{"!=2.4.0p17", "2.4.0p17", false},
+		{">= 2.4.0p10", "2.4.0p10", true},
+		{"=2.2 || >=2.4.0p10", "2.2.0p44", true},
+		{"=2.2 || >=2.4.0p10", "2.3.0p41", false},
+		{"=2.2 || >=2.4.0p10", "2.4.0p18", true},
+		{">=2", "10.0.0", true},
+		{"<3", "2.5.0b1", true},
+	}
+
+	for _, tt := range tests {
+		r, err := ParseRange(tt.rng)
+		if err != nil {
+			t.Fatalf("ParseRange(%q) error: %v", tt.rng, err)
+		}
+		if got := r.Contains(MustParse(tt.version)); got != tt.want {
+			t.Errorf("ParseRange(%q).Contains(%s) = %v, want %v", tt.rng, tt.version, got, tt.want)
+		}
+	}
+}
+
+func TestParseRangeInvalid(t *testing.T) {
+	for _, input := range []string{"", ">=2.4.0p10 ||", ">=latest", "!=2.4", "=>2.4.0"} {
+		if _, err := ParseRange(input); err == nil {
+			t.Errorf("ParseRange(%q) succeeded, want error", input)
+		}
+	}
+}
@@ -0,0 +1,164 @@
+package cmkversion
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Range is a set of versions given by constraints, such as
+// ">=2.3.0p5 <2.4". Space-separated constraints must all hold; "||"
+// separates alternatives of which one must hold.
+//
+// A constraint is an operator (=, !=, <, <=, >, >=; = if omitted) and a
+// version. The version may be partial: "2.4" stands for the whole 2.4 line,
+// so "<2.4" ends before its first beta, "<=2.4" includes all of it and
+// "=2.4" matches any 2.4 version.
+type Range struct {
+	raw          string
+	alternatives [][]constraint
+}
+
+type constraint struct {
+	op string
+	v  Version
+}
+
+var (
+	operators    = []string{">=", "<=", "!=", ">", "<", "="} // Longest first
+	partialRegex = regexp.MustCompile(`^(\d+)(?:\.(\d+))?$`)
+)
+
+// ParseRange parses a range such as ">=2.3.0p5 <2.4" or "=2.2 || >=2.4.0p10"
+func ParseRange(s string) (Range, error) {
+	r := Range{raw: strings.TrimSpace(s)}
+	for _, alt := range strings.Split(s, "||") {
+		fields := strings.Fields(alt)
+		if len(fields) == 0 {
+			return Range{}, fmt.Errorf("invalid version range %q: empty constraint", s)
+		}
+		var constraints []constraint
+		for i := 0; i < len(fields); i++ {
+			token := fields[i]
+			// Allow a space between operator and version (">= 2.4.0")
+			if isOperator(token) && i+1 < len(fields) {
+				i++
+				token += fields[i]
+			}
+			cs, err := parseConstraint(token)
+			if err != nil {
+				return Range{}, fmt.Errorf("invalid version range %q: %w", s, err)
+			}
+			constraints = append(constraints, cs...)
+		}
+		r.alternatives = append(r.alternatives, constraints)
+	}
+	return r, nil
+}
+
+// MustParseRange is like ParseRange but panics on invalid ranges
+func MustParseRange(s string) Range {
+	r, err := ParseRange(s)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// Contains reports whether the version is in the range
+func (r Range) Contains(v Version) bool {
+	for _, constraints := range r.alternatives {
+		ok := true
+		for _, c := range constraints {
+			if !c.matches(v) {
+				ok = false
+				break
+			}
+		}
+		if ok {
+			return true
+		}
+	}
+	return false
+}
+
+// String returns the range as it was parsed
+func (r Range) String() string {
+	return r.raw
+}
+
+func isOperator(s string) bool {
+	for _, op := range operators {
+		if s == op {
+			return true
+		}
+	}
+	return false
+}
+
+// parseConstraint parses one constraint. Partial versions are expanded into
+// bounds of their line.
+func parseConstraint(s string) ([]constraint, error) {
+	op := "="
+	for _, candidate := range operators {
+		if strings.HasPrefix(s, candidate) {
+			op = candidate
+			break
+		}
+	}
+	version := strings.TrimPrefix(s, op)
+
+	if m := partialRegex.FindStringSubmatch(version); m != nil {
+		first, next := partialBounds(m)
+		switch op {
+		case ">=":
+			return []constraint{{">=", first}}, nil
+		case ">":
+			return []constraint{{">=", next}}, nil
+		case "<":
+			return []constraint{{"<", first}}, nil
+		case "<=":
+			return []constraint{{"<", next}}, nil
+		case "=":
+			return []constraint{{">=", first}, {"<", next}}, nil
+		}
+		return nil, fmt.Errorf("%q: %s needs a full version", s, op)
+	}
+
+	v, err := Parse(version)
+	if err != nil {
+		return nil, err
+	}
+	return []constraint{{op, v}}, nil
+}
+
+// partialBounds returns the first version of a partial version's line and
+// the first version after it, e.g. 2.4.0b0 and 2.5.0b0 for "2.4"
+func partialBounds(m []string) (Version, Version) {
+	major, _ := strconv.Atoi(m[1])
+	if m[2] == "" {
+		return Version{major: major, kind: Beta}, Version{major: major + 1, kind: Beta}
+	}
+	minor, _ := strconv.Atoi(m[2])
+	return Version{major: major, minor: minor, kind: Beta}, Version{major: major, minor: minor + 1, kind: Beta}
+}
+
+func (c constraint) matches(v Version) bool {
+	diff := v.Compare(c.v)
+	switch c.op {
+	case "=":
+		return diff == 0
+	case "!=":
+		return diff != 0
+	case "<":
+		return diff < 0
+	case "<=":
+		return diff <= 0
+	case ">":
+		return diff > 0
+	case ">=":
+		return diff >= 0
+	}
+	return false
+}
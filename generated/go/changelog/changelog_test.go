@@ -0,0 +1,63 @@
+package changelog
+
+import (
+	"testing"
+
+	"github.com/BlackMesaLTD/checkmk-api-spec/cmkversion"
+)
+
+func TestChangesBetween(t *testing.T) {
+	changes := ChangesBetween("2.2.0p12", "2.2.0p15")
+	if len(changes) == 0 {
+		t.Fatal("ChangesBetween(2.2.0p12, 2.2.0p15) is empty")
+	}
+	for _, c := range changes {
+		if c.Version != "2.2.0p14" {
+			t.Errorf("change of %s outside 2.2.0p12..2.2.0p15: %s", c.Version, c)
+		}
+	}
+
+	if changes := ChangesBetween("2.4.0p18", "2.4.0p18"); len(changes) != 0 {
+		t.Errorf("ChangesBetween of one version = %d changes, want 0", len(changes))
+	}
+}
+
+func TestFieldHistory(t *testing.T) {
+	history := FieldHistory("DeleteDowntimeByName", "site_id")
+	var removed *Change
+	for i, c := range history {
+		if c.Kind == FieldRemoved {
+			removed = &history[i]
+		}
+	}
+	if removed == nil || removed.Version != "2.2.0p14" || !removed.Severity.IsBreaking() {
+		t.Errorf("FieldHistory(DeleteDowntimeByName, site_id) = %v, want a breaking removal in 2.2.0p14", history)
+	}
+}
+
+func TestFieldHistoryFollowsRenames(t *testing.T) {
+	history := FieldHistory("ContactGroup", "extensions")
+	if len(history) == 0 {
+		t.Fatal("FieldHistory(ContactGroup, extensions) is empty")
+	}
+	var renamed bool
+	for i, c := range history {
+		if i > 0 && cmkversion.CompareStrings(history[i-1].Version, c.Version) > 0 {
+			t.Errorf("history not oldest first: %s before %s", history[i-1].Version, c.Version)
+		}
+		if c.Kind == SchemaRenamed && c.RenamedFrom == "ContactGroupObject" {
+			renamed = true
+		}
+	}
+	if !renamed {
+		t.Error("FieldHistory(ContactGroup, extensions) misses the rename from ContactGroupObject")
+	}
+}
+
+func TestEndpointHistory(t *testing.T) {
+	history := EndpointHistory("/domain-types/agent/actions/automatic-deployment/invoke", "get")
+	if len(history) < 2 || history[0].Kind != EndpointAdded ||
+		history[1].Kind != EndpointRemoved || history[1].Version != "2.2.0p44" {
+		t.Errorf("EndpointHistory = %v, want it added, then removed in 2.2.0p44", history)
+	}
+}
@@ -0,0 +1,40 @@
+package client
+
+import "net/http"
+
+// Authenticator adds credentials to an outgoing request.
+type Authenticator interface {
+	Authenticate(req *http.Request)
+}
+
+// AuthFunc adapts a function to the Authenticator interface.
+type AuthFunc func(req *http.Request)
+
+// Authenticate calls f(req).
+func (f AuthFunc) Authenticate(req *http.Request) {
+	f(req)
+}
+
+// BearerAuth implements the spec's headerAuth scheme: the Authorization
+// header carries "Bearer <user> <secret>", typically for an automation user.
+func BearerAuth(user, secret string) Authenticator {
+	return AuthFunc(func(req *http.Request) {
+		req.Header.Set("Authorization", "Bearer "+user+" "+secret)
+	})
+}
+
+// BasicAuth implements the spec's webserverAuth scheme (HTTP basic
+// authentication handled by the site's Apache).
+func BasicAuth(user, password string) Authenticator {
+	return AuthFunc(func(req *http.Request) {
+		req.SetBasicAuth(user, password)
+	})
+}
+
+// CookieAuth implements the spec's cookieAuth scheme, reusing the session
+// cookie "auth_<site>" of a user who logged in to the GUI.
+func CookieAuth(site, value string) Authenticator {
+	return AuthFunc(func(req *http.Request) {
+		req.AddCookie(&http.Cookie{Name: "auth_" + site, Value: value})
+	})
+}
@@ -0,0 +1,209 @@
+// Package client is the HTTP transport shared by the generated per-baseline
+// API clients.
+//
+// It handles authentication, base URL, JSON encoding and problem+json error
+// decoding for any *openapi.Operation. Each baseline package wraps it in a
+// Client type with one typed method per operation (client.gen.go):
+//
+//	c := p17.NewClient(client.New("https://cmk.example.com/mysite/check_mk/api/1.0",
+//		client.WithAuth(client.BearerAuth("automation", secret))))
+//	host, _, err := c.HostConfigShowHost(ctx, &p17.HostConfigShowHostParams{HostName: "web01"})
+//
+// When the baseline is only known at runtime, call Do with a descriptor from
+// types.GetOperation instead.
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"strings"
+
+	"github.com/BlackMesaLTD/checkmk-api-spec/generated/go/openapi"
+)
+
+// Client sends requests for operation descriptors to one CheckMK site.
+type Client struct {
+	BaseURL    string        // API root, e.g. "https://cmk.example.com/mysite/check_mk/api/1.0"
+	HTTPClient *http.Client  // Defaults to http.DefaultClient
+	Auth       Authenticator // Optional; requests are sent unauthenticated if nil
+	UserAgent  string
+
+	// UpdateAttempts bounds the fetch-then-update cycles of RetryPreconditionFailed
+	// and the generated ...WithRetry methods. Defaults to DefaultUpdateAttempts.
+	UpdateAttempts int
+}
+
+// DefaultUpdateAttempts is the default number of fetch-then-update cycles.
+const DefaultUpdateAttempts = 3
+
+// Option configures a Client.
+type Option func(*Client)
+
+// WithHTTPClient sets the HTTP client used to send requests.
+func WithHTTPClient(hc *http.Client) Option {
+	return func(c *Client) { c.HTTPClient = hc }
+}
+
+// WithAuth sets the authentication scheme.
+func WithAuth(auth Authenticator) Option {
+	return func(c *Client) { c.Auth = auth }
+}
+
+// WithUpdateAttempts sets how often a fetch-then-update cycle is tried
+// before a 412 Precondition Failed is returned to the caller.
+func WithUpdateAttempts(n int) Option {
+	return func(c *Client) { c.UpdateAttempts = n }
+}
+
+// WithUserAgent sets the User-Agent header.
+func WithUserAgent(ua string) Option {
+	return func(c *Client) { c.UserAgent = ua }
+}
+
+// New creates a Client for the API root baseURL.
+func New(baseURL string, opts ...Option) *Client {
+	c := &Client{
+		BaseURL:        strings.TrimRight(baseURL, "/"),
+		HTTPClient:     http.DefaultClient,
+		UpdateAttempts: DefaultUpdateAttempts,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// Response is the raw result of a call.
+type Response struct {
+	StatusCode int
+	Header     http.Header
+	Body       []byte
+}
+
+// ETag returns the response's ETag header. Pass it as the If-Match parameter
+// of a later update or delete of the same object.
+func (r *Response) ETag() string {
+	if r == nil {
+		return ""
+	}
+	return r.Header.Get("ETag")
+}
+
+// Do sends the request described by op.
+//
+// params must be nil or a pointer to the operation's parameter struct. body is
+// JSON-encoded unless it is a []byte, which is sent as is. On a 2xx status the
+// response body is decoded into out, if given (a *[]byte receives the raw
+// body). Any other status returns an *APIError.
+func (c *Client) Do(ctx context.Context, op *openapi.Operation, params, body, out interface{}) (*Response, error) {
+	path, query, header, err := op.Encode(params)
+	if err != nil {
+		return nil, err
+	}
+
+	url := c.BaseURL + path
+	if len(query) > 0 {
+		url += "?" + query.Encode()
+	}
+
+	var reader io.Reader
+	if !isNil(body) {
+		data, ok := body.([]byte)
+		if !ok {
+			if data, err = json.Marshal(body); err != nil {
+				return nil, fmt.Errorf("%s: encoding request body: %w", op.ID, err)
+			}
+		}
+		reader = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, op.Method, url, reader)
+	if err != nil {
+		return nil, fmt.Errorf("%s: building request: %w", op.ID, err)
+	}
+	for name, values := range header {
+		req.Header[name] = values
+	}
+	req.Header.Set("Accept", "application/json")
+	if reader != nil {
+		contentType := "application/json"
+		if op.RequestBody != nil && op.RequestBody.ContentType != "" {
+			contentType = op.RequestBody.ContentType
+		}
+		req.Header.Set("Content-Type", contentType)
+	}
+	if c.UserAgent != "" {
+		req.Header.Set("User-Agent", c.UserAgent)
+	}
+	if c.Auth != nil {
+		c.Auth.Authenticate(req)
+	}
+
+	hc := c.HTTPClient
+	if hc == nil {
+		hc = http.DefaultClient
+	}
+	httpResp, err := hc.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op.ID, err)
+	}
+	defer httpResp.Body.Close()
+
+	data, err := io.ReadAll(httpResp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("%s: reading response: %w", op.ID, err)
+	}
+	resp := &Response{StatusCode: httpResp.StatusCode, Header: httpResp.Header, Body: data}
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return resp, newAPIError(op, resp)
+	}
+
+	if raw, ok := out.(*[]byte); ok {
+		*raw = data
+	} else if out != nil && len(data) > 0 {
+		if err := json.Unmarshal(data, out); err != nil {
+			return resp, fmt.Errorf("%s: decoding response: %w", op.ID, err)
+		}
+	}
+	return resp, nil
+}
+
+func isNil(v interface{}) bool {
+	if v == nil {
+		return true
+	}
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Ptr, reflect.Map, reflect.Slice, reflect.Interface:
+		return rv.IsNil()
+	}
+	return false
+}
+
+// RetryPreconditionFailed runs attempt, which should read the current object
+// and send an update carrying its ETag as If-Match. If the update fails with
+// 412 Precondition Failed because another writer got there first, the cycle
+// is repeated with a fresh read, up to UpdateAttempts times.
+func (c *Client) RetryPreconditionFailed(ctx context.Context, attempt func(ctx context.Context) error) error {
+	attempts := c.UpdateAttempts
+	if attempts < 1 {
+		attempts = 1
+	}
+
+	var err error
+	for i := 0; i < attempts; i++ {
+		if err = attempt(ctx); !IsPreconditionFailed(err) {
+			return err
+		}
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return ctxErr
+		}
+	}
+	return err
+}
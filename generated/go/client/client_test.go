@@ -0,0 +1,201 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/BlackMesaLTD/checkmk-api-spec/generated/go/openapi"
+)
+
+type hostConfig struct {
+	ID    string `json:"id"`
+	Title string `json:"title"`
+}
+
+type updateHost struct {
+	Attributes map[string]interface{} `json:"attributes,omitempty"`
+}
+
+type updateHostParams struct {
+	HostName string `path:"host_name"`
+	IfMatch  string `header:"If-Match"`
+}
+
+type problem struct {
+	Title  string                 `json:"title"`
+	Status int                    `json:"status"`
+	Detail string                 `json:"detail"`
+	Fields map[string]interface{} `json:"fields"`
+}
+
+var updateHostOp = &openapi.Operation{
+	ID:          "cmk.gui.openapi.endpoints.host_config.update_host",
+	Method:      "PUT",
+	Path:        "/objects/host_config/{host_name}",
+	RequestBody: &openapi.RequestBody{ContentType: "application/json", Schema: "UpdateHost"},
+	Responses: []openapi.Response{
+		{Status: "200", Schema: "HostConfig", New: func() interface{} { return new(hostConfig) }},
+		{Status: "400", Schema: "Api400DefaultError", New: func() interface{} { return new(problem) }},
+	},
+}
+
+func TestDoDecodesSuccess(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "PUT" || r.URL.Path != "/site/check_mk/api/1.0/objects/host_config/web01" {
+			t.Errorf("request = %s %s", r.Method, r.URL.Path)
+		}
+		if got := r.Header.Get("If-Match"); got != `"etag"` {
+			t.Errorf("If-Match = %q", got)
+		}
+		if got := r.Header.Get("Content-Type"); got != "application/json" {
+			t.Errorf("Content-Type = %q", got)
+		}
+		var body updateHost
+		data, _ := io.ReadAll(r.Body)
+		if err := json.Unmarshal(data, &body); err != nil || body.Attributes["alias"] != "Web" {
+			t.Errorf("body = %s", data)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id": "web01", "title": "web01"}`))
+	}))
+	defer srv.Close()
+
+	c := New(srv.URL + "/site/check_mk/api/1.0/")
+	var out hostConfig
+	resp, err := c.Do(context.Background(), updateHostOp,
+		&updateHostParams{HostName: "web01", IfMatch: `"etag"`},
+		&updateHost{Attributes: map[string]interface{}{"alias": "Web"}}, &out)
+	if err != nil {
+		t.Fatalf("Do() error = %v", err)
+	}
+	if resp.StatusCode != 200 || out.ID != "web01" {
+		t.Errorf("status = %d, out = %+v", resp.StatusCode, out)
+	}
+}
+
+func TestDoDecodesProblem(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/problem+json")
+		w.WriteHeader(400)
+		w.Write([]byte(`{"title": "Bad Request", "status": 400, "detail": "These fields have problems: host_name", "fields": {"host_name": ["Invalid"]}}`))
+	}))
+	defer srv.Close()
+
+	_, err := New(srv.URL).Do(context.Background(), updateHostOp, &updateHostParams{HostName: "x"}, nil, nil)
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("Do() error = %v, want *APIError", err)
+	}
+	if apiErr.StatusCode != 400 || apiErr.Title != "Bad Request" || apiErr.Fields["host_name"] == nil {
+		t.Errorf("APIError = %+v", apiErr)
+	}
+	typed, ok := apiErr.Problem.(*problem)
+	if !ok || typed.Detail != "These fields have problems: host_name" {
+		t.Errorf("Problem = %#v, want decoded *problem", apiErr.Problem)
+	}
+	if StatusCode(err) != 400 || IsNotFound(err) {
+		t.Errorf("StatusCode(err) = %d", StatusCode(err))
+	}
+}
+
+func TestAuthSchemes(t *testing.T) {
+	tests := []struct {
+		name  string
+		auth  Authenticator
+		check func(r *http.Request) bool
+	}{
+		{"headerAuth", BearerAuth("automation", "secret"), func(r *http.Request) bool {
+			return r.Header.Get("Authorization") == "Bearer automation secret"
+		}},
+		{"webserverAuth", BasicAuth("cmkadmin", "pw"), func(r *http.Request) bool {
+			user, pass, ok := r.BasicAuth()
+			return ok && user == "cmkadmin" && pass == "pw"
+		}},
+		{"cookieAuth", CookieAuth("mysite", "session"), func(r *http.Request) bool {
+			cookie, err := r.Cookie("auth_mysite")
+			return err == nil && cookie.Value == "session"
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if !tt.check(r) {
+					w.WriteHeader(401)
+				}
+			}))
+			defer srv.Close()
+
+			c := New(srv.URL, WithAuth(tt.auth))
+			if _, err := c.Do(context.Background(), updateHostOp, &updateHostParams{HostName: "x"}, nil, nil); err != nil {
+				t.Errorf("Do() error = %v", err)
+			}
+		})
+	}
+}
+
+func TestRetryPreconditionFailed(t *testing.T) {
+	showHostOp := &openapi.Operation{
+		ID:     "cmk.gui.openapi.endpoints.host_config.show_host",
+		Method: "GET",
+		Path:   "/objects/host_config/{host_name}",
+	}
+
+	// Another writer bumps the ETag after the first read
+	version, updates := 1, 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		etag := `"v` + string(rune('0'+version)) + `"`
+		switch r.Method {
+		case "GET":
+			w.Header().Set("ETag", etag)
+			w.Write([]byte(`{"id": "web01"}`))
+			if version == 1 {
+				version = 2
+			}
+		case "PUT":
+			updates++
+			if r.Header.Get("If-Match") != etag {
+				w.WriteHeader(412)
+				w.Write([]byte(`{"title": "Precondition Failed", "status": 412}`))
+				return
+			}
+			w.Write([]byte(`{"id": "web01", "title": "updated"}`))
+		}
+	}))
+	defer srv.Close()
+
+	c := New(srv.URL)
+	var out hostConfig
+	err := c.RetryPreconditionFailed(context.Background(), func(ctx context.Context) error {
+		fetched, err := c.Do(ctx, showHostOp, &updateHostParams{HostName: "web01"}, nil, nil)
+		if err != nil {
+			return err
+		}
+		_, err = c.Do(ctx, updateHostOp, &updateHostParams{HostName: "web01", IfMatch: fetched.ETag()}, &updateHost{}, &out)
+		return err
+	})
+	if err != nil {
+		t.Fatalf("RetryPreconditionFailed() error = %v", err)
+	}
+	if updates != 2 || out.Title != "updated" {
+		t.Errorf("updates = %d, out = %+v, want success on second attempt", updates, out)
+	}
+
+	// Give up after UpdateAttempts
+	c.UpdateAttempts = 2
+	attempts := 0
+	err = c.RetryPreconditionFailed(context.Background(), func(ctx context.Context) error {
+		attempts++
+		_, err := c.Do(ctx, updateHostOp, &updateHostParams{HostName: "web01", IfMatch: `"stale"`}, &updateHost{}, nil)
+		return err
+	})
+	if !IsPreconditionFailed(err) || attempts != 2 {
+		t.Errorf("err = %v after %d attempts, want 412 after 2", err, attempts)
+	}
+}
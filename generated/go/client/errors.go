@@ -0,0 +1,96 @@
+package client
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/BlackMesaLTD/checkmk-api-spec/generated/go/openapi"
+)
+
+// APIError is a non-2xx response, decoded from the problem+json body.
+type APIError struct {
+	Operation  string // operationId of the failed call
+	StatusCode int
+	Title      string
+	Detail     string
+	Fields     map[string]interface{} // Per-field validation messages
+	Ext        map[string]interface{} // Additional error information
+
+	// Problem holds the body decoded into the schema the operation declares
+	// for this status (e.g. *p17.Api404DefaultError), or nil if none is declared
+	// or the body does not decode.
+	Problem interface{}
+
+	Body []byte // Raw response body
+}
+
+func (e *APIError) Error() string {
+	msg := e.Title
+	if e.Detail != "" {
+		if msg != "" {
+			msg += ": "
+		}
+		msg += e.Detail
+	}
+	if msg == "" {
+		msg = string(e.Body)
+	}
+	return fmt.Sprintf("%s: HTTP %d: %s", e.Operation, e.StatusCode, msg)
+}
+
+// StatusCode returns the HTTP status of an *APIError in err's chain, or 0.
+func StatusCode(err error) int {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.StatusCode
+	}
+	return 0
+}
+
+// IsNotFound reports whether err is an API error with status 404.
+func IsNotFound(err error) bool {
+	return StatusCode(err) == 404
+}
+
+// IsPreconditionFailed reports whether err is an API error with status 412,
+// returned when the If-Match value no longer matches the object's ETag.
+func IsPreconditionFailed(err error) bool {
+	return StatusCode(err) == 412
+}
+
+// IsPreconditionRequired reports whether err is an API error with status 428,
+// returned when an update that needs If-Match was sent without it.
+func IsPreconditionRequired(err error) bool {
+	return StatusCode(err) == 428
+}
+
+func newAPIError(op *openapi.Operation, resp *Response) *APIError {
+	apiErr := &APIError{
+		Operation:  op.ID,
+		StatusCode: resp.StatusCode,
+		Body:       resp.Body,
+	}
+
+	var problem struct {
+		Title  string                 `json:"title"`
+		Detail string                 `json:"detail"`
+		Fields map[string]interface{} `json:"fields"`
+		Ext    map[string]interface{} `json:"ext"`
+	}
+	if json.Unmarshal(resp.Body, &problem) == nil {
+		apiErr.Title = problem.Title
+		apiErr.Detail = problem.Detail
+		apiErr.Fields = problem.Fields
+		apiErr.Ext = problem.Ext
+	}
+
+	if declared := op.Response(resp.StatusCode); declared != nil && declared.New != nil {
+		typed := declared.New()
+		if json.Unmarshal(resp.Body, typed) == nil {
+			apiErr.Problem = typed
+		}
+	}
+
+	return apiErr
+}
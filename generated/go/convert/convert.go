@@ -0,0 +1,361 @@
+// Package convert maps schema values between baselines, such as stored
+// state shaped like v2_3_0/p41.HostConfig that must become a
+// v2_4_0/p17.HostConfig after an upgrade.
+//
+// Values are matched by JSON field name, recursing into nested schemas.
+// Whatever the target baseline cannot take is reported as a Warning instead
+// of being lost silently: fields its schema no longer has (SchemaFieldNames),
+// values that do not fit the field's new type and enum values it no longer
+// accepts (EnumValuesLookup).
+//
+//	var dst p17.HostConfig
+//	warnings, err := convert.Convert(&dst, stored) // stored is a p41.HostConfig
+//	for _, w := range warnings {
+//		fmt.Printf("%s: %s\n", w.Pointer, w.Message) // "/recurring: cannot convert a JSON string to bool"
+//	}
+package convert
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	types "github.com/BlackMesaLTD/checkmk-api-spec/generated/go"
+)
+
+// Kind classifies a value lost in a conversion.
+type Kind string
+
+const (
+	DroppedField     Kind = "dropped_field"      // The target schema has no field of the name
+	TypeMismatch     Kind = "type_mismatch"      // The value does not fit the type of the target field
+	InvalidEnumValue Kind = "invalid_enum_value" // The target field does not accept the value
+)
+
+// Warning is a value of the source that did not make it into the target.
+type Warning struct {
+	Pointer string // JSON Pointer (RFC 6901) to the value in the source
+	Kind    Kind
+	Value   json.RawMessage // The lost value
+	Message string
+}
+
+func (w *Warning) String() string {
+	return w.Pointer + ": " + w.Message
+}
+
+// Warnings lists the values lost in a conversion, ordered by pointer.
+type Warnings []*Warning
+
+func (w Warnings) String() string {
+	msgs := make([]string, len(w))
+	for i, warning := range w {
+		msgs[i] = warning.String()
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// Convert maps src onto dst, a pointer to a schema type of a baseline
+// package. src is usually a schema value of another baseline, but may be
+// anything encoding to a JSON object, such as a map. Fields of dst that src
+// lacks are left as they are.
+//
+// Every key of src is converted whatever its value, so false, 0 and ""
+// overwrite dst and a dropped field is reported even if zero; only keys src
+// omits are skipped. Struct sources are walked field by field rather than
+// through json.Marshal, so omitempty does not hide their zero values: every
+// field is converted except nil pointers, slices, maps and interfaces. The
+// error is non-nil only if dst is no schema type of a compiled in baseline
+// or src is no JSON object.
+func Convert(dst, src interface{}) (Warnings, error) {
+	dv := reflect.ValueOf(dst)
+	if dv.Kind() != reflect.Pointer || dv.IsNil() || dv.Elem().Kind() != reflect.Struct {
+		return nil, fmt.Errorf("dst must be a non-nil pointer to a schema type, got %T", dst)
+	}
+	t := dv.Elem().Type()
+	pkg, ok := types.BaselineOfPackage(t.PkgPath())
+	if !ok {
+		return nil, fmt.Errorf("%s is not a type of a baseline package", t)
+	}
+	r, err := types.GetRegistry(pkg)
+	if err != nil {
+		return nil, err
+	}
+	if r.Schemas[t.Name()] == nil {
+		return nil, fmt.Errorf("schema %q not found in baseline %s", t.Name(), pkg)
+	}
+
+	data, err := encode(reflect.ValueOf(src))
+	if err != nil {
+		return nil, fmt.Errorf("encoding source: %w", err)
+	}
+	var obj map[string]json.RawMessage
+	if err := json.Unmarshal(data, &obj); err != nil || obj == nil {
+		return nil, fmt.Errorf("source %T is not a JSON object", src)
+	}
+
+	c := &converter{registry: r, pkgPath: t.PkgPath()}
+	c.object(dv.Elem(), t.Name(), obj, "")
+	sort.SliceStable(c.warnings, func(i, j int) bool { return c.warnings[i].Pointer < c.warnings[j].Pointer })
+	return c.warnings, nil
+}
+
+type converter struct {
+	registry *types.BaselineFuncs
+	pkgPath  string // Import path of the target baseline package
+	warnings Warnings
+}
+
+var (
+	marshalerType   = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+	unmarshalerType = reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()
+)
+
+func (c *converter) add(pointer string, kind Kind, value json.RawMessage, format string, args ...interface{}) {
+	c.warnings = append(c.warnings, &Warning{Pointer: pointer, Kind: kind, Value: value, Message: fmt.Sprintf(format, args...)})
+}
+
+// object sets the fields of v, a struct of the given schema, from a JSON
+// object
+func (c *converter) object(v reflect.Value, schema string, obj map[string]json.RawMessage, pointer string) {
+	fields := jsonFields(v.Type())
+	known := c.registry.GetSchemaFieldNames(schema)
+
+	names := make([]string, 0, len(obj))
+	for name := range obj {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		raw, p := obj[name], pointer+"/"+escape(name)
+		index, ok := fields[name]
+		if !ok || !slices.Contains(known, name) {
+			c.add(p, DroppedField, raw, "%s has no field %s", schema, name)
+			continue
+		}
+		if allowed := c.registry.GetValidEnumValues(schema, name); len(allowed) > 0 {
+			if raw = c.enum(allowed, raw, p); raw == nil {
+				continue
+			}
+		}
+		field := v.Field(index)
+		if value, ok := c.decode(field.Type(), raw, p); ok {
+			field.Set(value)
+		}
+	}
+}
+
+// enum drops the values an enum field does not accept: a single value
+// entirely, from an array only the offending elements. It returns nil if
+// nothing is left.
+func (c *converter) enum(allowed []string, raw json.RawMessage, pointer string) json.RawMessage {
+	var s string
+	if json.Unmarshal(raw, &s) == nil {
+		if slices.Contains(allowed, s) {
+			return raw
+		}
+		c.add(pointer, InvalidEnumValue, raw, "%q is not one of %s", s, strings.Join(allowed, ", "))
+		return nil
+	}
+
+	var list []string
+	if json.Unmarshal(raw, &list) != nil {
+		return raw // Type mismatches are reported on decoding
+	}
+	kept := make([]string, 0, len(list))
+	for i, s := range list {
+		if slices.Contains(allowed, s) {
+			kept = append(kept, s)
+			continue
+		}
+		value, _ := json.Marshal(s)
+		c.add(pointer+"/"+strconv.Itoa(i), InvalidEnumValue, value, "%q is not one of %s", s, strings.Join(allowed, ", "))
+	}
+	if len(kept) == 0 {
+		return nil
+	}
+	data, _ := json.Marshal(kept)
+	return data
+}
+
+// decode returns a JSON value as a value of type t. Schemas of the target
+// baseline, also behind pointers and in slices, are converted field by field.
+func (c *converter) decode(t reflect.Type, raw json.RawMessage, pointer string) (reflect.Value, bool) {
+	switch {
+	case t.Kind() == reflect.Pointer && c.isSchema(t.Elem()):
+		v, ok := c.decode(t.Elem(), raw, pointer)
+		if !ok {
+			return reflect.Value{}, false
+		}
+		ptr := reflect.New(t.Elem())
+		ptr.Elem().Set(v)
+		return ptr, true
+
+	case c.isSchema(t):
+		var obj map[string]json.RawMessage
+		if err := json.Unmarshal(raw, &obj); err != nil || obj == nil {
+			c.add(pointer, TypeMismatch, raw, "cannot convert a JSON %s to %s", jsonKind(raw), t)
+			return reflect.Value{}, false
+		}
+		v := reflect.New(t).Elem()
+		c.object(v, t.Name(), obj, pointer)
+		return v, true
+
+	case t.Kind() == reflect.Slice && (c.isSchema(t.Elem()) || t.Elem().Kind() == reflect.Pointer && c.isSchema(t.Elem().Elem())):
+		var items []json.RawMessage
+		if err := json.Unmarshal(raw, &items); err != nil {
+			c.add(pointer, TypeMismatch, raw, "cannot convert a JSON %s to %s", jsonKind(raw), t)
+			return reflect.Value{}, false
+		}
+		s := reflect.MakeSlice(t, 0, len(items))
+		for i, item := range items {
+			if v, ok := c.decode(t.Elem(), item, pointer+"/"+strconv.Itoa(i)); ok {
+				s = reflect.Append(s, v)
+			}
+		}
+		return s, true
+	}
+
+	v := reflect.New(t)
+	if err := json.Unmarshal(raw, v.Interface()); err != nil {
+		c.add(pointer, TypeMismatch, raw, "cannot convert a JSON %s to %s", jsonKind(raw), t)
+		return reflect.Value{}, false
+	}
+	return v.Elem(), true
+}
+
+// isSchema reports whether t is a schema struct of the target baseline that
+// decodes field by field
+func (c *converter) isSchema(t reflect.Type) bool {
+	return t.Kind() == reflect.Struct && t.PkgPath() == c.pkgPath &&
+		c.registry.Schemas[t.Name()] != nil && !reflect.PointerTo(t).Implements(unmarshalerType)
+}
+
+// encode returns the JSON of a source value like json.Marshal, except that
+// struct fields are kept whatever their omitempty option unless they are
+// nil. Types with a MarshalJSON method, such as unions, encode themselves.
+func encode(v reflect.Value) (json.RawMessage, error) {
+	if !v.IsValid() {
+		return json.RawMessage("null"), nil
+	}
+	if v.Type().Implements(marshalerType) {
+		return json.Marshal(v.Interface())
+	}
+	if v.Kind() != reflect.Pointer && reflect.PointerTo(v.Type()).Implements(marshalerType) {
+		ptr := reflect.New(v.Type())
+		ptr.Elem().Set(v)
+		return json.Marshal(ptr.Interface())
+	}
+
+	switch v.Kind() {
+	case reflect.Pointer, reflect.Interface:
+		if v.IsNil() {
+			return json.RawMessage("null"), nil
+		}
+		return encode(v.Elem())
+
+	case reflect.Struct:
+		obj := make(map[string]json.RawMessage)
+		for name, index := range jsonFields(v.Type()) {
+			field := v.Field(index)
+			switch field.Kind() {
+			case reflect.Pointer, reflect.Interface, reflect.Slice, reflect.Map:
+				if field.IsNil() {
+					continue
+				}
+			}
+			raw, err := encode(field)
+			if err != nil {
+				return nil, err
+			}
+			obj[name] = raw
+		}
+		return json.Marshal(obj)
+
+	case reflect.Slice, reflect.Array:
+		if v.Kind() == reflect.Slice && v.IsNil() {
+			return json.RawMessage("null"), nil
+		}
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			return json.Marshal(v.Interface()) // Base64, as json.Marshal does
+		}
+		items := make([]json.RawMessage, v.Len())
+		for i := range items {
+			raw, err := encode(v.Index(i))
+			if err != nil {
+				return nil, err
+			}
+			items[i] = raw
+		}
+		return json.Marshal(items)
+
+	case reflect.Map:
+		if v.IsNil() {
+			return json.RawMessage("null"), nil
+		}
+		if v.Type().Key().Kind() != reflect.String {
+			return json.Marshal(v.Interface())
+		}
+		obj := make(map[string]json.RawMessage, v.Len())
+		iter := v.MapRange()
+		for iter.Next() {
+			raw, err := encode(iter.Value())
+			if err != nil {
+				return nil, err
+			}
+			obj[iter.Key().String()] = raw
+		}
+		return json.Marshal(obj)
+	}
+	return json.Marshal(v.Interface())
+}
+
+// jsonFields maps the JSON names of a struct's fields to their index
+func jsonFields(t reflect.Type) map[string]int {
+	fields := make(map[string]int, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if !f.IsExported() {
+			continue
+		}
+		name, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+		switch name {
+		case "-":
+			continue
+		case "":
+			name = f.Name
+		}
+		fields[name] = i
+	}
+	return fields
+}
+
+// jsonKind names the JSON type of a value
+func jsonKind(raw json.RawMessage) string {
+	s := strings.TrimSpace(string(raw))
+	switch {
+	case s == "":
+		return "value"
+	case s[0] == '{':
+		return "object"
+	case s[0] == '[':
+		return "array"
+	case s[0] == '"':
+		return "string"
+	case s == "true" || s == "false":
+		return "boolean"
+	case s == "null":
+		return "null"
+	}
+	return "number"
+}
+
+// escape encodes a property name as a JSON Pointer reference token.
+func escape(name string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(name, "~", "~0"), "/", "~1")
+}
@@ -0,0 +1,178 @@
+//go:build checkmk_all || (checkmk_v2_3_0 && checkmk_v2_4_0)
+
+package convert
+
+import (
+	"testing"
+
+	p41 "github.com/BlackMesaLTD/checkmk-api-spec/generated/go/v2_3_0/p41"
+	p17 "github.com/BlackMesaLTD/checkmk-api-spec/generated/go/v2_4_0/p17"
+)
+
+func TestConvert(t *testing.T) {
+	tests := []struct {
+		name     string
+		dst      interface{}
+		src      interface{}
+		warnings map[string]Kind // Pointer -> kind
+	}{
+		{
+			name: "nested schemas",
+			dst:  &p17.HostConfig{},
+			src: p41.HostConfig{
+				Id:    "web01",
+				Links: []p41.Link{{Href: "/objects/host_config/web01", Method: "GET", Rel: "self"}},
+				Extensions: &p41.HostExtensions{
+					Folder: "/linux",
+				},
+			},
+		},
+		{
+			name: "dropped field",
+			dst:  &p17.BulkDiscovery{},
+			src: p41.BulkDiscovery{
+				Hostnames: []string{"web01"},
+				Mode:      "new",
+				Options:   &p41.BulkDiscoveryOptions{MonitorUndecidedServices: true},
+			},
+			warnings: map[string]Kind{"/mode": DroppedField},
+		},
+		{
+			name:     "type mismatch",
+			dst:      &p17.ServiceDowntimeAttributes{},
+			src:      p41.ServiceDowntimeAttributes{HostName: "web01", Recurring: "yes"},
+			warnings: map[string]Kind{"/recurring": TypeMismatch},
+		},
+		{
+			name:     "invalid enum value",
+			dst:      &p17.AcknowledgeHostProblem{},
+			src:      p41.AcknowledgeHostProblem{HostName: "web01", AcknowledgeType: p41.AcknowledgeHostProblemAcknowledgeTypeHostgroup},
+			warnings: map[string]Kind{"/acknowledge_type": InvalidEnumValue},
+		},
+		{
+			name:     "map source",
+			dst:      &p17.AcknowledgeHostProblem{},
+			src:      map[string]interface{}{"host_name": "web01", "acknowledge_type": "host", "colour": "red"},
+			warnings: map[string]Kind{"/colour": DroppedField},
+		},
+		{
+			name: "dropped zero values",
+			dst:  &p17.AcknowledgeHostProblem{},
+			src: map[string]interface{}{
+				"host_name": "web01", "acknowledge_type": "host", "sticky": false,
+				"disabled": false, "count": 0, "colour": "", "tags": []string{}, "extra": nil,
+			},
+			warnings: map[string]Kind{
+				"/disabled": DroppedField, "/count": DroppedField, "/colour": DroppedField,
+				"/tags": DroppedField, "/extra": DroppedField,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			warnings, err := Convert(tt.dst, tt.src)
+			if err != nil {
+				t.Fatalf("Convert() error = %v", err)
+			}
+			if len(warnings) != len(tt.warnings) {
+				t.Fatalf("Convert() warnings = %v, want %v", warnings, tt.warnings)
+			}
+			for _, w := range warnings {
+				if kind, ok := tt.warnings[w.Pointer]; !ok || kind != w.Kind {
+					t.Errorf("unexpected warning %s (%s)", w, w.Kind)
+				}
+			}
+		})
+	}
+}
+
+func TestConvertValues(t *testing.T) {
+	var host p17.HostConfig
+	if _, err := Convert(&host, p41.HostConfig{
+		Id:         "web01",
+		Links:      []p41.Link{{Href: "/objects/host_config/web01", Rel: "self"}},
+		Extensions: &p41.HostExtensions{Folder: "/linux"},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if host.Id != "web01" || len(host.Links) != 1 || host.Links[0].Href != "/objects/host_config/web01" ||
+		host.Extensions == nil || host.Extensions.Folder != "/linux" {
+		t.Errorf("Convert() = %+v", host)
+	}
+
+	// Pointers become values, fields lost elsewhere do not affect the rest
+	var discovery p17.BulkDiscovery
+	if _, err := Convert(&discovery, p41.BulkDiscovery{
+		Hostnames: []string{"web01"},
+		Mode:      "new",
+		Options:   &p41.BulkDiscoveryOptions{MonitorUndecidedServices: true},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if len(discovery.Hostnames) != 1 || !discovery.Options.MonitorUndecidedServices {
+		t.Errorf("Convert() = %+v", discovery)
+	}
+
+	// Zero values overwrite the target like any other
+	ack := p17.AcknowledgeHostProblem{HostName: "web01", Comment: "down", Sticky: true}
+	if _, err := Convert(&ack, map[string]interface{}{"sticky": false, "comment": ""}); err != nil {
+		t.Fatal(err)
+	}
+	if ack.Sticky || ack.Comment != "" || ack.HostName != "web01" {
+		t.Errorf("Convert() = %+v, want sticky and comment cleared", ack)
+	}
+	discovery = p17.BulkDiscovery{BulkSize: 10, Hostnames: []string{"web01"}}
+	if _, err := Convert(&discovery, map[string]interface{}{"bulk_size": 0, "hostnames": []string{}}); err != nil {
+		t.Fatal(err)
+	}
+	if discovery.BulkSize != 0 || len(discovery.Hostnames) != 0 {
+		t.Errorf("Convert() = %+v, want bulk_size and hostnames cleared", discovery)
+	}
+
+	// Struct sources carry their zero values too, despite omitempty
+	discovery = p17.BulkDiscovery{BulkSize: 10, DoFullScan: true, Hostnames: []string{"db01"}}
+	if _, err := Convert(&discovery, p41.BulkDiscovery{Hostnames: []string{"web01"}}); err != nil {
+		t.Fatal(err)
+	}
+	if discovery.BulkSize != 0 || discovery.DoFullScan || len(discovery.Hostnames) != 1 || discovery.Hostnames[0] != "web01" {
+		t.Errorf("Convert() = %+v, want bulk_size and do_full_scan cleared", discovery)
+	}
+	ack = p17.AcknowledgeHostProblem{HostName: "web01", Notify: true}
+	if _, err := Convert(&ack, p41.AcknowledgeHostProblem{HostName: "web01", AcknowledgeType: p41.AcknowledgeHostProblemAcknowledgeTypeHost}); err != nil {
+		t.Fatal(err)
+	}
+	if ack.Notify {
+		t.Errorf("Convert() = %+v, want notify cleared", ack)
+	}
+
+	// Lost values leave the target field alone
+	ack = p17.AcknowledgeHostProblem{AcknowledgeType: p17.AcknowledgeHostProblemAcknowledgeTypeHost}
+	if _, err := Convert(&ack, p41.AcknowledgeHostProblem{AcknowledgeType: p41.AcknowledgeHostProblemAcknowledgeTypeHostgroup}); err != nil {
+		t.Fatal(err)
+	}
+	if ack.AcknowledgeType != p17.AcknowledgeHostProblemAcknowledgeTypeHost {
+		t.Errorf("AcknowledgeType = %q, want host", ack.AcknowledgeType)
+	}
+}
+
+func TestConvertErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		dst  interface{}
+		src  interface{}
+	}{
+		{"not a pointer", p17.HostConfig{}, p41.HostConfig{}},
+		{"nil pointer", (*p17.HostConfig)(nil), p41.HostConfig{}},
+		{"not a baseline type", &struct{ Name string }{}, p41.HostConfig{}},
+		{"not an object", &p17.HostConfig{}, []string{"web01"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := Convert(tt.dst, tt.src); err == nil {
+				t.Error("Convert() succeeded, want error")
+			}
+		})
+	}
+}
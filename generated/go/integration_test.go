@@ -1,7 +1,7 @@
 // Package types provides version-aware type information for CheckMK API.
 //
 // Integration tests that verify generated types match live API responses.
-// Run with: go test -tags=integration ./generated/go/...
+// Run with: go test -tags=integration,checkmk_all ./generated/go/...
 //
 //go:build integration
 
@@ -16,6 +16,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/BlackMesaLTD/checkmk-api-spec/cmkversion"
 	"gopkg.in/yaml.v3"
 )
 
@@ -98,35 +99,36 @@ func fetchOpenAPISpec(cfg *TestConfig) (*OpenAPISpec, error) {
 	return &spec, nil
 }
 
-func fetchVersion(cfg *TestConfig) (string, error) {
+func fetchVersion(cfg *TestConfig) (string, cmkversion.Edition, error) {
 	url := fmt.Sprintf("%s/check_mk/api/1.0/version", cfg.URL)
 
 	req, err := http.NewRequest("GET", url, nil)
 	if err != nil {
-		return "", err
+		return "", "", err
 	}
 	req.SetBasicAuth(cfg.Username, cfg.Password)
 
 	resp, err := http.DefaultClient.Do(req)
 	if err != nil {
-		return "", err
+		return "", "", err
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode != 200 {
-		return "", fmt.Errorf("failed to fetch version: %d", resp.StatusCode)
+		return "", "", fmt.Errorf("failed to fetch version: %d", resp.StatusCode)
 	}
 
 	var result struct {
 		Versions struct {
 			CheckMK string `json:"checkmk"`
 		} `json:"versions"`
+		Edition cmkversion.Edition `json:"edition"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-		return "", err
+		return "", "", err
 	}
 
-	return result.Versions.CheckMK, nil
+	return result.Versions.CheckMK, result.Edition, nil
 }
 
 // extractEnumValues extracts enum values from a schema property
@@ -203,16 +205,16 @@ func TestGeneratedMatchesLiveAPI_TagAgent(t *testing.T) {
 	cfg := getTestConfig(t)
 
 	// Get version from API
-	version, err := fetchVersion(cfg)
+	version, edition, err := fetchVersion(cfg)
 	if err != nil {
 		t.Fatalf("Failed to fetch version: %v", err)
 	}
 	t.Logf("Testing against CheckMK version: %s", version)
 
 	// Look up baseline for this version
-	baseline := LookupBaseline(version)
-	if baseline == "" {
-		t.Skipf("No baseline for version %s", version)
+	baseline, err := LookupBaselineEdition(version, edition)
+	if err != nil {
+		t.Skipf("No baseline for version %s: %v", version, err)
 	}
 	t.Logf("Using baseline: %s", baseline)
 
@@ -258,15 +260,15 @@ func TestGeneratedMatchesLiveAPI_TagAgent(t *testing.T) {
 func TestGeneratedMatchesLiveAPI_HostFields(t *testing.T) {
 	cfg := getTestConfig(t)
 
-	version, err := fetchVersion(cfg)
+	version, edition, err := fetchVersion(cfg)
 	if err != nil {
 		t.Fatalf("Failed to fetch version: %v", err)
 	}
 	t.Logf("Testing against CheckMK version: %s", version)
 
-	baseline := LookupBaseline(version)
-	if baseline == "" {
-		t.Skipf("No baseline for version %s", version)
+	baseline, err := LookupBaselineEdition(version, edition)
+	if err != nil {
+		t.Skipf("No baseline for version %s: %v", version, err)
 	}
 
 	generatedFields := HostCreateAttributeFieldNames(baseline)
@@ -303,14 +305,14 @@ func TestGeneratedMatchesLiveAPI_HostFields(t *testing.T) {
 func TestGeneratedMatchesLiveAPI_FolderFields(t *testing.T) {
 	cfg := getTestConfig(t)
 
-	version, err := fetchVersion(cfg)
+	version, edition, err := fetchVersion(cfg)
 	if err != nil {
 		t.Fatalf("Failed to fetch version: %v", err)
 	}
 
-	baseline := LookupBaseline(version)
-	if baseline == "" {
-		t.Skipf("No baseline for version %s", version)
+	baseline, err := LookupBaselineEdition(version, edition)
+	if err != nil {
+		t.Skipf("No baseline for version %s: %v", version, err)
 	}
 
 	generatedFields := FolderCreateAttributeFieldNames(baseline)
@@ -346,16 +348,16 @@ func TestGeneratedMatchesLiveAPI_FolderFields(t *testing.T) {
 func TestBaselineLookupMatchesRunningVersion(t *testing.T) {
 	cfg := getTestConfig(t)
 
-	version, err := fetchVersion(cfg)
+	version, edition, err := fetchVersion(cfg)
 	if err != nil {
 		t.Fatalf("Failed to fetch version: %v", err)
 	}
 
-	baseline := LookupBaseline(version)
+	baseline, err := LookupBaselineEdition(version, edition)
 	t.Logf("Version %s -> Baseline %s", version, baseline)
 
-	if baseline == "" {
-		t.Errorf("LookupBaseline returned empty for running version %s", version)
+	if err != nil {
+		t.Errorf("LookupBaselineEdition failed for running version %s: %v", version, err)
 	}
 
 	// Verify baseline returns valid values
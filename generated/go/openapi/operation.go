@@ -0,0 +1,252 @@
+// Package openapi defines the endpoint descriptors shared by all baseline
+// packages.
+//
+// Each baseline's operations.gen.go declares one *Operation per operationId
+// of its spec, together with a typed parameter struct whose fields carry
+// `path`, `query` and `header` tags. Operation.Encode turns such a struct into
+// the concrete path, query string and headers of a request, so callers never
+// hand-write URLs per CheckMK version.
+package openapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// Operation describes one REST API endpoint of a baseline.
+type Operation struct {
+	ID          string // operationId, e.g. "cmk.gui.openapi.endpoints.host_config.show_host"
+	Name        string // Go name of the operation, e.g. "HostConfigShowHost"
+	Method      string // HTTP method, e.g. "GET"
+	Path        string // Path template relative to the API root, e.g. "/objects/host_config/{host_name}"
+	Summary     string
+	Tags        []string
+	Deprecated  bool
+	Parameters  []Parameter  // Path, query and header parameters in spec order
+	RequestBody *RequestBody // nil if the operation takes no body
+	Responses   []Response   // Declared responses, sorted by status
+
+	// NewParams allocates the operation's parameter struct.
+	// It is nil if the operation has no parameters.
+	NewParams func() interface{}
+}
+
+// Parameter describes a path, query or header parameter.
+type Parameter struct {
+	Name        string // Wire name, e.g. "host_name" or "If-Match"
+	In          string // "path", "query" or "header"
+	Required    bool
+	Type        string // OpenAPI type, e.g. "string", "boolean", "array"
+	Description string
+}
+
+// RequestBody describes the body an operation accepts.
+type RequestBody struct {
+	ContentType string // e.g. "application/json"
+	Schema      string // Component schema name, empty if the body is not a named schema
+	Required    bool
+
+	// New allocates a value of the body's Go type, or is nil if there is none.
+	New func() interface{}
+}
+
+// Response describes one declared response of an operation.
+type Response struct {
+	Status      string // Status code, e.g. "200", or "default"
+	Description string
+	ContentType string   // e.g. "application/json", "application/problem+json"; empty for no body
+	Schema      string   // Component schema name, empty if the body is not a named schema
+	Headers     []string // Response headers declared by the spec, e.g. "ETag"
+
+	// New allocates a value of the response's Go type, or is nil if there is none.
+	New func() interface{}
+}
+
+// IsError reports whether the response describes a failure status (4xx or 5xx).
+func (r *Response) IsError() bool {
+	return len(r.Status) == 3 && (r.Status[0] == '4' || r.Status[0] == '5')
+}
+
+// Response returns the declared response for a status code, falling back to
+// the "default" response. Returns nil if neither is declared.
+func (op *Operation) Response(status int) *Response {
+	code := strconv.Itoa(status)
+	var fallback *Response
+	for i := range op.Responses {
+		switch op.Responses[i].Status {
+		case code:
+			return &op.Responses[i]
+		case "default":
+			fallback = &op.Responses[i]
+		}
+	}
+	return fallback
+}
+
+// Parameter returns the parameter with the given name and location, or nil.
+func (op *Operation) Parameter(in, name string) *Parameter {
+	for i := range op.Parameters {
+		if op.Parameters[i].In == in && op.Parameters[i].Name == name {
+			return &op.Parameters[i]
+		}
+	}
+	return nil
+}
+
+// HasTag reports whether the operation is listed under tag.
+func (op *Operation) HasTag(tag string) bool {
+	for _, t := range op.Tags {
+		if t == tag {
+			return true
+		}
+	}
+	return false
+}
+
+// Encode expands the path template and collects query and header values from
+// params, which must be nil or a pointer to the operation's parameter struct.
+//
+// Nil pointers, empty strings and empty slices are omitted, so optional
+// numbers and booleans are pointers in the generated structs. Other values
+// are sent even if zero: a required integer parameter of 0 is encoded as
+// "0". A path parameter left unset is an error.
+func (op *Operation) Encode(params interface{}) (path string, query url.Values, header http.Header, err error) {
+	query = url.Values{}
+	header = http.Header{}
+	values := map[string]string{}
+
+	if params != nil {
+		v := reflect.ValueOf(params)
+		for v.Kind() == reflect.Ptr {
+			if v.IsNil() {
+				break
+			}
+			v = v.Elem()
+		}
+		if v.Kind() == reflect.Struct {
+			t := v.Type()
+			for i := 0; i < t.NumField(); i++ {
+				if err := op.encodeField(t.Field(i), v.Field(i), values, query, header); err != nil {
+					return "", nil, nil, err
+				}
+			}
+		} else if v.Kind() != reflect.Ptr {
+			return "", nil, nil, fmt.Errorf("%s: params must be a struct pointer, got %T", op.ID, params)
+		}
+	}
+
+	path, err = op.expandPath(values)
+	return path, query, header, err
+}
+
+func (op *Operation) encodeField(field reflect.StructField, value reflect.Value, values map[string]string, query url.Values, header http.Header) error {
+	for _, in := range []string{"path", "query", "header"} {
+		tag, ok := field.Tag.Lookup(in)
+		if !ok {
+			continue
+		}
+		name, opts, _ := strings.Cut(tag, ",")
+
+		if unset(value) {
+			return nil
+		}
+		if opts == "json" {
+			data, err := json.Marshal(value.Interface())
+			if err != nil {
+				return fmt.Errorf("%s: encoding %s parameter %s: %w", op.ID, in, name, err)
+			}
+			setParam(in, name, []string{string(data)}, values, query, header)
+			return nil
+		}
+
+		strs, err := paramStrings(value)
+		if err != nil {
+			return fmt.Errorf("%s: %s parameter %s: %w", op.ID, in, name, err)
+		}
+		setParam(in, name, strs, values, query, header)
+		return nil
+	}
+	return nil
+}
+
+// unset reports whether a parameter field was left out
+func unset(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		return v.IsNil()
+	case reflect.String, reflect.Slice, reflect.Map:
+		return v.Len() == 0
+	}
+	return false
+}
+
+func setParam(in, name string, strs []string, values map[string]string, query url.Values, header http.Header) {
+	switch in {
+	case "path":
+		values[name] = strs[0]
+	case "query":
+		for _, s := range strs {
+			query.Add(name, s)
+		}
+	case "header":
+		for _, s := range strs {
+			header.Add(name, s)
+		}
+	}
+}
+
+func paramStrings(v reflect.Value) ([]string, error) {
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		v = v.Elem()
+	}
+	switch v.Kind() {
+	case reflect.String:
+		return []string{v.String()}, nil
+	case reflect.Bool:
+		return []string{strconv.FormatBool(v.Bool())}, nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return []string{strconv.FormatInt(v.Int(), 10)}, nil
+	case reflect.Float32, reflect.Float64:
+		return []string{strconv.FormatFloat(v.Float(), 'f', -1, 64)}, nil
+	case reflect.Slice:
+		var result []string
+		for i := 0; i < v.Len(); i++ {
+			strs, err := paramStrings(v.Index(i))
+			if err != nil {
+				return nil, err
+			}
+			result = append(result, strs...)
+		}
+		return result, nil
+	}
+	return nil, fmt.Errorf("unsupported type %s", v.Type())
+}
+
+func (op *Operation) expandPath(values map[string]string) (string, error) {
+	var b strings.Builder
+	rest := op.Path
+	for {
+		start := strings.IndexByte(rest, '{')
+		if start < 0 {
+			b.WriteString(rest)
+			return b.String(), nil
+		}
+		end := strings.IndexByte(rest[start:], '}')
+		if end < 0 {
+			return "", fmt.Errorf("%s: malformed path template %q", op.ID, op.Path)
+		}
+		name := rest[start+1 : start+end]
+		value, ok := values[name]
+		if !ok {
+			return "", fmt.Errorf("%s: missing path parameter %s", op.ID, name)
+		}
+		b.WriteString(rest[:start])
+		b.WriteString(url.PathEscape(value))
+		rest = rest[start+end+1:]
+	}
+}
@@ -0,0 +1,114 @@
+package openapi
+
+import "testing"
+
+type showHostParams struct {
+	HostName            string   `path:"host_name"`
+	EffectiveAttributes *bool    `query:"effective_attributes"`
+	Columns             []string `query:"columns"`
+	IfMatch             string   `header:"If-Match"`
+	Query               *expr    `query:"query,json"`
+}
+
+type expr struct {
+	Op    string `json:"op"`
+	Left  string `json:"left"`
+	Right string `json:"right"`
+}
+
+var showHost = &Operation{
+	ID:     "cmk.gui.openapi.endpoints.host_config.show_host",
+	Method: "GET",
+	Path:   "/objects/host_config/{host_name}",
+	Responses: []Response{
+		{Status: "200", Schema: "HostConfig"},
+		{Status: "404", Schema: "Api404DefaultError"},
+		{Status: "default", Schema: "GeneralRestAPIException"},
+	},
+}
+
+func TestEncode(t *testing.T) {
+	no := false
+	params := &showHostParams{
+		HostName:            "web 01/a",
+		EffectiveAttributes: &no,
+		Columns:             []string{"name", "state"},
+		IfMatch:             `"abc"`,
+		Query:               &expr{Op: "=", Left: "name", Right: "x"},
+	}
+
+	path, query, header, err := showHost.Encode(params)
+	if err != nil {
+		t.Fatalf("Encode() error = %v", err)
+	}
+	if want := "/objects/host_config/web%2001%2Fa"; path != want {
+		t.Errorf("path = %q, want %q", path, want)
+	}
+	if got := query.Get("effective_attributes"); got != "false" {
+		t.Errorf("effective_attributes = %q, want explicit false", got)
+	}
+	if got := query["columns"]; len(got) != 2 || got[0] != "name" || got[1] != "state" {
+		t.Errorf("columns = %v, want exploded values", got)
+	}
+	if got := query.Get("query"); got != `{"op":"=","left":"name","right":"x"}` {
+		t.Errorf("query = %q, want JSON expression", got)
+	}
+	if got := header.Get("If-Match"); got != `"abc"` {
+		t.Errorf("If-Match = %q", got)
+	}
+}
+
+func TestEncodeOmitsZeroValues(t *testing.T) {
+	_, query, header, err := showHost.Encode(&showHostParams{HostName: "web01"})
+	if err != nil {
+		t.Fatalf("Encode() error = %v", err)
+	}
+	if len(query) != 0 || len(header) != 0 {
+		t.Errorf("query = %v, header = %v, want both empty", query, header)
+	}
+}
+
+func TestEncodeZeroNumbers(t *testing.T) {
+	type showCommentParams struct {
+		CommentId int  `path:"comment_id"`
+		Limit     *int `query:"limit"`
+	}
+	showComment := &Operation{ID: "show_comment", Method: "GET", Path: "/objects/comment/{comment_id}"}
+
+	zero := 0
+	path, query, _, err := showComment.Encode(&showCommentParams{Limit: &zero})
+	if err != nil {
+		t.Fatalf("Encode() error = %v", err)
+	}
+	if path != "/objects/comment/0" {
+		t.Errorf("path = %q, want comment ID 0", path)
+	}
+	if got := query.Get("limit"); got != "0" {
+		t.Errorf("limit = %q, want explicit 0", got)
+	}
+}
+
+func TestEncodeMissingPathParameter(t *testing.T) {
+	if _, _, _, err := showHost.Encode(nil); err == nil {
+		t.Error("Encode(nil) succeeded, want missing path parameter error")
+	}
+}
+
+func TestResponse(t *testing.T) {
+	tests := []struct {
+		status int
+		want   string
+	}{
+		{200, "HostConfig"},
+		{404, "Api404DefaultError"},
+		{500, "GeneralRestAPIException"},
+	}
+	for _, tt := range tests {
+		if got := showHost.Response(tt.status); got == nil || got.Schema != tt.want {
+			t.Errorf("Response(%d) = %+v, want schema %s", tt.status, got, tt.want)
+		}
+	}
+	if !showHost.Response(404).IsError() || showHost.Response(200).IsError() {
+		t.Error("IsError() misclassifies statuses")
+	}
+}
@@ -0,0 +1,69 @@
+package openapi
+
+// Schema is a component schema of a baseline in the form runtime validators
+// need. Each baseline's schemas.gen.go declares one per components.schemas
+// entry of its spec.
+//
+// allOf compositions are flattened into Properties and Required. Where a
+// schema uses another component, it holds a reference by name (Ref) instead
+// of a copy, so recursive schemas stay finite; resolve it through the
+// baseline's Schemas map.
+type Schema struct {
+	// Ref names the component this schema refers to. Only ReadOnly and
+	// Nullable may be set alongside it.
+	Ref string
+
+	Type   string // "object", "array", "string", "integer", "number", "boolean", or empty for any
+	Format string // e.g. "date-time", "email"
+
+	Properties map[string]*Schema
+	Required   []string
+	// AdditionalProperties is the schema of undeclared properties, or nil if
+	// the object only accepts its declared Properties.
+	AdditionalProperties *Schema
+	Items                *Schema // Element schema of arrays
+
+	Enum []string // Allowed values, formatted with fmt.Sprint
+
+	OneOf         []*Schema
+	AnyOf         []*Schema
+	Discriminator string            // Property selecting the OneOf/AnyOf member
+	Mapping       map[string]string // Discriminator value to component name
+
+	Pattern   string // Python regular expression, as in the spec
+	MinLength *int
+	MaxLength *int
+	Minimum   *float64
+	Maximum   *float64
+	MinItems  *int
+	MaxItems  *int
+
+	ReadOnly   bool
+	Nullable   bool
+	Deprecated bool
+}
+
+// Int returns a pointer to v, for generated Schema and FieldConstraints literals.
+func Int(v int) *int { return &v }
+
+// Float returns a pointer to v, for generated Schema and FieldConstraints literals.
+func Float(v float64) *float64 { return &v }
+
+// FieldConstraints are the validation keywords and default value of a schema
+// field, as returned by a baseline's GetFieldConstraints. Unset keywords are
+// nil or empty.
+type FieldConstraints struct {
+	Format    string // e.g. "date-time", "email", "ipv4"
+	Pattern   string // Python regular expression, as in the spec
+	Minimum   *float64
+	Maximum   *float64
+	MinLength *int
+	MaxLength *int
+	MinItems  *int
+	MaxItems  *int
+
+	// Default is the value the server assumes when the field is omitted, as
+	// encoding/json would decode it: numbers are float64, arrays
+	// []interface{}, objects map[string]interface{}. nil if there is none.
+	Default interface{}
+}
@@ -13,6 +13,7 @@ type UnionField struct {
 	Type        string // OpenAPI type
 	MinVersion  string // First minor version with this field (e.g., "2.2")
 	MaxVersion  string // Empty if still present, else last version (e.g., "2.2")
+	RenamedFrom string // "Schema.field" this field was renamed from, if any
 }
 
 // UnionDescriptions maps schema.field to merged metadata.
@@ -17260,6 +17261,11 @@ func (f *UnionField) FormatMarkdown() string {
 		sb.WriteString(f.MaxVersion)
 		sb.WriteString("**")
 	}
+	if f.RenamedFrom != "" {
+		sb.WriteString("\n\n*Previously ")
+		sb.WriteString(f.RenamedFrom)
+		sb.WriteString("*")
+	}
 
 	return sb.String()
 }
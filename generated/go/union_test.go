@@ -0,0 +1,45 @@
+//go:build checkmk_all || checkmk_v2_4_0
+
+package types_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	p17 "github.com/BlackMesaLTD/checkmk-api-spec/generated/go/v2_4_0/p17"
+)
+
+func TestUnionRoundTrip(t *testing.T) {
+	var u p17.AcknowledgeHostRelatedProblem
+	if err := u.FromAcknowledgeHostGroupProblem(p17.AcknowledgeHostGroupProblem{
+		Comment:       "expected",
+		HostgroupName: "linux",
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := json.Marshal(u)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var decoded p17.AcknowledgeHostRelatedProblem
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatal(err)
+	}
+
+	if d, err := decoded.Discriminator(); err != nil || d != "hostgroup" {
+		t.Errorf("Discriminator() = %q, %v, want hostgroup", d, err)
+	}
+	value, err := decoded.ValueByDiscriminator()
+	if err != nil {
+		t.Fatal(err)
+	}
+	group, ok := value.(p17.AcknowledgeHostGroupProblem)
+	if !ok || group.HostgroupName != "linux" || group.Comment != "expected" {
+		t.Errorf("ValueByDiscriminator() = %#v", value)
+	}
+
+	if err := json.Unmarshal([]byte(`{"acknowledge_type": "unknown"}`), &decoded); err == nil {
+		t.Error("Unmarshal() succeeded for an unknown discriminator")
+	}
+}
@@ -2,13 +2,15 @@
 
 // Code generated by openapi-gen from CheckMK 1.0. DO NOT EDIT.
 //
-// Field metadata including descriptions and types
+// Field metadata including descriptions, types and constraints
 //
 // Source: metadata.gen.go
 // Schemas: All (unfiltered)
 
 package p1
 
+import "github.com/BlackMesaLTD/checkmk-api-spec/generated/go/openapi"
+
 // FieldDescriptions maps schema names to field descriptions.
 // Use for generating documentation or user-facing error messages.
 var FieldDescriptions = map[string]map[string]string{
@@ -1624,7 +1626,7 @@ var FieldTypes = map[string]map[string]string{
 		"comment": "string",
 		"notify": "boolean",
 		"persistent": "boolean",
-		"query": "",
+		"query": "object",
 		"sticky": "boolean",
 	},
 	"AcknowledgeServiceGroupProblem": {
@@ -1640,7 +1642,7 @@ var FieldTypes = map[string]map[string]string{
 		"comment": "string",
 		"notify": "boolean",
 		"persistent": "boolean",
-		"query": "",
+		"query": "object",
 		"sticky": "boolean",
 	},
 	"AcknowledgeSpecificServiceProblem": {
@@ -1673,7 +1675,7 @@ var FieldTypes = map[string]map[string]string{
 	},
 	"ActivationRunResponse": {
 		"domainType": "",
-		"extensions": "",
+		"extensions": "object",
 		"id": "string",
 		"links": "array",
 		"members": "object",
@@ -1731,7 +1733,7 @@ var FieldTypes = map[string]map[string]string{
 	},
 	"AuxTagResponse": {
 		"domainType": "",
-		"extensions": "",
+		"extensions": "object",
 		"id": "string",
 		"links": "array",
 		"members": "object",
@@ -1752,13 +1754,13 @@ var FieldTypes = map[string]map[string]string{
 		"use_hard_states": "boolean",
 	},
 	"BIAggregationEndpoint": {
-		"aggregation_visualization": "",
+		"aggregation_visualization": "object",
 		"comment": "string",
-		"computation_options": "",
+		"computation_options": "object",
 		"customer": "string",
-		"groups": "",
+		"groups": "object",
 		"id": "string",
-		"node": "",
+		"node": "object",
 		"pack_id": "string",
 	},
 	"BIAggregationFunctionBest": {
@@ -1802,7 +1804,7 @@ var FieldTypes = map[string]map[string]string{
 		"type": "",
 	},
 	"BICallARuleAction": {
-		"params": "",
+		"params": "object",
 		"rule_id": "string",
 		"type": "",
 	},
@@ -1831,8 +1833,8 @@ var FieldTypes = map[string]map[string]string{
 		"type": "",
 	},
 	"BINodeGenerator": {
-		"action": "",
-		"search": "",
+		"action": "object",
+		"search": "object",
 	},
 	"BINodeVisBlockStyle": {
 		"style_config": "",
@@ -1876,14 +1878,14 @@ var FieldTypes = map[string]map[string]string{
 		"disabled": "boolean",
 	},
 	"BIRuleEndpoint": {
-		"aggregation_function": "",
-		"computation_options": "",
+		"aggregation_function": "object",
+		"computation_options": "object",
 		"id": "string",
-		"node_visualization": "",
+		"node_visualization": "object",
 		"nodes": "array",
 		"pack_id": "string",
-		"params": "",
-		"properties": "",
+		"params": "object",
+		"properties": "object",
 	},
 	"BIRuleProperties": {
 		"comment": "string",
@@ -1911,18 +1913,18 @@ var FieldTypes = map[string]map[string]string{
 	},
 	"BackgroundJobStatus": {
 		"active": "boolean",
-		"logs": "",
+		"logs": "object",
 		"state": "string",
 	},
 	"BaseUserAttributes": {
-		"auth_option": "",
+		"auth_option": "object",
 		"authorized_sites": "array",
-		"contact_options": "",
+		"contact_options": "object",
 		"contactgroups": "array",
 		"disable_login": "boolean",
 		"disable_notifications": "object",
 		"fullname": "string",
-		"idle_timeout": "",
+		"idle_timeout": "object",
 		"interface_options": "object",
 		"language": "string",
 		"pager_address": "string",
@@ -1970,7 +1972,7 @@ var FieldTypes = map[string]map[string]string{
 	},
 	"BulkHostActionWithFailedHosts": {
 		"detail": "string",
-		"ext": "",
+		"ext": "object",
 		"fields": "object",
 		"status": "integer",
 		"title": "string",
@@ -2010,7 +2012,7 @@ var FieldTypes = map[string]map[string]string{
 	"ChangeStateWithQuery": {
 		"filter_type": "string",
 		"new_state": "string",
-		"query": "",
+		"query": "object",
 	},
 	"ChangesFields": {
 		"action_name": "string",
@@ -2024,27 +2026,27 @@ var FieldTypes = map[string]map[string]string{
 	},
 	"ChildWith": {
 		"conditions": "object",
-		"host_choice": "",
+		"host_choice": "object",
 	},
 	"ClusterCreateAttribute": {
 		"additional_ipv4addresses": "array",
 		"additional_ipv6addresses": "array",
 		"alias": "string",
-		"contactgroups": "",
+		"contactgroups": "object",
 		"inventory_failed": "boolean",
 		"ipaddress": "string",
 		"ipv6address": "string",
 		"labels": "object",
 		"locked_attributes": "array",
-		"locked_by": "",
+		"locked_by": "object",
 		"management_address": "string",
-		"management_ipmi_credentials": "",
+		"management_ipmi_credentials": "object",
 		"management_protocol": "string",
-		"management_snmp_community": "",
-		"network_scan": "",
+		"management_snmp_community": "object",
+		"network_scan": "object",
 		"parents": "array",
 		"site": "string",
-		"snmp_community": "",
+		"snmp_community": "object",
 		"tag_address_family": "string",
 		"tag_agent": "string",
 		"tag_criticality": "string",
@@ -2072,7 +2074,7 @@ var FieldTypes = map[string]map[string]string{
 	},
 	"CommentObject": {
 		"domainType": "",
-		"extensions": "",
+		"extensions": "object",
 		"id": "string",
 		"links": "array",
 		"members": "object",
@@ -2080,11 +2082,11 @@ var FieldTypes = map[string]map[string]string{
 	},
 	"ConcreteDisabledNotifications": {
 		"disable": "boolean",
-		"timerange": "",
+		"timerange": "object",
 	},
 	"ConcreteHostTagGroup": {
 		"domainType": "",
-		"extensions": "",
+		"extensions": "object",
 		"id": "string",
 		"links": "array",
 		"members": "object",
@@ -2121,7 +2123,7 @@ var FieldTypes = map[string]map[string]string{
 		"replicate_event_console": "boolean",
 		"replicate_extensions": "boolean",
 		"url_of_remote_site": "string",
-		"user_sync": "",
+		"user_sync": "object",
 	},
 	"ConfigurationConnectionAttributes1": {
 		"direct_login_to_web_gui_allowed": "boolean",
@@ -2131,7 +2133,7 @@ var FieldTypes = map[string]map[string]string{
 		"replicate_event_console": "boolean",
 		"replicate_extensions": "boolean",
 		"url_of_remote_site": "string",
-		"user_sync": "",
+		"user_sync": "object",
 	},
 	"ConnectionMode": {
 		"connection_mode": "string",
@@ -2205,14 +2207,14 @@ var FieldTypes = map[string]map[string]string{
 		"comment": "string",
 		"comment_type": "string",
 		"persistent": "boolean",
-		"query": "",
+		"query": "object",
 	},
 	"CreateHostQueryDowntime": {
 		"comment": "string",
 		"downtime_type": "string",
 		"duration": "integer",
 		"end_time": "string",
-		"query": "",
+		"query": "object",
 		"recur": "string",
 		"start_time": "string",
 	},
@@ -2246,14 +2248,14 @@ var FieldTypes = map[string]map[string]string{
 		"comment": "string",
 		"comment_type": "string",
 		"persistent": "boolean",
-		"query": "",
+		"query": "object",
 	},
 	"CreateServiceQueryDowntime": {
 		"comment": "string",
 		"downtime_type": "string",
 		"duration": "integer",
 		"end_time": "string",
-		"query": "",
+		"query": "object",
 		"recur": "string",
 		"start_time": "string",
 	},
@@ -2265,15 +2267,15 @@ var FieldTypes = map[string]map[string]string{
 		"name": "string",
 	},
 	"CreateUser": {
-		"auth_option": "",
+		"auth_option": "object",
 		"authorized_sites": "array",
-		"contact_options": "",
+		"contact_options": "object",
 		"contactgroups": "array",
 		"disable_login": "boolean",
-		"disable_notifications": "",
+		"disable_notifications": "object",
 		"fullname": "string",
-		"idle_timeout": "",
-		"interface_options": "",
+		"idle_timeout": "object",
+		"interface_options": "object",
 		"language": "string",
 		"pager_address": "string",
 		"roles": "array",
@@ -2304,7 +2306,7 @@ var FieldTypes = map[string]map[string]string{
 	},
 	"DeleteCommentsByQuery": {
 		"delete_type": "string",
-		"query": "",
+		"query": "object",
 	},
 	"DeleteDowntimeById": {
 		"delete_type": "string",
@@ -2317,7 +2319,7 @@ var FieldTypes = map[string]map[string]string{
 	},
 	"DeleteDowntimeByQuery": {
 		"delete_type": "string",
-		"query": "",
+		"query": "object",
 	},
 	"DirectMapping": {
 		"hostname": "string",
@@ -2325,7 +2327,7 @@ var FieldTypes = map[string]map[string]string{
 	},
 	"DisabledNotifications": {
 		"disable": "boolean",
-		"timerange": "",
+		"timerange": "object",
 	},
 	"DiscoverServices": {
 		"host_name": "string",
@@ -2336,7 +2338,7 @@ var FieldTypes = map[string]map[string]string{
 	},
 	"DiscoveryBackgroundJobStatusObject": {
 		"domainType": "",
-		"extensions": "",
+		"extensions": "object",
 		"id": "string",
 		"links": "array",
 		"members": "object",
@@ -2377,7 +2379,7 @@ var FieldTypes = map[string]map[string]string{
 	},
 	"ECEventResponse": {
 		"domainType": "",
-		"extensions": "",
+		"extensions": "object",
 		"id": "string",
 		"links": "array",
 		"members": "object",
@@ -2399,7 +2401,7 @@ var FieldTypes = map[string]map[string]string{
 	},
 	"FailedHosts": {
 		"failed_hosts": "object",
-		"succeeded_hosts": "",
+		"succeeded_hosts": "object",
 	},
 	"FilterById": {
 		"event_id": "integer",
@@ -2411,7 +2413,7 @@ var FieldTypes = map[string]map[string]string{
 	},
 	"FilterByQuery": {
 		"filter_type": "string",
-		"query": "",
+		"query": "object",
 	},
 	"FilterParams": {
 		"application": "string",
@@ -2426,10 +2428,10 @@ var FieldTypes = map[string]map[string]string{
 	},
 	"Folder": {
 		"domainType": "",
-		"extensions": "",
+		"extensions": "object",
 		"id": "string",
 		"links": "array",
-		"members": "",
+		"members": "object",
 		"title": "string",
 	},
 	"FolderCollection": {
@@ -2441,15 +2443,15 @@ var FieldTypes = map[string]map[string]string{
 		"value": "array",
 	},
 	"FolderCreateAttribute": {
-		"contactgroups": "",
+		"contactgroups": "object",
 		"labels": "object",
-		"management_ipmi_credentials": "",
+		"management_ipmi_credentials": "object",
 		"management_protocol": "string",
-		"management_snmp_community": "",
-		"network_scan": "",
+		"management_snmp_community": "object",
+		"network_scan": "object",
 		"parents": "array",
 		"site": "string",
-		"snmp_community": "",
+		"snmp_community": "object",
 		"tag_address_family": "string",
 		"tag_agent": "string",
 		"tag_criticality": "string",
@@ -2462,19 +2464,19 @@ var FieldTypes = map[string]map[string]string{
 		"path": "string",
 	},
 	"FolderMembers": {
-		"hosts": "",
-		"move": "",
+		"hosts": "object",
+		"move": "object",
 	},
 	"FolderUpdateAttribute": {
-		"contactgroups": "",
+		"contactgroups": "object",
 		"labels": "object",
-		"management_ipmi_credentials": "",
+		"management_ipmi_credentials": "object",
 		"management_protocol": "string",
-		"management_snmp_community": "",
-		"network_scan": "",
+		"management_snmp_community": "object",
+		"network_scan": "object",
 		"parents": "array",
 		"site": "string",
-		"snmp_community": "",
+		"snmp_community": "object",
 		"tag_address_family": "string",
 		"tag_agent": "string",
 		"tag_criticality": "string",
@@ -2483,17 +2485,17 @@ var FieldTypes = map[string]map[string]string{
 		"tag_snmp_ds": "string",
 	},
 	"FolderViewAttribute": {
-		"contactgroups": "",
+		"contactgroups": "object",
 		"labels": "object",
-		"management_ipmi_credentials": "",
+		"management_ipmi_credentials": "object",
 		"management_protocol": "string",
-		"management_snmp_community": "",
-		"meta_data": "",
-		"network_scan": "",
-		"network_scan_result": "",
+		"management_snmp_community": "object",
+		"meta_data": "object",
+		"network_scan": "object",
+		"network_scan_result": "object",
 		"parents": "array",
 		"site": "string",
-		"snmp_community": "",
+		"snmp_community": "object",
 		"tag_address_family": "string",
 		"tag_agent": "string",
 		"tag_criticality": "string",
@@ -2507,7 +2509,7 @@ var FieldTypes = map[string]map[string]string{
 		"reduce": "string",
 		"service_description": "string",
 		"site": "string",
-		"time_range": "",
+		"time_range": "object",
 		"type": "string",
 	},
 	"GetMetric": {
@@ -2516,13 +2518,13 @@ var FieldTypes = map[string]map[string]string{
 		"reduce": "string",
 		"service_description": "string",
 		"site": "string",
-		"time_range": "",
+		"time_range": "object",
 		"type": "string",
 	},
 	"GraphCollection": {
 		"metrics": "array",
 		"step": "integer",
-		"time_range": "",
+		"time_range": "object",
 	},
 	"Heartbeat": {
 		"interval": "integer",
@@ -2536,17 +2538,17 @@ var FieldTypes = map[string]map[string]string{
 		"type": "",
 	},
 	"HostConditions": {
-		"host_choice": "",
+		"host_choice": "object",
 		"host_folder": "string",
 		"host_labels": "object",
 		"host_tags": "object",
 	},
 	"HostConfig": {
 		"domainType": "",
-		"extensions": "",
+		"extensions": "object",
 		"id": "string",
 		"links": "array",
-		"members": "",
+		"members": "object",
 		"title": "string",
 	},
 	"HostConfigCollection": {
@@ -2572,21 +2574,21 @@ var FieldTypes = map[string]map[string]string{
 		"additional_ipv4addresses": "array",
 		"additional_ipv6addresses": "array",
 		"alias": "string",
-		"contactgroups": "",
+		"contactgroups": "object",
 		"inventory_failed": "boolean",
 		"ipaddress": "string",
 		"ipv6address": "string",
 		"labels": "object",
 		"locked_attributes": "array",
-		"locked_by": "",
+		"locked_by": "object",
 		"management_address": "string",
-		"management_ipmi_credentials": "",
+		"management_ipmi_credentials": "object",
 		"management_protocol": "string",
-		"management_snmp_community": "",
-		"network_scan": "",
+		"management_snmp_community": "object",
+		"network_scan": "object",
 		"parents": "array",
 		"site": "string",
-		"snmp_community": "",
+		"snmp_community": "object",
 		"tag_address_family": "string",
 		"tag_agent": "string",
 		"tag_criticality": "string",
@@ -2597,7 +2599,7 @@ var FieldTypes = map[string]map[string]string{
 	"HostExtensions": {
 		"attributes": "",
 		"cluster_nodes": "array",
-		"effective_attributes": "",
+		"effective_attributes": "object",
 		"folder": "string",
 		"is_cluster": "boolean",
 		"is_offline": "boolean",
@@ -2627,7 +2629,7 @@ var FieldTypes = map[string]map[string]string{
 		"title": "string",
 	},
 	"HostMembers": {
-		"folder_config": "",
+		"folder_config": "object",
 	},
 	"HostOrServiceCondition": {
 		"match_on": "array",
@@ -2659,21 +2661,21 @@ var FieldTypes = map[string]map[string]string{
 		"additional_ipv4addresses": "array",
 		"additional_ipv6addresses": "array",
 		"alias": "string",
-		"contactgroups": "",
+		"contactgroups": "object",
 		"inventory_failed": "boolean",
 		"ipaddress": "string",
 		"ipv6address": "string",
 		"labels": "object",
 		"locked_attributes": "array",
-		"locked_by": "",
+		"locked_by": "object",
 		"management_address": "string",
-		"management_ipmi_credentials": "",
+		"management_ipmi_credentials": "object",
 		"management_protocol": "string",
-		"management_snmp_community": "",
-		"network_scan": "",
+		"management_snmp_community": "object",
+		"network_scan": "object",
 		"parents": "array",
 		"site": "string",
-		"snmp_community": "",
+		"snmp_community": "object",
 		"tag_address_family": "string",
 		"tag_agent": "string",
 		"tag_criticality": "string",
@@ -2685,23 +2687,23 @@ var FieldTypes = map[string]map[string]string{
 		"additional_ipv4addresses": "array",
 		"additional_ipv6addresses": "array",
 		"alias": "string",
-		"contactgroups": "",
+		"contactgroups": "object",
 		"inventory_failed": "boolean",
 		"ipaddress": "string",
 		"ipv6address": "string",
 		"labels": "object",
 		"locked_attributes": "array",
-		"locked_by": "",
+		"locked_by": "object",
 		"management_address": "string",
-		"management_ipmi_credentials": "",
+		"management_ipmi_credentials": "object",
 		"management_protocol": "string",
-		"management_snmp_community": "",
-		"meta_data": "",
-		"network_scan": "",
-		"network_scan_result": "",
+		"management_snmp_community": "object",
+		"meta_data": "object",
+		"network_scan": "object",
+		"network_scan_result": "object",
 		"parents": "array",
 		"site": "string",
-		"snmp_community": "",
+		"snmp_community": "object",
 		"tag_address_family": "string",
 		"tag_agent": "string",
 		"tag_criticality": "string",
@@ -2759,9 +2761,9 @@ var FieldTypes = map[string]map[string]string{
 		"title": "string",
 	},
 	"InputRuleObject": {
-		"conditions": "",
+		"conditions": "object",
 		"folder": "string",
-		"properties": "",
+		"properties": "object",
 		"ruleset": "string",
 		"value_raw": "string",
 	},
@@ -2850,7 +2852,7 @@ var FieldTypes = map[string]map[string]string{
 		"state": "string",
 	},
 	"NotExpr": {
-		"expr": "",
+		"expr": "object",
 		"op": "string",
 	},
 	"ObjectActionMember": {
@@ -2902,7 +2904,7 @@ var FieldTypes = map[string]map[string]string{
 	},
 	"PasswordObject": {
 		"domainType": "",
-		"extensions": "",
+		"extensions": "object",
 		"id": "string",
 		"links": "array",
 		"members": "object",
@@ -2910,14 +2912,14 @@ var FieldTypes = map[string]map[string]string{
 	},
 	"ProxyAttributes": {
 		"global_settings": "boolean",
-		"params": "",
-		"tcp": "",
+		"params": "object",
+		"tcp": "object",
 		"use_livestatus_daemon": "string",
 	},
 	"ProxyAttributes1": {
 		"global_settings": "boolean",
-		"params": "",
-		"tcp": "",
+		"params": "object",
+		"tcp": "object",
 		"use_livestatus_daemon": "string",
 	},
 	"ProxyParams": {
@@ -2925,7 +2927,7 @@ var FieldTypes = map[string]map[string]string{
 		"channel_timeout": "number",
 		"channels": "integer",
 		"connect_retry": "number",
-		"heartbeat": "",
+		"heartbeat": "object",
 		"query_timeout": "number",
 	},
 	"ProxyParams1": {
@@ -2933,7 +2935,7 @@ var FieldTypes = map[string]map[string]string{
 		"channel_timeout": "number",
 		"channels": "integer",
 		"connect_retry": "number",
-		"heartbeat": "",
+		"heartbeat": "object",
 		"query_timeout": "number",
 	},
 	"ProxyTcp": {
@@ -2966,22 +2968,22 @@ var FieldTypes = map[string]map[string]string{
 	},
 	"RuleConditions": {
 		"host_labels": "array",
-		"host_name": "",
+		"host_name": "object",
 		"host_tags": "array",
-		"service_description": "",
+		"service_description": "object",
 		"service_labels": "array",
 	},
 	"RuleExtensions": {
-		"conditions": "",
+		"conditions": "object",
 		"folder": "string",
 		"folder_index": "integer",
-		"properties": "",
+		"properties": "object",
 		"ruleset": "string",
 		"value_raw": "string",
 	},
 	"RuleObject": {
 		"domainType": "",
-		"extensions": "",
+		"extensions": "object",
 		"id": "string",
 		"links": "array",
 		"members": "object",
@@ -3008,7 +3010,7 @@ var FieldTypes = map[string]map[string]string{
 	},
 	"RulesetObject": {
 		"domainType": "",
-		"extensions": "",
+		"extensions": "object",
 		"id": "string",
 		"links": "array",
 		"members": "object",
@@ -3037,7 +3039,7 @@ var FieldTypes = map[string]map[string]string{
 		"type": "",
 	},
 	"ServiceConditions": {
-		"host_choice": "",
+		"host_choice": "object",
 		"host_folder": "string",
 		"host_labels": "object",
 		"host_tags": "object",
@@ -3087,14 +3089,14 @@ var FieldTypes = map[string]map[string]string{
 		"status_connection": "object",
 	},
 	"SiteConnectionRequestCreate": {
-		"site_config": "",
+		"site_config": "object",
 	},
 	"SiteConnectionRequestUpdate": {
-		"site_config": "",
+		"site_config": "object",
 	},
 	"SiteConnectionResponse": {
 		"domainType": "",
-		"extensions": "",
+		"extensions": "object",
 		"id": "string",
 		"links": "array",
 		"members": "object",
@@ -3143,20 +3145,20 @@ var FieldTypes = map[string]map[string]string{
 	},
 	"StatusConnectionAttributes": {
 		"connect_timeout": "integer",
-		"connection": "",
+		"connection": "object",
 		"disable_in_status_gui": "boolean",
 		"persistent_connection": "boolean",
-		"proxy": "",
-		"status_host": "",
+		"proxy": "object",
+		"status_host": "object",
 		"url_prefix": "string",
 	},
 	"StatusConnectionAttributes1": {
 		"connect_timeout": "integer",
-		"connection": "",
+		"connection": "object",
 		"disable_in_status_gui": "boolean",
 		"persistent_connection": "boolean",
-		"proxy": "",
-		"status_host": "",
+		"proxy": "object",
+		"status_host": "object",
 		"url_prefix": "string",
 	},
 	"StatusHostAttributes": {
@@ -3198,7 +3200,7 @@ var FieldTypes = map[string]map[string]string{
 	},
 	"TimePeriodResponse": {
 		"domainType": "",
-		"extensions": "",
+		"extensions": "object",
 		"id": "string",
 		"links": "array",
 		"members": "object",
@@ -3253,7 +3255,7 @@ var FieldTypes = map[string]map[string]string{
 		"change_contact": "string",
 		"filter_type": "string",
 		"phase": "string",
-		"query": "",
+		"query": "object",
 	},
 	"UpdateContactGroup": {
 		"attributes": "object",
@@ -3315,15 +3317,15 @@ var FieldTypes = map[string]map[string]string{
 		"exceptions": "array",
 	},
 	"UpdateUser": {
-		"auth_option": "",
+		"auth_option": "object",
 		"authorized_sites": "array",
-		"contact_options": "",
+		"contact_options": "object",
 		"contactgroups": "array",
 		"disable_login": "boolean",
-		"disable_notifications": "",
+		"disable_notifications": "object",
 		"fullname": "string",
-		"idle_timeout": "",
-		"interface_options": "",
+		"idle_timeout": "object",
+		"interface_options": "object",
 		"language": "string",
 		"pager_address": "string",
 		"roles": "array",
@@ -3386,7 +3388,7 @@ var FieldTypes = map[string]map[string]string{
 	},
 	"UserRoleObject": {
 		"domainType": "",
-		"extensions": "",
+		"extensions": "object",
 		"id": "string",
 		"links": "array",
 		"members": "object",
@@ -3411,6 +3413,613 @@ var FieldTypes = map[string]map[string]string{
 	},
 }
 
+// FieldConstraints maps schema names to the validation keywords and
+// defaults of their fields. Fields without any are omitted.
+// Use GetFieldConstraints() for safe lookup.
+var FieldConstraints = map[string]map[string]*openapi.FieldConstraints{
+	"AcknowledgeHostGroupProblem": {
+		"notify": {Default: true},
+		"persistent": {Default: false},
+		"sticky": {Default: true},
+	},
+	"AcknowledgeHostProblem": {
+		"host_name": {Pattern: "^[-0-9a-zA-Z_.]+$"},
+		"notify": {Default: true},
+		"persistent": {Default: false},
+		"sticky": {Default: true},
+	},
+	"AcknowledgeHostQueryProblem": {
+		"notify": {Default: true},
+		"persistent": {Default: false},
+		"sticky": {Default: true},
+	},
+	"AcknowledgeServiceGroupProblem": {
+		"notify": {Default: true},
+		"persistent": {Default: false},
+		"sticky": {Default: true},
+	},
+	"AcknowledgeServiceQueryProblem": {
+		"notify": {Default: true},
+		"persistent": {Default: false},
+		"sticky": {Default: true},
+	},
+	"AcknowledgeSpecificServiceProblem": {
+		"host_name": {Pattern: "^[-0-9a-zA-Z_.]+$"},
+		"notify": {Default: true},
+		"persistent": {Default: false},
+		"sticky": {Default: true},
+	},
+	"ActivateChanges": {
+		"force_foreign_changes": {Default: false},
+		"redirect": {Default: false},
+	},
+	"ActivationExtensionFields": {
+		"time_started": {Format: "date-time"},
+	},
+	"ActivationRunCollection": {
+		"domainType": {Default: "activation_run"},
+	},
+	"ActivationRunResponse": {
+		"domainType": {Default: "activation_run"},
+		"id": {Format: "uuid"},
+	},
+	"AuthPassword": {
+		"enforce_password_change": {Default: false},
+		"password": {MinLength: openapi.Int(1)},
+	},
+	"AuthUpdatePassword": {
+		"password": {MinLength: openapi.Int(1)},
+	},
+	"AuxTagAttrsCreate": {
+		"aux_tag_id": {Pattern: "^[-a-z0-9A-Z_]+$"},
+		"help": {Default: ""},
+		"title": {MinLength: openapi.Int(1)},
+		"topic": {MinLength: openapi.Int(1)},
+	},
+	"AuxTagAttrsResponse": {
+		"topic": {MinLength: openapi.Int(1)},
+	},
+	"AuxTagAttrsUpdate": {
+		"title": {MinLength: openapi.Int(1)},
+		"topic": {MinLength: openapi.Int(1)},
+	},
+	"AuxTagResponse": {
+		"domainType": {Default: "aux_tag"},
+	},
+	"AuxTagResponseCollection": {
+		"domainType": {Default: "aux_tag"},
+		"id": {Default: "all"},
+	},
+	"BIAggregationFunctionBest": {
+		"type": {Default: "best"},
+	},
+	"BIAggregationFunctionCountOK": {
+		"type": {Default: "count_ok"},
+	},
+	"BIAggregationFunctionWorst": {
+		"type": {Default: "worst"},
+	},
+	"BIAllHostsChoice": {
+		"type": {Default: "all_hosts"},
+	},
+	"BICallARuleAction": {
+		"type": {Default: "call_a_rule"},
+	},
+	"BIEmptySearch": {
+		"type": {Default: "empty"},
+	},
+	"BIFixedArgumentsSearch": {
+		"type": {Default: "fixed_arguments"},
+	},
+	"BIHostAliasRegexChoice": {
+		"type": {Default: "host_alias_regex"},
+	},
+	"BIHostNameRegexChoice": {
+		"type": {Default: "host_name_regex"},
+	},
+	"BIHostSearch": {
+		"type": {Default: "host_search"},
+	},
+	"BINodeVisBlockStyle": {
+		"style_config": {Default: map[string]interface{}{}},
+		"type": {Default: "block"},
+	},
+	"BINodeVisForceStyle": {
+		"style_config": {Default: map[string]interface{}{}},
+		"type": {Default: "force"},
+	},
+	"BINodeVisHierarchyStyle": {
+		"type": {Default: "hierarchy"},
+	},
+	"BINodeVisNoneStyle": {
+		"style_config": {Default: map[string]interface{}{}},
+		"type": {Default: "none"},
+	},
+	"BINodeVisRadialStyle": {
+		"type": {Default: "radial"},
+	},
+	"BIServiceSearch": {
+		"type": {Default: "service_search"},
+	},
+	"BIStateOfHostAction": {
+		"type": {Default: "state_of_host"},
+	},
+	"BIStateOfRemainingServicesAction": {
+		"type": {Default: "state_of_remaining_services"},
+	},
+	"BIStateOfServiceAction": {
+		"type": {Default: "state_of_service"},
+	},
+	"BinaryExpr": {
+		"left": {Pattern: "^([a-z]+\\.)?[_a-z]+$"},
+	},
+	"BulkDiscovery": {
+		"bulk_size": {Default: float64(10)},
+		"do_full_scan": {Default: true},
+		"ignore_errors": {Default: true},
+		"mode": {Default: "new"},
+	},
+	"ChangesFields": {
+		"id": {Format: "uuid"},
+		"time": {Format: "date-time"},
+	},
+	"Child": {
+		"type": {Default: "child"},
+	},
+	"CommentCollection": {
+		"domainType": {Default: "comment"},
+		"id": {Default: "all"},
+	},
+	"CommentObject": {
+		"domainType": {Default: "comment"},
+	},
+	"ConcreteHostTagGroup": {
+		"domainType": {Default: "host_tag_group"},
+	},
+	"ConcreteTimePeriodException": {
+		"date": {Format: "date"},
+	},
+	"ConcreteTimeRange": {
+		"end": {Format: "time"},
+		"start": {Format: "time"},
+	},
+	"ContactGroup": {
+		"domainType": {Default: "contact_group"},
+	},
+	"ContactGroupCollection": {
+		"domainType": {Default: "contact_group_config"},
+		"id": {Default: "all"},
+	},
+	"ContactGroupObject": {
+		"domainType": {Default: "contact_group_config"},
+	},
+	"CreateClusterHost": {
+		"folder": {Pattern: "^(?:(?:[~\\\\\\/]|(?:[~\\\\\\/][-_ a-zA-Z0-9.]+)+[~\\\\\\/]?)|[0-9a-fA-F]{32})$"},
+		"host_name": {Pattern: "^[-0-9a-zA-Z_.]+$"},
+	},
+	"CreateFolder": {
+		"name": {Pattern: "^[-\\w]*$", MinLength: openapi.Int(1)},
+		"parent": {Pattern: "^(?:(?:[~\\\\\\/]|(?:[~\\\\\\/][-_ a-zA-Z0-9.]+)+[~\\\\\\/]?)|[0-9a-fA-F]{32})$"},
+	},
+	"CreateHost": {
+		"folder": {Pattern: "^(?:(?:[~\\\\\\/]|(?:[~\\\\\\/][-_ a-zA-Z0-9.]+)+[~\\\\\\/]?)|[0-9a-fA-F]{32})$"},
+		"host_name": {Pattern: "^[-0-9a-zA-Z_.]+$"},
+	},
+	"CreateHostComment": {
+		"host_name": {Pattern: "^[-0-9a-zA-Z_.]+$"},
+		"persistent": {Default: false},
+	},
+	"CreateHostDowntime": {
+		"duration": {Default: float64(0)},
+		"end_time": {Format: "iso8601"},
+		"host_name": {Pattern: "^[-0-9a-zA-Z_.]+$"},
+		"recur": {Default: "fixed"},
+		"start_time": {Format: "iso8601"},
+	},
+	"CreateHostGroupDowntime": {
+		"duration": {Default: float64(0)},
+		"end_time": {Format: "iso8601"},
+		"recur": {Default: "fixed"},
+		"start_time": {Format: "iso8601"},
+	},
+	"CreateHostQueryComment": {
+		"persistent": {Default: false},
+	},
+	"CreateHostQueryDowntime": {
+		"duration": {Default: float64(0)},
+		"end_time": {Format: "iso8601"},
+		"recur": {Default: "fixed"},
+		"start_time": {Format: "iso8601"},
+	},
+	"CreateServiceComment": {
+		"host_name": {Pattern: "^[-0-9a-zA-Z_.]+$"},
+		"persistent": {Default: false},
+	},
+	"CreateServiceDowntime": {
+		"duration": {Default: float64(0)},
+		"end_time": {Format: "iso8601"},
+		"host_name": {Pattern: "^[-0-9a-zA-Z_.]+$"},
+		"recur": {Default: "fixed"},
+		"start_time": {Format: "iso8601"},
+	},
+	"CreateServiceGroupDowntime": {
+		"duration": {Default: float64(0)},
+		"end_time": {Format: "iso8601"},
+		"recur": {Default: "fixed"},
+		"start_time": {Format: "iso8601"},
+	},
+	"CreateServiceQueryComment": {
+		"persistent": {Default: false},
+	},
+	"CreateServiceQueryDowntime": {
+		"duration": {Default: float64(0)},
+		"end_time": {Format: "iso8601"},
+		"recur": {Default: "fixed"},
+		"start_time": {Format: "iso8601"},
+	},
+	"CreateTimePeriod": {
+		"name": {Pattern: "^[-a-z0-9A-Z_]+$"},
+	},
+	"CreateUser": {
+		"authorized_sites": {Default: []interface{}{"all"}},
+		"disable_login": {Default: false},
+		"interface_options": {Default: map[string]interface{}{"interface_theme": "default", "mega_menu_icons": "topic", "navigation_bar_icons": "hide", "show_mode": "default", "sidebar_position": "right"}},
+		"pager_address": {Default: ""},
+	},
+	"CustomTimeRange": {
+		"end_time": {Format: "iso8601"},
+		"start_time": {Format: "iso8601"},
+	},
+	"DateTimeRange": {
+		"end_time": {Format: "iso8601"},
+		"start_time": {Format: "date-time"},
+	},
+	"DeleteCommentsByParams": {
+		"host_name": {Pattern: "^[-0-9a-zA-Z_.]+$"},
+	},
+	"DeleteDowntimeByName": {
+		"host_name": {Pattern: "^[-0-9a-zA-Z_.]+$"},
+	},
+	"DiscoverServices": {
+		"host_name": {Pattern: "^[-0-9a-zA-Z_.]+$"},
+		"mode": {Default: "fix_all"},
+	},
+	"DiscoverServicesDeprecated": {
+		"mode": {Default: "fix_all"},
+	},
+	"DiscoveryBackgroundJobStatusObject": {
+		"domainType": {Default: "discovery_run"},
+	},
+	"DomainObjectCollection": {
+		"id": {Default: "all"},
+	},
+	"ECEventAttributes": {
+		"first": {Format: "date-time"},
+		"host": {Pattern: "^[-0-9a-zA-Z_.]+$"},
+		"last": {Format: "date-time"},
+	},
+	"ECEventResponse": {
+		"domainType": {Default: "event_console"},
+	},
+	"EventConsoleResponseCollection": {
+		"domainType": {Default: "event_console"},
+		"id": {Default: "all"},
+	},
+	"FilterParams": {
+		"host": {Pattern: "^[-0-9a-zA-Z_.]+$"},
+	},
+	"FilterParamsUpdateAndAcknowledge": {
+		"host": {Pattern: "^[-0-9a-zA-Z_.]+$"},
+	},
+	"Folder": {
+		"domainType": {Default: "folder_config"},
+	},
+	"FolderCollection": {
+		"domainType": {Default: "folder_config"},
+		"id": {Default: "all"},
+	},
+	"GetGraph": {
+		"graph_id": {Pattern: "^\\w[_\\-\\w\\d]*$"},
+		"host_name": {Pattern: "^[-0-9a-zA-Z_.]+$"},
+		"reduce": {Default: "average"},
+	},
+	"GetMetric": {
+		"host_name": {Pattern: "^[-0-9a-zA-Z_.]+$"},
+		"metric_id": {Pattern: "^\\w[_\\-\\w\\d]*$"},
+		"reduce": {Default: "average"},
+	},
+	"Heartbeat": {
+		"interval": {Minimum: openapi.Float(1)},
+	},
+	"Host": {
+		"type": {Default: "host"},
+	},
+	"HostConfig": {
+		"domainType": {Default: "host_config"},
+	},
+	"HostConfigCollection": {
+		"domainType": {Default: "host_config"},
+		"id": {Default: "all"},
+	},
+	"HostContactGroup": {
+		"recurse_perms": {Default: false},
+		"recurse_use": {Default: false},
+		"use": {Default: false},
+		"use_for_services": {Default: false},
+	},
+	"HostExtensions": {
+		"folder": {Pattern: "^(?:(?:[~\\\\\\/]|(?:[~\\\\\\/][-_ a-zA-Z0-9.]+)+[~\\\\\\/]?)|[0-9a-fA-F]{32})$"},
+	},
+	"HostGroup": {
+		"domainType": {Default: "host_group"},
+	},
+	"HostGroupCollection": {
+		"domainType": {Default: "host_group_config"},
+		"id": {Default: "all"},
+	},
+	"HostGroupObject": {
+		"domainType": {Default: "host_group_config"},
+	},
+	"HostTagGroupCollection": {
+		"domainType": {Default: "host_tag_group"},
+		"id": {Default: "all"},
+	},
+	"IPAddressRange": {
+		"type": {Default: "ip_range"},
+	},
+	"IPAddresses": {
+		"type": {Default: "ip_list"},
+	},
+	"IPNetwork": {
+		"type": {Default: "ip_network"},
+	},
+	"IPRegexp": {
+		"type": {Default: "ip_regex_list"},
+	},
+	"IdleOption": {
+		"duration": {Default: float64(3600)},
+	},
+	"InputContactGroup": {
+		"name": {Pattern: "^[-a-z0-9A-Z_\\.]*$"},
+	},
+	"InputHostGroup": {
+		"name": {Pattern: "^[-a-z0-9A-Z_\\.]*$"},
+	},
+	"InputHostTagGroup": {
+		"help": {Default: ""},
+		"ident": {Pattern: "^[^\\d\\W][-\\w]*$"},
+		"tags": {MinLength: openapi.Int(1)},
+	},
+	"InputPassword": {
+		"comment": {Default: ""},
+		"documentation_url": {Default: ""},
+		"ident": {Pattern: "^[^\\d\\W][-\\w]*$"},
+		"password": {MinLength: openapi.Int(1)},
+	},
+	"InputRuleObject": {
+		"folder": {Pattern: "^(?:(?:[~\\\\\\/]|(?:[~\\\\\\/][-_ a-zA-Z0-9.]+)+[~\\\\\\/]?)|[0-9a-fA-F]{32})$"},
+	},
+	"InputServiceGroup": {
+		"name": {Pattern: "^[-a-z0-9A-Z_\\.]*$"},
+	},
+	"Link": {
+		"domainType": {Default: "link"},
+	},
+	"LinkHostUUID": {
+		"uuid": {Format: "uuid"},
+	},
+	"MetaData": {
+		"created_at": {Format: "date-time"},
+		"updated_at": {Format: "date-time"},
+	},
+	"MoveFolder": {
+		"destination": {Pattern: "^(?:(?:[~\\\\\\/]|(?:[~\\\\\\/][-_ a-zA-Z0-9.]+)+[~\\\\\\/]?)|[0-9a-fA-F]{32})$"},
+	},
+	"MoveHost": {
+		"target_folder": {Pattern: "^(?:(?:[~\\\\\\/]|(?:[~\\\\\\/][-_ a-zA-Z0-9.]+)+[~\\\\\\/]?)|[0-9a-fA-F]{32})$"},
+	},
+	"MoveToFolder": {
+		"folder": {Pattern: "^(?:(?:[~\\\\\\/]|(?:[~\\\\\\/][-_ a-zA-Z0-9.]+)+[~\\\\\\/]?)|[0-9a-fA-F]{32})$"},
+	},
+	"NetworkScan": {
+		"max_parallel_pings": {Minimum: openapi.Float(1), Maximum: openapi.Float(200), Default: float64(100)},
+		"scan_interval": {Minimum: openapi.Float(3600), Default: float64(86400)},
+		"set_ip_address": {Default: true},
+	},
+	"NetworkScanResult": {
+		"end": {Format: "date-time"},
+		"start": {Format: "date-time"},
+	},
+	"ObjectActionMember": {
+		"memberType": {Default: "action"},
+	},
+	"ObjectCollectionMember": {
+		"memberType": {Default: "collection"},
+	},
+	"Parent": {
+		"type": {Default: "parent"},
+	},
+	"PasswordCollection": {
+		"domainType": {Default: "password"},
+		"id": {Default: "all"},
+	},
+	"PasswordObject": {
+		"domainType": {Default: "password"},
+	},
+	"ProxyParams": {
+		"channels": {Minimum: openapi.Float(2), Maximum: openapi.Float(50)},
+	},
+	"ProxyTcp": {
+		"port": {Minimum: openapi.Float(1), Maximum: openapi.Float(65535)},
+	},
+	"ProxyTcp1": {
+		"port": {Minimum: openapi.Float(1), Maximum: openapi.Float(65535)},
+	},
+	"RegexpRewrites": {
+		"replace_with": {MaxLength: openapi.Int(30)},
+		"search": {MaxLength: openapi.Int(30)},
+	},
+	"RegisterHost": {
+		"uuid": {Format: "uuid"},
+	},
+	"RenameHost": {
+		"new_name": {Pattern: "^[-0-9a-zA-Z_.]+$"},
+	},
+	"RuleCollection": {
+		"domainType": {Default: "rule"},
+		"id": {Default: "all"},
+	},
+	"RuleExtensions": {
+		"folder": {Pattern: "^(?:(?:[~\\\\\\/]|(?:[~\\\\\\/][-_ a-zA-Z0-9.]+)+[~\\\\\\/]?)|[0-9a-fA-F]{32})$"},
+	},
+	"RuleObject": {
+		"domainType": {Default: "rule"},
+	},
+	"RuleProperties": {
+		"disabled": {Default: false},
+		"documentation_url": {Format: "url"},
+	},
+	"RulesetCollection": {
+		"domainType": {Default: "ruleset"},
+		"id": {Default: "all"},
+	},
+	"RulesetExtensions": {
+		"folder": {Pattern: "^(?:(?:[~\\\\\\/]|(?:[~\\\\\\/][-_ a-zA-Z0-9.]+)+[~\\\\\\/]?)|[0-9a-fA-F]{32})$"},
+	},
+	"RulesetObject": {
+		"domainType": {Default: "ruleset"},
+	},
+	"SNMPCommunity": {
+		"type": {Default: "v1_v2_community"},
+	},
+	"SNMPv3AuthNoPrivacy": {
+		"auth_password": {MinLength: openapi.Int(8)},
+		"type": {Default: "authNoPriv"},
+	},
+	"SNMPv3AuthPrivacy": {
+		"auth_password": {MinLength: openapi.Int(8)},
+		"privacy_password": {MinLength: openapi.Int(8)},
+		"type": {Default: "authPriv"},
+	},
+	"SNMPv3NoAuthNoPrivacy": {
+		"type": {Default: "noAuthNoPriv"},
+	},
+	"ServiceGroup": {
+		"domainType": {Default: "service_group"},
+	},
+	"ServiceGroupCollection": {
+		"domainType": {Default: "service_group_config"},
+		"id": {Default: "all"},
+	},
+	"ServiceGroupObject": {
+		"domainType": {Default: "service_group_config"},
+	},
+	"SiteConnectionResponse": {
+		"domainType": {Default: "site_connection"},
+	},
+	"SiteConnectionResponseCollection": {
+		"domainType": {Default: "site_connection"},
+		"id": {Default: "all"},
+	},
+	"SocketIP4": {
+		"port": {Minimum: openapi.Float(1), Maximum: openapi.Float(65535)},
+	},
+	"SocketIP6": {
+		"port": {Minimum: openapi.Float(1), Maximum: openapi.Float(65535)},
+	},
+	"StatusHostAttributesSet": {
+		"host": {Pattern: "^[-0-9a-zA-Z_.]+$"},
+	},
+	"TimeAllowedRange": {
+		"end": {Pattern: "^\\d\\d\\:\\d\\d\\(:\\d\\d)?$"},
+		"start": {Pattern: "^\\d\\d\\:\\d\\d\\(:\\d\\d)?$"},
+	},
+	"TimePeriodException": {
+		"date": {Format: "date"},
+		"time_ranges": {Default: []interface{}{}},
+	},
+	"TimePeriodResponse": {
+		"domainType": {Default: "time_period"},
+	},
+	"TimePeriodResponseCollection": {
+		"domainType": {Default: "time_period"},
+		"id": {Default: "all"},
+	},
+	"TimeRange": {
+		"end": {Format: "date-time"},
+		"start": {Format: "date-time"},
+	},
+	"TimeRange1": {
+		"end": {Format: "time"},
+		"start": {Format: "time"},
+	},
+	"TimeRangeActive": {
+		"day": {Default: "all"},
+		"time_ranges": {Default: []interface{}{map[string]interface{}{"end": "23:59:00", "start": "00:00:00"}}},
+	},
+	"TranslateNames": {
+		"convert_case": {Default: "nop"},
+	},
+	"UpdateAndAcknowledgeEvent": {
+		"phase": {Default: "ack"},
+	},
+	"UpdateAndAcknowledgeFilter": {
+		"phase": {Default: "ack"},
+	},
+	"UpdateAndAcknowledgeWithParams": {
+		"phase": {Default: "ack"},
+	},
+	"UpdateAndAcknowledgeWithQuery": {
+		"phase": {Default: "ack"},
+	},
+	"UpdateFolderEntry": {
+		"folder": {Pattern: "^(?:(?:[~\\\\\\/]|(?:[~\\\\\\/][-_ a-zA-Z0-9.]+)+[~\\\\\\/]?)|[0-9a-fA-F]{32})$"},
+	},
+	"UpdateHostEntry": {
+		"host_name": {Pattern: "^[-0-9a-zA-Z_.]+$"},
+	},
+	"UpdateHostTagGroup": {
+		"repair": {Default: false},
+		"tags": {MinLength: openapi.Int(1)},
+	},
+	"UpdatePassword": {
+		"password": {MinLength: openapi.Int(1)},
+	},
+	"UserCollection": {
+		"domainType": {Default: "user_config"},
+		"id": {Default: "all"},
+	},
+	"UserContactOption": {
+		"fallback_contact": {Default: false},
+	},
+	"UserInterfaceAttributes": {
+		"interface_theme": {Default: "default"},
+		"mega_menu_icons": {Default: "topic"},
+		"navigation_bar_icons": {Default: "hide"},
+		"show_mode": {Default: "default"},
+		"sidebar_position": {Default: "right"},
+	},
+	"UserObject": {
+		"domainType": {Default: "user_config"},
+	},
+	"UserRoleCollection": {
+		"domainType": {Default: "user_role"},
+		"id": {Default: "all"},
+	},
+	"UserRoleObject": {
+		"domainType": {Default: "user_role"},
+	},
+}
+
+// GetFieldConstraints returns the validation keywords and default of a field.
+// Returns nil if the field has none or is not found.
+func GetFieldConstraints(schemaName, fieldName string) *openapi.FieldConstraints {
+	if fields, ok := FieldConstraints[schemaName]; ok {
+		return fields[fieldName]
+	}
+	return nil
+}
+
 // GetFieldDescription returns the description for a field in a schema.
 // Returns empty string if not found.
 func GetFieldDescription(schemaName, fieldName string) string {
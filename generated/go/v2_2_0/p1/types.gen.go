@@ -9,6 +9,11 @@
 
 package p1
 
+import (
+	"encoding/json"
+	"fmt"
+)
+
 // AcknowledgeHostGroupProblem represents a CheckMK API type.
 type AcknowledgeHostGroupProblem struct {
 	// The acknowledge host selection type.
@@ -69,14 +74,115 @@ type AcknowledgeHostQueryProblem struct {
 	Persistent bool `json:"persistent,omitempty"`
 	// An query expression of the Livestatus 'hosts' table in nested dictionary form. If you want to use multiple expressions, nest them with the AND/OR operators.
 	// Example: {"op": "and", "expr": [{"op": "=", "left": "name", "right": "example.com"}, {"op": "!=", "left": "state", "right": "0"}]}
-	Query interface{} `json:"query"`
+	Query Expr `json:"query"`
 	// If set, only a state-change of the host to an UP state will discard the acknowledgement. Otherwise it will be discarded on any state-change. Defaults to False.
 	// Example: false
 	Sticky bool `json:"sticky,omitempty"`
 }
 
-// AcknowledgeHostRelatedProblem represents a CheckMK API type.
+// AcknowledgeHostRelatedProblem is a union of AcknowledgeHostProblem, AcknowledgeHostGroupProblem, AcknowledgeHostQueryProblem, selected by the "acknowledge_type" property.
 type AcknowledgeHostRelatedProblem struct {
+	union json.RawMessage
+}
+
+// Discriminator returns the value of the "acknowledge_type" property.
+func (u AcknowledgeHostRelatedProblem) Discriminator() (string, error) {
+	return unionDiscriminator(u.union, "acknowledge_type")
+}
+
+// AsAcknowledgeHostProblem returns the union data as a AcknowledgeHostProblem.
+func (u AcknowledgeHostRelatedProblem) AsAcknowledgeHostProblem() (AcknowledgeHostProblem, error) {
+	var v AcknowledgeHostProblem
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromAcknowledgeHostProblem sets the union to v, with "acknowledge_type" set to "host".
+func (u *AcknowledgeHostRelatedProblem) FromAcknowledgeHostProblem(v AcknowledgeHostProblem) error {
+	data, err := unionFrom(v, "acknowledge_type", "host")
+	if err != nil {
+		return fmt.Errorf("AcknowledgeHostRelatedProblem: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsAcknowledgeHostGroupProblem returns the union data as a AcknowledgeHostGroupProblem.
+func (u AcknowledgeHostRelatedProblem) AsAcknowledgeHostGroupProblem() (AcknowledgeHostGroupProblem, error) {
+	var v AcknowledgeHostGroupProblem
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromAcknowledgeHostGroupProblem sets the union to v, with "acknowledge_type" set to "hostgroup".
+func (u *AcknowledgeHostRelatedProblem) FromAcknowledgeHostGroupProblem(v AcknowledgeHostGroupProblem) error {
+	data, err := unionFrom(v, "acknowledge_type", "hostgroup")
+	if err != nil {
+		return fmt.Errorf("AcknowledgeHostRelatedProblem: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsAcknowledgeHostQueryProblem returns the union data as a AcknowledgeHostQueryProblem.
+func (u AcknowledgeHostRelatedProblem) AsAcknowledgeHostQueryProblem() (AcknowledgeHostQueryProblem, error) {
+	var v AcknowledgeHostQueryProblem
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromAcknowledgeHostQueryProblem sets the union to v, with "acknowledge_type" set to "host_by_query".
+func (u *AcknowledgeHostRelatedProblem) FromAcknowledgeHostQueryProblem(v AcknowledgeHostQueryProblem) error {
+	data, err := unionFrom(v, "acknowledge_type", "host_by_query")
+	if err != nil {
+		return fmt.Errorf("AcknowledgeHostRelatedProblem: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// ValueByDiscriminator decodes the union into the member selected by "acknowledge_type".
+func (u AcknowledgeHostRelatedProblem) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := u.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "host":
+		return u.AsAcknowledgeHostProblem()
+	case "hostgroup":
+		return u.AsAcknowledgeHostGroupProblem()
+	case "host_by_query":
+		return u.AsAcknowledgeHostQueryProblem()
+	}
+	return nil, fmt.Errorf("AcknowledgeHostRelatedProblem: unknown acknowledge_type %q", discriminator)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u AcknowledgeHostRelatedProblem) MarshalJSON() ([]byte, error) {
+	if len(u.union) == 0 {
+		return []byte("null"), nil
+	}
+	return u.union, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting unknown "acknowledge_type" values.
+func (u *AcknowledgeHostRelatedProblem) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		u.union = nil
+		return nil
+	}
+	discriminator, err := unionDiscriminator(data, "acknowledge_type")
+	if err != nil {
+		return fmt.Errorf("AcknowledgeHostRelatedProblem: %w", err)
+	}
+	switch discriminator {
+	case "host", "hostgroup", "host_by_query":
+	default:
+		return fmt.Errorf("AcknowledgeHostRelatedProblem: unknown acknowledge_type %q", discriminator)
+	}
+	u.union = append(json.RawMessage(nil), data...)
+	return nil
 }
 
 // AcknowledgeServiceGroupProblem represents a CheckMK API type.
@@ -117,14 +223,115 @@ type AcknowledgeServiceQueryProblem struct {
 	Persistent bool `json:"persistent,omitempty"`
 	// An query expression of the Livestatus 'services' table in nested dictionary form. If you want to use multiple expressions, nest them with the AND/OR operators.
 	// Example: {"op": "and", "expr": [{"op": "=", "left": "name", "right": "example.com"}, {"op": "!=", "left": "state", "right": "0"}]}
-	Query interface{} `json:"query"`
+	Query Expr `json:"query"`
 	// If set, only a state-change of the service to an OK state will discard the acknowledgement. Otherwise, it will be discarded on any state-change. Defaults to False.
 	// Example: false
 	Sticky bool `json:"sticky,omitempty"`
 }
 
-// AcknowledgeServiceRelatedProblem represents a CheckMK API type.
+// AcknowledgeServiceRelatedProblem is a union of AcknowledgeSpecificServiceProblem, AcknowledgeServiceGroupProblem, AcknowledgeServiceQueryProblem, selected by the "acknowledge_type" property.
 type AcknowledgeServiceRelatedProblem struct {
+	union json.RawMessage
+}
+
+// Discriminator returns the value of the "acknowledge_type" property.
+func (u AcknowledgeServiceRelatedProblem) Discriminator() (string, error) {
+	return unionDiscriminator(u.union, "acknowledge_type")
+}
+
+// AsAcknowledgeSpecificServiceProblem returns the union data as a AcknowledgeSpecificServiceProblem.
+func (u AcknowledgeServiceRelatedProblem) AsAcknowledgeSpecificServiceProblem() (AcknowledgeSpecificServiceProblem, error) {
+	var v AcknowledgeSpecificServiceProblem
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromAcknowledgeSpecificServiceProblem sets the union to v, with "acknowledge_type" set to "service".
+func (u *AcknowledgeServiceRelatedProblem) FromAcknowledgeSpecificServiceProblem(v AcknowledgeSpecificServiceProblem) error {
+	data, err := unionFrom(v, "acknowledge_type", "service")
+	if err != nil {
+		return fmt.Errorf("AcknowledgeServiceRelatedProblem: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsAcknowledgeServiceGroupProblem returns the union data as a AcknowledgeServiceGroupProblem.
+func (u AcknowledgeServiceRelatedProblem) AsAcknowledgeServiceGroupProblem() (AcknowledgeServiceGroupProblem, error) {
+	var v AcknowledgeServiceGroupProblem
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromAcknowledgeServiceGroupProblem sets the union to v, with "acknowledge_type" set to "servicegroup".
+func (u *AcknowledgeServiceRelatedProblem) FromAcknowledgeServiceGroupProblem(v AcknowledgeServiceGroupProblem) error {
+	data, err := unionFrom(v, "acknowledge_type", "servicegroup")
+	if err != nil {
+		return fmt.Errorf("AcknowledgeServiceRelatedProblem: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsAcknowledgeServiceQueryProblem returns the union data as a AcknowledgeServiceQueryProblem.
+func (u AcknowledgeServiceRelatedProblem) AsAcknowledgeServiceQueryProblem() (AcknowledgeServiceQueryProblem, error) {
+	var v AcknowledgeServiceQueryProblem
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromAcknowledgeServiceQueryProblem sets the union to v, with "acknowledge_type" set to "service_by_query".
+func (u *AcknowledgeServiceRelatedProblem) FromAcknowledgeServiceQueryProblem(v AcknowledgeServiceQueryProblem) error {
+	data, err := unionFrom(v, "acknowledge_type", "service_by_query")
+	if err != nil {
+		return fmt.Errorf("AcknowledgeServiceRelatedProblem: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// ValueByDiscriminator decodes the union into the member selected by "acknowledge_type".
+func (u AcknowledgeServiceRelatedProblem) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := u.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "service":
+		return u.AsAcknowledgeSpecificServiceProblem()
+	case "servicegroup":
+		return u.AsAcknowledgeServiceGroupProblem()
+	case "service_by_query":
+		return u.AsAcknowledgeServiceQueryProblem()
+	}
+	return nil, fmt.Errorf("AcknowledgeServiceRelatedProblem: unknown acknowledge_type %q", discriminator)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u AcknowledgeServiceRelatedProblem) MarshalJSON() ([]byte, error) {
+	if len(u.union) == 0 {
+		return []byte("null"), nil
+	}
+	return u.union, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting unknown "acknowledge_type" values.
+func (u *AcknowledgeServiceRelatedProblem) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		u.union = nil
+		return nil
+	}
+	discriminator, err := unionDiscriminator(data, "acknowledge_type")
+	if err != nil {
+		return fmt.Errorf("AcknowledgeServiceRelatedProblem: %w", err)
+	}
+	switch discriminator {
+	case "service", "servicegroup", "service_by_query":
+	default:
+		return fmt.Errorf("AcknowledgeServiceRelatedProblem: unknown acknowledge_type %q", discriminator)
+	}
+	u.union = append(json.RawMessage(nil), data...)
+	return nil
 }
 
 // AcknowledgeSpecificServiceProblem represents a CheckMK API type.
@@ -166,7 +373,7 @@ type ActivateChanges struct {
 // ActivationExtensionFields represents a CheckMK API type.
 type ActivationExtensionFields struct {
 	// The changes in this activation
-	Changes []map[string]interface{} `json:"changes,omitempty"`
+	Changes []ChangesFields `json:"changes,omitempty"`
 	// If the activation is still running
 	// Example: false
 	ForceForeignChanges bool `json:"force_foreign_changes,omitempty"`
@@ -190,10 +397,10 @@ type ActivationRunCollection struct {
 	// The name of this collection.
 	Id string `json:"id,omitempty"`
 	// list of links to other resources.
-	Links []map[string]interface{} `json:"links"`
+	Links []Link `json:"links"`
 	// A list of activation runs.
 	// Example: [map[domainType:activation_run extensions:map[activate_foreign:true changes:[map[action_name:edit-host id:da5430a5-6d0a-48ae-9efd-0563482a3b36 text:Modified host heute. time:2023-01-20T16:31:51.362057+00:00 user_id:cmkadmin]] comment: is_running:false sites:[heute] time_started:2023-01-20T16:31:5...
-	Value []map[string]interface{} `json:"value,omitempty"`
+	Value []ActivationRunResponse `json:"value,omitempty"`
 }
 
 // ActivationRunResponse represents a CheckMK API type.
@@ -202,12 +409,12 @@ type ActivationRunResponse struct {
 	DomainType interface{} `json:"domainType,omitempty"`
 	// The activation run attributes.
 	// Example: map[activate_foreign:true changes:[map[action_name:edit-host id:da5430a5-6d0a-48ae-9efd-0563482a3b36 text:Modified host heute. time:2023-01-20T16:31:51.362057+00:00 user_id:cmkadmin]] comment: is_running:false sites:[heute] time_started:2023-01-20T16:31:54.306846+00:00]
-	Extensions interface{} `json:"extensions,omitempty"`
+	Extensions *ActivationExtensionFields `json:"extensions,omitempty"`
 	// The unique identifier for this activation run.
 	// Example: 84b18e42-355e-4f13-80b6-404bd8f21149
 	Id string `json:"id,omitempty"`
 	// list of links to other resources.
-	Links []map[string]interface{} `json:"links"`
+	Links []Link `json:"links"`
 	// The container for external resources, like linked foreign objects or actions.
 	Members map[string]interface{} `json:"members,omitempty"`
 	// The activation run status.
@@ -239,8 +446,90 @@ type ApiError struct {
 	Title string `json:"title"`
 }
 
-// AuthOption represents a CheckMK API type.
+// AuthOption is a union of AuthPassword, AuthSecret, selected by the "auth_type" property.
 type AuthOption struct {
+	union json.RawMessage
+}
+
+// Discriminator returns the value of the "auth_type" property.
+func (u AuthOption) Discriminator() (string, error) {
+	return unionDiscriminator(u.union, "auth_type")
+}
+
+// AsAuthPassword returns the union data as a AuthPassword.
+func (u AuthOption) AsAuthPassword() (AuthPassword, error) {
+	var v AuthPassword
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromAuthPassword sets the union to v, with "auth_type" set to "password".
+func (u *AuthOption) FromAuthPassword(v AuthPassword) error {
+	data, err := unionFrom(v, "auth_type", "password")
+	if err != nil {
+		return fmt.Errorf("AuthOption: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsAuthSecret returns the union data as a AuthSecret.
+func (u AuthOption) AsAuthSecret() (AuthSecret, error) {
+	var v AuthSecret
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromAuthSecret sets the union to v, with "auth_type" set to "automation".
+func (u *AuthOption) FromAuthSecret(v AuthSecret) error {
+	data, err := unionFrom(v, "auth_type", "automation")
+	if err != nil {
+		return fmt.Errorf("AuthOption: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// ValueByDiscriminator decodes the union into the member selected by "auth_type".
+func (u AuthOption) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := u.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "password":
+		return u.AsAuthPassword()
+	case "automation":
+		return u.AsAuthSecret()
+	}
+	return nil, fmt.Errorf("AuthOption: unknown auth_type %q", discriminator)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u AuthOption) MarshalJSON() ([]byte, error) {
+	if len(u.union) == 0 {
+		return []byte("null"), nil
+	}
+	return u.union, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting unknown "auth_type" values.
+func (u *AuthOption) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		u.union = nil
+		return nil
+	}
+	discriminator, err := unionDiscriminator(data, "auth_type")
+	if err != nil {
+		return fmt.Errorf("AuthOption: %w", err)
+	}
+	switch discriminator {
+	case "password", "automation":
+	default:
+		return fmt.Errorf("AuthOption: unknown auth_type %q", discriminator)
+	}
+	u.union = append(json.RawMessage(nil), data...)
+	return nil
 }
 
 // AuthOption1 represents a CheckMK API type.
@@ -274,8 +563,109 @@ type AuthSecret struct {
 	Secret string `json:"secret,omitempty"`
 }
 
-// AuthUpdateOption represents a CheckMK API type.
+// AuthUpdateOption is a union of AuthUpdatePassword, AuthUpdateSecret, AuthUpdateRemove, selected by the "auth_type" property.
 type AuthUpdateOption struct {
+	union json.RawMessage
+}
+
+// Discriminator returns the value of the "auth_type" property.
+func (u AuthUpdateOption) Discriminator() (string, error) {
+	return unionDiscriminator(u.union, "auth_type")
+}
+
+// AsAuthUpdatePassword returns the union data as a AuthUpdatePassword.
+func (u AuthUpdateOption) AsAuthUpdatePassword() (AuthUpdatePassword, error) {
+	var v AuthUpdatePassword
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromAuthUpdatePassword sets the union to v, with "auth_type" set to "password".
+func (u *AuthUpdateOption) FromAuthUpdatePassword(v AuthUpdatePassword) error {
+	data, err := unionFrom(v, "auth_type", "password")
+	if err != nil {
+		return fmt.Errorf("AuthUpdateOption: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsAuthUpdateSecret returns the union data as a AuthUpdateSecret.
+func (u AuthUpdateOption) AsAuthUpdateSecret() (AuthUpdateSecret, error) {
+	var v AuthUpdateSecret
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromAuthUpdateSecret sets the union to v, with "auth_type" set to "automation".
+func (u *AuthUpdateOption) FromAuthUpdateSecret(v AuthUpdateSecret) error {
+	data, err := unionFrom(v, "auth_type", "automation")
+	if err != nil {
+		return fmt.Errorf("AuthUpdateOption: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsAuthUpdateRemove returns the union data as a AuthUpdateRemove.
+func (u AuthUpdateOption) AsAuthUpdateRemove() (AuthUpdateRemove, error) {
+	var v AuthUpdateRemove
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromAuthUpdateRemove sets the union to v, with "auth_type" set to "remove".
+func (u *AuthUpdateOption) FromAuthUpdateRemove(v AuthUpdateRemove) error {
+	data, err := unionFrom(v, "auth_type", "remove")
+	if err != nil {
+		return fmt.Errorf("AuthUpdateOption: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// ValueByDiscriminator decodes the union into the member selected by "auth_type".
+func (u AuthUpdateOption) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := u.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "password":
+		return u.AsAuthUpdatePassword()
+	case "automation":
+		return u.AsAuthUpdateSecret()
+	case "remove":
+		return u.AsAuthUpdateRemove()
+	}
+	return nil, fmt.Errorf("AuthUpdateOption: unknown auth_type %q", discriminator)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u AuthUpdateOption) MarshalJSON() ([]byte, error) {
+	if len(u.union) == 0 {
+		return []byte("null"), nil
+	}
+	return u.union, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting unknown "auth_type" values.
+func (u *AuthUpdateOption) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		u.union = nil
+		return nil
+	}
+	discriminator, err := unionDiscriminator(data, "auth_type")
+	if err != nil {
+		return fmt.Errorf("AuthUpdateOption: %w", err)
+	}
+	switch discriminator {
+	case "password", "automation", "remove":
+	default:
+		return fmt.Errorf("AuthUpdateOption: unknown auth_type %q", discriminator)
+	}
+	u.union = append(json.RawMessage(nil), data...)
+	return nil
 }
 
 // AuthUpdatePassword represents a CheckMK API type.
@@ -353,11 +743,11 @@ type AuxTagResponse struct {
 	DomainType interface{} `json:"domainType,omitempty"`
 	// The Auxiliary Tag attributes.
 	// Example: map[help:Your help text id:snmp title:Monitoring via SNMP topic:Monitoring agents]
-	Extensions interface{} `json:"extensions,omitempty"`
+	Extensions *AuxTagAttrsResponse `json:"extensions,omitempty"`
 	// The unique identifier for this domain-object type.
 	Id string `json:"id,omitempty"`
 	// list of links to other resources.
-	Links []map[string]interface{} `json:"links"`
+	Links []Link `json:"links"`
 	// The container for external resources, like linked foreign objects or actions.
 	Members map[string]interface{} `json:"members,omitempty"`
 	// A human readable title of this object. Can be used for user interfaces.
@@ -373,16 +763,136 @@ type AuxTagResponseCollection struct {
 	// The name of this collection.
 	Id string `json:"id,omitempty"`
 	// list of links to other resources.
-	Links []map[string]interface{} `json:"links"`
+	Links []Link `json:"links"`
 	// A human readable title of this object. Can be used for user interfaces.
 	Title string `json:"title,omitempty"`
 	// A list of site configuration objects.
 	// Example: [map[domainType:aux_tag extensions:map[help:Your help text id:snmp title:Monitoring via SNMP topic:Monitoring agents] id:snmp links:[] members:map[] title:Monitoring via SNMP]]
-	Value []map[string]interface{} `json:"value,omitempty"`
+	Value []AuxTagResponse `json:"value,omitempty"`
 }
 
-// BIAction represents a CheckMK API type.
+// BIAction is a union of BICallARuleAction, BIStateOfHostAction, BIStateOfServiceAction, BIStateOfRemainingServicesAction, selected by the "type" property.
 type BIAction struct {
+	union json.RawMessage
+}
+
+// Discriminator returns the value of the "type" property.
+func (u BIAction) Discriminator() (string, error) {
+	return unionDiscriminator(u.union, "type")
+}
+
+// AsBICallARuleAction returns the union data as a BICallARuleAction.
+func (u BIAction) AsBICallARuleAction() (BICallARuleAction, error) {
+	var v BICallARuleAction
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromBICallARuleAction sets the union to v, with "type" set to "call_a_rule".
+func (u *BIAction) FromBICallARuleAction(v BICallARuleAction) error {
+	data, err := unionFrom(v, "type", "call_a_rule")
+	if err != nil {
+		return fmt.Errorf("BIAction: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsBIStateOfHostAction returns the union data as a BIStateOfHostAction.
+func (u BIAction) AsBIStateOfHostAction() (BIStateOfHostAction, error) {
+	var v BIStateOfHostAction
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromBIStateOfHostAction sets the union to v, with "type" set to "state_of_host".
+func (u *BIAction) FromBIStateOfHostAction(v BIStateOfHostAction) error {
+	data, err := unionFrom(v, "type", "state_of_host")
+	if err != nil {
+		return fmt.Errorf("BIAction: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsBIStateOfServiceAction returns the union data as a BIStateOfServiceAction.
+func (u BIAction) AsBIStateOfServiceAction() (BIStateOfServiceAction, error) {
+	var v BIStateOfServiceAction
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromBIStateOfServiceAction sets the union to v, with "type" set to "state_of_service".
+func (u *BIAction) FromBIStateOfServiceAction(v BIStateOfServiceAction) error {
+	data, err := unionFrom(v, "type", "state_of_service")
+	if err != nil {
+		return fmt.Errorf("BIAction: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsBIStateOfRemainingServicesAction returns the union data as a BIStateOfRemainingServicesAction.
+func (u BIAction) AsBIStateOfRemainingServicesAction() (BIStateOfRemainingServicesAction, error) {
+	var v BIStateOfRemainingServicesAction
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromBIStateOfRemainingServicesAction sets the union to v, with "type" set to "state_of_remaining_services".
+func (u *BIAction) FromBIStateOfRemainingServicesAction(v BIStateOfRemainingServicesAction) error {
+	data, err := unionFrom(v, "type", "state_of_remaining_services")
+	if err != nil {
+		return fmt.Errorf("BIAction: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// ValueByDiscriminator decodes the union into the member selected by "type".
+func (u BIAction) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := u.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "call_a_rule":
+		return u.AsBICallARuleAction()
+	case "state_of_host":
+		return u.AsBIStateOfHostAction()
+	case "state_of_service":
+		return u.AsBIStateOfServiceAction()
+	case "state_of_remaining_services":
+		return u.AsBIStateOfRemainingServicesAction()
+	}
+	return nil, fmt.Errorf("BIAction: unknown type %q", discriminator)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u BIAction) MarshalJSON() ([]byte, error) {
+	if len(u.union) == 0 {
+		return []byte("null"), nil
+	}
+	return u.union, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting unknown "type" values.
+func (u *BIAction) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		u.union = nil
+		return nil
+	}
+	discriminator, err := unionDiscriminator(data, "type")
+	if err != nil {
+		return fmt.Errorf("BIAction: %w", err)
+	}
+	switch discriminator {
+	case "call_a_rule", "state_of_host", "state_of_service", "state_of_remaining_services":
+	default:
+		return fmt.Errorf("BIAction: unknown type %q", discriminator)
+	}
+	u.union = append(json.RawMessage(nil), data...)
+	return nil
 }
 
 // BIAggregationComputationOptions represents a CheckMK API type.
@@ -397,32 +907,133 @@ type BIAggregationComputationOptions struct {
 type BIAggregationEndpoint struct {
 	// Nested dictionary
 	// Example: map[ignore_rule_styles:false layout_id:builtin_default line_style:round]
-	AggregationVisualization interface{} `json:"aggregation_visualization"`
+	AggregationVisualization BIAggregationVisualization `json:"aggregation_visualization"`
 	// An optional comment that may be used to explain the purpose of this object.
 	// Example: Rule comment
 	Comment string `json:"comment,omitempty"`
 	// Nested dictionary
 	// Example: map[disabled:false escalate_downtimes_as_warn:false freeze_aggregations:false use_hard_states:false]
-	ComputationOptions interface{} `json:"computation_options"`
+	ComputationOptions BIAggregationComputationOptions `json:"computation_options"`
 	// CME Edition only: The customer id for this aggregation.
 	// Example: customer1
 	Customer string `json:"customer,omitempty"`
 	// Nested dictionary
 	// Example: map[names:[groupA groupB] paths:[[path group a] [path group b]]]
-	Groups interface{} `json:"groups"`
+	Groups BIAggregationGroups `json:"groups"`
 	// The unique aggregation id
 	// Example: aggr1
 	Id string `json:"id"`
 	// Nested dictionary
 	// Example: map[action:map[host_regex: type:state_of_host] search:map[type:empty]]
-	Node interface{} `json:"node"`
+	Node BINodeGenerator `json:"node"`
 	// The identifier of the BI pack.
 	// Example: pack1
 	PackId string `json:"pack_id"`
 }
 
-// BIAggregationFunction represents a CheckMK API type.
+// BIAggregationFunction is a union of BIAggregationFunctionBest, BIAggregationFunctionWorst, BIAggregationFunctionCountOK, selected by the "type" property.
 type BIAggregationFunction struct {
+	union json.RawMessage
+}
+
+// Discriminator returns the value of the "type" property.
+func (u BIAggregationFunction) Discriminator() (string, error) {
+	return unionDiscriminator(u.union, "type")
+}
+
+// AsBIAggregationFunctionBest returns the union data as a BIAggregationFunctionBest.
+func (u BIAggregationFunction) AsBIAggregationFunctionBest() (BIAggregationFunctionBest, error) {
+	var v BIAggregationFunctionBest
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromBIAggregationFunctionBest sets the union to v, with "type" set to "best".
+func (u *BIAggregationFunction) FromBIAggregationFunctionBest(v BIAggregationFunctionBest) error {
+	data, err := unionFrom(v, "type", "best")
+	if err != nil {
+		return fmt.Errorf("BIAggregationFunction: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsBIAggregationFunctionWorst returns the union data as a BIAggregationFunctionWorst.
+func (u BIAggregationFunction) AsBIAggregationFunctionWorst() (BIAggregationFunctionWorst, error) {
+	var v BIAggregationFunctionWorst
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromBIAggregationFunctionWorst sets the union to v, with "type" set to "worst".
+func (u *BIAggregationFunction) FromBIAggregationFunctionWorst(v BIAggregationFunctionWorst) error {
+	data, err := unionFrom(v, "type", "worst")
+	if err != nil {
+		return fmt.Errorf("BIAggregationFunction: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsBIAggregationFunctionCountOK returns the union data as a BIAggregationFunctionCountOK.
+func (u BIAggregationFunction) AsBIAggregationFunctionCountOK() (BIAggregationFunctionCountOK, error) {
+	var v BIAggregationFunctionCountOK
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromBIAggregationFunctionCountOK sets the union to v, with "type" set to "count_ok".
+func (u *BIAggregationFunction) FromBIAggregationFunctionCountOK(v BIAggregationFunctionCountOK) error {
+	data, err := unionFrom(v, "type", "count_ok")
+	if err != nil {
+		return fmt.Errorf("BIAggregationFunction: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// ValueByDiscriminator decodes the union into the member selected by "type".
+func (u BIAggregationFunction) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := u.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "best":
+		return u.AsBIAggregationFunctionBest()
+	case "worst":
+		return u.AsBIAggregationFunctionWorst()
+	case "count_ok":
+		return u.AsBIAggregationFunctionCountOK()
+	}
+	return nil, fmt.Errorf("BIAggregationFunction: unknown type %q", discriminator)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u BIAggregationFunction) MarshalJSON() ([]byte, error) {
+	if len(u.union) == 0 {
+		return []byte("null"), nil
+	}
+	return u.union, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting unknown "type" values.
+func (u *BIAggregationFunction) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		u.union = nil
+		return nil
+	}
+	discriminator, err := unionDiscriminator(data, "type")
+	if err != nil {
+		return fmt.Errorf("BIAggregationFunction: %w", err)
+	}
+	switch discriminator {
+	case "best", "worst", "count_ok":
+	default:
+		return fmt.Errorf("BIAggregationFunction: unknown type %q", discriminator)
+	}
+	u.union = append(json.RawMessage(nil), data...)
+	return nil
 }
 
 // BIAggregationFunctionBest represents a CheckMK API type.
@@ -434,8 +1045,8 @@ type BIAggregationFunctionBest struct {
 
 // BIAggregationFunctionCountOK represents a CheckMK API type.
 type BIAggregationFunctionCountOK struct {
-	LevelsOk map[string]interface{} `json:"levels_ok"`
-	LevelsWarn map[string]interface{} `json:"levels_warn"`
+	LevelsOk BIAggregationFunctionCountSettings `json:"levels_ok"`
+	LevelsWarn BIAggregationFunctionCountSettings `json:"levels_warn"`
 	Type interface{} `json:"type"`
 }
 
@@ -495,7 +1106,7 @@ type BIAllHostsChoice struct {
 
 // BICallARuleAction represents a CheckMK API type.
 type BICallARuleAction struct {
-	Params interface{} `json:"params"`
+	Params BIParams `json:"params"`
 	RuleId string `json:"rule_id"`
 	Type interface{} `json:"type"`
 }
@@ -507,7 +1118,7 @@ type BIEmptySearch struct {
 
 // BIFixedArgumentsSearch represents a CheckMK API type.
 type BIFixedArgumentsSearch struct {
-	Arguments []map[string]interface{} `json:"arguments"`
+	Arguments []BIFixedArgumentsSearchToken `json:"arguments"`
 	Type interface{} `json:"type"`
 }
 
@@ -523,8 +1134,109 @@ type BIHostAliasRegexChoice struct {
 	Type interface{} `json:"type"`
 }
 
-// BIHostChoice represents a CheckMK API type.
+// BIHostChoice is a union of BIAllHostsChoice, BIHostNameRegexChoice, BIHostAliasRegexChoice, selected by the "type" property.
 type BIHostChoice struct {
+	union json.RawMessage
+}
+
+// Discriminator returns the value of the "type" property.
+func (u BIHostChoice) Discriminator() (string, error) {
+	return unionDiscriminator(u.union, "type")
+}
+
+// AsBIAllHostsChoice returns the union data as a BIAllHostsChoice.
+func (u BIHostChoice) AsBIAllHostsChoice() (BIAllHostsChoice, error) {
+	var v BIAllHostsChoice
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromBIAllHostsChoice sets the union to v, with "type" set to "all_hosts".
+func (u *BIHostChoice) FromBIAllHostsChoice(v BIAllHostsChoice) error {
+	data, err := unionFrom(v, "type", "all_hosts")
+	if err != nil {
+		return fmt.Errorf("BIHostChoice: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsBIHostNameRegexChoice returns the union data as a BIHostNameRegexChoice.
+func (u BIHostChoice) AsBIHostNameRegexChoice() (BIHostNameRegexChoice, error) {
+	var v BIHostNameRegexChoice
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromBIHostNameRegexChoice sets the union to v, with "type" set to "host_name_regex".
+func (u *BIHostChoice) FromBIHostNameRegexChoice(v BIHostNameRegexChoice) error {
+	data, err := unionFrom(v, "type", "host_name_regex")
+	if err != nil {
+		return fmt.Errorf("BIHostChoice: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsBIHostAliasRegexChoice returns the union data as a BIHostAliasRegexChoice.
+func (u BIHostChoice) AsBIHostAliasRegexChoice() (BIHostAliasRegexChoice, error) {
+	var v BIHostAliasRegexChoice
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromBIHostAliasRegexChoice sets the union to v, with "type" set to "host_alias_regex".
+func (u *BIHostChoice) FromBIHostAliasRegexChoice(v BIHostAliasRegexChoice) error {
+	data, err := unionFrom(v, "type", "host_alias_regex")
+	if err != nil {
+		return fmt.Errorf("BIHostChoice: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// ValueByDiscriminator decodes the union into the member selected by "type".
+func (u BIHostChoice) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := u.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "all_hosts":
+		return u.AsBIAllHostsChoice()
+	case "host_name_regex":
+		return u.AsBIHostNameRegexChoice()
+	case "host_alias_regex":
+		return u.AsBIHostAliasRegexChoice()
+	}
+	return nil, fmt.Errorf("BIHostChoice: unknown type %q", discriminator)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u BIHostChoice) MarshalJSON() ([]byte, error) {
+	if len(u.union) == 0 {
+		return []byte("null"), nil
+	}
+	return u.union, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting unknown "type" values.
+func (u *BIHostChoice) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		u.union = nil
+		return nil
+	}
+	discriminator, err := unionDiscriminator(data, "type")
+	if err != nil {
+		return fmt.Errorf("BIHostChoice: %w", err)
+	}
+	switch discriminator {
+	case "all_hosts", "host_name_regex", "host_alias_regex":
+	default:
+		return fmt.Errorf("BIHostChoice: unknown type %q", discriminator)
+	}
+	u.union = append(json.RawMessage(nil), data...)
+	return nil
 }
 
 // BIHostNameRegexChoice represents a CheckMK API type.
@@ -535,8 +1247,8 @@ type BIHostNameRegexChoice struct {
 
 // BIHostSearch represents a CheckMK API type.
 type BIHostSearch struct {
-	Conditions map[string]interface{} `json:"conditions"`
-	ReferTo map[string]interface{} `json:"refer_to"`
+	Conditions HostConditions `json:"conditions"`
+	ReferTo ReferTo `json:"refer_to"`
 	Type interface{} `json:"type"`
 }
 
@@ -544,10 +1256,10 @@ type BIHostSearch struct {
 type BINodeGenerator struct {
 	// Nested dictionary
 	// Example: map[host_regex: type:state_of_host]
-	Action interface{} `json:"action"`
+	Action BIAction `json:"action"`
 	// Nested dictionary
 	// Example: map[type:empty]
-	Search interface{} `json:"search"`
+	Search BISearch `json:"search"`
 }
 
 // BINodeVisBlockStyle represents a CheckMK API type.
@@ -564,7 +1276,7 @@ type BINodeVisForceStyle struct {
 
 // BINodeVisHierarchyStyle represents a CheckMK API type.
 type BINodeVisHierarchyStyle struct {
-	StyleConfig map[string]interface{} `json:"style_config"`
+	StyleConfig BINodeVisHierarchyStyleConfig `json:"style_config"`
 	Type interface{} `json:"type"`
 }
 
@@ -575,8 +1287,147 @@ type BINodeVisHierarchyStyleConfig struct {
 	Rotation int `json:"rotation"`
 }
 
-// BINodeVisLayoutStyle represents a CheckMK API type.
+// BINodeVisLayoutStyle is a union of BINodeVisNoneStyle, BINodeVisBlockStyle, BINodeVisHierarchyStyle, BINodeVisRadialStyle, BINodeVisForceStyle, selected by the "type" property.
 type BINodeVisLayoutStyle struct {
+	union json.RawMessage
+}
+
+// Discriminator returns the value of the "type" property.
+func (u BINodeVisLayoutStyle) Discriminator() (string, error) {
+	return unionDiscriminator(u.union, "type")
+}
+
+// AsBINodeVisNoneStyle returns the union data as a BINodeVisNoneStyle.
+func (u BINodeVisLayoutStyle) AsBINodeVisNoneStyle() (BINodeVisNoneStyle, error) {
+	var v BINodeVisNoneStyle
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromBINodeVisNoneStyle sets the union to v, with "type" set to "none".
+func (u *BINodeVisLayoutStyle) FromBINodeVisNoneStyle(v BINodeVisNoneStyle) error {
+	data, err := unionFrom(v, "type", "none")
+	if err != nil {
+		return fmt.Errorf("BINodeVisLayoutStyle: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsBINodeVisBlockStyle returns the union data as a BINodeVisBlockStyle.
+func (u BINodeVisLayoutStyle) AsBINodeVisBlockStyle() (BINodeVisBlockStyle, error) {
+	var v BINodeVisBlockStyle
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromBINodeVisBlockStyle sets the union to v, with "type" set to "block".
+func (u *BINodeVisLayoutStyle) FromBINodeVisBlockStyle(v BINodeVisBlockStyle) error {
+	data, err := unionFrom(v, "type", "block")
+	if err != nil {
+		return fmt.Errorf("BINodeVisLayoutStyle: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsBINodeVisHierarchyStyle returns the union data as a BINodeVisHierarchyStyle.
+func (u BINodeVisLayoutStyle) AsBINodeVisHierarchyStyle() (BINodeVisHierarchyStyle, error) {
+	var v BINodeVisHierarchyStyle
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromBINodeVisHierarchyStyle sets the union to v, with "type" set to "hierarchy".
+func (u *BINodeVisLayoutStyle) FromBINodeVisHierarchyStyle(v BINodeVisHierarchyStyle) error {
+	data, err := unionFrom(v, "type", "hierarchy")
+	if err != nil {
+		return fmt.Errorf("BINodeVisLayoutStyle: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsBINodeVisRadialStyle returns the union data as a BINodeVisRadialStyle.
+func (u BINodeVisLayoutStyle) AsBINodeVisRadialStyle() (BINodeVisRadialStyle, error) {
+	var v BINodeVisRadialStyle
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromBINodeVisRadialStyle sets the union to v, with "type" set to "radial".
+func (u *BINodeVisLayoutStyle) FromBINodeVisRadialStyle(v BINodeVisRadialStyle) error {
+	data, err := unionFrom(v, "type", "radial")
+	if err != nil {
+		return fmt.Errorf("BINodeVisLayoutStyle: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsBINodeVisForceStyle returns the union data as a BINodeVisForceStyle.
+func (u BINodeVisLayoutStyle) AsBINodeVisForceStyle() (BINodeVisForceStyle, error) {
+	var v BINodeVisForceStyle
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromBINodeVisForceStyle sets the union to v, with "type" set to "force".
+func (u *BINodeVisLayoutStyle) FromBINodeVisForceStyle(v BINodeVisForceStyle) error {
+	data, err := unionFrom(v, "type", "force")
+	if err != nil {
+		return fmt.Errorf("BINodeVisLayoutStyle: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// ValueByDiscriminator decodes the union into the member selected by "type".
+func (u BINodeVisLayoutStyle) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := u.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "none":
+		return u.AsBINodeVisNoneStyle()
+	case "block":
+		return u.AsBINodeVisBlockStyle()
+	case "hierarchy":
+		return u.AsBINodeVisHierarchyStyle()
+	case "radial":
+		return u.AsBINodeVisRadialStyle()
+	case "force":
+		return u.AsBINodeVisForceStyle()
+	}
+	return nil, fmt.Errorf("BINodeVisLayoutStyle: unknown type %q", discriminator)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u BINodeVisLayoutStyle) MarshalJSON() ([]byte, error) {
+	if len(u.union) == 0 {
+		return []byte("null"), nil
+	}
+	return u.union, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting unknown "type" values.
+func (u *BINodeVisLayoutStyle) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		u.union = nil
+		return nil
+	}
+	discriminator, err := unionDiscriminator(data, "type")
+	if err != nil {
+		return fmt.Errorf("BINodeVisLayoutStyle: %w", err)
+	}
+	switch discriminator {
+	case "none", "block", "hierarchy", "radial", "force":
+	default:
+		return fmt.Errorf("BINodeVisLayoutStyle: unknown type %q", discriminator)
+	}
+	u.union = append(json.RawMessage(nil), data...)
+	return nil
 }
 
 // BINodeVisNoneStyle represents a CheckMK API type.
@@ -587,7 +1438,7 @@ type BINodeVisNoneStyle struct {
 
 // BINodeVisRadialStyle represents a CheckMK API type.
 type BINodeVisRadialStyle struct {
-	StyleConfig map[string]interface{} `json:"style_config"`
+	StyleConfig BINodeVisRadialStyleConfig `json:"style_config"`
 	Type interface{} `json:"type"`
 }
 
@@ -625,28 +1476,28 @@ type BIRuleComputationOptions struct {
 type BIRuleEndpoint struct {
 	// Nested dictionary
 	// Example: map[count:1 restrict_state:2 type:best]
-	AggregationFunction interface{} `json:"aggregation_function"`
+	AggregationFunction BIAggregationFunction `json:"aggregation_function"`
 	// Nested dictionary
 	// Example: map[disabled:false]
-	ComputationOptions interface{} `json:"computation_options"`
+	ComputationOptions BIRuleComputationOptions `json:"computation_options"`
 	// The unique rule id
 	// Example: rule1
 	Id string `json:"id"`
 	// Nested dictionary
 	// Example: map[style_config:map[] type:block]
-	NodeVisualization interface{} `json:"node_visualization"`
+	NodeVisualization BINodeVisLayoutStyle `json:"node_visualization"`
 	// A list of nodes for for this rule
 	// Example: []
-	Nodes []map[string]interface{} `json:"nodes"`
+	Nodes []BINodeGenerator `json:"nodes"`
 	// The identifier of the BI pack.
 	// Example: pack1
 	PackId string `json:"pack_id"`
 	// Nested dictionary
 	// Example: map[arguments:[foo bar]]
-	Params interface{} `json:"params"`
+	Params BIParams `json:"params"`
 	// Nested dictionary
 	// Example: map[comment: docu_url: icon: state_messages:map[] title:]
-	Properties interface{} `json:"properties"`
+	Properties BIRuleProperties `json:"properties"`
 }
 
 // BIRuleProperties represents a CheckMK API type.
@@ -658,13 +1509,133 @@ type BIRuleProperties struct {
 	Title string `json:"title"`
 }
 
-// BISearch represents a CheckMK API type.
+// BISearch is a union of BIEmptySearch, BIHostSearch, BIServiceSearch, BIFixedArgumentsSearch, selected by the "type" property.
 type BISearch struct {
+	union json.RawMessage
+}
+
+// Discriminator returns the value of the "type" property.
+func (u BISearch) Discriminator() (string, error) {
+	return unionDiscriminator(u.union, "type")
+}
+
+// AsBIEmptySearch returns the union data as a BIEmptySearch.
+func (u BISearch) AsBIEmptySearch() (BIEmptySearch, error) {
+	var v BIEmptySearch
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromBIEmptySearch sets the union to v, with "type" set to "empty".
+func (u *BISearch) FromBIEmptySearch(v BIEmptySearch) error {
+	data, err := unionFrom(v, "type", "empty")
+	if err != nil {
+		return fmt.Errorf("BISearch: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsBIHostSearch returns the union data as a BIHostSearch.
+func (u BISearch) AsBIHostSearch() (BIHostSearch, error) {
+	var v BIHostSearch
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromBIHostSearch sets the union to v, with "type" set to "host_search".
+func (u *BISearch) FromBIHostSearch(v BIHostSearch) error {
+	data, err := unionFrom(v, "type", "host_search")
+	if err != nil {
+		return fmt.Errorf("BISearch: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsBIServiceSearch returns the union data as a BIServiceSearch.
+func (u BISearch) AsBIServiceSearch() (BIServiceSearch, error) {
+	var v BIServiceSearch
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromBIServiceSearch sets the union to v, with "type" set to "service_search".
+func (u *BISearch) FromBIServiceSearch(v BIServiceSearch) error {
+	data, err := unionFrom(v, "type", "service_search")
+	if err != nil {
+		return fmt.Errorf("BISearch: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsBIFixedArgumentsSearch returns the union data as a BIFixedArgumentsSearch.
+func (u BISearch) AsBIFixedArgumentsSearch() (BIFixedArgumentsSearch, error) {
+	var v BIFixedArgumentsSearch
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromBIFixedArgumentsSearch sets the union to v, with "type" set to "fixed_arguments".
+func (u *BISearch) FromBIFixedArgumentsSearch(v BIFixedArgumentsSearch) error {
+	data, err := unionFrom(v, "type", "fixed_arguments")
+	if err != nil {
+		return fmt.Errorf("BISearch: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// ValueByDiscriminator decodes the union into the member selected by "type".
+func (u BISearch) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := u.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "empty":
+		return u.AsBIEmptySearch()
+	case "host_search":
+		return u.AsBIHostSearch()
+	case "service_search":
+		return u.AsBIServiceSearch()
+	case "fixed_arguments":
+		return u.AsBIFixedArgumentsSearch()
+	}
+	return nil, fmt.Errorf("BISearch: unknown type %q", discriminator)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u BISearch) MarshalJSON() ([]byte, error) {
+	if len(u.union) == 0 {
+		return []byte("null"), nil
+	}
+	return u.union, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting unknown "type" values.
+func (u *BISearch) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		u.union = nil
+		return nil
+	}
+	discriminator, err := unionDiscriminator(data, "type")
+	if err != nil {
+		return fmt.Errorf("BISearch: %w", err)
+	}
+	switch discriminator {
+	case "empty", "host_search", "service_search", "fixed_arguments":
+	default:
+		return fmt.Errorf("BISearch: unknown type %q", discriminator)
+	}
+	u.union = append(json.RawMessage(nil), data...)
+	return nil
 }
 
 // BIServiceSearch represents a CheckMK API type.
 type BIServiceSearch struct {
-	Conditions map[string]interface{} `json:"conditions"`
+	Conditions ServiceConditions `json:"conditions"`
 	Type interface{} `json:"type"`
 }
 
@@ -694,7 +1665,7 @@ type BackgroundJobStatus struct {
 	Active bool `json:"active"`
 	// Logs related to the background job.
 	// Example: map[progress:[progress1] result:[result1]]
-	Logs interface{} `json:"logs"`
+	Logs JobLogs `json:"logs"`
 	// This field indicates the current state of the background job.
 	// Example: initialized
 	State BackgroundJobStatusState `json:"state"`
@@ -704,22 +1675,22 @@ type BackgroundJobStatus struct {
 type BaseUserAttributes struct {
 	// Enforce password change attribute for the user
 	// Example: map[auth_type:password enforce_password_change:false]
-	AuthOption interface{} `json:"auth_option,omitempty"`
+	AuthOption *AuthOption1 `json:"auth_option,omitempty"`
 	// The names of the sites that this user is authorized to handle
 	AuthorizedSites []string `json:"authorized_sites,omitempty"`
 	// Contact settings for the user
-	ContactOptions interface{} `json:"contact_options,omitempty"`
+	ContactOptions *ConcreteUserContactOption `json:"contact_options,omitempty"`
 	// The contact groups that this user is a member of
 	Contactgroups []string `json:"contactgroups,omitempty"`
 	// This field indicates if the user is allowed to login to the monitoring.
 	DisableLogin bool `json:"disable_login,omitempty"`
-	DisableNotifications map[string]interface{} `json:"disable_notifications,omitempty"`
+	DisableNotifications *ConcreteDisabledNotifications `json:"disable_notifications,omitempty"`
 	// The alias or full name of the user.
 	Fullname string `json:"fullname"`
 	// Idle timeout for the user. Per default, the global configuration is used.
 	// Example: map[option:global]
-	IdleTimeout interface{} `json:"idle_timeout,omitempty"`
-	InterfaceOptions map[string]interface{} `json:"interface_options,omitempty"`
+	IdleTimeout *UserIdleOption `json:"idle_timeout,omitempty"`
+	InterfaceOptions *ConcreteUserInterfaceAttributes `json:"interface_options,omitempty"`
 	// The language used by the user in the user interface
 	Language string `json:"language,omitempty"`
 	PagerAddress string `json:"pager_address,omitempty"`
@@ -774,7 +1745,7 @@ type BinaryExpr struct {
 type BulkCreateHost struct {
 	// A list of host entries.
 	// Example: [map[attributes:map[] folder:/ host_name:example.com]]
-	Entries []map[string]interface{} `json:"entries,omitempty"`
+	Entries []CreateHost `json:"entries,omitempty"`
 }
 
 // BulkDeleteContactGroup represents a CheckMK API type.
@@ -830,7 +1801,7 @@ type BulkHostActionWithFailedHosts struct {
 	// Example: The resource could not be found.
 	Detail string `json:"detail"`
 	// Details for which hosts have failed
-	Ext interface{} `json:"ext,omitempty"`
+	Ext *FailedHosts `json:"ext,omitempty"`
 	// Detailed error messages on all fields failing validation.
 	Fields map[string]interface{} `json:"fields,omitempty"`
 	// The HTTP status code.
@@ -845,56 +1816,56 @@ type BulkHostActionWithFailedHosts struct {
 type BulkInputContactGroup struct {
 	// A collection of contact group entries.
 	// Example: [map[alias:Not on Sundays name:OnCall]]
-	Entries []map[string]interface{} `json:"entries,omitempty"`
+	Entries []InputContactGroup `json:"entries,omitempty"`
 }
 
 // BulkInputHostGroup represents a CheckMK API type.
 type BulkInputHostGroup struct {
 	// A list of host group entries.
 	// Example: [map[alias:Windows Servers name:windows]]
-	Entries []map[string]interface{} `json:"entries,omitempty"`
+	Entries []InputHostGroup `json:"entries,omitempty"`
 }
 
 // BulkInputServiceGroup represents a CheckMK API type.
 type BulkInputServiceGroup struct {
 	// A list of service group entries.
 	// Example: [map[alias:Environment Sensors name:environment]]
-	Entries []map[string]interface{} `json:"entries,omitempty"`
+	Entries []InputServiceGroup `json:"entries,omitempty"`
 }
 
 // BulkUpdateContactGroup represents a CheckMK API type.
 type BulkUpdateContactGroup struct {
 	// A list of contact group entries.
 	// Example: [map[attributes:map[alias:Not on Sundays] name:OnCall]]
-	Entries []map[string]interface{} `json:"entries,omitempty"`
+	Entries []UpdateContactGroup `json:"entries,omitempty"`
 }
 
 // BulkUpdateFolder represents a CheckMK API type.
 type BulkUpdateFolder struct {
 	// A list of folder entries.
 	// Example: [map[remove_attributes:[tag_foobar]]]
-	Entries []map[string]interface{} `json:"entries,omitempty"`
+	Entries []UpdateFolderEntry `json:"entries,omitempty"`
 }
 
 // BulkUpdateHost represents a CheckMK API type.
 type BulkUpdateHost struct {
 	// A list of host entries.
 	// Example: [map[attributes:map[] host_name:example.com]]
-	Entries []map[string]interface{} `json:"entries,omitempty"`
+	Entries []UpdateHostEntry `json:"entries,omitempty"`
 }
 
 // BulkUpdateHostGroup represents a CheckMK API type.
 type BulkUpdateHostGroup struct {
 	// A list of host group entries.
 	// Example: [map[attributes:map[alias:Windows Servers] name:windows]]
-	Entries []map[string]interface{} `json:"entries,omitempty"`
+	Entries []UpdateHostGroup `json:"entries,omitempty"`
 }
 
 // BulkUpdateServiceGroup represents a CheckMK API type.
 type BulkUpdateServiceGroup struct {
 	// A list of service group entries.
 	// Example: [map[attributes:map[alias:Windows Servers] name:windows]]
-	Entries []map[string]interface{} `json:"entries,omitempty"`
+	Entries []UpdateServiceGroup `json:"entries,omitempty"`
 }
 
 // ChangeEventState represents a CheckMK API type.
@@ -904,8 +1875,90 @@ type ChangeEventState struct {
 	NewState ChangeEventStateNewState `json:"new_state"`
 }
 
-// ChangeEventStateSelector represents a CheckMK API type.
+// ChangeEventStateSelector is a union of ChangeStateWithQuery, ChangeStateWithParams, selected by the "filter_type" property.
 type ChangeEventStateSelector struct {
+	union json.RawMessage
+}
+
+// Discriminator returns the value of the "filter_type" property.
+func (u ChangeEventStateSelector) Discriminator() (string, error) {
+	return unionDiscriminator(u.union, "filter_type")
+}
+
+// AsChangeStateWithQuery returns the union data as a ChangeStateWithQuery.
+func (u ChangeEventStateSelector) AsChangeStateWithQuery() (ChangeStateWithQuery, error) {
+	var v ChangeStateWithQuery
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromChangeStateWithQuery sets the union to v, with "filter_type" set to "query".
+func (u *ChangeEventStateSelector) FromChangeStateWithQuery(v ChangeStateWithQuery) error {
+	data, err := unionFrom(v, "filter_type", "query")
+	if err != nil {
+		return fmt.Errorf("ChangeEventStateSelector: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsChangeStateWithParams returns the union data as a ChangeStateWithParams.
+func (u ChangeEventStateSelector) AsChangeStateWithParams() (ChangeStateWithParams, error) {
+	var v ChangeStateWithParams
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromChangeStateWithParams sets the union to v, with "filter_type" set to "params".
+func (u *ChangeEventStateSelector) FromChangeStateWithParams(v ChangeStateWithParams) error {
+	data, err := unionFrom(v, "filter_type", "params")
+	if err != nil {
+		return fmt.Errorf("ChangeEventStateSelector: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// ValueByDiscriminator decodes the union into the member selected by "filter_type".
+func (u ChangeEventStateSelector) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := u.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "query":
+		return u.AsChangeStateWithQuery()
+	case "params":
+		return u.AsChangeStateWithParams()
+	}
+	return nil, fmt.Errorf("ChangeEventStateSelector: unknown filter_type %q", discriminator)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u ChangeEventStateSelector) MarshalJSON() ([]byte, error) {
+	if len(u.union) == 0 {
+		return []byte("null"), nil
+	}
+	return u.union, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting unknown "filter_type" values.
+func (u *ChangeEventStateSelector) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		u.union = nil
+		return nil
+	}
+	discriminator, err := unionDiscriminator(data, "filter_type")
+	if err != nil {
+		return fmt.Errorf("ChangeEventStateSelector: %w", err)
+	}
+	switch discriminator {
+	case "query", "params":
+	default:
+		return fmt.Errorf("ChangeEventStateSelector: unknown filter_type %q", discriminator)
+	}
+	u.union = append(json.RawMessage(nil), data...)
+	return nil
 }
 
 // ChangeStateWithParams represents a CheckMK API type.
@@ -913,7 +1966,7 @@ type ChangeStateWithParams struct {
 	// The way you would like to filter events.
 	// Example: params
 	FilterType ChangeStateWithParamsFilterType `json:"filter_type"`
-	Filters map[string]interface{} `json:"filters"`
+	Filters FilterParams `json:"filters"`
 	// The state
 	// Example: ok
 	NewState ChangeStateWithParamsNewState `json:"new_state"`
@@ -929,7 +1982,7 @@ type ChangeStateWithQuery struct {
 	NewState ChangeStateWithQueryNewState `json:"new_state"`
 	// An query expression of the Livestatus 'eventconsoleevents' table in nested dictionary form. If you want to use multiple expressions, nest them with the AND/OR operators.
 	// Example: {"op": "=", "left": "eventconsoleevents.event_host", "right": "test_host"}
-	Query interface{} `json:"query"`
+	Query Expr `json:"query"`
 }
 
 // ChangesFields represents a CheckMK API type.
@@ -956,8 +2009,8 @@ type Child struct {
 
 // ChildWith represents a CheckMK API type.
 type ChildWith struct {
-	Conditions map[string]interface{} `json:"conditions"`
-	HostChoice interface{} `json:"host_choice"`
+	Conditions HostConditions `json:"conditions"`
+	HostChoice BIHostChoice `json:"host_choice"`
 }
 
 // ClusterCreateAttribute represents a CheckMK API type.
@@ -969,7 +2022,7 @@ type ClusterCreateAttribute struct {
 	// Add a comment or describe this host
 	Alias string `json:"alias,omitempty"`
 	// Only members of the contact groups listed here have Setup permission for the host/folder. Optionally, you can make these contact groups automatically monitor contacts. The assignment of hosts to contact groups can also be defined by <a href='wato.py?mode=edit_ruleset&varname=host_contactgroups'>r...
-	Contactgroups interface{} `json:"contactgroups,omitempty"`
+	Contactgroups *HostContactGroup `json:"contactgroups,omitempty"`
 	// Whether or not the last bulk discovery failed. It is set to True once it fails and unset in case a later discovery succeeds.
 	// Example: false
 	InventoryFailed bool `json:"inventory_failed,omitempty"`
@@ -982,23 +2035,23 @@ type ClusterCreateAttribute struct {
 	// Name of host attributes which are locked in the UI.
 	LockedAttributes []string `json:"locked_attributes,omitempty"`
 	// Identity of the entity which locked the locked_attributes. The identity is built out of the Site ID, the program name and the connection ID.
-	LockedBy interface{} `json:"locked_by,omitempty"`
+	LockedBy *LockedBy `json:"locked_by,omitempty"`
 	// Address (IPv4, IPv6 or hostname) under which the management board can be reached.
 	ManagementAddress string `json:"management_address,omitempty"`
 	// IPMI credentials
-	ManagementIpmiCredentials interface{} `json:"management_ipmi_credentials,omitempty"`
+	ManagementIpmiCredentials *IPMIParameters `json:"management_ipmi_credentials,omitempty"`
 	// The protocol used to connect to the management board. Valid options are: * `none` - No management board * `snmp` - Connect using SNMP * `ipmi` - Connect using IPMI
 	ManagementProtocol ClusterCreateAttributeManagementProtocol `json:"management_protocol,omitempty"`
 	// SNMP credentials
-	ManagementSnmpCommunity interface{} `json:"management_snmp_community,omitempty"`
+	ManagementSnmpCommunity *SNMPCredentials `json:"management_snmp_community,omitempty"`
 	// Configuration for automatic network scan. Pings will besent to each IP address in the configured ranges to checkif a host is up or down. Each found host will be added tothe folder by it's hostname (if possible) or IP address.
-	NetworkScan interface{} `json:"network_scan,omitempty"`
+	NetworkScan *NetworkScan `json:"network_scan,omitempty"`
 	// A list of parents of this host.
 	Parents []string `json:"parents,omitempty"`
 	// The site that should monitor this host.
 	Site string `json:"site,omitempty"`
 	// The SNMP access configuration. A configured SNMP v1/v2 community here will have precedence over any configured SNMP community rule. For this attribute to take effect, the attribute `tag_snmp_ds` needs to be set first.
-	SnmpCommunity interface{} `json:"snmp_community,omitempty"`
+	SnmpCommunity *SNMPCredentials `json:"snmp_community,omitempty"`
 	// Choices: * `"ip-v4-only"`: IPv4 only * `"ip-v6-only"`: IPv6 only * `"ip-v4v6"`: IPv4/IPv6 dual-stack * `"no-ip"`: No IP
 	TagAddressFamily ClusterCreateAttributeTagAddressFamily `json:"tag_address_family,omitempty"`
 	// Choices: * `"cmk-agent"`: API integrations if configured, else Checkmk agent * `"all-agents"`: Configured API integrations and Checkmk agent * `"special-agents"`: Configured API integrations, no Checkmk agent * `"no-agent"`: No API integrations, no Checkmk agent
@@ -1013,8 +2066,71 @@ type ClusterCreateAttribute struct {
 	TagSnmpDs ClusterCreateAttributeTagSnmpDs `json:"tag_snmp_ds,omitempty"`
 }
 
-// CollectionItem represents a CheckMK API type.
+// CollectionItem is a union of Link, selected by the "domainType" property.
 type CollectionItem struct {
+	union json.RawMessage
+}
+
+// Discriminator returns the value of the "domainType" property.
+func (u CollectionItem) Discriminator() (string, error) {
+	return unionDiscriminator(u.union, "domainType")
+}
+
+// AsLink returns the union data as a Link.
+func (u CollectionItem) AsLink() (Link, error) {
+	var v Link
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromLink sets the union to v, with "domainType" set to "link".
+func (u *CollectionItem) FromLink(v Link) error {
+	data, err := unionFrom(v, "domainType", "link")
+	if err != nil {
+		return fmt.Errorf("CollectionItem: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// ValueByDiscriminator decodes the union into the member selected by "domainType".
+func (u CollectionItem) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := u.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "link":
+		return u.AsLink()
+	}
+	return nil, fmt.Errorf("CollectionItem: unknown domainType %q", discriminator)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u CollectionItem) MarshalJSON() ([]byte, error) {
+	if len(u.union) == 0 {
+		return []byte("null"), nil
+	}
+	return u.union, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting unknown "domainType" values.
+func (u *CollectionItem) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		u.union = nil
+		return nil
+	}
+	discriminator, err := unionDiscriminator(data, "domainType")
+	if err != nil {
+		return fmt.Errorf("CollectionItem: %w", err)
+	}
+	switch discriminator {
+	case "link":
+	default:
+		return fmt.Errorf("CollectionItem: unknown domainType %q", discriminator)
+	}
+	u.union = append(json.RawMessage(nil), data...)
+	return nil
 }
 
 // CommentAttributes represents a CheckMK API type.
@@ -1046,11 +2162,11 @@ type CommentCollection struct {
 	// The name of this collection.
 	Id string `json:"id,omitempty"`
 	// list of links to other resources.
-	Links []map[string]interface{} `json:"links"`
+	Links []Link `json:"links"`
 	// A human readable title of this object. Can be used for user interfaces.
 	Title string `json:"title,omitempty"`
 	// A list of comment objects.
-	Value []map[string]interface{} `json:"value,omitempty"`
+	Value []CommentObject `json:"value,omitempty"`
 }
 
 // CommentObject represents a CheckMK API type.
@@ -1058,11 +2174,11 @@ type CommentObject struct {
 	// The domain type of the object.
 	DomainType interface{} `json:"domainType,omitempty"`
 	// The attributes of a service/host comment.
-	Extensions interface{} `json:"extensions,omitempty"`
+	Extensions *CommentAttributes `json:"extensions,omitempty"`
 	// The unique identifier for this domain-object type.
 	Id string `json:"id,omitempty"`
 	// list of links to other resources.
-	Links []map[string]interface{} `json:"links"`
+	Links []Link `json:"links"`
 	// The container for external resources, like linked foreign objects or actions.
 	Members map[string]interface{} `json:"members,omitempty"`
 	// A human readable title of this object. Can be used for user interfaces.
@@ -1074,7 +2190,7 @@ type ConcreteDisabledNotifications struct {
 	// Option if all notifications should be temporarily disabled
 	Disable bool `json:"disable,omitempty"`
 	// A custom timerange during which notifications are disabled
-	Timerange interface{} `json:"timerange,omitempty"`
+	Timerange *DateTimeRange `json:"timerange,omitempty"`
 }
 
 // ConcreteHostTagGroup represents a CheckMK API type.
@@ -1082,11 +2198,11 @@ type ConcreteHostTagGroup struct {
 	// The domain type of the object.
 	DomainType interface{} `json:"domainType"`
 	// Additional fields for objects of this type.
-	Extensions interface{} `json:"extensions,omitempty"`
+	Extensions *HostTagExtensions `json:"extensions,omitempty"`
 	// The unique identifier for this domain-object type.
 	Id string `json:"id,omitempty"`
 	// list of links to other resources.
-	Links []map[string]interface{} `json:"links"`
+	Links []Link `json:"links"`
 	// The container for external resources, like linked foreign objects or actions.
 	Members map[string]interface{} `json:"members,omitempty"`
 	// A human readable title of this object. Can be used for user interfaces.
@@ -1098,7 +2214,7 @@ type ConcreteTimePeriodException struct {
 	// The date of the time period exception.8601 profile
 	// Example: 2020-01-01
 	Date string `json:"date,omitempty"`
-	TimeRanges []map[string]interface{} `json:"time_ranges,omitempty"`
+	TimeRanges []ConcreteTimeRange `json:"time_ranges,omitempty"`
 }
 
 // ConcreteTimeRange represents a CheckMK API type.
@@ -1115,7 +2231,7 @@ type ConcreteTimeRange struct {
 type ConcreteTimeRangeActive struct {
 	// The day for which the time ranges are specified
 	Day ConcreteTimeRangeActiveDay `json:"day,omitempty"`
-	TimeRanges []map[string]interface{} `json:"time_ranges,omitempty"`
+	TimeRanges []ConcreteTimeRange `json:"time_ranges,omitempty"`
 }
 
 // ConcreteUserContactOption represents a CheckMK API type.
@@ -1170,7 +2286,7 @@ type ConfigurationConnectionAttributes struct {
 	// Example: http://remote_site_1/check_mk/
 	UrlOfRemoteSite string `json:"url_of_remote_site"`
 	// By default the users are synchronized automatically in the interval configured in the connection. For example the LDAP connector synchronizes the users every five minutes by default. The interval can be changed for each connection individually in the connection settings. Please note that the sync...
-	UserSync interface{} `json:"user_sync"`
+	UserSync UserSyncAttributes `json:"user_sync"`
 }
 
 // ConfigurationConnectionAttributes1 represents a CheckMK API type.
@@ -1197,7 +2313,7 @@ type ConfigurationConnectionAttributes1 struct {
 	// Example: http://remote_site_1/check_mk/
 	UrlOfRemoteSite string `json:"url_of_remote_site,omitempty"`
 	// By default the users are synchronized automatically in the interval configured in the connection. For example the LDAP connector synchronizes the users every five minutes by default. The interval can be changed for each connection individually in the connection settings. Please note that the sync...
-	UserSync interface{} `json:"user_sync"`
+	UserSync UserSyncAttributes1 `json:"user_sync"`
 }
 
 // ConnectionMode represents a CheckMK API type.
@@ -1215,7 +2331,7 @@ type ContactGroup struct {
 	// The unique identifier for this domain-object type.
 	Id string `json:"id,omitempty"`
 	// list of links to other resources.
-	Links []map[string]interface{} `json:"links"`
+	Links []Link `json:"links"`
 	// The container for external resources, like linked foreign objects or actions.
 	Members map[string]interface{} `json:"members,omitempty"`
 	// A human readable title of this object. Can be used for user interfaces.
@@ -1231,11 +2347,11 @@ type ContactGroupCollection struct {
 	// The name of this collection.
 	Id string `json:"id,omitempty"`
 	// list of links to other resources.
-	Links []map[string]interface{} `json:"links"`
+	Links []Link `json:"links"`
 	// A human readable title of this object. Can be used for user interfaces.
 	Title string `json:"title,omitempty"`
 	// A list of contact group objects.
-	Value []map[string]interface{} `json:"value,omitempty"`
+	Value []ContactGroupObject `json:"value,omitempty"`
 }
 
 // ContactGroupObject represents a CheckMK API type.
@@ -1247,7 +2363,7 @@ type ContactGroupObject struct {
 	// The unique identifier for this domain-object type.
 	Id string `json:"id,omitempty"`
 	// list of links to other resources.
-	Links []map[string]interface{} `json:"links"`
+	Links []Link `json:"links"`
 	// The container for external resources, like linked foreign objects or actions.
 	Members map[string]interface{} `json:"members,omitempty"`
 	// A human readable title of this object. Can be used for user interfaces.
@@ -1374,7 +2490,7 @@ type CreateHostQueryComment struct {
 	Persistent bool `json:"persistent,omitempty"`
 	// An query expression of the Livestatus 'hosts' table in nested dictionary form. If you want to use multiple expressions, nest them with the AND/OR operators.
 	// Example: {"op": "and", "expr": [{"op": "=", "left": "name", "right": "example.com"}, {"op": "!=", "left": "state", "right": "0"}]}
-	Query interface{} `json:"query,omitempty"`
+	Query *Expr `json:"query,omitempty"`
 }
 
 // CreateHostQueryDowntime represents a CheckMK API type.
@@ -1391,7 +2507,7 @@ type CreateHostQueryDowntime struct {
 	EndTime string `json:"end_time"`
 	// An query expression of the Livestatus 'hosts' table in nested dictionary form. If you want to use multiple expressions, nest them with the AND/OR operators.
 	// Example: {"op": "and", "expr": [{"op": "=", "left": "name", "right": "example.com"}, {"op": "!=", "left": "state", "right": "0"}]}
-	Query interface{} `json:"query"`
+	Query Expr `json:"query"`
 	// The recurring mode of the new downtime. Available modes are: * fixed * hour * day * week * second_week * fourth_week * weekday_start * weekday_end * day_of_month This only works when using the Enterprise Editions. Defaults to 'fixed'.
 	// Example: hour
 	Recur CreateHostQueryDowntimeRecur `json:"recur,omitempty"`
@@ -1400,12 +2516,195 @@ type CreateHostQueryDowntime struct {
 	StartTime string `json:"start_time"`
 }
 
-// CreateHostRelatedComment represents a CheckMK API type.
+// CreateHostRelatedComment is a union of CreateHostComment, CreateHostQueryComment, selected by the "comment_type" property.
 type CreateHostRelatedComment struct {
-}
-
-// CreateHostRelatedDowntime represents a CheckMK API type.
+	union json.RawMessage
+}
+
+// Discriminator returns the value of the "comment_type" property.
+func (u CreateHostRelatedComment) Discriminator() (string, error) {
+	return unionDiscriminator(u.union, "comment_type")
+}
+
+// AsCreateHostComment returns the union data as a CreateHostComment.
+func (u CreateHostRelatedComment) AsCreateHostComment() (CreateHostComment, error) {
+	var v CreateHostComment
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromCreateHostComment sets the union to v, with "comment_type" set to "host".
+func (u *CreateHostRelatedComment) FromCreateHostComment(v CreateHostComment) error {
+	data, err := unionFrom(v, "comment_type", "host")
+	if err != nil {
+		return fmt.Errorf("CreateHostRelatedComment: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsCreateHostQueryComment returns the union data as a CreateHostQueryComment.
+func (u CreateHostRelatedComment) AsCreateHostQueryComment() (CreateHostQueryComment, error) {
+	var v CreateHostQueryComment
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromCreateHostQueryComment sets the union to v, with "comment_type" set to "host_by_query".
+func (u *CreateHostRelatedComment) FromCreateHostQueryComment(v CreateHostQueryComment) error {
+	data, err := unionFrom(v, "comment_type", "host_by_query")
+	if err != nil {
+		return fmt.Errorf("CreateHostRelatedComment: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// ValueByDiscriminator decodes the union into the member selected by "comment_type".
+func (u CreateHostRelatedComment) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := u.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "host":
+		return u.AsCreateHostComment()
+	case "host_by_query":
+		return u.AsCreateHostQueryComment()
+	}
+	return nil, fmt.Errorf("CreateHostRelatedComment: unknown comment_type %q", discriminator)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u CreateHostRelatedComment) MarshalJSON() ([]byte, error) {
+	if len(u.union) == 0 {
+		return []byte("null"), nil
+	}
+	return u.union, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting unknown "comment_type" values.
+func (u *CreateHostRelatedComment) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		u.union = nil
+		return nil
+	}
+	discriminator, err := unionDiscriminator(data, "comment_type")
+	if err != nil {
+		return fmt.Errorf("CreateHostRelatedComment: %w", err)
+	}
+	switch discriminator {
+	case "host", "host_by_query":
+	default:
+		return fmt.Errorf("CreateHostRelatedComment: unknown comment_type %q", discriminator)
+	}
+	u.union = append(json.RawMessage(nil), data...)
+	return nil
+}
+
+// CreateHostRelatedDowntime is a union of CreateHostDowntime, CreateHostGroupDowntime, CreateHostQueryDowntime, selected by the "downtime_type" property.
 type CreateHostRelatedDowntime struct {
+	union json.RawMessage
+}
+
+// Discriminator returns the value of the "downtime_type" property.
+func (u CreateHostRelatedDowntime) Discriminator() (string, error) {
+	return unionDiscriminator(u.union, "downtime_type")
+}
+
+// AsCreateHostDowntime returns the union data as a CreateHostDowntime.
+func (u CreateHostRelatedDowntime) AsCreateHostDowntime() (CreateHostDowntime, error) {
+	var v CreateHostDowntime
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromCreateHostDowntime sets the union to v, with "downtime_type" set to "host".
+func (u *CreateHostRelatedDowntime) FromCreateHostDowntime(v CreateHostDowntime) error {
+	data, err := unionFrom(v, "downtime_type", "host")
+	if err != nil {
+		return fmt.Errorf("CreateHostRelatedDowntime: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsCreateHostGroupDowntime returns the union data as a CreateHostGroupDowntime.
+func (u CreateHostRelatedDowntime) AsCreateHostGroupDowntime() (CreateHostGroupDowntime, error) {
+	var v CreateHostGroupDowntime
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromCreateHostGroupDowntime sets the union to v, with "downtime_type" set to "hostgroup".
+func (u *CreateHostRelatedDowntime) FromCreateHostGroupDowntime(v CreateHostGroupDowntime) error {
+	data, err := unionFrom(v, "downtime_type", "hostgroup")
+	if err != nil {
+		return fmt.Errorf("CreateHostRelatedDowntime: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsCreateHostQueryDowntime returns the union data as a CreateHostQueryDowntime.
+func (u CreateHostRelatedDowntime) AsCreateHostQueryDowntime() (CreateHostQueryDowntime, error) {
+	var v CreateHostQueryDowntime
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromCreateHostQueryDowntime sets the union to v, with "downtime_type" set to "host_by_query".
+func (u *CreateHostRelatedDowntime) FromCreateHostQueryDowntime(v CreateHostQueryDowntime) error {
+	data, err := unionFrom(v, "downtime_type", "host_by_query")
+	if err != nil {
+		return fmt.Errorf("CreateHostRelatedDowntime: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// ValueByDiscriminator decodes the union into the member selected by "downtime_type".
+func (u CreateHostRelatedDowntime) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := u.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "host":
+		return u.AsCreateHostDowntime()
+	case "hostgroup":
+		return u.AsCreateHostGroupDowntime()
+	case "host_by_query":
+		return u.AsCreateHostQueryDowntime()
+	}
+	return nil, fmt.Errorf("CreateHostRelatedDowntime: unknown downtime_type %q", discriminator)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u CreateHostRelatedDowntime) MarshalJSON() ([]byte, error) {
+	if len(u.union) == 0 {
+		return []byte("null"), nil
+	}
+	return u.union, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting unknown "downtime_type" values.
+func (u *CreateHostRelatedDowntime) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		u.union = nil
+		return nil
+	}
+	discriminator, err := unionDiscriminator(data, "downtime_type")
+	if err != nil {
+		return fmt.Errorf("CreateHostRelatedDowntime: %w", err)
+	}
+	switch discriminator {
+	case "host", "hostgroup", "host_by_query":
+	default:
+		return fmt.Errorf("CreateHostRelatedDowntime: unknown downtime_type %q", discriminator)
+	}
+	u.union = append(json.RawMessage(nil), data...)
+	return nil
 }
 
 // CreateServiceComment represents a CheckMK API type.
@@ -1487,7 +2786,7 @@ type CreateServiceQueryComment struct {
 	Persistent bool `json:"persistent,omitempty"`
 	// An query expression of the Livestatus 'services' table in nested dictionary form. If you want to use multiple expressions, nest them with the AND/OR operators.
 	// Example: {"op": "and", "expr": [{"op": "=", "left": "name", "right": "example.com"}, {"op": "!=", "left": "state", "right": "0"}]}
-	Query interface{} `json:"query"`
+	Query Expr `json:"query"`
 }
 
 // CreateServiceQueryDowntime represents a CheckMK API type.
@@ -1504,7 +2803,7 @@ type CreateServiceQueryDowntime struct {
 	EndTime string `json:"end_time"`
 	// An query expression of the Livestatus 'services' table in nested dictionary form. If you want to use multiple expressions, nest them with the AND/OR operators.
 	// Example: {"op": "and", "expr": [{"op": "=", "left": "name", "right": "example.com"}, {"op": "!=", "left": "state", "right": "0"}]}
-	Query interface{} `json:"query"`
+	Query Expr `json:"query"`
 	// The recurring mode of the new downtime. Available modes are: * fixed * hour * day * week * second_week * fourth_week * weekday_start * weekday_end * day_of_month This only works when using the Enterprise Editions. Defaults to 'fixed'.
 	// Example: hour
 	Recur CreateServiceQueryDowntimeRecur `json:"recur,omitempty"`
@@ -1513,25 +2812,208 @@ type CreateServiceQueryDowntime struct {
 	StartTime string `json:"start_time"`
 }
 
-// CreateServiceRelatedComment represents a CheckMK API type.
+// CreateServiceRelatedComment is a union of CreateServiceComment, CreateServiceQueryComment, selected by the "comment_type" property.
 type CreateServiceRelatedComment struct {
-}
-
-// CreateServiceRelatedDowntime represents a CheckMK API type.
+	union json.RawMessage
+}
+
+// Discriminator returns the value of the "comment_type" property.
+func (u CreateServiceRelatedComment) Discriminator() (string, error) {
+	return unionDiscriminator(u.union, "comment_type")
+}
+
+// AsCreateServiceComment returns the union data as a CreateServiceComment.
+func (u CreateServiceRelatedComment) AsCreateServiceComment() (CreateServiceComment, error) {
+	var v CreateServiceComment
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromCreateServiceComment sets the union to v, with "comment_type" set to "service".
+func (u *CreateServiceRelatedComment) FromCreateServiceComment(v CreateServiceComment) error {
+	data, err := unionFrom(v, "comment_type", "service")
+	if err != nil {
+		return fmt.Errorf("CreateServiceRelatedComment: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsCreateServiceQueryComment returns the union data as a CreateServiceQueryComment.
+func (u CreateServiceRelatedComment) AsCreateServiceQueryComment() (CreateServiceQueryComment, error) {
+	var v CreateServiceQueryComment
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromCreateServiceQueryComment sets the union to v, with "comment_type" set to "service_by_query".
+func (u *CreateServiceRelatedComment) FromCreateServiceQueryComment(v CreateServiceQueryComment) error {
+	data, err := unionFrom(v, "comment_type", "service_by_query")
+	if err != nil {
+		return fmt.Errorf("CreateServiceRelatedComment: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// ValueByDiscriminator decodes the union into the member selected by "comment_type".
+func (u CreateServiceRelatedComment) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := u.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "service":
+		return u.AsCreateServiceComment()
+	case "service_by_query":
+		return u.AsCreateServiceQueryComment()
+	}
+	return nil, fmt.Errorf("CreateServiceRelatedComment: unknown comment_type %q", discriminator)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u CreateServiceRelatedComment) MarshalJSON() ([]byte, error) {
+	if len(u.union) == 0 {
+		return []byte("null"), nil
+	}
+	return u.union, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting unknown "comment_type" values.
+func (u *CreateServiceRelatedComment) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		u.union = nil
+		return nil
+	}
+	discriminator, err := unionDiscriminator(data, "comment_type")
+	if err != nil {
+		return fmt.Errorf("CreateServiceRelatedComment: %w", err)
+	}
+	switch discriminator {
+	case "service", "service_by_query":
+	default:
+		return fmt.Errorf("CreateServiceRelatedComment: unknown comment_type %q", discriminator)
+	}
+	u.union = append(json.RawMessage(nil), data...)
+	return nil
+}
+
+// CreateServiceRelatedDowntime is a union of CreateServiceDowntime, CreateServiceGroupDowntime, CreateServiceQueryDowntime, selected by the "downtime_type" property.
 type CreateServiceRelatedDowntime struct {
+	union json.RawMessage
+}
+
+// Discriminator returns the value of the "downtime_type" property.
+func (u CreateServiceRelatedDowntime) Discriminator() (string, error) {
+	return unionDiscriminator(u.union, "downtime_type")
+}
+
+// AsCreateServiceDowntime returns the union data as a CreateServiceDowntime.
+func (u CreateServiceRelatedDowntime) AsCreateServiceDowntime() (CreateServiceDowntime, error) {
+	var v CreateServiceDowntime
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromCreateServiceDowntime sets the union to v, with "downtime_type" set to "service".
+func (u *CreateServiceRelatedDowntime) FromCreateServiceDowntime(v CreateServiceDowntime) error {
+	data, err := unionFrom(v, "downtime_type", "service")
+	if err != nil {
+		return fmt.Errorf("CreateServiceRelatedDowntime: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsCreateServiceGroupDowntime returns the union data as a CreateServiceGroupDowntime.
+func (u CreateServiceRelatedDowntime) AsCreateServiceGroupDowntime() (CreateServiceGroupDowntime, error) {
+	var v CreateServiceGroupDowntime
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromCreateServiceGroupDowntime sets the union to v, with "downtime_type" set to "servicegroup".
+func (u *CreateServiceRelatedDowntime) FromCreateServiceGroupDowntime(v CreateServiceGroupDowntime) error {
+	data, err := unionFrom(v, "downtime_type", "servicegroup")
+	if err != nil {
+		return fmt.Errorf("CreateServiceRelatedDowntime: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsCreateServiceQueryDowntime returns the union data as a CreateServiceQueryDowntime.
+func (u CreateServiceRelatedDowntime) AsCreateServiceQueryDowntime() (CreateServiceQueryDowntime, error) {
+	var v CreateServiceQueryDowntime
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromCreateServiceQueryDowntime sets the union to v, with "downtime_type" set to "service_by_query".
+func (u *CreateServiceRelatedDowntime) FromCreateServiceQueryDowntime(v CreateServiceQueryDowntime) error {
+	data, err := unionFrom(v, "downtime_type", "service_by_query")
+	if err != nil {
+		return fmt.Errorf("CreateServiceRelatedDowntime: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// ValueByDiscriminator decodes the union into the member selected by "downtime_type".
+func (u CreateServiceRelatedDowntime) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := u.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "service":
+		return u.AsCreateServiceDowntime()
+	case "servicegroup":
+		return u.AsCreateServiceGroupDowntime()
+	case "service_by_query":
+		return u.AsCreateServiceQueryDowntime()
+	}
+	return nil, fmt.Errorf("CreateServiceRelatedDowntime: unknown downtime_type %q", discriminator)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u CreateServiceRelatedDowntime) MarshalJSON() ([]byte, error) {
+	if len(u.union) == 0 {
+		return []byte("null"), nil
+	}
+	return u.union, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting unknown "downtime_type" values.
+func (u *CreateServiceRelatedDowntime) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		u.union = nil
+		return nil
+	}
+	discriminator, err := unionDiscriminator(data, "downtime_type")
+	if err != nil {
+		return fmt.Errorf("CreateServiceRelatedDowntime: %w", err)
+	}
+	switch discriminator {
+	case "service", "servicegroup", "service_by_query":
+	default:
+		return fmt.Errorf("CreateServiceRelatedDowntime: unknown downtime_type %q", discriminator)
+	}
+	u.union = append(json.RawMessage(nil), data...)
+	return nil
 }
 
 // CreateTimePeriod represents a CheckMK API type.
 type CreateTimePeriod struct {
 	// The list of active time ranges.
 	// Example: [map[day:monday time_ranges:[map[end:14:00:00 start:12:00:00]]]]
-	ActiveTimeRanges []map[string]interface{} `json:"active_time_ranges"`
+	ActiveTimeRanges []TimeRangeActive `json:"active_time_ranges"`
 	// An alias for the time period.
 	// Example: alias
 	Alias string `json:"alias"`
 	// A list of additional time ranges to be added.
 	// Example: [map[date:2020-01-01 time_ranges:[map[end:18:00:00 start:14:00:00]]]]
-	Exceptions []map[string]interface{} `json:"exceptions,omitempty"`
+	Exceptions []TimePeriodException `json:"exceptions,omitempty"`
 	// A list of time period aliases whose periods are excluded.
 	// Example: [alias]
 	Exclude []string `json:"exclude,omitempty"`
@@ -1544,27 +3026,27 @@ type CreateTimePeriod struct {
 type CreateUser struct {
 	// Authentication option for the user
 	// Example: map[auth_type:password password:password]
-	AuthOption interface{} `json:"auth_option,omitempty"`
+	AuthOption *AuthOption `json:"auth_option,omitempty"`
 	// The names of the sites the user is authorized to handle.
 	// Example: [heute]
 	AuthorizedSites []string `json:"authorized_sites,omitempty"`
 	// Contact settings for the user
 	// Example: map[email:user@example.com]
-	ContactOptions interface{} `json:"contact_options,omitempty"`
+	ContactOptions *UserContactOption `json:"contact_options,omitempty"`
 	// Assign the user to one or multiple contact groups. If no contact group is specified then no monitoring contact will be created for the user.
 	// Example: [all]
 	Contactgroups []string `json:"contactgroups,omitempty"`
 	// The user can be blocked from login but will remain part of the site. The disabling does not affect notification and alerts.
 	// Example: false
 	DisableLogin bool `json:"disable_login,omitempty"`
-	DisableNotifications interface{} `json:"disable_notifications,omitempty"`
+	DisableNotifications *DisabledNotifications `json:"disable_notifications,omitempty"`
 	// The alias or full name of the user
 	// Example: Mathias Kettner
 	Fullname string `json:"fullname"`
 	// Idle timeout for the user. Per default, the global configuration is used.
 	// Example: map[option:global]
-	IdleTimeout interface{} `json:"idle_timeout,omitempty"`
-	InterfaceOptions interface{} `json:"interface_options,omitempty"`
+	IdleTimeout *IdleOption `json:"idle_timeout,omitempty"`
+	InterfaceOptions *UserInterfaceAttributes `json:"interface_options,omitempty"`
 	// Configure the language to be used by the user in the user interface. Omitting this will configure the default language.
 	// Example: en
 	Language CreateUserLanguage `json:"language,omitempty"`
@@ -1631,8 +3113,109 @@ type DeleteCommentById struct {
 	DeleteType DeleteCommentByIdDeleteType `json:"delete_type"`
 }
 
-// DeleteComments represents a CheckMK API type.
+// DeleteComments is a union of DeleteCommentById, DeleteCommentsByQuery, DeleteCommentsByParams, selected by the "delete_type" property.
 type DeleteComments struct {
+	union json.RawMessage
+}
+
+// Discriminator returns the value of the "delete_type" property.
+func (u DeleteComments) Discriminator() (string, error) {
+	return unionDiscriminator(u.union, "delete_type")
+}
+
+// AsDeleteCommentById returns the union data as a DeleteCommentById.
+func (u DeleteComments) AsDeleteCommentById() (DeleteCommentById, error) {
+	var v DeleteCommentById
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromDeleteCommentById sets the union to v, with "delete_type" set to "by_id".
+func (u *DeleteComments) FromDeleteCommentById(v DeleteCommentById) error {
+	data, err := unionFrom(v, "delete_type", "by_id")
+	if err != nil {
+		return fmt.Errorf("DeleteComments: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsDeleteCommentsByQuery returns the union data as a DeleteCommentsByQuery.
+func (u DeleteComments) AsDeleteCommentsByQuery() (DeleteCommentsByQuery, error) {
+	var v DeleteCommentsByQuery
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromDeleteCommentsByQuery sets the union to v, with "delete_type" set to "query".
+func (u *DeleteComments) FromDeleteCommentsByQuery(v DeleteCommentsByQuery) error {
+	data, err := unionFrom(v, "delete_type", "query")
+	if err != nil {
+		return fmt.Errorf("DeleteComments: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsDeleteCommentsByParams returns the union data as a DeleteCommentsByParams.
+func (u DeleteComments) AsDeleteCommentsByParams() (DeleteCommentsByParams, error) {
+	var v DeleteCommentsByParams
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromDeleteCommentsByParams sets the union to v, with "delete_type" set to "params".
+func (u *DeleteComments) FromDeleteCommentsByParams(v DeleteCommentsByParams) error {
+	data, err := unionFrom(v, "delete_type", "params")
+	if err != nil {
+		return fmt.Errorf("DeleteComments: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// ValueByDiscriminator decodes the union into the member selected by "delete_type".
+func (u DeleteComments) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := u.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "by_id":
+		return u.AsDeleteCommentById()
+	case "query":
+		return u.AsDeleteCommentsByQuery()
+	case "params":
+		return u.AsDeleteCommentsByParams()
+	}
+	return nil, fmt.Errorf("DeleteComments: unknown delete_type %q", discriminator)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u DeleteComments) MarshalJSON() ([]byte, error) {
+	if len(u.union) == 0 {
+		return []byte("null"), nil
+	}
+	return u.union, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting unknown "delete_type" values.
+func (u *DeleteComments) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		u.union = nil
+		return nil
+	}
+	discriminator, err := unionDiscriminator(data, "delete_type")
+	if err != nil {
+		return fmt.Errorf("DeleteComments: %w", err)
+	}
+	switch discriminator {
+	case "by_id", "query", "params":
+	default:
+		return fmt.Errorf("DeleteComments: unknown delete_type %q", discriminator)
+	}
+	u.union = append(json.RawMessage(nil), data...)
+	return nil
 }
 
 // DeleteCommentsByParams represents a CheckMK API type.
@@ -1655,11 +3238,112 @@ type DeleteCommentsByQuery struct {
 	DeleteType DeleteCommentsByQueryDeleteType `json:"delete_type"`
 	// An query expression of the Livestatus 'comments' table in nested dictionary form. If you want to use multiple expressions, nest them with the AND/OR operators.
 	// Example: {"op": "and", "expr": [{"op": "=", "left": "name", "right": "example.com"}, {"op": "!=", "left": "state", "right": "0"}]}
-	Query interface{} `json:"query,omitempty"`
+	Query *Expr `json:"query,omitempty"`
 }
 
-// DeleteDowntime represents a CheckMK API type.
+// DeleteDowntime is a union of DeleteDowntimeById, DeleteDowntimeByName, DeleteDowntimeByQuery, selected by the "delete_type" property.
 type DeleteDowntime struct {
+	union json.RawMessage
+}
+
+// Discriminator returns the value of the "delete_type" property.
+func (u DeleteDowntime) Discriminator() (string, error) {
+	return unionDiscriminator(u.union, "delete_type")
+}
+
+// AsDeleteDowntimeById returns the union data as a DeleteDowntimeById.
+func (u DeleteDowntime) AsDeleteDowntimeById() (DeleteDowntimeById, error) {
+	var v DeleteDowntimeById
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromDeleteDowntimeById sets the union to v, with "delete_type" set to "by_id".
+func (u *DeleteDowntime) FromDeleteDowntimeById(v DeleteDowntimeById) error {
+	data, err := unionFrom(v, "delete_type", "by_id")
+	if err != nil {
+		return fmt.Errorf("DeleteDowntime: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsDeleteDowntimeByName returns the union data as a DeleteDowntimeByName.
+func (u DeleteDowntime) AsDeleteDowntimeByName() (DeleteDowntimeByName, error) {
+	var v DeleteDowntimeByName
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromDeleteDowntimeByName sets the union to v, with "delete_type" set to "params".
+func (u *DeleteDowntime) FromDeleteDowntimeByName(v DeleteDowntimeByName) error {
+	data, err := unionFrom(v, "delete_type", "params")
+	if err != nil {
+		return fmt.Errorf("DeleteDowntime: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsDeleteDowntimeByQuery returns the union data as a DeleteDowntimeByQuery.
+func (u DeleteDowntime) AsDeleteDowntimeByQuery() (DeleteDowntimeByQuery, error) {
+	var v DeleteDowntimeByQuery
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromDeleteDowntimeByQuery sets the union to v, with "delete_type" set to "query".
+func (u *DeleteDowntime) FromDeleteDowntimeByQuery(v DeleteDowntimeByQuery) error {
+	data, err := unionFrom(v, "delete_type", "query")
+	if err != nil {
+		return fmt.Errorf("DeleteDowntime: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// ValueByDiscriminator decodes the union into the member selected by "delete_type".
+func (u DeleteDowntime) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := u.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "by_id":
+		return u.AsDeleteDowntimeById()
+	case "params":
+		return u.AsDeleteDowntimeByName()
+	case "query":
+		return u.AsDeleteDowntimeByQuery()
+	}
+	return nil, fmt.Errorf("DeleteDowntime: unknown delete_type %q", discriminator)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u DeleteDowntime) MarshalJSON() ([]byte, error) {
+	if len(u.union) == 0 {
+		return []byte("null"), nil
+	}
+	return u.union, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting unknown "delete_type" values.
+func (u *DeleteDowntime) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		u.union = nil
+		return nil
+	}
+	discriminator, err := unionDiscriminator(data, "delete_type")
+	if err != nil {
+		return fmt.Errorf("DeleteDowntime: %w", err)
+	}
+	switch discriminator {
+	case "by_id", "params", "query":
+	default:
+		return fmt.Errorf("DeleteDowntime: unknown delete_type %q", discriminator)
+	}
+	u.union = append(json.RawMessage(nil), data...)
+	return nil
 }
 
 // DeleteDowntimeById represents a CheckMK API type.
@@ -1692,11 +3376,112 @@ type DeleteDowntimeByQuery struct {
 	DeleteType DeleteDowntimeByQueryDeleteType `json:"delete_type"`
 	// An query expression of the Livestatus 'downtimes' table in nested dictionary form. If you want to use multiple expressions, nest them with the AND/OR operators.
 	// Example: {"op": "and", "expr": [{"op": "=", "left": "name", "right": "example.com"}, {"op": "!=", "left": "state", "right": "0"}]}
-	Query interface{} `json:"query"`
+	Query Expr `json:"query"`
 }
 
-// DeleteECEvents represents a CheckMK API type.
+// DeleteECEvents is a union of FilterById, FilterByQuery, FilterByParams, selected by the "filter_type" property.
 type DeleteECEvents struct {
+	union json.RawMessage
+}
+
+// Discriminator returns the value of the "filter_type" property.
+func (u DeleteECEvents) Discriminator() (string, error) {
+	return unionDiscriminator(u.union, "filter_type")
+}
+
+// AsFilterById returns the union data as a FilterById.
+func (u DeleteECEvents) AsFilterById() (FilterById, error) {
+	var v FilterById
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromFilterById sets the union to v, with "filter_type" set to "by_id".
+func (u *DeleteECEvents) FromFilterById(v FilterById) error {
+	data, err := unionFrom(v, "filter_type", "by_id")
+	if err != nil {
+		return fmt.Errorf("DeleteECEvents: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsFilterByQuery returns the union data as a FilterByQuery.
+func (u DeleteECEvents) AsFilterByQuery() (FilterByQuery, error) {
+	var v FilterByQuery
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromFilterByQuery sets the union to v, with "filter_type" set to "query".
+func (u *DeleteECEvents) FromFilterByQuery(v FilterByQuery) error {
+	data, err := unionFrom(v, "filter_type", "query")
+	if err != nil {
+		return fmt.Errorf("DeleteECEvents: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsFilterByParams returns the union data as a FilterByParams.
+func (u DeleteECEvents) AsFilterByParams() (FilterByParams, error) {
+	var v FilterByParams
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromFilterByParams sets the union to v, with "filter_type" set to "params".
+func (u *DeleteECEvents) FromFilterByParams(v FilterByParams) error {
+	data, err := unionFrom(v, "filter_type", "params")
+	if err != nil {
+		return fmt.Errorf("DeleteECEvents: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// ValueByDiscriminator decodes the union into the member selected by "filter_type".
+func (u DeleteECEvents) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := u.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "by_id":
+		return u.AsFilterById()
+	case "query":
+		return u.AsFilterByQuery()
+	case "params":
+		return u.AsFilterByParams()
+	}
+	return nil, fmt.Errorf("DeleteECEvents: unknown filter_type %q", discriminator)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u DeleteECEvents) MarshalJSON() ([]byte, error) {
+	if len(u.union) == 0 {
+		return []byte("null"), nil
+	}
+	return u.union, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting unknown "filter_type" values.
+func (u *DeleteECEvents) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		u.union = nil
+		return nil
+	}
+	discriminator, err := unionDiscriminator(data, "filter_type")
+	if err != nil {
+		return fmt.Errorf("DeleteECEvents: %w", err)
+	}
+	switch discriminator {
+	case "by_id", "query", "params":
+	default:
+		return fmt.Errorf("DeleteECEvents: unknown filter_type %q", discriminator)
+	}
+	u.union = append(json.RawMessage(nil), data...)
+	return nil
 }
 
 // DirectMapping represents a CheckMK API type.
@@ -1714,7 +3499,7 @@ type DisabledNotifications struct {
 	Disable bool `json:"disable,omitempty"`
 	// A custom timerange during which notifications are disabled
 	// Example: map[end_time:2017-07-21T18:32:28Z start_time:2017-07-21T17:32:28Z]
-	Timerange interface{} `json:"timerange,omitempty"`
+	Timerange *CustomTimeRange `json:"timerange,omitempty"`
 }
 
 // DiscoverServices represents a CheckMK API type.
@@ -1739,11 +3524,11 @@ type DiscoveryBackgroundJobStatusObject struct {
 	// The domain type of the object
 	DomainType interface{} `json:"domainType,omitempty"`
 	// The attributes of the background job
-	Extensions interface{} `json:"extensions,omitempty"`
+	Extensions *BackgroundJobStatus `json:"extensions,omitempty"`
 	// The unique identifier for this domain-object type.
 	Id string `json:"id,omitempty"`
 	// list of links to other resources.
-	Links []map[string]interface{} `json:"links"`
+	Links []Link `json:"links"`
 	// The container for external resources, like linked foreign objects or actions.
 	Members map[string]interface{} `json:"members,omitempty"`
 	// A human readable title of this object. Can be used for user interfaces.
@@ -1759,7 +3544,7 @@ type DomainObject struct {
 	// The unique identifier for this domain-object type.
 	Id string `json:"id,omitempty"`
 	// list of links to other resources.
-	Links []map[string]interface{} `json:"links"`
+	Links []Link `json:"links"`
 	// The container for external resources, like linked foreign objects or actions.
 	Members map[string]interface{} `json:"members,omitempty"`
 	// A human readable title of this object. Can be used for user interfaces.
@@ -1775,11 +3560,11 @@ type DomainObjectCollection struct {
 	// The name of this collection.
 	Id string `json:"id,omitempty"`
 	// list of links to other resources.
-	Links []map[string]interface{} `json:"links"`
+	Links []Link `json:"links"`
 	// A human readable title of this object. Can be used for user interfaces.
 	Title string `json:"title,omitempty"`
 	// The collection itself. Each entry in here is part of the collection.
-	Value []map[string]interface{} `json:"value,omitempty"`
+	Value []CollectionItem `json:"value,omitempty"`
 }
 
 // ECEventAttributes represents a CheckMK API type.
@@ -1833,11 +3618,11 @@ type ECEventResponse struct {
 	DomainType interface{} `json:"domainType,omitempty"`
 	// The configuration attributes of a site.
 	// Example: map[application:app_1 comment:example_comment contact:Mr Monitor count:1 facility:kern first:Oct 26 2022 07:51:25 host:host_1 ipaddress:127.0.0.1 last:Oct 21 2022 09:11:12 phase:open priority:warning rule_id:rule_1 service_level:gold state:okay text:Sample message text.]
-	Extensions interface{} `json:"extensions,omitempty"`
+	Extensions *ECEventAttributes `json:"extensions,omitempty"`
 	// The unique identifier for this domain-object type.
 	Id string `json:"id,omitempty"`
 	// list of links to other resources.
-	Links []map[string]interface{} `json:"links"`
+	Links []Link `json:"links"`
 	// The container for external resources, like linked foreign objects or actions.
 	Members map[string]interface{} `json:"members,omitempty"`
 	// A human readable title of this object. Can be used for user interfaces.
@@ -1869,16 +3654,117 @@ type EventConsoleResponseCollection struct {
 	// The name of this collection.
 	Id string `json:"id,omitempty"`
 	// list of links to other resources.
-	Links []map[string]interface{} `json:"links"`
+	Links []Link `json:"links"`
 	// A human readable title of this object. Can be used for user interfaces.
 	Title string `json:"title,omitempty"`
 	// A list of site configuration objects.
 	// Example: [map[domainType:event_console extensions:map[application:app_1 comment:example_comment contact:Mr Monitor count:1 facility:kern first:Oct 26 2022 07:51:25 host:host_1 ipaddress:127.0.0.1 last:Oct 21 2022 09:11:12 phase:open priority:warning rule_id:rule_1 service_level:gold state:okay text:Sample...
-	Value []map[string]interface{} `json:"value,omitempty"`
+	Value []ECEventResponse `json:"value,omitempty"`
 }
 
-// Expr represents a CheckMK API type.
+// Expr is a union of LogicalExpr, NotExpr, BinaryExpr, selected by the "op" property.
 type Expr struct {
+	union json.RawMessage
+}
+
+// Discriminator returns the value of the "op" property.
+func (u Expr) Discriminator() (string, error) {
+	return unionDiscriminator(u.union, "op")
+}
+
+// AsLogicalExpr returns the union data as a LogicalExpr.
+func (u Expr) AsLogicalExpr() (LogicalExpr, error) {
+	var v LogicalExpr
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromLogicalExpr sets the union to v, whose "op" must be one of "and", "or".
+func (u *Expr) FromLogicalExpr(v LogicalExpr) error {
+	data, err := unionFrom(v, "op", "and", "or")
+	if err != nil {
+		return fmt.Errorf("Expr: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsNotExpr returns the union data as a NotExpr.
+func (u Expr) AsNotExpr() (NotExpr, error) {
+	var v NotExpr
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromNotExpr sets the union to v, with "op" set to "not".
+func (u *Expr) FromNotExpr(v NotExpr) error {
+	data, err := unionFrom(v, "op", "not")
+	if err != nil {
+		return fmt.Errorf("Expr: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsBinaryExpr returns the union data as a BinaryExpr.
+func (u Expr) AsBinaryExpr() (BinaryExpr, error) {
+	var v BinaryExpr
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromBinaryExpr sets the union to v, whose "op" must be one of "!<", "!<=", "!=", "!>", "!>=", "!~", "!~~", "<", "<=", "=", ">", ">=", "~", "~~".
+func (u *Expr) FromBinaryExpr(v BinaryExpr) error {
+	data, err := unionFrom(v, "op", "!<", "!<=", "!=", "!>", "!>=", "!~", "!~~", "<", "<=", "=", ">", ">=", "~", "~~")
+	if err != nil {
+		return fmt.Errorf("Expr: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// ValueByDiscriminator decodes the union into the member selected by "op".
+func (u Expr) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := u.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "and", "or":
+		return u.AsLogicalExpr()
+	case "not":
+		return u.AsNotExpr()
+	case "!<", "!<=", "!=", "!>", "!>=", "!~", "!~~", "<", "<=", "=", ">", ">=", "~", "~~":
+		return u.AsBinaryExpr()
+	}
+	return nil, fmt.Errorf("Expr: unknown op %q", discriminator)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u Expr) MarshalJSON() ([]byte, error) {
+	if len(u.union) == 0 {
+		return []byte("null"), nil
+	}
+	return u.union, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting unknown "op" values.
+func (u *Expr) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		u.union = nil
+		return nil
+	}
+	discriminator, err := unionDiscriminator(data, "op")
+	if err != nil {
+		return fmt.Errorf("Expr: %w", err)
+	}
+	switch discriminator {
+	case "and", "or", "not", "!<", "!<=", "!=", "!>", "!>=", "!~", "!~~", "<", "<=", "=", ">", ">=", "~", "~~":
+	default:
+		return fmt.Errorf("Expr: unknown op %q", discriminator)
+	}
+	u.union = append(json.RawMessage(nil), data...)
+	return nil
 }
 
 // FailedHosts represents a CheckMK API type.
@@ -1886,7 +3772,7 @@ type FailedHosts struct {
 	// Detailed error messages on hosts failing the action
 	FailedHosts map[string]interface{} `json:"failed_hosts,omitempty"`
 	// The list of succeeded host objects
-	SucceededHosts interface{} `json:"succeeded_hosts,omitempty"`
+	SucceededHosts *HostConfigCollection `json:"succeeded_hosts,omitempty"`
 }
 
 // FilterById represents a CheckMK API type.
@@ -1904,7 +3790,7 @@ type FilterByParams struct {
 	// The way you would like to filter events.
 	// Example: by_id
 	FilterType FilterByParamsFilterType `json:"filter_type"`
-	Filters map[string]interface{} `json:"filters"`
+	Filters FilterParams `json:"filters"`
 }
 
 // FilterByQuery represents a CheckMK API type.
@@ -1914,7 +3800,7 @@ type FilterByQuery struct {
 	FilterType FilterByQueryFilterType `json:"filter_type"`
 	// An query expression of the Livestatus 'eventconsoleevents' table in nested dictionary form. If you want to use multiple expressions, nest them with the AND/OR operators.
 	// Example: {"op": "=", "left": "eventconsoleevents.event_host", "right": "test_host"}
-	Query interface{} `json:"query"`
+	Query Expr `json:"query"`
 }
 
 // FilterParams represents a CheckMK API type.
@@ -1951,13 +3837,13 @@ type Folder struct {
 	// The domain type of the object.
 	DomainType interface{} `json:"domainType,omitempty"`
 	// Data and Meta-Data of this object.
-	Extensions interface{} `json:"extensions,omitempty"`
+	Extensions *FolderExtensions `json:"extensions,omitempty"`
 	// The full path of the folder, tilde-separated.
 	Id string `json:"id,omitempty"`
 	// list of links to other resources.
-	Links []map[string]interface{} `json:"links"`
+	Links []Link `json:"links"`
 	// Specific collections or actions applicable to this object.
-	Members interface{} `json:"members,omitempty"`
+	Members *FolderMembers `json:"members,omitempty"`
 	// The human readable title for this folder.
 	Title string `json:"title,omitempty"`
 }
@@ -1971,33 +3857,33 @@ type FolderCollection struct {
 	// The name of this collection.
 	Id string `json:"id,omitempty"`
 	// list of links to other resources.
-	Links []map[string]interface{} `json:"links"`
+	Links []Link `json:"links"`
 	// A human readable title of this object. Can be used for user interfaces.
 	Title string `json:"title,omitempty"`
 	// A list of folder objects.
-	Value []map[string]interface{} `json:"value,omitempty"`
+	Value []Folder `json:"value,omitempty"`
 }
 
 // FolderCreateAttribute represents a CheckMK API type.
 type FolderCreateAttribute struct {
 	// Only members of the contact groups listed here have Setup permission for the host/folder. Optionally, you can make these contact groups automatically monitor contacts. The assignment of hosts to contact groups can also be defined by <a href='wato.py?mode=edit_ruleset&varname=host_contactgroups'>r...
-	Contactgroups interface{} `json:"contactgroups,omitempty"`
+	Contactgroups *HostContactGroup `json:"contactgroups,omitempty"`
 	// Labels allow you to flexibly group your hosts in order to refer to them later at other places in Checkmk, e.g. in rule chains.<br><b>Label format:</b> key:value<br><br>Checkmk does not perform any validation on the labels you use.
 	Labels map[string]interface{} `json:"labels,omitempty"`
 	// IPMI credentials
-	ManagementIpmiCredentials interface{} `json:"management_ipmi_credentials,omitempty"`
+	ManagementIpmiCredentials *IPMIParameters `json:"management_ipmi_credentials,omitempty"`
 	// The protocol used to connect to the management board. Valid options are: * `none` - No management board * `snmp` - Connect using SNMP * `ipmi` - Connect using IPMI
 	ManagementProtocol FolderCreateAttributeManagementProtocol `json:"management_protocol,omitempty"`
 	// SNMP credentials
-	ManagementSnmpCommunity interface{} `json:"management_snmp_community,omitempty"`
+	ManagementSnmpCommunity *SNMPCredentials `json:"management_snmp_community,omitempty"`
 	// Configuration for automatic network scan. Pings will besent to each IP address in the configured ranges to checkif a host is up or down. Each found host will be added tothe folder by it's hostname (if possible) or IP address.
-	NetworkScan interface{} `json:"network_scan,omitempty"`
+	NetworkScan *NetworkScan `json:"network_scan,omitempty"`
 	// A list of parents of this host.
 	Parents []string `json:"parents,omitempty"`
 	// The site that should monitor this host.
 	Site string `json:"site,omitempty"`
 	// The SNMP access configuration. A configured SNMP v1/v2 community here will have precedence over any configured SNMP community rule. For this attribute to take effect, the attribute `tag_snmp_ds` needs to be set first.
-	SnmpCommunity interface{} `json:"snmp_community,omitempty"`
+	SnmpCommunity *SNMPCredentials `json:"snmp_community,omitempty"`
 	// Choices: * `"ip-v4-only"`: IPv4 only * `"ip-v6-only"`: IPv6 only * `"ip-v4v6"`: IPv4/IPv6 dual-stack * `"no-ip"`: No IP
 	TagAddressFamily FolderCreateAttributeTagAddressFamily `json:"tag_address_family,omitempty"`
 	// Choices: * `"cmk-agent"`: API integrations if configured, else Checkmk agent * `"all-agents"`: Configured API integrations and Checkmk agent * `"special-agents"`: Configured API integrations, no Checkmk agent * `"no-agent"`: No API integrations, no Checkmk agent
@@ -2023,31 +3909,31 @@ type FolderExtensions struct {
 // FolderMembers represents a CheckMK API type.
 type FolderMembers struct {
 	// A list of links pointing to the actual host-resources.
-	Hosts interface{} `json:"hosts,omitempty"`
+	Hosts *ObjectCollectionMember `json:"hosts,omitempty"`
 	// An action which triggers the move of this folder to another folder.
-	Move interface{} `json:"move,omitempty"`
+	Move *ObjectActionMember `json:"move,omitempty"`
 }
 
 // FolderUpdateAttribute represents a CheckMK API type.
 type FolderUpdateAttribute struct {
 	// Only members of the contact groups listed here have Setup permission for the host/folder. Optionally, you can make these contact groups automatically monitor contacts. The assignment of hosts to contact groups can also be defined by <a href='wato.py?mode=edit_ruleset&varname=host_contactgroups'>r...
-	Contactgroups interface{} `json:"contactgroups,omitempty"`
+	Contactgroups *HostContactGroup `json:"contactgroups,omitempty"`
 	// Labels allow you to flexibly group your hosts in order to refer to them later at other places in Checkmk, e.g. in rule chains.<br><b>Label format:</b> key:value<br><br>Checkmk does not perform any validation on the labels you use.
 	Labels map[string]interface{} `json:"labels,omitempty"`
 	// IPMI credentials
-	ManagementIpmiCredentials interface{} `json:"management_ipmi_credentials,omitempty"`
+	ManagementIpmiCredentials *IPMIParameters `json:"management_ipmi_credentials,omitempty"`
 	// The protocol used to connect to the management board. Valid options are: * `none` - No management board * `snmp` - Connect using SNMP * `ipmi` - Connect using IPMI
 	ManagementProtocol FolderUpdateAttributeManagementProtocol `json:"management_protocol,omitempty"`
 	// SNMP credentials
-	ManagementSnmpCommunity interface{} `json:"management_snmp_community,omitempty"`
+	ManagementSnmpCommunity *SNMPCredentials `json:"management_snmp_community,omitempty"`
 	// Configuration for automatic network scan. Pings will besent to each IP address in the configured ranges to checkif a host is up or down. Each found host will be added tothe folder by it's hostname (if possible) or IP address.
-	NetworkScan interface{} `json:"network_scan,omitempty"`
+	NetworkScan *NetworkScan `json:"network_scan,omitempty"`
 	// A list of parents of this host.
 	Parents []string `json:"parents,omitempty"`
 	// The site that should monitor this host.
 	Site string `json:"site,omitempty"`
 	// The SNMP access configuration. A configured SNMP v1/v2 community here will have precedence over any configured SNMP community rule. For this attribute to take effect, the attribute `tag_snmp_ds` needs to be set first.
-	SnmpCommunity interface{} `json:"snmp_community,omitempty"`
+	SnmpCommunity *SNMPCredentials `json:"snmp_community,omitempty"`
 	// Choices: * `"ip-v4-only"`: IPv4 only * `"ip-v6-only"`: IPv6 only * `"ip-v4v6"`: IPv4/IPv6 dual-stack * `"no-ip"`: No IP
 	TagAddressFamily FolderUpdateAttributeTagAddressFamily `json:"tag_address_family,omitempty"`
 	// Choices: * `"cmk-agent"`: API integrations if configured, else Checkmk agent * `"all-agents"`: Configured API integrations and Checkmk agent * `"special-agents"`: Configured API integrations, no Checkmk agent * `"no-agent"`: No API integrations, no Checkmk agent
@@ -2065,27 +3951,27 @@ type FolderUpdateAttribute struct {
 // FolderViewAttribute represents a CheckMK API type.
 type FolderViewAttribute struct {
 	// Only members of the contact groups listed here have Setup permission for the host/folder. Optionally, you can make these contact groups automatically monitor contacts. The assignment of hosts to contact groups can also be defined by <a href='wato.py?mode=edit_ruleset&varname=host_contactgroups'>r...
-	Contactgroups interface{} `json:"contactgroups,omitempty"`
+	Contactgroups *HostContactGroup `json:"contactgroups,omitempty"`
 	// Labels allow you to flexibly group your hosts in order to refer to them later at other places in Checkmk, e.g. in rule chains.<br><b>Label format:</b> key:value<br><br>Checkmk does not perform any validation on the labels you use.
 	Labels map[string]interface{} `json:"labels,omitempty"`
 	// IPMI credentials
-	ManagementIpmiCredentials interface{} `json:"management_ipmi_credentials,omitempty"`
+	ManagementIpmiCredentials *IPMIParameters `json:"management_ipmi_credentials,omitempty"`
 	// The protocol used to connect to the management board. Valid options are: * `none` - No management board * `snmp` - Connect using SNMP * `ipmi` - Connect using IPMI
 	ManagementProtocol FolderViewAttributeManagementProtocol `json:"management_protocol,omitempty"`
 	// SNMP credentials
-	ManagementSnmpCommunity interface{} `json:"management_snmp_community,omitempty"`
+	ManagementSnmpCommunity *SNMPCredentials `json:"management_snmp_community,omitempty"`
 	// Read only access to configured metadata.
-	MetaData interface{} `json:"meta_data,omitempty"`
+	MetaData *MetaData `json:"meta_data,omitempty"`
 	// Configuration for automatic network scan. Pings will besent to each IP address in the configured ranges to checkif a host is up or down. Each found host will be added tothe folder by it's hostname (if possible) or IP address.
-	NetworkScan interface{} `json:"network_scan,omitempty"`
+	NetworkScan *NetworkScan `json:"network_scan,omitempty"`
 	// Read only access to the network scan result
-	NetworkScanResult interface{} `json:"network_scan_result,omitempty"`
+	NetworkScanResult *NetworkScanResult `json:"network_scan_result,omitempty"`
 	// A list of parents of this host.
 	Parents []string `json:"parents,omitempty"`
 	// The site that should monitor this host.
 	Site string `json:"site,omitempty"`
 	// The SNMP access configuration. A configured SNMP v1/v2 community here will have precedence over any configured SNMP community rule. For this attribute to take effect, the attribute `tag_snmp_ds` needs to be set first.
-	SnmpCommunity interface{} `json:"snmp_community,omitempty"`
+	SnmpCommunity *SNMPCredentials `json:"snmp_community,omitempty"`
 	TagAddressFamily string `json:"tag_address_family,omitempty"`
 	TagAgent string `json:"tag_agent,omitempty"`
 	TagCriticality string `json:"tag_criticality,omitempty"`
@@ -2095,8 +3981,90 @@ type FolderViewAttribute struct {
 	TagSnmpDs string `json:"tag_snmp_ds,omitempty"`
 }
 
-// Get represents a CheckMK API type.
+// Get is a union of GetGraph, GetMetric, selected by the "type" property.
 type Get struct {
+	union json.RawMessage
+}
+
+// Discriminator returns the value of the "type" property.
+func (u Get) Discriminator() (string, error) {
+	return unionDiscriminator(u.union, "type")
+}
+
+// AsGetGraph returns the union data as a GetGraph.
+func (u Get) AsGetGraph() (GetGraph, error) {
+	var v GetGraph
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromGetGraph sets the union to v, with "type" set to "predefined_graph".
+func (u *Get) FromGetGraph(v GetGraph) error {
+	data, err := unionFrom(v, "type", "predefined_graph")
+	if err != nil {
+		return fmt.Errorf("Get: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsGetMetric returns the union data as a GetMetric.
+func (u Get) AsGetMetric() (GetMetric, error) {
+	var v GetMetric
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromGetMetric sets the union to v, with "type" set to "single_metric".
+func (u *Get) FromGetMetric(v GetMetric) error {
+	data, err := unionFrom(v, "type", "single_metric")
+	if err != nil {
+		return fmt.Errorf("Get: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// ValueByDiscriminator decodes the union into the member selected by "type".
+func (u Get) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := u.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "predefined_graph":
+		return u.AsGetGraph()
+	case "single_metric":
+		return u.AsGetMetric()
+	}
+	return nil, fmt.Errorf("Get: unknown type %q", discriminator)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u Get) MarshalJSON() ([]byte, error) {
+	if len(u.union) == 0 {
+		return []byte("null"), nil
+	}
+	return u.union, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting unknown "type" values.
+func (u *Get) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		u.union = nil
+		return nil
+	}
+	discriminator, err := unionDiscriminator(data, "type")
+	if err != nil {
+		return fmt.Errorf("Get: %w", err)
+	}
+	switch discriminator {
+	case "predefined_graph", "single_metric":
+	default:
+		return fmt.Errorf("Get: unknown type %q", discriminator)
+	}
+	u.union = append(json.RawMessage(nil), data...)
+	return nil
 }
 
 // GetGraph represents a CheckMK API type.
@@ -2118,7 +4086,7 @@ type GetGraph struct {
 	Site string `json:"site,omitempty"`
 	// The time range from which to source the metrics.
 	// Example: map[end:2026-01-01 13:58:04.000471 start:2026-01-01 13:43:04.000469]
-	TimeRange interface{} `json:"time_range"`
+	TimeRange TimeRange `json:"time_range"`
 	// Specify whether you want to receive a single metric (via metric_id), or a predefined graph containing multiple metrics (via graph_id).
 	// Example: single_metric
 	Type GetGraphType `json:"type"`
@@ -2143,7 +4111,7 @@ type GetMetric struct {
 	Site string `json:"site,omitempty"`
 	// The time range from which to source the metrics.
 	// Example: map[end:2026-01-01 13:58:04.000471 start:2026-01-01 13:43:04.000469]
-	TimeRange interface{} `json:"time_range"`
+	TimeRange TimeRange `json:"time_range"`
 	// Specify whether you want to receive a single metric (via metric_id), or a predefined graph containing multiple metrics (via graph_id).
 	// Example: single_metric
 	Type GetMetricType `json:"type"`
@@ -2153,13 +4121,13 @@ type GetMetric struct {
 type GraphCollection struct {
 	// The actual graph data.
 	// Example: [map[color:#ffffff data_points:[1 2 3 1] line_type:area title:RAM used]]
-	Metrics []map[string]interface{} `json:"metrics"`
+	Metrics []Metric `json:"metrics"`
 	// The interval between two samples in seconds.
 	// Example: 60
 	Step int `json:"step"`
 	// The time range withing the samples of the response lie.
 	// Example: map[time_range:map[end:1970-01-01T00:00:30Z start:1970-01-01T00:00:00Z]]
-	TimeRange interface{} `json:"time_range"`
+	TimeRange TimeRange `json:"time_range"`
 }
 
 // Heartbeat represents a CheckMK API type.
@@ -2189,7 +4157,7 @@ type Host struct {
 
 // HostConditions represents a CheckMK API type.
 type HostConditions struct {
-	HostChoice interface{} `json:"host_choice"`
+	HostChoice BIHostChoice `json:"host_choice"`
 	HostFolder string `json:"host_folder"`
 	HostLabels map[string]interface{} `json:"host_labels"`
 	HostTags map[string]interface{} `json:"host_tags"`
@@ -2200,13 +4168,13 @@ type HostConfig struct {
 	// The domain type of the object.
 	DomainType interface{} `json:"domainType"`
 	// All the data and metadata of this host.
-	Extensions interface{} `json:"extensions,omitempty"`
+	Extensions *HostExtensions `json:"extensions,omitempty"`
 	// The unique identifier for this domain-object type.
 	Id string `json:"id,omitempty"`
 	// list of links to other resources.
-	Links []map[string]interface{} `json:"links"`
+	Links []Link `json:"links"`
 	// All the members of the host object.
-	Members interface{} `json:"members,omitempty"`
+	Members *HostMembers `json:"members,omitempty"`
 	// A human readable title of this object. Can be used for user interfaces.
 	Title string `json:"title,omitempty"`
 }
@@ -2220,11 +4188,11 @@ type HostConfigCollection struct {
 	// The name of this collection.
 	Id string `json:"id,omitempty"`
 	// list of links to other resources.
-	Links []map[string]interface{} `json:"links"`
+	Links []Link `json:"links"`
 	// A human readable title of this object. Can be used for user interfaces.
 	Title string `json:"title,omitempty"`
 	// A list of host objects.
-	Value []map[string]interface{} `json:"value,omitempty"`
+	Value []HostConfig `json:"value,omitempty"`
 }
 
 // HostConfigSchemaInternal represents a CheckMK API type.
@@ -2258,7 +4226,7 @@ type HostCreateAttribute struct {
 	// Add a comment or describe this host
 	Alias string `json:"alias,omitempty"`
 	// Only members of the contact groups listed here have Setup permission for the host/folder. Optionally, you can make these contact groups automatically monitor contacts. The assignment of hosts to contact groups can also be defined by <a href='wato.py?mode=edit_ruleset&varname=host_contactgroups'>r...
-	Contactgroups interface{} `json:"contactgroups,omitempty"`
+	Contactgroups *HostContactGroup `json:"contactgroups,omitempty"`
 	// Whether or not the last bulk discovery failed. It is set to True once it fails and unset in case a later discovery succeeds.
 	// Example: false
 	InventoryFailed bool `json:"inventory_failed,omitempty"`
@@ -2271,23 +4239,23 @@ type HostCreateAttribute struct {
 	// Name of host attributes which are locked in the UI.
 	LockedAttributes []string `json:"locked_attributes,omitempty"`
 	// Identity of the entity which locked the locked_attributes. The identity is built out of the Site ID, the program name and the connection ID.
-	LockedBy interface{} `json:"locked_by,omitempty"`
+	LockedBy *LockedBy `json:"locked_by,omitempty"`
 	// Address (IPv4, IPv6 or hostname) under which the management board can be reached.
 	ManagementAddress string `json:"management_address,omitempty"`
 	// IPMI credentials
-	ManagementIpmiCredentials interface{} `json:"management_ipmi_credentials,omitempty"`
+	ManagementIpmiCredentials *IPMIParameters `json:"management_ipmi_credentials,omitempty"`
 	// The protocol used to connect to the management board. Valid options are: * `none` - No management board * `snmp` - Connect using SNMP * `ipmi` - Connect using IPMI
 	ManagementProtocol HostCreateAttributeManagementProtocol `json:"management_protocol,omitempty"`
 	// SNMP credentials
-	ManagementSnmpCommunity interface{} `json:"management_snmp_community,omitempty"`
+	ManagementSnmpCommunity *SNMPCredentials `json:"management_snmp_community,omitempty"`
 	// Configuration for automatic network scan. Pings will besent to each IP address in the configured ranges to checkif a host is up or down. Each found host will be added tothe folder by it's hostname (if possible) or IP address.
-	NetworkScan interface{} `json:"network_scan,omitempty"`
+	NetworkScan *NetworkScan `json:"network_scan,omitempty"`
 	// A list of parents of this host.
 	Parents []string `json:"parents,omitempty"`
 	// The site that should monitor this host.
 	Site string `json:"site,omitempty"`
 	// The SNMP access configuration. A configured SNMP v1/v2 community here will have precedence over any configured SNMP community rule. For this attribute to take effect, the attribute `tag_snmp_ds` needs to be set first.
-	SnmpCommunity interface{} `json:"snmp_community,omitempty"`
+	SnmpCommunity *SNMPCredentials `json:"snmp_community,omitempty"`
 	// Choices: * `"ip-v4-only"`: IPv4 only * `"ip-v6-only"`: IPv6 only * `"ip-v4v6"`: IPv4/IPv6 dual-stack * `"no-ip"`: No IP
 	TagAddressFamily HostCreateAttributeTagAddressFamily `json:"tag_address_family,omitempty"`
 	// Choices: * `"cmk-agent"`: API integrations if configured, else Checkmk agent * `"all-agents"`: Configured API integrations and Checkmk agent * `"special-agents"`: Configured API integrations, no Checkmk agent * `"no-agent"`: No API integrations, no Checkmk agent
@@ -2311,7 +4279,7 @@ type HostExtensions struct {
 	ClusterNodes []string `json:"cluster_nodes,omitempty"`
 	// All attributes of this host and all parent folders.
 	// Example: map[tag_snmp_ds:<nil>]
-	EffectiveAttributes interface{} `json:"effective_attributes,omitempty"`
+	EffectiveAttributes *HostViewAttribute `json:"effective_attributes,omitempty"`
 	// The folder, in which this host resides. Path delimiters can be either `~`, `/` or `\`. Please use the one most appropriate for your quoting/escaping needs. A good default choice is `~`.
 	Folder string `json:"folder,omitempty"`
 	// If this is a cluster host, i.e. a container for other hosts.
@@ -2329,7 +4297,7 @@ type HostGroup struct {
 	// The unique identifier for this domain-object type.
 	Id string `json:"id,omitempty"`
 	// list of links to other resources.
-	Links []map[string]interface{} `json:"links"`
+	Links []Link `json:"links"`
 	// The container for external resources, like linked foreign objects or actions.
 	Members map[string]interface{} `json:"members,omitempty"`
 	// A human readable title of this object. Can be used for user interfaces.
@@ -2345,11 +4313,11 @@ type HostGroupCollection struct {
 	// The name of this collection.
 	Id string `json:"id,omitempty"`
 	// list of links to other resources.
-	Links []map[string]interface{} `json:"links"`
+	Links []Link `json:"links"`
 	// A human readable title of this object. Can be used for user interfaces.
 	Title string `json:"title,omitempty"`
 	// A list of host group objects.
-	Value []map[string]interface{} `json:"value,omitempty"`
+	Value []HostGroupObject `json:"value,omitempty"`
 }
 
 // HostGroupObject represents a CheckMK API type.
@@ -2361,7 +4329,7 @@ type HostGroupObject struct {
 	// The unique identifier for this domain-object type.
 	Id string `json:"id,omitempty"`
 	// list of links to other resources.
-	Links []map[string]interface{} `json:"links"`
+	Links []Link `json:"links"`
 	// The container for external resources, like linked foreign objects or actions.
 	Members map[string]interface{} `json:"members,omitempty"`
 	// A human readable title of this object. Can be used for user interfaces.
@@ -2371,7 +4339,7 @@ type HostGroupObject struct {
 // HostMembers represents a CheckMK API type.
 type HostMembers struct {
 	// The folder in which this host resides. It is represented by a hexadecimal identifier which is it's 'primary key'. The folder can be accessed via the `self`-link provided in the links array.
-	FolderConfig interface{} `json:"folder_config,omitempty"`
+	FolderConfig *Folder `json:"folder_config,omitempty"`
 }
 
 // HostOrServiceCondition represents a CheckMK API type.
@@ -2408,7 +4376,7 @@ type HostTag1 struct {
 // HostTagExtensions represents a CheckMK API type.
 type HostTagExtensions struct {
 	// The list of tags in this group.
-	Tags []map[string]interface{} `json:"tags,omitempty"`
+	Tags []HostTag1 `json:"tags,omitempty"`
 	// The topic this host tag group is organized in.
 	Topic string `json:"topic,omitempty"`
 }
@@ -2422,11 +4390,11 @@ type HostTagGroupCollection struct {
 	// The name of this collection.
 	Id string `json:"id,omitempty"`
 	// list of links to other resources.
-	Links []map[string]interface{} `json:"links"`
+	Links []Link `json:"links"`
 	// A human readable title of this object. Can be used for user interfaces.
 	Title string `json:"title,omitempty"`
 	// A list of host tag group objects.
-	Value []map[string]interface{} `json:"value,omitempty"`
+	Value []ConcreteHostTagGroup `json:"value,omitempty"`
 }
 
 // HostUpdateAttribute represents a CheckMK API type.
@@ -2438,7 +4406,7 @@ type HostUpdateAttribute struct {
 	// Add a comment or describe this host
 	Alias string `json:"alias,omitempty"`
 	// Only members of the contact groups listed here have Setup permission for the host/folder. Optionally, you can make these contact groups automatically monitor contacts. The assignment of hosts to contact groups can also be defined by <a href='wato.py?mode=edit_ruleset&varname=host_contactgroups'>r...
-	Contactgroups interface{} `json:"contactgroups,omitempty"`
+	Contactgroups *HostContactGroup `json:"contactgroups,omitempty"`
 	// Whether or not the last bulk discovery failed. It is set to True once it fails and unset in case a later discovery succeeds.
 	// Example: false
 	InventoryFailed bool `json:"inventory_failed,omitempty"`
@@ -2451,23 +4419,23 @@ type HostUpdateAttribute struct {
 	// Name of host attributes which are locked in the UI.
 	LockedAttributes []string `json:"locked_attributes,omitempty"`
 	// Identity of the entity which locked the locked_attributes. The identity is built out of the Site ID, the program name and the connection ID.
-	LockedBy interface{} `json:"locked_by,omitempty"`
+	LockedBy *LockedBy `json:"locked_by,omitempty"`
 	// Address (IPv4, IPv6 or hostname) under which the management board can be reached.
 	ManagementAddress string `json:"management_address,omitempty"`
 	// IPMI credentials
-	ManagementIpmiCredentials interface{} `json:"management_ipmi_credentials,omitempty"`
+	ManagementIpmiCredentials *IPMIParameters `json:"management_ipmi_credentials,omitempty"`
 	// The protocol used to connect to the management board. Valid options are: * `none` - No management board * `snmp` - Connect using SNMP * `ipmi` - Connect using IPMI
 	ManagementProtocol HostUpdateAttributeManagementProtocol `json:"management_protocol,omitempty"`
 	// SNMP credentials
-	ManagementSnmpCommunity interface{} `json:"management_snmp_community,omitempty"`
+	ManagementSnmpCommunity *SNMPCredentials `json:"management_snmp_community,omitempty"`
 	// Configuration for automatic network scan. Pings will besent to each IP address in the configured ranges to checkif a host is up or down. Each found host will be added tothe folder by it's hostname (if possible) or IP address.
-	NetworkScan interface{} `json:"network_scan,omitempty"`
+	NetworkScan *NetworkScan `json:"network_scan,omitempty"`
 	// A list of parents of this host.
 	Parents []string `json:"parents,omitempty"`
 	// The site that should monitor this host.
 	Site string `json:"site,omitempty"`
 	// The SNMP access configuration. A configured SNMP v1/v2 community here will have precedence over any configured SNMP community rule. For this attribute to take effect, the attribute `tag_snmp_ds` needs to be set first.
-	SnmpCommunity interface{} `json:"snmp_community,omitempty"`
+	SnmpCommunity *SNMPCredentials `json:"snmp_community,omitempty"`
 	// Choices: * `"ip-v4-only"`: IPv4 only * `"ip-v6-only"`: IPv6 only * `"ip-v4v6"`: IPv4/IPv6 dual-stack * `"no-ip"`: No IP
 	TagAddressFamily HostUpdateAttributeTagAddressFamily `json:"tag_address_family,omitempty"`
 	// Choices: * `"cmk-agent"`: API integrations if configured, else Checkmk agent * `"all-agents"`: Configured API integrations and Checkmk agent * `"special-agents"`: Configured API integrations, no Checkmk agent * `"no-agent"`: No API integrations, no Checkmk agent
@@ -2491,7 +4459,7 @@ type HostViewAttribute struct {
 	// Add a comment or describe this host
 	Alias string `json:"alias,omitempty"`
 	// Only members of the contact groups listed here have Setup permission for the host/folder. Optionally, you can make these contact groups automatically monitor contacts. The assignment of hosts to contact groups can also be defined by <a href='wato.py?mode=edit_ruleset&varname=host_contactgroups'>r...
-	Contactgroups interface{} `json:"contactgroups,omitempty"`
+	Contactgroups *HostContactGroup `json:"contactgroups,omitempty"`
 	// Whether or not the last bulk discovery failed. It is set to True once it fails and unset in case a later discovery succeeds.
 	// Example: false
 	InventoryFailed bool `json:"inventory_failed,omitempty"`
@@ -2504,27 +4472,27 @@ type HostViewAttribute struct {
 	// Name of host attributes which are locked in the UI.
 	LockedAttributes []string `json:"locked_attributes,omitempty"`
 	// Identity of the entity which locked the locked_attributes. The identity is built out of the Site ID, the program name and the connection ID.
-	LockedBy interface{} `json:"locked_by,omitempty"`
+	LockedBy *LockedBy `json:"locked_by,omitempty"`
 	// Address (IPv4, IPv6 or hostname) under which the management board can be reached.
 	ManagementAddress string `json:"management_address,omitempty"`
 	// IPMI credentials
-	ManagementIpmiCredentials interface{} `json:"management_ipmi_credentials,omitempty"`
+	ManagementIpmiCredentials *IPMIParameters `json:"management_ipmi_credentials,omitempty"`
 	// The protocol used to connect to the management board. Valid options are: * `none` - No management board * `snmp` - Connect using SNMP * `ipmi` - Connect using IPMI
 	ManagementProtocol HostViewAttributeManagementProtocol `json:"management_protocol,omitempty"`
 	// SNMP credentials
-	ManagementSnmpCommunity interface{} `json:"management_snmp_community,omitempty"`
+	ManagementSnmpCommunity *SNMPCredentials `json:"management_snmp_community,omitempty"`
 	// Read only access to configured metadata.
-	MetaData interface{} `json:"meta_data,omitempty"`
+	MetaData *MetaData `json:"meta_data,omitempty"`
 	// Configuration for automatic network scan. Pings will besent to each IP address in the configured ranges to checkif a host is up or down. Each found host will be added tothe folder by it's hostname (if possible) or IP address.
-	NetworkScan interface{} `json:"network_scan,omitempty"`
+	NetworkScan *NetworkScan `json:"network_scan,omitempty"`
 	// Read only access to the network scan result
-	NetworkScanResult interface{} `json:"network_scan_result,omitempty"`
+	NetworkScanResult *NetworkScanResult `json:"network_scan_result,omitempty"`
 	// A list of parents of this host.
 	Parents []string `json:"parents,omitempty"`
 	// The site that should monitor this host.
 	Site string `json:"site,omitempty"`
 	// The SNMP access configuration. A configured SNMP v1/v2 community here will have precedence over any configured SNMP community rule. For this attribute to take effect, the attribute `tag_snmp_ds` needs to be set first.
-	SnmpCommunity interface{} `json:"snmp_community,omitempty"`
+	SnmpCommunity *SNMPCredentials `json:"snmp_community,omitempty"`
 	TagAddressFamily string `json:"tag_address_family,omitempty"`
 	TagAgent string `json:"tag_agent,omitempty"`
 	TagCriticality string `json:"tag_criticality,omitempty"`
@@ -2565,8 +4533,128 @@ type IPNetwork struct {
 	Type interface{} `json:"type,omitempty"`
 }
 
-// IPRangeWithRegexp represents a CheckMK API type.
+// IPRangeWithRegexp is a union of IPAddressRange, IPNetwork, IPAddresses, IPRegexp, selected by the "type" property.
 type IPRangeWithRegexp struct {
+	union json.RawMessage
+}
+
+// Discriminator returns the value of the "type" property.
+func (u IPRangeWithRegexp) Discriminator() (string, error) {
+	return unionDiscriminator(u.union, "type")
+}
+
+// AsIPAddressRange returns the union data as a IPAddressRange.
+func (u IPRangeWithRegexp) AsIPAddressRange() (IPAddressRange, error) {
+	var v IPAddressRange
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromIPAddressRange sets the union to v, with "type" set to "address_range".
+func (u *IPRangeWithRegexp) FromIPAddressRange(v IPAddressRange) error {
+	data, err := unionFrom(v, "type", "address_range")
+	if err != nil {
+		return fmt.Errorf("IPRangeWithRegexp: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsIPNetwork returns the union data as a IPNetwork.
+func (u IPRangeWithRegexp) AsIPNetwork() (IPNetwork, error) {
+	var v IPNetwork
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromIPNetwork sets the union to v, with "type" set to "network_range".
+func (u *IPRangeWithRegexp) FromIPNetwork(v IPNetwork) error {
+	data, err := unionFrom(v, "type", "network_range")
+	if err != nil {
+		return fmt.Errorf("IPRangeWithRegexp: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsIPAddresses returns the union data as a IPAddresses.
+func (u IPRangeWithRegexp) AsIPAddresses() (IPAddresses, error) {
+	var v IPAddresses
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromIPAddresses sets the union to v, with "type" set to "explicit_addresses".
+func (u *IPRangeWithRegexp) FromIPAddresses(v IPAddresses) error {
+	data, err := unionFrom(v, "type", "explicit_addresses")
+	if err != nil {
+		return fmt.Errorf("IPRangeWithRegexp: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsIPRegexp returns the union data as a IPRegexp.
+func (u IPRangeWithRegexp) AsIPRegexp() (IPRegexp, error) {
+	var v IPRegexp
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromIPRegexp sets the union to v, with "type" set to "exclude_by_regexp".
+func (u *IPRangeWithRegexp) FromIPRegexp(v IPRegexp) error {
+	data, err := unionFrom(v, "type", "exclude_by_regexp")
+	if err != nil {
+		return fmt.Errorf("IPRangeWithRegexp: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// ValueByDiscriminator decodes the union into the member selected by "type".
+func (u IPRangeWithRegexp) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := u.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "address_range":
+		return u.AsIPAddressRange()
+	case "network_range":
+		return u.AsIPNetwork()
+	case "explicit_addresses":
+		return u.AsIPAddresses()
+	case "exclude_by_regexp":
+		return u.AsIPRegexp()
+	}
+	return nil, fmt.Errorf("IPRangeWithRegexp: unknown type %q", discriminator)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u IPRangeWithRegexp) MarshalJSON() ([]byte, error) {
+	if len(u.union) == 0 {
+		return []byte("null"), nil
+	}
+	return u.union, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting unknown "type" values.
+func (u *IPRangeWithRegexp) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		u.union = nil
+		return nil
+	}
+	discriminator, err := unionDiscriminator(data, "type")
+	if err != nil {
+		return fmt.Errorf("IPRangeWithRegexp: %w", err)
+	}
+	switch discriminator {
+	case "address_range", "network_range", "explicit_addresses", "exclude_by_regexp":
+	default:
+		return fmt.Errorf("IPRangeWithRegexp: unknown type %q", discriminator)
+	}
+	u.union = append(json.RawMessage(nil), data...)
+	return nil
 }
 
 // IPRegexp represents a CheckMK API type.
@@ -2617,7 +4705,7 @@ type InputHostTagGroup struct {
 	Ident string `json:"ident"`
 	// A list of host tags belonging to the host tag group
 	// Example: [map[ident:pod title:Pod]]
-	Tags []map[string]interface{} `json:"tags"`
+	Tags []HostTag `json:"tags"`
 	// A title for the host tag
 	// Example: Kubernetes
 	Title string `json:"title"`
@@ -2655,13 +4743,13 @@ type InputPassword struct {
 type InputRuleObject struct {
 	// Conditions.
 	// Example: map[]
-	Conditions interface{} `json:"conditions,omitempty"`
+	Conditions *RuleConditions `json:"conditions,omitempty"`
 	// The path name of the folder. Path delimiters can be either `~`, `/` or `\`. Please use the one most appropriate for your quoting/escaping needs. A good default choice is `~`.
 	// Example: ~hosts~linux
 	Folder string `json:"folder"`
 	// Configuration values for rules.
 	// Example: map[disabled:false]
-	Properties interface{} `json:"properties,omitempty"`
+	Properties *RuleProperties `json:"properties,omitempty"`
 	// Name of rule set.
 	// Example: host_label_rules
 	Ruleset string `json:"ruleset"`
@@ -2761,7 +4849,7 @@ type LockedBy struct {
 
 // LogicalExpr represents a CheckMK API type.
 type LogicalExpr struct {
-	Expr []interface{} `json:"expr,omitempty"`
+	Expr []Expr `json:"expr,omitempty"`
 	// The operator.
 	Op string `json:"op,omitempty"`
 }
@@ -2806,8 +4894,90 @@ type MoveHost struct {
 	TargetFolder string `json:"target_folder"`
 }
 
-// MoveRuleTo represents a CheckMK API type.
+// MoveRuleTo is a union of MoveToFolder, MoveToSpecificRule, selected by the "position" property.
 type MoveRuleTo struct {
+	union json.RawMessage
+}
+
+// Discriminator returns the value of the "position" property.
+func (u MoveRuleTo) Discriminator() (string, error) {
+	return unionDiscriminator(u.union, "position")
+}
+
+// AsMoveToFolder returns the union data as a MoveToFolder.
+func (u MoveRuleTo) AsMoveToFolder() (MoveToFolder, error) {
+	var v MoveToFolder
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromMoveToFolder sets the union to v, whose "position" must be one of "bottom_of_folder", "top_of_folder".
+func (u *MoveRuleTo) FromMoveToFolder(v MoveToFolder) error {
+	data, err := unionFrom(v, "position", "bottom_of_folder", "top_of_folder")
+	if err != nil {
+		return fmt.Errorf("MoveRuleTo: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsMoveToSpecificRule returns the union data as a MoveToSpecificRule.
+func (u MoveRuleTo) AsMoveToSpecificRule() (MoveToSpecificRule, error) {
+	var v MoveToSpecificRule
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromMoveToSpecificRule sets the union to v, whose "position" must be one of "after_specific_rule", "before_specific_rule".
+func (u *MoveRuleTo) FromMoveToSpecificRule(v MoveToSpecificRule) error {
+	data, err := unionFrom(v, "position", "after_specific_rule", "before_specific_rule")
+	if err != nil {
+		return fmt.Errorf("MoveRuleTo: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// ValueByDiscriminator decodes the union into the member selected by "position".
+func (u MoveRuleTo) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := u.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "bottom_of_folder", "top_of_folder":
+		return u.AsMoveToFolder()
+	case "after_specific_rule", "before_specific_rule":
+		return u.AsMoveToSpecificRule()
+	}
+	return nil, fmt.Errorf("MoveRuleTo: unknown position %q", discriminator)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u MoveRuleTo) MarshalJSON() ([]byte, error) {
+	if len(u.union) == 0 {
+		return []byte("null"), nil
+	}
+	return u.union, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting unknown "position" values.
+func (u *MoveRuleTo) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		u.union = nil
+		return nil
+	}
+	discriminator, err := unionDiscriminator(data, "position")
+	if err != nil {
+		return fmt.Errorf("MoveRuleTo: %w", err)
+	}
+	switch discriminator {
+	case "bottom_of_folder", "top_of_folder", "after_specific_rule", "before_specific_rule":
+	default:
+		return fmt.Errorf("MoveRuleTo: unknown position %q", discriminator)
+	}
+	u.union = append(json.RawMessage(nil), data...)
+	return nil
 }
 
 // MoveToFolder represents a CheckMK API type.
@@ -2833,9 +5003,9 @@ type MoveToSpecificRule struct {
 // NetworkScan represents a CheckMK API type.
 type NetworkScan struct {
 	// IPv4 addresses to include.
-	Addresses []map[string]interface{} `json:"addresses"`
+	Addresses []IPRangeWithRegexp `json:"addresses"`
 	// IPv4 addresses to exclude.
-	ExcludeAddresses []map[string]interface{} `json:"exclude_addresses,omitempty"`
+	ExcludeAddresses []IPRangeWithRegexp `json:"exclude_addresses,omitempty"`
 	// Set the maximum number of concurrent pings sent to target IP addresses.
 	MaxParallelPings int `json:"max_parallel_pings,omitempty"`
 	// Execute the network scan in the Checkmk user context of the chosen user. This user needs the permission to add new hosts to this folder.
@@ -2847,8 +5017,8 @@ type NetworkScan struct {
 	// Specify which criticality tag to set on the host created by the network scan. This field is required if the criticality tag group exists, otherwise it as to be omitted.
 	TagCriticality string `json:"tag_criticality,omitempty"`
 	// Only execute the discovery during this time range each day..
-	TimeAllowed []map[string]interface{} `json:"time_allowed"`
-	TranslateNames map[string]interface{} `json:"translate_names,omitempty"`
+	TimeAllowed []TimeAllowedRange `json:"time_allowed"`
+	TranslateNames *TranslateNames `json:"translate_names,omitempty"`
 }
 
 // NetworkScanResult represents a CheckMK API type.
@@ -2866,7 +5036,7 @@ type NetworkScanResult struct {
 // NotExpr represents a CheckMK API type.
 type NotExpr struct {
 	// The query expression to negate.
-	Expr interface{} `json:"expr,omitempty"`
+	Expr *Expr `json:"expr,omitempty"`
 	// The operator. In this case `not`.
 	Op string `json:"op,omitempty"`
 }
@@ -2880,7 +5050,7 @@ type ObjectActionMember struct {
 	// Example: invalid
 	InvalidReason string `json:"invalidReason,omitempty"`
 	// list of links to other resources.
-	Links []map[string]interface{} `json:"links"`
+	Links []Link `json:"links"`
 	MemberType interface{} `json:"memberType,omitempty"`
 	Name string `json:"name,omitempty"`
 	Parameters map[string]interface{} `json:"parameters,omitempty"`
@@ -2899,12 +5069,12 @@ type ObjectCollectionMember struct {
 	// Example: invalid
 	InvalidReason string `json:"invalidReason,omitempty"`
 	// list of links to other resources.
-	Links []map[string]interface{} `json:"links"`
+	Links []Link `json:"links"`
 	MemberType interface{} `json:"memberType,omitempty"`
 	Name string `json:"name,omitempty"`
 	// A human readable title of this object. Can be used for user interfaces.
 	Title string `json:"title,omitempty"`
-	Value []map[string]interface{} `json:"value,omitempty"`
+	Value []Link `json:"value,omitempty"`
 	// Provides the reason why a SET OF proposed values for properties or arguments is invalid.
 	XRoInvalidReason string `json:"x-ro-invalidReason,omitempty"`
 }
@@ -2916,7 +5086,7 @@ type ObjectProperty struct {
 	// The unique name of this property, local to this domain type.
 	Id string `json:"id,omitempty"`
 	// list of links to other resources.
-	Links []map[string]interface{} `json:"links"`
+	Links []Link `json:"links"`
 	// The value of the property. In this case a list.
 	Value []string `json:"value,omitempty"`
 }
@@ -2935,11 +5105,11 @@ type PasswordCollection struct {
 	// The name of this collection.
 	Id string `json:"id,omitempty"`
 	// list of links to other resources.
-	Links []map[string]interface{} `json:"links"`
+	Links []Link `json:"links"`
 	// A human readable title of this object. Can be used for user interfaces.
 	Title string `json:"title,omitempty"`
 	// A list of password objects.
-	Value []map[string]interface{} `json:"value,omitempty"`
+	Value []PasswordObject `json:"value,omitempty"`
 }
 
 // PasswordExtension represents a CheckMK API type.
@@ -2969,11 +5139,11 @@ type PasswordObject struct {
 	// The type of the domain-object.
 	DomainType interface{} `json:"domainType,omitempty"`
 	// All the attributes of the domain object.
-	Extensions interface{} `json:"extensions,omitempty"`
+	Extensions *PasswordExtension `json:"extensions,omitempty"`
 	// The unique identifier for this domain-object type.
 	Id string `json:"id,omitempty"`
 	// list of links to other resources.
-	Links []map[string]interface{} `json:"links"`
+	Links []Link `json:"links"`
 	// The container for external resources, like linked foreign objects or actions.
 	Members map[string]interface{} `json:"members,omitempty"`
 	// A human readable title of this object. Can be used for user interfaces.
@@ -2986,9 +5156,9 @@ type ProxyAttributes struct {
 	// Example: true
 	GlobalSettings bool `json:"global_settings"`
 	// The live status proxy daemon parameters.
-	Params interface{} `json:"params,omitempty"`
+	Params *ProxyParams `json:"params,omitempty"`
 	// Allow access via TCP configuration.
-	Tcp interface{} `json:"tcp,omitempty"`
+	Tcp *ProxyTcp `json:"tcp,omitempty"`
 	// Use livestatus daemon with direct connection or with livestatus proxy.
 	// Example: true
 	UseLivestatusDaemon ProxyAttributesUseLivestatusDaemon `json:"use_livestatus_daemon"`
@@ -3000,16 +5170,98 @@ type ProxyAttributes1 struct {
 	// Example: true
 	GlobalSettings bool `json:"global_settings,omitempty"`
 	// The live status proxy daemon parameters.
-	Params interface{} `json:"params,omitempty"`
+	Params *ProxyParams1 `json:"params,omitempty"`
 	// Allow access via TCP configuration.
-	Tcp interface{} `json:"tcp,omitempty"`
+	Tcp *ProxyTcp1 `json:"tcp,omitempty"`
 	// Use livestatus daemon with direct connection or with livestatus proxy.
 	// Example: true
 	UseLivestatusDaemon string `json:"use_livestatus_daemon"`
 }
 
-// ProxyOrDirect represents a CheckMK API type.
+// ProxyOrDirect is a union of UseLiveStatusDaemon, ProxyAttributes, selected by the "use_livestatus_daemon" property.
 type ProxyOrDirect struct {
+	union json.RawMessage
+}
+
+// Discriminator returns the value of the "use_livestatus_daemon" property.
+func (u ProxyOrDirect) Discriminator() (string, error) {
+	return unionDiscriminator(u.union, "use_livestatus_daemon")
+}
+
+// AsUseLiveStatusDaemon returns the union data as a UseLiveStatusDaemon.
+func (u ProxyOrDirect) AsUseLiveStatusDaemon() (UseLiveStatusDaemon, error) {
+	var v UseLiveStatusDaemon
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromUseLiveStatusDaemon sets the union to v, with "use_livestatus_daemon" set to "direct".
+func (u *ProxyOrDirect) FromUseLiveStatusDaemon(v UseLiveStatusDaemon) error {
+	data, err := unionFrom(v, "use_livestatus_daemon", "direct")
+	if err != nil {
+		return fmt.Errorf("ProxyOrDirect: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsProxyAttributes returns the union data as a ProxyAttributes.
+func (u ProxyOrDirect) AsProxyAttributes() (ProxyAttributes, error) {
+	var v ProxyAttributes
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromProxyAttributes sets the union to v, with "use_livestatus_daemon" set to "with_proxy".
+func (u *ProxyOrDirect) FromProxyAttributes(v ProxyAttributes) error {
+	data, err := unionFrom(v, "use_livestatus_daemon", "with_proxy")
+	if err != nil {
+		return fmt.Errorf("ProxyOrDirect: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// ValueByDiscriminator decodes the union into the member selected by "use_livestatus_daemon".
+func (u ProxyOrDirect) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := u.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "direct":
+		return u.AsUseLiveStatusDaemon()
+	case "with_proxy":
+		return u.AsProxyAttributes()
+	}
+	return nil, fmt.Errorf("ProxyOrDirect: unknown use_livestatus_daemon %q", discriminator)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u ProxyOrDirect) MarshalJSON() ([]byte, error) {
+	if len(u.union) == 0 {
+		return []byte("null"), nil
+	}
+	return u.union, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting unknown "use_livestatus_daemon" values.
+func (u *ProxyOrDirect) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		u.union = nil
+		return nil
+	}
+	discriminator, err := unionDiscriminator(data, "use_livestatus_daemon")
+	if err != nil {
+		return fmt.Errorf("ProxyOrDirect: %w", err)
+	}
+	switch discriminator {
+	case "direct", "with_proxy":
+	default:
+		return fmt.Errorf("ProxyOrDirect: unknown use_livestatus_daemon %q", discriminator)
+	}
+	u.union = append(json.RawMessage(nil), data...)
+	return nil
 }
 
 // ProxyParams represents a CheckMK API type.
@@ -3027,7 +5279,7 @@ type ProxyParams struct {
 	// Example: 4
 	ConnectRetry float64 `json:"connect_retry,omitempty"`
 	// The heartbeat interval and timeout configuration.
-	Heartbeat interface{} `json:"heartbeat,omitempty"`
+	Heartbeat *Heartbeat `json:"heartbeat,omitempty"`
 	// The total query timeout.
 	// Example: 120
 	QueryTimeout float64 `json:"query_timeout,omitempty"`
@@ -3048,7 +5300,7 @@ type ProxyParams1 struct {
 	// Example: 4
 	ConnectRetry float64 `json:"connect_retry,omitempty"`
 	// The heartbeat interval and timeout configuration.
-	Heartbeat interface{} `json:"heartbeat,omitempty"`
+	Heartbeat *Heartbeat1 `json:"heartbeat,omitempty"`
 	// The total query timeout.
 	// Example: 120
 	QueryTimeout float64 `json:"query_timeout,omitempty"`
@@ -3080,8 +5332,128 @@ type ProxyTcp1 struct {
 	Tls bool `json:"tls,omitempty"`
 }
 
-// ReferTo represents a CheckMK API type.
+// ReferTo is a union of Host, Parent, Child, ChildWith, selected by the "type" property.
 type ReferTo struct {
+	union json.RawMessage
+}
+
+// Discriminator returns the value of the "type" property.
+func (u ReferTo) Discriminator() (string, error) {
+	return unionDiscriminator(u.union, "type")
+}
+
+// AsHost returns the union data as a Host.
+func (u ReferTo) AsHost() (Host, error) {
+	var v Host
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromHost sets the union to v, with "type" set to "host".
+func (u *ReferTo) FromHost(v Host) error {
+	data, err := unionFrom(v, "type", "host")
+	if err != nil {
+		return fmt.Errorf("ReferTo: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsParent returns the union data as a Parent.
+func (u ReferTo) AsParent() (Parent, error) {
+	var v Parent
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromParent sets the union to v, with "type" set to "parent".
+func (u *ReferTo) FromParent(v Parent) error {
+	data, err := unionFrom(v, "type", "parent")
+	if err != nil {
+		return fmt.Errorf("ReferTo: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsChild returns the union data as a Child.
+func (u ReferTo) AsChild() (Child, error) {
+	var v Child
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromChild sets the union to v, with "type" set to "child".
+func (u *ReferTo) FromChild(v Child) error {
+	data, err := unionFrom(v, "type", "child")
+	if err != nil {
+		return fmt.Errorf("ReferTo: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsChildWith returns the union data as a ChildWith.
+func (u ReferTo) AsChildWith() (ChildWith, error) {
+	var v ChildWith
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromChildWith sets the union to v, with "type" set to "child_with".
+func (u *ReferTo) FromChildWith(v ChildWith) error {
+	data, err := unionFrom(v, "type", "child_with")
+	if err != nil {
+		return fmt.Errorf("ReferTo: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// ValueByDiscriminator decodes the union into the member selected by "type".
+func (u ReferTo) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := u.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "host":
+		return u.AsHost()
+	case "parent":
+		return u.AsParent()
+	case "child":
+		return u.AsChild()
+	case "child_with":
+		return u.AsChildWith()
+	}
+	return nil, fmt.Errorf("ReferTo: unknown type %q", discriminator)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u ReferTo) MarshalJSON() ([]byte, error) {
+	if len(u.union) == 0 {
+		return []byte("null"), nil
+	}
+	return u.union, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting unknown "type" values.
+func (u *ReferTo) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		u.union = nil
+		return nil
+	}
+	discriminator, err := unionDiscriminator(data, "type")
+	if err != nil {
+		return fmt.Errorf("ReferTo: %w", err)
+	}
+	switch discriminator {
+	case "host", "parent", "child", "child_with":
+	default:
+		return fmt.Errorf("ReferTo: unknown type %q", discriminator)
+	}
+	u.union = append(json.RawMessage(nil), data...)
+	return nil
 }
 
 // RegexpRewrites represents a CheckMK API type.
@@ -3115,36 +5487,36 @@ type RuleCollection struct {
 	// The name of this collection.
 	Id string `json:"id,omitempty"`
 	// list of links to other resources.
-	Links []map[string]interface{} `json:"links"`
+	Links []Link `json:"links"`
 	// A human readable title of this object. Can be used for user interfaces.
 	Title string `json:"title,omitempty"`
 	// The collection itself. Each entry in here is part of the collection.
-	Value []map[string]interface{} `json:"value,omitempty"`
+	Value []RuleObject `json:"value,omitempty"`
 }
 
 // RuleConditions represents a CheckMK API type.
 type RuleConditions struct {
 	// Further restrict this rule by applying host label conditions.
 	// Example: [map[key:os operator:is value:windows]]
-	HostLabels []map[string]interface{} `json:"host_labels,omitempty"`
+	HostLabels []LabelCondition `json:"host_labels,omitempty"`
 	// Here you can enter a list of explicit host names that the rule should or should not apply to. Leave this option disabled if you want the rule to apply for all hosts specified by the given tags. The names that you enter here are compared with case sensitive exact matching. Alternatively you can us...
 	// Example: map[match_on:[host1 host2] operator:one_of]
-	HostName interface{} `json:"host_name,omitempty"`
+	HostName *HostOrServiceCondition `json:"host_name,omitempty"`
 	// The rule will only be applied to hosts fulfilling all the host tag conditions listed here, even if they appear in the list of explicit host names.
 	// Example: [map[key:criticality operator:is value:prod]]
-	HostTags []map[string]interface{} `json:"host_tags,omitempty"`
+	HostTags []TagCondition `json:"host_tags,omitempty"`
 	// Specify a list of service patterns this rule shall apply to. * The patterns must match the beginning of the service in question. * Adding a `$` to the end forces an exact match. * Pattern use regular expressions. e.g. a `.*` will match an arbitrary text. * The text entered here is handled as a re...
 	// Example: map[match_on:[foo1 bar2] operator:none_of]
-	ServiceDescription interface{} `json:"service_description,omitempty"`
+	ServiceDescription *HostOrServiceCondition `json:"service_description,omitempty"`
 	// Restrict the application of the rule, by checking against service label conditions.
 	// Example: [map[key:os operator:is value:windows]]
-	ServiceLabels []map[string]interface{} `json:"service_labels,omitempty"`
+	ServiceLabels []LabelCondition `json:"service_labels,omitempty"`
 }
 
 // RuleExtensions represents a CheckMK API type.
 type RuleExtensions struct {
 	// Conditions.
-	Conditions interface{} `json:"conditions,omitempty"`
+	Conditions *RuleConditions `json:"conditions,omitempty"`
 	// The path name of the folder. Path delimiters can be either `~`, `/` or `\`. Please use the one most appropriate for your quoting/escaping needs. A good default choice is `~`.
 	// Example: ~router
 	Folder string `json:"folder"`
@@ -3152,7 +5524,7 @@ type RuleExtensions struct {
 	FolderIndex int `json:"folder_index,omitempty"`
 	// Property values of this rule.
 	// Example: map[]
-	Properties interface{} `json:"properties,omitempty"`
+	Properties *RuleProperties `json:"properties,omitempty"`
 	// The name of the ruleset.
 	Ruleset string `json:"ruleset,omitempty"`
 	// The raw parameter value for this rule.
@@ -3166,11 +5538,11 @@ type RuleObject struct {
 	// Example: rule
 	DomainType interface{} `json:"domainType,omitempty"`
 	// Attributes specific to rule objects.
-	Extensions interface{} `json:"extensions,omitempty"`
+	Extensions *RuleExtensions `json:"extensions,omitempty"`
 	// The unique identifier for this domain-object type.
 	Id string `json:"id,omitempty"`
 	// list of links to other resources.
-	Links []map[string]interface{} `json:"links"`
+	Links []Link `json:"links"`
 	// The container for external resources, like linked foreign objects or actions.
 	Members map[string]interface{} `json:"members,omitempty"`
 	// A human readable title of this object. Can be used for user interfaces.
@@ -3203,11 +5575,11 @@ type RulesetCollection struct {
 	// The name of this collection.
 	Id string `json:"id,omitempty"`
 	// list of links to other resources.
-	Links []map[string]interface{} `json:"links"`
+	Links []Link `json:"links"`
 	// A human readable title of this object. Can be used for user interfaces.
 	Title string `json:"title,omitempty"`
 	// The collection itself. Each entry in here is part of the collection.
-	Value []map[string]interface{} `json:"value,omitempty"`
+	Value []CollectionItem `json:"value,omitempty"`
 }
 
 // RulesetExtensions represents a CheckMK API type.
@@ -3229,11 +5601,11 @@ type RulesetObject struct {
 	// Example: ruleset
 	DomainType interface{} `json:"domainType,omitempty"`
 	// Specific attributes related to rulesets.
-	Extensions interface{} `json:"extensions,omitempty"`
+	Extensions *RulesetExtensions `json:"extensions,omitempty"`
 	// The unique identifier for this domain-object type.
 	Id string `json:"id,omitempty"`
 	// list of links to other resources.
-	Links []map[string]interface{} `json:"links"`
+	Links []Link `json:"links"`
 	// The container for external resources, like linked foreign objects or actions.
 	Members map[string]interface{} `json:"members,omitempty"`
 	// A human readable title of this object. Can be used for user interfaces.
@@ -3247,8 +5619,128 @@ type SNMPCommunity struct {
 	Type interface{} `json:"type,omitempty"`
 }
 
-// SNMPCredentials represents a CheckMK API type.
+// SNMPCredentials is a union of SNMPCommunity, SNMPv3NoAuthNoPrivacy, SNMPv3AuthNoPrivacy, SNMPv3AuthPrivacy, selected by the "type" property.
 type SNMPCredentials struct {
+	union json.RawMessage
+}
+
+// Discriminator returns the value of the "type" property.
+func (u SNMPCredentials) Discriminator() (string, error) {
+	return unionDiscriminator(u.union, "type")
+}
+
+// AsSNMPCommunity returns the union data as a SNMPCommunity.
+func (u SNMPCredentials) AsSNMPCommunity() (SNMPCommunity, error) {
+	var v SNMPCommunity
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromSNMPCommunity sets the union to v, with "type" set to "v1_v2_community".
+func (u *SNMPCredentials) FromSNMPCommunity(v SNMPCommunity) error {
+	data, err := unionFrom(v, "type", "v1_v2_community")
+	if err != nil {
+		return fmt.Errorf("SNMPCredentials: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsSNMPv3NoAuthNoPrivacy returns the union data as a SNMPv3NoAuthNoPrivacy.
+func (u SNMPCredentials) AsSNMPv3NoAuthNoPrivacy() (SNMPv3NoAuthNoPrivacy, error) {
+	var v SNMPv3NoAuthNoPrivacy
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromSNMPv3NoAuthNoPrivacy sets the union to v, with "type" set to "v3_no_auth_no_privacy".
+func (u *SNMPCredentials) FromSNMPv3NoAuthNoPrivacy(v SNMPv3NoAuthNoPrivacy) error {
+	data, err := unionFrom(v, "type", "v3_no_auth_no_privacy")
+	if err != nil {
+		return fmt.Errorf("SNMPCredentials: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsSNMPv3AuthNoPrivacy returns the union data as a SNMPv3AuthNoPrivacy.
+func (u SNMPCredentials) AsSNMPv3AuthNoPrivacy() (SNMPv3AuthNoPrivacy, error) {
+	var v SNMPv3AuthNoPrivacy
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromSNMPv3AuthNoPrivacy sets the union to v, with "type" set to "v3_auth_no_privacy".
+func (u *SNMPCredentials) FromSNMPv3AuthNoPrivacy(v SNMPv3AuthNoPrivacy) error {
+	data, err := unionFrom(v, "type", "v3_auth_no_privacy")
+	if err != nil {
+		return fmt.Errorf("SNMPCredentials: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsSNMPv3AuthPrivacy returns the union data as a SNMPv3AuthPrivacy.
+func (u SNMPCredentials) AsSNMPv3AuthPrivacy() (SNMPv3AuthPrivacy, error) {
+	var v SNMPv3AuthPrivacy
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromSNMPv3AuthPrivacy sets the union to v, with "type" set to "v3_auth_privacy".
+func (u *SNMPCredentials) FromSNMPv3AuthPrivacy(v SNMPv3AuthPrivacy) error {
+	data, err := unionFrom(v, "type", "v3_auth_privacy")
+	if err != nil {
+		return fmt.Errorf("SNMPCredentials: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// ValueByDiscriminator decodes the union into the member selected by "type".
+func (u SNMPCredentials) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := u.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "v1_v2_community":
+		return u.AsSNMPCommunity()
+	case "v3_no_auth_no_privacy":
+		return u.AsSNMPv3NoAuthNoPrivacy()
+	case "v3_auth_no_privacy":
+		return u.AsSNMPv3AuthNoPrivacy()
+	case "v3_auth_privacy":
+		return u.AsSNMPv3AuthPrivacy()
+	}
+	return nil, fmt.Errorf("SNMPCredentials: unknown type %q", discriminator)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u SNMPCredentials) MarshalJSON() ([]byte, error) {
+	if len(u.union) == 0 {
+		return []byte("null"), nil
+	}
+	return u.union, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting unknown "type" values.
+func (u *SNMPCredentials) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		u.union = nil
+		return nil
+	}
+	discriminator, err := unionDiscriminator(data, "type")
+	if err != nil {
+		return fmt.Errorf("SNMPCredentials: %w", err)
+	}
+	switch discriminator {
+	case "v1_v2_community", "v3_no_auth_no_privacy", "v3_auth_no_privacy", "v3_auth_privacy":
+	default:
+		return fmt.Errorf("SNMPCredentials: unknown type %q", discriminator)
+	}
+	u.union = append(json.RawMessage(nil), data...)
+	return nil
 }
 
 // SNMPv3AuthNoPrivacy represents a CheckMK API type.
@@ -3289,7 +5781,7 @@ type SNMPv3NoAuthNoPrivacy struct {
 
 // ServiceConditions represents a CheckMK API type.
 type ServiceConditions struct {
-	HostChoice interface{} `json:"host_choice"`
+	HostChoice BIHostChoice `json:"host_choice"`
 	HostFolder string `json:"host_folder"`
 	HostLabels map[string]interface{} `json:"host_labels"`
 	HostTags map[string]interface{} `json:"host_tags"`
@@ -3306,7 +5798,7 @@ type ServiceGroup struct {
 	// The unique identifier for this domain-object type.
 	Id string `json:"id,omitempty"`
 	// list of links to other resources.
-	Links []map[string]interface{} `json:"links"`
+	Links []Link `json:"links"`
 	// The container for external resources, like linked foreign objects or actions.
 	Members map[string]interface{} `json:"members,omitempty"`
 	// A human readable title of this object. Can be used for user interfaces.
@@ -3322,11 +5814,11 @@ type ServiceGroupCollection struct {
 	// The name of this collection.
 	Id string `json:"id,omitempty"`
 	// list of links to other resources.
-	Links []map[string]interface{} `json:"links"`
+	Links []Link `json:"links"`
 	// A human readable title of this object. Can be used for user interfaces.
 	Title string `json:"title,omitempty"`
 	// A list of service group objects.
-	Value []map[string]interface{} `json:"value,omitempty"`
+	Value []ServiceGroupObject `json:"value,omitempty"`
 }
 
 // ServiceGroupObject represents a CheckMK API type.
@@ -3338,7 +5830,7 @@ type ServiceGroupObject struct {
 	// The unique identifier for this domain-object type.
 	Id string `json:"id,omitempty"`
 	// list of links to other resources.
-	Links []map[string]interface{} `json:"links"`
+	Links []Link `json:"links"`
 	// The container for external resources, like linked foreign objects or actions.
 	Members map[string]interface{} `json:"members,omitempty"`
 	// A human readable title of this object. Can be used for user interfaces.
@@ -3347,46 +5839,46 @@ type ServiceGroupObject struct {
 
 // SiteConfigAttributes represents a CheckMK API type.
 type SiteConfigAttributes struct {
-	BasicSettings map[string]interface{} `json:"basic_settings,omitempty"`
-	ConfigurationConnection map[string]interface{} `json:"configuration_connection,omitempty"`
+	BasicSettings *BasicSettingsAttributes `json:"basic_settings,omitempty"`
+	ConfigurationConnection *ConfigurationConnectionAttributes1 `json:"configuration_connection,omitempty"`
 	// The shared secret used by the central site to authenticate with the remote site for configuring Checkmk.
 	// Example: secret
 	Secret string `json:"secret,omitempty"`
-	StatusConnection map[string]interface{} `json:"status_connection,omitempty"`
+	StatusConnection *StatusConnectionAttributes1 `json:"status_connection,omitempty"`
 }
 
 // SiteConfigAttributesCreate represents a CheckMK API type.
 type SiteConfigAttributesCreate struct {
-	BasicSettings map[string]interface{} `json:"basic_settings"`
-	ConfigurationConnection map[string]interface{} `json:"configuration_connection"`
+	BasicSettings BasicSettingsAttributesCreate `json:"basic_settings"`
+	ConfigurationConnection ConfigurationConnectionAttributes `json:"configuration_connection"`
 	// The shared secret used by the central site to authenticate with the remote site for configuring Checkmk.
 	// Example: secret
 	Secret string `json:"secret,omitempty"`
-	StatusConnection map[string]interface{} `json:"status_connection"`
+	StatusConnection StatusConnectionAttributes `json:"status_connection"`
 }
 
 // SiteConfigAttributesUpdate represents a CheckMK API type.
 type SiteConfigAttributesUpdate struct {
-	BasicSettings map[string]interface{} `json:"basic_settings"`
-	ConfigurationConnection map[string]interface{} `json:"configuration_connection"`
+	BasicSettings BasicSettingsAttributesUpdate `json:"basic_settings"`
+	ConfigurationConnection ConfigurationConnectionAttributes `json:"configuration_connection"`
 	// The shared secret used by the central site to authenticate with the remote site for configuring Checkmk.
 	// Example: secret
 	Secret string `json:"secret,omitempty"`
-	StatusConnection map[string]interface{} `json:"status_connection"`
+	StatusConnection StatusConnectionAttributes `json:"status_connection"`
 }
 
 // SiteConnectionRequestCreate represents a CheckMK API type.
 type SiteConnectionRequestCreate struct {
 	// A site's connection.
 	// Example: map[basic_settings:map[alias:Die remote site 1 site_id:site_id_1] configuration_connection:map[direct_login_to_web_gui_allowed:true disable_remote_configuration:true enable_replication:true ignore_tls_errors:false replicate_event_console:true replicate_extensions:true url_of_remote_site:http://lo...
-	SiteConfig interface{} `json:"site_config"`
+	SiteConfig SiteConfigAttributesCreate `json:"site_config"`
 }
 
 // SiteConnectionRequestUpdate represents a CheckMK API type.
 type SiteConnectionRequestUpdate struct {
 	// A site's connection.
 	// Example: map[basic_settings:map[alias:Die remote site 1 site_id:site_id_1] configuration_connection:map[direct_login_to_web_gui_allowed:true disable_remote_configuration:true enable_replication:true ignore_tls_errors:false replicate_event_console:true replicate_extensions:true url_of_remote_site:http://lo...
-	SiteConfig interface{} `json:"site_config"`
+	SiteConfig SiteConfigAttributesUpdate `json:"site_config"`
 }
 
 // SiteConnectionResponse represents a CheckMK API type.
@@ -3395,11 +5887,11 @@ type SiteConnectionResponse struct {
 	DomainType interface{} `json:"domainType,omitempty"`
 	// The configuration attributes of a site.
 	// Example: map[basic_settings:map[alias:Die remote site 1 site_id:site_id_1] configuration_connection:map[direct_login_to_web_gui_allowed:true disable_remote_configuration:true enable_replication:true ignore_tls_errors:false replicate_event_console:true replicate_extensions:true url_of_remote_site:http://lo...
-	Extensions interface{} `json:"extensions,omitempty"`
+	Extensions *SiteConfigAttributes `json:"extensions,omitempty"`
 	// The unique identifier for this domain-object type.
 	Id string `json:"id,omitempty"`
 	// list of links to other resources.
-	Links []map[string]interface{} `json:"links"`
+	Links []Link `json:"links"`
 	// The container for external resources, like linked foreign objects or actions.
 	Members map[string]interface{} `json:"members,omitempty"`
 	// A human readable title of this object. Can be used for user interfaces.
@@ -3415,12 +5907,12 @@ type SiteConnectionResponseCollection struct {
 	// The name of this collection.
 	Id string `json:"id,omitempty"`
 	// list of links to other resources.
-	Links []map[string]interface{} `json:"links"`
+	Links []Link `json:"links"`
 	// A human readable title of this object. Can be used for user interfaces.
 	Title string `json:"title,omitempty"`
 	// A list of site configuration objects.
 	// Example: [map[domainType:site_connection extensions:map[basic_settings:map[alias:Die remote site 1 site_id:site_id_1] configuration_connection:map[direct_login_to_web_gui_allowed:true disable_remote_configuration:true enable_replication:true ignore_tls_errors:false replicate_event_console:true replicate_e...
-	Value []map[string]interface{} `json:"value,omitempty"`
+	Value []SiteConnectionResponse `json:"value,omitempty"`
 }
 
 // SiteLoginRequest represents a CheckMK API type.
@@ -3433,8 +5925,128 @@ type SiteLoginRequest struct {
 	Username string `json:"username"`
 }
 
-// SocketAttributes represents a CheckMK API type.
+// SocketAttributes is a union of SocketIP4, SocketIP6, SocketUnixAttributes, SocketType, selected by the "socket_type" property.
 type SocketAttributes struct {
+	union json.RawMessage
+}
+
+// Discriminator returns the value of the "socket_type" property.
+func (u SocketAttributes) Discriminator() (string, error) {
+	return unionDiscriminator(u.union, "socket_type")
+}
+
+// AsSocketIP4 returns the union data as a SocketIP4.
+func (u SocketAttributes) AsSocketIP4() (SocketIP4, error) {
+	var v SocketIP4
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromSocketIP4 sets the union to v, with "socket_type" set to "tcp".
+func (u *SocketAttributes) FromSocketIP4(v SocketIP4) error {
+	data, err := unionFrom(v, "socket_type", "tcp")
+	if err != nil {
+		return fmt.Errorf("SocketAttributes: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsSocketIP6 returns the union data as a SocketIP6.
+func (u SocketAttributes) AsSocketIP6() (SocketIP6, error) {
+	var v SocketIP6
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromSocketIP6 sets the union to v, with "socket_type" set to "tcp6".
+func (u *SocketAttributes) FromSocketIP6(v SocketIP6) error {
+	data, err := unionFrom(v, "socket_type", "tcp6")
+	if err != nil {
+		return fmt.Errorf("SocketAttributes: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsSocketUnixAttributes returns the union data as a SocketUnixAttributes.
+func (u SocketAttributes) AsSocketUnixAttributes() (SocketUnixAttributes, error) {
+	var v SocketUnixAttributes
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromSocketUnixAttributes sets the union to v, with "socket_type" set to "unix".
+func (u *SocketAttributes) FromSocketUnixAttributes(v SocketUnixAttributes) error {
+	data, err := unionFrom(v, "socket_type", "unix")
+	if err != nil {
+		return fmt.Errorf("SocketAttributes: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsSocketType returns the union data as a SocketType.
+func (u SocketAttributes) AsSocketType() (SocketType, error) {
+	var v SocketType
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromSocketType sets the union to v, with "socket_type" set to "local".
+func (u *SocketAttributes) FromSocketType(v SocketType) error {
+	data, err := unionFrom(v, "socket_type", "local")
+	if err != nil {
+		return fmt.Errorf("SocketAttributes: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// ValueByDiscriminator decodes the union into the member selected by "socket_type".
+func (u SocketAttributes) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := u.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "tcp":
+		return u.AsSocketIP4()
+	case "tcp6":
+		return u.AsSocketIP6()
+	case "unix":
+		return u.AsSocketUnixAttributes()
+	case "local":
+		return u.AsSocketType()
+	}
+	return nil, fmt.Errorf("SocketAttributes: unknown socket_type %q", discriminator)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u SocketAttributes) MarshalJSON() ([]byte, error) {
+	if len(u.union) == 0 {
+		return []byte("null"), nil
+	}
+	return u.union, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting unknown "socket_type" values.
+func (u *SocketAttributes) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		u.union = nil
+		return nil
+	}
+	discriminator, err := unionDiscriminator(data, "socket_type")
+	if err != nil {
+		return fmt.Errorf("SocketAttributes: %w", err)
+	}
+	switch discriminator {
+	case "tcp", "tcp6", "unix", "local":
+	default:
+		return fmt.Errorf("SocketAttributes: unknown socket_type %q", discriminator)
+	}
+	u.union = append(json.RawMessage(nil), data...)
+	return nil
 }
 
 // SocketAttributes1 represents a CheckMK API type.
@@ -3520,7 +6132,7 @@ type StatusConnectionAttributes struct {
 	// Example: 2
 	ConnectTimeout int `json:"connect_timeout"`
 	// When connecting to remote site please make sure that Livestatus over TCP is activated there. You can use UNIX sockets to connect to foreign sites on localhost.
-	Connection interface{} `json:"connection"`
+	Connection SocketAttributes `json:"connection"`
 	// If you disable a connection, then no data of this site will be shown in the status GUI. The replication is not affected by this, however.
 	// Example: false
 	DisableInStatusGui bool `json:"disable_in_status_gui,omitempty"`
@@ -3528,9 +6140,9 @@ type StatusConnectionAttributes struct {
 	// Example: false
 	PersistentConnection bool `json:"persistent_connection,omitempty"`
 	// The Livestatus proxy daemon configuration attributes.
-	Proxy interface{} `json:"proxy"`
+	Proxy ProxyOrDirect `json:"proxy"`
 	// By specifying a status host for each non-local connection you prevent Multisite from running into timeouts when remote sites do not respond.
-	StatusHost interface{} `json:"status_host"`
+	StatusHost StatusHostSet `json:"status_host"`
 	// The URL prefix will be prepended to links of addons like NagVis when a link to such applications points to a host or service on that site.
 	// Example: /remote_1/
 	UrlPrefix string `json:"url_prefix,omitempty"`
@@ -3542,7 +6154,7 @@ type StatusConnectionAttributes1 struct {
 	// Example: 2
 	ConnectTimeout int `json:"connect_timeout"`
 	// When connecting to remote site please make sure that Livestatus over TCP is activated there. You can use UNIX sockets to connect to foreign sites on localhost.
-	Connection interface{} `json:"connection"`
+	Connection SocketAttributes1 `json:"connection"`
 	// If you disable a connection, then no data of this site will be shown in the status GUI. The replication is not affected by this, however.
 	// Example: false
 	DisableInStatusGui bool `json:"disable_in_status_gui,omitempty"`
@@ -3550,9 +6162,9 @@ type StatusConnectionAttributes1 struct {
 	// Example: true
 	PersistentConnection bool `json:"persistent_connection,omitempty"`
 	// The Livestatus proxy daemon configuration attributes.
-	Proxy interface{} `json:"proxy"`
+	Proxy ProxyAttributes1 `json:"proxy"`
 	// By specifying a status host for each non-local connection you prevent Multisite from running into timeouts when remote sites do not respond.
-	StatusHost interface{} `json:"status_host,omitempty"`
+	StatusHost *StatusHostAttributes `json:"status_host,omitempty"`
 	// The URL prefix will be prepended to links of addons like NagVis when a link to such applications points to a host or service on that site.
 	// Example: /remote_1/
 	UrlPrefix string `json:"url_prefix,omitempty"`
@@ -3591,12 +6203,176 @@ type StatusHostAttributesSet struct {
 	StatusHostSet StatusHostAttributesSetStatusHostSet `json:"status_host_set"`
 }
 
-// StatusHostSet represents a CheckMK API type.
+// StatusHostSet is a union of StatusHostAttributesSet, StatusHostAttributesBase, selected by the "status_host_set" property.
 type StatusHostSet struct {
-}
-
-// TagCondition represents a CheckMK API type.
+	union json.RawMessage
+}
+
+// Discriminator returns the value of the "status_host_set" property.
+func (u StatusHostSet) Discriminator() (string, error) {
+	return unionDiscriminator(u.union, "status_host_set")
+}
+
+// AsStatusHostAttributesSet returns the union data as a StatusHostAttributesSet.
+func (u StatusHostSet) AsStatusHostAttributesSet() (StatusHostAttributesSet, error) {
+	var v StatusHostAttributesSet
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromStatusHostAttributesSet sets the union to v, with "status_host_set" set to "enabled".
+func (u *StatusHostSet) FromStatusHostAttributesSet(v StatusHostAttributesSet) error {
+	data, err := unionFrom(v, "status_host_set", "enabled")
+	if err != nil {
+		return fmt.Errorf("StatusHostSet: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsStatusHostAttributesBase returns the union data as a StatusHostAttributesBase.
+func (u StatusHostSet) AsStatusHostAttributesBase() (StatusHostAttributesBase, error) {
+	var v StatusHostAttributesBase
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromStatusHostAttributesBase sets the union to v, with "status_host_set" set to "disabled".
+func (u *StatusHostSet) FromStatusHostAttributesBase(v StatusHostAttributesBase) error {
+	data, err := unionFrom(v, "status_host_set", "disabled")
+	if err != nil {
+		return fmt.Errorf("StatusHostSet: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// ValueByDiscriminator decodes the union into the member selected by "status_host_set".
+func (u StatusHostSet) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := u.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "enabled":
+		return u.AsStatusHostAttributesSet()
+	case "disabled":
+		return u.AsStatusHostAttributesBase()
+	}
+	return nil, fmt.Errorf("StatusHostSet: unknown status_host_set %q", discriminator)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u StatusHostSet) MarshalJSON() ([]byte, error) {
+	if len(u.union) == 0 {
+		return []byte("null"), nil
+	}
+	return u.union, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting unknown "status_host_set" values.
+func (u *StatusHostSet) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		u.union = nil
+		return nil
+	}
+	discriminator, err := unionDiscriminator(data, "status_host_set")
+	if err != nil {
+		return fmt.Errorf("StatusHostSet: %w", err)
+	}
+	switch discriminator {
+	case "enabled", "disabled":
+	default:
+		return fmt.Errorf("StatusHostSet: unknown status_host_set %q", discriminator)
+	}
+	u.union = append(json.RawMessage(nil), data...)
+	return nil
+}
+
+// TagCondition is a union of TagConditionScalarSchemaBase, TagConditionConditionSchemaBase, selected by the "operator" property.
 type TagCondition struct {
+	union json.RawMessage
+}
+
+// Discriminator returns the value of the "operator" property.
+func (u TagCondition) Discriminator() (string, error) {
+	return unionDiscriminator(u.union, "operator")
+}
+
+// AsTagConditionScalarSchemaBase returns the union data as a TagConditionScalarSchemaBase.
+func (u TagCondition) AsTagConditionScalarSchemaBase() (TagConditionScalarSchemaBase, error) {
+	var v TagConditionScalarSchemaBase
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromTagConditionScalarSchemaBase sets the union to v, whose "operator" must be one of "is", "is_not".
+func (u *TagCondition) FromTagConditionScalarSchemaBase(v TagConditionScalarSchemaBase) error {
+	data, err := unionFrom(v, "operator", "is", "is_not")
+	if err != nil {
+		return fmt.Errorf("TagCondition: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsTagConditionConditionSchemaBase returns the union data as a TagConditionConditionSchemaBase.
+func (u TagCondition) AsTagConditionConditionSchemaBase() (TagConditionConditionSchemaBase, error) {
+	var v TagConditionConditionSchemaBase
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromTagConditionConditionSchemaBase sets the union to v, whose "operator" must be one of "none_of", "one_of".
+func (u *TagCondition) FromTagConditionConditionSchemaBase(v TagConditionConditionSchemaBase) error {
+	data, err := unionFrom(v, "operator", "none_of", "one_of")
+	if err != nil {
+		return fmt.Errorf("TagCondition: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// ValueByDiscriminator decodes the union into the member selected by "operator".
+func (u TagCondition) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := u.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "is", "is_not":
+		return u.AsTagConditionScalarSchemaBase()
+	case "none_of", "one_of":
+		return u.AsTagConditionConditionSchemaBase()
+	}
+	return nil, fmt.Errorf("TagCondition: unknown operator %q", discriminator)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u TagCondition) MarshalJSON() ([]byte, error) {
+	if len(u.union) == 0 {
+		return []byte("null"), nil
+	}
+	return u.union, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting unknown "operator" values.
+func (u *TagCondition) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		u.union = nil
+		return nil
+	}
+	discriminator, err := unionDiscriminator(data, "operator")
+	if err != nil {
+		return fmt.Errorf("TagCondition: %w", err)
+	}
+	switch discriminator {
+	case "is", "is_not", "none_of", "one_of":
+	default:
+		return fmt.Errorf("TagCondition: unknown operator %q", discriminator)
+	}
+	u.union = append(json.RawMessage(nil), data...)
+	return nil
 }
 
 // TagConditionConditionSchemaBase represents a CheckMK API type.
@@ -3635,13 +6411,13 @@ type TimeAllowedRange struct {
 type TimePeriodAttrsResponse struct {
 	// The days for which time ranges were specified
 	// Example: map[day:all time_ranges:[map[end:14:00 start:12:00]]]
-	ActiveTimeRanges []map[string]interface{} `json:"active_time_ranges,omitempty"`
+	ActiveTimeRanges []ConcreteTimeRangeActive `json:"active_time_ranges,omitempty"`
 	// The alias of the time period
 	// Example: alias
 	Alias string `json:"alias,omitempty"`
 	// Specific day exclusions with their list of time ranges
 	// Example: [map[date:2020-01-01 time_ranges:[map[end:18:00 start:14:00]]]]
-	Exceptions []map[string]interface{} `json:"exceptions,omitempty"`
+	Exceptions []ConcreteTimePeriodException `json:"exceptions,omitempty"`
 	// The collection of time period aliases whose periods are excluded
 	// Example: [time_period_1 time_period_2 time_period_3]
 	Exclude []string `json:"exclude,omitempty"`
@@ -3652,7 +6428,7 @@ type TimePeriodException struct {
 	// The date of the time period exception.8601 profile
 	// Example: 2020-01-01
 	Date string `json:"date"`
-	TimeRanges []map[string]interface{} `json:"time_ranges,omitempty"`
+	TimeRanges []TimeRange1 `json:"time_ranges,omitempty"`
 }
 
 // TimePeriodResponse represents a CheckMK API type.
@@ -3661,12 +6437,12 @@ type TimePeriodResponse struct {
 	DomainType interface{} `json:"domainType,omitempty"`
 	// The time period attributes.
 	// Example: map[active_time_ranges:[map[day:monday time_ranges:[map[end:15:00 start:12:00]]]] alias:holidays exceptions:[map[date:2023-01-01 time_ranges:[map[end:13:30 start:12:30]]]] exclude:[time_period_1 time_period_2 time_period_3]]
-	Extensions interface{} `json:"extensions,omitempty"`
+	Extensions *TimePeriodAttrsResponse `json:"extensions,omitempty"`
 	// The unique identifier for this time period.
 	// Example: time_period_name
 	Id string `json:"id,omitempty"`
 	// list of links to other resources.
-	Links []map[string]interface{} `json:"links"`
+	Links []Link `json:"links"`
 	// The container for external resources, like linked foreign objects or actions.
 	Members map[string]interface{} `json:"members,omitempty"`
 	// The time period name.
@@ -3683,12 +6459,12 @@ type TimePeriodResponseCollection struct {
 	// The name of this collection.
 	Id string `json:"id,omitempty"`
 	// list of links to other resources.
-	Links []map[string]interface{} `json:"links"`
+	Links []Link `json:"links"`
 	// A human readable title of this object. Can be used for user interfaces.
 	Title string `json:"title,omitempty"`
 	// A list of time period objects.
 	// Example: [map[domainType:time_period extensions:map[active_time_ranges:[map[day:monday time_ranges:[map[end:15:00 start:12:00]]]] alias:holidays exceptions:[map[date:2023-01-01 time_ranges:[map[end:13:30 start:12:30]]]] exclude:[time_period_1 time_period_2 time_period_3]] id:time_period links:[] members:m...
-	Value []map[string]interface{} `json:"value,omitempty"`
+	Value []TimePeriodResponse `json:"value,omitempty"`
 }
 
 // TimeRange represents a CheckMK API type.
@@ -3715,7 +6491,7 @@ type TimeRange1 struct {
 type TimeRangeActive struct {
 	// The day for which time ranges are to be specified. The 'all' option allows to specify time ranges for all days.
 	Day TimeRangeActiveDay `json:"day,omitempty"`
-	TimeRanges []map[string]interface{} `json:"time_ranges,omitempty"`
+	TimeRanges []TimeRange1 `json:"time_ranges,omitempty"`
 }
 
 // TranslateNames represents a CheckMK API type.
@@ -3725,9 +6501,9 @@ type TranslateNames struct {
 	// Drop the rest of the domain, only keep the hostname. Will not affect IP addresses. Examples: * `192.168.0.1` -> `192.168.0.1` * `foobar.example.com` -> `foobar` * `example.com` -> `example` * `example` -> `example` This will be executed **after**: * `convert_case`
 	DropDomain bool `json:"drop_domain,omitempty"`
 	// Replace one value with another. These will be executed **after**: * `convert_case` * `drop_domain` * `regexp_rewrites`
-	HostnameReplacement []map[string]interface{} `json:"hostname_replacement,omitempty"`
+	HostnameReplacement []DirectMapping `json:"hostname_replacement,omitempty"`
 	// Rewrite discovered hostnames with multiple regular expressions. The replacements will be done one after another in the order they appear in the list. If not anchored at the end by a `$` character, the regexpwill be anchored at the end implicitly by adding a `$` character. These will be executed *...
-	RegexpRewrites []map[string]interface{} `json:"regexp_rewrites,omitempty"`
+	RegexpRewrites []RegexpRewrites `json:"regexp_rewrites,omitempty"`
 }
 
 // UpdateAndAcknowledgeEvent represents a CheckMK API type.
@@ -3759,8 +6535,109 @@ type UpdateAndAcknowledgeFilter struct {
 	Phase UpdateAndAcknowledgeFilterPhase `json:"phase,omitempty"`
 }
 
-// UpdateAndAcknowledgeSelector represents a CheckMK API type.
+// UpdateAndAcknowledgeSelector is a union of UpdateAndAcknowledgeWithQuery, UpdateAndAcknowledgeWithParams, UpdateAndAcknowledgeFilter, selected by the "filter_type" property.
 type UpdateAndAcknowledgeSelector struct {
+	union json.RawMessage
+}
+
+// Discriminator returns the value of the "filter_type" property.
+func (u UpdateAndAcknowledgeSelector) Discriminator() (string, error) {
+	return unionDiscriminator(u.union, "filter_type")
+}
+
+// AsUpdateAndAcknowledgeWithQuery returns the union data as a UpdateAndAcknowledgeWithQuery.
+func (u UpdateAndAcknowledgeSelector) AsUpdateAndAcknowledgeWithQuery() (UpdateAndAcknowledgeWithQuery, error) {
+	var v UpdateAndAcknowledgeWithQuery
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromUpdateAndAcknowledgeWithQuery sets the union to v, with "filter_type" set to "query".
+func (u *UpdateAndAcknowledgeSelector) FromUpdateAndAcknowledgeWithQuery(v UpdateAndAcknowledgeWithQuery) error {
+	data, err := unionFrom(v, "filter_type", "query")
+	if err != nil {
+		return fmt.Errorf("UpdateAndAcknowledgeSelector: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsUpdateAndAcknowledgeWithParams returns the union data as a UpdateAndAcknowledgeWithParams.
+func (u UpdateAndAcknowledgeSelector) AsUpdateAndAcknowledgeWithParams() (UpdateAndAcknowledgeWithParams, error) {
+	var v UpdateAndAcknowledgeWithParams
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromUpdateAndAcknowledgeWithParams sets the union to v, with "filter_type" set to "params".
+func (u *UpdateAndAcknowledgeSelector) FromUpdateAndAcknowledgeWithParams(v UpdateAndAcknowledgeWithParams) error {
+	data, err := unionFrom(v, "filter_type", "params")
+	if err != nil {
+		return fmt.Errorf("UpdateAndAcknowledgeSelector: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsUpdateAndAcknowledgeFilter returns the union data as a UpdateAndAcknowledgeFilter.
+func (u UpdateAndAcknowledgeSelector) AsUpdateAndAcknowledgeFilter() (UpdateAndAcknowledgeFilter, error) {
+	var v UpdateAndAcknowledgeFilter
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromUpdateAndAcknowledgeFilter sets the union to v, with "filter_type" set to "all".
+func (u *UpdateAndAcknowledgeSelector) FromUpdateAndAcknowledgeFilter(v UpdateAndAcknowledgeFilter) error {
+	data, err := unionFrom(v, "filter_type", "all")
+	if err != nil {
+		return fmt.Errorf("UpdateAndAcknowledgeSelector: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// ValueByDiscriminator decodes the union into the member selected by "filter_type".
+func (u UpdateAndAcknowledgeSelector) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := u.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "query":
+		return u.AsUpdateAndAcknowledgeWithQuery()
+	case "params":
+		return u.AsUpdateAndAcknowledgeWithParams()
+	case "all":
+		return u.AsUpdateAndAcknowledgeFilter()
+	}
+	return nil, fmt.Errorf("UpdateAndAcknowledgeSelector: unknown filter_type %q", discriminator)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u UpdateAndAcknowledgeSelector) MarshalJSON() ([]byte, error) {
+	if len(u.union) == 0 {
+		return []byte("null"), nil
+	}
+	return u.union, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting unknown "filter_type" values.
+func (u *UpdateAndAcknowledgeSelector) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		u.union = nil
+		return nil
+	}
+	discriminator, err := unionDiscriminator(data, "filter_type")
+	if err != nil {
+		return fmt.Errorf("UpdateAndAcknowledgeSelector: %w", err)
+	}
+	switch discriminator {
+	case "query", "params", "all":
+	default:
+		return fmt.Errorf("UpdateAndAcknowledgeSelector: unknown filter_type %q", discriminator)
+	}
+	u.union = append(json.RawMessage(nil), data...)
+	return nil
 }
 
 // UpdateAndAcknowledgeWithParams represents a CheckMK API type.
@@ -3774,7 +6651,7 @@ type UpdateAndAcknowledgeWithParams struct {
 	// The way you would like to filter events.
 	// Example: all
 	FilterType UpdateAndAcknowledgeWithParamsFilterType `json:"filter_type"`
-	Filters map[string]interface{} `json:"filters"`
+	Filters FilterParamsUpdateAndAcknowledge `json:"filters"`
 	// To change the phase of an event
 	// Example: ack
 	Phase UpdateAndAcknowledgeWithParamsPhase `json:"phase,omitempty"`
@@ -3796,12 +6673,12 @@ type UpdateAndAcknowledgeWithQuery struct {
 	Phase UpdateAndAcknowledgeWithQueryPhase `json:"phase,omitempty"`
 	// An query expression of the Livestatus 'eventconsoleevents' table in nested dictionary form. If you want to use multiple expressions, nest them with the AND/OR operators.
 	// Example: {"op": "=", "left": "eventconsoleevents.event_host", "right": "test_host"}
-	Query interface{} `json:"query"`
+	Query Expr `json:"query"`
 }
 
 // UpdateContactGroup represents a CheckMK API type.
 type UpdateContactGroup struct {
-	Attributes map[string]interface{} `json:"attributes,omitempty"`
+	Attributes *UpdateGroup `json:"attributes,omitempty"`
 	// The name of the contact group.
 	// Example: OnCall
 	Name string `json:"name"`
@@ -3869,7 +6746,7 @@ type UpdateHostEntry struct {
 
 // UpdateHostGroup represents a CheckMK API type.
 type UpdateHostGroup struct {
-	Attributes map[string]interface{} `json:"attributes,omitempty"`
+	Attributes *UpdateGroup `json:"attributes,omitempty"`
 	// The name of the host group.
 	// Example: windows
 	Name string `json:"name"`
@@ -3885,7 +6762,7 @@ type UpdateHostTagGroup struct {
 	Repair bool `json:"repair,omitempty"`
 	// A list of host tags belonging to the host tag group
 	// Example: [map[ident:pod title:Pod]]
-	Tags []map[string]interface{} `json:"tags,omitempty"`
+	Tags []HostTag `json:"tags,omitempty"`
 	// A title for the host tag
 	// Example: Kubernetes
 	Title string `json:"title,omitempty"`
@@ -3925,7 +6802,7 @@ type UpdatePassword struct {
 
 // UpdateServiceGroup represents a CheckMK API type.
 type UpdateServiceGroup struct {
-	Attributes map[string]interface{} `json:"attributes,omitempty"`
+	Attributes *UpdateGroup `json:"attributes,omitempty"`
 	// The name of the service group.
 	// Example: windows
 	Name string `json:"name"`
@@ -3935,40 +6812,40 @@ type UpdateServiceGroup struct {
 type UpdateTimePeriod struct {
 	// The list of active time ranges which replaces the existing list of time ranges
 	// Example: [map[day:monday time_ranges:[map[end:14:00:00 start:12:00:00]]]]
-	ActiveTimeRanges []map[string]interface{} `json:"active_time_ranges,omitempty"`
+	ActiveTimeRanges []TimeRangeActive `json:"active_time_ranges,omitempty"`
 	// An alias for the time period
 	// Example: new_alias
 	Alias string `json:"alias,omitempty"`
 	// A list of additional time ranges to be added.
 	// Example: [map[date:2020-01-01 time_ranges:[map[end:18:00:00 start:14:00:00]]]]
-	Exceptions []map[string]interface{} `json:"exceptions,omitempty"`
+	Exceptions []TimePeriodException `json:"exceptions,omitempty"`
 }
 
 // UpdateUser represents a CheckMK API type.
 type UpdateUser struct {
 	// Authentication option for the user
 	// Example: map[auth_type:password password:password]
-	AuthOption interface{} `json:"auth_option,omitempty"`
+	AuthOption *AuthUpdateOption `json:"auth_option,omitempty"`
 	// The names of the sites the user is authorized to handle. Specifying 'all' will grant the user access to all sites.
 	// Example: [heute]
 	AuthorizedSites []string `json:"authorized_sites,omitempty"`
 	// Contact settings for the user
 	// Example: map[email:user@example.com]
-	ContactOptions interface{} `json:"contact_options,omitempty"`
+	ContactOptions *UserContactOption `json:"contact_options,omitempty"`
 	// Assign the user to one or multiple contact groups. If no contact group is specified then no monitoring contact will be created for the user.
 	// Example: [all]
 	Contactgroups []string `json:"contactgroups,omitempty"`
 	// The user can be blocked from login but will remain part of the site. The disabling does not affect notification and alerts.
 	// Example: false
 	DisableLogin bool `json:"disable_login,omitempty"`
-	DisableNotifications interface{} `json:"disable_notifications,omitempty"`
+	DisableNotifications *DisabledNotifications `json:"disable_notifications,omitempty"`
 	// The alias or full name of the user
 	// Example: Mathias Kettner
 	Fullname string `json:"fullname,omitempty"`
 	// Idle timeout for the user
 	// Example: map[]
-	IdleTimeout interface{} `json:"idle_timeout,omitempty"`
-	InterfaceOptions interface{} `json:"interface_options,omitempty"`
+	IdleTimeout *IdleOption `json:"idle_timeout,omitempty"`
+	InterfaceOptions *UserInterfaceUpdateAttributes `json:"interface_options,omitempty"`
 	// Configure the language to be used by the user in the user interface. Omitting this will configure the default language
 	// Example: en
 	Language UpdateUserLanguage `json:"language,omitempty"`
@@ -3997,11 +6874,11 @@ type UserCollection struct {
 	// The name of this collection.
 	Id string `json:"id,omitempty"`
 	// list of links to other resources.
-	Links []map[string]interface{} `json:"links"`
+	Links []Link `json:"links"`
 	// A human readable title of this object. Can be used for user interfaces.
 	Title string `json:"title,omitempty"`
 	// A list of user objects.
-	Value []map[string]interface{} `json:"value,omitempty"`
+	Value []UserObject `json:"value,omitempty"`
 }
 
 // UserContactOption represents a CheckMK API type.
@@ -4060,7 +6937,7 @@ type UserObject struct {
 	// The unique identifier for this domain-object type.
 	Id string `json:"id,omitempty"`
 	// list of links to other resources.
-	Links []map[string]interface{} `json:"links"`
+	Links []Link `json:"links"`
 	// The container for external resources, like linked foreign objects or actions.
 	Members map[string]interface{} `json:"members,omitempty"`
 	// A human readable title of this object. Can be used for user interfaces.
@@ -4088,11 +6965,11 @@ type UserRoleCollection struct {
 	// The name of this collection.
 	Id string `json:"id,omitempty"`
 	// list of links to other resources.
-	Links []map[string]interface{} `json:"links"`
+	Links []Link `json:"links"`
 	// A human readable title of this object. Can be used for user interfaces.
 	Title string `json:"title,omitempty"`
 	// A list of user role objects.
-	Value []map[string]interface{} `json:"value,omitempty"`
+	Value []UserRoleObject `json:"value,omitempty"`
 }
 
 // UserRoleObject represents a CheckMK API type.
@@ -4100,19 +6977,101 @@ type UserRoleObject struct {
 	// The domain type of the object.
 	DomainType interface{} `json:"domainType,omitempty"`
 	// All the attributes of a user role.
-	Extensions interface{} `json:"extensions,omitempty"`
+	Extensions *UserRoleAttributes `json:"extensions,omitempty"`
 	// The unique identifier for this domain-object type.
 	Id string `json:"id,omitempty"`
 	// list of links to other resources.
-	Links []map[string]interface{} `json:"links"`
+	Links []Link `json:"links"`
 	// The container for external resources, like linked foreign objects or actions.
 	Members map[string]interface{} `json:"members,omitempty"`
 	// A human readable title of this object. Can be used for user interfaces.
 	Title string `json:"title,omitempty"`
 }
 
-// UserSyncAttributes represents a CheckMK API type.
+// UserSyncAttributes is a union of UserSyncWithLdapConnection, UserSyncBase, selected by the "sync_with_ldap_connections" property.
 type UserSyncAttributes struct {
+	union json.RawMessage
+}
+
+// Discriminator returns the value of the "sync_with_ldap_connections" property.
+func (u UserSyncAttributes) Discriminator() (string, error) {
+	return unionDiscriminator(u.union, "sync_with_ldap_connections")
+}
+
+// AsUserSyncWithLdapConnection returns the union data as a UserSyncWithLdapConnection.
+func (u UserSyncAttributes) AsUserSyncWithLdapConnection() (UserSyncWithLdapConnection, error) {
+	var v UserSyncWithLdapConnection
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromUserSyncWithLdapConnection sets the union to v, with "sync_with_ldap_connections" set to "ldap".
+func (u *UserSyncAttributes) FromUserSyncWithLdapConnection(v UserSyncWithLdapConnection) error {
+	data, err := unionFrom(v, "sync_with_ldap_connections", "ldap")
+	if err != nil {
+		return fmt.Errorf("UserSyncAttributes: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsUserSyncBase returns the union data as a UserSyncBase.
+func (u UserSyncAttributes) AsUserSyncBase() (UserSyncBase, error) {
+	var v UserSyncBase
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromUserSyncBase sets the union to v, whose "sync_with_ldap_connections" must be one of "all", "disabled".
+func (u *UserSyncAttributes) FromUserSyncBase(v UserSyncBase) error {
+	data, err := unionFrom(v, "sync_with_ldap_connections", "all", "disabled")
+	if err != nil {
+		return fmt.Errorf("UserSyncAttributes: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// ValueByDiscriminator decodes the union into the member selected by "sync_with_ldap_connections".
+func (u UserSyncAttributes) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := u.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "ldap":
+		return u.AsUserSyncWithLdapConnection()
+	case "all", "disabled":
+		return u.AsUserSyncBase()
+	}
+	return nil, fmt.Errorf("UserSyncAttributes: unknown sync_with_ldap_connections %q", discriminator)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u UserSyncAttributes) MarshalJSON() ([]byte, error) {
+	if len(u.union) == 0 {
+		return []byte("null"), nil
+	}
+	return u.union, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting unknown "sync_with_ldap_connections" values.
+func (u *UserSyncAttributes) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		u.union = nil
+		return nil
+	}
+	discriminator, err := unionDiscriminator(data, "sync_with_ldap_connections")
+	if err != nil {
+		return fmt.Errorf("UserSyncAttributes: %w", err)
+	}
+	switch discriminator {
+	case "ldap", "all", "disabled":
+	default:
+		return fmt.Errorf("UserSyncAttributes: unknown sync_with_ldap_connections %q", discriminator)
+	}
+	u.union = append(json.RawMessage(nil), data...)
+	return nil
 }
 
 // UserSyncAttributes1 represents a CheckMK API type.
@@ -4155,3 +7114,46 @@ type X509ReqPEMUUID struct {
 	Csr string `json:"csr"`
 }
 
+// unionDiscriminator reads a string discriminator property from a JSON object.
+func unionDiscriminator(data json.RawMessage, property string) (string, error) {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return "", err
+	}
+	raw, ok := fields[property]
+	if !ok {
+		return "", fmt.Errorf("missing discriminator %q", property)
+	}
+	var value string
+	if err := json.Unmarshal(raw, &value); err != nil {
+		return "", fmt.Errorf("discriminator %q: %w", property, err)
+	}
+	return value, nil
+}
+
+// unionFrom encodes a union member, setting its discriminator property.
+// A member selected by several values must already carry one of them.
+func unionFrom(v interface{}, property string, values ...string) (json.RawMessage, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+	if len(values) == 1 {
+		fields[property], _ = json.Marshal(values[0])
+		return json.Marshal(fields)
+	}
+	current, err := unionDiscriminator(data, property)
+	if err != nil {
+		return nil, err
+	}
+	for _, value := range values {
+		if current == value {
+			return data, nil
+		}
+	}
+	return nil, fmt.Errorf("discriminator %q must be one of %q, got %q", property, values, current)
+}
@@ -0,0 +1,8148 @@
+//go:build checkmk_all || checkmk_v2_2_0
+
+// Code generated by openapi-gen from CheckMK 1.0. DO NOT EDIT.
+//
+// Schema definitions for runtime validation
+//
+// Source: schemas.gen.go
+// Schemas: All (unfiltered)
+
+package p12
+
+import "github.com/BlackMesaLTD/checkmk-api-spec/generated/go/openapi"
+
+// Schemas maps component schema names to their definitions, with allOf
+// flattened and references to other components kept by name.
+// Use for validating request documents before sending them.
+var Schemas = map[string]*openapi.Schema{
+	"AcknowledgeHostGroupProblem": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"acknowledge_type": {
+				Type: "string",
+				Enum: []string{"host", "hostgroup", "host_by_query"},
+			},
+			"comment": {
+				Type: "string",
+			},
+			"hostgroup_name": {
+				Type: "string",
+			},
+			"notify": {
+				Type: "boolean",
+			},
+			"persistent": {
+				Type: "boolean",
+			},
+			"sticky": {
+				Type: "boolean",
+			},
+		},
+		Required: []string{"acknowledge_type", "comment", "hostgroup_name"},
+	},
+	"AcknowledgeHostProblem": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"acknowledge_type": {
+				Type: "string",
+				Enum: []string{"host", "hostgroup", "host_by_query"},
+			},
+			"comment": {
+				Type: "string",
+			},
+			"host_name": {
+				Type: "string",
+				Pattern: "^[-0-9a-zA-Z_.]+\\Z",
+			},
+			"notify": {
+				Type: "boolean",
+			},
+			"persistent": {
+				Type: "boolean",
+			},
+			"sticky": {
+				Type: "boolean",
+			},
+		},
+		Required: []string{"acknowledge_type", "comment", "host_name"},
+	},
+	"AcknowledgeHostQueryProblem": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"acknowledge_type": {
+				Type: "string",
+				Enum: []string{"host", "hostgroup", "host_by_query"},
+			},
+			"comment": {
+				Type: "string",
+			},
+			"notify": {
+				Type: "boolean",
+			},
+			"persistent": {
+				Type: "boolean",
+			},
+			"query": {Ref: "Expr"},
+			"sticky": {
+				Type: "boolean",
+			},
+		},
+		Required: []string{"acknowledge_type", "comment", "query"},
+	},
+	"AcknowledgeHostRelatedProblem": {
+		Type: "object",
+		OneOf: []*openapi.Schema{
+			{Ref: "AcknowledgeHostProblem"},
+			{Ref: "AcknowledgeHostGroupProblem"},
+			{Ref: "AcknowledgeHostQueryProblem"},
+		},
+		Discriminator: "acknowledge_type",
+		Mapping: map[string]string{
+			"host": "AcknowledgeHostProblem",
+			"host_by_query": "AcknowledgeHostQueryProblem",
+			"hostgroup": "AcknowledgeHostGroupProblem",
+		},
+	},
+	"AcknowledgeServiceGroupProblem": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"acknowledge_type": {
+				Type: "string",
+				Enum: []string{"service", "servicegroup", "service_by_query"},
+			},
+			"comment": {
+				Type: "string",
+			},
+			"notify": {
+				Type: "boolean",
+			},
+			"persistent": {
+				Type: "boolean",
+			},
+			"servicegroup_name": {
+				Type: "string",
+			},
+			"sticky": {
+				Type: "boolean",
+			},
+		},
+		Required: []string{"acknowledge_type", "comment", "servicegroup_name"},
+	},
+	"AcknowledgeServiceQueryProblem": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"acknowledge_type": {
+				Type: "string",
+				Enum: []string{"service", "servicegroup", "service_by_query"},
+			},
+			"comment": {
+				Type: "string",
+			},
+			"notify": {
+				Type: "boolean",
+			},
+			"persistent": {
+				Type: "boolean",
+			},
+			"query": {Ref: "Expr"},
+			"sticky": {
+				Type: "boolean",
+			},
+		},
+		Required: []string{"acknowledge_type", "comment", "query"},
+	},
+	"AcknowledgeServiceRelatedProblem": {
+		Type: "object",
+		OneOf: []*openapi.Schema{
+			{Ref: "AcknowledgeSpecificServiceProblem"},
+			{Ref: "AcknowledgeServiceGroupProblem"},
+			{Ref: "AcknowledgeServiceQueryProblem"},
+		},
+		Discriminator: "acknowledge_type",
+		Mapping: map[string]string{
+			"service": "AcknowledgeSpecificServiceProblem",
+			"service_by_query": "AcknowledgeServiceQueryProblem",
+			"servicegroup": "AcknowledgeServiceGroupProblem",
+		},
+	},
+	"AcknowledgeSpecificServiceProblem": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"acknowledge_type": {
+				Type: "string",
+				Enum: []string{"service", "servicegroup", "service_by_query"},
+			},
+			"comment": {
+				Type: "string",
+			},
+			"host_name": {
+				Type: "string",
+				Pattern: "^[-0-9a-zA-Z_.]+\\Z",
+			},
+			"notify": {
+				Type: "boolean",
+			},
+			"persistent": {
+				Type: "boolean",
+			},
+			"service_description": {
+				Type: "string",
+			},
+			"sticky": {
+				Type: "boolean",
+			},
+		},
+		Required: []string{"acknowledge_type", "comment", "host_name", "service_description"},
+	},
+	"ActivateChanges": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"force_foreign_changes": {
+				Type: "boolean",
+			},
+			"redirect": {
+				Type: "boolean",
+			},
+			"sites": {
+				Type: "array",
+				Items: &openapi.Schema{
+					Type: "string",
+				},
+			},
+		},
+	},
+	"ActivationExtensionFields": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"changes": {
+				Type: "array",
+				Items: &openapi.Schema{Ref: "ChangesFields"},
+			},
+			"force_foreign_changes": {
+				Type: "boolean",
+			},
+			"is_running": {
+				Type: "boolean",
+			},
+			"sites": {
+				Type: "array",
+				Items: &openapi.Schema{
+					Type: "string",
+				},
+			},
+			"time_started": {
+				Type: "string",
+				Format: "date-time",
+			},
+		},
+	},
+	"ActivationRunCollection": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"domainType": {
+			},
+			"extensions": {
+				Type: "object",
+				AdditionalProperties: &openapi.Schema{},
+			},
+			"id": {
+				Type: "string",
+			},
+			"links": {
+				Type: "array",
+				Items: &openapi.Schema{Ref: "Link"},
+			},
+			"value": {
+				Type: "array",
+				Items: &openapi.Schema{Ref: "ActivationRunResponse"},
+			},
+		},
+		Required: []string{"links"},
+	},
+	"ActivationRunResponse": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"domainType": {
+			},
+			"extensions": {Ref: "ActivationExtensionFields"},
+			"id": {
+				Type: "string",
+				Format: "uuid",
+			},
+			"links": {
+				Type: "array",
+				Items: &openapi.Schema{Ref: "Link"},
+			},
+			"members": {
+				Type: "object",
+				AdditionalProperties: &openapi.Schema{},
+			},
+			"title": {
+				Type: "string",
+			},
+		},
+		Required: []string{"links"},
+	},
+	"AgentControllerCertificateSettings": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"lifetime_in_months": {
+				Type: "integer",
+			},
+		},
+		Required: []string{"lifetime_in_months"},
+	},
+	"ApiError": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"detail": {
+				Type: "string",
+			},
+			"ext": {
+				Type: "object",
+				AdditionalProperties: &openapi.Schema{},
+			},
+			"fields": {
+				Type: "object",
+				AdditionalProperties: &openapi.Schema{},
+			},
+			"status": {
+				Type: "integer",
+			},
+			"title": {
+				Type: "string",
+			},
+		},
+		Required: []string{"detail", "status", "title"},
+	},
+	"AsciiMailPluginCreate": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"body_head_for_both_host_and_service_notifications": {Ref: "CheckboxOneOf"},
+			"body_tail_for_host_notifications": {Ref: "CheckboxOneOf"},
+			"body_tail_for_service_notifications": {Ref: "CheckboxOneOf"},
+			"from_details": {Ref: "CheckboxOneOf"},
+			"plugin_name": {
+				Type: "string",
+				Enum: []string{"asciimail", "cisco_webex_teams", "mkeventd", "mail", "ilert", "jira_issues", "opsgenie_issues", "pagerduty", "pushover", "servicenow", "signl4", "slack", "sms_api", "sms", "spectrum", "victorops", "msteams"},
+			},
+			"reply_to": {Ref: "CheckboxOneOf"},
+			"send_separate_notification_to_every_recipient": {Ref: "Checkbox"},
+			"sort_order_for_bulk_notificaions": {Ref: "CheckboxOneOf"},
+			"subject_for_host_notifications": {Ref: "CheckboxOneOf"},
+			"subject_for_service_notifications": {Ref: "CheckboxOneOf"},
+		},
+		Required: []string{"body_head_for_both_host_and_service_notifications", "body_tail_for_host_notifications", "body_tail_for_service_notifications", "from_details", "plugin_name", "reply_to", "send_separate_notification_to_every_recipient", "sort_order_for_bulk_notificaions", "subject_for_host_notifications", "subject_for_service_notifications"},
+	},
+	"AuthOption": {
+		Type: "object",
+		OneOf: []*openapi.Schema{
+			{Ref: "AuthPassword"},
+			{Ref: "AuthSecret"},
+		},
+		Discriminator: "auth_type",
+		Mapping: map[string]string{
+			"automation": "AuthSecret",
+			"password": "AuthPassword",
+		},
+	},
+	"AuthOption1": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"auth_type": {
+				Type: "string",
+				Enum: []string{"password", "automation", "saml2", "ldap"},
+			},
+			"enforce_password_change": {
+				Type: "boolean",
+			},
+		},
+	},
+	"AuthPassword": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"auth_type": {
+				Type: "string",
+				Enum: []string{"automation", "password"},
+			},
+			"enforce_password_change": {
+				Type: "boolean",
+			},
+			"password": {
+				Type: "string",
+				MinLength: openapi.Int(1),
+			},
+		},
+	},
+	"AuthSecret": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"auth_type": {
+				Type: "string",
+				Enum: []string{"automation", "password"},
+			},
+			"secret": {
+				Type: "string",
+			},
+		},
+	},
+	"AuthUpdateOption": {
+		Type: "object",
+		OneOf: []*openapi.Schema{
+			{Ref: "AuthUpdatePassword"},
+			{Ref: "AuthUpdateSecret"},
+			{Ref: "AuthUpdateRemove"},
+		},
+		Discriminator: "auth_type",
+		Mapping: map[string]string{
+			"automation": "AuthUpdateSecret",
+			"password": "AuthUpdatePassword",
+			"remove": "AuthUpdateRemove",
+		},
+	},
+	"AuthUpdatePassword": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"auth_type": {
+				Type: "string",
+				Enum: []string{"automation", "password", "remove"},
+			},
+			"enforce_password_change": {
+				Type: "boolean",
+			},
+			"password": {
+				Type: "string",
+				MinLength: openapi.Int(1),
+			},
+		},
+		Required: []string{"auth_type"},
+	},
+	"AuthUpdateRemove": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"auth_type": {
+				Type: "string",
+				Enum: []string{"automation", "password", "remove"},
+			},
+		},
+		Required: []string{"auth_type"},
+	},
+	"AuthUpdateSecret": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"auth_type": {
+				Type: "string",
+				Enum: []string{"automation", "password", "remove"},
+			},
+			"secret": {
+				Type: "string",
+			},
+		},
+		Required: []string{"auth_type"},
+	},
+	"AuxTagAttrsCreate": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"aux_tag_id": {
+				Type: "string",
+				Pattern: "^[-0-9a-zA-Z_]+\\Z",
+			},
+			"help": {
+				Type: "string",
+			},
+			"title": {
+				Type: "string",
+				MinLength: openapi.Int(1),
+			},
+			"topic": {
+				Type: "string",
+				MinLength: openapi.Int(1),
+			},
+		},
+		Required: []string{"aux_tag_id", "title", "topic"},
+	},
+	"AuxTagAttrsResponse": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"help": {
+				Type: "string",
+			},
+			"topic": {
+				Type: "string",
+				MinLength: openapi.Int(1),
+			},
+		},
+		Required: []string{"help", "topic"},
+	},
+	"AuxTagAttrsUpdate": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"help": {
+				Type: "string",
+			},
+			"title": {
+				Type: "string",
+				MinLength: openapi.Int(1),
+			},
+			"topic": {
+				Type: "string",
+				MinLength: openapi.Int(1),
+			},
+		},
+	},
+	"AuxTagResponse": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"domainType": {
+			},
+			"extensions": {Ref: "AuxTagAttrsResponse"},
+			"id": {
+				Type: "string",
+			},
+			"links": {
+				Type: "array",
+				Items: &openapi.Schema{Ref: "Link"},
+			},
+			"members": {
+				Type: "object",
+				AdditionalProperties: &openapi.Schema{},
+			},
+			"title": {
+				Type: "string",
+			},
+		},
+		Required: []string{"links"},
+	},
+	"AuxTagResponseCollection": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"domainType": {
+			},
+			"extensions": {
+				Type: "object",
+				AdditionalProperties: &openapi.Schema{},
+			},
+			"id": {
+				Type: "string",
+			},
+			"links": {
+				Type: "array",
+				Items: &openapi.Schema{Ref: "Link"},
+			},
+			"title": {
+				Type: "string",
+			},
+			"value": {
+				Type: "array",
+				Items: &openapi.Schema{Ref: "AuxTagResponse"},
+			},
+		},
+		Required: []string{"links"},
+	},
+	"BIAction": {
+		Type: "object",
+		OneOf: []*openapi.Schema{
+			{Ref: "BICallARuleAction"},
+			{Ref: "BIStateOfHostAction"},
+			{Ref: "BIStateOfServiceAction"},
+			{Ref: "BIStateOfRemainingServicesAction"},
+		},
+		Discriminator: "type",
+		Mapping: map[string]string{
+			"call_a_rule": "BICallARuleAction",
+			"state_of_host": "BIStateOfHostAction",
+			"state_of_remaining_services": "BIStateOfRemainingServicesAction",
+			"state_of_service": "BIStateOfServiceAction",
+		},
+	},
+	"BIAggregationComputationOptions": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"disabled": {
+				Type: "boolean",
+			},
+			"escalate_downtimes_as_warn": {
+				Type: "boolean",
+			},
+			"freeze_aggregations": {
+				Type: "boolean",
+			},
+			"use_hard_states": {
+				Type: "boolean",
+			},
+		},
+		Required: []string{"disabled", "escalate_downtimes_as_warn", "use_hard_states"},
+	},
+	"BIAggregationEndpoint": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"aggregation_visualization": {Ref: "BIAggregationVisualization"},
+			"comment": {
+				Type: "string",
+				Nullable: true,
+			},
+			"computation_options": {Ref: "BIAggregationComputationOptions"},
+			"customer": {
+				Type: "string",
+				Nullable: true,
+			},
+			"groups": {Ref: "BIAggregationGroups"},
+			"id": {
+				Type: "string",
+			},
+			"node": {Ref: "BINodeGenerator"},
+			"pack_id": {
+				Type: "string",
+			},
+		},
+		Required: []string{"aggregation_visualization", "computation_options", "groups", "id", "node", "pack_id"},
+	},
+	"BIAggregationFunction": {
+		Type: "object",
+		OneOf: []*openapi.Schema{
+			{Ref: "BIAggregationFunctionBest"},
+			{Ref: "BIAggregationFunctionWorst"},
+			{Ref: "BIAggregationFunctionCountOK"},
+		},
+		Discriminator: "type",
+		Mapping: map[string]string{
+			"best": "BIAggregationFunctionBest",
+			"count_ok": "BIAggregationFunctionCountOK",
+			"worst": "BIAggregationFunctionWorst",
+		},
+	},
+	"BIAggregationFunctionBest": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"count": {
+				Type: "integer",
+			},
+			"restrict_state": {
+				Type: "integer",
+				Enum: []string{"0", "1", "2"},
+			},
+			"type": {
+			},
+		},
+		Required: []string{"count", "restrict_state", "type"},
+	},
+	"BIAggregationFunctionCountOK": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"levels_ok": {Ref: "BIAggregationFunctionCountSettings"},
+			"levels_warn": {Ref: "BIAggregationFunctionCountSettings"},
+			"type": {
+			},
+		},
+		Required: []string{"levels_ok", "levels_warn", "type"},
+	},
+	"BIAggregationFunctionCountSettings": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"type": {
+				Type: "string",
+				Enum: []string{"count", "percentage"},
+			},
+			"value": {
+				Type: "integer",
+			},
+		},
+		Required: []string{"type", "value"},
+	},
+	"BIAggregationFunctionWorst": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"count": {
+				Type: "integer",
+			},
+			"restrict_state": {
+				Type: "integer",
+				Enum: []string{"0", "1", "2"},
+			},
+			"type": {
+			},
+		},
+		Required: []string{"count", "restrict_state", "type"},
+	},
+	"BIAggregationGroups": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"names": {
+				Type: "array",
+				Items: &openapi.Schema{
+					Type: "string",
+				},
+			},
+			"paths": {
+				Type: "array",
+				Items: &openapi.Schema{
+					Type: "array",
+					Items: &openapi.Schema{
+						Type: "string",
+					},
+				},
+			},
+		},
+	},
+	"BIAggregationStateRequest": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"filter_groups": {
+				Type: "array",
+				Items: &openapi.Schema{
+					Type: "string",
+				},
+			},
+			"filter_names": {
+				Type: "array",
+				Items: &openapi.Schema{
+					Type: "string",
+				},
+			},
+		},
+	},
+	"BIAggregationStateResponse": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"aggregations": {
+				Type: "object",
+				AdditionalProperties: &openapi.Schema{},
+			},
+			"missing_aggr": {
+				Type: "array",
+				Items: &openapi.Schema{
+					Type: "string",
+				},
+			},
+			"missing_sites": {
+				Type: "array",
+				Items: &openapi.Schema{
+					Type: "string",
+				},
+			},
+		},
+	},
+	"BIAggregationVisualization": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"ignore_rule_styles": {
+				Type: "boolean",
+			},
+			"layout_id": {
+				Type: "string",
+			},
+			"line_style": {
+				Type: "string",
+			},
+		},
+		Required: []string{"ignore_rule_styles", "layout_id", "line_style"},
+	},
+	"BIAllHostsChoice": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"type": {
+			},
+		},
+		Required: []string{"type"},
+	},
+	"BICallARuleAction": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"params": {Ref: "BIParams"},
+			"rule_id": {
+				Type: "string",
+			},
+			"type": {
+			},
+		},
+		Required: []string{"params", "rule_id", "type"},
+	},
+	"BIEmptySearch": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"type": {
+			},
+		},
+		Required: []string{"type"},
+	},
+	"BIFixedArgumentsSearch": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"arguments": {
+				Type: "array",
+				Items: &openapi.Schema{Ref: "BIFixedArgumentsSearchToken"},
+			},
+			"type": {
+			},
+		},
+		Required: []string{"arguments", "type"},
+	},
+	"BIFixedArgumentsSearchToken": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"key": {
+				Type: "string",
+			},
+			"values": {
+				Type: "array",
+				Items: &openapi.Schema{
+					Type: "string",
+				},
+			},
+		},
+		Required: []string{"key", "values"},
+	},
+	"BIHostAliasRegexChoice": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"pattern": {
+				Type: "string",
+			},
+			"type": {
+			},
+		},
+		Required: []string{"pattern", "type"},
+	},
+	"BIHostChoice": {
+		Type: "object",
+		OneOf: []*openapi.Schema{
+			{Ref: "BIAllHostsChoice"},
+			{Ref: "BIHostNameRegexChoice"},
+			{Ref: "BIHostAliasRegexChoice"},
+		},
+		Discriminator: "type",
+		Mapping: map[string]string{
+			"all_hosts": "BIAllHostsChoice",
+			"host_alias_regex": "BIHostAliasRegexChoice",
+			"host_name_regex": "BIHostNameRegexChoice",
+		},
+	},
+	"BIHostNameRegexChoice": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"pattern": {
+				Type: "string",
+			},
+			"type": {
+			},
+		},
+		Required: []string{"pattern", "type"},
+	},
+	"BIHostSearch": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"conditions": {Ref: "HostConditions"},
+			"refer_to": {Ref: "ReferTo"},
+			"type": {
+			},
+		},
+		Required: []string{"conditions", "refer_to", "type"},
+	},
+	"BINodeGenerator": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"action": {Ref: "BIAction"},
+			"search": {Ref: "BISearch"},
+		},
+		Required: []string{"action", "search"},
+	},
+	"BINodeVisBlockStyle": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"style_config": {
+			},
+			"type": {
+			},
+		},
+		Required: []string{"style_config", "type"},
+	},
+	"BINodeVisForceStyle": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"style_config": {
+			},
+			"type": {
+			},
+		},
+		Required: []string{"style_config", "type"},
+	},
+	"BINodeVisHierarchyStyle": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"style_config": {Ref: "BINodeVisHierarchyStyleConfig"},
+			"type": {
+			},
+		},
+		Required: []string{"style_config", "type"},
+	},
+	"BINodeVisHierarchyStyleConfig": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"layer_height": {
+				Type: "integer",
+			},
+			"node_size": {
+				Type: "integer",
+			},
+			"rotation": {
+				Type: "integer",
+			},
+		},
+		Required: []string{"layer_height", "node_size", "rotation"},
+	},
+	"BINodeVisLayoutStyle": {
+		Type: "object",
+		OneOf: []*openapi.Schema{
+			{Ref: "BINodeVisNoneStyle"},
+			{Ref: "BINodeVisBlockStyle"},
+			{Ref: "BINodeVisHierarchyStyle"},
+			{Ref: "BINodeVisRadialStyle"},
+			{Ref: "BINodeVisForceStyle"},
+		},
+		Discriminator: "type",
+		Mapping: map[string]string{
+			"block": "BINodeVisBlockStyle",
+			"force": "BINodeVisForceStyle",
+			"hierarchy": "BINodeVisHierarchyStyle",
+			"none": "BINodeVisNoneStyle",
+			"radial": "BINodeVisRadialStyle",
+		},
+	},
+	"BINodeVisNoneStyle": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"style_config": {
+			},
+			"type": {
+			},
+		},
+		Required: []string{"style_config", "type"},
+	},
+	"BINodeVisRadialStyle": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"style_config": {Ref: "BINodeVisRadialStyleConfig"},
+			"type": {
+			},
+		},
+		Required: []string{"style_config", "type"},
+	},
+	"BINodeVisRadialStyleConfig": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"degree": {
+				Type: "integer",
+			},
+			"radius": {
+				Type: "integer",
+			},
+			"rotation": {
+				Type: "integer",
+			},
+		},
+		Required: []string{"degree", "radius", "rotation"},
+	},
+	"BIPackEndpoint": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"contact_groups": {
+				Type: "array",
+				Items: &openapi.Schema{
+					Type: "string",
+				},
+			},
+			"public": {
+				Type: "boolean",
+			},
+			"title": {
+				Type: "string",
+			},
+		},
+		Required: []string{"contact_groups", "public", "title"},
+	},
+	"BIParams": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"arguments": {
+				Type: "array",
+				Items: &openapi.Schema{
+					Type: "string",
+				},
+			},
+		},
+		Required: []string{"arguments"},
+	},
+	"BIRuleComputationOptions": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"disabled": {
+				Type: "boolean",
+			},
+		},
+		Required: []string{"disabled"},
+	},
+	"BIRuleEndpoint": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"aggregation_function": {Ref: "BIAggregationFunction"},
+			"computation_options": {Ref: "BIRuleComputationOptions"},
+			"id": {
+				Type: "string",
+			},
+			"node_visualization": {Ref: "BINodeVisLayoutStyle"},
+			"nodes": {
+				Type: "array",
+				Items: &openapi.Schema{Ref: "BINodeGenerator"},
+			},
+			"pack_id": {
+				Type: "string",
+			},
+			"params": {Ref: "BIParams"},
+			"properties": {Ref: "BIRuleProperties"},
+		},
+		Required: []string{"aggregation_function", "computation_options", "id", "node_visualization", "nodes", "pack_id", "params", "properties"},
+	},
+	"BIRuleProperties": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"comment": {
+				Type: "string",
+			},
+			"docu_url": {
+				Type: "string",
+			},
+			"icon": {
+				Type: "string",
+			},
+			"state_messages": {
+				Type: "object",
+				AdditionalProperties: &openapi.Schema{},
+			},
+			"title": {
+				Type: "string",
+			},
+		},
+		Required: []string{"comment", "docu_url", "icon", "state_messages", "title"},
+	},
+	"BISearch": {
+		Type: "object",
+		OneOf: []*openapi.Schema{
+			{Ref: "BIEmptySearch"},
+			{Ref: "BIHostSearch"},
+			{Ref: "BIServiceSearch"},
+			{Ref: "BIFixedArgumentsSearch"},
+		},
+		Discriminator: "type",
+		Mapping: map[string]string{
+			"empty": "BIEmptySearch",
+			"fixed_arguments": "BIFixedArgumentsSearch",
+			"host_search": "BIHostSearch",
+			"service_search": "BIServiceSearch",
+		},
+	},
+	"BIServiceSearch": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"conditions": {Ref: "ServiceConditions"},
+			"type": {
+			},
+		},
+		Required: []string{"conditions", "type"},
+	},
+	"BIStateOfHostAction": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"host_regex": {
+				Type: "string",
+			},
+			"type": {
+			},
+		},
+		Required: []string{"host_regex", "type"},
+	},
+	"BIStateOfRemainingServicesAction": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"host_regex": {
+				Type: "string",
+			},
+			"type": {
+			},
+		},
+		Required: []string{"host_regex", "type"},
+	},
+	"BIStateOfServiceAction": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"host_regex": {
+				Type: "string",
+			},
+			"service_regex": {
+				Type: "string",
+			},
+			"type": {
+			},
+		},
+		Required: []string{"host_regex", "service_regex", "type"},
+	},
+	"BackgroundJobStatus": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"active": {
+				Type: "boolean",
+			},
+			"logs": {Ref: "JobLogs"},
+			"state": {
+				Type: "string",
+				Enum: []string{"initialized", "running", "finished", "stopped", "exception"},
+			},
+		},
+		Required: []string{"active", "logs", "state"},
+	},
+	"BaseUserAttributes": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"auth_option": {Ref: "AuthOption1"},
+			"authorized_sites": {
+				Type: "array",
+				Items: &openapi.Schema{
+					Type: "string",
+				},
+			},
+			"contact_options": {Ref: "ConcreteUserContactOption"},
+			"contactgroups": {
+				Type: "array",
+				Items: &openapi.Schema{
+					Type: "string",
+				},
+			},
+			"disable_login": {
+				Type: "boolean",
+			},
+			"disable_notifications": {Ref: "ConcreteDisabledNotifications"},
+			"fullname": {
+				Type: "string",
+			},
+			"idle_timeout": {Ref: "UserIdleOption"},
+			"interface_options": {Ref: "ConcreteUserInterfaceAttributes"},
+			"language": {
+				Type: "string",
+			},
+			"pager_address": {
+				Type: "string",
+			},
+			"roles": {
+				Type: "array",
+				Items: &openapi.Schema{
+					Type: "string",
+				},
+			},
+			"temperature_unit": {
+				Type: "string",
+			},
+		},
+		Required: []string{"fullname"},
+	},
+	"BasicSettingsAttributes": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"alias": {
+				Type: "string",
+			},
+			"site_id": {
+				Type: "string",
+			},
+		},
+		Required: []string{"alias", "site_id"},
+	},
+	"BasicSettingsAttributesCreate": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"alias": {
+				Type: "string",
+			},
+			"site_id": {
+				Type: "string",
+			},
+		},
+		Required: []string{"alias", "site_id"},
+	},
+	"BasicSettingsAttributesUpdate": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"alias": {
+				Type: "string",
+			},
+			"site_id": {
+				Type: "string",
+			},
+		},
+		Required: []string{"alias", "site_id"},
+	},
+	"BinaryExpr": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"left": {
+				Type: "string",
+				Pattern: "^([a-z]+\\.)?[_a-z]+$",
+			},
+			"op": {
+				Type: "string",
+			},
+			"right": {
+				Type: "string",
+			},
+		},
+	},
+	"BulkCreateHost": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"entries": {
+				Type: "array",
+				Items: &openapi.Schema{Ref: "CreateHost"},
+			},
+		},
+		Required: []string{"entries"},
+	},
+	"BulkDeleteContactGroup": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"entries": {
+				Type: "array",
+				Items: &openapi.Schema{
+					Type: "string",
+				},
+			},
+		},
+		Required: []string{"entries"},
+	},
+	"BulkDeleteHost": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"entries": {
+				Type: "array",
+				Items: &openapi.Schema{
+					Type: "string",
+					Pattern: "^[-0-9a-zA-Z_.]+\\Z",
+				},
+			},
+		},
+		Required: []string{"entries"},
+	},
+	"BulkDeleteHostGroup": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"entries": {
+				Type: "array",
+				Items: &openapi.Schema{
+					Type: "string",
+				},
+			},
+		},
+		Required: []string{"entries"},
+	},
+	"BulkDeleteServiceGroup": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"entries": {
+				Type: "array",
+				Items: &openapi.Schema{
+					Type: "string",
+				},
+			},
+		},
+		Required: []string{"entries"},
+	},
+	"BulkDiscovery": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"bulk_size": {
+				Type: "integer",
+			},
+			"do_full_scan": {
+				Type: "boolean",
+			},
+			"hostnames": {
+				Type: "array",
+				Items: &openapi.Schema{
+					Type: "string",
+					Pattern: "^[-0-9a-zA-Z_.]+\\Z",
+				},
+			},
+			"ignore_errors": {
+				Type: "boolean",
+			},
+			"mode": {
+				Type: "string",
+				Enum: []string{"new", "remove", "fix_all", "refresh", "only_host_labels", "tabula_rasa"},
+			},
+		},
+		Required: []string{"hostnames"},
+	},
+	"BulkHostActionWithFailedHosts": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"detail": {
+				Type: "string",
+			},
+			"ext": {Ref: "FailedHosts"},
+			"fields": {
+				Type: "object",
+				AdditionalProperties: &openapi.Schema{},
+			},
+			"status": {
+				Type: "integer",
+			},
+			"title": {
+				Type: "string",
+			},
+		},
+		Required: []string{"detail", "status", "title"},
+	},
+	"BulkInputContactGroup": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"entries": {
+				Type: "array",
+				Items: &openapi.Schema{Ref: "InputContactGroup"},
+			},
+		},
+		Required: []string{"entries"},
+	},
+	"BulkInputHostGroup": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"entries": {
+				Type: "array",
+				Items: &openapi.Schema{Ref: "InputHostGroup"},
+			},
+		},
+		Required: []string{"entries"},
+	},
+	"BulkInputServiceGroup": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"entries": {
+				Type: "array",
+				Items: &openapi.Schema{Ref: "InputServiceGroup"},
+			},
+		},
+		Required: []string{"entries"},
+	},
+	"BulkOutsideTimePeriodValue": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"state": {
+				Type: "string",
+				Enum: []string{"enabled", "disabled"},
+			},
+			"value": {Ref: "NotificationBulkingCommonAttributes"},
+		},
+		Required: []string{"state"},
+	},
+	"BulkUpdateContactGroup": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"entries": {
+				Type: "array",
+				Items: &openapi.Schema{Ref: "UpdateContactGroup"},
+			},
+		},
+		Required: []string{"entries"},
+	},
+	"BulkUpdateFolder": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"entries": {
+				Type: "array",
+				Items: &openapi.Schema{Ref: "UpdateFolderEntry"},
+			},
+		},
+		Required: []string{"entries"},
+	},
+	"BulkUpdateHost": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"entries": {
+				Type: "array",
+				Items: &openapi.Schema{Ref: "UpdateHostEntry"},
+			},
+		},
+		Required: []string{"entries"},
+	},
+	"BulkUpdateHostGroup": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"entries": {
+				Type: "array",
+				Items: &openapi.Schema{Ref: "UpdateHostGroup"},
+			},
+		},
+		Required: []string{"entries"},
+	},
+	"BulkUpdateServiceGroup": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"entries": {
+				Type: "array",
+				Items: &openapi.Schema{Ref: "UpdateServiceGroup"},
+			},
+		},
+		Required: []string{"entries"},
+	},
+	"CaseParams": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"host_description": {Ref: "CheckboxOneOf"},
+			"host_short_description": {Ref: "CheckboxOneOf"},
+			"priority": {Ref: "CheckboxOneOf"},
+			"service_description": {Ref: "CheckboxOneOf"},
+			"service_short_description": {Ref: "CheckboxOneOf"},
+			"state_recovery": {Ref: "CheckboxOneOf"},
+		},
+	},
+	"ChangeEventState": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"new_state": {
+				Type: "string",
+				Enum: []string{"ok", "warning", "critical", "unknown"},
+			},
+			"site_id": {
+				Type: "string",
+			},
+		},
+		Required: []string{"new_state", "site_id"},
+	},
+	"ChangeEventStateSelector": {
+		Type: "object",
+		OneOf: []*openapi.Schema{
+			{Ref: "ChangeStateWithQuery"},
+			{Ref: "ChangeStateWithParams"},
+		},
+		Discriminator: "filter_type",
+		Mapping: map[string]string{
+			"params": "ChangeStateWithParams",
+			"query": "ChangeStateWithQuery",
+		},
+	},
+	"ChangeStateWithParams": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"filter_type": {
+				Type: "string",
+				Enum: []string{"query", "params"},
+			},
+			"filters": {Ref: "FilterParams"},
+			"new_state": {
+				Type: "string",
+				Enum: []string{"ok", "warning", "critical", "unknown"},
+			},
+			"site_id": {
+				Type: "string",
+			},
+		},
+		Required: []string{"filter_type", "filters", "new_state"},
+	},
+	"ChangeStateWithQuery": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"filter_type": {
+				Type: "string",
+				Enum: []string{"query", "params"},
+			},
+			"new_state": {
+				Type: "string",
+				Enum: []string{"ok", "warning", "critical", "unknown"},
+			},
+			"query": {Ref: "Expr"},
+			"site_id": {
+				Type: "string",
+			},
+		},
+		Required: []string{"filter_type", "new_state", "query"},
+	},
+	"ChangesFields": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"action_name": {
+				Type: "string",
+			},
+			"id": {
+				Type: "string",
+				Format: "uuid",
+			},
+			"text": {
+				Type: "string",
+			},
+			"time": {
+				Type: "string",
+				Format: "date-time",
+			},
+			"user_id": {
+				Type: "string",
+			},
+		},
+	},
+	"Checkbox": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"state": {
+				Type: "string",
+				Enum: []string{"enabled", "disabled"},
+			},
+		},
+		Required: []string{"state"},
+	},
+	"CheckboxHostEventType": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"state": {
+				Type: "string",
+				Enum: []string{"enabled", "disabled"},
+			},
+			"value": {Ref: "HostEventType"},
+		},
+		Required: []string{"state", "value"},
+	},
+	"CheckboxLabel": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"key": {
+				Type: "string",
+			},
+			"value": {
+				Type: "string",
+			},
+		},
+		Required: []string{"key", "value"},
+	},
+	"CheckboxMatchHostTags": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"state": {
+				Type: "string",
+				Enum: []string{"enabled", "disabled"},
+			},
+			"value": {Ref: "HostTagValues"},
+		},
+		Required: []string{"state", "value"},
+	},
+	"CheckboxOneOf": {
+		Type: "object",
+		OneOf: []*openapi.Schema{
+			{Ref: "Checkbox"},
+			{Ref: "FromEmailAndNameCheckbox"},
+		},
+		Discriminator: "state",
+		Mapping: map[string]string{
+			"disabled": "Checkbox",
+			"enabled": "FromEmailAndNameCheckbox",
+		},
+	},
+	"CheckboxRestrictNotificationNumbers": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"state": {
+				Type: "string",
+				Enum: []string{"enabled", "disabled"},
+			},
+			"value": {Ref: "FromToNotificationNumbers"},
+		},
+		Required: []string{"state", "value"},
+	},
+	"CheckboxServiceEventType": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"state": {
+				Type: "string",
+				Enum: []string{"enabled", "disabled"},
+			},
+			"value": {Ref: "ServiceEventType"},
+		},
+		Required: []string{"state", "value"},
+	},
+	"CheckboxThrottlePeriodicNotifcations": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"state": {
+				Type: "string",
+				Enum: []string{"enabled", "disabled"},
+			},
+			"value": {Ref: "ThrottlePeriodicNotifications"},
+		},
+		Required: []string{"state", "value"},
+	},
+	"CheckboxWithFolderStr": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"state": {
+				Type: "string",
+				Enum: []string{"enabled", "disabled"},
+			},
+			"value": {
+				Type: "string",
+				Pattern: "^(?:(?:[~\\\\\\/]|(?:[~\\\\\\/][-_ a-zA-Z0-9.]+)+[~\\\\\\/]?)|[0-9a-fA-F]{32})$",
+			},
+		},
+		Required: []string{"state", "value"},
+	},
+	"CheckboxWithFromToServiceLevels": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"state": {
+				Type: "string",
+				Enum: []string{"enabled", "disabled"},
+			},
+			"value": {Ref: "FromToServiceLevels"},
+		},
+		Required: []string{"state", "value"},
+	},
+	"CheckboxWithListOfLabels": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"state": {
+				Type: "string",
+				Enum: []string{"enabled", "disabled"},
+			},
+			"value": {
+				Type: "array",
+				Items: &openapi.Schema{Ref: "CheckboxLabel"},
+			},
+		},
+		Required: []string{"state", "value"},
+	},
+	"CheckboxWithListOfServiceGroupsRegex": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"state": {
+				Type: "string",
+				Enum: []string{"enabled", "disabled"},
+			},
+			"value": {Ref: "ServiceGroupsRegex"},
+		},
+		Required: []string{"state", "value"},
+	},
+	"CheckboxWithListOfStr": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"state": {
+				Type: "string",
+				Enum: []string{"enabled", "disabled"},
+			},
+			"value": {
+				Type: "array",
+				Items: &openapi.Schema{
+					Type: "string",
+				},
+			},
+		},
+		Required: []string{"state", "value"},
+	},
+	"CheckboxWithStr": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"state": {
+				Type: "string",
+				Enum: []string{"enabled", "disabled"},
+			},
+			"value": {
+				Type: "string",
+			},
+		},
+		Required: []string{"state", "value"},
+	},
+	"CheckboxWithStrValue": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"state": {
+				Type: "string",
+				Enum: []string{"enabled", "disabled"},
+			},
+			"value": {
+				Type: "string",
+			},
+		},
+		Required: []string{"state", "value"},
+	},
+	"CheckboxWithSysLogPriority": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"state": {
+				Type: "string",
+				Enum: []string{"enabled", "disabled"},
+			},
+			"value": {Ref: "SysLogToFromPriorities"},
+		},
+		Required: []string{"state"},
+	},
+	"Child": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"type": {
+			},
+		},
+		Required: []string{"type"},
+	},
+	"ChildWith": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"conditions": {Ref: "HostConditions"},
+			"host_choice": {Ref: "BIHostChoice"},
+		},
+		Required: []string{"conditions", "host_choice"},
+	},
+	"CiscoExplicitWebhookUrl": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"option": {
+				Type: "string",
+				Enum: []string{"store", "explicit"},
+			},
+			"url": {
+				Type: "string",
+				Format: "url",
+			},
+		},
+		Required: []string{"option", "url"},
+	},
+	"CiscoPasswordStore": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"option": {
+				Type: "string",
+				Enum: []string{"store", "explicit"},
+			},
+			"store_id": {
+				Type: "string",
+			},
+		},
+		Required: []string{"option", "store_id"},
+	},
+	"CiscoUrlOrStoreSelector": {
+		Type: "object",
+		OneOf: []*openapi.Schema{
+			{Ref: "CiscoExplicitWebhookUrl"},
+			{Ref: "CiscoPasswordStore"},
+		},
+		Discriminator: "option",
+		Mapping: map[string]string{
+			"explicit": "CiscoExplicitWebhookUrl",
+			"store": "CiscoPasswordStore",
+		},
+	},
+	"CiscoWebexPluginCreate": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"disable_ssl_cert_verification": {Ref: "Checkbox"},
+			"http_proxy": {Ref: "CheckboxOneOf"},
+			"plugin_name": {
+				Type: "string",
+				Enum: []string{"asciimail", "cisco_webex_teams", "mkeventd", "mail", "ilert", "jira_issues", "opsgenie_issues", "pagerduty", "pushover", "servicenow", "signl4", "slack", "sms_api", "sms", "spectrum", "victorops", "msteams"},
+			},
+			"url_prefix_for_links_to_checkmk": {Ref: "CheckboxOneOf"},
+			"webhook_url": {Ref: "CiscoUrlOrStoreSelector"},
+		},
+		Required: []string{"disable_ssl_cert_verification", "http_proxy", "plugin_name", "url_prefix_for_links_to_checkmk"},
+	},
+	"ClusterCreateAttribute": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"additional_ipv4addresses": {
+				Type: "array",
+				Items: &openapi.Schema{
+					Type: "string",
+				},
+			},
+			"additional_ipv6addresses": {
+				Type: "array",
+				Items: &openapi.Schema{
+					Type: "string",
+				},
+			},
+			"alias": {
+				Type: "string",
+			},
+			"contactgroups": {Ref: "HostContactGroup"},
+			"inventory_failed": {
+				Type: "boolean",
+			},
+			"ipaddress": {
+				Type: "string",
+			},
+			"ipv6address": {
+				Type: "string",
+			},
+			"labels": {
+				Type: "object",
+				AdditionalProperties: &openapi.Schema{
+					Type: "string",
+				},
+			},
+			"locked_attributes": {
+				Type: "array",
+				Items: &openapi.Schema{
+					Type: "string",
+				},
+			},
+			"locked_by": {Ref: "LockedBy"},
+			"management_address": {
+				Type: "string",
+			},
+			"management_ipmi_credentials": {Ref: "IPMIParameters"},
+			"management_protocol": {
+				Type: "string",
+				Enum: []string{"none", "snmp", "ipmi"},
+			},
+			"management_snmp_community": {Ref: "SNMPCredentials", Nullable: true},
+			"network_scan": {Ref: "NetworkScan"},
+			"parents": {
+				Type: "array",
+				Items: &openapi.Schema{
+					Type: "string",
+					Pattern: "^[-0-9a-zA-Z_.]+\\Z",
+				},
+			},
+			"site": {
+				Type: "string",
+			},
+			"snmp_community": {Ref: "SNMPCredentials"},
+			"tag_address_family": {
+				Type: "string",
+				Enum: []string{"ip-v4-only", "ip-v6-only", "ip-v4v6", "no-ip"},
+			},
+			"tag_agent": {
+				Type: "string",
+				Enum: []string{"cmk-agent", "all-agents", "special-agents", "no-agent"},
+			},
+			"tag_criticality": {
+				Type: "string",
+				Enum: []string{"prod", "critical", "test", "offline"},
+			},
+			"tag_networking": {
+				Type: "string",
+				Enum: []string{"lan", "wan", "dmz"},
+			},
+			"tag_piggyback": {
+				Type: "string",
+				Enum: []string{"auto-piggyback", "piggyback", "no-piggyback"},
+			},
+			"tag_snmp_ds": {
+				Type: "string",
+				Enum: []string{"no-snmp", "snmp-v2", "snmp-v1"},
+			},
+		},
+	},
+	"CollectionItem": {
+		Type: "object",
+		OneOf: []*openapi.Schema{
+			{Ref: "Link"},
+		},
+		Discriminator: "domainType",
+		Mapping: map[string]string{
+			"link": "Link",
+		},
+	},
+	"CommentAttributes": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"author": {
+				Type: "string",
+			},
+			"comment": {
+				Type: "string",
+			},
+			"entry_time": {
+				Type: "string",
+			},
+			"host_name": {
+				Type: "string",
+			},
+			"id": {
+				Type: "integer",
+			},
+			"is_service": {
+				Type: "boolean",
+			},
+			"persistent": {
+				Type: "boolean",
+			},
+			"service_description": {
+				Type: "string",
+			},
+			"site_id": {
+				Type: "string",
+			},
+		},
+		Required: []string{"author", "comment", "entry_time", "host_name", "id", "is_service", "persistent", "site_id"},
+	},
+	"CommentCollection": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"domainType": {
+			},
+			"extensions": {
+				Type: "object",
+				AdditionalProperties: &openapi.Schema{},
+			},
+			"id": {
+				Type: "string",
+			},
+			"links": {
+				Type: "array",
+				Items: &openapi.Schema{Ref: "Link"},
+			},
+			"title": {
+				Type: "string",
+			},
+			"value": {
+				Type: "array",
+				Items: &openapi.Schema{Ref: "CommentObject"},
+			},
+		},
+		Required: []string{"links"},
+	},
+	"CommentObject": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"domainType": {
+			},
+			"extensions": {Ref: "CommentAttributes"},
+			"id": {
+				Type: "string",
+			},
+			"links": {
+				Type: "array",
+				Items: &openapi.Schema{Ref: "Link"},
+			},
+			"members": {
+				Type: "object",
+				AdditionalProperties: &openapi.Schema{},
+			},
+			"title": {
+				Type: "string",
+			},
+		},
+		Required: []string{"links"},
+	},
+	"ConcreteDisabledNotifications": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"disable": {
+				Type: "boolean",
+			},
+			"timerange": {Ref: "DateTimeRange"},
+		},
+	},
+	"ConcreteHostTagGroup": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"domainType": {
+			},
+			"extensions": {Ref: "HostTagExtensions"},
+			"id": {
+				Type: "string",
+			},
+			"links": {
+				Type: "array",
+				Items: &openapi.Schema{Ref: "Link"},
+			},
+			"members": {
+				Type: "object",
+				AdditionalProperties: &openapi.Schema{},
+			},
+			"title": {
+				Type: "string",
+			},
+		},
+		Required: []string{"domainType", "links"},
+	},
+	"ConcreteTimePeriodException": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"date": {
+				Type: "string",
+				Format: "date",
+			},
+			"time_ranges": {
+				Type: "array",
+				Items: &openapi.Schema{Ref: "ConcreteTimeRange"},
+			},
+		},
+	},
+	"ConcreteTimeRange": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"end": {
+				Type: "string",
+				Format: "time",
+			},
+			"start": {
+				Type: "string",
+				Format: "time",
+			},
+		},
+	},
+	"ConcreteTimeRangeActive": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"day": {
+				Type: "string",
+				Enum: []string{"monday", "tuesday", "wednesday", "thursday", "friday", "saturday", "sunday"},
+			},
+			"time_ranges": {
+				Type: "array",
+				Items: &openapi.Schema{Ref: "ConcreteTimeRange"},
+			},
+		},
+	},
+	"ConcreteUserContactOption": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"email": {
+				Type: "string",
+			},
+			"fallback_contact": {
+				Type: "boolean",
+			},
+		},
+		Required: []string{"email"},
+	},
+	"ConcreteUserInterfaceAttributes": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"interface_theme": {
+				Type: "string",
+				Enum: []string{"default", "dark", "light"},
+			},
+			"mega_menu_icons": {
+				Type: "string",
+				Enum: []string{"topic", "entry"},
+			},
+			"navigation_bar_icons": {
+				Type: "string",
+				Enum: []string{"hide", "show"},
+			},
+			"show_mode": {
+				Type: "string",
+				Enum: []string{"default", "default_show_less", "default_show_more", "enforce_show_more"},
+			},
+			"sidebar_position": {
+				Type: "string",
+				Enum: []string{"left", "right"},
+			},
+		},
+	},
+	"ConditionsAttributes": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"event_console_alerts": {Ref: "MatchEventConsoleAlertsResponse"},
+			"match_check_types": {Ref: "CheckboxWithListOfStr"},
+			"match_contact_groups": {Ref: "CheckboxWithListOfStr"},
+			"match_exclude_hosts": {Ref: "CheckboxWithListOfStr"},
+			"match_exclude_service_groups": {Ref: "CheckboxWithListOfStr"},
+			"match_exclude_service_groups_regex": {Ref: "CheckboxWithListOfServiceGroupsRegex"},
+			"match_exclude_services": {Ref: "CheckboxWithListOfStr"},
+			"match_folder": {Ref: "CheckboxWithFolderStr"},
+			"match_host_event_type": {Ref: "CheckboxHostEventType"},
+			"match_host_groups": {Ref: "CheckboxWithListOfStr"},
+			"match_host_labels": {Ref: "CheckboxWithListOfLabels"},
+			"match_host_tags": {Ref: "CheckboxMatchHostTags"},
+			"match_hosts": {Ref: "CheckboxWithListOfStr"},
+			"match_notification_comment": {Ref: "CheckboxWithStr"},
+			"match_only_during_time_period": {Ref: "CheckboxWithStr"},
+			"match_plugin_output": {Ref: "CheckboxWithStr"},
+			"match_service_event_type": {Ref: "CheckboxServiceEventType"},
+			"match_service_groups": {Ref: "CheckboxWithListOfStr"},
+			"match_service_groups_regex": {Ref: "CheckboxWithListOfServiceGroupsRegex"},
+			"match_service_labels": {Ref: "CheckboxWithListOfLabels"},
+			"match_service_levels": {Ref: "CheckboxWithFromToServiceLevels"},
+			"match_services": {Ref: "CheckboxWithListOfStr"},
+			"match_sites": {Ref: "CheckboxWithListOfStr"},
+			"restrict_to_notification_numbers": {Ref: "CheckboxRestrictNotificationNumbers"},
+			"throttle_periodic_notifications": {Ref: "CheckboxThrottlePeriodicNotifcations"},
+		},
+	},
+	"ConfigurationConnectionAttributes": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"direct_login_to_web_gui_allowed": {
+				Type: "boolean",
+			},
+			"disable_remote_configuration": {
+				Type: "boolean",
+			},
+			"enable_replication": {
+				Type: "boolean",
+			},
+			"ignore_tls_errors": {
+				Type: "boolean",
+			},
+			"replicate_event_console": {
+				Type: "boolean",
+			},
+			"replicate_extensions": {
+				Type: "boolean",
+			},
+			"url_of_remote_site": {
+				Type: "string",
+			},
+			"user_sync": {Ref: "UserSyncAttributes"},
+		},
+		Required: []string{"direct_login_to_web_gui_allowed", "disable_remote_configuration", "enable_replication", "ignore_tls_errors", "replicate_event_console", "replicate_extensions", "url_of_remote_site", "user_sync"},
+	},
+	"ConfigurationConnectionAttributes1": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"direct_login_to_web_gui_allowed": {
+				Type: "boolean",
+			},
+			"disable_remote_configuration": {
+				Type: "boolean",
+			},
+			"enable_replication": {
+				Type: "boolean",
+			},
+			"ignore_tls_errors": {
+				Type: "boolean",
+			},
+			"replicate_event_console": {
+				Type: "boolean",
+			},
+			"replicate_extensions": {
+				Type: "boolean",
+			},
+			"url_of_remote_site": {
+				Type: "string",
+			},
+			"user_sync": {Ref: "UserSyncAttributes1"},
+		},
+		Required: []string{"user_sync"},
+	},
+	"ConnectionMode": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"connection_mode": {
+				Type: "string",
+				Enum: []string{"pull-agent", "push-agent"},
+			},
+		},
+	},
+	"ContactGroup": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"domainType": {
+			},
+			"extensions": {
+				Type: "object",
+				AdditionalProperties: &openapi.Schema{},
+			},
+			"id": {
+				Type: "string",
+			},
+			"links": {
+				Type: "array",
+				Items: &openapi.Schema{Ref: "Link"},
+			},
+			"members": {
+				Type: "object",
+				AdditionalProperties: &openapi.Schema{},
+			},
+			"title": {
+				Type: "string",
+			},
+		},
+		Required: []string{"links"},
+	},
+	"ContactGroupCollection": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"domainType": {
+			},
+			"extensions": {
+				Type: "object",
+				AdditionalProperties: &openapi.Schema{},
+			},
+			"id": {
+				Type: "string",
+			},
+			"links": {
+				Type: "array",
+				Items: &openapi.Schema{Ref: "Link"},
+			},
+			"title": {
+				Type: "string",
+			},
+			"value": {
+				Type: "array",
+				Items: &openapi.Schema{Ref: "ContactGroup"},
+			},
+		},
+		Required: []string{"links"},
+	},
+	"ContactSelection": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"all_contacts_of_the_notified_object": {Ref: "Checkbox"},
+			"all_users": {Ref: "Checkbox"},
+			"all_users_with_an_email_address": {Ref: "Checkbox"},
+			"explicit_email_addresses": {Ref: "CheckboxOneOf"},
+			"members_of_contact_groups": {Ref: "CheckboxOneOf"},
+			"restrict_by_contact_groups": {Ref: "CheckboxOneOf"},
+			"restrict_by_custom_macros": {Ref: "CheckboxOneOf"},
+			"the_following_users": {Ref: "CheckboxOneOf"},
+		},
+		Required: []string{"all_contacts_of_the_notified_object", "all_users", "all_users_with_an_email_address", "explicit_email_addresses", "members_of_contact_groups", "restrict_by_contact_groups", "restrict_by_custom_macros", "the_following_users"},
+	},
+	"ContactSelectionAttributes": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"all_contacts_of_the_notified_object": {Ref: "Checkbox"},
+			"all_users": {Ref: "Checkbox"},
+			"all_users_with_an_email_address": {Ref: "Checkbox"},
+			"explicit_email_addresses": {Ref: "CheckboxWithListOfStr"},
+			"members_of_contact_groups": {Ref: "CheckboxWithListOfStr"},
+			"restrict_by_contact_groups": {Ref: "CheckboxWithListOfStr"},
+			"restrict_by_custom_macros": {Ref: "MatchCustomMacros"},
+			"the_following_users": {Ref: "CheckboxWithListOfStr"},
+		},
+	},
+	"CreateClusterHost": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"attributes": {
+				AnyOf: []*openapi.Schema{
+					{Ref: "ClusterCreateAttribute"},
+					{Ref: "CustomHostAttributes"},
+					{Ref: "TagGroupAttributes"},
+				},
+			},
+			"folder": {
+				Type: "string",
+				Pattern: "^(?:(?:[~\\\\\\/]|(?:[~\\\\\\/][-_ a-zA-Z0-9.]+)+[~\\\\\\/]?)|[0-9a-fA-F]{32})$",
+			},
+			"host_name": {
+				Type: "string",
+				Pattern: "^[-0-9a-zA-Z_.]+\\Z",
+			},
+			"nodes": {
+				Type: "array",
+				Items: &openapi.Schema{
+					Type: "string",
+					Pattern: "^[-0-9a-zA-Z_.]+\\Z",
+				},
+			},
+		},
+		Required: []string{"folder", "host_name", "nodes"},
+	},
+	"CreateFolder": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"attributes": {
+				AnyOf: []*openapi.Schema{
+					{Ref: "FolderCreateAttribute"},
+					{Ref: "CustomHostAttributes"},
+					{Ref: "TagGroupAttributes"},
+				},
+			},
+			"name": {
+				Type: "string",
+				Pattern: "^[-\\w]*\\Z",
+				MinLength: openapi.Int(1),
+			},
+			"parent": {
+				Type: "string",
+				Pattern: "^(?:(?:[~\\\\\\/]|(?:[~\\\\\\/][-_ a-zA-Z0-9.]+)+[~\\\\\\/]?)|[0-9a-fA-F]{32})$",
+			},
+			"title": {
+				Type: "string",
+			},
+		},
+		Required: []string{"parent", "title"},
+	},
+	"CreateHost": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"attributes": {
+				AnyOf: []*openapi.Schema{
+					{Ref: "HostCreateAttribute"},
+					{Ref: "CustomHostAttributes"},
+					{Ref: "TagGroupAttributes"},
+				},
+			},
+			"folder": {
+				Type: "string",
+				Pattern: "^(?:(?:[~\\\\\\/]|(?:[~\\\\\\/][-_ a-zA-Z0-9.]+)+[~\\\\\\/]?)|[0-9a-fA-F]{32})$",
+			},
+			"host_name": {
+				Type: "string",
+				Pattern: "^[-0-9a-zA-Z_.]+\\Z",
+			},
+		},
+		Required: []string{"folder", "host_name"},
+	},
+	"CreateHostComment": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"comment": {
+				Type: "string",
+			},
+			"comment_type": {
+				Type: "string",
+				Enum: []string{"host", "host_by_query"},
+			},
+			"host_name": {
+				Type: "string",
+				Pattern: "^[-0-9a-zA-Z_.]+\\Z",
+			},
+			"persistent": {
+				Type: "boolean",
+			},
+		},
+		Required: []string{"comment", "comment_type", "host_name"},
+	},
+	"CreateHostDowntime": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"comment": {
+				Type: "string",
+			},
+			"downtime_type": {
+				Type: "string",
+				Enum: []string{"host", "hostgroup", "host_by_query"},
+			},
+			"duration": {
+				Type: "integer",
+			},
+			"end_time": {
+				Type: "string",
+				Format: "iso8601",
+			},
+			"host_name": {
+				Type: "string",
+				Pattern: "^[-0-9a-zA-Z_.]+\\Z",
+			},
+			"recur": {
+				Type: "string",
+				Enum: []string{"fixed", "hour", "day", "week", "second_week", "fourth_week", "weekday_start", "weekday_end", "day_of_month"},
+			},
+			"start_time": {
+				Type: "string",
+				Format: "iso8601",
+			},
+		},
+		Required: []string{"downtime_type", "end_time", "host_name", "start_time"},
+	},
+	"CreateHostGroupDowntime": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"comment": {
+				Type: "string",
+			},
+			"downtime_type": {
+				Type: "string",
+				Enum: []string{"host", "hostgroup", "host_by_query"},
+			},
+			"duration": {
+				Type: "integer",
+			},
+			"end_time": {
+				Type: "string",
+				Format: "iso8601",
+			},
+			"hostgroup_name": {
+				Type: "string",
+			},
+			"recur": {
+				Type: "string",
+				Enum: []string{"fixed", "hour", "day", "week", "second_week", "fourth_week", "weekday_start", "weekday_end", "day_of_month"},
+			},
+			"start_time": {
+				Type: "string",
+				Format: "iso8601",
+			},
+		},
+		Required: []string{"downtime_type", "end_time", "hostgroup_name", "start_time"},
+	},
+	"CreateHostQueryComment": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"comment": {
+				Type: "string",
+			},
+			"comment_type": {
+				Type: "string",
+				Enum: []string{"host", "host_by_query"},
+			},
+			"persistent": {
+				Type: "boolean",
+			},
+			"query": {Ref: "Expr"},
+		},
+		Required: []string{"comment", "comment_type"},
+	},
+	"CreateHostQueryDowntime": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"comment": {
+				Type: "string",
+			},
+			"downtime_type": {
+				Type: "string",
+				Enum: []string{"host", "hostgroup", "host_by_query"},
+			},
+			"duration": {
+				Type: "integer",
+			},
+			"end_time": {
+				Type: "string",
+				Format: "iso8601",
+			},
+			"query": {Ref: "Expr"},
+			"recur": {
+				Type: "string",
+				Enum: []string{"fixed", "hour", "day", "week", "second_week", "fourth_week", "weekday_start", "weekday_end", "day_of_month"},
+			},
+			"start_time": {
+				Type: "string",
+				Format: "iso8601",
+			},
+		},
+		Required: []string{"downtime_type", "end_time", "query", "start_time"},
+	},
+	"CreateHostRelatedComment": {
+		Type: "object",
+		OneOf: []*openapi.Schema{
+			{Ref: "CreateHostComment"},
+			{Ref: "CreateHostQueryComment"},
+		},
+		Discriminator: "comment_type",
+		Mapping: map[string]string{
+			"host": "CreateHostComment",
+			"host_by_query": "CreateHostQueryComment",
+		},
+	},
+	"CreateHostRelatedDowntime": {
+		Type: "object",
+		OneOf: []*openapi.Schema{
+			{Ref: "CreateHostDowntime"},
+			{Ref: "CreateHostGroupDowntime"},
+			{Ref: "CreateHostQueryDowntime"},
+		},
+		Discriminator: "downtime_type",
+		Mapping: map[string]string{
+			"host": "CreateHostDowntime",
+			"host_by_query": "CreateHostQueryDowntime",
+			"hostgroup": "CreateHostGroupDowntime",
+		},
+	},
+	"CreateServiceComment": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"comment": {
+				Type: "string",
+			},
+			"comment_type": {
+				Type: "string",
+				Enum: []string{"service", "service_by_query"},
+			},
+			"host_name": {
+				Type: "string",
+				Pattern: "^[-0-9a-zA-Z_.]+\\Z",
+			},
+			"persistent": {
+				Type: "boolean",
+			},
+			"service_description": {
+				Type: "string",
+			},
+		},
+		Required: []string{"comment", "comment_type", "host_name", "service_description"},
+	},
+	"CreateServiceDowntime": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"comment": {
+				Type: "string",
+			},
+			"downtime_type": {
+				Type: "string",
+				Enum: []string{"service", "servicegroup", "service_by_query"},
+			},
+			"duration": {
+				Type: "integer",
+			},
+			"end_time": {
+				Type: "string",
+				Format: "iso8601",
+			},
+			"host_name": {
+				Type: "string",
+				Pattern: "^[-0-9a-zA-Z_.]+\\Z",
+			},
+			"recur": {
+				Type: "string",
+				Enum: []string{"fixed", "hour", "day", "week", "second_week", "fourth_week", "weekday_start", "weekday_end", "day_of_month"},
+			},
+			"service_descriptions": {
+				Type: "array",
+				Items: &openapi.Schema{
+					Type: "string",
+				},
+			},
+			"start_time": {
+				Type: "string",
+				Format: "iso8601",
+			},
+		},
+		Required: []string{"downtime_type", "end_time", "host_name", "service_descriptions", "start_time"},
+	},
+	"CreateServiceGroupDowntime": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"comment": {
+				Type: "string",
+			},
+			"downtime_type": {
+				Type: "string",
+				Enum: []string{"service", "servicegroup", "service_by_query"},
+			},
+			"duration": {
+				Type: "integer",
+			},
+			"end_time": {
+				Type: "string",
+				Format: "iso8601",
+			},
+			"recur": {
+				Type: "string",
+				Enum: []string{"fixed", "hour", "day", "week", "second_week", "fourth_week", "weekday_start", "weekday_end", "day_of_month"},
+			},
+			"servicegroup_name": {
+				Type: "string",
+			},
+			"start_time": {
+				Type: "string",
+				Format: "iso8601",
+			},
+		},
+		Required: []string{"downtime_type", "end_time", "servicegroup_name", "start_time"},
+	},
+	"CreateServiceQueryComment": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"comment": {
+				Type: "string",
+			},
+			"comment_type": {
+				Type: "string",
+				Enum: []string{"service", "service_by_query"},
+			},
+			"persistent": {
+				Type: "boolean",
+			},
+			"query": {Ref: "Expr"},
+		},
+		Required: []string{"comment", "comment_type", "query"},
+	},
+	"CreateServiceQueryDowntime": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"comment": {
+				Type: "string",
+			},
+			"downtime_type": {
+				Type: "string",
+				Enum: []string{"service", "servicegroup", "service_by_query"},
+			},
+			"duration": {
+				Type: "integer",
+			},
+			"end_time": {
+				Type: "string",
+				Format: "iso8601",
+			},
+			"query": {Ref: "Expr"},
+			"recur": {
+				Type: "string",
+				Enum: []string{"fixed", "hour", "day", "week", "second_week", "fourth_week", "weekday_start", "weekday_end", "day_of_month"},
+			},
+			"start_time": {
+				Type: "string",
+				Format: "iso8601",
+			},
+		},
+		Required: []string{"downtime_type", "end_time", "query", "start_time"},
+	},
+	"CreateServiceRelatedComment": {
+		Type: "object",
+		OneOf: []*openapi.Schema{
+			{Ref: "CreateServiceComment"},
+			{Ref: "CreateServiceQueryComment"},
+		},
+		Discriminator: "comment_type",
+		Mapping: map[string]string{
+			"service": "CreateServiceComment",
+			"service_by_query": "CreateServiceQueryComment",
+		},
+	},
+	"CreateServiceRelatedDowntime": {
+		Type: "object",
+		OneOf: []*openapi.Schema{
+			{Ref: "CreateServiceDowntime"},
+			{Ref: "CreateServiceGroupDowntime"},
+			{Ref: "CreateServiceQueryDowntime"},
+		},
+		Discriminator: "downtime_type",
+		Mapping: map[string]string{
+			"service": "CreateServiceDowntime",
+			"service_by_query": "CreateServiceQueryDowntime",
+			"servicegroup": "CreateServiceGroupDowntime",
+		},
+	},
+	"CreateTimePeriod": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"active_time_ranges": {
+				Type: "array",
+				Items: &openapi.Schema{Ref: "TimeRangeActive"},
+			},
+			"alias": {
+				Type: "string",
+			},
+			"exceptions": {
+				Type: "array",
+				Items: &openapi.Schema{Ref: "TimePeriodException"},
+			},
+			"exclude": {
+				Type: "array",
+				Items: &openapi.Schema{
+					Type: "string",
+					Pattern: "^[-a-z0-9A-Z_]+\\Z",
+				},
+			},
+			"name": {
+				Type: "string",
+				Pattern: "^[-a-z0-9A-Z_]+\\Z",
+			},
+		},
+		Required: []string{"active_time_ranges", "alias", "name"},
+	},
+	"CreateUser": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"auth_option": {Ref: "AuthOption"},
+			"authorized_sites": {
+				Type: "array",
+				Items: &openapi.Schema{
+					Type: "string",
+				},
+			},
+			"contact_options": {Ref: "UserContactOption"},
+			"contactgroups": {
+				Type: "array",
+				Items: &openapi.Schema{
+					Type: "string",
+				},
+			},
+			"disable_login": {
+				Type: "boolean",
+			},
+			"disable_notifications": {Ref: "DisabledNotifications"},
+			"fullname": {
+				Type: "string",
+			},
+			"idle_timeout": {Ref: "IdleOption"},
+			"interface_options": {Ref: "UserInterfaceAttributes"},
+			"language": {
+				Type: "string",
+				Enum: []string{"de", "en", "ro"},
+			},
+			"pager_address": {
+				Type: "string",
+			},
+			"roles": {
+				Type: "array",
+				Items: &openapi.Schema{
+					Type: "string",
+				},
+			},
+			"temperature_unit": {
+				Type: "string",
+				Enum: []string{"default", "celsius", "fahrenheit"},
+			},
+			"username": {
+				Type: "string",
+			},
+		},
+		Required: []string{"fullname", "username"},
+		AdditionalProperties: &openapi.Schema{},
+	},
+	"CreateUserRole": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"new_alias": {
+				Type: "string",
+			},
+			"new_role_id": {
+				Type: "string",
+			},
+			"role_id": {
+				Type: "string",
+			},
+		},
+		Required: []string{"role_id"},
+	},
+	"CustomHostAttributes": {
+		Type: "object",
+		AdditionalProperties: &openapi.Schema{
+			Type: "string",
+		},
+	},
+	"CustomMacro": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"macro_name": {
+				Type: "string",
+			},
+			"match_regex": {
+				Type: "string",
+			},
+		},
+		Required: []string{"macro_name", "match_regex"},
+	},
+	"CustomTimeRange": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"end_time": {
+				Type: "string",
+				Format: "iso8601",
+			},
+			"start_time": {
+				Type: "string",
+				Format: "iso8601",
+			},
+		},
+		Required: []string{"end_time", "start_time"},
+	},
+	"CustomUserAttributes": {
+		Type: "object",
+		AdditionalProperties: &openapi.Schema{
+			Type: "string",
+		},
+	},
+	"DateTimeRange": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"end_time": {
+				Type: "string",
+				Format: "iso8601",
+			},
+			"start_time": {
+				Type: "string",
+				Format: "date-time",
+			},
+		},
+		Required: []string{"start_time"},
+	},
+	"DeleteCommentById": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"comment_id": {
+				Type: "integer",
+			},
+			"delete_type": {
+				Type: "string",
+				Enum: []string{"by_id", "query", "params"},
+			},
+			"site_id": {
+				Type: "string",
+			},
+		},
+		Required: []string{"delete_type", "site_id"},
+	},
+	"DeleteComments": {
+		Type: "object",
+		OneOf: []*openapi.Schema{
+			{Ref: "DeleteCommentById"},
+			{Ref: "DeleteCommentsByQuery"},
+			{Ref: "DeleteCommentsByParams"},
+		},
+		Discriminator: "delete_type",
+		Mapping: map[string]string{
+			"by_id": "DeleteCommentById",
+			"params": "DeleteCommentsByParams",
+			"query": "DeleteCommentsByQuery",
+		},
+	},
+	"DeleteCommentsByParams": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"delete_type": {
+				Type: "string",
+				Enum: []string{"by_id", "query", "params"},
+			},
+			"host_name": {
+				Type: "string",
+				Pattern: "^[-0-9a-zA-Z_.]+\\Z",
+			},
+			"service_descriptions": {
+				Type: "array",
+				Items: &openapi.Schema{
+					Type: "string",
+				},
+			},
+			"site_id": {
+				Type: "string",
+			},
+		},
+		Required: []string{"delete_type", "host_name", "site_id"},
+	},
+	"DeleteCommentsByQuery": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"delete_type": {
+				Type: "string",
+				Enum: []string{"by_id", "query", "params"},
+			},
+			"query": {Ref: "Expr"},
+			"site_id": {
+				Type: "string",
+			},
+		},
+		Required: []string{"delete_type", "site_id"},
+	},
+	"DeleteDowntime": {
+		Type: "object",
+		OneOf: []*openapi.Schema{
+			{Ref: "DeleteDowntimeById"},
+			{Ref: "DeleteDowntimeByName"},
+			{Ref: "DeleteDowntimeByQuery"},
+		},
+		Discriminator: "delete_type",
+		Mapping: map[string]string{
+			"by_id": "DeleteDowntimeById",
+			"params": "DeleteDowntimeByName",
+			"query": "DeleteDowntimeByQuery",
+		},
+	},
+	"DeleteDowntimeById": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"delete_type": {
+				Type: "string",
+				Enum: []string{"params", "query", "by_id"},
+			},
+			"downtime_id": {
+				Type: "string",
+			},
+			"site_id": {
+				Type: "string",
+			},
+		},
+		Required: []string{"delete_type", "downtime_id", "site_id"},
+	},
+	"DeleteDowntimeByName": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"delete_type": {
+				Type: "string",
+				Enum: []string{"params", "query", "by_id"},
+			},
+			"host_name": {
+				Type: "string",
+				Pattern: "^[-0-9a-zA-Z_.]+\\Z",
+			},
+			"service_descriptions": {
+				Type: "array",
+				Items: &openapi.Schema{
+					Type: "string",
+				},
+			},
+			"site_id": {
+				Type: "string",
+			},
+		},
+		Required: []string{"delete_type", "host_name", "site_id"},
+	},
+	"DeleteDowntimeByQuery": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"delete_type": {
+				Type: "string",
+				Enum: []string{"params", "query", "by_id"},
+			},
+			"query": {Ref: "Expr"},
+			"site_id": {
+				Type: "string",
+			},
+		},
+		Required: []string{"delete_type", "query", "site_id"},
+	},
+	"DeleteECEvents": {
+		Type: "object",
+		OneOf: []*openapi.Schema{
+			{Ref: "FilterById"},
+			{Ref: "FilterByQuery"},
+			{Ref: "FilterByParams"},
+		},
+		Discriminator: "filter_type",
+		Mapping: map[string]string{
+			"by_id": "FilterById",
+			"params": "FilterByParams",
+			"query": "FilterByQuery",
+		},
+	},
+	"DirectMapping": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"hostname": {
+				Type: "string",
+			},
+			"replace_with": {
+				Type: "string",
+			},
+		},
+		Required: []string{"hostname", "replace_with"},
+	},
+	"DisabledNotifications": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"disable": {
+				Type: "boolean",
+			},
+			"timerange": {Ref: "CustomTimeRange"},
+		},
+	},
+	"DiscoverServices": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"host_name": {
+				Type: "string",
+				Pattern: "^[-0-9a-zA-Z_.]+\\Z",
+			},
+			"mode": {
+				Type: "string",
+				Enum: []string{"new", "remove", "fix_all", "refresh", "only_host_labels", "tabula_rasa"},
+			},
+		},
+		Required: []string{"host_name"},
+	},
+	"DiscoverServicesDeprecated": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"mode": {
+				Type: "string",
+				Enum: []string{"new", "remove", "fix_all", "refresh", "only_host_labels", "tabula_rasa"},
+			},
+		},
+	},
+	"DiscoveryBackgroundJobStatusObject": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"domainType": {
+			},
+			"extensions": {Ref: "BackgroundJobStatus"},
+			"id": {
+				Type: "string",
+			},
+			"links": {
+				Type: "array",
+				Items: &openapi.Schema{Ref: "Link"},
+			},
+			"members": {
+				Type: "object",
+				AdditionalProperties: &openapi.Schema{},
+			},
+			"title": {
+				Type: "string",
+			},
+		},
+		Required: []string{"links"},
+	},
+	"DomainObject": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"domainType": {
+				Type: "string",
+			},
+			"extensions": {
+				Type: "object",
+				AdditionalProperties: &openapi.Schema{},
+			},
+			"id": {
+				Type: "string",
+			},
+			"links": {
+				Type: "array",
+				Items: &openapi.Schema{Ref: "Link"},
+			},
+			"members": {
+				Type: "object",
+				AdditionalProperties: &openapi.Schema{},
+			},
+			"title": {
+				Type: "string",
+			},
+		},
+		Required: []string{"domainType", "links"},
+	},
+	"DomainObjectCollection": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"domainType": {
+				Type: "string",
+			},
+			"extensions": {
+				Type: "object",
+				AdditionalProperties: &openapi.Schema{},
+			},
+			"id": {
+				Type: "string",
+			},
+			"links": {
+				Type: "array",
+				Items: &openapi.Schema{Ref: "Link"},
+			},
+			"title": {
+				Type: "string",
+			},
+			"value": {
+				Type: "array",
+				Items: &openapi.Schema{Ref: "CollectionItem"},
+			},
+		},
+		Required: []string{"links"},
+	},
+	"DowntimeAttributes": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"author": {
+				Type: "string",
+			},
+			"comment": {
+				Type: "string",
+			},
+			"end_time": {
+				Type: "string",
+				Format: "date-time",
+			},
+			"host_name": {
+				Type: "string",
+				Pattern: "^[-0-9a-zA-Z_.]+\\Z",
+			},
+			"is_service": {
+				Type: "string",
+			},
+			"recurring": {
+				Type: "string",
+			},
+			"site_id": {
+				Type: "string",
+			},
+			"start_time": {
+				Type: "string",
+				Format: "date-time",
+			},
+		},
+		Required: []string{"author", "comment", "end_time", "host_name", "is_service", "recurring", "site_id", "start_time"},
+	},
+	"DowntimeCollection": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"domainType": {
+			},
+			"extensions": {
+				Type: "object",
+				AdditionalProperties: &openapi.Schema{},
+			},
+			"id": {
+				Type: "string",
+			},
+			"links": {
+				Type: "array",
+				Items: &openapi.Schema{Ref: "Link"},
+			},
+			"title": {
+				Type: "string",
+			},
+			"value": {
+				Type: "array",
+				Items: &openapi.Schema{Ref: "DowntimeObject"},
+			},
+		},
+		Required: []string{"links"},
+	},
+	"DowntimeObject": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"domainType": {
+			},
+			"extensions": {Ref: "DowntimeAttributes"},
+			"id": {
+				Type: "string",
+			},
+			"links": {
+				Type: "array",
+				Items: &openapi.Schema{Ref: "Link"},
+			},
+			"members": {
+				Type: "object",
+				AdditionalProperties: &openapi.Schema{},
+			},
+			"title": {
+				Type: "string",
+			},
+		},
+		Required: []string{"links"},
+	},
+	"ECEventAttributes": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"application": {
+				Type: "string",
+			},
+			"comment": {
+				Type: "string",
+			},
+			"contact": {
+				Type: "string",
+			},
+			"count": {
+				Type: "integer",
+			},
+			"facility": {
+				Type: "string",
+				Enum: []string{"kern", "user", "mail", "daemon", "auth", "syslog", "lpr", "news", "uucp", "cron", "authpriv", "ftp", "ntp", "logaudit", "logalert", "clock", "local0", "local1", "local2", "local3", "local4", "local5", "local6", "local7", "logfile", "snmptrap"},
+			},
+			"first": {
+				Type: "string",
+				Format: "date-time",
+			},
+			"host": {
+				Type: "string",
+				Pattern: "^[-0-9a-zA-Z_.]+\\Z",
+			},
+			"ipaddress": {
+				Type: "string",
+			},
+			"last": {
+				Type: "string",
+				Format: "date-time",
+			},
+			"phase": {
+				Type: "string",
+				Enum: []string{"open", "ack"},
+			},
+			"priority": {
+				Type: "string",
+				Enum: []string{"emerg", "alert", "crit", "err", "warning", "notice", "info", "debug"},
+			},
+			"rule_id": {
+				Type: "string",
+			},
+			"service_level": {
+				Type: "string",
+				Enum: []string{"no_service_level", "silver", "gold", "platinum"},
+			},
+			"site_id": {
+				Type: "string",
+			},
+			"state": {
+				Type: "string",
+				Enum: []string{"ok", "warning", "critical", "unknown"},
+			},
+			"text": {
+				Type: "string",
+			},
+		},
+		Required: []string{"application", "comment", "contact", "count", "facility", "first", "host", "ipaddress", "last", "phase", "priority", "rule_id", "service_level", "site_id", "state", "text"},
+	},
+	"ECEventResponse": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"domainType": {
+			},
+			"extensions": {Ref: "ECEventAttributes"},
+			"id": {
+				Type: "string",
+			},
+			"links": {
+				Type: "array",
+				Items: &openapi.Schema{Ref: "Link"},
+			},
+			"members": {
+				Type: "object",
+				AdditionalProperties: &openapi.Schema{},
+			},
+			"title": {
+				Type: "string",
+			},
+		},
+		Required: []string{"links"},
+	},
+	"EditUserRole": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"new_alias": {
+				Type: "string",
+			},
+			"new_basedon": {
+				Type: "string",
+			},
+			"new_permissions": {
+				Type: "object",
+				AdditionalProperties: &openapi.Schema{
+					Type: "string",
+					Enum: []string{"yes", "no", "default"},
+				},
+			},
+			"new_role_id": {
+				Type: "string",
+			},
+		},
+	},
+	"EmailAndDisplayName": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"address": {
+				Type: "string",
+			},
+			"display_name": {
+				Type: "string",
+			},
+		},
+	},
+	"EventConsoleAlertAttrsResponse": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"match_event_comment": {Ref: "CheckboxWithStr"},
+			"match_rule_ids": {Ref: "CheckboxWithListOfStr"},
+			"match_syslog_facility": {Ref: "CheckboxWithStr"},
+			"match_syslog_priority": {Ref: "CheckboxWithSysLogPriority"},
+		},
+	},
+	"EventConsoleAlertsResponse": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"match_type": {
+				Type: "string",
+				Enum: []string{"match_only_event_console_alerts", "do_not_match_event_console_alerts"},
+			},
+			"state": {
+				Type: "string",
+				Enum: []string{"enabled", "disabled"},
+			},
+			"values": {Ref: "EventConsoleAlertAttrsResponse"},
+		},
+		Required: []string{"match_type", "state"},
+	},
+	"EventConsoleResponseCollection": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"domainType": {
+			},
+			"extensions": {
+				Type: "object",
+				AdditionalProperties: &openapi.Schema{},
+			},
+			"id": {
+				Type: "string",
+			},
+			"links": {
+				Type: "array",
+				Items: &openapi.Schema{Ref: "Link"},
+			},
+			"title": {
+				Type: "string",
+			},
+			"value": {
+				Type: "array",
+				Items: &openapi.Schema{Ref: "ECEventResponse"},
+			},
+		},
+		Required: []string{"links"},
+	},
+	"Expr": {
+		Type: "object",
+		OneOf: []*openapi.Schema{
+			{Ref: "LogicalExpr"},
+			{Ref: "LogicalExpr"},
+			{Ref: "NotExpr"},
+			{Ref: "BinaryExpr"},
+			{Ref: "BinaryExpr"},
+			{Ref: "BinaryExpr"},
+			{Ref: "BinaryExpr"},
+			{Ref: "BinaryExpr"},
+			{Ref: "BinaryExpr"},
+			{Ref: "BinaryExpr"},
+			{Ref: "BinaryExpr"},
+			{Ref: "BinaryExpr"},
+			{Ref: "BinaryExpr"},
+			{Ref: "BinaryExpr"},
+			{Ref: "BinaryExpr"},
+			{Ref: "BinaryExpr"},
+			{Ref: "BinaryExpr"},
+		},
+		Discriminator: "op",
+		Mapping: map[string]string{
+			"!<": "BinaryExpr",
+			"!<=": "BinaryExpr",
+			"!=": "BinaryExpr",
+			"!>": "BinaryExpr",
+			"!>=": "BinaryExpr",
+			"!~": "BinaryExpr",
+			"!~~": "BinaryExpr",
+			"<": "BinaryExpr",
+			"<=": "BinaryExpr",
+			"=": "BinaryExpr",
+			">": "BinaryExpr",
+			">=": "BinaryExpr",
+			"and": "LogicalExpr",
+			"not": "NotExpr",
+			"or": "LogicalExpr",
+			"~": "BinaryExpr",
+			"~~": "BinaryExpr",
+		},
+	},
+	"FailedHosts": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"failed_hosts": {
+				Type: "object",
+				AdditionalProperties: &openapi.Schema{
+					Type: "string",
+				},
+			},
+			"succeeded_hosts": {Ref: "HostConfigCollection"},
+		},
+	},
+	"FilterById": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"event_id": {
+				Type: "integer",
+			},
+			"filter_type": {
+				Type: "string",
+				Enum: []string{"by_id", "query", "params"},
+			},
+			"site_id": {
+				Type: "string",
+			},
+		},
+		Required: []string{"event_id", "filter_type", "site_id"},
+	},
+	"FilterByParams": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"filter_type": {
+				Type: "string",
+				Enum: []string{"by_id", "query", "params"},
+			},
+			"filters": {Ref: "FilterParams"},
+			"site_id": {
+				Type: "string",
+			},
+		},
+		Required: []string{"filter_type", "filters", "site_id"},
+	},
+	"FilterByQuery": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"filter_type": {
+				Type: "string",
+				Enum: []string{"by_id", "query", "params"},
+			},
+			"query": {Ref: "Expr"},
+			"site_id": {
+				Type: "string",
+			},
+		},
+		Required: []string{"filter_type", "query", "site_id"},
+	},
+	"FilterParams": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"application": {
+				Type: "string",
+			},
+			"host": {
+				Type: "string",
+				Pattern: "^[-0-9a-zA-Z_.]+\\Z",
+			},
+			"phase": {
+				Type: "string",
+				Enum: []string{"open", "ack"},
+			},
+			"state": {
+				Type: "string",
+				Enum: []string{"ok", "warning", "critical", "unknown"},
+			},
+		},
+	},
+	"FilterParamsUpdateAndAcknowledge": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"application": {
+				Type: "string",
+			},
+			"host": {
+				Type: "string",
+				Pattern: "^[-0-9a-zA-Z_.]+\\Z",
+			},
+			"state": {
+				Type: "string",
+				Enum: []string{"ok", "warning", "critical", "unknown"},
+			},
+		},
+	},
+	"Folder": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"domainType": {
+			},
+			"extensions": {Ref: "FolderExtensions"},
+			"id": {
+				Type: "string",
+			},
+			"links": {
+				Type: "array",
+				Items: &openapi.Schema{Ref: "Link"},
+			},
+			"members": {Ref: "FolderMembers"},
+			"title": {
+				Type: "string",
+			},
+		},
+		Required: []string{"links"},
+	},
+	"FolderCollection": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"domainType": {
+			},
+			"extensions": {
+				Type: "object",
+				AdditionalProperties: &openapi.Schema{},
+			},
+			"id": {
+				Type: "string",
+			},
+			"links": {
+				Type: "array",
+				Items: &openapi.Schema{Ref: "Link"},
+			},
+			"title": {
+				Type: "string",
+			},
+			"value": {
+				Type: "array",
+				Items: &openapi.Schema{Ref: "Folder"},
+			},
+		},
+		Required: []string{"links"},
+	},
+	"FolderCreateAttribute": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"contactgroups": {Ref: "HostContactGroup"},
+			"labels": {
+				Type: "object",
+				AdditionalProperties: &openapi.Schema{
+					Type: "string",
+				},
+			},
+			"management_ipmi_credentials": {Ref: "IPMIParameters"},
+			"management_protocol": {
+				Type: "string",
+				Enum: []string{"none", "snmp", "ipmi"},
+			},
+			"management_snmp_community": {Ref: "SNMPCredentials", Nullable: true},
+			"network_scan": {Ref: "NetworkScan"},
+			"parents": {
+				Type: "array",
+				Items: &openapi.Schema{
+					Type: "string",
+					Pattern: "^[-0-9a-zA-Z_.]+\\Z",
+				},
+			},
+			"site": {
+				Type: "string",
+			},
+			"snmp_community": {Ref: "SNMPCredentials"},
+			"tag_address_family": {
+				Type: "string",
+				Enum: []string{"ip-v4-only", "ip-v6-only", "ip-v4v6", "no-ip"},
+			},
+			"tag_agent": {
+				Type: "string",
+				Enum: []string{"cmk-agent", "all-agents", "special-agents", "no-agent"},
+			},
+			"tag_criticality": {
+				Type: "string",
+				Enum: []string{"prod", "critical", "test", "offline"},
+			},
+			"tag_networking": {
+				Type: "string",
+				Enum: []string{"lan", "wan", "dmz"},
+			},
+			"tag_piggyback": {
+				Type: "string",
+				Enum: []string{"auto-piggyback", "piggyback", "no-piggyback"},
+			},
+			"tag_snmp_ds": {
+				Type: "string",
+				Enum: []string{"no-snmp", "snmp-v2", "snmp-v1"},
+			},
+		},
+	},
+	"FolderExtensions": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"attributes": {
+				AnyOf: []*openapi.Schema{
+					{Ref: "FolderViewAttribute"},
+					{Ref: "CustomHostAttributes"},
+					{Ref: "TagGroupAttributes"},
+				},
+			},
+			"path": {
+				Type: "string",
+			},
+		},
+	},
+	"FolderMembers": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"hosts": {Ref: "ObjectCollectionMember"},
+			"move": {Ref: "ObjectActionMember"},
+		},
+	},
+	"FolderUpdateAttribute": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"contactgroups": {Ref: "HostContactGroup"},
+			"labels": {
+				Type: "object",
+				AdditionalProperties: &openapi.Schema{
+					Type: "string",
+				},
+			},
+			"management_ipmi_credentials": {Ref: "IPMIParameters"},
+			"management_protocol": {
+				Type: "string",
+				Enum: []string{"none", "snmp", "ipmi"},
+			},
+			"management_snmp_community": {Ref: "SNMPCredentials", Nullable: true},
+			"network_scan": {Ref: "NetworkScan"},
+			"parents": {
+				Type: "array",
+				Items: &openapi.Schema{
+					Type: "string",
+					Pattern: "^[-0-9a-zA-Z_.]+\\Z",
+				},
+			},
+			"site": {
+				Type: "string",
+			},
+			"snmp_community": {Ref: "SNMPCredentials"},
+			"tag_address_family": {
+				Type: "string",
+				Enum: []string{"ip-v4-only", "ip-v6-only", "ip-v4v6", "no-ip"},
+			},
+			"tag_agent": {
+				Type: "string",
+				Enum: []string{"cmk-agent", "all-agents", "special-agents", "no-agent"},
+			},
+			"tag_criticality": {
+				Type: "string",
+				Enum: []string{"prod", "critical", "test", "offline"},
+			},
+			"tag_networking": {
+				Type: "string",
+				Enum: []string{"lan", "wan", "dmz"},
+			},
+			"tag_piggyback": {
+				Type: "string",
+				Enum: []string{"auto-piggyback", "piggyback", "no-piggyback"},
+			},
+			"tag_snmp_ds": {
+				Type: "string",
+				Enum: []string{"no-snmp", "snmp-v2", "snmp-v1"},
+			},
+		},
+	},
+	"FolderViewAttribute": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"contactgroups": {Ref: "HostContactGroup"},
+			"labels": {
+				Type: "object",
+				AdditionalProperties: &openapi.Schema{
+					Type: "string",
+				},
+			},
+			"management_ipmi_credentials": {Ref: "IPMIParameters"},
+			"management_protocol": {
+				Type: "string",
+				Enum: []string{"none", "snmp", "ipmi"},
+			},
+			"management_snmp_community": {Ref: "SNMPCredentials", Nullable: true},
+			"meta_data": {Ref: "MetaData"},
+			"network_scan": {Ref: "NetworkScan"},
+			"network_scan_result": {Ref: "NetworkScanResult"},
+			"parents": {
+				Type: "array",
+				Items: &openapi.Schema{
+					Type: "string",
+					Pattern: "^[-0-9a-zA-Z_.]+\\Z",
+				},
+			},
+			"site": {
+				Type: "string",
+			},
+			"snmp_community": {Ref: "SNMPCredentials"},
+			"tag_address_family": {
+				Type: "string",
+			},
+			"tag_agent": {
+				Type: "string",
+			},
+			"tag_criticality": {
+				Type: "string",
+			},
+			"tag_networking": {
+				Type: "string",
+			},
+			"tag_piggyback": {
+				Type: "string",
+			},
+			"tag_snmp_ds": {
+				Type: "string",
+			},
+		},
+	},
+	"FromEmailAndNameCheckbox": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"state": {
+				Type: "string",
+				Enum: []string{"enabled", "disabled"},
+			},
+			"value": {Ref: "EmailAndDisplayName"},
+		},
+		Required: []string{"state"},
+	},
+	"FromToNotificationNumbers": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"beginning_from": {
+				Type: "integer",
+			},
+			"up_to": {
+				Type: "integer",
+			},
+		},
+		Required: []string{"beginning_from", "up_to"},
+	},
+	"FromToServiceLevels": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"from_level": {
+				Type: "string",
+				Enum: []string{"no_service_level", "silver", "gold", "platinum"},
+			},
+			"to_level": {
+				Type: "string",
+				Enum: []string{"no_service_level", "silver", "gold", "platinum"},
+			},
+		},
+		Required: []string{"from_level", "to_level"},
+	},
+	"Get": {
+		Type: "object",
+		OneOf: []*openapi.Schema{
+			{Ref: "GetGraph"},
+			{Ref: "GetMetric"},
+		},
+		Discriminator: "type",
+		Mapping: map[string]string{
+			"predefined_graph": "GetGraph",
+			"single_metric": "GetMetric",
+		},
+	},
+	"GetGraph": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"graph_id": {
+				Type: "string",
+				Pattern: "^\\w[_\\-\\w\\d]*$",
+			},
+			"host_name": {
+				Type: "string",
+				Pattern: "^[-0-9a-zA-Z_.]+\\Z",
+			},
+			"reduce": {
+				Type: "string",
+				Enum: []string{"min", "max", "average"},
+			},
+			"service_description": {
+				Type: "string",
+			},
+			"site": {
+				Type: "string",
+			},
+			"time_range": {Ref: "TimeRange"},
+			"type": {
+				Type: "string",
+				Enum: []string{"predefined_graph", "single_metric"},
+			},
+		},
+		Required: []string{"graph_id", "host_name", "service_description", "time_range", "type"},
+	},
+	"GetMetric": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"host_name": {
+				Type: "string",
+				Pattern: "^[-0-9a-zA-Z_.]+\\Z",
+			},
+			"metric_id": {
+				Type: "string",
+				Pattern: "^\\w[_\\-\\w\\d]*$",
+			},
+			"reduce": {
+				Type: "string",
+				Enum: []string{"min", "max", "average"},
+			},
+			"service_description": {
+				Type: "string",
+			},
+			"site": {
+				Type: "string",
+			},
+			"time_range": {Ref: "TimeRange"},
+			"type": {
+				Type: "string",
+				Enum: []string{"predefined_graph", "single_metric"},
+			},
+		},
+		Required: []string{"host_name", "metric_id", "service_description", "time_range", "type"},
+	},
+	"GraphCollection": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"metrics": {
+				Type: "array",
+				Items: &openapi.Schema{Ref: "Metric"},
+			},
+			"step": {
+				Type: "integer",
+			},
+			"time_range": {Ref: "TimeRange"},
+		},
+		Required: []string{"metrics", "step", "time_range"},
+	},
+	"HTMLMailPluginCreate": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"bulk_notifications_with_graphs": {Ref: "CheckboxOneOf"},
+			"display_graphs_among_each_other": {Ref: "Checkbox"},
+			"enable_sync_smtp": {Ref: "CheckboxOneOf"},
+			"from_details": {Ref: "CheckboxOneOf"},
+			"graphs_per_notification": {Ref: "CheckboxOneOf"},
+			"info_to_be_displayed_in_the_email_body": {Ref: "CheckboxOneOf"},
+			"insert_html_section_between_body_and_table": {Ref: "CheckboxOneOf"},
+			"plugin_name": {
+				Type: "string",
+				Enum: []string{"asciimail", "cisco_webex_teams", "mkeventd", "mail", "ilert", "jira_issues", "opsgenie_issues", "pagerduty", "pushover", "servicenow", "signl4", "slack", "sms_api", "sms", "spectrum", "victorops", "msteams"},
+			},
+			"reply_to": {Ref: "CheckboxOneOf"},
+			"send_separate_notification_to_every_recipient": {Ref: "Checkbox"},
+			"sort_order_for_bulk_notificaions": {Ref: "CheckboxOneOf"},
+			"subject_for_host_notifications": {Ref: "CheckboxOneOf"},
+			"subject_for_service_notifications": {Ref: "CheckboxOneOf"},
+			"url_prefix_for_links_to_checkmk": {Ref: "CheckboxOneOf"},
+		},
+		Required: []string{"bulk_notifications_with_graphs", "display_graphs_among_each_other", "enable_sync_smtp", "from_details", "graphs_per_notification", "info_to_be_displayed_in_the_email_body", "insert_html_section_between_body_and_table", "plugin_name", "reply_to", "send_separate_notification_to_every_recipient", "sort_order_for_bulk_notificaions", "subject_for_host_notifications", "subject_for_service_notifications", "url_prefix_for_links_to_checkmk"},
+	},
+	"Heartbeat": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"interval": {
+				Type: "integer",
+				Minimum: openapi.Float(1),
+			},
+			"timeout": {
+				Type: "number",
+			},
+		},
+	},
+	"Heartbeat1": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"interval": {
+				Type: "integer",
+			},
+			"timeout": {
+				Type: "number",
+			},
+		},
+	},
+	"Host": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"type": {
+			},
+		},
+		Required: []string{"type"},
+	},
+	"HostConditions": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"host_choice": {Ref: "BIHostChoice"},
+			"host_folder": {
+				Type: "string",
+			},
+			"host_labels": {
+				Type: "object",
+				AdditionalProperties: &openapi.Schema{},
+			},
+			"host_tags": {
+				Type: "object",
+				AdditionalProperties: &openapi.Schema{},
+			},
+		},
+		Required: []string{"host_choice", "host_folder", "host_labels", "host_tags"},
+	},
+	"HostConfig": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"domainType": {
+			},
+			"extensions": {Ref: "HostExtensions"},
+			"id": {
+				Type: "string",
+			},
+			"links": {
+				Type: "array",
+				Items: &openapi.Schema{Ref: "Link"},
+			},
+			"members": {Ref: "HostMembers"},
+			"title": {
+				Type: "string",
+			},
+		},
+		Required: []string{"domainType", "links"},
+	},
+	"HostConfigCollection": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"domainType": {
+			},
+			"extensions": {
+				Type: "object",
+				AdditionalProperties: &openapi.Schema{},
+			},
+			"id": {
+				Type: "string",
+			},
+			"links": {
+				Type: "array",
+				Items: &openapi.Schema{Ref: "Link"},
+			},
+			"title": {
+				Type: "string",
+			},
+			"value": {
+				Type: "array",
+				Items: &openapi.Schema{Ref: "HostConfig"},
+			},
+		},
+		Required: []string{"links"},
+	},
+	"HostConfigSchemaInternal": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"is_cluster": {
+				Type: "boolean",
+			},
+			"site": {
+				Type: "string",
+			},
+		},
+		Required: []string{"is_cluster", "site"},
+	},
+	"HostContactGroup": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"groups": {
+				Type: "array",
+				Items: &openapi.Schema{
+					Type: "string",
+				},
+			},
+			"recurse_perms": {
+				Type: "boolean",
+			},
+			"recurse_use": {
+				Type: "boolean",
+			},
+			"use": {
+				Type: "boolean",
+			},
+			"use_for_services": {
+				Type: "boolean",
+			},
+		},
+		Required: []string{"groups"},
+	},
+	"HostCreateAttribute": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"additional_ipv4addresses": {
+				Type: "array",
+				Items: &openapi.Schema{
+					Type: "string",
+				},
+			},
+			"additional_ipv6addresses": {
+				Type: "array",
+				Items: &openapi.Schema{
+					Type: "string",
+				},
+			},
+			"alias": {
+				Type: "string",
+			},
+			"contactgroups": {Ref: "HostContactGroup"},
+			"inventory_failed": {
+				Type: "boolean",
+			},
+			"ipaddress": {
+				Type: "string",
+			},
+			"ipv6address": {
+				Type: "string",
+			},
+			"labels": {
+				Type: "object",
+				AdditionalProperties: &openapi.Schema{
+					Type: "string",
+				},
+			},
+			"locked_attributes": {
+				Type: "array",
+				Items: &openapi.Schema{
+					Type: "string",
+				},
+			},
+			"locked_by": {Ref: "LockedBy"},
+			"management_address": {
+				Type: "string",
+			},
+			"management_ipmi_credentials": {Ref: "IPMIParameters"},
+			"management_protocol": {
+				Type: "string",
+				Enum: []string{"none", "snmp", "ipmi"},
+			},
+			"management_snmp_community": {Ref: "SNMPCredentials", Nullable: true},
+			"network_scan": {Ref: "NetworkScan"},
+			"parents": {
+				Type: "array",
+				Items: &openapi.Schema{
+					Type: "string",
+					Pattern: "^[-0-9a-zA-Z_.]+\\Z",
+				},
+			},
+			"site": {
+				Type: "string",
+			},
+			"snmp_community": {Ref: "SNMPCredentials"},
+			"tag_address_family": {
+				Type: "string",
+				Enum: []string{"ip-v4-only", "ip-v6-only", "ip-v4v6", "no-ip"},
+			},
+			"tag_agent": {
+				Type: "string",
+				Enum: []string{"cmk-agent", "all-agents", "special-agents", "no-agent"},
+			},
+			"tag_criticality": {
+				Type: "string",
+				Enum: []string{"prod", "critical", "test", "offline"},
+			},
+			"tag_networking": {
+				Type: "string",
+				Enum: []string{"lan", "wan", "dmz"},
+			},
+			"tag_piggyback": {
+				Type: "string",
+				Enum: []string{"auto-piggyback", "piggyback", "no-piggyback"},
+			},
+			"tag_snmp_ds": {
+				Type: "string",
+				Enum: []string{"no-snmp", "snmp-v2", "snmp-v1"},
+			},
+		},
+	},
+	"HostEventType": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"acknowledgement_of_problem": {
+				Type: "boolean",
+			},
+			"alert_handler_execution_failed": {
+				Type: "boolean",
+			},
+			"alert_handler_execution_successful": {
+				Type: "boolean",
+			},
+			"any_down": {
+				Type: "boolean",
+			},
+			"any_unreachable": {
+				Type: "boolean",
+			},
+			"any_up": {
+				Type: "boolean",
+			},
+			"down_unreachable": {
+				Type: "boolean",
+			},
+			"down_up": {
+				Type: "boolean",
+			},
+			"start_or_end_of_flapping_state": {
+				Type: "boolean",
+			},
+			"start_or_end_of_scheduled_downtime": {
+				Type: "boolean",
+			},
+			"unreachable_down": {
+				Type: "boolean",
+			},
+			"unreachable_up": {
+				Type: "boolean",
+			},
+			"up_down": {
+				Type: "boolean",
+			},
+			"up_unreachable": {
+				Type: "boolean",
+			},
+		},
+		Required: []string{"acknowledgement_of_problem", "alert_handler_execution_failed", "alert_handler_execution_successful", "any_down", "any_unreachable", "any_up", "down_unreachable", "down_up", "start_or_end_of_flapping_state", "start_or_end_of_scheduled_downtime", "unreachable_down", "unreachable_up", "up_down", "up_unreachable"},
+	},
+	"HostExtensions": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"attributes": {
+				AnyOf: []*openapi.Schema{
+					{Ref: "HostViewAttribute"},
+					{Ref: "CustomHostAttributes"},
+					{Ref: "TagGroupAttributes"},
+				},
+			},
+			"cluster_nodes": {
+				Type: "array",
+				Items: &openapi.Schema{
+					Type: "string",
+					Pattern: "^[-0-9a-zA-Z_.]+\\Z",
+				},
+				Nullable: true,
+			},
+			"effective_attributes": {Ref: "HostExtensionsEffectiveAttributes"},
+			"folder": {
+				Type: "string",
+				Pattern: "^(?:(?:[~\\\\\\/]|(?:[~\\\\\\/][-_ a-zA-Z0-9.]+)+[~\\\\\\/]?)|[0-9a-fA-F]{32})$",
+			},
+			"is_cluster": {
+				Type: "boolean",
+			},
+			"is_offline": {
+				Type: "boolean",
+			},
+		},
+	},
+	"HostExtensionsEffectiveAttributes": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"additional_ipv4addresses": {
+				Type: "array",
+				Items: &openapi.Schema{
+					Type: "string",
+				},
+			},
+			"additional_ipv6addresses": {
+				Type: "array",
+				Items: &openapi.Schema{
+					Type: "string",
+				},
+			},
+			"alias": {
+				Type: "string",
+			},
+			"contactgroups": {Ref: "HostContactGroup"},
+			"inventory_failed": {
+				Type: "boolean",
+			},
+			"ipaddress": {
+				Type: "string",
+			},
+			"ipv6address": {
+				Type: "string",
+			},
+			"labels": {
+				Type: "object",
+				AdditionalProperties: &openapi.Schema{
+					Type: "string",
+				},
+			},
+			"locked_attributes": {
+				Type: "array",
+				Items: &openapi.Schema{
+					Type: "string",
+				},
+			},
+			"locked_by": {Ref: "LockedBy"},
+			"management_address": {
+				Type: "string",
+			},
+			"management_ipmi_credentials": {Ref: "IPMIParameters"},
+			"management_protocol": {
+				Type: "string",
+				Enum: []string{"none", "snmp", "ipmi"},
+			},
+			"management_snmp_community": {Ref: "SNMPCredentials", Nullable: true},
+			"meta_data": {Ref: "MetaData"},
+			"network_scan": {Ref: "NetworkScan"},
+			"network_scan_result": {Ref: "NetworkScanResult"},
+			"parents": {
+				Type: "array",
+				Items: &openapi.Schema{
+					Type: "string",
+					Pattern: "^[-0-9a-zA-Z_.]+\\Z",
+				},
+			},
+			"site": {
+				Type: "string",
+			},
+			"snmp_community": {Ref: "SNMPCredentials"},
+			"tag_address_family": {
+				Type: "string",
+			},
+			"tag_agent": {
+				Type: "string",
+			},
+			"tag_criticality": {
+				Type: "string",
+			},
+			"tag_networking": {
+				Type: "string",
+			},
+			"tag_piggyback": {
+				Type: "string",
+			},
+			"tag_snmp_ds": {
+				Type: "string",
+			},
+		},
+	},
+	"HostGroup": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"domainType": {
+			},
+			"extensions": {
+				Type: "object",
+				AdditionalProperties: &openapi.Schema{},
+			},
+			"id": {
+				Type: "string",
+			},
+			"links": {
+				Type: "array",
+				Items: &openapi.Schema{Ref: "Link"},
+			},
+			"members": {
+				Type: "object",
+				AdditionalProperties: &openapi.Schema{},
+			},
+			"title": {
+				Type: "string",
+			},
+		},
+		Required: []string{"links"},
+	},
+	"HostGroupCollection": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"domainType": {
+			},
+			"extensions": {
+				Type: "object",
+				AdditionalProperties: &openapi.Schema{},
+			},
+			"id": {
+				Type: "string",
+			},
+			"links": {
+				Type: "array",
+				Items: &openapi.Schema{Ref: "Link"},
+			},
+			"title": {
+				Type: "string",
+			},
+			"value": {
+				Type: "array",
+				Items: &openapi.Schema{Ref: "HostGroup"},
+			},
+		},
+		Required: []string{"links"},
+	},
+	"HostMembers": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"folder_config": {Ref: "Folder"},
+		},
+	},
+	"HostOrServiceCondition": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"match_on": {
+				Type: "array",
+				Items: &openapi.Schema{
+					Type: "string",
+				},
+			},
+			"operator": {
+				Type: "string",
+				Enum: []string{"one_of", "none_of"},
+			},
+		},
+	},
+	"HostTag": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"aux_tags": {
+				Type: "array",
+				Items: &openapi.Schema{
+					Type: "string",
+					Pattern: "^[-0-9a-zA-Z_]+\\Z",
+				},
+			},
+			"ident": {
+				Type: "string",
+				Nullable: true,
+			},
+			"title": {
+				Type: "string",
+			},
+		},
+		Required: []string{"title"},
+	},
+	"HostTag1": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"aux_tags": {
+				Type: "array",
+				Items: &openapi.Schema{
+					Type: "string",
+				},
+			},
+			"id": {
+				Type: "string",
+				Nullable: true,
+			},
+			"title": {
+				Type: "string",
+			},
+		},
+	},
+	"HostTagExtensions": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"tags": {
+				Type: "array",
+				Items: &openapi.Schema{Ref: "HostTag1"},
+			},
+			"topic": {
+				Type: "string",
+			},
+		},
+	},
+	"HostTagGroupCollection": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"domainType": {
+			},
+			"extensions": {
+				Type: "object",
+				AdditionalProperties: &openapi.Schema{},
+			},
+			"id": {
+				Type: "string",
+			},
+			"links": {
+				Type: "array",
+				Items: &openapi.Schema{Ref: "Link"},
+			},
+			"title": {
+				Type: "string",
+			},
+			"value": {
+				Type: "array",
+				Items: &openapi.Schema{Ref: "ConcreteHostTagGroup"},
+			},
+		},
+		Required: []string{"links"},
+	},
+	"HostTagValues": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"checkmk_agent_api_integration": {
+				Type: "string",
+				Enum: []string{"cmk-agent", "!cmk-agent", "all-agents", "!all-agents", "special-agents", "!special-agents", "no-agent", "!no-agent", "ignore"},
+			},
+			"criticality": {
+				Type: "string",
+				Enum: []string{"prod", "critical", "test", "offline", "!prod", "!critical", "!test", "!offline", "ignore"},
+			},
+			"ip_address_family": {
+				Type: "string",
+				Enum: []string{"ip-v4-only", "ip-v6-only", "ip-v4v6", "no-ip", "!ip-v4-only", "!ip-v6-only", "!ip-v4v6", "!no-ip", "ignore"},
+			},
+			"ip_v4": {
+				Type: "string",
+				Enum: []string{"ip-v4", "!ip-v4", "ignore"},
+			},
+			"ip_v6": {
+				Type: "string",
+				Enum: []string{"ip-v6", "!ip-v6", "ignore"},
+			},
+			"monitor_via_checkmkagent": {
+				Type: "string",
+				Enum: []string{"checkmk-agent", "!checkmk-agent", "ignore"},
+			},
+			"monitor_via_checkmkagent_or_specialagent": {
+				Type: "string",
+				Enum: []string{"tcp", "!tcp", "ignore"},
+			},
+			"monitor_via_snmp": {
+				Type: "string",
+				Enum: []string{"snmp", "!snmp", "ignore"},
+			},
+			"networking_segment": {
+				Type: "string",
+				Enum: []string{"lan", "wan", "dmz", "ignore", "!lan", "!wan", "!dmz"},
+			},
+			"only_ping_this_device": {
+				Type: "string",
+				Enum: []string{"ping", "!ping", "ignore"},
+			},
+			"piggyback": {
+				Type: "string",
+				Enum: []string{"auto-piggyback", "!auto-piggyback", "piggyback", "!piggyback", "no-piggyback", "!no-piggyback", "ignore"},
+			},
+			"snmp": {
+				Type: "string",
+				Enum: []string{"no-snmp", "!no-snmp", "snmp-v1", "!snmp-v1", "snmp-v2", "!snmp-v2", "ignore"},
+			},
+		},
+		Required: []string{"checkmk_agent_api_integration", "criticality", "ip_address_family", "ip_v4", "ip_v6", "monitor_via_checkmkagent", "monitor_via_checkmkagent_or_specialagent", "monitor_via_snmp", "networking_segment", "only_ping_this_device", "piggyback", "snmp"},
+	},
+	"HostUpdateAttribute": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"additional_ipv4addresses": {
+				Type: "array",
+				Items: &openapi.Schema{
+					Type: "string",
+				},
+			},
+			"additional_ipv6addresses": {
+				Type: "array",
+				Items: &openapi.Schema{
+					Type: "string",
+				},
+			},
+			"alias": {
+				Type: "string",
+			},
+			"contactgroups": {Ref: "HostContactGroup"},
+			"inventory_failed": {
+				Type: "boolean",
+			},
+			"ipaddress": {
+				Type: "string",
+			},
+			"ipv6address": {
+				Type: "string",
+			},
+			"labels": {
+				Type: "object",
+				AdditionalProperties: &openapi.Schema{
+					Type: "string",
+				},
+			},
+			"locked_attributes": {
+				Type: "array",
+				Items: &openapi.Schema{
+					Type: "string",
+				},
+			},
+			"locked_by": {Ref: "LockedBy"},
+			"management_address": {
+				Type: "string",
+			},
+			"management_ipmi_credentials": {Ref: "IPMIParameters"},
+			"management_protocol": {
+				Type: "string",
+				Enum: []string{"none", "snmp", "ipmi"},
+			},
+			"management_snmp_community": {Ref: "SNMPCredentials", Nullable: true},
+			"network_scan": {Ref: "NetworkScan"},
+			"parents": {
+				Type: "array",
+				Items: &openapi.Schema{
+					Type: "string",
+					Pattern: "^[-0-9a-zA-Z_.]+\\Z",
+				},
+			},
+			"site": {
+				Type: "string",
+			},
+			"snmp_community": {Ref: "SNMPCredentials"},
+			"tag_address_family": {
+				Type: "string",
+				Enum: []string{"ip-v4-only", "ip-v6-only", "ip-v4v6", "no-ip"},
+			},
+			"tag_agent": {
+				Type: "string",
+				Enum: []string{"cmk-agent", "all-agents", "special-agents", "no-agent"},
+			},
+			"tag_criticality": {
+				Type: "string",
+				Enum: []string{"prod", "critical", "test", "offline"},
+			},
+			"tag_networking": {
+				Type: "string",
+				Enum: []string{"lan", "wan", "dmz"},
+			},
+			"tag_piggyback": {
+				Type: "string",
+				Enum: []string{"auto-piggyback", "piggyback", "no-piggyback"},
+			},
+			"tag_snmp_ds": {
+				Type: "string",
+				Enum: []string{"no-snmp", "snmp-v2", "snmp-v1"},
+			},
+		},
+	},
+	"HostViewAttribute": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"additional_ipv4addresses": {
+				Type: "array",
+				Items: &openapi.Schema{
+					Type: "string",
+				},
+			},
+			"additional_ipv6addresses": {
+				Type: "array",
+				Items: &openapi.Schema{
+					Type: "string",
+				},
+			},
+			"alias": {
+				Type: "string",
+			},
+			"contactgroups": {Ref: "HostContactGroup"},
+			"inventory_failed": {
+				Type: "boolean",
+			},
+			"ipaddress": {
+				Type: "string",
+			},
+			"ipv6address": {
+				Type: "string",
+			},
+			"labels": {
+				Type: "object",
+				AdditionalProperties: &openapi.Schema{
+					Type: "string",
+				},
+			},
+			"locked_attributes": {
+				Type: "array",
+				Items: &openapi.Schema{
+					Type: "string",
+				},
+			},
+			"locked_by": {Ref: "LockedBy"},
+			"management_address": {
+				Type: "string",
+			},
+			"management_ipmi_credentials": {Ref: "IPMIParameters"},
+			"management_protocol": {
+				Type: "string",
+				Enum: []string{"none", "snmp", "ipmi"},
+			},
+			"management_snmp_community": {Ref: "SNMPCredentials", Nullable: true},
+			"meta_data": {Ref: "MetaData"},
+			"network_scan": {Ref: "NetworkScan"},
+			"network_scan_result": {Ref: "NetworkScanResult"},
+			"parents": {
+				Type: "array",
+				Items: &openapi.Schema{
+					Type: "string",
+					Pattern: "^[-0-9a-zA-Z_.]+\\Z",
+				},
+			},
+			"site": {
+				Type: "string",
+			},
+			"snmp_community": {Ref: "SNMPCredentials"},
+			"tag_address_family": {
+				Type: "string",
+			},
+			"tag_agent": {
+				Type: "string",
+			},
+			"tag_criticality": {
+				Type: "string",
+			},
+			"tag_networking": {
+				Type: "string",
+			},
+			"tag_piggyback": {
+				Type: "string",
+			},
+			"tag_snmp_ds": {
+				Type: "string",
+			},
+		},
+	},
+	"IPAddressRange": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"from_address": {
+				Type: "string",
+			},
+			"to_address": {
+				Type: "string",
+			},
+			"type": {
+			},
+		},
+	},
+	"IPAddresses": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"addresses": {
+				Type: "array",
+				Items: &openapi.Schema{
+					Type: "string",
+				},
+			},
+			"type": {
+			},
+		},
+	},
+	"IPMIParameters": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"password": {
+				Type: "string",
+			},
+			"username": {
+				Type: "string",
+			},
+		},
+		Required: []string{"password", "username"},
+	},
+	"IPNetwork": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"network": {
+				Type: "string",
+			},
+			"type": {
+			},
+		},
+	},
+	"IPRangeWithRegexp": {
+		Type: "object",
+		OneOf: []*openapi.Schema{
+			{Ref: "IPAddressRange"},
+			{Ref: "IPNetwork"},
+			{Ref: "IPAddresses"},
+			{Ref: "IPRegexp"},
+		},
+		Discriminator: "type",
+		Mapping: map[string]string{
+			"address_range": "IPAddressRange",
+			"exclude_by_regexp": "IPRegexp",
+			"explicit_addresses": "IPAddresses",
+			"network_range": "IPNetwork",
+		},
+	},
+	"IPRegexp": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"regexp_list": {
+				Type: "array",
+				Items: &openapi.Schema{
+					Type: "string",
+				},
+			},
+			"type": {
+			},
+		},
+	},
+	"IdleOption": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"duration": {
+				Type: "integer",
+			},
+			"option": {
+				Type: "string",
+				Enum: []string{"global", "disable", "individual"},
+			},
+		},
+		Required: []string{"option"},
+	},
+	"IlertAPIKey": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"key": {
+				Type: "string",
+			},
+			"option": {
+				Type: "string",
+				Enum: []string{"store", "explicit"},
+			},
+		},
+		Required: []string{"key", "option"},
+	},
+	"IlertKeyOrStoreSelector": {
+		Type: "object",
+		OneOf: []*openapi.Schema{
+			{Ref: "IlertAPIKey"},
+			{Ref: "IlertPasswordStoreID"},
+		},
+		Discriminator: "option",
+		Mapping: map[string]string{
+			"explicit": "IlertAPIKey",
+			"store": "IlertPasswordStoreID",
+		},
+	},
+	"IlertPasswordStoreID": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"option": {
+				Type: "string",
+				Enum: []string{"store", "explicit"},
+			},
+			"store_id": {
+				Type: "string",
+			},
+		},
+		Required: []string{"option", "store_id"},
+	},
+	"IlertPluginCreate": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"api_key": {Ref: "IlertKeyOrStoreSelector"},
+			"custom_summary_for_host_alerts": {
+				Type: "string",
+			},
+			"custom_summary_for_service_alerts": {
+				Type: "string",
+			},
+			"disable_ssl_cert_verification": {Ref: "Checkbox"},
+			"http_proxy": {Ref: "CheckboxOneOf"},
+			"notification_priority": {
+				Type: "string",
+				Enum: []string{"HIGH", "LOW"},
+			},
+			"plugin_name": {
+				Type: "string",
+				Enum: []string{"asciimail", "cisco_webex_teams", "mkeventd", "mail", "ilert", "jira_issues", "opsgenie_issues", "pagerduty", "pushover", "servicenow", "signl4", "slack", "sms_api", "sms", "spectrum", "victorops", "msteams"},
+			},
+			"url_prefix_for_links_to_checkmk": {Ref: "CheckboxOneOf"},
+		},
+		Required: []string{"api_key", "custom_summary_for_host_alerts", "custom_summary_for_service_alerts", "disable_ssl_cert_verification", "http_proxy", "notification_priority", "plugin_name", "url_prefix_for_links_to_checkmk"},
+	},
+	"IncidentParams": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"caller": {
+				Type: "string",
+			},
+			"host_description": {Ref: "CheckboxOneOf"},
+			"host_short_description": {Ref: "CheckboxOneOf"},
+			"impact": {Ref: "CheckboxOneOf"},
+			"service_description": {Ref: "CheckboxOneOf"},
+			"service_short_description": {Ref: "CheckboxOneOf"},
+			"state_acknowledgement": {Ref: "CheckboxOneOf"},
+			"state_downtime": {Ref: "CheckboxOneOf"},
+			"state_recovery": {Ref: "CheckboxOneOf"},
+			"urgency": {Ref: "CheckboxOneOf"},
+		},
+		Required: []string{"caller"},
+	},
+	"InputContactGroup": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"alias": {
+				Type: "string",
+			},
+			"name": {
+				Type: "string",
+				Pattern: "^[-a-z0-9A-Z_\\.]*\\Z",
+			},
+		},
+		Required: []string{"alias", "name"},
+	},
+	"InputHostGroup": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"alias": {
+				Type: "string",
+			},
+			"name": {
+				Type: "string",
+				Pattern: "^[-a-z0-9A-Z_\\.]*\\Z",
+			},
+		},
+		Required: []string{"alias", "name"},
+	},
+	"InputHostTagGroup": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"help": {
+				Type: "string",
+			},
+			"ident": {
+				Type: "string",
+				Pattern: "^[^\\d\\W][-\\w]*\\Z",
+			},
+			"tags": {
+				Type: "array",
+				Items: &openapi.Schema{Ref: "HostTag"},
+				MinLength: openapi.Int(1),
+			},
+			"title": {
+				Type: "string",
+			},
+			"topic": {
+				Type: "string",
+			},
+		},
+		Required: []string{"ident", "tags", "title"},
+	},
+	"InputPassword": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"comment": {
+				Type: "string",
+			},
+			"documentation_url": {
+				Type: "string",
+			},
+			"ident": {
+				Type: "string",
+			},
+			"owner": {
+				Type: "string",
+			},
+			"password": {
+				Type: "string",
+				MinLength: openapi.Int(1),
+			},
+			"shared": {
+				Type: "array",
+				Items: &openapi.Schema{
+					Type: "string",
+				},
+			},
+			"title": {
+				Type: "string",
+			},
+		},
+		Required: []string{"ident", "owner", "password", "title"},
+	},
+	"InputRuleObject": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"conditions": {Ref: "RuleConditions1"},
+			"folder": {
+				Type: "string",
+				Pattern: "^(?:(?:[~\\\\\\/]|(?:[~\\\\\\/][-_ a-zA-Z0-9.]+)+[~\\\\\\/]?)|[0-9a-fA-F]{32})$",
+			},
+			"properties": {Ref: "RuleProperties1"},
+			"ruleset": {
+				Type: "string",
+			},
+			"value_raw": {
+				Type: "string",
+			},
+		},
+		Required: []string{"folder", "ruleset"},
+	},
+	"InputServiceGroup": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"alias": {
+				Type: "string",
+			},
+			"name": {
+				Type: "string",
+				Pattern: "^[-a-z0-9A-Z_\\.]*\\Z",
+			},
+		},
+		Required: []string{"alias", "name"},
+	},
+	"InstalledVersions": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"demo": {
+				Type: "boolean",
+			},
+			"edition": {
+				Type: "string",
+			},
+			"group": {
+				Type: "string",
+			},
+			"rest_api": {
+				Type: "object",
+				AdditionalProperties: &openapi.Schema{},
+			},
+			"site": {
+				Type: "string",
+			},
+			"versions": {
+				Type: "object",
+				AdditionalProperties: &openapi.Schema{},
+			},
+		},
+	},
+	"JiraPluginCreate": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"disable_ssl_cert_verification": {Ref: "Checkbox"},
+			"host_custom_id": {
+				Type: "string",
+			},
+			"host_summary": {Ref: "CheckboxOneOf"},
+			"issue_type_id": {
+				Type: "string",
+			},
+			"jira_url": {
+				Type: "string",
+			},
+			"label": {Ref: "CheckboxOneOf"},
+			"monitoring_url": {
+				Type: "string",
+			},
+			"optional_timeout": {Ref: "CheckboxOneOf"},
+			"password": {
+				Type: "string",
+			},
+			"plugin_name": {
+				Type: "string",
+				Enum: []string{"asciimail", "cisco_webex_teams", "mkeventd", "mail", "ilert", "jira_issues", "opsgenie_issues", "pagerduty", "pushover", "servicenow", "signl4", "slack", "sms_api", "sms", "spectrum", "victorops", "msteams"},
+			},
+			"priority_id": {Ref: "CheckboxOneOf"},
+			"project_id": {
+				Type: "string",
+			},
+			"resolution_id": {Ref: "CheckboxOneOf"},
+			"service_custom_id": {
+				Type: "string",
+			},
+			"service_summary": {Ref: "CheckboxOneOf"},
+			"site_custom_id": {Ref: "CheckboxOneOf"},
+			"username": {
+				Type: "string",
+			},
+		},
+		Required: []string{"disable_ssl_cert_verification", "host_custom_id", "host_summary", "issue_type_id", "label", "monitoring_url", "optional_timeout", "password", "plugin_name", "priority_id", "project_id", "resolution_id", "service_custom_id", "service_summary", "site_custom_id", "username"},
+	},
+	"JobLogs": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"progress": {
+				Type: "array",
+				Items: &openapi.Schema{
+					Type: "string",
+				},
+			},
+			"result": {
+				Type: "array",
+				Items: &openapi.Schema{
+					Type: "string",
+				},
+			},
+		},
+	},
+	"LabelCondition": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"key": {
+				Type: "string",
+			},
+			"operator": {
+				Type: "string",
+				Enum: []string{"is", "is_not"},
+			},
+			"value": {
+				Type: "string",
+			},
+		},
+		Required: []string{"key", "value"},
+	},
+	"Link": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"body_params": {
+				Type: "object",
+				AdditionalProperties: &openapi.Schema{},
+			},
+			"domainType": {
+			},
+			"href": {
+				Type: "string",
+			},
+			"method": {
+				Type: "string",
+				Enum: []string{"GET", "PUT", "POST", "DELETE"},
+			},
+			"rel": {
+				Type: "string",
+			},
+			"title": {
+				Type: "string",
+				Nullable: true,
+			},
+			"type": {
+				Type: "string",
+			},
+		},
+		Required: []string{"domainType", "href", "method", "rel", "type"},
+	},
+	"LinkHostUUID": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"uuid": {
+				Type: "string",
+				Format: "uuid",
+			},
+		},
+		Required: []string{"uuid"},
+	},
+	"LockedBy": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"instance_id": {
+				Type: "string",
+			},
+			"program_id": {
+				Type: "string",
+			},
+			"site_id": {
+				Type: "string",
+			},
+		},
+		Required: []string{"instance_id", "program_id", "site_id"},
+	},
+	"LogicalExpr": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"expr": {
+				Type: "array",
+				Items: &openapi.Schema{Ref: "Expr"},
+			},
+			"op": {
+				Type: "string",
+			},
+		},
+	},
+	"MSTeamsExplicitWebhookUrl": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"option": {
+				Type: "string",
+				Enum: []string{"store", "explicit"},
+			},
+			"url": {
+				Type: "string",
+				Format: "url",
+			},
+		},
+		Required: []string{"option", "url"},
+	},
+	"MSTeamsPluginCreate": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"affected_host_groups": {Ref: "Checkbox"},
+			"host_details": {Ref: "CheckboxOneOf"},
+			"host_summary": {Ref: "CheckboxOneOf"},
+			"host_title": {Ref: "CheckboxOneOf"},
+			"http_proxy": {Ref: "CheckboxOneOf"},
+			"plugin_name": {
+				Type: "string",
+				Enum: []string{"asciimail", "cisco_webex_teams", "mkeventd", "mail", "ilert", "jira_issues", "opsgenie_issues", "pagerduty", "pushover", "servicenow", "signl4", "slack", "sms_api", "sms", "spectrum", "victorops", "msteams"},
+			},
+			"service_details": {Ref: "CheckboxOneOf"},
+			"service_summary": {Ref: "CheckboxOneOf"},
+			"service_title": {Ref: "CheckboxOneOf"},
+			"url_prefix_for_links_to_checkmk": {Ref: "CheckboxOneOf"},
+			"webhook_url": {Ref: "MSTeamsUrlOrStoreSelector"},
+		},
+		Required: []string{"affected_host_groups", "host_details", "host_summary", "host_title", "http_proxy", "plugin_name", "service_details", "service_summary", "service_title", "url_prefix_for_links_to_checkmk", "webhook_url"},
+	},
+	"MSTeamsURLResponse": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"option": {
+				Type: "string",
+				Enum: []string{"store", "explicit"},
+			},
+			"store_id": {
+				Type: "string",
+			},
+			"url": {
+				Type: "string",
+			},
+		},
+		Required: []string{"option", "store_id"},
+	},
+	"MSTeamsUrlOrStoreSelector": {
+		Type: "object",
+		OneOf: []*openapi.Schema{
+			{Ref: "MSTeamsExplicitWebhookUrl"},
+			{Ref: "MSTeamsURLResponse"},
+		},
+		Discriminator: "option",
+		Mapping: map[string]string{
+			"explicit": "MSTeamsExplicitWebhookUrl",
+			"store": "MSTeamsURLResponse",
+		},
+	},
+	"MatchCustomMacros": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"state": {
+				Type: "string",
+				Enum: []string{"enabled", "disabled"},
+			},
+			"value": {
+				Type: "array",
+				Items: &openapi.Schema{Ref: "CustomMacro"},
+			},
+		},
+		Required: []string{"state"},
+	},
+	"MatchEventConsoleAlertsResponse": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"state": {
+				Type: "string",
+				Enum: []string{"enabled", "disabled"},
+			},
+			"value": {Ref: "EventConsoleAlertsResponse"},
+		},
+		Required: []string{"state"},
+	},
+	"MetaData": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"created_at": {
+				Type: "string",
+				Format: "date-time",
+				Nullable: true,
+			},
+			"created_by": {
+				Type: "string",
+				Nullable: true,
+			},
+			"updated_at": {
+				Type: "string",
+				Format: "date-time",
+				Nullable: true,
+			},
+		},
+	},
+	"Metric": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"color": {
+				Type: "string",
+			},
+			"data_points": {
+				Type: "array",
+				Items: &openapi.Schema{
+					Type: "number",
+				},
+			},
+			"line_type": {
+				Type: "string",
+			},
+			"title": {
+				Type: "string",
+			},
+		},
+		Required: []string{"data_points", "line_type", "title"},
+	},
+	"MgmntTypeCaseParams": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"option": {
+				Type: "string",
+				Enum: []string{"case", "incident"},
+			},
+			"params": {Ref: "CaseParams"},
+		},
+		Required: []string{"option"},
+	},
+	"MgmntTypeIncidentParams": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"option": {
+				Type: "string",
+				Enum: []string{"case", "incident"},
+			},
+			"params": {Ref: "IncidentParams"},
+		},
+		Required: []string{"option"},
+	},
+	"MgmntTypeSelector": {
+		Type: "object",
+		OneOf: []*openapi.Schema{
+			{Ref: "MgmntTypeCaseParams"},
+			{Ref: "MgmntTypeIncidentParams"},
+		},
+		Discriminator: "option",
+		Mapping: map[string]string{
+			"case": "MgmntTypeCaseParams",
+			"incident": "MgmntTypeIncidentParams",
+		},
+	},
+	"MkEventDPluginCreate": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"ip_address_of_remote_event_console": {Ref: "CheckboxOneOf"},
+			"plugin_name": {
+				Type: "string",
+				Enum: []string{"asciimail", "cisco_webex_teams", "mkeventd", "mail", "ilert", "jira_issues", "opsgenie_issues", "pagerduty", "pushover", "servicenow", "signl4", "slack", "sms_api", "sms", "spectrum", "victorops", "msteams"},
+			},
+			"syslog_facility_to_use": {Ref: "CheckboxOneOf"},
+		},
+		Required: []string{"ip_address_of_remote_event_console", "plugin_name", "syslog_facility_to_use"},
+	},
+	"MoveFolder": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"destination": {
+				Type: "string",
+				Pattern: "^(?:(?:[~\\\\\\/]|(?:[~\\\\\\/][-_ a-zA-Z0-9.]+)+[~\\\\\\/]?)|[0-9a-fA-F]{32})$",
+			},
+		},
+		Required: []string{"destination"},
+	},
+	"MoveHost": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"target_folder": {
+				Type: "string",
+				Pattern: "^(?:(?:[~\\\\\\/]|(?:[~\\\\\\/][-_ a-zA-Z0-9.]+)+[~\\\\\\/]?)|[0-9a-fA-F]{32})$",
+			},
+		},
+		Required: []string{"target_folder"},
+	},
+	"MoveRuleTo": {
+		Type: "object",
+		OneOf: []*openapi.Schema{
+			{Ref: "MoveToFolder"},
+			{Ref: "MoveToFolder"},
+			{Ref: "MoveToSpecificRule"},
+			{Ref: "MoveToSpecificRule"},
+		},
+		Discriminator: "position",
+		Mapping: map[string]string{
+			"after_specific_rule": "MoveToSpecificRule",
+			"before_specific_rule": "MoveToSpecificRule",
+			"bottom_of_folder": "MoveToFolder",
+			"top_of_folder": "MoveToFolder",
+		},
+	},
+	"MoveToFolder": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"folder": {
+				Type: "string",
+				Pattern: "^(?:(?:[~\\\\\\/]|(?:[~\\\\\\/][-_ a-zA-Z0-9.]+)+[~\\\\\\/]?)|[0-9a-fA-F]{32})$",
+			},
+			"position": {
+				Type: "string",
+			},
+		},
+	},
+	"MoveToSpecificRule": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"position": {
+				Type: "string",
+			},
+			"rule_id": {
+				Type: "string",
+			},
+		},
+	},
+	"NetworkScan": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"addresses": {
+				Type: "array",
+				Items: &openapi.Schema{Ref: "IPRangeWithRegexp"},
+			},
+			"exclude_addresses": {
+				Type: "array",
+				Items: &openapi.Schema{Ref: "IPRangeWithRegexp"},
+			},
+			"max_parallel_pings": {
+				Type: "integer",
+				Minimum: openapi.Float(1),
+				Maximum: openapi.Float(200),
+			},
+			"run_as": {
+				Type: "string",
+			},
+			"scan_interval": {
+				Type: "integer",
+				Minimum: openapi.Float(3600),
+			},
+			"set_ip_address": {
+				Type: "boolean",
+			},
+			"tag_criticality": {
+				Type: "string",
+			},
+			"time_allowed": {
+				Type: "array",
+				Items: &openapi.Schema{Ref: "TimeAllowedRange"},
+			},
+			"translate_names": {Ref: "TranslateNames"},
+		},
+		Required: []string{"addresses", "time_allowed"},
+	},
+	"NetworkScanResult": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"end": {
+				Type: "string",
+				Format: "date-time",
+				Nullable: true,
+			},
+			"output": {
+				Type: "string",
+			},
+			"start": {
+				Type: "string",
+				Format: "date-time",
+			},
+			"state": {
+				Type: "string",
+				Enum: []string{"running", "succeeded", "failed"},
+			},
+		},
+	},
+	"NotExpr": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"expr": {Ref: "Expr"},
+			"op": {
+				Type: "string",
+			},
+		},
+	},
+	"NotificationBulking": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"bulk_outside_timeperiod": {Ref: "BulkOutsideTimePeriodValue"},
+			"max_bulk_size": {
+				Type: "integer",
+			},
+			"notification_bulks_based_on": {
+				Type: "array",
+				Items: &openapi.Schema{
+					Type: "string",
+					Enum: []string{"folder", "host", "service", "sl", "check_type", "state", "ec_contact", "ec_comment"},
+				},
+			},
+			"notification_bulks_based_on_custom_macros": {
+				Type: "array",
+				Items: &openapi.Schema{
+					Type: "string",
+				},
+			},
+			"state": {
+				Type: "string",
+				Enum: []string{"enabled", "disabled"},
+			},
+			"subject_for_bulk_notifications": {Ref: "CheckboxWithStrValue"},
+			"time_horizon": {
+				Type: "integer",
+			},
+			"time_period": {
+				Type: "string",
+			},
+		},
+		Required: []string{"bulk_outside_timeperiod", "max_bulk_size", "notification_bulks_based_on", "state", "time_horizon", "time_period"},
+	},
+	"NotificationBulkingCheckbox": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"state": {
+				Type: "string",
+				Enum: []string{"enabled", "disabled"},
+			},
+			"value": {Ref: "WhenToBulk"},
+		},
+		Required: []string{"state", "value"},
+	},
+	"NotificationBulkingCommonAttributes": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"max_bulk_size": {
+				Type: "integer",
+			},
+			"notification_bulks_based_on": {
+				Type: "array",
+				Items: &openapi.Schema{
+					Type: "string",
+					Enum: []string{"folder", "host", "service", "sl", "check_type", "state", "ec_contact", "ec_comment"},
+				},
+			},
+			"notification_bulks_based_on_custom_macros": {
+				Type: "array",
+				Items: &openapi.Schema{
+					Type: "string",
+				},
+			},
+			"state": {
+				Type: "string",
+				Enum: []string{"enabled", "disabled"},
+			},
+			"subject_for_bulk_notifications": {Ref: "CheckboxWithStrValue"},
+			"time_horizon": {
+				Type: "integer",
+			},
+		},
+		Required: []string{"max_bulk_size", "notification_bulks_based_on", "state", "time_horizon"},
+	},
+	"NotificationPlugin": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"notification_bulking": {Ref: "NotificationBulkingCheckbox"},
+			"notify_plugin": {Ref: "PluginBase1"},
+		},
+	},
+	"NotificationRuleAttributes": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"conditions": {Ref: "ConditionsAttributes"},
+			"contact_selection": {Ref: "ContactSelectionAttributes"},
+			"notification_method": {Ref: "NotificationPlugin"},
+			"rule_properties": {Ref: "RulePropertiesAttributes"},
+		},
+	},
+	"NotificationRuleConfig": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"rule_config": {Ref: "NotificationRuleAttributes"},
+		},
+	},
+	"NotificationRuleRequest": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"rule_config": {Ref: "RuleNotification"},
+		},
+		Required: []string{"rule_config"},
+	},
+	"NotificationRuleResponse": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"domainType": {
+			},
+			"extensions": {Ref: "NotificationRuleConfig"},
+			"id": {
+				Type: "string",
+			},
+			"links": {
+				Type: "array",
+				Items: &openapi.Schema{Ref: "Link"},
+			},
+			"members": {
+				Type: "object",
+				AdditionalProperties: &openapi.Schema{},
+			},
+			"title": {
+				Type: "string",
+			},
+		},
+		Required: []string{"links"},
+	},
+	"NotificationRuleResponseCollection": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"domainType": {
+			},
+			"extensions": {
+				Type: "object",
+				AdditionalProperties: &openapi.Schema{},
+			},
+			"id": {
+				Type: "string",
+			},
+			"links": {
+				Type: "array",
+				Items: &openapi.Schema{Ref: "Link"},
+			},
+			"title": {
+				Type: "string",
+			},
+			"value": {
+				Type: "array",
+				Items: &openapi.Schema{Ref: "NotificationRuleResponse"},
+			},
+		},
+		Required: []string{"links"},
+	},
+	"ObjectActionMember": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"disabledReason": {
+				Type: "string",
+				Nullable: true,
+			},
+			"id": {
+				Type: "string",
+			},
+			"invalidReason": {
+				Type: "string",
+				Nullable: true,
+			},
+			"links": {
+				Type: "array",
+				Items: &openapi.Schema{Ref: "Link"},
+			},
+			"memberType": {
+			},
+			"name": {
+				Type: "string",
+			},
+			"parameters": {
+				Type: "object",
+				AdditionalProperties: &openapi.Schema{},
+			},
+			"title": {
+				Type: "string",
+			},
+			"x-ro-invalidReason": {
+				Type: "string",
+				Nullable: true,
+			},
+		},
+		Required: []string{"id", "links"},
+	},
+	"ObjectCollectionMember": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"disabledReason": {
+				Type: "string",
+				Nullable: true,
+			},
+			"id": {
+				Type: "string",
+			},
+			"invalidReason": {
+				Type: "string",
+				Nullable: true,
+			},
+			"links": {
+				Type: "array",
+				Items: &openapi.Schema{Ref: "Link"},
+			},
+			"memberType": {
+			},
+			"name": {
+				Type: "string",
+			},
+			"title": {
+				Type: "string",
+			},
+			"value": {
+				Type: "array",
+				Items: &openapi.Schema{Ref: "Link"},
+			},
+			"x-ro-invalidReason": {
+				Type: "string",
+				Nullable: true,
+			},
+		},
+		Required: []string{"id", "links"},
+	},
+	"ObjectProperty": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"extensions": {
+				Type: "object",
+				AdditionalProperties: &openapi.Schema{},
+			},
+			"id": {
+				Type: "string",
+			},
+			"links": {
+				Type: "array",
+				Items: &openapi.Schema{Ref: "Link"},
+			},
+			"value": {
+				Type: "array",
+				Items: &openapi.Schema{
+					Type: "string",
+				},
+			},
+		},
+		Required: []string{"links"},
+	},
+	"OpsGenieExplicitKey": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"key": {
+				Type: "string",
+			},
+			"option": {
+				Type: "string",
+				Enum: []string{"store", "explicit"},
+			},
+		},
+		Required: []string{"key", "option"},
+	},
+	"OpsGeniePluginCreate": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"actions": {Ref: "CheckboxOneOf"},
+			"api_key": {Ref: "OpsGenisStoreOrExplicitKeySelector"},
+			"desc_for_host_alerts": {Ref: "CheckboxOneOf"},
+			"desc_for_service_alerts": {Ref: "CheckboxOneOf"},
+			"domain": {Ref: "CheckboxOneOf"},
+			"entity": {Ref: "CheckboxOneOf"},
+			"http_proxy": {Ref: "CheckboxOneOf"},
+			"message_for_host_alerts": {Ref: "CheckboxOneOf"},
+			"message_for_service_alerts": {Ref: "CheckboxOneOf"},
+			"note_while_closing": {Ref: "CheckboxOneOf"},
+			"note_while_creating": {Ref: "CheckboxOneOf"},
+			"owner": {Ref: "CheckboxOneOf"},
+			"plugin_name": {
+				Type: "string",
+				Enum: []string{"asciimail", "cisco_webex_teams", "mkeventd", "mail", "ilert", "jira_issues", "opsgenie_issues", "pagerduty", "pushover", "servicenow", "signl4", "slack", "sms_api", "sms", "spectrum", "victorops", "msteams"},
+			},
+			"priority": {Ref: "CheckboxOneOf"},
+			"responsible_teams": {Ref: "CheckboxOneOf"},
+			"source": {Ref: "CheckboxOneOf"},
+			"tags": {Ref: "CheckboxOneOf"},
+		},
+		Required: []string{"actions", "api_key", "desc_for_host_alerts", "desc_for_service_alerts", "domain", "entity", "http_proxy", "message_for_host_alerts", "message_for_service_alerts", "note_while_closing", "note_while_creating", "owner", "plugin_name", "priority", "responsible_teams", "source", "tags"},
+	},
+	"OpsGenieStoreID": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"option": {
+				Type: "string",
+				Enum: []string{"store", "explicit"},
+			},
+			"store_id": {
+				Type: "string",
+			},
+		},
+		Required: []string{"option", "store_id"},
+	},
+	"OpsGenisStoreOrExplicitKeySelector": {
+		Type: "object",
+		OneOf: []*openapi.Schema{
+			{Ref: "OpsGenieExplicitKey"},
+			{Ref: "OpsGenieStoreID"},
+		},
+		Discriminator: "option",
+		Mapping: map[string]string{
+			"explicit": "OpsGenieExplicitKey",
+			"store": "OpsGenieStoreID",
+		},
+	},
+	"PagerDutyAPIKeyStoreID": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"option": {
+				Type: "string",
+				Enum: []string{"store", "explicit"},
+			},
+			"store_id": {
+				Type: "string",
+			},
+		},
+		Required: []string{"option", "store_id"},
+	},
+	"PagerDutyExplicitKey": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"key": {
+				Type: "string",
+			},
+			"option": {
+				Type: "string",
+				Enum: []string{"store", "explicit"},
+			},
+		},
+		Required: []string{"key", "option"},
+	},
+	"PagerDutyPluginCreate": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"disable_ssl_cert_verification": {Ref: "Checkbox"},
+			"http_proxy": {Ref: "CheckboxOneOf"},
+			"integration_key": {Ref: "PagerDutyStoreOrIntegrationKeySelector"},
+			"plugin_name": {
+				Type: "string",
+				Enum: []string{"asciimail", "cisco_webex_teams", "mkeventd", "mail", "ilert", "jira_issues", "opsgenie_issues", "pagerduty", "pushover", "servicenow", "signl4", "slack", "sms_api", "sms", "spectrum", "victorops", "msteams"},
+			},
+			"url_prefix_for_links_to_checkmk": {Ref: "CheckboxOneOf"},
+		},
+		Required: []string{"disable_ssl_cert_verification", "http_proxy", "integration_key", "plugin_name", "url_prefix_for_links_to_checkmk"},
+	},
+	"PagerDutyStoreOrIntegrationKeySelector": {
+		Type: "object",
+		OneOf: []*openapi.Schema{
+			{Ref: "PagerDutyExplicitKey"},
+			{Ref: "PagerDutyAPIKeyStoreID"},
+		},
+		Discriminator: "option",
+		Mapping: map[string]string{
+			"explicit": "PagerDutyExplicitKey",
+			"store": "PagerDutyAPIKeyStoreID",
+		},
+	},
+	"Parent": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"type": {
+			},
+		},
+		Required: []string{"type"},
+	},
+	"PasswordCollection": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"domainType": {
+			},
+			"extensions": {
+				Type: "object",
+				AdditionalProperties: &openapi.Schema{},
+			},
+			"id": {
+				Type: "string",
+			},
+			"links": {
+				Type: "array",
+				Items: &openapi.Schema{Ref: "Link"},
+			},
+			"title": {
+				Type: "string",
+			},
+			"value": {
+				Type: "array",
+				Items: &openapi.Schema{Ref: "PasswordObject"},
+			},
+		},
+		Required: []string{"links"},
+	},
+	"PasswordExtension": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"comment": {
+				Type: "string",
+			},
+			"documentation_url": {
+				Type: "string",
+			},
+			"ident": {
+				Type: "string",
+			},
+			"owned_by": {
+				Type: "string",
+			},
+			"shared": {
+				Type: "array",
+				Items: &openapi.Schema{
+					Type: "string",
+				},
+			},
+			"title": {
+				Type: "string",
+			},
+		},
+	},
+	"PasswordObject": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"domainType": {
+			},
+			"extensions": {Ref: "PasswordExtension"},
+			"id": {
+				Type: "string",
+			},
+			"links": {
+				Type: "array",
+				Items: &openapi.Schema{Ref: "Link"},
+			},
+			"members": {
+				Type: "object",
+				AdditionalProperties: &openapi.Schema{},
+			},
+			"title": {
+				Type: "string",
+			},
+		},
+		Required: []string{"links"},
+	},
+	"PendingChangesCollection": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"domainType": {
+			},
+			"extensions": {
+				Type: "object",
+				AdditionalProperties: &openapi.Schema{},
+			},
+			"links": {
+				Type: "array",
+				Items: &openapi.Schema{Ref: "Link"},
+			},
+			"value": {
+				Type: "array",
+				Items: &openapi.Schema{Ref: "ChangesFields"},
+			},
+		},
+		Required: []string{"links"},
+	},
+	"PluginBase": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"option": {
+				Type: "string",
+				Enum: []string{"cancel_previous_notifications", "create_notification_with_the_following_parameters"},
+			},
+			"plugin_params": {Ref: "PluginName"},
+		},
+		Required: []string{"plugin_params"},
+	},
+	"PluginBase1": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"option": {
+				Type: "string",
+				Enum: []string{"cancel_previous_notifications", "create_notification_with_the_following_parameters"},
+			},
+		},
+		Required: []string{"option"},
+	},
+	"PluginName": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"plugin_name": {
+				Type: "string",
+				Enum: []string{"asciimail", "cisco_webex_teams", "mkeventd", "mail", "ilert", "jira_issues", "opsgenie_issues", "pagerduty", "pushover", "servicenow", "signl4", "slack", "sms_api", "sms", "spectrum", "victorops", "msteams"},
+			},
+		},
+		Required: []string{"plugin_name"},
+	},
+	"PluginOptionsSelector": {
+		Type: "object",
+		OneOf: []*openapi.Schema{
+			{Ref: "PluginBase"},
+			{Ref: "PluginWithParams"},
+		},
+		Discriminator: "option",
+		Mapping: map[string]string{
+			"cancel_previous_notifications": "PluginBase",
+			"create_notification_with_the_following_parameters": "PluginWithParams",
+		},
+	},
+	"PluginSelector": {
+		Type: "object",
+		OneOf: []*openapi.Schema{
+			{Ref: "AsciiMailPluginCreate"},
+			{Ref: "HTMLMailPluginCreate"},
+			{Ref: "CiscoWebexPluginCreate"},
+			{Ref: "MkEventDPluginCreate"},
+			{Ref: "IlertPluginCreate"},
+			{Ref: "JiraPluginCreate"},
+			{Ref: "OpsGeniePluginCreate"},
+			{Ref: "PagerDutyPluginCreate"},
+			{Ref: "PushOverPluginCreate"},
+			{Ref: "ServiceNowPluginCreate"},
+			{Ref: "Signl4PluginCreate"},
+			{Ref: "SlackPluginCreate"},
+			{Ref: "SMSAPIPluginCreate"},
+			{Ref: "SMSPluginBase"},
+			{Ref: "SpectrumPluginBase"},
+			{Ref: "VictoropsPluginCreate"},
+			{Ref: "MSTeamsPluginCreate"},
+		},
+		Discriminator: "plugin_name",
+		Mapping: map[string]string{
+			"asciimail": "AsciiMailPluginCreate",
+			"cisco_webex_teams": "CiscoWebexPluginCreate",
+			"ilert": "IlertPluginCreate",
+			"jira_issues": "JiraPluginCreate",
+			"mail": "HTMLMailPluginCreate",
+			"mkeventd": "MkEventDPluginCreate",
+			"msteams": "MSTeamsPluginCreate",
+			"opsgenie_issues": "OpsGeniePluginCreate",
+			"pagerduty": "PagerDutyPluginCreate",
+			"pushover": "PushOverPluginCreate",
+			"servicenow": "ServiceNowPluginCreate",
+			"signl4": "Signl4PluginCreate",
+			"slack": "SlackPluginCreate",
+			"sms": "SMSPluginBase",
+			"sms_api": "SMSAPIPluginCreate",
+			"spectrum": "SpectrumPluginBase",
+			"victorops": "VictoropsPluginCreate",
+		},
+	},
+	"PluginWithParams": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"option": {
+				Type: "string",
+				Enum: []string{"cancel_previous_notifications", "create_notification_with_the_following_parameters"},
+			},
+			"plugin_params": {Ref: "PluginSelector"},
+		},
+		Required: []string{"plugin_params"},
+	},
+	"ProxyAttributes": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"global_settings": {
+				Type: "boolean",
+			},
+			"params": {Ref: "ProxyParams"},
+			"tcp": {Ref: "ProxyTcp"},
+			"use_livestatus_daemon": {
+				Type: "string",
+				Enum: []string{"direct", "with_proxy"},
+			},
+		},
+		Required: []string{"global_settings", "use_livestatus_daemon"},
+	},
+	"ProxyAttributes1": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"global_settings": {
+				Type: "boolean",
+			},
+			"params": {Ref: "ProxyParams1"},
+			"tcp": {Ref: "ProxyTcp1"},
+			"use_livestatus_daemon": {
+				Type: "string",
+			},
+		},
+		Required: []string{"use_livestatus_daemon"},
+	},
+	"ProxyOrDirect": {
+		Type: "object",
+		OneOf: []*openapi.Schema{
+			{Ref: "UseLiveStatusDaemon"},
+			{Ref: "ProxyAttributes"},
+		},
+		Discriminator: "use_livestatus_daemon",
+		Mapping: map[string]string{
+			"direct": "UseLiveStatusDaemon",
+			"with_proxy": "ProxyAttributes",
+		},
+	},
+	"ProxyParams": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"cache": {
+				Type: "boolean",
+			},
+			"channel_timeout": {
+				Type: "number",
+			},
+			"channels": {
+				Type: "integer",
+				Minimum: openapi.Float(2),
+				Maximum: openapi.Float(50),
+			},
+			"connect_retry": {
+				Type: "number",
+			},
+			"heartbeat": {Ref: "Heartbeat"},
+			"query_timeout": {
+				Type: "number",
+			},
+		},
+	},
+	"ProxyParams1": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"cache": {
+				Type: "boolean",
+			},
+			"channel_timeout": {
+				Type: "number",
+			},
+			"channels": {
+				Type: "integer",
+			},
+			"connect_retry": {
+				Type: "number",
+			},
+			"heartbeat": {Ref: "Heartbeat1"},
+			"query_timeout": {
+				Type: "number",
+			},
+		},
+	},
+	"ProxyTcp": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"only_from": {
+				Type: "array",
+				Items: &openapi.Schema{
+					Type: "string",
+				},
+			},
+			"port": {
+				Type: "integer",
+				Minimum: openapi.Float(1),
+				Maximum: openapi.Float(65535),
+			},
+			"tls": {
+				Type: "boolean",
+			},
+		},
+		Required: []string{"port"},
+	},
+	"ProxyTcp1": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"only_from": {
+				Type: "array",
+				Items: &openapi.Schema{
+					Type: "string",
+				},
+			},
+			"port": {
+				Type: "integer",
+				Minimum: openapi.Float(1),
+				Maximum: openapi.Float(65535),
+			},
+			"tls": {
+				Type: "boolean",
+			},
+		},
+	},
+	"PushOverPluginCreate": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"api_key": {
+				Type: "string",
+				Pattern: "^[a-zA-Z0-9]{30,40}$",
+			},
+			"http_proxy": {Ref: "CheckboxOneOf"},
+			"plugin_name": {
+				Type: "string",
+				Enum: []string{"asciimail", "cisco_webex_teams", "mkeventd", "mail", "ilert", "jira_issues", "opsgenie_issues", "pagerduty", "pushover", "servicenow", "signl4", "slack", "sms_api", "sms", "spectrum", "victorops", "msteams"},
+			},
+			"priority": {Ref: "CheckboxOneOf"},
+			"sound": {Ref: "CheckboxOneOf"},
+			"url_prefix_for_links_to_checkmk": {Ref: "CheckboxOneOf"},
+			"user_group_key": {
+				Type: "string",
+				Pattern: "^[a-zA-Z0-9]{30,40}$",
+			},
+		},
+		Required: []string{"api_key", "http_proxy", "plugin_name", "priority", "sound", "url_prefix_for_links_to_checkmk", "user_group_key"},
+	},
+	"ReferTo": {
+		Type: "object",
+		OneOf: []*openapi.Schema{
+			{Ref: "Host"},
+			{Ref: "Parent"},
+			{Ref: "Child"},
+			{Ref: "ChildWith"},
+		},
+		Discriminator: "type",
+		Mapping: map[string]string{
+			"child": "Child",
+			"child_with": "ChildWith",
+			"host": "Host",
+			"parent": "Parent",
+		},
+	},
+	"RegexpRewrites": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"replace_with": {
+				Type: "string",
+				MaxLength: openapi.Int(30),
+			},
+			"search": {
+				Type: "string",
+				MaxLength: openapi.Int(30),
+			},
+		},
+		Required: []string{"replace_with", "search"},
+	},
+	"RegisterHost": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"uuid": {
+				Type: "string",
+				Format: "uuid",
+			},
+		},
+		Required: []string{"uuid"},
+	},
+	"RenameHost": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"new_name": {
+				Type: "string",
+				Pattern: "^[-0-9a-zA-Z_.]+\\Z",
+			},
+		},
+		Required: []string{"new_name"},
+	},
+	"RuleCollection": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"domainType": {
+			},
+			"extensions": {
+				Type: "object",
+				AdditionalProperties: &openapi.Schema{},
+			},
+			"id": {
+				Type: "string",
+			},
+			"links": {
+				Type: "array",
+				Items: &openapi.Schema{Ref: "Link"},
+			},
+			"title": {
+				Type: "string",
+			},
+			"value": {
+				Type: "array",
+				Items: &openapi.Schema{Ref: "RuleObject"},
+			},
+		},
+		Required: []string{"links"},
+	},
+	"RuleConditions": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"event_console_alerts": {Ref: "CheckboxOneOf"},
+			"match_check_types": {Ref: "CheckboxOneOf"},
+			"match_contact_groups": {Ref: "CheckboxOneOf"},
+			"match_exclude_hosts": {Ref: "CheckboxOneOf"},
+			"match_exclude_service_groups": {Ref: "CheckboxOneOf"},
+			"match_exclude_service_groups_regex": {Ref: "CheckboxOneOf"},
+			"match_exclude_services": {Ref: "CheckboxOneOf"},
+			"match_folder": {Ref: "CheckboxOneOf"},
+			"match_host_event_type": {Ref: "CheckboxOneOf"},
+			"match_host_groups": {Ref: "CheckboxOneOf"},
+			"match_host_labels": {Ref: "CheckboxOneOf"},
+			"match_host_tags": {Ref: "CheckboxOneOf"},
+			"match_hosts": {Ref: "CheckboxOneOf"},
+			"match_notification_comment": {Ref: "CheckboxOneOf"},
+			"match_only_during_time_period": {Ref: "CheckboxOneOf"},
+			"match_plugin_output": {Ref: "CheckboxOneOf"},
+			"match_service_event_type": {Ref: "CheckboxOneOf"},
+			"match_service_groups": {Ref: "CheckboxOneOf"},
+			"match_service_groups_regex": {Ref: "CheckboxOneOf"},
+			"match_service_labels": {Ref: "CheckboxOneOf"},
+			"match_service_levels": {Ref: "CheckboxOneOf"},
+			"match_services": {Ref: "CheckboxOneOf"},
+			"match_sites": {Ref: "CheckboxOneOf"},
+			"restrict_to_notification_numbers": {Ref: "CheckboxOneOf"},
+			"throttle_periodic_notifications": {Ref: "CheckboxOneOf"},
+		},
+		Required: []string{"event_console_alerts", "match_check_types", "match_contact_groups", "match_exclude_hosts", "match_exclude_service_groups", "match_exclude_service_groups_regex", "match_exclude_services", "match_folder", "match_host_event_type", "match_host_groups", "match_host_labels", "match_host_tags", "match_hosts", "match_notification_comment", "match_only_during_time_period", "match_plugin_output", "match_service_event_type", "match_service_groups", "match_service_groups_regex", "match_service_labels", "match_service_levels", "match_services", "match_sites", "restrict_to_notification_numbers", "throttle_periodic_notifications"},
+	},
+	"RuleConditions1": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"host_labels": {
+				Type: "array",
+				Items: &openapi.Schema{Ref: "LabelCondition"},
+			},
+			"host_name": {Ref: "HostOrServiceCondition"},
+			"host_tags": {
+				Type: "array",
+				Items: &openapi.Schema{Ref: "TagCondition"},
+			},
+			"service_description": {Ref: "HostOrServiceCondition"},
+			"service_labels": {
+				Type: "array",
+				Items: &openapi.Schema{Ref: "LabelCondition"},
+			},
+		},
+	},
+	"RuleExtensions": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"conditions": {Ref: "RuleConditions1"},
+			"folder": {
+				Type: "string",
+				Pattern: "^(?:(?:[~\\\\\\/]|(?:[~\\\\\\/][-_ a-zA-Z0-9.]+)+[~\\\\\\/]?)|[0-9a-fA-F]{32})$",
+			},
+			"folder_index": {
+				Type: "integer",
+			},
+			"properties": {Ref: "RuleProperties1"},
+			"ruleset": {
+				Type: "string",
+			},
+			"value_raw": {
+				Type: "string",
+			},
+		},
+		Required: []string{"folder"},
+	},
+	"RuleNotification": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"conditions": {Ref: "RuleConditions"},
+			"contact_selection": {Ref: "ContactSelection"},
+			"notification_method": {Ref: "RuleNotificationMethod"},
+			"rule_properties": {Ref: "RuleProperties"},
+		},
+		Required: []string{"conditions", "contact_selection", "notification_method", "rule_properties"},
+	},
+	"RuleNotificationMethod": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"notification_bulking": {Ref: "CheckboxOneOf"},
+			"notify_plugin": {Ref: "PluginOptionsSelector"},
+		},
+		Required: []string{"notification_bulking", "notify_plugin"},
+	},
+	"RuleObject": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"domainType": {
+			},
+			"extensions": {Ref: "RuleExtensions"},
+			"id": {
+				Type: "string",
+			},
+			"links": {
+				Type: "array",
+				Items: &openapi.Schema{Ref: "Link"},
+			},
+			"members": {
+				Type: "object",
+				AdditionalProperties: &openapi.Schema{},
+			},
+			"title": {
+				Type: "string",
+			},
+		},
+		Required: []string{"links"},
+	},
+	"RuleProperties": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"allow_users_to_deactivate": {Ref: "Checkbox"},
+			"comment": {
+				Type: "string",
+			},
+			"description": {
+				Type: "string",
+			},
+			"do_not_apply_this_rule": {Ref: "Checkbox"},
+			"documentation_url": {
+				Type: "string",
+			},
+		},
+		Required: []string{"allow_users_to_deactivate", "comment", "description", "do_not_apply_this_rule", "documentation_url"},
+	},
+	"RuleProperties1": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"comment": {
+				Type: "string",
+			},
+			"description": {
+				Type: "string",
+			},
+			"disabled": {
+				Type: "boolean",
+			},
+			"documentation_url": {
+				Type: "string",
+				Format: "url",
+			},
+		},
+	},
+	"RulePropertiesAttributes": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"allow_users_to_deactivate": {Ref: "Checkbox"},
+			"comment": {
+				Type: "string",
+			},
+			"description": {
+				Type: "string",
+			},
+			"do_not_apply_this_rule": {Ref: "Checkbox"},
+			"documentation_url": {
+				Type: "string",
+			},
+		},
+		Required: []string{"allow_users_to_deactivate", "comment", "description", "do_not_apply_this_rule", "documentation_url"},
+	},
+	"RulesetCollection": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"domainType": {
+			},
+			"extensions": {
+				Type: "object",
+				AdditionalProperties: &openapi.Schema{},
+			},
+			"id": {
+				Type: "string",
+			},
+			"links": {
+				Type: "array",
+				Items: &openapi.Schema{Ref: "Link"},
+			},
+			"title": {
+				Type: "string",
+			},
+			"value": {
+				Type: "array",
+				Items: &openapi.Schema{Ref: "CollectionItem"},
+			},
+		},
+		Required: []string{"links"},
+	},
+	"RulesetExtensions": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"folder": {
+				Type: "string",
+				Pattern: "^(?:(?:[~\\\\\\/]|(?:[~\\\\\\/][-_ a-zA-Z0-9.]+)+[~\\\\\\/]?)|[0-9a-fA-F]{32})$",
+			},
+			"name": {
+				Type: "string",
+			},
+			"number_of_rules": {
+				Type: "integer",
+			},
+		},
+		Required: []string{"folder"},
+	},
+	"RulesetObject": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"domainType": {
+			},
+			"extensions": {Ref: "RulesetExtensions"},
+			"id": {
+				Type: "string",
+			},
+			"links": {
+				Type: "array",
+				Items: &openapi.Schema{Ref: "Link"},
+			},
+			"members": {
+				Type: "object",
+				AdditionalProperties: &openapi.Schema{},
+			},
+			"title": {
+				Type: "string",
+			},
+		},
+		Required: []string{"links"},
+	},
+	"SMSAPIExplicitPassword": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"option": {
+				Type: "string",
+				Enum: []string{"store", "explicit"},
+			},
+			"password": {
+				Type: "string",
+			},
+		},
+		Required: []string{"option", "password"},
+	},
+	"SMSAPIPStoreID": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"option": {
+				Type: "string",
+				Enum: []string{"store", "explicit"},
+			},
+			"store_id": {
+				Type: "string",
+			},
+		},
+		Required: []string{"option", "store_id"},
+	},
+	"SMSAPIPasswordSelector": {
+		Type: "object",
+		OneOf: []*openapi.Schema{
+			{Ref: "SMSAPIExplicitPassword"},
+			{Ref: "SMSAPIPStoreID"},
+		},
+		Discriminator: "option",
+		Mapping: map[string]string{
+			"explicit": "SMSAPIExplicitPassword",
+			"store": "SMSAPIPStoreID",
+		},
+	},
+	"SMSAPIPluginCreate": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"disable_ssl_cert_verification": {Ref: "Checkbox"},
+			"http_proxy": {Ref: "CheckboxOneOf"},
+			"modem_type": {
+				Type: "string",
+				Enum: []string{"trb140"},
+			},
+			"modem_url": {
+				Type: "string",
+				Format: "url",
+			},
+			"plugin_name": {
+				Type: "string",
+				Enum: []string{"asciimail", "cisco_webex_teams", "mkeventd", "mail", "ilert", "jira_issues", "opsgenie_issues", "pagerduty", "pushover", "servicenow", "signl4", "slack", "sms_api", "sms", "spectrum", "victorops", "msteams"},
+			},
+			"timeout": {
+				Type: "string",
+			},
+			"user_password": {Ref: "SMSAPIPasswordSelector"},
+			"username": {
+				Type: "string",
+			},
+		},
+		Required: []string{"disable_ssl_cert_verification", "http_proxy", "modem_type", "modem_url", "plugin_name", "timeout", "user_password", "username"},
+	},
+	"SMSPluginBase": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"params": {
+				Type: "array",
+				Items: &openapi.Schema{
+					Type: "string",
+				},
+			},
+			"plugin_name": {
+				Type: "string",
+				Enum: []string{"asciimail", "cisco_webex_teams", "mkeventd", "mail", "ilert", "jira_issues", "opsgenie_issues", "pagerduty", "pushover", "servicenow", "signl4", "slack", "sms_api", "sms", "spectrum", "victorops", "msteams"},
+			},
+		},
+		Required: []string{"params", "plugin_name"},
+	},
+	"SNMPCommunity": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"community": {
+				Type: "string",
+			},
+			"type": {
+			},
+		},
+		Required: []string{"community"},
+	},
+	"SNMPCredentials": {
+		Type: "object",
+		OneOf: []*openapi.Schema{
+			{Ref: "SNMPCommunity"},
+			{Ref: "SNMPv3NoAuthNoPrivacy"},
+			{Ref: "SNMPv3AuthNoPrivacy"},
+			{Ref: "SNMPv3AuthPrivacy"},
+		},
+		Discriminator: "type",
+		Mapping: map[string]string{
+			"v1_v2_community": "SNMPCommunity",
+			"v3_auth_no_privacy": "SNMPv3AuthNoPrivacy",
+			"v3_auth_privacy": "SNMPv3AuthPrivacy",
+			"v3_no_auth_no_privacy": "SNMPv3NoAuthNoPrivacy",
+		},
+	},
+	"SNMPv3AuthNoPrivacy": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"auth_password": {
+				Type: "string",
+				MinLength: openapi.Int(8),
+			},
+			"auth_protocol": {
+				Type: "string",
+				Enum: []string{"MD5-96", "SHA-1-96", "SHA-2-224", "SHA-2-256", "SHA-2-384", "SHA-2-512"},
+			},
+			"security_name": {
+				Type: "string",
+			},
+			"type": {
+			},
+		},
+		Required: []string{"auth_password", "auth_protocol", "security_name"},
+	},
+	"SNMPv3AuthPrivacy": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"auth_password": {
+				Type: "string",
+				MinLength: openapi.Int(8),
+			},
+			"auth_protocol": {
+				Type: "string",
+				Enum: []string{"MD5-96", "SHA-1-96", "SHA-2-224", "SHA-2-256", "SHA-2-384", "SHA-2-512"},
+			},
+			"privacy_password": {
+				Type: "string",
+				MinLength: openapi.Int(8),
+			},
+			"privacy_protocol": {
+				Type: "string",
+				Enum: []string{"CBC-DES", "AES-128", "3DES-EDE", "AES-192", "AES-256", "AES-192-Blumenthal", "AES-256-Blumenthal"},
+			},
+			"security_name": {
+				Type: "string",
+			},
+			"type": {
+			},
+		},
+		Required: []string{"auth_password", "auth_protocol", "privacy_password", "privacy_protocol", "security_name"},
+	},
+	"SNMPv3NoAuthNoPrivacy": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"security_name": {
+				Type: "string",
+			},
+			"type": {
+			},
+		},
+		Required: []string{"security_name"},
+	},
+	"ServiceConditions": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"host_choice": {Ref: "BIHostChoice"},
+			"host_folder": {
+				Type: "string",
+			},
+			"host_labels": {
+				Type: "object",
+				AdditionalProperties: &openapi.Schema{},
+			},
+			"host_tags": {
+				Type: "object",
+				AdditionalProperties: &openapi.Schema{},
+			},
+			"service_labels": {
+				Type: "object",
+				AdditionalProperties: &openapi.Schema{},
+			},
+			"service_regex": {
+				Type: "string",
+			},
+		},
+		Required: []string{"host_choice", "host_folder", "host_labels", "host_tags", "service_labels", "service_regex"},
+	},
+	"ServiceEventType": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"acknowledgement_of_problem": {
+				Type: "boolean",
+			},
+			"alert_handler_execution_failed": {
+				Type: "boolean",
+			},
+			"alert_handler_execution_successful": {
+				Type: "boolean",
+			},
+			"any_crit": {
+				Type: "boolean",
+			},
+			"any_ok": {
+				Type: "boolean",
+			},
+			"any_unknown": {
+				Type: "boolean",
+			},
+			"any_warn": {
+				Type: "boolean",
+			},
+			"crit_ok": {
+				Type: "boolean",
+			},
+			"crit_unknown": {
+				Type: "boolean",
+			},
+			"crit_warn": {
+				Type: "boolean",
+			},
+			"ok_crit": {
+				Type: "boolean",
+			},
+			"ok_ok": {
+				Type: "boolean",
+			},
+			"ok_unknown": {
+				Type: "boolean",
+			},
+			"ok_warn": {
+				Type: "boolean",
+			},
+			"start_or_end_of_flapping_state": {
+				Type: "boolean",
+			},
+			"start_or_end_of_scheduled_downtime": {
+				Type: "boolean",
+			},
+			"unknown_crit": {
+				Type: "boolean",
+			},
+			"unknown_ok": {
+				Type: "boolean",
+			},
+			"unknown_warn": {
+				Type: "boolean",
+			},
+			"warn_crit": {
+				Type: "boolean",
+			},
+			"warn_ok": {
+				Type: "boolean",
+			},
+			"warn_unknown": {
+				Type: "boolean",
+			},
+		},
+		Required: []string{"acknowledgement_of_problem", "alert_handler_execution_failed", "alert_handler_execution_successful", "any_crit", "any_ok", "any_unknown", "any_warn", "crit_ok", "crit_unknown", "crit_warn", "ok_crit", "ok_ok", "ok_unknown", "ok_warn", "start_or_end_of_flapping_state", "start_or_end_of_scheduled_downtime", "unknown_crit", "unknown_ok", "unknown_warn", "warn_crit", "warn_ok", "warn_unknown"},
+	},
+	"ServiceGroup": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"domainType": {
+			},
+			"extensions": {
+				Type: "object",
+				AdditionalProperties: &openapi.Schema{},
+			},
+			"id": {
+				Type: "string",
+			},
+			"links": {
+				Type: "array",
+				Items: &openapi.Schema{Ref: "Link"},
+			},
+			"members": {
+				Type: "object",
+				AdditionalProperties: &openapi.Schema{},
+			},
+			"title": {
+				Type: "string",
+			},
+		},
+		Required: []string{"links"},
+	},
+	"ServiceGroupCollection": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"domainType": {
+			},
+			"extensions": {
+				Type: "object",
+				AdditionalProperties: &openapi.Schema{},
+			},
+			"id": {
+				Type: "string",
+			},
+			"links": {
+				Type: "array",
+				Items: &openapi.Schema{Ref: "Link"},
+			},
+			"title": {
+				Type: "string",
+			},
+			"value": {
+				Type: "array",
+				Items: &openapi.Schema{Ref: "ServiceGroup"},
+			},
+		},
+		Required: []string{"links"},
+	},
+	"ServiceGroupsRegex": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"match_type": {
+				Type: "string",
+				Enum: []string{"match_id", "match_alias"},
+			},
+			"regex_list": {
+				Type: "array",
+				Items: &openapi.Schema{
+					Type: "string",
+				},
+			},
+		},
+		Required: []string{"match_type", "regex_list"},
+	},
+	"ServiceNowExplicitPassword": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"option": {
+				Type: "string",
+				Enum: []string{"store", "explicit"},
+			},
+			"password": {
+				Type: "string",
+			},
+		},
+		Required: []string{"option", "password"},
+	},
+	"ServiceNowPasswordSelector": {
+		Type: "object",
+		OneOf: []*openapi.Schema{
+			{Ref: "ServiceNowExplicitPassword"},
+			{Ref: "ServiceNowPasswordStoreID"},
+		},
+		Discriminator: "option",
+		Mapping: map[string]string{
+			"explicit": "ServiceNowExplicitPassword",
+			"store": "ServiceNowPasswordStoreID",
+		},
+	},
+	"ServiceNowPasswordStoreID": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"option": {
+				Type: "string",
+				Enum: []string{"store", "explicit"},
+			},
+			"store_id": {
+				Type: "string",
+			},
+		},
+		Required: []string{"option", "store_id"},
+	},
+	"ServiceNowPluginCreate": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"http_proxy": {Ref: "CheckboxOneOf"},
+			"management_type": {Ref: "MgmntTypeSelector"},
+			"optional_timeout": {Ref: "CheckboxOneOf"},
+			"plugin_name": {
+				Type: "string",
+				Enum: []string{"asciimail", "cisco_webex_teams", "mkeventd", "mail", "ilert", "jira_issues", "opsgenie_issues", "pagerduty", "pushover", "servicenow", "signl4", "slack", "sms_api", "sms", "spectrum", "victorops", "msteams"},
+			},
+			"servicenow_url": {
+				Type: "string",
+			},
+			"use_site_id_prefix": {Ref: "CheckboxOneOf"},
+			"user_password": {Ref: "ServiceNowPasswordSelector"},
+			"username": {
+				Type: "string",
+			},
+		},
+		Required: []string{"http_proxy", "optional_timeout", "plugin_name", "servicenow_url", "use_site_id_prefix", "user_password", "username"},
+	},
+	"SignL4ExplicitOrStoreSelector": {
+		Type: "object",
+		OneOf: []*openapi.Schema{
+			{Ref: "SignL4TeamSecret"},
+			{Ref: "SignL4TeamSecretStoreID"},
+		},
+		Discriminator: "option",
+		Mapping: map[string]string{
+			"explicit": "SignL4TeamSecret",
+			"store": "SignL4TeamSecretStoreID",
+		},
+	},
+	"SignL4TeamSecret": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"option": {
+				Type: "string",
+				Enum: []string{"store", "explicit"},
+			},
+			"secret": {
+				Type: "string",
+			},
+		},
+		Required: []string{"option", "secret"},
+	},
+	"SignL4TeamSecretStoreID": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"option": {
+				Type: "string",
+				Enum: []string{"store", "explicit"},
+			},
+			"store_id": {
+				Type: "string",
+			},
+		},
+		Required: []string{"option", "store_id"},
+	},
+	"Signl4PluginCreate": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"disable_ssl_cert_verification": {Ref: "Checkbox"},
+			"http_proxy": {Ref: "CheckboxOneOf"},
+			"plugin_name": {
+				Type: "string",
+				Enum: []string{"asciimail", "cisco_webex_teams", "mkeventd", "mail", "ilert", "jira_issues", "opsgenie_issues", "pagerduty", "pushover", "servicenow", "signl4", "slack", "sms_api", "sms", "spectrum", "victorops", "msteams"},
+			},
+			"team_secret": {Ref: "SignL4ExplicitOrStoreSelector"},
+			"url_prefix_for_links_to_checkmk": {Ref: "CheckboxOneOf"},
+		},
+		Required: []string{"disable_ssl_cert_verification", "http_proxy", "plugin_name", "team_secret", "url_prefix_for_links_to_checkmk"},
+	},
+	"SiteConfigAttributes": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"basic_settings": {Ref: "BasicSettingsAttributes"},
+			"configuration_connection": {Ref: "ConfigurationConnectionAttributes1"},
+			"secret": {
+				Type: "string",
+			},
+			"status_connection": {Ref: "StatusConnectionAttributes1"},
+		},
+	},
+	"SiteConfigAttributesCreate": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"basic_settings": {Ref: "BasicSettingsAttributesCreate"},
+			"configuration_connection": {Ref: "ConfigurationConnectionAttributes"},
+			"secret": {
+				Type: "string",
+			},
+			"status_connection": {Ref: "StatusConnectionAttributes"},
+		},
+		Required: []string{"basic_settings", "configuration_connection", "status_connection"},
+	},
+	"SiteConfigAttributesUpdate": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"basic_settings": {Ref: "BasicSettingsAttributesUpdate"},
+			"configuration_connection": {Ref: "ConfigurationConnectionAttributes"},
+			"secret": {
+				Type: "string",
+			},
+			"status_connection": {Ref: "StatusConnectionAttributes"},
+		},
+		Required: []string{"basic_settings", "configuration_connection", "status_connection"},
+	},
+	"SiteConnectionRequestCreate": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"site_config": {Ref: "SiteConfigAttributesCreate"},
+		},
+		Required: []string{"site_config"},
+	},
+	"SiteConnectionRequestUpdate": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"site_config": {Ref: "SiteConfigAttributesUpdate"},
+		},
+		Required: []string{"site_config"},
+	},
+	"SiteConnectionResponse": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"domainType": {
+			},
+			"extensions": {Ref: "SiteConfigAttributes"},
+			"id": {
+				Type: "string",
+			},
+			"links": {
+				Type: "array",
+				Items: &openapi.Schema{Ref: "Link"},
+			},
+			"members": {
+				Type: "object",
+				AdditionalProperties: &openapi.Schema{},
+			},
+			"title": {
+				Type: "string",
+			},
+		},
+		Required: []string{"links"},
+	},
+	"SiteConnectionResponseCollection": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"domainType": {
+			},
+			"extensions": {
+				Type: "object",
+				AdditionalProperties: &openapi.Schema{},
+			},
+			"id": {
+				Type: "string",
+			},
+			"links": {
+				Type: "array",
+				Items: &openapi.Schema{Ref: "Link"},
+			},
+			"title": {
+				Type: "string",
+			},
+			"value": {
+				Type: "array",
+				Items: &openapi.Schema{Ref: "SiteConnectionResponse"},
+			},
+		},
+		Required: []string{"links"},
+	},
+	"SiteLoginRequest": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"password": {
+				Type: "string",
+			},
+			"username": {
+				Type: "string",
+			},
+		},
+		Required: []string{"password", "username"},
+	},
+	"SlackPluginCreate": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"disable_ssl_cert_verification": {Ref: "Checkbox"},
+			"http_proxy": {Ref: "CheckboxOneOf"},
+			"plugin_name": {
+				Type: "string",
+				Enum: []string{"asciimail", "cisco_webex_teams", "mkeventd", "mail", "ilert", "jira_issues", "opsgenie_issues", "pagerduty", "pushover", "servicenow", "signl4", "slack", "sms_api", "sms", "spectrum", "victorops", "msteams"},
+			},
+			"url_prefix_for_links_to_checkmk": {Ref: "CheckboxOneOf"},
+			"webhook_url": {Ref: "SlackStoreOrExplicitURLSelector"},
+		},
+		Required: []string{"disable_ssl_cert_verification", "http_proxy", "plugin_name", "url_prefix_for_links_to_checkmk", "webhook_url"},
+	},
+	"SlackStoreOrExplicitURLSelector": {
+		Type: "object",
+		OneOf: []*openapi.Schema{
+			{Ref: "SlackWebhookURL"},
+			{Ref: "SlackWebhookStore"},
+		},
+		Discriminator: "option",
+		Mapping: map[string]string{
+			"explicit": "SlackWebhookURL",
+			"store": "SlackWebhookStore",
+		},
+	},
+	"SlackWebhookStore": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"option": {
+				Type: "string",
+				Enum: []string{"store", "explicit"},
+			},
+			"store_id": {
+				Type: "string",
+			},
+		},
+		Required: []string{"option", "store_id"},
+	},
+	"SlackWebhookURL": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"option": {
+				Type: "string",
+				Enum: []string{"store", "explicit"},
+			},
+			"url": {
+				Type: "string",
+			},
+		},
+		Required: []string{"option", "url"},
+	},
+	"SocketAttributes": {
+		Type: "object",
+		OneOf: []*openapi.Schema{
+			{Ref: "SocketIP4"},
+			{Ref: "SocketIP6"},
+			{Ref: "SocketUnixAttributes"},
+			{Ref: "SocketType"},
+		},
+		Discriminator: "socket_type",
+		Mapping: map[string]string{
+			"local": "SocketType",
+			"tcp": "SocketIP4",
+			"tcp6": "SocketIP6",
+			"unix": "SocketUnixAttributes",
+		},
+	},
+	"SocketAttributes1": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"encrypted": {
+				Type: "boolean",
+			},
+			"host": {
+				Type: "string",
+			},
+			"path": {
+				Type: "string",
+			},
+			"port": {
+				Type: "integer",
+			},
+			"socket_type": {
+				Type: "string",
+			},
+			"verify": {
+				Type: "boolean",
+			},
+		},
+		Required: []string{"socket_type"},
+	},
+	"SocketIP4": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"encrypted": {
+				Type: "boolean",
+			},
+			"host": {
+				Type: "string",
+			},
+			"port": {
+				Type: "integer",
+				Minimum: openapi.Float(1),
+				Maximum: openapi.Float(65535),
+			},
+			"socket_type": {
+				Type: "string",
+				Enum: []string{"tcp", "tcp6", "unix", "local"},
+			},
+			"verify": {
+				Type: "boolean",
+			},
+		},
+		Required: []string{"encrypted", "host", "port", "socket_type"},
+	},
+	"SocketIP6": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"encrypted": {
+				Type: "boolean",
+			},
+			"host": {
+				Type: "string",
+			},
+			"port": {
+				Type: "integer",
+				Minimum: openapi.Float(1),
+				Maximum: openapi.Float(65535),
+			},
+			"socket_type": {
+				Type: "string",
+				Enum: []string{"tcp", "tcp6", "unix", "local"},
+			},
+			"verify": {
+				Type: "boolean",
+			},
+		},
+		Required: []string{"encrypted", "host", "port", "socket_type"},
+	},
+	"SocketType": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"socket_type": {
+				Type: "string",
+				Enum: []string{"tcp", "tcp6", "unix", "local"},
+			},
+		},
+		Required: []string{"socket_type"},
+	},
+	"SocketUnixAttributes": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"path": {
+				Type: "string",
+			},
+			"socket_type": {
+				Type: "string",
+				Enum: []string{"tcp", "tcp6", "unix", "local"},
+			},
+		},
+		Required: []string{"path", "socket_type"},
+	},
+	"SpectrumPluginBase": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"base_oid": {
+				Type: "string",
+			},
+			"destination_ip": {
+				Type: "string",
+			},
+			"plugin_name": {
+				Type: "string",
+				Enum: []string{"asciimail", "cisco_webex_teams", "mkeventd", "mail", "ilert", "jira_issues", "opsgenie_issues", "pagerduty", "pushover", "servicenow", "signl4", "slack", "sms_api", "sms", "spectrum", "victorops", "msteams"},
+			},
+			"snmp_community": {
+				Type: "string",
+			},
+		},
+		Required: []string{"base_oid", "destination_ip", "plugin_name", "snmp_community"},
+	},
+	"SplunkRESTEndpointSelector": {
+		Type: "object",
+		OneOf: []*openapi.Schema{
+			{Ref: "SplunkURLExplicit"},
+			{Ref: "SplunkStoreID"},
+		},
+		Discriminator: "option",
+		Mapping: map[string]string{
+			"explicit": "SplunkURLExplicit",
+			"store": "SplunkStoreID",
+		},
+	},
+	"SplunkStoreID": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"option": {
+				Type: "string",
+				Enum: []string{"store", "explicit"},
+			},
+			"store_id": {
+				Type: "string",
+			},
+		},
+		Required: []string{"option", "store_id"},
+	},
+	"SplunkURLExplicit": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"option": {
+				Type: "string",
+				Enum: []string{"store", "explicit"},
+			},
+			"url": {
+				Type: "string",
+				Format: "url",
+			},
+		},
+		Required: []string{"option", "url"},
+	},
+	"StatusConnectionAttributes": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"connect_timeout": {
+				Type: "integer",
+			},
+			"connection": {Ref: "SocketAttributes"},
+			"disable_in_status_gui": {
+				Type: "boolean",
+			},
+			"persistent_connection": {
+				Type: "boolean",
+			},
+			"proxy": {Ref: "ProxyOrDirect"},
+			"status_host": {Ref: "StatusHostSet"},
+			"url_prefix": {
+				Type: "string",
+			},
+		},
+		Required: []string{"connect_timeout", "connection", "proxy", "status_host"},
+	},
+	"StatusConnectionAttributes1": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"connect_timeout": {
+				Type: "integer",
+			},
+			"connection": {Ref: "SocketAttributes1"},
+			"disable_in_status_gui": {
+				Type: "boolean",
+			},
+			"persistent_connection": {
+				Type: "boolean",
+			},
+			"proxy": {Ref: "ProxyAttributes1"},
+			"status_host": {Ref: "StatusHostAttributes"},
+			"url_prefix": {
+				Type: "string",
+			},
+		},
+		Required: []string{"connect_timeout", "connection", "proxy"},
+	},
+	"StatusHostAttributes": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"host": {
+				Type: "string",
+			},
+			"site": {
+				Type: "string",
+			},
+			"status_host_set": {
+				Type: "string",
+			},
+		},
+		Required: []string{"status_host_set"},
+	},
+	"StatusHostAttributesBase": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"status_host_set": {
+				Type: "string",
+				Enum: []string{"enabled", "disabled"},
+			},
+		},
+		Required: []string{"status_host_set"},
+	},
+	"StatusHostAttributesSet": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"host": {
+				Type: "string",
+				Pattern: "^[-0-9a-zA-Z_.]+\\Z",
+			},
+			"site": {
+				Type: "string",
+			},
+			"status_host_set": {
+				Type: "string",
+				Enum: []string{"enabled", "disabled"},
+			},
+		},
+		Required: []string{"host", "site", "status_host_set"},
+	},
+	"StatusHostSet": {
+		Type: "object",
+		OneOf: []*openapi.Schema{
+			{Ref: "StatusHostAttributesSet"},
+			{Ref: "StatusHostAttributesBase"},
+		},
+		Discriminator: "status_host_set",
+		Mapping: map[string]string{
+			"disabled": "StatusHostAttributesBase",
+			"enabled": "StatusHostAttributesSet",
+		},
+	},
+	"SysLogToFromPriorities": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"from_priority": {
+				Type: "string",
+				Enum: []string{"emerg", "alert", "crit", "err", "warning", "notice", "info", "debug"},
+			},
+			"to_priority": {
+				Type: "string",
+				Enum: []string{"emerg", "alert", "crit", "err", "warning", "notice", "info", "debug"},
+			},
+		},
+		Required: []string{"from_priority", "to_priority"},
+	},
+	"TagCondition": {
+		Type: "object",
+		OneOf: []*openapi.Schema{
+			{Ref: "TagConditionScalarSchemaBase"},
+			{Ref: "TagConditionScalarSchemaBase"},
+			{Ref: "TagConditionConditionSchemaBase"},
+			{Ref: "TagConditionConditionSchemaBase"},
+		},
+		Discriminator: "operator",
+		Mapping: map[string]string{
+			"is": "TagConditionScalarSchemaBase",
+			"is_not": "TagConditionScalarSchemaBase",
+			"none_of": "TagConditionConditionSchemaBase",
+			"one_of": "TagConditionConditionSchemaBase",
+		},
+	},
+	"TagConditionConditionSchemaBase": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"key": {
+				Type: "string",
+			},
+			"operator": {
+				Type: "string",
+				Enum: []string{"one_of", "none_of"},
+			},
+			"value": {
+				Type: "array",
+				Items: &openapi.Schema{
+					Type: "string",
+				},
+			},
+		},
+	},
+	"TagConditionScalarSchemaBase": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"key": {
+				Type: "string",
+			},
+			"operator": {
+				Type: "string",
+				Enum: []string{"is", "is_not"},
+			},
+			"value": {
+				Type: "string",
+			},
+		},
+	},
+	"TagGroupAttributes": {
+		Type: "object",
+		AdditionalProperties: &openapi.Schema{
+			Type: "string",
+		},
+	},
+	"ThrottlePeriodicNotifications": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"beginning_from": {
+				Type: "integer",
+			},
+			"send_every_nth_notification": {
+				Type: "integer",
+			},
+		},
+		Required: []string{"beginning_from", "send_every_nth_notification"},
+	},
+	"TimeAllowedRange": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"end": {
+				Type: "string",
+				Pattern: "^\\d\\d\\:\\d\\d\\(:\\d\\d)?$",
+			},
+			"start": {
+				Type: "string",
+				Pattern: "^\\d\\d\\:\\d\\d\\(:\\d\\d)?$",
+			},
+		},
+		Required: []string{"end", "start"},
+	},
+	"TimePeriodAttrsResponse": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"active_time_ranges": {
+				Type: "array",
+				Items: &openapi.Schema{Ref: "ConcreteTimeRangeActive"},
+			},
+			"alias": {
+				Type: "string",
+			},
+			"exceptions": {
+				Type: "array",
+				Items: &openapi.Schema{Ref: "ConcreteTimePeriodException"},
+			},
+			"exclude": {
+				Type: "array",
+				Items: &openapi.Schema{
+					Type: "string",
+				},
+			},
+		},
+	},
+	"TimePeriodException": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"date": {
+				Type: "string",
+				Format: "date",
+			},
+			"time_ranges": {
+				Type: "array",
+				Items: &openapi.Schema{Ref: "TimeRange1"},
+			},
+		},
+		Required: []string{"date"},
+	},
+	"TimePeriodResponse": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"domainType": {
+			},
+			"extensions": {Ref: "TimePeriodAttrsResponse"},
+			"id": {
+				Type: "string",
+			},
+			"links": {
+				Type: "array",
+				Items: &openapi.Schema{Ref: "Link"},
+			},
+			"members": {
+				Type: "object",
+				AdditionalProperties: &openapi.Schema{},
+			},
+			"title": {
+				Type: "string",
+			},
+		},
+		Required: []string{"links"},
+	},
+	"TimePeriodResponseCollection": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"domainType": {
+			},
+			"extensions": {
+				Type: "object",
+				AdditionalProperties: &openapi.Schema{},
+			},
+			"id": {
+				Type: "string",
+			},
+			"links": {
+				Type: "array",
+				Items: &openapi.Schema{Ref: "Link"},
+			},
+			"title": {
+				Type: "string",
+			},
+			"value": {
+				Type: "array",
+				Items: &openapi.Schema{Ref: "TimePeriodResponse"},
+			},
+		},
+		Required: []string{"links"},
+	},
+	"TimeRange": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"end": {
+				Type: "string",
+				Format: "date-time",
+			},
+			"start": {
+				Type: "string",
+				Format: "date-time",
+			},
+		},
+		Required: []string{"end", "start"},
+	},
+	"TimeRange1": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"end": {
+				Type: "string",
+				Format: "time",
+			},
+			"start": {
+				Type: "string",
+				Format: "time",
+			},
+		},
+		Required: []string{"end", "start"},
+	},
+	"TimeRangeActive": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"day": {
+				Type: "string",
+				Enum: []string{"all", "monday", "tuesday", "wednesday", "thursday", "friday", "saturday", "sunday"},
+			},
+			"time_ranges": {
+				Type: "array",
+				Items: &openapi.Schema{Ref: "TimeRange1"},
+			},
+		},
+	},
+	"TranslateNames": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"convert_case": {
+				Type: "string",
+				Enum: []string{"nop", "lower", "upper"},
+			},
+			"drop_domain": {
+				Type: "boolean",
+			},
+			"hostname_replacement": {
+				Type: "array",
+				Items: &openapi.Schema{Ref: "DirectMapping"},
+			},
+			"regexp_rewrites": {
+				Type: "array",
+				Items: &openapi.Schema{Ref: "RegexpRewrites"},
+			},
+		},
+	},
+	"UpdateAndAcknowledeEventSiteIDRequired": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"change_comment": {
+				Type: "string",
+			},
+			"change_contact": {
+				Type: "string",
+			},
+			"phase": {
+				Type: "string",
+				Enum: []string{"ack", "open"},
+			},
+			"site_id": {
+				Type: "string",
+			},
+		},
+		Required: []string{"site_id"},
+	},
+	"UpdateAndAcknowledgeFilter": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"change_comment": {
+				Type: "string",
+			},
+			"change_contact": {
+				Type: "string",
+			},
+			"filter_type": {
+				Type: "string",
+				Enum: []string{"query", "params", "all"},
+			},
+			"phase": {
+				Type: "string",
+				Enum: []string{"ack", "open"},
+			},
+			"site_id": {
+				Type: "string",
+			},
+		},
+		Required: []string{"filter_type"},
+	},
+	"UpdateAndAcknowledgeSelector": {
+		Type: "object",
+		OneOf: []*openapi.Schema{
+			{Ref: "UpdateAndAcknowledgeWithQuery"},
+			{Ref: "UpdateAndAcknowledgeWithParams"},
+			{Ref: "UpdateAndAcknowledgeFilter"},
+		},
+		Discriminator: "filter_type",
+		Mapping: map[string]string{
+			"all": "UpdateAndAcknowledgeFilter",
+			"params": "UpdateAndAcknowledgeWithParams",
+			"query": "UpdateAndAcknowledgeWithQuery",
+		},
+	},
+	"UpdateAndAcknowledgeWithParams": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"change_comment": {
+				Type: "string",
+			},
+			"change_contact": {
+				Type: "string",
+			},
+			"filter_type": {
+				Type: "string",
+				Enum: []string{"query", "params", "all"},
+			},
+			"filters": {Ref: "FilterParamsUpdateAndAcknowledge"},
+			"phase": {
+				Type: "string",
+				Enum: []string{"ack", "open"},
+			},
+			"site_id": {
+				Type: "string",
+			},
+		},
+		Required: []string{"filter_type", "filters"},
+	},
+	"UpdateAndAcknowledgeWithQuery": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"change_comment": {
+				Type: "string",
+			},
+			"change_contact": {
+				Type: "string",
+			},
+			"filter_type": {
+				Type: "string",
+				Enum: []string{"query", "params", "all"},
+			},
+			"phase": {
+				Type: "string",
+				Enum: []string{"ack", "open"},
+			},
+			"query": {Ref: "Expr"},
+			"site_id": {
+				Type: "string",
+			},
+		},
+		Required: []string{"filter_type", "query"},
+	},
+	"UpdateContactGroup": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"attributes": {Ref: "UpdateGroup"},
+			"name": {
+				Type: "string",
+			},
+		},
+		Required: []string{"name"},
+	},
+	"UpdateDiscoveryPhase": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"check_type": {
+				Type: "string",
+			},
+			"service_item": {
+				Type: "string",
+				Nullable: true,
+			},
+			"target_phase": {
+				Type: "string",
+				Enum: []string{"active", "active_ignored", "clustered_ignored", "clustered_monitored", "clustered_undecided", "clustered_vanished", "custom", "custom_ignored", "ignored", "legacy", "legacy_ignored", "manual", "monitored", "removed", "undecided", "vanished"},
+			},
+		},
+		Required: []string{"check_type", "service_item", "target_phase"},
+	},
+	"UpdateFolder": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"attributes": {
+				AnyOf: []*openapi.Schema{
+					{Ref: "FolderUpdateAttribute"},
+					{Ref: "CustomHostAttributes"},
+					{Ref: "TagGroupAttributes"},
+				},
+			},
+			"remove_attributes": {
+				Type: "array",
+				Items: &openapi.Schema{
+					Type: "string",
+				},
+			},
+			"title": {
+				Type: "string",
+			},
+			"update_attributes": {
+				AnyOf: []*openapi.Schema{
+					{Ref: "FolderUpdateAttribute"},
+					{Ref: "CustomHostAttributes"},
+					{Ref: "TagGroupAttributes"},
+				},
+			},
+		},
+	},
+	"UpdateFolderEntry": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"attributes": {
+				AnyOf: []*openapi.Schema{
+					{Ref: "FolderUpdateAttribute"},
+					{Ref: "CustomHostAttributes"},
+					{Ref: "TagGroupAttributes"},
+				},
+			},
+			"folder": {
+				Type: "string",
+				Pattern: "^(?:(?:[~\\\\\\/]|(?:[~\\\\\\/][-_ a-zA-Z0-9.]+)+[~\\\\\\/]?)|[0-9a-fA-F]{32})$",
+			},
+			"remove_attributes": {
+				Type: "array",
+				Items: &openapi.Schema{
+					Type: "string",
+				},
+			},
+			"title": {
+				Type: "string",
+			},
+			"update_attributes": {
+				AnyOf: []*openapi.Schema{
+					{Ref: "FolderUpdateAttribute"},
+					{Ref: "CustomHostAttributes"},
+					{Ref: "TagGroupAttributes"},
+				},
+			},
+		},
+		Required: []string{"folder"},
+	},
+	"UpdateGroup": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"alias": {
+				Type: "string",
+			},
+		},
+		Required: []string{"alias"},
+	},
+	"UpdateGroup1": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"alias": {
+				Type: "string",
+			},
+		},
+		Required: []string{"alias"},
+	},
+	"UpdateGroup2": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"alias": {
+				Type: "string",
+			},
+		},
+		Required: []string{"alias"},
+	},
+	"UpdateHost": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"attributes": {
+				AnyOf: []*openapi.Schema{
+					{Ref: "HostUpdateAttribute"},
+					{Ref: "CustomHostAttributes"},
+					{Ref: "TagGroupAttributes"},
+				},
+			},
+			"remove_attributes": {
+				Type: "array",
+				Items: &openapi.Schema{
+					Type: "string",
+				},
+			},
+			"update_attributes": {
+				AnyOf: []*openapi.Schema{
+					{Ref: "HostUpdateAttribute"},
+					{Ref: "CustomHostAttributes"},
+					{Ref: "TagGroupAttributes"},
+				},
+			},
+		},
+	},
+	"UpdateHostEntry": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"attributes": {
+				AnyOf: []*openapi.Schema{
+					{Ref: "HostUpdateAttribute"},
+					{Ref: "CustomHostAttributes"},
+					{Ref: "TagGroupAttributes"},
+				},
+			},
+			"host_name": {
+				Type: "string",
+				Pattern: "^[-0-9a-zA-Z_.]+\\Z",
+			},
+			"remove_attributes": {
+				Type: "array",
+				Items: &openapi.Schema{
+					Type: "string",
+				},
+			},
+			"update_attributes": {
+				AnyOf: []*openapi.Schema{
+					{Ref: "HostUpdateAttribute"},
+					{Ref: "CustomHostAttributes"},
+					{Ref: "TagGroupAttributes"},
+				},
+			},
+		},
+		Required: []string{"host_name"},
+	},
+	"UpdateHostGroup": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"attributes": {Ref: "UpdateGroup1"},
+			"name": {
+				Type: "string",
+			},
+		},
+		Required: []string{"name"},
+	},
+	"UpdateHostTagGroup": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"help": {
+				Type: "string",
+			},
+			"repair": {
+				Type: "boolean",
+			},
+			"tags": {
+				Type: "array",
+				Items: &openapi.Schema{Ref: "HostTag"},
+				MinLength: openapi.Int(1),
+			},
+			"title": {
+				Type: "string",
+			},
+			"topic": {
+				Type: "string",
+			},
+		},
+	},
+	"UpdateNodes": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"nodes": {
+				Type: "array",
+				Items: &openapi.Schema{
+					Type: "string",
+					Pattern: "^[-0-9a-zA-Z_.]+\\Z",
+				},
+			},
+		},
+		Required: []string{"nodes"},
+	},
+	"UpdatePassword": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"comment": {
+				Type: "string",
+			},
+			"documentation_url": {
+				Type: "string",
+			},
+			"owner": {
+				Type: "string",
+			},
+			"password": {
+				Type: "string",
+				MinLength: openapi.Int(1),
+			},
+			"shared": {
+				Type: "array",
+				Items: &openapi.Schema{
+					Type: "string",
+				},
+			},
+			"title": {
+				Type: "string",
+			},
+		},
+	},
+	"UpdateRuleObject": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"conditions": {Ref: "RuleConditions1"},
+			"properties": {Ref: "RuleProperties1"},
+			"value_raw": {
+				Type: "string",
+			},
+		},
+	},
+	"UpdateServiceGroup": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"attributes": {Ref: "UpdateGroup2"},
+			"name": {
+				Type: "string",
+			},
+		},
+		Required: []string{"name"},
+	},
+	"UpdateTimePeriod": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"active_time_ranges": {
+				Type: "array",
+				Items: &openapi.Schema{Ref: "TimeRangeActive"},
+			},
+			"alias": {
+				Type: "string",
+			},
+			"exceptions": {
+				Type: "array",
+				Items: &openapi.Schema{Ref: "TimePeriodException"},
+			},
+			"exclude": {
+				Type: "array",
+				Items: &openapi.Schema{
+					Type: "string",
+					Pattern: "^[-a-z0-9A-Z_]+\\Z",
+				},
+			},
+		},
+	},
+	"UpdateUser": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"auth_option": {Ref: "AuthUpdateOption"},
+			"authorized_sites": {
+				Type: "array",
+				Items: &openapi.Schema{
+					Type: "string",
+				},
+			},
+			"contact_options": {Ref: "UserContactOption"},
+			"contactgroups": {
+				Type: "array",
+				Items: &openapi.Schema{
+					Type: "string",
+				},
+			},
+			"disable_login": {
+				Type: "boolean",
+			},
+			"disable_notifications": {Ref: "DisabledNotifications"},
+			"fullname": {
+				Type: "string",
+			},
+			"idle_timeout": {Ref: "IdleOption"},
+			"interface_options": {Ref: "UserInterfaceUpdateAttributes"},
+			"language": {
+				Type: "string",
+				Enum: []string{"de", "en", "ro"},
+			},
+			"pager_address": {
+				Type: "string",
+			},
+			"roles": {
+				Type: "array",
+				Items: &openapi.Schema{
+					Type: "string",
+				},
+			},
+			"temperature_unit": {
+				Type: "string",
+				Enum: []string{"default", "celsius", "fahrenheit"},
+			},
+		},
+		AdditionalProperties: &openapi.Schema{},
+	},
+	"UseLiveStatusDaemon": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"use_livestatus_daemon": {
+				Type: "string",
+				Enum: []string{"direct", "with_proxy"},
+			},
+		},
+		Required: []string{"use_livestatus_daemon"},
+	},
+	"UserCollection": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"domainType": {
+			},
+			"extensions": {
+				Type: "object",
+				AdditionalProperties: &openapi.Schema{},
+			},
+			"id": {
+				Type: "string",
+			},
+			"links": {
+				Type: "array",
+				Items: &openapi.Schema{Ref: "Link"},
+			},
+			"title": {
+				Type: "string",
+			},
+			"value": {
+				Type: "array",
+				Items: &openapi.Schema{Ref: "UserObject"},
+			},
+		},
+		Required: []string{"links"},
+	},
+	"UserContactOption": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"email": {
+				Type: "string",
+			},
+			"fallback_contact": {
+				Type: "boolean",
+			},
+		},
+		Required: []string{"email"},
+	},
+	"UserIdleOption": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"duration": {
+				Type: "integer",
+			},
+			"option": {
+				Type: "string",
+				Enum: []string{"global", "disable", "individual"},
+			},
+		},
+		Required: []string{"option"},
+	},
+	"UserInterfaceAttributes": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"interface_theme": {
+				Type: "string",
+				Enum: []string{"default", "dark", "light"},
+			},
+			"mega_menu_icons": {
+				Type: "string",
+				Enum: []string{"topic", "entry"},
+			},
+			"navigation_bar_icons": {
+				Type: "string",
+				Enum: []string{"hide", "show"},
+			},
+			"show_mode": {
+				Type: "string",
+				Enum: []string{"default", "default_show_less", "default_show_more", "enforce_show_more"},
+			},
+			"sidebar_position": {
+				Type: "string",
+				Enum: []string{"left", "right"},
+			},
+		},
+	},
+	"UserInterfaceUpdateAttributes": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"interface_theme": {
+				Type: "string",
+				Enum: []string{"default", "dark", "light"},
+			},
+			"mega_menu_icons": {
+				Type: "string",
+				Enum: []string{"topic", "entry"},
+			},
+			"navigation_bar_icons": {
+				Type: "string",
+				Enum: []string{"hide", "show"},
+			},
+			"show_mode": {
+				Type: "string",
+				Enum: []string{"default", "default_show_less", "default_show_more", "enforce_show_more"},
+			},
+			"sidebar_position": {
+				Type: "string",
+				Enum: []string{"left", "right"},
+			},
+		},
+	},
+	"UserObject": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"domainType": {
+			},
+			"extensions": {
+				AnyOf: []*openapi.Schema{
+					{Ref: "BaseUserAttributes"},
+					{Ref: "CustomUserAttributes"},
+				},
+			},
+			"id": {
+				Type: "string",
+			},
+			"links": {
+				Type: "array",
+				Items: &openapi.Schema{Ref: "Link"},
+			},
+			"members": {
+				Type: "object",
+				AdditionalProperties: &openapi.Schema{},
+			},
+			"title": {
+				Type: "string",
+			},
+		},
+		Required: []string{"links"},
+	},
+	"UserRoleAttributes": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"alias": {
+				Type: "string",
+			},
+			"basedon": {
+				Type: "string",
+				Enum: []string{"user", "admin", "guest", "agent_registration"},
+			},
+			"builtin": {
+				Type: "boolean",
+			},
+			"permissions": {
+				Type: "array",
+				Items: &openapi.Schema{
+					Type: "string",
+				},
+			},
+		},
+		Required: []string{"alias", "builtin", "permissions"},
+	},
+	"UserRoleCollection": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"domainType": {
+			},
+			"extensions": {
+				Type: "object",
+				AdditionalProperties: &openapi.Schema{},
+			},
+			"id": {
+				Type: "string",
+			},
+			"links": {
+				Type: "array",
+				Items: &openapi.Schema{Ref: "Link"},
+			},
+			"title": {
+				Type: "string",
+			},
+			"value": {
+				Type: "array",
+				Items: &openapi.Schema{Ref: "UserRoleObject"},
+			},
+		},
+		Required: []string{"links"},
+	},
+	"UserRoleObject": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"domainType": {
+			},
+			"extensions": {Ref: "UserRoleAttributes"},
+			"id": {
+				Type: "string",
+			},
+			"links": {
+				Type: "array",
+				Items: &openapi.Schema{Ref: "Link"},
+			},
+			"members": {
+				Type: "object",
+				AdditionalProperties: &openapi.Schema{},
+			},
+			"title": {
+				Type: "string",
+			},
+		},
+		Required: []string{"links"},
+	},
+	"UserSyncAttributes": {
+		Type: "object",
+		OneOf: []*openapi.Schema{
+			{Ref: "UserSyncWithLdapConnection"},
+			{Ref: "UserSyncBase"},
+			{Ref: "UserSyncBase"},
+		},
+		Discriminator: "sync_with_ldap_connections",
+		Mapping: map[string]string{
+			"all": "UserSyncBase",
+			"disabled": "UserSyncBase",
+			"ldap": "UserSyncWithLdapConnection",
+		},
+	},
+	"UserSyncAttributes1": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"ldap_connections": {
+				Type: "array",
+				Items: &openapi.Schema{
+					Type: "string",
+				},
+			},
+			"sync_with_ldap_connections": {
+				Type: "string",
+			},
+		},
+		Required: []string{"sync_with_ldap_connections"},
+	},
+	"UserSyncBase": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"sync_with_ldap_connections": {
+				Type: "string",
+				Enum: []string{"ldap", "all", "disabled"},
+			},
+		},
+		Required: []string{"sync_with_ldap_connections"},
+	},
+	"UserSyncWithLdapConnection": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"ldap_connections": {
+				Type: "array",
+				Items: &openapi.Schema{
+					Type: "string",
+				},
+			},
+			"sync_with_ldap_connections": {
+				Type: "string",
+				Enum: []string{"ldap", "all", "disabled"},
+			},
+		},
+		Required: []string{"ldap_connections", "sync_with_ldap_connections"},
+	},
+	"VictoropsPluginCreate": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"disable_ssl_cert_verification": {Ref: "Checkbox"},
+			"http_proxy": {Ref: "CheckboxOneOf"},
+			"plugin_name": {
+				Type: "string",
+				Enum: []string{"asciimail", "cisco_webex_teams", "mkeventd", "mail", "ilert", "jira_issues", "opsgenie_issues", "pagerduty", "pushover", "servicenow", "signl4", "slack", "sms_api", "sms", "spectrum", "victorops", "msteams"},
+			},
+			"splunk_on_call_rest_endpoint": {Ref: "SplunkRESTEndpointSelector"},
+			"url_prefix_for_links_to_checkmk": {Ref: "CheckboxOneOf"},
+		},
+		Required: []string{"disable_ssl_cert_verification", "http_proxy", "plugin_name", "splunk_on_call_rest_endpoint", "url_prefix_for_links_to_checkmk"},
+	},
+	"WhenToBulk": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"params": {Ref: "NotificationBulking"},
+			"when_to_bulk": {
+				Type: "string",
+				Enum: []string{"always", "timeperiod"},
+			},
+		},
+		Required: []string{"params", "when_to_bulk"},
+	},
+	"X509PEM": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"cert": {
+				Type: "string",
+			},
+		},
+		Required: []string{"cert"},
+	},
+	"X509ReqPEMUUID": {
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"csr": {
+				Type: "string",
+			},
+		},
+		Required: []string{"csr"},
+	},
+}
+
+// GetSchema returns the definition of a component schema.
+// Returns nil if not found.
+func GetSchema(schemaName string) *openapi.Schema {
+	return Schemas[schemaName]
+}
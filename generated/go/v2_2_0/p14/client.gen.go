@@ -0,0 +1,1715 @@
+//go:build checkmk_all || checkmk_v2_2_0
+
+// Code generated by openapi-gen from CheckMK 1.0. DO NOT EDIT.
+//
+// Typed API client methods, one per operation
+//
+// Source: client.gen.go
+// Schemas: All (unfiltered)
+
+package p14
+
+import (
+	"context"
+
+	"github.com/BlackMesaLTD/checkmk-api-spec/generated/go/client"
+)
+
+// Client calls the CheckMK REST API with the types of this package.
+type Client struct {
+	*client.Client
+}
+
+// NewClient wraps a transport client with the operations of this baseline.
+func NewClient(c *client.Client) *Client {
+	return &Client{Client: c}
+}
+
+// CertAgentControllerCertificatesSettings calls the "Show agent controller certificate settings" operation.
+func (c *Client) CertAgentControllerCertificatesSettings(ctx context.Context) (*AgentControllerCertificateSettings, *client.Response, error) {
+	out := new(AgentControllerCertificateSettings)
+	resp, err := c.Do(ctx, CertAgentControllerCertificatesSettingsOperation, nil, nil, out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return out, resp, nil
+}
+
+// CertMakeCertificate calls the "X.509 PEM-encoded Certificate Signing Requests (CSRs)" operation.
+func (c *Client) CertMakeCertificate(ctx context.Context, body *X509ReqPEMUUID) (*X509PEM, *client.Response, error) {
+	out := new(X509PEM)
+	resp, err := c.Do(ctx, CertMakeCertificateOperation, nil, body, out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return out, resp, nil
+}
+
+// AcknowledgementSetAcknowledgementOnHosts calls the "Set acknowledgement on related hosts" operation.
+func (c *Client) AcknowledgementSetAcknowledgementOnHosts(ctx context.Context, body *AcknowledgeHostRelatedProblem) (*client.Response, error) {
+	return c.Do(ctx, AcknowledgementSetAcknowledgementOnHostsOperation, nil, body, nil)
+}
+
+// AcknowledgementSetAcknowledgementOnServices calls the "Set acknowledgement on related services" operation.
+func (c *Client) AcknowledgementSetAcknowledgementOnServices(ctx context.Context, body *AcknowledgeServiceRelatedProblem) (*client.Response, error) {
+	return c.Do(ctx, AcknowledgementSetAcknowledgementOnServicesOperation, nil, body, nil)
+}
+
+// ActivateChangesActivateChanges calls the "Activate pending changes" operation.
+func (c *Client) ActivateChangesActivateChanges(ctx context.Context, params *ActivateChangesActivateChangesParams, body *ActivateChanges) (*ActivationRunResponse, *client.Response, error) {
+	out := new(ActivationRunResponse)
+	resp, err := c.Do(ctx, ActivateChangesActivateChangesOperation, params, body, out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return out, resp, nil
+}
+
+// ActivateChangesListPendingChanges calls the "Show all pending changes" operation.
+func (c *Client) ActivateChangesListPendingChanges(ctx context.Context) (*PendingChangesCollection, *client.Response, error) {
+	out := new(PendingChangesCollection)
+	resp, err := c.Do(ctx, ActivateChangesListPendingChangesOperation, nil, nil, out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return out, resp, nil
+}
+
+// ActivateChangesListActivations calls the "Show all currently running activations" operation.
+func (c *Client) ActivateChangesListActivations(ctx context.Context) (*ActivationRunCollection, *client.Response, error) {
+	out := new(ActivationRunCollection)
+	resp, err := c.Do(ctx, ActivateChangesListActivationsOperation, nil, nil, out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return out, resp, nil
+}
+
+// AgentDownloadAgent calls the "Download agents shipped with Checkmk" operation.
+func (c *Client) AgentDownloadAgent(ctx context.Context, params *AgentDownloadAgentParams) (*client.Response, error) {
+	return c.Do(ctx, AgentDownloadAgentOperation, params, nil, nil)
+}
+
+// AuxTagsShowAuxTags calls the "Show Auxiliary Tags" operation.
+func (c *Client) AuxTagsShowAuxTags(ctx context.Context) (*AuxTagResponseCollection, *client.Response, error) {
+	out := new(AuxTagResponseCollection)
+	resp, err := c.Do(ctx, AuxTagsShowAuxTagsOperation, nil, nil, out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return out, resp, nil
+}
+
+// AuxTagsCreateAuxTag calls the "Create an Auxiliary Tag" operation.
+func (c *Client) AuxTagsCreateAuxTag(ctx context.Context, body *AuxTagAttrsCreate) (*AuxTagResponse, *client.Response, error) {
+	out := new(AuxTagResponse)
+	resp, err := c.Do(ctx, AuxTagsCreateAuxTagOperation, nil, body, out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return out, resp, nil
+}
+
+// BiGetBiAggregationState calls the "Get the state of BI aggregations" operation.
+func (c *Client) BiGetBiAggregationState(ctx context.Context, body *BIAggregationStateRequest) (*BIAggregationStateResponse, *client.Response, error) {
+	out := new(BIAggregationStateResponse)
+	resp, err := c.Do(ctx, BiGetBiAggregationStateOperation, nil, body, out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return out, resp, nil
+}
+
+// BiGetBiPacks calls the "Show all BI packs" operation.
+func (c *Client) BiGetBiPacks(ctx context.Context) (*DomainObjectCollection, *client.Response, error) {
+	out := new(DomainObjectCollection)
+	resp, err := c.Do(ctx, BiGetBiPacksOperation, nil, nil, out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return out, resp, nil
+}
+
+// CommentDeleteComments calls the "Delete comments" operation.
+func (c *Client) CommentDeleteComments(ctx context.Context, body *DeleteComments) (*client.Response, error) {
+	return c.Do(ctx, CommentDeleteCommentsOperation, nil, body, nil)
+}
+
+// CommentCreateHostComment calls the "Create a host comment" operation.
+func (c *Client) CommentCreateHostComment(ctx context.Context, body *CreateHostRelatedComment) (*client.Response, error) {
+	return c.Do(ctx, CommentCreateHostCommentOperation, nil, body, nil)
+}
+
+// CommentCreateServiceComment calls the "Create a service comment" operation.
+func (c *Client) CommentCreateServiceComment(ctx context.Context, body *CreateServiceRelatedComment) (*client.Response, error) {
+	return c.Do(ctx, CommentCreateServiceCommentOperation, nil, body, nil)
+}
+
+// CommentShowComments calls the "Show comments" operation.
+func (c *Client) CommentShowComments(ctx context.Context, params *CommentShowCommentsParams) (*CommentCollection, *client.Response, error) {
+	out := new(CommentCollection)
+	resp, err := c.Do(ctx, CommentShowCommentsOperation, params, nil, out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return out, resp, nil
+}
+
+// ContactGroupConfigBulkCreate calls the "Bulk create contact groups" operation.
+func (c *Client) ContactGroupConfigBulkCreate(ctx context.Context, body *BulkInputContactGroup) (*ContactGroupCollection, *client.Response, error) {
+	out := new(ContactGroupCollection)
+	resp, err := c.Do(ctx, ContactGroupConfigBulkCreateOperation, nil, body, out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return out, resp, nil
+}
+
+// ContactGroupConfigBulkDelete calls the "Bulk delete contact groups" operation.
+func (c *Client) ContactGroupConfigBulkDelete(ctx context.Context, body *BulkDeleteContactGroup) (*client.Response, error) {
+	return c.Do(ctx, ContactGroupConfigBulkDeleteOperation, nil, body, nil)
+}
+
+// ContactGroupConfigBulkUpdate calls the "Bulk update contact groups" operation.
+func (c *Client) ContactGroupConfigBulkUpdate(ctx context.Context, body *BulkUpdateContactGroup) (*ContactGroupCollection, *client.Response, error) {
+	out := new(ContactGroupCollection)
+	resp, err := c.Do(ctx, ContactGroupConfigBulkUpdateOperation, nil, body, out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return out, resp, nil
+}
+
+// ContactGroupConfigListGroup calls the "Show all contact groups" operation.
+func (c *Client) ContactGroupConfigListGroup(ctx context.Context) (*ContactGroupCollection, *client.Response, error) {
+	out := new(ContactGroupCollection)
+	resp, err := c.Do(ctx, ContactGroupConfigListGroupOperation, nil, nil, out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return out, resp, nil
+}
+
+// ContactGroupConfigCreate calls the "Create a contact group" operation.
+func (c *Client) ContactGroupConfigCreate(ctx context.Context, body *InputContactGroup) (*DomainObject, *client.Response, error) {
+	out := new(DomainObject)
+	resp, err := c.Do(ctx, ContactGroupConfigCreateOperation, nil, body, out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return out, resp, nil
+}
+
+// ServiceDiscoveryExecuteBulkDiscovery calls the "Start a bulk discovery job" operation.
+func (c *Client) ServiceDiscoveryExecuteBulkDiscovery(ctx context.Context, body *BulkDiscovery) (*DiscoveryBackgroundJobStatusObject, *client.Response, error) {
+	out := new(DiscoveryBackgroundJobStatusObject)
+	resp, err := c.Do(ctx, ServiceDiscoveryExecuteBulkDiscoveryOperation, nil, body, out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return out, resp, nil
+}
+
+// DowntimeDeleteDowntime calls the "Delete a scheduled downtime" operation.
+func (c *Client) DowntimeDeleteDowntime(ctx context.Context, body *DeleteDowntime) (*client.Response, error) {
+	return c.Do(ctx, DowntimeDeleteDowntimeOperation, nil, body, nil)
+}
+
+// DowntimeShowDowntimes calls the "Show all scheduled downtimes" operation.
+func (c *Client) DowntimeShowDowntimes(ctx context.Context, params *DowntimeShowDowntimesParams) (*DowntimeCollection, *client.Response, error) {
+	out := new(DowntimeCollection)
+	resp, err := c.Do(ctx, DowntimeShowDowntimesOperation, params, nil, out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return out, resp, nil
+}
+
+// DowntimeCreateHostRelatedDowntime calls the "Create a host related scheduled downtime" operation.
+func (c *Client) DowntimeCreateHostRelatedDowntime(ctx context.Context, body *CreateHostRelatedDowntime) (*client.Response, error) {
+	return c.Do(ctx, DowntimeCreateHostRelatedDowntimeOperation, nil, body, nil)
+}
+
+// DowntimeCreateServiceRelatedDowntime calls the "Create a service related scheduled downtime" operation.
+func (c *Client) DowntimeCreateServiceRelatedDowntime(ctx context.Context, body *CreateServiceRelatedDowntime) (*client.Response, error) {
+	return c.Do(ctx, DowntimeCreateServiceRelatedDowntimeOperation, nil, body, nil)
+}
+
+// EventConsoleChangeMultipleEventStates calls the "Change multiple event states" operation.
+func (c *Client) EventConsoleChangeMultipleEventStates(ctx context.Context, body *ChangeEventStateSelector) (*client.Response, error) {
+	return c.Do(ctx, EventConsoleChangeMultipleEventStatesOperation, nil, body, nil)
+}
+
+// EventConsoleArchiveEventsWithFilter calls the "Archive events" operation.
+func (c *Client) EventConsoleArchiveEventsWithFilter(ctx context.Context, body *DeleteECEvents) (*client.Response, error) {
+	return c.Do(ctx, EventConsoleArchiveEventsWithFilterOperation, nil, body, nil)
+}
+
+// EventConsoleUpdateAndAcknowledgeMultipleEvents calls the "Update and acknowledge events" operation.
+func (c *Client) EventConsoleUpdateAndAcknowledgeMultipleEvents(ctx context.Context, body *UpdateAndAcknowledgeSelector) (*client.Response, error) {
+	return c.Do(ctx, EventConsoleUpdateAndAcknowledgeMultipleEventsOperation, nil, body, nil)
+}
+
+// EventConsoleShowEvents calls the "Show events" operation.
+func (c *Client) EventConsoleShowEvents(ctx context.Context, params *EventConsoleShowEventsParams) (*EventConsoleResponseCollection, *client.Response, error) {
+	out := new(EventConsoleResponseCollection)
+	resp, err := c.Do(ctx, EventConsoleShowEventsOperation, params, nil, out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return out, resp, nil
+}
+
+// FolderConfigBulkUpdate calls the "Bulk update folders" operation.
+func (c *Client) FolderConfigBulkUpdate(ctx context.Context, body *BulkUpdateFolder) (*FolderCollection, *client.Response, error) {
+	out := new(FolderCollection)
+	resp, err := c.Do(ctx, FolderConfigBulkUpdateOperation, nil, body, out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return out, resp, nil
+}
+
+// FolderConfigListFolders calls the "Show all folders" operation.
+func (c *Client) FolderConfigListFolders(ctx context.Context, params *FolderConfigListFoldersParams) (*FolderCollection, *client.Response, error) {
+	out := new(FolderCollection)
+	resp, err := c.Do(ctx, FolderConfigListFoldersOperation, params, nil, out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return out, resp, nil
+}
+
+// FolderConfigCreate calls the "Create a folder" operation.
+func (c *Client) FolderConfigCreate(ctx context.Context, body *CreateFolder) (*Folder, *client.Response, error) {
+	out := new(Folder)
+	resp, err := c.Do(ctx, FolderConfigCreateOperation, nil, body, out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return out, resp, nil
+}
+
+// HostConfigBulkCreateHosts calls the "Bulk create hosts" operation.
+func (c *Client) HostConfigBulkCreateHosts(ctx context.Context, params *HostConfigBulkCreateHostsParams, body *BulkCreateHost) (*HostConfigCollection, *client.Response, error) {
+	out := new(HostConfigCollection)
+	resp, err := c.Do(ctx, HostConfigBulkCreateHostsOperation, params, body, out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return out, resp, nil
+}
+
+// HostConfigBulkDelete calls the "Bulk delete hosts" operation.
+func (c *Client) HostConfigBulkDelete(ctx context.Context, body *BulkDeleteHost) (*client.Response, error) {
+	return c.Do(ctx, HostConfigBulkDeleteOperation, nil, body, nil)
+}
+
+// HostConfigBulkUpdateHosts calls the "Bulk update hosts" operation.
+func (c *Client) HostConfigBulkUpdateHosts(ctx context.Context, body *BulkUpdateHost) (*HostConfigCollection, *client.Response, error) {
+	out := new(HostConfigCollection)
+	resp, err := c.Do(ctx, HostConfigBulkUpdateHostsOperation, nil, body, out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return out, resp, nil
+}
+
+// HostConfigListHosts calls the "Show all hosts" operation.
+func (c *Client) HostConfigListHosts(ctx context.Context, params *HostConfigListHostsParams) (*HostConfigCollection, *client.Response, error) {
+	out := new(HostConfigCollection)
+	resp, err := c.Do(ctx, HostConfigListHostsOperation, params, nil, out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return out, resp, nil
+}
+
+// HostConfigCreateHost calls the "Create a host" operation.
+func (c *Client) HostConfigCreateHost(ctx context.Context, params *HostConfigCreateHostParams, body *CreateHost) (*HostConfig, *client.Response, error) {
+	out := new(HostConfig)
+	resp, err := c.Do(ctx, HostConfigCreateHostOperation, params, body, out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return out, resp, nil
+}
+
+// HostConfigCreateClusterHost calls the "Create a cluster host" operation.
+func (c *Client) HostConfigCreateClusterHost(ctx context.Context, params *HostConfigCreateClusterHostParams, body *CreateClusterHost) (*HostConfig, *client.Response, error) {
+	out := new(HostConfig)
+	resp, err := c.Do(ctx, HostConfigCreateClusterHostOperation, params, body, out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return out, resp, nil
+}
+
+// HostGroupConfigBulkCreate calls the "Bulk create host groups" operation.
+func (c *Client) HostGroupConfigBulkCreate(ctx context.Context, body *BulkInputHostGroup) (*HostGroupCollection, *client.Response, error) {
+	out := new(HostGroupCollection)
+	resp, err := c.Do(ctx, HostGroupConfigBulkCreateOperation, nil, body, out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return out, resp, nil
+}
+
+// HostGroupConfigBulkDelete calls the "Bulk delete host groups" operation.
+func (c *Client) HostGroupConfigBulkDelete(ctx context.Context, body *BulkDeleteHostGroup) (*client.Response, error) {
+	return c.Do(ctx, HostGroupConfigBulkDeleteOperation, nil, body, nil)
+}
+
+// HostGroupConfigBulkUpdate calls the "Bulk update host groups" operation.
+func (c *Client) HostGroupConfigBulkUpdate(ctx context.Context, body *BulkUpdateHostGroup) (*HostGroupCollection, *client.Response, error) {
+	out := new(HostGroupCollection)
+	resp, err := c.Do(ctx, HostGroupConfigBulkUpdateOperation, nil, body, out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return out, resp, nil
+}
+
+// HostGroupConfigListGroups calls the "Show all host groups" operation.
+func (c *Client) HostGroupConfigListGroups(ctx context.Context) (*HostGroupCollection, *client.Response, error) {
+	out := new(HostGroupCollection)
+	resp, err := c.Do(ctx, HostGroupConfigListGroupsOperation, nil, nil, out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return out, resp, nil
+}
+
+// HostGroupConfigCreate calls the "Create a host group" operation.
+func (c *Client) HostGroupConfigCreate(ctx context.Context, body *InputHostGroup) (*HostGroup, *client.Response, error) {
+	out := new(HostGroup)
+	resp, err := c.Do(ctx, HostGroupConfigCreateOperation, nil, body, out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return out, resp, nil
+}
+
+// HostTagGroupListHostTagGroups calls the "Show all host tag groups" operation.
+func (c *Client) HostTagGroupListHostTagGroups(ctx context.Context) (*HostTagGroupCollection, *client.Response, error) {
+	out := new(HostTagGroupCollection)
+	resp, err := c.Do(ctx, HostTagGroupListHostTagGroupsOperation, nil, nil, out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return out, resp, nil
+}
+
+// HostTagGroupCreateHostTagGroup calls the "Create a host tag group" operation.
+func (c *Client) HostTagGroupCreateHostTagGroup(ctx context.Context, body *InputHostTagGroup) (*DomainObject, *client.Response, error) {
+	out := new(DomainObject)
+	resp, err := c.Do(ctx, HostTagGroupCreateHostTagGroupOperation, nil, body, out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return out, resp, nil
+}
+
+// MetricGetGraph calls the "Get metrics" operation.
+func (c *Client) MetricGetGraph(ctx context.Context, body *Get) (*GraphCollection, *client.Response, error) {
+	out := new(GraphCollection)
+	resp, err := c.Do(ctx, MetricGetGraphOperation, nil, body, out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return out, resp, nil
+}
+
+// NotificationRulesShowRules calls the "Show all notification rules" operation.
+func (c *Client) NotificationRulesShowRules(ctx context.Context) (*NotificationRuleResponseCollection, *client.Response, error) {
+	out := new(NotificationRuleResponseCollection)
+	resp, err := c.Do(ctx, NotificationRulesShowRulesOperation, nil, nil, out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return out, resp, nil
+}
+
+// NotificationRulesPostRule calls the "Create a notification rule" operation.
+func (c *Client) NotificationRulesPostRule(ctx context.Context, body *NotificationRuleRequest) (*NotificationRuleResponse, *client.Response, error) {
+	out := new(NotificationRuleResponse)
+	resp, err := c.Do(ctx, NotificationRulesPostRuleOperation, nil, body, out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return out, resp, nil
+}
+
+// PasswordListPasswords calls the "Show all passwords" operation.
+func (c *Client) PasswordListPasswords(ctx context.Context) (*PasswordCollection, *client.Response, error) {
+	out := new(PasswordCollection)
+	resp, err := c.Do(ctx, PasswordListPasswordsOperation, nil, nil, out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return out, resp, nil
+}
+
+// PasswordCreatePassword calls the "Create a password" operation.
+func (c *Client) PasswordCreatePassword(ctx context.Context, body *InputPassword) (*PasswordObject, *client.Response, error) {
+	out := new(PasswordObject)
+	resp, err := c.Do(ctx, PasswordCreatePasswordOperation, nil, body, out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return out, resp, nil
+}
+
+// RuleListRules calls the "List rules" operation.
+func (c *Client) RuleListRules(ctx context.Context, params *RuleListRulesParams) (*RuleCollection, *client.Response, error) {
+	out := new(RuleCollection)
+	resp, err := c.Do(ctx, RuleListRulesOperation, params, nil, out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return out, resp, nil
+}
+
+// RuleCreateRule calls the "Create rule" operation.
+func (c *Client) RuleCreateRule(ctx context.Context, body *InputRuleObject) (*RuleObject, *client.Response, error) {
+	out := new(RuleObject)
+	resp, err := c.Do(ctx, RuleCreateRuleOperation, nil, body, out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return out, resp, nil
+}
+
+// RulesetListRulesets calls the "Search rule sets" operation.
+func (c *Client) RulesetListRulesets(ctx context.Context, params *RulesetListRulesetsParams) (*RulesetCollection, *client.Response, error) {
+	out := new(RulesetCollection)
+	resp, err := c.Do(ctx, RulesetListRulesetsOperation, params, nil, out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return out, resp, nil
+}
+
+// ServiceDiscoveryShowServices14537 calls the "Show all services of specific phase" operation.
+//
+// Deprecated: this endpoint is deprecated by CheckMK.
+func (c *Client) ServiceDiscoveryShowServices14537(ctx context.Context, params *ServiceDiscoveryShowServices14537Params) (*DomainObject, *client.Response, error) {
+	out := new(DomainObject)
+	resp, err := c.Do(ctx, ServiceDiscoveryShowServices14537Operation, params, nil, out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return out, resp, nil
+}
+
+// ServiceDiscoveryExecuteServiceDiscovery calls the "Execute a service discovery on a host" operation.
+func (c *Client) ServiceDiscoveryExecuteServiceDiscovery(ctx context.Context, body *DiscoverServices) (*DomainObject, *client.Response, error) {
+	out := new(DomainObject)
+	resp, err := c.Do(ctx, ServiceDiscoveryExecuteServiceDiscoveryOperation, nil, body, out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return out, resp, nil
+}
+
+// ServiceGroupConfigBulkCreate calls the "Bulk create service groups" operation.
+func (c *Client) ServiceGroupConfigBulkCreate(ctx context.Context, body *BulkInputServiceGroup) (*ServiceGroupCollection, *client.Response, error) {
+	out := new(ServiceGroupCollection)
+	resp, err := c.Do(ctx, ServiceGroupConfigBulkCreateOperation, nil, body, out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return out, resp, nil
+}
+
+// ServiceGroupConfigBulkDelete calls the "Bulk delete service groups" operation.
+func (c *Client) ServiceGroupConfigBulkDelete(ctx context.Context, body *BulkDeleteServiceGroup) (*client.Response, error) {
+	return c.Do(ctx, ServiceGroupConfigBulkDeleteOperation, nil, body, nil)
+}
+
+// ServiceGroupConfigBulkUpdate calls the "Bulk update service groups" operation.
+func (c *Client) ServiceGroupConfigBulkUpdate(ctx context.Context, body *BulkUpdateServiceGroup) (*ServiceGroupCollection, *client.Response, error) {
+	out := new(ServiceGroupCollection)
+	resp, err := c.Do(ctx, ServiceGroupConfigBulkUpdateOperation, nil, body, out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return out, resp, nil
+}
+
+// ServiceGroupConfigListGroups calls the "Show all service groups" operation.
+func (c *Client) ServiceGroupConfigListGroups(ctx context.Context) (*ServiceGroupCollection, *client.Response, error) {
+	out := new(ServiceGroupCollection)
+	resp, err := c.Do(ctx, ServiceGroupConfigListGroupsOperation, nil, nil, out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return out, resp, nil
+}
+
+// ServiceGroupConfigCreate calls the "Create a service group" operation.
+func (c *Client) ServiceGroupConfigCreate(ctx context.Context, body *InputServiceGroup) (*DomainObject, *client.Response, error) {
+	out := new(DomainObject)
+	resp, err := c.Do(ctx, ServiceGroupConfigCreateOperation, nil, body, out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return out, resp, nil
+}
+
+// SiteManagementShowSites calls the "Show all site connections" operation.
+func (c *Client) SiteManagementShowSites(ctx context.Context) (*SiteConnectionResponseCollection, *client.Response, error) {
+	out := new(SiteConnectionResponseCollection)
+	resp, err := c.Do(ctx, SiteManagementShowSitesOperation, nil, nil, out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return out, resp, nil
+}
+
+// SiteManagementPostSite calls the "Create a site connection" operation.
+func (c *Client) SiteManagementPostSite(ctx context.Context, body *SiteConnectionRequestCreate) (*SiteConnectionResponse, *client.Response, error) {
+	out := new(SiteConnectionResponse)
+	resp, err := c.Do(ctx, SiteManagementPostSiteOperation, nil, body, out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return out, resp, nil
+}
+
+// TimePeriodsListTimePeriods calls the "Show all time periods" operation.
+func (c *Client) TimePeriodsListTimePeriods(ctx context.Context) (*TimePeriodResponseCollection, *client.Response, error) {
+	out := new(TimePeriodResponseCollection)
+	resp, err := c.Do(ctx, TimePeriodsListTimePeriodsOperation, nil, nil, out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return out, resp, nil
+}
+
+// TimePeriodsCreateTimeperiod calls the "Create a time period" operation.
+func (c *Client) TimePeriodsCreateTimeperiod(ctx context.Context, body *CreateTimePeriod) (*TimePeriodResponse, *client.Response, error) {
+	out := new(TimePeriodResponse)
+	resp, err := c.Do(ctx, TimePeriodsCreateTimeperiodOperation, nil, body, out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return out, resp, nil
+}
+
+// UserConfigListUsers calls the "Show all users" operation.
+func (c *Client) UserConfigListUsers(ctx context.Context) (*UserCollection, *client.Response, error) {
+	out := new(UserCollection)
+	resp, err := c.Do(ctx, UserConfigListUsersOperation, nil, nil, out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return out, resp, nil
+}
+
+// UserConfigCreateUser calls the "Create a user" operation.
+func (c *Client) UserConfigCreateUser(ctx context.Context, body *CreateUser) (*UserObject, *client.Response, error) {
+	out := new(UserObject)
+	resp, err := c.Do(ctx, UserConfigCreateUserOperation, nil, body, out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return out, resp, nil
+}
+
+// UserRoleListUserRoles calls the "Show all user roles" operation.
+func (c *Client) UserRoleListUserRoles(ctx context.Context) (*UserRoleCollection, *client.Response, error) {
+	out := new(UserRoleCollection)
+	resp, err := c.Do(ctx, UserRoleListUserRolesOperation, nil, nil, out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return out, resp, nil
+}
+
+// UserRoleCreateUserrole calls the "Create/clone a user role" operation.
+func (c *Client) UserRoleCreateUserrole(ctx context.Context, body *CreateUserRole) (*UserRoleObject, *client.Response, error) {
+	out := new(UserRoleObject)
+	resp, err := c.Do(ctx, UserRoleCreateUserroleOperation, nil, body, out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return out, resp, nil
+}
+
+// ActivateChangesShowActivation calls the "Show the activation status" operation.
+func (c *Client) ActivateChangesShowActivation(ctx context.Context, params *ActivateChangesShowActivationParams) (*ActivationRunResponse, *client.Response, error) {
+	out := new(ActivationRunResponse)
+	resp, err := c.Do(ctx, ActivateChangesShowActivationOperation, params, nil, out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return out, resp, nil
+}
+
+// ActivateChangesActivateChangesWaitForCompletion calls the "Wait for activation completion" operation.
+func (c *Client) ActivateChangesActivateChangesWaitForCompletion(ctx context.Context, params *ActivateChangesActivateChangesWaitForCompletionParams) (*client.Response, error) {
+	return c.Do(ctx, ActivateChangesActivateChangesWaitForCompletionOperation, params, nil, nil)
+}
+
+// AuxTagsShowAuxTag calls the "Show an Auxiliary Tag" operation.
+func (c *Client) AuxTagsShowAuxTag(ctx context.Context, params *AuxTagsShowAuxTagParams) (*AuxTagResponse, *client.Response, error) {
+	out := new(AuxTagResponse)
+	resp, err := c.Do(ctx, AuxTagsShowAuxTagOperation, params, nil, out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return out, resp, nil
+}
+
+// AuxTagsPutAuxTag calls the "Update an aux tag" operation.
+func (c *Client) AuxTagsPutAuxTag(ctx context.Context, params *AuxTagsPutAuxTagParams, body *AuxTagAttrsUpdate) (*AuxTagResponse, *client.Response, error) {
+	out := new(AuxTagResponse)
+	resp, err := c.Do(ctx, AuxTagsPutAuxTagOperation, params, body, out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return out, resp, nil
+}
+
+// AuxTagsDeleteAuxTag calls the "Delete an Auxiliary Tag" operation.
+func (c *Client) AuxTagsDeleteAuxTag(ctx context.Context, params *AuxTagsDeleteAuxTagParams) (*client.Response, error) {
+	return c.Do(ctx, AuxTagsDeleteAuxTagOperation, params, nil, nil)
+}
+
+// BiGetBiAggregation calls the "Get a BI aggregation" operation.
+func (c *Client) BiGetBiAggregation(ctx context.Context, params *BiGetBiAggregationParams) (*BIAggregationEndpoint, *client.Response, error) {
+	out := new(BIAggregationEndpoint)
+	resp, err := c.Do(ctx, BiGetBiAggregationOperation, params, nil, out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return out, resp, nil
+}
+
+// BiPutBiAggregation calls the "Update an existing BI aggregation" operation.
+func (c *Client) BiPutBiAggregation(ctx context.Context, params *BiPutBiAggregationParams, body *BIAggregationEndpoint) (*BIAggregationEndpoint, *client.Response, error) {
+	out := new(BIAggregationEndpoint)
+	resp, err := c.Do(ctx, BiPutBiAggregationOperation, params, body, out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return out, resp, nil
+}
+
+// BiPostBiAggregation calls the "Create a BI aggregation" operation.
+func (c *Client) BiPostBiAggregation(ctx context.Context, params *BiPostBiAggregationParams, body *BIAggregationEndpoint) (*BIAggregationEndpoint, *client.Response, error) {
+	out := new(BIAggregationEndpoint)
+	resp, err := c.Do(ctx, BiPostBiAggregationOperation, params, body, out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return out, resp, nil
+}
+
+// BiDeleteBiAggregation calls the "Delete a BI aggregation" operation.
+func (c *Client) BiDeleteBiAggregation(ctx context.Context, params *BiDeleteBiAggregationParams) (*client.Response, error) {
+	return c.Do(ctx, BiDeleteBiAggregationOperation, params, nil, nil)
+}
+
+// BiGetBiPack calls the "Get a BI pack and its rules and aggregations" operation.
+func (c *Client) BiGetBiPack(ctx context.Context, params *BiGetBiPackParams) (*DomainObject, *client.Response, error) {
+	out := new(DomainObject)
+	resp, err := c.Do(ctx, BiGetBiPackOperation, params, nil, out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return out, resp, nil
+}
+
+// BiPutBiPack calls the "Update an existing BI pack" operation.
+func (c *Client) BiPutBiPack(ctx context.Context, params *BiPutBiPackParams, body *BIPackEndpoint) (*BIPackEndpoint, *client.Response, error) {
+	out := new(BIPackEndpoint)
+	resp, err := c.Do(ctx, BiPutBiPackOperation, params, body, out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return out, resp, nil
+}
+
+// BiPostBiPack calls the "Create a new BI pack" operation.
+func (c *Client) BiPostBiPack(ctx context.Context, params *BiPostBiPackParams, body *BIPackEndpoint) (*BIPackEndpoint, *client.Response, error) {
+	out := new(BIPackEndpoint)
+	resp, err := c.Do(ctx, BiPostBiPackOperation, params, body, out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return out, resp, nil
+}
+
+// BiDeleteBiPack calls the "Delete BI pack" operation.
+func (c *Client) BiDeleteBiPack(ctx context.Context, params *BiDeleteBiPackParams) (*client.Response, error) {
+	return c.Do(ctx, BiDeleteBiPackOperation, params, nil, nil)
+}
+
+// BiGetBiRule calls the "Show a BI rule" operation.
+func (c *Client) BiGetBiRule(ctx context.Context, params *BiGetBiRuleParams) (*BIRuleEndpoint, *client.Response, error) {
+	out := new(BIRuleEndpoint)
+	resp, err := c.Do(ctx, BiGetBiRuleOperation, params, nil, out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return out, resp, nil
+}
+
+// BiPutBiRule calls the "Update an existing BI rule" operation.
+func (c *Client) BiPutBiRule(ctx context.Context, params *BiPutBiRuleParams, body *BIRuleEndpoint) (*BIRuleEndpoint, *client.Response, error) {
+	out := new(BIRuleEndpoint)
+	resp, err := c.Do(ctx, BiPutBiRuleOperation, params, body, out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return out, resp, nil
+}
+
+// BiPostBiRule calls the "Create a new BI rule" operation.
+func (c *Client) BiPostBiRule(ctx context.Context, params *BiPostBiRuleParams, body *BIRuleEndpoint) (*BIRuleEndpoint, *client.Response, error) {
+	out := new(BIRuleEndpoint)
+	resp, err := c.Do(ctx, BiPostBiRuleOperation, params, body, out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return out, resp, nil
+}
+
+// BiDeleteBiRule calls the "Delete BI rule" operation.
+func (c *Client) BiDeleteBiRule(ctx context.Context, params *BiDeleteBiRuleParams) (*client.Response, error) {
+	return c.Do(ctx, BiDeleteBiRuleOperation, params, nil, nil)
+}
+
+// CommentShowComment calls the "Show a comment" operation.
+func (c *Client) CommentShowComment(ctx context.Context, params *CommentShowCommentParams) (*CommentObject, *client.Response, error) {
+	out := new(CommentObject)
+	resp, err := c.Do(ctx, CommentShowCommentOperation, params, nil, out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return out, resp, nil
+}
+
+// ContactGroupConfigShow calls the "Show a contact group" operation.
+func (c *Client) ContactGroupConfigShow(ctx context.Context, params *ContactGroupConfigShowParams) (*ContactGroup, *client.Response, error) {
+	out := new(ContactGroup)
+	resp, err := c.Do(ctx, ContactGroupConfigShowOperation, params, nil, out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return out, resp, nil
+}
+
+// ContactGroupConfigUpdate calls the "Update a contact group" operation.
+func (c *Client) ContactGroupConfigUpdate(ctx context.Context, params *ContactGroupConfigUpdateParams, body *UpdateGroup) (*ContactGroup, *client.Response, error) {
+	out := new(ContactGroup)
+	resp, err := c.Do(ctx, ContactGroupConfigUpdateOperation, params, body, out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return out, resp, nil
+}
+
+// ContactGroupConfigDelete calls the "Delete a contact group" operation.
+func (c *Client) ContactGroupConfigDelete(ctx context.Context, params *ContactGroupConfigDeleteParams) (*client.Response, error) {
+	return c.Do(ctx, ContactGroupConfigDeleteOperation, params, nil, nil)
+}
+
+// ServiceDiscoveryShowBulkDiscoveryStatus calls the "Show the status of a bulk discovery job" operation.
+func (c *Client) ServiceDiscoveryShowBulkDiscoveryStatus(ctx context.Context, params *ServiceDiscoveryShowBulkDiscoveryStatusParams) (*DiscoveryBackgroundJobStatusObject, *client.Response, error) {
+	out := new(DiscoveryBackgroundJobStatusObject)
+	resp, err := c.Do(ctx, ServiceDiscoveryShowBulkDiscoveryStatusOperation, params, nil, out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return out, resp, nil
+}
+
+// DowntimeShowDowntime calls the "Show downtime" operation.
+func (c *Client) DowntimeShowDowntime(ctx context.Context, params *DowntimeShowDowntimeParams) (*DowntimeObject, *client.Response, error) {
+	out := new(DowntimeObject)
+	resp, err := c.Do(ctx, DowntimeShowDowntimeOperation, params, nil, out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return out, resp, nil
+}
+
+// EventConsoleShowEvent calls the "Show an event" operation.
+func (c *Client) EventConsoleShowEvent(ctx context.Context, params *EventConsoleShowEventParams) (*ECEventResponse, *client.Response, error) {
+	out := new(ECEventResponse)
+	resp, err := c.Do(ctx, EventConsoleShowEventOperation, params, nil, out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return out, resp, nil
+}
+
+// EventConsoleChangeEventState calls the "Change event state" operation.
+func (c *Client) EventConsoleChangeEventState(ctx context.Context, params *EventConsoleChangeEventStateParams, body *ChangeEventState) (*client.Response, error) {
+	return c.Do(ctx, EventConsoleChangeEventStateOperation, params, body, nil)
+}
+
+// EventConsoleUpdateAndAcknowledgeEvent calls the "Update and acknowledge an event" operation.
+func (c *Client) EventConsoleUpdateAndAcknowledgeEvent(ctx context.Context, params *EventConsoleUpdateAndAcknowledgeEventParams, body *UpdateAndAcknowledeEventSiteIDRequired) (*client.Response, error) {
+	return c.Do(ctx, EventConsoleUpdateAndAcknowledgeEventOperation, params, body, nil)
+}
+
+// FolderConfigShowFolder calls the "Show a folder" operation.
+func (c *Client) FolderConfigShowFolder(ctx context.Context, params *FolderConfigShowFolderParams) (*Folder, *client.Response, error) {
+	out := new(Folder)
+	resp, err := c.Do(ctx, FolderConfigShowFolderOperation, params, nil, out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return out, resp, nil
+}
+
+// FolderConfigUpdate calls the "Update a folder" operation.
+func (c *Client) FolderConfigUpdate(ctx context.Context, params *FolderConfigUpdateParams, body *UpdateFolder) (*Folder, *client.Response, error) {
+	out := new(Folder)
+	resp, err := c.Do(ctx, FolderConfigUpdateOperation, params, body, out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return out, resp, nil
+}
+
+// FolderConfigDelete calls the "Delete a folder" operation.
+func (c *Client) FolderConfigDelete(ctx context.Context, params *FolderConfigDeleteParams) (*client.Response, error) {
+	return c.Do(ctx, FolderConfigDeleteOperation, params, nil, nil)
+}
+
+// FolderConfigMove calls the "Move a folder" operation.
+func (c *Client) FolderConfigMove(ctx context.Context, params *FolderConfigMoveParams, body *MoveFolder) (*Folder, *client.Response, error) {
+	out := new(Folder)
+	resp, err := c.Do(ctx, FolderConfigMoveOperation, params, body, out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return out, resp, nil
+}
+
+// FolderConfigHostsOfFolder calls the "Show all hosts in a folder" operation.
+func (c *Client) FolderConfigHostsOfFolder(ctx context.Context, params *FolderConfigHostsOfFolderParams) (*HostConfigCollection, *client.Response, error) {
+	out := new(HostConfigCollection)
+	resp, err := c.Do(ctx, FolderConfigHostsOfFolderOperation, params, nil, out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return out, resp, nil
+}
+
+// ServiceDiscoveryExecute14538 calls the "Execute a service discovery on a host" operation.
+//
+// Deprecated: this endpoint is deprecated by CheckMK.
+func (c *Client) ServiceDiscoveryExecute14538(ctx context.Context, params *ServiceDiscoveryExecute14538Params, body *DiscoverServicesDeprecated) (*DomainObject, *client.Response, error) {
+	out := new(DomainObject)
+	resp, err := c.Do(ctx, ServiceDiscoveryExecute14538Operation, params, body, out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return out, resp, nil
+}
+
+// ServiceShowService calls the "Show the monitored service of a host" operation.
+func (c *Client) ServiceShowService(ctx context.Context, params *ServiceShowServiceParams) (*DomainObject, *client.Response, error) {
+	out := new(DomainObject)
+	resp, err := c.Do(ctx, ServiceShowServiceOperation, params, nil, out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return out, resp, nil
+}
+
+// ServiceDiscoveryUpdateServicePhase calls the "Update the phase of a service" operation.
+func (c *Client) ServiceDiscoveryUpdateServicePhase(ctx context.Context, params *ServiceDiscoveryUpdateServicePhaseParams, body *UpdateDiscoveryPhase) (*client.Response, error) {
+	return c.Do(ctx, ServiceDiscoveryUpdateServicePhaseOperation, params, body, nil)
+}
+
+// HostConfigShowHost calls the "Show a host" operation.
+func (c *Client) HostConfigShowHost(ctx context.Context, params *HostConfigShowHostParams) (*HostConfig, *client.Response, error) {
+	out := new(HostConfig)
+	resp, err := c.Do(ctx, HostConfigShowHostOperation, params, nil, out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return out, resp, nil
+}
+
+// HostConfigUpdateHost calls the "Update a host" operation.
+func (c *Client) HostConfigUpdateHost(ctx context.Context, params *HostConfigUpdateHostParams, body *UpdateHost) (*HostConfig, *client.Response, error) {
+	out := new(HostConfig)
+	resp, err := c.Do(ctx, HostConfigUpdateHostOperation, params, body, out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return out, resp, nil
+}
+
+// HostConfigDelete calls the "Delete a host" operation.
+func (c *Client) HostConfigDelete(ctx context.Context, params *HostConfigDeleteParams) (*client.Response, error) {
+	return c.Do(ctx, HostConfigDeleteOperation, params, nil, nil)
+}
+
+// HostConfigMove calls the "Move a host to another folder" operation.
+func (c *Client) HostConfigMove(ctx context.Context, params *HostConfigMoveParams, body *MoveHost) (*HostConfig, *client.Response, error) {
+	out := new(HostConfig)
+	resp, err := c.Do(ctx, HostConfigMoveOperation, params, body, out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return out, resp, nil
+}
+
+// HostConfigRenameHost calls the "Rename a host" operation.
+func (c *Client) HostConfigRenameHost(ctx context.Context, params *HostConfigRenameHostParams, body *RenameHost) (*HostConfig, *client.Response, error) {
+	out := new(HostConfig)
+	resp, err := c.Do(ctx, HostConfigRenameHostOperation, params, body, out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return out, resp, nil
+}
+
+// HostConfigUpdateNodes calls the "Update the nodes of a cluster host" operation.
+func (c *Client) HostConfigUpdateNodes(ctx context.Context, params *HostConfigUpdateNodesParams, body *UpdateNodes) (*ObjectProperty, *client.Response, error) {
+	out := new(ObjectProperty)
+	resp, err := c.Do(ctx, HostConfigUpdateNodesOperation, params, body, out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return out, resp, nil
+}
+
+// HostInternalShowHost calls the "Show a host" operation.
+func (c *Client) HostInternalShowHost(ctx context.Context, params *HostInternalShowHostParams) (*HostConfigSchemaInternal, *client.Response, error) {
+	out := new(HostConfigSchemaInternal)
+	resp, err := c.Do(ctx, HostInternalShowHostOperation, params, nil, out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return out, resp, nil
+}
+
+// HostInternalLinkWithUuid calls the "Link a host to a UUID" operation.
+func (c *Client) HostInternalLinkWithUuid(ctx context.Context, params *HostInternalLinkWithUuidParams, body *LinkHostUUID) (*client.Response, error) {
+	return c.Do(ctx, HostInternalLinkWithUuidOperation, params, body, nil)
+}
+
+// HostInternalRegister calls the "Register an existing host, ie. link it to a UUID" operation.
+func (c *Client) HostInternalRegister(ctx context.Context, params *HostInternalRegisterParams, body *RegisterHost) (*ConnectionMode, *client.Response, error) {
+	out := new(ConnectionMode)
+	resp, err := c.Do(ctx, HostInternalRegisterOperation, params, body, out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return out, resp, nil
+}
+
+// HostGroupConfigGet calls the "Show a host group" operation.
+func (c *Client) HostGroupConfigGet(ctx context.Context, params *HostGroupConfigGetParams) (*HostGroup, *client.Response, error) {
+	out := new(HostGroup)
+	resp, err := c.Do(ctx, HostGroupConfigGetOperation, params, nil, out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return out, resp, nil
+}
+
+// HostGroupConfigUpdate calls the "Update a host group" operation.
+func (c *Client) HostGroupConfigUpdate(ctx context.Context, params *HostGroupConfigUpdateParams, body *UpdateGroup1) (*HostGroup, *client.Response, error) {
+	out := new(HostGroup)
+	resp, err := c.Do(ctx, HostGroupConfigUpdateOperation, params, body, out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return out, resp, nil
+}
+
+// HostGroupConfigDelete calls the "Delete a host group" operation.
+func (c *Client) HostGroupConfigDelete(ctx context.Context, params *HostGroupConfigDeleteParams) (*client.Response, error) {
+	return c.Do(ctx, HostGroupConfigDeleteOperation, params, nil, nil)
+}
+
+// HostTagGroupShowHostTagGroup calls the "Show a host tag group" operation.
+func (c *Client) HostTagGroupShowHostTagGroup(ctx context.Context, params *HostTagGroupShowHostTagGroupParams) (*ConcreteHostTagGroup, *client.Response, error) {
+	out := new(ConcreteHostTagGroup)
+	resp, err := c.Do(ctx, HostTagGroupShowHostTagGroupOperation, params, nil, out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return out, resp, nil
+}
+
+// HostTagGroupUpdateHostTagGroup calls the "Update a host tag group" operation.
+func (c *Client) HostTagGroupUpdateHostTagGroup(ctx context.Context, params *HostTagGroupUpdateHostTagGroupParams, body *UpdateHostTagGroup) (*ConcreteHostTagGroup, *client.Response, error) {
+	out := new(ConcreteHostTagGroup)
+	resp, err := c.Do(ctx, HostTagGroupUpdateHostTagGroupOperation, params, body, out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return out, resp, nil
+}
+
+// HostTagGroupDeleteHostTagGroup calls the "Delete a host tag group" operation.
+func (c *Client) HostTagGroupDeleteHostTagGroup(ctx context.Context, params *HostTagGroupDeleteHostTagGroupParams) (*client.Response, error) {
+	return c.Do(ctx, HostTagGroupDeleteHostTagGroupOperation, params, nil, nil)
+}
+
+// NotificationRulesShowRule calls the "Show a notification rule" operation.
+func (c *Client) NotificationRulesShowRule(ctx context.Context, params *NotificationRulesShowRuleParams) (*NotificationRuleResponse, *client.Response, error) {
+	out := new(NotificationRuleResponse)
+	resp, err := c.Do(ctx, NotificationRulesShowRuleOperation, params, nil, out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return out, resp, nil
+}
+
+// NotificationRulesPutRule calls the "Update a notification rule" operation.
+func (c *Client) NotificationRulesPutRule(ctx context.Context, params *NotificationRulesPutRuleParams, body *NotificationRuleRequest) (*NotificationRuleResponse, *client.Response, error) {
+	out := new(NotificationRuleResponse)
+	resp, err := c.Do(ctx, NotificationRulesPutRuleOperation, params, body, out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return out, resp, nil
+}
+
+// NotificationRulesDeleteRule calls the "Delete a notification rule" operation.
+func (c *Client) NotificationRulesDeleteRule(ctx context.Context, params *NotificationRulesDeleteRuleParams) (*client.Response, error) {
+	return c.Do(ctx, NotificationRulesDeleteRuleOperation, params, nil, nil)
+}
+
+// PasswordShowPassword calls the "Show a password" operation.
+func (c *Client) PasswordShowPassword(ctx context.Context, params *PasswordShowPasswordParams) (*PasswordObject, *client.Response, error) {
+	out := new(PasswordObject)
+	resp, err := c.Do(ctx, PasswordShowPasswordOperation, params, nil, out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return out, resp, nil
+}
+
+// PasswordUpdatePassword calls the "Update a password" operation.
+func (c *Client) PasswordUpdatePassword(ctx context.Context, params *PasswordUpdatePasswordParams, body *UpdatePassword) (*PasswordObject, *client.Response, error) {
+	out := new(PasswordObject)
+	resp, err := c.Do(ctx, PasswordUpdatePasswordOperation, params, body, out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return out, resp, nil
+}
+
+// PasswordDeletePassword calls the "Delete a password" operation.
+func (c *Client) PasswordDeletePassword(ctx context.Context, params *PasswordDeletePasswordParams) (*client.Response, error) {
+	return c.Do(ctx, PasswordDeletePasswordOperation, params, nil, nil)
+}
+
+// RuleShowRule calls the "Show a rule" operation.
+func (c *Client) RuleShowRule(ctx context.Context, params *RuleShowRuleParams) (*RuleObject, *client.Response, error) {
+	out := new(RuleObject)
+	resp, err := c.Do(ctx, RuleShowRuleOperation, params, nil, out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return out, resp, nil
+}
+
+// RuleEditRule calls the "Modify a rule" operation.
+func (c *Client) RuleEditRule(ctx context.Context, params *RuleEditRuleParams, body *UpdateRuleObject) (*RuleObject, *client.Response, error) {
+	out := new(RuleObject)
+	resp, err := c.Do(ctx, RuleEditRuleOperation, params, body, out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return out, resp, nil
+}
+
+// RuleDeleteRule calls the "Delete a rule" operation.
+func (c *Client) RuleDeleteRule(ctx context.Context, params *RuleDeleteRuleParams) (*client.Response, error) {
+	return c.Do(ctx, RuleDeleteRuleOperation, params, nil, nil)
+}
+
+// RuleMoveRuleTo calls the "Move a rule to a specific location" operation.
+func (c *Client) RuleMoveRuleTo(ctx context.Context, params *RuleMoveRuleToParams, body *MoveRuleTo) (*RuleObject, *client.Response, error) {
+	out := new(RuleObject)
+	resp, err := c.Do(ctx, RuleMoveRuleToOperation, params, body, out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return out, resp, nil
+}
+
+// RulesetShowRuleset calls the "Show a ruleset" operation.
+func (c *Client) RulesetShowRuleset(ctx context.Context, params *RulesetShowRulesetParams) (*RulesetObject, *client.Response, error) {
+	out := new(RulesetObject)
+	resp, err := c.Do(ctx, RulesetShowRulesetOperation, params, nil, out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return out, resp, nil
+}
+
+// ServiceDiscoveryShowServiceDiscoveryResult calls the "Show the current service discovery result" operation.
+func (c *Client) ServiceDiscoveryShowServiceDiscoveryResult(ctx context.Context, params *ServiceDiscoveryShowServiceDiscoveryResultParams) (*DomainObject, *client.Response, error) {
+	out := new(DomainObject)
+	resp, err := c.Do(ctx, ServiceDiscoveryShowServiceDiscoveryResultOperation, params, nil, out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return out, resp, nil
+}
+
+// ServiceDiscoveryShowServiceDiscoveryRun calls the "Show the last service discovery background job on a host" operation.
+func (c *Client) ServiceDiscoveryShowServiceDiscoveryRun(ctx context.Context, params *ServiceDiscoveryShowServiceDiscoveryRunParams) (*DomainObject, *client.Response, error) {
+	out := new(DomainObject)
+	resp, err := c.Do(ctx, ServiceDiscoveryShowServiceDiscoveryRunOperation, params, nil, out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return out, resp, nil
+}
+
+// ServiceDiscoveryServiceDiscoveryRunWaitForCompletion calls the "Wait for service discovery completion" operation.
+func (c *Client) ServiceDiscoveryServiceDiscoveryRunWaitForCompletion(ctx context.Context, params *ServiceDiscoveryServiceDiscoveryRunWaitForCompletionParams) (*client.Response, error) {
+	return c.Do(ctx, ServiceDiscoveryServiceDiscoveryRunWaitForCompletionOperation, params, nil, nil)
+}
+
+// ServiceGroupConfigShowGroup calls the "Show a service group" operation.
+func (c *Client) ServiceGroupConfigShowGroup(ctx context.Context, params *ServiceGroupConfigShowGroupParams) (*ServiceGroup, *client.Response, error) {
+	out := new(ServiceGroup)
+	resp, err := c.Do(ctx, ServiceGroupConfigShowGroupOperation, params, nil, out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return out, resp, nil
+}
+
+// ServiceGroupConfigUpdate calls the "Update a service group" operation.
+func (c *Client) ServiceGroupConfigUpdate(ctx context.Context, params *ServiceGroupConfigUpdateParams, body *UpdateGroup2) (*ServiceGroup, *client.Response, error) {
+	out := new(ServiceGroup)
+	resp, err := c.Do(ctx, ServiceGroupConfigUpdateOperation, params, body, out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return out, resp, nil
+}
+
+// ServiceGroupConfigDelete calls the "Delete a service group" operation.
+func (c *Client) ServiceGroupConfigDelete(ctx context.Context, params *ServiceGroupConfigDeleteParams) (*client.Response, error) {
+	return c.Do(ctx, ServiceGroupConfigDeleteOperation, params, nil, nil)
+}
+
+// SiteManagementShowSite calls the "Show a site connection" operation.
+func (c *Client) SiteManagementShowSite(ctx context.Context, params *SiteManagementShowSiteParams) (*SiteConnectionResponse, *client.Response, error) {
+	out := new(SiteConnectionResponse)
+	resp, err := c.Do(ctx, SiteManagementShowSiteOperation, params, nil, out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return out, resp, nil
+}
+
+// SiteManagementPutSite calls the "Update a site connection" operation.
+func (c *Client) SiteManagementPutSite(ctx context.Context, params *SiteManagementPutSiteParams, body *SiteConnectionRequestUpdate) (*SiteConnectionResponse, *client.Response, error) {
+	out := new(SiteConnectionResponse)
+	resp, err := c.Do(ctx, SiteManagementPutSiteOperation, params, body, out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return out, resp, nil
+}
+
+// SiteManagementDeleteSite calls the "Delete a site connection" operation.
+func (c *Client) SiteManagementDeleteSite(ctx context.Context, params *SiteManagementDeleteSiteParams) (*client.Response, error) {
+	return c.Do(ctx, SiteManagementDeleteSiteOperation, params, nil, nil)
+}
+
+// SiteManagementSiteLogin calls the "Login to a remote site" operation.
+func (c *Client) SiteManagementSiteLogin(ctx context.Context, params *SiteManagementSiteLoginParams, body *SiteLoginRequest) (*client.Response, error) {
+	return c.Do(ctx, SiteManagementSiteLoginOperation, params, body, nil)
+}
+
+// SiteManagementSiteLogout calls the "Logout from a remote site" operation.
+func (c *Client) SiteManagementSiteLogout(ctx context.Context, params *SiteManagementSiteLogoutParams) (*client.Response, error) {
+	return c.Do(ctx, SiteManagementSiteLogoutOperation, params, nil, nil)
+}
+
+// TimePeriodsShowTimePeriod calls the "Show a time period" operation.
+func (c *Client) TimePeriodsShowTimePeriod(ctx context.Context, params *TimePeriodsShowTimePeriodParams) (*TimePeriodResponse, *client.Response, error) {
+	out := new(TimePeriodResponse)
+	resp, err := c.Do(ctx, TimePeriodsShowTimePeriodOperation, params, nil, out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return out, resp, nil
+}
+
+// TimePeriodsUpdateTimeperiod calls the "Update a time period" operation.
+func (c *Client) TimePeriodsUpdateTimeperiod(ctx context.Context, params *TimePeriodsUpdateTimeperiodParams, body *UpdateTimePeriod) (*TimePeriodResponse, *client.Response, error) {
+	out := new(TimePeriodResponse)
+	resp, err := c.Do(ctx, TimePeriodsUpdateTimeperiodOperation, params, body, out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return out, resp, nil
+}
+
+// TimePeriodsDelete calls the "Delete a time period" operation.
+func (c *Client) TimePeriodsDelete(ctx context.Context, params *TimePeriodsDeleteParams) (*client.Response, error) {
+	return c.Do(ctx, TimePeriodsDeleteOperation, params, nil, nil)
+}
+
+// UserConfigShowUser calls the "Show a user" operation.
+func (c *Client) UserConfigShowUser(ctx context.Context, params *UserConfigShowUserParams) (*UserObject, *client.Response, error) {
+	out := new(UserObject)
+	resp, err := c.Do(ctx, UserConfigShowUserOperation, params, nil, out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return out, resp, nil
+}
+
+// UserConfigEditUser calls the "Edit a user" operation.
+func (c *Client) UserConfigEditUser(ctx context.Context, params *UserConfigEditUserParams, body *UpdateUser) (*UserObject, *client.Response, error) {
+	out := new(UserObject)
+	resp, err := c.Do(ctx, UserConfigEditUserOperation, params, body, out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return out, resp, nil
+}
+
+// UserConfigDeleteUser calls the "Delete a user" operation.
+func (c *Client) UserConfigDeleteUser(ctx context.Context, params *UserConfigDeleteUserParams) (*client.Response, error) {
+	return c.Do(ctx, UserConfigDeleteUserOperation, params, nil, nil)
+}
+
+// UserRoleShowUserRole calls the "Show a user role" operation.
+func (c *Client) UserRoleShowUserRole(ctx context.Context, params *UserRoleShowUserRoleParams) (*UserRoleObject, *client.Response, error) {
+	out := new(UserRoleObject)
+	resp, err := c.Do(ctx, UserRoleShowUserRoleOperation, params, nil, out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return out, resp, nil
+}
+
+// UserRoleEditUserrole calls the "Edit a user role" operation.
+func (c *Client) UserRoleEditUserrole(ctx context.Context, params *UserRoleEditUserroleParams, body *EditUserRole) (*UserRoleObject, *client.Response, error) {
+	out := new(UserRoleObject)
+	resp, err := c.Do(ctx, UserRoleEditUserroleOperation, params, body, out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return out, resp, nil
+}
+
+// UserRoleDeleteUserrole calls the "Delete a user role" operation.
+func (c *Client) UserRoleDeleteUserrole(ctx context.Context, params *UserRoleDeleteUserroleParams) (*client.Response, error) {
+	return c.Do(ctx, UserRoleDeleteUserroleOperation, params, nil, nil)
+}
+
+// CertRootCert calls the "X.509 PEM-encoded root certificate" operation.
+func (c *Client) CertRootCert(ctx context.Context) (*X509PEM, *client.Response, error) {
+	out := new(X509PEM)
+	resp, err := c.Do(ctx, CertRootCertOperation, nil, nil, out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return out, resp, nil
+}
+
+// VersionSearch calls the "Display some version information" operation.
+func (c *Client) VersionSearch(ctx context.Context) (*InstalledVersions, *client.Response, error) {
+	out := new(InstalledVersions)
+	resp, err := c.Do(ctx, VersionSearchOperation, nil, nil, out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return out, resp, nil
+}
+
+// ContactGroupConfigUpdateWithRetry reads the object with ContactGroupConfigShow and calls
+// ContactGroupConfigUpdate with its ETag as If-Match, building the body with mutate.
+// On 412 Precondition Failed the cycle is repeated with a fresh read,
+// up to Client.UpdateAttempts times.
+func (c *Client) ContactGroupConfigUpdateWithRetry(ctx context.Context, params *ContactGroupConfigUpdateParams, mutate func(current *ContactGroup) (*UpdateGroup, error)) (*ContactGroup, *client.Response, error) {
+	var out *ContactGroup
+	var resp *client.Response
+	err := c.RetryPreconditionFailed(ctx, func(ctx context.Context) error {
+		current, fetched, err := c.ContactGroupConfigShow(ctx, &ContactGroupConfigShowParams{Name: params.Name})
+		if err != nil {
+			return err
+		}
+		body, err := mutate(current)
+		if err != nil {
+			return err
+		}
+		p := *params
+		p.IfMatch = fetched.ETag()
+		out, resp, err = c.ContactGroupConfigUpdate(ctx, &p, body)
+		return err
+	})
+	return out, resp, err
+}
+
+// FolderConfigUpdateWithRetry reads the object with FolderConfigShowFolder and calls
+// FolderConfigUpdate with its ETag as If-Match, building the body with mutate.
+// On 412 Precondition Failed the cycle is repeated with a fresh read,
+// up to Client.UpdateAttempts times.
+func (c *Client) FolderConfigUpdateWithRetry(ctx context.Context, params *FolderConfigUpdateParams, mutate func(current *Folder) (*UpdateFolder, error)) (*Folder, *client.Response, error) {
+	var out *Folder
+	var resp *client.Response
+	err := c.RetryPreconditionFailed(ctx, func(ctx context.Context) error {
+		current, fetched, err := c.FolderConfigShowFolder(ctx, &FolderConfigShowFolderParams{Folder: params.Folder})
+		if err != nil {
+			return err
+		}
+		body, err := mutate(current)
+		if err != nil {
+			return err
+		}
+		p := *params
+		p.IfMatch = fetched.ETag()
+		out, resp, err = c.FolderConfigUpdate(ctx, &p, body)
+		return err
+	})
+	return out, resp, err
+}
+
+// FolderConfigMoveWithRetry reads the object with FolderConfigShowFolder and calls
+// FolderConfigMove with its ETag as If-Match, building the body with mutate.
+// On 412 Precondition Failed the cycle is repeated with a fresh read,
+// up to Client.UpdateAttempts times.
+func (c *Client) FolderConfigMoveWithRetry(ctx context.Context, params *FolderConfigMoveParams, mutate func(current *Folder) (*MoveFolder, error)) (*Folder, *client.Response, error) {
+	var out *Folder
+	var resp *client.Response
+	err := c.RetryPreconditionFailed(ctx, func(ctx context.Context) error {
+		current, fetched, err := c.FolderConfigShowFolder(ctx, &FolderConfigShowFolderParams{Folder: params.Folder})
+		if err != nil {
+			return err
+		}
+		body, err := mutate(current)
+		if err != nil {
+			return err
+		}
+		p := *params
+		p.IfMatch = fetched.ETag()
+		out, resp, err = c.FolderConfigMove(ctx, &p, body)
+		return err
+	})
+	return out, resp, err
+}
+
+// HostConfigUpdateHostWithRetry reads the object with HostConfigShowHost and calls
+// HostConfigUpdateHost with its ETag as If-Match, building the body with mutate.
+// On 412 Precondition Failed the cycle is repeated with a fresh read,
+// up to Client.UpdateAttempts times.
+func (c *Client) HostConfigUpdateHostWithRetry(ctx context.Context, params *HostConfigUpdateHostParams, mutate func(current *HostConfig) (*UpdateHost, error)) (*HostConfig, *client.Response, error) {
+	var out *HostConfig
+	var resp *client.Response
+	err := c.RetryPreconditionFailed(ctx, func(ctx context.Context) error {
+		current, fetched, err := c.HostConfigShowHost(ctx, &HostConfigShowHostParams{HostName: params.HostName})
+		if err != nil {
+			return err
+		}
+		body, err := mutate(current)
+		if err != nil {
+			return err
+		}
+		p := *params
+		p.IfMatch = fetched.ETag()
+		out, resp, err = c.HostConfigUpdateHost(ctx, &p, body)
+		return err
+	})
+	return out, resp, err
+}
+
+// HostConfigMoveWithRetry reads the object with HostConfigShowHost and calls
+// HostConfigMove with its ETag as If-Match, building the body with mutate.
+// On 412 Precondition Failed the cycle is repeated with a fresh read,
+// up to Client.UpdateAttempts times.
+func (c *Client) HostConfigMoveWithRetry(ctx context.Context, params *HostConfigMoveParams, mutate func(current *HostConfig) (*MoveHost, error)) (*HostConfig, *client.Response, error) {
+	var out *HostConfig
+	var resp *client.Response
+	err := c.RetryPreconditionFailed(ctx, func(ctx context.Context) error {
+		current, fetched, err := c.HostConfigShowHost(ctx, &HostConfigShowHostParams{HostName: params.HostName})
+		if err != nil {
+			return err
+		}
+		body, err := mutate(current)
+		if err != nil {
+			return err
+		}
+		p := *params
+		p.IfMatch = fetched.ETag()
+		out, resp, err = c.HostConfigMove(ctx, &p, body)
+		return err
+	})
+	return out, resp, err
+}
+
+// HostConfigRenameHostWithRetry reads the object with HostConfigShowHost and calls
+// HostConfigRenameHost with its ETag as If-Match, building the body with mutate.
+// On 412 Precondition Failed the cycle is repeated with a fresh read,
+// up to Client.UpdateAttempts times.
+func (c *Client) HostConfigRenameHostWithRetry(ctx context.Context, params *HostConfigRenameHostParams, mutate func(current *HostConfig) (*RenameHost, error)) (*HostConfig, *client.Response, error) {
+	var out *HostConfig
+	var resp *client.Response
+	err := c.RetryPreconditionFailed(ctx, func(ctx context.Context) error {
+		current, fetched, err := c.HostConfigShowHost(ctx, &HostConfigShowHostParams{HostName: params.HostName})
+		if err != nil {
+			return err
+		}
+		body, err := mutate(current)
+		if err != nil {
+			return err
+		}
+		p := *params
+		p.IfMatch = fetched.ETag()
+		out, resp, err = c.HostConfigRenameHost(ctx, &p, body)
+		return err
+	})
+	return out, resp, err
+}
+
+// HostConfigUpdateNodesWithRetry reads the object with HostConfigShowHost and calls
+// HostConfigUpdateNodes with its ETag as If-Match, building the body with mutate.
+// On 412 Precondition Failed the cycle is repeated with a fresh read,
+// up to Client.UpdateAttempts times.
+func (c *Client) HostConfigUpdateNodesWithRetry(ctx context.Context, params *HostConfigUpdateNodesParams, mutate func(current *HostConfig) (*UpdateNodes, error)) (*ObjectProperty, *client.Response, error) {
+	var out *ObjectProperty
+	var resp *client.Response
+	err := c.RetryPreconditionFailed(ctx, func(ctx context.Context) error {
+		current, fetched, err := c.HostConfigShowHost(ctx, &HostConfigShowHostParams{HostName: params.HostName})
+		if err != nil {
+			return err
+		}
+		body, err := mutate(current)
+		if err != nil {
+			return err
+		}
+		p := *params
+		p.IfMatch = fetched.ETag()
+		out, resp, err = c.HostConfigUpdateNodes(ctx, &p, body)
+		return err
+	})
+	return out, resp, err
+}
+
+// HostGroupConfigUpdateWithRetry reads the object with HostGroupConfigGet and calls
+// HostGroupConfigUpdate with its ETag as If-Match, building the body with mutate.
+// On 412 Precondition Failed the cycle is repeated with a fresh read,
+// up to Client.UpdateAttempts times.
+func (c *Client) HostGroupConfigUpdateWithRetry(ctx context.Context, params *HostGroupConfigUpdateParams, mutate func(current *HostGroup) (*UpdateGroup1, error)) (*HostGroup, *client.Response, error) {
+	var out *HostGroup
+	var resp *client.Response
+	err := c.RetryPreconditionFailed(ctx, func(ctx context.Context) error {
+		current, fetched, err := c.HostGroupConfigGet(ctx, &HostGroupConfigGetParams{Name: params.Name})
+		if err != nil {
+			return err
+		}
+		body, err := mutate(current)
+		if err != nil {
+			return err
+		}
+		p := *params
+		p.IfMatch = fetched.ETag()
+		out, resp, err = c.HostGroupConfigUpdate(ctx, &p, body)
+		return err
+	})
+	return out, resp, err
+}
+
+// HostTagGroupUpdateHostTagGroupWithRetry reads the object with HostTagGroupShowHostTagGroup and calls
+// HostTagGroupUpdateHostTagGroup with its ETag as If-Match, building the body with mutate.
+// On 412 Precondition Failed the cycle is repeated with a fresh read,
+// up to Client.UpdateAttempts times.
+func (c *Client) HostTagGroupUpdateHostTagGroupWithRetry(ctx context.Context, params *HostTagGroupUpdateHostTagGroupParams, mutate func(current *ConcreteHostTagGroup) (*UpdateHostTagGroup, error)) (*ConcreteHostTagGroup, *client.Response, error) {
+	var out *ConcreteHostTagGroup
+	var resp *client.Response
+	err := c.RetryPreconditionFailed(ctx, func(ctx context.Context) error {
+		current, fetched, err := c.HostTagGroupShowHostTagGroup(ctx, &HostTagGroupShowHostTagGroupParams{Name: params.Name})
+		if err != nil {
+			return err
+		}
+		body, err := mutate(current)
+		if err != nil {
+			return err
+		}
+		p := *params
+		p.IfMatch = fetched.ETag()
+		out, resp, err = c.HostTagGroupUpdateHostTagGroup(ctx, &p, body)
+		return err
+	})
+	return out, resp, err
+}
+
+// PasswordUpdatePasswordWithRetry reads the object with PasswordShowPassword and calls
+// PasswordUpdatePassword with its ETag as If-Match, building the body with mutate.
+// On 412 Precondition Failed the cycle is repeated with a fresh read,
+// up to Client.UpdateAttempts times.
+func (c *Client) PasswordUpdatePasswordWithRetry(ctx context.Context, params *PasswordUpdatePasswordParams, mutate func(current *PasswordObject) (*UpdatePassword, error)) (*PasswordObject, *client.Response, error) {
+	var out *PasswordObject
+	var resp *client.Response
+	err := c.RetryPreconditionFailed(ctx, func(ctx context.Context) error {
+		current, fetched, err := c.PasswordShowPassword(ctx, &PasswordShowPasswordParams{Name: params.Name})
+		if err != nil {
+			return err
+		}
+		body, err := mutate(current)
+		if err != nil {
+			return err
+		}
+		p := *params
+		p.IfMatch = fetched.ETag()
+		out, resp, err = c.PasswordUpdatePassword(ctx, &p, body)
+		return err
+	})
+	return out, resp, err
+}
+
+// RuleEditRuleWithRetry reads the object with RuleShowRule and calls
+// RuleEditRule with its ETag as If-Match, building the body with mutate.
+// On 412 Precondition Failed the cycle is repeated with a fresh read,
+// up to Client.UpdateAttempts times.
+func (c *Client) RuleEditRuleWithRetry(ctx context.Context, params *RuleEditRuleParams, mutate func(current *RuleObject) (*UpdateRuleObject, error)) (*RuleObject, *client.Response, error) {
+	var out *RuleObject
+	var resp *client.Response
+	err := c.RetryPreconditionFailed(ctx, func(ctx context.Context) error {
+		current, fetched, err := c.RuleShowRule(ctx, &RuleShowRuleParams{RuleId: params.RuleId})
+		if err != nil {
+			return err
+		}
+		body, err := mutate(current)
+		if err != nil {
+			return err
+		}
+		p := *params
+		p.IfMatch = fetched.ETag()
+		out, resp, err = c.RuleEditRule(ctx, &p, body)
+		return err
+	})
+	return out, resp, err
+}
+
+// RuleMoveRuleToWithRetry reads the object with RuleShowRule and calls
+// RuleMoveRuleTo with its ETag as If-Match, building the body with mutate.
+// On 412 Precondition Failed the cycle is repeated with a fresh read,
+// up to Client.UpdateAttempts times.
+func (c *Client) RuleMoveRuleToWithRetry(ctx context.Context, params *RuleMoveRuleToParams, mutate func(current *RuleObject) (*MoveRuleTo, error)) (*RuleObject, *client.Response, error) {
+	var out *RuleObject
+	var resp *client.Response
+	err := c.RetryPreconditionFailed(ctx, func(ctx context.Context) error {
+		current, fetched, err := c.RuleShowRule(ctx, &RuleShowRuleParams{RuleId: params.RuleId})
+		if err != nil {
+			return err
+		}
+		body, err := mutate(current)
+		if err != nil {
+			return err
+		}
+		p := *params
+		p.IfMatch = fetched.ETag()
+		out, resp, err = c.RuleMoveRuleTo(ctx, &p, body)
+		return err
+	})
+	return out, resp, err
+}
+
+// ServiceGroupConfigUpdateWithRetry reads the object with ServiceGroupConfigShowGroup and calls
+// ServiceGroupConfigUpdate with its ETag as If-Match, building the body with mutate.
+// On 412 Precondition Failed the cycle is repeated with a fresh read,
+// up to Client.UpdateAttempts times.
+func (c *Client) ServiceGroupConfigUpdateWithRetry(ctx context.Context, params *ServiceGroupConfigUpdateParams, mutate func(current *ServiceGroup) (*UpdateGroup2, error)) (*ServiceGroup, *client.Response, error) {
+	var out *ServiceGroup
+	var resp *client.Response
+	err := c.RetryPreconditionFailed(ctx, func(ctx context.Context) error {
+		current, fetched, err := c.ServiceGroupConfigShowGroup(ctx, &ServiceGroupConfigShowGroupParams{Name: params.Name})
+		if err != nil {
+			return err
+		}
+		body, err := mutate(current)
+		if err != nil {
+			return err
+		}
+		p := *params
+		p.IfMatch = fetched.ETag()
+		out, resp, err = c.ServiceGroupConfigUpdate(ctx, &p, body)
+		return err
+	})
+	return out, resp, err
+}
+
+// TimePeriodsUpdateTimeperiodWithRetry reads the object with TimePeriodsShowTimePeriod and calls
+// TimePeriodsUpdateTimeperiod with its ETag as If-Match, building the body with mutate.
+// On 412 Precondition Failed the cycle is repeated with a fresh read,
+// up to Client.UpdateAttempts times.
+func (c *Client) TimePeriodsUpdateTimeperiodWithRetry(ctx context.Context, params *TimePeriodsUpdateTimeperiodParams, mutate func(current *TimePeriodResponse) (*UpdateTimePeriod, error)) (*TimePeriodResponse, *client.Response, error) {
+	var out *TimePeriodResponse
+	var resp *client.Response
+	err := c.RetryPreconditionFailed(ctx, func(ctx context.Context) error {
+		current, fetched, err := c.TimePeriodsShowTimePeriod(ctx, &TimePeriodsShowTimePeriodParams{Name: params.Name})
+		if err != nil {
+			return err
+		}
+		body, err := mutate(current)
+		if err != nil {
+			return err
+		}
+		p := *params
+		p.IfMatch = fetched.ETag()
+		out, resp, err = c.TimePeriodsUpdateTimeperiod(ctx, &p, body)
+		return err
+	})
+	return out, resp, err
+}
+
+// TimePeriodsDeleteWithRetry reads the object with TimePeriodsShowTimePeriod and calls
+// TimePeriodsDelete with its ETag as If-Match.
+// On 412 Precondition Failed the cycle is repeated with a fresh read,
+// up to Client.UpdateAttempts times.
+func (c *Client) TimePeriodsDeleteWithRetry(ctx context.Context, params *TimePeriodsDeleteParams) (*client.Response, error) {
+	var resp *client.Response
+	err := c.RetryPreconditionFailed(ctx, func(ctx context.Context) error {
+		_, fetched, err := c.TimePeriodsShowTimePeriod(ctx, &TimePeriodsShowTimePeriodParams{Name: params.Name})
+		if err != nil {
+			return err
+		}
+		p := *params
+		p.IfMatch = fetched.ETag()
+		resp, err = c.TimePeriodsDelete(ctx, &p)
+		return err
+	})
+	return resp, err
+}
+
+// UserConfigEditUserWithRetry reads the object with UserConfigShowUser and calls
+// UserConfigEditUser with its ETag as If-Match, building the body with mutate.
+// On 412 Precondition Failed the cycle is repeated with a fresh read,
+// up to Client.UpdateAttempts times.
+func (c *Client) UserConfigEditUserWithRetry(ctx context.Context, params *UserConfigEditUserParams, mutate func(current *UserObject) (*UpdateUser, error)) (*UserObject, *client.Response, error) {
+	var out *UserObject
+	var resp *client.Response
+	err := c.RetryPreconditionFailed(ctx, func(ctx context.Context) error {
+		current, fetched, err := c.UserConfigShowUser(ctx, &UserConfigShowUserParams{Username: params.Username})
+		if err != nil {
+			return err
+		}
+		body, err := mutate(current)
+		if err != nil {
+			return err
+		}
+		p := *params
+		p.IfMatch = fetched.ETag()
+		out, resp, err = c.UserConfigEditUser(ctx, &p, body)
+		return err
+	})
+	return out, resp, err
+}
+
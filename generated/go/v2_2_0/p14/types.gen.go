@@ -9,6 +9,11 @@
 
 package p14
 
+import (
+	"encoding/json"
+	"fmt"
+)
+
 // AcknowledgeHostGroupProblem represents a CheckMK API type.
 type AcknowledgeHostGroupProblem struct {
 	// The acknowledge host selection type.
@@ -69,14 +74,115 @@ type AcknowledgeHostQueryProblem struct {
 	Persistent bool `json:"persistent,omitempty"`
 	// An query expression of the Livestatus 'hosts' table in nested dictionary form. If you want to use multiple expressions, nest them with the AND/OR operators.
 	// Example: {"op": "and", "expr": [{"op": "=", "left": "name", "right": "example.com"}, {"op": "!=", "left": "state", "right": "0"}]}
-	Query interface{} `json:"query"`
+	Query Expr `json:"query"`
 	// If set, only a state-change of the host to an UP state will discard the acknowledgement. Otherwise it will be discarded on any state-change. Defaults to False.
 	// Example: false
 	Sticky bool `json:"sticky,omitempty"`
 }
 
-// AcknowledgeHostRelatedProblem represents a CheckMK API type.
+// AcknowledgeHostRelatedProblem is a union of AcknowledgeHostProblem, AcknowledgeHostGroupProblem, AcknowledgeHostQueryProblem, selected by the "acknowledge_type" property.
 type AcknowledgeHostRelatedProblem struct {
+	union json.RawMessage
+}
+
+// Discriminator returns the value of the "acknowledge_type" property.
+func (u AcknowledgeHostRelatedProblem) Discriminator() (string, error) {
+	return unionDiscriminator(u.union, "acknowledge_type")
+}
+
+// AsAcknowledgeHostProblem returns the union data as a AcknowledgeHostProblem.
+func (u AcknowledgeHostRelatedProblem) AsAcknowledgeHostProblem() (AcknowledgeHostProblem, error) {
+	var v AcknowledgeHostProblem
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromAcknowledgeHostProblem sets the union to v, with "acknowledge_type" set to "host".
+func (u *AcknowledgeHostRelatedProblem) FromAcknowledgeHostProblem(v AcknowledgeHostProblem) error {
+	data, err := unionFrom(v, "acknowledge_type", "host")
+	if err != nil {
+		return fmt.Errorf("AcknowledgeHostRelatedProblem: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsAcknowledgeHostGroupProblem returns the union data as a AcknowledgeHostGroupProblem.
+func (u AcknowledgeHostRelatedProblem) AsAcknowledgeHostGroupProblem() (AcknowledgeHostGroupProblem, error) {
+	var v AcknowledgeHostGroupProblem
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromAcknowledgeHostGroupProblem sets the union to v, with "acknowledge_type" set to "hostgroup".
+func (u *AcknowledgeHostRelatedProblem) FromAcknowledgeHostGroupProblem(v AcknowledgeHostGroupProblem) error {
+	data, err := unionFrom(v, "acknowledge_type", "hostgroup")
+	if err != nil {
+		return fmt.Errorf("AcknowledgeHostRelatedProblem: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsAcknowledgeHostQueryProblem returns the union data as a AcknowledgeHostQueryProblem.
+func (u AcknowledgeHostRelatedProblem) AsAcknowledgeHostQueryProblem() (AcknowledgeHostQueryProblem, error) {
+	var v AcknowledgeHostQueryProblem
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromAcknowledgeHostQueryProblem sets the union to v, with "acknowledge_type" set to "host_by_query".
+func (u *AcknowledgeHostRelatedProblem) FromAcknowledgeHostQueryProblem(v AcknowledgeHostQueryProblem) error {
+	data, err := unionFrom(v, "acknowledge_type", "host_by_query")
+	if err != nil {
+		return fmt.Errorf("AcknowledgeHostRelatedProblem: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// ValueByDiscriminator decodes the union into the member selected by "acknowledge_type".
+func (u AcknowledgeHostRelatedProblem) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := u.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "host":
+		return u.AsAcknowledgeHostProblem()
+	case "hostgroup":
+		return u.AsAcknowledgeHostGroupProblem()
+	case "host_by_query":
+		return u.AsAcknowledgeHostQueryProblem()
+	}
+	return nil, fmt.Errorf("AcknowledgeHostRelatedProblem: unknown acknowledge_type %q", discriminator)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u AcknowledgeHostRelatedProblem) MarshalJSON() ([]byte, error) {
+	if len(u.union) == 0 {
+		return []byte("null"), nil
+	}
+	return u.union, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting unknown "acknowledge_type" values.
+func (u *AcknowledgeHostRelatedProblem) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		u.union = nil
+		return nil
+	}
+	discriminator, err := unionDiscriminator(data, "acknowledge_type")
+	if err != nil {
+		return fmt.Errorf("AcknowledgeHostRelatedProblem: %w", err)
+	}
+	switch discriminator {
+	case "host", "hostgroup", "host_by_query":
+	default:
+		return fmt.Errorf("AcknowledgeHostRelatedProblem: unknown acknowledge_type %q", discriminator)
+	}
+	u.union = append(json.RawMessage(nil), data...)
+	return nil
 }
 
 // AcknowledgeServiceGroupProblem represents a CheckMK API type.
@@ -117,14 +223,115 @@ type AcknowledgeServiceQueryProblem struct {
 	Persistent bool `json:"persistent,omitempty"`
 	// An query expression of the Livestatus 'services' table in nested dictionary form. If you want to use multiple expressions, nest them with the AND/OR operators.
 	// Example: {"op": "and", "expr": [{"op": "=", "left": "name", "right": "example.com"}, {"op": "!=", "left": "state", "right": "0"}]}
-	Query interface{} `json:"query"`
+	Query Expr `json:"query"`
 	// If set, only a state-change of the service to an OK state will discard the acknowledgement. Otherwise, it will be discarded on any state-change. Defaults to False.
 	// Example: false
 	Sticky bool `json:"sticky,omitempty"`
 }
 
-// AcknowledgeServiceRelatedProblem represents a CheckMK API type.
+// AcknowledgeServiceRelatedProblem is a union of AcknowledgeSpecificServiceProblem, AcknowledgeServiceGroupProblem, AcknowledgeServiceQueryProblem, selected by the "acknowledge_type" property.
 type AcknowledgeServiceRelatedProblem struct {
+	union json.RawMessage
+}
+
+// Discriminator returns the value of the "acknowledge_type" property.
+func (u AcknowledgeServiceRelatedProblem) Discriminator() (string, error) {
+	return unionDiscriminator(u.union, "acknowledge_type")
+}
+
+// AsAcknowledgeSpecificServiceProblem returns the union data as a AcknowledgeSpecificServiceProblem.
+func (u AcknowledgeServiceRelatedProblem) AsAcknowledgeSpecificServiceProblem() (AcknowledgeSpecificServiceProblem, error) {
+	var v AcknowledgeSpecificServiceProblem
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromAcknowledgeSpecificServiceProblem sets the union to v, with "acknowledge_type" set to "service".
+func (u *AcknowledgeServiceRelatedProblem) FromAcknowledgeSpecificServiceProblem(v AcknowledgeSpecificServiceProblem) error {
+	data, err := unionFrom(v, "acknowledge_type", "service")
+	if err != nil {
+		return fmt.Errorf("AcknowledgeServiceRelatedProblem: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsAcknowledgeServiceGroupProblem returns the union data as a AcknowledgeServiceGroupProblem.
+func (u AcknowledgeServiceRelatedProblem) AsAcknowledgeServiceGroupProblem() (AcknowledgeServiceGroupProblem, error) {
+	var v AcknowledgeServiceGroupProblem
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromAcknowledgeServiceGroupProblem sets the union to v, with "acknowledge_type" set to "servicegroup".
+func (u *AcknowledgeServiceRelatedProblem) FromAcknowledgeServiceGroupProblem(v AcknowledgeServiceGroupProblem) error {
+	data, err := unionFrom(v, "acknowledge_type", "servicegroup")
+	if err != nil {
+		return fmt.Errorf("AcknowledgeServiceRelatedProblem: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsAcknowledgeServiceQueryProblem returns the union data as a AcknowledgeServiceQueryProblem.
+func (u AcknowledgeServiceRelatedProblem) AsAcknowledgeServiceQueryProblem() (AcknowledgeServiceQueryProblem, error) {
+	var v AcknowledgeServiceQueryProblem
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromAcknowledgeServiceQueryProblem sets the union to v, with "acknowledge_type" set to "service_by_query".
+func (u *AcknowledgeServiceRelatedProblem) FromAcknowledgeServiceQueryProblem(v AcknowledgeServiceQueryProblem) error {
+	data, err := unionFrom(v, "acknowledge_type", "service_by_query")
+	if err != nil {
+		return fmt.Errorf("AcknowledgeServiceRelatedProblem: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// ValueByDiscriminator decodes the union into the member selected by "acknowledge_type".
+func (u AcknowledgeServiceRelatedProblem) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := u.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "service":
+		return u.AsAcknowledgeSpecificServiceProblem()
+	case "servicegroup":
+		return u.AsAcknowledgeServiceGroupProblem()
+	case "service_by_query":
+		return u.AsAcknowledgeServiceQueryProblem()
+	}
+	return nil, fmt.Errorf("AcknowledgeServiceRelatedProblem: unknown acknowledge_type %q", discriminator)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u AcknowledgeServiceRelatedProblem) MarshalJSON() ([]byte, error) {
+	if len(u.union) == 0 {
+		return []byte("null"), nil
+	}
+	return u.union, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting unknown "acknowledge_type" values.
+func (u *AcknowledgeServiceRelatedProblem) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		u.union = nil
+		return nil
+	}
+	discriminator, err := unionDiscriminator(data, "acknowledge_type")
+	if err != nil {
+		return fmt.Errorf("AcknowledgeServiceRelatedProblem: %w", err)
+	}
+	switch discriminator {
+	case "service", "servicegroup", "service_by_query":
+	default:
+		return fmt.Errorf("AcknowledgeServiceRelatedProblem: unknown acknowledge_type %q", discriminator)
+	}
+	u.union = append(json.RawMessage(nil), data...)
+	return nil
 }
 
 // AcknowledgeSpecificServiceProblem represents a CheckMK API type.
@@ -166,7 +373,7 @@ type ActivateChanges struct {
 // ActivationExtensionFields represents a CheckMK API type.
 type ActivationExtensionFields struct {
 	// The changes in this activation
-	Changes []map[string]interface{} `json:"changes,omitempty"`
+	Changes []ChangesFields `json:"changes,omitempty"`
 	// If the activation is still running
 	// Example: false
 	ForceForeignChanges bool `json:"force_foreign_changes,omitempty"`
@@ -190,10 +397,10 @@ type ActivationRunCollection struct {
 	// The name of this collection.
 	Id string `json:"id,omitempty"`
 	// list of links to other resources.
-	Links []map[string]interface{} `json:"links"`
+	Links []Link `json:"links"`
 	// A list of activation runs.
 	// Example: [map[domainType:activation_run extensions:map[activate_foreign:true changes:[map[action_name:edit-host id:da5430a5-6d0a-48ae-9efd-0563482a3b36 text:Modified host heute. time:2023-01-20T16:31:51.362057+00:00 user_id:cmkadmin]] comment: is_running:false sites:[heute] time_started:2023-01-20T16:31:5...
-	Value []map[string]interface{} `json:"value,omitempty"`
+	Value []ActivationRunResponse `json:"value,omitempty"`
 }
 
 // ActivationRunResponse represents a CheckMK API type.
@@ -202,12 +409,12 @@ type ActivationRunResponse struct {
 	DomainType interface{} `json:"domainType,omitempty"`
 	// The activation run attributes.
 	// Example: map[activate_foreign:true changes:[map[action_name:edit-host id:da5430a5-6d0a-48ae-9efd-0563482a3b36 text:Modified host heute. time:2023-01-20T16:31:51.362057+00:00 user_id:cmkadmin]] comment: is_running:false sites:[heute] time_started:2023-01-20T16:31:54.306846+00:00]
-	Extensions interface{} `json:"extensions,omitempty"`
+	Extensions *ActivationExtensionFields `json:"extensions,omitempty"`
 	// The unique identifier for this activation run.
 	// Example: 84b18e42-355e-4f13-80b6-404bd8f21149
 	Id string `json:"id,omitempty"`
 	// list of links to other resources.
-	Links []map[string]interface{} `json:"links"`
+	Links []Link `json:"links"`
 	// The container for external resources, like linked foreign objects or actions.
 	Members map[string]interface{} `json:"members,omitempty"`
 	// The activation run status.
@@ -241,22 +448,104 @@ type ApiError struct {
 
 // AsciiMailPluginCreate represents a CheckMK API type.
 type AsciiMailPluginCreate struct {
-	BodyHeadForBothHostAndServiceNotifications map[string]interface{} `json:"body_head_for_both_host_and_service_notifications"`
-	BodyTailForHostNotifications map[string]interface{} `json:"body_tail_for_host_notifications"`
-	BodyTailForServiceNotifications map[string]interface{} `json:"body_tail_for_service_notifications"`
-	FromDetails map[string]interface{} `json:"from_details"`
+	BodyHeadForBothHostAndServiceNotifications CheckboxOneOf `json:"body_head_for_both_host_and_service_notifications"`
+	BodyTailForHostNotifications CheckboxOneOf `json:"body_tail_for_host_notifications"`
+	BodyTailForServiceNotifications CheckboxOneOf `json:"body_tail_for_service_notifications"`
+	FromDetails CheckboxOneOf `json:"from_details"`
 	// The plugin name.
 	// Example: mail
 	PluginName AsciiMailPluginCreatePluginName `json:"plugin_name"`
-	ReplyTo map[string]interface{} `json:"reply_to"`
-	SendSeparateNotificationToEveryRecipient map[string]interface{} `json:"send_separate_notification_to_every_recipient"`
-	SortOrderForBulkNotificaions map[string]interface{} `json:"sort_order_for_bulk_notificaions"`
-	SubjectForHostNotifications map[string]interface{} `json:"subject_for_host_notifications"`
-	SubjectForServiceNotifications map[string]interface{} `json:"subject_for_service_notifications"`
+	ReplyTo CheckboxOneOf `json:"reply_to"`
+	SendSeparateNotificationToEveryRecipient Checkbox `json:"send_separate_notification_to_every_recipient"`
+	SortOrderForBulkNotificaions CheckboxOneOf `json:"sort_order_for_bulk_notificaions"`
+	SubjectForHostNotifications CheckboxOneOf `json:"subject_for_host_notifications"`
+	SubjectForServiceNotifications CheckboxOneOf `json:"subject_for_service_notifications"`
 }
 
-// AuthOption represents a CheckMK API type.
+// AuthOption is a union of AuthPassword, AuthSecret, selected by the "auth_type" property.
 type AuthOption struct {
+	union json.RawMessage
+}
+
+// Discriminator returns the value of the "auth_type" property.
+func (u AuthOption) Discriminator() (string, error) {
+	return unionDiscriminator(u.union, "auth_type")
+}
+
+// AsAuthPassword returns the union data as a AuthPassword.
+func (u AuthOption) AsAuthPassword() (AuthPassword, error) {
+	var v AuthPassword
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromAuthPassword sets the union to v, with "auth_type" set to "password".
+func (u *AuthOption) FromAuthPassword(v AuthPassword) error {
+	data, err := unionFrom(v, "auth_type", "password")
+	if err != nil {
+		return fmt.Errorf("AuthOption: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsAuthSecret returns the union data as a AuthSecret.
+func (u AuthOption) AsAuthSecret() (AuthSecret, error) {
+	var v AuthSecret
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromAuthSecret sets the union to v, with "auth_type" set to "automation".
+func (u *AuthOption) FromAuthSecret(v AuthSecret) error {
+	data, err := unionFrom(v, "auth_type", "automation")
+	if err != nil {
+		return fmt.Errorf("AuthOption: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// ValueByDiscriminator decodes the union into the member selected by "auth_type".
+func (u AuthOption) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := u.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "password":
+		return u.AsAuthPassword()
+	case "automation":
+		return u.AsAuthSecret()
+	}
+	return nil, fmt.Errorf("AuthOption: unknown auth_type %q", discriminator)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u AuthOption) MarshalJSON() ([]byte, error) {
+	if len(u.union) == 0 {
+		return []byte("null"), nil
+	}
+	return u.union, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting unknown "auth_type" values.
+func (u *AuthOption) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		u.union = nil
+		return nil
+	}
+	discriminator, err := unionDiscriminator(data, "auth_type")
+	if err != nil {
+		return fmt.Errorf("AuthOption: %w", err)
+	}
+	switch discriminator {
+	case "password", "automation":
+	default:
+		return fmt.Errorf("AuthOption: unknown auth_type %q", discriminator)
+	}
+	u.union = append(json.RawMessage(nil), data...)
+	return nil
 }
 
 // AuthOption1 represents a CheckMK API type.
@@ -290,8 +579,109 @@ type AuthSecret struct {
 	Secret string `json:"secret,omitempty"`
 }
 
-// AuthUpdateOption represents a CheckMK API type.
+// AuthUpdateOption is a union of AuthUpdatePassword, AuthUpdateSecret, AuthUpdateRemove, selected by the "auth_type" property.
 type AuthUpdateOption struct {
+	union json.RawMessage
+}
+
+// Discriminator returns the value of the "auth_type" property.
+func (u AuthUpdateOption) Discriminator() (string, error) {
+	return unionDiscriminator(u.union, "auth_type")
+}
+
+// AsAuthUpdatePassword returns the union data as a AuthUpdatePassword.
+func (u AuthUpdateOption) AsAuthUpdatePassword() (AuthUpdatePassword, error) {
+	var v AuthUpdatePassword
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromAuthUpdatePassword sets the union to v, with "auth_type" set to "password".
+func (u *AuthUpdateOption) FromAuthUpdatePassword(v AuthUpdatePassword) error {
+	data, err := unionFrom(v, "auth_type", "password")
+	if err != nil {
+		return fmt.Errorf("AuthUpdateOption: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsAuthUpdateSecret returns the union data as a AuthUpdateSecret.
+func (u AuthUpdateOption) AsAuthUpdateSecret() (AuthUpdateSecret, error) {
+	var v AuthUpdateSecret
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromAuthUpdateSecret sets the union to v, with "auth_type" set to "automation".
+func (u *AuthUpdateOption) FromAuthUpdateSecret(v AuthUpdateSecret) error {
+	data, err := unionFrom(v, "auth_type", "automation")
+	if err != nil {
+		return fmt.Errorf("AuthUpdateOption: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsAuthUpdateRemove returns the union data as a AuthUpdateRemove.
+func (u AuthUpdateOption) AsAuthUpdateRemove() (AuthUpdateRemove, error) {
+	var v AuthUpdateRemove
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromAuthUpdateRemove sets the union to v, with "auth_type" set to "remove".
+func (u *AuthUpdateOption) FromAuthUpdateRemove(v AuthUpdateRemove) error {
+	data, err := unionFrom(v, "auth_type", "remove")
+	if err != nil {
+		return fmt.Errorf("AuthUpdateOption: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// ValueByDiscriminator decodes the union into the member selected by "auth_type".
+func (u AuthUpdateOption) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := u.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "password":
+		return u.AsAuthUpdatePassword()
+	case "automation":
+		return u.AsAuthUpdateSecret()
+	case "remove":
+		return u.AsAuthUpdateRemove()
+	}
+	return nil, fmt.Errorf("AuthUpdateOption: unknown auth_type %q", discriminator)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u AuthUpdateOption) MarshalJSON() ([]byte, error) {
+	if len(u.union) == 0 {
+		return []byte("null"), nil
+	}
+	return u.union, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting unknown "auth_type" values.
+func (u *AuthUpdateOption) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		u.union = nil
+		return nil
+	}
+	discriminator, err := unionDiscriminator(data, "auth_type")
+	if err != nil {
+		return fmt.Errorf("AuthUpdateOption: %w", err)
+	}
+	switch discriminator {
+	case "password", "automation", "remove":
+	default:
+		return fmt.Errorf("AuthUpdateOption: unknown auth_type %q", discriminator)
+	}
+	u.union = append(json.RawMessage(nil), data...)
+	return nil
 }
 
 // AuthUpdatePassword represents a CheckMK API type.
@@ -369,11 +759,11 @@ type AuxTagResponse struct {
 	DomainType interface{} `json:"domainType,omitempty"`
 	// The Auxiliary Tag attributes.
 	// Example: map[help:Your help text id:snmp title:Monitoring via SNMP topic:Monitoring agents]
-	Extensions interface{} `json:"extensions,omitempty"`
+	Extensions *AuxTagAttrsResponse `json:"extensions,omitempty"`
 	// The unique identifier for this domain-object type.
 	Id string `json:"id,omitempty"`
 	// list of links to other resources.
-	Links []map[string]interface{} `json:"links"`
+	Links []Link `json:"links"`
 	// The container for external resources, like linked foreign objects or actions.
 	Members map[string]interface{} `json:"members,omitempty"`
 	// A human readable title of this object. Can be used for user interfaces.
@@ -389,16 +779,136 @@ type AuxTagResponseCollection struct {
 	// The name of this collection.
 	Id string `json:"id,omitempty"`
 	// list of links to other resources.
-	Links []map[string]interface{} `json:"links"`
+	Links []Link `json:"links"`
 	// A human readable title of this object. Can be used for user interfaces.
 	Title string `json:"title,omitempty"`
 	// A list of site configuration objects.
 	// Example: [map[domainType:aux_tag extensions:map[help:Your help text id:snmp title:Monitoring via SNMP topic:Monitoring agents] id:snmp links:[] members:map[] title:Monitoring via SNMP]]
-	Value []map[string]interface{} `json:"value,omitempty"`
+	Value []AuxTagResponse `json:"value,omitempty"`
 }
 
-// BIAction represents a CheckMK API type.
+// BIAction is a union of BICallARuleAction, BIStateOfHostAction, BIStateOfServiceAction, BIStateOfRemainingServicesAction, selected by the "type" property.
 type BIAction struct {
+	union json.RawMessage
+}
+
+// Discriminator returns the value of the "type" property.
+func (u BIAction) Discriminator() (string, error) {
+	return unionDiscriminator(u.union, "type")
+}
+
+// AsBICallARuleAction returns the union data as a BICallARuleAction.
+func (u BIAction) AsBICallARuleAction() (BICallARuleAction, error) {
+	var v BICallARuleAction
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromBICallARuleAction sets the union to v, with "type" set to "call_a_rule".
+func (u *BIAction) FromBICallARuleAction(v BICallARuleAction) error {
+	data, err := unionFrom(v, "type", "call_a_rule")
+	if err != nil {
+		return fmt.Errorf("BIAction: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsBIStateOfHostAction returns the union data as a BIStateOfHostAction.
+func (u BIAction) AsBIStateOfHostAction() (BIStateOfHostAction, error) {
+	var v BIStateOfHostAction
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromBIStateOfHostAction sets the union to v, with "type" set to "state_of_host".
+func (u *BIAction) FromBIStateOfHostAction(v BIStateOfHostAction) error {
+	data, err := unionFrom(v, "type", "state_of_host")
+	if err != nil {
+		return fmt.Errorf("BIAction: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsBIStateOfServiceAction returns the union data as a BIStateOfServiceAction.
+func (u BIAction) AsBIStateOfServiceAction() (BIStateOfServiceAction, error) {
+	var v BIStateOfServiceAction
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromBIStateOfServiceAction sets the union to v, with "type" set to "state_of_service".
+func (u *BIAction) FromBIStateOfServiceAction(v BIStateOfServiceAction) error {
+	data, err := unionFrom(v, "type", "state_of_service")
+	if err != nil {
+		return fmt.Errorf("BIAction: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsBIStateOfRemainingServicesAction returns the union data as a BIStateOfRemainingServicesAction.
+func (u BIAction) AsBIStateOfRemainingServicesAction() (BIStateOfRemainingServicesAction, error) {
+	var v BIStateOfRemainingServicesAction
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromBIStateOfRemainingServicesAction sets the union to v, with "type" set to "state_of_remaining_services".
+func (u *BIAction) FromBIStateOfRemainingServicesAction(v BIStateOfRemainingServicesAction) error {
+	data, err := unionFrom(v, "type", "state_of_remaining_services")
+	if err != nil {
+		return fmt.Errorf("BIAction: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// ValueByDiscriminator decodes the union into the member selected by "type".
+func (u BIAction) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := u.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "call_a_rule":
+		return u.AsBICallARuleAction()
+	case "state_of_host":
+		return u.AsBIStateOfHostAction()
+	case "state_of_service":
+		return u.AsBIStateOfServiceAction()
+	case "state_of_remaining_services":
+		return u.AsBIStateOfRemainingServicesAction()
+	}
+	return nil, fmt.Errorf("BIAction: unknown type %q", discriminator)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u BIAction) MarshalJSON() ([]byte, error) {
+	if len(u.union) == 0 {
+		return []byte("null"), nil
+	}
+	return u.union, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting unknown "type" values.
+func (u *BIAction) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		u.union = nil
+		return nil
+	}
+	discriminator, err := unionDiscriminator(data, "type")
+	if err != nil {
+		return fmt.Errorf("BIAction: %w", err)
+	}
+	switch discriminator {
+	case "call_a_rule", "state_of_host", "state_of_service", "state_of_remaining_services":
+	default:
+		return fmt.Errorf("BIAction: unknown type %q", discriminator)
+	}
+	u.union = append(json.RawMessage(nil), data...)
+	return nil
 }
 
 // BIAggregationComputationOptions represents a CheckMK API type.
@@ -413,32 +923,133 @@ type BIAggregationComputationOptions struct {
 type BIAggregationEndpoint struct {
 	// Nested dictionary
 	// Example: map[ignore_rule_styles:false layout_id:builtin_default line_style:round]
-	AggregationVisualization interface{} `json:"aggregation_visualization"`
+	AggregationVisualization BIAggregationVisualization `json:"aggregation_visualization"`
 	// An optional comment that may be used to explain the purpose of this object.
 	// Example: Rule comment
 	Comment string `json:"comment,omitempty"`
 	// Nested dictionary
 	// Example: map[disabled:false escalate_downtimes_as_warn:false freeze_aggregations:false use_hard_states:false]
-	ComputationOptions interface{} `json:"computation_options"`
+	ComputationOptions BIAggregationComputationOptions `json:"computation_options"`
 	// CME Edition only: The customer id for this aggregation.
 	// Example: customer1
 	Customer string `json:"customer,omitempty"`
 	// Nested dictionary
 	// Example: map[names:[groupA groupB] paths:[[path group a] [path group b]]]
-	Groups interface{} `json:"groups"`
+	Groups BIAggregationGroups `json:"groups"`
 	// The unique aggregation id
 	// Example: aggr1
 	Id string `json:"id"`
 	// Nested dictionary
 	// Example: map[action:map[host_regex: type:state_of_host] search:map[type:empty]]
-	Node interface{} `json:"node"`
+	Node BINodeGenerator `json:"node"`
 	// The identifier of the BI pack.
 	// Example: pack1
 	PackId string `json:"pack_id"`
 }
 
-// BIAggregationFunction represents a CheckMK API type.
+// BIAggregationFunction is a union of BIAggregationFunctionBest, BIAggregationFunctionWorst, BIAggregationFunctionCountOK, selected by the "type" property.
 type BIAggregationFunction struct {
+	union json.RawMessage
+}
+
+// Discriminator returns the value of the "type" property.
+func (u BIAggregationFunction) Discriminator() (string, error) {
+	return unionDiscriminator(u.union, "type")
+}
+
+// AsBIAggregationFunctionBest returns the union data as a BIAggregationFunctionBest.
+func (u BIAggregationFunction) AsBIAggregationFunctionBest() (BIAggregationFunctionBest, error) {
+	var v BIAggregationFunctionBest
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromBIAggregationFunctionBest sets the union to v, with "type" set to "best".
+func (u *BIAggregationFunction) FromBIAggregationFunctionBest(v BIAggregationFunctionBest) error {
+	data, err := unionFrom(v, "type", "best")
+	if err != nil {
+		return fmt.Errorf("BIAggregationFunction: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsBIAggregationFunctionWorst returns the union data as a BIAggregationFunctionWorst.
+func (u BIAggregationFunction) AsBIAggregationFunctionWorst() (BIAggregationFunctionWorst, error) {
+	var v BIAggregationFunctionWorst
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromBIAggregationFunctionWorst sets the union to v, with "type" set to "worst".
+func (u *BIAggregationFunction) FromBIAggregationFunctionWorst(v BIAggregationFunctionWorst) error {
+	data, err := unionFrom(v, "type", "worst")
+	if err != nil {
+		return fmt.Errorf("BIAggregationFunction: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsBIAggregationFunctionCountOK returns the union data as a BIAggregationFunctionCountOK.
+func (u BIAggregationFunction) AsBIAggregationFunctionCountOK() (BIAggregationFunctionCountOK, error) {
+	var v BIAggregationFunctionCountOK
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromBIAggregationFunctionCountOK sets the union to v, with "type" set to "count_ok".
+func (u *BIAggregationFunction) FromBIAggregationFunctionCountOK(v BIAggregationFunctionCountOK) error {
+	data, err := unionFrom(v, "type", "count_ok")
+	if err != nil {
+		return fmt.Errorf("BIAggregationFunction: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// ValueByDiscriminator decodes the union into the member selected by "type".
+func (u BIAggregationFunction) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := u.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "best":
+		return u.AsBIAggregationFunctionBest()
+	case "worst":
+		return u.AsBIAggregationFunctionWorst()
+	case "count_ok":
+		return u.AsBIAggregationFunctionCountOK()
+	}
+	return nil, fmt.Errorf("BIAggregationFunction: unknown type %q", discriminator)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u BIAggregationFunction) MarshalJSON() ([]byte, error) {
+	if len(u.union) == 0 {
+		return []byte("null"), nil
+	}
+	return u.union, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting unknown "type" values.
+func (u *BIAggregationFunction) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		u.union = nil
+		return nil
+	}
+	discriminator, err := unionDiscriminator(data, "type")
+	if err != nil {
+		return fmt.Errorf("BIAggregationFunction: %w", err)
+	}
+	switch discriminator {
+	case "best", "worst", "count_ok":
+	default:
+		return fmt.Errorf("BIAggregationFunction: unknown type %q", discriminator)
+	}
+	u.union = append(json.RawMessage(nil), data...)
+	return nil
 }
 
 // BIAggregationFunctionBest represents a CheckMK API type.
@@ -450,8 +1061,8 @@ type BIAggregationFunctionBest struct {
 
 // BIAggregationFunctionCountOK represents a CheckMK API type.
 type BIAggregationFunctionCountOK struct {
-	LevelsOk map[string]interface{} `json:"levels_ok"`
-	LevelsWarn map[string]interface{} `json:"levels_warn"`
+	LevelsOk BIAggregationFunctionCountSettings `json:"levels_ok"`
+	LevelsWarn BIAggregationFunctionCountSettings `json:"levels_warn"`
 	Type interface{} `json:"type"`
 }
 
@@ -511,7 +1122,7 @@ type BIAllHostsChoice struct {
 
 // BICallARuleAction represents a CheckMK API type.
 type BICallARuleAction struct {
-	Params interface{} `json:"params"`
+	Params BIParams `json:"params"`
 	RuleId string `json:"rule_id"`
 	Type interface{} `json:"type"`
 }
@@ -523,7 +1134,7 @@ type BIEmptySearch struct {
 
 // BIFixedArgumentsSearch represents a CheckMK API type.
 type BIFixedArgumentsSearch struct {
-	Arguments []map[string]interface{} `json:"arguments"`
+	Arguments []BIFixedArgumentsSearchToken `json:"arguments"`
 	Type interface{} `json:"type"`
 }
 
@@ -539,8 +1150,109 @@ type BIHostAliasRegexChoice struct {
 	Type interface{} `json:"type"`
 }
 
-// BIHostChoice represents a CheckMK API type.
+// BIHostChoice is a union of BIAllHostsChoice, BIHostNameRegexChoice, BIHostAliasRegexChoice, selected by the "type" property.
 type BIHostChoice struct {
+	union json.RawMessage
+}
+
+// Discriminator returns the value of the "type" property.
+func (u BIHostChoice) Discriminator() (string, error) {
+	return unionDiscriminator(u.union, "type")
+}
+
+// AsBIAllHostsChoice returns the union data as a BIAllHostsChoice.
+func (u BIHostChoice) AsBIAllHostsChoice() (BIAllHostsChoice, error) {
+	var v BIAllHostsChoice
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromBIAllHostsChoice sets the union to v, with "type" set to "all_hosts".
+func (u *BIHostChoice) FromBIAllHostsChoice(v BIAllHostsChoice) error {
+	data, err := unionFrom(v, "type", "all_hosts")
+	if err != nil {
+		return fmt.Errorf("BIHostChoice: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsBIHostNameRegexChoice returns the union data as a BIHostNameRegexChoice.
+func (u BIHostChoice) AsBIHostNameRegexChoice() (BIHostNameRegexChoice, error) {
+	var v BIHostNameRegexChoice
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromBIHostNameRegexChoice sets the union to v, with "type" set to "host_name_regex".
+func (u *BIHostChoice) FromBIHostNameRegexChoice(v BIHostNameRegexChoice) error {
+	data, err := unionFrom(v, "type", "host_name_regex")
+	if err != nil {
+		return fmt.Errorf("BIHostChoice: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsBIHostAliasRegexChoice returns the union data as a BIHostAliasRegexChoice.
+func (u BIHostChoice) AsBIHostAliasRegexChoice() (BIHostAliasRegexChoice, error) {
+	var v BIHostAliasRegexChoice
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromBIHostAliasRegexChoice sets the union to v, with "type" set to "host_alias_regex".
+func (u *BIHostChoice) FromBIHostAliasRegexChoice(v BIHostAliasRegexChoice) error {
+	data, err := unionFrom(v, "type", "host_alias_regex")
+	if err != nil {
+		return fmt.Errorf("BIHostChoice: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// ValueByDiscriminator decodes the union into the member selected by "type".
+func (u BIHostChoice) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := u.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "all_hosts":
+		return u.AsBIAllHostsChoice()
+	case "host_name_regex":
+		return u.AsBIHostNameRegexChoice()
+	case "host_alias_regex":
+		return u.AsBIHostAliasRegexChoice()
+	}
+	return nil, fmt.Errorf("BIHostChoice: unknown type %q", discriminator)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u BIHostChoice) MarshalJSON() ([]byte, error) {
+	if len(u.union) == 0 {
+		return []byte("null"), nil
+	}
+	return u.union, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting unknown "type" values.
+func (u *BIHostChoice) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		u.union = nil
+		return nil
+	}
+	discriminator, err := unionDiscriminator(data, "type")
+	if err != nil {
+		return fmt.Errorf("BIHostChoice: %w", err)
+	}
+	switch discriminator {
+	case "all_hosts", "host_name_regex", "host_alias_regex":
+	default:
+		return fmt.Errorf("BIHostChoice: unknown type %q", discriminator)
+	}
+	u.union = append(json.RawMessage(nil), data...)
+	return nil
 }
 
 // BIHostNameRegexChoice represents a CheckMK API type.
@@ -551,8 +1263,8 @@ type BIHostNameRegexChoice struct {
 
 // BIHostSearch represents a CheckMK API type.
 type BIHostSearch struct {
-	Conditions map[string]interface{} `json:"conditions"`
-	ReferTo map[string]interface{} `json:"refer_to"`
+	Conditions HostConditions `json:"conditions"`
+	ReferTo ReferTo `json:"refer_to"`
 	Type interface{} `json:"type"`
 }
 
@@ -560,10 +1272,10 @@ type BIHostSearch struct {
 type BINodeGenerator struct {
 	// Nested dictionary
 	// Example: map[host_regex: type:state_of_host]
-	Action interface{} `json:"action"`
+	Action BIAction `json:"action"`
 	// Nested dictionary
 	// Example: map[type:empty]
-	Search interface{} `json:"search"`
+	Search BISearch `json:"search"`
 }
 
 // BINodeVisBlockStyle represents a CheckMK API type.
@@ -580,7 +1292,7 @@ type BINodeVisForceStyle struct {
 
 // BINodeVisHierarchyStyle represents a CheckMK API type.
 type BINodeVisHierarchyStyle struct {
-	StyleConfig map[string]interface{} `json:"style_config"`
+	StyleConfig BINodeVisHierarchyStyleConfig `json:"style_config"`
 	Type interface{} `json:"type"`
 }
 
@@ -591,8 +1303,147 @@ type BINodeVisHierarchyStyleConfig struct {
 	Rotation int `json:"rotation"`
 }
 
-// BINodeVisLayoutStyle represents a CheckMK API type.
+// BINodeVisLayoutStyle is a union of BINodeVisNoneStyle, BINodeVisBlockStyle, BINodeVisHierarchyStyle, BINodeVisRadialStyle, BINodeVisForceStyle, selected by the "type" property.
 type BINodeVisLayoutStyle struct {
+	union json.RawMessage
+}
+
+// Discriminator returns the value of the "type" property.
+func (u BINodeVisLayoutStyle) Discriminator() (string, error) {
+	return unionDiscriminator(u.union, "type")
+}
+
+// AsBINodeVisNoneStyle returns the union data as a BINodeVisNoneStyle.
+func (u BINodeVisLayoutStyle) AsBINodeVisNoneStyle() (BINodeVisNoneStyle, error) {
+	var v BINodeVisNoneStyle
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromBINodeVisNoneStyle sets the union to v, with "type" set to "none".
+func (u *BINodeVisLayoutStyle) FromBINodeVisNoneStyle(v BINodeVisNoneStyle) error {
+	data, err := unionFrom(v, "type", "none")
+	if err != nil {
+		return fmt.Errorf("BINodeVisLayoutStyle: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsBINodeVisBlockStyle returns the union data as a BINodeVisBlockStyle.
+func (u BINodeVisLayoutStyle) AsBINodeVisBlockStyle() (BINodeVisBlockStyle, error) {
+	var v BINodeVisBlockStyle
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromBINodeVisBlockStyle sets the union to v, with "type" set to "block".
+func (u *BINodeVisLayoutStyle) FromBINodeVisBlockStyle(v BINodeVisBlockStyle) error {
+	data, err := unionFrom(v, "type", "block")
+	if err != nil {
+		return fmt.Errorf("BINodeVisLayoutStyle: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsBINodeVisHierarchyStyle returns the union data as a BINodeVisHierarchyStyle.
+func (u BINodeVisLayoutStyle) AsBINodeVisHierarchyStyle() (BINodeVisHierarchyStyle, error) {
+	var v BINodeVisHierarchyStyle
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromBINodeVisHierarchyStyle sets the union to v, with "type" set to "hierarchy".
+func (u *BINodeVisLayoutStyle) FromBINodeVisHierarchyStyle(v BINodeVisHierarchyStyle) error {
+	data, err := unionFrom(v, "type", "hierarchy")
+	if err != nil {
+		return fmt.Errorf("BINodeVisLayoutStyle: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsBINodeVisRadialStyle returns the union data as a BINodeVisRadialStyle.
+func (u BINodeVisLayoutStyle) AsBINodeVisRadialStyle() (BINodeVisRadialStyle, error) {
+	var v BINodeVisRadialStyle
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromBINodeVisRadialStyle sets the union to v, with "type" set to "radial".
+func (u *BINodeVisLayoutStyle) FromBINodeVisRadialStyle(v BINodeVisRadialStyle) error {
+	data, err := unionFrom(v, "type", "radial")
+	if err != nil {
+		return fmt.Errorf("BINodeVisLayoutStyle: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsBINodeVisForceStyle returns the union data as a BINodeVisForceStyle.
+func (u BINodeVisLayoutStyle) AsBINodeVisForceStyle() (BINodeVisForceStyle, error) {
+	var v BINodeVisForceStyle
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromBINodeVisForceStyle sets the union to v, with "type" set to "force".
+func (u *BINodeVisLayoutStyle) FromBINodeVisForceStyle(v BINodeVisForceStyle) error {
+	data, err := unionFrom(v, "type", "force")
+	if err != nil {
+		return fmt.Errorf("BINodeVisLayoutStyle: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// ValueByDiscriminator decodes the union into the member selected by "type".
+func (u BINodeVisLayoutStyle) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := u.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "none":
+		return u.AsBINodeVisNoneStyle()
+	case "block":
+		return u.AsBINodeVisBlockStyle()
+	case "hierarchy":
+		return u.AsBINodeVisHierarchyStyle()
+	case "radial":
+		return u.AsBINodeVisRadialStyle()
+	case "force":
+		return u.AsBINodeVisForceStyle()
+	}
+	return nil, fmt.Errorf("BINodeVisLayoutStyle: unknown type %q", discriminator)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u BINodeVisLayoutStyle) MarshalJSON() ([]byte, error) {
+	if len(u.union) == 0 {
+		return []byte("null"), nil
+	}
+	return u.union, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting unknown "type" values.
+func (u *BINodeVisLayoutStyle) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		u.union = nil
+		return nil
+	}
+	discriminator, err := unionDiscriminator(data, "type")
+	if err != nil {
+		return fmt.Errorf("BINodeVisLayoutStyle: %w", err)
+	}
+	switch discriminator {
+	case "none", "block", "hierarchy", "radial", "force":
+	default:
+		return fmt.Errorf("BINodeVisLayoutStyle: unknown type %q", discriminator)
+	}
+	u.union = append(json.RawMessage(nil), data...)
+	return nil
 }
 
 // BINodeVisNoneStyle represents a CheckMK API type.
@@ -603,7 +1454,7 @@ type BINodeVisNoneStyle struct {
 
 // BINodeVisRadialStyle represents a CheckMK API type.
 type BINodeVisRadialStyle struct {
-	StyleConfig map[string]interface{} `json:"style_config"`
+	StyleConfig BINodeVisRadialStyleConfig `json:"style_config"`
 	Type interface{} `json:"type"`
 }
 
@@ -641,28 +1492,28 @@ type BIRuleComputationOptions struct {
 type BIRuleEndpoint struct {
 	// Nested dictionary
 	// Example: map[count:1 restrict_state:2 type:best]
-	AggregationFunction interface{} `json:"aggregation_function"`
+	AggregationFunction BIAggregationFunction `json:"aggregation_function"`
 	// Nested dictionary
 	// Example: map[disabled:false]
-	ComputationOptions interface{} `json:"computation_options"`
+	ComputationOptions BIRuleComputationOptions `json:"computation_options"`
 	// The unique rule id
 	// Example: rule1
 	Id string `json:"id"`
 	// Nested dictionary
 	// Example: map[style_config:map[] type:block]
-	NodeVisualization interface{} `json:"node_visualization"`
+	NodeVisualization BINodeVisLayoutStyle `json:"node_visualization"`
 	// A list of nodes for for this rule
 	// Example: []
-	Nodes []map[string]interface{} `json:"nodes"`
+	Nodes []BINodeGenerator `json:"nodes"`
 	// The identifier of the BI pack.
 	// Example: pack1
 	PackId string `json:"pack_id"`
 	// Nested dictionary
 	// Example: map[arguments:[foo bar]]
-	Params interface{} `json:"params"`
+	Params BIParams `json:"params"`
 	// Nested dictionary
 	// Example: map[comment: docu_url: icon: state_messages:map[] title:]
-	Properties interface{} `json:"properties"`
+	Properties BIRuleProperties `json:"properties"`
 }
 
 // BIRuleProperties represents a CheckMK API type.
@@ -674,13 +1525,133 @@ type BIRuleProperties struct {
 	Title string `json:"title"`
 }
 
-// BISearch represents a CheckMK API type.
+// BISearch is a union of BIEmptySearch, BIHostSearch, BIServiceSearch, BIFixedArgumentsSearch, selected by the "type" property.
 type BISearch struct {
+	union json.RawMessage
+}
+
+// Discriminator returns the value of the "type" property.
+func (u BISearch) Discriminator() (string, error) {
+	return unionDiscriminator(u.union, "type")
+}
+
+// AsBIEmptySearch returns the union data as a BIEmptySearch.
+func (u BISearch) AsBIEmptySearch() (BIEmptySearch, error) {
+	var v BIEmptySearch
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromBIEmptySearch sets the union to v, with "type" set to "empty".
+func (u *BISearch) FromBIEmptySearch(v BIEmptySearch) error {
+	data, err := unionFrom(v, "type", "empty")
+	if err != nil {
+		return fmt.Errorf("BISearch: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsBIHostSearch returns the union data as a BIHostSearch.
+func (u BISearch) AsBIHostSearch() (BIHostSearch, error) {
+	var v BIHostSearch
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromBIHostSearch sets the union to v, with "type" set to "host_search".
+func (u *BISearch) FromBIHostSearch(v BIHostSearch) error {
+	data, err := unionFrom(v, "type", "host_search")
+	if err != nil {
+		return fmt.Errorf("BISearch: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsBIServiceSearch returns the union data as a BIServiceSearch.
+func (u BISearch) AsBIServiceSearch() (BIServiceSearch, error) {
+	var v BIServiceSearch
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromBIServiceSearch sets the union to v, with "type" set to "service_search".
+func (u *BISearch) FromBIServiceSearch(v BIServiceSearch) error {
+	data, err := unionFrom(v, "type", "service_search")
+	if err != nil {
+		return fmt.Errorf("BISearch: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsBIFixedArgumentsSearch returns the union data as a BIFixedArgumentsSearch.
+func (u BISearch) AsBIFixedArgumentsSearch() (BIFixedArgumentsSearch, error) {
+	var v BIFixedArgumentsSearch
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromBIFixedArgumentsSearch sets the union to v, with "type" set to "fixed_arguments".
+func (u *BISearch) FromBIFixedArgumentsSearch(v BIFixedArgumentsSearch) error {
+	data, err := unionFrom(v, "type", "fixed_arguments")
+	if err != nil {
+		return fmt.Errorf("BISearch: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// ValueByDiscriminator decodes the union into the member selected by "type".
+func (u BISearch) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := u.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "empty":
+		return u.AsBIEmptySearch()
+	case "host_search":
+		return u.AsBIHostSearch()
+	case "service_search":
+		return u.AsBIServiceSearch()
+	case "fixed_arguments":
+		return u.AsBIFixedArgumentsSearch()
+	}
+	return nil, fmt.Errorf("BISearch: unknown type %q", discriminator)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u BISearch) MarshalJSON() ([]byte, error) {
+	if len(u.union) == 0 {
+		return []byte("null"), nil
+	}
+	return u.union, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting unknown "type" values.
+func (u *BISearch) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		u.union = nil
+		return nil
+	}
+	discriminator, err := unionDiscriminator(data, "type")
+	if err != nil {
+		return fmt.Errorf("BISearch: %w", err)
+	}
+	switch discriminator {
+	case "empty", "host_search", "service_search", "fixed_arguments":
+	default:
+		return fmt.Errorf("BISearch: unknown type %q", discriminator)
+	}
+	u.union = append(json.RawMessage(nil), data...)
+	return nil
 }
 
 // BIServiceSearch represents a CheckMK API type.
 type BIServiceSearch struct {
-	Conditions map[string]interface{} `json:"conditions"`
+	Conditions ServiceConditions `json:"conditions"`
 	Type interface{} `json:"type"`
 }
 
@@ -710,7 +1681,7 @@ type BackgroundJobStatus struct {
 	Active bool `json:"active"`
 	// Logs related to the background job.
 	// Example: map[progress:[progress1] result:[result1]]
-	Logs interface{} `json:"logs"`
+	Logs JobLogs `json:"logs"`
 	// This field indicates the current state of the background job.
 	// Example: initialized
 	State BackgroundJobStatusState `json:"state"`
@@ -720,22 +1691,22 @@ type BackgroundJobStatus struct {
 type BaseUserAttributes struct {
 	// Enforce password change attribute for the user
 	// Example: map[auth_type:password enforce_password_change:false]
-	AuthOption interface{} `json:"auth_option,omitempty"`
+	AuthOption *AuthOption1 `json:"auth_option,omitempty"`
 	// The names of the sites that this user is authorized to handle
 	AuthorizedSites []string `json:"authorized_sites,omitempty"`
 	// Contact settings for the user
-	ContactOptions interface{} `json:"contact_options,omitempty"`
+	ContactOptions *ConcreteUserContactOption `json:"contact_options,omitempty"`
 	// The contact groups that this user is a member of
 	Contactgroups []string `json:"contactgroups,omitempty"`
 	// This field indicates if the user is allowed to login to the monitoring.
 	DisableLogin bool `json:"disable_login,omitempty"`
-	DisableNotifications map[string]interface{} `json:"disable_notifications,omitempty"`
+	DisableNotifications *ConcreteDisabledNotifications `json:"disable_notifications,omitempty"`
 	// The alias or full name of the user.
 	Fullname string `json:"fullname"`
 	// Idle timeout for the user. Per default, the global configuration is used.
 	// Example: map[option:global]
-	IdleTimeout interface{} `json:"idle_timeout,omitempty"`
-	InterfaceOptions map[string]interface{} `json:"interface_options,omitempty"`
+	IdleTimeout *UserIdleOption `json:"idle_timeout,omitempty"`
+	InterfaceOptions *ConcreteUserInterfaceAttributes `json:"interface_options,omitempty"`
 	// The language used by the user in the user interface
 	Language string `json:"language,omitempty"`
 	PagerAddress string `json:"pager_address,omitempty"`
@@ -790,7 +1761,7 @@ type BinaryExpr struct {
 type BulkCreateHost struct {
 	// A list of host entries.
 	// Example: [map[attributes:map[] folder:/ host_name:example.com]]
-	Entries []map[string]interface{} `json:"entries"`
+	Entries []CreateHost `json:"entries"`
 }
 
 // BulkDeleteContactGroup represents a CheckMK API type.
@@ -846,7 +1817,7 @@ type BulkHostActionWithFailedHosts struct {
 	// Example: The resource could not be found.
 	Detail string `json:"detail"`
 	// Details for which hosts have failed
-	Ext interface{} `json:"ext,omitempty"`
+	Ext *FailedHosts `json:"ext,omitempty"`
 	// Detailed error messages on all fields failing validation.
 	Fields map[string]interface{} `json:"fields,omitempty"`
 	// The HTTP status code.
@@ -861,72 +1832,72 @@ type BulkHostActionWithFailedHosts struct {
 type BulkInputContactGroup struct {
 	// A collection of contact group entries.
 	// Example: [map[alias:Not on Sundays name:OnCall]]
-	Entries []map[string]interface{} `json:"entries"`
+	Entries []InputContactGroup `json:"entries"`
 }
 
 // BulkInputHostGroup represents a CheckMK API type.
 type BulkInputHostGroup struct {
 	// A list of host group entries.
 	// Example: [map[alias:Windows Servers name:windows]]
-	Entries []map[string]interface{} `json:"entries"`
+	Entries []InputHostGroup `json:"entries"`
 }
 
 // BulkInputServiceGroup represents a CheckMK API type.
 type BulkInputServiceGroup struct {
 	// A list of service group entries.
 	// Example: [map[alias:Environment Sensors name:environment]]
-	Entries []map[string]interface{} `json:"entries"`
+	Entries []InputServiceGroup `json:"entries"`
 }
 
 // BulkOutsideTimePeriodValue represents a CheckMK API type.
 type BulkOutsideTimePeriodValue struct {
 	State BulkOutsideTimePeriodValueState `json:"state"`
-	Value map[string]interface{} `json:"value,omitempty"`
+	Value *NotificationBulkingCommonAttributes `json:"value,omitempty"`
 }
 
 // BulkUpdateContactGroup represents a CheckMK API type.
 type BulkUpdateContactGroup struct {
 	// A list of contact group entries.
 	// Example: [map[attributes:map[alias:Not on Sundays] name:OnCall]]
-	Entries []map[string]interface{} `json:"entries"`
+	Entries []UpdateContactGroup `json:"entries"`
 }
 
 // BulkUpdateFolder represents a CheckMK API type.
 type BulkUpdateFolder struct {
 	// A list of folder entries.
 	// Example: [map[remove_attributes:[tag_foobar]]]
-	Entries []map[string]interface{} `json:"entries"`
+	Entries []UpdateFolderEntry `json:"entries"`
 }
 
 // BulkUpdateHost represents a CheckMK API type.
 type BulkUpdateHost struct {
 	// A list of host entries.
 	// Example: [map[attributes:map[] host_name:example.com]]
-	Entries []map[string]interface{} `json:"entries"`
+	Entries []UpdateHostEntry `json:"entries"`
 }
 
 // BulkUpdateHostGroup represents a CheckMK API type.
 type BulkUpdateHostGroup struct {
 	// A list of host group entries.
 	// Example: [map[attributes:map[alias:Windows Servers] name:windows]]
-	Entries []map[string]interface{} `json:"entries"`
+	Entries []UpdateHostGroup `json:"entries"`
 }
 
 // BulkUpdateServiceGroup represents a CheckMK API type.
 type BulkUpdateServiceGroup struct {
 	// A list of service group entries.
 	// Example: [map[attributes:map[alias:Windows Servers] name:windows]]
-	Entries []map[string]interface{} `json:"entries"`
+	Entries []UpdateServiceGroup `json:"entries"`
 }
 
 // CaseParams represents a CheckMK API type.
 type CaseParams struct {
-	HostDescription map[string]interface{} `json:"host_description,omitempty"`
-	HostShortDescription map[string]interface{} `json:"host_short_description,omitempty"`
-	Priority map[string]interface{} `json:"priority,omitempty"`
-	ServiceDescription map[string]interface{} `json:"service_description,omitempty"`
-	ServiceShortDescription map[string]interface{} `json:"service_short_description,omitempty"`
-	StateRecovery map[string]interface{} `json:"state_recovery,omitempty"`
+	HostDescription *CheckboxOneOf `json:"host_description,omitempty"`
+	HostShortDescription *CheckboxOneOf `json:"host_short_description,omitempty"`
+	Priority *CheckboxOneOf `json:"priority,omitempty"`
+	ServiceDescription *CheckboxOneOf `json:"service_description,omitempty"`
+	ServiceShortDescription *CheckboxOneOf `json:"service_short_description,omitempty"`
+	StateRecovery *CheckboxOneOf `json:"state_recovery,omitempty"`
 }
 
 // ChangeEventState represents a CheckMK API type.
@@ -939,8 +1910,90 @@ type ChangeEventState struct {
 	SiteId string `json:"site_id"`
 }
 
-// ChangeEventStateSelector represents a CheckMK API type.
+// ChangeEventStateSelector is a union of ChangeStateWithQuery, ChangeStateWithParams, selected by the "filter_type" property.
 type ChangeEventStateSelector struct {
+	union json.RawMessage
+}
+
+// Discriminator returns the value of the "filter_type" property.
+func (u ChangeEventStateSelector) Discriminator() (string, error) {
+	return unionDiscriminator(u.union, "filter_type")
+}
+
+// AsChangeStateWithQuery returns the union data as a ChangeStateWithQuery.
+func (u ChangeEventStateSelector) AsChangeStateWithQuery() (ChangeStateWithQuery, error) {
+	var v ChangeStateWithQuery
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromChangeStateWithQuery sets the union to v, with "filter_type" set to "query".
+func (u *ChangeEventStateSelector) FromChangeStateWithQuery(v ChangeStateWithQuery) error {
+	data, err := unionFrom(v, "filter_type", "query")
+	if err != nil {
+		return fmt.Errorf("ChangeEventStateSelector: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsChangeStateWithParams returns the union data as a ChangeStateWithParams.
+func (u ChangeEventStateSelector) AsChangeStateWithParams() (ChangeStateWithParams, error) {
+	var v ChangeStateWithParams
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromChangeStateWithParams sets the union to v, with "filter_type" set to "params".
+func (u *ChangeEventStateSelector) FromChangeStateWithParams(v ChangeStateWithParams) error {
+	data, err := unionFrom(v, "filter_type", "params")
+	if err != nil {
+		return fmt.Errorf("ChangeEventStateSelector: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// ValueByDiscriminator decodes the union into the member selected by "filter_type".
+func (u ChangeEventStateSelector) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := u.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "query":
+		return u.AsChangeStateWithQuery()
+	case "params":
+		return u.AsChangeStateWithParams()
+	}
+	return nil, fmt.Errorf("ChangeEventStateSelector: unknown filter_type %q", discriminator)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u ChangeEventStateSelector) MarshalJSON() ([]byte, error) {
+	if len(u.union) == 0 {
+		return []byte("null"), nil
+	}
+	return u.union, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting unknown "filter_type" values.
+func (u *ChangeEventStateSelector) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		u.union = nil
+		return nil
+	}
+	discriminator, err := unionDiscriminator(data, "filter_type")
+	if err != nil {
+		return fmt.Errorf("ChangeEventStateSelector: %w", err)
+	}
+	switch discriminator {
+	case "query", "params":
+	default:
+		return fmt.Errorf("ChangeEventStateSelector: unknown filter_type %q", discriminator)
+	}
+	u.union = append(json.RawMessage(nil), data...)
+	return nil
 }
 
 // ChangeStateWithParams represents a CheckMK API type.
@@ -948,7 +2001,7 @@ type ChangeStateWithParams struct {
 	// The way you would like to filter events.
 	// Example: params
 	FilterType ChangeStateWithParamsFilterType `json:"filter_type"`
-	Filters map[string]interface{} `json:"filters"`
+	Filters FilterParams `json:"filters"`
 	// The state
 	// Example: ok
 	NewState ChangeStateWithParamsNewState `json:"new_state"`
@@ -967,7 +2020,7 @@ type ChangeStateWithQuery struct {
 	NewState ChangeStateWithQueryNewState `json:"new_state"`
 	// An query expression of the Livestatus 'eventconsoleevents' table in nested dictionary form. If you want to use multiple expressions, nest them with the AND/OR operators.
 	// Example: {"op": "=", "left": "eventconsoleevents.event_host", "right": "test_host"}
-	Query interface{} `json:"query"`
+	Query Expr `json:"query"`
 	// An existing site id
 	// Example: heute
 	SiteId string `json:"site_id,omitempty"`
@@ -999,7 +2052,7 @@ type Checkbox struct {
 type CheckboxHostEventType struct {
 	State CheckboxHostEventTypeState `json:"state"`
 	// Select the host event types and transitions this rule should handle. Note: If you activate this option and do not also specify service event types then this rule will never hold for service notifications! Note: You can only match on event types created by the core.
-	Value interface{} `json:"value"`
+	Value HostEventType `json:"value"`
 }
 
 // CheckboxLabel represents a CheckMK API type.
@@ -1012,30 +2065,112 @@ type CheckboxLabel struct {
 type CheckboxMatchHostTags struct {
 	State CheckboxMatchHostTagsState `json:"state"`
 	// Match host tags with the following parameters
-	Value interface{} `json:"value"`
+	Value HostTagValues `json:"value"`
 }
 
-// CheckboxOneOf represents a CheckMK API type.
+// CheckboxOneOf is a union of Checkbox, FromEmailAndNameCheckbox, selected by the "state" property.
 type CheckboxOneOf struct {
+	union json.RawMessage
+}
+
+// Discriminator returns the value of the "state" property.
+func (u CheckboxOneOf) Discriminator() (string, error) {
+	return unionDiscriminator(u.union, "state")
+}
+
+// AsCheckbox returns the union data as a Checkbox.
+func (u CheckboxOneOf) AsCheckbox() (Checkbox, error) {
+	var v Checkbox
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromCheckbox sets the union to v, with "state" set to "disabled".
+func (u *CheckboxOneOf) FromCheckbox(v Checkbox) error {
+	data, err := unionFrom(v, "state", "disabled")
+	if err != nil {
+		return fmt.Errorf("CheckboxOneOf: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsFromEmailAndNameCheckbox returns the union data as a FromEmailAndNameCheckbox.
+func (u CheckboxOneOf) AsFromEmailAndNameCheckbox() (FromEmailAndNameCheckbox, error) {
+	var v FromEmailAndNameCheckbox
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromFromEmailAndNameCheckbox sets the union to v, with "state" set to "enabled".
+func (u *CheckboxOneOf) FromFromEmailAndNameCheckbox(v FromEmailAndNameCheckbox) error {
+	data, err := unionFrom(v, "state", "enabled")
+	if err != nil {
+		return fmt.Errorf("CheckboxOneOf: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// ValueByDiscriminator decodes the union into the member selected by "state".
+func (u CheckboxOneOf) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := u.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "disabled":
+		return u.AsCheckbox()
+	case "enabled":
+		return u.AsFromEmailAndNameCheckbox()
+	}
+	return nil, fmt.Errorf("CheckboxOneOf: unknown state %q", discriminator)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u CheckboxOneOf) MarshalJSON() ([]byte, error) {
+	if len(u.union) == 0 {
+		return []byte("null"), nil
+	}
+	return u.union, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting unknown "state" values.
+func (u *CheckboxOneOf) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		u.union = nil
+		return nil
+	}
+	discriminator, err := unionDiscriminator(data, "state")
+	if err != nil {
+		return fmt.Errorf("CheckboxOneOf: %w", err)
+	}
+	switch discriminator {
+	case "disabled", "enabled":
+	default:
+		return fmt.Errorf("CheckboxOneOf: unknown state %q", discriminator)
+	}
+	u.union = append(json.RawMessage(nil), data...)
+	return nil
 }
 
 // CheckboxRestrictNotificationNumbers represents a CheckMK API type.
 type CheckboxRestrictNotificationNumbers struct {
 	State CheckboxRestrictNotificationNumbersState `json:"state"`
-	Value map[string]interface{} `json:"value"`
+	Value FromToNotificationNumbers `json:"value"`
 }
 
 // CheckboxServiceEventType represents a CheckMK API type.
 type CheckboxServiceEventType struct {
 	State CheckboxServiceEventTypeState `json:"state"`
 	// Select the service event types and transitions this rule should handle. Note: If you activate this option and do not also specify host event types then this rule will never hold for host notifications! Note: You can only match on event types created by the core
-	Value interface{} `json:"value"`
+	Value ServiceEventType `json:"value"`
 }
 
 // CheckboxThrottlePeriodicNotifcations represents a CheckMK API type.
 type CheckboxThrottlePeriodicNotifcations struct {
 	State CheckboxThrottlePeriodicNotifcationsState `json:"state"`
-	Value map[string]interface{} `json:"value"`
+	Value ThrottlePeriodicNotifications `json:"value"`
 }
 
 // CheckboxWithFolderStr represents a CheckMK API type.
@@ -1049,21 +2184,21 @@ type CheckboxWithFolderStr struct {
 type CheckboxWithFromToServiceLevels struct {
 	State CheckboxWithFromToServiceLevelsState `json:"state"`
 	// Host or service must be in the following service level to get notification
-	Value interface{} `json:"value"`
+	Value FromToServiceLevels `json:"value"`
 }
 
 // CheckboxWithListOfLabels represents a CheckMK API type.
 type CheckboxWithListOfLabels struct {
 	State CheckboxWithListOfLabelsState `json:"state"`
 	// A list of key, value label pairs
-	Value []map[string]interface{} `json:"value"`
+	Value []CheckboxLabel `json:"value"`
 }
 
 // CheckboxWithListOfServiceGroupsRegex represents a CheckMK API type.
 type CheckboxWithListOfServiceGroupsRegex struct {
 	State CheckboxWithListOfServiceGroupsRegexState `json:"state"`
 	// The service group alias must not match one of the following regular expressions. For host events this condition is simply ignored. The text entered here is handled as a regular expression pattern. The pattern is applied as infix search. Add a leading ^ to make it match from the beginning and/or a...
-	Value interface{} `json:"value"`
+	Value ServiceGroupsRegex `json:"value"`
 }
 
 // CheckboxWithListOfStr represents a CheckMK API type.
@@ -1087,7 +2222,7 @@ type CheckboxWithStrValue struct {
 // CheckboxWithSysLogPriority represents a CheckMK API type.
 type CheckboxWithSysLogPriority struct {
 	State CheckboxWithSysLogPriorityState `json:"state"`
-	Value map[string]interface{} `json:"value,omitempty"`
+	Value *SysLogToFromPriorities `json:"value,omitempty"`
 }
 
 // Child represents a CheckMK API type.
@@ -1097,8 +2232,8 @@ type Child struct {
 
 // ChildWith represents a CheckMK API type.
 type ChildWith struct {
-	Conditions map[string]interface{} `json:"conditions"`
-	HostChoice interface{} `json:"host_choice"`
+	Conditions HostConditions `json:"conditions"`
+	HostChoice BIHostChoice `json:"host_choice"`
 }
 
 // CiscoExplicitWebhookUrl represents a CheckMK API type.
@@ -1115,21 +2250,103 @@ type CiscoPasswordStore struct {
 	StoreId string `json:"store_id"`
 }
 
-// CiscoUrlOrStoreSelector represents a CheckMK API type.
+// CiscoUrlOrStoreSelector is a union of CiscoExplicitWebhookUrl, CiscoPasswordStore, selected by the "option" property.
 type CiscoUrlOrStoreSelector struct {
+	union json.RawMessage
+}
+
+// Discriminator returns the value of the "option" property.
+func (u CiscoUrlOrStoreSelector) Discriminator() (string, error) {
+	return unionDiscriminator(u.union, "option")
+}
+
+// AsCiscoExplicitWebhookUrl returns the union data as a CiscoExplicitWebhookUrl.
+func (u CiscoUrlOrStoreSelector) AsCiscoExplicitWebhookUrl() (CiscoExplicitWebhookUrl, error) {
+	var v CiscoExplicitWebhookUrl
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromCiscoExplicitWebhookUrl sets the union to v, with "option" set to "explicit".
+func (u *CiscoUrlOrStoreSelector) FromCiscoExplicitWebhookUrl(v CiscoExplicitWebhookUrl) error {
+	data, err := unionFrom(v, "option", "explicit")
+	if err != nil {
+		return fmt.Errorf("CiscoUrlOrStoreSelector: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsCiscoPasswordStore returns the union data as a CiscoPasswordStore.
+func (u CiscoUrlOrStoreSelector) AsCiscoPasswordStore() (CiscoPasswordStore, error) {
+	var v CiscoPasswordStore
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromCiscoPasswordStore sets the union to v, with "option" set to "store".
+func (u *CiscoUrlOrStoreSelector) FromCiscoPasswordStore(v CiscoPasswordStore) error {
+	data, err := unionFrom(v, "option", "store")
+	if err != nil {
+		return fmt.Errorf("CiscoUrlOrStoreSelector: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// ValueByDiscriminator decodes the union into the member selected by "option".
+func (u CiscoUrlOrStoreSelector) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := u.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "explicit":
+		return u.AsCiscoExplicitWebhookUrl()
+	case "store":
+		return u.AsCiscoPasswordStore()
+	}
+	return nil, fmt.Errorf("CiscoUrlOrStoreSelector: unknown option %q", discriminator)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u CiscoUrlOrStoreSelector) MarshalJSON() ([]byte, error) {
+	if len(u.union) == 0 {
+		return []byte("null"), nil
+	}
+	return u.union, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting unknown "option" values.
+func (u *CiscoUrlOrStoreSelector) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		u.union = nil
+		return nil
+	}
+	discriminator, err := unionDiscriminator(data, "option")
+	if err != nil {
+		return fmt.Errorf("CiscoUrlOrStoreSelector: %w", err)
+	}
+	switch discriminator {
+	case "explicit", "store":
+	default:
+		return fmt.Errorf("CiscoUrlOrStoreSelector: unknown option %q", discriminator)
+	}
+	u.union = append(json.RawMessage(nil), data...)
+	return nil
 }
 
 // CiscoWebexPluginCreate represents a CheckMK API type.
 type CiscoWebexPluginCreate struct {
 	// Ignore unverified HTTPS request warnings. Use with caution.
-	DisableSslCertVerification interface{} `json:"disable_ssl_cert_verification"`
+	DisableSslCertVerification Checkbox `json:"disable_ssl_cert_verification"`
 	// Use the proxy settings from the environment variables. The variables NO_PROXY, HTTP_PROXY and HTTPS_PROXY are taken into account during execution.
-	HttpProxy interface{} `json:"http_proxy"`
+	HttpProxy CheckboxOneOf `json:"http_proxy"`
 	// The plugin name.
 	// Example: mail
 	PluginName CiscoWebexPluginCreatePluginName `json:"plugin_name"`
-	UrlPrefixForLinksToCheckmk map[string]interface{} `json:"url_prefix_for_links_to_checkmk"`
-	WebhookUrl map[string]interface{} `json:"webhook_url,omitempty"`
+	UrlPrefixForLinksToCheckmk CheckboxOneOf `json:"url_prefix_for_links_to_checkmk"`
+	WebhookUrl *CiscoUrlOrStoreSelector `json:"webhook_url,omitempty"`
 }
 
 // ClusterCreateAttribute represents a CheckMK API type.
@@ -1141,7 +2358,7 @@ type ClusterCreateAttribute struct {
 	// Add a comment or describe this host
 	Alias string `json:"alias,omitempty"`
 	// Only members of the contact groups listed here have Setup permission for the host/folder. Optionally, you can make these contact groups automatically monitor contacts. The assignment of hosts to contact groups can also be defined by <a href='wato.py?mode=edit_ruleset&varname=host_contactgroups'>r...
-	Contactgroups interface{} `json:"contactgroups,omitempty"`
+	Contactgroups *HostContactGroup `json:"contactgroups,omitempty"`
 	// Whether or not the last bulk discovery failed. It is set to True once it fails and unset in case a later discovery succeeds.
 	// Example: false
 	InventoryFailed bool `json:"inventory_failed,omitempty"`
@@ -1154,23 +2371,23 @@ type ClusterCreateAttribute struct {
 	// Name of host attributes which are locked in the UI.
 	LockedAttributes []string `json:"locked_attributes,omitempty"`
 	// Identity of the entity which locked the locked_attributes. The identity is built out of the Site ID, the program name and the connection ID.
-	LockedBy interface{} `json:"locked_by,omitempty"`
+	LockedBy *LockedBy `json:"locked_by,omitempty"`
 	// Address (IPv4, IPv6 or hostname) under which the management board can be reached.
 	ManagementAddress string `json:"management_address,omitempty"`
 	// IPMI credentials
-	ManagementIpmiCredentials interface{} `json:"management_ipmi_credentials,omitempty"`
+	ManagementIpmiCredentials *IPMIParameters `json:"management_ipmi_credentials,omitempty"`
 	// The protocol used to connect to the management board. Valid options are: * `none` - No management board * `snmp` - Connect using SNMP * `ipmi` - Connect using IPMI
 	ManagementProtocol ClusterCreateAttributeManagementProtocol `json:"management_protocol,omitempty"`
 	// SNMP credentials
-	ManagementSnmpCommunity interface{} `json:"management_snmp_community,omitempty"`
+	ManagementSnmpCommunity *SNMPCredentials `json:"management_snmp_community,omitempty"`
 	// Configuration for automatic network scan. Pings will besent to each IP address in the configured ranges to checkif a host is up or down. Each found host will be added tothe folder by it's hostname (if possible) or IP address.
-	NetworkScan interface{} `json:"network_scan,omitempty"`
+	NetworkScan *NetworkScan `json:"network_scan,omitempty"`
 	// A list of parents of this host.
 	Parents []string `json:"parents,omitempty"`
 	// The site that should monitor this host.
 	Site string `json:"site,omitempty"`
 	// The SNMP access configuration. A configured SNMP v1/v2 community here will have precedence over any configured SNMP community rule. For this attribute to take effect, the attribute `tag_snmp_ds` needs to be set first.
-	SnmpCommunity interface{} `json:"snmp_community,omitempty"`
+	SnmpCommunity *SNMPCredentials `json:"snmp_community,omitempty"`
 	// Choices: * `"ip-v4-only"`: IPv4 only * `"ip-v6-only"`: IPv6 only * `"ip-v4v6"`: IPv4/IPv6 dual-stack * `"no-ip"`: No IP
 	TagAddressFamily ClusterCreateAttributeTagAddressFamily `json:"tag_address_family,omitempty"`
 	// Choices: * `"cmk-agent"`: API integrations if configured, else Checkmk agent * `"all-agents"`: Configured API integrations and Checkmk agent * `"special-agents"`: Configured API integrations, no Checkmk agent * `"no-agent"`: No API integrations, no Checkmk agent
@@ -1185,8 +2402,71 @@ type ClusterCreateAttribute struct {
 	TagSnmpDs ClusterCreateAttributeTagSnmpDs `json:"tag_snmp_ds,omitempty"`
 }
 
-// CollectionItem represents a CheckMK API type.
+// CollectionItem is a union of Link, selected by the "domainType" property.
 type CollectionItem struct {
+	union json.RawMessage
+}
+
+// Discriminator returns the value of the "domainType" property.
+func (u CollectionItem) Discriminator() (string, error) {
+	return unionDiscriminator(u.union, "domainType")
+}
+
+// AsLink returns the union data as a Link.
+func (u CollectionItem) AsLink() (Link, error) {
+	var v Link
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromLink sets the union to v, with "domainType" set to "link".
+func (u *CollectionItem) FromLink(v Link) error {
+	data, err := unionFrom(v, "domainType", "link")
+	if err != nil {
+		return fmt.Errorf("CollectionItem: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// ValueByDiscriminator decodes the union into the member selected by "domainType".
+func (u CollectionItem) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := u.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "link":
+		return u.AsLink()
+	}
+	return nil, fmt.Errorf("CollectionItem: unknown domainType %q", discriminator)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u CollectionItem) MarshalJSON() ([]byte, error) {
+	if len(u.union) == 0 {
+		return []byte("null"), nil
+	}
+	return u.union, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting unknown "domainType" values.
+func (u *CollectionItem) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		u.union = nil
+		return nil
+	}
+	discriminator, err := unionDiscriminator(data, "domainType")
+	if err != nil {
+		return fmt.Errorf("CollectionItem: %w", err)
+	}
+	switch discriminator {
+	case "link":
+	default:
+		return fmt.Errorf("CollectionItem: unknown domainType %q", discriminator)
+	}
+	u.union = append(json.RawMessage(nil), data...)
+	return nil
 }
 
 // CommentAttributes represents a CheckMK API type.
@@ -1221,11 +2501,11 @@ type CommentCollection struct {
 	// The name of this collection.
 	Id string `json:"id,omitempty"`
 	// list of links to other resources.
-	Links []map[string]interface{} `json:"links"`
+	Links []Link `json:"links"`
 	// A human readable title of this object. Can be used for user interfaces.
 	Title string `json:"title,omitempty"`
 	// A list of comment objects.
-	Value []map[string]interface{} `json:"value,omitempty"`
+	Value []CommentObject `json:"value,omitempty"`
 }
 
 // CommentObject represents a CheckMK API type.
@@ -1233,11 +2513,11 @@ type CommentObject struct {
 	// The domain type of the object.
 	DomainType interface{} `json:"domainType,omitempty"`
 	// The attributes of a service/host comment.
-	Extensions interface{} `json:"extensions,omitempty"`
+	Extensions *CommentAttributes `json:"extensions,omitempty"`
 	// The unique identifier for this domain-object type.
 	Id string `json:"id,omitempty"`
 	// list of links to other resources.
-	Links []map[string]interface{} `json:"links"`
+	Links []Link `json:"links"`
 	// The container for external resources, like linked foreign objects or actions.
 	Members map[string]interface{} `json:"members,omitempty"`
 	// A human readable title of this object. Can be used for user interfaces.
@@ -1249,7 +2529,7 @@ type ConcreteDisabledNotifications struct {
 	// Option if all notifications should be temporarily disabled
 	Disable bool `json:"disable,omitempty"`
 	// A custom timerange during which notifications are disabled
-	Timerange interface{} `json:"timerange,omitempty"`
+	Timerange *DateTimeRange `json:"timerange,omitempty"`
 }
 
 // ConcreteHostTagGroup represents a CheckMK API type.
@@ -1257,11 +2537,11 @@ type ConcreteHostTagGroup struct {
 	// The domain type of the object.
 	DomainType interface{} `json:"domainType"`
 	// Additional fields for objects of this type.
-	Extensions interface{} `json:"extensions,omitempty"`
+	Extensions *HostTagExtensions `json:"extensions,omitempty"`
 	// The unique identifier for this domain-object type.
 	Id string `json:"id,omitempty"`
 	// list of links to other resources.
-	Links []map[string]interface{} `json:"links"`
+	Links []Link `json:"links"`
 	// The container for external resources, like linked foreign objects or actions.
 	Members map[string]interface{} `json:"members,omitempty"`
 	// A human readable title of this object. Can be used for user interfaces.
@@ -1273,7 +2553,7 @@ type ConcreteTimePeriodException struct {
 	// The date of the time period exception.8601 profile
 	// Example: 2020-01-01
 	Date string `json:"date,omitempty"`
-	TimeRanges []map[string]interface{} `json:"time_ranges,omitempty"`
+	TimeRanges []ConcreteTimeRange `json:"time_ranges,omitempty"`
 }
 
 // ConcreteTimeRange represents a CheckMK API type.
@@ -1290,7 +2570,7 @@ type ConcreteTimeRange struct {
 type ConcreteTimeRangeActive struct {
 	// The day for which the time ranges are specified
 	Day ConcreteTimeRangeActiveDay `json:"day,omitempty"`
-	TimeRanges []map[string]interface{} `json:"time_ranges,omitempty"`
+	TimeRanges []ConcreteTimeRange `json:"time_ranges,omitempty"`
 }
 
 // ConcreteUserContactOption represents a CheckMK API type.
@@ -1323,31 +2603,31 @@ type ConcreteUserInterfaceAttributes struct {
 
 // ConditionsAttributes represents a CheckMK API type.
 type ConditionsAttributes struct {
-	EventConsoleAlerts map[string]interface{} `json:"event_console_alerts,omitempty"`
-	MatchCheckTypes map[string]interface{} `json:"match_check_types,omitempty"`
-	MatchContactGroups map[string]interface{} `json:"match_contact_groups,omitempty"`
-	MatchExcludeHosts map[string]interface{} `json:"match_exclude_hosts,omitempty"`
-	MatchExcludeServiceGroups map[string]interface{} `json:"match_exclude_service_groups,omitempty"`
-	MatchExcludeServiceGroupsRegex map[string]interface{} `json:"match_exclude_service_groups_regex,omitempty"`
-	MatchExcludeServices map[string]interface{} `json:"match_exclude_services,omitempty"`
-	MatchFolder map[string]interface{} `json:"match_folder,omitempty"`
-	MatchHostEventType map[string]interface{} `json:"match_host_event_type,omitempty"`
-	MatchHostGroups map[string]interface{} `json:"match_host_groups,omitempty"`
-	MatchHostLabels map[string]interface{} `json:"match_host_labels,omitempty"`
-	MatchHostTags map[string]interface{} `json:"match_host_tags,omitempty"`
-	MatchHosts map[string]interface{} `json:"match_hosts,omitempty"`
-	MatchNotificationComment map[string]interface{} `json:"match_notification_comment,omitempty"`
-	MatchOnlyDuringTimePeriod map[string]interface{} `json:"match_only_during_time_period,omitempty"`
-	MatchPluginOutput map[string]interface{} `json:"match_plugin_output,omitempty"`
-	MatchServiceEventType map[string]interface{} `json:"match_service_event_type,omitempty"`
-	MatchServiceGroups map[string]interface{} `json:"match_service_groups,omitempty"`
-	MatchServiceGroupsRegex map[string]interface{} `json:"match_service_groups_regex,omitempty"`
-	MatchServiceLabels map[string]interface{} `json:"match_service_labels,omitempty"`
-	MatchServiceLevels map[string]interface{} `json:"match_service_levels,omitempty"`
-	MatchServices map[string]interface{} `json:"match_services,omitempty"`
-	MatchSites map[string]interface{} `json:"match_sites,omitempty"`
-	RestrictToNotificationNumbers map[string]interface{} `json:"restrict_to_notification_numbers,omitempty"`
-	ThrottlePeriodicNotifications map[string]interface{} `json:"throttle_periodic_notifications,omitempty"`
+	EventConsoleAlerts *MatchEventConsoleAlertsResponse `json:"event_console_alerts,omitempty"`
+	MatchCheckTypes *CheckboxWithListOfStr `json:"match_check_types,omitempty"`
+	MatchContactGroups *CheckboxWithListOfStr `json:"match_contact_groups,omitempty"`
+	MatchExcludeHosts *CheckboxWithListOfStr `json:"match_exclude_hosts,omitempty"`
+	MatchExcludeServiceGroups *CheckboxWithListOfStr `json:"match_exclude_service_groups,omitempty"`
+	MatchExcludeServiceGroupsRegex *CheckboxWithListOfServiceGroupsRegex `json:"match_exclude_service_groups_regex,omitempty"`
+	MatchExcludeServices *CheckboxWithListOfStr `json:"match_exclude_services,omitempty"`
+	MatchFolder *CheckboxWithFolderStr `json:"match_folder,omitempty"`
+	MatchHostEventType *CheckboxHostEventType `json:"match_host_event_type,omitempty"`
+	MatchHostGroups *CheckboxWithListOfStr `json:"match_host_groups,omitempty"`
+	MatchHostLabels *CheckboxWithListOfLabels `json:"match_host_labels,omitempty"`
+	MatchHostTags *CheckboxMatchHostTags `json:"match_host_tags,omitempty"`
+	MatchHosts *CheckboxWithListOfStr `json:"match_hosts,omitempty"`
+	MatchNotificationComment *CheckboxWithStr `json:"match_notification_comment,omitempty"`
+	MatchOnlyDuringTimePeriod *CheckboxWithStr `json:"match_only_during_time_period,omitempty"`
+	MatchPluginOutput *CheckboxWithStr `json:"match_plugin_output,omitempty"`
+	MatchServiceEventType *CheckboxServiceEventType `json:"match_service_event_type,omitempty"`
+	MatchServiceGroups *CheckboxWithListOfStr `json:"match_service_groups,omitempty"`
+	MatchServiceGroupsRegex *CheckboxWithListOfServiceGroupsRegex `json:"match_service_groups_regex,omitempty"`
+	MatchServiceLabels *CheckboxWithListOfLabels `json:"match_service_labels,omitempty"`
+	MatchServiceLevels *CheckboxWithFromToServiceLevels `json:"match_service_levels,omitempty"`
+	MatchServices *CheckboxWithListOfStr `json:"match_services,omitempty"`
+	MatchSites *CheckboxWithListOfStr `json:"match_sites,omitempty"`
+	RestrictToNotificationNumbers *CheckboxRestrictNotificationNumbers `json:"restrict_to_notification_numbers,omitempty"`
+	ThrottlePeriodicNotifications *CheckboxThrottlePeriodicNotifcations `json:"throttle_periodic_notifications,omitempty"`
 }
 
 // ConfigurationConnectionAttributes represents a CheckMK API type.
@@ -1374,7 +2654,7 @@ type ConfigurationConnectionAttributes struct {
 	// Example: http://remote_site_1/check_mk/
 	UrlOfRemoteSite string `json:"url_of_remote_site"`
 	// By default the users are synchronized automatically in the interval configured in the connection. For example the LDAP connector synchronizes the users every five minutes by default. The interval can be changed for each connection individually in the connection settings. Please note that the sync...
-	UserSync interface{} `json:"user_sync"`
+	UserSync UserSyncAttributes `json:"user_sync"`
 }
 
 // ConfigurationConnectionAttributes1 represents a CheckMK API type.
@@ -1401,7 +2681,7 @@ type ConfigurationConnectionAttributes1 struct {
 	// Example: http://remote_site_1/check_mk/
 	UrlOfRemoteSite string `json:"url_of_remote_site,omitempty"`
 	// By default the users are synchronized automatically in the interval configured in the connection. For example the LDAP connector synchronizes the users every five minutes by default. The interval can be changed for each connection individually in the connection settings. Please note that the sync...
-	UserSync interface{} `json:"user_sync"`
+	UserSync UserSyncAttributes1 `json:"user_sync"`
 }
 
 // ConnectionMode represents a CheckMK API type.
@@ -1419,7 +2699,7 @@ type ContactGroup struct {
 	// The unique identifier for this domain-object type.
 	Id string `json:"id,omitempty"`
 	// list of links to other resources.
-	Links []map[string]interface{} `json:"links"`
+	Links []Link `json:"links"`
 	// The container for external resources, like linked foreign objects or actions.
 	Members map[string]interface{} `json:"members,omitempty"`
 	// A human readable title of this object. Can be used for user interfaces.
@@ -1435,35 +2715,35 @@ type ContactGroupCollection struct {
 	// The name of this collection.
 	Id string `json:"id,omitempty"`
 	// list of links to other resources.
-	Links []map[string]interface{} `json:"links"`
+	Links []Link `json:"links"`
 	// A human readable title of this object. Can be used for user interfaces.
 	Title string `json:"title,omitempty"`
 	// A list of contact group objects.
-	Value []map[string]interface{} `json:"value,omitempty"`
+	Value []ContactGroup `json:"value,omitempty"`
 }
 
 // ContactSelection represents a CheckMK API type.
 type ContactSelection struct {
-	AllContactsOfTheNotifiedObject map[string]interface{} `json:"all_contacts_of_the_notified_object"`
-	AllUsers map[string]interface{} `json:"all_users"`
-	AllUsersWithAnEmailAddress map[string]interface{} `json:"all_users_with_an_email_address"`
-	ExplicitEmailAddresses map[string]interface{} `json:"explicit_email_addresses"`
-	MembersOfContactGroups map[string]interface{} `json:"members_of_contact_groups"`
-	RestrictByContactGroups map[string]interface{} `json:"restrict_by_contact_groups"`
-	RestrictByCustomMacros map[string]interface{} `json:"restrict_by_custom_macros"`
-	TheFollowingUsers map[string]interface{} `json:"the_following_users"`
+	AllContactsOfTheNotifiedObject Checkbox `json:"all_contacts_of_the_notified_object"`
+	AllUsers Checkbox `json:"all_users"`
+	AllUsersWithAnEmailAddress Checkbox `json:"all_users_with_an_email_address"`
+	ExplicitEmailAddresses CheckboxOneOf `json:"explicit_email_addresses"`
+	MembersOfContactGroups CheckboxOneOf `json:"members_of_contact_groups"`
+	RestrictByContactGroups CheckboxOneOf `json:"restrict_by_contact_groups"`
+	RestrictByCustomMacros CheckboxOneOf `json:"restrict_by_custom_macros"`
+	TheFollowingUsers CheckboxOneOf `json:"the_following_users"`
 }
 
 // ContactSelectionAttributes represents a CheckMK API type.
 type ContactSelectionAttributes struct {
-	AllContactsOfTheNotifiedObject map[string]interface{} `json:"all_contacts_of_the_notified_object,omitempty"`
-	AllUsers map[string]interface{} `json:"all_users,omitempty"`
-	AllUsersWithAnEmailAddress map[string]interface{} `json:"all_users_with_an_email_address,omitempty"`
-	ExplicitEmailAddresses map[string]interface{} `json:"explicit_email_addresses,omitempty"`
-	MembersOfContactGroups map[string]interface{} `json:"members_of_contact_groups,omitempty"`
-	RestrictByContactGroups map[string]interface{} `json:"restrict_by_contact_groups,omitempty"`
-	RestrictByCustomMacros map[string]interface{} `json:"restrict_by_custom_macros,omitempty"`
-	TheFollowingUsers map[string]interface{} `json:"the_following_users,omitempty"`
+	AllContactsOfTheNotifiedObject *Checkbox `json:"all_contacts_of_the_notified_object,omitempty"`
+	AllUsers *Checkbox `json:"all_users,omitempty"`
+	AllUsersWithAnEmailAddress *Checkbox `json:"all_users_with_an_email_address,omitempty"`
+	ExplicitEmailAddresses *CheckboxWithListOfStr `json:"explicit_email_addresses,omitempty"`
+	MembersOfContactGroups *CheckboxWithListOfStr `json:"members_of_contact_groups,omitempty"`
+	RestrictByContactGroups *CheckboxWithListOfStr `json:"restrict_by_contact_groups,omitempty"`
+	RestrictByCustomMacros *MatchCustomMacros `json:"restrict_by_custom_macros,omitempty"`
+	TheFollowingUsers *CheckboxWithListOfStr `json:"the_following_users,omitempty"`
 }
 
 // CreateClusterHost represents a CheckMK API type.
@@ -1586,7 +2866,7 @@ type CreateHostQueryComment struct {
 	Persistent bool `json:"persistent,omitempty"`
 	// An query expression of the Livestatus 'hosts' table in nested dictionary form. If you want to use multiple expressions, nest them with the AND/OR operators.
 	// Example: {"op": "and", "expr": [{"op": "=", "left": "name", "right": "example.com"}, {"op": "!=", "left": "state", "right": "0"}]}
-	Query interface{} `json:"query,omitempty"`
+	Query *Expr `json:"query,omitempty"`
 }
 
 // CreateHostQueryDowntime represents a CheckMK API type.
@@ -1603,7 +2883,7 @@ type CreateHostQueryDowntime struct {
 	EndTime string `json:"end_time"`
 	// An query expression of the Livestatus 'hosts' table in nested dictionary form. If you want to use multiple expressions, nest them with the AND/OR operators.
 	// Example: {"op": "and", "expr": [{"op": "=", "left": "name", "right": "example.com"}, {"op": "!=", "left": "state", "right": "0"}]}
-	Query interface{} `json:"query"`
+	Query Expr `json:"query"`
 	// The recurring mode of the new downtime. Available modes are: * fixed * hour * day * week * second_week * fourth_week * weekday_start * weekday_end * day_of_month This only works when using the Enterprise Editions. Defaults to 'fixed'.
 	// Example: hour
 	Recur CreateHostQueryDowntimeRecur `json:"recur,omitempty"`
@@ -1612,12 +2892,195 @@ type CreateHostQueryDowntime struct {
 	StartTime string `json:"start_time"`
 }
 
-// CreateHostRelatedComment represents a CheckMK API type.
+// CreateHostRelatedComment is a union of CreateHostComment, CreateHostQueryComment, selected by the "comment_type" property.
 type CreateHostRelatedComment struct {
-}
-
-// CreateHostRelatedDowntime represents a CheckMK API type.
+	union json.RawMessage
+}
+
+// Discriminator returns the value of the "comment_type" property.
+func (u CreateHostRelatedComment) Discriminator() (string, error) {
+	return unionDiscriminator(u.union, "comment_type")
+}
+
+// AsCreateHostComment returns the union data as a CreateHostComment.
+func (u CreateHostRelatedComment) AsCreateHostComment() (CreateHostComment, error) {
+	var v CreateHostComment
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromCreateHostComment sets the union to v, with "comment_type" set to "host".
+func (u *CreateHostRelatedComment) FromCreateHostComment(v CreateHostComment) error {
+	data, err := unionFrom(v, "comment_type", "host")
+	if err != nil {
+		return fmt.Errorf("CreateHostRelatedComment: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsCreateHostQueryComment returns the union data as a CreateHostQueryComment.
+func (u CreateHostRelatedComment) AsCreateHostQueryComment() (CreateHostQueryComment, error) {
+	var v CreateHostQueryComment
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromCreateHostQueryComment sets the union to v, with "comment_type" set to "host_by_query".
+func (u *CreateHostRelatedComment) FromCreateHostQueryComment(v CreateHostQueryComment) error {
+	data, err := unionFrom(v, "comment_type", "host_by_query")
+	if err != nil {
+		return fmt.Errorf("CreateHostRelatedComment: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// ValueByDiscriminator decodes the union into the member selected by "comment_type".
+func (u CreateHostRelatedComment) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := u.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "host":
+		return u.AsCreateHostComment()
+	case "host_by_query":
+		return u.AsCreateHostQueryComment()
+	}
+	return nil, fmt.Errorf("CreateHostRelatedComment: unknown comment_type %q", discriminator)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u CreateHostRelatedComment) MarshalJSON() ([]byte, error) {
+	if len(u.union) == 0 {
+		return []byte("null"), nil
+	}
+	return u.union, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting unknown "comment_type" values.
+func (u *CreateHostRelatedComment) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		u.union = nil
+		return nil
+	}
+	discriminator, err := unionDiscriminator(data, "comment_type")
+	if err != nil {
+		return fmt.Errorf("CreateHostRelatedComment: %w", err)
+	}
+	switch discriminator {
+	case "host", "host_by_query":
+	default:
+		return fmt.Errorf("CreateHostRelatedComment: unknown comment_type %q", discriminator)
+	}
+	u.union = append(json.RawMessage(nil), data...)
+	return nil
+}
+
+// CreateHostRelatedDowntime is a union of CreateHostDowntime, CreateHostGroupDowntime, CreateHostQueryDowntime, selected by the "downtime_type" property.
 type CreateHostRelatedDowntime struct {
+	union json.RawMessage
+}
+
+// Discriminator returns the value of the "downtime_type" property.
+func (u CreateHostRelatedDowntime) Discriminator() (string, error) {
+	return unionDiscriminator(u.union, "downtime_type")
+}
+
+// AsCreateHostDowntime returns the union data as a CreateHostDowntime.
+func (u CreateHostRelatedDowntime) AsCreateHostDowntime() (CreateHostDowntime, error) {
+	var v CreateHostDowntime
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromCreateHostDowntime sets the union to v, with "downtime_type" set to "host".
+func (u *CreateHostRelatedDowntime) FromCreateHostDowntime(v CreateHostDowntime) error {
+	data, err := unionFrom(v, "downtime_type", "host")
+	if err != nil {
+		return fmt.Errorf("CreateHostRelatedDowntime: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsCreateHostGroupDowntime returns the union data as a CreateHostGroupDowntime.
+func (u CreateHostRelatedDowntime) AsCreateHostGroupDowntime() (CreateHostGroupDowntime, error) {
+	var v CreateHostGroupDowntime
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromCreateHostGroupDowntime sets the union to v, with "downtime_type" set to "hostgroup".
+func (u *CreateHostRelatedDowntime) FromCreateHostGroupDowntime(v CreateHostGroupDowntime) error {
+	data, err := unionFrom(v, "downtime_type", "hostgroup")
+	if err != nil {
+		return fmt.Errorf("CreateHostRelatedDowntime: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsCreateHostQueryDowntime returns the union data as a CreateHostQueryDowntime.
+func (u CreateHostRelatedDowntime) AsCreateHostQueryDowntime() (CreateHostQueryDowntime, error) {
+	var v CreateHostQueryDowntime
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromCreateHostQueryDowntime sets the union to v, with "downtime_type" set to "host_by_query".
+func (u *CreateHostRelatedDowntime) FromCreateHostQueryDowntime(v CreateHostQueryDowntime) error {
+	data, err := unionFrom(v, "downtime_type", "host_by_query")
+	if err != nil {
+		return fmt.Errorf("CreateHostRelatedDowntime: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// ValueByDiscriminator decodes the union into the member selected by "downtime_type".
+func (u CreateHostRelatedDowntime) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := u.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "host":
+		return u.AsCreateHostDowntime()
+	case "hostgroup":
+		return u.AsCreateHostGroupDowntime()
+	case "host_by_query":
+		return u.AsCreateHostQueryDowntime()
+	}
+	return nil, fmt.Errorf("CreateHostRelatedDowntime: unknown downtime_type %q", discriminator)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u CreateHostRelatedDowntime) MarshalJSON() ([]byte, error) {
+	if len(u.union) == 0 {
+		return []byte("null"), nil
+	}
+	return u.union, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting unknown "downtime_type" values.
+func (u *CreateHostRelatedDowntime) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		u.union = nil
+		return nil
+	}
+	discriminator, err := unionDiscriminator(data, "downtime_type")
+	if err != nil {
+		return fmt.Errorf("CreateHostRelatedDowntime: %w", err)
+	}
+	switch discriminator {
+	case "host", "hostgroup", "host_by_query":
+	default:
+		return fmt.Errorf("CreateHostRelatedDowntime: unknown downtime_type %q", discriminator)
+	}
+	u.union = append(json.RawMessage(nil), data...)
+	return nil
 }
 
 // CreateServiceComment represents a CheckMK API type.
@@ -1699,7 +3162,7 @@ type CreateServiceQueryComment struct {
 	Persistent bool `json:"persistent,omitempty"`
 	// An query expression of the Livestatus 'services' table in nested dictionary form. If you want to use multiple expressions, nest them with the AND/OR operators.
 	// Example: {"op": "and", "expr": [{"op": "=", "left": "name", "right": "example.com"}, {"op": "!=", "left": "state", "right": "0"}]}
-	Query interface{} `json:"query"`
+	Query Expr `json:"query"`
 }
 
 // CreateServiceQueryDowntime represents a CheckMK API type.
@@ -1716,7 +3179,7 @@ type CreateServiceQueryDowntime struct {
 	EndTime string `json:"end_time"`
 	// An query expression of the Livestatus 'services' table in nested dictionary form. If you want to use multiple expressions, nest them with the AND/OR operators.
 	// Example: {"op": "and", "expr": [{"op": "=", "left": "name", "right": "example.com"}, {"op": "!=", "left": "state", "right": "0"}]}
-	Query interface{} `json:"query"`
+	Query Expr `json:"query"`
 	// The recurring mode of the new downtime. Available modes are: * fixed * hour * day * week * second_week * fourth_week * weekday_start * weekday_end * day_of_month This only works when using the Enterprise Editions. Defaults to 'fixed'.
 	// Example: hour
 	Recur CreateServiceQueryDowntimeRecur `json:"recur,omitempty"`
@@ -1725,25 +3188,208 @@ type CreateServiceQueryDowntime struct {
 	StartTime string `json:"start_time"`
 }
 
-// CreateServiceRelatedComment represents a CheckMK API type.
+// CreateServiceRelatedComment is a union of CreateServiceComment, CreateServiceQueryComment, selected by the "comment_type" property.
 type CreateServiceRelatedComment struct {
-}
-
-// CreateServiceRelatedDowntime represents a CheckMK API type.
+	union json.RawMessage
+}
+
+// Discriminator returns the value of the "comment_type" property.
+func (u CreateServiceRelatedComment) Discriminator() (string, error) {
+	return unionDiscriminator(u.union, "comment_type")
+}
+
+// AsCreateServiceComment returns the union data as a CreateServiceComment.
+func (u CreateServiceRelatedComment) AsCreateServiceComment() (CreateServiceComment, error) {
+	var v CreateServiceComment
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromCreateServiceComment sets the union to v, with "comment_type" set to "service".
+func (u *CreateServiceRelatedComment) FromCreateServiceComment(v CreateServiceComment) error {
+	data, err := unionFrom(v, "comment_type", "service")
+	if err != nil {
+		return fmt.Errorf("CreateServiceRelatedComment: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsCreateServiceQueryComment returns the union data as a CreateServiceQueryComment.
+func (u CreateServiceRelatedComment) AsCreateServiceQueryComment() (CreateServiceQueryComment, error) {
+	var v CreateServiceQueryComment
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromCreateServiceQueryComment sets the union to v, with "comment_type" set to "service_by_query".
+func (u *CreateServiceRelatedComment) FromCreateServiceQueryComment(v CreateServiceQueryComment) error {
+	data, err := unionFrom(v, "comment_type", "service_by_query")
+	if err != nil {
+		return fmt.Errorf("CreateServiceRelatedComment: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// ValueByDiscriminator decodes the union into the member selected by "comment_type".
+func (u CreateServiceRelatedComment) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := u.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "service":
+		return u.AsCreateServiceComment()
+	case "service_by_query":
+		return u.AsCreateServiceQueryComment()
+	}
+	return nil, fmt.Errorf("CreateServiceRelatedComment: unknown comment_type %q", discriminator)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u CreateServiceRelatedComment) MarshalJSON() ([]byte, error) {
+	if len(u.union) == 0 {
+		return []byte("null"), nil
+	}
+	return u.union, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting unknown "comment_type" values.
+func (u *CreateServiceRelatedComment) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		u.union = nil
+		return nil
+	}
+	discriminator, err := unionDiscriminator(data, "comment_type")
+	if err != nil {
+		return fmt.Errorf("CreateServiceRelatedComment: %w", err)
+	}
+	switch discriminator {
+	case "service", "service_by_query":
+	default:
+		return fmt.Errorf("CreateServiceRelatedComment: unknown comment_type %q", discriminator)
+	}
+	u.union = append(json.RawMessage(nil), data...)
+	return nil
+}
+
+// CreateServiceRelatedDowntime is a union of CreateServiceDowntime, CreateServiceGroupDowntime, CreateServiceQueryDowntime, selected by the "downtime_type" property.
 type CreateServiceRelatedDowntime struct {
+	union json.RawMessage
+}
+
+// Discriminator returns the value of the "downtime_type" property.
+func (u CreateServiceRelatedDowntime) Discriminator() (string, error) {
+	return unionDiscriminator(u.union, "downtime_type")
+}
+
+// AsCreateServiceDowntime returns the union data as a CreateServiceDowntime.
+func (u CreateServiceRelatedDowntime) AsCreateServiceDowntime() (CreateServiceDowntime, error) {
+	var v CreateServiceDowntime
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromCreateServiceDowntime sets the union to v, with "downtime_type" set to "service".
+func (u *CreateServiceRelatedDowntime) FromCreateServiceDowntime(v CreateServiceDowntime) error {
+	data, err := unionFrom(v, "downtime_type", "service")
+	if err != nil {
+		return fmt.Errorf("CreateServiceRelatedDowntime: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsCreateServiceGroupDowntime returns the union data as a CreateServiceGroupDowntime.
+func (u CreateServiceRelatedDowntime) AsCreateServiceGroupDowntime() (CreateServiceGroupDowntime, error) {
+	var v CreateServiceGroupDowntime
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromCreateServiceGroupDowntime sets the union to v, with "downtime_type" set to "servicegroup".
+func (u *CreateServiceRelatedDowntime) FromCreateServiceGroupDowntime(v CreateServiceGroupDowntime) error {
+	data, err := unionFrom(v, "downtime_type", "servicegroup")
+	if err != nil {
+		return fmt.Errorf("CreateServiceRelatedDowntime: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsCreateServiceQueryDowntime returns the union data as a CreateServiceQueryDowntime.
+func (u CreateServiceRelatedDowntime) AsCreateServiceQueryDowntime() (CreateServiceQueryDowntime, error) {
+	var v CreateServiceQueryDowntime
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromCreateServiceQueryDowntime sets the union to v, with "downtime_type" set to "service_by_query".
+func (u *CreateServiceRelatedDowntime) FromCreateServiceQueryDowntime(v CreateServiceQueryDowntime) error {
+	data, err := unionFrom(v, "downtime_type", "service_by_query")
+	if err != nil {
+		return fmt.Errorf("CreateServiceRelatedDowntime: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// ValueByDiscriminator decodes the union into the member selected by "downtime_type".
+func (u CreateServiceRelatedDowntime) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := u.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "service":
+		return u.AsCreateServiceDowntime()
+	case "servicegroup":
+		return u.AsCreateServiceGroupDowntime()
+	case "service_by_query":
+		return u.AsCreateServiceQueryDowntime()
+	}
+	return nil, fmt.Errorf("CreateServiceRelatedDowntime: unknown downtime_type %q", discriminator)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u CreateServiceRelatedDowntime) MarshalJSON() ([]byte, error) {
+	if len(u.union) == 0 {
+		return []byte("null"), nil
+	}
+	return u.union, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting unknown "downtime_type" values.
+func (u *CreateServiceRelatedDowntime) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		u.union = nil
+		return nil
+	}
+	discriminator, err := unionDiscriminator(data, "downtime_type")
+	if err != nil {
+		return fmt.Errorf("CreateServiceRelatedDowntime: %w", err)
+	}
+	switch discriminator {
+	case "service", "servicegroup", "service_by_query":
+	default:
+		return fmt.Errorf("CreateServiceRelatedDowntime: unknown downtime_type %q", discriminator)
+	}
+	u.union = append(json.RawMessage(nil), data...)
+	return nil
 }
 
 // CreateTimePeriod represents a CheckMK API type.
 type CreateTimePeriod struct {
 	// The list of active time ranges.
 	// Example: [map[day:monday time_ranges:[map[end:14:00:00 start:12:00:00]]]]
-	ActiveTimeRanges []map[string]interface{} `json:"active_time_ranges"`
+	ActiveTimeRanges []TimeRangeActive `json:"active_time_ranges"`
 	// An alias for the time period.
 	// Example: alias
 	Alias string `json:"alias"`
 	// A list of additional time ranges to be added.
 	// Example: [map[date:2020-01-01 time_ranges:[map[end:18:00:00 start:14:00:00]]]]
-	Exceptions []map[string]interface{} `json:"exceptions,omitempty"`
+	Exceptions []TimePeriodException `json:"exceptions,omitempty"`
 	// A list of time period names whose periods are excluded.
 	// Example: [name]
 	Exclude []string `json:"exclude,omitempty"`
@@ -1756,27 +3402,27 @@ type CreateTimePeriod struct {
 type CreateUser struct {
 	// Authentication option for the user
 	// Example: map[auth_type:password password:password]
-	AuthOption interface{} `json:"auth_option,omitempty"`
+	AuthOption *AuthOption `json:"auth_option,omitempty"`
 	// The names of the sites the user is authorized to handle.
 	// Example: [heute]
 	AuthorizedSites []string `json:"authorized_sites,omitempty"`
 	// Contact settings for the user
 	// Example: map[email:user@example.com]
-	ContactOptions interface{} `json:"contact_options,omitempty"`
+	ContactOptions *UserContactOption `json:"contact_options,omitempty"`
 	// Assign the user to one or multiple contact groups. If no contact group is specified then no monitoring contact will be created for the user.
 	// Example: [all]
 	Contactgroups []string `json:"contactgroups,omitempty"`
 	// The user can be blocked from login but will remain part of the site. The disabling does not affect notification and alerts.
 	// Example: false
 	DisableLogin bool `json:"disable_login,omitempty"`
-	DisableNotifications interface{} `json:"disable_notifications,omitempty"`
+	DisableNotifications *DisabledNotifications `json:"disable_notifications,omitempty"`
 	// The alias or full name of the user
 	// Example: Mathias Kettner
 	Fullname string `json:"fullname"`
 	// Idle timeout for the user. Per default, the global configuration is used.
 	// Example: map[option:global]
-	IdleTimeout interface{} `json:"idle_timeout,omitempty"`
-	InterfaceOptions interface{} `json:"interface_options,omitempty"`
+	IdleTimeout *IdleOption `json:"idle_timeout,omitempty"`
+	InterfaceOptions *UserInterfaceAttributes `json:"interface_options,omitempty"`
 	// Configure the language to be used by the user in the user interface. Omitting this will configure the default language.
 	// Example: en
 	Language CreateUserLanguage `json:"language,omitempty"`
@@ -1831,7 +3477,7 @@ type CustomPluginWithParams struct {
 	// Create notifications with parameters or cancel previous notifications
 	// Example: cancel_previous_notifications
 	Option CustomPluginWithParamsOption `json:"option,omitempty"`
-	PluginParams map[string]interface{} `json:"plugin_params"`
+	PluginParams CustomPlugin `json:"plugin_params"`
 }
 
 // CustomTimeRange represents a CheckMK API type.
@@ -1871,8 +3517,109 @@ type DeleteCommentById struct {
 	SiteId string `json:"site_id"`
 }
 
-// DeleteComments represents a CheckMK API type.
+// DeleteComments is a union of DeleteCommentById, DeleteCommentsByQuery, DeleteCommentsByParams, selected by the "delete_type" property.
 type DeleteComments struct {
+	union json.RawMessage
+}
+
+// Discriminator returns the value of the "delete_type" property.
+func (u DeleteComments) Discriminator() (string, error) {
+	return unionDiscriminator(u.union, "delete_type")
+}
+
+// AsDeleteCommentById returns the union data as a DeleteCommentById.
+func (u DeleteComments) AsDeleteCommentById() (DeleteCommentById, error) {
+	var v DeleteCommentById
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromDeleteCommentById sets the union to v, with "delete_type" set to "by_id".
+func (u *DeleteComments) FromDeleteCommentById(v DeleteCommentById) error {
+	data, err := unionFrom(v, "delete_type", "by_id")
+	if err != nil {
+		return fmt.Errorf("DeleteComments: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsDeleteCommentsByQuery returns the union data as a DeleteCommentsByQuery.
+func (u DeleteComments) AsDeleteCommentsByQuery() (DeleteCommentsByQuery, error) {
+	var v DeleteCommentsByQuery
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromDeleteCommentsByQuery sets the union to v, with "delete_type" set to "query".
+func (u *DeleteComments) FromDeleteCommentsByQuery(v DeleteCommentsByQuery) error {
+	data, err := unionFrom(v, "delete_type", "query")
+	if err != nil {
+		return fmt.Errorf("DeleteComments: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsDeleteCommentsByParams returns the union data as a DeleteCommentsByParams.
+func (u DeleteComments) AsDeleteCommentsByParams() (DeleteCommentsByParams, error) {
+	var v DeleteCommentsByParams
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromDeleteCommentsByParams sets the union to v, with "delete_type" set to "params".
+func (u *DeleteComments) FromDeleteCommentsByParams(v DeleteCommentsByParams) error {
+	data, err := unionFrom(v, "delete_type", "params")
+	if err != nil {
+		return fmt.Errorf("DeleteComments: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// ValueByDiscriminator decodes the union into the member selected by "delete_type".
+func (u DeleteComments) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := u.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "by_id":
+		return u.AsDeleteCommentById()
+	case "query":
+		return u.AsDeleteCommentsByQuery()
+	case "params":
+		return u.AsDeleteCommentsByParams()
+	}
+	return nil, fmt.Errorf("DeleteComments: unknown delete_type %q", discriminator)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u DeleteComments) MarshalJSON() ([]byte, error) {
+	if len(u.union) == 0 {
+		return []byte("null"), nil
+	}
+	return u.union, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting unknown "delete_type" values.
+func (u *DeleteComments) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		u.union = nil
+		return nil
+	}
+	discriminator, err := unionDiscriminator(data, "delete_type")
+	if err != nil {
+		return fmt.Errorf("DeleteComments: %w", err)
+	}
+	switch discriminator {
+	case "by_id", "query", "params":
+	default:
+		return fmt.Errorf("DeleteComments: unknown delete_type %q", discriminator)
+	}
+	u.union = append(json.RawMessage(nil), data...)
+	return nil
 }
 
 // DeleteCommentsByParams represents a CheckMK API type.
@@ -1898,14 +3645,115 @@ type DeleteCommentsByQuery struct {
 	DeleteType DeleteCommentsByQueryDeleteType `json:"delete_type"`
 	// An query expression of the Livestatus 'comments' table in nested dictionary form. If you want to use multiple expressions, nest them with the AND/OR operators.
 	// Example: {"op": "and", "expr": [{"op": "=", "left": "name", "right": "example.com"}, {"op": "!=", "left": "state", "right": "0"}]}
-	Query interface{} `json:"query,omitempty"`
+	Query *Expr `json:"query,omitempty"`
 	// The ID of an existing site
 	// Example: production
 	SiteId string `json:"site_id"`
 }
 
-// DeleteDowntime represents a CheckMK API type.
+// DeleteDowntime is a union of DeleteDowntimeById, DeleteDowntimeByName, DeleteDowntimeByQuery, selected by the "delete_type" property.
 type DeleteDowntime struct {
+	union json.RawMessage
+}
+
+// Discriminator returns the value of the "delete_type" property.
+func (u DeleteDowntime) Discriminator() (string, error) {
+	return unionDiscriminator(u.union, "delete_type")
+}
+
+// AsDeleteDowntimeById returns the union data as a DeleteDowntimeById.
+func (u DeleteDowntime) AsDeleteDowntimeById() (DeleteDowntimeById, error) {
+	var v DeleteDowntimeById
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromDeleteDowntimeById sets the union to v, with "delete_type" set to "by_id".
+func (u *DeleteDowntime) FromDeleteDowntimeById(v DeleteDowntimeById) error {
+	data, err := unionFrom(v, "delete_type", "by_id")
+	if err != nil {
+		return fmt.Errorf("DeleteDowntime: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsDeleteDowntimeByName returns the union data as a DeleteDowntimeByName.
+func (u DeleteDowntime) AsDeleteDowntimeByName() (DeleteDowntimeByName, error) {
+	var v DeleteDowntimeByName
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromDeleteDowntimeByName sets the union to v, with "delete_type" set to "params".
+func (u *DeleteDowntime) FromDeleteDowntimeByName(v DeleteDowntimeByName) error {
+	data, err := unionFrom(v, "delete_type", "params")
+	if err != nil {
+		return fmt.Errorf("DeleteDowntime: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsDeleteDowntimeByQuery returns the union data as a DeleteDowntimeByQuery.
+func (u DeleteDowntime) AsDeleteDowntimeByQuery() (DeleteDowntimeByQuery, error) {
+	var v DeleteDowntimeByQuery
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromDeleteDowntimeByQuery sets the union to v, with "delete_type" set to "query".
+func (u *DeleteDowntime) FromDeleteDowntimeByQuery(v DeleteDowntimeByQuery) error {
+	data, err := unionFrom(v, "delete_type", "query")
+	if err != nil {
+		return fmt.Errorf("DeleteDowntime: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// ValueByDiscriminator decodes the union into the member selected by "delete_type".
+func (u DeleteDowntime) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := u.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "by_id":
+		return u.AsDeleteDowntimeById()
+	case "params":
+		return u.AsDeleteDowntimeByName()
+	case "query":
+		return u.AsDeleteDowntimeByQuery()
+	}
+	return nil, fmt.Errorf("DeleteDowntime: unknown delete_type %q", discriminator)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u DeleteDowntime) MarshalJSON() ([]byte, error) {
+	if len(u.union) == 0 {
+		return []byte("null"), nil
+	}
+	return u.union, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting unknown "delete_type" values.
+func (u *DeleteDowntime) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		u.union = nil
+		return nil
+	}
+	discriminator, err := unionDiscriminator(data, "delete_type")
+	if err != nil {
+		return fmt.Errorf("DeleteDowntime: %w", err)
+	}
+	switch discriminator {
+	case "by_id", "params", "query":
+	default:
+		return fmt.Errorf("DeleteDowntime: unknown delete_type %q", discriminator)
+	}
+	u.union = append(json.RawMessage(nil), data...)
+	return nil
 }
 
 // DeleteDowntimeById represents a CheckMK API type.
@@ -1941,11 +3789,112 @@ type DeleteDowntimeByQuery struct {
 	DeleteType DeleteDowntimeByQueryDeleteType `json:"delete_type"`
 	// An query expression of the Livestatus 'downtimes' table in nested dictionary form. If you want to use multiple expressions, nest them with the AND/OR operators.
 	// Example: {"op": "and", "expr": [{"op": "=", "left": "name", "right": "example.com"}, {"op": "!=", "left": "state", "right": "0"}]}
-	Query interface{} `json:"query"`
+	Query Expr `json:"query"`
 }
 
-// DeleteECEvents represents a CheckMK API type.
+// DeleteECEvents is a union of FilterById, FilterByQuery, FilterByParams, selected by the "filter_type" property.
 type DeleteECEvents struct {
+	union json.RawMessage
+}
+
+// Discriminator returns the value of the "filter_type" property.
+func (u DeleteECEvents) Discriminator() (string, error) {
+	return unionDiscriminator(u.union, "filter_type")
+}
+
+// AsFilterById returns the union data as a FilterById.
+func (u DeleteECEvents) AsFilterById() (FilterById, error) {
+	var v FilterById
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromFilterById sets the union to v, with "filter_type" set to "by_id".
+func (u *DeleteECEvents) FromFilterById(v FilterById) error {
+	data, err := unionFrom(v, "filter_type", "by_id")
+	if err != nil {
+		return fmt.Errorf("DeleteECEvents: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsFilterByQuery returns the union data as a FilterByQuery.
+func (u DeleteECEvents) AsFilterByQuery() (FilterByQuery, error) {
+	var v FilterByQuery
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromFilterByQuery sets the union to v, with "filter_type" set to "query".
+func (u *DeleteECEvents) FromFilterByQuery(v FilterByQuery) error {
+	data, err := unionFrom(v, "filter_type", "query")
+	if err != nil {
+		return fmt.Errorf("DeleteECEvents: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsFilterByParams returns the union data as a FilterByParams.
+func (u DeleteECEvents) AsFilterByParams() (FilterByParams, error) {
+	var v FilterByParams
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromFilterByParams sets the union to v, with "filter_type" set to "params".
+func (u *DeleteECEvents) FromFilterByParams(v FilterByParams) error {
+	data, err := unionFrom(v, "filter_type", "params")
+	if err != nil {
+		return fmt.Errorf("DeleteECEvents: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// ValueByDiscriminator decodes the union into the member selected by "filter_type".
+func (u DeleteECEvents) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := u.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "by_id":
+		return u.AsFilterById()
+	case "query":
+		return u.AsFilterByQuery()
+	case "params":
+		return u.AsFilterByParams()
+	}
+	return nil, fmt.Errorf("DeleteECEvents: unknown filter_type %q", discriminator)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u DeleteECEvents) MarshalJSON() ([]byte, error) {
+	if len(u.union) == 0 {
+		return []byte("null"), nil
+	}
+	return u.union, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting unknown "filter_type" values.
+func (u *DeleteECEvents) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		u.union = nil
+		return nil
+	}
+	discriminator, err := unionDiscriminator(data, "filter_type")
+	if err != nil {
+		return fmt.Errorf("DeleteECEvents: %w", err)
+	}
+	switch discriminator {
+	case "by_id", "query", "params":
+	default:
+		return fmt.Errorf("DeleteECEvents: unknown filter_type %q", discriminator)
+	}
+	u.union = append(json.RawMessage(nil), data...)
+	return nil
 }
 
 // DirectMapping represents a CheckMK API type.
@@ -1963,7 +3912,7 @@ type DisabledNotifications struct {
 	Disable bool `json:"disable,omitempty"`
 	// A custom timerange during which notifications are disabled
 	// Example: map[end_time:2017-07-21T18:32:28Z start_time:2017-07-21T17:32:28Z]
-	Timerange interface{} `json:"timerange,omitempty"`
+	Timerange *CustomTimeRange `json:"timerange,omitempty"`
 }
 
 // DiscoverServices represents a CheckMK API type.
@@ -1988,11 +3937,11 @@ type DiscoveryBackgroundJobStatusObject struct {
 	// The domain type of the object
 	DomainType interface{} `json:"domainType,omitempty"`
 	// The attributes of the background job
-	Extensions interface{} `json:"extensions,omitempty"`
+	Extensions *BackgroundJobStatus `json:"extensions,omitempty"`
 	// The unique identifier for this domain-object type.
 	Id string `json:"id,omitempty"`
 	// list of links to other resources.
-	Links []map[string]interface{} `json:"links"`
+	Links []Link `json:"links"`
 	// The container for external resources, like linked foreign objects or actions.
 	Members map[string]interface{} `json:"members,omitempty"`
 	// A human readable title of this object. Can be used for user interfaces.
@@ -2008,7 +3957,7 @@ type DomainObject struct {
 	// The unique identifier for this domain-object type.
 	Id string `json:"id,omitempty"`
 	// list of links to other resources.
-	Links []map[string]interface{} `json:"links"`
+	Links []Link `json:"links"`
 	// The container for external resources, like linked foreign objects or actions.
 	Members map[string]interface{} `json:"members,omitempty"`
 	// A human readable title of this object. Can be used for user interfaces.
@@ -2024,11 +3973,11 @@ type DomainObjectCollection struct {
 	// The name of this collection.
 	Id string `json:"id,omitempty"`
 	// list of links to other resources.
-	Links []map[string]interface{} `json:"links"`
+	Links []Link `json:"links"`
 	// A human readable title of this object. Can be used for user interfaces.
 	Title string `json:"title,omitempty"`
 	// The collection itself. Each entry in here is part of the collection.
-	Value []map[string]interface{} `json:"value,omitempty"`
+	Value []CollectionItem `json:"value,omitempty"`
 }
 
 // DowntimeAttributes represents a CheckMK API type.
@@ -2068,11 +4017,11 @@ type DowntimeCollection struct {
 	// The name of this collection.
 	Id string `json:"id,omitempty"`
 	// list of links to other resources.
-	Links []map[string]interface{} `json:"links"`
+	Links []Link `json:"links"`
 	// A human readable title of this object. Can be used for user interfaces.
 	Title string `json:"title,omitempty"`
 	// A list of downtime objects.
-	Value []map[string]interface{} `json:"value,omitempty"`
+	Value []DowntimeObject `json:"value,omitempty"`
 }
 
 // DowntimeObject represents a CheckMK API type.
@@ -2080,11 +4029,11 @@ type DowntimeObject struct {
 	// The domain type of the object.
 	DomainType interface{} `json:"domainType,omitempty"`
 	// The attributes of a downtime.
-	Extensions interface{} `json:"extensions,omitempty"`
+	Extensions *DowntimeAttributes `json:"extensions,omitempty"`
 	// The unique identifier for this domain-object type.
 	Id string `json:"id,omitempty"`
 	// list of links to other resources.
-	Links []map[string]interface{} `json:"links"`
+	Links []Link `json:"links"`
 	// The container for external resources, like linked foreign objects or actions.
 	Members map[string]interface{} `json:"members,omitempty"`
 	// A human readable title of this object. Can be used for user interfaces.
@@ -2145,11 +4094,11 @@ type ECEventResponse struct {
 	DomainType interface{} `json:"domainType,omitempty"`
 	// The configuration attributes of a site.
 	// Example: map[application:app_1 comment:example_comment contact:Mr Monitor count:1 facility:kern first:Oct 26 2022 07:51:25 host:host_1 ipaddress:127.0.0.1 last:Oct 21 2022 09:11:12 phase:open priority:warning rule_id:rule_1 service_level:gold state:okay text:Sample message text.]
-	Extensions interface{} `json:"extensions,omitempty"`
+	Extensions *ECEventAttributes `json:"extensions,omitempty"`
 	// The unique identifier for this domain-object type.
 	Id string `json:"id,omitempty"`
 	// list of links to other resources.
-	Links []map[string]interface{} `json:"links"`
+	Links []Link `json:"links"`
 	// The container for external resources, like linked foreign objects or actions.
 	Members map[string]interface{} `json:"members,omitempty"`
 	// A human readable title of this object. Can be used for user interfaces.
@@ -2180,17 +4129,17 @@ type EmailAndDisplayName struct {
 
 // EventConsoleAlertAttrsResponse represents a CheckMK API type.
 type EventConsoleAlertAttrsResponse struct {
-	MatchEventComment map[string]interface{} `json:"match_event_comment,omitempty"`
-	MatchRuleIds map[string]interface{} `json:"match_rule_ids,omitempty"`
-	MatchSyslogFacility map[string]interface{} `json:"match_syslog_facility,omitempty"`
-	MatchSyslogPriority map[string]interface{} `json:"match_syslog_priority,omitempty"`
+	MatchEventComment *CheckboxWithStr `json:"match_event_comment,omitempty"`
+	MatchRuleIds *CheckboxWithListOfStr `json:"match_rule_ids,omitempty"`
+	MatchSyslogFacility *CheckboxWithStr `json:"match_syslog_facility,omitempty"`
+	MatchSyslogPriority *CheckboxWithSysLogPriority `json:"match_syslog_priority,omitempty"`
 }
 
 // EventConsoleAlertsResponse represents a CheckMK API type.
 type EventConsoleAlertsResponse struct {
 	MatchType EventConsoleAlertsResponseMatchType `json:"match_type"`
 	State EventConsoleAlertsResponseState `json:"state"`
-	Values map[string]interface{} `json:"values,omitempty"`
+	Values *EventConsoleAlertAttrsResponse `json:"values,omitempty"`
 }
 
 // EventConsoleResponseCollection represents a CheckMK API type.
@@ -2202,16 +4151,117 @@ type EventConsoleResponseCollection struct {
 	// The name of this collection.
 	Id string `json:"id,omitempty"`
 	// list of links to other resources.
-	Links []map[string]interface{} `json:"links"`
+	Links []Link `json:"links"`
 	// A human readable title of this object. Can be used for user interfaces.
 	Title string `json:"title,omitempty"`
 	// A list of site configuration objects.
 	// Example: [map[domainType:event_console extensions:map[application:app_1 comment:example_comment contact:Mr Monitor count:1 facility:kern first:Oct 26 2022 07:51:25 host:host_1 ipaddress:127.0.0.1 last:Oct 21 2022 09:11:12 phase:open priority:warning rule_id:rule_1 service_level:gold state:okay text:Sample...
-	Value []map[string]interface{} `json:"value,omitempty"`
+	Value []ECEventResponse `json:"value,omitempty"`
 }
 
-// Expr represents a CheckMK API type.
+// Expr is a union of LogicalExpr, NotExpr, BinaryExpr, selected by the "op" property.
 type Expr struct {
+	union json.RawMessage
+}
+
+// Discriminator returns the value of the "op" property.
+func (u Expr) Discriminator() (string, error) {
+	return unionDiscriminator(u.union, "op")
+}
+
+// AsLogicalExpr returns the union data as a LogicalExpr.
+func (u Expr) AsLogicalExpr() (LogicalExpr, error) {
+	var v LogicalExpr
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromLogicalExpr sets the union to v, whose "op" must be one of "and", "or".
+func (u *Expr) FromLogicalExpr(v LogicalExpr) error {
+	data, err := unionFrom(v, "op", "and", "or")
+	if err != nil {
+		return fmt.Errorf("Expr: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsNotExpr returns the union data as a NotExpr.
+func (u Expr) AsNotExpr() (NotExpr, error) {
+	var v NotExpr
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromNotExpr sets the union to v, with "op" set to "not".
+func (u *Expr) FromNotExpr(v NotExpr) error {
+	data, err := unionFrom(v, "op", "not")
+	if err != nil {
+		return fmt.Errorf("Expr: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsBinaryExpr returns the union data as a BinaryExpr.
+func (u Expr) AsBinaryExpr() (BinaryExpr, error) {
+	var v BinaryExpr
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromBinaryExpr sets the union to v, whose "op" must be one of "!<", "!<=", "!=", "!>", "!>=", "!~", "!~~", "<", "<=", "=", ">", ">=", "~", "~~".
+func (u *Expr) FromBinaryExpr(v BinaryExpr) error {
+	data, err := unionFrom(v, "op", "!<", "!<=", "!=", "!>", "!>=", "!~", "!~~", "<", "<=", "=", ">", ">=", "~", "~~")
+	if err != nil {
+		return fmt.Errorf("Expr: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// ValueByDiscriminator decodes the union into the member selected by "op".
+func (u Expr) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := u.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "and", "or":
+		return u.AsLogicalExpr()
+	case "not":
+		return u.AsNotExpr()
+	case "!<", "!<=", "!=", "!>", "!>=", "!~", "!~~", "<", "<=", "=", ">", ">=", "~", "~~":
+		return u.AsBinaryExpr()
+	}
+	return nil, fmt.Errorf("Expr: unknown op %q", discriminator)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u Expr) MarshalJSON() ([]byte, error) {
+	if len(u.union) == 0 {
+		return []byte("null"), nil
+	}
+	return u.union, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting unknown "op" values.
+func (u *Expr) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		u.union = nil
+		return nil
+	}
+	discriminator, err := unionDiscriminator(data, "op")
+	if err != nil {
+		return fmt.Errorf("Expr: %w", err)
+	}
+	switch discriminator {
+	case "and", "or", "not", "!<", "!<=", "!=", "!>", "!>=", "!~", "!~~", "<", "<=", "=", ">", ">=", "~", "~~":
+	default:
+		return fmt.Errorf("Expr: unknown op %q", discriminator)
+	}
+	u.union = append(json.RawMessage(nil), data...)
+	return nil
 }
 
 // FailedHosts represents a CheckMK API type.
@@ -2219,7 +4269,7 @@ type FailedHosts struct {
 	// Detailed error messages on hosts failing the action
 	FailedHosts map[string]interface{} `json:"failed_hosts,omitempty"`
 	// The list of succeeded host objects
-	SucceededHosts interface{} `json:"succeeded_hosts,omitempty"`
+	SucceededHosts *HostConfigCollection `json:"succeeded_hosts,omitempty"`
 }
 
 // FilterById represents a CheckMK API type.
@@ -2240,7 +4290,7 @@ type FilterByParams struct {
 	// The way you would like to filter events.
 	// Example: by_id
 	FilterType FilterByParamsFilterType `json:"filter_type"`
-	Filters map[string]interface{} `json:"filters"`
+	Filters FilterParams `json:"filters"`
 	// An existing site id
 	// Example: heute
 	SiteId string `json:"site_id"`
@@ -2253,7 +4303,7 @@ type FilterByQuery struct {
 	FilterType FilterByQueryFilterType `json:"filter_type"`
 	// An query expression of the Livestatus 'eventconsoleevents' table in nested dictionary form. If you want to use multiple expressions, nest them with the AND/OR operators.
 	// Example: {"op": "=", "left": "eventconsoleevents.event_host", "right": "test_host"}
-	Query interface{} `json:"query"`
+	Query Expr `json:"query"`
 	// An existing site id
 	// Example: heute
 	SiteId string `json:"site_id"`
@@ -2293,13 +4343,13 @@ type Folder struct {
 	// The domain type of the object.
 	DomainType interface{} `json:"domainType,omitempty"`
 	// Data and Meta-Data of this object.
-	Extensions interface{} `json:"extensions,omitempty"`
+	Extensions *FolderExtensions `json:"extensions,omitempty"`
 	// The full path of the folder, tilde-separated.
 	Id string `json:"id,omitempty"`
 	// list of links to other resources.
-	Links []map[string]interface{} `json:"links"`
+	Links []Link `json:"links"`
 	// Specific collections or actions applicable to this object.
-	Members interface{} `json:"members,omitempty"`
+	Members *FolderMembers `json:"members,omitempty"`
 	// The human readable title for this folder.
 	Title string `json:"title,omitempty"`
 }
@@ -2313,33 +4363,33 @@ type FolderCollection struct {
 	// The name of this collection.
 	Id string `json:"id,omitempty"`
 	// list of links to other resources.
-	Links []map[string]interface{} `json:"links"`
+	Links []Link `json:"links"`
 	// A human readable title of this object. Can be used for user interfaces.
 	Title string `json:"title,omitempty"`
 	// A list of folder objects.
-	Value []map[string]interface{} `json:"value,omitempty"`
+	Value []Folder `json:"value,omitempty"`
 }
 
 // FolderCreateAttribute represents a CheckMK API type.
 type FolderCreateAttribute struct {
 	// Only members of the contact groups listed here have Setup permission for the host/folder. Optionally, you can make these contact groups automatically monitor contacts. The assignment of hosts to contact groups can also be defined by <a href='wato.py?mode=edit_ruleset&varname=host_contactgroups'>r...
-	Contactgroups interface{} `json:"contactgroups,omitempty"`
+	Contactgroups *HostContactGroup `json:"contactgroups,omitempty"`
 	// Labels allow you to flexibly group your hosts in order to refer to them later at other places in Checkmk, e.g. in rule chains.<br><b>Label format:</b> key:value<br><br>Checkmk does not perform any validation on the labels you use.
 	Labels map[string]interface{} `json:"labels,omitempty"`
 	// IPMI credentials
-	ManagementIpmiCredentials interface{} `json:"management_ipmi_credentials,omitempty"`
+	ManagementIpmiCredentials *IPMIParameters `json:"management_ipmi_credentials,omitempty"`
 	// The protocol used to connect to the management board. Valid options are: * `none` - No management board * `snmp` - Connect using SNMP * `ipmi` - Connect using IPMI
 	ManagementProtocol FolderCreateAttributeManagementProtocol `json:"management_protocol,omitempty"`
 	// SNMP credentials
-	ManagementSnmpCommunity interface{} `json:"management_snmp_community,omitempty"`
+	ManagementSnmpCommunity *SNMPCredentials `json:"management_snmp_community,omitempty"`
 	// Configuration for automatic network scan. Pings will besent to each IP address in the configured ranges to checkif a host is up or down. Each found host will be added tothe folder by it's hostname (if possible) or IP address.
-	NetworkScan interface{} `json:"network_scan,omitempty"`
+	NetworkScan *NetworkScan `json:"network_scan,omitempty"`
 	// A list of parents of this host.
 	Parents []string `json:"parents,omitempty"`
 	// The site that should monitor this host.
 	Site string `json:"site,omitempty"`
 	// The SNMP access configuration. A configured SNMP v1/v2 community here will have precedence over any configured SNMP community rule. For this attribute to take effect, the attribute `tag_snmp_ds` needs to be set first.
-	SnmpCommunity interface{} `json:"snmp_community,omitempty"`
+	SnmpCommunity *SNMPCredentials `json:"snmp_community,omitempty"`
 	// Choices: * `"ip-v4-only"`: IPv4 only * `"ip-v6-only"`: IPv6 only * `"ip-v4v6"`: IPv4/IPv6 dual-stack * `"no-ip"`: No IP
 	TagAddressFamily FolderCreateAttributeTagAddressFamily `json:"tag_address_family,omitempty"`
 	// Choices: * `"cmk-agent"`: API integrations if configured, else Checkmk agent * `"all-agents"`: Configured API integrations and Checkmk agent * `"special-agents"`: Configured API integrations, no Checkmk agent * `"no-agent"`: No API integrations, no Checkmk agent
@@ -2365,31 +4415,31 @@ type FolderExtensions struct {
 // FolderMembers represents a CheckMK API type.
 type FolderMembers struct {
 	// A list of links pointing to the actual host-resources.
-	Hosts interface{} `json:"hosts,omitempty"`
+	Hosts *ObjectCollectionMember `json:"hosts,omitempty"`
 	// An action which triggers the move of this folder to another folder.
-	Move interface{} `json:"move,omitempty"`
+	Move *ObjectActionMember `json:"move,omitempty"`
 }
 
 // FolderUpdateAttribute represents a CheckMK API type.
 type FolderUpdateAttribute struct {
 	// Only members of the contact groups listed here have Setup permission for the host/folder. Optionally, you can make these contact groups automatically monitor contacts. The assignment of hosts to contact groups can also be defined by <a href='wato.py?mode=edit_ruleset&varname=host_contactgroups'>r...
-	Contactgroups interface{} `json:"contactgroups,omitempty"`
+	Contactgroups *HostContactGroup `json:"contactgroups,omitempty"`
 	// Labels allow you to flexibly group your hosts in order to refer to them later at other places in Checkmk, e.g. in rule chains.<br><b>Label format:</b> key:value<br><br>Checkmk does not perform any validation on the labels you use.
 	Labels map[string]interface{} `json:"labels,omitempty"`
 	// IPMI credentials
-	ManagementIpmiCredentials interface{} `json:"management_ipmi_credentials,omitempty"`
+	ManagementIpmiCredentials *IPMIParameters `json:"management_ipmi_credentials,omitempty"`
 	// The protocol used to connect to the management board. Valid options are: * `none` - No management board * `snmp` - Connect using SNMP * `ipmi` - Connect using IPMI
 	ManagementProtocol FolderUpdateAttributeManagementProtocol `json:"management_protocol,omitempty"`
 	// SNMP credentials
-	ManagementSnmpCommunity interface{} `json:"management_snmp_community,omitempty"`
+	ManagementSnmpCommunity *SNMPCredentials `json:"management_snmp_community,omitempty"`
 	// Configuration for automatic network scan. Pings will besent to each IP address in the configured ranges to checkif a host is up or down. Each found host will be added tothe folder by it's hostname (if possible) or IP address.
-	NetworkScan interface{} `json:"network_scan,omitempty"`
+	NetworkScan *NetworkScan `json:"network_scan,omitempty"`
 	// A list of parents of this host.
 	Parents []string `json:"parents,omitempty"`
 	// The site that should monitor this host.
 	Site string `json:"site,omitempty"`
 	// The SNMP access configuration. A configured SNMP v1/v2 community here will have precedence over any configured SNMP community rule. For this attribute to take effect, the attribute `tag_snmp_ds` needs to be set first.
-	SnmpCommunity interface{} `json:"snmp_community,omitempty"`
+	SnmpCommunity *SNMPCredentials `json:"snmp_community,omitempty"`
 	// Choices: * `"ip-v4-only"`: IPv4 only * `"ip-v6-only"`: IPv6 only * `"ip-v4v6"`: IPv4/IPv6 dual-stack * `"no-ip"`: No IP
 	TagAddressFamily FolderUpdateAttributeTagAddressFamily `json:"tag_address_family,omitempty"`
 	// Choices: * `"cmk-agent"`: API integrations if configured, else Checkmk agent * `"all-agents"`: Configured API integrations and Checkmk agent * `"special-agents"`: Configured API integrations, no Checkmk agent * `"no-agent"`: No API integrations, no Checkmk agent
@@ -2407,27 +4457,27 @@ type FolderUpdateAttribute struct {
 // FolderViewAttribute represents a CheckMK API type.
 type FolderViewAttribute struct {
 	// Only members of the contact groups listed here have Setup permission for the host/folder. Optionally, you can make these contact groups automatically monitor contacts. The assignment of hosts to contact groups can also be defined by <a href='wato.py?mode=edit_ruleset&varname=host_contactgroups'>r...
-	Contactgroups interface{} `json:"contactgroups,omitempty"`
+	Contactgroups *HostContactGroup `json:"contactgroups,omitempty"`
 	// Labels allow you to flexibly group your hosts in order to refer to them later at other places in Checkmk, e.g. in rule chains.<br><b>Label format:</b> key:value<br><br>Checkmk does not perform any validation on the labels you use.
 	Labels map[string]interface{} `json:"labels,omitempty"`
 	// IPMI credentials
-	ManagementIpmiCredentials interface{} `json:"management_ipmi_credentials,omitempty"`
+	ManagementIpmiCredentials *IPMIParameters `json:"management_ipmi_credentials,omitempty"`
 	// The protocol used to connect to the management board. Valid options are: * `none` - No management board * `snmp` - Connect using SNMP * `ipmi` - Connect using IPMI
 	ManagementProtocol FolderViewAttributeManagementProtocol `json:"management_protocol,omitempty"`
 	// SNMP credentials
-	ManagementSnmpCommunity interface{} `json:"management_snmp_community,omitempty"`
+	ManagementSnmpCommunity *SNMPCredentials `json:"management_snmp_community,omitempty"`
 	// Read only access to configured metadata.
-	MetaData interface{} `json:"meta_data,omitempty"`
+	MetaData *MetaData `json:"meta_data,omitempty"`
 	// Configuration for automatic network scan. Pings will besent to each IP address in the configured ranges to checkif a host is up or down. Each found host will be added tothe folder by it's hostname (if possible) or IP address.
-	NetworkScan interface{} `json:"network_scan,omitempty"`
+	NetworkScan *NetworkScan `json:"network_scan,omitempty"`
 	// Read only access to the network scan result
-	NetworkScanResult interface{} `json:"network_scan_result,omitempty"`
+	NetworkScanResult *NetworkScanResult `json:"network_scan_result,omitempty"`
 	// A list of parents of this host.
 	Parents []string `json:"parents,omitempty"`
 	// The site that should monitor this host.
 	Site string `json:"site,omitempty"`
 	// The SNMP access configuration. A configured SNMP v1/v2 community here will have precedence over any configured SNMP community rule. For this attribute to take effect, the attribute `tag_snmp_ds` needs to be set first.
-	SnmpCommunity interface{} `json:"snmp_community,omitempty"`
+	SnmpCommunity *SNMPCredentials `json:"snmp_community,omitempty"`
 	TagAddressFamily string `json:"tag_address_family,omitempty"`
 	TagAgent string `json:"tag_agent,omitempty"`
 	TagCriticality string `json:"tag_criticality,omitempty"`
@@ -2441,7 +4491,7 @@ type FolderViewAttribute struct {
 type FromEmailAndNameCheckbox struct {
 	State FromEmailAndNameCheckboxState `json:"state"`
 	// The email address and visible name used in the From header of notifications messages. If no email address is specified the default address is OMD_SITE@FQDN is used. If the environment variable OMD_SITE is not set it defaults to checkmk
-	Value interface{} `json:"value,omitempty"`
+	Value *EmailAndDisplayName `json:"value,omitempty"`
 }
 
 // FromToNotificationNumbers represents a CheckMK API type.
@@ -2460,8 +4510,90 @@ type FromToServiceLevels struct {
 	ToLevel FromToServiceLevelsToLevel `json:"to_level"`
 }
 
-// Get represents a CheckMK API type.
+// Get is a union of GetGraph, GetMetric, selected by the "type" property.
 type Get struct {
+	union json.RawMessage
+}
+
+// Discriminator returns the value of the "type" property.
+func (u Get) Discriminator() (string, error) {
+	return unionDiscriminator(u.union, "type")
+}
+
+// AsGetGraph returns the union data as a GetGraph.
+func (u Get) AsGetGraph() (GetGraph, error) {
+	var v GetGraph
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromGetGraph sets the union to v, with "type" set to "predefined_graph".
+func (u *Get) FromGetGraph(v GetGraph) error {
+	data, err := unionFrom(v, "type", "predefined_graph")
+	if err != nil {
+		return fmt.Errorf("Get: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsGetMetric returns the union data as a GetMetric.
+func (u Get) AsGetMetric() (GetMetric, error) {
+	var v GetMetric
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromGetMetric sets the union to v, with "type" set to "single_metric".
+func (u *Get) FromGetMetric(v GetMetric) error {
+	data, err := unionFrom(v, "type", "single_metric")
+	if err != nil {
+		return fmt.Errorf("Get: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// ValueByDiscriminator decodes the union into the member selected by "type".
+func (u Get) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := u.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "predefined_graph":
+		return u.AsGetGraph()
+	case "single_metric":
+		return u.AsGetMetric()
+	}
+	return nil, fmt.Errorf("Get: unknown type %q", discriminator)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u Get) MarshalJSON() ([]byte, error) {
+	if len(u.union) == 0 {
+		return []byte("null"), nil
+	}
+	return u.union, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting unknown "type" values.
+func (u *Get) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		u.union = nil
+		return nil
+	}
+	discriminator, err := unionDiscriminator(data, "type")
+	if err != nil {
+		return fmt.Errorf("Get: %w", err)
+	}
+	switch discriminator {
+	case "predefined_graph", "single_metric":
+	default:
+		return fmt.Errorf("Get: unknown type %q", discriminator)
+	}
+	u.union = append(json.RawMessage(nil), data...)
+	return nil
 }
 
 // GetGraph represents a CheckMK API type.
@@ -2483,7 +4615,7 @@ type GetGraph struct {
 	Site string `json:"site,omitempty"`
 	// The time range from which to source the metrics.
 	// Example: map[end:2026-01-01 14:07:09.947458 start:2026-01-01 13:52:09.947456]
-	TimeRange interface{} `json:"time_range"`
+	TimeRange TimeRange `json:"time_range"`
 	// Specify whether you want to receive a single metric (via metric_id), or a predefined graph containing multiple metrics (via graph_id).
 	// Example: single_metric
 	Type GetGraphType `json:"type"`
@@ -2508,7 +4640,7 @@ type GetMetric struct {
 	Site string `json:"site,omitempty"`
 	// The time range from which to source the metrics.
 	// Example: map[end:2026-01-01 14:07:09.947458 start:2026-01-01 13:52:09.947456]
-	TimeRange interface{} `json:"time_range"`
+	TimeRange TimeRange `json:"time_range"`
 	// Specify whether you want to receive a single metric (via metric_id), or a predefined graph containing multiple metrics (via graph_id).
 	// Example: single_metric
 	Type GetMetricType `json:"type"`
@@ -2518,33 +4650,33 @@ type GetMetric struct {
 type GraphCollection struct {
 	// The actual graph data.
 	// Example: [map[color:#ffffff data_points:[1 2 3 1] line_type:area title:RAM used]]
-	Metrics []map[string]interface{} `json:"metrics"`
+	Metrics []Metric `json:"metrics"`
 	// The interval between two samples in seconds.
 	// Example: 60
 	Step int `json:"step"`
 	// The time range withing the samples of the response lie.
 	// Example: map[time_range:map[end:1970-01-01T00:00:30Z start:1970-01-01T00:00:00Z]]
-	TimeRange interface{} `json:"time_range"`
+	TimeRange TimeRange `json:"time_range"`
 }
 
 // HTMLMailPluginCreate represents a CheckMK API type.
 type HTMLMailPluginCreate struct {
-	BulkNotificationsWithGraphs map[string]interface{} `json:"bulk_notifications_with_graphs"`
-	DisplayGraphsAmongEachOther map[string]interface{} `json:"display_graphs_among_each_other"`
-	EnableSyncSmtp map[string]interface{} `json:"enable_sync_smtp"`
-	FromDetails map[string]interface{} `json:"from_details"`
-	GraphsPerNotification map[string]interface{} `json:"graphs_per_notification"`
-	InfoToBeDisplayedInTheEmailBody map[string]interface{} `json:"info_to_be_displayed_in_the_email_body"`
-	InsertHtmlSectionBetweenBodyAndTable map[string]interface{} `json:"insert_html_section_between_body_and_table"`
+	BulkNotificationsWithGraphs CheckboxOneOf `json:"bulk_notifications_with_graphs"`
+	DisplayGraphsAmongEachOther Checkbox `json:"display_graphs_among_each_other"`
+	EnableSyncSmtp CheckboxOneOf `json:"enable_sync_smtp"`
+	FromDetails CheckboxOneOf `json:"from_details"`
+	GraphsPerNotification CheckboxOneOf `json:"graphs_per_notification"`
+	InfoToBeDisplayedInTheEmailBody CheckboxOneOf `json:"info_to_be_displayed_in_the_email_body"`
+	InsertHtmlSectionBetweenBodyAndTable CheckboxOneOf `json:"insert_html_section_between_body_and_table"`
 	// The plugin name.
 	// Example: mail
 	PluginName HTMLMailPluginCreatePluginName `json:"plugin_name"`
-	ReplyTo map[string]interface{} `json:"reply_to"`
-	SendSeparateNotificationToEveryRecipient map[string]interface{} `json:"send_separate_notification_to_every_recipient"`
-	SortOrderForBulkNotificaions map[string]interface{} `json:"sort_order_for_bulk_notificaions"`
-	SubjectForHostNotifications map[string]interface{} `json:"subject_for_host_notifications"`
-	SubjectForServiceNotifications map[string]interface{} `json:"subject_for_service_notifications"`
-	UrlPrefixForLinksToCheckmk map[string]interface{} `json:"url_prefix_for_links_to_checkmk"`
+	ReplyTo CheckboxOneOf `json:"reply_to"`
+	SendSeparateNotificationToEveryRecipient Checkbox `json:"send_separate_notification_to_every_recipient"`
+	SortOrderForBulkNotificaions CheckboxOneOf `json:"sort_order_for_bulk_notificaions"`
+	SubjectForHostNotifications CheckboxOneOf `json:"subject_for_host_notifications"`
+	SubjectForServiceNotifications CheckboxOneOf `json:"subject_for_service_notifications"`
+	UrlPrefixForLinksToCheckmk CheckboxOneOf `json:"url_prefix_for_links_to_checkmk"`
 }
 
 // Heartbeat represents a CheckMK API type.
@@ -2574,7 +4706,7 @@ type Host struct {
 
 // HostConditions represents a CheckMK API type.
 type HostConditions struct {
-	HostChoice interface{} `json:"host_choice"`
+	HostChoice BIHostChoice `json:"host_choice"`
 	HostFolder string `json:"host_folder"`
 	HostLabels map[string]interface{} `json:"host_labels"`
 	HostTags map[string]interface{} `json:"host_tags"`
@@ -2585,13 +4717,13 @@ type HostConfig struct {
 	// The domain type of the object.
 	DomainType interface{} `json:"domainType"`
 	// All the data and metadata of this host.
-	Extensions interface{} `json:"extensions,omitempty"`
+	Extensions *HostExtensions `json:"extensions,omitempty"`
 	// The unique identifier for this domain-object type.
 	Id string `json:"id,omitempty"`
 	// list of links to other resources.
-	Links []map[string]interface{} `json:"links"`
+	Links []Link `json:"links"`
 	// All the members of the host object.
-	Members interface{} `json:"members,omitempty"`
+	Members *HostMembers `json:"members,omitempty"`
 	// A human readable title of this object. Can be used for user interfaces.
 	Title string `json:"title,omitempty"`
 }
@@ -2605,11 +4737,11 @@ type HostConfigCollection struct {
 	// The name of this collection.
 	Id string `json:"id,omitempty"`
 	// list of links to other resources.
-	Links []map[string]interface{} `json:"links"`
+	Links []Link `json:"links"`
 	// A human readable title of this object. Can be used for user interfaces.
 	Title string `json:"title,omitempty"`
 	// A list of host objects.
-	Value []map[string]interface{} `json:"value,omitempty"`
+	Value []HostConfig `json:"value,omitempty"`
 }
 
 // HostConfigSchemaInternal represents a CheckMK API type.
@@ -2643,7 +4775,7 @@ type HostCreateAttribute struct {
 	// Add a comment or describe this host
 	Alias string `json:"alias,omitempty"`
 	// Only members of the contact groups listed here have Setup permission for the host/folder. Optionally, you can make these contact groups automatically monitor contacts. The assignment of hosts to contact groups can also be defined by <a href='wato.py?mode=edit_ruleset&varname=host_contactgroups'>r...
-	Contactgroups interface{} `json:"contactgroups,omitempty"`
+	Contactgroups *HostContactGroup `json:"contactgroups,omitempty"`
 	// Whether or not the last bulk discovery failed. It is set to True once it fails and unset in case a later discovery succeeds.
 	// Example: false
 	InventoryFailed bool `json:"inventory_failed,omitempty"`
@@ -2656,23 +4788,23 @@ type HostCreateAttribute struct {
 	// Name of host attributes which are locked in the UI.
 	LockedAttributes []string `json:"locked_attributes,omitempty"`
 	// Identity of the entity which locked the locked_attributes. The identity is built out of the Site ID, the program name and the connection ID.
-	LockedBy interface{} `json:"locked_by,omitempty"`
+	LockedBy *LockedBy `json:"locked_by,omitempty"`
 	// Address (IPv4, IPv6 or hostname) under which the management board can be reached.
 	ManagementAddress string `json:"management_address,omitempty"`
 	// IPMI credentials
-	ManagementIpmiCredentials interface{} `json:"management_ipmi_credentials,omitempty"`
+	ManagementIpmiCredentials *IPMIParameters `json:"management_ipmi_credentials,omitempty"`
 	// The protocol used to connect to the management board. Valid options are: * `none` - No management board * `snmp` - Connect using SNMP * `ipmi` - Connect using IPMI
 	ManagementProtocol HostCreateAttributeManagementProtocol `json:"management_protocol,omitempty"`
 	// SNMP credentials
-	ManagementSnmpCommunity interface{} `json:"management_snmp_community,omitempty"`
+	ManagementSnmpCommunity *SNMPCredentials `json:"management_snmp_community,omitempty"`
 	// Configuration for automatic network scan. Pings will besent to each IP address in the configured ranges to checkif a host is up or down. Each found host will be added tothe folder by it's hostname (if possible) or IP address.
-	NetworkScan interface{} `json:"network_scan,omitempty"`
+	NetworkScan *NetworkScan `json:"network_scan,omitempty"`
 	// A list of parents of this host.
 	Parents []string `json:"parents,omitempty"`
 	// The site that should monitor this host.
 	Site string `json:"site,omitempty"`
 	// The SNMP access configuration. A configured SNMP v1/v2 community here will have precedence over any configured SNMP community rule. For this attribute to take effect, the attribute `tag_snmp_ds` needs to be set first.
-	SnmpCommunity interface{} `json:"snmp_community,omitempty"`
+	SnmpCommunity *SNMPCredentials `json:"snmp_community,omitempty"`
 	// Choices: * `"ip-v4-only"`: IPv4 only * `"ip-v6-only"`: IPv6 only * `"ip-v4v6"`: IPv4/IPv6 dual-stack * `"no-ip"`: No IP
 	TagAddressFamily HostCreateAttributeTagAddressFamily `json:"tag_address_family,omitempty"`
 	// Choices: * `"cmk-agent"`: API integrations if configured, else Checkmk agent * `"all-agents"`: Configured API integrations and Checkmk agent * `"special-agents"`: Configured API integrations, no Checkmk agent * `"no-agent"`: No API integrations, no Checkmk agent
@@ -2714,7 +4846,7 @@ type HostExtensions struct {
 	ClusterNodes []string `json:"cluster_nodes,omitempty"`
 	// All attributes of this host and all parent folders.
 	// Example: map[tag_snmp_ds:<nil>]
-	EffectiveAttributes interface{} `json:"effective_attributes,omitempty"`
+	EffectiveAttributes *HostExtensionsEffectiveAttributes `json:"effective_attributes,omitempty"`
 	// The folder, in which this host resides. Path delimiters can be either `~`, `/` or `\`. Please use the one most appropriate for your quoting/escaping needs. A good default choice is `~`.
 	Folder string `json:"folder,omitempty"`
 	// If this is a cluster host, i.e. a container for other hosts.
@@ -2732,7 +4864,7 @@ type HostExtensionsEffectiveAttributes struct {
 	// Add a comment or describe this host
 	Alias string `json:"alias,omitempty"`
 	// Only members of the contact groups listed here have Setup permission for the host/folder. Optionally, you can make these contact groups automatically monitor contacts. The assignment of hosts to contact groups can also be defined by <a href='wato.py?mode=edit_ruleset&varname=host_contactgroups'>r...
-	Contactgroups interface{} `json:"contactgroups,omitempty"`
+	Contactgroups *HostContactGroup `json:"contactgroups,omitempty"`
 	// Whether or not the last bulk discovery failed. It is set to True once it fails and unset in case a later discovery succeeds.
 	// Example: false
 	InventoryFailed bool `json:"inventory_failed,omitempty"`
@@ -2745,27 +4877,27 @@ type HostExtensionsEffectiveAttributes struct {
 	// Name of host attributes which are locked in the UI.
 	LockedAttributes []string `json:"locked_attributes,omitempty"`
 	// Identity of the entity which locked the locked_attributes. The identity is built out of the Site ID, the program name and the connection ID.
-	LockedBy interface{} `json:"locked_by,omitempty"`
+	LockedBy *LockedBy `json:"locked_by,omitempty"`
 	// Address (IPv4, IPv6 or hostname) under which the management board can be reached.
 	ManagementAddress string `json:"management_address,omitempty"`
 	// IPMI credentials
-	ManagementIpmiCredentials interface{} `json:"management_ipmi_credentials,omitempty"`
+	ManagementIpmiCredentials *IPMIParameters `json:"management_ipmi_credentials,omitempty"`
 	// The protocol used to connect to the management board. Valid options are: * `none` - No management board * `snmp` - Connect using SNMP * `ipmi` - Connect using IPMI
 	ManagementProtocol HostExtensionsEffectiveAttributesManagementProtocol `json:"management_protocol,omitempty"`
 	// SNMP credentials
-	ManagementSnmpCommunity interface{} `json:"management_snmp_community,omitempty"`
+	ManagementSnmpCommunity *SNMPCredentials `json:"management_snmp_community,omitempty"`
 	// Read only access to configured metadata.
-	MetaData interface{} `json:"meta_data,omitempty"`
+	MetaData *MetaData `json:"meta_data,omitempty"`
 	// Configuration for automatic network scan. Pings will besent to each IP address in the configured ranges to checkif a host is up or down. Each found host will be added tothe folder by it's hostname (if possible) or IP address.
-	NetworkScan interface{} `json:"network_scan,omitempty"`
+	NetworkScan *NetworkScan `json:"network_scan,omitempty"`
 	// Read only access to the network scan result
-	NetworkScanResult interface{} `json:"network_scan_result,omitempty"`
+	NetworkScanResult *NetworkScanResult `json:"network_scan_result,omitempty"`
 	// A list of parents of this host.
 	Parents []string `json:"parents,omitempty"`
 	// The site that should monitor this host.
 	Site string `json:"site,omitempty"`
 	// The SNMP access configuration. A configured SNMP v1/v2 community here will have precedence over any configured SNMP community rule. For this attribute to take effect, the attribute `tag_snmp_ds` needs to be set first.
-	SnmpCommunity interface{} `json:"snmp_community,omitempty"`
+	SnmpCommunity *SNMPCredentials `json:"snmp_community,omitempty"`
 	TagAddressFamily string `json:"tag_address_family,omitempty"`
 	TagAgent string `json:"tag_agent,omitempty"`
 	TagCriticality string `json:"tag_criticality,omitempty"`
@@ -2784,7 +4916,7 @@ type HostGroup struct {
 	// The unique identifier for this domain-object type.
 	Id string `json:"id,omitempty"`
 	// list of links to other resources.
-	Links []map[string]interface{} `json:"links"`
+	Links []Link `json:"links"`
 	// The container for external resources, like linked foreign objects or actions.
 	Members map[string]interface{} `json:"members,omitempty"`
 	// A human readable title of this object. Can be used for user interfaces.
@@ -2800,17 +4932,17 @@ type HostGroupCollection struct {
 	// The name of this collection.
 	Id string `json:"id,omitempty"`
 	// list of links to other resources.
-	Links []map[string]interface{} `json:"links"`
+	Links []Link `json:"links"`
 	// A human readable title of this object. Can be used for user interfaces.
 	Title string `json:"title,omitempty"`
 	// A list of host group objects.
-	Value []map[string]interface{} `json:"value,omitempty"`
+	Value []HostGroup `json:"value,omitempty"`
 }
 
 // HostMembers represents a CheckMK API type.
 type HostMembers struct {
 	// The folder in which this host resides. It is represented by a hexadecimal identifier which is it's 'primary key'. The folder can be accessed via the `self`-link provided in the links array.
-	FolderConfig interface{} `json:"folder_config,omitempty"`
+	FolderConfig *Folder `json:"folder_config,omitempty"`
 }
 
 // HostOrServiceCondition represents a CheckMK API type.
@@ -2847,7 +4979,7 @@ type HostTag1 struct {
 // HostTagExtensions represents a CheckMK API type.
 type HostTagExtensions struct {
 	// The list of tags in this group.
-	Tags []map[string]interface{} `json:"tags,omitempty"`
+	Tags []HostTag1 `json:"tags,omitempty"`
 	// The topic this host tag group is organized in.
 	Topic string `json:"topic,omitempty"`
 }
@@ -2861,11 +4993,11 @@ type HostTagGroupCollection struct {
 	// The name of this collection.
 	Id string `json:"id,omitempty"`
 	// list of links to other resources.
-	Links []map[string]interface{} `json:"links"`
+	Links []Link `json:"links"`
 	// A human readable title of this object. Can be used for user interfaces.
 	Title string `json:"title,omitempty"`
 	// A list of host tag group objects.
-	Value []map[string]interface{} `json:"value,omitempty"`
+	Value []ConcreteHostTagGroup `json:"value,omitempty"`
 }
 
 // HostTagValues represents a CheckMK API type.
@@ -2893,7 +5025,7 @@ type HostUpdateAttribute struct {
 	// Add a comment or describe this host
 	Alias string `json:"alias,omitempty"`
 	// Only members of the contact groups listed here have Setup permission for the host/folder. Optionally, you can make these contact groups automatically monitor contacts. The assignment of hosts to contact groups can also be defined by <a href='wato.py?mode=edit_ruleset&varname=host_contactgroups'>r...
-	Contactgroups interface{} `json:"contactgroups,omitempty"`
+	Contactgroups *HostContactGroup `json:"contactgroups,omitempty"`
 	// Whether or not the last bulk discovery failed. It is set to True once it fails and unset in case a later discovery succeeds.
 	// Example: false
 	InventoryFailed bool `json:"inventory_failed,omitempty"`
@@ -2906,23 +5038,23 @@ type HostUpdateAttribute struct {
 	// Name of host attributes which are locked in the UI.
 	LockedAttributes []string `json:"locked_attributes,omitempty"`
 	// Identity of the entity which locked the locked_attributes. The identity is built out of the Site ID, the program name and the connection ID.
-	LockedBy interface{} `json:"locked_by,omitempty"`
+	LockedBy *LockedBy `json:"locked_by,omitempty"`
 	// Address (IPv4, IPv6 or hostname) under which the management board can be reached.
 	ManagementAddress string `json:"management_address,omitempty"`
 	// IPMI credentials
-	ManagementIpmiCredentials interface{} `json:"management_ipmi_credentials,omitempty"`
+	ManagementIpmiCredentials *IPMIParameters `json:"management_ipmi_credentials,omitempty"`
 	// The protocol used to connect to the management board. Valid options are: * `none` - No management board * `snmp` - Connect using SNMP * `ipmi` - Connect using IPMI
 	ManagementProtocol HostUpdateAttributeManagementProtocol `json:"management_protocol,omitempty"`
 	// SNMP credentials
-	ManagementSnmpCommunity interface{} `json:"management_snmp_community,omitempty"`
+	ManagementSnmpCommunity *SNMPCredentials `json:"management_snmp_community,omitempty"`
 	// Configuration for automatic network scan. Pings will besent to each IP address in the configured ranges to checkif a host is up or down. Each found host will be added tothe folder by it's hostname (if possible) or IP address.
-	NetworkScan interface{} `json:"network_scan,omitempty"`
+	NetworkScan *NetworkScan `json:"network_scan,omitempty"`
 	// A list of parents of this host.
 	Parents []string `json:"parents,omitempty"`
 	// The site that should monitor this host.
 	Site string `json:"site,omitempty"`
 	// The SNMP access configuration. A configured SNMP v1/v2 community here will have precedence over any configured SNMP community rule. For this attribute to take effect, the attribute `tag_snmp_ds` needs to be set first.
-	SnmpCommunity interface{} `json:"snmp_community,omitempty"`
+	SnmpCommunity *SNMPCredentials `json:"snmp_community,omitempty"`
 	// Choices: * `"ip-v4-only"`: IPv4 only * `"ip-v6-only"`: IPv6 only * `"ip-v4v6"`: IPv4/IPv6 dual-stack * `"no-ip"`: No IP
 	TagAddressFamily HostUpdateAttributeTagAddressFamily `json:"tag_address_family,omitempty"`
 	// Choices: * `"cmk-agent"`: API integrations if configured, else Checkmk agent * `"all-agents"`: Configured API integrations and Checkmk agent * `"special-agents"`: Configured API integrations, no Checkmk agent * `"no-agent"`: No API integrations, no Checkmk agent
@@ -2946,7 +5078,7 @@ type HostViewAttribute struct {
 	// Add a comment or describe this host
 	Alias string `json:"alias,omitempty"`
 	// Only members of the contact groups listed here have Setup permission for the host/folder. Optionally, you can make these contact groups automatically monitor contacts. The assignment of hosts to contact groups can also be defined by <a href='wato.py?mode=edit_ruleset&varname=host_contactgroups'>r...
-	Contactgroups interface{} `json:"contactgroups,omitempty"`
+	Contactgroups *HostContactGroup `json:"contactgroups,omitempty"`
 	// Whether or not the last bulk discovery failed. It is set to True once it fails and unset in case a later discovery succeeds.
 	// Example: false
 	InventoryFailed bool `json:"inventory_failed,omitempty"`
@@ -2959,27 +5091,27 @@ type HostViewAttribute struct {
 	// Name of host attributes which are locked in the UI.
 	LockedAttributes []string `json:"locked_attributes,omitempty"`
 	// Identity of the entity which locked the locked_attributes. The identity is built out of the Site ID, the program name and the connection ID.
-	LockedBy interface{} `json:"locked_by,omitempty"`
+	LockedBy *LockedBy `json:"locked_by,omitempty"`
 	// Address (IPv4, IPv6 or hostname) under which the management board can be reached.
 	ManagementAddress string `json:"management_address,omitempty"`
 	// IPMI credentials
-	ManagementIpmiCredentials interface{} `json:"management_ipmi_credentials,omitempty"`
+	ManagementIpmiCredentials *IPMIParameters `json:"management_ipmi_credentials,omitempty"`
 	// The protocol used to connect to the management board. Valid options are: * `none` - No management board * `snmp` - Connect using SNMP * `ipmi` - Connect using IPMI
 	ManagementProtocol HostViewAttributeManagementProtocol `json:"management_protocol,omitempty"`
 	// SNMP credentials
-	ManagementSnmpCommunity interface{} `json:"management_snmp_community,omitempty"`
+	ManagementSnmpCommunity *SNMPCredentials `json:"management_snmp_community,omitempty"`
 	// Read only access to configured metadata.
-	MetaData interface{} `json:"meta_data,omitempty"`
+	MetaData *MetaData `json:"meta_data,omitempty"`
 	// Configuration for automatic network scan. Pings will besent to each IP address in the configured ranges to checkif a host is up or down. Each found host will be added tothe folder by it's hostname (if possible) or IP address.
-	NetworkScan interface{} `json:"network_scan,omitempty"`
+	NetworkScan *NetworkScan `json:"network_scan,omitempty"`
 	// Read only access to the network scan result
-	NetworkScanResult interface{} `json:"network_scan_result,omitempty"`
+	NetworkScanResult *NetworkScanResult `json:"network_scan_result,omitempty"`
 	// A list of parents of this host.
 	Parents []string `json:"parents,omitempty"`
 	// The site that should monitor this host.
 	Site string `json:"site,omitempty"`
 	// The SNMP access configuration. A configured SNMP v1/v2 community here will have precedence over any configured SNMP community rule. For this attribute to take effect, the attribute `tag_snmp_ds` needs to be set first.
-	SnmpCommunity interface{} `json:"snmp_community,omitempty"`
+	SnmpCommunity *SNMPCredentials `json:"snmp_community,omitempty"`
 	TagAddressFamily string `json:"tag_address_family,omitempty"`
 	TagAgent string `json:"tag_agent,omitempty"`
 	TagCriticality string `json:"tag_criticality,omitempty"`
@@ -3020,8 +5152,128 @@ type IPNetwork struct {
 	Type interface{} `json:"type,omitempty"`
 }
 
-// IPRangeWithRegexp represents a CheckMK API type.
+// IPRangeWithRegexp is a union of IPAddressRange, IPNetwork, IPAddresses, IPRegexp, selected by the "type" property.
 type IPRangeWithRegexp struct {
+	union json.RawMessage
+}
+
+// Discriminator returns the value of the "type" property.
+func (u IPRangeWithRegexp) Discriminator() (string, error) {
+	return unionDiscriminator(u.union, "type")
+}
+
+// AsIPAddressRange returns the union data as a IPAddressRange.
+func (u IPRangeWithRegexp) AsIPAddressRange() (IPAddressRange, error) {
+	var v IPAddressRange
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromIPAddressRange sets the union to v, with "type" set to "address_range".
+func (u *IPRangeWithRegexp) FromIPAddressRange(v IPAddressRange) error {
+	data, err := unionFrom(v, "type", "address_range")
+	if err != nil {
+		return fmt.Errorf("IPRangeWithRegexp: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsIPNetwork returns the union data as a IPNetwork.
+func (u IPRangeWithRegexp) AsIPNetwork() (IPNetwork, error) {
+	var v IPNetwork
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromIPNetwork sets the union to v, with "type" set to "network_range".
+func (u *IPRangeWithRegexp) FromIPNetwork(v IPNetwork) error {
+	data, err := unionFrom(v, "type", "network_range")
+	if err != nil {
+		return fmt.Errorf("IPRangeWithRegexp: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsIPAddresses returns the union data as a IPAddresses.
+func (u IPRangeWithRegexp) AsIPAddresses() (IPAddresses, error) {
+	var v IPAddresses
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromIPAddresses sets the union to v, with "type" set to "explicit_addresses".
+func (u *IPRangeWithRegexp) FromIPAddresses(v IPAddresses) error {
+	data, err := unionFrom(v, "type", "explicit_addresses")
+	if err != nil {
+		return fmt.Errorf("IPRangeWithRegexp: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsIPRegexp returns the union data as a IPRegexp.
+func (u IPRangeWithRegexp) AsIPRegexp() (IPRegexp, error) {
+	var v IPRegexp
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromIPRegexp sets the union to v, with "type" set to "exclude_by_regexp".
+func (u *IPRangeWithRegexp) FromIPRegexp(v IPRegexp) error {
+	data, err := unionFrom(v, "type", "exclude_by_regexp")
+	if err != nil {
+		return fmt.Errorf("IPRangeWithRegexp: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// ValueByDiscriminator decodes the union into the member selected by "type".
+func (u IPRangeWithRegexp) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := u.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "address_range":
+		return u.AsIPAddressRange()
+	case "network_range":
+		return u.AsIPNetwork()
+	case "explicit_addresses":
+		return u.AsIPAddresses()
+	case "exclude_by_regexp":
+		return u.AsIPRegexp()
+	}
+	return nil, fmt.Errorf("IPRangeWithRegexp: unknown type %q", discriminator)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u IPRangeWithRegexp) MarshalJSON() ([]byte, error) {
+	if len(u.union) == 0 {
+		return []byte("null"), nil
+	}
+	return u.union, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting unknown "type" values.
+func (u *IPRangeWithRegexp) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		u.union = nil
+		return nil
+	}
+	discriminator, err := unionDiscriminator(data, "type")
+	if err != nil {
+		return fmt.Errorf("IPRangeWithRegexp: %w", err)
+	}
+	switch discriminator {
+	case "address_range", "network_range", "explicit_addresses", "exclude_by_regexp":
+	default:
+		return fmt.Errorf("IPRangeWithRegexp: unknown type %q", discriminator)
+	}
+	u.union = append(json.RawMessage(nil), data...)
+	return nil
 }
 
 // IPRegexp represents a CheckMK API type.
@@ -3048,8 +5300,90 @@ type IlertAPIKey struct {
 	Option IlertAPIKeyOption `json:"option"`
 }
 
-// IlertKeyOrStoreSelector represents a CheckMK API type.
+// IlertKeyOrStoreSelector is a union of IlertAPIKey, IlertPasswordStoreID, selected by the "option" property.
 type IlertKeyOrStoreSelector struct {
+	union json.RawMessage
+}
+
+// Discriminator returns the value of the "option" property.
+func (u IlertKeyOrStoreSelector) Discriminator() (string, error) {
+	return unionDiscriminator(u.union, "option")
+}
+
+// AsIlertAPIKey returns the union data as a IlertAPIKey.
+func (u IlertKeyOrStoreSelector) AsIlertAPIKey() (IlertAPIKey, error) {
+	var v IlertAPIKey
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromIlertAPIKey sets the union to v, with "option" set to "explicit".
+func (u *IlertKeyOrStoreSelector) FromIlertAPIKey(v IlertAPIKey) error {
+	data, err := unionFrom(v, "option", "explicit")
+	if err != nil {
+		return fmt.Errorf("IlertKeyOrStoreSelector: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsIlertPasswordStoreID returns the union data as a IlertPasswordStoreID.
+func (u IlertKeyOrStoreSelector) AsIlertPasswordStoreID() (IlertPasswordStoreID, error) {
+	var v IlertPasswordStoreID
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromIlertPasswordStoreID sets the union to v, with "option" set to "store".
+func (u *IlertKeyOrStoreSelector) FromIlertPasswordStoreID(v IlertPasswordStoreID) error {
+	data, err := unionFrom(v, "option", "store")
+	if err != nil {
+		return fmt.Errorf("IlertKeyOrStoreSelector: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// ValueByDiscriminator decodes the union into the member selected by "option".
+func (u IlertKeyOrStoreSelector) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := u.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "explicit":
+		return u.AsIlertAPIKey()
+	case "store":
+		return u.AsIlertPasswordStoreID()
+	}
+	return nil, fmt.Errorf("IlertKeyOrStoreSelector: unknown option %q", discriminator)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u IlertKeyOrStoreSelector) MarshalJSON() ([]byte, error) {
+	if len(u.union) == 0 {
+		return []byte("null"), nil
+	}
+	return u.union, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting unknown "option" values.
+func (u *IlertKeyOrStoreSelector) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		u.union = nil
+		return nil
+	}
+	discriminator, err := unionDiscriminator(data, "option")
+	if err != nil {
+		return fmt.Errorf("IlertKeyOrStoreSelector: %w", err)
+	}
+	switch discriminator {
+	case "explicit", "store":
+	default:
+		return fmt.Errorf("IlertKeyOrStoreSelector: unknown option %q", discriminator)
+	}
+	u.union = append(json.RawMessage(nil), data...)
+	return nil
 }
 
 // IlertPasswordStoreID represents a CheckMK API type.
@@ -3062,7 +5396,7 @@ type IlertPasswordStoreID struct {
 
 // IlertPluginCreate represents a CheckMK API type.
 type IlertPluginCreate struct {
-	ApiKey map[string]interface{} `json:"api_key"`
+	ApiKey IlertKeyOrStoreSelector `json:"api_key"`
 	// A custom summary for host alerts
 	// Example: $NOTIFICATIONTYPE$ Host Alert: $HOSTNAME$ is $HOSTSTATE$ - $HOSTOUTPUT$
 	CustomSummaryForHostAlerts string `json:"custom_summary_for_host_alerts"`
@@ -3070,16 +5404,16 @@ type IlertPluginCreate struct {
 	// Example: $NOTIFICATIONTYPE$ Service Alert: $HOSTALIAS$/$SERVICEDESC$ is $SERVICESTATE$ - $SERVICEOUTPUT$
 	CustomSummaryForServiceAlerts string `json:"custom_summary_for_service_alerts"`
 	// Ignore unverified HTTPS request warnings. Use with caution.
-	DisableSslCertVerification interface{} `json:"disable_ssl_cert_verification"`
+	DisableSslCertVerification Checkbox `json:"disable_ssl_cert_verification"`
 	// Use the proxy settings from the environment variables. The variables NO_PROXY, HTTP_PROXY and HTTPS_PROXY are taken into account during execution.
-	HttpProxy interface{} `json:"http_proxy"`
+	HttpProxy CheckboxOneOf `json:"http_proxy"`
 	// HIGH - with escalation, LOW - without escalation
 	// Example: HIGH
 	NotificationPriority IlertPluginCreateNotificationPriority `json:"notification_priority"`
 	// The plugin name.
 	// Example: mail
 	PluginName IlertPluginCreatePluginName `json:"plugin_name"`
-	UrlPrefixForLinksToCheckmk map[string]interface{} `json:"url_prefix_for_links_to_checkmk"`
+	UrlPrefixForLinksToCheckmk CheckboxOneOf `json:"url_prefix_for_links_to_checkmk"`
 }
 
 // IncidentParams represents a CheckMK API type.
@@ -3087,15 +5421,15 @@ type IncidentParams struct {
 	// Caller is the user on behalf of whom the incident is being reported within ServiceNow.
 	// Example: Alice
 	Caller string `json:"caller"`
-	HostDescription map[string]interface{} `json:"host_description,omitempty"`
-	HostShortDescription map[string]interface{} `json:"host_short_description,omitempty"`
-	Impact map[string]interface{} `json:"impact,omitempty"`
-	ServiceDescription map[string]interface{} `json:"service_description,omitempty"`
-	ServiceShortDescription map[string]interface{} `json:"service_short_description,omitempty"`
-	StateAcknowledgement map[string]interface{} `json:"state_acknowledgement,omitempty"`
-	StateDowntime map[string]interface{} `json:"state_downtime,omitempty"`
-	StateRecovery map[string]interface{} `json:"state_recovery,omitempty"`
-	Urgency map[string]interface{} `json:"urgency,omitempty"`
+	HostDescription *CheckboxOneOf `json:"host_description,omitempty"`
+	HostShortDescription *CheckboxOneOf `json:"host_short_description,omitempty"`
+	Impact *CheckboxOneOf `json:"impact,omitempty"`
+	ServiceDescription *CheckboxOneOf `json:"service_description,omitempty"`
+	ServiceShortDescription *CheckboxOneOf `json:"service_short_description,omitempty"`
+	StateAcknowledgement *CheckboxOneOf `json:"state_acknowledgement,omitempty"`
+	StateDowntime *CheckboxOneOf `json:"state_downtime,omitempty"`
+	StateRecovery *CheckboxOneOf `json:"state_recovery,omitempty"`
+	Urgency *CheckboxOneOf `json:"urgency,omitempty"`
 }
 
 // InputContactGroup represents a CheckMK API type.
@@ -3128,7 +5462,7 @@ type InputHostTagGroup struct {
 	Ident string `json:"ident"`
 	// A list of host tags belonging to the host tag group
 	// Example: [map[ident:pod title:Pod]]
-	Tags []map[string]interface{} `json:"tags"`
+	Tags []HostTag `json:"tags"`
 	// A title for the host tag
 	// Example: Kubernetes
 	Title string `json:"title"`
@@ -3166,13 +5500,13 @@ type InputPassword struct {
 type InputRuleObject struct {
 	// Conditions.
 	// Example: map[]
-	Conditions interface{} `json:"conditions,omitempty"`
+	Conditions *RuleConditions1 `json:"conditions,omitempty"`
 	// The path name of the folder. Path delimiters can be either `~`, `/` or `\`. Please use the one most appropriate for your quoting/escaping needs. A good default choice is `~`.
 	// Example: ~hosts~linux
 	Folder string `json:"folder"`
 	// Configuration values for rules.
 	// Example: map[disabled:false]
-	Properties interface{} `json:"properties,omitempty"`
+	Properties *RuleProperties1 `json:"properties,omitempty"`
 	// Name of rule set.
 	// Example: host_label_rules
 	Ruleset string `json:"ruleset"`
@@ -3216,22 +5550,22 @@ type InstalledVersions struct {
 // JiraPluginCreate represents a CheckMK API type.
 type JiraPluginCreate struct {
 	// Ignore unverified HTTPS request warnings. Use with caution.
-	DisableSslCertVerification interface{} `json:"disable_ssl_cert_verification"`
+	DisableSslCertVerification Checkbox `json:"disable_ssl_cert_verification"`
 	// The numerical JIRA custom field ID for host problems
 	HostCustomId string `json:"host_custom_id"`
 	// Here you are allowed to use all macros that are defined in the notification context
-	HostSummary interface{} `json:"host_summary"`
+	HostSummary CheckboxOneOf `json:"host_summary"`
 	// The numerical JIRA issue type ID. If not set, it will be retrieved from a custom user attribute named jiraissuetype. If that is not set, the notification will fail
 	IssueTypeId string `json:"issue_type_id"`
 	// Configure the JIRA URL here
 	// Example: http://jira_url_example.com
 	JiraUrl string `json:"jira_url,omitempty"`
 	// Here you can set a custom label for new issues. If not set, 'monitoring' will be used
-	Label interface{} `json:"label"`
+	Label CheckboxOneOf `json:"label"`
 	// Configure the base URL for the Monitoring Web-GUI here. Include the site name. Used for link to check_mk out of jira
 	MonitoringUrl string `json:"monitoring_url"`
 	// Here you can configure timeout settings.
-	OptionalTimeout interface{} `json:"optional_timeout"`
+	OptionalTimeout CheckboxOneOf `json:"optional_timeout"`
 	// The password entered here is stored in plain text within the monitoring site. This usually needed because the monitoring process needs to have access to the unencrypted password because it needs to submit it to authenticate with remote systems
 	// Example: example_pass_123&*
 	Password string `json:"password"`
@@ -3239,17 +5573,17 @@ type JiraPluginCreate struct {
 	// Example: mail
 	PluginName JiraPluginCreatePluginName `json:"plugin_name"`
 	// The numerical JIRA priority ID. If not set, it will be retrieved from a custom user attribute named jirapriority. If that is not set, the standard priority will be used
-	PriorityId interface{} `json:"priority_id"`
+	PriorityId CheckboxOneOf `json:"priority_id"`
 	// The numerical JIRA project ID. If not set, it will be retrieved from a custom user attribute named jiraproject. If that is not set, the notification will fail
 	ProjectId string `json:"project_id"`
 	// The numerical JIRA resolution transition ID. 11 - 'To Do', 21 - 'In Progress', 31 - 'Done'
-	ResolutionId interface{} `json:"resolution_id"`
+	ResolutionId CheckboxOneOf `json:"resolution_id"`
 	// The numerical JIRA custom field ID for service problems
 	ServiceCustomId string `json:"service_custom_id"`
 	// Here you are allowed to use all macros that are defined in the notification context
-	ServiceSummary interface{} `json:"service_summary"`
+	ServiceSummary CheckboxOneOf `json:"service_summary"`
 	// The numerical ID of the JIRA custom field for sites. Please use this option if you have multiple sites in a distributed setup which send their notifications to the same JIRA instance
-	SiteCustomId interface{} `json:"site_custom_id"`
+	SiteCustomId CheckboxOneOf `json:"site_custom_id"`
 	// Configure the user name here
 	// Example: username_a
 	Username string `json:"username"`
@@ -3314,7 +5648,7 @@ type LockedBy struct {
 
 // LogicalExpr represents a CheckMK API type.
 type LogicalExpr struct {
-	Expr []interface{} `json:"expr,omitempty"`
+	Expr []Expr `json:"expr,omitempty"`
 	// The operator.
 	Op string `json:"op,omitempty"`
 }
@@ -3328,26 +5662,26 @@ type MSTeamsExplicitWebhookUrl struct {
 // MSTeamsPluginCreate represents a CheckMK API type.
 type MSTeamsPluginCreate struct {
 	// Enable/disable if we show affected host groups in the created message
-	AffectedHostGroups interface{} `json:"affected_host_groups"`
+	AffectedHostGroups Checkbox `json:"affected_host_groups"`
 	// Enable/disable the details for host notifications
-	HostDetails interface{} `json:"host_details"`
+	HostDetails CheckboxOneOf `json:"host_details"`
 	// Enable/disable the summary for host notifications
-	HostSummary interface{} `json:"host_summary"`
+	HostSummary CheckboxOneOf `json:"host_summary"`
 	// Enable/disable the title for host notifications
-	HostTitle interface{} `json:"host_title"`
+	HostTitle CheckboxOneOf `json:"host_title"`
 	// Use the proxy settings from the environment variables. The variables NO_PROXY, HTTP_PROXY and HTTPS_PROXY are taken into account during execution.
-	HttpProxy interface{} `json:"http_proxy"`
+	HttpProxy CheckboxOneOf `json:"http_proxy"`
 	// The plugin name.
 	// Example: mail
 	PluginName MSTeamsPluginCreatePluginName `json:"plugin_name"`
 	// Enable/disable the details for service notifications
-	ServiceDetails interface{} `json:"service_details"`
+	ServiceDetails CheckboxOneOf `json:"service_details"`
 	// Enable/disable the summary for service notifications
-	ServiceSummary interface{} `json:"service_summary"`
+	ServiceSummary CheckboxOneOf `json:"service_summary"`
 	// Enable/disable the title for service notifications
-	ServiceTitle interface{} `json:"service_title"`
-	UrlPrefixForLinksToCheckmk map[string]interface{} `json:"url_prefix_for_links_to_checkmk"`
-	WebhookUrl map[string]interface{} `json:"webhook_url"`
+	ServiceTitle CheckboxOneOf `json:"service_title"`
+	UrlPrefixForLinksToCheckmk CheckboxOneOf `json:"url_prefix_for_links_to_checkmk"`
+	WebhookUrl MSTeamsUrlOrStoreSelector `json:"webhook_url"`
 }
 
 // MSTeamsURLResponse represents a CheckMK API type.
@@ -3359,20 +5693,102 @@ type MSTeamsURLResponse struct {
 	Url string `json:"url,omitempty"`
 }
 
-// MSTeamsUrlOrStoreSelector represents a CheckMK API type.
+// MSTeamsUrlOrStoreSelector is a union of MSTeamsExplicitWebhookUrl, MSTeamsURLResponse, selected by the "option" property.
 type MSTeamsUrlOrStoreSelector struct {
+	union json.RawMessage
+}
+
+// Discriminator returns the value of the "option" property.
+func (u MSTeamsUrlOrStoreSelector) Discriminator() (string, error) {
+	return unionDiscriminator(u.union, "option")
+}
+
+// AsMSTeamsExplicitWebhookUrl returns the union data as a MSTeamsExplicitWebhookUrl.
+func (u MSTeamsUrlOrStoreSelector) AsMSTeamsExplicitWebhookUrl() (MSTeamsExplicitWebhookUrl, error) {
+	var v MSTeamsExplicitWebhookUrl
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromMSTeamsExplicitWebhookUrl sets the union to v, with "option" set to "explicit".
+func (u *MSTeamsUrlOrStoreSelector) FromMSTeamsExplicitWebhookUrl(v MSTeamsExplicitWebhookUrl) error {
+	data, err := unionFrom(v, "option", "explicit")
+	if err != nil {
+		return fmt.Errorf("MSTeamsUrlOrStoreSelector: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsMSTeamsURLResponse returns the union data as a MSTeamsURLResponse.
+func (u MSTeamsUrlOrStoreSelector) AsMSTeamsURLResponse() (MSTeamsURLResponse, error) {
+	var v MSTeamsURLResponse
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromMSTeamsURLResponse sets the union to v, with "option" set to "store".
+func (u *MSTeamsUrlOrStoreSelector) FromMSTeamsURLResponse(v MSTeamsURLResponse) error {
+	data, err := unionFrom(v, "option", "store")
+	if err != nil {
+		return fmt.Errorf("MSTeamsUrlOrStoreSelector: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// ValueByDiscriminator decodes the union into the member selected by "option".
+func (u MSTeamsUrlOrStoreSelector) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := u.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "explicit":
+		return u.AsMSTeamsExplicitWebhookUrl()
+	case "store":
+		return u.AsMSTeamsURLResponse()
+	}
+	return nil, fmt.Errorf("MSTeamsUrlOrStoreSelector: unknown option %q", discriminator)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u MSTeamsUrlOrStoreSelector) MarshalJSON() ([]byte, error) {
+	if len(u.union) == 0 {
+		return []byte("null"), nil
+	}
+	return u.union, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting unknown "option" values.
+func (u *MSTeamsUrlOrStoreSelector) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		u.union = nil
+		return nil
+	}
+	discriminator, err := unionDiscriminator(data, "option")
+	if err != nil {
+		return fmt.Errorf("MSTeamsUrlOrStoreSelector: %w", err)
+	}
+	switch discriminator {
+	case "explicit", "store":
+	default:
+		return fmt.Errorf("MSTeamsUrlOrStoreSelector: unknown option %q", discriminator)
+	}
+	u.union = append(json.RawMessage(nil), data...)
+	return nil
 }
 
 // MatchCustomMacros represents a CheckMK API type.
 type MatchCustomMacros struct {
 	State MatchCustomMacrosState `json:"state"`
-	Value []map[string]interface{} `json:"value,omitempty"`
+	Value []CustomMacro `json:"value,omitempty"`
 }
 
 // MatchEventConsoleAlertsResponse represents a CheckMK API type.
 type MatchEventConsoleAlertsResponse struct {
 	State MatchEventConsoleAlertsResponseState `json:"state"`
-	Value map[string]interface{} `json:"value,omitempty"`
+	Value *EventConsoleAlertsResponse `json:"value,omitempty"`
 }
 
 // MetaData represents a CheckMK API type.
@@ -3404,26 +5820,108 @@ type Metric struct {
 // MgmntTypeCaseParams represents a CheckMK API type.
 type MgmntTypeCaseParams struct {
 	Option MgmntTypeCaseParamsOption `json:"option"`
-	Params map[string]interface{} `json:"params,omitempty"`
+	Params *CaseParams `json:"params,omitempty"`
 }
 
 // MgmntTypeIncidentParams represents a CheckMK API type.
 type MgmntTypeIncidentParams struct {
 	Option MgmntTypeIncidentParamsOption `json:"option"`
-	Params map[string]interface{} `json:"params,omitempty"`
+	Params *IncidentParams `json:"params,omitempty"`
 }
 
-// MgmntTypeSelector represents a CheckMK API type.
+// MgmntTypeSelector is a union of MgmntTypeCaseParams, MgmntTypeIncidentParams, selected by the "option" property.
 type MgmntTypeSelector struct {
+	union json.RawMessage
+}
+
+// Discriminator returns the value of the "option" property.
+func (u MgmntTypeSelector) Discriminator() (string, error) {
+	return unionDiscriminator(u.union, "option")
+}
+
+// AsMgmntTypeCaseParams returns the union data as a MgmntTypeCaseParams.
+func (u MgmntTypeSelector) AsMgmntTypeCaseParams() (MgmntTypeCaseParams, error) {
+	var v MgmntTypeCaseParams
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromMgmntTypeCaseParams sets the union to v, with "option" set to "case".
+func (u *MgmntTypeSelector) FromMgmntTypeCaseParams(v MgmntTypeCaseParams) error {
+	data, err := unionFrom(v, "option", "case")
+	if err != nil {
+		return fmt.Errorf("MgmntTypeSelector: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsMgmntTypeIncidentParams returns the union data as a MgmntTypeIncidentParams.
+func (u MgmntTypeSelector) AsMgmntTypeIncidentParams() (MgmntTypeIncidentParams, error) {
+	var v MgmntTypeIncidentParams
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromMgmntTypeIncidentParams sets the union to v, with "option" set to "incident".
+func (u *MgmntTypeSelector) FromMgmntTypeIncidentParams(v MgmntTypeIncidentParams) error {
+	data, err := unionFrom(v, "option", "incident")
+	if err != nil {
+		return fmt.Errorf("MgmntTypeSelector: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// ValueByDiscriminator decodes the union into the member selected by "option".
+func (u MgmntTypeSelector) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := u.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "case":
+		return u.AsMgmntTypeCaseParams()
+	case "incident":
+		return u.AsMgmntTypeIncidentParams()
+	}
+	return nil, fmt.Errorf("MgmntTypeSelector: unknown option %q", discriminator)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u MgmntTypeSelector) MarshalJSON() ([]byte, error) {
+	if len(u.union) == 0 {
+		return []byte("null"), nil
+	}
+	return u.union, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting unknown "option" values.
+func (u *MgmntTypeSelector) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		u.union = nil
+		return nil
+	}
+	discriminator, err := unionDiscriminator(data, "option")
+	if err != nil {
+		return fmt.Errorf("MgmntTypeSelector: %w", err)
+	}
+	switch discriminator {
+	case "case", "incident":
+	default:
+		return fmt.Errorf("MgmntTypeSelector: unknown option %q", discriminator)
+	}
+	u.union = append(json.RawMessage(nil), data...)
+	return nil
 }
 
 // MkEventDPluginCreate represents a CheckMK API type.
 type MkEventDPluginCreate struct {
-	IpAddressOfRemoteEventConsole map[string]interface{} `json:"ip_address_of_remote_event_console"`
+	IpAddressOfRemoteEventConsole CheckboxOneOf `json:"ip_address_of_remote_event_console"`
 	// The plugin name.
 	// Example: mail
 	PluginName MkEventDPluginCreatePluginName `json:"plugin_name"`
-	SyslogFacilityToUse map[string]interface{} `json:"syslog_facility_to_use"`
+	SyslogFacilityToUse CheckboxOneOf `json:"syslog_facility_to_use"`
 }
 
 // MoveFolder represents a CheckMK API type.
@@ -3440,8 +5938,90 @@ type MoveHost struct {
 	TargetFolder string `json:"target_folder"`
 }
 
-// MoveRuleTo represents a CheckMK API type.
+// MoveRuleTo is a union of MoveToFolder, MoveToSpecificRule, selected by the "position" property.
 type MoveRuleTo struct {
+	union json.RawMessage
+}
+
+// Discriminator returns the value of the "position" property.
+func (u MoveRuleTo) Discriminator() (string, error) {
+	return unionDiscriminator(u.union, "position")
+}
+
+// AsMoveToFolder returns the union data as a MoveToFolder.
+func (u MoveRuleTo) AsMoveToFolder() (MoveToFolder, error) {
+	var v MoveToFolder
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromMoveToFolder sets the union to v, whose "position" must be one of "bottom_of_folder", "top_of_folder".
+func (u *MoveRuleTo) FromMoveToFolder(v MoveToFolder) error {
+	data, err := unionFrom(v, "position", "bottom_of_folder", "top_of_folder")
+	if err != nil {
+		return fmt.Errorf("MoveRuleTo: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsMoveToSpecificRule returns the union data as a MoveToSpecificRule.
+func (u MoveRuleTo) AsMoveToSpecificRule() (MoveToSpecificRule, error) {
+	var v MoveToSpecificRule
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromMoveToSpecificRule sets the union to v, whose "position" must be one of "after_specific_rule", "before_specific_rule".
+func (u *MoveRuleTo) FromMoveToSpecificRule(v MoveToSpecificRule) error {
+	data, err := unionFrom(v, "position", "after_specific_rule", "before_specific_rule")
+	if err != nil {
+		return fmt.Errorf("MoveRuleTo: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// ValueByDiscriminator decodes the union into the member selected by "position".
+func (u MoveRuleTo) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := u.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "bottom_of_folder", "top_of_folder":
+		return u.AsMoveToFolder()
+	case "after_specific_rule", "before_specific_rule":
+		return u.AsMoveToSpecificRule()
+	}
+	return nil, fmt.Errorf("MoveRuleTo: unknown position %q", discriminator)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u MoveRuleTo) MarshalJSON() ([]byte, error) {
+	if len(u.union) == 0 {
+		return []byte("null"), nil
+	}
+	return u.union, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting unknown "position" values.
+func (u *MoveRuleTo) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		u.union = nil
+		return nil
+	}
+	discriminator, err := unionDiscriminator(data, "position")
+	if err != nil {
+		return fmt.Errorf("MoveRuleTo: %w", err)
+	}
+	switch discriminator {
+	case "bottom_of_folder", "top_of_folder", "after_specific_rule", "before_specific_rule":
+	default:
+		return fmt.Errorf("MoveRuleTo: unknown position %q", discriminator)
+	}
+	u.union = append(json.RawMessage(nil), data...)
+	return nil
 }
 
 // MoveToFolder represents a CheckMK API type.
@@ -3467,9 +6047,9 @@ type MoveToSpecificRule struct {
 // NetworkScan represents a CheckMK API type.
 type NetworkScan struct {
 	// IPv4 addresses to include.
-	Addresses []map[string]interface{} `json:"addresses"`
+	Addresses []IPRangeWithRegexp `json:"addresses"`
 	// IPv4 addresses to exclude.
-	ExcludeAddresses []map[string]interface{} `json:"exclude_addresses,omitempty"`
+	ExcludeAddresses []IPRangeWithRegexp `json:"exclude_addresses,omitempty"`
 	// Set the maximum number of concurrent pings sent to target IP addresses.
 	MaxParallelPings int `json:"max_parallel_pings,omitempty"`
 	// Execute the network scan in the Checkmk user context of the chosen user. This user needs the permission to add new hosts to this folder.
@@ -3481,8 +6061,8 @@ type NetworkScan struct {
 	// Specify which criticality tag to set on the host created by the network scan. This field is required if the criticality tag group exists, otherwise it as to be omitted.
 	TagCriticality string `json:"tag_criticality,omitempty"`
 	// Only execute the discovery during this time range each day..
-	TimeAllowed []map[string]interface{} `json:"time_allowed"`
-	TranslateNames map[string]interface{} `json:"translate_names,omitempty"`
+	TimeAllowed []TimeAllowedRange `json:"time_allowed"`
+	TranslateNames *TranslateNames `json:"translate_names,omitempty"`
 }
 
 // NetworkScanResult represents a CheckMK API type.
@@ -3500,21 +6080,21 @@ type NetworkScanResult struct {
 // NotExpr represents a CheckMK API type.
 type NotExpr struct {
 	// The query expression to negate.
-	Expr interface{} `json:"expr,omitempty"`
+	Expr *Expr `json:"expr,omitempty"`
 	// The operator. In this case `not`.
 	Op string `json:"op,omitempty"`
 }
 
 // NotificationBulking represents a CheckMK API type.
 type NotificationBulking struct {
-	BulkOutsideTimeperiod map[string]interface{} `json:"bulk_outside_timeperiod"`
+	BulkOutsideTimeperiod BulkOutsideTimePeriodValue `json:"bulk_outside_timeperiod"`
 	// At most that many Notifications are kept back for bulking. A value of 1 essentially turns off notification bulking.
 	// Example: 1000
 	MaxBulkSize int `json:"max_bulk_size"`
 	NotificationBulksBasedOn []NotificationBulkingNotificationBulksBasedOn `json:"notification_bulks_based_on"`
 	NotificationBulksBasedOnCustomMacros []string `json:"notification_bulks_based_on_custom_macros,omitempty"`
 	State NotificationBulkingState `json:"state"`
-	SubjectForBulkNotifications map[string]interface{} `json:"subject_for_bulk_notifications,omitempty"`
+	SubjectForBulkNotifications *CheckboxWithStrValue `json:"subject_for_bulk_notifications,omitempty"`
 	// Notifications are kept back for bulking at most for this time (seconds)
 	// Example: 60
 	TimeHorizon int `json:"time_horizon"`
@@ -3524,7 +6104,7 @@ type NotificationBulking struct {
 // NotificationBulkingCheckbox represents a CheckMK API type.
 type NotificationBulkingCheckbox struct {
 	State NotificationBulkingCheckboxState `json:"state"`
-	Value map[string]interface{} `json:"value"`
+	Value WhenToBulk `json:"value"`
 }
 
 // NotificationBulkingCommonAttributes represents a CheckMK API type.
@@ -3535,7 +6115,7 @@ type NotificationBulkingCommonAttributes struct {
 	NotificationBulksBasedOn []NotificationBulkingCommonAttributesNotificationBulksBasedOn `json:"notification_bulks_based_on"`
 	NotificationBulksBasedOnCustomMacros []string `json:"notification_bulks_based_on_custom_macros,omitempty"`
 	State NotificationBulkingCommonAttributesState `json:"state"`
-	SubjectForBulkNotifications map[string]interface{} `json:"subject_for_bulk_notifications,omitempty"`
+	SubjectForBulkNotifications *CheckboxWithStrValue `json:"subject_for_bulk_notifications,omitempty"`
 	// Notifications are kept back for bulking at most for this time (seconds)
 	// Example: 60
 	TimeHorizon int `json:"time_horizon"`
@@ -3543,26 +6123,26 @@ type NotificationBulkingCommonAttributes struct {
 
 // NotificationPlugin represents a CheckMK API type.
 type NotificationPlugin struct {
-	NotificationBulking map[string]interface{} `json:"notification_bulking,omitempty"`
-	NotifyPlugin map[string]interface{} `json:"notify_plugin,omitempty"`
+	NotificationBulking *NotificationBulkingCheckbox `json:"notification_bulking,omitempty"`
+	NotifyPlugin *PluginBase1 `json:"notify_plugin,omitempty"`
 }
 
 // NotificationRuleAttributes represents a CheckMK API type.
 type NotificationRuleAttributes struct {
-	Conditions map[string]interface{} `json:"conditions,omitempty"`
-	ContactSelection map[string]interface{} `json:"contact_selection,omitempty"`
-	NotificationMethod map[string]interface{} `json:"notification_method,omitempty"`
-	RuleProperties map[string]interface{} `json:"rule_properties,omitempty"`
+	Conditions *ConditionsAttributes `json:"conditions,omitempty"`
+	ContactSelection *ContactSelectionAttributes `json:"contact_selection,omitempty"`
+	NotificationMethod *NotificationPlugin `json:"notification_method,omitempty"`
+	RuleProperties *RulePropertiesAttributes `json:"rule_properties,omitempty"`
 }
 
 // NotificationRuleConfig represents a CheckMK API type.
 type NotificationRuleConfig struct {
-	RuleConfig map[string]interface{} `json:"rule_config,omitempty"`
+	RuleConfig *NotificationRuleAttributes `json:"rule_config,omitempty"`
 }
 
 // NotificationRuleRequest represents a CheckMK API type.
 type NotificationRuleRequest struct {
-	RuleConfig interface{} `json:"rule_config"`
+	RuleConfig RuleNotification `json:"rule_config"`
 }
 
 // NotificationRuleResponse represents a CheckMK API type.
@@ -3571,11 +6151,11 @@ type NotificationRuleResponse struct {
 	DomainType interface{} `json:"domainType,omitempty"`
 	// The configuration attributes of a notification rule.
 	// Example: map[rule_config:map[conditions:map[event_console_alerts:map[state:disabled] match_check_types:map[state:disabled] match_contact_groups:map[state:disabled] match_exclude_hosts:map[state:disabled] match_exclude_service_groups:map[state:disabled] match_exclude_service_groups_regex:map[state:disabled...
-	Extensions interface{} `json:"extensions,omitempty"`
+	Extensions *NotificationRuleConfig `json:"extensions,omitempty"`
 	// The unique identifier for this domain-object type.
 	Id string `json:"id,omitempty"`
 	// list of links to other resources.
-	Links []map[string]interface{} `json:"links"`
+	Links []Link `json:"links"`
 	// The container for external resources, like linked foreign objects or actions.
 	Members map[string]interface{} `json:"members,omitempty"`
 	// A human readable title of this object. Can be used for user interfaces.
@@ -3591,12 +6171,12 @@ type NotificationRuleResponseCollection struct {
 	// The name of this collection.
 	Id string `json:"id,omitempty"`
 	// list of links to other resources.
-	Links []map[string]interface{} `json:"links"`
+	Links []Link `json:"links"`
 	// A human readable title of this object. Can be used for user interfaces.
 	Title string `json:"title,omitempty"`
 	// A list of notification rule objects.
 	// Example: [map[domainType:rule_notifications extensions:map[rule_config:map[conditions:map[event_console_alerts:map[state:disabled] match_check_types:map[state:disabled] match_contact_groups:map[state:disabled] match_exclude_hosts:map[state:disabled] match_exclude_service_groups:map[state:disabled] match_e...
-	Value []map[string]interface{} `json:"value,omitempty"`
+	Value []NotificationRuleResponse `json:"value,omitempty"`
 }
 
 // ObjectActionMember represents a CheckMK API type.
@@ -3608,7 +6188,7 @@ type ObjectActionMember struct {
 	// Example: invalid
 	InvalidReason string `json:"invalidReason,omitempty"`
 	// list of links to other resources.
-	Links []map[string]interface{} `json:"links"`
+	Links []Link `json:"links"`
 	MemberType interface{} `json:"memberType,omitempty"`
 	Name string `json:"name,omitempty"`
 	Parameters map[string]interface{} `json:"parameters,omitempty"`
@@ -3627,12 +6207,12 @@ type ObjectCollectionMember struct {
 	// Example: invalid
 	InvalidReason string `json:"invalidReason,omitempty"`
 	// list of links to other resources.
-	Links []map[string]interface{} `json:"links"`
+	Links []Link `json:"links"`
 	MemberType interface{} `json:"memberType,omitempty"`
 	Name string `json:"name,omitempty"`
 	// A human readable title of this object. Can be used for user interfaces.
 	Title string `json:"title,omitempty"`
-	Value []map[string]interface{} `json:"value,omitempty"`
+	Value []Link `json:"value,omitempty"`
 	// Provides the reason why a SET OF proposed values for properties or arguments is invalid.
 	XRoInvalidReason string `json:"x-ro-invalidReason,omitempty"`
 }
@@ -3644,7 +6224,7 @@ type ObjectProperty struct {
 	// The unique name of this property, local to this domain type.
 	Id string `json:"id,omitempty"`
 	// list of links to other resources.
-	Links []map[string]interface{} `json:"links"`
+	Links []Link `json:"links"`
 	// The value of the property. In this case a list.
 	Value []string `json:"value,omitempty"`
 }
@@ -3658,36 +6238,36 @@ type OpsGenieExplicitKey struct {
 // OpsGeniePluginCreate represents a CheckMK API type.
 type OpsGeniePluginCreate struct {
 	// Custom actions that will be available for the alert. You may paste a text from your clipboard which contains several parts separated by ';' characters into the last input field. The text will then be split by these separators and the single parts are added into dedicated input fields
-	Actions interface{} `json:"actions"`
-	ApiKey map[string]interface{} `json:"api_key"`
+	Actions CheckboxOneOf `json:"actions"`
+	ApiKey OpsGenisStoreOrExplicitKeySelector `json:"api_key"`
 	// Description field of host alert that is generally used to provide a detailed information about the alert
-	DescForHostAlerts interface{} `json:"desc_for_host_alerts"`
+	DescForHostAlerts CheckboxOneOf `json:"desc_for_host_alerts"`
 	// Description field of service alert that is generally used to provide a detailed information about the alert
-	DescForServiceAlerts interface{} `json:"desc_for_service_alerts"`
+	DescForServiceAlerts CheckboxOneOf `json:"desc_for_service_alerts"`
 	// If you have an european account, please set the domain of your opsgenie. Specify an absolute URL like https://api.eu.opsgenie.com
-	Domain interface{} `json:"domain"`
+	Domain CheckboxOneOf `json:"domain"`
 	// Is used to specify which domain the alert is related to
-	Entity interface{} `json:"entity"`
+	Entity CheckboxOneOf `json:"entity"`
 	// Use the proxy settings from the environment variables. The variables NO_PROXY, HTTP_PROXY and HTTPS_PROXY are taken into account during execution.
-	HttpProxy interface{} `json:"http_proxy"`
-	MessageForHostAlerts interface{} `json:"message_for_host_alerts"`
-	MessageForServiceAlerts interface{} `json:"message_for_service_alerts"`
+	HttpProxy CheckboxOneOf `json:"http_proxy"`
+	MessageForHostAlerts CheckboxOneOf `json:"message_for_host_alerts"`
+	MessageForServiceAlerts CheckboxOneOf `json:"message_for_service_alerts"`
 	// Additional note that will be added while closing the alert
-	NoteWhileClosing interface{} `json:"note_while_closing"`
+	NoteWhileClosing CheckboxOneOf `json:"note_while_closing"`
 	// Additional note that will be added while creating the alert
-	NoteWhileCreating interface{} `json:"note_while_creating"`
+	NoteWhileCreating CheckboxOneOf `json:"note_while_creating"`
 	// Sets the user of the alert. Display name of the request owner
-	Owner interface{} `json:"owner"`
+	Owner CheckboxOneOf `json:"owner"`
 	// The plugin name.
 	// Example: mail
 	PluginName OpsGeniePluginCreatePluginName `json:"plugin_name"`
-	Priority map[string]interface{} `json:"priority"`
+	Priority CheckboxOneOf `json:"priority"`
 	// Team names which will be responsible for the alert. If the API Key belongs to a team integration, this field will be overwritten with the owner team. You may paste a text from your clipboard which contains several parts separated by ';' characters into the last input field. The text will then be ...
-	ResponsibleTeams interface{} `json:"responsible_teams"`
+	ResponsibleTeams CheckboxOneOf `json:"responsible_teams"`
 	// Source field of the alert. Default value is IP address of the incoming request
-	Source interface{} `json:"source"`
+	Source CheckboxOneOf `json:"source"`
 	// Tags of the alert. You may paste a text from your clipboard which contains several parts separated by ';' characters into the last input field. The text will then be split by these separators and the single parts are added into dedicated input fields
-	Tags interface{} `json:"tags"`
+	Tags CheckboxOneOf `json:"tags"`
 }
 
 // OpsGenieStoreID represents a CheckMK API type.
@@ -3698,8 +6278,90 @@ type OpsGenieStoreID struct {
 	StoreId string `json:"store_id"`
 }
 
-// OpsGenisStoreOrExplicitKeySelector represents a CheckMK API type.
+// OpsGenisStoreOrExplicitKeySelector is a union of OpsGenieExplicitKey, OpsGenieStoreID, selected by the "option" property.
 type OpsGenisStoreOrExplicitKeySelector struct {
+	union json.RawMessage
+}
+
+// Discriminator returns the value of the "option" property.
+func (u OpsGenisStoreOrExplicitKeySelector) Discriminator() (string, error) {
+	return unionDiscriminator(u.union, "option")
+}
+
+// AsOpsGenieExplicitKey returns the union data as a OpsGenieExplicitKey.
+func (u OpsGenisStoreOrExplicitKeySelector) AsOpsGenieExplicitKey() (OpsGenieExplicitKey, error) {
+	var v OpsGenieExplicitKey
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromOpsGenieExplicitKey sets the union to v, with "option" set to "explicit".
+func (u *OpsGenisStoreOrExplicitKeySelector) FromOpsGenieExplicitKey(v OpsGenieExplicitKey) error {
+	data, err := unionFrom(v, "option", "explicit")
+	if err != nil {
+		return fmt.Errorf("OpsGenisStoreOrExplicitKeySelector: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsOpsGenieStoreID returns the union data as a OpsGenieStoreID.
+func (u OpsGenisStoreOrExplicitKeySelector) AsOpsGenieStoreID() (OpsGenieStoreID, error) {
+	var v OpsGenieStoreID
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromOpsGenieStoreID sets the union to v, with "option" set to "store".
+func (u *OpsGenisStoreOrExplicitKeySelector) FromOpsGenieStoreID(v OpsGenieStoreID) error {
+	data, err := unionFrom(v, "option", "store")
+	if err != nil {
+		return fmt.Errorf("OpsGenisStoreOrExplicitKeySelector: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// ValueByDiscriminator decodes the union into the member selected by "option".
+func (u OpsGenisStoreOrExplicitKeySelector) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := u.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "explicit":
+		return u.AsOpsGenieExplicitKey()
+	case "store":
+		return u.AsOpsGenieStoreID()
+	}
+	return nil, fmt.Errorf("OpsGenisStoreOrExplicitKeySelector: unknown option %q", discriminator)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u OpsGenisStoreOrExplicitKeySelector) MarshalJSON() ([]byte, error) {
+	if len(u.union) == 0 {
+		return []byte("null"), nil
+	}
+	return u.union, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting unknown "option" values.
+func (u *OpsGenisStoreOrExplicitKeySelector) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		u.union = nil
+		return nil
+	}
+	discriminator, err := unionDiscriminator(data, "option")
+	if err != nil {
+		return fmt.Errorf("OpsGenisStoreOrExplicitKeySelector: %w", err)
+	}
+	switch discriminator {
+	case "explicit", "store":
+	default:
+		return fmt.Errorf("OpsGenisStoreOrExplicitKeySelector: unknown option %q", discriminator)
+	}
+	u.union = append(json.RawMessage(nil), data...)
+	return nil
 }
 
 // PagerDutyAPIKeyStoreID represents a CheckMK API type.
@@ -3719,18 +6381,100 @@ type PagerDutyExplicitKey struct {
 // PagerDutyPluginCreate represents a CheckMK API type.
 type PagerDutyPluginCreate struct {
 	// Ignore unverified HTTPS request warnings. Use with caution.
-	DisableSslCertVerification interface{} `json:"disable_ssl_cert_verification"`
+	DisableSslCertVerification Checkbox `json:"disable_ssl_cert_verification"`
 	// Use the proxy settings from the environment variables. The variables NO_PROXY, HTTP_PROXY and HTTPS_PROXY are taken into account during execution.
-	HttpProxy interface{} `json:"http_proxy"`
-	IntegrationKey map[string]interface{} `json:"integration_key"`
+	HttpProxy CheckboxOneOf `json:"http_proxy"`
+	IntegrationKey PagerDutyStoreOrIntegrationKeySelector `json:"integration_key"`
 	// The plugin name.
 	// Example: mail
 	PluginName PagerDutyPluginCreatePluginName `json:"plugin_name"`
-	UrlPrefixForLinksToCheckmk map[string]interface{} `json:"url_prefix_for_links_to_checkmk"`
+	UrlPrefixForLinksToCheckmk CheckboxOneOf `json:"url_prefix_for_links_to_checkmk"`
 }
 
-// PagerDutyStoreOrIntegrationKeySelector represents a CheckMK API type.
+// PagerDutyStoreOrIntegrationKeySelector is a union of PagerDutyExplicitKey, PagerDutyAPIKeyStoreID, selected by the "option" property.
 type PagerDutyStoreOrIntegrationKeySelector struct {
+	union json.RawMessage
+}
+
+// Discriminator returns the value of the "option" property.
+func (u PagerDutyStoreOrIntegrationKeySelector) Discriminator() (string, error) {
+	return unionDiscriminator(u.union, "option")
+}
+
+// AsPagerDutyExplicitKey returns the union data as a PagerDutyExplicitKey.
+func (u PagerDutyStoreOrIntegrationKeySelector) AsPagerDutyExplicitKey() (PagerDutyExplicitKey, error) {
+	var v PagerDutyExplicitKey
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromPagerDutyExplicitKey sets the union to v, with "option" set to "explicit".
+func (u *PagerDutyStoreOrIntegrationKeySelector) FromPagerDutyExplicitKey(v PagerDutyExplicitKey) error {
+	data, err := unionFrom(v, "option", "explicit")
+	if err != nil {
+		return fmt.Errorf("PagerDutyStoreOrIntegrationKeySelector: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsPagerDutyAPIKeyStoreID returns the union data as a PagerDutyAPIKeyStoreID.
+func (u PagerDutyStoreOrIntegrationKeySelector) AsPagerDutyAPIKeyStoreID() (PagerDutyAPIKeyStoreID, error) {
+	var v PagerDutyAPIKeyStoreID
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromPagerDutyAPIKeyStoreID sets the union to v, with "option" set to "store".
+func (u *PagerDutyStoreOrIntegrationKeySelector) FromPagerDutyAPIKeyStoreID(v PagerDutyAPIKeyStoreID) error {
+	data, err := unionFrom(v, "option", "store")
+	if err != nil {
+		return fmt.Errorf("PagerDutyStoreOrIntegrationKeySelector: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// ValueByDiscriminator decodes the union into the member selected by "option".
+func (u PagerDutyStoreOrIntegrationKeySelector) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := u.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "explicit":
+		return u.AsPagerDutyExplicitKey()
+	case "store":
+		return u.AsPagerDutyAPIKeyStoreID()
+	}
+	return nil, fmt.Errorf("PagerDutyStoreOrIntegrationKeySelector: unknown option %q", discriminator)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u PagerDutyStoreOrIntegrationKeySelector) MarshalJSON() ([]byte, error) {
+	if len(u.union) == 0 {
+		return []byte("null"), nil
+	}
+	return u.union, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting unknown "option" values.
+func (u *PagerDutyStoreOrIntegrationKeySelector) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		u.union = nil
+		return nil
+	}
+	discriminator, err := unionDiscriminator(data, "option")
+	if err != nil {
+		return fmt.Errorf("PagerDutyStoreOrIntegrationKeySelector: %w", err)
+	}
+	switch discriminator {
+	case "explicit", "store":
+	default:
+		return fmt.Errorf("PagerDutyStoreOrIntegrationKeySelector: unknown option %q", discriminator)
+	}
+	u.union = append(json.RawMessage(nil), data...)
+	return nil
 }
 
 // Parent represents a CheckMK API type.
@@ -3747,11 +6491,11 @@ type PasswordCollection struct {
 	// The name of this collection.
 	Id string `json:"id,omitempty"`
 	// list of links to other resources.
-	Links []map[string]interface{} `json:"links"`
+	Links []Link `json:"links"`
 	// A human readable title of this object. Can be used for user interfaces.
 	Title string `json:"title,omitempty"`
 	// A list of password objects.
-	Value []map[string]interface{} `json:"value,omitempty"`
+	Value []PasswordObject `json:"value,omitempty"`
 }
 
 // PasswordExtension represents a CheckMK API type.
@@ -3781,11 +6525,11 @@ type PasswordObject struct {
 	// The type of the domain-object.
 	DomainType interface{} `json:"domainType,omitempty"`
 	// All the attributes of the domain object.
-	Extensions interface{} `json:"extensions,omitempty"`
+	Extensions *PasswordExtension `json:"extensions,omitempty"`
 	// The unique identifier for this domain-object type.
 	Id string `json:"id,omitempty"`
 	// list of links to other resources.
-	Links []map[string]interface{} `json:"links"`
+	Links []Link `json:"links"`
 	// The container for external resources, like linked foreign objects or actions.
 	Members map[string]interface{} `json:"members,omitempty"`
 	// A human readable title of this object. Can be used for user interfaces.
@@ -3799,10 +6543,10 @@ type PendingChangesCollection struct {
 	// Additional attributes alongside the collection.
 	Extensions map[string]interface{} `json:"extensions,omitempty"`
 	// list of links to other resources.
-	Links []map[string]interface{} `json:"links"`
+	Links []Link `json:"links"`
 	// The changes that are pending
 	// Example: [map[action_name:create-host id:da5430a5-6d0a-48ae-9efd-0563482a3b36 text:Created new host foobar. time:2023-01-20T16:31:51.362057+00:00 user_id:cmkadmin] map[action_name:edit-host id:4ba28393-567e-4a9a-9368-e600d28c2a7e text:Modified host foobar. time:2023-01-20T16:32:12.362057+00:00 user_id:cmk...
-	Value []map[string]interface{} `json:"value,omitempty"`
+	Value []ChangesFields `json:"value,omitempty"`
 }
 
 // PluginBase represents a CheckMK API type.
@@ -3810,7 +6554,7 @@ type PluginBase struct {
 	// Create notifications with parameters or cancel previous notifications
 	// Example: cancel_previous_notifications
 	Option PluginBaseOption `json:"option,omitempty"`
-	PluginParams map[string]interface{} `json:"plugin_params"`
+	PluginParams PluginName `json:"plugin_params"`
 }
 
 // PluginBase1 represents a CheckMK API type.
@@ -3825,12 +6569,480 @@ type PluginName struct {
 	PluginName PluginNamePluginName `json:"plugin_name"`
 }
 
-// PluginOptionsSelector represents a CheckMK API type.
+// PluginOptionsSelector is a union of PluginBase, PluginWithParams, CustomPluginWithParams, selected by the "option" property.
 type PluginOptionsSelector struct {
+	union json.RawMessage
+}
+
+// Discriminator returns the value of the "option" property.
+func (u PluginOptionsSelector) Discriminator() (string, error) {
+	return unionDiscriminator(u.union, "option")
+}
+
+// AsPluginBase returns the union data as a PluginBase.
+func (u PluginOptionsSelector) AsPluginBase() (PluginBase, error) {
+	var v PluginBase
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromPluginBase sets the union to v, with "option" set to "cancel_previous_notifications".
+func (u *PluginOptionsSelector) FromPluginBase(v PluginBase) error {
+	data, err := unionFrom(v, "option", "cancel_previous_notifications")
+	if err != nil {
+		return fmt.Errorf("PluginOptionsSelector: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsPluginWithParams returns the union data as a PluginWithParams.
+func (u PluginOptionsSelector) AsPluginWithParams() (PluginWithParams, error) {
+	var v PluginWithParams
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromPluginWithParams sets the union to v, with "option" set to "create_notification_with_the_following_parameters".
+func (u *PluginOptionsSelector) FromPluginWithParams(v PluginWithParams) error {
+	data, err := unionFrom(v, "option", "create_notification_with_the_following_parameters")
+	if err != nil {
+		return fmt.Errorf("PluginOptionsSelector: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsCustomPluginWithParams returns the union data as a CustomPluginWithParams.
+func (u PluginOptionsSelector) AsCustomPluginWithParams() (CustomPluginWithParams, error) {
+	var v CustomPluginWithParams
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromCustomPluginWithParams sets the union to v, with "option" set to "create_notification_with_custom_parameters".
+func (u *PluginOptionsSelector) FromCustomPluginWithParams(v CustomPluginWithParams) error {
+	data, err := unionFrom(v, "option", "create_notification_with_custom_parameters")
+	if err != nil {
+		return fmt.Errorf("PluginOptionsSelector: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// ValueByDiscriminator decodes the union into the member selected by "option".
+func (u PluginOptionsSelector) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := u.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "cancel_previous_notifications":
+		return u.AsPluginBase()
+	case "create_notification_with_the_following_parameters":
+		return u.AsPluginWithParams()
+	case "create_notification_with_custom_parameters":
+		return u.AsCustomPluginWithParams()
+	}
+	return nil, fmt.Errorf("PluginOptionsSelector: unknown option %q", discriminator)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u PluginOptionsSelector) MarshalJSON() ([]byte, error) {
+	if len(u.union) == 0 {
+		return []byte("null"), nil
+	}
+	return u.union, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting unknown "option" values.
+func (u *PluginOptionsSelector) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		u.union = nil
+		return nil
+	}
+	discriminator, err := unionDiscriminator(data, "option")
+	if err != nil {
+		return fmt.Errorf("PluginOptionsSelector: %w", err)
+	}
+	switch discriminator {
+	case "cancel_previous_notifications", "create_notification_with_the_following_parameters", "create_notification_with_custom_parameters":
+	default:
+		return fmt.Errorf("PluginOptionsSelector: unknown option %q", discriminator)
+	}
+	u.union = append(json.RawMessage(nil), data...)
+	return nil
+}
+
+// PluginSelector is a union of AsciiMailPluginCreate, HTMLMailPluginCreate, CiscoWebexPluginCreate, MkEventDPluginCreate, IlertPluginCreate, JiraPluginCreate, OpsGeniePluginCreate, PagerDutyPluginCreate, PushOverPluginCreate, ServiceNowPluginCreate, Signl4PluginCreate, SlackPluginCreate, SMSAPIPluginCreate, SMSPluginBase, SpectrumPluginBase, VictoropsPluginCreate, MSTeamsPluginCreate, selected by the "plugin_name" property.
+type PluginSelector struct {
+	union json.RawMessage
 }
 
-// PluginSelector represents a CheckMK API type.
-type PluginSelector struct {
+// Discriminator returns the value of the "plugin_name" property.
+func (u PluginSelector) Discriminator() (string, error) {
+	return unionDiscriminator(u.union, "plugin_name")
+}
+
+// AsAsciiMailPluginCreate returns the union data as a AsciiMailPluginCreate.
+func (u PluginSelector) AsAsciiMailPluginCreate() (AsciiMailPluginCreate, error) {
+	var v AsciiMailPluginCreate
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromAsciiMailPluginCreate sets the union to v, with "plugin_name" set to "asciimail".
+func (u *PluginSelector) FromAsciiMailPluginCreate(v AsciiMailPluginCreate) error {
+	data, err := unionFrom(v, "plugin_name", "asciimail")
+	if err != nil {
+		return fmt.Errorf("PluginSelector: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsHTMLMailPluginCreate returns the union data as a HTMLMailPluginCreate.
+func (u PluginSelector) AsHTMLMailPluginCreate() (HTMLMailPluginCreate, error) {
+	var v HTMLMailPluginCreate
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromHTMLMailPluginCreate sets the union to v, with "plugin_name" set to "mail".
+func (u *PluginSelector) FromHTMLMailPluginCreate(v HTMLMailPluginCreate) error {
+	data, err := unionFrom(v, "plugin_name", "mail")
+	if err != nil {
+		return fmt.Errorf("PluginSelector: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsCiscoWebexPluginCreate returns the union data as a CiscoWebexPluginCreate.
+func (u PluginSelector) AsCiscoWebexPluginCreate() (CiscoWebexPluginCreate, error) {
+	var v CiscoWebexPluginCreate
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromCiscoWebexPluginCreate sets the union to v, with "plugin_name" set to "cisco_webex_teams".
+func (u *PluginSelector) FromCiscoWebexPluginCreate(v CiscoWebexPluginCreate) error {
+	data, err := unionFrom(v, "plugin_name", "cisco_webex_teams")
+	if err != nil {
+		return fmt.Errorf("PluginSelector: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsMkEventDPluginCreate returns the union data as a MkEventDPluginCreate.
+func (u PluginSelector) AsMkEventDPluginCreate() (MkEventDPluginCreate, error) {
+	var v MkEventDPluginCreate
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromMkEventDPluginCreate sets the union to v, with "plugin_name" set to "mkeventd".
+func (u *PluginSelector) FromMkEventDPluginCreate(v MkEventDPluginCreate) error {
+	data, err := unionFrom(v, "plugin_name", "mkeventd")
+	if err != nil {
+		return fmt.Errorf("PluginSelector: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsIlertPluginCreate returns the union data as a IlertPluginCreate.
+func (u PluginSelector) AsIlertPluginCreate() (IlertPluginCreate, error) {
+	var v IlertPluginCreate
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromIlertPluginCreate sets the union to v, with "plugin_name" set to "ilert".
+func (u *PluginSelector) FromIlertPluginCreate(v IlertPluginCreate) error {
+	data, err := unionFrom(v, "plugin_name", "ilert")
+	if err != nil {
+		return fmt.Errorf("PluginSelector: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsJiraPluginCreate returns the union data as a JiraPluginCreate.
+func (u PluginSelector) AsJiraPluginCreate() (JiraPluginCreate, error) {
+	var v JiraPluginCreate
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromJiraPluginCreate sets the union to v, with "plugin_name" set to "jira_issues".
+func (u *PluginSelector) FromJiraPluginCreate(v JiraPluginCreate) error {
+	data, err := unionFrom(v, "plugin_name", "jira_issues")
+	if err != nil {
+		return fmt.Errorf("PluginSelector: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsOpsGeniePluginCreate returns the union data as a OpsGeniePluginCreate.
+func (u PluginSelector) AsOpsGeniePluginCreate() (OpsGeniePluginCreate, error) {
+	var v OpsGeniePluginCreate
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromOpsGeniePluginCreate sets the union to v, with "plugin_name" set to "opsgenie_issues".
+func (u *PluginSelector) FromOpsGeniePluginCreate(v OpsGeniePluginCreate) error {
+	data, err := unionFrom(v, "plugin_name", "opsgenie_issues")
+	if err != nil {
+		return fmt.Errorf("PluginSelector: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsPagerDutyPluginCreate returns the union data as a PagerDutyPluginCreate.
+func (u PluginSelector) AsPagerDutyPluginCreate() (PagerDutyPluginCreate, error) {
+	var v PagerDutyPluginCreate
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromPagerDutyPluginCreate sets the union to v, with "plugin_name" set to "pagerduty".
+func (u *PluginSelector) FromPagerDutyPluginCreate(v PagerDutyPluginCreate) error {
+	data, err := unionFrom(v, "plugin_name", "pagerduty")
+	if err != nil {
+		return fmt.Errorf("PluginSelector: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsPushOverPluginCreate returns the union data as a PushOverPluginCreate.
+func (u PluginSelector) AsPushOverPluginCreate() (PushOverPluginCreate, error) {
+	var v PushOverPluginCreate
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromPushOverPluginCreate sets the union to v, with "plugin_name" set to "pushover".
+func (u *PluginSelector) FromPushOverPluginCreate(v PushOverPluginCreate) error {
+	data, err := unionFrom(v, "plugin_name", "pushover")
+	if err != nil {
+		return fmt.Errorf("PluginSelector: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsServiceNowPluginCreate returns the union data as a ServiceNowPluginCreate.
+func (u PluginSelector) AsServiceNowPluginCreate() (ServiceNowPluginCreate, error) {
+	var v ServiceNowPluginCreate
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromServiceNowPluginCreate sets the union to v, with "plugin_name" set to "servicenow".
+func (u *PluginSelector) FromServiceNowPluginCreate(v ServiceNowPluginCreate) error {
+	data, err := unionFrom(v, "plugin_name", "servicenow")
+	if err != nil {
+		return fmt.Errorf("PluginSelector: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsSignl4PluginCreate returns the union data as a Signl4PluginCreate.
+func (u PluginSelector) AsSignl4PluginCreate() (Signl4PluginCreate, error) {
+	var v Signl4PluginCreate
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromSignl4PluginCreate sets the union to v, with "plugin_name" set to "signl4".
+func (u *PluginSelector) FromSignl4PluginCreate(v Signl4PluginCreate) error {
+	data, err := unionFrom(v, "plugin_name", "signl4")
+	if err != nil {
+		return fmt.Errorf("PluginSelector: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsSlackPluginCreate returns the union data as a SlackPluginCreate.
+func (u PluginSelector) AsSlackPluginCreate() (SlackPluginCreate, error) {
+	var v SlackPluginCreate
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromSlackPluginCreate sets the union to v, with "plugin_name" set to "slack".
+func (u *PluginSelector) FromSlackPluginCreate(v SlackPluginCreate) error {
+	data, err := unionFrom(v, "plugin_name", "slack")
+	if err != nil {
+		return fmt.Errorf("PluginSelector: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsSMSAPIPluginCreate returns the union data as a SMSAPIPluginCreate.
+func (u PluginSelector) AsSMSAPIPluginCreate() (SMSAPIPluginCreate, error) {
+	var v SMSAPIPluginCreate
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromSMSAPIPluginCreate sets the union to v, with "plugin_name" set to "sms_api".
+func (u *PluginSelector) FromSMSAPIPluginCreate(v SMSAPIPluginCreate) error {
+	data, err := unionFrom(v, "plugin_name", "sms_api")
+	if err != nil {
+		return fmt.Errorf("PluginSelector: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsSMSPluginBase returns the union data as a SMSPluginBase.
+func (u PluginSelector) AsSMSPluginBase() (SMSPluginBase, error) {
+	var v SMSPluginBase
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromSMSPluginBase sets the union to v, with "plugin_name" set to "sms".
+func (u *PluginSelector) FromSMSPluginBase(v SMSPluginBase) error {
+	data, err := unionFrom(v, "plugin_name", "sms")
+	if err != nil {
+		return fmt.Errorf("PluginSelector: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsSpectrumPluginBase returns the union data as a SpectrumPluginBase.
+func (u PluginSelector) AsSpectrumPluginBase() (SpectrumPluginBase, error) {
+	var v SpectrumPluginBase
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromSpectrumPluginBase sets the union to v, with "plugin_name" set to "spectrum".
+func (u *PluginSelector) FromSpectrumPluginBase(v SpectrumPluginBase) error {
+	data, err := unionFrom(v, "plugin_name", "spectrum")
+	if err != nil {
+		return fmt.Errorf("PluginSelector: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsVictoropsPluginCreate returns the union data as a VictoropsPluginCreate.
+func (u PluginSelector) AsVictoropsPluginCreate() (VictoropsPluginCreate, error) {
+	var v VictoropsPluginCreate
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromVictoropsPluginCreate sets the union to v, with "plugin_name" set to "victorops".
+func (u *PluginSelector) FromVictoropsPluginCreate(v VictoropsPluginCreate) error {
+	data, err := unionFrom(v, "plugin_name", "victorops")
+	if err != nil {
+		return fmt.Errorf("PluginSelector: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsMSTeamsPluginCreate returns the union data as a MSTeamsPluginCreate.
+func (u PluginSelector) AsMSTeamsPluginCreate() (MSTeamsPluginCreate, error) {
+	var v MSTeamsPluginCreate
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromMSTeamsPluginCreate sets the union to v, with "plugin_name" set to "msteams".
+func (u *PluginSelector) FromMSTeamsPluginCreate(v MSTeamsPluginCreate) error {
+	data, err := unionFrom(v, "plugin_name", "msteams")
+	if err != nil {
+		return fmt.Errorf("PluginSelector: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// ValueByDiscriminator decodes the union into the member selected by "plugin_name".
+func (u PluginSelector) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := u.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "asciimail":
+		return u.AsAsciiMailPluginCreate()
+	case "mail":
+		return u.AsHTMLMailPluginCreate()
+	case "cisco_webex_teams":
+		return u.AsCiscoWebexPluginCreate()
+	case "mkeventd":
+		return u.AsMkEventDPluginCreate()
+	case "ilert":
+		return u.AsIlertPluginCreate()
+	case "jira_issues":
+		return u.AsJiraPluginCreate()
+	case "opsgenie_issues":
+		return u.AsOpsGeniePluginCreate()
+	case "pagerduty":
+		return u.AsPagerDutyPluginCreate()
+	case "pushover":
+		return u.AsPushOverPluginCreate()
+	case "servicenow":
+		return u.AsServiceNowPluginCreate()
+	case "signl4":
+		return u.AsSignl4PluginCreate()
+	case "slack":
+		return u.AsSlackPluginCreate()
+	case "sms_api":
+		return u.AsSMSAPIPluginCreate()
+	case "sms":
+		return u.AsSMSPluginBase()
+	case "spectrum":
+		return u.AsSpectrumPluginBase()
+	case "victorops":
+		return u.AsVictoropsPluginCreate()
+	case "msteams":
+		return u.AsMSTeamsPluginCreate()
+	}
+	return nil, fmt.Errorf("PluginSelector: unknown plugin_name %q", discriminator)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u PluginSelector) MarshalJSON() ([]byte, error) {
+	if len(u.union) == 0 {
+		return []byte("null"), nil
+	}
+	return u.union, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting unknown "plugin_name" values.
+func (u *PluginSelector) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		u.union = nil
+		return nil
+	}
+	discriminator, err := unionDiscriminator(data, "plugin_name")
+	if err != nil {
+		return fmt.Errorf("PluginSelector: %w", err)
+	}
+	switch discriminator {
+	case "asciimail", "mail", "cisco_webex_teams", "mkeventd", "ilert", "jira_issues", "opsgenie_issues", "pagerduty", "pushover", "servicenow", "signl4", "slack", "sms_api", "sms", "spectrum", "victorops", "msteams":
+	default:
+		return fmt.Errorf("PluginSelector: unknown plugin_name %q", discriminator)
+	}
+	u.union = append(json.RawMessage(nil), data...)
+	return nil
 }
 
 // PluginWithParams represents a CheckMK API type.
@@ -3838,7 +7050,7 @@ type PluginWithParams struct {
 	// Create notifications with parameters or cancel previous notifications
 	// Example: cancel_previous_notifications
 	Option PluginWithParamsOption `json:"option,omitempty"`
-	PluginParams map[string]interface{} `json:"plugin_params"`
+	PluginParams PluginSelector `json:"plugin_params"`
 }
 
 // ProxyAttributes represents a CheckMK API type.
@@ -3847,9 +7059,9 @@ type ProxyAttributes struct {
 	// Example: true
 	GlobalSettings bool `json:"global_settings"`
 	// The live status proxy daemon parameters.
-	Params interface{} `json:"params,omitempty"`
+	Params *ProxyParams `json:"params,omitempty"`
 	// Allow access via TCP configuration.
-	Tcp interface{} `json:"tcp,omitempty"`
+	Tcp *ProxyTcp `json:"tcp,omitempty"`
 	// Use livestatus daemon with direct connection or with livestatus proxy.
 	// Example: true
 	UseLivestatusDaemon ProxyAttributesUseLivestatusDaemon `json:"use_livestatus_daemon"`
@@ -3861,16 +7073,98 @@ type ProxyAttributes1 struct {
 	// Example: true
 	GlobalSettings bool `json:"global_settings,omitempty"`
 	// The live status proxy daemon parameters.
-	Params interface{} `json:"params,omitempty"`
+	Params *ProxyParams1 `json:"params,omitempty"`
 	// Allow access via TCP configuration.
-	Tcp interface{} `json:"tcp,omitempty"`
+	Tcp *ProxyTcp1 `json:"tcp,omitempty"`
 	// Use livestatus daemon with direct connection or with livestatus proxy.
 	// Example: true
 	UseLivestatusDaemon string `json:"use_livestatus_daemon"`
 }
 
-// ProxyOrDirect represents a CheckMK API type.
+// ProxyOrDirect is a union of UseLiveStatusDaemon, ProxyAttributes, selected by the "use_livestatus_daemon" property.
 type ProxyOrDirect struct {
+	union json.RawMessage
+}
+
+// Discriminator returns the value of the "use_livestatus_daemon" property.
+func (u ProxyOrDirect) Discriminator() (string, error) {
+	return unionDiscriminator(u.union, "use_livestatus_daemon")
+}
+
+// AsUseLiveStatusDaemon returns the union data as a UseLiveStatusDaemon.
+func (u ProxyOrDirect) AsUseLiveStatusDaemon() (UseLiveStatusDaemon, error) {
+	var v UseLiveStatusDaemon
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromUseLiveStatusDaemon sets the union to v, with "use_livestatus_daemon" set to "direct".
+func (u *ProxyOrDirect) FromUseLiveStatusDaemon(v UseLiveStatusDaemon) error {
+	data, err := unionFrom(v, "use_livestatus_daemon", "direct")
+	if err != nil {
+		return fmt.Errorf("ProxyOrDirect: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsProxyAttributes returns the union data as a ProxyAttributes.
+func (u ProxyOrDirect) AsProxyAttributes() (ProxyAttributes, error) {
+	var v ProxyAttributes
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromProxyAttributes sets the union to v, with "use_livestatus_daemon" set to "with_proxy".
+func (u *ProxyOrDirect) FromProxyAttributes(v ProxyAttributes) error {
+	data, err := unionFrom(v, "use_livestatus_daemon", "with_proxy")
+	if err != nil {
+		return fmt.Errorf("ProxyOrDirect: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// ValueByDiscriminator decodes the union into the member selected by "use_livestatus_daemon".
+func (u ProxyOrDirect) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := u.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "direct":
+		return u.AsUseLiveStatusDaemon()
+	case "with_proxy":
+		return u.AsProxyAttributes()
+	}
+	return nil, fmt.Errorf("ProxyOrDirect: unknown use_livestatus_daemon %q", discriminator)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u ProxyOrDirect) MarshalJSON() ([]byte, error) {
+	if len(u.union) == 0 {
+		return []byte("null"), nil
+	}
+	return u.union, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting unknown "use_livestatus_daemon" values.
+func (u *ProxyOrDirect) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		u.union = nil
+		return nil
+	}
+	discriminator, err := unionDiscriminator(data, "use_livestatus_daemon")
+	if err != nil {
+		return fmt.Errorf("ProxyOrDirect: %w", err)
+	}
+	switch discriminator {
+	case "direct", "with_proxy":
+	default:
+		return fmt.Errorf("ProxyOrDirect: unknown use_livestatus_daemon %q", discriminator)
+	}
+	u.union = append(json.RawMessage(nil), data...)
+	return nil
 }
 
 // ProxyParams represents a CheckMK API type.
@@ -3888,7 +7182,7 @@ type ProxyParams struct {
 	// Example: 4
 	ConnectRetry float64 `json:"connect_retry,omitempty"`
 	// The heartbeat interval and timeout configuration.
-	Heartbeat interface{} `json:"heartbeat,omitempty"`
+	Heartbeat *Heartbeat `json:"heartbeat,omitempty"`
 	// The total query timeout.
 	// Example: 120
 	QueryTimeout float64 `json:"query_timeout,omitempty"`
@@ -3909,7 +7203,7 @@ type ProxyParams1 struct {
 	// Example: 4
 	ConnectRetry float64 `json:"connect_retry,omitempty"`
 	// The heartbeat interval and timeout configuration.
-	Heartbeat interface{} `json:"heartbeat,omitempty"`
+	Heartbeat *Heartbeat1 `json:"heartbeat,omitempty"`
 	// The total query timeout.
 	// Example: 120
 	QueryTimeout float64 `json:"query_timeout,omitempty"`
@@ -3947,21 +7241,141 @@ type PushOverPluginCreate struct {
 	// Example: azGDORePK8gMaC0QOYAMyEEuzJnyUi
 	ApiKey string `json:"api_key"`
 	// Use the proxy settings from the environment variables. The variables NO_PROXY, HTTP_PROXY and HTTPS_PROXY are taken into account during execution.
-	HttpProxy interface{} `json:"http_proxy"`
+	HttpProxy CheckboxOneOf `json:"http_proxy"`
 	// The plugin name.
 	// Example: mail
 	PluginName PushOverPluginCreatePluginName `json:"plugin_name"`
-	Priority map[string]interface{} `json:"priority"`
-	Sound map[string]interface{} `json:"sound"`
+	Priority CheckboxOneOf `json:"priority"`
+	Sound CheckboxOneOf `json:"sound"`
 	// If you specify an URL prefix here, then several parts of the email body are armed with hyperlinks to your Check_MK GUI, so that the recipient of the email can directly visit the host or service in question in Check_MK. Specify an absolute URL including the .../check_mk/
-	UrlPrefixForLinksToCheckmk interface{} `json:"url_prefix_for_links_to_checkmk"`
+	UrlPrefixForLinksToCheckmk CheckboxOneOf `json:"url_prefix_for_links_to_checkmk"`
 	// Configure the user or group to receive the notifications by providing the user or group key here. The key can be obtained from the Pushover website.
 	// Example: azGDORePK8gMaC0QOYAMyEEuzJnyUi
 	UserGroupKey string `json:"user_group_key"`
 }
 
-// ReferTo represents a CheckMK API type.
+// ReferTo is a union of Host, Parent, Child, ChildWith, selected by the "type" property.
 type ReferTo struct {
+	union json.RawMessage
+}
+
+// Discriminator returns the value of the "type" property.
+func (u ReferTo) Discriminator() (string, error) {
+	return unionDiscriminator(u.union, "type")
+}
+
+// AsHost returns the union data as a Host.
+func (u ReferTo) AsHost() (Host, error) {
+	var v Host
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromHost sets the union to v, with "type" set to "host".
+func (u *ReferTo) FromHost(v Host) error {
+	data, err := unionFrom(v, "type", "host")
+	if err != nil {
+		return fmt.Errorf("ReferTo: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsParent returns the union data as a Parent.
+func (u ReferTo) AsParent() (Parent, error) {
+	var v Parent
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromParent sets the union to v, with "type" set to "parent".
+func (u *ReferTo) FromParent(v Parent) error {
+	data, err := unionFrom(v, "type", "parent")
+	if err != nil {
+		return fmt.Errorf("ReferTo: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsChild returns the union data as a Child.
+func (u ReferTo) AsChild() (Child, error) {
+	var v Child
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromChild sets the union to v, with "type" set to "child".
+func (u *ReferTo) FromChild(v Child) error {
+	data, err := unionFrom(v, "type", "child")
+	if err != nil {
+		return fmt.Errorf("ReferTo: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsChildWith returns the union data as a ChildWith.
+func (u ReferTo) AsChildWith() (ChildWith, error) {
+	var v ChildWith
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromChildWith sets the union to v, with "type" set to "child_with".
+func (u *ReferTo) FromChildWith(v ChildWith) error {
+	data, err := unionFrom(v, "type", "child_with")
+	if err != nil {
+		return fmt.Errorf("ReferTo: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// ValueByDiscriminator decodes the union into the member selected by "type".
+func (u ReferTo) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := u.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "host":
+		return u.AsHost()
+	case "parent":
+		return u.AsParent()
+	case "child":
+		return u.AsChild()
+	case "child_with":
+		return u.AsChildWith()
+	}
+	return nil, fmt.Errorf("ReferTo: unknown type %q", discriminator)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u ReferTo) MarshalJSON() ([]byte, error) {
+	if len(u.union) == 0 {
+		return []byte("null"), nil
+	}
+	return u.union, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting unknown "type" values.
+func (u *ReferTo) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		u.union = nil
+		return nil
+	}
+	discriminator, err := unionDiscriminator(data, "type")
+	if err != nil {
+		return fmt.Errorf("ReferTo: %w", err)
+	}
+	switch discriminator {
+	case "host", "parent", "child", "child_with":
+	default:
+		return fmt.Errorf("ReferTo: unknown type %q", discriminator)
+	}
+	u.union = append(json.RawMessage(nil), data...)
+	return nil
 }
 
 // RegexpRewrites represents a CheckMK API type.
@@ -3995,65 +7409,65 @@ type RuleCollection struct {
 	// The name of this collection.
 	Id string `json:"id,omitempty"`
 	// list of links to other resources.
-	Links []map[string]interface{} `json:"links"`
+	Links []Link `json:"links"`
 	// A human readable title of this object. Can be used for user interfaces.
 	Title string `json:"title,omitempty"`
 	// The collection itself. Each entry in here is part of the collection.
-	Value []map[string]interface{} `json:"value,omitempty"`
+	Value []RuleObject `json:"value,omitempty"`
 }
 
 // RuleConditions represents a CheckMK API type.
 type RuleConditions struct {
-	EventConsoleAlerts map[string]interface{} `json:"event_console_alerts"`
-	MatchCheckTypes map[string]interface{} `json:"match_check_types"`
-	MatchContactGroups map[string]interface{} `json:"match_contact_groups"`
-	MatchExcludeHosts map[string]interface{} `json:"match_exclude_hosts"`
-	MatchExcludeServiceGroups map[string]interface{} `json:"match_exclude_service_groups"`
-	MatchExcludeServiceGroupsRegex map[string]interface{} `json:"match_exclude_service_groups_regex"`
-	MatchExcludeServices map[string]interface{} `json:"match_exclude_services"`
-	MatchFolder map[string]interface{} `json:"match_folder"`
-	MatchHostEventType map[string]interface{} `json:"match_host_event_type"`
-	MatchHostGroups map[string]interface{} `json:"match_host_groups"`
-	MatchHostLabels map[string]interface{} `json:"match_host_labels"`
-	MatchHostTags map[string]interface{} `json:"match_host_tags"`
-	MatchHosts map[string]interface{} `json:"match_hosts"`
-	MatchNotificationComment map[string]interface{} `json:"match_notification_comment"`
-	MatchOnlyDuringTimePeriod map[string]interface{} `json:"match_only_during_time_period"`
-	MatchPluginOutput map[string]interface{} `json:"match_plugin_output"`
-	MatchServiceEventType map[string]interface{} `json:"match_service_event_type"`
-	MatchServiceGroups map[string]interface{} `json:"match_service_groups"`
-	MatchServiceGroupsRegex map[string]interface{} `json:"match_service_groups_regex"`
-	MatchServiceLabels map[string]interface{} `json:"match_service_labels"`
-	MatchServiceLevels map[string]interface{} `json:"match_service_levels"`
-	MatchServices map[string]interface{} `json:"match_services"`
-	MatchSites map[string]interface{} `json:"match_sites"`
-	RestrictToNotificationNumbers map[string]interface{} `json:"restrict_to_notification_numbers"`
-	ThrottlePeriodicNotifications map[string]interface{} `json:"throttle_periodic_notifications"`
+	EventConsoleAlerts CheckboxOneOf `json:"event_console_alerts"`
+	MatchCheckTypes CheckboxOneOf `json:"match_check_types"`
+	MatchContactGroups CheckboxOneOf `json:"match_contact_groups"`
+	MatchExcludeHosts CheckboxOneOf `json:"match_exclude_hosts"`
+	MatchExcludeServiceGroups CheckboxOneOf `json:"match_exclude_service_groups"`
+	MatchExcludeServiceGroupsRegex CheckboxOneOf `json:"match_exclude_service_groups_regex"`
+	MatchExcludeServices CheckboxOneOf `json:"match_exclude_services"`
+	MatchFolder CheckboxOneOf `json:"match_folder"`
+	MatchHostEventType CheckboxOneOf `json:"match_host_event_type"`
+	MatchHostGroups CheckboxOneOf `json:"match_host_groups"`
+	MatchHostLabels CheckboxOneOf `json:"match_host_labels"`
+	MatchHostTags CheckboxOneOf `json:"match_host_tags"`
+	MatchHosts CheckboxOneOf `json:"match_hosts"`
+	MatchNotificationComment CheckboxOneOf `json:"match_notification_comment"`
+	MatchOnlyDuringTimePeriod CheckboxOneOf `json:"match_only_during_time_period"`
+	MatchPluginOutput CheckboxOneOf `json:"match_plugin_output"`
+	MatchServiceEventType CheckboxOneOf `json:"match_service_event_type"`
+	MatchServiceGroups CheckboxOneOf `json:"match_service_groups"`
+	MatchServiceGroupsRegex CheckboxOneOf `json:"match_service_groups_regex"`
+	MatchServiceLabels CheckboxOneOf `json:"match_service_labels"`
+	MatchServiceLevels CheckboxOneOf `json:"match_service_levels"`
+	MatchServices CheckboxOneOf `json:"match_services"`
+	MatchSites CheckboxOneOf `json:"match_sites"`
+	RestrictToNotificationNumbers CheckboxOneOf `json:"restrict_to_notification_numbers"`
+	ThrottlePeriodicNotifications CheckboxOneOf `json:"throttle_periodic_notifications"`
 }
 
 // RuleConditions1 represents a CheckMK API type.
 type RuleConditions1 struct {
 	// Further restrict this rule by applying host label conditions.
 	// Example: [map[key:os operator:is value:windows]]
-	HostLabels []map[string]interface{} `json:"host_labels,omitempty"`
+	HostLabels []LabelCondition `json:"host_labels,omitempty"`
 	// Here you can enter a list of explicit host names that the rule should or should not apply to. Leave this option disabled if you want the rule to apply for all hosts specified by the given tags. The names that you enter here are compared with case sensitive exact matching. Alternatively you can us...
 	// Example: map[match_on:[host1 host2] operator:one_of]
-	HostName interface{} `json:"host_name,omitempty"`
+	HostName *HostOrServiceCondition `json:"host_name,omitempty"`
 	// The rule will only be applied to hosts fulfilling all the host tag conditions listed here, even if they appear in the list of explicit host names.
 	// Example: [map[key:criticality operator:is value:prod]]
-	HostTags []map[string]interface{} `json:"host_tags,omitempty"`
+	HostTags []TagCondition `json:"host_tags,omitempty"`
 	// Specify a list of service patterns this rule shall apply to. * The patterns must match the beginning of the service in question. * Adding a `$` to the end forces an exact match. * Pattern use regular expressions. e.g. a `.*` will match an arbitrary text. * The text entered here is handled as a re...
 	// Example: map[match_on:[foo1 bar2] operator:none_of]
-	ServiceDescription interface{} `json:"service_description,omitempty"`
+	ServiceDescription *HostOrServiceCondition `json:"service_description,omitempty"`
 	// Restrict the application of the rule, by checking against service label conditions.
 	// Example: [map[key:os operator:is value:windows]]
-	ServiceLabels []map[string]interface{} `json:"service_labels,omitempty"`
+	ServiceLabels []LabelCondition `json:"service_labels,omitempty"`
 }
 
 // RuleExtensions represents a CheckMK API type.
 type RuleExtensions struct {
 	// Conditions.
-	Conditions interface{} `json:"conditions,omitempty"`
+	Conditions *RuleConditions1 `json:"conditions,omitempty"`
 	// The path name of the folder. Path delimiters can be either `~`, `/` or `\`. Please use the one most appropriate for your quoting/escaping needs. A good default choice is `~`.
 	// Example: ~router
 	Folder string `json:"folder"`
@@ -4061,7 +7475,7 @@ type RuleExtensions struct {
 	FolderIndex int `json:"folder_index,omitempty"`
 	// Property values of this rule.
 	// Example: map[]
-	Properties interface{} `json:"properties,omitempty"`
+	Properties *RuleProperties1 `json:"properties,omitempty"`
 	// The name of the ruleset.
 	Ruleset string `json:"ruleset,omitempty"`
 	// The raw parameter value for this rule.
@@ -4071,16 +7485,16 @@ type RuleExtensions struct {
 
 // RuleNotification represents a CheckMK API type.
 type RuleNotification struct {
-	Conditions map[string]interface{} `json:"conditions"`
-	ContactSelection map[string]interface{} `json:"contact_selection"`
-	NotificationMethod map[string]interface{} `json:"notification_method"`
-	RuleProperties map[string]interface{} `json:"rule_properties"`
+	Conditions RuleConditions `json:"conditions"`
+	ContactSelection ContactSelection `json:"contact_selection"`
+	NotificationMethod RuleNotificationMethod `json:"notification_method"`
+	RuleProperties RuleProperties `json:"rule_properties"`
 }
 
 // RuleNotificationMethod represents a CheckMK API type.
 type RuleNotificationMethod struct {
-	NotificationBulking map[string]interface{} `json:"notification_bulking"`
-	NotifyPlugin map[string]interface{} `json:"notify_plugin"`
+	NotificationBulking CheckboxOneOf `json:"notification_bulking"`
+	NotifyPlugin PluginOptionsSelector `json:"notify_plugin"`
 }
 
 // RuleObject represents a CheckMK API type.
@@ -4089,11 +7503,11 @@ type RuleObject struct {
 	// Example: rule
 	DomainType interface{} `json:"domainType,omitempty"`
 	// Attributes specific to rule objects.
-	Extensions interface{} `json:"extensions,omitempty"`
+	Extensions *RuleExtensions `json:"extensions,omitempty"`
 	// The unique identifier for this domain-object type.
 	Id string `json:"id,omitempty"`
 	// list of links to other resources.
-	Links []map[string]interface{} `json:"links"`
+	Links []Link `json:"links"`
 	// The container for external resources, like linked foreign objects or actions.
 	Members map[string]interface{} `json:"members,omitempty"`
 	// A human readable title of this object. Can be used for user interfaces.
@@ -4104,7 +7518,7 @@ type RuleObject struct {
 type RuleProperties struct {
 	// If you set this option then users are allowed to deactivate notifications that are created by this rule.
 	// Example: map[state:enabled]
-	AllowUsersToDeactivate interface{} `json:"allow_users_to_deactivate"`
+	AllowUsersToDeactivate Checkbox `json:"allow_users_to_deactivate"`
 	// An optional comment that may be used to explain the purpose of this object.
 	// Example: An example comment
 	Comment string `json:"comment"`
@@ -4113,7 +7527,7 @@ type RuleProperties struct {
 	Description string `json:"description"`
 	// Disabled rules are kept in the configuration but are not applied.
 	// Example: map[state:enabled]
-	DoNotApplyThisRule interface{} `json:"do_not_apply_this_rule"`
+	DoNotApplyThisRule Checkbox `json:"do_not_apply_this_rule"`
 	// An optional URL pointing to documentation or any other page. This will be displayed as an icon and open a new page when clicked.
 	// Example: http://link/to/documentation
 	DocumentationUrl string `json:"documentation_url"`
@@ -4139,7 +7553,7 @@ type RuleProperties1 struct {
 type RulePropertiesAttributes struct {
 	// If you set this option then users are allowed to deactivate notifications that are created by this rule.
 	// Example: map[state:enabled]
-	AllowUsersToDeactivate interface{} `json:"allow_users_to_deactivate"`
+	AllowUsersToDeactivate Checkbox `json:"allow_users_to_deactivate"`
 	// An optional comment that may be used to explain the purpose of this object.
 	// Example: An example comment
 	Comment string `json:"comment"`
@@ -4148,7 +7562,7 @@ type RulePropertiesAttributes struct {
 	Description string `json:"description"`
 	// Disabled rules are kept in the configuration but are not applied.
 	// Example: map[state:enabled]
-	DoNotApplyThisRule interface{} `json:"do_not_apply_this_rule"`
+	DoNotApplyThisRule Checkbox `json:"do_not_apply_this_rule"`
 	// An optional URL pointing to documentation or any other page. This will be displayed as an icon and open a new page when clicked.
 	// Example: http://link/to/documentation
 	DocumentationUrl string `json:"documentation_url"`
@@ -4164,11 +7578,11 @@ type RulesetCollection struct {
 	// The name of this collection.
 	Id string `json:"id,omitempty"`
 	// list of links to other resources.
-	Links []map[string]interface{} `json:"links"`
+	Links []Link `json:"links"`
 	// A human readable title of this object. Can be used for user interfaces.
 	Title string `json:"title,omitempty"`
 	// The collection itself. Each entry in here is part of the collection.
-	Value []map[string]interface{} `json:"value,omitempty"`
+	Value []CollectionItem `json:"value,omitempty"`
 }
 
 // RulesetExtensions represents a CheckMK API type.
@@ -4190,11 +7604,11 @@ type RulesetObject struct {
 	// Example: ruleset
 	DomainType interface{} `json:"domainType,omitempty"`
 	// Specific attributes related to rulesets.
-	Extensions interface{} `json:"extensions,omitempty"`
+	Extensions *RulesetExtensions `json:"extensions,omitempty"`
 	// The unique identifier for this domain-object type.
 	Id string `json:"id,omitempty"`
 	// list of links to other resources.
-	Links []map[string]interface{} `json:"links"`
+	Links []Link `json:"links"`
 	// The container for external resources, like linked foreign objects or actions.
 	Members map[string]interface{} `json:"members,omitempty"`
 	// A human readable title of this object. Can be used for user interfaces.
@@ -4215,16 +7629,98 @@ type SMSAPIPStoreID struct {
 	StoreId string `json:"store_id"`
 }
 
-// SMSAPIPasswordSelector represents a CheckMK API type.
+// SMSAPIPasswordSelector is a union of SMSAPIExplicitPassword, SMSAPIPStoreID, selected by the "option" property.
 type SMSAPIPasswordSelector struct {
+	union json.RawMessage
+}
+
+// Discriminator returns the value of the "option" property.
+func (u SMSAPIPasswordSelector) Discriminator() (string, error) {
+	return unionDiscriminator(u.union, "option")
+}
+
+// AsSMSAPIExplicitPassword returns the union data as a SMSAPIExplicitPassword.
+func (u SMSAPIPasswordSelector) AsSMSAPIExplicitPassword() (SMSAPIExplicitPassword, error) {
+	var v SMSAPIExplicitPassword
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromSMSAPIExplicitPassword sets the union to v, with "option" set to "explicit".
+func (u *SMSAPIPasswordSelector) FromSMSAPIExplicitPassword(v SMSAPIExplicitPassword) error {
+	data, err := unionFrom(v, "option", "explicit")
+	if err != nil {
+		return fmt.Errorf("SMSAPIPasswordSelector: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsSMSAPIPStoreID returns the union data as a SMSAPIPStoreID.
+func (u SMSAPIPasswordSelector) AsSMSAPIPStoreID() (SMSAPIPStoreID, error) {
+	var v SMSAPIPStoreID
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromSMSAPIPStoreID sets the union to v, with "option" set to "store".
+func (u *SMSAPIPasswordSelector) FromSMSAPIPStoreID(v SMSAPIPStoreID) error {
+	data, err := unionFrom(v, "option", "store")
+	if err != nil {
+		return fmt.Errorf("SMSAPIPasswordSelector: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// ValueByDiscriminator decodes the union into the member selected by "option".
+func (u SMSAPIPasswordSelector) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := u.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "explicit":
+		return u.AsSMSAPIExplicitPassword()
+	case "store":
+		return u.AsSMSAPIPStoreID()
+	}
+	return nil, fmt.Errorf("SMSAPIPasswordSelector: unknown option %q", discriminator)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u SMSAPIPasswordSelector) MarshalJSON() ([]byte, error) {
+	if len(u.union) == 0 {
+		return []byte("null"), nil
+	}
+	return u.union, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting unknown "option" values.
+func (u *SMSAPIPasswordSelector) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		u.union = nil
+		return nil
+	}
+	discriminator, err := unionDiscriminator(data, "option")
+	if err != nil {
+		return fmt.Errorf("SMSAPIPasswordSelector: %w", err)
+	}
+	switch discriminator {
+	case "explicit", "store":
+	default:
+		return fmt.Errorf("SMSAPIPasswordSelector: unknown option %q", discriminator)
+	}
+	u.union = append(json.RawMessage(nil), data...)
+	return nil
 }
 
 // SMSAPIPluginCreate represents a CheckMK API type.
 type SMSAPIPluginCreate struct {
 	// Ignore unverified HTTPS request warnings. Use with caution.
-	DisableSslCertVerification interface{} `json:"disable_ssl_cert_verification"`
+	DisableSslCertVerification Checkbox `json:"disable_ssl_cert_verification"`
 	// Use the proxy settings from the environment variables. The variables NO_PROXY, HTTP_PROXY and HTTPS_PROXY are taken into account during execution.
-	HttpProxy interface{} `json:"http_proxy"`
+	HttpProxy CheckboxOneOf `json:"http_proxy"`
 	// Choose what modem is used. Currently supported is only Teltonika-TRB140.
 	// Example: trb140
 	ModemType SMSAPIPluginCreateModemType `json:"modem_type"`
@@ -4237,7 +7733,7 @@ type SMSAPIPluginCreate struct {
 	// Here you can configure timeout settings
 	// Example: 10
 	Timeout string `json:"timeout"`
-	UserPassword map[string]interface{} `json:"user_password"`
+	UserPassword SMSAPIPasswordSelector `json:"user_password"`
 	// Configure the user name here
 	// Example: username_a
 	Username string `json:"username"`
@@ -4260,8 +7756,128 @@ type SNMPCommunity struct {
 	Type interface{} `json:"type,omitempty"`
 }
 
-// SNMPCredentials represents a CheckMK API type.
+// SNMPCredentials is a union of SNMPCommunity, SNMPv3NoAuthNoPrivacy, SNMPv3AuthNoPrivacy, SNMPv3AuthPrivacy, selected by the "type" property.
 type SNMPCredentials struct {
+	union json.RawMessage
+}
+
+// Discriminator returns the value of the "type" property.
+func (u SNMPCredentials) Discriminator() (string, error) {
+	return unionDiscriminator(u.union, "type")
+}
+
+// AsSNMPCommunity returns the union data as a SNMPCommunity.
+func (u SNMPCredentials) AsSNMPCommunity() (SNMPCommunity, error) {
+	var v SNMPCommunity
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromSNMPCommunity sets the union to v, with "type" set to "v1_v2_community".
+func (u *SNMPCredentials) FromSNMPCommunity(v SNMPCommunity) error {
+	data, err := unionFrom(v, "type", "v1_v2_community")
+	if err != nil {
+		return fmt.Errorf("SNMPCredentials: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsSNMPv3NoAuthNoPrivacy returns the union data as a SNMPv3NoAuthNoPrivacy.
+func (u SNMPCredentials) AsSNMPv3NoAuthNoPrivacy() (SNMPv3NoAuthNoPrivacy, error) {
+	var v SNMPv3NoAuthNoPrivacy
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromSNMPv3NoAuthNoPrivacy sets the union to v, with "type" set to "v3_no_auth_no_privacy".
+func (u *SNMPCredentials) FromSNMPv3NoAuthNoPrivacy(v SNMPv3NoAuthNoPrivacy) error {
+	data, err := unionFrom(v, "type", "v3_no_auth_no_privacy")
+	if err != nil {
+		return fmt.Errorf("SNMPCredentials: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsSNMPv3AuthNoPrivacy returns the union data as a SNMPv3AuthNoPrivacy.
+func (u SNMPCredentials) AsSNMPv3AuthNoPrivacy() (SNMPv3AuthNoPrivacy, error) {
+	var v SNMPv3AuthNoPrivacy
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromSNMPv3AuthNoPrivacy sets the union to v, with "type" set to "v3_auth_no_privacy".
+func (u *SNMPCredentials) FromSNMPv3AuthNoPrivacy(v SNMPv3AuthNoPrivacy) error {
+	data, err := unionFrom(v, "type", "v3_auth_no_privacy")
+	if err != nil {
+		return fmt.Errorf("SNMPCredentials: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsSNMPv3AuthPrivacy returns the union data as a SNMPv3AuthPrivacy.
+func (u SNMPCredentials) AsSNMPv3AuthPrivacy() (SNMPv3AuthPrivacy, error) {
+	var v SNMPv3AuthPrivacy
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromSNMPv3AuthPrivacy sets the union to v, with "type" set to "v3_auth_privacy".
+func (u *SNMPCredentials) FromSNMPv3AuthPrivacy(v SNMPv3AuthPrivacy) error {
+	data, err := unionFrom(v, "type", "v3_auth_privacy")
+	if err != nil {
+		return fmt.Errorf("SNMPCredentials: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// ValueByDiscriminator decodes the union into the member selected by "type".
+func (u SNMPCredentials) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := u.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "v1_v2_community":
+		return u.AsSNMPCommunity()
+	case "v3_no_auth_no_privacy":
+		return u.AsSNMPv3NoAuthNoPrivacy()
+	case "v3_auth_no_privacy":
+		return u.AsSNMPv3AuthNoPrivacy()
+	case "v3_auth_privacy":
+		return u.AsSNMPv3AuthPrivacy()
+	}
+	return nil, fmt.Errorf("SNMPCredentials: unknown type %q", discriminator)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u SNMPCredentials) MarshalJSON() ([]byte, error) {
+	if len(u.union) == 0 {
+		return []byte("null"), nil
+	}
+	return u.union, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting unknown "type" values.
+func (u *SNMPCredentials) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		u.union = nil
+		return nil
+	}
+	discriminator, err := unionDiscriminator(data, "type")
+	if err != nil {
+		return fmt.Errorf("SNMPCredentials: %w", err)
+	}
+	switch discriminator {
+	case "v1_v2_community", "v3_no_auth_no_privacy", "v3_auth_no_privacy", "v3_auth_privacy":
+	default:
+		return fmt.Errorf("SNMPCredentials: unknown type %q", discriminator)
+	}
+	u.union = append(json.RawMessage(nil), data...)
+	return nil
 }
 
 // SNMPv3AuthNoPrivacy represents a CheckMK API type.
@@ -4302,7 +7918,7 @@ type SNMPv3NoAuthNoPrivacy struct {
 
 // ServiceConditions represents a CheckMK API type.
 type ServiceConditions struct {
-	HostChoice interface{} `json:"host_choice"`
+	HostChoice BIHostChoice `json:"host_choice"`
 	HostFolder string `json:"host_folder"`
 	HostLabels map[string]interface{} `json:"host_labels"`
 	HostTags map[string]interface{} `json:"host_tags"`
@@ -4345,7 +7961,7 @@ type ServiceGroup struct {
 	// The unique identifier for this domain-object type.
 	Id string `json:"id,omitempty"`
 	// list of links to other resources.
-	Links []map[string]interface{} `json:"links"`
+	Links []Link `json:"links"`
 	// The container for external resources, like linked foreign objects or actions.
 	Members map[string]interface{} `json:"members,omitempty"`
 	// A human readable title of this object. Can be used for user interfaces.
@@ -4361,11 +7977,11 @@ type ServiceGroupCollection struct {
 	// The name of this collection.
 	Id string `json:"id,omitempty"`
 	// list of links to other resources.
-	Links []map[string]interface{} `json:"links"`
+	Links []Link `json:"links"`
 	// A human readable title of this object. Can be used for user interfaces.
 	Title string `json:"title,omitempty"`
 	// A list of service group objects.
-	Value []map[string]interface{} `json:"value,omitempty"`
+	Value []ServiceGroup `json:"value,omitempty"`
 }
 
 // ServiceGroupsRegex represents a CheckMK API type.
@@ -4382,8 +7998,90 @@ type ServiceNowExplicitPassword struct {
 	Password string `json:"password"`
 }
 
-// ServiceNowPasswordSelector represents a CheckMK API type.
+// ServiceNowPasswordSelector is a union of ServiceNowExplicitPassword, ServiceNowPasswordStoreID, selected by the "option" property.
 type ServiceNowPasswordSelector struct {
+	union json.RawMessage
+}
+
+// Discriminator returns the value of the "option" property.
+func (u ServiceNowPasswordSelector) Discriminator() (string, error) {
+	return unionDiscriminator(u.union, "option")
+}
+
+// AsServiceNowExplicitPassword returns the union data as a ServiceNowExplicitPassword.
+func (u ServiceNowPasswordSelector) AsServiceNowExplicitPassword() (ServiceNowExplicitPassword, error) {
+	var v ServiceNowExplicitPassword
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromServiceNowExplicitPassword sets the union to v, with "option" set to "explicit".
+func (u *ServiceNowPasswordSelector) FromServiceNowExplicitPassword(v ServiceNowExplicitPassword) error {
+	data, err := unionFrom(v, "option", "explicit")
+	if err != nil {
+		return fmt.Errorf("ServiceNowPasswordSelector: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsServiceNowPasswordStoreID returns the union data as a ServiceNowPasswordStoreID.
+func (u ServiceNowPasswordSelector) AsServiceNowPasswordStoreID() (ServiceNowPasswordStoreID, error) {
+	var v ServiceNowPasswordStoreID
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromServiceNowPasswordStoreID sets the union to v, with "option" set to "store".
+func (u *ServiceNowPasswordSelector) FromServiceNowPasswordStoreID(v ServiceNowPasswordStoreID) error {
+	data, err := unionFrom(v, "option", "store")
+	if err != nil {
+		return fmt.Errorf("ServiceNowPasswordSelector: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// ValueByDiscriminator decodes the union into the member selected by "option".
+func (u ServiceNowPasswordSelector) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := u.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "explicit":
+		return u.AsServiceNowExplicitPassword()
+	case "store":
+		return u.AsServiceNowPasswordStoreID()
+	}
+	return nil, fmt.Errorf("ServiceNowPasswordSelector: unknown option %q", discriminator)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u ServiceNowPasswordSelector) MarshalJSON() ([]byte, error) {
+	if len(u.union) == 0 {
+		return []byte("null"), nil
+	}
+	return u.union, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting unknown "option" values.
+func (u *ServiceNowPasswordSelector) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		u.union = nil
+		return nil
+	}
+	discriminator, err := unionDiscriminator(data, "option")
+	if err != nil {
+		return fmt.Errorf("ServiceNowPasswordSelector: %w", err)
+	}
+	switch discriminator {
+	case "explicit", "store":
+	default:
+		return fmt.Errorf("ServiceNowPasswordSelector: unknown option %q", discriminator)
+	}
+	u.union = append(json.RawMessage(nil), data...)
+	return nil
 }
 
 // ServiceNowPasswordStoreID represents a CheckMK API type.
@@ -4397,24 +8095,106 @@ type ServiceNowPasswordStoreID struct {
 // ServiceNowPluginCreate represents a CheckMK API type.
 type ServiceNowPluginCreate struct {
 	// Use the proxy settings from the environment variables. The variables NO_PROXY, HTTP_PROXY and HTTPS_PROXY are taken into account during execution.
-	HttpProxy interface{} `json:"http_proxy"`
-	ManagementType map[string]interface{} `json:"management_type,omitempty"`
-	OptionalTimeout map[string]interface{} `json:"optional_timeout"`
+	HttpProxy CheckboxOneOf `json:"http_proxy"`
+	ManagementType *MgmntTypeSelector `json:"management_type,omitempty"`
+	OptionalTimeout CheckboxOneOf `json:"optional_timeout"`
 	// The plugin name.
 	// Example: mail
 	PluginName ServiceNowPluginCreatePluginName `json:"plugin_name"`
 	// Configure your ServiceNow URL here
 	// Example: https://myservicenow.com
 	ServicenowUrl string `json:"servicenow_url"`
-	UseSiteIdPrefix map[string]interface{} `json:"use_site_id_prefix"`
-	UserPassword map[string]interface{} `json:"user_password"`
+	UseSiteIdPrefix CheckboxOneOf `json:"use_site_id_prefix"`
+	UserPassword ServiceNowPasswordSelector `json:"user_password"`
 	// Configure the user name here
 	// Example: username_a
 	Username string `json:"username"`
 }
 
-// SignL4ExplicitOrStoreSelector represents a CheckMK API type.
+// SignL4ExplicitOrStoreSelector is a union of SignL4TeamSecret, SignL4TeamSecretStoreID, selected by the "option" property.
 type SignL4ExplicitOrStoreSelector struct {
+	union json.RawMessage
+}
+
+// Discriminator returns the value of the "option" property.
+func (u SignL4ExplicitOrStoreSelector) Discriminator() (string, error) {
+	return unionDiscriminator(u.union, "option")
+}
+
+// AsSignL4TeamSecret returns the union data as a SignL4TeamSecret.
+func (u SignL4ExplicitOrStoreSelector) AsSignL4TeamSecret() (SignL4TeamSecret, error) {
+	var v SignL4TeamSecret
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromSignL4TeamSecret sets the union to v, with "option" set to "explicit".
+func (u *SignL4ExplicitOrStoreSelector) FromSignL4TeamSecret(v SignL4TeamSecret) error {
+	data, err := unionFrom(v, "option", "explicit")
+	if err != nil {
+		return fmt.Errorf("SignL4ExplicitOrStoreSelector: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsSignL4TeamSecretStoreID returns the union data as a SignL4TeamSecretStoreID.
+func (u SignL4ExplicitOrStoreSelector) AsSignL4TeamSecretStoreID() (SignL4TeamSecretStoreID, error) {
+	var v SignL4TeamSecretStoreID
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromSignL4TeamSecretStoreID sets the union to v, with "option" set to "store".
+func (u *SignL4ExplicitOrStoreSelector) FromSignL4TeamSecretStoreID(v SignL4TeamSecretStoreID) error {
+	data, err := unionFrom(v, "option", "store")
+	if err != nil {
+		return fmt.Errorf("SignL4ExplicitOrStoreSelector: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// ValueByDiscriminator decodes the union into the member selected by "option".
+func (u SignL4ExplicitOrStoreSelector) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := u.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "explicit":
+		return u.AsSignL4TeamSecret()
+	case "store":
+		return u.AsSignL4TeamSecretStoreID()
+	}
+	return nil, fmt.Errorf("SignL4ExplicitOrStoreSelector: unknown option %q", discriminator)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u SignL4ExplicitOrStoreSelector) MarshalJSON() ([]byte, error) {
+	if len(u.union) == 0 {
+		return []byte("null"), nil
+	}
+	return u.union, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting unknown "option" values.
+func (u *SignL4ExplicitOrStoreSelector) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		u.union = nil
+		return nil
+	}
+	discriminator, err := unionDiscriminator(data, "option")
+	if err != nil {
+		return fmt.Errorf("SignL4ExplicitOrStoreSelector: %w", err)
+	}
+	switch discriminator {
+	case "explicit", "store":
+	default:
+		return fmt.Errorf("SignL4ExplicitOrStoreSelector: unknown option %q", discriminator)
+	}
+	u.union = append(json.RawMessage(nil), data...)
+	return nil
 }
 
 // SignL4TeamSecret represents a CheckMK API type.
@@ -4434,58 +8214,58 @@ type SignL4TeamSecretStoreID struct {
 // Signl4PluginCreate represents a CheckMK API type.
 type Signl4PluginCreate struct {
 	// Ignore unverified HTTPS request warnings. Use with caution.
-	DisableSslCertVerification interface{} `json:"disable_ssl_cert_verification"`
+	DisableSslCertVerification Checkbox `json:"disable_ssl_cert_verification"`
 	// Use the proxy settings from the environment variables. The variables NO_PROXY, HTTP_PROXY and HTTPS_PROXY are taken into account during execution.
-	HttpProxy interface{} `json:"http_proxy"`
+	HttpProxy CheckboxOneOf `json:"http_proxy"`
 	// The plugin name.
 	// Example: mail
 	PluginName Signl4PluginCreatePluginName `json:"plugin_name"`
-	TeamSecret map[string]interface{} `json:"team_secret"`
-	UrlPrefixForLinksToCheckmk map[string]interface{} `json:"url_prefix_for_links_to_checkmk"`
+	TeamSecret SignL4ExplicitOrStoreSelector `json:"team_secret"`
+	UrlPrefixForLinksToCheckmk CheckboxOneOf `json:"url_prefix_for_links_to_checkmk"`
 }
 
 // SiteConfigAttributes represents a CheckMK API type.
 type SiteConfigAttributes struct {
-	BasicSettings map[string]interface{} `json:"basic_settings,omitempty"`
-	ConfigurationConnection map[string]interface{} `json:"configuration_connection,omitempty"`
+	BasicSettings *BasicSettingsAttributes `json:"basic_settings,omitempty"`
+	ConfigurationConnection *ConfigurationConnectionAttributes1 `json:"configuration_connection,omitempty"`
 	// The shared secret used by the central site to authenticate with the remote site for configuring Checkmk.
 	// Example: secret
 	Secret string `json:"secret,omitempty"`
-	StatusConnection map[string]interface{} `json:"status_connection,omitempty"`
+	StatusConnection *StatusConnectionAttributes1 `json:"status_connection,omitempty"`
 }
 
 // SiteConfigAttributesCreate represents a CheckMK API type.
 type SiteConfigAttributesCreate struct {
-	BasicSettings map[string]interface{} `json:"basic_settings"`
-	ConfigurationConnection map[string]interface{} `json:"configuration_connection"`
+	BasicSettings BasicSettingsAttributesCreate `json:"basic_settings"`
+	ConfigurationConnection ConfigurationConnectionAttributes `json:"configuration_connection"`
 	// The shared secret used by the central site to authenticate with the remote site for configuring Checkmk.
 	// Example: secret
 	Secret string `json:"secret,omitempty"`
-	StatusConnection map[string]interface{} `json:"status_connection"`
+	StatusConnection StatusConnectionAttributes `json:"status_connection"`
 }
 
 // SiteConfigAttributesUpdate represents a CheckMK API type.
 type SiteConfigAttributesUpdate struct {
-	BasicSettings map[string]interface{} `json:"basic_settings"`
-	ConfigurationConnection map[string]interface{} `json:"configuration_connection"`
+	BasicSettings BasicSettingsAttributesUpdate `json:"basic_settings"`
+	ConfigurationConnection ConfigurationConnectionAttributes `json:"configuration_connection"`
 	// The shared secret used by the central site to authenticate with the remote site for configuring Checkmk.
 	// Example: secret
 	Secret string `json:"secret,omitempty"`
-	StatusConnection map[string]interface{} `json:"status_connection"`
+	StatusConnection StatusConnectionAttributes `json:"status_connection"`
 }
 
 // SiteConnectionRequestCreate represents a CheckMK API type.
 type SiteConnectionRequestCreate struct {
 	// A site's connection.
 	// Example: map[basic_settings:map[alias:Die remote site 1 site_id:site_id_1] configuration_connection:map[direct_login_to_web_gui_allowed:true disable_remote_configuration:true enable_replication:true ignore_tls_errors:false replicate_event_console:true replicate_extensions:true url_of_remote_site:http://lo...
-	SiteConfig interface{} `json:"site_config"`
+	SiteConfig SiteConfigAttributesCreate `json:"site_config"`
 }
 
 // SiteConnectionRequestUpdate represents a CheckMK API type.
 type SiteConnectionRequestUpdate struct {
 	// A site's connection.
 	// Example: map[basic_settings:map[alias:Die remote site 1 site_id:site_id_1] configuration_connection:map[direct_login_to_web_gui_allowed:true disable_remote_configuration:true enable_replication:true ignore_tls_errors:false replicate_event_console:true replicate_extensions:true url_of_remote_site:http://lo...
-	SiteConfig interface{} `json:"site_config"`
+	SiteConfig SiteConfigAttributesUpdate `json:"site_config"`
 }
 
 // SiteConnectionResponse represents a CheckMK API type.
@@ -4494,11 +8274,11 @@ type SiteConnectionResponse struct {
 	DomainType interface{} `json:"domainType,omitempty"`
 	// The configuration attributes of a site.
 	// Example: map[basic_settings:map[alias:Die remote site 1 site_id:site_id_1] configuration_connection:map[direct_login_to_web_gui_allowed:true disable_remote_configuration:true enable_replication:true ignore_tls_errors:false replicate_event_console:true replicate_extensions:true url_of_remote_site:http://lo...
-	Extensions interface{} `json:"extensions,omitempty"`
+	Extensions *SiteConfigAttributes `json:"extensions,omitempty"`
 	// The unique identifier for this domain-object type.
 	Id string `json:"id,omitempty"`
 	// list of links to other resources.
-	Links []map[string]interface{} `json:"links"`
+	Links []Link `json:"links"`
 	// The container for external resources, like linked foreign objects or actions.
 	Members map[string]interface{} `json:"members,omitempty"`
 	// A human readable title of this object. Can be used for user interfaces.
@@ -4514,12 +8294,12 @@ type SiteConnectionResponseCollection struct {
 	// The name of this collection.
 	Id string `json:"id,omitempty"`
 	// list of links to other resources.
-	Links []map[string]interface{} `json:"links"`
+	Links []Link `json:"links"`
 	// A human readable title of this object. Can be used for user interfaces.
 	Title string `json:"title,omitempty"`
 	// A list of site configuration objects.
 	// Example: [map[domainType:site_connection extensions:map[basic_settings:map[alias:Die remote site 1 site_id:site_id_1] configuration_connection:map[direct_login_to_web_gui_allowed:true disable_remote_configuration:true enable_replication:true ignore_tls_errors:false replicate_event_console:true replicate_e...
-	Value []map[string]interface{} `json:"value,omitempty"`
+	Value []SiteConnectionResponse `json:"value,omitempty"`
 }
 
 // SiteLoginRequest represents a CheckMK API type.
@@ -4535,18 +8315,100 @@ type SiteLoginRequest struct {
 // SlackPluginCreate represents a CheckMK API type.
 type SlackPluginCreate struct {
 	// Ignore unverified HTTPS request warnings. Use with caution.
-	DisableSslCertVerification interface{} `json:"disable_ssl_cert_verification"`
+	DisableSslCertVerification Checkbox `json:"disable_ssl_cert_verification"`
 	// Use the proxy settings from the environment variables. The variables NO_PROXY, HTTP_PROXY and HTTPS_PROXY are taken into account during execution.
-	HttpProxy interface{} `json:"http_proxy"`
+	HttpProxy CheckboxOneOf `json:"http_proxy"`
 	// The plugin name.
 	// Example: mail
 	PluginName SlackPluginCreatePluginName `json:"plugin_name"`
-	UrlPrefixForLinksToCheckmk map[string]interface{} `json:"url_prefix_for_links_to_checkmk"`
-	WebhookUrl map[string]interface{} `json:"webhook_url"`
+	UrlPrefixForLinksToCheckmk CheckboxOneOf `json:"url_prefix_for_links_to_checkmk"`
+	WebhookUrl SlackStoreOrExplicitURLSelector `json:"webhook_url"`
 }
 
-// SlackStoreOrExplicitURLSelector represents a CheckMK API type.
+// SlackStoreOrExplicitURLSelector is a union of SlackWebhookURL, SlackWebhookStore, selected by the "option" property.
 type SlackStoreOrExplicitURLSelector struct {
+	union json.RawMessage
+}
+
+// Discriminator returns the value of the "option" property.
+func (u SlackStoreOrExplicitURLSelector) Discriminator() (string, error) {
+	return unionDiscriminator(u.union, "option")
+}
+
+// AsSlackWebhookURL returns the union data as a SlackWebhookURL.
+func (u SlackStoreOrExplicitURLSelector) AsSlackWebhookURL() (SlackWebhookURL, error) {
+	var v SlackWebhookURL
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromSlackWebhookURL sets the union to v, with "option" set to "explicit".
+func (u *SlackStoreOrExplicitURLSelector) FromSlackWebhookURL(v SlackWebhookURL) error {
+	data, err := unionFrom(v, "option", "explicit")
+	if err != nil {
+		return fmt.Errorf("SlackStoreOrExplicitURLSelector: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsSlackWebhookStore returns the union data as a SlackWebhookStore.
+func (u SlackStoreOrExplicitURLSelector) AsSlackWebhookStore() (SlackWebhookStore, error) {
+	var v SlackWebhookStore
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromSlackWebhookStore sets the union to v, with "option" set to "store".
+func (u *SlackStoreOrExplicitURLSelector) FromSlackWebhookStore(v SlackWebhookStore) error {
+	data, err := unionFrom(v, "option", "store")
+	if err != nil {
+		return fmt.Errorf("SlackStoreOrExplicitURLSelector: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// ValueByDiscriminator decodes the union into the member selected by "option".
+func (u SlackStoreOrExplicitURLSelector) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := u.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "explicit":
+		return u.AsSlackWebhookURL()
+	case "store":
+		return u.AsSlackWebhookStore()
+	}
+	return nil, fmt.Errorf("SlackStoreOrExplicitURLSelector: unknown option %q", discriminator)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u SlackStoreOrExplicitURLSelector) MarshalJSON() ([]byte, error) {
+	if len(u.union) == 0 {
+		return []byte("null"), nil
+	}
+	return u.union, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting unknown "option" values.
+func (u *SlackStoreOrExplicitURLSelector) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		u.union = nil
+		return nil
+	}
+	discriminator, err := unionDiscriminator(data, "option")
+	if err != nil {
+		return fmt.Errorf("SlackStoreOrExplicitURLSelector: %w", err)
+	}
+	switch discriminator {
+	case "explicit", "store":
+	default:
+		return fmt.Errorf("SlackStoreOrExplicitURLSelector: unknown option %q", discriminator)
+	}
+	u.union = append(json.RawMessage(nil), data...)
+	return nil
 }
 
 // SlackWebhookStore represents a CheckMK API type.
@@ -4563,8 +8425,128 @@ type SlackWebhookURL struct {
 	Url string `json:"url"`
 }
 
-// SocketAttributes represents a CheckMK API type.
+// SocketAttributes is a union of SocketIP4, SocketIP6, SocketUnixAttributes, SocketType, selected by the "socket_type" property.
 type SocketAttributes struct {
+	union json.RawMessage
+}
+
+// Discriminator returns the value of the "socket_type" property.
+func (u SocketAttributes) Discriminator() (string, error) {
+	return unionDiscriminator(u.union, "socket_type")
+}
+
+// AsSocketIP4 returns the union data as a SocketIP4.
+func (u SocketAttributes) AsSocketIP4() (SocketIP4, error) {
+	var v SocketIP4
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromSocketIP4 sets the union to v, with "socket_type" set to "tcp".
+func (u *SocketAttributes) FromSocketIP4(v SocketIP4) error {
+	data, err := unionFrom(v, "socket_type", "tcp")
+	if err != nil {
+		return fmt.Errorf("SocketAttributes: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsSocketIP6 returns the union data as a SocketIP6.
+func (u SocketAttributes) AsSocketIP6() (SocketIP6, error) {
+	var v SocketIP6
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromSocketIP6 sets the union to v, with "socket_type" set to "tcp6".
+func (u *SocketAttributes) FromSocketIP6(v SocketIP6) error {
+	data, err := unionFrom(v, "socket_type", "tcp6")
+	if err != nil {
+		return fmt.Errorf("SocketAttributes: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsSocketUnixAttributes returns the union data as a SocketUnixAttributes.
+func (u SocketAttributes) AsSocketUnixAttributes() (SocketUnixAttributes, error) {
+	var v SocketUnixAttributes
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromSocketUnixAttributes sets the union to v, with "socket_type" set to "unix".
+func (u *SocketAttributes) FromSocketUnixAttributes(v SocketUnixAttributes) error {
+	data, err := unionFrom(v, "socket_type", "unix")
+	if err != nil {
+		return fmt.Errorf("SocketAttributes: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsSocketType returns the union data as a SocketType.
+func (u SocketAttributes) AsSocketType() (SocketType, error) {
+	var v SocketType
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromSocketType sets the union to v, with "socket_type" set to "local".
+func (u *SocketAttributes) FromSocketType(v SocketType) error {
+	data, err := unionFrom(v, "socket_type", "local")
+	if err != nil {
+		return fmt.Errorf("SocketAttributes: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// ValueByDiscriminator decodes the union into the member selected by "socket_type".
+func (u SocketAttributes) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := u.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "tcp":
+		return u.AsSocketIP4()
+	case "tcp6":
+		return u.AsSocketIP6()
+	case "unix":
+		return u.AsSocketUnixAttributes()
+	case "local":
+		return u.AsSocketType()
+	}
+	return nil, fmt.Errorf("SocketAttributes: unknown socket_type %q", discriminator)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u SocketAttributes) MarshalJSON() ([]byte, error) {
+	if len(u.union) == 0 {
+		return []byte("null"), nil
+	}
+	return u.union, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting unknown "socket_type" values.
+func (u *SocketAttributes) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		u.union = nil
+		return nil
+	}
+	discriminator, err := unionDiscriminator(data, "socket_type")
+	if err != nil {
+		return fmt.Errorf("SocketAttributes: %w", err)
+	}
+	switch discriminator {
+	case "tcp", "tcp6", "unix", "local":
+	default:
+		return fmt.Errorf("SocketAttributes: unknown socket_type %q", discriminator)
+	}
+	u.union = append(json.RawMessage(nil), data...)
+	return nil
 }
 
 // SocketAttributes1 represents a CheckMK API type.
@@ -4659,8 +8641,90 @@ type SpectrumPluginBase struct {
 	SnmpCommunity string `json:"snmp_community"`
 }
 
-// SplunkRESTEndpointSelector represents a CheckMK API type.
+// SplunkRESTEndpointSelector is a union of SplunkURLExplicit, SplunkStoreID, selected by the "option" property.
 type SplunkRESTEndpointSelector struct {
+	union json.RawMessage
+}
+
+// Discriminator returns the value of the "option" property.
+func (u SplunkRESTEndpointSelector) Discriminator() (string, error) {
+	return unionDiscriminator(u.union, "option")
+}
+
+// AsSplunkURLExplicit returns the union data as a SplunkURLExplicit.
+func (u SplunkRESTEndpointSelector) AsSplunkURLExplicit() (SplunkURLExplicit, error) {
+	var v SplunkURLExplicit
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromSplunkURLExplicit sets the union to v, with "option" set to "explicit".
+func (u *SplunkRESTEndpointSelector) FromSplunkURLExplicit(v SplunkURLExplicit) error {
+	data, err := unionFrom(v, "option", "explicit")
+	if err != nil {
+		return fmt.Errorf("SplunkRESTEndpointSelector: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsSplunkStoreID returns the union data as a SplunkStoreID.
+func (u SplunkRESTEndpointSelector) AsSplunkStoreID() (SplunkStoreID, error) {
+	var v SplunkStoreID
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromSplunkStoreID sets the union to v, with "option" set to "store".
+func (u *SplunkRESTEndpointSelector) FromSplunkStoreID(v SplunkStoreID) error {
+	data, err := unionFrom(v, "option", "store")
+	if err != nil {
+		return fmt.Errorf("SplunkRESTEndpointSelector: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// ValueByDiscriminator decodes the union into the member selected by "option".
+func (u SplunkRESTEndpointSelector) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := u.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "explicit":
+		return u.AsSplunkURLExplicit()
+	case "store":
+		return u.AsSplunkStoreID()
+	}
+	return nil, fmt.Errorf("SplunkRESTEndpointSelector: unknown option %q", discriminator)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u SplunkRESTEndpointSelector) MarshalJSON() ([]byte, error) {
+	if len(u.union) == 0 {
+		return []byte("null"), nil
+	}
+	return u.union, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting unknown "option" values.
+func (u *SplunkRESTEndpointSelector) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		u.union = nil
+		return nil
+	}
+	discriminator, err := unionDiscriminator(data, "option")
+	if err != nil {
+		return fmt.Errorf("SplunkRESTEndpointSelector: %w", err)
+	}
+	switch discriminator {
+	case "explicit", "store":
+	default:
+		return fmt.Errorf("SplunkRESTEndpointSelector: unknown option %q", discriminator)
+	}
+	u.union = append(json.RawMessage(nil), data...)
+	return nil
 }
 
 // SplunkStoreID represents a CheckMK API type.
@@ -4685,7 +8749,7 @@ type StatusConnectionAttributes struct {
 	// Example: 2
 	ConnectTimeout int `json:"connect_timeout"`
 	// When connecting to remote site please make sure that Livestatus over TCP is activated there. You can use UNIX sockets to connect to foreign sites on localhost.
-	Connection interface{} `json:"connection"`
+	Connection SocketAttributes `json:"connection"`
 	// If you disable a connection, then no data of this site will be shown in the status GUI. The replication is not affected by this, however.
 	// Example: false
 	DisableInStatusGui bool `json:"disable_in_status_gui,omitempty"`
@@ -4693,9 +8757,9 @@ type StatusConnectionAttributes struct {
 	// Example: false
 	PersistentConnection bool `json:"persistent_connection,omitempty"`
 	// The Livestatus proxy daemon configuration attributes.
-	Proxy interface{} `json:"proxy"`
+	Proxy ProxyOrDirect `json:"proxy"`
 	// By specifying a status host for each non-local connection you prevent Multisite from running into timeouts when remote sites do not respond.
-	StatusHost interface{} `json:"status_host"`
+	StatusHost StatusHostSet `json:"status_host"`
 	// The URL prefix will be prepended to links of addons like NagVis when a link to such applications points to a host or service on that site.
 	// Example: /remote_1/
 	UrlPrefix string `json:"url_prefix,omitempty"`
@@ -4707,7 +8771,7 @@ type StatusConnectionAttributes1 struct {
 	// Example: 2
 	ConnectTimeout int `json:"connect_timeout"`
 	// When connecting to remote site please make sure that Livestatus over TCP is activated there. You can use UNIX sockets to connect to foreign sites on localhost.
-	Connection interface{} `json:"connection"`
+	Connection SocketAttributes1 `json:"connection"`
 	// If you disable a connection, then no data of this site will be shown in the status GUI. The replication is not affected by this, however.
 	// Example: false
 	DisableInStatusGui bool `json:"disable_in_status_gui,omitempty"`
@@ -4715,9 +8779,9 @@ type StatusConnectionAttributes1 struct {
 	// Example: true
 	PersistentConnection bool `json:"persistent_connection,omitempty"`
 	// The Livestatus proxy daemon configuration attributes.
-	Proxy interface{} `json:"proxy"`
+	Proxy ProxyAttributes1 `json:"proxy"`
 	// By specifying a status host for each non-local connection you prevent Multisite from running into timeouts when remote sites do not respond.
-	StatusHost interface{} `json:"status_host,omitempty"`
+	StatusHost *StatusHostAttributes `json:"status_host,omitempty"`
 	// The URL prefix will be prepended to links of addons like NagVis when a link to such applications points to a host or service on that site.
 	// Example: /remote_1/
 	UrlPrefix string `json:"url_prefix,omitempty"`
@@ -4756,8 +8820,90 @@ type StatusHostAttributesSet struct {
 	StatusHostSet StatusHostAttributesSetStatusHostSet `json:"status_host_set"`
 }
 
-// StatusHostSet represents a CheckMK API type.
+// StatusHostSet is a union of StatusHostAttributesSet, StatusHostAttributesBase, selected by the "status_host_set" property.
 type StatusHostSet struct {
+	union json.RawMessage
+}
+
+// Discriminator returns the value of the "status_host_set" property.
+func (u StatusHostSet) Discriminator() (string, error) {
+	return unionDiscriminator(u.union, "status_host_set")
+}
+
+// AsStatusHostAttributesSet returns the union data as a StatusHostAttributesSet.
+func (u StatusHostSet) AsStatusHostAttributesSet() (StatusHostAttributesSet, error) {
+	var v StatusHostAttributesSet
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromStatusHostAttributesSet sets the union to v, with "status_host_set" set to "enabled".
+func (u *StatusHostSet) FromStatusHostAttributesSet(v StatusHostAttributesSet) error {
+	data, err := unionFrom(v, "status_host_set", "enabled")
+	if err != nil {
+		return fmt.Errorf("StatusHostSet: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsStatusHostAttributesBase returns the union data as a StatusHostAttributesBase.
+func (u StatusHostSet) AsStatusHostAttributesBase() (StatusHostAttributesBase, error) {
+	var v StatusHostAttributesBase
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromStatusHostAttributesBase sets the union to v, with "status_host_set" set to "disabled".
+func (u *StatusHostSet) FromStatusHostAttributesBase(v StatusHostAttributesBase) error {
+	data, err := unionFrom(v, "status_host_set", "disabled")
+	if err != nil {
+		return fmt.Errorf("StatusHostSet: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// ValueByDiscriminator decodes the union into the member selected by "status_host_set".
+func (u StatusHostSet) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := u.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "enabled":
+		return u.AsStatusHostAttributesSet()
+	case "disabled":
+		return u.AsStatusHostAttributesBase()
+	}
+	return nil, fmt.Errorf("StatusHostSet: unknown status_host_set %q", discriminator)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u StatusHostSet) MarshalJSON() ([]byte, error) {
+	if len(u.union) == 0 {
+		return []byte("null"), nil
+	}
+	return u.union, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting unknown "status_host_set" values.
+func (u *StatusHostSet) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		u.union = nil
+		return nil
+	}
+	discriminator, err := unionDiscriminator(data, "status_host_set")
+	if err != nil {
+		return fmt.Errorf("StatusHostSet: %w", err)
+	}
+	switch discriminator {
+	case "enabled", "disabled":
+	default:
+		return fmt.Errorf("StatusHostSet: unknown status_host_set %q", discriminator)
+	}
+	u.union = append(json.RawMessage(nil), data...)
+	return nil
 }
 
 // SysLogToFromPriorities represents a CheckMK API type.
@@ -4766,8 +8912,90 @@ type SysLogToFromPriorities struct {
 	ToPriority SysLogToFromPrioritiesToPriority `json:"to_priority"`
 }
 
-// TagCondition represents a CheckMK API type.
+// TagCondition is a union of TagConditionScalarSchemaBase, TagConditionConditionSchemaBase, selected by the "operator" property.
 type TagCondition struct {
+	union json.RawMessage
+}
+
+// Discriminator returns the value of the "operator" property.
+func (u TagCondition) Discriminator() (string, error) {
+	return unionDiscriminator(u.union, "operator")
+}
+
+// AsTagConditionScalarSchemaBase returns the union data as a TagConditionScalarSchemaBase.
+func (u TagCondition) AsTagConditionScalarSchemaBase() (TagConditionScalarSchemaBase, error) {
+	var v TagConditionScalarSchemaBase
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromTagConditionScalarSchemaBase sets the union to v, whose "operator" must be one of "is", "is_not".
+func (u *TagCondition) FromTagConditionScalarSchemaBase(v TagConditionScalarSchemaBase) error {
+	data, err := unionFrom(v, "operator", "is", "is_not")
+	if err != nil {
+		return fmt.Errorf("TagCondition: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsTagConditionConditionSchemaBase returns the union data as a TagConditionConditionSchemaBase.
+func (u TagCondition) AsTagConditionConditionSchemaBase() (TagConditionConditionSchemaBase, error) {
+	var v TagConditionConditionSchemaBase
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromTagConditionConditionSchemaBase sets the union to v, whose "operator" must be one of "none_of", "one_of".
+func (u *TagCondition) FromTagConditionConditionSchemaBase(v TagConditionConditionSchemaBase) error {
+	data, err := unionFrom(v, "operator", "none_of", "one_of")
+	if err != nil {
+		return fmt.Errorf("TagCondition: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// ValueByDiscriminator decodes the union into the member selected by "operator".
+func (u TagCondition) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := u.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "is", "is_not":
+		return u.AsTagConditionScalarSchemaBase()
+	case "none_of", "one_of":
+		return u.AsTagConditionConditionSchemaBase()
+	}
+	return nil, fmt.Errorf("TagCondition: unknown operator %q", discriminator)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u TagCondition) MarshalJSON() ([]byte, error) {
+	if len(u.union) == 0 {
+		return []byte("null"), nil
+	}
+	return u.union, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting unknown "operator" values.
+func (u *TagCondition) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		u.union = nil
+		return nil
+	}
+	discriminator, err := unionDiscriminator(data, "operator")
+	if err != nil {
+		return fmt.Errorf("TagCondition: %w", err)
+	}
+	switch discriminator {
+	case "is", "is_not", "none_of", "one_of":
+	default:
+		return fmt.Errorf("TagCondition: unknown operator %q", discriminator)
+	}
+	u.union = append(json.RawMessage(nil), data...)
+	return nil
 }
 
 // TagConditionConditionSchemaBase represents a CheckMK API type.
@@ -4816,13 +9044,13 @@ type TimeAllowedRange struct {
 type TimePeriodAttrsResponse struct {
 	// The days for which time ranges were specified
 	// Example: map[day:all time_ranges:[map[end:14:00 start:12:00]]]
-	ActiveTimeRanges []map[string]interface{} `json:"active_time_ranges,omitempty"`
+	ActiveTimeRanges []ConcreteTimeRangeActive `json:"active_time_ranges,omitempty"`
 	// The alias of the time period
 	// Example: alias
 	Alias string `json:"alias,omitempty"`
 	// Specific day exclusions with their list of time ranges
 	// Example: [map[date:2020-01-01 time_ranges:[map[end:18:00 start:14:00]]]]
-	Exceptions []map[string]interface{} `json:"exceptions,omitempty"`
+	Exceptions []ConcreteTimePeriodException `json:"exceptions,omitempty"`
 	// The collection of time period names whose periods are excluded
 	// Example: [time_period_1 time_period_2 time_period_3]
 	Exclude []string `json:"exclude,omitempty"`
@@ -4833,7 +9061,7 @@ type TimePeriodException struct {
 	// The date of the time period exception.8601 profile
 	// Example: 2020-01-01
 	Date string `json:"date"`
-	TimeRanges []map[string]interface{} `json:"time_ranges,omitempty"`
+	TimeRanges []TimeRange1 `json:"time_ranges,omitempty"`
 }
 
 // TimePeriodResponse represents a CheckMK API type.
@@ -4842,12 +9070,12 @@ type TimePeriodResponse struct {
 	DomainType interface{} `json:"domainType,omitempty"`
 	// The time period attributes.
 	// Example: map[active_time_ranges:[map[day:monday time_ranges:[map[end:15:00 start:12:00]]]] alias:holidays exceptions:[map[date:2023-01-01 time_ranges:[map[end:13:30 start:12:30]]]] exclude:[time_period_1 time_period_2 time_period_3]]
-	Extensions interface{} `json:"extensions,omitempty"`
+	Extensions *TimePeriodAttrsResponse `json:"extensions,omitempty"`
 	// The unique identifier for this time period.
 	// Example: time_period_name
 	Id string `json:"id,omitempty"`
 	// list of links to other resources.
-	Links []map[string]interface{} `json:"links"`
+	Links []Link `json:"links"`
 	// The container for external resources, like linked foreign objects or actions.
 	Members map[string]interface{} `json:"members,omitempty"`
 	// The time period name.
@@ -4864,12 +9092,12 @@ type TimePeriodResponseCollection struct {
 	// The name of this collection.
 	Id string `json:"id,omitempty"`
 	// list of links to other resources.
-	Links []map[string]interface{} `json:"links"`
+	Links []Link `json:"links"`
 	// A human readable title of this object. Can be used for user interfaces.
 	Title string `json:"title,omitempty"`
 	// A list of time period objects.
 	// Example: [map[domainType:time_period extensions:map[active_time_ranges:[map[day:monday time_ranges:[map[end:15:00 start:12:00]]]] alias:holidays exceptions:[map[date:2023-01-01 time_ranges:[map[end:13:30 start:12:30]]]] exclude:[time_period_1 time_period_2 time_period_3]] id:time_period links:[] members:m...
-	Value []map[string]interface{} `json:"value,omitempty"`
+	Value []TimePeriodResponse `json:"value,omitempty"`
 }
 
 // TimeRange represents a CheckMK API type.
@@ -4896,7 +9124,7 @@ type TimeRange1 struct {
 type TimeRangeActive struct {
 	// The day for which time ranges are to be specified. The 'all' option allows to specify time ranges for all days.
 	Day TimeRangeActiveDay `json:"day,omitempty"`
-	TimeRanges []map[string]interface{} `json:"time_ranges,omitempty"`
+	TimeRanges []TimeRange1 `json:"time_ranges,omitempty"`
 }
 
 // TranslateNames represents a CheckMK API type.
@@ -4906,9 +9134,9 @@ type TranslateNames struct {
 	// Drop the rest of the domain, only keep the hostname. Will not affect IP addresses. Examples: * `192.168.0.1` -> `192.168.0.1` * `foobar.example.com` -> `foobar` * `example.com` -> `example` * `example` -> `example` This will be executed **after**: * `convert_case`
 	DropDomain bool `json:"drop_domain,omitempty"`
 	// Replace one value with another. These will be executed **after**: * `convert_case` * `drop_domain` * `regexp_rewrites`
-	HostnameReplacement []map[string]interface{} `json:"hostname_replacement,omitempty"`
+	HostnameReplacement []DirectMapping `json:"hostname_replacement,omitempty"`
 	// Rewrite discovered hostnames with multiple regular expressions. The replacements will be done one after another in the order they appear in the list. If not anchored at the end by a `$` character, the regexpwill be anchored at the end implicitly by adding a `$` character. These will be executed *...
-	RegexpRewrites []map[string]interface{} `json:"regexp_rewrites,omitempty"`
+	RegexpRewrites []RegexpRewrites `json:"regexp_rewrites,omitempty"`
 }
 
 // UpdateAndAcknowledeEventSiteIDRequired represents a CheckMK API type.
@@ -4946,8 +9174,109 @@ type UpdateAndAcknowledgeFilter struct {
 	SiteId string `json:"site_id,omitempty"`
 }
 
-// UpdateAndAcknowledgeSelector represents a CheckMK API type.
+// UpdateAndAcknowledgeSelector is a union of UpdateAndAcknowledgeWithQuery, UpdateAndAcknowledgeWithParams, UpdateAndAcknowledgeFilter, selected by the "filter_type" property.
 type UpdateAndAcknowledgeSelector struct {
+	union json.RawMessage
+}
+
+// Discriminator returns the value of the "filter_type" property.
+func (u UpdateAndAcknowledgeSelector) Discriminator() (string, error) {
+	return unionDiscriminator(u.union, "filter_type")
+}
+
+// AsUpdateAndAcknowledgeWithQuery returns the union data as a UpdateAndAcknowledgeWithQuery.
+func (u UpdateAndAcknowledgeSelector) AsUpdateAndAcknowledgeWithQuery() (UpdateAndAcknowledgeWithQuery, error) {
+	var v UpdateAndAcknowledgeWithQuery
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromUpdateAndAcknowledgeWithQuery sets the union to v, with "filter_type" set to "query".
+func (u *UpdateAndAcknowledgeSelector) FromUpdateAndAcknowledgeWithQuery(v UpdateAndAcknowledgeWithQuery) error {
+	data, err := unionFrom(v, "filter_type", "query")
+	if err != nil {
+		return fmt.Errorf("UpdateAndAcknowledgeSelector: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsUpdateAndAcknowledgeWithParams returns the union data as a UpdateAndAcknowledgeWithParams.
+func (u UpdateAndAcknowledgeSelector) AsUpdateAndAcknowledgeWithParams() (UpdateAndAcknowledgeWithParams, error) {
+	var v UpdateAndAcknowledgeWithParams
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromUpdateAndAcknowledgeWithParams sets the union to v, with "filter_type" set to "params".
+func (u *UpdateAndAcknowledgeSelector) FromUpdateAndAcknowledgeWithParams(v UpdateAndAcknowledgeWithParams) error {
+	data, err := unionFrom(v, "filter_type", "params")
+	if err != nil {
+		return fmt.Errorf("UpdateAndAcknowledgeSelector: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsUpdateAndAcknowledgeFilter returns the union data as a UpdateAndAcknowledgeFilter.
+func (u UpdateAndAcknowledgeSelector) AsUpdateAndAcknowledgeFilter() (UpdateAndAcknowledgeFilter, error) {
+	var v UpdateAndAcknowledgeFilter
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromUpdateAndAcknowledgeFilter sets the union to v, with "filter_type" set to "all".
+func (u *UpdateAndAcknowledgeSelector) FromUpdateAndAcknowledgeFilter(v UpdateAndAcknowledgeFilter) error {
+	data, err := unionFrom(v, "filter_type", "all")
+	if err != nil {
+		return fmt.Errorf("UpdateAndAcknowledgeSelector: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// ValueByDiscriminator decodes the union into the member selected by "filter_type".
+func (u UpdateAndAcknowledgeSelector) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := u.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "query":
+		return u.AsUpdateAndAcknowledgeWithQuery()
+	case "params":
+		return u.AsUpdateAndAcknowledgeWithParams()
+	case "all":
+		return u.AsUpdateAndAcknowledgeFilter()
+	}
+	return nil, fmt.Errorf("UpdateAndAcknowledgeSelector: unknown filter_type %q", discriminator)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u UpdateAndAcknowledgeSelector) MarshalJSON() ([]byte, error) {
+	if len(u.union) == 0 {
+		return []byte("null"), nil
+	}
+	return u.union, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting unknown "filter_type" values.
+func (u *UpdateAndAcknowledgeSelector) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		u.union = nil
+		return nil
+	}
+	discriminator, err := unionDiscriminator(data, "filter_type")
+	if err != nil {
+		return fmt.Errorf("UpdateAndAcknowledgeSelector: %w", err)
+	}
+	switch discriminator {
+	case "query", "params", "all":
+	default:
+		return fmt.Errorf("UpdateAndAcknowledgeSelector: unknown filter_type %q", discriminator)
+	}
+	u.union = append(json.RawMessage(nil), data...)
+	return nil
 }
 
 // UpdateAndAcknowledgeWithParams represents a CheckMK API type.
@@ -4961,7 +9290,7 @@ type UpdateAndAcknowledgeWithParams struct {
 	// The way you would like to filter events.
 	// Example: all
 	FilterType UpdateAndAcknowledgeWithParamsFilterType `json:"filter_type"`
-	Filters map[string]interface{} `json:"filters"`
+	Filters FilterParamsUpdateAndAcknowledge `json:"filters"`
 	// To change the phase of an event
 	// Example: ack
 	Phase UpdateAndAcknowledgeWithParamsPhase `json:"phase,omitempty"`
@@ -4986,7 +9315,7 @@ type UpdateAndAcknowledgeWithQuery struct {
 	Phase UpdateAndAcknowledgeWithQueryPhase `json:"phase,omitempty"`
 	// An query expression of the Livestatus 'eventconsoleevents' table in nested dictionary form. If you want to use multiple expressions, nest them with the AND/OR operators.
 	// Example: {"op": "=", "left": "eventconsoleevents.event_host", "right": "test_host"}
-	Query interface{} `json:"query"`
+	Query Expr `json:"query"`
 	// An existing site id
 	// Example: heute
 	SiteId string `json:"site_id,omitempty"`
@@ -4994,7 +9323,7 @@ type UpdateAndAcknowledgeWithQuery struct {
 
 // UpdateContactGroup represents a CheckMK API type.
 type UpdateContactGroup struct {
-	Attributes map[string]interface{} `json:"attributes,omitempty"`
+	Attributes *UpdateGroup `json:"attributes,omitempty"`
 	// The name of the contact group.
 	// Example: OnCall
 	Name string `json:"name"`
@@ -5076,7 +9405,7 @@ type UpdateHostEntry struct {
 
 // UpdateHostGroup represents a CheckMK API type.
 type UpdateHostGroup struct {
-	Attributes map[string]interface{} `json:"attributes,omitempty"`
+	Attributes *UpdateGroup1 `json:"attributes,omitempty"`
 	// The name of the host group.
 	// Example: windows
 	Name string `json:"name"`
@@ -5092,7 +9421,7 @@ type UpdateHostTagGroup struct {
 	Repair bool `json:"repair,omitempty"`
 	// A list of host tags belonging to the host tag group
 	// Example: [map[ident:pod title:Pod]]
-	Tags []map[string]interface{} `json:"tags,omitempty"`
+	Tags []HostTag `json:"tags,omitempty"`
 	// A title for the host tag
 	// Example: Kubernetes
 	Title string `json:"title,omitempty"`
@@ -5134,10 +9463,10 @@ type UpdatePassword struct {
 type UpdateRuleObject struct {
 	// Conditions.
 	// Example: map[]
-	Conditions interface{} `json:"conditions,omitempty"`
+	Conditions *RuleConditions1 `json:"conditions,omitempty"`
 	// Configuration values for rules.
 	// Example: map[disabled:false]
-	Properties interface{} `json:"properties,omitempty"`
+	Properties *RuleProperties1 `json:"properties,omitempty"`
 	// The raw parameter value for this rule. To create the correct structure, for now use the 'export for API' menu item in the Rule Editor of the GUI. The value is expected to be a valid Python type.
 	// Example: {'cmk/os_family': 'linux'}
 	ValueRaw string `json:"value_raw,omitempty"`
@@ -5145,7 +9474,7 @@ type UpdateRuleObject struct {
 
 // UpdateServiceGroup represents a CheckMK API type.
 type UpdateServiceGroup struct {
-	Attributes map[string]interface{} `json:"attributes,omitempty"`
+	Attributes *UpdateGroup2 `json:"attributes,omitempty"`
 	// The name of the service group.
 	// Example: windows
 	Name string `json:"name"`
@@ -5155,13 +9484,13 @@ type UpdateServiceGroup struct {
 type UpdateTimePeriod struct {
 	// The list of active time ranges which replaces the existing list of time ranges
 	// Example: [map[day:monday time_ranges:[map[end:14:00:00 start:12:00:00]]]]
-	ActiveTimeRanges []map[string]interface{} `json:"active_time_ranges,omitempty"`
+	ActiveTimeRanges []TimeRangeActive `json:"active_time_ranges,omitempty"`
 	// An alias for the time period
 	// Example: new_alias
 	Alias string `json:"alias,omitempty"`
 	// A list of additional time ranges to be added.
 	// Example: [map[date:2020-01-01 time_ranges:[map[end:18:00:00 start:14:00:00]]]]
-	Exceptions []map[string]interface{} `json:"exceptions,omitempty"`
+	Exceptions []TimePeriodException `json:"exceptions,omitempty"`
 	// A list of time period names whose periods are excluded.
 	// Example: [time_name]
 	Exclude []string `json:"exclude,omitempty"`
@@ -5171,27 +9500,27 @@ type UpdateTimePeriod struct {
 type UpdateUser struct {
 	// Authentication option for the user
 	// Example: map[auth_type:password password:password]
-	AuthOption interface{} `json:"auth_option,omitempty"`
+	AuthOption *AuthUpdateOption `json:"auth_option,omitempty"`
 	// The names of the sites the user is authorized to handle. Specifying 'all' will grant the user access to all sites.
 	// Example: [heute]
 	AuthorizedSites []string `json:"authorized_sites,omitempty"`
 	// Contact settings for the user
 	// Example: map[email:user@example.com]
-	ContactOptions interface{} `json:"contact_options,omitempty"`
+	ContactOptions *UserContactOption `json:"contact_options,omitempty"`
 	// Assign the user to one or multiple contact groups. If no contact group is specified then no monitoring contact will be created for the user.
 	// Example: [all]
 	Contactgroups []string `json:"contactgroups,omitempty"`
 	// The user can be blocked from login but will remain part of the site. The disabling does not affect notification and alerts.
 	// Example: false
 	DisableLogin bool `json:"disable_login,omitempty"`
-	DisableNotifications interface{} `json:"disable_notifications,omitempty"`
+	DisableNotifications *DisabledNotifications `json:"disable_notifications,omitempty"`
 	// The alias or full name of the user
 	// Example: Mathias Kettner
 	Fullname string `json:"fullname,omitempty"`
 	// Idle timeout for the user
 	// Example: map[]
-	IdleTimeout interface{} `json:"idle_timeout,omitempty"`
-	InterfaceOptions interface{} `json:"interface_options,omitempty"`
+	IdleTimeout *IdleOption `json:"idle_timeout,omitempty"`
+	InterfaceOptions *UserInterfaceUpdateAttributes `json:"interface_options,omitempty"`
 	// Configure the language to be used by the user in the user interface. Omitting this will configure the default language
 	// Example: en
 	Language UpdateUserLanguage `json:"language,omitempty"`
@@ -5220,11 +9549,11 @@ type UserCollection struct {
 	// The name of this collection.
 	Id string `json:"id,omitempty"`
 	// list of links to other resources.
-	Links []map[string]interface{} `json:"links"`
+	Links []Link `json:"links"`
 	// A human readable title of this object. Can be used for user interfaces.
 	Title string `json:"title,omitempty"`
 	// A list of user objects.
-	Value []map[string]interface{} `json:"value,omitempty"`
+	Value []UserObject `json:"value,omitempty"`
 }
 
 // UserContactOption represents a CheckMK API type.
@@ -5284,7 +9613,7 @@ type UserObject struct {
 	// The unique identifier for this domain-object type.
 	Id string `json:"id,omitempty"`
 	// list of links to other resources.
-	Links []map[string]interface{} `json:"links"`
+	Links []Link `json:"links"`
 	// The container for external resources, like linked foreign objects or actions.
 	Members map[string]interface{} `json:"members,omitempty"`
 	// A human readable title of this object. Can be used for user interfaces.
@@ -5312,11 +9641,11 @@ type UserRoleCollection struct {
 	// The name of this collection.
 	Id string `json:"id,omitempty"`
 	// list of links to other resources.
-	Links []map[string]interface{} `json:"links"`
+	Links []Link `json:"links"`
 	// A human readable title of this object. Can be used for user interfaces.
 	Title string `json:"title,omitempty"`
 	// A list of user role objects.
-	Value []map[string]interface{} `json:"value,omitempty"`
+	Value []UserRoleObject `json:"value,omitempty"`
 }
 
 // UserRoleObject represents a CheckMK API type.
@@ -5324,19 +9653,101 @@ type UserRoleObject struct {
 	// The domain type of the object.
 	DomainType interface{} `json:"domainType,omitempty"`
 	// All the attributes of a user role.
-	Extensions interface{} `json:"extensions,omitempty"`
+	Extensions *UserRoleAttributes `json:"extensions,omitempty"`
 	// The unique identifier for this domain-object type.
 	Id string `json:"id,omitempty"`
 	// list of links to other resources.
-	Links []map[string]interface{} `json:"links"`
+	Links []Link `json:"links"`
 	// The container for external resources, like linked foreign objects or actions.
 	Members map[string]interface{} `json:"members,omitempty"`
 	// A human readable title of this object. Can be used for user interfaces.
 	Title string `json:"title,omitempty"`
 }
 
-// UserSyncAttributes represents a CheckMK API type.
+// UserSyncAttributes is a union of UserSyncWithLdapConnection, UserSyncBase, selected by the "sync_with_ldap_connections" property.
 type UserSyncAttributes struct {
+	union json.RawMessage
+}
+
+// Discriminator returns the value of the "sync_with_ldap_connections" property.
+func (u UserSyncAttributes) Discriminator() (string, error) {
+	return unionDiscriminator(u.union, "sync_with_ldap_connections")
+}
+
+// AsUserSyncWithLdapConnection returns the union data as a UserSyncWithLdapConnection.
+func (u UserSyncAttributes) AsUserSyncWithLdapConnection() (UserSyncWithLdapConnection, error) {
+	var v UserSyncWithLdapConnection
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromUserSyncWithLdapConnection sets the union to v, with "sync_with_ldap_connections" set to "ldap".
+func (u *UserSyncAttributes) FromUserSyncWithLdapConnection(v UserSyncWithLdapConnection) error {
+	data, err := unionFrom(v, "sync_with_ldap_connections", "ldap")
+	if err != nil {
+		return fmt.Errorf("UserSyncAttributes: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsUserSyncBase returns the union data as a UserSyncBase.
+func (u UserSyncAttributes) AsUserSyncBase() (UserSyncBase, error) {
+	var v UserSyncBase
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromUserSyncBase sets the union to v, whose "sync_with_ldap_connections" must be one of "all", "disabled".
+func (u *UserSyncAttributes) FromUserSyncBase(v UserSyncBase) error {
+	data, err := unionFrom(v, "sync_with_ldap_connections", "all", "disabled")
+	if err != nil {
+		return fmt.Errorf("UserSyncAttributes: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// ValueByDiscriminator decodes the union into the member selected by "sync_with_ldap_connections".
+func (u UserSyncAttributes) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := u.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "ldap":
+		return u.AsUserSyncWithLdapConnection()
+	case "all", "disabled":
+		return u.AsUserSyncBase()
+	}
+	return nil, fmt.Errorf("UserSyncAttributes: unknown sync_with_ldap_connections %q", discriminator)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u UserSyncAttributes) MarshalJSON() ([]byte, error) {
+	if len(u.union) == 0 {
+		return []byte("null"), nil
+	}
+	return u.union, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting unknown "sync_with_ldap_connections" values.
+func (u *UserSyncAttributes) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		u.union = nil
+		return nil
+	}
+	discriminator, err := unionDiscriminator(data, "sync_with_ldap_connections")
+	if err != nil {
+		return fmt.Errorf("UserSyncAttributes: %w", err)
+	}
+	switch discriminator {
+	case "ldap", "all", "disabled":
+	default:
+		return fmt.Errorf("UserSyncAttributes: unknown sync_with_ldap_connections %q", discriminator)
+	}
+	u.union = append(json.RawMessage(nil), data...)
+	return nil
 }
 
 // UserSyncAttributes1 represents a CheckMK API type.
@@ -5369,19 +9780,19 @@ type UserSyncWithLdapConnection struct {
 // VictoropsPluginCreate represents a CheckMK API type.
 type VictoropsPluginCreate struct {
 	// Ignore unverified HTTPS request warnings. Use with caution.
-	DisableSslCertVerification interface{} `json:"disable_ssl_cert_verification"`
+	DisableSslCertVerification Checkbox `json:"disable_ssl_cert_verification"`
 	// Use the proxy settings from the environment variables. The variables NO_PROXY, HTTP_PROXY and HTTPS_PROXY are taken into account during execution.
-	HttpProxy interface{} `json:"http_proxy"`
+	HttpProxy CheckboxOneOf `json:"http_proxy"`
 	// The plugin name.
 	// Example: mail
 	PluginName VictoropsPluginCreatePluginName `json:"plugin_name"`
-	SplunkOnCallRestEndpoint map[string]interface{} `json:"splunk_on_call_rest_endpoint"`
-	UrlPrefixForLinksToCheckmk map[string]interface{} `json:"url_prefix_for_links_to_checkmk"`
+	SplunkOnCallRestEndpoint SplunkRESTEndpointSelector `json:"splunk_on_call_rest_endpoint"`
+	UrlPrefixForLinksToCheckmk CheckboxOneOf `json:"url_prefix_for_links_to_checkmk"`
 }
 
 // WhenToBulk represents a CheckMK API type.
 type WhenToBulk struct {
-	Params map[string]interface{} `json:"params"`
+	Params NotificationBulking `json:"params"`
 	// Bulking can always happen or during a set time period
 	// Example: always
 	WhenToBulk WhenToBulkWhenToBulk `json:"when_to_bulk"`
@@ -5400,3 +9811,46 @@ type X509ReqPEMUUID struct {
 	Csr string `json:"csr"`
 }
 
+// unionDiscriminator reads a string discriminator property from a JSON object.
+func unionDiscriminator(data json.RawMessage, property string) (string, error) {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return "", err
+	}
+	raw, ok := fields[property]
+	if !ok {
+		return "", fmt.Errorf("missing discriminator %q", property)
+	}
+	var value string
+	if err := json.Unmarshal(raw, &value); err != nil {
+		return "", fmt.Errorf("discriminator %q: %w", property, err)
+	}
+	return value, nil
+}
+
+// unionFrom encodes a union member, setting its discriminator property.
+// A member selected by several values must already carry one of them.
+func unionFrom(v interface{}, property string, values ...string) (json.RawMessage, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+	if len(values) == 1 {
+		fields[property], _ = json.Marshal(values[0])
+		return json.Marshal(fields)
+	}
+	current, err := unionDiscriminator(data, property)
+	if err != nil {
+		return nil, err
+	}
+	for _, value := range values {
+		if current == value {
+			return data, nil
+		}
+	}
+	return nil, fmt.Errorf("discriminator %q must be one of %q, got %q", property, values, current)
+}
@@ -2,13 +2,15 @@
 
 // Code generated by openapi-gen from CheckMK 1.0. DO NOT EDIT.
 //
-// Field metadata including descriptions and types
+// Field metadata including descriptions, types and constraints
 //
 // Source: metadata.gen.go
 // Schemas: All (unfiltered)
 
 package p18
 
+import "github.com/BlackMesaLTD/checkmk-api-spec/generated/go/openapi"
+
 // FieldDescriptions maps schema names to field descriptions.
 // Use for generating documentation or user-facing error messages.
 var FieldDescriptions = map[string]map[string]string{
@@ -1934,7 +1936,7 @@ var FieldTypes = map[string]map[string]string{
 		"comment": "string",
 		"notify": "boolean",
 		"persistent": "boolean",
-		"query": "",
+		"query": "object",
 		"sticky": "boolean",
 	},
 	"AcknowledgeServiceGroupProblem": {
@@ -1950,7 +1952,7 @@ var FieldTypes = map[string]map[string]string{
 		"comment": "string",
 		"notify": "boolean",
 		"persistent": "boolean",
-		"query": "",
+		"query": "object",
 		"sticky": "boolean",
 	},
 	"AcknowledgeSpecificServiceProblem": {
@@ -1983,7 +1985,7 @@ var FieldTypes = map[string]map[string]string{
 	},
 	"ActivationRunResponse": {
 		"domainType": "",
-		"extensions": "",
+		"extensions": "object",
 		"id": "string",
 		"links": "array",
 		"members": "object",
@@ -2053,7 +2055,7 @@ var FieldTypes = map[string]map[string]string{
 	},
 	"AuxTagResponse": {
 		"domainType": "",
-		"extensions": "",
+		"extensions": "object",
 		"id": "string",
 		"links": "array",
 		"members": "object",
@@ -2074,13 +2076,13 @@ var FieldTypes = map[string]map[string]string{
 		"use_hard_states": "boolean",
 	},
 	"BIAggregationEndpoint": {
-		"aggregation_visualization": "",
+		"aggregation_visualization": "object",
 		"comment": "string",
-		"computation_options": "",
+		"computation_options": "object",
 		"customer": "string",
-		"groups": "",
+		"groups": "object",
 		"id": "string",
-		"node": "",
+		"node": "object",
 		"pack_id": "string",
 	},
 	"BIAggregationFunctionBest": {
@@ -2124,7 +2126,7 @@ var FieldTypes = map[string]map[string]string{
 		"type": "",
 	},
 	"BICallARuleAction": {
-		"params": "",
+		"params": "object",
 		"rule_id": "string",
 		"type": "",
 	},
@@ -2153,8 +2155,8 @@ var FieldTypes = map[string]map[string]string{
 		"type": "",
 	},
 	"BINodeGenerator": {
-		"action": "",
-		"search": "",
+		"action": "object",
+		"search": "object",
 	},
 	"BINodeVisBlockStyle": {
 		"style_config": "",
@@ -2198,14 +2200,14 @@ var FieldTypes = map[string]map[string]string{
 		"disabled": "boolean",
 	},
 	"BIRuleEndpoint": {
-		"aggregation_function": "",
-		"computation_options": "",
+		"aggregation_function": "object",
+		"computation_options": "object",
 		"id": "string",
-		"node_visualization": "",
+		"node_visualization": "object",
 		"nodes": "array",
 		"pack_id": "string",
-		"params": "",
-		"properties": "",
+		"params": "object",
+		"properties": "object",
 	},
 	"BIRuleProperties": {
 		"comment": "string",
@@ -2233,18 +2235,18 @@ var FieldTypes = map[string]map[string]string{
 	},
 	"BackgroundJobStatus": {
 		"active": "boolean",
-		"logs": "",
+		"logs": "object",
 		"state": "string",
 	},
 	"BaseUserAttributes": {
-		"auth_option": "",
+		"auth_option": "object",
 		"authorized_sites": "array",
-		"contact_options": "",
+		"contact_options": "object",
 		"contactgroups": "array",
 		"disable_login": "boolean",
 		"disable_notifications": "object",
 		"fullname": "string",
-		"idle_timeout": "",
+		"idle_timeout": "object",
 		"interface_options": "object",
 		"language": "string",
 		"pager_address": "string",
@@ -2292,7 +2294,7 @@ var FieldTypes = map[string]map[string]string{
 	},
 	"BulkHostActionWithFailedHosts": {
 		"detail": "string",
-		"ext": "",
+		"ext": "object",
 		"fields": "object",
 		"status": "integer",
 		"title": "string",
@@ -2346,7 +2348,7 @@ var FieldTypes = map[string]map[string]string{
 	"ChangeStateWithQuery": {
 		"filter_type": "string",
 		"new_state": "string",
-		"query": "",
+		"query": "object",
 		"site_id": "string",
 	},
 	"ChangesFields": {
@@ -2361,7 +2363,7 @@ var FieldTypes = map[string]map[string]string{
 	},
 	"CheckboxHostEventType": {
 		"state": "string",
-		"value": "",
+		"value": "object",
 	},
 	"CheckboxLabel": {
 		"key": "string",
@@ -2369,7 +2371,7 @@ var FieldTypes = map[string]map[string]string{
 	},
 	"CheckboxMatchHostTags": {
 		"state": "string",
-		"value": "",
+		"value": "object",
 	},
 	"CheckboxRestrictNotificationNumbers": {
 		"state": "string",
@@ -2377,7 +2379,7 @@ var FieldTypes = map[string]map[string]string{
 	},
 	"CheckboxServiceEventType": {
 		"state": "string",
-		"value": "",
+		"value": "object",
 	},
 	"CheckboxThrottlePeriodicNotifcations": {
 		"state": "string",
@@ -2389,7 +2391,7 @@ var FieldTypes = map[string]map[string]string{
 	},
 	"CheckboxWithFromToServiceLevels": {
 		"state": "string",
-		"value": "",
+		"value": "object",
 	},
 	"CheckboxWithListOfLabels": {
 		"state": "string",
@@ -2397,7 +2399,7 @@ var FieldTypes = map[string]map[string]string{
 	},
 	"CheckboxWithListOfServiceGroupsRegex": {
 		"state": "string",
-		"value": "",
+		"value": "object",
 	},
 	"CheckboxWithListOfStr": {
 		"state": "string",
@@ -2420,7 +2422,7 @@ var FieldTypes = map[string]map[string]string{
 	},
 	"ChildWith": {
 		"conditions": "object",
-		"host_choice": "",
+		"host_choice": "object",
 	},
 	"CiscoExplicitWebhookUrl": {
 		"option": "string",
@@ -2431,8 +2433,8 @@ var FieldTypes = map[string]map[string]string{
 		"store_id": "string",
 	},
 	"CiscoWebexPluginCreate": {
-		"disable_ssl_cert_verification": "",
-		"http_proxy": "",
+		"disable_ssl_cert_verification": "object",
+		"http_proxy": "object",
 		"plugin_name": "string",
 		"url_prefix_for_links_to_checkmk": "object",
 		"webhook_url": "object",
@@ -2441,21 +2443,21 @@ var FieldTypes = map[string]map[string]string{
 		"additional_ipv4addresses": "array",
 		"additional_ipv6addresses": "array",
 		"alias": "string",
-		"contactgroups": "",
+		"contactgroups": "object",
 		"inventory_failed": "boolean",
 		"ipaddress": "string",
 		"ipv6address": "string",
 		"labels": "object",
 		"locked_attributes": "array",
-		"locked_by": "",
+		"locked_by": "object",
 		"management_address": "string",
-		"management_ipmi_credentials": "",
+		"management_ipmi_credentials": "object",
 		"management_protocol": "string",
-		"management_snmp_community": "",
-		"network_scan": "",
+		"management_snmp_community": "object",
+		"network_scan": "object",
 		"parents": "array",
 		"site": "string",
-		"snmp_community": "",
+		"snmp_community": "object",
 		"tag_address_family": "string",
 		"tag_agent": "string",
 		"tag_criticality": "string",
@@ -2484,7 +2486,7 @@ var FieldTypes = map[string]map[string]string{
 	},
 	"CommentObject": {
 		"domainType": "",
-		"extensions": "",
+		"extensions": "object",
 		"id": "string",
 		"links": "array",
 		"members": "object",
@@ -2492,11 +2494,11 @@ var FieldTypes = map[string]map[string]string{
 	},
 	"ConcreteDisabledNotifications": {
 		"disable": "boolean",
-		"timerange": "",
+		"timerange": "object",
 	},
 	"ConcreteHostTagGroup": {
 		"domainType": "",
-		"extensions": "",
+		"extensions": "object",
 		"id": "string",
 		"links": "array",
 		"members": "object",
@@ -2560,7 +2562,7 @@ var FieldTypes = map[string]map[string]string{
 		"replicate_event_console": "boolean",
 		"replicate_extensions": "boolean",
 		"url_of_remote_site": "string",
-		"user_sync": "",
+		"user_sync": "object",
 	},
 	"ConfigurationConnectionAttributes1": {
 		"direct_login_to_web_gui_allowed": "boolean",
@@ -2570,7 +2572,7 @@ var FieldTypes = map[string]map[string]string{
 		"replicate_event_console": "boolean",
 		"replicate_extensions": "boolean",
 		"url_of_remote_site": "string",
-		"user_sync": "",
+		"user_sync": "object",
 	},
 	"ConnectionMode": {
 		"connection_mode": "string",
@@ -2656,14 +2658,14 @@ var FieldTypes = map[string]map[string]string{
 		"comment": "string",
 		"comment_type": "string",
 		"persistent": "boolean",
-		"query": "",
+		"query": "object",
 	},
 	"CreateHostQueryDowntime": {
 		"comment": "string",
 		"downtime_type": "string",
 		"duration": "integer",
 		"end_time": "string",
-		"query": "",
+		"query": "object",
 		"recur": "string",
 		"start_time": "string",
 	},
@@ -2697,14 +2699,14 @@ var FieldTypes = map[string]map[string]string{
 		"comment": "string",
 		"comment_type": "string",
 		"persistent": "boolean",
-		"query": "",
+		"query": "object",
 	},
 	"CreateServiceQueryDowntime": {
 		"comment": "string",
 		"downtime_type": "string",
 		"duration": "integer",
 		"end_time": "string",
-		"query": "",
+		"query": "object",
 		"recur": "string",
 		"start_time": "string",
 	},
@@ -2716,15 +2718,15 @@ var FieldTypes = map[string]map[string]string{
 		"name": "string",
 	},
 	"CreateUser": {
-		"auth_option": "",
+		"auth_option": "object",
 		"authorized_sites": "array",
-		"contact_options": "",
+		"contact_options": "object",
 		"contactgroups": "array",
 		"disable_login": "boolean",
-		"disable_notifications": "",
+		"disable_notifications": "object",
 		"fullname": "string",
-		"idle_timeout": "",
-		"interface_options": "",
+		"idle_timeout": "object",
+		"interface_options": "object",
 		"language": "string",
 		"pager_address": "string",
 		"roles": "array",
@@ -2768,7 +2770,7 @@ var FieldTypes = map[string]map[string]string{
 	},
 	"DeleteCommentsByQuery": {
 		"delete_type": "string",
-		"query": "",
+		"query": "object",
 		"site_id": "string",
 	},
 	"DeleteDowntimeById": {
@@ -2783,7 +2785,7 @@ var FieldTypes = map[string]map[string]string{
 	},
 	"DeleteDowntimeByQuery": {
 		"delete_type": "string",
-		"query": "",
+		"query": "object",
 	},
 	"DirectMapping": {
 		"hostname": "string",
@@ -2791,7 +2793,7 @@ var FieldTypes = map[string]map[string]string{
 	},
 	"DisabledNotifications": {
 		"disable": "boolean",
-		"timerange": "",
+		"timerange": "object",
 	},
 	"DiscoverServices": {
 		"host_name": "string",
@@ -2802,7 +2804,7 @@ var FieldTypes = map[string]map[string]string{
 	},
 	"DiscoveryBackgroundJobStatusObject": {
 		"domainType": "",
-		"extensions": "",
+		"extensions": "object",
 		"id": "string",
 		"links": "array",
 		"members": "object",
@@ -2844,7 +2846,7 @@ var FieldTypes = map[string]map[string]string{
 	},
 	"DowntimeObject": {
 		"domainType": "",
-		"extensions": "",
+		"extensions": "object",
 		"id": "string",
 		"links": "array",
 		"members": "object",
@@ -2870,7 +2872,7 @@ var FieldTypes = map[string]map[string]string{
 	},
 	"ECEventResponse": {
 		"domainType": "",
-		"extensions": "",
+		"extensions": "object",
 		"id": "string",
 		"links": "array",
 		"members": "object",
@@ -2907,7 +2909,7 @@ var FieldTypes = map[string]map[string]string{
 	},
 	"FailedHosts": {
 		"failed_hosts": "object",
-		"succeeded_hosts": "",
+		"succeeded_hosts": "object",
 	},
 	"FilterById": {
 		"event_id": "integer",
@@ -2921,7 +2923,7 @@ var FieldTypes = map[string]map[string]string{
 	},
 	"FilterByQuery": {
 		"filter_type": "string",
-		"query": "",
+		"query": "object",
 		"site_id": "string",
 	},
 	"FilterParams": {
@@ -2937,10 +2939,10 @@ var FieldTypes = map[string]map[string]string{
 	},
 	"Folder": {
 		"domainType": "",
-		"extensions": "",
+		"extensions": "object",
 		"id": "string",
 		"links": "array",
-		"members": "",
+		"members": "object",
 		"title": "string",
 	},
 	"FolderCollection": {
@@ -2952,15 +2954,15 @@ var FieldTypes = map[string]map[string]string{
 		"value": "array",
 	},
 	"FolderCreateAttribute": {
-		"contactgroups": "",
+		"contactgroups": "object",
 		"labels": "object",
-		"management_ipmi_credentials": "",
+		"management_ipmi_credentials": "object",
 		"management_protocol": "string",
-		"management_snmp_community": "",
-		"network_scan": "",
+		"management_snmp_community": "object",
+		"network_scan": "object",
 		"parents": "array",
 		"site": "string",
-		"snmp_community": "",
+		"snmp_community": "object",
 		"tag_address_family": "string",
 		"tag_agent": "string",
 		"tag_criticality": "string",
@@ -2973,19 +2975,19 @@ var FieldTypes = map[string]map[string]string{
 		"path": "string",
 	},
 	"FolderMembers": {
-		"hosts": "",
-		"move": "",
+		"hosts": "object",
+		"move": "object",
 	},
 	"FolderUpdateAttribute": {
-		"contactgroups": "",
+		"contactgroups": "object",
 		"labels": "object",
-		"management_ipmi_credentials": "",
+		"management_ipmi_credentials": "object",
 		"management_protocol": "string",
-		"management_snmp_community": "",
-		"network_scan": "",
+		"management_snmp_community": "object",
+		"network_scan": "object",
 		"parents": "array",
 		"site": "string",
-		"snmp_community": "",
+		"snmp_community": "object",
 		"tag_address_family": "string",
 		"tag_agent": "string",
 		"tag_criticality": "string",
@@ -2994,17 +2996,17 @@ var FieldTypes = map[string]map[string]string{
 		"tag_snmp_ds": "string",
 	},
 	"FolderViewAttribute": {
-		"contactgroups": "",
+		"contactgroups": "object",
 		"labels": "object",
-		"management_ipmi_credentials": "",
+		"management_ipmi_credentials": "object",
 		"management_protocol": "string",
-		"management_snmp_community": "",
-		"meta_data": "",
-		"network_scan": "",
-		"network_scan_result": "",
+		"management_snmp_community": "object",
+		"meta_data": "object",
+		"network_scan": "object",
+		"network_scan_result": "object",
 		"parents": "array",
 		"site": "string",
-		"snmp_community": "",
+		"snmp_community": "object",
 		"tag_address_family": "string",
 		"tag_agent": "string",
 		"tag_criticality": "string",
@@ -3014,7 +3016,7 @@ var FieldTypes = map[string]map[string]string{
 	},
 	"FromEmailAndNameCheckbox": {
 		"state": "string",
-		"value": "",
+		"value": "object",
 	},
 	"FromToNotificationNumbers": {
 		"beginning_from": "integer",
@@ -3030,7 +3032,7 @@ var FieldTypes = map[string]map[string]string{
 		"reduce": "string",
 		"service_description": "string",
 		"site": "string",
-		"time_range": "",
+		"time_range": "object",
 		"type": "string",
 	},
 	"GetMetric": {
@@ -3039,13 +3041,13 @@ var FieldTypes = map[string]map[string]string{
 		"reduce": "string",
 		"service_description": "string",
 		"site": "string",
-		"time_range": "",
+		"time_range": "object",
 		"type": "string",
 	},
 	"GraphCollection": {
 		"metrics": "array",
 		"step": "integer",
-		"time_range": "",
+		"time_range": "object",
 	},
 	"HTMLMailPluginCreate": {
 		"bulk_notifications_with_graphs": "object",
@@ -3075,17 +3077,17 @@ var FieldTypes = map[string]map[string]string{
 		"type": "",
 	},
 	"HostConditions": {
-		"host_choice": "",
+		"host_choice": "object",
 		"host_folder": "string",
 		"host_labels": "object",
 		"host_tags": "object",
 	},
 	"HostConfig": {
 		"domainType": "",
-		"extensions": "",
+		"extensions": "object",
 		"id": "string",
 		"links": "array",
-		"members": "",
+		"members": "object",
 		"title": "string",
 	},
 	"HostConfigCollection": {
@@ -3111,21 +3113,21 @@ var FieldTypes = map[string]map[string]string{
 		"additional_ipv4addresses": "array",
 		"additional_ipv6addresses": "array",
 		"alias": "string",
-		"contactgroups": "",
+		"contactgroups": "object",
 		"inventory_failed": "boolean",
 		"ipaddress": "string",
 		"ipv6address": "string",
 		"labels": "object",
 		"locked_attributes": "array",
-		"locked_by": "",
+		"locked_by": "object",
 		"management_address": "string",
-		"management_ipmi_credentials": "",
+		"management_ipmi_credentials": "object",
 		"management_protocol": "string",
-		"management_snmp_community": "",
-		"network_scan": "",
+		"management_snmp_community": "object",
+		"network_scan": "object",
 		"parents": "array",
 		"site": "string",
-		"snmp_community": "",
+		"snmp_community": "object",
 		"tag_address_family": "string",
 		"tag_agent": "string",
 		"tag_criticality": "string",
@@ -3152,7 +3154,7 @@ var FieldTypes = map[string]map[string]string{
 	"HostExtensions": {
 		"attributes": "",
 		"cluster_nodes": "array",
-		"effective_attributes": "",
+		"effective_attributes": "object",
 		"folder": "string",
 		"is_cluster": "boolean",
 		"is_offline": "boolean",
@@ -3161,23 +3163,23 @@ var FieldTypes = map[string]map[string]string{
 		"additional_ipv4addresses": "array",
 		"additional_ipv6addresses": "array",
 		"alias": "string",
-		"contactgroups": "",
+		"contactgroups": "object",
 		"inventory_failed": "boolean",
 		"ipaddress": "string",
 		"ipv6address": "string",
 		"labels": "object",
 		"locked_attributes": "array",
-		"locked_by": "",
+		"locked_by": "object",
 		"management_address": "string",
-		"management_ipmi_credentials": "",
+		"management_ipmi_credentials": "object",
 		"management_protocol": "string",
-		"management_snmp_community": "",
-		"meta_data": "",
-		"network_scan": "",
-		"network_scan_result": "",
+		"management_snmp_community": "object",
+		"meta_data": "object",
+		"network_scan": "object",
+		"network_scan_result": "object",
 		"parents": "array",
 		"site": "string",
-		"snmp_community": "",
+		"snmp_community": "object",
 		"tag_address_family": "string",
 		"tag_agent": "string",
 		"tag_criticality": "string",
@@ -3202,7 +3204,7 @@ var FieldTypes = map[string]map[string]string{
 		"value": "array",
 	},
 	"HostMembers": {
-		"folder_config": "",
+		"folder_config": "object",
 	},
 	"HostOrServiceCondition": {
 		"match_on": "array",
@@ -3249,21 +3251,21 @@ var FieldTypes = map[string]map[string]string{
 		"additional_ipv4addresses": "array",
 		"additional_ipv6addresses": "array",
 		"alias": "string",
-		"contactgroups": "",
+		"contactgroups": "object",
 		"inventory_failed": "boolean",
 		"ipaddress": "string",
 		"ipv6address": "string",
 		"labels": "object",
 		"locked_attributes": "array",
-		"locked_by": "",
+		"locked_by": "object",
 		"management_address": "string",
-		"management_ipmi_credentials": "",
+		"management_ipmi_credentials": "object",
 		"management_protocol": "string",
-		"management_snmp_community": "",
-		"network_scan": "",
+		"management_snmp_community": "object",
+		"network_scan": "object",
 		"parents": "array",
 		"site": "string",
-		"snmp_community": "",
+		"snmp_community": "object",
 		"tag_address_family": "string",
 		"tag_agent": "string",
 		"tag_criticality": "string",
@@ -3275,23 +3277,23 @@ var FieldTypes = map[string]map[string]string{
 		"additional_ipv4addresses": "array",
 		"additional_ipv6addresses": "array",
 		"alias": "string",
-		"contactgroups": "",
+		"contactgroups": "object",
 		"inventory_failed": "boolean",
 		"ipaddress": "string",
 		"ipv6address": "string",
 		"labels": "object",
 		"locked_attributes": "array",
-		"locked_by": "",
+		"locked_by": "object",
 		"management_address": "string",
-		"management_ipmi_credentials": "",
+		"management_ipmi_credentials": "object",
 		"management_protocol": "string",
-		"management_snmp_community": "",
-		"meta_data": "",
-		"network_scan": "",
-		"network_scan_result": "",
+		"management_snmp_community": "object",
+		"meta_data": "object",
+		"network_scan": "object",
+		"network_scan_result": "object",
 		"parents": "array",
 		"site": "string",
-		"snmp_community": "",
+		"snmp_community": "object",
 		"tag_address_family": "string",
 		"tag_agent": "string",
 		"tag_criticality": "string",
@@ -3336,8 +3338,8 @@ var FieldTypes = map[string]map[string]string{
 		"api_key": "object",
 		"custom_summary_for_host_alerts": "string",
 		"custom_summary_for_service_alerts": "string",
-		"disable_ssl_cert_verification": "",
-		"http_proxy": "",
+		"disable_ssl_cert_verification": "object",
+		"http_proxy": "object",
 		"notification_priority": "string",
 		"plugin_name": "string",
 		"url_prefix_for_links_to_checkmk": "object",
@@ -3379,9 +3381,9 @@ var FieldTypes = map[string]map[string]string{
 		"title": "string",
 	},
 	"InputRuleObject": {
-		"conditions": "",
+		"conditions": "object",
 		"folder": "string",
-		"properties": "",
+		"properties": "object",
 		"ruleset": "string",
 		"value_raw": "string",
 	},
@@ -3398,22 +3400,22 @@ var FieldTypes = map[string]map[string]string{
 		"versions": "object",
 	},
 	"JiraPluginCreate": {
-		"disable_ssl_cert_verification": "",
+		"disable_ssl_cert_verification": "object",
 		"host_custom_id": "string",
-		"host_summary": "",
+		"host_summary": "object",
 		"issue_type_id": "string",
 		"jira_url": "string",
-		"label": "",
+		"label": "object",
 		"monitoring_url": "string",
-		"optional_timeout": "",
+		"optional_timeout": "object",
 		"password": "string",
 		"plugin_name": "string",
-		"priority_id": "",
+		"priority_id": "object",
 		"project_id": "string",
-		"resolution_id": "",
+		"resolution_id": "object",
 		"service_custom_id": "string",
-		"service_summary": "",
-		"site_custom_id": "",
+		"service_summary": "object",
+		"site_custom_id": "object",
 		"username": "string",
 	},
 	"JobLogs": {
@@ -3451,15 +3453,15 @@ var FieldTypes = map[string]map[string]string{
 		"url": "string",
 	},
 	"MSTeamsPluginCreate": {
-		"affected_host_groups": "",
-		"host_details": "",
-		"host_summary": "",
-		"host_title": "",
-		"http_proxy": "",
+		"affected_host_groups": "object",
+		"host_details": "object",
+		"host_summary": "object",
+		"host_title": "object",
+		"http_proxy": "object",
 		"plugin_name": "string",
-		"service_details": "",
-		"service_summary": "",
-		"service_title": "",
+		"service_details": "object",
+		"service_summary": "object",
+		"service_title": "object",
 		"url_prefix_for_links_to_checkmk": "object",
 		"webhook_url": "object",
 	},
@@ -3532,7 +3534,7 @@ var FieldTypes = map[string]map[string]string{
 		"state": "string",
 	},
 	"NotExpr": {
-		"expr": "",
+		"expr": "object",
 		"op": "string",
 	},
 	"NotificationBulking": {
@@ -3571,11 +3573,11 @@ var FieldTypes = map[string]map[string]string{
 		"rule_config": "object",
 	},
 	"NotificationRuleRequest": {
-		"rule_config": "",
+		"rule_config": "object",
 	},
 	"NotificationRuleResponse": {
 		"domainType": "",
-		"extensions": "",
+		"extensions": "object",
 		"id": "string",
 		"links": "array",
 		"members": "object",
@@ -3622,23 +3624,23 @@ var FieldTypes = map[string]map[string]string{
 		"option": "string",
 	},
 	"OpsGeniePluginCreate": {
-		"actions": "",
+		"actions": "object",
 		"api_key": "object",
-		"desc_for_host_alerts": "",
-		"desc_for_service_alerts": "",
-		"domain": "",
-		"entity": "",
-		"http_proxy": "",
-		"message_for_host_alerts": "",
-		"message_for_service_alerts": "",
-		"note_while_closing": "",
-		"note_while_creating": "",
-		"owner": "",
+		"desc_for_host_alerts": "object",
+		"desc_for_service_alerts": "object",
+		"domain": "object",
+		"entity": "object",
+		"http_proxy": "object",
+		"message_for_host_alerts": "object",
+		"message_for_service_alerts": "object",
+		"note_while_closing": "object",
+		"note_while_creating": "object",
+		"owner": "object",
 		"plugin_name": "string",
 		"priority": "object",
-		"responsible_teams": "",
-		"source": "",
-		"tags": "",
+		"responsible_teams": "object",
+		"source": "object",
+		"tags": "object",
 	},
 	"OpsGenieStoreID": {
 		"option": "string",
@@ -3653,8 +3655,8 @@ var FieldTypes = map[string]map[string]string{
 		"option": "string",
 	},
 	"PagerDutyPluginCreate": {
-		"disable_ssl_cert_verification": "",
-		"http_proxy": "",
+		"disable_ssl_cert_verification": "object",
+		"http_proxy": "object",
 		"integration_key": "object",
 		"plugin_name": "string",
 		"url_prefix_for_links_to_checkmk": "object",
@@ -3680,7 +3682,7 @@ var FieldTypes = map[string]map[string]string{
 	},
 	"PasswordObject": {
 		"domainType": "",
-		"extensions": "",
+		"extensions": "object",
 		"id": "string",
 		"links": "array",
 		"members": "object",
@@ -3708,14 +3710,14 @@ var FieldTypes = map[string]map[string]string{
 	},
 	"ProxyAttributes": {
 		"global_settings": "boolean",
-		"params": "",
-		"tcp": "",
+		"params": "object",
+		"tcp": "object",
 		"use_livestatus_daemon": "string",
 	},
 	"ProxyAttributes1": {
 		"global_settings": "boolean",
-		"params": "",
-		"tcp": "",
+		"params": "object",
+		"tcp": "object",
 		"use_livestatus_daemon": "string",
 	},
 	"ProxyParams": {
@@ -3723,7 +3725,7 @@ var FieldTypes = map[string]map[string]string{
 		"channel_timeout": "number",
 		"channels": "integer",
 		"connect_retry": "number",
-		"heartbeat": "",
+		"heartbeat": "object",
 		"query_timeout": "number",
 	},
 	"ProxyParams1": {
@@ -3731,7 +3733,7 @@ var FieldTypes = map[string]map[string]string{
 		"channel_timeout": "number",
 		"channels": "integer",
 		"connect_retry": "number",
-		"heartbeat": "",
+		"heartbeat": "object",
 		"query_timeout": "number",
 	},
 	"ProxyTcp": {
@@ -3746,11 +3748,11 @@ var FieldTypes = map[string]map[string]string{
 	},
 	"PushOverPluginCreate": {
 		"api_key": "string",
-		"http_proxy": "",
+		"http_proxy": "object",
 		"plugin_name": "string",
 		"priority": "object",
 		"sound": "object",
-		"url_prefix_for_links_to_checkmk": "",
+		"url_prefix_for_links_to_checkmk": "object",
 		"user_group_key": "string",
 	},
 	"RegexpRewrites": {
@@ -3800,16 +3802,16 @@ var FieldTypes = map[string]map[string]string{
 	},
 	"RuleConditions1": {
 		"host_labels": "array",
-		"host_name": "",
+		"host_name": "object",
 		"host_tags": "array",
-		"service_description": "",
+		"service_description": "object",
 		"service_labels": "array",
 	},
 	"RuleExtensions": {
-		"conditions": "",
+		"conditions": "object",
 		"folder": "string",
 		"folder_index": "integer",
-		"properties": "",
+		"properties": "object",
 		"ruleset": "string",
 		"value_raw": "string",
 	},
@@ -3825,17 +3827,17 @@ var FieldTypes = map[string]map[string]string{
 	},
 	"RuleObject": {
 		"domainType": "",
-		"extensions": "",
+		"extensions": "object",
 		"id": "string",
 		"links": "array",
 		"members": "object",
 		"title": "string",
 	},
 	"RuleProperties": {
-		"allow_users_to_deactivate": "",
+		"allow_users_to_deactivate": "object",
 		"comment": "string",
 		"description": "string",
-		"do_not_apply_this_rule": "",
+		"do_not_apply_this_rule": "object",
 		"documentation_url": "string",
 	},
 	"RuleProperties1": {
@@ -3845,10 +3847,10 @@ var FieldTypes = map[string]map[string]string{
 		"documentation_url": "string",
 	},
 	"RulePropertiesAttributes": {
-		"allow_users_to_deactivate": "",
+		"allow_users_to_deactivate": "object",
 		"comment": "string",
 		"description": "string",
-		"do_not_apply_this_rule": "",
+		"do_not_apply_this_rule": "object",
 		"documentation_url": "string",
 	},
 	"RulesetCollection": {
@@ -3866,7 +3868,7 @@ var FieldTypes = map[string]map[string]string{
 	},
 	"RulesetObject": {
 		"domainType": "",
-		"extensions": "",
+		"extensions": "object",
 		"id": "string",
 		"links": "array",
 		"members": "object",
@@ -3881,8 +3883,8 @@ var FieldTypes = map[string]map[string]string{
 		"store_id": "string",
 	},
 	"SMSAPIPluginCreate": {
-		"disable_ssl_cert_verification": "",
-		"http_proxy": "",
+		"disable_ssl_cert_verification": "object",
+		"http_proxy": "object",
 		"modem_type": "string",
 		"modem_url": "string",
 		"plugin_name": "string",
@@ -3917,7 +3919,7 @@ var FieldTypes = map[string]map[string]string{
 		"type": "",
 	},
 	"ServiceConditions": {
-		"host_choice": "",
+		"host_choice": "object",
 		"host_folder": "string",
 		"host_labels": "object",
 		"host_tags": "object",
@@ -3977,7 +3979,7 @@ var FieldTypes = map[string]map[string]string{
 		"store_id": "string",
 	},
 	"ServiceNowPluginCreate": {
-		"http_proxy": "",
+		"http_proxy": "object",
 		"management_type": "object",
 		"optional_timeout": "object",
 		"plugin_name": "string",
@@ -3995,8 +3997,8 @@ var FieldTypes = map[string]map[string]string{
 		"store_id": "string",
 	},
 	"Signl4PluginCreate": {
-		"disable_ssl_cert_verification": "",
-		"http_proxy": "",
+		"disable_ssl_cert_verification": "object",
+		"http_proxy": "object",
 		"plugin_name": "string",
 		"team_secret": "object",
 		"url_prefix_for_links_to_checkmk": "object",
@@ -4020,14 +4022,14 @@ var FieldTypes = map[string]map[string]string{
 		"status_connection": "object",
 	},
 	"SiteConnectionRequestCreate": {
-		"site_config": "",
+		"site_config": "object",
 	},
 	"SiteConnectionRequestUpdate": {
-		"site_config": "",
+		"site_config": "object",
 	},
 	"SiteConnectionResponse": {
 		"domainType": "",
-		"extensions": "",
+		"extensions": "object",
 		"id": "string",
 		"links": "array",
 		"members": "object",
@@ -4046,8 +4048,8 @@ var FieldTypes = map[string]map[string]string{
 		"username": "string",
 	},
 	"SlackPluginCreate": {
-		"disable_ssl_cert_verification": "",
-		"http_proxy": "",
+		"disable_ssl_cert_verification": "object",
+		"http_proxy": "object",
 		"plugin_name": "string",
 		"url_prefix_for_links_to_checkmk": "object",
 		"webhook_url": "object",
@@ -4105,20 +4107,20 @@ var FieldTypes = map[string]map[string]string{
 	},
 	"StatusConnectionAttributes": {
 		"connect_timeout": "integer",
-		"connection": "",
+		"connection": "object",
 		"disable_in_status_gui": "boolean",
 		"persistent_connection": "boolean",
-		"proxy": "",
-		"status_host": "",
+		"proxy": "object",
+		"status_host": "object",
 		"url_prefix": "string",
 	},
 	"StatusConnectionAttributes1": {
 		"connect_timeout": "integer",
-		"connection": "",
+		"connection": "object",
 		"disable_in_status_gui": "boolean",
 		"persistent_connection": "boolean",
-		"proxy": "",
-		"status_host": "",
+		"proxy": "object",
+		"status_host": "object",
 		"url_prefix": "string",
 	},
 	"StatusHostAttributes": {
@@ -4168,7 +4170,7 @@ var FieldTypes = map[string]map[string]string{
 	},
 	"TimePeriodResponse": {
 		"domainType": "",
-		"extensions": "",
+		"extensions": "object",
 		"id": "string",
 		"links": "array",
 		"members": "object",
@@ -4226,7 +4228,7 @@ var FieldTypes = map[string]map[string]string{
 		"change_contact": "string",
 		"filter_type": "string",
 		"phase": "string",
-		"query": "",
+		"query": "object",
 		"site_id": "string",
 	},
 	"UpdateContactGroup": {
@@ -4286,8 +4288,8 @@ var FieldTypes = map[string]map[string]string{
 		"title": "string",
 	},
 	"UpdateRuleObject": {
-		"conditions": "",
-		"properties": "",
+		"conditions": "object",
+		"properties": "object",
 		"value_raw": "string",
 	},
 	"UpdateServiceGroup": {
@@ -4301,15 +4303,15 @@ var FieldTypes = map[string]map[string]string{
 		"exclude": "array",
 	},
 	"UpdateUser": {
-		"auth_option": "",
+		"auth_option": "object",
 		"authorized_sites": "array",
-		"contact_options": "",
+		"contact_options": "object",
 		"contactgroups": "array",
 		"disable_login": "boolean",
-		"disable_notifications": "",
+		"disable_notifications": "object",
 		"fullname": "string",
-		"idle_timeout": "",
-		"interface_options": "",
+		"idle_timeout": "object",
+		"interface_options": "object",
 		"language": "string",
 		"pager_address": "string",
 		"roles": "array",
@@ -4372,7 +4374,7 @@ var FieldTypes = map[string]map[string]string{
 	},
 	"UserRoleObject": {
 		"domainType": "",
-		"extensions": "",
+		"extensions": "object",
 		"id": "string",
 		"links": "array",
 		"members": "object",
@@ -4390,8 +4392,8 @@ var FieldTypes = map[string]map[string]string{
 		"sync_with_ldap_connections": "string",
 	},
 	"VictoropsPluginCreate": {
-		"disable_ssl_cert_verification": "",
-		"http_proxy": "",
+		"disable_ssl_cert_verification": "object",
+		"http_proxy": "object",
 		"plugin_name": "string",
 		"splunk_on_call_rest_endpoint": "object",
 		"url_prefix_for_links_to_checkmk": "object",
@@ -4408,6 +4410,643 @@ var FieldTypes = map[string]map[string]string{
 	},
 }
 
+// FieldConstraints maps schema names to the validation keywords and
+// defaults of their fields. Fields without any are omitted.
+// Use GetFieldConstraints() for safe lookup.
+var FieldConstraints = map[string]map[string]*openapi.FieldConstraints{
+	"AcknowledgeHostGroupProblem": {
+		"notify": {Default: true},
+		"persistent": {Default: false},
+		"sticky": {Default: true},
+	},
+	"AcknowledgeHostProblem": {
+		"host_name": {Pattern: "^[-0-9a-zA-Z_.]+\\Z"},
+		"notify": {Default: true},
+		"persistent": {Default: false},
+		"sticky": {Default: true},
+	},
+	"AcknowledgeHostQueryProblem": {
+		"notify": {Default: true},
+		"persistent": {Default: false},
+		"sticky": {Default: true},
+	},
+	"AcknowledgeServiceGroupProblem": {
+		"notify": {Default: true},
+		"persistent": {Default: false},
+		"sticky": {Default: true},
+	},
+	"AcknowledgeServiceQueryProblem": {
+		"notify": {Default: true},
+		"persistent": {Default: false},
+		"sticky": {Default: true},
+	},
+	"AcknowledgeSpecificServiceProblem": {
+		"host_name": {Pattern: "^[-0-9a-zA-Z_.]+\\Z"},
+		"notify": {Default: true},
+		"persistent": {Default: false},
+		"sticky": {Default: true},
+	},
+	"ActivateChanges": {
+		"force_foreign_changes": {Default: false},
+		"redirect": {Default: false},
+	},
+	"ActivationExtensionFields": {
+		"time_started": {Format: "date-time"},
+	},
+	"ActivationRunCollection": {
+		"domainType": {Default: "activation_run"},
+	},
+	"ActivationRunResponse": {
+		"domainType": {Default: "activation_run"},
+		"id": {Format: "uuid"},
+	},
+	"AuthPassword": {
+		"enforce_password_change": {Default: false},
+		"password": {MinLength: openapi.Int(1)},
+	},
+	"AuthUpdatePassword": {
+		"password": {MinLength: openapi.Int(1)},
+	},
+	"AuxTagAttrsCreate": {
+		"aux_tag_id": {Pattern: "^[-0-9a-zA-Z_]+\\Z"},
+		"help": {Default: ""},
+		"title": {MinLength: openapi.Int(1)},
+		"topic": {MinLength: openapi.Int(1)},
+	},
+	"AuxTagAttrsResponse": {
+		"topic": {MinLength: openapi.Int(1)},
+	},
+	"AuxTagAttrsUpdate": {
+		"title": {MinLength: openapi.Int(1)},
+		"topic": {MinLength: openapi.Int(1)},
+	},
+	"AuxTagResponse": {
+		"domainType": {Default: "aux_tag"},
+	},
+	"AuxTagResponseCollection": {
+		"domainType": {Default: "aux_tag"},
+		"id": {Default: "all"},
+	},
+	"BIAggregationFunctionBest": {
+		"type": {Default: "best"},
+	},
+	"BIAggregationFunctionCountOK": {
+		"type": {Default: "count_ok"},
+	},
+	"BIAggregationFunctionWorst": {
+		"type": {Default: "worst"},
+	},
+	"BIAllHostsChoice": {
+		"type": {Default: "all_hosts"},
+	},
+	"BICallARuleAction": {
+		"type": {Default: "call_a_rule"},
+	},
+	"BIEmptySearch": {
+		"type": {Default: "empty"},
+	},
+	"BIFixedArgumentsSearch": {
+		"type": {Default: "fixed_arguments"},
+	},
+	"BIHostAliasRegexChoice": {
+		"type": {Default: "host_alias_regex"},
+	},
+	"BIHostNameRegexChoice": {
+		"type": {Default: "host_name_regex"},
+	},
+	"BIHostSearch": {
+		"type": {Default: "host_search"},
+	},
+	"BINodeVisBlockStyle": {
+		"style_config": {Default: map[string]interface{}{}},
+		"type": {Default: "block"},
+	},
+	"BINodeVisForceStyle": {
+		"style_config": {Default: map[string]interface{}{}},
+		"type": {Default: "force"},
+	},
+	"BINodeVisHierarchyStyle": {
+		"type": {Default: "hierarchy"},
+	},
+	"BINodeVisNoneStyle": {
+		"style_config": {Default: map[string]interface{}{}},
+		"type": {Default: "none"},
+	},
+	"BINodeVisRadialStyle": {
+		"type": {Default: "radial"},
+	},
+	"BIServiceSearch": {
+		"type": {Default: "service_search"},
+	},
+	"BIStateOfHostAction": {
+		"type": {Default: "state_of_host"},
+	},
+	"BIStateOfRemainingServicesAction": {
+		"type": {Default: "state_of_remaining_services"},
+	},
+	"BIStateOfServiceAction": {
+		"type": {Default: "state_of_service"},
+	},
+	"BinaryExpr": {
+		"left": {Pattern: "^([a-z]+\\.)?[_a-z]+$"},
+	},
+	"BulkDiscovery": {
+		"bulk_size": {Default: float64(10)},
+		"do_full_scan": {Default: true},
+		"ignore_errors": {Default: true},
+		"mode": {Default: "new"},
+	},
+	"ChangesFields": {
+		"id": {Format: "uuid"},
+		"time": {Format: "date-time"},
+	},
+	"CheckboxWithFolderStr": {
+		"value": {Pattern: "^(?:(?:[~\\\\\\/]|(?:[~\\\\\\/][-_ a-zA-Z0-9.]+)+[~\\\\\\/]?)|[0-9a-fA-F]{32})$"},
+	},
+	"Child": {
+		"type": {Default: "child"},
+	},
+	"CiscoExplicitWebhookUrl": {
+		"url": {Format: "url"},
+	},
+	"CommentCollection": {
+		"domainType": {Default: "comment"},
+		"id": {Default: "all"},
+	},
+	"CommentObject": {
+		"domainType": {Default: "comment"},
+	},
+	"ConcreteHostTagGroup": {
+		"domainType": {Default: "host_tag_group"},
+	},
+	"ConcreteTimePeriodException": {
+		"date": {Format: "date"},
+	},
+	"ConcreteTimeRange": {
+		"end": {Format: "time"},
+		"start": {Format: "time"},
+	},
+	"ContactGroup": {
+		"domainType": {Default: "contact_group_config"},
+	},
+	"ContactGroupCollection": {
+		"domainType": {Default: "contact_group_config"},
+		"id": {Default: "all"},
+	},
+	"CreateClusterHost": {
+		"folder": {Pattern: "^(?:(?:[~\\\\\\/]|(?:[~\\\\\\/][-_ a-zA-Z0-9.]+)+[~\\\\\\/]?)|[0-9a-fA-F]{32})$"},
+		"host_name": {Pattern: "^[-0-9a-zA-Z_.]+\\Z"},
+	},
+	"CreateFolder": {
+		"name": {Pattern: "^[-\\w]*\\Z", MinLength: openapi.Int(1)},
+		"parent": {Pattern: "^(?:(?:[~\\\\\\/]|(?:[~\\\\\\/][-_ a-zA-Z0-9.]+)+[~\\\\\\/]?)|[0-9a-fA-F]{32})$"},
+	},
+	"CreateHost": {
+		"folder": {Pattern: "^(?:(?:[~\\\\\\/]|(?:[~\\\\\\/][-_ a-zA-Z0-9.]+)+[~\\\\\\/]?)|[0-9a-fA-F]{32})$"},
+		"host_name": {Pattern: "^[-0-9a-zA-Z_.]+\\Z"},
+	},
+	"CreateHostComment": {
+		"host_name": {Pattern: "^[-0-9a-zA-Z_.]+\\Z"},
+		"persistent": {Default: false},
+	},
+	"CreateHostDowntime": {
+		"duration": {Default: float64(0)},
+		"end_time": {Format: "iso8601"},
+		"host_name": {Pattern: "^[-0-9a-zA-Z_.]+\\Z"},
+		"recur": {Default: "fixed"},
+		"start_time": {Format: "iso8601"},
+	},
+	"CreateHostGroupDowntime": {
+		"duration": {Default: float64(0)},
+		"end_time": {Format: "iso8601"},
+		"recur": {Default: "fixed"},
+		"start_time": {Format: "iso8601"},
+	},
+	"CreateHostQueryComment": {
+		"persistent": {Default: false},
+	},
+	"CreateHostQueryDowntime": {
+		"duration": {Default: float64(0)},
+		"end_time": {Format: "iso8601"},
+		"recur": {Default: "fixed"},
+		"start_time": {Format: "iso8601"},
+	},
+	"CreateServiceComment": {
+		"host_name": {Pattern: "^[-0-9a-zA-Z_.]+\\Z"},
+		"persistent": {Default: false},
+	},
+	"CreateServiceDowntime": {
+		"duration": {Default: float64(0)},
+		"end_time": {Format: "iso8601"},
+		"host_name": {Pattern: "^[-0-9a-zA-Z_.]+\\Z"},
+		"recur": {Default: "fixed"},
+		"start_time": {Format: "iso8601"},
+	},
+	"CreateServiceGroupDowntime": {
+		"duration": {Default: float64(0)},
+		"end_time": {Format: "iso8601"},
+		"recur": {Default: "fixed"},
+		"start_time": {Format: "iso8601"},
+	},
+	"CreateServiceQueryComment": {
+		"persistent": {Default: false},
+	},
+	"CreateServiceQueryDowntime": {
+		"duration": {Default: float64(0)},
+		"end_time": {Format: "iso8601"},
+		"recur": {Default: "fixed"},
+		"start_time": {Format: "iso8601"},
+	},
+	"CreateTimePeriod": {
+		"name": {Pattern: "^[-a-z0-9A-Z_]+\\Z"},
+	},
+	"CreateUser": {
+		"authorized_sites": {Default: []interface{}{"all"}},
+		"disable_login": {Default: false},
+		"interface_options": {Default: map[string]interface{}{"interface_theme": "default", "mega_menu_icons": "topic", "navigation_bar_icons": "hide", "show_mode": "default", "sidebar_position": "right"}},
+		"pager_address": {Default: ""},
+	},
+	"CustomTimeRange": {
+		"end_time": {Format: "iso8601"},
+		"start_time": {Format: "iso8601"},
+	},
+	"DateTimeRange": {
+		"end_time": {Format: "iso8601"},
+		"start_time": {Format: "date-time"},
+	},
+	"DeleteCommentsByParams": {
+		"host_name": {Pattern: "^[-0-9a-zA-Z_.]+\\Z"},
+	},
+	"DeleteDowntimeByName": {
+		"host_name": {Pattern: "^[-0-9a-zA-Z_.]+\\Z"},
+	},
+	"DiscoverServices": {
+		"host_name": {Pattern: "^[-0-9a-zA-Z_.]+\\Z"},
+		"mode": {Default: "fix_all"},
+	},
+	"DiscoverServicesDeprecated": {
+		"mode": {Default: "fix_all"},
+	},
+	"DiscoveryBackgroundJobStatusObject": {
+		"domainType": {Default: "discovery_run"},
+	},
+	"DomainObjectCollection": {
+		"id": {Default: "all"},
+	},
+	"DowntimeAttributes": {
+		"end_time": {Format: "date-time"},
+		"host_name": {Pattern: "^[-0-9a-zA-Z_.]+\\Z"},
+		"start_time": {Format: "date-time"},
+	},
+	"DowntimeCollection": {
+		"domainType": {Default: "downtime"},
+		"id": {Default: "all"},
+	},
+	"DowntimeObject": {
+		"domainType": {Default: "downtime"},
+	},
+	"ECEventAttributes": {
+		"first": {Format: "date-time"},
+		"host": {Pattern: "^[-0-9a-zA-Z_.]+\\Z"},
+		"last": {Format: "date-time"},
+	},
+	"ECEventResponse": {
+		"domainType": {Default: "event_console"},
+	},
+	"EventConsoleResponseCollection": {
+		"domainType": {Default: "event_console"},
+		"id": {Default: "all"},
+	},
+	"FilterParams": {
+		"host": {Pattern: "^[-0-9a-zA-Z_.]+\\Z"},
+	},
+	"FilterParamsUpdateAndAcknowledge": {
+		"host": {Pattern: "^[-0-9a-zA-Z_.]+\\Z"},
+	},
+	"Folder": {
+		"domainType": {Default: "folder_config"},
+	},
+	"FolderCollection": {
+		"domainType": {Default: "folder_config"},
+		"id": {Default: "all"},
+	},
+	"GetGraph": {
+		"graph_id": {Pattern: "^\\w[_\\-\\w\\d]*$"},
+		"host_name": {Pattern: "^[-0-9a-zA-Z_.]+\\Z"},
+		"reduce": {Default: "average"},
+	},
+	"GetMetric": {
+		"host_name": {Pattern: "^[-0-9a-zA-Z_.]+\\Z"},
+		"metric_id": {Pattern: "^\\w[_\\-\\w\\d]*$"},
+		"reduce": {Default: "average"},
+	},
+	"Heartbeat": {
+		"interval": {Minimum: openapi.Float(1)},
+	},
+	"Host": {
+		"type": {Default: "host"},
+	},
+	"HostConfig": {
+		"domainType": {Default: "host_config"},
+	},
+	"HostConfigCollection": {
+		"domainType": {Default: "host_config"},
+		"id": {Default: "all"},
+	},
+	"HostContactGroup": {
+		"recurse_perms": {Default: false},
+		"recurse_use": {Default: false},
+		"use": {Default: false},
+		"use_for_services": {Default: false},
+	},
+	"HostExtensions": {
+		"folder": {Pattern: "^(?:(?:[~\\\\\\/]|(?:[~\\\\\\/][-_ a-zA-Z0-9.]+)+[~\\\\\\/]?)|[0-9a-fA-F]{32})$"},
+	},
+	"HostGroup": {
+		"domainType": {Default: "host_group_config"},
+	},
+	"HostGroupCollection": {
+		"domainType": {Default: "host_group_config"},
+		"id": {Default: "all"},
+	},
+	"HostTagGroupCollection": {
+		"domainType": {Default: "host_tag_group"},
+		"id": {Default: "all"},
+	},
+	"IPAddressRange": {
+		"type": {Default: "ip_range"},
+	},
+	"IPAddresses": {
+		"type": {Default: "ip_list"},
+	},
+	"IPNetwork": {
+		"type": {Default: "ip_network"},
+	},
+	"IPRegexp": {
+		"type": {Default: "ip_regex_list"},
+	},
+	"IdleOption": {
+		"duration": {Default: float64(3600)},
+	},
+	"InputContactGroup": {
+		"name": {Pattern: "^[-a-z0-9A-Z_\\.]*\\Z"},
+	},
+	"InputHostGroup": {
+		"name": {Pattern: "^[-a-z0-9A-Z_\\.]*\\Z"},
+	},
+	"InputHostTagGroup": {
+		"help": {Default: ""},
+		"tags": {MinLength: openapi.Int(1)},
+	},
+	"InputPassword": {
+		"comment": {Default: ""},
+		"documentation_url": {Default: ""},
+		"password": {MinLength: openapi.Int(1)},
+	},
+	"InputRuleObject": {
+		"folder": {Pattern: "^(?:(?:[~\\\\\\/]|(?:[~\\\\\\/][-_ a-zA-Z0-9.]+)+[~\\\\\\/]?)|[0-9a-fA-F]{32})$"},
+	},
+	"InputServiceGroup": {
+		"name": {Pattern: "^[-a-z0-9A-Z_\\.]*\\Z"},
+	},
+	"Link": {
+		"domainType": {Default: "link"},
+	},
+	"LinkHostUUID": {
+		"uuid": {Format: "uuid"},
+	},
+	"MSTeamsExplicitWebhookUrl": {
+		"url": {Format: "url"},
+	},
+	"MetaData": {
+		"created_at": {Format: "date-time"},
+		"updated_at": {Format: "date-time"},
+	},
+	"MoveFolder": {
+		"destination": {Pattern: "^(?:(?:[~\\\\\\/]|(?:[~\\\\\\/][-_ a-zA-Z0-9.]+)+[~\\\\\\/]?)|[0-9a-fA-F]{32})$"},
+	},
+	"MoveHost": {
+		"target_folder": {Pattern: "^(?:(?:[~\\\\\\/]|(?:[~\\\\\\/][-_ a-zA-Z0-9.]+)+[~\\\\\\/]?)|[0-9a-fA-F]{32})$"},
+	},
+	"MoveToFolder": {
+		"folder": {Pattern: "^(?:(?:[~\\\\\\/]|(?:[~\\\\\\/][-_ a-zA-Z0-9.]+)+[~\\\\\\/]?)|[0-9a-fA-F]{32})$"},
+	},
+	"NetworkScan": {
+		"max_parallel_pings": {Minimum: openapi.Float(1), Maximum: openapi.Float(200), Default: float64(100)},
+		"scan_interval": {Minimum: openapi.Float(3600), Default: float64(86400)},
+		"set_ip_address": {Default: true},
+	},
+	"NetworkScanResult": {
+		"end": {Format: "date-time"},
+		"start": {Format: "date-time"},
+	},
+	"NotificationRuleResponse": {
+		"domainType": {Default: "rule_notifications"},
+	},
+	"NotificationRuleResponseCollection": {
+		"domainType": {Default: "rule_notifications"},
+		"id": {Default: "all"},
+	},
+	"ObjectActionMember": {
+		"memberType": {Default: "action"},
+	},
+	"ObjectCollectionMember": {
+		"memberType": {Default: "collection"},
+	},
+	"Parent": {
+		"type": {Default: "parent"},
+	},
+	"PasswordCollection": {
+		"domainType": {Default: "password"},
+		"id": {Default: "all"},
+	},
+	"PasswordObject": {
+		"domainType": {Default: "password"},
+	},
+	"PendingChangesCollection": {
+		"domainType": {Default: "activation_run"},
+	},
+	"ProxyParams": {
+		"channels": {Minimum: openapi.Float(2), Maximum: openapi.Float(50)},
+	},
+	"ProxyTcp": {
+		"port": {Minimum: openapi.Float(1), Maximum: openapi.Float(65535)},
+	},
+	"ProxyTcp1": {
+		"port": {Minimum: openapi.Float(1), Maximum: openapi.Float(65535)},
+	},
+	"PushOverPluginCreate": {
+		"api_key": {Pattern: "^[a-zA-Z0-9]{30,40}$"},
+		"user_group_key": {Pattern: "^[a-zA-Z0-9]{30,40}$"},
+	},
+	"RegexpRewrites": {
+		"replace_with": {MaxLength: openapi.Int(30)},
+		"search": {MaxLength: openapi.Int(30)},
+	},
+	"RegisterHost": {
+		"uuid": {Format: "uuid"},
+	},
+	"RenameHost": {
+		"new_name": {Pattern: "^[-0-9a-zA-Z_.]+\\Z"},
+	},
+	"RuleCollection": {
+		"domainType": {Default: "rule"},
+		"id": {Default: "all"},
+	},
+	"RuleExtensions": {
+		"folder": {Pattern: "^(?:(?:[~\\\\\\/]|(?:[~\\\\\\/][-_ a-zA-Z0-9.]+)+[~\\\\\\/]?)|[0-9a-fA-F]{32})$"},
+	},
+	"RuleObject": {
+		"domainType": {Default: "rule"},
+	},
+	"RuleProperties1": {
+		"disabled": {Default: false},
+		"documentation_url": {Format: "url"},
+	},
+	"RulesetCollection": {
+		"domainType": {Default: "ruleset"},
+		"id": {Default: "all"},
+	},
+	"RulesetExtensions": {
+		"folder": {Pattern: "^(?:(?:[~\\\\\\/]|(?:[~\\\\\\/][-_ a-zA-Z0-9.]+)+[~\\\\\\/]?)|[0-9a-fA-F]{32})$"},
+	},
+	"RulesetObject": {
+		"domainType": {Default: "ruleset"},
+	},
+	"SMSAPIPluginCreate": {
+		"modem_url": {Format: "url"},
+	},
+	"SNMPCommunity": {
+		"type": {Default: "v1_v2_community"},
+	},
+	"SNMPv3AuthNoPrivacy": {
+		"auth_password": {MinLength: openapi.Int(8)},
+		"type": {Default: "authNoPriv"},
+	},
+	"SNMPv3AuthPrivacy": {
+		"auth_password": {MinLength: openapi.Int(8)},
+		"privacy_password": {MinLength: openapi.Int(8)},
+		"type": {Default: "authPriv"},
+	},
+	"SNMPv3NoAuthNoPrivacy": {
+		"type": {Default: "noAuthNoPriv"},
+	},
+	"ServiceGroup": {
+		"domainType": {Default: "service_group_config"},
+	},
+	"ServiceGroupCollection": {
+		"domainType": {Default: "service_group_config"},
+		"id": {Default: "all"},
+	},
+	"SiteConnectionResponse": {
+		"domainType": {Default: "site_connection"},
+	},
+	"SiteConnectionResponseCollection": {
+		"domainType": {Default: "site_connection"},
+		"id": {Default: "all"},
+	},
+	"SocketIP4": {
+		"port": {Minimum: openapi.Float(1), Maximum: openapi.Float(65535)},
+	},
+	"SocketIP6": {
+		"port": {Minimum: openapi.Float(1), Maximum: openapi.Float(65535)},
+	},
+	"SplunkURLExplicit": {
+		"url": {Format: "url"},
+	},
+	"StatusHostAttributesSet": {
+		"host": {Pattern: "^[-0-9a-zA-Z_.]+\\Z"},
+	},
+	"TimeAllowedRange": {
+		"end": {Pattern: "^\\d\\d\\:\\d\\d\\(:\\d\\d)?$"},
+		"start": {Pattern: "^\\d\\d\\:\\d\\d\\(:\\d\\d)?$"},
+	},
+	"TimePeriodException": {
+		"date": {Format: "date"},
+		"time_ranges": {Default: []interface{}{}},
+	},
+	"TimePeriodResponse": {
+		"domainType": {Default: "time_period"},
+	},
+	"TimePeriodResponseCollection": {
+		"domainType": {Default: "time_period"},
+		"id": {Default: "all"},
+	},
+	"TimeRange": {
+		"end": {Format: "date-time"},
+		"start": {Format: "date-time"},
+	},
+	"TimeRange1": {
+		"end": {Format: "time"},
+		"start": {Format: "time"},
+	},
+	"TimeRangeActive": {
+		"day": {Default: "all"},
+		"time_ranges": {Default: []interface{}{map[string]interface{}{"end": "23:59:00", "start": "00:00:00"}}},
+	},
+	"TranslateNames": {
+		"convert_case": {Default: "nop"},
+	},
+	"UpdateAndAcknowledeEventSiteIDRequired": {
+		"phase": {Default: "ack"},
+	},
+	"UpdateAndAcknowledgeFilter": {
+		"phase": {Default: "ack"},
+	},
+	"UpdateAndAcknowledgeWithParams": {
+		"phase": {Default: "ack"},
+	},
+	"UpdateAndAcknowledgeWithQuery": {
+		"phase": {Default: "ack"},
+	},
+	"UpdateFolderEntry": {
+		"folder": {Pattern: "^(?:(?:[~\\\\\\/]|(?:[~\\\\\\/][-_ a-zA-Z0-9.]+)+[~\\\\\\/]?)|[0-9a-fA-F]{32})$"},
+	},
+	"UpdateHostEntry": {
+		"host_name": {Pattern: "^[-0-9a-zA-Z_.]+\\Z"},
+	},
+	"UpdateHostTagGroup": {
+		"repair": {Default: false},
+		"tags": {MinLength: openapi.Int(1)},
+	},
+	"UpdatePassword": {
+		"password": {MinLength: openapi.Int(1)},
+	},
+	"UserCollection": {
+		"domainType": {Default: "user_config"},
+		"id": {Default: "all"},
+	},
+	"UserContactOption": {
+		"fallback_contact": {Default: false},
+	},
+	"UserInterfaceAttributes": {
+		"interface_theme": {Default: "default"},
+		"mega_menu_icons": {Default: "topic"},
+		"navigation_bar_icons": {Default: "hide"},
+		"show_mode": {Default: "default"},
+		"sidebar_position": {Default: "right"},
+	},
+	"UserObject": {
+		"domainType": {Default: "user_config"},
+	},
+	"UserRoleCollection": {
+		"domainType": {Default: "user_role"},
+		"id": {Default: "all"},
+	},
+	"UserRoleObject": {
+		"domainType": {Default: "user_role"},
+	},
+}
+
+// GetFieldConstraints returns the validation keywords and default of a field.
+// Returns nil if the field has none or is not found.
+func GetFieldConstraints(schemaName, fieldName string) *openapi.FieldConstraints {
+	if fields, ok := FieldConstraints[schemaName]; ok {
+		return fields[fieldName]
+	}
+	return nil
+}
+
 // GetFieldDescription returns the description for a field in a schema.
 // Returns empty string if not found.
 func GetFieldDescription(schemaName, fieldName string) string {
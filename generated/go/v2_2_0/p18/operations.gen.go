@@ -0,0 +1,4288 @@
+//go:build checkmk_all || checkmk_v2_2_0
+
+// Code generated by openapi-gen from CheckMK 1.0. DO NOT EDIT.
+//
+// Endpoint descriptors and parameter types for every API operation
+//
+// Source: operations.gen.go
+// Schemas: All (unfiltered)
+
+package p18
+
+import "github.com/BlackMesaLTD/checkmk-api-spec/generated/go/openapi"
+
+// CertAgentControllerCertificatesSettingsOperation describes the "Show agent controller certificate settings" operation (GET /agent_controller_certificates_settings).
+var CertAgentControllerCertificatesSettingsOperation = &openapi.Operation{
+	ID: "cmk.gui.plugins.openapi.endpoints.cert.agent_controller_certificates_settings",
+	Name: "CertAgentControllerCertificatesSettings",
+	Method: "GET",
+	Path: "/agent_controller_certificates_settings",
+	Summary: "Show agent controller certificate settings",
+	Tags: []string{"Certificates"},
+	Responses: []openapi.Response{
+		{Status: "200", Description: "OK: The operation was done successfully.", ContentType: "application/json", Schema: "AgentControllerCertificateSettings", Headers: []string{"Content-Type", "X-Checkmk-Edition", "X-Checkmk-Version"}, New: func() interface{} { return new(AgentControllerCertificateSettings) }},
+		{Status: "403", Description: "Forbidden: Unauthorized to read the global settings", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "406", Description: "Not Acceptable: The requests accept headers can not be satisfied.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+	},
+}
+
+// CertMakeCertificateOperation describes the "X.509 PEM-encoded Certificate Signing Requests (CSRs)" operation (POST /csr).
+var CertMakeCertificateOperation = &openapi.Operation{
+	ID: "cmk.gui.plugins.openapi.endpoints.cert.make_certificate",
+	Name: "CertMakeCertificate",
+	Method: "POST",
+	Path: "/csr",
+	Summary: "X.509 PEM-encoded Certificate Signing Requests (CSRs)",
+	Tags: []string{"Certificates"},
+	Parameters: []openapi.Parameter{
+		{Name: "Content-Type", In: "header", Required: true, Type: "string", Description: "A header specifying which type of content is in the request/response body. This is required when sending encoded data in a POST/PUT body. When the request body is empty, this header should not be sent."},
+	},
+	RequestBody: &openapi.RequestBody{ContentType: "application/json", Schema: "X509ReqPEMUUID", Required: true, New: func() interface{} { return new(X509ReqPEMUUID) }},
+	Responses: []openapi.Response{
+		{Status: "200", Description: "OK: The operation was done successfully.", ContentType: "application/json", Schema: "X509PEM", Headers: []string{"Content-Type", "X-Checkmk-Edition", "X-Checkmk-Version"}, New: func() interface{} { return new(X509PEM) }},
+		{Status: "400", Description: "Bad Request: Parameter or validation failure.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "403", Description: "Forbidden: You do not have the permission for agent pairing.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "406", Description: "Not Acceptable: The requests accept headers can not be satisfied.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "415", Description: "Unsupported Media Type: The submitted content-type is not supported.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+	},
+}
+
+// AcknowledgementSetAcknowledgementOnHostsOperation describes the "Set acknowledgement on related hosts" operation (POST /domain-types/acknowledge/collections/host).
+var AcknowledgementSetAcknowledgementOnHostsOperation = &openapi.Operation{
+	ID: "cmk.gui.plugins.openapi.endpoints.acknowledgement.set_acknowledgement_on_hosts",
+	Name: "AcknowledgementSetAcknowledgementOnHosts",
+	Method: "POST",
+	Path: "/domain-types/acknowledge/collections/host",
+	Summary: "Set acknowledgement on related hosts",
+	Tags: []string{"Acknowledge problems"},
+	Parameters: []openapi.Parameter{
+		{Name: "Content-Type", In: "header", Required: true, Type: "string", Description: "A header specifying which type of content is in the request/response body. This is required when sending encoded data in a POST/PUT body. When the request body is empty, this header should not be sent."},
+	},
+	RequestBody: &openapi.RequestBody{ContentType: "application/json", Schema: "AcknowledgeHostRelatedProblem", Required: true, New: func() interface{} { return new(AcknowledgeHostRelatedProblem) }},
+	Responses: []openapi.Response{
+		{Status: "204", Description: "No Content: Operation done successfully. No further output.", ContentType: "", Schema: "", New: nil},
+		{Status: "400", Description: "Bad Request: Parameter or validation failure.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "406", Description: "Not Acceptable: The requests accept headers can not be satisfied.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "415", Description: "Unsupported Media Type: The submitted content-type is not supported.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "422", Description: "Unprocessable Entity: The query yielded no result.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+	},
+}
+
+// AcknowledgementSetAcknowledgementOnServicesOperation describes the "Set acknowledgement on related services" operation (POST /domain-types/acknowledge/collections/service).
+var AcknowledgementSetAcknowledgementOnServicesOperation = &openapi.Operation{
+	ID: "cmk.gui.plugins.openapi.endpoints.acknowledgement.set_acknowledgement_on_services",
+	Name: "AcknowledgementSetAcknowledgementOnServices",
+	Method: "POST",
+	Path: "/domain-types/acknowledge/collections/service",
+	Summary: "Set acknowledgement on related services",
+	Tags: []string{"Acknowledge problems"},
+	Parameters: []openapi.Parameter{
+		{Name: "Content-Type", In: "header", Required: true, Type: "string", Description: "A header specifying which type of content is in the request/response body. This is required when sending encoded data in a POST/PUT body. When the request body is empty, this header should not be sent."},
+	},
+	RequestBody: &openapi.RequestBody{ContentType: "application/json", Schema: "AcknowledgeServiceRelatedProblem", Required: true, New: func() interface{} { return new(AcknowledgeServiceRelatedProblem) }},
+	Responses: []openapi.Response{
+		{Status: "204", Description: "No Content: Operation done successfully. No further output.", ContentType: "", Schema: "", New: nil},
+		{Status: "400", Description: "Bad Request: Parameter or validation failure.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "406", Description: "Not Acceptable: The requests accept headers can not be satisfied.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "415", Description: "Unsupported Media Type: The submitted content-type is not supported.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "422", Description: "Unprocessable Entity: Service was not in a problem state.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+	},
+}
+
+// ActivateChangesActivateChangesParams holds the path, query and header parameters of ActivateChangesActivateChangesOperation.
+type ActivateChangesActivateChangesParams struct {
+	// The value of the, to be modified, object's ETag header. You can get this value by displaying the object it individually. To update this object the currently stored ETag needs to be the same as the one sent. The content of the ETag can potentially be anything and should be treated as semantically ...
+	IfMatch string `header:"If-Match"`
+}
+
+// ActivateChangesActivateChangesOperation describes the "Activate pending changes" operation (POST /domain-types/activation_run/actions/activate-changes/invoke).
+var ActivateChangesActivateChangesOperation = &openapi.Operation{
+	ID: "cmk.gui.plugins.openapi.endpoints.activate_changes.activate_changes",
+	Name: "ActivateChangesActivateChanges",
+	Method: "POST",
+	Path: "/domain-types/activation_run/actions/activate-changes/invoke",
+	Summary: "Activate pending changes",
+	Tags: []string{"Activate changes"},
+	Parameters: []openapi.Parameter{
+		{Name: "If-Match", In: "header", Required: true, Type: "string", Description: "The value of the, to be modified, object's ETag header. You can get this value by displaying the object it individually. To update this object the currently stored ETag needs to be the same as the one sent. The content of the ETag can potentially be anything and should be treated as semantically opaque."},
+		{Name: "Content-Type", In: "header", Required: true, Type: "string", Description: "A header specifying which type of content is in the request/response body. This is required when sending encoded data in a POST/PUT body. When the request body is empty, this header should not be sent."},
+	},
+	RequestBody: &openapi.RequestBody{ContentType: "application/json", Schema: "ActivateChanges", Required: true, New: func() interface{} { return new(ActivateChanges) }},
+	Responses: []openapi.Response{
+		{Status: "200", Description: "OK: Activation has been started, but not completed (if you need to wait for completion, see documentation for this endpoint).", ContentType: "application/json", Schema: "ActivationRunResponse", Headers: []string{"Content-Type", "X-Checkmk-Edition", "X-Checkmk-Version"}, New: func() interface{} { return new(ActivationRunResponse) }},
+		{Status: "302", Description: "Found: The activation has been started and is still running. Redirecting to the 'Wait for completion' endpoint.", ContentType: "", Schema: "", New: nil},
+		{Status: "400", Description: "Bad Request: Parameter or validation failure.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "401", Description: "Unauthorized: The API user may not activate another users changes, or the user may and activation was not forced explicitly.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "403", Description: "Forbidden: Activation not possible because of licensing issues.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "406", Description: "Not Acceptable: The requests accept headers can not be satisfied.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "409", Description: "Conflict: Some sites could not be activated.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "412", Description: "Precondition Failed: The value of the If-Match header doesn't match the object's ETag.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "415", Description: "Unsupported Media Type: The submitted content-type is not supported.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "422", Description: "Unprocessable Entity: There are no changes to be activated.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "423", Description: "Locked: There is already an activation running.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "428", Description: "Precondition Required: The required If-Match header is missing.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+	},
+	NewParams: func() interface{} { return new(ActivateChangesActivateChangesParams) },
+}
+
+// ActivateChangesListPendingChangesOperation describes the "Show all pending changes" operation (GET /domain-types/activation_run/collections/pending_changes).
+var ActivateChangesListPendingChangesOperation = &openapi.Operation{
+	ID: "cmk.gui.plugins.openapi.endpoints.activate_changes.list_pending_changes",
+	Name: "ActivateChangesListPendingChanges",
+	Method: "GET",
+	Path: "/domain-types/activation_run/collections/pending_changes",
+	Summary: "Show all pending changes",
+	Tags: []string{"Activate changes"},
+	Responses: []openapi.Response{
+		{Status: "200", Description: "OK: The operation was done successfully.", ContentType: "application/json", Schema: "PendingChangesCollection", Headers: []string{"Content-Type", "X-Checkmk-Edition", "X-Checkmk-Version"}, New: func() interface{} { return new(PendingChangesCollection) }},
+		{Status: "403", Description: "Forbidden: Configuration via Setup is disabled.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "406", Description: "Not Acceptable: The requests accept headers can not be satisfied.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+	},
+}
+
+// ActivateChangesListActivationsOperation describes the "Show all currently running activations" operation (GET /domain-types/activation_run/collections/running).
+var ActivateChangesListActivationsOperation = &openapi.Operation{
+	ID: "cmk.gui.plugins.openapi.endpoints.activate_changes.list_activations",
+	Name: "ActivateChangesListActivations",
+	Method: "GET",
+	Path: "/domain-types/activation_run/collections/running",
+	Summary: "Show all currently running activations",
+	Tags: []string{"Activate changes"},
+	Responses: []openapi.Response{
+		{Status: "200", Description: "OK: The operation was done successfully.", ContentType: "application/json", Schema: "ActivationRunCollection", Headers: []string{"Content-Type", "X-Checkmk-Edition", "X-Checkmk-Version"}, New: func() interface{} { return new(ActivationRunCollection) }},
+		{Status: "403", Description: "Forbidden: Configuration via Setup is disabled.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "406", Description: "Not Acceptable: The requests accept headers can not be satisfied.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+	},
+}
+
+// AgentDownloadAgentParams holds the path, query and header parameters of AgentDownloadAgentOperation.
+type AgentDownloadAgentParams struct {
+	// The type of the operating system. May be one of linux_rpm, linux_deb, windows_msi
+	OsType string `query:"os_type"`
+}
+
+// AgentDownloadAgentOperation describes the "Download agents shipped with Checkmk" operation (GET /domain-types/agent/actions/download/invoke).
+var AgentDownloadAgentOperation = &openapi.Operation{
+	ID: "cmk.gui.plugins.openapi.endpoints.agent.download_agent",
+	Name: "AgentDownloadAgent",
+	Method: "GET",
+	Path: "/domain-types/agent/actions/download/invoke",
+	Summary: "Download agents shipped with Checkmk",
+	Tags: []string{"Agents"},
+	Parameters: []openapi.Parameter{
+		{Name: "os_type", In: "query", Required: true, Type: "string", Description: "The type of the operating system. May be one of linux_rpm, linux_deb, windows_msi"},
+	},
+	Responses: []openapi.Response{
+		{Status: "200", Description: "OK: The operation was done successfully.", ContentType: "application/octet-stream", Schema: "", Headers: []string{"Content-Type", "X-Checkmk-Edition", "X-Checkmk-Version"}, New: nil},
+		{Status: "400", Description: "Bad Request: Parameter or validation failure.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "403", Description: "Forbidden: Configuration via Setup is disabled.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "406", Description: "Not Acceptable: The requests accept headers can not be satisfied.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+	},
+	NewParams: func() interface{} { return new(AgentDownloadAgentParams) },
+}
+
+// AuxTagsShowAuxTagsOperation describes the "Show Auxiliary Tags" operation (GET /domain-types/aux_tag/collections/all).
+var AuxTagsShowAuxTagsOperation = &openapi.Operation{
+	ID: "cmk.gui.plugins.openapi.endpoints.aux_tags.show_aux_tags",
+	Name: "AuxTagsShowAuxTags",
+	Method: "GET",
+	Path: "/domain-types/aux_tag/collections/all",
+	Summary: "Show Auxiliary Tags",
+	Tags: []string{"Aux Tags"},
+	Responses: []openapi.Response{
+		{Status: "200", Description: "OK: The operation was done successfully.", ContentType: "application/json", Schema: "AuxTagResponseCollection", Headers: []string{"Content-Type", "X-Checkmk-Edition", "X-Checkmk-Version"}, New: func() interface{} { return new(AuxTagResponseCollection) }},
+		{Status: "403", Description: "Forbidden: Configuration via Setup is disabled.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "406", Description: "Not Acceptable: The requests accept headers can not be satisfied.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+	},
+}
+
+// AuxTagsCreateAuxTagOperation describes the "Create an Auxiliary Tag" operation (POST /domain-types/aux_tag/collections/all).
+var AuxTagsCreateAuxTagOperation = &openapi.Operation{
+	ID: "cmk.gui.plugins.openapi.endpoints.aux_tags.create_aux_tag",
+	Name: "AuxTagsCreateAuxTag",
+	Method: "POST",
+	Path: "/domain-types/aux_tag/collections/all",
+	Summary: "Create an Auxiliary Tag",
+	Tags: []string{"Aux Tags"},
+	Parameters: []openapi.Parameter{
+		{Name: "Content-Type", In: "header", Required: true, Type: "string", Description: "A header specifying which type of content is in the request/response body. This is required when sending encoded data in a POST/PUT body. When the request body is empty, this header should not be sent."},
+	},
+	RequestBody: &openapi.RequestBody{ContentType: "application/json", Schema: "AuxTagAttrsCreate", Required: true, New: func() interface{} { return new(AuxTagAttrsCreate) }},
+	Responses: []openapi.Response{
+		{Status: "200", Description: "OK: The operation was done successfully.", ContentType: "application/json", Schema: "AuxTagResponse", Headers: []string{"Content-Type", "X-Checkmk-Edition", "X-Checkmk-Version"}, New: func() interface{} { return new(AuxTagResponse) }},
+		{Status: "400", Description: "Bad Request: Parameter or validation failure.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "403", Description: "Forbidden: Configuration via Setup is disabled.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "406", Description: "Not Acceptable: The requests accept headers can not be satisfied.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "415", Description: "Unsupported Media Type: The submitted content-type is not supported.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+	},
+}
+
+// BiBiAggregationStateGetParams holds the path, query and header parameters of BiBiAggregationStateGetOperation.
+type BiBiAggregationStateGetParams struct {
+	// Filter by names
+	FilterNames []string `query:"filter_names"`
+	// Filter by group
+	FilterGroups []string `query:"filter_groups"`
+}
+
+// BiBiAggregationStateGetOperation describes the "Get the state of BI aggregations" operation (GET /domain-types/bi_aggregation/actions/aggregation_state/invoke).
+var BiBiAggregationStateGetOperation = &openapi.Operation{
+	ID: "cmk.gui.plugins.openapi.endpoints.bi.bi_aggregation_state_get",
+	Name: "BiBiAggregationStateGet",
+	Method: "GET",
+	Path: "/domain-types/bi_aggregation/actions/aggregation_state/invoke",
+	Summary: "Get the state of BI aggregations",
+	Tags: []string{"Business intelligence (BI)"},
+	Parameters: []openapi.Parameter{
+		{Name: "filter_names", In: "query", Required: false, Type: "array", Description: "Filter by names"},
+		{Name: "filter_groups", In: "query", Required: false, Type: "array", Description: "Filter by group"},
+	},
+	Responses: []openapi.Response{
+		{Status: "200", Description: "OK: The operation was done successfully.", ContentType: "application/json", Schema: "BIAggregationStateResponse", Headers: []string{"Content-Type", "X-Checkmk-Edition", "X-Checkmk-Version"}, New: func() interface{} { return new(BIAggregationStateResponse) }},
+		{Status: "400", Description: "Bad Request: Parameter or validation failure.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "406", Description: "Not Acceptable: The requests accept headers can not be satisfied.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+	},
+	NewParams: func() interface{} { return new(BiBiAggregationStateGetParams) },
+}
+
+// BiBiAggregationStatePostOperation describes the "Get the state of BI aggregations" operation (POST /domain-types/bi_aggregation/actions/aggregation_state/invoke).
+var BiBiAggregationStatePostOperation = &openapi.Operation{
+	ID: "cmk.gui.plugins.openapi.endpoints.bi.bi_aggregation_state_post",
+	Name: "BiBiAggregationStatePost",
+	Method: "POST",
+	Path: "/domain-types/bi_aggregation/actions/aggregation_state/invoke",
+	Summary: "Get the state of BI aggregations",
+	Tags: []string{"Business intelligence (BI)"},
+	Parameters: []openapi.Parameter{
+		{Name: "Content-Type", In: "header", Required: true, Type: "string", Description: "A header specifying which type of content is in the request/response body. This is required when sending encoded data in a POST/PUT body. When the request body is empty, this header should not be sent."},
+	},
+	RequestBody: &openapi.RequestBody{ContentType: "application/json", Schema: "BIAggregationStateRequest", Required: true, New: func() interface{} { return new(BIAggregationStateRequest) }},
+	Responses: []openapi.Response{
+		{Status: "200", Description: "OK: The operation was done successfully.", ContentType: "application/json", Schema: "BIAggregationStateResponse", Headers: []string{"Content-Type", "X-Checkmk-Edition", "X-Checkmk-Version"}, New: func() interface{} { return new(BIAggregationStateResponse) }},
+		{Status: "400", Description: "Bad Request: Parameter or validation failure.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "406", Description: "Not Acceptable: The requests accept headers can not be satisfied.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "415", Description: "Unsupported Media Type: The submitted content-type is not supported.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+	},
+}
+
+// BiGetBiPacksOperation describes the "Show all BI packs" operation (GET /domain-types/bi_pack/collections/all).
+var BiGetBiPacksOperation = &openapi.Operation{
+	ID: "cmk.gui.plugins.openapi.endpoints.bi.get_bi_packs",
+	Name: "BiGetBiPacks",
+	Method: "GET",
+	Path: "/domain-types/bi_pack/collections/all",
+	Summary: "Show all BI packs",
+	Tags: []string{"Business intelligence (BI)"},
+	Responses: []openapi.Response{
+		{Status: "200", Description: "OK: The operation was done successfully.", ContentType: "application/json", Schema: "DomainObjectCollection", Headers: []string{"Content-Type", "X-Checkmk-Edition", "X-Checkmk-Version"}, New: func() interface{} { return new(DomainObjectCollection) }},
+		{Status: "403", Description: "Forbidden: Configuration via Setup is disabled.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "406", Description: "Not Acceptable: The requests accept headers can not be satisfied.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+	},
+}
+
+// CommentDeleteCommentsOperation describes the "Delete comments" operation (POST /domain-types/comment/actions/delete/invoke).
+var CommentDeleteCommentsOperation = &openapi.Operation{
+	ID: "cmk.gui.plugins.openapi.endpoints.comment.delete_comments",
+	Name: "CommentDeleteComments",
+	Method: "POST",
+	Path: "/domain-types/comment/actions/delete/invoke",
+	Summary: "Delete comments",
+	Tags: []string{"Comments"},
+	Parameters: []openapi.Parameter{
+		{Name: "Content-Type", In: "header", Required: true, Type: "string", Description: "A header specifying which type of content is in the request/response body. This is required when sending encoded data in a POST/PUT body. When the request body is empty, this header should not be sent."},
+	},
+	RequestBody: &openapi.RequestBody{ContentType: "application/json", Schema: "DeleteComments", Required: true, New: func() interface{} { return new(DeleteComments) }},
+	Responses: []openapi.Response{
+		{Status: "204", Description: "No Content: Operation done successfully. No further output.", ContentType: "", Schema: "", New: nil},
+		{Status: "400", Description: "Bad Request: Parameter or validation failure.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "406", Description: "Not Acceptable: The requests accept headers can not be satisfied.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "415", Description: "Unsupported Media Type: The submitted content-type is not supported.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+	},
+}
+
+// CommentCreateHostCommentOperation describes the "Create a host comment" operation (POST /domain-types/comment/collections/host).
+var CommentCreateHostCommentOperation = &openapi.Operation{
+	ID: "cmk.gui.plugins.openapi.endpoints.comment.create_host_comment",
+	Name: "CommentCreateHostComment",
+	Method: "POST",
+	Path: "/domain-types/comment/collections/host",
+	Summary: "Create a host comment",
+	Tags: []string{"Comments"},
+	Parameters: []openapi.Parameter{
+		{Name: "Content-Type", In: "header", Required: true, Type: "string", Description: "A header specifying which type of content is in the request/response body. This is required when sending encoded data in a POST/PUT body. When the request body is empty, this header should not be sent."},
+	},
+	RequestBody: &openapi.RequestBody{ContentType: "application/json", Schema: "CreateHostRelatedComment", Required: true, New: func() interface{} { return new(CreateHostRelatedComment) }},
+	Responses: []openapi.Response{
+		{Status: "204", Description: "No Content: Operation done successfully. No further output.", ContentType: "", Schema: "", New: nil},
+		{Status: "400", Description: "Bad Request: Parameter or validation failure.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "406", Description: "Not Acceptable: The requests accept headers can not be satisfied.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "415", Description: "Unsupported Media Type: The submitted content-type is not supported.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+	},
+}
+
+// CommentCreateServiceCommentOperation describes the "Create a service comment" operation (POST /domain-types/comment/collections/service).
+var CommentCreateServiceCommentOperation = &openapi.Operation{
+	ID: "cmk.gui.plugins.openapi.endpoints.comment.create_service_comment",
+	Name: "CommentCreateServiceComment",
+	Method: "POST",
+	Path: "/domain-types/comment/collections/service",
+	Summary: "Create a service comment",
+	Tags: []string{"Comments"},
+	Parameters: []openapi.Parameter{
+		{Name: "Content-Type", In: "header", Required: true, Type: "string", Description: "A header specifying which type of content is in the request/response body. This is required when sending encoded data in a POST/PUT body. When the request body is empty, this header should not be sent."},
+	},
+	RequestBody: &openapi.RequestBody{ContentType: "application/json", Schema: "CreateServiceRelatedComment", Required: true, New: func() interface{} { return new(CreateServiceRelatedComment) }},
+	Responses: []openapi.Response{
+		{Status: "204", Description: "No Content: Operation done successfully. No further output.", ContentType: "", Schema: "", New: nil},
+		{Status: "400", Description: "Bad Request: Parameter or validation failure.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "406", Description: "Not Acceptable: The requests accept headers can not be satisfied.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "415", Description: "Unsupported Media Type: The submitted content-type is not supported.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+	},
+}
+
+// CommentShowCommentsParams holds the path, query and header parameters of CommentShowCommentsOperation.
+type CommentShowCommentsParams struct {
+	// An query expression of the Livestatus 'comments' table in nested dictionary form. If you want to use multiple expressions, nest them with the AND/OR operators.
+	Query string `query:"query"`
+	// The service description. No exception is raised when the specified service description does not exist
+	ServiceDescription string `query:"service_description"`
+	// The host name. No exception is raised when the specified host name does not exist
+	HostName string `query:"host_name"`
+	// An existing site id
+	SiteId string `query:"site_id"`
+	// Do you want to get comments from 'hosts', 'services' or 'all'
+	CollectionName string `path:"collection_name"`
+}
+
+// CommentShowCommentsOperation describes the "Show comments" operation (GET /domain-types/comment/collections/{collection_name}).
+var CommentShowCommentsOperation = &openapi.Operation{
+	ID: "cmk.gui.plugins.openapi.endpoints.comment.show_comments",
+	Name: "CommentShowComments",
+	Method: "GET",
+	Path: "/domain-types/comment/collections/{collection_name}",
+	Summary: "Show comments",
+	Tags: []string{"Comments"},
+	Parameters: []openapi.Parameter{
+		{Name: "query", In: "query", Required: false, Type: "string", Description: "An query expression of the Livestatus 'comments' table in nested dictionary form. If you want to use multiple expressions, nest them with the AND/OR operators."},
+		{Name: "service_description", In: "query", Required: false, Type: "string", Description: "The service description. No exception is raised when the specified service description does not exist"},
+		{Name: "host_name", In: "query", Required: false, Type: "string", Description: "The host name. No exception is raised when the specified host name does not exist"},
+		{Name: "site_id", In: "query", Required: false, Type: "string", Description: "An existing site id"},
+		{Name: "collection_name", In: "path", Required: true, Type: "string", Description: "Do you want to get comments from 'hosts', 'services' or 'all'"},
+	},
+	Responses: []openapi.Response{
+		{Status: "200", Description: "OK: The operation was done successfully.", ContentType: "application/json", Schema: "CommentCollection", Headers: []string{"Content-Type", "X-Checkmk-Edition", "X-Checkmk-Version"}, New: func() interface{} { return new(CommentCollection) }},
+		{Status: "400", Description: "Bad Request: Parameter or validation failure.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "404", Description: "Not Found: The requested object has not been found.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "406", Description: "Not Acceptable: The requests accept headers can not be satisfied.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+	},
+	NewParams: func() interface{} { return new(CommentShowCommentsParams) },
+}
+
+// ContactGroupConfigBulkCreateOperation describes the "Bulk create contact groups" operation (POST /domain-types/contact_group_config/actions/bulk-create/invoke).
+var ContactGroupConfigBulkCreateOperation = &openapi.Operation{
+	ID: "cmk.gui.plugins.openapi.endpoints.contact_group_config.bulk_create",
+	Name: "ContactGroupConfigBulkCreate",
+	Method: "POST",
+	Path: "/domain-types/contact_group_config/actions/bulk-create/invoke",
+	Summary: "Bulk create contact groups",
+	Tags: []string{"Contact groups"},
+	Parameters: []openapi.Parameter{
+		{Name: "Content-Type", In: "header", Required: true, Type: "string", Description: "A header specifying which type of content is in the request/response body. This is required when sending encoded data in a POST/PUT body. When the request body is empty, this header should not be sent."},
+	},
+	RequestBody: &openapi.RequestBody{ContentType: "application/json", Schema: "BulkInputContactGroup", Required: true, New: func() interface{} { return new(BulkInputContactGroup) }},
+	Responses: []openapi.Response{
+		{Status: "200", Description: "OK: The operation was done successfully.", ContentType: "application/json", Schema: "ContactGroupCollection", Headers: []string{"Content-Type", "X-Checkmk-Edition", "X-Checkmk-Version"}, New: func() interface{} { return new(ContactGroupCollection) }},
+		{Status: "400", Description: "Bad Request: Parameter or validation failure.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "403", Description: "Forbidden: Configuration via Setup is disabled.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "406", Description: "Not Acceptable: The requests accept headers can not be satisfied.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "415", Description: "Unsupported Media Type: The submitted content-type is not supported.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+	},
+}
+
+// ContactGroupConfigBulkDeleteOperation describes the "Bulk delete contact groups" operation (POST /domain-types/contact_group_config/actions/bulk-delete/invoke).
+var ContactGroupConfigBulkDeleteOperation = &openapi.Operation{
+	ID: "cmk.gui.plugins.openapi.endpoints.contact_group_config.bulk_delete",
+	Name: "ContactGroupConfigBulkDelete",
+	Method: "POST",
+	Path: "/domain-types/contact_group_config/actions/bulk-delete/invoke",
+	Summary: "Bulk delete contact groups",
+	Tags: []string{"Contact groups"},
+	Parameters: []openapi.Parameter{
+		{Name: "Content-Type", In: "header", Required: true, Type: "string", Description: "A header specifying which type of content is in the request/response body. This is required when sending encoded data in a POST/PUT body. When the request body is empty, this header should not be sent."},
+	},
+	RequestBody: &openapi.RequestBody{ContentType: "application/json", Schema: "BulkDeleteContactGroup", Required: true, New: func() interface{} { return new(BulkDeleteContactGroup) }},
+	Responses: []openapi.Response{
+		{Status: "204", Description: "No Content: Operation done successfully. No further output.", ContentType: "", Schema: "", New: nil},
+		{Status: "400", Description: "Bad Request: Parameter or validation failure.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "403", Description: "Forbidden: Configuration via Setup is disabled.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "404", Description: "Not Found: The requested object has not been found.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "406", Description: "Not Acceptable: The requests accept headers can not be satisfied.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "409", Description: "Conflict: The request is in conflict with the stored resource.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "415", Description: "Unsupported Media Type: The submitted content-type is not supported.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+	},
+}
+
+// ContactGroupConfigBulkUpdateOperation describes the "Bulk update contact groups" operation (PUT /domain-types/contact_group_config/actions/bulk-update/invoke).
+var ContactGroupConfigBulkUpdateOperation = &openapi.Operation{
+	ID: "cmk.gui.plugins.openapi.endpoints.contact_group_config.bulk_update",
+	Name: "ContactGroupConfigBulkUpdate",
+	Method: "PUT",
+	Path: "/domain-types/contact_group_config/actions/bulk-update/invoke",
+	Summary: "Bulk update contact groups",
+	Tags: []string{"Contact groups"},
+	Parameters: []openapi.Parameter{
+		{Name: "Content-Type", In: "header", Required: true, Type: "string", Description: "A header specifying which type of content is in the request/response body. This is required when sending encoded data in a POST/PUT body. When the request body is empty, this header should not be sent."},
+	},
+	RequestBody: &openapi.RequestBody{ContentType: "application/json", Schema: "BulkUpdateContactGroup", Required: true, New: func() interface{} { return new(BulkUpdateContactGroup) }},
+	Responses: []openapi.Response{
+		{Status: "200", Description: "OK: The operation was done successfully.", ContentType: "application/json", Schema: "ContactGroupCollection", Headers: []string{"Content-Type", "X-Checkmk-Edition", "X-Checkmk-Version"}, New: func() interface{} { return new(ContactGroupCollection) }},
+		{Status: "400", Description: "Bad Request: Parameter or validation failure.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "403", Description: "Forbidden: Configuration via Setup is disabled.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "406", Description: "Not Acceptable: The requests accept headers can not be satisfied.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "415", Description: "Unsupported Media Type: The submitted content-type is not supported.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+	},
+}
+
+// ContactGroupConfigListGroupOperation describes the "Show all contact groups" operation (GET /domain-types/contact_group_config/collections/all).
+var ContactGroupConfigListGroupOperation = &openapi.Operation{
+	ID: "cmk.gui.plugins.openapi.endpoints.contact_group_config.list_group",
+	Name: "ContactGroupConfigListGroup",
+	Method: "GET",
+	Path: "/domain-types/contact_group_config/collections/all",
+	Summary: "Show all contact groups",
+	Tags: []string{"Contact groups"},
+	Responses: []openapi.Response{
+		{Status: "200", Description: "OK: The operation was done successfully.", ContentType: "application/json", Schema: "ContactGroupCollection", Headers: []string{"Content-Type", "X-Checkmk-Edition", "X-Checkmk-Version"}, New: func() interface{} { return new(ContactGroupCollection) }},
+		{Status: "403", Description: "Forbidden: Configuration via Setup is disabled.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "406", Description: "Not Acceptable: The requests accept headers can not be satisfied.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+	},
+}
+
+// ContactGroupConfigCreateOperation describes the "Create a contact group" operation (POST /domain-types/contact_group_config/collections/all).
+var ContactGroupConfigCreateOperation = &openapi.Operation{
+	ID: "cmk.gui.plugins.openapi.endpoints.contact_group_config.create",
+	Name: "ContactGroupConfigCreate",
+	Method: "POST",
+	Path: "/domain-types/contact_group_config/collections/all",
+	Summary: "Create a contact group",
+	Tags: []string{"Contact groups"},
+	Parameters: []openapi.Parameter{
+		{Name: "Content-Type", In: "header", Required: true, Type: "string", Description: "A header specifying which type of content is in the request/response body. This is required when sending encoded data in a POST/PUT body. When the request body is empty, this header should not be sent."},
+	},
+	RequestBody: &openapi.RequestBody{ContentType: "application/json", Schema: "InputContactGroup", Required: true, New: func() interface{} { return new(InputContactGroup) }},
+	Responses: []openapi.Response{
+		{Status: "200", Description: "OK: The operation was done successfully.", ContentType: "application/json", Schema: "DomainObject", Headers: []string{"Content-Type", "ETag", "X-Checkmk-Edition", "X-Checkmk-Version"}, New: func() interface{} { return new(DomainObject) }},
+		{Status: "400", Description: "Bad Request: Parameter or validation failure.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "403", Description: "Forbidden: Configuration via Setup is disabled.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "406", Description: "Not Acceptable: The requests accept headers can not be satisfied.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "415", Description: "Unsupported Media Type: The submitted content-type is not supported.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+	},
+}
+
+// ServiceDiscoveryExecuteBulkDiscoveryOperation describes the "Start a bulk discovery job" operation (POST /domain-types/discovery_run/actions/bulk-discovery-start/invoke).
+var ServiceDiscoveryExecuteBulkDiscoveryOperation = &openapi.Operation{
+	ID: "cmk.gui.plugins.openapi.endpoints.service_discovery.execute_bulk_discovery",
+	Name: "ServiceDiscoveryExecuteBulkDiscovery",
+	Method: "POST",
+	Path: "/domain-types/discovery_run/actions/bulk-discovery-start/invoke",
+	Summary: "Start a bulk discovery job",
+	Tags: []string{"Service discovery"},
+	Parameters: []openapi.Parameter{
+		{Name: "Content-Type", In: "header", Required: true, Type: "string", Description: "A header specifying which type of content is in the request/response body. This is required when sending encoded data in a POST/PUT body. When the request body is empty, this header should not be sent."},
+	},
+	RequestBody: &openapi.RequestBody{ContentType: "application/json", Schema: "BulkDiscovery", Required: true, New: func() interface{} { return new(BulkDiscovery) }},
+	Responses: []openapi.Response{
+		{Status: "200", Description: "OK: The operation was done successfully.", ContentType: "application/json", Schema: "DiscoveryBackgroundJobStatusObject", Headers: []string{"Content-Type", "X-Checkmk-Edition", "X-Checkmk-Version"}, New: func() interface{} { return new(DiscoveryBackgroundJobStatusObject) }},
+		{Status: "400", Description: "Bad Request: Parameter or validation failure.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "403", Description: "Forbidden: Configuration via Setup is disabled.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "406", Description: "Not Acceptable: The requests accept headers can not be satisfied.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "409", Description: "Conflict: A bulk discovery job is already active", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "415", Description: "Unsupported Media Type: The submitted content-type is not supported.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+	},
+}
+
+// DowntimeDeleteDowntimeOperation describes the "Delete a scheduled downtime" operation (POST /domain-types/downtime/actions/delete/invoke).
+var DowntimeDeleteDowntimeOperation = &openapi.Operation{
+	ID: "cmk.gui.plugins.openapi.endpoints.downtime.delete_downtime",
+	Name: "DowntimeDeleteDowntime",
+	Method: "POST",
+	Path: "/domain-types/downtime/actions/delete/invoke",
+	Summary: "Delete a scheduled downtime",
+	Tags: []string{"Downtimes"},
+	Parameters: []openapi.Parameter{
+		{Name: "Content-Type", In: "header", Required: true, Type: "string", Description: "A header specifying which type of content is in the request/response body. This is required when sending encoded data in a POST/PUT body. When the request body is empty, this header should not be sent."},
+	},
+	RequestBody: &openapi.RequestBody{ContentType: "application/json", Schema: "DeleteDowntime", Required: true, New: func() interface{} { return new(DeleteDowntime) }},
+	Responses: []openapi.Response{
+		{Status: "204", Description: "No Content: Operation done successfully. No further output.", ContentType: "", Schema: "", New: nil},
+		{Status: "400", Description: "Bad Request: Parameter or validation failure.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "406", Description: "Not Acceptable: The requests accept headers can not be satisfied.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "415", Description: "Unsupported Media Type: The submitted content-type is not supported.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+	},
+}
+
+// DowntimeShowDowntimesParams holds the path, query and header parameters of DowntimeShowDowntimesOperation.
+type DowntimeShowDowntimesParams struct {
+	// An query expression of the Livestatus 'downtimes' table in nested dictionary form. If you want to use multiple expressions, nest them with the AND/OR operators.
+	Query string `query:"query"`
+	// The service description. No exception is raised when the specified service description does not exist. This parameter can be combined with the host_name parameter to only filter for service downtimes of on a specific host. Cannot be used together with the query parameter.
+	ServiceDescription string `query:"service_description"`
+	// The host name. No exception is raised when the specified host name does not exist. Using this parameter only will filter for host downtimes only. Cannot be used together with the query parameter.
+	HostName string `query:"host_name"`
+	// The type of the downtime to be listed. Only filters the result when using the host_name or service_description parameter.
+	DowntimeType string `query:"downtime_type"`
+	// An existing site id
+	SiteId string `query:"site_id"`
+}
+
+// DowntimeShowDowntimesOperation describes the "Show all scheduled downtimes" operation (GET /domain-types/downtime/collections/all).
+var DowntimeShowDowntimesOperation = &openapi.Operation{
+	ID: "cmk.gui.plugins.openapi.endpoints.downtime.show_downtimes",
+	Name: "DowntimeShowDowntimes",
+	Method: "GET",
+	Path: "/domain-types/downtime/collections/all",
+	Summary: "Show all scheduled downtimes",
+	Tags: []string{"Downtimes"},
+	Parameters: []openapi.Parameter{
+		{Name: "query", In: "query", Required: false, Type: "string", Description: "An query expression of the Livestatus 'downtimes' table in nested dictionary form. If you want to use multiple expressions, nest them with the AND/OR operators."},
+		{Name: "service_description", In: "query", Required: false, Type: "string", Description: "The service description. No exception is raised when the specified service description does not exist. This parameter can be combined with the host_name parameter to only filter for service downtimes of on a specific host. Cannot be used together with the query parameter."},
+		{Name: "host_name", In: "query", Required: false, Type: "string", Description: "The host name. No exception is raised when the specified host name does not exist. Using this parameter only will filter for host downtimes only. Cannot be used together with the query parameter."},
+		{Name: "downtime_type", In: "query", Required: false, Type: "string", Description: "The type of the downtime to be listed. Only filters the result when using the host_name or service_description parameter."},
+		{Name: "site_id", In: "query", Required: false, Type: "string", Description: "An existing site id"},
+	},
+	Responses: []openapi.Response{
+		{Status: "200", Description: "OK: The operation was done successfully.", ContentType: "application/json", Schema: "DowntimeCollection", Headers: []string{"Content-Type", "X-Checkmk-Edition", "X-Checkmk-Version"}, New: func() interface{} { return new(DowntimeCollection) }},
+		{Status: "400", Description: "Bad Request: Parameter or validation failure.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "406", Description: "Not Acceptable: The requests accept headers can not be satisfied.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+	},
+	NewParams: func() interface{} { return new(DowntimeShowDowntimesParams) },
+}
+
+// DowntimeCreateHostRelatedDowntimeOperation describes the "Create a host related scheduled downtime" operation (POST /domain-types/downtime/collections/host).
+var DowntimeCreateHostRelatedDowntimeOperation = &openapi.Operation{
+	ID: "cmk.gui.plugins.openapi.endpoints.downtime.create_host_related_downtime",
+	Name: "DowntimeCreateHostRelatedDowntime",
+	Method: "POST",
+	Path: "/domain-types/downtime/collections/host",
+	Summary: "Create a host related scheduled downtime",
+	Tags: []string{"Downtimes"},
+	Parameters: []openapi.Parameter{
+		{Name: "Content-Type", In: "header", Required: true, Type: "string", Description: "A header specifying which type of content is in the request/response body. This is required when sending encoded data in a POST/PUT body. When the request body is empty, this header should not be sent."},
+	},
+	RequestBody: &openapi.RequestBody{ContentType: "application/json", Schema: "CreateHostRelatedDowntime", Required: true, New: func() interface{} { return new(CreateHostRelatedDowntime) }},
+	Responses: []openapi.Response{
+		{Status: "204", Description: "No Content: Operation done successfully. No further output.", ContentType: "", Schema: "", New: nil},
+		{Status: "400", Description: "Bad Request: Parameter or validation failure.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "406", Description: "Not Acceptable: The requests accept headers can not be satisfied.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "415", Description: "Unsupported Media Type: The submitted content-type is not supported.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "422", Description: "Unprocessable Entity: The request could not be processed.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+	},
+}
+
+// DowntimeCreateServiceRelatedDowntimeOperation describes the "Create a service related scheduled downtime" operation (POST /domain-types/downtime/collections/service).
+var DowntimeCreateServiceRelatedDowntimeOperation = &openapi.Operation{
+	ID: "cmk.gui.plugins.openapi.endpoints.downtime.create_service_related_downtime",
+	Name: "DowntimeCreateServiceRelatedDowntime",
+	Method: "POST",
+	Path: "/domain-types/downtime/collections/service",
+	Summary: "Create a service related scheduled downtime",
+	Tags: []string{"Downtimes"},
+	Parameters: []openapi.Parameter{
+		{Name: "Content-Type", In: "header", Required: true, Type: "string", Description: "A header specifying which type of content is in the request/response body. This is required when sending encoded data in a POST/PUT body. When the request body is empty, this header should not be sent."},
+	},
+	RequestBody: &openapi.RequestBody{ContentType: "application/json", Schema: "CreateServiceRelatedDowntime", Required: true, New: func() interface{} { return new(CreateServiceRelatedDowntime) }},
+	Responses: []openapi.Response{
+		{Status: "204", Description: "No Content: Operation done successfully. No further output.", ContentType: "", Schema: "", New: nil},
+		{Status: "400", Description: "Bad Request: Parameter or validation failure.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "406", Description: "Not Acceptable: The requests accept headers can not be satisfied.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "415", Description: "Unsupported Media Type: The submitted content-type is not supported.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "422", Description: "Unprocessable Entity: The request could not be processed.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+	},
+}
+
+// EventConsoleChangeMultipleEventStatesOperation describes the "Change multiple event states" operation (POST /domain-types/event_console/actions/change_state/invoke).
+var EventConsoleChangeMultipleEventStatesOperation = &openapi.Operation{
+	ID: "cmk.gui.plugins.openapi.endpoints.event_console.change_multiple_event_states",
+	Name: "EventConsoleChangeMultipleEventStates",
+	Method: "POST",
+	Path: "/domain-types/event_console/actions/change_state/invoke",
+	Summary: "Change multiple event states",
+	Tags: []string{"Event Console"},
+	Parameters: []openapi.Parameter{
+		{Name: "Content-Type", In: "header", Required: true, Type: "string", Description: "A header specifying which type of content is in the request/response body. This is required when sending encoded data in a POST/PUT body. When the request body is empty, this header should not be sent."},
+	},
+	RequestBody: &openapi.RequestBody{ContentType: "application/json", Schema: "ChangeEventStateSelector", Required: true, New: func() interface{} { return new(ChangeEventStateSelector) }},
+	Responses: []openapi.Response{
+		{Status: "204", Description: "No Content: Operation done successfully. No further output.", ContentType: "", Schema: "", New: nil},
+		{Status: "400", Description: "Bad Request: Parameter or validation failure.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "406", Description: "Not Acceptable: The requests accept headers can not be satisfied.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "415", Description: "Unsupported Media Type: The submitted content-type is not supported.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+	},
+}
+
+// EventConsoleArchiveEventsWithFilterOperation describes the "Archive events" operation (POST /domain-types/event_console/actions/delete/invoke).
+var EventConsoleArchiveEventsWithFilterOperation = &openapi.Operation{
+	ID: "cmk.gui.plugins.openapi.endpoints.event_console.archive_events_with_filter",
+	Name: "EventConsoleArchiveEventsWithFilter",
+	Method: "POST",
+	Path: "/domain-types/event_console/actions/delete/invoke",
+	Summary: "Archive events",
+	Tags: []string{"Event Console"},
+	Parameters: []openapi.Parameter{
+		{Name: "Content-Type", In: "header", Required: true, Type: "string", Description: "A header specifying which type of content is in the request/response body. This is required when sending encoded data in a POST/PUT body. When the request body is empty, this header should not be sent."},
+	},
+	RequestBody: &openapi.RequestBody{ContentType: "application/json", Schema: "DeleteECEvents", Required: true, New: func() interface{} { return new(DeleteECEvents) }},
+	Responses: []openapi.Response{
+		{Status: "204", Description: "No Content: Operation done successfully. No further output.", ContentType: "", Schema: "", New: nil},
+		{Status: "400", Description: "Bad Request: Parameter or validation failure.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "406", Description: "Not Acceptable: The requests accept headers can not be satisfied.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "415", Description: "Unsupported Media Type: The submitted content-type is not supported.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+	},
+}
+
+// EventConsoleUpdateAndAcknowledgeMultipleEventsOperation describes the "Update and acknowledge events" operation (POST /domain-types/event_console/actions/update_and_acknowledge/invoke).
+var EventConsoleUpdateAndAcknowledgeMultipleEventsOperation = &openapi.Operation{
+	ID: "cmk.gui.plugins.openapi.endpoints.event_console.update_and_acknowledge_multiple_events",
+	Name: "EventConsoleUpdateAndAcknowledgeMultipleEvents",
+	Method: "POST",
+	Path: "/domain-types/event_console/actions/update_and_acknowledge/invoke",
+	Summary: "Update and acknowledge events",
+	Tags: []string{"Event Console"},
+	Parameters: []openapi.Parameter{
+		{Name: "Content-Type", In: "header", Required: true, Type: "string", Description: "A header specifying which type of content is in the request/response body. This is required when sending encoded data in a POST/PUT body. When the request body is empty, this header should not be sent."},
+	},
+	RequestBody: &openapi.RequestBody{ContentType: "application/json", Schema: "UpdateAndAcknowledgeSelector", Required: true, New: func() interface{} { return new(UpdateAndAcknowledgeSelector) }},
+	Responses: []openapi.Response{
+		{Status: "204", Description: "No Content: Operation done successfully. No further output.", ContentType: "", Schema: "", New: nil},
+		{Status: "400", Description: "Bad Request: Parameter or validation failure.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "406", Description: "Not Acceptable: The requests accept headers can not be satisfied.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "415", Description: "Unsupported Media Type: The submitted content-type is not supported.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+	},
+}
+
+// EventConsoleShowEventsParams holds the path, query and header parameters of EventConsoleShowEventsOperation.
+type EventConsoleShowEventsParams struct {
+	// An query expression of the Livestatus 'eventconsoleevents' table in nested dictionary form. If you want to use multiple expressions, nest them with the AND/OR operators.
+	Query string `query:"query"`
+	// The host name. No exception is raised when the specified host name does not exist
+	Host string `query:"host"`
+	// Show events that originated from this app.
+	Application string `query:"application"`
+	// The state
+	State string `query:"state"`
+	// The event phase, open or ack
+	Phase string `query:"phase"`
+	// An existing site id
+	SiteId string `query:"site_id"`
+}
+
+// EventConsoleShowEventsOperation describes the "Show events" operation (GET /domain-types/event_console/collections/all).
+var EventConsoleShowEventsOperation = &openapi.Operation{
+	ID: "cmk.gui.plugins.openapi.endpoints.event_console.show_events",
+	Name: "EventConsoleShowEvents",
+	Method: "GET",
+	Path: "/domain-types/event_console/collections/all",
+	Summary: "Show events",
+	Tags: []string{"Event Console"},
+	Parameters: []openapi.Parameter{
+		{Name: "query", In: "query", Required: false, Type: "string", Description: "An query expression of the Livestatus 'eventconsoleevents' table in nested dictionary form. If you want to use multiple expressions, nest them with the AND/OR operators."},
+		{Name: "host", In: "query", Required: false, Type: "string", Description: "The host name. No exception is raised when the specified host name does not exist"},
+		{Name: "application", In: "query", Required: false, Type: "string", Description: "Show events that originated from this app."},
+		{Name: "state", In: "query", Required: false, Type: "string", Description: "The state"},
+		{Name: "phase", In: "query", Required: false, Type: "string", Description: "The event phase, open or ack"},
+		{Name: "site_id", In: "query", Required: false, Type: "string", Description: "An existing site id"},
+	},
+	Responses: []openapi.Response{
+		{Status: "200", Description: "OK: The operation was done successfully.", ContentType: "application/json", Schema: "EventConsoleResponseCollection", Headers: []string{"Content-Type", "X-Checkmk-Edition", "X-Checkmk-Version"}, New: func() interface{} { return new(EventConsoleResponseCollection) }},
+		{Status: "400", Description: "Bad Request: Parameter or validation failure.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "406", Description: "Not Acceptable: The requests accept headers can not be satisfied.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+	},
+	NewParams: func() interface{} { return new(EventConsoleShowEventsParams) },
+}
+
+// FolderConfigBulkUpdateOperation describes the "Bulk update folders" operation (PUT /domain-types/folder_config/actions/bulk-update/invoke).
+var FolderConfigBulkUpdateOperation = &openapi.Operation{
+	ID: "cmk.gui.plugins.openapi.endpoints.folder_config.bulk_update",
+	Name: "FolderConfigBulkUpdate",
+	Method: "PUT",
+	Path: "/domain-types/folder_config/actions/bulk-update/invoke",
+	Summary: "Bulk update folders",
+	Tags: []string{"Folders"},
+	Parameters: []openapi.Parameter{
+		{Name: "Content-Type", In: "header", Required: true, Type: "string", Description: "A header specifying which type of content is in the request/response body. This is required when sending encoded data in a POST/PUT body. When the request body is empty, this header should not be sent."},
+	},
+	RequestBody: &openapi.RequestBody{ContentType: "application/json", Schema: "BulkUpdateFolder", Required: true, New: func() interface{} { return new(BulkUpdateFolder) }},
+	Responses: []openapi.Response{
+		{Status: "200", Description: "OK: The operation was done successfully.", ContentType: "application/json", Schema: "FolderCollection", Headers: []string{"Content-Type", "X-Checkmk-Edition", "X-Checkmk-Version"}, New: func() interface{} { return new(FolderCollection) }},
+		{Status: "400", Description: "Bad Request: Parameter or validation failure.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "403", Description: "Forbidden: Configuration via Setup is disabled.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "406", Description: "Not Acceptable: The requests accept headers can not be satisfied.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "415", Description: "Unsupported Media Type: The submitted content-type is not supported.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+	},
+}
+
+// FolderConfigListFoldersParams holds the path, query and header parameters of FolderConfigListFoldersOperation.
+type FolderConfigListFoldersParams struct {
+	// Show all sub-folders of this folder. The default is the root-folder. Path delimiters can be either `~`, `/` or `\`. Please use the one most appropriate for your quoting/escaping needs. A good default choice is `~`.
+	Parent string `query:"parent"`
+	// List the folder (default: root) and all its sub-folders recursively.
+	Recursive *bool `query:"recursive"`
+	// When set, all hosts that are stored in each folder will also be shown. On large setups this may come at a performance cost, so by default this is switched off.
+	ShowHosts *bool `query:"show_hosts"`
+}
+
+// FolderConfigListFoldersOperation describes the "Show all folders" operation (GET /domain-types/folder_config/collections/all).
+var FolderConfigListFoldersOperation = &openapi.Operation{
+	ID: "cmk.gui.plugins.openapi.endpoints.folder_config.list_folders",
+	Name: "FolderConfigListFolders",
+	Method: "GET",
+	Path: "/domain-types/folder_config/collections/all",
+	Summary: "Show all folders",
+	Tags: []string{"Folders"},
+	Parameters: []openapi.Parameter{
+		{Name: "parent", In: "query", Required: false, Type: "string", Description: "Show all sub-folders of this folder. The default is the root-folder. Path delimiters can be either `~`, `/` or `\\`. Please use the one most appropriate for your quoting/escaping needs. A good default choice is `~`."},
+		{Name: "recursive", In: "query", Required: false, Type: "boolean", Description: "List the folder (default: root) and all its sub-folders recursively."},
+		{Name: "show_hosts", In: "query", Required: false, Type: "boolean", Description: "When set, all hosts that are stored in each folder will also be shown. On large setups this may come at a performance cost, so by default this is switched off."},
+	},
+	Responses: []openapi.Response{
+		{Status: "200", Description: "OK: The operation was done successfully.", ContentType: "application/json", Schema: "FolderCollection", Headers: []string{"Content-Type", "X-Checkmk-Edition", "X-Checkmk-Version"}, New: func() interface{} { return new(FolderCollection) }},
+		{Status: "400", Description: "Bad Request: Parameter or validation failure.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "403", Description: "Forbidden: Configuration via Setup is disabled.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "406", Description: "Not Acceptable: The requests accept headers can not be satisfied.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+	},
+	NewParams: func() interface{} { return new(FolderConfigListFoldersParams) },
+}
+
+// FolderConfigCreateOperation describes the "Create a folder" operation (POST /domain-types/folder_config/collections/all).
+var FolderConfigCreateOperation = &openapi.Operation{
+	ID: "cmk.gui.plugins.openapi.endpoints.folder_config.create",
+	Name: "FolderConfigCreate",
+	Method: "POST",
+	Path: "/domain-types/folder_config/collections/all",
+	Summary: "Create a folder",
+	Tags: []string{"Folders"},
+	Parameters: []openapi.Parameter{
+		{Name: "Content-Type", In: "header", Required: true, Type: "string", Description: "A header specifying which type of content is in the request/response body. This is required when sending encoded data in a POST/PUT body. When the request body is empty, this header should not be sent."},
+	},
+	RequestBody: &openapi.RequestBody{ContentType: "application/json", Schema: "CreateFolder", Required: true, New: func() interface{} { return new(CreateFolder) }},
+	Responses: []openapi.Response{
+		{Status: "200", Description: "OK: The operation was done successfully.", ContentType: "application/json", Schema: "Folder", Headers: []string{"Content-Type", "ETag", "X-Checkmk-Edition", "X-Checkmk-Version"}, New: func() interface{} { return new(Folder) }},
+		{Status: "400", Description: "Bad Request: Parameter or validation failure.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "403", Description: "Forbidden: Configuration via Setup is disabled.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "406", Description: "Not Acceptable: The requests accept headers can not be satisfied.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "415", Description: "Unsupported Media Type: The submitted content-type is not supported.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+	},
+}
+
+// HostConfigBulkCreateHostsParams holds the path, query and header parameters of HostConfigBulkCreateHostsOperation.
+type HostConfigBulkCreateHostsParams struct {
+	// Tries to bake the agents for the just created hosts. This process is started in the background after configuring the host. Please note that the backing may take some time and might block subsequent API calls. This only works when using the Enterprise Editions.
+	BakeAgent *bool `query:"bake_agent"`
+}
+
+// HostConfigBulkCreateHostsOperation describes the "Bulk create hosts" operation (POST /domain-types/host_config/actions/bulk-create/invoke).
+var HostConfigBulkCreateHostsOperation = &openapi.Operation{
+	ID: "cmk.gui.plugins.openapi.endpoints.host_config.bulk_create_hosts",
+	Name: "HostConfigBulkCreateHosts",
+	Method: "POST",
+	Path: "/domain-types/host_config/actions/bulk-create/invoke",
+	Summary: "Bulk create hosts",
+	Tags: []string{"Hosts"},
+	Parameters: []openapi.Parameter{
+		{Name: "Content-Type", In: "header", Required: true, Type: "string", Description: "A header specifying which type of content is in the request/response body. This is required when sending encoded data in a POST/PUT body. When the request body is empty, this header should not be sent."},
+		{Name: "bake_agent", In: "query", Required: false, Type: "boolean", Description: "Tries to bake the agents for the just created hosts. This process is started in the background after configuring the host. Please note that the backing may take some time and might block subsequent API calls. This only works when using the Enterprise Editions."},
+	},
+	RequestBody: &openapi.RequestBody{ContentType: "application/json", Schema: "BulkCreateHost", Required: true, New: func() interface{} { return new(BulkCreateHost) }},
+	Responses: []openapi.Response{
+		{Status: "200", Description: "OK: The operation was done successfully.", ContentType: "application/json", Schema: "HostConfigCollection", Headers: []string{"Content-Type", "X-Checkmk-Edition", "X-Checkmk-Version"}, New: func() interface{} { return new(HostConfigCollection) }},
+		{Status: "400", Description: "Bad Request: Parameter or validation failure.", ContentType: "application/problem+json", Schema: "BulkHostActionWithFailedHosts", New: func() interface{} { return new(BulkHostActionWithFailedHosts) }},
+		{Status: "403", Description: "Forbidden: Configuration via Setup is disabled.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "406", Description: "Not Acceptable: The requests accept headers can not be satisfied.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "415", Description: "Unsupported Media Type: The submitted content-type is not supported.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+	},
+	NewParams: func() interface{} { return new(HostConfigBulkCreateHostsParams) },
+}
+
+// HostConfigBulkDeleteOperation describes the "Bulk delete hosts" operation (POST /domain-types/host_config/actions/bulk-delete/invoke).
+var HostConfigBulkDeleteOperation = &openapi.Operation{
+	ID: "cmk.gui.plugins.openapi.endpoints.host_config.bulk_delete",
+	Name: "HostConfigBulkDelete",
+	Method: "POST",
+	Path: "/domain-types/host_config/actions/bulk-delete/invoke",
+	Summary: "Bulk delete hosts",
+	Tags: []string{"Hosts"},
+	Parameters: []openapi.Parameter{
+		{Name: "Content-Type", In: "header", Required: true, Type: "string", Description: "A header specifying which type of content is in the request/response body. This is required when sending encoded data in a POST/PUT body. When the request body is empty, this header should not be sent."},
+	},
+	RequestBody: &openapi.RequestBody{ContentType: "application/json", Schema: "BulkDeleteHost", Required: true, New: func() interface{} { return new(BulkDeleteHost) }},
+	Responses: []openapi.Response{
+		{Status: "204", Description: "No Content: Operation done successfully. No further output.", ContentType: "", Schema: "", New: nil},
+		{Status: "400", Description: "Bad Request: Parameter or validation failure.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "403", Description: "Forbidden: Configuration via Setup is disabled.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "406", Description: "Not Acceptable: The requests accept headers can not be satisfied.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "415", Description: "Unsupported Media Type: The submitted content-type is not supported.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+	},
+}
+
+// HostConfigBulkUpdateHostsOperation describes the "Bulk update hosts" operation (PUT /domain-types/host_config/actions/bulk-update/invoke).
+var HostConfigBulkUpdateHostsOperation = &openapi.Operation{
+	ID: "cmk.gui.plugins.openapi.endpoints.host_config.bulk_update_hosts",
+	Name: "HostConfigBulkUpdateHosts",
+	Method: "PUT",
+	Path: "/domain-types/host_config/actions/bulk-update/invoke",
+	Summary: "Bulk update hosts",
+	Tags: []string{"Hosts"},
+	Parameters: []openapi.Parameter{
+		{Name: "Content-Type", In: "header", Required: true, Type: "string", Description: "A header specifying which type of content is in the request/response body. This is required when sending encoded data in a POST/PUT body. When the request body is empty, this header should not be sent."},
+	},
+	RequestBody: &openapi.RequestBody{ContentType: "application/json", Schema: "BulkUpdateHost", Required: true, New: func() interface{} { return new(BulkUpdateHost) }},
+	Responses: []openapi.Response{
+		{Status: "200", Description: "OK: The operation was done successfully.", ContentType: "application/json", Schema: "HostConfigCollection", Headers: []string{"Content-Type", "X-Checkmk-Edition", "X-Checkmk-Version"}, New: func() interface{} { return new(HostConfigCollection) }},
+		{Status: "400", Description: "Bad Request: Parameter or validation failure.", ContentType: "application/problem+json", Schema: "BulkHostActionWithFailedHosts", New: func() interface{} { return new(BulkHostActionWithFailedHosts) }},
+		{Status: "403", Description: "Forbidden: Configuration via Setup is disabled.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "406", Description: "Not Acceptable: The requests accept headers can not be satisfied.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "415", Description: "Unsupported Media Type: The submitted content-type is not supported.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+	},
+}
+
+// HostConfigListHostsParams holds the path, query and header parameters of HostConfigListHostsOperation.
+type HostConfigListHostsParams struct {
+	// Show all effective attributes on hosts, not just the attributes which were set on this host specifically. This includes all attributes of all of this host's parent folders.
+	EffectiveAttributes *bool `query:"effective_attributes"`
+}
+
+// HostConfigListHostsOperation describes the "Show all hosts" operation (GET /domain-types/host_config/collections/all).
+var HostConfigListHostsOperation = &openapi.Operation{
+	ID: "cmk.gui.plugins.openapi.endpoints.host_config.list_hosts",
+	Name: "HostConfigListHosts",
+	Method: "GET",
+	Path: "/domain-types/host_config/collections/all",
+	Summary: "Show all hosts",
+	Tags: []string{"Hosts"},
+	Parameters: []openapi.Parameter{
+		{Name: "effective_attributes", In: "query", Required: false, Type: "boolean", Description: "Show all effective attributes on hosts, not just the attributes which were set on this host specifically. This includes all attributes of all of this host's parent folders."},
+	},
+	Responses: []openapi.Response{
+		{Status: "200", Description: "OK: The operation was done successfully.", ContentType: "application/json", Schema: "HostConfigCollection", Headers: []string{"Content-Type", "X-Checkmk-Edition", "X-Checkmk-Version"}, New: func() interface{} { return new(HostConfigCollection) }},
+		{Status: "400", Description: "Bad Request: Parameter or validation failure.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "403", Description: "Forbidden: Configuration via Setup is disabled.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "406", Description: "Not Acceptable: The requests accept headers can not be satisfied.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+	},
+	NewParams: func() interface{} { return new(HostConfigListHostsParams) },
+}
+
+// HostConfigCreateHostParams holds the path, query and header parameters of HostConfigCreateHostOperation.
+type HostConfigCreateHostParams struct {
+	// Tries to bake the agents for the just created hosts. This process is started in the background after configuring the host. Please note that the backing may take some time and might block subsequent API calls. This only works when using the Enterprise Editions.
+	BakeAgent *bool `query:"bake_agent"`
+}
+
+// HostConfigCreateHostOperation describes the "Create a host" operation (POST /domain-types/host_config/collections/all).
+var HostConfigCreateHostOperation = &openapi.Operation{
+	ID: "cmk.gui.plugins.openapi.endpoints.host_config.create_host",
+	Name: "HostConfigCreateHost",
+	Method: "POST",
+	Path: "/domain-types/host_config/collections/all",
+	Summary: "Create a host",
+	Tags: []string{"Hosts"},
+	Parameters: []openapi.Parameter{
+		{Name: "Content-Type", In: "header", Required: true, Type: "string", Description: "A header specifying which type of content is in the request/response body. This is required when sending encoded data in a POST/PUT body. When the request body is empty, this header should not be sent."},
+		{Name: "bake_agent", In: "query", Required: false, Type: "boolean", Description: "Tries to bake the agents for the just created hosts. This process is started in the background after configuring the host. Please note that the backing may take some time and might block subsequent API calls. This only works when using the Enterprise Editions."},
+	},
+	RequestBody: &openapi.RequestBody{ContentType: "application/json", Schema: "CreateHost", Required: true, New: func() interface{} { return new(CreateHost) }},
+	Responses: []openapi.Response{
+		{Status: "200", Description: "OK: The operation was done successfully.", ContentType: "application/json", Schema: "HostConfig", Headers: []string{"Content-Type", "ETag", "X-Checkmk-Edition", "X-Checkmk-Version"}, New: func() interface{} { return new(HostConfig) }},
+		{Status: "400", Description: "Bad Request: Parameter or validation failure.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "403", Description: "Forbidden: Configuration via Setup is disabled.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "406", Description: "Not Acceptable: The requests accept headers can not be satisfied.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "415", Description: "Unsupported Media Type: The submitted content-type is not supported.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+	},
+	NewParams: func() interface{} { return new(HostConfigCreateHostParams) },
+}
+
+// HostConfigCreateClusterHostParams holds the path, query and header parameters of HostConfigCreateClusterHostOperation.
+type HostConfigCreateClusterHostParams struct {
+	// Tries to bake the agents for the just created hosts. This process is started in the background after configuring the host. Please note that the backing may take some time and might block subsequent API calls. This only works when using the Enterprise Editions.
+	BakeAgent *bool `query:"bake_agent"`
+}
+
+// HostConfigCreateClusterHostOperation describes the "Create a cluster host" operation (POST /domain-types/host_config/collections/clusters).
+var HostConfigCreateClusterHostOperation = &openapi.Operation{
+	ID: "cmk.gui.plugins.openapi.endpoints.host_config.create_cluster_host",
+	Name: "HostConfigCreateClusterHost",
+	Method: "POST",
+	Path: "/domain-types/host_config/collections/clusters",
+	Summary: "Create a cluster host",
+	Tags: []string{"Hosts"},
+	Parameters: []openapi.Parameter{
+		{Name: "Content-Type", In: "header", Required: true, Type: "string", Description: "A header specifying which type of content is in the request/response body. This is required when sending encoded data in a POST/PUT body. When the request body is empty, this header should not be sent."},
+		{Name: "bake_agent", In: "query", Required: false, Type: "boolean", Description: "Tries to bake the agents for the just created hosts. This process is started in the background after configuring the host. Please note that the backing may take some time and might block subsequent API calls. This only works when using the Enterprise Editions."},
+	},
+	RequestBody: &openapi.RequestBody{ContentType: "application/json", Schema: "CreateClusterHost", Required: true, New: func() interface{} { return new(CreateClusterHost) }},
+	Responses: []openapi.Response{
+		{Status: "200", Description: "OK: The operation was done successfully.", ContentType: "application/json", Schema: "HostConfig", Headers: []string{"Content-Type", "ETag", "X-Checkmk-Edition", "X-Checkmk-Version"}, New: func() interface{} { return new(HostConfig) }},
+		{Status: "400", Description: "Bad Request: Parameter or validation failure.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "403", Description: "Forbidden: Configuration via Setup is disabled.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "406", Description: "Not Acceptable: The requests accept headers can not be satisfied.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "415", Description: "Unsupported Media Type: The submitted content-type is not supported.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+	},
+	NewParams: func() interface{} { return new(HostConfigCreateClusterHostParams) },
+}
+
+// HostGroupConfigBulkCreateOperation describes the "Bulk create host groups" operation (POST /domain-types/host_group_config/actions/bulk-create/invoke).
+var HostGroupConfigBulkCreateOperation = &openapi.Operation{
+	ID: "cmk.gui.plugins.openapi.endpoints.host_group_config.bulk_create",
+	Name: "HostGroupConfigBulkCreate",
+	Method: "POST",
+	Path: "/domain-types/host_group_config/actions/bulk-create/invoke",
+	Summary: "Bulk create host groups",
+	Tags: []string{"Host groups"},
+	Parameters: []openapi.Parameter{
+		{Name: "Content-Type", In: "header", Required: true, Type: "string", Description: "A header specifying which type of content is in the request/response body. This is required when sending encoded data in a POST/PUT body. When the request body is empty, this header should not be sent."},
+	},
+	RequestBody: &openapi.RequestBody{ContentType: "application/json", Schema: "BulkInputHostGroup", Required: true, New: func() interface{} { return new(BulkInputHostGroup) }},
+	Responses: []openapi.Response{
+		{Status: "200", Description: "OK: The operation was done successfully.", ContentType: "application/json", Schema: "HostGroupCollection", Headers: []string{"Content-Type", "X-Checkmk-Edition", "X-Checkmk-Version"}, New: func() interface{} { return new(HostGroupCollection) }},
+		{Status: "400", Description: "Bad Request: Parameter or validation failure.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "403", Description: "Forbidden: Configuration via Setup is disabled.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "406", Description: "Not Acceptable: The requests accept headers can not be satisfied.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "415", Description: "Unsupported Media Type: The submitted content-type is not supported.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+	},
+}
+
+// HostGroupConfigBulkDeleteOperation describes the "Bulk delete host groups" operation (POST /domain-types/host_group_config/actions/bulk-delete/invoke).
+var HostGroupConfigBulkDeleteOperation = &openapi.Operation{
+	ID: "cmk.gui.plugins.openapi.endpoints.host_group_config.bulk_delete",
+	Name: "HostGroupConfigBulkDelete",
+	Method: "POST",
+	Path: "/domain-types/host_group_config/actions/bulk-delete/invoke",
+	Summary: "Bulk delete host groups",
+	Tags: []string{"Host groups"},
+	Parameters: []openapi.Parameter{
+		{Name: "Content-Type", In: "header", Required: true, Type: "string", Description: "A header specifying which type of content is in the request/response body. This is required when sending encoded data in a POST/PUT body. When the request body is empty, this header should not be sent."},
+	},
+	RequestBody: &openapi.RequestBody{ContentType: "application/json", Schema: "BulkDeleteHostGroup", Required: true, New: func() interface{} { return new(BulkDeleteHostGroup) }},
+	Responses: []openapi.Response{
+		{Status: "204", Description: "No Content: Operation done successfully. No further output.", ContentType: "", Schema: "", New: nil},
+		{Status: "400", Description: "Bad Request: Parameter or validation failure.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "403", Description: "Forbidden: Configuration via Setup is disabled.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "404", Description: "Not Found: The requested object has not been found.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "406", Description: "Not Acceptable: The requests accept headers can not be satisfied.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "409", Description: "Conflict: The request is in conflict with the stored resource.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "415", Description: "Unsupported Media Type: The submitted content-type is not supported.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+	},
+}
+
+// HostGroupConfigBulkUpdateOperation describes the "Bulk update host groups" operation (PUT /domain-types/host_group_config/actions/bulk-update/invoke).
+var HostGroupConfigBulkUpdateOperation = &openapi.Operation{
+	ID: "cmk.gui.plugins.openapi.endpoints.host_group_config.bulk_update",
+	Name: "HostGroupConfigBulkUpdate",
+	Method: "PUT",
+	Path: "/domain-types/host_group_config/actions/bulk-update/invoke",
+	Summary: "Bulk update host groups",
+	Tags: []string{"Host groups"},
+	Parameters: []openapi.Parameter{
+		{Name: "Content-Type", In: "header", Required: true, Type: "string", Description: "A header specifying which type of content is in the request/response body. This is required when sending encoded data in a POST/PUT body. When the request body is empty, this header should not be sent."},
+	},
+	RequestBody: &openapi.RequestBody{ContentType: "application/json", Schema: "BulkUpdateHostGroup", Required: true, New: func() interface{} { return new(BulkUpdateHostGroup) }},
+	Responses: []openapi.Response{
+		{Status: "200", Description: "OK: The operation was done successfully.", ContentType: "application/json", Schema: "HostGroupCollection", Headers: []string{"Content-Type", "X-Checkmk-Edition", "X-Checkmk-Version"}, New: func() interface{} { return new(HostGroupCollection) }},
+		{Status: "400", Description: "Bad Request: Parameter or validation failure.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "403", Description: "Forbidden: Configuration via Setup is disabled.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "406", Description: "Not Acceptable: The requests accept headers can not be satisfied.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "415", Description: "Unsupported Media Type: The submitted content-type is not supported.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+	},
+}
+
+// HostGroupConfigListGroupsOperation describes the "Show all host groups" operation (GET /domain-types/host_group_config/collections/all).
+var HostGroupConfigListGroupsOperation = &openapi.Operation{
+	ID: "cmk.gui.plugins.openapi.endpoints.host_group_config.list_groups",
+	Name: "HostGroupConfigListGroups",
+	Method: "GET",
+	Path: "/domain-types/host_group_config/collections/all",
+	Summary: "Show all host groups",
+	Tags: []string{"Host groups"},
+	Responses: []openapi.Response{
+		{Status: "200", Description: "OK: The operation was done successfully.", ContentType: "application/json", Schema: "HostGroupCollection", Headers: []string{"Content-Type", "X-Checkmk-Edition", "X-Checkmk-Version"}, New: func() interface{} { return new(HostGroupCollection) }},
+		{Status: "403", Description: "Forbidden: Configuration via Setup is disabled.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "406", Description: "Not Acceptable: The requests accept headers can not be satisfied.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+	},
+}
+
+// HostGroupConfigCreateOperation describes the "Create a host group" operation (POST /domain-types/host_group_config/collections/all).
+var HostGroupConfigCreateOperation = &openapi.Operation{
+	ID: "cmk.gui.plugins.openapi.endpoints.host_group_config.create",
+	Name: "HostGroupConfigCreate",
+	Method: "POST",
+	Path: "/domain-types/host_group_config/collections/all",
+	Summary: "Create a host group",
+	Tags: []string{"Host groups"},
+	Parameters: []openapi.Parameter{
+		{Name: "Content-Type", In: "header", Required: true, Type: "string", Description: "A header specifying which type of content is in the request/response body. This is required when sending encoded data in a POST/PUT body. When the request body is empty, this header should not be sent."},
+	},
+	RequestBody: &openapi.RequestBody{ContentType: "application/json", Schema: "InputHostGroup", Required: true, New: func() interface{} { return new(InputHostGroup) }},
+	Responses: []openapi.Response{
+		{Status: "200", Description: "OK: The operation was done successfully.", ContentType: "application/json", Schema: "HostGroup", Headers: []string{"Content-Type", "ETag", "X-Checkmk-Edition", "X-Checkmk-Version"}, New: func() interface{} { return new(HostGroup) }},
+		{Status: "400", Description: "Bad Request: Parameter or validation failure.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "403", Description: "Forbidden: Configuration via Setup is disabled.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "406", Description: "Not Acceptable: The requests accept headers can not be satisfied.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "415", Description: "Unsupported Media Type: The submitted content-type is not supported.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+	},
+}
+
+// HostTagGroupListHostTagGroupsOperation describes the "Show all host tag groups" operation (GET /domain-types/host_tag_group/collections/all).
+var HostTagGroupListHostTagGroupsOperation = &openapi.Operation{
+	ID: "cmk.gui.plugins.openapi.endpoints.host_tag_group.list_host_tag_groups",
+	Name: "HostTagGroupListHostTagGroups",
+	Method: "GET",
+	Path: "/domain-types/host_tag_group/collections/all",
+	Summary: "Show all host tag groups",
+	Tags: []string{"Host tag groups"},
+	Responses: []openapi.Response{
+		{Status: "200", Description: "OK: The operation was done successfully.", ContentType: "application/json", Schema: "HostTagGroupCollection", Headers: []string{"Content-Type", "X-Checkmk-Edition", "X-Checkmk-Version"}, New: func() interface{} { return new(HostTagGroupCollection) }},
+		{Status: "403", Description: "Forbidden: Configuration via Setup is disabled.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "406", Description: "Not Acceptable: The requests accept headers can not be satisfied.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+	},
+}
+
+// HostTagGroupCreateHostTagGroupOperation describes the "Create a host tag group" operation (POST /domain-types/host_tag_group/collections/all).
+var HostTagGroupCreateHostTagGroupOperation = &openapi.Operation{
+	ID: "cmk.gui.plugins.openapi.endpoints.host_tag_group.create_host_tag_group",
+	Name: "HostTagGroupCreateHostTagGroup",
+	Method: "POST",
+	Path: "/domain-types/host_tag_group/collections/all",
+	Summary: "Create a host tag group",
+	Tags: []string{"Host tag groups"},
+	Parameters: []openapi.Parameter{
+		{Name: "Content-Type", In: "header", Required: true, Type: "string", Description: "A header specifying which type of content is in the request/response body. This is required when sending encoded data in a POST/PUT body. When the request body is empty, this header should not be sent."},
+	},
+	RequestBody: &openapi.RequestBody{ContentType: "application/json", Schema: "InputHostTagGroup", Required: true, New: func() interface{} { return new(InputHostTagGroup) }},
+	Responses: []openapi.Response{
+		{Status: "200", Description: "OK: The operation was done successfully.", ContentType: "application/json", Schema: "ConcreteHostTagGroup", Headers: []string{"Content-Type", "ETag", "X-Checkmk-Edition", "X-Checkmk-Version"}, New: func() interface{} { return new(ConcreteHostTagGroup) }},
+		{Status: "400", Description: "Bad Request: Parameter or validation failure.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "403", Description: "Forbidden: Configuration via Setup is disabled.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "406", Description: "Not Acceptable: The requests accept headers can not be satisfied.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "415", Description: "Unsupported Media Type: The submitted content-type is not supported.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+	},
+}
+
+// MetricGetGraphOperation describes the "Get metrics" operation (POST /domain-types/metric/actions/get/invoke).
+var MetricGetGraphOperation = &openapi.Operation{
+	ID: "cmk.gui.plugins.openapi.endpoints.metric.get_graph",
+	Name: "MetricGetGraph",
+	Method: "POST",
+	Path: "/domain-types/metric/actions/get/invoke",
+	Summary: "Get metrics",
+	Tags: []string{"Metrics"},
+	Parameters: []openapi.Parameter{
+		{Name: "Content-Type", In: "header", Required: true, Type: "string", Description: "A header specifying which type of content is in the request/response body. This is required when sending encoded data in a POST/PUT body. When the request body is empty, this header should not be sent."},
+	},
+	RequestBody: &openapi.RequestBody{ContentType: "application/json", Schema: "Get", Required: true, New: func() interface{} { return new(Get) }},
+	Responses: []openapi.Response{
+		{Status: "200", Description: "OK: The operation was done successfully.", ContentType: "application/json", Schema: "GraphCollection", Headers: []string{"Content-Type", "X-Checkmk-Edition", "X-Checkmk-Version"}, New: func() interface{} { return new(GraphCollection) }},
+		{Status: "400", Description: "Bad Request: Parameter or validation failure.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "406", Description: "Not Acceptable: The requests accept headers can not be satisfied.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "415", Description: "Unsupported Media Type: The submitted content-type is not supported.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+	},
+}
+
+// NotificationRulesShowRulesOperation describes the "Show all notification rules" operation (GET /domain-types/notification_rule/collections/all).
+var NotificationRulesShowRulesOperation = &openapi.Operation{
+	ID: "cmk.gui.plugins.openapi.endpoints.notification_rules.show_rules",
+	Name: "NotificationRulesShowRules",
+	Method: "GET",
+	Path: "/domain-types/notification_rule/collections/all",
+	Summary: "Show all notification rules",
+	Tags: []string{"Notification Rules"},
+	Responses: []openapi.Response{
+		{Status: "200", Description: "OK: The operation was done successfully.", ContentType: "application/json", Schema: "NotificationRuleResponseCollection", Headers: []string{"Content-Type", "X-Checkmk-Edition", "X-Checkmk-Version"}, New: func() interface{} { return new(NotificationRuleResponseCollection) }},
+		{Status: "403", Description: "Forbidden: Configuration via Setup is disabled.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "406", Description: "Not Acceptable: The requests accept headers can not be satisfied.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+	},
+}
+
+// NotificationRulesPostRuleOperation describes the "Create a notification rule" operation (POST /domain-types/notification_rule/collections/all).
+var NotificationRulesPostRuleOperation = &openapi.Operation{
+	ID: "cmk.gui.plugins.openapi.endpoints.notification_rules.post_rule",
+	Name: "NotificationRulesPostRule",
+	Method: "POST",
+	Path: "/domain-types/notification_rule/collections/all",
+	Summary: "Create a notification rule",
+	Tags: []string{"Notification Rules"},
+	Parameters: []openapi.Parameter{
+		{Name: "Content-Type", In: "header", Required: true, Type: "string", Description: "A header specifying which type of content is in the request/response body. This is required when sending encoded data in a POST/PUT body. When the request body is empty, this header should not be sent."},
+	},
+	RequestBody: &openapi.RequestBody{ContentType: "application/json", Schema: "NotificationRuleRequest", Required: true, New: func() interface{} { return new(NotificationRuleRequest) }},
+	Responses: []openapi.Response{
+		{Status: "200", Description: "OK: The operation was done successfully.", ContentType: "application/json", Schema: "NotificationRuleResponse", Headers: []string{"Content-Type", "X-Checkmk-Edition", "X-Checkmk-Version"}, New: func() interface{} { return new(NotificationRuleResponse) }},
+		{Status: "400", Description: "Bad Request: Parameter or validation failure.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "403", Description: "Forbidden: Configuration via Setup is disabled.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "406", Description: "Not Acceptable: The requests accept headers can not be satisfied.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "415", Description: "Unsupported Media Type: The submitted content-type is not supported.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+	},
+}
+
+// PasswordListPasswordsOperation describes the "Show all passwords" operation (GET /domain-types/password/collections/all).
+var PasswordListPasswordsOperation = &openapi.Operation{
+	ID: "cmk.gui.plugins.openapi.endpoints.password.list_passwords",
+	Name: "PasswordListPasswords",
+	Method: "GET",
+	Path: "/domain-types/password/collections/all",
+	Summary: "Show all passwords",
+	Tags: []string{"Passwords"},
+	Responses: []openapi.Response{
+		{Status: "200", Description: "OK: The operation was done successfully.", ContentType: "application/json", Schema: "PasswordCollection", Headers: []string{"Content-Type", "X-Checkmk-Edition", "X-Checkmk-Version"}, New: func() interface{} { return new(PasswordCollection) }},
+		{Status: "403", Description: "Forbidden: Configuration via Setup is disabled.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "406", Description: "Not Acceptable: The requests accept headers can not be satisfied.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+	},
+}
+
+// PasswordCreatePasswordOperation describes the "Create a password" operation (POST /domain-types/password/collections/all).
+var PasswordCreatePasswordOperation = &openapi.Operation{
+	ID: "cmk.gui.plugins.openapi.endpoints.password.create_password",
+	Name: "PasswordCreatePassword",
+	Method: "POST",
+	Path: "/domain-types/password/collections/all",
+	Summary: "Create a password",
+	Tags: []string{"Passwords"},
+	Parameters: []openapi.Parameter{
+		{Name: "Content-Type", In: "header", Required: true, Type: "string", Description: "A header specifying which type of content is in the request/response body. This is required when sending encoded data in a POST/PUT body. When the request body is empty, this header should not be sent."},
+	},
+	RequestBody: &openapi.RequestBody{ContentType: "application/json", Schema: "InputPassword", Required: true, New: func() interface{} { return new(InputPassword) }},
+	Responses: []openapi.Response{
+		{Status: "200", Description: "OK: The operation was done successfully.", ContentType: "application/json", Schema: "PasswordObject", Headers: []string{"Content-Type", "ETag", "X-Checkmk-Edition", "X-Checkmk-Version"}, New: func() interface{} { return new(PasswordObject) }},
+		{Status: "400", Description: "Bad Request: Parameter or validation failure.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "403", Description: "Forbidden: Configuration via Setup is disabled.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "406", Description: "Not Acceptable: The requests accept headers can not be satisfied.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "415", Description: "Unsupported Media Type: The submitted content-type is not supported.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+	},
+}
+
+// RuleListRulesParams holds the path, query and header parameters of RuleListRulesOperation.
+type RuleListRulesParams struct {
+	// The name of the ruleset.
+	RulesetName string `query:"ruleset_name"`
+}
+
+// RuleListRulesOperation describes the "List rules" operation (GET /domain-types/rule/collections/all).
+var RuleListRulesOperation = &openapi.Operation{
+	ID: "cmk.gui.plugins.openapi.endpoints.rule.list_rules",
+	Name: "RuleListRules",
+	Method: "GET",
+	Path: "/domain-types/rule/collections/all",
+	Summary: "List rules",
+	Tags: []string{"Rules"},
+	Parameters: []openapi.Parameter{
+		{Name: "ruleset_name", In: "query", Required: true, Type: "string", Description: "The name of the ruleset."},
+	},
+	Responses: []openapi.Response{
+		{Status: "200", Description: "OK: The operation was done successfully.", ContentType: "application/json", Schema: "RuleCollection", Headers: []string{"Content-Type", "X-Checkmk-Edition", "X-Checkmk-Version"}, New: func() interface{} { return new(RuleCollection) }},
+		{Status: "400", Description: "Bad Request: Parameter or validation failure.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "403", Description: "Forbidden: Configuration via Setup is disabled.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "406", Description: "Not Acceptable: The requests accept headers can not be satisfied.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+	},
+	NewParams: func() interface{} { return new(RuleListRulesParams) },
+}
+
+// RuleCreateRuleOperation describes the "Create rule" operation (POST /domain-types/rule/collections/all).
+var RuleCreateRuleOperation = &openapi.Operation{
+	ID: "cmk.gui.plugins.openapi.endpoints.rule.create_rule",
+	Name: "RuleCreateRule",
+	Method: "POST",
+	Path: "/domain-types/rule/collections/all",
+	Summary: "Create rule",
+	Tags: []string{"Rules"},
+	Parameters: []openapi.Parameter{
+		{Name: "Content-Type", In: "header", Required: true, Type: "string", Description: "A header specifying which type of content is in the request/response body. This is required when sending encoded data in a POST/PUT body. When the request body is empty, this header should not be sent."},
+	},
+	RequestBody: &openapi.RequestBody{ContentType: "application/json", Schema: "InputRuleObject", Required: true, New: func() interface{} { return new(InputRuleObject) }},
+	Responses: []openapi.Response{
+		{Status: "200", Description: "OK: The operation was done successfully.", ContentType: "application/json", Schema: "RuleObject", Headers: []string{"Content-Type", "ETag", "X-Checkmk-Edition", "X-Checkmk-Version"}, New: func() interface{} { return new(RuleObject) }},
+		{Status: "400", Description: "Bad Request: Parameter or validation failure.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "403", Description: "Forbidden: Configuration via Setup is disabled.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "406", Description: "Not Acceptable: The requests accept headers can not be satisfied.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "415", Description: "Unsupported Media Type: The submitted content-type is not supported.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+	},
+}
+
+// RulesetListRulesetsParams holds the path, query and header parameters of RulesetListRulesetsOperation.
+type RulesetListRulesetsParams struct {
+	// Search all keys (like `name`, `title`, `help`, etc.) for this text. Regex allowed.
+	Fulltext string `query:"fulltext"`
+	// The folder in which to search for rules. Path delimiters can be either `~`, `/` or `\`. Please use the one most appropriate for your quoting/escaping needs. A good default choice is `~`.
+	Folder string `query:"folder"`
+	// Only show deprecated rulesets. Defaults to False.
+	Deprecated *bool `query:"deprecated"`
+	// Only show used rulesets. Defaults to True.
+	Used *bool `query:"used"`
+	// A regex of the name.
+	Name string `query:"name"`
+}
+
+// RulesetListRulesetsOperation describes the "Search rule sets" operation (GET /domain-types/ruleset/collections/all).
+var RulesetListRulesetsOperation = &openapi.Operation{
+	ID: "cmk.gui.plugins.openapi.endpoints.ruleset.list_rulesets",
+	Name: "RulesetListRulesets",
+	Method: "GET",
+	Path: "/domain-types/ruleset/collections/all",
+	Summary: "Search rule sets",
+	Tags: []string{"Rulesets"},
+	Parameters: []openapi.Parameter{
+		{Name: "fulltext", In: "query", Required: false, Type: "string", Description: "Search all keys (like `name`, `title`, `help`, etc.) for this text. Regex allowed."},
+		{Name: "folder", In: "query", Required: false, Type: "string", Description: "The folder in which to search for rules. Path delimiters can be either `~`, `/` or `\\`. Please use the one most appropriate for your quoting/escaping needs. A good default choice is `~`."},
+		{Name: "deprecated", In: "query", Required: false, Type: "boolean", Description: "Only show deprecated rulesets. Defaults to False."},
+		{Name: "used", In: "query", Required: false, Type: "boolean", Description: "Only show used rulesets. Defaults to True."},
+		{Name: "name", In: "query", Required: false, Type: "string", Description: "A regex of the name."},
+	},
+	Responses: []openapi.Response{
+		{Status: "200", Description: "OK: The operation was done successfully.", ContentType: "application/json", Schema: "RulesetCollection", Headers: []string{"Content-Type", "X-Checkmk-Edition", "X-Checkmk-Version"}, New: func() interface{} { return new(RulesetCollection) }},
+		{Status: "400", Description: "Bad Request: Parameter or validation failure.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "403", Description: "Forbidden: Configuration via Setup is disabled.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "406", Description: "Not Acceptable: The requests accept headers can not be satisfied.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+	},
+	NewParams: func() interface{} { return new(RulesetListRulesetsParams) },
+}
+
+// ServiceDiscoveryShowServices14537Params holds the path, query and header parameters of ServiceDiscoveryShowServices14537Operation.
+type ServiceDiscoveryShowServices14537Params struct {
+	// The host of the discovered services.
+	HostName string `query:"host_name"`
+	// The discovery phase of the services.
+	DiscoveryPhase string `query:"discovery_phase"`
+}
+
+// ServiceDiscoveryShowServices14537Operation describes the "Show all services of specific phase" operation (GET /domain-types/service/collections/services).
+//
+// Deprecated: this endpoint is deprecated by CheckMK.
+var ServiceDiscoveryShowServices14537Operation = &openapi.Operation{
+	ID: "cmk.gui.plugins.openapi.endpoints.service_discovery.show_services-14537",
+	Name: "ServiceDiscoveryShowServices14537",
+	Method: "GET",
+	Path: "/domain-types/service/collections/services",
+	Summary: "Show all services of specific phase",
+	Tags: []string{"Service discovery"},
+	Deprecated: true,
+	Parameters: []openapi.Parameter{
+		{Name: "host_name", In: "query", Required: true, Type: "string", Description: "The host of the discovered services."},
+		{Name: "discovery_phase", In: "query", Required: true, Type: "string", Description: "The discovery phase of the services."},
+	},
+	Responses: []openapi.Response{
+		{Status: "200", Description: "OK: The operation was done successfully.", ContentType: "application/json", Schema: "DomainObject", Headers: []string{"Content-Type", "X-Checkmk-Edition", "X-Checkmk-Version"}, New: func() interface{} { return new(DomainObject) }},
+		{Status: "400", Description: "Bad Request: Parameter or validation failure.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "403", Description: "Forbidden: Configuration via Setup is disabled.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "406", Description: "Not Acceptable: The requests accept headers can not be satisfied.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+	},
+	NewParams: func() interface{} { return new(ServiceDiscoveryShowServices14537Params) },
+}
+
+// ServiceDiscoveryExecuteServiceDiscoveryOperation describes the "Execute a service discovery on a host" operation (POST /domain-types/service_discovery_run/actions/start/invoke).
+var ServiceDiscoveryExecuteServiceDiscoveryOperation = &openapi.Operation{
+	ID: "cmk.gui.plugins.openapi.endpoints.service_discovery.execute_service_discovery",
+	Name: "ServiceDiscoveryExecuteServiceDiscovery",
+	Method: "POST",
+	Path: "/domain-types/service_discovery_run/actions/start/invoke",
+	Summary: "Execute a service discovery on a host",
+	Tags: []string{"Service discovery"},
+	Parameters: []openapi.Parameter{
+		{Name: "Content-Type", In: "header", Required: true, Type: "string", Description: "A header specifying which type of content is in the request/response body. This is required when sending encoded data in a POST/PUT body. When the request body is empty, this header should not be sent."},
+	},
+	RequestBody: &openapi.RequestBody{ContentType: "application/json", Schema: "DiscoverServices", Required: true, New: func() interface{} { return new(DiscoverServices) }},
+	Responses: []openapi.Response{
+		{Status: "200", Description: "OK: The operation was done successfully.", ContentType: "application/json", Schema: "DomainObject", Headers: []string{"Content-Type", "X-Checkmk-Edition", "X-Checkmk-Version"}, New: func() interface{} { return new(DomainObject) }},
+		{Status: "302", Description: "Found: The service discovery background job has been initialized. Redirecting to the 'Wait for service discovery completion' endpoint.", ContentType: "", Schema: "", New: nil},
+		{Status: "400", Description: "Bad Request: Parameter or validation failure.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "403", Description: "Forbidden: Configuration via Setup is disabled.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "406", Description: "Not Acceptable: The requests accept headers can not be satisfied.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "409", Description: "Conflict: A service discovery background job is currently running", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "415", Description: "Unsupported Media Type: The submitted content-type is not supported.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+	},
+}
+
+// ServiceGroupConfigBulkCreateOperation describes the "Bulk create service groups" operation (POST /domain-types/service_group_config/actions/bulk-create/invoke).
+var ServiceGroupConfigBulkCreateOperation = &openapi.Operation{
+	ID: "cmk.gui.plugins.openapi.endpoints.service_group_config.bulk_create",
+	Name: "ServiceGroupConfigBulkCreate",
+	Method: "POST",
+	Path: "/domain-types/service_group_config/actions/bulk-create/invoke",
+	Summary: "Bulk create service groups",
+	Tags: []string{"Service groups"},
+	Parameters: []openapi.Parameter{
+		{Name: "Content-Type", In: "header", Required: true, Type: "string", Description: "A header specifying which type of content is in the request/response body. This is required when sending encoded data in a POST/PUT body. When the request body is empty, this header should not be sent."},
+	},
+	RequestBody: &openapi.RequestBody{ContentType: "application/json", Schema: "BulkInputServiceGroup", Required: true, New: func() interface{} { return new(BulkInputServiceGroup) }},
+	Responses: []openapi.Response{
+		{Status: "200", Description: "OK: The operation was done successfully.", ContentType: "application/json", Schema: "ServiceGroupCollection", Headers: []string{"Content-Type", "X-Checkmk-Edition", "X-Checkmk-Version"}, New: func() interface{} { return new(ServiceGroupCollection) }},
+		{Status: "400", Description: "Bad Request: Parameter or validation failure.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "403", Description: "Forbidden: Configuration via Setup is disabled.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "406", Description: "Not Acceptable: The requests accept headers can not be satisfied.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "415", Description: "Unsupported Media Type: The submitted content-type is not supported.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+	},
+}
+
+// ServiceGroupConfigBulkDeleteOperation describes the "Bulk delete service groups" operation (POST /domain-types/service_group_config/actions/bulk-delete/invoke).
+var ServiceGroupConfigBulkDeleteOperation = &openapi.Operation{
+	ID: "cmk.gui.plugins.openapi.endpoints.service_group_config.bulk_delete",
+	Name: "ServiceGroupConfigBulkDelete",
+	Method: "POST",
+	Path: "/domain-types/service_group_config/actions/bulk-delete/invoke",
+	Summary: "Bulk delete service groups",
+	Tags: []string{"Service groups"},
+	Parameters: []openapi.Parameter{
+		{Name: "Content-Type", In: "header", Required: true, Type: "string", Description: "A header specifying which type of content is in the request/response body. This is required when sending encoded data in a POST/PUT body. When the request body is empty, this header should not be sent."},
+	},
+	RequestBody: &openapi.RequestBody{ContentType: "application/json", Schema: "BulkDeleteServiceGroup", Required: true, New: func() interface{} { return new(BulkDeleteServiceGroup) }},
+	Responses: []openapi.Response{
+		{Status: "204", Description: "No Content: Operation done successfully. No further output.", ContentType: "", Schema: "", New: nil},
+		{Status: "400", Description: "Bad Request: Parameter or validation failure.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "403", Description: "Forbidden: Configuration via Setup is disabled.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "404", Description: "Not Found: The requested object has not been found.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "406", Description: "Not Acceptable: The requests accept headers can not be satisfied.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "409", Description: "Conflict: The request is in conflict with the stored resource.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "415", Description: "Unsupported Media Type: The submitted content-type is not supported.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+	},
+}
+
+// ServiceGroupConfigBulkUpdateOperation describes the "Bulk update service groups" operation (PUT /domain-types/service_group_config/actions/bulk-update/invoke).
+var ServiceGroupConfigBulkUpdateOperation = &openapi.Operation{
+	ID: "cmk.gui.plugins.openapi.endpoints.service_group_config.bulk_update",
+	Name: "ServiceGroupConfigBulkUpdate",
+	Method: "PUT",
+	Path: "/domain-types/service_group_config/actions/bulk-update/invoke",
+	Summary: "Bulk update service groups",
+	Tags: []string{"Service groups"},
+	Parameters: []openapi.Parameter{
+		{Name: "Content-Type", In: "header", Required: true, Type: "string", Description: "A header specifying which type of content is in the request/response body. This is required when sending encoded data in a POST/PUT body. When the request body is empty, this header should not be sent."},
+	},
+	RequestBody: &openapi.RequestBody{ContentType: "application/json", Schema: "BulkUpdateServiceGroup", Required: true, New: func() interface{} { return new(BulkUpdateServiceGroup) }},
+	Responses: []openapi.Response{
+		{Status: "200", Description: "OK: The operation was done successfully.", ContentType: "application/json", Schema: "ServiceGroupCollection", Headers: []string{"Content-Type", "X-Checkmk-Edition", "X-Checkmk-Version"}, New: func() interface{} { return new(ServiceGroupCollection) }},
+		{Status: "400", Description: "Bad Request: Parameter or validation failure.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "403", Description: "Forbidden: Configuration via Setup is disabled.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "406", Description: "Not Acceptable: The requests accept headers can not be satisfied.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "415", Description: "Unsupported Media Type: The submitted content-type is not supported.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+	},
+}
+
+// ServiceGroupConfigListGroupsOperation describes the "Show all service groups" operation (GET /domain-types/service_group_config/collections/all).
+var ServiceGroupConfigListGroupsOperation = &openapi.Operation{
+	ID: "cmk.gui.plugins.openapi.endpoints.service_group_config.list_groups",
+	Name: "ServiceGroupConfigListGroups",
+	Method: "GET",
+	Path: "/domain-types/service_group_config/collections/all",
+	Summary: "Show all service groups",
+	Tags: []string{"Service groups"},
+	Responses: []openapi.Response{
+		{Status: "200", Description: "OK: The operation was done successfully.", ContentType: "application/json", Schema: "ServiceGroupCollection", Headers: []string{"Content-Type", "X-Checkmk-Edition", "X-Checkmk-Version"}, New: func() interface{} { return new(ServiceGroupCollection) }},
+		{Status: "403", Description: "Forbidden: Configuration via Setup is disabled.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "406", Description: "Not Acceptable: The requests accept headers can not be satisfied.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+	},
+}
+
+// ServiceGroupConfigCreateOperation describes the "Create a service group" operation (POST /domain-types/service_group_config/collections/all).
+var ServiceGroupConfigCreateOperation = &openapi.Operation{
+	ID: "cmk.gui.plugins.openapi.endpoints.service_group_config.create",
+	Name: "ServiceGroupConfigCreate",
+	Method: "POST",
+	Path: "/domain-types/service_group_config/collections/all",
+	Summary: "Create a service group",
+	Tags: []string{"Service groups"},
+	Parameters: []openapi.Parameter{
+		{Name: "Content-Type", In: "header", Required: true, Type: "string", Description: "A header specifying which type of content is in the request/response body. This is required when sending encoded data in a POST/PUT body. When the request body is empty, this header should not be sent."},
+	},
+	RequestBody: &openapi.RequestBody{ContentType: "application/json", Schema: "InputServiceGroup", Required: true, New: func() interface{} { return new(InputServiceGroup) }},
+	Responses: []openapi.Response{
+		{Status: "200", Description: "OK: The operation was done successfully.", ContentType: "application/json", Schema: "DomainObject", Headers: []string{"Content-Type", "ETag", "X-Checkmk-Edition", "X-Checkmk-Version"}, New: func() interface{} { return new(DomainObject) }},
+		{Status: "400", Description: "Bad Request: Parameter or validation failure.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "403", Description: "Forbidden: Configuration via Setup is disabled.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "406", Description: "Not Acceptable: The requests accept headers can not be satisfied.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "415", Description: "Unsupported Media Type: The submitted content-type is not supported.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+	},
+}
+
+// SiteManagementShowSitesOperation describes the "Show all site connections" operation (GET /domain-types/site_connection/collections/all).
+var SiteManagementShowSitesOperation = &openapi.Operation{
+	ID: "cmk.gui.plugins.openapi.endpoints.site_management.show_sites",
+	Name: "SiteManagementShowSites",
+	Method: "GET",
+	Path: "/domain-types/site_connection/collections/all",
+	Summary: "Show all site connections",
+	Tags: []string{"Site Management"},
+	Responses: []openapi.Response{
+		{Status: "200", Description: "OK: The operation was done successfully.", ContentType: "application/json", Schema: "SiteConnectionResponseCollection", Headers: []string{"Content-Type", "X-Checkmk-Edition", "X-Checkmk-Version"}, New: func() interface{} { return new(SiteConnectionResponseCollection) }},
+		{Status: "403", Description: "Forbidden: Configuration via Setup is disabled.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "406", Description: "Not Acceptable: The requests accept headers can not be satisfied.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+	},
+}
+
+// SiteManagementPostSiteOperation describes the "Create a site connection" operation (POST /domain-types/site_connection/collections/all).
+var SiteManagementPostSiteOperation = &openapi.Operation{
+	ID: "cmk.gui.plugins.openapi.endpoints.site_management.post_site",
+	Name: "SiteManagementPostSite",
+	Method: "POST",
+	Path: "/domain-types/site_connection/collections/all",
+	Summary: "Create a site connection",
+	Tags: []string{"Site Management"},
+	Parameters: []openapi.Parameter{
+		{Name: "Content-Type", In: "header", Required: true, Type: "string", Description: "A header specifying which type of content is in the request/response body. This is required when sending encoded data in a POST/PUT body. When the request body is empty, this header should not be sent."},
+	},
+	RequestBody: &openapi.RequestBody{ContentType: "application/json", Schema: "SiteConnectionRequestCreate", Required: true, New: func() interface{} { return new(SiteConnectionRequestCreate) }},
+	Responses: []openapi.Response{
+		{Status: "200", Description: "OK: The operation was done successfully.", ContentType: "application/json", Schema: "SiteConnectionResponse", Headers: []string{"Content-Type", "X-Checkmk-Edition", "X-Checkmk-Version"}, New: func() interface{} { return new(SiteConnectionResponse) }},
+		{Status: "400", Description: "Bad Request: Parameter or validation failure.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "403", Description: "Forbidden: Configuration via Setup is disabled.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "406", Description: "Not Acceptable: The requests accept headers can not be satisfied.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "415", Description: "Unsupported Media Type: The submitted content-type is not supported.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+	},
+}
+
+// TimePeriodsListTimePeriodsOperation describes the "Show all time periods" operation (GET /domain-types/time_period/collections/all).
+var TimePeriodsListTimePeriodsOperation = &openapi.Operation{
+	ID: "cmk.gui.plugins.openapi.endpoints.time_periods.list_time_periods",
+	Name: "TimePeriodsListTimePeriods",
+	Method: "GET",
+	Path: "/domain-types/time_period/collections/all",
+	Summary: "Show all time periods",
+	Tags: []string{"Time periods"},
+	Responses: []openapi.Response{
+		{Status: "200", Description: "OK: The operation was done successfully.", ContentType: "application/json", Schema: "TimePeriodResponseCollection", Headers: []string{"Content-Type", "X-Checkmk-Edition", "X-Checkmk-Version"}, New: func() interface{} { return new(TimePeriodResponseCollection) }},
+		{Status: "403", Description: "Forbidden: Configuration via Setup is disabled.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "406", Description: "Not Acceptable: The requests accept headers can not be satisfied.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+	},
+}
+
+// TimePeriodsCreateTimeperiodOperation describes the "Create a time period" operation (POST /domain-types/time_period/collections/all).
+var TimePeriodsCreateTimeperiodOperation = &openapi.Operation{
+	ID: "cmk.gui.plugins.openapi.endpoints.time_periods.create_timeperiod",
+	Name: "TimePeriodsCreateTimeperiod",
+	Method: "POST",
+	Path: "/domain-types/time_period/collections/all",
+	Summary: "Create a time period",
+	Tags: []string{"Time periods"},
+	Parameters: []openapi.Parameter{
+		{Name: "Content-Type", In: "header", Required: true, Type: "string", Description: "A header specifying which type of content is in the request/response body. This is required when sending encoded data in a POST/PUT body. When the request body is empty, this header should not be sent."},
+	},
+	RequestBody: &openapi.RequestBody{ContentType: "application/json", Schema: "CreateTimePeriod", Required: true, New: func() interface{} { return new(CreateTimePeriod) }},
+	Responses: []openapi.Response{
+		{Status: "200", Description: "OK: The operation was done successfully.", ContentType: "application/json", Schema: "TimePeriodResponse", Headers: []string{"Content-Type", "ETag", "X-Checkmk-Edition", "X-Checkmk-Version"}, New: func() interface{} { return new(TimePeriodResponse) }},
+		{Status: "400", Description: "Bad Request: Parameter or validation failure.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "403", Description: "Forbidden: Configuration via Setup is disabled.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "406", Description: "Not Acceptable: The requests accept headers can not be satisfied.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "415", Description: "Unsupported Media Type: The submitted content-type is not supported.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+	},
+}
+
+// UserConfigListUsersOperation describes the "Show all users" operation (GET /domain-types/user_config/collections/all).
+var UserConfigListUsersOperation = &openapi.Operation{
+	ID: "cmk.gui.plugins.openapi.endpoints.user_config.list_users",
+	Name: "UserConfigListUsers",
+	Method: "GET",
+	Path: "/domain-types/user_config/collections/all",
+	Summary: "Show all users",
+	Tags: []string{"Users"},
+	Responses: []openapi.Response{
+		{Status: "200", Description: "OK: The operation was done successfully.", ContentType: "application/json", Schema: "UserCollection", Headers: []string{"Content-Type", "X-Checkmk-Edition", "X-Checkmk-Version"}, New: func() interface{} { return new(UserCollection) }},
+		{Status: "403", Description: "Forbidden: Configuration via Setup is disabled.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "406", Description: "Not Acceptable: The requests accept headers can not be satisfied.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+	},
+}
+
+// UserConfigCreateUserOperation describes the "Create a user" operation (POST /domain-types/user_config/collections/all).
+var UserConfigCreateUserOperation = &openapi.Operation{
+	ID: "cmk.gui.plugins.openapi.endpoints.user_config.create_user",
+	Name: "UserConfigCreateUser",
+	Method: "POST",
+	Path: "/domain-types/user_config/collections/all",
+	Summary: "Create a user",
+	Tags: []string{"Users"},
+	Parameters: []openapi.Parameter{
+		{Name: "Content-Type", In: "header", Required: true, Type: "string", Description: "A header specifying which type of content is in the request/response body. This is required when sending encoded data in a POST/PUT body. When the request body is empty, this header should not be sent."},
+	},
+	RequestBody: &openapi.RequestBody{ContentType: "application/json", Schema: "CreateUser", Required: true, New: func() interface{} { return new(CreateUser) }},
+	Responses: []openapi.Response{
+		{Status: "200", Description: "OK: The operation was done successfully.", ContentType: "application/json", Schema: "UserObject", Headers: []string{"Content-Type", "ETag", "X-Checkmk-Edition", "X-Checkmk-Version"}, New: func() interface{} { return new(UserObject) }},
+		{Status: "400", Description: "Bad Request: Parameter or validation failure.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "403", Description: "Forbidden: Configuration via Setup is disabled.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "406", Description: "Not Acceptable: The requests accept headers can not be satisfied.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "415", Description: "Unsupported Media Type: The submitted content-type is not supported.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+	},
+}
+
+// UserRoleListUserRolesOperation describes the "Show all user roles" operation (GET /domain-types/user_role/collections/all).
+var UserRoleListUserRolesOperation = &openapi.Operation{
+	ID: "cmk.gui.plugins.openapi.endpoints.user_role.list_user_roles",
+	Name: "UserRoleListUserRoles",
+	Method: "GET",
+	Path: "/domain-types/user_role/collections/all",
+	Summary: "Show all user roles",
+	Tags: []string{"User Roles"},
+	Responses: []openapi.Response{
+		{Status: "200", Description: "OK: The operation was done successfully.", ContentType: "application/json", Schema: "UserRoleCollection", Headers: []string{"Content-Type", "X-Checkmk-Edition", "X-Checkmk-Version"}, New: func() interface{} { return new(UserRoleCollection) }},
+		{Status: "403", Description: "Forbidden: Configuration via Setup is disabled.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "406", Description: "Not Acceptable: The requests accept headers can not be satisfied.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+	},
+}
+
+// UserRoleCreateUserroleOperation describes the "Create/clone a user role" operation (POST /domain-types/user_role/collections/all).
+var UserRoleCreateUserroleOperation = &openapi.Operation{
+	ID: "cmk.gui.plugins.openapi.endpoints.user_role.create_userrole",
+	Name: "UserRoleCreateUserrole",
+	Method: "POST",
+	Path: "/domain-types/user_role/collections/all",
+	Summary: "Create/clone a user role",
+	Tags: []string{"User Roles"},
+	Parameters: []openapi.Parameter{
+		{Name: "Content-Type", In: "header", Required: true, Type: "string", Description: "A header specifying which type of content is in the request/response body. This is required when sending encoded data in a POST/PUT body. When the request body is empty, this header should not be sent."},
+	},
+	RequestBody: &openapi.RequestBody{ContentType: "application/json", Schema: "CreateUserRole", Required: true, New: func() interface{} { return new(CreateUserRole) }},
+	Responses: []openapi.Response{
+		{Status: "200", Description: "OK: The operation was done successfully.", ContentType: "application/json", Schema: "UserRoleObject", Headers: []string{"Content-Type", "X-Checkmk-Edition", "X-Checkmk-Version"}, New: func() interface{} { return new(UserRoleObject) }},
+		{Status: "400", Description: "Bad Request: Parameter or validation failure.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "403", Description: "Forbidden: Configuration via Setup is disabled.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "406", Description: "Not Acceptable: The requests accept headers can not be satisfied.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "415", Description: "Unsupported Media Type: The submitted content-type is not supported.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+	},
+}
+
+// ActivateChangesShowActivationParams holds the path, query and header parameters of ActivateChangesShowActivationOperation.
+type ActivateChangesShowActivationParams struct {
+	// The activation-id.
+	ActivationId string `path:"activation_id"`
+}
+
+// ActivateChangesShowActivationOperation describes the "Show the activation status" operation (GET /objects/activation_run/{activation_id}).
+var ActivateChangesShowActivationOperation = &openapi.Operation{
+	ID: "cmk.gui.plugins.openapi.endpoints.activate_changes.show_activation",
+	Name: "ActivateChangesShowActivation",
+	Method: "GET",
+	Path: "/objects/activation_run/{activation_id}",
+	Summary: "Show the activation status",
+	Tags: []string{"Activate changes"},
+	Parameters: []openapi.Parameter{
+		{Name: "activation_id", In: "path", Required: true, Type: "string", Description: "The activation-id."},
+	},
+	Responses: []openapi.Response{
+		{Status: "200", Description: "OK: The operation was done successfully.", ContentType: "application/json", Schema: "ActivationRunResponse", Headers: []string{"Content-Type", "X-Checkmk-Edition", "X-Checkmk-Version"}, New: func() interface{} { return new(ActivationRunResponse) }},
+		{Status: "403", Description: "Forbidden: Configuration via Setup is disabled.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "404", Description: "Not Found: There is no running activation with this activation_id.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "406", Description: "Not Acceptable: The requests accept headers can not be satisfied.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+	},
+	NewParams: func() interface{} { return new(ActivateChangesShowActivationParams) },
+}
+
+// ActivateChangesActivateChangesWaitForCompletionParams holds the path, query and header parameters of ActivateChangesActivateChangesWaitForCompletionOperation.
+type ActivateChangesActivateChangesWaitForCompletionParams struct {
+	// The activation-id.
+	ActivationId string `path:"activation_id"`
+}
+
+// ActivateChangesActivateChangesWaitForCompletionOperation describes the "Wait for activation completion" operation (GET /objects/activation_run/{activation_id}/actions/wait-for-completion/invoke).
+var ActivateChangesActivateChangesWaitForCompletionOperation = &openapi.Operation{
+	ID: "cmk.gui.plugins.openapi.endpoints.activate_changes.activate_changes_wait_for_completion",
+	Name: "ActivateChangesActivateChangesWaitForCompletion",
+	Method: "GET",
+	Path: "/objects/activation_run/{activation_id}/actions/wait-for-completion/invoke",
+	Summary: "Wait for activation completion",
+	Tags: []string{"Activate changes"},
+	Parameters: []openapi.Parameter{
+		{Name: "activation_id", In: "path", Required: true, Type: "string", Description: "The activation-id."},
+	},
+	Responses: []openapi.Response{
+		{Status: "204", Description: "No Content: The activation has been completed.", ContentType: "", Schema: "", New: nil},
+		{Status: "302", Description: "Found: The activation is still running. Redirecting to the 'Wait for completion' endpoint.", ContentType: "", Schema: "", New: nil},
+		{Status: "403", Description: "Forbidden: Configuration via Setup is disabled.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "404", Description: "Not Found: There is no running activation with this activation_id.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "406", Description: "Not Acceptable: The requests accept headers can not be satisfied.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+	},
+	NewParams: func() interface{} { return new(ActivateChangesActivateChangesWaitForCompletionParams) },
+}
+
+// AuxTagsShowAuxTagParams holds the path, query and header parameters of AuxTagsShowAuxTagOperation.
+type AuxTagsShowAuxTagParams struct {
+	// An auxiliary tag id
+	AuxTagId string `path:"aux_tag_id"`
+}
+
+// AuxTagsShowAuxTagOperation describes the "Show an Auxiliary Tag" operation (GET /objects/aux_tag/{aux_tag_id}).
+var AuxTagsShowAuxTagOperation = &openapi.Operation{
+	ID: "cmk.gui.plugins.openapi.endpoints.aux_tags.show_aux_tag",
+	Name: "AuxTagsShowAuxTag",
+	Method: "GET",
+	Path: "/objects/aux_tag/{aux_tag_id}",
+	Summary: "Show an Auxiliary Tag",
+	Tags: []string{"Aux Tags"},
+	Parameters: []openapi.Parameter{
+		{Name: "aux_tag_id", In: "path", Required: true, Type: "string", Description: "An auxiliary tag id"},
+	},
+	Responses: []openapi.Response{
+		{Status: "200", Description: "OK: The operation was done successfully.", ContentType: "application/json", Schema: "AuxTagResponse", Headers: []string{"Content-Type", "X-Checkmk-Edition", "X-Checkmk-Version"}, New: func() interface{} { return new(AuxTagResponse) }},
+		{Status: "403", Description: "Forbidden: Configuration via Setup is disabled.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "404", Description: "Not Found: The requested object has not been found.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "406", Description: "Not Acceptable: The requests accept headers can not be satisfied.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+	},
+	NewParams: func() interface{} { return new(AuxTagsShowAuxTagParams) },
+}
+
+// AuxTagsPutAuxTagParams holds the path, query and header parameters of AuxTagsPutAuxTagOperation.
+type AuxTagsPutAuxTagParams struct {
+	// An auxiliary tag id
+	AuxTagId string `path:"aux_tag_id"`
+}
+
+// AuxTagsPutAuxTagOperation describes the "Update an aux tag" operation (PUT /objects/aux_tag/{aux_tag_id}).
+var AuxTagsPutAuxTagOperation = &openapi.Operation{
+	ID: "cmk.gui.plugins.openapi.endpoints.aux_tags.put_aux_tag",
+	Name: "AuxTagsPutAuxTag",
+	Method: "PUT",
+	Path: "/objects/aux_tag/{aux_tag_id}",
+	Summary: "Update an aux tag",
+	Tags: []string{"Aux Tags"},
+	Parameters: []openapi.Parameter{
+		{Name: "Content-Type", In: "header", Required: true, Type: "string", Description: "A header specifying which type of content is in the request/response body. This is required when sending encoded data in a POST/PUT body. When the request body is empty, this header should not be sent."},
+		{Name: "aux_tag_id", In: "path", Required: true, Type: "string", Description: "An auxiliary tag id"},
+	},
+	RequestBody: &openapi.RequestBody{ContentType: "application/json", Schema: "AuxTagAttrsUpdate", Required: true, New: func() interface{} { return new(AuxTagAttrsUpdate) }},
+	Responses: []openapi.Response{
+		{Status: "200", Description: "OK: The operation was done successfully.", ContentType: "application/json", Schema: "AuxTagResponse", Headers: []string{"Content-Type", "X-Checkmk-Edition", "X-Checkmk-Version"}, New: func() interface{} { return new(AuxTagResponse) }},
+		{Status: "400", Description: "Bad Request: Parameter or validation failure.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "403", Description: "Forbidden: Configuration via Setup is disabled.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "404", Description: "Not Found: The requested object has not been found.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "406", Description: "Not Acceptable: The requests accept headers can not be satisfied.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "415", Description: "Unsupported Media Type: The submitted content-type is not supported.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+	},
+	NewParams: func() interface{} { return new(AuxTagsPutAuxTagParams) },
+}
+
+// AuxTagsDeleteAuxTagParams holds the path, query and header parameters of AuxTagsDeleteAuxTagOperation.
+type AuxTagsDeleteAuxTagParams struct {
+	// An auxiliary tag id
+	AuxTagId string `path:"aux_tag_id"`
+}
+
+// AuxTagsDeleteAuxTagOperation describes the "Delete an Auxiliary Tag" operation (POST /objects/aux_tag/{aux_tag_id}/actions/delete/invoke).
+var AuxTagsDeleteAuxTagOperation = &openapi.Operation{
+	ID: "cmk.gui.plugins.openapi.endpoints.aux_tags.delete_aux_tag",
+	Name: "AuxTagsDeleteAuxTag",
+	Method: "POST",
+	Path: "/objects/aux_tag/{aux_tag_id}/actions/delete/invoke",
+	Summary: "Delete an Auxiliary Tag",
+	Tags: []string{"Aux Tags"},
+	Parameters: []openapi.Parameter{
+		{Name: "aux_tag_id", In: "path", Required: true, Type: "string", Description: "An auxiliary tag id"},
+	},
+	Responses: []openapi.Response{
+		{Status: "204", Description: "No Content: Operation done successfully. No further output.", ContentType: "", Schema: "", New: nil},
+		{Status: "400", Description: "Bad Request: Parameter or validation failure.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "403", Description: "Forbidden: Configuration via Setup is disabled.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "404", Description: "Not Found: The requested object has not been found.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "406", Description: "Not Acceptable: The requests accept headers can not be satisfied.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "409", Description: "Conflict: The request is in conflict with the stored resource.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "415", Description: "Unsupported Media Type: The submitted content-type is not supported.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+	},
+	NewParams: func() interface{} { return new(AuxTagsDeleteAuxTagParams) },
+}
+
+// BiGetBiAggregationParams holds the path, query and header parameters of BiGetBiAggregationOperation.
+type BiGetBiAggregationParams struct {
+	// The unique id for the aggregation
+	AggregationId string `path:"aggregation_id"`
+}
+
+// BiGetBiAggregationOperation describes the "Get a BI aggregation" operation (GET /objects/bi_aggregation/{aggregation_id}).
+var BiGetBiAggregationOperation = &openapi.Operation{
+	ID: "cmk.gui.plugins.openapi.endpoints.bi.get_bi_aggregation",
+	Name: "BiGetBiAggregation",
+	Method: "GET",
+	Path: "/objects/bi_aggregation/{aggregation_id}",
+	Summary: "Get a BI aggregation",
+	Tags: []string{"Business intelligence (BI)"},
+	Parameters: []openapi.Parameter{
+		{Name: "aggregation_id", In: "path", Required: true, Type: "string", Description: "The unique id for the aggregation"},
+	},
+	Responses: []openapi.Response{
+		{Status: "200", Description: "OK: The operation was done successfully.", ContentType: "application/json", Schema: "BIAggregationEndpoint", Headers: []string{"Content-Type", "X-Checkmk-Edition", "X-Checkmk-Version"}, New: func() interface{} { return new(BIAggregationEndpoint) }},
+		{Status: "403", Description: "Forbidden: Configuration via Setup is disabled.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "404", Description: "Not Found: The requested object has not been found.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "406", Description: "Not Acceptable: The requests accept headers can not be satisfied.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+	},
+	NewParams: func() interface{} { return new(BiGetBiAggregationParams) },
+}
+
+// BiPutBiAggregationParams holds the path, query and header parameters of BiPutBiAggregationOperation.
+type BiPutBiAggregationParams struct {
+	// The unique id for the aggregation
+	AggregationId string `path:"aggregation_id"`
+}
+
+// BiPutBiAggregationOperation describes the "Update an existing BI aggregation" operation (PUT /objects/bi_aggregation/{aggregation_id}).
+var BiPutBiAggregationOperation = &openapi.Operation{
+	ID: "cmk.gui.plugins.openapi.endpoints.bi.put_bi_aggregation",
+	Name: "BiPutBiAggregation",
+	Method: "PUT",
+	Path: "/objects/bi_aggregation/{aggregation_id}",
+	Summary: "Update an existing BI aggregation",
+	Tags: []string{"Business intelligence (BI)"},
+	Parameters: []openapi.Parameter{
+		{Name: "Content-Type", In: "header", Required: true, Type: "string", Description: "A header specifying which type of content is in the request/response body. This is required when sending encoded data in a POST/PUT body. When the request body is empty, this header should not be sent."},
+		{Name: "aggregation_id", In: "path", Required: true, Type: "string", Description: "The unique id for the aggregation"},
+	},
+	RequestBody: &openapi.RequestBody{ContentType: "application/json", Schema: "BIAggregationEndpoint", Required: true, New: func() interface{} { return new(BIAggregationEndpoint) }},
+	Responses: []openapi.Response{
+		{Status: "200", Description: "OK: The operation was done successfully.", ContentType: "application/json", Schema: "BIAggregationEndpoint", Headers: []string{"Content-Type", "X-Checkmk-Edition", "X-Checkmk-Version"}, New: func() interface{} { return new(BIAggregationEndpoint) }},
+		{Status: "400", Description: "Bad Request: Parameter or validation failure.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "403", Description: "Forbidden: Configuration via Setup is disabled.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "404", Description: "Not Found: The requested object has not been found.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "406", Description: "Not Acceptable: The requests accept headers can not be satisfied.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "415", Description: "Unsupported Media Type: The submitted content-type is not supported.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+	},
+	NewParams: func() interface{} { return new(BiPutBiAggregationParams) },
+}
+
+// BiPostBiAggregationParams holds the path, query and header parameters of BiPostBiAggregationOperation.
+type BiPostBiAggregationParams struct {
+	// The unique id for the aggregation
+	AggregationId string `path:"aggregation_id"`
+}
+
+// BiPostBiAggregationOperation describes the "Create a BI aggregation" operation (POST /objects/bi_aggregation/{aggregation_id}).
+var BiPostBiAggregationOperation = &openapi.Operation{
+	ID: "cmk.gui.plugins.openapi.endpoints.bi.post_bi_aggregation",
+	Name: "BiPostBiAggregation",
+	Method: "POST",
+	Path: "/objects/bi_aggregation/{aggregation_id}",
+	Summary: "Create a BI aggregation",
+	Tags: []string{"Business intelligence (BI)"},
+	Parameters: []openapi.Parameter{
+		{Name: "Content-Type", In: "header", Required: true, Type: "string", Description: "A header specifying which type of content is in the request/response body. This is required when sending encoded data in a POST/PUT body. When the request body is empty, this header should not be sent."},
+		{Name: "aggregation_id", In: "path", Required: true, Type: "string", Description: "The unique id for the aggregation"},
+	},
+	RequestBody: &openapi.RequestBody{ContentType: "application/json", Schema: "BIAggregationEndpoint", Required: true, New: func() interface{} { return new(BIAggregationEndpoint) }},
+	Responses: []openapi.Response{
+		{Status: "200", Description: "OK: The operation was done successfully.", ContentType: "application/json", Schema: "BIAggregationEndpoint", Headers: []string{"Content-Type", "X-Checkmk-Edition", "X-Checkmk-Version"}, New: func() interface{} { return new(BIAggregationEndpoint) }},
+		{Status: "400", Description: "Bad Request: Parameter or validation failure.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "403", Description: "Forbidden: Configuration via Setup is disabled.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "404", Description: "Not Found: The requested object has not been found.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "406", Description: "Not Acceptable: The requests accept headers can not be satisfied.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "415", Description: "Unsupported Media Type: The submitted content-type is not supported.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+	},
+	NewParams: func() interface{} { return new(BiPostBiAggregationParams) },
+}
+
+// BiDeleteBiAggregationParams holds the path, query and header parameters of BiDeleteBiAggregationOperation.
+type BiDeleteBiAggregationParams struct {
+	// The unique id for the aggregation
+	AggregationId string `path:"aggregation_id"`
+}
+
+// BiDeleteBiAggregationOperation describes the "Delete a BI aggregation" operation (DELETE /objects/bi_aggregation/{aggregation_id}).
+var BiDeleteBiAggregationOperation = &openapi.Operation{
+	ID: "cmk.gui.plugins.openapi.endpoints.bi.delete_bi_aggregation",
+	Name: "BiDeleteBiAggregation",
+	Method: "DELETE",
+	Path: "/objects/bi_aggregation/{aggregation_id}",
+	Summary: "Delete a BI aggregation",
+	Tags: []string{"Business intelligence (BI)"},
+	Parameters: []openapi.Parameter{
+		{Name: "aggregation_id", In: "path", Required: true, Type: "string", Description: "The unique id for the aggregation"},
+	},
+	Responses: []openapi.Response{
+		{Status: "204", Description: "No Content: Operation done successfully. No further output.", ContentType: "", Schema: "", New: nil},
+		{Status: "403", Description: "Forbidden: Configuration via Setup is disabled.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "404", Description: "Not Found: The requested object has not been found.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "406", Description: "Not Acceptable: The requests accept headers can not be satisfied.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+	},
+	NewParams: func() interface{} { return new(BiDeleteBiAggregationParams) },
+}
+
+// BiGetBiPackParams holds the path, query and header parameters of BiGetBiPackOperation.
+type BiGetBiPackParams struct {
+	// The unique id for the aggregation pack
+	PackId string `path:"pack_id"`
+}
+
+// BiGetBiPackOperation describes the "Get a BI pack and its rules and aggregations" operation (GET /objects/bi_pack/{pack_id}).
+var BiGetBiPackOperation = &openapi.Operation{
+	ID: "cmk.gui.plugins.openapi.endpoints.bi.get_bi_pack",
+	Name: "BiGetBiPack",
+	Method: "GET",
+	Path: "/objects/bi_pack/{pack_id}",
+	Summary: "Get a BI pack and its rules and aggregations",
+	Tags: []string{"Business intelligence (BI)"},
+	Parameters: []openapi.Parameter{
+		{Name: "pack_id", In: "path", Required: true, Type: "string", Description: "The unique id for the aggregation pack"},
+	},
+	Responses: []openapi.Response{
+		{Status: "200", Description: "OK: The operation was done successfully.", ContentType: "application/json", Schema: "DomainObject", Headers: []string{"Content-Type", "X-Checkmk-Edition", "X-Checkmk-Version"}, New: func() interface{} { return new(DomainObject) }},
+		{Status: "403", Description: "Forbidden: Configuration via Setup is disabled.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "404", Description: "Not Found: The requested object has not been found.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "406", Description: "Not Acceptable: The requests accept headers can not be satisfied.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+	},
+	NewParams: func() interface{} { return new(BiGetBiPackParams) },
+}
+
+// BiPutBiPackParams holds the path, query and header parameters of BiPutBiPackOperation.
+type BiPutBiPackParams struct {
+	// The unique id for the aggregation pack
+	PackId string `path:"pack_id"`
+}
+
+// BiPutBiPackOperation describes the "Update an existing BI pack" operation (PUT /objects/bi_pack/{pack_id}).
+var BiPutBiPackOperation = &openapi.Operation{
+	ID: "cmk.gui.plugins.openapi.endpoints.bi.put_bi_pack",
+	Name: "BiPutBiPack",
+	Method: "PUT",
+	Path: "/objects/bi_pack/{pack_id}",
+	Summary: "Update an existing BI pack",
+	Tags: []string{"Business intelligence (BI)"},
+	Parameters: []openapi.Parameter{
+		{Name: "Content-Type", In: "header", Required: true, Type: "string", Description: "A header specifying which type of content is in the request/response body. This is required when sending encoded data in a POST/PUT body. When the request body is empty, this header should not be sent."},
+		{Name: "pack_id", In: "path", Required: true, Type: "string", Description: "The unique id for the aggregation pack"},
+	},
+	RequestBody: &openapi.RequestBody{ContentType: "application/json", Schema: "BIPackEndpoint", Required: true, New: func() interface{} { return new(BIPackEndpoint) }},
+	Responses: []openapi.Response{
+		{Status: "200", Description: "OK: The operation was done successfully.", ContentType: "application/json", Schema: "BIPackEndpoint", Headers: []string{"Content-Type", "X-Checkmk-Edition", "X-Checkmk-Version"}, New: func() interface{} { return new(BIPackEndpoint) }},
+		{Status: "400", Description: "Bad Request: Parameter or validation failure.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "403", Description: "Forbidden: Configuration via Setup is disabled.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "404", Description: "Not Found: The requested object has not been found.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "406", Description: "Not Acceptable: The requests accept headers can not be satisfied.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "415", Description: "Unsupported Media Type: The submitted content-type is not supported.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+	},
+	NewParams: func() interface{} { return new(BiPutBiPackParams) },
+}
+
+// BiPostBiPackParams holds the path, query and header parameters of BiPostBiPackOperation.
+type BiPostBiPackParams struct {
+	// The unique id for the aggregation pack
+	PackId string `path:"pack_id"`
+}
+
+// BiPostBiPackOperation describes the "Create a new BI pack" operation (POST /objects/bi_pack/{pack_id}).
+var BiPostBiPackOperation = &openapi.Operation{
+	ID: "cmk.gui.plugins.openapi.endpoints.bi.post_bi_pack",
+	Name: "BiPostBiPack",
+	Method: "POST",
+	Path: "/objects/bi_pack/{pack_id}",
+	Summary: "Create a new BI pack",
+	Tags: []string{"Business intelligence (BI)"},
+	Parameters: []openapi.Parameter{
+		{Name: "Content-Type", In: "header", Required: true, Type: "string", Description: "A header specifying which type of content is in the request/response body. This is required when sending encoded data in a POST/PUT body. When the request body is empty, this header should not be sent."},
+		{Name: "pack_id", In: "path", Required: true, Type: "string", Description: "The unique id for the aggregation pack"},
+	},
+	RequestBody: &openapi.RequestBody{ContentType: "application/json", Schema: "BIPackEndpoint", Required: true, New: func() interface{} { return new(BIPackEndpoint) }},
+	Responses: []openapi.Response{
+		{Status: "200", Description: "OK: The operation was done successfully.", ContentType: "application/json", Schema: "BIPackEndpoint", Headers: []string{"Content-Type", "X-Checkmk-Edition", "X-Checkmk-Version"}, New: func() interface{} { return new(BIPackEndpoint) }},
+		{Status: "400", Description: "Bad Request: Parameter or validation failure.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "403", Description: "Forbidden: Configuration via Setup is disabled.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "404", Description: "Not Found: The requested object has not been found.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "406", Description: "Not Acceptable: The requests accept headers can not be satisfied.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "415", Description: "Unsupported Media Type: The submitted content-type is not supported.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+	},
+	NewParams: func() interface{} { return new(BiPostBiPackParams) },
+}
+
+// BiDeleteBiPackParams holds the path, query and header parameters of BiDeleteBiPackOperation.
+type BiDeleteBiPackParams struct {
+	// The unique id for the aggregation pack
+	PackId string `path:"pack_id"`
+}
+
+// BiDeleteBiPackOperation describes the "Delete BI pack" operation (DELETE /objects/bi_pack/{pack_id}).
+var BiDeleteBiPackOperation = &openapi.Operation{
+	ID: "cmk.gui.plugins.openapi.endpoints.bi.delete_bi_pack",
+	Name: "BiDeleteBiPack",
+	Method: "DELETE",
+	Path: "/objects/bi_pack/{pack_id}",
+	Summary: "Delete BI pack",
+	Tags: []string{"Business intelligence (BI)"},
+	Parameters: []openapi.Parameter{
+		{Name: "pack_id", In: "path", Required: true, Type: "string", Description: "The unique id for the aggregation pack"},
+	},
+	Responses: []openapi.Response{
+		{Status: "204", Description: "No Content: Operation done successfully. No further output.", ContentType: "", Schema: "", New: nil},
+		{Status: "403", Description: "Forbidden: Configuration via Setup is disabled.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "404", Description: "Not Found: The requested object has not been found.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "406", Description: "Not Acceptable: The requests accept headers can not be satisfied.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+	},
+	NewParams: func() interface{} { return new(BiDeleteBiPackParams) },
+}
+
+// BiGetBiRuleParams holds the path, query and header parameters of BiGetBiRuleOperation.
+type BiGetBiRuleParams struct {
+	// The unique id for the rule
+	RuleId string `path:"rule_id"`
+}
+
+// BiGetBiRuleOperation describes the "Show a BI rule" operation (GET /objects/bi_rule/{rule_id}).
+var BiGetBiRuleOperation = &openapi.Operation{
+	ID: "cmk.gui.plugins.openapi.endpoints.bi.get_bi_rule",
+	Name: "BiGetBiRule",
+	Method: "GET",
+	Path: "/objects/bi_rule/{rule_id}",
+	Summary: "Show a BI rule",
+	Tags: []string{"Business intelligence (BI)"},
+	Parameters: []openapi.Parameter{
+		{Name: "rule_id", In: "path", Required: true, Type: "string", Description: "The unique id for the rule"},
+	},
+	Responses: []openapi.Response{
+		{Status: "200", Description: "OK: The operation was done successfully.", ContentType: "application/json", Schema: "BIRuleEndpoint", Headers: []string{"Content-Type", "X-Checkmk-Edition", "X-Checkmk-Version"}, New: func() interface{} { return new(BIRuleEndpoint) }},
+		{Status: "403", Description: "Forbidden: Configuration via Setup is disabled.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "404", Description: "Not Found: The requested object has not been found.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "406", Description: "Not Acceptable: The requests accept headers can not be satisfied.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+	},
+	NewParams: func() interface{} { return new(BiGetBiRuleParams) },
+}
+
+// BiPutBiRuleParams holds the path, query and header parameters of BiPutBiRuleOperation.
+type BiPutBiRuleParams struct {
+	// The unique id for the rule
+	RuleId string `path:"rule_id"`
+}
+
+// BiPutBiRuleOperation describes the "Update an existing BI rule" operation (PUT /objects/bi_rule/{rule_id}).
+var BiPutBiRuleOperation = &openapi.Operation{
+	ID: "cmk.gui.plugins.openapi.endpoints.bi.put_bi_rule",
+	Name: "BiPutBiRule",
+	Method: "PUT",
+	Path: "/objects/bi_rule/{rule_id}",
+	Summary: "Update an existing BI rule",
+	Tags: []string{"Business intelligence (BI)"},
+	Parameters: []openapi.Parameter{
+		{Name: "Content-Type", In: "header", Required: true, Type: "string", Description: "A header specifying which type of content is in the request/response body. This is required when sending encoded data in a POST/PUT body. When the request body is empty, this header should not be sent."},
+		{Name: "rule_id", In: "path", Required: true, Type: "string", Description: "The unique id for the rule"},
+	},
+	RequestBody: &openapi.RequestBody{ContentType: "application/json", Schema: "BIRuleEndpoint", Required: true, New: func() interface{} { return new(BIRuleEndpoint) }},
+	Responses: []openapi.Response{
+		{Status: "200", Description: "OK: The operation was done successfully.", ContentType: "application/json", Schema: "BIRuleEndpoint", Headers: []string{"Content-Type", "X-Checkmk-Edition", "X-Checkmk-Version"}, New: func() interface{} { return new(BIRuleEndpoint) }},
+		{Status: "400", Description: "Bad Request: Parameter or validation failure.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "403", Description: "Forbidden: Configuration via Setup is disabled.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "404", Description: "Not Found: The requested object has not been found.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "406", Description: "Not Acceptable: The requests accept headers can not be satisfied.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "415", Description: "Unsupported Media Type: The submitted content-type is not supported.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+	},
+	NewParams: func() interface{} { return new(BiPutBiRuleParams) },
+}
+
+// BiPostBiRuleParams holds the path, query and header parameters of BiPostBiRuleOperation.
+type BiPostBiRuleParams struct {
+	// The unique id for the rule
+	RuleId string `path:"rule_id"`
+}
+
+// BiPostBiRuleOperation describes the "Create a new BI rule" operation (POST /objects/bi_rule/{rule_id}).
+var BiPostBiRuleOperation = &openapi.Operation{
+	ID: "cmk.gui.plugins.openapi.endpoints.bi.post_bi_rule",
+	Name: "BiPostBiRule",
+	Method: "POST",
+	Path: "/objects/bi_rule/{rule_id}",
+	Summary: "Create a new BI rule",
+	Tags: []string{"Business intelligence (BI)"},
+	Parameters: []openapi.Parameter{
+		{Name: "Content-Type", In: "header", Required: true, Type: "string", Description: "A header specifying which type of content is in the request/response body. This is required when sending encoded data in a POST/PUT body. When the request body is empty, this header should not be sent."},
+		{Name: "rule_id", In: "path", Required: true, Type: "string", Description: "The unique id for the rule"},
+	},
+	RequestBody: &openapi.RequestBody{ContentType: "application/json", Schema: "BIRuleEndpoint", Required: true, New: func() interface{} { return new(BIRuleEndpoint) }},
+	Responses: []openapi.Response{
+		{Status: "200", Description: "OK: The operation was done successfully.", ContentType: "application/json", Schema: "BIRuleEndpoint", Headers: []string{"Content-Type", "X-Checkmk-Edition", "X-Checkmk-Version"}, New: func() interface{} { return new(BIRuleEndpoint) }},
+		{Status: "400", Description: "Bad Request: Parameter or validation failure.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "403", Description: "Forbidden: Configuration via Setup is disabled.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "404", Description: "Not Found: The requested object has not been found.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "406", Description: "Not Acceptable: The requests accept headers can not be satisfied.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "415", Description: "Unsupported Media Type: The submitted content-type is not supported.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+	},
+	NewParams: func() interface{} { return new(BiPostBiRuleParams) },
+}
+
+// BiDeleteBiRuleParams holds the path, query and header parameters of BiDeleteBiRuleOperation.
+type BiDeleteBiRuleParams struct {
+	// The unique id for the rule
+	RuleId string `path:"rule_id"`
+}
+
+// BiDeleteBiRuleOperation describes the "Delete BI rule" operation (DELETE /objects/bi_rule/{rule_id}).
+var BiDeleteBiRuleOperation = &openapi.Operation{
+	ID: "cmk.gui.plugins.openapi.endpoints.bi.delete_bi_rule",
+	Name: "BiDeleteBiRule",
+	Method: "DELETE",
+	Path: "/objects/bi_rule/{rule_id}",
+	Summary: "Delete BI rule",
+	Tags: []string{"Business intelligence (BI)"},
+	Parameters: []openapi.Parameter{
+		{Name: "rule_id", In: "path", Required: true, Type: "string", Description: "The unique id for the rule"},
+	},
+	Responses: []openapi.Response{
+		{Status: "204", Description: "No Content: Operation done successfully. No further output.", ContentType: "", Schema: "", New: nil},
+		{Status: "403", Description: "Forbidden: Configuration via Setup is disabled.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "404", Description: "Not Found: The requested object has not been found.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "406", Description: "Not Acceptable: The requests accept headers can not be satisfied.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "409", Description: "Conflict: The request is in conflict with the stored resource.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+	},
+	NewParams: func() interface{} { return new(BiDeleteBiRuleParams) },
+}
+
+// CommentShowCommentParams holds the path, query and header parameters of CommentShowCommentOperation.
+type CommentShowCommentParams struct {
+	// An existing site id
+	SiteId string `query:"site_id"`
+	// An existing comment's ID
+	CommentId int `path:"comment_id"`
+}
+
+// CommentShowCommentOperation describes the "Show a comment" operation (GET /objects/comment/{comment_id}).
+var CommentShowCommentOperation = &openapi.Operation{
+	ID: "cmk.gui.plugins.openapi.endpoints.comment.show_comment",
+	Name: "CommentShowComment",
+	Method: "GET",
+	Path: "/objects/comment/{comment_id}",
+	Summary: "Show a comment",
+	Tags: []string{"Comments"},
+	Parameters: []openapi.Parameter{
+		{Name: "site_id", In: "query", Required: true, Type: "string", Description: "An existing site id"},
+		{Name: "comment_id", In: "path", Required: true, Type: "integer", Description: "An existing comment's ID"},
+	},
+	Responses: []openapi.Response{
+		{Status: "200", Description: "OK: The operation was done successfully.", ContentType: "application/json", Schema: "CommentObject", Headers: []string{"Content-Type", "X-Checkmk-Edition", "X-Checkmk-Version"}, New: func() interface{} { return new(CommentObject) }},
+		{Status: "400", Description: "Bad Request: Parameter or validation failure.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "404", Description: "Not Found: The requested object has not been found.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "406", Description: "Not Acceptable: The requests accept headers can not be satisfied.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+	},
+	NewParams: func() interface{} { return new(CommentShowCommentParams) },
+}
+
+// ContactGroupConfigShowParams holds the path, query and header parameters of ContactGroupConfigShowOperation.
+type ContactGroupConfigShowParams struct {
+	// The identifier name of the group.
+	Name string `path:"name"`
+}
+
+// ContactGroupConfigShowOperation describes the "Show a contact group" operation (GET /objects/contact_group_config/{name}).
+var ContactGroupConfigShowOperation = &openapi.Operation{
+	ID: "cmk.gui.plugins.openapi.endpoints.contact_group_config.show",
+	Name: "ContactGroupConfigShow",
+	Method: "GET",
+	Path: "/objects/contact_group_config/{name}",
+	Summary: "Show a contact group",
+	Tags: []string{"Contact groups"},
+	Parameters: []openapi.Parameter{
+		{Name: "name", In: "path", Required: true, Type: "string", Description: "The identifier name of the group."},
+	},
+	Responses: []openapi.Response{
+		{Status: "200", Description: "OK: The operation was done successfully.", ContentType: "application/json", Schema: "ContactGroup", Headers: []string{"Content-Type", "ETag", "X-Checkmk-Edition", "X-Checkmk-Version"}, New: func() interface{} { return new(ContactGroup) }},
+		{Status: "403", Description: "Forbidden: Configuration via Setup is disabled.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "404", Description: "Not Found: The requested object has not been found.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "406", Description: "Not Acceptable: The requests accept headers can not be satisfied.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+	},
+	NewParams: func() interface{} { return new(ContactGroupConfigShowParams) },
+}
+
+// ContactGroupConfigUpdateParams holds the path, query and header parameters of ContactGroupConfigUpdateOperation.
+type ContactGroupConfigUpdateParams struct {
+	// The value of the, to be modified, object's ETag header. You can get this value by displaying the object it individually. To update this object the currently stored ETag needs to be the same as the one sent. The content of the ETag can potentially be anything and should be treated as semantically ...
+	IfMatch string `header:"If-Match"`
+	// The identifier name of the group.
+	Name string `path:"name"`
+}
+
+// ContactGroupConfigUpdateOperation describes the "Update a contact group" operation (PUT /objects/contact_group_config/{name}).
+var ContactGroupConfigUpdateOperation = &openapi.Operation{
+	ID: "cmk.gui.plugins.openapi.endpoints.contact_group_config.update",
+	Name: "ContactGroupConfigUpdate",
+	Method: "PUT",
+	Path: "/objects/contact_group_config/{name}",
+	Summary: "Update a contact group",
+	Tags: []string{"Contact groups"},
+	Parameters: []openapi.Parameter{
+		{Name: "If-Match", In: "header", Required: true, Type: "string", Description: "The value of the, to be modified, object's ETag header. You can get this value by displaying the object it individually. To update this object the currently stored ETag needs to be the same as the one sent. The content of the ETag can potentially be anything and should be treated as semantically opaque."},
+		{Name: "Content-Type", In: "header", Required: true, Type: "string", Description: "A header specifying which type of content is in the request/response body. This is required when sending encoded data in a POST/PUT body. When the request body is empty, this header should not be sent."},
+		{Name: "name", In: "path", Required: true, Type: "string", Description: "The identifier name of the group."},
+	},
+	RequestBody: &openapi.RequestBody{ContentType: "application/json", Schema: "UpdateGroup", Required: true, New: func() interface{} { return new(UpdateGroup) }},
+	Responses: []openapi.Response{
+		{Status: "200", Description: "OK: The operation was done successfully.", ContentType: "application/json", Schema: "ContactGroup", Headers: []string{"Content-Type", "ETag", "X-Checkmk-Edition", "X-Checkmk-Version"}, New: func() interface{} { return new(ContactGroup) }},
+		{Status: "400", Description: "Bad Request: Parameter or validation failure.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "403", Description: "Forbidden: Configuration via Setup is disabled.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "404", Description: "Not Found: The requested object has not been found.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "406", Description: "Not Acceptable: The requests accept headers can not be satisfied.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "412", Description: "Precondition Failed: The value of the If-Match header doesn't match the object's ETag.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "415", Description: "Unsupported Media Type: The submitted content-type is not supported.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "428", Description: "Precondition Required: The required If-Match header is missing.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+	},
+	NewParams: func() interface{} { return new(ContactGroupConfigUpdateParams) },
+}
+
+// ContactGroupConfigDeleteParams holds the path, query and header parameters of ContactGroupConfigDeleteOperation.
+type ContactGroupConfigDeleteParams struct {
+	// The identifier name of the group.
+	Name string `path:"name"`
+}
+
+// ContactGroupConfigDeleteOperation describes the "Delete a contact group" operation (DELETE /objects/contact_group_config/{name}).
+var ContactGroupConfigDeleteOperation = &openapi.Operation{
+	ID: "cmk.gui.plugins.openapi.endpoints.contact_group_config.delete",
+	Name: "ContactGroupConfigDelete",
+	Method: "DELETE",
+	Path: "/objects/contact_group_config/{name}",
+	Summary: "Delete a contact group",
+	Tags: []string{"Contact groups"},
+	Parameters: []openapi.Parameter{
+		{Name: "name", In: "path", Required: true, Type: "string", Description: "The identifier name of the group."},
+	},
+	Responses: []openapi.Response{
+		{Status: "204", Description: "No Content: Operation done successfully. No further output.", ContentType: "", Schema: "", New: nil},
+		{Status: "403", Description: "Forbidden: Configuration via Setup is disabled.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "404", Description: "Not Found: The requested object has not been found.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "406", Description: "Not Acceptable: The requests accept headers can not be satisfied.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "409", Description: "Conflict: The request is in conflict with the stored resource.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+	},
+	NewParams: func() interface{} { return new(ContactGroupConfigDeleteParams) },
+}
+
+// ServiceDiscoveryShowBulkDiscoveryStatusParams holds the path, query and header parameters of ServiceDiscoveryShowBulkDiscoveryStatusOperation.
+type ServiceDiscoveryShowBulkDiscoveryStatusParams struct {
+	// The unique identifier of the background job executing the bulk discovery
+	JobId string `path:"job_id"`
+}
+
+// ServiceDiscoveryShowBulkDiscoveryStatusOperation describes the "Show the status of a bulk discovery job" operation (GET /objects/discovery_run/{job_id}).
+var ServiceDiscoveryShowBulkDiscoveryStatusOperation = &openapi.Operation{
+	ID: "cmk.gui.plugins.openapi.endpoints.service_discovery.show_bulk_discovery_status",
+	Name: "ServiceDiscoveryShowBulkDiscoveryStatus",
+	Method: "GET",
+	Path: "/objects/discovery_run/{job_id}",
+	Summary: "Show the status of a bulk discovery job",
+	Tags: []string{"Service discovery"},
+	Parameters: []openapi.Parameter{
+		{Name: "job_id", In: "path", Required: true, Type: "string", Description: "The unique identifier of the background job executing the bulk discovery"},
+	},
+	Responses: []openapi.Response{
+		{Status: "200", Description: "OK: The operation was done successfully.", ContentType: "application/json", Schema: "DiscoveryBackgroundJobStatusObject", Headers: []string{"Content-Type", "X-Checkmk-Edition", "X-Checkmk-Version"}, New: func() interface{} { return new(DiscoveryBackgroundJobStatusObject) }},
+		{Status: "403", Description: "Forbidden: Configuration via Setup is disabled.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "404", Description: "Not Found: There is no running background job with this job_id.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "406", Description: "Not Acceptable: The requests accept headers can not be satisfied.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+	},
+	NewParams: func() interface{} { return new(ServiceDiscoveryShowBulkDiscoveryStatusParams) },
+}
+
+// DowntimeShowDowntimeParams holds the path, query and header parameters of DowntimeShowDowntimeOperation.
+type DowntimeShowDowntimeParams struct {
+	// An existing site id
+	SiteId string `query:"site_id"`
+	// The id of the downtime
+	DowntimeId int `path:"downtime_id"`
+}
+
+// DowntimeShowDowntimeOperation describes the "Show downtime" operation (GET /objects/downtime/{downtime_id}).
+var DowntimeShowDowntimeOperation = &openapi.Operation{
+	ID: "cmk.gui.plugins.openapi.endpoints.downtime.show_downtime",
+	Name: "DowntimeShowDowntime",
+	Method: "GET",
+	Path: "/objects/downtime/{downtime_id}",
+	Summary: "Show downtime",
+	Tags: []string{"Downtimes"},
+	Parameters: []openapi.Parameter{
+		{Name: "site_id", In: "query", Required: true, Type: "string", Description: "An existing site id"},
+		{Name: "downtime_id", In: "path", Required: true, Type: "integer", Description: "The id of the downtime"},
+	},
+	Responses: []openapi.Response{
+		{Status: "200", Description: "OK: The operation was done successfully.", ContentType: "application/json", Schema: "DowntimeObject", Headers: []string{"Content-Type", "X-Checkmk-Edition", "X-Checkmk-Version"}, New: func() interface{} { return new(DowntimeObject) }},
+		{Status: "400", Description: "Bad Request: Parameter or validation failure.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "404", Description: "Not Found: The requested object has not been found.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "406", Description: "Not Acceptable: The requests accept headers can not be satisfied.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+	},
+	NewParams: func() interface{} { return new(DowntimeShowDowntimeParams) },
+}
+
+// EventConsoleShowEventParams holds the path, query and header parameters of EventConsoleShowEventOperation.
+type EventConsoleShowEventParams struct {
+	// An existing site id
+	SiteId string `query:"site_id"`
+	// The event console event ID.
+	EventId string `path:"event_id"`
+}
+
+// EventConsoleShowEventOperation describes the "Show an event" operation (GET /objects/event_console/{event_id}).
+var EventConsoleShowEventOperation = &openapi.Operation{
+	ID: "cmk.gui.plugins.openapi.endpoints.event_console.show_event",
+	Name: "EventConsoleShowEvent",
+	Method: "GET",
+	Path: "/objects/event_console/{event_id}",
+	Summary: "Show an event",
+	Tags: []string{"Event Console"},
+	Parameters: []openapi.Parameter{
+		{Name: "site_id", In: "query", Required: true, Type: "string", Description: "An existing site id"},
+		{Name: "event_id", In: "path", Required: true, Type: "string", Description: "The event console event ID."},
+	},
+	Responses: []openapi.Response{
+		{Status: "200", Description: "OK: The operation was done successfully.", ContentType: "application/json", Schema: "ECEventResponse", Headers: []string{"Content-Type", "X-Checkmk-Edition", "X-Checkmk-Version"}, New: func() interface{} { return new(ECEventResponse) }},
+		{Status: "400", Description: "Bad Request: Parameter or validation failure.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "404", Description: "Not Found: The requested object has not been found.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "406", Description: "Not Acceptable: The requests accept headers can not be satisfied.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+	},
+	NewParams: func() interface{} { return new(EventConsoleShowEventParams) },
+}
+
+// EventConsoleChangeEventStateParams holds the path, query and header parameters of EventConsoleChangeEventStateOperation.
+type EventConsoleChangeEventStateParams struct {
+	// The event console event ID.
+	EventId string `path:"event_id"`
+}
+
+// EventConsoleChangeEventStateOperation describes the "Change event state" operation (POST /objects/event_console/{event_id}/actions/change_state/invoke).
+var EventConsoleChangeEventStateOperation = &openapi.Operation{
+	ID: "cmk.gui.plugins.openapi.endpoints.event_console.change_event_state",
+	Name: "EventConsoleChangeEventState",
+	Method: "POST",
+	Path: "/objects/event_console/{event_id}/actions/change_state/invoke",
+	Summary: "Change event state",
+	Tags: []string{"Event Console"},
+	Parameters: []openapi.Parameter{
+		{Name: "Content-Type", In: "header", Required: true, Type: "string", Description: "A header specifying which type of content is in the request/response body. This is required when sending encoded data in a POST/PUT body. When the request body is empty, this header should not be sent."},
+		{Name: "event_id", In: "path", Required: true, Type: "string", Description: "The event console event ID."},
+	},
+	RequestBody: &openapi.RequestBody{ContentType: "application/json", Schema: "ChangeEventState", Required: true, New: func() interface{} { return new(ChangeEventState) }},
+	Responses: []openapi.Response{
+		{Status: "204", Description: "No Content: Operation done successfully. No further output.", ContentType: "", Schema: "", New: nil},
+		{Status: "400", Description: "Bad Request: Parameter or validation failure.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "404", Description: "Not Found: The requested object has not been found.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "406", Description: "Not Acceptable: The requests accept headers can not be satisfied.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "415", Description: "Unsupported Media Type: The submitted content-type is not supported.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+	},
+	NewParams: func() interface{} { return new(EventConsoleChangeEventStateParams) },
+}
+
+// EventConsoleUpdateAndAcknowledgeEventParams holds the path, query and header parameters of EventConsoleUpdateAndAcknowledgeEventOperation.
+type EventConsoleUpdateAndAcknowledgeEventParams struct {
+	// The event console event ID.
+	EventId string `path:"event_id"`
+}
+
+// EventConsoleUpdateAndAcknowledgeEventOperation describes the "Update and acknowledge an event" operation (POST /objects/event_console/{event_id}/actions/update_and_acknowledge/invoke).
+var EventConsoleUpdateAndAcknowledgeEventOperation = &openapi.Operation{
+	ID: "cmk.gui.plugins.openapi.endpoints.event_console.update_and_acknowledge_event",
+	Name: "EventConsoleUpdateAndAcknowledgeEvent",
+	Method: "POST",
+	Path: "/objects/event_console/{event_id}/actions/update_and_acknowledge/invoke",
+	Summary: "Update and acknowledge an event",
+	Tags: []string{"Event Console"},
+	Parameters: []openapi.Parameter{
+		{Name: "Content-Type", In: "header", Required: true, Type: "string", Description: "A header specifying which type of content is in the request/response body. This is required when sending encoded data in a POST/PUT body. When the request body is empty, this header should not be sent."},
+		{Name: "event_id", In: "path", Required: true, Type: "string", Description: "The event console event ID."},
+	},
+	RequestBody: &openapi.RequestBody{ContentType: "application/json", Schema: "UpdateAndAcknowledeEventSiteIDRequired", Required: true, New: func() interface{} { return new(UpdateAndAcknowledeEventSiteIDRequired) }},
+	Responses: []openapi.Response{
+		{Status: "204", Description: "No Content: Operation done successfully. No further output.", ContentType: "", Schema: "", New: nil},
+		{Status: "400", Description: "Bad Request: Parameter or validation failure.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "404", Description: "Not Found: The requested object has not been found.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "406", Description: "Not Acceptable: The requests accept headers can not be satisfied.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "415", Description: "Unsupported Media Type: The submitted content-type is not supported.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+	},
+	NewParams: func() interface{} { return new(EventConsoleUpdateAndAcknowledgeEventParams) },
+}
+
+// FolderConfigShowFolderParams holds the path, query and header parameters of FolderConfigShowFolderOperation.
+type FolderConfigShowFolderParams struct {
+	// When set, all hosts that are stored in this folder will also be shown. On large setups this may come at a performance cost, so by default this is switched off.
+	ShowHosts *bool `query:"show_hosts"`
+	// The path of the folder being requested. Please be aware that slashes can't be used in the URL. Also, escaping the slashes via %2f will not work. Please replace the path delimiters with the tilde character `~`. Path delimiters can be either `~`, `/` or `\`. Please use the one most appropriate for ...
+	Folder string `path:"folder"`
+}
+
+// FolderConfigShowFolderOperation describes the "Show a folder" operation (GET /objects/folder_config/{folder}).
+var FolderConfigShowFolderOperation = &openapi.Operation{
+	ID: "cmk.gui.plugins.openapi.endpoints.folder_config.show_folder",
+	Name: "FolderConfigShowFolder",
+	Method: "GET",
+	Path: "/objects/folder_config/{folder}",
+	Summary: "Show a folder",
+	Tags: []string{"Folders"},
+	Parameters: []openapi.Parameter{
+		{Name: "show_hosts", In: "query", Required: false, Type: "boolean", Description: "When set, all hosts that are stored in this folder will also be shown. On large setups this may come at a performance cost, so by default this is switched off."},
+		{Name: "folder", In: "path", Required: true, Type: "string", Description: "The path of the folder being requested. Please be aware that slashes can't be used in the URL. Also, escaping the slashes via %2f will not work. Please replace the path delimiters with the tilde character `~`. Path delimiters can be either `~`, `/` or `\\`. Please use the one most appropriate for your quoting/escaping needs. A good default choice is `~`."},
+	},
+	Responses: []openapi.Response{
+		{Status: "200", Description: "OK: The operation was done successfully.", ContentType: "application/json", Schema: "Folder", Headers: []string{"Content-Type", "ETag", "X-Checkmk-Edition", "X-Checkmk-Version"}, New: func() interface{} { return new(Folder) }},
+		{Status: "400", Description: "Bad Request: Parameter or validation failure.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "403", Description: "Forbidden: Configuration via Setup is disabled.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "404", Description: "Not Found: The requested object has not been found.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "406", Description: "Not Acceptable: The requests accept headers can not be satisfied.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+	},
+	NewParams: func() interface{} { return new(FolderConfigShowFolderParams) },
+}
+
+// FolderConfigUpdateParams holds the path, query and header parameters of FolderConfigUpdateOperation.
+type FolderConfigUpdateParams struct {
+	// The value of the, to be modified, object's ETag header. You can get this value by displaying the object it individually. To update this object the currently stored ETag needs to be the same as the one sent. The content of the ETag can potentially be anything and should be treated as semantically ...
+	IfMatch string `header:"If-Match"`
+	// The path of the folder being requested. Please be aware that slashes can't be used in the URL. Also, escaping the slashes via %2f will not work. Please replace the path delimiters with the tilde character `~`. Path delimiters can be either `~`, `/` or `\`. Please use the one most appropriate for ...
+	Folder string `path:"folder"`
+}
+
+// FolderConfigUpdateOperation describes the "Update a folder" operation (PUT /objects/folder_config/{folder}).
+var FolderConfigUpdateOperation = &openapi.Operation{
+	ID: "cmk.gui.plugins.openapi.endpoints.folder_config.update",
+	Name: "FolderConfigUpdate",
+	Method: "PUT",
+	Path: "/objects/folder_config/{folder}",
+	Summary: "Update a folder",
+	Tags: []string{"Folders"},
+	Parameters: []openapi.Parameter{
+		{Name: "If-Match", In: "header", Required: true, Type: "string", Description: "The value of the, to be modified, object's ETag header. You can get this value by displaying the object it individually. To update this object the currently stored ETag needs to be the same as the one sent. The content of the ETag can potentially be anything and should be treated as semantically opaque."},
+		{Name: "Content-Type", In: "header", Required: true, Type: "string", Description: "A header specifying which type of content is in the request/response body. This is required when sending encoded data in a POST/PUT body. When the request body is empty, this header should not be sent."},
+		{Name: "folder", In: "path", Required: true, Type: "string", Description: "The path of the folder being requested. Please be aware that slashes can't be used in the URL. Also, escaping the slashes via %2f will not work. Please replace the path delimiters with the tilde character `~`. Path delimiters can be either `~`, `/` or `\\`. Please use the one most appropriate for your quoting/escaping needs. A good default choice is `~`."},
+	},
+	RequestBody: &openapi.RequestBody{ContentType: "application/json", Schema: "UpdateFolder", Required: true, New: func() interface{} { return new(UpdateFolder) }},
+	Responses: []openapi.Response{
+		{Status: "200", Description: "OK: The operation was done successfully.", ContentType: "application/json", Schema: "Folder", Headers: []string{"Content-Type", "ETag", "X-Checkmk-Edition", "X-Checkmk-Version"}, New: func() interface{} { return new(Folder) }},
+		{Status: "400", Description: "Bad Request: Parameter or validation failure.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "403", Description: "Forbidden: Configuration via Setup is disabled.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "404", Description: "Not Found: The requested object has not been found.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "406", Description: "Not Acceptable: The requests accept headers can not be satisfied.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "412", Description: "Precondition Failed: The value of the If-Match header doesn't match the object's ETag.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "415", Description: "Unsupported Media Type: The submitted content-type is not supported.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "428", Description: "Precondition Required: The required If-Match header is missing.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+	},
+	NewParams: func() interface{} { return new(FolderConfigUpdateParams) },
+}
+
+// FolderConfigDeleteParams holds the path, query and header parameters of FolderConfigDeleteOperation.
+type FolderConfigDeleteParams struct {
+	// The path of the folder being requested. Please be aware that slashes can't be used in the URL. Also, escaping the slashes via %2f will not work. Please replace the path delimiters with the tilde character `~`. Path delimiters can be either `~`, `/` or `\`. Please use the one most appropriate for ...
+	Folder string `path:"folder"`
+}
+
+// FolderConfigDeleteOperation describes the "Delete a folder" operation (DELETE /objects/folder_config/{folder}).
+var FolderConfigDeleteOperation = &openapi.Operation{
+	ID: "cmk.gui.plugins.openapi.endpoints.folder_config.delete",
+	Name: "FolderConfigDelete",
+	Method: "DELETE",
+	Path: "/objects/folder_config/{folder}",
+	Summary: "Delete a folder",
+	Tags: []string{"Folders"},
+	Parameters: []openapi.Parameter{
+		{Name: "folder", In: "path", Required: true, Type: "string", Description: "The path of the folder being requested. Please be aware that slashes can't be used in the URL. Also, escaping the slashes via %2f will not work. Please replace the path delimiters with the tilde character `~`. Path delimiters can be either `~`, `/` or `\\`. Please use the one most appropriate for your quoting/escaping needs. A good default choice is `~`."},
+	},
+	Responses: []openapi.Response{
+		{Status: "204", Description: "No Content: Operation done successfully. No further output.", ContentType: "", Schema: "", New: nil},
+		{Status: "401", Description: "Unauthorized: The user is not authorized to do this request.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "403", Description: "Forbidden: Configuration via Setup is disabled.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "404", Description: "Not Found: The requested object has not been found.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "406", Description: "Not Acceptable: The requests accept headers can not be satisfied.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+	},
+	NewParams: func() interface{} { return new(FolderConfigDeleteParams) },
+}
+
+// FolderConfigMoveParams holds the path, query and header parameters of FolderConfigMoveOperation.
+type FolderConfigMoveParams struct {
+	// The value of the, to be modified, object's ETag header. You can get this value by displaying the object it individually. To update this object the currently stored ETag needs to be the same as the one sent. The content of the ETag can potentially be anything and should be treated as semantically ...
+	IfMatch string `header:"If-Match"`
+	// The path of the folder being requested. Please be aware that slashes can't be used in the URL. Also, escaping the slashes via %2f will not work. Please replace the path delimiters with the tilde character `~`. Path delimiters can be either `~`, `/` or `\`. Please use the one most appropriate for ...
+	Folder string `path:"folder"`
+}
+
+// FolderConfigMoveOperation describes the "Move a folder" operation (POST /objects/folder_config/{folder}/actions/move/invoke).
+var FolderConfigMoveOperation = &openapi.Operation{
+	ID: "cmk.gui.plugins.openapi.endpoints.folder_config.move",
+	Name: "FolderConfigMove",
+	Method: "POST",
+	Path: "/objects/folder_config/{folder}/actions/move/invoke",
+	Summary: "Move a folder",
+	Tags: []string{"Folders"},
+	Parameters: []openapi.Parameter{
+		{Name: "If-Match", In: "header", Required: true, Type: "string", Description: "The value of the, to be modified, object's ETag header. You can get this value by displaying the object it individually. To update this object the currently stored ETag needs to be the same as the one sent. The content of the ETag can potentially be anything and should be treated as semantically opaque."},
+		{Name: "Content-Type", In: "header", Required: true, Type: "string", Description: "A header specifying which type of content is in the request/response body. This is required when sending encoded data in a POST/PUT body. When the request body is empty, this header should not be sent."},
+		{Name: "folder", In: "path", Required: true, Type: "string", Description: "The path of the folder being requested. Please be aware that slashes can't be used in the URL. Also, escaping the slashes via %2f will not work. Please replace the path delimiters with the tilde character `~`. Path delimiters can be either `~`, `/` or `\\`. Please use the one most appropriate for your quoting/escaping needs. A good default choice is `~`."},
+	},
+	RequestBody: &openapi.RequestBody{ContentType: "application/json", Schema: "MoveFolder", Required: true, New: func() interface{} { return new(MoveFolder) }},
+	Responses: []openapi.Response{
+		{Status: "200", Description: "OK: The operation was done successfully.", ContentType: "application/json", Schema: "Folder", Headers: []string{"Content-Type", "ETag", "X-Checkmk-Edition", "X-Checkmk-Version"}, New: func() interface{} { return new(Folder) }},
+		{Status: "400", Description: "Bad Request: Parameter or validation failure.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "403", Description: "Forbidden: Configuration via Setup is disabled.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "404", Description: "Not Found: The requested object has not been found.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "406", Description: "Not Acceptable: The requests accept headers can not be satisfied.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "412", Description: "Precondition Failed: The value of the If-Match header doesn't match the object's ETag.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "415", Description: "Unsupported Media Type: The submitted content-type is not supported.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "428", Description: "Precondition Required: The required If-Match header is missing.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+	},
+	NewParams: func() interface{} { return new(FolderConfigMoveParams) },
+}
+
+// FolderConfigHostsOfFolderParams holds the path, query and header parameters of FolderConfigHostsOfFolderOperation.
+type FolderConfigHostsOfFolderParams struct {
+	// The path of the folder being requested. Please be aware that slashes can't be used in the URL. Also, escaping the slashes via %2f will not work. Please replace the path delimiters with the tilde character `~`. Path delimiters can be either `~`, `/` or `\`. Please use the one most appropriate for ...
+	Folder string `path:"folder"`
+}
+
+// FolderConfigHostsOfFolderOperation describes the "Show all hosts in a folder" operation (GET /objects/folder_config/{folder}/collections/hosts).
+var FolderConfigHostsOfFolderOperation = &openapi.Operation{
+	ID: "cmk.gui.plugins.openapi.endpoints.folder_config.hosts_of_folder",
+	Name: "FolderConfigHostsOfFolder",
+	Method: "GET",
+	Path: "/objects/folder_config/{folder}/collections/hosts",
+	Summary: "Show all hosts in a folder",
+	Tags: []string{"Folders"},
+	Parameters: []openapi.Parameter{
+		{Name: "folder", In: "path", Required: true, Type: "string", Description: "The path of the folder being requested. Please be aware that slashes can't be used in the URL. Also, escaping the slashes via %2f will not work. Please replace the path delimiters with the tilde character `~`. Path delimiters can be either `~`, `/` or `\\`. Please use the one most appropriate for your quoting/escaping needs. A good default choice is `~`."},
+	},
+	Responses: []openapi.Response{
+		{Status: "200", Description: "OK: The operation was done successfully.", ContentType: "application/json", Schema: "HostConfigCollection", Headers: []string{"Content-Type", "X-Checkmk-Edition", "X-Checkmk-Version"}, New: func() interface{} { return new(HostConfigCollection) }},
+		{Status: "403", Description: "Forbidden: Configuration via Setup is disabled.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "404", Description: "Not Found: The requested object has not been found.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "406", Description: "Not Acceptable: The requests accept headers can not be satisfied.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+	},
+	NewParams: func() interface{} { return new(FolderConfigHostsOfFolderParams) },
+}
+
+// ServiceDiscoveryExecute14538Params holds the path, query and header parameters of ServiceDiscoveryExecute14538Operation.
+type ServiceDiscoveryExecute14538Params struct {
+	// A hostname.
+	HostName string `path:"host_name"`
+}
+
+// ServiceDiscoveryExecute14538Operation describes the "Execute a service discovery on a host" operation (POST /objects/host/{host_name}/actions/discover_services/invoke).
+//
+// Deprecated: this endpoint is deprecated by CheckMK.
+var ServiceDiscoveryExecute14538Operation = &openapi.Operation{
+	ID: "cmk.gui.plugins.openapi.endpoints.service_discovery.execute-14538",
+	Name: "ServiceDiscoveryExecute14538",
+	Method: "POST",
+	Path: "/objects/host/{host_name}/actions/discover_services/invoke",
+	Summary: "Execute a service discovery on a host",
+	Tags: []string{"Service discovery"},
+	Deprecated: true,
+	Parameters: []openapi.Parameter{
+		{Name: "Content-Type", In: "header", Required: true, Type: "string", Description: "A header specifying which type of content is in the request/response body. This is required when sending encoded data in a POST/PUT body. When the request body is empty, this header should not be sent."},
+		{Name: "host_name", In: "path", Required: true, Type: "string", Description: "A hostname."},
+	},
+	RequestBody: &openapi.RequestBody{ContentType: "application/json", Schema: "DiscoverServicesDeprecated", Required: true, New: func() interface{} { return new(DiscoverServicesDeprecated) }},
+	Responses: []openapi.Response{
+		{Status: "200", Description: "OK: The operation was done successfully.", ContentType: "application/json", Schema: "DomainObject", Headers: []string{"Content-Type", "X-Checkmk-Edition", "X-Checkmk-Version"}, New: func() interface{} { return new(DomainObject) }},
+		{Status: "302", Description: "Found: The service discovery background job has been initialized. Redirecting to the 'Wait for service discovery completion' endpoint.", ContentType: "", Schema: "", New: nil},
+		{Status: "400", Description: "Bad Request: Parameter or validation failure.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "403", Description: "Forbidden: Configuration via Setup is disabled.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "404", Description: "Not Found: Host could not be found", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "406", Description: "Not Acceptable: The requests accept headers can not be satisfied.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "409", Description: "Conflict: A service discovery background job is currently running", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "415", Description: "Unsupported Media Type: The submitted content-type is not supported.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+	},
+	NewParams: func() interface{} { return new(ServiceDiscoveryExecute14538Params) },
+}
+
+// ServiceShowServiceParams holds the path, query and header parameters of ServiceShowServiceOperation.
+type ServiceShowServiceParams struct {
+	// The service description of the selected host
+	ServiceDescription string `query:"service_description"`
+	// A hostname.
+	HostName string `path:"host_name"`
+}
+
+// ServiceShowServiceOperation describes the "Show the monitored service of a host" operation (GET /objects/host/{host_name}/actions/show_service/invoke).
+var ServiceShowServiceOperation = &openapi.Operation{
+	ID: "cmk.gui.plugins.openapi.endpoints.service.show_service",
+	Name: "ServiceShowService",
+	Method: "GET",
+	Path: "/objects/host/{host_name}/actions/show_service/invoke",
+	Summary: "Show the monitored service of a host",
+	Tags: []string{"Service status"},
+	Parameters: []openapi.Parameter{
+		{Name: "service_description", In: "query", Required: true, Type: "string", Description: "The service description of the selected host"},
+		{Name: "host_name", In: "path", Required: true, Type: "string", Description: "A hostname."},
+	},
+	Responses: []openapi.Response{
+		{Status: "200", Description: "OK: The operation was done successfully.", ContentType: "application/json", Schema: "DomainObject", Headers: []string{"Content-Type", "X-Checkmk-Edition", "X-Checkmk-Version"}, New: func() interface{} { return new(DomainObject) }},
+		{Status: "400", Description: "Bad Request: Parameter or validation failure.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "404", Description: "Not Found: The requested object has not been found.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "406", Description: "Not Acceptable: The requests accept headers can not be satisfied.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+	},
+	NewParams: func() interface{} { return new(ServiceShowServiceParams) },
+}
+
+// ServiceDiscoveryUpdateServicePhaseParams holds the path, query and header parameters of ServiceDiscoveryUpdateServicePhaseOperation.
+type ServiceDiscoveryUpdateServicePhaseParams struct {
+	// The host of the service which shall be updated.
+	HostName string `path:"host_name"`
+}
+
+// ServiceDiscoveryUpdateServicePhaseOperation describes the "Update the phase of a service" operation (PUT /objects/host/{host_name}/actions/update_discovery_phase/invoke).
+var ServiceDiscoveryUpdateServicePhaseOperation = &openapi.Operation{
+	ID: "cmk.gui.plugins.openapi.endpoints.service_discovery.update_service_phase",
+	Name: "ServiceDiscoveryUpdateServicePhase",
+	Method: "PUT",
+	Path: "/objects/host/{host_name}/actions/update_discovery_phase/invoke",
+	Summary: "Update the phase of a service",
+	Tags: []string{"Service discovery"},
+	Parameters: []openapi.Parameter{
+		{Name: "Content-Type", In: "header", Required: true, Type: "string", Description: "A header specifying which type of content is in the request/response body. This is required when sending encoded data in a POST/PUT body. When the request body is empty, this header should not be sent."},
+		{Name: "host_name", In: "path", Required: true, Type: "string", Description: "The host of the service which shall be updated."},
+	},
+	RequestBody: &openapi.RequestBody{ContentType: "application/json", Schema: "UpdateDiscoveryPhase", Required: true, New: func() interface{} { return new(UpdateDiscoveryPhase) }},
+	Responses: []openapi.Response{
+		{Status: "204", Description: "No Content: Operation done successfully. No further output.", ContentType: "", Schema: "", New: nil},
+		{Status: "400", Description: "Bad Request: Parameter or validation failure.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "403", Description: "Forbidden: Configuration via Setup is disabled.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "404", Description: "Not Found: Host could not be found", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "406", Description: "Not Acceptable: The requests accept headers can not be satisfied.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "415", Description: "Unsupported Media Type: The submitted content-type is not supported.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+	},
+	NewParams: func() interface{} { return new(ServiceDiscoveryUpdateServicePhaseParams) },
+}
+
+// HostConfigShowHostParams holds the path, query and header parameters of HostConfigShowHostOperation.
+type HostConfigShowHostParams struct {
+	// Show all effective attributes on hosts, not just the attributes which were set on this host specifically. This includes all attributes of all of this host's parent folders.
+	EffectiveAttributes *bool `query:"effective_attributes"`
+	// A hostname.
+	HostName string `path:"host_name"`
+}
+
+// HostConfigShowHostOperation describes the "Show a host" operation (GET /objects/host_config/{host_name}).
+var HostConfigShowHostOperation = &openapi.Operation{
+	ID: "cmk.gui.plugins.openapi.endpoints.host_config.show_host",
+	Name: "HostConfigShowHost",
+	Method: "GET",
+	Path: "/objects/host_config/{host_name}",
+	Summary: "Show a host",
+	Tags: []string{"Hosts"},
+	Parameters: []openapi.Parameter{
+		{Name: "effective_attributes", In: "query", Required: false, Type: "boolean", Description: "Show all effective attributes on hosts, not just the attributes which were set on this host specifically. This includes all attributes of all of this host's parent folders."},
+		{Name: "host_name", In: "path", Required: true, Type: "string", Description: "A hostname."},
+	},
+	Responses: []openapi.Response{
+		{Status: "200", Description: "OK: The operation was done successfully.", ContentType: "application/json", Schema: "HostConfig", Headers: []string{"Content-Type", "ETag", "X-Checkmk-Edition", "X-Checkmk-Version"}, New: func() interface{} { return new(HostConfig) }},
+		{Status: "400", Description: "Bad Request: Parameter or validation failure.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "403", Description: "Forbidden: Configuration via Setup is disabled.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "404", Description: "Not Found: The requested object has not been found.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "406", Description: "Not Acceptable: The requests accept headers can not be satisfied.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+	},
+	NewParams: func() interface{} { return new(HostConfigShowHostParams) },
+}
+
+// HostConfigUpdateHostParams holds the path, query and header parameters of HostConfigUpdateHostOperation.
+type HostConfigUpdateHostParams struct {
+	// The value of the, to be modified, object's ETag header. You can get this value by displaying the object it individually. To update this object the currently stored ETag needs to be the same as the one sent. The content of the ETag can potentially be anything and should be treated as semantically ...
+	IfMatch string `header:"If-Match"`
+	// A hostname.
+	HostName string `path:"host_name"`
+}
+
+// HostConfigUpdateHostOperation describes the "Update a host" operation (PUT /objects/host_config/{host_name}).
+var HostConfigUpdateHostOperation = &openapi.Operation{
+	ID: "cmk.gui.plugins.openapi.endpoints.host_config.update_host",
+	Name: "HostConfigUpdateHost",
+	Method: "PUT",
+	Path: "/objects/host_config/{host_name}",
+	Summary: "Update a host",
+	Tags: []string{"Hosts"},
+	Parameters: []openapi.Parameter{
+		{Name: "If-Match", In: "header", Required: true, Type: "string", Description: "The value of the, to be modified, object's ETag header. You can get this value by displaying the object it individually. To update this object the currently stored ETag needs to be the same as the one sent. The content of the ETag can potentially be anything and should be treated as semantically opaque."},
+		{Name: "Content-Type", In: "header", Required: true, Type: "string", Description: "A header specifying which type of content is in the request/response body. This is required when sending encoded data in a POST/PUT body. When the request body is empty, this header should not be sent."},
+		{Name: "host_name", In: "path", Required: true, Type: "string", Description: "A hostname."},
+	},
+	RequestBody: &openapi.RequestBody{ContentType: "application/json", Schema: "UpdateHost", Required: true, New: func() interface{} { return new(UpdateHost) }},
+	Responses: []openapi.Response{
+		{Status: "200", Description: "OK: The operation was done successfully.", ContentType: "application/json", Schema: "HostConfig", Headers: []string{"Content-Type", "ETag", "X-Checkmk-Edition", "X-Checkmk-Version"}, New: func() interface{} { return new(HostConfig) }},
+		{Status: "400", Description: "Bad Request: Parameter or validation failure.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "403", Description: "Forbidden: Configuration via Setup is disabled.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "404", Description: "Not Found: The requested object has not been found.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "406", Description: "Not Acceptable: The requests accept headers can not be satisfied.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "412", Description: "Precondition Failed: The value of the If-Match header doesn't match the object's ETag.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "415", Description: "Unsupported Media Type: The submitted content-type is not supported.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "428", Description: "Precondition Required: The required If-Match header is missing.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+	},
+	NewParams: func() interface{} { return new(HostConfigUpdateHostParams) },
+}
+
+// HostConfigDeleteParams holds the path, query and header parameters of HostConfigDeleteOperation.
+type HostConfigDeleteParams struct {
+	// A hostname.
+	HostName string `path:"host_name"`
+}
+
+// HostConfigDeleteOperation describes the "Delete a host" operation (DELETE /objects/host_config/{host_name}).
+var HostConfigDeleteOperation = &openapi.Operation{
+	ID: "cmk.gui.plugins.openapi.endpoints.host_config.delete",
+	Name: "HostConfigDelete",
+	Method: "DELETE",
+	Path: "/objects/host_config/{host_name}",
+	Summary: "Delete a host",
+	Tags: []string{"Hosts"},
+	Parameters: []openapi.Parameter{
+		{Name: "host_name", In: "path", Required: true, Type: "string", Description: "A hostname."},
+	},
+	Responses: []openapi.Response{
+		{Status: "204", Description: "No Content: Operation done successfully. No further output.", ContentType: "", Schema: "", New: nil},
+		{Status: "403", Description: "Forbidden: Configuration via Setup is disabled.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "404", Description: "Not Found: The requested object has not been found.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "406", Description: "Not Acceptable: The requests accept headers can not be satisfied.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+	},
+	NewParams: func() interface{} { return new(HostConfigDeleteParams) },
+}
+
+// HostConfigMoveParams holds the path, query and header parameters of HostConfigMoveOperation.
+type HostConfigMoveParams struct {
+	// The value of the, to be modified, object's ETag header. You can get this value by displaying the object it individually. To update this object the currently stored ETag needs to be the same as the one sent. The content of the ETag can potentially be anything and should be treated as semantically ...
+	IfMatch string `header:"If-Match"`
+	// A hostname.
+	HostName string `path:"host_name"`
+}
+
+// HostConfigMoveOperation describes the "Move a host to another folder" operation (POST /objects/host_config/{host_name}/actions/move/invoke).
+var HostConfigMoveOperation = &openapi.Operation{
+	ID: "cmk.gui.plugins.openapi.endpoints.host_config.move",
+	Name: "HostConfigMove",
+	Method: "POST",
+	Path: "/objects/host_config/{host_name}/actions/move/invoke",
+	Summary: "Move a host to another folder",
+	Tags: []string{"Hosts"},
+	Parameters: []openapi.Parameter{
+		{Name: "If-Match", In: "header", Required: true, Type: "string", Description: "The value of the, to be modified, object's ETag header. You can get this value by displaying the object it individually. To update this object the currently stored ETag needs to be the same as the one sent. The content of the ETag can potentially be anything and should be treated as semantically opaque."},
+		{Name: "Content-Type", In: "header", Required: true, Type: "string", Description: "A header specifying which type of content is in the request/response body. This is required when sending encoded data in a POST/PUT body. When the request body is empty, this header should not be sent."},
+		{Name: "host_name", In: "path", Required: true, Type: "string", Description: "A hostname."},
+	},
+	RequestBody: &openapi.RequestBody{ContentType: "application/json", Schema: "MoveHost", Required: true, New: func() interface{} { return new(MoveHost) }},
+	Responses: []openapi.Response{
+		{Status: "200", Description: "OK: The operation was done successfully.", ContentType: "application/json", Schema: "HostConfig", Headers: []string{"Content-Type", "ETag", "X-Checkmk-Edition", "X-Checkmk-Version"}, New: func() interface{} { return new(HostConfig) }},
+		{Status: "400", Description: "Bad Request: Parameter or validation failure.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "403", Description: "Forbidden: Configuration via Setup is disabled.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "404", Description: "Not Found: The requested object has not been found.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "406", Description: "Not Acceptable: The requests accept headers can not be satisfied.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "412", Description: "Precondition Failed: The value of the If-Match header doesn't match the object's ETag.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "415", Description: "Unsupported Media Type: The submitted content-type is not supported.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "428", Description: "Precondition Required: The required If-Match header is missing.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+	},
+	NewParams: func() interface{} { return new(HostConfigMoveParams) },
+}
+
+// HostConfigRenameHostParams holds the path, query and header parameters of HostConfigRenameHostOperation.
+type HostConfigRenameHostParams struct {
+	// The value of the, to be modified, object's ETag header. You can get this value by displaying the object it individually. To update this object the currently stored ETag needs to be the same as the one sent. The content of the ETag can potentially be anything and should be treated as semantically ...
+	IfMatch string `header:"If-Match"`
+	// A hostname.
+	HostName string `path:"host_name"`
+}
+
+// HostConfigRenameHostOperation describes the "Rename a host" operation (PUT /objects/host_config/{host_name}/actions/rename/invoke).
+var HostConfigRenameHostOperation = &openapi.Operation{
+	ID: "cmk.gui.plugins.openapi.endpoints.host_config.rename_host",
+	Name: "HostConfigRenameHost",
+	Method: "PUT",
+	Path: "/objects/host_config/{host_name}/actions/rename/invoke",
+	Summary: "Rename a host",
+	Tags: []string{"Hosts"},
+	Parameters: []openapi.Parameter{
+		{Name: "If-Match", In: "header", Required: true, Type: "string", Description: "The value of the, to be modified, object's ETag header. You can get this value by displaying the object it individually. To update this object the currently stored ETag needs to be the same as the one sent. The content of the ETag can potentially be anything and should be treated as semantically opaque."},
+		{Name: "Content-Type", In: "header", Required: true, Type: "string", Description: "A header specifying which type of content is in the request/response body. This is required when sending encoded data in a POST/PUT body. When the request body is empty, this header should not be sent."},
+		{Name: "host_name", In: "path", Required: true, Type: "string", Description: "A hostname."},
+	},
+	RequestBody: &openapi.RequestBody{ContentType: "application/json", Schema: "RenameHost", Required: true, New: func() interface{} { return new(RenameHost) }},
+	Responses: []openapi.Response{
+		{Status: "200", Description: "OK: The operation was done successfully.", ContentType: "application/json", Schema: "HostConfig", Headers: []string{"Content-Type", "ETag", "X-Checkmk-Edition", "X-Checkmk-Version"}, New: func() interface{} { return new(HostConfig) }},
+		{Status: "400", Description: "Bad Request: Parameter or validation failure.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "403", Description: "Forbidden: Configuration via Setup is disabled.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "404", Description: "Not Found: The requested object has not been found.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "406", Description: "Not Acceptable: The requests accept headers can not be satisfied.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "409", Description: "Conflict: There are pending changes not yet activated.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "412", Description: "Precondition Failed: The value of the If-Match header doesn't match the object's ETag.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "415", Description: "Unsupported Media Type: The submitted content-type is not supported.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "422", Description: "Unprocessable Entity: The host could not be renamed.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "428", Description: "Precondition Required: The required If-Match header is missing.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+	},
+	NewParams: func() interface{} { return new(HostConfigRenameHostParams) },
+}
+
+// HostConfigUpdateNodesParams holds the path, query and header parameters of HostConfigUpdateNodesOperation.
+type HostConfigUpdateNodesParams struct {
+	// The value of the, to be modified, object's ETag header. You can get this value by displaying the object it individually. To update this object the currently stored ETag needs to be the same as the one sent. The content of the ETag can potentially be anything and should be treated as semantically ...
+	IfMatch string `header:"If-Match"`
+	// A cluster host.
+	HostName string `path:"host_name"`
+}
+
+// HostConfigUpdateNodesOperation describes the "Update the nodes of a cluster host" operation (PUT /objects/host_config/{host_name}/properties/nodes).
+var HostConfigUpdateNodesOperation = &openapi.Operation{
+	ID: "cmk.gui.plugins.openapi.endpoints.host_config.update_nodes",
+	Name: "HostConfigUpdateNodes",
+	Method: "PUT",
+	Path: "/objects/host_config/{host_name}/properties/nodes",
+	Summary: "Update the nodes of a cluster host",
+	Tags: []string{"Hosts"},
+	Parameters: []openapi.Parameter{
+		{Name: "If-Match", In: "header", Required: true, Type: "string", Description: "The value of the, to be modified, object's ETag header. You can get this value by displaying the object it individually. To update this object the currently stored ETag needs to be the same as the one sent. The content of the ETag can potentially be anything and should be treated as semantically opaque."},
+		{Name: "Content-Type", In: "header", Required: true, Type: "string", Description: "A header specifying which type of content is in the request/response body. This is required when sending encoded data in a POST/PUT body. When the request body is empty, this header should not be sent."},
+		{Name: "host_name", In: "path", Required: true, Type: "string", Description: "A cluster host."},
+	},
+	RequestBody: &openapi.RequestBody{ContentType: "application/json", Schema: "UpdateNodes", Required: true, New: func() interface{} { return new(UpdateNodes) }},
+	Responses: []openapi.Response{
+		{Status: "200", Description: "OK: The operation was done successfully.", ContentType: "application/json", Schema: "ObjectProperty", Headers: []string{"Content-Type", "ETag", "X-Checkmk-Edition", "X-Checkmk-Version"}, New: func() interface{} { return new(ObjectProperty) }},
+		{Status: "400", Description: "Bad Request: Parameter or validation failure.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "403", Description: "Forbidden: Configuration via Setup is disabled.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "404", Description: "Not Found: The requested object has not been found.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "406", Description: "Not Acceptable: The requests accept headers can not be satisfied.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "412", Description: "Precondition Failed: The value of the If-Match header doesn't match the object's ETag.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "415", Description: "Unsupported Media Type: The submitted content-type is not supported.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "428", Description: "Precondition Required: The required If-Match header is missing.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+	},
+	NewParams: func() interface{} { return new(HostConfigUpdateNodesParams) },
+}
+
+// HostInternalShowHostParams holds the path, query and header parameters of HostInternalShowHostOperation.
+type HostInternalShowHostParams struct {
+	// A hostname.
+	HostName string `path:"host_name"`
+}
+
+// HostInternalShowHostOperation describes the "Show a host" operation (GET /objects/host_config_internal/{host_name}).
+var HostInternalShowHostOperation = &openapi.Operation{
+	ID: "cmk.gui.plugins.openapi.endpoints.host_internal.show_host",
+	Name: "HostInternalShowHost",
+	Method: "GET",
+	Path: "/objects/host_config_internal/{host_name}",
+	Summary: "Show a host",
+	Tags: []string{"Hosts (internal)"},
+	Parameters: []openapi.Parameter{
+		{Name: "host_name", In: "path", Required: true, Type: "string", Description: "A hostname."},
+	},
+	Responses: []openapi.Response{
+		{Status: "200", Description: "OK: The operation was done successfully.", ContentType: "application/json", Schema: "HostConfigSchemaInternal", Headers: []string{"Content-Type", "X-Checkmk-Edition", "X-Checkmk-Version"}, New: func() interface{} { return new(HostConfigSchemaInternal) }},
+		{Status: "401", Description: "Unauthorized: You do not have read access to this host.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "404", Description: "Not Found: The requested object has not been found.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "406", Description: "Not Acceptable: The requests accept headers can not be satisfied.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+	},
+	NewParams: func() interface{} { return new(HostInternalShowHostParams) },
+}
+
+// HostInternalLinkWithUuidParams holds the path, query and header parameters of HostInternalLinkWithUuidOperation.
+type HostInternalLinkWithUuidParams struct {
+	// A hostname.
+	HostName string `path:"host_name"`
+}
+
+// HostInternalLinkWithUuidOperation describes the "Link a host to a UUID" operation (PUT /objects/host_config_internal/{host_name}/actions/link_uuid/invoke).
+var HostInternalLinkWithUuidOperation = &openapi.Operation{
+	ID: "cmk.gui.plugins.openapi.endpoints.host_internal.link_with_uuid",
+	Name: "HostInternalLinkWithUuid",
+	Method: "PUT",
+	Path: "/objects/host_config_internal/{host_name}/actions/link_uuid/invoke",
+	Summary: "Link a host to a UUID",
+	Tags: []string{"Hosts (internal)"},
+	Parameters: []openapi.Parameter{
+		{Name: "Content-Type", In: "header", Required: true, Type: "string", Description: "A header specifying which type of content is in the request/response body. This is required when sending encoded data in a POST/PUT body. When the request body is empty, this header should not be sent."},
+		{Name: "host_name", In: "path", Required: true, Type: "string", Description: "A hostname."},
+	},
+	RequestBody: &openapi.RequestBody{ContentType: "application/json", Schema: "LinkHostUUID", Required: true, New: func() interface{} { return new(LinkHostUUID) }},
+	Responses: []openapi.Response{
+		{Status: "204", Description: "No Content: Operation done successfully. No further output.", ContentType: "", Schema: "", New: nil},
+		{Status: "400", Description: "Bad Request: Parameter or validation failure.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "401", Description: "Unauthorized: You do not have the permissions to edit this host.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "404", Description: "Not Found: The requested object has not been found.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "406", Description: "Not Acceptable: The requests accept headers can not be satisfied.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "415", Description: "Unsupported Media Type: The submitted content-type is not supported.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+	},
+	NewParams: func() interface{} { return new(HostInternalLinkWithUuidParams) },
+}
+
+// HostInternalRegisterParams holds the path, query and header parameters of HostInternalRegisterOperation.
+type HostInternalRegisterParams struct {
+	// A hostname.
+	HostName string `path:"host_name"`
+}
+
+// HostInternalRegisterOperation describes the "Register an existing host, ie. link it to a UUID" operation (PUT /objects/host_config_internal/{host_name}/actions/register/invoke).
+var HostInternalRegisterOperation = &openapi.Operation{
+	ID: "cmk.gui.plugins.openapi.endpoints.host_internal.register",
+	Name: "HostInternalRegister",
+	Method: "PUT",
+	Path: "/objects/host_config_internal/{host_name}/actions/register/invoke",
+	Summary: "Register an existing host, ie. link it to a UUID",
+	Tags: []string{"Hosts (internal)"},
+	Parameters: []openapi.Parameter{
+		{Name: "Content-Type", In: "header", Required: true, Type: "string", Description: "A header specifying which type of content is in the request/response body. This is required when sending encoded data in a POST/PUT body. When the request body is empty, this header should not be sent."},
+		{Name: "host_name", In: "path", Required: true, Type: "string", Description: "A hostname."},
+	},
+	RequestBody: &openapi.RequestBody{ContentType: "application/json", Schema: "RegisterHost", Required: true, New: func() interface{} { return new(RegisterHost) }},
+	Responses: []openapi.Response{
+		{Status: "200", Description: "OK: The operation was done successfully.", ContentType: "application/json", Schema: "ConnectionMode", Headers: []string{"Content-Type", "X-Checkmk-Edition", "X-Checkmk-Version"}, New: func() interface{} { return new(ConnectionMode) }},
+		{Status: "400", Description: "Bad Request: Parameter or validation failure.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "403", Description: "Forbidden: You do not have the permissions to register this host.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "404", Description: "Not Found: The requested object has not been found.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "405", Description: "Method Not Allowed: This host cannot be registered on this site.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "406", Description: "Not Acceptable: The requests accept headers can not be satisfied.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "415", Description: "Unsupported Media Type: The submitted content-type is not supported.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+	},
+	NewParams: func() interface{} { return new(HostInternalRegisterParams) },
+}
+
+// HostGroupConfigGetParams holds the path, query and header parameters of HostGroupConfigGetOperation.
+type HostGroupConfigGetParams struct {
+	// The identifier name of the group.
+	Name string `path:"name"`
+}
+
+// HostGroupConfigGetOperation describes the "Show a host group" operation (GET /objects/host_group_config/{name}).
+var HostGroupConfigGetOperation = &openapi.Operation{
+	ID: "cmk.gui.plugins.openapi.endpoints.host_group_config.get",
+	Name: "HostGroupConfigGet",
+	Method: "GET",
+	Path: "/objects/host_group_config/{name}",
+	Summary: "Show a host group",
+	Tags: []string{"Host groups"},
+	Parameters: []openapi.Parameter{
+		{Name: "name", In: "path", Required: true, Type: "string", Description: "The identifier name of the group."},
+	},
+	Responses: []openapi.Response{
+		{Status: "200", Description: "OK: The operation was done successfully.", ContentType: "application/json", Schema: "HostGroup", Headers: []string{"Content-Type", "ETag", "X-Checkmk-Edition", "X-Checkmk-Version"}, New: func() interface{} { return new(HostGroup) }},
+		{Status: "403", Description: "Forbidden: Configuration via Setup is disabled.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "404", Description: "Not Found: The requested object has not been found.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "406", Description: "Not Acceptable: The requests accept headers can not be satisfied.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+	},
+	NewParams: func() interface{} { return new(HostGroupConfigGetParams) },
+}
+
+// HostGroupConfigUpdateParams holds the path, query and header parameters of HostGroupConfigUpdateOperation.
+type HostGroupConfigUpdateParams struct {
+	// The value of the, to be modified, object's ETag header. You can get this value by displaying the object it individually. To update this object the currently stored ETag needs to be the same as the one sent. The content of the ETag can potentially be anything and should be treated as semantically ...
+	IfMatch string `header:"If-Match"`
+	// The identifier name of the group.
+	Name string `path:"name"`
+}
+
+// HostGroupConfigUpdateOperation describes the "Update a host group" operation (PUT /objects/host_group_config/{name}).
+var HostGroupConfigUpdateOperation = &openapi.Operation{
+	ID: "cmk.gui.plugins.openapi.endpoints.host_group_config.update",
+	Name: "HostGroupConfigUpdate",
+	Method: "PUT",
+	Path: "/objects/host_group_config/{name}",
+	Summary: "Update a host group",
+	Tags: []string{"Host groups"},
+	Parameters: []openapi.Parameter{
+		{Name: "If-Match", In: "header", Required: true, Type: "string", Description: "The value of the, to be modified, object's ETag header. You can get this value by displaying the object it individually. To update this object the currently stored ETag needs to be the same as the one sent. The content of the ETag can potentially be anything and should be treated as semantically opaque."},
+		{Name: "Content-Type", In: "header", Required: true, Type: "string", Description: "A header specifying which type of content is in the request/response body. This is required when sending encoded data in a POST/PUT body. When the request body is empty, this header should not be sent."},
+		{Name: "name", In: "path", Required: true, Type: "string", Description: "The identifier name of the group."},
+	},
+	RequestBody: &openapi.RequestBody{ContentType: "application/json", Schema: "UpdateGroup1", Required: true, New: func() interface{} { return new(UpdateGroup1) }},
+	Responses: []openapi.Response{
+		{Status: "200", Description: "OK: The operation was done successfully.", ContentType: "application/json", Schema: "HostGroup", Headers: []string{"Content-Type", "ETag", "X-Checkmk-Edition", "X-Checkmk-Version"}, New: func() interface{} { return new(HostGroup) }},
+		{Status: "400", Description: "Bad Request: Parameter or validation failure.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "403", Description: "Forbidden: Configuration via Setup is disabled.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "404", Description: "Not Found: The requested object has not been found.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "406", Description: "Not Acceptable: The requests accept headers can not be satisfied.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "412", Description: "Precondition Failed: The value of the If-Match header doesn't match the object's ETag.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "415", Description: "Unsupported Media Type: The submitted content-type is not supported.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "428", Description: "Precondition Required: The required If-Match header is missing.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+	},
+	NewParams: func() interface{} { return new(HostGroupConfigUpdateParams) },
+}
+
+// HostGroupConfigDeleteParams holds the path, query and header parameters of HostGroupConfigDeleteOperation.
+type HostGroupConfigDeleteParams struct {
+	// The identifier name of the group.
+	Name string `path:"name"`
+}
+
+// HostGroupConfigDeleteOperation describes the "Delete a host group" operation (DELETE /objects/host_group_config/{name}).
+var HostGroupConfigDeleteOperation = &openapi.Operation{
+	ID: "cmk.gui.plugins.openapi.endpoints.host_group_config.delete",
+	Name: "HostGroupConfigDelete",
+	Method: "DELETE",
+	Path: "/objects/host_group_config/{name}",
+	Summary: "Delete a host group",
+	Tags: []string{"Host groups"},
+	Parameters: []openapi.Parameter{
+		{Name: "name", In: "path", Required: true, Type: "string", Description: "The identifier name of the group."},
+	},
+	Responses: []openapi.Response{
+		{Status: "204", Description: "No Content: Operation done successfully. No further output.", ContentType: "", Schema: "", New: nil},
+		{Status: "403", Description: "Forbidden: Configuration via Setup is disabled.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "404", Description: "Not Found: The requested object has not been found.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "406", Description: "Not Acceptable: The requests accept headers can not be satisfied.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "409", Description: "Conflict: The request is in conflict with the stored resource.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+	},
+	NewParams: func() interface{} { return new(HostGroupConfigDeleteParams) },
+}
+
+// HostTagGroupShowHostTagGroupParams holds the path, query and header parameters of HostTagGroupShowHostTagGroupOperation.
+type HostTagGroupShowHostTagGroupParams struct {
+	// The name of the host tag group
+	Name string `path:"name"`
+}
+
+// HostTagGroupShowHostTagGroupOperation describes the "Show a host tag group" operation (GET /objects/host_tag_group/{name}).
+var HostTagGroupShowHostTagGroupOperation = &openapi.Operation{
+	ID: "cmk.gui.plugins.openapi.endpoints.host_tag_group.show_host_tag_group",
+	Name: "HostTagGroupShowHostTagGroup",
+	Method: "GET",
+	Path: "/objects/host_tag_group/{name}",
+	Summary: "Show a host tag group",
+	Tags: []string{"Host tag groups"},
+	Parameters: []openapi.Parameter{
+		{Name: "name", In: "path", Required: true, Type: "string", Description: "The name of the host tag group"},
+	},
+	Responses: []openapi.Response{
+		{Status: "200", Description: "OK: The operation was done successfully.", ContentType: "application/json", Schema: "ConcreteHostTagGroup", Headers: []string{"Content-Type", "ETag", "X-Checkmk-Edition", "X-Checkmk-Version"}, New: func() interface{} { return new(ConcreteHostTagGroup) }},
+		{Status: "403", Description: "Forbidden: Configuration via Setup is disabled.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "404", Description: "Not Found: The requested object has not been found.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "406", Description: "Not Acceptable: The requests accept headers can not be satisfied.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+	},
+	NewParams: func() interface{} { return new(HostTagGroupShowHostTagGroupParams) },
+}
+
+// HostTagGroupUpdateHostTagGroupParams holds the path, query and header parameters of HostTagGroupUpdateHostTagGroupOperation.
+type HostTagGroupUpdateHostTagGroupParams struct {
+	// The value of the, to be modified, object's ETag header. You can get this value by displaying the object it individually. To update this object the currently stored ETag needs to be the same as the one sent. The content of the ETag can potentially be anything and should be treated as semantically ...
+	IfMatch string `header:"If-Match"`
+	// The name of the host tag group
+	Name string `path:"name"`
+}
+
+// HostTagGroupUpdateHostTagGroupOperation describes the "Update a host tag group" operation (PUT /objects/host_tag_group/{name}).
+var HostTagGroupUpdateHostTagGroupOperation = &openapi.Operation{
+	ID: "cmk.gui.plugins.openapi.endpoints.host_tag_group.update_host_tag_group",
+	Name: "HostTagGroupUpdateHostTagGroup",
+	Method: "PUT",
+	Path: "/objects/host_tag_group/{name}",
+	Summary: "Update a host tag group",
+	Tags: []string{"Host tag groups"},
+	Parameters: []openapi.Parameter{
+		{Name: "If-Match", In: "header", Required: true, Type: "string", Description: "The value of the, to be modified, object's ETag header. You can get this value by displaying the object it individually. To update this object the currently stored ETag needs to be the same as the one sent. The content of the ETag can potentially be anything and should be treated as semantically opaque."},
+		{Name: "Content-Type", In: "header", Required: true, Type: "string", Description: "A header specifying which type of content is in the request/response body. This is required when sending encoded data in a POST/PUT body. When the request body is empty, this header should not be sent."},
+		{Name: "name", In: "path", Required: true, Type: "string", Description: "The name of the host tag group"},
+	},
+	RequestBody: &openapi.RequestBody{ContentType: "application/json", Schema: "UpdateHostTagGroup", Required: true, New: func() interface{} { return new(UpdateHostTagGroup) }},
+	Responses: []openapi.Response{
+		{Status: "200", Description: "OK: The operation was done successfully.", ContentType: "application/json", Schema: "ConcreteHostTagGroup", Headers: []string{"Content-Type", "ETag", "X-Checkmk-Edition", "X-Checkmk-Version"}, New: func() interface{} { return new(ConcreteHostTagGroup) }},
+		{Status: "400", Description: "Bad Request: Parameter or validation failure.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "401", Description: "Unauthorized: The user is not authorized to do this request.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "403", Description: "Forbidden: Configuration via Setup is disabled.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "404", Description: "Not Found: The requested object has not been found.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "405", Description: "Method Not Allowed: Method not allowed: This request is only allowed with other HTTP methods", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "406", Description: "Not Acceptable: The requests accept headers can not be satisfied.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "412", Description: "Precondition Failed: The value of the If-Match header doesn't match the object's ETag.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "415", Description: "Unsupported Media Type: The submitted content-type is not supported.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "428", Description: "Precondition Required: The required If-Match header is missing.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+	},
+	NewParams: func() interface{} { return new(HostTagGroupUpdateHostTagGroupParams) },
+}
+
+// HostTagGroupDeleteHostTagGroupParams holds the path, query and header parameters of HostTagGroupDeleteHostTagGroupOperation.
+type HostTagGroupDeleteHostTagGroupParams struct {
+	// The host tag group can still be in use. Setting repair to True gives permission to automatically remove the tag from the affected hosts.
+	Repair *bool `query:"repair"`
+	// The name of the host tag group
+	Name string `path:"name"`
+}
+
+// HostTagGroupDeleteHostTagGroupOperation describes the "Delete a host tag group" operation (DELETE /objects/host_tag_group/{name}).
+var HostTagGroupDeleteHostTagGroupOperation = &openapi.Operation{
+	ID: "cmk.gui.plugins.openapi.endpoints.host_tag_group.delete_host_tag_group",
+	Name: "HostTagGroupDeleteHostTagGroup",
+	Method: "DELETE",
+	Path: "/objects/host_tag_group/{name}",
+	Summary: "Delete a host tag group",
+	Tags: []string{"Host tag groups"},
+	Parameters: []openapi.Parameter{
+		{Name: "repair", In: "query", Required: false, Type: "boolean", Description: "The host tag group can still be in use. Setting repair to True gives permission to automatically remove the tag from the affected hosts."},
+		{Name: "name", In: "path", Required: true, Type: "string", Description: "The name of the host tag group"},
+	},
+	Responses: []openapi.Response{
+		{Status: "204", Description: "No Content: Operation done successfully. No further output.", ContentType: "", Schema: "", New: nil},
+		{Status: "400", Description: "Bad Request: Parameter or validation failure.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "401", Description: "Unauthorized: The user is not authorized to do this request.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "403", Description: "Forbidden: Configuration via Setup is disabled.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "404", Description: "Not Found: The requested object has not been found.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "405", Description: "Method Not Allowed: Method not allowed: This request is only allowed with other HTTP methods", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "406", Description: "Not Acceptable: The requests accept headers can not be satisfied.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+	},
+	NewParams: func() interface{} { return new(HostTagGroupDeleteHostTagGroupParams) },
+}
+
+// NotificationRulesShowRuleParams holds the path, query and header parameters of NotificationRulesShowRuleOperation.
+type NotificationRulesShowRuleParams struct {
+	// The notification rule ID.
+	RuleId string `path:"rule_id"`
+}
+
+// NotificationRulesShowRuleOperation describes the "Show a notification rule" operation (GET /objects/notification_rule/{rule_id}).
+var NotificationRulesShowRuleOperation = &openapi.Operation{
+	ID: "cmk.gui.plugins.openapi.endpoints.notification_rules.show_rule",
+	Name: "NotificationRulesShowRule",
+	Method: "GET",
+	Path: "/objects/notification_rule/{rule_id}",
+	Summary: "Show a notification rule",
+	Tags: []string{"Notification Rules"},
+	Parameters: []openapi.Parameter{
+		{Name: "rule_id", In: "path", Required: true, Type: "string", Description: "The notification rule ID."},
+	},
+	Responses: []openapi.Response{
+		{Status: "200", Description: "OK: The operation was done successfully.", ContentType: "application/json", Schema: "NotificationRuleResponse", Headers: []string{"Content-Type", "X-Checkmk-Edition", "X-Checkmk-Version"}, New: func() interface{} { return new(NotificationRuleResponse) }},
+		{Status: "403", Description: "Forbidden: Configuration via Setup is disabled.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "404", Description: "Not Found: The requested object has not been found.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "406", Description: "Not Acceptable: The requests accept headers can not be satisfied.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+	},
+	NewParams: func() interface{} { return new(NotificationRulesShowRuleParams) },
+}
+
+// NotificationRulesPutRuleParams holds the path, query and header parameters of NotificationRulesPutRuleOperation.
+type NotificationRulesPutRuleParams struct {
+	// The notification rule ID.
+	RuleId string `path:"rule_id"`
+}
+
+// NotificationRulesPutRuleOperation describes the "Update a notification rule" operation (PUT /objects/notification_rule/{rule_id}).
+var NotificationRulesPutRuleOperation = &openapi.Operation{
+	ID: "cmk.gui.plugins.openapi.endpoints.notification_rules.put_rule",
+	Name: "NotificationRulesPutRule",
+	Method: "PUT",
+	Path: "/objects/notification_rule/{rule_id}",
+	Summary: "Update a notification rule",
+	Tags: []string{"Notification Rules"},
+	Parameters: []openapi.Parameter{
+		{Name: "Content-Type", In: "header", Required: true, Type: "string", Description: "A header specifying which type of content is in the request/response body. This is required when sending encoded data in a POST/PUT body. When the request body is empty, this header should not be sent."},
+		{Name: "rule_id", In: "path", Required: true, Type: "string", Description: "The notification rule ID."},
+	},
+	RequestBody: &openapi.RequestBody{ContentType: "application/json", Schema: "NotificationRuleRequest", Required: true, New: func() interface{} { return new(NotificationRuleRequest) }},
+	Responses: []openapi.Response{
+		{Status: "200", Description: "OK: The operation was done successfully.", ContentType: "application/json", Schema: "NotificationRuleResponse", Headers: []string{"Content-Type", "X-Checkmk-Edition", "X-Checkmk-Version"}, New: func() interface{} { return new(NotificationRuleResponse) }},
+		{Status: "400", Description: "Bad Request: Parameter or validation failure.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "403", Description: "Forbidden: Configuration via Setup is disabled.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "404", Description: "Not Found: The requested object has not been found.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "406", Description: "Not Acceptable: The requests accept headers can not be satisfied.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "415", Description: "Unsupported Media Type: The submitted content-type is not supported.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+	},
+	NewParams: func() interface{} { return new(NotificationRulesPutRuleParams) },
+}
+
+// NotificationRulesDeleteRuleParams holds the path, query and header parameters of NotificationRulesDeleteRuleOperation.
+type NotificationRulesDeleteRuleParams struct {
+	// The notification rule ID.
+	RuleId string `path:"rule_id"`
+}
+
+// NotificationRulesDeleteRuleOperation describes the "Delete a notification rule" operation (POST /objects/notification_rule/{rule_id}/actions/delete/invoke).
+var NotificationRulesDeleteRuleOperation = &openapi.Operation{
+	ID: "cmk.gui.plugins.openapi.endpoints.notification_rules.delete_rule",
+	Name: "NotificationRulesDeleteRule",
+	Method: "POST",
+	Path: "/objects/notification_rule/{rule_id}/actions/delete/invoke",
+	Summary: "Delete a notification rule",
+	Tags: []string{"Notification Rules"},
+	Parameters: []openapi.Parameter{
+		{Name: "rule_id", In: "path", Required: true, Type: "string", Description: "The notification rule ID."},
+	},
+	Responses: []openapi.Response{
+		{Status: "204", Description: "No Content: Operation done successfully. No further output.", ContentType: "", Schema: "", New: nil},
+		{Status: "400", Description: "Bad Request: Parameter or validation failure.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "403", Description: "Forbidden: Configuration via Setup is disabled.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "404", Description: "Not Found: The requested object has not been found.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "406", Description: "Not Acceptable: The requests accept headers can not be satisfied.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "415", Description: "Unsupported Media Type: The submitted content-type is not supported.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+	},
+	NewParams: func() interface{} { return new(NotificationRulesDeleteRuleParams) },
+}
+
+// PasswordShowPasswordParams holds the path, query and header parameters of PasswordShowPasswordOperation.
+type PasswordShowPasswordParams struct {
+	// A name used as an identifier. Can be of arbitrary (sensible) length.
+	Name string `path:"name"`
+}
+
+// PasswordShowPasswordOperation describes the "Show a password" operation (GET /objects/password/{name}).
+var PasswordShowPasswordOperation = &openapi.Operation{
+	ID: "cmk.gui.plugins.openapi.endpoints.password.show_password",
+	Name: "PasswordShowPassword",
+	Method: "GET",
+	Path: "/objects/password/{name}",
+	Summary: "Show a password",
+	Tags: []string{"Passwords"},
+	Parameters: []openapi.Parameter{
+		{Name: "name", In: "path", Required: true, Type: "string", Description: "A name used as an identifier. Can be of arbitrary (sensible) length."},
+	},
+	Responses: []openapi.Response{
+		{Status: "200", Description: "OK: The operation was done successfully.", ContentType: "application/json", Schema: "PasswordObject", Headers: []string{"Content-Type", "X-Checkmk-Edition", "X-Checkmk-Version"}, New: func() interface{} { return new(PasswordObject) }},
+		{Status: "403", Description: "Forbidden: Configuration via Setup is disabled.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "404", Description: "Not Found: The requested object has not been found.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "406", Description: "Not Acceptable: The requests accept headers can not be satisfied.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+	},
+	NewParams: func() interface{} { return new(PasswordShowPasswordParams) },
+}
+
+// PasswordUpdatePasswordParams holds the path, query and header parameters of PasswordUpdatePasswordOperation.
+type PasswordUpdatePasswordParams struct {
+	// The value of the, to be modified, object's ETag header. You can get this value by displaying the object it individually. To update this object the currently stored ETag needs to be the same as the one sent. The content of the ETag can potentially be anything and should be treated as semantically ...
+	IfMatch string `header:"If-Match"`
+	// A name used as an identifier. Can be of arbitrary (sensible) length.
+	Name string `path:"name"`
+}
+
+// PasswordUpdatePasswordOperation describes the "Update a password" operation (PUT /objects/password/{name}).
+var PasswordUpdatePasswordOperation = &openapi.Operation{
+	ID: "cmk.gui.plugins.openapi.endpoints.password.update_password",
+	Name: "PasswordUpdatePassword",
+	Method: "PUT",
+	Path: "/objects/password/{name}",
+	Summary: "Update a password",
+	Tags: []string{"Passwords"},
+	Parameters: []openapi.Parameter{
+		{Name: "If-Match", In: "header", Required: true, Type: "string", Description: "The value of the, to be modified, object's ETag header. You can get this value by displaying the object it individually. To update this object the currently stored ETag needs to be the same as the one sent. The content of the ETag can potentially be anything and should be treated as semantically opaque."},
+		{Name: "Content-Type", In: "header", Required: true, Type: "string", Description: "A header specifying which type of content is in the request/response body. This is required when sending encoded data in a POST/PUT body. When the request body is empty, this header should not be sent."},
+		{Name: "name", In: "path", Required: true, Type: "string", Description: "A name used as an identifier. Can be of arbitrary (sensible) length."},
+	},
+	RequestBody: &openapi.RequestBody{ContentType: "application/json", Schema: "UpdatePassword", Required: true, New: func() interface{} { return new(UpdatePassword) }},
+	Responses: []openapi.Response{
+		{Status: "200", Description: "OK: The operation was done successfully.", ContentType: "application/json", Schema: "PasswordObject", Headers: []string{"Content-Type", "ETag", "X-Checkmk-Edition", "X-Checkmk-Version"}, New: func() interface{} { return new(PasswordObject) }},
+		{Status: "400", Description: "Bad Request: Parameter or validation failure.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "403", Description: "Forbidden: Configuration via Setup is disabled.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "404", Description: "Not Found: The requested object has not been found.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "406", Description: "Not Acceptable: The requests accept headers can not be satisfied.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "412", Description: "Precondition Failed: The value of the If-Match header doesn't match the object's ETag.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "415", Description: "Unsupported Media Type: The submitted content-type is not supported.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "428", Description: "Precondition Required: The required If-Match header is missing.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+	},
+	NewParams: func() interface{} { return new(PasswordUpdatePasswordParams) },
+}
+
+// PasswordDeletePasswordParams holds the path, query and header parameters of PasswordDeletePasswordOperation.
+type PasswordDeletePasswordParams struct {
+	// A name used as an identifier. Can be of arbitrary (sensible) length.
+	Name string `path:"name"`
+}
+
+// PasswordDeletePasswordOperation describes the "Delete a password" operation (DELETE /objects/password/{name}).
+var PasswordDeletePasswordOperation = &openapi.Operation{
+	ID: "cmk.gui.plugins.openapi.endpoints.password.delete_password",
+	Name: "PasswordDeletePassword",
+	Method: "DELETE",
+	Path: "/objects/password/{name}",
+	Summary: "Delete a password",
+	Tags: []string{"Passwords"},
+	Parameters: []openapi.Parameter{
+		{Name: "name", In: "path", Required: true, Type: "string", Description: "A name used as an identifier. Can be of arbitrary (sensible) length."},
+	},
+	Responses: []openapi.Response{
+		{Status: "204", Description: "No Content: Operation done successfully. No further output.", ContentType: "", Schema: "", New: nil},
+		{Status: "403", Description: "Forbidden: Configuration via Setup is disabled.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "404", Description: "Not Found: The requested object has not been found.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "406", Description: "Not Acceptable: The requests accept headers can not be satisfied.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+	},
+	NewParams: func() interface{} { return new(PasswordDeletePasswordParams) },
+}
+
+// RuleShowRuleParams holds the path, query and header parameters of RuleShowRuleOperation.
+type RuleShowRuleParams struct {
+	// The ID of the rule.
+	RuleId string `path:"rule_id"`
+}
+
+// RuleShowRuleOperation describes the "Show a rule" operation (GET /objects/rule/{rule_id}).
+var RuleShowRuleOperation = &openapi.Operation{
+	ID: "cmk.gui.plugins.openapi.endpoints.rule.show_rule",
+	Name: "RuleShowRule",
+	Method: "GET",
+	Path: "/objects/rule/{rule_id}",
+	Summary: "Show a rule",
+	Tags: []string{"Rules"},
+	Parameters: []openapi.Parameter{
+		{Name: "rule_id", In: "path", Required: true, Type: "string", Description: "The ID of the rule."},
+	},
+	Responses: []openapi.Response{
+		{Status: "200", Description: "OK: The operation was done successfully.", ContentType: "application/json", Schema: "RuleObject", Headers: []string{"Content-Type", "X-Checkmk-Edition", "X-Checkmk-Version"}, New: func() interface{} { return new(RuleObject) }},
+		{Status: "403", Description: "Forbidden: Configuration via Setup is disabled.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "404", Description: "Not Found: The requested object has not been found.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "406", Description: "Not Acceptable: The requests accept headers can not be satisfied.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+	},
+	NewParams: func() interface{} { return new(RuleShowRuleParams) },
+}
+
+// RuleEditRuleParams holds the path, query and header parameters of RuleEditRuleOperation.
+type RuleEditRuleParams struct {
+	// The value of the, to be modified, object's ETag header. You can get this value by displaying the object it individually. To update this object the currently stored ETag needs to be the same as the one sent. The content of the ETag can potentially be anything and should be treated as semantically ...
+	IfMatch string `header:"If-Match"`
+	// The ID of the rule.
+	RuleId string `path:"rule_id"`
+}
+
+// RuleEditRuleOperation describes the "Modify a rule" operation (PUT /objects/rule/{rule_id}).
+var RuleEditRuleOperation = &openapi.Operation{
+	ID: "cmk.gui.plugins.openapi.endpoints.rule.edit_rule",
+	Name: "RuleEditRule",
+	Method: "PUT",
+	Path: "/objects/rule/{rule_id}",
+	Summary: "Modify a rule",
+	Tags: []string{"Rules"},
+	Parameters: []openapi.Parameter{
+		{Name: "If-Match", In: "header", Required: true, Type: "string", Description: "The value of the, to be modified, object's ETag header. You can get this value by displaying the object it individually. To update this object the currently stored ETag needs to be the same as the one sent. The content of the ETag can potentially be anything and should be treated as semantically opaque."},
+		{Name: "Content-Type", In: "header", Required: true, Type: "string", Description: "A header specifying which type of content is in the request/response body. This is required when sending encoded data in a POST/PUT body. When the request body is empty, this header should not be sent."},
+		{Name: "rule_id", In: "path", Required: true, Type: "string", Description: "The ID of the rule."},
+	},
+	RequestBody: &openapi.RequestBody{ContentType: "application/json", Schema: "UpdateRuleObject", Required: true, New: func() interface{} { return new(UpdateRuleObject) }},
+	Responses: []openapi.Response{
+		{Status: "200", Description: "OK: The operation was done successfully.", ContentType: "application/json", Schema: "RuleObject", Headers: []string{"Content-Type", "ETag", "X-Checkmk-Edition", "X-Checkmk-Version"}, New: func() interface{} { return new(RuleObject) }},
+		{Status: "400", Description: "Bad Request: Parameter or validation failure.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "403", Description: "Forbidden: Configuration via Setup is disabled.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "404", Description: "Not Found: The requested object has not been found.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "406", Description: "Not Acceptable: The requests accept headers can not be satisfied.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "412", Description: "Precondition Failed: The value of the If-Match header doesn't match the object's ETag.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "415", Description: "Unsupported Media Type: The submitted content-type is not supported.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "428", Description: "Precondition Required: The required If-Match header is missing.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+	},
+	NewParams: func() interface{} { return new(RuleEditRuleParams) },
+}
+
+// RuleDeleteRuleParams holds the path, query and header parameters of RuleDeleteRuleOperation.
+type RuleDeleteRuleParams struct {
+	// The ID of the rule.
+	RuleId string `path:"rule_id"`
+}
+
+// RuleDeleteRuleOperation describes the "Delete a rule" operation (DELETE /objects/rule/{rule_id}).
+var RuleDeleteRuleOperation = &openapi.Operation{
+	ID: "cmk.gui.plugins.openapi.endpoints.rule.delete_rule",
+	Name: "RuleDeleteRule",
+	Method: "DELETE",
+	Path: "/objects/rule/{rule_id}",
+	Summary: "Delete a rule",
+	Tags: []string{"Rules"},
+	Parameters: []openapi.Parameter{
+		{Name: "rule_id", In: "path", Required: true, Type: "string", Description: "The ID of the rule."},
+	},
+	Responses: []openapi.Response{
+		{Status: "204", Description: "No Content: Rule was deleted successfully.", ContentType: "", Schema: "", New: nil},
+		{Status: "403", Description: "Forbidden: Configuration via Setup is disabled.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "404", Description: "Not Found: The rule to be deleted was not found.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "406", Description: "Not Acceptable: The requests accept headers can not be satisfied.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+	},
+	NewParams: func() interface{} { return new(RuleDeleteRuleParams) },
+}
+
+// RuleMoveRuleToParams holds the path, query and header parameters of RuleMoveRuleToOperation.
+type RuleMoveRuleToParams struct {
+	// The value of the, to be modified, object's ETag header. You can get this value by displaying the object it individually. To update this object the currently stored ETag needs to be the same as the one sent. The content of the ETag can potentially be anything and should be treated as semantically ...
+	IfMatch string `header:"If-Match"`
+	// The ID of the rule.
+	RuleId string `path:"rule_id"`
+}
+
+// RuleMoveRuleToOperation describes the "Move a rule to a specific location" operation (POST /objects/rule/{rule_id}/actions/move/invoke).
+var RuleMoveRuleToOperation = &openapi.Operation{
+	ID: "cmk.gui.plugins.openapi.endpoints.rule.move_rule_to",
+	Name: "RuleMoveRuleTo",
+	Method: "POST",
+	Path: "/objects/rule/{rule_id}/actions/move/invoke",
+	Summary: "Move a rule to a specific location",
+	Tags: []string{"Rules"},
+	Parameters: []openapi.Parameter{
+		{Name: "If-Match", In: "header", Required: true, Type: "string", Description: "The value of the, to be modified, object's ETag header. You can get this value by displaying the object it individually. To update this object the currently stored ETag needs to be the same as the one sent. The content of the ETag can potentially be anything and should be treated as semantically opaque."},
+		{Name: "Content-Type", In: "header", Required: true, Type: "string", Description: "A header specifying which type of content is in the request/response body. This is required when sending encoded data in a POST/PUT body. When the request body is empty, this header should not be sent."},
+		{Name: "rule_id", In: "path", Required: true, Type: "string", Description: "The ID of the rule."},
+	},
+	RequestBody: &openapi.RequestBody{ContentType: "application/json", Schema: "MoveRuleTo", Required: true, New: func() interface{} { return new(MoveRuleTo) }},
+	Responses: []openapi.Response{
+		{Status: "200", Description: "OK: The operation was done successfully.", ContentType: "application/json", Schema: "RuleObject", Headers: []string{"Content-Type", "X-Checkmk-Edition", "X-Checkmk-Version"}, New: func() interface{} { return new(RuleObject) }},
+		{Status: "400", Description: "Bad Request: Parameter or validation failure.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "403", Description: "Forbidden: Configuration via Setup is disabled.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "404", Description: "Not Found: The requested object has not been found.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "406", Description: "Not Acceptable: The requests accept headers can not be satisfied.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "412", Description: "Precondition Failed: The value of the If-Match header doesn't match the object's ETag.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "415", Description: "Unsupported Media Type: The submitted content-type is not supported.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "428", Description: "Precondition Required: The required If-Match header is missing.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+	},
+	NewParams: func() interface{} { return new(RuleMoveRuleToParams) },
+}
+
+// RulesetShowRulesetParams holds the path, query and header parameters of RulesetShowRulesetOperation.
+type RulesetShowRulesetParams struct {
+	// The name of the ruleset.
+	RulesetName string `path:"ruleset_name"`
+}
+
+// RulesetShowRulesetOperation describes the "Show a ruleset" operation (GET /objects/ruleset/{ruleset_name}).
+var RulesetShowRulesetOperation = &openapi.Operation{
+	ID: "cmk.gui.plugins.openapi.endpoints.ruleset.show_ruleset",
+	Name: "RulesetShowRuleset",
+	Method: "GET",
+	Path: "/objects/ruleset/{ruleset_name}",
+	Summary: "Show a ruleset",
+	Tags: []string{"Rulesets"},
+	Parameters: []openapi.Parameter{
+		{Name: "ruleset_name", In: "path", Required: true, Type: "string", Description: "The name of the ruleset."},
+	},
+	Responses: []openapi.Response{
+		{Status: "200", Description: "OK: The operation was done successfully.", ContentType: "application/json", Schema: "RulesetObject", Headers: []string{"Content-Type", "ETag", "X-Checkmk-Edition", "X-Checkmk-Version"}, New: func() interface{} { return new(RulesetObject) }},
+		{Status: "403", Description: "Forbidden: Configuration via Setup is disabled.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "404", Description: "Not Found: The requested object has not been found.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "406", Description: "Not Acceptable: The requests accept headers can not be satisfied.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+	},
+	NewParams: func() interface{} { return new(RulesetShowRulesetParams) },
+}
+
+// ServiceDiscoveryShowServiceDiscoveryResultParams holds the path, query and header parameters of ServiceDiscoveryShowServiceDiscoveryResultOperation.
+type ServiceDiscoveryShowServiceDiscoveryResultParams struct {
+	// The host of the service discovery result
+	HostName string `path:"host_name"`
+}
+
+// ServiceDiscoveryShowServiceDiscoveryResultOperation describes the "Show the current service discovery result" operation (GET /objects/service_discovery/{host_name}).
+var ServiceDiscoveryShowServiceDiscoveryResultOperation = &openapi.Operation{
+	ID: "cmk.gui.plugins.openapi.endpoints.service_discovery.show_service_discovery_result",
+	Name: "ServiceDiscoveryShowServiceDiscoveryResult",
+	Method: "GET",
+	Path: "/objects/service_discovery/{host_name}",
+	Summary: "Show the current service discovery result",
+	Tags: []string{"Service discovery"},
+	Parameters: []openapi.Parameter{
+		{Name: "host_name", In: "path", Required: true, Type: "string", Description: "The host of the service discovery result"},
+	},
+	Responses: []openapi.Response{
+		{Status: "200", Description: "OK: The operation was done successfully.", ContentType: "application/json", Schema: "DomainObject", Headers: []string{"Content-Type", "X-Checkmk-Edition", "X-Checkmk-Version"}, New: func() interface{} { return new(DomainObject) }},
+		{Status: "403", Description: "Forbidden: Configuration via Setup is disabled.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "404", Description: "Not Found: The requested object has not been found.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "406", Description: "Not Acceptable: The requests accept headers can not be satisfied.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+	},
+	NewParams: func() interface{} { return new(ServiceDiscoveryShowServiceDiscoveryResultParams) },
+}
+
+// ServiceDiscoveryShowServiceDiscoveryRunParams holds the path, query and header parameters of ServiceDiscoveryShowServiceDiscoveryRunOperation.
+type ServiceDiscoveryShowServiceDiscoveryRunParams struct {
+	// A hostname.
+	HostName string `path:"host_name"`
+}
+
+// ServiceDiscoveryShowServiceDiscoveryRunOperation describes the "Show the last service discovery background job on a host" operation (GET /objects/service_discovery_run/{host_name}).
+var ServiceDiscoveryShowServiceDiscoveryRunOperation = &openapi.Operation{
+	ID: "cmk.gui.plugins.openapi.endpoints.service_discovery.show_service_discovery_run",
+	Name: "ServiceDiscoveryShowServiceDiscoveryRun",
+	Method: "GET",
+	Path: "/objects/service_discovery_run/{host_name}",
+	Summary: "Show the last service discovery background job on a host",
+	Tags: []string{"Service discovery"},
+	Parameters: []openapi.Parameter{
+		{Name: "host_name", In: "path", Required: true, Type: "string", Description: "A hostname."},
+	},
+	Responses: []openapi.Response{
+		{Status: "200", Description: "OK: The operation was done successfully.", ContentType: "application/json", Schema: "DomainObject", Headers: []string{"Content-Type", "X-Checkmk-Edition", "X-Checkmk-Version"}, New: func() interface{} { return new(DomainObject) }},
+		{Status: "403", Description: "Forbidden: Configuration via Setup is disabled.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "404", Description: "Not Found: The requested object has not been found.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "406", Description: "Not Acceptable: The requests accept headers can not be satisfied.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+	},
+	NewParams: func() interface{} { return new(ServiceDiscoveryShowServiceDiscoveryRunParams) },
+}
+
+// ServiceDiscoveryServiceDiscoveryRunWaitForCompletionParams holds the path, query and header parameters of ServiceDiscoveryServiceDiscoveryRunWaitForCompletionOperation.
+type ServiceDiscoveryServiceDiscoveryRunWaitForCompletionParams struct {
+	// A hostname.
+	HostName string `path:"host_name"`
+}
+
+// ServiceDiscoveryServiceDiscoveryRunWaitForCompletionOperation describes the "Wait for service discovery completion" operation (GET /objects/service_discovery_run/{host_name}/actions/wait-for-completion/invoke).
+var ServiceDiscoveryServiceDiscoveryRunWaitForCompletionOperation = &openapi.Operation{
+	ID: "cmk.gui.plugins.openapi.endpoints.service_discovery.service_discovery_run_wait_for_completion",
+	Name: "ServiceDiscoveryServiceDiscoveryRunWaitForCompletion",
+	Method: "GET",
+	Path: "/objects/service_discovery_run/{host_name}/actions/wait-for-completion/invoke",
+	Summary: "Wait for service discovery completion",
+	Tags: []string{"Service discovery"},
+	Parameters: []openapi.Parameter{
+		{Name: "host_name", In: "path", Required: true, Type: "string", Description: "A hostname."},
+	},
+	Responses: []openapi.Response{
+		{Status: "204", Description: "No Content: The service discovery has been completed.", ContentType: "", Schema: "", New: nil},
+		{Status: "302", Description: "Found: The service discovery is still running. Redirecting to the 'Wait for completion' endpoint.", ContentType: "", Schema: "", New: nil},
+		{Status: "403", Description: "Forbidden: Configuration via Setup is disabled.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "404", Description: "Not Found: There is no running service discovery", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "406", Description: "Not Acceptable: The requests accept headers can not be satisfied.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+	},
+	NewParams: func() interface{} { return new(ServiceDiscoveryServiceDiscoveryRunWaitForCompletionParams) },
+}
+
+// ServiceGroupConfigShowGroupParams holds the path, query and header parameters of ServiceGroupConfigShowGroupOperation.
+type ServiceGroupConfigShowGroupParams struct {
+	// The identifier name of the group.
+	Name string `path:"name"`
+}
+
+// ServiceGroupConfigShowGroupOperation describes the "Show a service group" operation (GET /objects/service_group_config/{name}).
+var ServiceGroupConfigShowGroupOperation = &openapi.Operation{
+	ID: "cmk.gui.plugins.openapi.endpoints.service_group_config.show_group",
+	Name: "ServiceGroupConfigShowGroup",
+	Method: "GET",
+	Path: "/objects/service_group_config/{name}",
+	Summary: "Show a service group",
+	Tags: []string{"Service groups"},
+	Parameters: []openapi.Parameter{
+		{Name: "name", In: "path", Required: true, Type: "string", Description: "The identifier name of the group."},
+	},
+	Responses: []openapi.Response{
+		{Status: "200", Description: "OK: The operation was done successfully.", ContentType: "application/json", Schema: "ServiceGroup", Headers: []string{"Content-Type", "ETag", "X-Checkmk-Edition", "X-Checkmk-Version"}, New: func() interface{} { return new(ServiceGroup) }},
+		{Status: "403", Description: "Forbidden: Configuration via Setup is disabled.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "404", Description: "Not Found: The requested object has not been found.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "406", Description: "Not Acceptable: The requests accept headers can not be satisfied.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+	},
+	NewParams: func() interface{} { return new(ServiceGroupConfigShowGroupParams) },
+}
+
+// ServiceGroupConfigUpdateParams holds the path, query and header parameters of ServiceGroupConfigUpdateOperation.
+type ServiceGroupConfigUpdateParams struct {
+	// The value of the, to be modified, object's ETag header. You can get this value by displaying the object it individually. To update this object the currently stored ETag needs to be the same as the one sent. The content of the ETag can potentially be anything and should be treated as semantically ...
+	IfMatch string `header:"If-Match"`
+	// The identifier name of the group.
+	Name string `path:"name"`
+}
+
+// ServiceGroupConfigUpdateOperation describes the "Update a service group" operation (PUT /objects/service_group_config/{name}).
+var ServiceGroupConfigUpdateOperation = &openapi.Operation{
+	ID: "cmk.gui.plugins.openapi.endpoints.service_group_config.update",
+	Name: "ServiceGroupConfigUpdate",
+	Method: "PUT",
+	Path: "/objects/service_group_config/{name}",
+	Summary: "Update a service group",
+	Tags: []string{"Service groups"},
+	Parameters: []openapi.Parameter{
+		{Name: "If-Match", In: "header", Required: true, Type: "string", Description: "The value of the, to be modified, object's ETag header. You can get this value by displaying the object it individually. To update this object the currently stored ETag needs to be the same as the one sent. The content of the ETag can potentially be anything and should be treated as semantically opaque."},
+		{Name: "Content-Type", In: "header", Required: true, Type: "string", Description: "A header specifying which type of content is in the request/response body. This is required when sending encoded data in a POST/PUT body. When the request body is empty, this header should not be sent."},
+		{Name: "name", In: "path", Required: true, Type: "string", Description: "The identifier name of the group."},
+	},
+	RequestBody: &openapi.RequestBody{ContentType: "application/json", Schema: "UpdateGroup2", Required: true, New: func() interface{} { return new(UpdateGroup2) }},
+	Responses: []openapi.Response{
+		{Status: "200", Description: "OK: The operation was done successfully.", ContentType: "application/json", Schema: "ServiceGroup", Headers: []string{"Content-Type", "ETag", "X-Checkmk-Edition", "X-Checkmk-Version"}, New: func() interface{} { return new(ServiceGroup) }},
+		{Status: "400", Description: "Bad Request: Parameter or validation failure.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "403", Description: "Forbidden: Configuration via Setup is disabled.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "404", Description: "Not Found: The requested object has not been found.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "406", Description: "Not Acceptable: The requests accept headers can not be satisfied.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "412", Description: "Precondition Failed: The value of the If-Match header doesn't match the object's ETag.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "415", Description: "Unsupported Media Type: The submitted content-type is not supported.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "428", Description: "Precondition Required: The required If-Match header is missing.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+	},
+	NewParams: func() interface{} { return new(ServiceGroupConfigUpdateParams) },
+}
+
+// ServiceGroupConfigDeleteParams holds the path, query and header parameters of ServiceGroupConfigDeleteOperation.
+type ServiceGroupConfigDeleteParams struct {
+	// The identifier name of the group.
+	Name string `path:"name"`
+}
+
+// ServiceGroupConfigDeleteOperation describes the "Delete a service group" operation (DELETE /objects/service_group_config/{name}).
+var ServiceGroupConfigDeleteOperation = &openapi.Operation{
+	ID: "cmk.gui.plugins.openapi.endpoints.service_group_config.delete",
+	Name: "ServiceGroupConfigDelete",
+	Method: "DELETE",
+	Path: "/objects/service_group_config/{name}",
+	Summary: "Delete a service group",
+	Tags: []string{"Service groups"},
+	Parameters: []openapi.Parameter{
+		{Name: "name", In: "path", Required: true, Type: "string", Description: "The identifier name of the group."},
+	},
+	Responses: []openapi.Response{
+		{Status: "204", Description: "No Content: Operation done successfully. No further output.", ContentType: "", Schema: "", New: nil},
+		{Status: "403", Description: "Forbidden: Configuration via Setup is disabled.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "404", Description: "Not Found: The requested object has not been found.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "406", Description: "Not Acceptable: The requests accept headers can not be satisfied.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "409", Description: "Conflict: The request is in conflict with the stored resource.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+	},
+	NewParams: func() interface{} { return new(ServiceGroupConfigDeleteParams) },
+}
+
+// SiteManagementShowSiteParams holds the path, query and header parameters of SiteManagementShowSiteOperation.
+type SiteManagementShowSiteParams struct {
+	// A site ID that exists.
+	SiteId string `path:"site_id"`
+}
+
+// SiteManagementShowSiteOperation describes the "Show a site connection" operation (GET /objects/site_connection/{site_id}).
+var SiteManagementShowSiteOperation = &openapi.Operation{
+	ID: "cmk.gui.plugins.openapi.endpoints.site_management.show_site",
+	Name: "SiteManagementShowSite",
+	Method: "GET",
+	Path: "/objects/site_connection/{site_id}",
+	Summary: "Show a site connection",
+	Tags: []string{"Site Management"},
+	Parameters: []openapi.Parameter{
+		{Name: "site_id", In: "path", Required: true, Type: "string", Description: "A site ID that exists."},
+	},
+	Responses: []openapi.Response{
+		{Status: "200", Description: "OK: The operation was done successfully.", ContentType: "application/json", Schema: "SiteConnectionResponse", Headers: []string{"Content-Type", "X-Checkmk-Edition", "X-Checkmk-Version"}, New: func() interface{} { return new(SiteConnectionResponse) }},
+		{Status: "403", Description: "Forbidden: Configuration via Setup is disabled.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "404", Description: "Not Found: The requested object has not been found.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "406", Description: "Not Acceptable: The requests accept headers can not be satisfied.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+	},
+	NewParams: func() interface{} { return new(SiteManagementShowSiteParams) },
+}
+
+// SiteManagementPutSiteParams holds the path, query and header parameters of SiteManagementPutSiteOperation.
+type SiteManagementPutSiteParams struct {
+	// A site ID that exists.
+	SiteId string `path:"site_id"`
+}
+
+// SiteManagementPutSiteOperation describes the "Update a site connection" operation (PUT /objects/site_connection/{site_id}).
+var SiteManagementPutSiteOperation = &openapi.Operation{
+	ID: "cmk.gui.plugins.openapi.endpoints.site_management.put_site",
+	Name: "SiteManagementPutSite",
+	Method: "PUT",
+	Path: "/objects/site_connection/{site_id}",
+	Summary: "Update a site connection",
+	Tags: []string{"Site Management"},
+	Parameters: []openapi.Parameter{
+		{Name: "Content-Type", In: "header", Required: true, Type: "string", Description: "A header specifying which type of content is in the request/response body. This is required when sending encoded data in a POST/PUT body. When the request body is empty, this header should not be sent."},
+		{Name: "site_id", In: "path", Required: true, Type: "string", Description: "A site ID that exists."},
+	},
+	RequestBody: &openapi.RequestBody{ContentType: "application/json", Schema: "SiteConnectionRequestUpdate", Required: true, New: func() interface{} { return new(SiteConnectionRequestUpdate) }},
+	Responses: []openapi.Response{
+		{Status: "200", Description: "OK: The operation was done successfully.", ContentType: "application/json", Schema: "SiteConnectionResponse", Headers: []string{"Content-Type", "X-Checkmk-Edition", "X-Checkmk-Version"}, New: func() interface{} { return new(SiteConnectionResponse) }},
+		{Status: "400", Description: "Bad Request: Parameter or validation failure.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "403", Description: "Forbidden: Configuration via Setup is disabled.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "404", Description: "Not Found: The requested object has not been found.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "406", Description: "Not Acceptable: The requests accept headers can not be satisfied.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "415", Description: "Unsupported Media Type: The submitted content-type is not supported.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+	},
+	NewParams: func() interface{} { return new(SiteManagementPutSiteParams) },
+}
+
+// SiteManagementDeleteSiteParams holds the path, query and header parameters of SiteManagementDeleteSiteOperation.
+type SiteManagementDeleteSiteParams struct {
+	// The site ID.
+	SiteId string `path:"site_id"`
+}
+
+// SiteManagementDeleteSiteOperation describes the "Delete a site connection" operation (POST /objects/site_connection/{site_id}/actions/delete/invoke).
+var SiteManagementDeleteSiteOperation = &openapi.Operation{
+	ID: "cmk.gui.plugins.openapi.endpoints.site_management.delete_site",
+	Name: "SiteManagementDeleteSite",
+	Method: "POST",
+	Path: "/objects/site_connection/{site_id}/actions/delete/invoke",
+	Summary: "Delete a site connection",
+	Tags: []string{"Site Management"},
+	Parameters: []openapi.Parameter{
+		{Name: "site_id", In: "path", Required: true, Type: "string", Description: "The site ID."},
+	},
+	Responses: []openapi.Response{
+		{Status: "204", Description: "No Content: Operation done successfully. No further output.", ContentType: "", Schema: "", New: nil},
+		{Status: "400", Description: "Bad Request: Parameter or validation failure.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "403", Description: "Forbidden: Configuration via Setup is disabled.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "404", Description: "Not Found: The requested object has not been found.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "406", Description: "Not Acceptable: The requests accept headers can not be satisfied.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "415", Description: "Unsupported Media Type: The submitted content-type is not supported.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+	},
+	NewParams: func() interface{} { return new(SiteManagementDeleteSiteParams) },
+}
+
+// SiteManagementSiteLoginParams holds the path, query and header parameters of SiteManagementSiteLoginOperation.
+type SiteManagementSiteLoginParams struct {
+	// A site ID that exists.
+	SiteId string `path:"site_id"`
+}
+
+// SiteManagementSiteLoginOperation describes the "Login to a remote site" operation (POST /objects/site_connection/{site_id}/actions/login/invoke).
+var SiteManagementSiteLoginOperation = &openapi.Operation{
+	ID: "cmk.gui.plugins.openapi.endpoints.site_management.site_login",
+	Name: "SiteManagementSiteLogin",
+	Method: "POST",
+	Path: "/objects/site_connection/{site_id}/actions/login/invoke",
+	Summary: "Login to a remote site",
+	Tags: []string{"Site Management"},
+	Parameters: []openapi.Parameter{
+		{Name: "Content-Type", In: "header", Required: true, Type: "string", Description: "A header specifying which type of content is in the request/response body. This is required when sending encoded data in a POST/PUT body. When the request body is empty, this header should not be sent."},
+		{Name: "site_id", In: "path", Required: true, Type: "string", Description: "A site ID that exists."},
+	},
+	RequestBody: &openapi.RequestBody{ContentType: "application/json", Schema: "SiteLoginRequest", Required: true, New: func() interface{} { return new(SiteLoginRequest) }},
+	Responses: []openapi.Response{
+		{Status: "204", Description: "No Content: Operation done successfully. No further output.", ContentType: "", Schema: "", New: nil},
+		{Status: "400", Description: "Bad Request: Parameter or validation failure.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "401", Description: "Unauthorized: The user is not authorized to do this request.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "403", Description: "Forbidden: Configuration via Setup is disabled.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "404", Description: "Not Found: The requested object has not been found.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "406", Description: "Not Acceptable: The requests accept headers can not be satisfied.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "415", Description: "Unsupported Media Type: The submitted content-type is not supported.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+	},
+	NewParams: func() interface{} { return new(SiteManagementSiteLoginParams) },
+}
+
+// SiteManagementSiteLogoutParams holds the path, query and header parameters of SiteManagementSiteLogoutOperation.
+type SiteManagementSiteLogoutParams struct {
+	// A site ID that exists.
+	SiteId string `path:"site_id"`
+}
+
+// SiteManagementSiteLogoutOperation describes the "Logout from a remote site" operation (POST /objects/site_connection/{site_id}/actions/logout/invoke).
+var SiteManagementSiteLogoutOperation = &openapi.Operation{
+	ID: "cmk.gui.plugins.openapi.endpoints.site_management.site_logout",
+	Name: "SiteManagementSiteLogout",
+	Method: "POST",
+	Path: "/objects/site_connection/{site_id}/actions/logout/invoke",
+	Summary: "Logout from a remote site",
+	Tags: []string{"Site Management"},
+	Parameters: []openapi.Parameter{
+		{Name: "site_id", In: "path", Required: true, Type: "string", Description: "A site ID that exists."},
+	},
+	Responses: []openapi.Response{
+		{Status: "204", Description: "No Content: Operation done successfully. No further output.", ContentType: "", Schema: "", New: nil},
+		{Status: "400", Description: "Bad Request: Parameter or validation failure.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "403", Description: "Forbidden: Configuration via Setup is disabled.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "404", Description: "Not Found: The requested object has not been found.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "406", Description: "Not Acceptable: The requests accept headers can not be satisfied.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "415", Description: "Unsupported Media Type: The submitted content-type is not supported.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+	},
+	NewParams: func() interface{} { return new(SiteManagementSiteLogoutParams) },
+}
+
+// TimePeriodsShowTimePeriodParams holds the path, query and header parameters of TimePeriodsShowTimePeriodOperation.
+type TimePeriodsShowTimePeriodParams struct {
+	// A name used as an identifier. Can be of arbitrary (sensible) length.
+	Name string `path:"name"`
+}
+
+// TimePeriodsShowTimePeriodOperation describes the "Show a time period" operation (GET /objects/time_period/{name}).
+var TimePeriodsShowTimePeriodOperation = &openapi.Operation{
+	ID: "cmk.gui.plugins.openapi.endpoints.time_periods.show_time_period",
+	Name: "TimePeriodsShowTimePeriod",
+	Method: "GET",
+	Path: "/objects/time_period/{name}",
+	Summary: "Show a time period",
+	Tags: []string{"Time periods"},
+	Parameters: []openapi.Parameter{
+		{Name: "name", In: "path", Required: true, Type: "string", Description: "A name used as an identifier. Can be of arbitrary (sensible) length."},
+	},
+	Responses: []openapi.Response{
+		{Status: "200", Description: "OK: The operation was done successfully.", ContentType: "application/json", Schema: "TimePeriodResponse", Headers: []string{"Content-Type", "ETag", "X-Checkmk-Edition", "X-Checkmk-Version"}, New: func() interface{} { return new(TimePeriodResponse) }},
+		{Status: "403", Description: "Forbidden: Configuration via Setup is disabled.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "404", Description: "Not Found: The requested object has not been found.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "406", Description: "Not Acceptable: The requests accept headers can not be satisfied.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+	},
+	NewParams: func() interface{} { return new(TimePeriodsShowTimePeriodParams) },
+}
+
+// TimePeriodsUpdateTimeperiodParams holds the path, query and header parameters of TimePeriodsUpdateTimeperiodOperation.
+type TimePeriodsUpdateTimeperiodParams struct {
+	// The value of the, to be modified, object's ETag header. You can get this value by displaying the object it individually. To update this object the currently stored ETag needs to be the same as the one sent. The content of the ETag can potentially be anything and should be treated as semantically ...
+	IfMatch string `header:"If-Match"`
+	// A name used as an identifier. Can be of arbitrary (sensible) length.
+	Name string `path:"name"`
+}
+
+// TimePeriodsUpdateTimeperiodOperation describes the "Update a time period" operation (PUT /objects/time_period/{name}).
+var TimePeriodsUpdateTimeperiodOperation = &openapi.Operation{
+	ID: "cmk.gui.plugins.openapi.endpoints.time_periods.update_timeperiod",
+	Name: "TimePeriodsUpdateTimeperiod",
+	Method: "PUT",
+	Path: "/objects/time_period/{name}",
+	Summary: "Update a time period",
+	Tags: []string{"Time periods"},
+	Parameters: []openapi.Parameter{
+		{Name: "If-Match", In: "header", Required: true, Type: "string", Description: "The value of the, to be modified, object's ETag header. You can get this value by displaying the object it individually. To update this object the currently stored ETag needs to be the same as the one sent. The content of the ETag can potentially be anything and should be treated as semantically opaque."},
+		{Name: "Content-Type", In: "header", Required: true, Type: "string", Description: "A header specifying which type of content is in the request/response body. This is required when sending encoded data in a POST/PUT body. When the request body is empty, this header should not be sent."},
+		{Name: "name", In: "path", Required: true, Type: "string", Description: "A name used as an identifier. Can be of arbitrary (sensible) length."},
+	},
+	RequestBody: &openapi.RequestBody{ContentType: "application/json", Schema: "UpdateTimePeriod", Required: true, New: func() interface{} { return new(UpdateTimePeriod) }},
+	Responses: []openapi.Response{
+		{Status: "200", Description: "OK: The operation was done successfully.", ContentType: "application/json", Schema: "TimePeriodResponse", Headers: []string{"Content-Type", "ETag", "X-Checkmk-Edition", "X-Checkmk-Version"}, New: func() interface{} { return new(TimePeriodResponse) }},
+		{Status: "400", Description: "Bad Request: Parameter or validation failure.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "403", Description: "Forbidden: Configuration via Setup is disabled.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "404", Description: "Not Found: The requested object has not been found.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "405", Description: "Method Not Allowed: Method not allowed: This request is only allowed with other HTTP methods", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "406", Description: "Not Acceptable: The requests accept headers can not be satisfied.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "412", Description: "Precondition Failed: The value of the If-Match header doesn't match the object's ETag.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "415", Description: "Unsupported Media Type: The submitted content-type is not supported.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "428", Description: "Precondition Required: The required If-Match header is missing.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+	},
+	NewParams: func() interface{} { return new(TimePeriodsUpdateTimeperiodParams) },
+}
+
+// TimePeriodsDeleteParams holds the path, query and header parameters of TimePeriodsDeleteOperation.
+type TimePeriodsDeleteParams struct {
+	// The value of the, to be modified, object's ETag header. You can get this value by displaying the object it individually. To update this object the currently stored ETag needs to be the same as the one sent. The content of the ETag can potentially be anything and should be treated as semantically ...
+	IfMatch string `header:"If-Match"`
+	// A name used as an identifier. Can be of arbitrary (sensible) length.
+	Name string `path:"name"`
+}
+
+// TimePeriodsDeleteOperation describes the "Delete a time period" operation (DELETE /objects/time_period/{name}).
+var TimePeriodsDeleteOperation = &openapi.Operation{
+	ID: "cmk.gui.plugins.openapi.endpoints.time_periods.delete",
+	Name: "TimePeriodsDelete",
+	Method: "DELETE",
+	Path: "/objects/time_period/{name}",
+	Summary: "Delete a time period",
+	Tags: []string{"Time periods"},
+	Parameters: []openapi.Parameter{
+		{Name: "If-Match", In: "header", Required: true, Type: "string", Description: "The value of the, to be modified, object's ETag header. You can get this value by displaying the object it individually. To update this object the currently stored ETag needs to be the same as the one sent. The content of the ETag can potentially be anything and should be treated as semantically opaque."},
+		{Name: "name", In: "path", Required: true, Type: "string", Description: "A name used as an identifier. Can be of arbitrary (sensible) length."},
+	},
+	Responses: []openapi.Response{
+		{Status: "204", Description: "No Content: Operation done successfully. No further output.", ContentType: "", Schema: "", New: nil},
+		{Status: "403", Description: "Forbidden: Configuration via Setup is disabled.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "404", Description: "Not Found: The requested object has not been found.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "405", Description: "Method Not Allowed: Method not allowed: This request is only allowed with other HTTP methods", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "406", Description: "Not Acceptable: The requests accept headers can not be satisfied.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "409", Description: "Conflict: The request is in conflict with the stored resource.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "412", Description: "Precondition Failed: The value of the If-Match header doesn't match the object's ETag.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "428", Description: "Precondition Required: The required If-Match header is missing.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+	},
+	NewParams: func() interface{} { return new(TimePeriodsDeleteParams) },
+}
+
+// UserConfigShowUserParams holds the path, query and header parameters of UserConfigShowUserOperation.
+type UserConfigShowUserParams struct {
+	// An unique username for the user
+	Username string `path:"username"`
+}
+
+// UserConfigShowUserOperation describes the "Show a user" operation (GET /objects/user_config/{username}).
+var UserConfigShowUserOperation = &openapi.Operation{
+	ID: "cmk.gui.plugins.openapi.endpoints.user_config.show_user",
+	Name: "UserConfigShowUser",
+	Method: "GET",
+	Path: "/objects/user_config/{username}",
+	Summary: "Show a user",
+	Tags: []string{"Users"},
+	Parameters: []openapi.Parameter{
+		{Name: "username", In: "path", Required: true, Type: "string", Description: "An unique username for the user"},
+	},
+	Responses: []openapi.Response{
+		{Status: "200", Description: "OK: The operation was done successfully.", ContentType: "application/json", Schema: "UserObject", Headers: []string{"Content-Type", "ETag", "X-Checkmk-Edition", "X-Checkmk-Version"}, New: func() interface{} { return new(UserObject) }},
+		{Status: "403", Description: "Forbidden: Configuration via Setup is disabled.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "404", Description: "Not Found: The requested object has not been found.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "406", Description: "Not Acceptable: The requests accept headers can not be satisfied.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+	},
+	NewParams: func() interface{} { return new(UserConfigShowUserParams) },
+}
+
+// UserConfigEditUserParams holds the path, query and header parameters of UserConfigEditUserOperation.
+type UserConfigEditUserParams struct {
+	// The value of the, to be modified, object's ETag header. You can get this value by displaying the object it individually. To update this object the currently stored ETag needs to be the same as the one sent. The content of the ETag can potentially be anything and should be treated as semantically ...
+	IfMatch string `header:"If-Match"`
+	// An unique username for the user
+	Username string `path:"username"`
+}
+
+// UserConfigEditUserOperation describes the "Edit a user" operation (PUT /objects/user_config/{username}).
+var UserConfigEditUserOperation = &openapi.Operation{
+	ID: "cmk.gui.plugins.openapi.endpoints.user_config.edit_user",
+	Name: "UserConfigEditUser",
+	Method: "PUT",
+	Path: "/objects/user_config/{username}",
+	Summary: "Edit a user",
+	Tags: []string{"Users"},
+	Parameters: []openapi.Parameter{
+		{Name: "If-Match", In: "header", Required: true, Type: "string", Description: "The value of the, to be modified, object's ETag header. You can get this value by displaying the object it individually. To update this object the currently stored ETag needs to be the same as the one sent. The content of the ETag can potentially be anything and should be treated as semantically opaque."},
+		{Name: "Content-Type", In: "header", Required: true, Type: "string", Description: "A header specifying which type of content is in the request/response body. This is required when sending encoded data in a POST/PUT body. When the request body is empty, this header should not be sent."},
+		{Name: "username", In: "path", Required: true, Type: "string", Description: "An unique username for the user"},
+	},
+	RequestBody: &openapi.RequestBody{ContentType: "application/json", Schema: "UpdateUser", Required: true, New: func() interface{} { return new(UpdateUser) }},
+	Responses: []openapi.Response{
+		{Status: "200", Description: "OK: The operation was done successfully.", ContentType: "application/json", Schema: "UserObject", Headers: []string{"Content-Type", "ETag", "X-Checkmk-Edition", "X-Checkmk-Version"}, New: func() interface{} { return new(UserObject) }},
+		{Status: "400", Description: "Bad Request: Parameter or validation failure.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "403", Description: "Forbidden: Configuration via Setup is disabled.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "404", Description: "Not Found: The requested object has not been found.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "406", Description: "Not Acceptable: The requests accept headers can not be satisfied.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "412", Description: "Precondition Failed: The value of the If-Match header doesn't match the object's ETag.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "415", Description: "Unsupported Media Type: The submitted content-type is not supported.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "428", Description: "Precondition Required: The required If-Match header is missing.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+	},
+	NewParams: func() interface{} { return new(UserConfigEditUserParams) },
+}
+
+// UserConfigDeleteUserParams holds the path, query and header parameters of UserConfigDeleteUserOperation.
+type UserConfigDeleteUserParams struct {
+	// An unique username for the user
+	Username string `path:"username"`
+}
+
+// UserConfigDeleteUserOperation describes the "Delete a user" operation (DELETE /objects/user_config/{username}).
+var UserConfigDeleteUserOperation = &openapi.Operation{
+	ID: "cmk.gui.plugins.openapi.endpoints.user_config.delete_user",
+	Name: "UserConfigDeleteUser",
+	Method: "DELETE",
+	Path: "/objects/user_config/{username}",
+	Summary: "Delete a user",
+	Tags: []string{"Users"},
+	Parameters: []openapi.Parameter{
+		{Name: "username", In: "path", Required: true, Type: "string", Description: "An unique username for the user"},
+	},
+	Responses: []openapi.Response{
+		{Status: "204", Description: "No Content: Operation done successfully. No further output.", ContentType: "", Schema: "", New: nil},
+		{Status: "403", Description: "Forbidden: Configuration via Setup is disabled.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "404", Description: "Not Found: The requested object has not been found.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "406", Description: "Not Acceptable: The requests accept headers can not be satisfied.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+	},
+	NewParams: func() interface{} { return new(UserConfigDeleteUserParams) },
+}
+
+// UserRoleShowUserRoleParams holds the path, query and header parameters of UserRoleShowUserRoleOperation.
+type UserRoleShowUserRoleParams struct {
+	// An existing user role.
+	RoleId string `path:"role_id"`
+}
+
+// UserRoleShowUserRoleOperation describes the "Show a user role" operation (GET /objects/user_role/{role_id}).
+var UserRoleShowUserRoleOperation = &openapi.Operation{
+	ID: "cmk.gui.plugins.openapi.endpoints.user_role.show_user_role",
+	Name: "UserRoleShowUserRole",
+	Method: "GET",
+	Path: "/objects/user_role/{role_id}",
+	Summary: "Show a user role",
+	Tags: []string{"User Roles"},
+	Parameters: []openapi.Parameter{
+		{Name: "role_id", In: "path", Required: true, Type: "string", Description: "An existing user role."},
+	},
+	Responses: []openapi.Response{
+		{Status: "200", Description: "OK: The operation was done successfully.", ContentType: "application/json", Schema: "UserRoleObject", Headers: []string{"Content-Type", "X-Checkmk-Edition", "X-Checkmk-Version"}, New: func() interface{} { return new(UserRoleObject) }},
+		{Status: "403", Description: "Forbidden: Configuration via Setup is disabled.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "404", Description: "Not Found: The requested object has not been found.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "406", Description: "Not Acceptable: The requests accept headers can not be satisfied.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+	},
+	NewParams: func() interface{} { return new(UserRoleShowUserRoleParams) },
+}
+
+// UserRoleEditUserroleParams holds the path, query and header parameters of UserRoleEditUserroleOperation.
+type UserRoleEditUserroleParams struct {
+	// An existing user role.
+	RoleId string `path:"role_id"`
+}
+
+// UserRoleEditUserroleOperation describes the "Edit a user role" operation (PUT /objects/user_role/{role_id}).
+var UserRoleEditUserroleOperation = &openapi.Operation{
+	ID: "cmk.gui.plugins.openapi.endpoints.user_role.edit_userrole",
+	Name: "UserRoleEditUserrole",
+	Method: "PUT",
+	Path: "/objects/user_role/{role_id}",
+	Summary: "Edit a user role",
+	Tags: []string{"User Roles"},
+	Parameters: []openapi.Parameter{
+		{Name: "Content-Type", In: "header", Required: true, Type: "string", Description: "A header specifying which type of content is in the request/response body. This is required when sending encoded data in a POST/PUT body. When the request body is empty, this header should not be sent."},
+		{Name: "role_id", In: "path", Required: true, Type: "string", Description: "An existing user role."},
+	},
+	RequestBody: &openapi.RequestBody{ContentType: "application/json", Schema: "EditUserRole", Required: true, New: func() interface{} { return new(EditUserRole) }},
+	Responses: []openapi.Response{
+		{Status: "200", Description: "OK: The operation was done successfully.", ContentType: "application/json", Schema: "UserRoleObject", Headers: []string{"Content-Type", "X-Checkmk-Edition", "X-Checkmk-Version"}, New: func() interface{} { return new(UserRoleObject) }},
+		{Status: "400", Description: "Bad Request: Parameter or validation failure.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "403", Description: "Forbidden: Configuration via Setup is disabled.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "404", Description: "Not Found: The requested object has not been found.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "406", Description: "Not Acceptable: The requests accept headers can not be satisfied.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "415", Description: "Unsupported Media Type: The submitted content-type is not supported.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+	},
+	NewParams: func() interface{} { return new(UserRoleEditUserroleParams) },
+}
+
+// UserRoleDeleteUserroleParams holds the path, query and header parameters of UserRoleDeleteUserroleOperation.
+type UserRoleDeleteUserroleParams struct {
+	// An existing custom user role that you want to delete.
+	RoleId string `path:"role_id"`
+}
+
+// UserRoleDeleteUserroleOperation describes the "Delete a user role" operation (DELETE /objects/user_role/{role_id}).
+var UserRoleDeleteUserroleOperation = &openapi.Operation{
+	ID: "cmk.gui.plugins.openapi.endpoints.user_role.delete_userrole",
+	Name: "UserRoleDeleteUserrole",
+	Method: "DELETE",
+	Path: "/objects/user_role/{role_id}",
+	Summary: "Delete a user role",
+	Tags: []string{"User Roles"},
+	Parameters: []openapi.Parameter{
+		{Name: "role_id", In: "path", Required: true, Type: "string", Description: "An existing custom user role that you want to delete."},
+	},
+	Responses: []openapi.Response{
+		{Status: "204", Description: "No Content: Operation done successfully. No further output.", ContentType: "", Schema: "", New: nil},
+		{Status: "403", Description: "Forbidden: Configuration via Setup is disabled.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "404", Description: "Not Found: The requested object has not been found.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "406", Description: "Not Acceptable: The requests accept headers can not be satisfied.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+	},
+	NewParams: func() interface{} { return new(UserRoleDeleteUserroleParams) },
+}
+
+// CertRootCertOperation describes the "X.509 PEM-encoded root certificate" operation (GET /root_cert).
+var CertRootCertOperation = &openapi.Operation{
+	ID: "cmk.gui.plugins.openapi.endpoints.cert.root_cert",
+	Name: "CertRootCert",
+	Method: "GET",
+	Path: "/root_cert",
+	Summary: "X.509 PEM-encoded root certificate",
+	Tags: []string{"Certificates"},
+	Responses: []openapi.Response{
+		{Status: "200", Description: "OK: The operation was done successfully.", ContentType: "application/json", Schema: "X509PEM", Headers: []string{"Content-Type", "X-Checkmk-Edition", "X-Checkmk-Version"}, New: func() interface{} { return new(X509PEM) }},
+		{Status: "403", Description: "Forbidden: You do not have the permission for agent pairing.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+		{Status: "406", Description: "Not Acceptable: The requests accept headers can not be satisfied.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+	},
+}
+
+// VersionSearchOperation describes the "Display some version information" operation (GET /version).
+var VersionSearchOperation = &openapi.Operation{
+	ID: "cmk.gui.plugins.openapi.endpoints.version.search",
+	Name: "VersionSearch",
+	Method: "GET",
+	Path: "/version",
+	Summary: "Display some version information",
+	Tags: []string{"Miscellaneous"},
+	Responses: []openapi.Response{
+		{Status: "200", Description: "OK: The operation was done successfully.", ContentType: "application/json", Schema: "InstalledVersions", Headers: []string{"Content-Type", "X-Checkmk-Edition", "X-Checkmk-Version"}, New: func() interface{} { return new(InstalledVersions) }},
+		{Status: "406", Description: "Not Acceptable: The requests accept headers can not be satisfied.", ContentType: "application/problem+json", Schema: "ApiError", New: func() interface{} { return new(ApiError) }},
+	},
+}
+
+// Operations maps operationIds to their endpoint descriptors.
+var Operations = map[string]*openapi.Operation{
+	"cmk.gui.plugins.openapi.endpoints.acknowledgement.set_acknowledgement_on_hosts": AcknowledgementSetAcknowledgementOnHostsOperation,
+	"cmk.gui.plugins.openapi.endpoints.acknowledgement.set_acknowledgement_on_services": AcknowledgementSetAcknowledgementOnServicesOperation,
+	"cmk.gui.plugins.openapi.endpoints.activate_changes.activate_changes": ActivateChangesActivateChangesOperation,
+	"cmk.gui.plugins.openapi.endpoints.activate_changes.activate_changes_wait_for_completion": ActivateChangesActivateChangesWaitForCompletionOperation,
+	"cmk.gui.plugins.openapi.endpoints.activate_changes.list_activations": ActivateChangesListActivationsOperation,
+	"cmk.gui.plugins.openapi.endpoints.activate_changes.list_pending_changes": ActivateChangesListPendingChangesOperation,
+	"cmk.gui.plugins.openapi.endpoints.activate_changes.show_activation": ActivateChangesShowActivationOperation,
+	"cmk.gui.plugins.openapi.endpoints.agent.download_agent": AgentDownloadAgentOperation,
+	"cmk.gui.plugins.openapi.endpoints.aux_tags.create_aux_tag": AuxTagsCreateAuxTagOperation,
+	"cmk.gui.plugins.openapi.endpoints.aux_tags.delete_aux_tag": AuxTagsDeleteAuxTagOperation,
+	"cmk.gui.plugins.openapi.endpoints.aux_tags.put_aux_tag": AuxTagsPutAuxTagOperation,
+	"cmk.gui.plugins.openapi.endpoints.aux_tags.show_aux_tag": AuxTagsShowAuxTagOperation,
+	"cmk.gui.plugins.openapi.endpoints.aux_tags.show_aux_tags": AuxTagsShowAuxTagsOperation,
+	"cmk.gui.plugins.openapi.endpoints.bi.bi_aggregation_state_get": BiBiAggregationStateGetOperation,
+	"cmk.gui.plugins.openapi.endpoints.bi.bi_aggregation_state_post": BiBiAggregationStatePostOperation,
+	"cmk.gui.plugins.openapi.endpoints.bi.delete_bi_aggregation": BiDeleteBiAggregationOperation,
+	"cmk.gui.plugins.openapi.endpoints.bi.delete_bi_pack": BiDeleteBiPackOperation,
+	"cmk.gui.plugins.openapi.endpoints.bi.delete_bi_rule": BiDeleteBiRuleOperation,
+	"cmk.gui.plugins.openapi.endpoints.bi.get_bi_aggregation": BiGetBiAggregationOperation,
+	"cmk.gui.plugins.openapi.endpoints.bi.get_bi_pack": BiGetBiPackOperation,
+	"cmk.gui.plugins.openapi.endpoints.bi.get_bi_packs": BiGetBiPacksOperation,
+	"cmk.gui.plugins.openapi.endpoints.bi.get_bi_rule": BiGetBiRuleOperation,
+	"cmk.gui.plugins.openapi.endpoints.bi.post_bi_aggregation": BiPostBiAggregationOperation,
+	"cmk.gui.plugins.openapi.endpoints.bi.post_bi_pack": BiPostBiPackOperation,
+	"cmk.gui.plugins.openapi.endpoints.bi.post_bi_rule": BiPostBiRuleOperation,
+	"cmk.gui.plugins.openapi.endpoints.bi.put_bi_aggregation": BiPutBiAggregationOperation,
+	"cmk.gui.plugins.openapi.endpoints.bi.put_bi_pack": BiPutBiPackOperation,
+	"cmk.gui.plugins.openapi.endpoints.bi.put_bi_rule": BiPutBiRuleOperation,
+	"cmk.gui.plugins.openapi.endpoints.cert.agent_controller_certificates_settings": CertAgentControllerCertificatesSettingsOperation,
+	"cmk.gui.plugins.openapi.endpoints.cert.make_certificate": CertMakeCertificateOperation,
+	"cmk.gui.plugins.openapi.endpoints.cert.root_cert": CertRootCertOperation,
+	"cmk.gui.plugins.openapi.endpoints.comment.create_host_comment": CommentCreateHostCommentOperation,
+	"cmk.gui.plugins.openapi.endpoints.comment.create_service_comment": CommentCreateServiceCommentOperation,
+	"cmk.gui.plugins.openapi.endpoints.comment.delete_comments": CommentDeleteCommentsOperation,
+	"cmk.gui.plugins.openapi.endpoints.comment.show_comment": CommentShowCommentOperation,
+	"cmk.gui.plugins.openapi.endpoints.comment.show_comments": CommentShowCommentsOperation,
+	"cmk.gui.plugins.openapi.endpoints.contact_group_config.bulk_create": ContactGroupConfigBulkCreateOperation,
+	"cmk.gui.plugins.openapi.endpoints.contact_group_config.bulk_delete": ContactGroupConfigBulkDeleteOperation,
+	"cmk.gui.plugins.openapi.endpoints.contact_group_config.bulk_update": ContactGroupConfigBulkUpdateOperation,
+	"cmk.gui.plugins.openapi.endpoints.contact_group_config.create": ContactGroupConfigCreateOperation,
+	"cmk.gui.plugins.openapi.endpoints.contact_group_config.delete": ContactGroupConfigDeleteOperation,
+	"cmk.gui.plugins.openapi.endpoints.contact_group_config.list_group": ContactGroupConfigListGroupOperation,
+	"cmk.gui.plugins.openapi.endpoints.contact_group_config.show": ContactGroupConfigShowOperation,
+	"cmk.gui.plugins.openapi.endpoints.contact_group_config.update": ContactGroupConfigUpdateOperation,
+	"cmk.gui.plugins.openapi.endpoints.downtime.create_host_related_downtime": DowntimeCreateHostRelatedDowntimeOperation,
+	"cmk.gui.plugins.openapi.endpoints.downtime.create_service_related_downtime": DowntimeCreateServiceRelatedDowntimeOperation,
+	"cmk.gui.plugins.openapi.endpoints.downtime.delete_downtime": DowntimeDeleteDowntimeOperation,
+	"cmk.gui.plugins.openapi.endpoints.downtime.show_downtime": DowntimeShowDowntimeOperation,
+	"cmk.gui.plugins.openapi.endpoints.downtime.show_downtimes": DowntimeShowDowntimesOperation,
+	"cmk.gui.plugins.openapi.endpoints.event_console.archive_events_with_filter": EventConsoleArchiveEventsWithFilterOperation,
+	"cmk.gui.plugins.openapi.endpoints.event_console.change_event_state": EventConsoleChangeEventStateOperation,
+	"cmk.gui.plugins.openapi.endpoints.event_console.change_multiple_event_states": EventConsoleChangeMultipleEventStatesOperation,
+	"cmk.gui.plugins.openapi.endpoints.event_console.show_event": EventConsoleShowEventOperation,
+	"cmk.gui.plugins.openapi.endpoints.event_console.show_events": EventConsoleShowEventsOperation,
+	"cmk.gui.plugins.openapi.endpoints.event_console.update_and_acknowledge_event": EventConsoleUpdateAndAcknowledgeEventOperation,
+	"cmk.gui.plugins.openapi.endpoints.event_console.update_and_acknowledge_multiple_events": EventConsoleUpdateAndAcknowledgeMultipleEventsOperation,
+	"cmk.gui.plugins.openapi.endpoints.folder_config.bulk_update": FolderConfigBulkUpdateOperation,
+	"cmk.gui.plugins.openapi.endpoints.folder_config.create": FolderConfigCreateOperation,
+	"cmk.gui.plugins.openapi.endpoints.folder_config.delete": FolderConfigDeleteOperation,
+	"cmk.gui.plugins.openapi.endpoints.folder_config.hosts_of_folder": FolderConfigHostsOfFolderOperation,
+	"cmk.gui.plugins.openapi.endpoints.folder_config.list_folders": FolderConfigListFoldersOperation,
+	"cmk.gui.plugins.openapi.endpoints.folder_config.move": FolderConfigMoveOperation,
+	"cmk.gui.plugins.openapi.endpoints.folder_config.show_folder": FolderConfigShowFolderOperation,
+	"cmk.gui.plugins.openapi.endpoints.folder_config.update": FolderConfigUpdateOperation,
+	"cmk.gui.plugins.openapi.endpoints.host_config.bulk_create_hosts": HostConfigBulkCreateHostsOperation,
+	"cmk.gui.plugins.openapi.endpoints.host_config.bulk_delete": HostConfigBulkDeleteOperation,
+	"cmk.gui.plugins.openapi.endpoints.host_config.bulk_update_hosts": HostConfigBulkUpdateHostsOperation,
+	"cmk.gui.plugins.openapi.endpoints.host_config.create_cluster_host": HostConfigCreateClusterHostOperation,
+	"cmk.gui.plugins.openapi.endpoints.host_config.create_host": HostConfigCreateHostOperation,
+	"cmk.gui.plugins.openapi.endpoints.host_config.delete": HostConfigDeleteOperation,
+	"cmk.gui.plugins.openapi.endpoints.host_config.list_hosts": HostConfigListHostsOperation,
+	"cmk.gui.plugins.openapi.endpoints.host_config.move": HostConfigMoveOperation,
+	"cmk.gui.plugins.openapi.endpoints.host_config.rename_host": HostConfigRenameHostOperation,
+	"cmk.gui.plugins.openapi.endpoints.host_config.show_host": HostConfigShowHostOperation,
+	"cmk.gui.plugins.openapi.endpoints.host_config.update_host": HostConfigUpdateHostOperation,
+	"cmk.gui.plugins.openapi.endpoints.host_config.update_nodes": HostConfigUpdateNodesOperation,
+	"cmk.gui.plugins.openapi.endpoints.host_group_config.bulk_create": HostGroupConfigBulkCreateOperation,
+	"cmk.gui.plugins.openapi.endpoints.host_group_config.bulk_delete": HostGroupConfigBulkDeleteOperation,
+	"cmk.gui.plugins.openapi.endpoints.host_group_config.bulk_update": HostGroupConfigBulkUpdateOperation,
+	"cmk.gui.plugins.openapi.endpoints.host_group_config.create": HostGroupConfigCreateOperation,
+	"cmk.gui.plugins.openapi.endpoints.host_group_config.delete": HostGroupConfigDeleteOperation,
+	"cmk.gui.plugins.openapi.endpoints.host_group_config.get": HostGroupConfigGetOperation,
+	"cmk.gui.plugins.openapi.endpoints.host_group_config.list_groups": HostGroupConfigListGroupsOperation,
+	"cmk.gui.plugins.openapi.endpoints.host_group_config.update": HostGroupConfigUpdateOperation,
+	"cmk.gui.plugins.openapi.endpoints.host_internal.link_with_uuid": HostInternalLinkWithUuidOperation,
+	"cmk.gui.plugins.openapi.endpoints.host_internal.register": HostInternalRegisterOperation,
+	"cmk.gui.plugins.openapi.endpoints.host_internal.show_host": HostInternalShowHostOperation,
+	"cmk.gui.plugins.openapi.endpoints.host_tag_group.create_host_tag_group": HostTagGroupCreateHostTagGroupOperation,
+	"cmk.gui.plugins.openapi.endpoints.host_tag_group.delete_host_tag_group": HostTagGroupDeleteHostTagGroupOperation,
+	"cmk.gui.plugins.openapi.endpoints.host_tag_group.list_host_tag_groups": HostTagGroupListHostTagGroupsOperation,
+	"cmk.gui.plugins.openapi.endpoints.host_tag_group.show_host_tag_group": HostTagGroupShowHostTagGroupOperation,
+	"cmk.gui.plugins.openapi.endpoints.host_tag_group.update_host_tag_group": HostTagGroupUpdateHostTagGroupOperation,
+	"cmk.gui.plugins.openapi.endpoints.metric.get_graph": MetricGetGraphOperation,
+	"cmk.gui.plugins.openapi.endpoints.notification_rules.delete_rule": NotificationRulesDeleteRuleOperation,
+	"cmk.gui.plugins.openapi.endpoints.notification_rules.post_rule": NotificationRulesPostRuleOperation,
+	"cmk.gui.plugins.openapi.endpoints.notification_rules.put_rule": NotificationRulesPutRuleOperation,
+	"cmk.gui.plugins.openapi.endpoints.notification_rules.show_rule": NotificationRulesShowRuleOperation,
+	"cmk.gui.plugins.openapi.endpoints.notification_rules.show_rules": NotificationRulesShowRulesOperation,
+	"cmk.gui.plugins.openapi.endpoints.password.create_password": PasswordCreatePasswordOperation,
+	"cmk.gui.plugins.openapi.endpoints.password.delete_password": PasswordDeletePasswordOperation,
+	"cmk.gui.plugins.openapi.endpoints.password.list_passwords": PasswordListPasswordsOperation,
+	"cmk.gui.plugins.openapi.endpoints.password.show_password": PasswordShowPasswordOperation,
+	"cmk.gui.plugins.openapi.endpoints.password.update_password": PasswordUpdatePasswordOperation,
+	"cmk.gui.plugins.openapi.endpoints.rule.create_rule": RuleCreateRuleOperation,
+	"cmk.gui.plugins.openapi.endpoints.rule.delete_rule": RuleDeleteRuleOperation,
+	"cmk.gui.plugins.openapi.endpoints.rule.edit_rule": RuleEditRuleOperation,
+	"cmk.gui.plugins.openapi.endpoints.rule.list_rules": RuleListRulesOperation,
+	"cmk.gui.plugins.openapi.endpoints.rule.move_rule_to": RuleMoveRuleToOperation,
+	"cmk.gui.plugins.openapi.endpoints.rule.show_rule": RuleShowRuleOperation,
+	"cmk.gui.plugins.openapi.endpoints.ruleset.list_rulesets": RulesetListRulesetsOperation,
+	"cmk.gui.plugins.openapi.endpoints.ruleset.show_ruleset": RulesetShowRulesetOperation,
+	"cmk.gui.plugins.openapi.endpoints.service.show_service": ServiceShowServiceOperation,
+	"cmk.gui.plugins.openapi.endpoints.service_discovery.execute-14538": ServiceDiscoveryExecute14538Operation,
+	"cmk.gui.plugins.openapi.endpoints.service_discovery.execute_bulk_discovery": ServiceDiscoveryExecuteBulkDiscoveryOperation,
+	"cmk.gui.plugins.openapi.endpoints.service_discovery.execute_service_discovery": ServiceDiscoveryExecuteServiceDiscoveryOperation,
+	"cmk.gui.plugins.openapi.endpoints.service_discovery.service_discovery_run_wait_for_completion": ServiceDiscoveryServiceDiscoveryRunWaitForCompletionOperation,
+	"cmk.gui.plugins.openapi.endpoints.service_discovery.show_bulk_discovery_status": ServiceDiscoveryShowBulkDiscoveryStatusOperation,
+	"cmk.gui.plugins.openapi.endpoints.service_discovery.show_service_discovery_result": ServiceDiscoveryShowServiceDiscoveryResultOperation,
+	"cmk.gui.plugins.openapi.endpoints.service_discovery.show_service_discovery_run": ServiceDiscoveryShowServiceDiscoveryRunOperation,
+	"cmk.gui.plugins.openapi.endpoints.service_discovery.show_services-14537": ServiceDiscoveryShowServices14537Operation,
+	"cmk.gui.plugins.openapi.endpoints.service_discovery.update_service_phase": ServiceDiscoveryUpdateServicePhaseOperation,
+	"cmk.gui.plugins.openapi.endpoints.service_group_config.bulk_create": ServiceGroupConfigBulkCreateOperation,
+	"cmk.gui.plugins.openapi.endpoints.service_group_config.bulk_delete": ServiceGroupConfigBulkDeleteOperation,
+	"cmk.gui.plugins.openapi.endpoints.service_group_config.bulk_update": ServiceGroupConfigBulkUpdateOperation,
+	"cmk.gui.plugins.openapi.endpoints.service_group_config.create": ServiceGroupConfigCreateOperation,
+	"cmk.gui.plugins.openapi.endpoints.service_group_config.delete": ServiceGroupConfigDeleteOperation,
+	"cmk.gui.plugins.openapi.endpoints.service_group_config.list_groups": ServiceGroupConfigListGroupsOperation,
+	"cmk.gui.plugins.openapi.endpoints.service_group_config.show_group": ServiceGroupConfigShowGroupOperation,
+	"cmk.gui.plugins.openapi.endpoints.service_group_config.update": ServiceGroupConfigUpdateOperation,
+	"cmk.gui.plugins.openapi.endpoints.site_management.delete_site": SiteManagementDeleteSiteOperation,
+	"cmk.gui.plugins.openapi.endpoints.site_management.post_site": SiteManagementPostSiteOperation,
+	"cmk.gui.plugins.openapi.endpoints.site_management.put_site": SiteManagementPutSiteOperation,
+	"cmk.gui.plugins.openapi.endpoints.site_management.show_site": SiteManagementShowSiteOperation,
+	"cmk.gui.plugins.openapi.endpoints.site_management.show_sites": SiteManagementShowSitesOperation,
+	"cmk.gui.plugins.openapi.endpoints.site_management.site_login": SiteManagementSiteLoginOperation,
+	"cmk.gui.plugins.openapi.endpoints.site_management.site_logout": SiteManagementSiteLogoutOperation,
+	"cmk.gui.plugins.openapi.endpoints.time_periods.create_timeperiod": TimePeriodsCreateTimeperiodOperation,
+	"cmk.gui.plugins.openapi.endpoints.time_periods.delete": TimePeriodsDeleteOperation,
+	"cmk.gui.plugins.openapi.endpoints.time_periods.list_time_periods": TimePeriodsListTimePeriodsOperation,
+	"cmk.gui.plugins.openapi.endpoints.time_periods.show_time_period": TimePeriodsShowTimePeriodOperation,
+	"cmk.gui.plugins.openapi.endpoints.time_periods.update_timeperiod": TimePeriodsUpdateTimeperiodOperation,
+	"cmk.gui.plugins.openapi.endpoints.user_config.create_user": UserConfigCreateUserOperation,
+	"cmk.gui.plugins.openapi.endpoints.user_config.delete_user": UserConfigDeleteUserOperation,
+	"cmk.gui.plugins.openapi.endpoints.user_config.edit_user": UserConfigEditUserOperation,
+	"cmk.gui.plugins.openapi.endpoints.user_config.list_users": UserConfigListUsersOperation,
+	"cmk.gui.plugins.openapi.endpoints.user_config.show_user": UserConfigShowUserOperation,
+	"cmk.gui.plugins.openapi.endpoints.user_role.create_userrole": UserRoleCreateUserroleOperation,
+	"cmk.gui.plugins.openapi.endpoints.user_role.delete_userrole": UserRoleDeleteUserroleOperation,
+	"cmk.gui.plugins.openapi.endpoints.user_role.edit_userrole": UserRoleEditUserroleOperation,
+	"cmk.gui.plugins.openapi.endpoints.user_role.list_user_roles": UserRoleListUserRolesOperation,
+	"cmk.gui.plugins.openapi.endpoints.user_role.show_user_role": UserRoleShowUserRoleOperation,
+	"cmk.gui.plugins.openapi.endpoints.version.search": VersionSearchOperation,
+}
+
+// OperationsByTag groups endpoint descriptors by tag, in path order.
+var OperationsByTag = map[string][]*openapi.Operation{
+	"Acknowledge problems": {
+		AcknowledgementSetAcknowledgementOnHostsOperation,
+		AcknowledgementSetAcknowledgementOnServicesOperation,
+	},
+	"Activate changes": {
+		ActivateChangesActivateChangesOperation,
+		ActivateChangesListPendingChangesOperation,
+		ActivateChangesListActivationsOperation,
+		ActivateChangesShowActivationOperation,
+		ActivateChangesActivateChangesWaitForCompletionOperation,
+	},
+	"Agents": {
+		AgentDownloadAgentOperation,
+	},
+	"Aux Tags": {
+		AuxTagsShowAuxTagsOperation,
+		AuxTagsCreateAuxTagOperation,
+		AuxTagsShowAuxTagOperation,
+		AuxTagsPutAuxTagOperation,
+		AuxTagsDeleteAuxTagOperation,
+	},
+	"Business intelligence (BI)": {
+		BiBiAggregationStateGetOperation,
+		BiBiAggregationStatePostOperation,
+		BiGetBiPacksOperation,
+		BiGetBiAggregationOperation,
+		BiPutBiAggregationOperation,
+		BiPostBiAggregationOperation,
+		BiDeleteBiAggregationOperation,
+		BiGetBiPackOperation,
+		BiPutBiPackOperation,
+		BiPostBiPackOperation,
+		BiDeleteBiPackOperation,
+		BiGetBiRuleOperation,
+		BiPutBiRuleOperation,
+		BiPostBiRuleOperation,
+		BiDeleteBiRuleOperation,
+	},
+	"Certificates": {
+		CertAgentControllerCertificatesSettingsOperation,
+		CertMakeCertificateOperation,
+		CertRootCertOperation,
+	},
+	"Comments": {
+		CommentDeleteCommentsOperation,
+		CommentCreateHostCommentOperation,
+		CommentCreateServiceCommentOperation,
+		CommentShowCommentsOperation,
+		CommentShowCommentOperation,
+	},
+	"Contact groups": {
+		ContactGroupConfigBulkCreateOperation,
+		ContactGroupConfigBulkDeleteOperation,
+		ContactGroupConfigBulkUpdateOperation,
+		ContactGroupConfigListGroupOperation,
+		ContactGroupConfigCreateOperation,
+		ContactGroupConfigShowOperation,
+		ContactGroupConfigUpdateOperation,
+		ContactGroupConfigDeleteOperation,
+	},
+	"Downtimes": {
+		DowntimeDeleteDowntimeOperation,
+		DowntimeShowDowntimesOperation,
+		DowntimeCreateHostRelatedDowntimeOperation,
+		DowntimeCreateServiceRelatedDowntimeOperation,
+		DowntimeShowDowntimeOperation,
+	},
+	"Event Console": {
+		EventConsoleChangeMultipleEventStatesOperation,
+		EventConsoleArchiveEventsWithFilterOperation,
+		EventConsoleUpdateAndAcknowledgeMultipleEventsOperation,
+		EventConsoleShowEventsOperation,
+		EventConsoleShowEventOperation,
+		EventConsoleChangeEventStateOperation,
+		EventConsoleUpdateAndAcknowledgeEventOperation,
+	},
+	"Folders": {
+		FolderConfigBulkUpdateOperation,
+		FolderConfigListFoldersOperation,
+		FolderConfigCreateOperation,
+		FolderConfigShowFolderOperation,
+		FolderConfigUpdateOperation,
+		FolderConfigDeleteOperation,
+		FolderConfigMoveOperation,
+		FolderConfigHostsOfFolderOperation,
+	},
+	"Host groups": {
+		HostGroupConfigBulkCreateOperation,
+		HostGroupConfigBulkDeleteOperation,
+		HostGroupConfigBulkUpdateOperation,
+		HostGroupConfigListGroupsOperation,
+		HostGroupConfigCreateOperation,
+		HostGroupConfigGetOperation,
+		HostGroupConfigUpdateOperation,
+		HostGroupConfigDeleteOperation,
+	},
+	"Host tag groups": {
+		HostTagGroupListHostTagGroupsOperation,
+		HostTagGroupCreateHostTagGroupOperation,
+		HostTagGroupShowHostTagGroupOperation,
+		HostTagGroupUpdateHostTagGroupOperation,
+		HostTagGroupDeleteHostTagGroupOperation,
+	},
+	"Hosts": {
+		HostConfigBulkCreateHostsOperation,
+		HostConfigBulkDeleteOperation,
+		HostConfigBulkUpdateHostsOperation,
+		HostConfigListHostsOperation,
+		HostConfigCreateHostOperation,
+		HostConfigCreateClusterHostOperation,
+		HostConfigShowHostOperation,
+		HostConfigUpdateHostOperation,
+		HostConfigDeleteOperation,
+		HostConfigMoveOperation,
+		HostConfigRenameHostOperation,
+		HostConfigUpdateNodesOperation,
+	},
+	"Hosts (internal)": {
+		HostInternalShowHostOperation,
+		HostInternalLinkWithUuidOperation,
+		HostInternalRegisterOperation,
+	},
+	"Metrics": {
+		MetricGetGraphOperation,
+	},
+	"Miscellaneous": {
+		VersionSearchOperation,
+	},
+	"Notification Rules": {
+		NotificationRulesShowRulesOperation,
+		NotificationRulesPostRuleOperation,
+		NotificationRulesShowRuleOperation,
+		NotificationRulesPutRuleOperation,
+		NotificationRulesDeleteRuleOperation,
+	},
+	"Passwords": {
+		PasswordListPasswordsOperation,
+		PasswordCreatePasswordOperation,
+		PasswordShowPasswordOperation,
+		PasswordUpdatePasswordOperation,
+		PasswordDeletePasswordOperation,
+	},
+	"Rules": {
+		RuleListRulesOperation,
+		RuleCreateRuleOperation,
+		RuleShowRuleOperation,
+		RuleEditRuleOperation,
+		RuleDeleteRuleOperation,
+		RuleMoveRuleToOperation,
+	},
+	"Rulesets": {
+		RulesetListRulesetsOperation,
+		RulesetShowRulesetOperation,
+	},
+	"Service discovery": {
+		ServiceDiscoveryExecuteBulkDiscoveryOperation,
+		ServiceDiscoveryShowServices14537Operation,
+		ServiceDiscoveryExecuteServiceDiscoveryOperation,
+		ServiceDiscoveryShowBulkDiscoveryStatusOperation,
+		ServiceDiscoveryExecute14538Operation,
+		ServiceDiscoveryUpdateServicePhaseOperation,
+		ServiceDiscoveryShowServiceDiscoveryResultOperation,
+		ServiceDiscoveryShowServiceDiscoveryRunOperation,
+		ServiceDiscoveryServiceDiscoveryRunWaitForCompletionOperation,
+	},
+	"Service groups": {
+		ServiceGroupConfigBulkCreateOperation,
+		ServiceGroupConfigBulkDeleteOperation,
+		ServiceGroupConfigBulkUpdateOperation,
+		ServiceGroupConfigListGroupsOperation,
+		ServiceGroupConfigCreateOperation,
+		ServiceGroupConfigShowGroupOperation,
+		ServiceGroupConfigUpdateOperation,
+		ServiceGroupConfigDeleteOperation,
+	},
+	"Service status": {
+		ServiceShowServiceOperation,
+	},
+	"Site Management": {
+		SiteManagementShowSitesOperation,
+		SiteManagementPostSiteOperation,
+		SiteManagementShowSiteOperation,
+		SiteManagementPutSiteOperation,
+		SiteManagementDeleteSiteOperation,
+		SiteManagementSiteLoginOperation,
+		SiteManagementSiteLogoutOperation,
+	},
+	"Time periods": {
+		TimePeriodsListTimePeriodsOperation,
+		TimePeriodsCreateTimeperiodOperation,
+		TimePeriodsShowTimePeriodOperation,
+		TimePeriodsUpdateTimeperiodOperation,
+		TimePeriodsDeleteOperation,
+	},
+	"User Roles": {
+		UserRoleListUserRolesOperation,
+		UserRoleCreateUserroleOperation,
+		UserRoleShowUserRoleOperation,
+		UserRoleEditUserroleOperation,
+		UserRoleDeleteUserroleOperation,
+	},
+	"Users": {
+		UserConfigListUsersOperation,
+		UserConfigCreateUserOperation,
+		UserConfigShowUserOperation,
+		UserConfigEditUserOperation,
+		UserConfigDeleteUserOperation,
+	},
+}
+
+// GetOperation returns the descriptor for an operationId, or nil if unknown.
+func GetOperation(operationID string) *openapi.Operation {
+	return Operations[operationID]
+}
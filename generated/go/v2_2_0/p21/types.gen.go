@@ -9,6 +9,11 @@
 
 package p21
 
+import (
+	"encoding/json"
+	"fmt"
+)
+
 // AcknowledgeHostGroupProblem represents a CheckMK API type.
 type AcknowledgeHostGroupProblem struct {
 	// The acknowledge host selection type.
@@ -69,14 +74,115 @@ type AcknowledgeHostQueryProblem struct {
 	Persistent bool `json:"persistent,omitempty"`
 	// An query expression of the Livestatus 'hosts' table in nested dictionary form. If you want to use multiple expressions, nest them with the AND/OR operators.
 	// Example: {"op": "and", "expr": [{"op": "=", "left": "name", "right": "example.com"}, {"op": "!=", "left": "state", "right": "0"}]}
-	Query interface{} `json:"query"`
+	Query Expr `json:"query"`
 	// If set, only a state-change of the host to an UP state will discard the acknowledgement. Otherwise it will be discarded on any state-change. Defaults to False.
 	// Example: false
 	Sticky bool `json:"sticky,omitempty"`
 }
 
-// AcknowledgeHostRelatedProblem represents a CheckMK API type.
+// AcknowledgeHostRelatedProblem is a union of AcknowledgeHostProblem, AcknowledgeHostGroupProblem, AcknowledgeHostQueryProblem, selected by the "acknowledge_type" property.
 type AcknowledgeHostRelatedProblem struct {
+	union json.RawMessage
+}
+
+// Discriminator returns the value of the "acknowledge_type" property.
+func (u AcknowledgeHostRelatedProblem) Discriminator() (string, error) {
+	return unionDiscriminator(u.union, "acknowledge_type")
+}
+
+// AsAcknowledgeHostProblem returns the union data as a AcknowledgeHostProblem.
+func (u AcknowledgeHostRelatedProblem) AsAcknowledgeHostProblem() (AcknowledgeHostProblem, error) {
+	var v AcknowledgeHostProblem
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromAcknowledgeHostProblem sets the union to v, with "acknowledge_type" set to "host".
+func (u *AcknowledgeHostRelatedProblem) FromAcknowledgeHostProblem(v AcknowledgeHostProblem) error {
+	data, err := unionFrom(v, "acknowledge_type", "host")
+	if err != nil {
+		return fmt.Errorf("AcknowledgeHostRelatedProblem: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsAcknowledgeHostGroupProblem returns the union data as a AcknowledgeHostGroupProblem.
+func (u AcknowledgeHostRelatedProblem) AsAcknowledgeHostGroupProblem() (AcknowledgeHostGroupProblem, error) {
+	var v AcknowledgeHostGroupProblem
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromAcknowledgeHostGroupProblem sets the union to v, with "acknowledge_type" set to "hostgroup".
+func (u *AcknowledgeHostRelatedProblem) FromAcknowledgeHostGroupProblem(v AcknowledgeHostGroupProblem) error {
+	data, err := unionFrom(v, "acknowledge_type", "hostgroup")
+	if err != nil {
+		return fmt.Errorf("AcknowledgeHostRelatedProblem: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsAcknowledgeHostQueryProblem returns the union data as a AcknowledgeHostQueryProblem.
+func (u AcknowledgeHostRelatedProblem) AsAcknowledgeHostQueryProblem() (AcknowledgeHostQueryProblem, error) {
+	var v AcknowledgeHostQueryProblem
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromAcknowledgeHostQueryProblem sets the union to v, with "acknowledge_type" set to "host_by_query".
+func (u *AcknowledgeHostRelatedProblem) FromAcknowledgeHostQueryProblem(v AcknowledgeHostQueryProblem) error {
+	data, err := unionFrom(v, "acknowledge_type", "host_by_query")
+	if err != nil {
+		return fmt.Errorf("AcknowledgeHostRelatedProblem: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// ValueByDiscriminator decodes the union into the member selected by "acknowledge_type".
+func (u AcknowledgeHostRelatedProblem) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := u.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "host":
+		return u.AsAcknowledgeHostProblem()
+	case "hostgroup":
+		return u.AsAcknowledgeHostGroupProblem()
+	case "host_by_query":
+		return u.AsAcknowledgeHostQueryProblem()
+	}
+	return nil, fmt.Errorf("AcknowledgeHostRelatedProblem: unknown acknowledge_type %q", discriminator)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u AcknowledgeHostRelatedProblem) MarshalJSON() ([]byte, error) {
+	if len(u.union) == 0 {
+		return []byte("null"), nil
+	}
+	return u.union, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting unknown "acknowledge_type" values.
+func (u *AcknowledgeHostRelatedProblem) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		u.union = nil
+		return nil
+	}
+	discriminator, err := unionDiscriminator(data, "acknowledge_type")
+	if err != nil {
+		return fmt.Errorf("AcknowledgeHostRelatedProblem: %w", err)
+	}
+	switch discriminator {
+	case "host", "hostgroup", "host_by_query":
+	default:
+		return fmt.Errorf("AcknowledgeHostRelatedProblem: unknown acknowledge_type %q", discriminator)
+	}
+	u.union = append(json.RawMessage(nil), data...)
+	return nil
 }
 
 // AcknowledgeServiceGroupProblem represents a CheckMK API type.
@@ -117,14 +223,115 @@ type AcknowledgeServiceQueryProblem struct {
 	Persistent bool `json:"persistent,omitempty"`
 	// An query expression of the Livestatus 'services' table in nested dictionary form. If you want to use multiple expressions, nest them with the AND/OR operators.
 	// Example: {"op": "and", "expr": [{"op": "=", "left": "name", "right": "example.com"}, {"op": "!=", "left": "state", "right": "0"}]}
-	Query interface{} `json:"query"`
+	Query Expr `json:"query"`
 	// If set, only a state-change of the service to an OK state will discard the acknowledgement. Otherwise, it will be discarded on any state-change. Defaults to False.
 	// Example: false
 	Sticky bool `json:"sticky,omitempty"`
 }
 
-// AcknowledgeServiceRelatedProblem represents a CheckMK API type.
+// AcknowledgeServiceRelatedProblem is a union of AcknowledgeSpecificServiceProblem, AcknowledgeServiceGroupProblem, AcknowledgeServiceQueryProblem, selected by the "acknowledge_type" property.
 type AcknowledgeServiceRelatedProblem struct {
+	union json.RawMessage
+}
+
+// Discriminator returns the value of the "acknowledge_type" property.
+func (u AcknowledgeServiceRelatedProblem) Discriminator() (string, error) {
+	return unionDiscriminator(u.union, "acknowledge_type")
+}
+
+// AsAcknowledgeSpecificServiceProblem returns the union data as a AcknowledgeSpecificServiceProblem.
+func (u AcknowledgeServiceRelatedProblem) AsAcknowledgeSpecificServiceProblem() (AcknowledgeSpecificServiceProblem, error) {
+	var v AcknowledgeSpecificServiceProblem
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromAcknowledgeSpecificServiceProblem sets the union to v, with "acknowledge_type" set to "service".
+func (u *AcknowledgeServiceRelatedProblem) FromAcknowledgeSpecificServiceProblem(v AcknowledgeSpecificServiceProblem) error {
+	data, err := unionFrom(v, "acknowledge_type", "service")
+	if err != nil {
+		return fmt.Errorf("AcknowledgeServiceRelatedProblem: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsAcknowledgeServiceGroupProblem returns the union data as a AcknowledgeServiceGroupProblem.
+func (u AcknowledgeServiceRelatedProblem) AsAcknowledgeServiceGroupProblem() (AcknowledgeServiceGroupProblem, error) {
+	var v AcknowledgeServiceGroupProblem
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromAcknowledgeServiceGroupProblem sets the union to v, with "acknowledge_type" set to "servicegroup".
+func (u *AcknowledgeServiceRelatedProblem) FromAcknowledgeServiceGroupProblem(v AcknowledgeServiceGroupProblem) error {
+	data, err := unionFrom(v, "acknowledge_type", "servicegroup")
+	if err != nil {
+		return fmt.Errorf("AcknowledgeServiceRelatedProblem: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsAcknowledgeServiceQueryProblem returns the union data as a AcknowledgeServiceQueryProblem.
+func (u AcknowledgeServiceRelatedProblem) AsAcknowledgeServiceQueryProblem() (AcknowledgeServiceQueryProblem, error) {
+	var v AcknowledgeServiceQueryProblem
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromAcknowledgeServiceQueryProblem sets the union to v, with "acknowledge_type" set to "service_by_query".
+func (u *AcknowledgeServiceRelatedProblem) FromAcknowledgeServiceQueryProblem(v AcknowledgeServiceQueryProblem) error {
+	data, err := unionFrom(v, "acknowledge_type", "service_by_query")
+	if err != nil {
+		return fmt.Errorf("AcknowledgeServiceRelatedProblem: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// ValueByDiscriminator decodes the union into the member selected by "acknowledge_type".
+func (u AcknowledgeServiceRelatedProblem) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := u.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "service":
+		return u.AsAcknowledgeSpecificServiceProblem()
+	case "servicegroup":
+		return u.AsAcknowledgeServiceGroupProblem()
+	case "service_by_query":
+		return u.AsAcknowledgeServiceQueryProblem()
+	}
+	return nil, fmt.Errorf("AcknowledgeServiceRelatedProblem: unknown acknowledge_type %q", discriminator)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u AcknowledgeServiceRelatedProblem) MarshalJSON() ([]byte, error) {
+	if len(u.union) == 0 {
+		return []byte("null"), nil
+	}
+	return u.union, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting unknown "acknowledge_type" values.
+func (u *AcknowledgeServiceRelatedProblem) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		u.union = nil
+		return nil
+	}
+	discriminator, err := unionDiscriminator(data, "acknowledge_type")
+	if err != nil {
+		return fmt.Errorf("AcknowledgeServiceRelatedProblem: %w", err)
+	}
+	switch discriminator {
+	case "service", "servicegroup", "service_by_query":
+	default:
+		return fmt.Errorf("AcknowledgeServiceRelatedProblem: unknown acknowledge_type %q", discriminator)
+	}
+	u.union = append(json.RawMessage(nil), data...)
+	return nil
 }
 
 // AcknowledgeSpecificServiceProblem represents a CheckMK API type.
@@ -166,7 +373,7 @@ type ActivateChanges struct {
 // ActivationExtensionFields represents a CheckMK API type.
 type ActivationExtensionFields struct {
 	// The changes in this activation
-	Changes []map[string]interface{} `json:"changes,omitempty"`
+	Changes []ChangesFields `json:"changes,omitempty"`
 	// If the activation is still running
 	// Example: false
 	ForceForeignChanges bool `json:"force_foreign_changes,omitempty"`
@@ -190,10 +397,10 @@ type ActivationRunCollection struct {
 	// The name of this collection.
 	Id string `json:"id,omitempty"`
 	// list of links to other resources.
-	Links []map[string]interface{} `json:"links"`
+	Links []Link `json:"links"`
 	// A list of activation runs.
 	// Example: [map[domainType:activation_run extensions:map[activate_foreign:true changes:[map[action_name:edit-host id:da5430a5-6d0a-48ae-9efd-0563482a3b36 text:Modified host heute. time:2023-01-20T16:31:51.362057+00:00 user_id:cmkadmin]] comment: is_running:false sites:[heute] time_started:2023-01-20T16:31:5...
-	Value []map[string]interface{} `json:"value,omitempty"`
+	Value []ActivationRunResponse `json:"value,omitempty"`
 }
 
 // ActivationRunResponse represents a CheckMK API type.
@@ -202,12 +409,12 @@ type ActivationRunResponse struct {
 	DomainType interface{} `json:"domainType,omitempty"`
 	// The activation run attributes.
 	// Example: map[activate_foreign:true changes:[map[action_name:edit-host id:da5430a5-6d0a-48ae-9efd-0563482a3b36 text:Modified host heute. time:2023-01-20T16:31:51.362057+00:00 user_id:cmkadmin]] comment: is_running:false sites:[heute] time_started:2023-01-20T16:31:54.306846+00:00]
-	Extensions interface{} `json:"extensions,omitempty"`
+	Extensions *ActivationExtensionFields `json:"extensions,omitempty"`
 	// The unique identifier for this activation run.
 	// Example: 84b18e42-355e-4f13-80b6-404bd8f21149
 	Id string `json:"id,omitempty"`
 	// list of links to other resources.
-	Links []map[string]interface{} `json:"links"`
+	Links []Link `json:"links"`
 	// The container for external resources, like linked foreign objects or actions.
 	Members map[string]interface{} `json:"members,omitempty"`
 	// The activation run status.
@@ -224,7 +431,7 @@ type AgentControllerCertificateSettings struct {
 
 // AlwaysBulk represents a CheckMK API type.
 type AlwaysBulk struct {
-	Params map[string]interface{} `json:"params"`
+	Params NotificationBulkingAlways `json:"params"`
 	// Bulking can always happen or during a set time period
 	// Example: always
 	WhenToBulk AlwaysBulkWhenToBulk `json:"when_to_bulk"`
@@ -249,22 +456,104 @@ type ApiError struct {
 
 // AsciiMailPluginCreate represents a CheckMK API type.
 type AsciiMailPluginCreate struct {
-	BodyHeadForBothHostAndServiceNotifications map[string]interface{} `json:"body_head_for_both_host_and_service_notifications"`
-	BodyTailForHostNotifications map[string]interface{} `json:"body_tail_for_host_notifications"`
-	BodyTailForServiceNotifications map[string]interface{} `json:"body_tail_for_service_notifications"`
-	FromDetails map[string]interface{} `json:"from_details"`
+	BodyHeadForBothHostAndServiceNotifications StrValueOneOf `json:"body_head_for_both_host_and_service_notifications"`
+	BodyTailForHostNotifications StrValueOneOf `json:"body_tail_for_host_notifications"`
+	BodyTailForServiceNotifications StrValueOneOf `json:"body_tail_for_service_notifications"`
+	FromDetails FromDetailsOneOf `json:"from_details"`
 	// The plugin name.
 	// Example: mail
 	PluginName AsciiMailPluginCreatePluginName `json:"plugin_name"`
-	ReplyTo map[string]interface{} `json:"reply_to"`
-	SendSeparateNotificationToEveryRecipient map[string]interface{} `json:"send_separate_notification_to_every_recipient"`
-	SortOrderForBulkNotificaions map[string]interface{} `json:"sort_order_for_bulk_notificaions"`
-	SubjectForHostNotifications map[string]interface{} `json:"subject_for_host_notifications"`
-	SubjectForServiceNotifications map[string]interface{} `json:"subject_for_service_notifications"`
+	ReplyTo ReplyToOneOf `json:"reply_to"`
+	SendSeparateNotificationToEveryRecipient Checkbox `json:"send_separate_notification_to_every_recipient"`
+	SortOrderForBulkNotificaions SortOrderOneOf `json:"sort_order_for_bulk_notificaions"`
+	SubjectForHostNotifications SubjectHostOneOf `json:"subject_for_host_notifications"`
+	SubjectForServiceNotifications SubjectServiceOneOf `json:"subject_for_service_notifications"`
 }
 
-// AuthOption represents a CheckMK API type.
+// AuthOption is a union of AuthPassword, AuthSecret, selected by the "auth_type" property.
 type AuthOption struct {
+	union json.RawMessage
+}
+
+// Discriminator returns the value of the "auth_type" property.
+func (u AuthOption) Discriminator() (string, error) {
+	return unionDiscriminator(u.union, "auth_type")
+}
+
+// AsAuthPassword returns the union data as a AuthPassword.
+func (u AuthOption) AsAuthPassword() (AuthPassword, error) {
+	var v AuthPassword
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromAuthPassword sets the union to v, with "auth_type" set to "password".
+func (u *AuthOption) FromAuthPassword(v AuthPassword) error {
+	data, err := unionFrom(v, "auth_type", "password")
+	if err != nil {
+		return fmt.Errorf("AuthOption: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsAuthSecret returns the union data as a AuthSecret.
+func (u AuthOption) AsAuthSecret() (AuthSecret, error) {
+	var v AuthSecret
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromAuthSecret sets the union to v, with "auth_type" set to "automation".
+func (u *AuthOption) FromAuthSecret(v AuthSecret) error {
+	data, err := unionFrom(v, "auth_type", "automation")
+	if err != nil {
+		return fmt.Errorf("AuthOption: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// ValueByDiscriminator decodes the union into the member selected by "auth_type".
+func (u AuthOption) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := u.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "password":
+		return u.AsAuthPassword()
+	case "automation":
+		return u.AsAuthSecret()
+	}
+	return nil, fmt.Errorf("AuthOption: unknown auth_type %q", discriminator)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u AuthOption) MarshalJSON() ([]byte, error) {
+	if len(u.union) == 0 {
+		return []byte("null"), nil
+	}
+	return u.union, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting unknown "auth_type" values.
+func (u *AuthOption) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		u.union = nil
+		return nil
+	}
+	discriminator, err := unionDiscriminator(data, "auth_type")
+	if err != nil {
+		return fmt.Errorf("AuthOption: %w", err)
+	}
+	switch discriminator {
+	case "password", "automation":
+	default:
+		return fmt.Errorf("AuthOption: unknown auth_type %q", discriminator)
+	}
+	u.union = append(json.RawMessage(nil), data...)
+	return nil
 }
 
 // AuthOption1 represents a CheckMK API type.
@@ -298,8 +587,109 @@ type AuthSecret struct {
 	Secret string `json:"secret,omitempty"`
 }
 
-// AuthUpdateOption represents a CheckMK API type.
+// AuthUpdateOption is a union of AuthUpdatePassword, AuthUpdateSecret, AuthUpdateRemove, selected by the "auth_type" property.
 type AuthUpdateOption struct {
+	union json.RawMessage
+}
+
+// Discriminator returns the value of the "auth_type" property.
+func (u AuthUpdateOption) Discriminator() (string, error) {
+	return unionDiscriminator(u.union, "auth_type")
+}
+
+// AsAuthUpdatePassword returns the union data as a AuthUpdatePassword.
+func (u AuthUpdateOption) AsAuthUpdatePassword() (AuthUpdatePassword, error) {
+	var v AuthUpdatePassword
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromAuthUpdatePassword sets the union to v, with "auth_type" set to "password".
+func (u *AuthUpdateOption) FromAuthUpdatePassword(v AuthUpdatePassword) error {
+	data, err := unionFrom(v, "auth_type", "password")
+	if err != nil {
+		return fmt.Errorf("AuthUpdateOption: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsAuthUpdateSecret returns the union data as a AuthUpdateSecret.
+func (u AuthUpdateOption) AsAuthUpdateSecret() (AuthUpdateSecret, error) {
+	var v AuthUpdateSecret
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromAuthUpdateSecret sets the union to v, with "auth_type" set to "automation".
+func (u *AuthUpdateOption) FromAuthUpdateSecret(v AuthUpdateSecret) error {
+	data, err := unionFrom(v, "auth_type", "automation")
+	if err != nil {
+		return fmt.Errorf("AuthUpdateOption: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsAuthUpdateRemove returns the union data as a AuthUpdateRemove.
+func (u AuthUpdateOption) AsAuthUpdateRemove() (AuthUpdateRemove, error) {
+	var v AuthUpdateRemove
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromAuthUpdateRemove sets the union to v, with "auth_type" set to "remove".
+func (u *AuthUpdateOption) FromAuthUpdateRemove(v AuthUpdateRemove) error {
+	data, err := unionFrom(v, "auth_type", "remove")
+	if err != nil {
+		return fmt.Errorf("AuthUpdateOption: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// ValueByDiscriminator decodes the union into the member selected by "auth_type".
+func (u AuthUpdateOption) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := u.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "password":
+		return u.AsAuthUpdatePassword()
+	case "automation":
+		return u.AsAuthUpdateSecret()
+	case "remove":
+		return u.AsAuthUpdateRemove()
+	}
+	return nil, fmt.Errorf("AuthUpdateOption: unknown auth_type %q", discriminator)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u AuthUpdateOption) MarshalJSON() ([]byte, error) {
+	if len(u.union) == 0 {
+		return []byte("null"), nil
+	}
+	return u.union, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting unknown "auth_type" values.
+func (u *AuthUpdateOption) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		u.union = nil
+		return nil
+	}
+	discriminator, err := unionDiscriminator(data, "auth_type")
+	if err != nil {
+		return fmt.Errorf("AuthUpdateOption: %w", err)
+	}
+	switch discriminator {
+	case "password", "automation", "remove":
+	default:
+		return fmt.Errorf("AuthUpdateOption: unknown auth_type %q", discriminator)
+	}
+	u.union = append(json.RawMessage(nil), data...)
+	return nil
 }
 
 // AuthUpdatePassword represents a CheckMK API type.
@@ -344,7 +734,7 @@ type AuthenticationValue struct {
 	// To enable or disable this field
 	// Example: enabled
 	State AuthenticationValueState `json:"state"`
-	Value map[string]interface{} `json:"value,omitempty"`
+	Value *Authentication `json:"value,omitempty"`
 }
 
 // AuxHostTag represents a CheckMK API type.
@@ -401,11 +791,11 @@ type AuxTagResponse struct {
 	DomainType interface{} `json:"domainType,omitempty"`
 	// The Auxiliary Tag attributes.
 	// Example: map[help:Your help text id:snmp title:Monitoring via SNMP topic:Monitoring agents]
-	Extensions interface{} `json:"extensions,omitempty"`
+	Extensions *AuxTagAttrsResponse `json:"extensions,omitempty"`
 	// The unique identifier for this domain-object type.
 	Id string `json:"id,omitempty"`
 	// list of links to other resources.
-	Links []map[string]interface{} `json:"links"`
+	Links []Link `json:"links"`
 	// The container for external resources, like linked foreign objects or actions.
 	Members map[string]interface{} `json:"members,omitempty"`
 	// A human readable title of this object. Can be used for user interfaces.
@@ -421,16 +811,136 @@ type AuxTagResponseCollection struct {
 	// The name of this collection.
 	Id string `json:"id,omitempty"`
 	// list of links to other resources.
-	Links []map[string]interface{} `json:"links"`
+	Links []Link `json:"links"`
 	// A human readable title of this object. Can be used for user interfaces.
 	Title string `json:"title,omitempty"`
 	// A list of site configuration objects.
 	// Example: [map[domainType:aux_tag extensions:map[help:Your help text id:snmp title:Monitoring via SNMP topic:Monitoring agents] id:snmp links:[] members:map[] title:Monitoring via SNMP]]
-	Value []map[string]interface{} `json:"value,omitempty"`
+	Value []AuxTagResponse `json:"value,omitempty"`
 }
 
-// BIAction represents a CheckMK API type.
+// BIAction is a union of BICallARuleAction, BIStateOfHostAction, BIStateOfServiceAction, BIStateOfRemainingServicesAction, selected by the "type" property.
 type BIAction struct {
+	union json.RawMessage
+}
+
+// Discriminator returns the value of the "type" property.
+func (u BIAction) Discriminator() (string, error) {
+	return unionDiscriminator(u.union, "type")
+}
+
+// AsBICallARuleAction returns the union data as a BICallARuleAction.
+func (u BIAction) AsBICallARuleAction() (BICallARuleAction, error) {
+	var v BICallARuleAction
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromBICallARuleAction sets the union to v, with "type" set to "call_a_rule".
+func (u *BIAction) FromBICallARuleAction(v BICallARuleAction) error {
+	data, err := unionFrom(v, "type", "call_a_rule")
+	if err != nil {
+		return fmt.Errorf("BIAction: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsBIStateOfHostAction returns the union data as a BIStateOfHostAction.
+func (u BIAction) AsBIStateOfHostAction() (BIStateOfHostAction, error) {
+	var v BIStateOfHostAction
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromBIStateOfHostAction sets the union to v, with "type" set to "state_of_host".
+func (u *BIAction) FromBIStateOfHostAction(v BIStateOfHostAction) error {
+	data, err := unionFrom(v, "type", "state_of_host")
+	if err != nil {
+		return fmt.Errorf("BIAction: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsBIStateOfServiceAction returns the union data as a BIStateOfServiceAction.
+func (u BIAction) AsBIStateOfServiceAction() (BIStateOfServiceAction, error) {
+	var v BIStateOfServiceAction
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromBIStateOfServiceAction sets the union to v, with "type" set to "state_of_service".
+func (u *BIAction) FromBIStateOfServiceAction(v BIStateOfServiceAction) error {
+	data, err := unionFrom(v, "type", "state_of_service")
+	if err != nil {
+		return fmt.Errorf("BIAction: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsBIStateOfRemainingServicesAction returns the union data as a BIStateOfRemainingServicesAction.
+func (u BIAction) AsBIStateOfRemainingServicesAction() (BIStateOfRemainingServicesAction, error) {
+	var v BIStateOfRemainingServicesAction
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromBIStateOfRemainingServicesAction sets the union to v, with "type" set to "state_of_remaining_services".
+func (u *BIAction) FromBIStateOfRemainingServicesAction(v BIStateOfRemainingServicesAction) error {
+	data, err := unionFrom(v, "type", "state_of_remaining_services")
+	if err != nil {
+		return fmt.Errorf("BIAction: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// ValueByDiscriminator decodes the union into the member selected by "type".
+func (u BIAction) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := u.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "call_a_rule":
+		return u.AsBICallARuleAction()
+	case "state_of_host":
+		return u.AsBIStateOfHostAction()
+	case "state_of_service":
+		return u.AsBIStateOfServiceAction()
+	case "state_of_remaining_services":
+		return u.AsBIStateOfRemainingServicesAction()
+	}
+	return nil, fmt.Errorf("BIAction: unknown type %q", discriminator)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u BIAction) MarshalJSON() ([]byte, error) {
+	if len(u.union) == 0 {
+		return []byte("null"), nil
+	}
+	return u.union, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting unknown "type" values.
+func (u *BIAction) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		u.union = nil
+		return nil
+	}
+	discriminator, err := unionDiscriminator(data, "type")
+	if err != nil {
+		return fmt.Errorf("BIAction: %w", err)
+	}
+	switch discriminator {
+	case "call_a_rule", "state_of_host", "state_of_service", "state_of_remaining_services":
+	default:
+		return fmt.Errorf("BIAction: unknown type %q", discriminator)
+	}
+	u.union = append(json.RawMessage(nil), data...)
+	return nil
 }
 
 // BIAggregationComputationOptions represents a CheckMK API type.
@@ -445,32 +955,133 @@ type BIAggregationComputationOptions struct {
 type BIAggregationEndpoint struct {
 	// Nested dictionary
 	// Example: map[ignore_rule_styles:false layout_id:builtin_default line_style:round]
-	AggregationVisualization interface{} `json:"aggregation_visualization"`
+	AggregationVisualization BIAggregationVisualization `json:"aggregation_visualization"`
 	// An optional comment that may be used to explain the purpose of this object.
 	// Example: Rule comment
 	Comment string `json:"comment,omitempty"`
 	// Nested dictionary
 	// Example: map[disabled:false escalate_downtimes_as_warn:false freeze_aggregations:false use_hard_states:false]
-	ComputationOptions interface{} `json:"computation_options"`
+	ComputationOptions BIAggregationComputationOptions `json:"computation_options"`
 	// CME Edition only: The customer id for this aggregation.
 	// Example: customer1
 	Customer string `json:"customer,omitempty"`
 	// Nested dictionary
 	// Example: map[names:[groupA groupB] paths:[[path group a] [path group b]]]
-	Groups interface{} `json:"groups"`
+	Groups BIAggregationGroups `json:"groups"`
 	// The unique aggregation id
 	// Example: aggr1
 	Id string `json:"id"`
 	// Nested dictionary
 	// Example: map[action:map[host_regex: type:state_of_host] search:map[type:empty]]
-	Node interface{} `json:"node"`
+	Node BINodeGenerator `json:"node"`
 	// The identifier of the BI pack.
 	// Example: pack1
 	PackId string `json:"pack_id"`
 }
 
-// BIAggregationFunction represents a CheckMK API type.
+// BIAggregationFunction is a union of BIAggregationFunctionBest, BIAggregationFunctionWorst, BIAggregationFunctionCountOK, selected by the "type" property.
 type BIAggregationFunction struct {
+	union json.RawMessage
+}
+
+// Discriminator returns the value of the "type" property.
+func (u BIAggregationFunction) Discriminator() (string, error) {
+	return unionDiscriminator(u.union, "type")
+}
+
+// AsBIAggregationFunctionBest returns the union data as a BIAggregationFunctionBest.
+func (u BIAggregationFunction) AsBIAggregationFunctionBest() (BIAggregationFunctionBest, error) {
+	var v BIAggregationFunctionBest
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromBIAggregationFunctionBest sets the union to v, with "type" set to "best".
+func (u *BIAggregationFunction) FromBIAggregationFunctionBest(v BIAggregationFunctionBest) error {
+	data, err := unionFrom(v, "type", "best")
+	if err != nil {
+		return fmt.Errorf("BIAggregationFunction: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsBIAggregationFunctionWorst returns the union data as a BIAggregationFunctionWorst.
+func (u BIAggregationFunction) AsBIAggregationFunctionWorst() (BIAggregationFunctionWorst, error) {
+	var v BIAggregationFunctionWorst
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromBIAggregationFunctionWorst sets the union to v, with "type" set to "worst".
+func (u *BIAggregationFunction) FromBIAggregationFunctionWorst(v BIAggregationFunctionWorst) error {
+	data, err := unionFrom(v, "type", "worst")
+	if err != nil {
+		return fmt.Errorf("BIAggregationFunction: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsBIAggregationFunctionCountOK returns the union data as a BIAggregationFunctionCountOK.
+func (u BIAggregationFunction) AsBIAggregationFunctionCountOK() (BIAggregationFunctionCountOK, error) {
+	var v BIAggregationFunctionCountOK
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromBIAggregationFunctionCountOK sets the union to v, with "type" set to "count_ok".
+func (u *BIAggregationFunction) FromBIAggregationFunctionCountOK(v BIAggregationFunctionCountOK) error {
+	data, err := unionFrom(v, "type", "count_ok")
+	if err != nil {
+		return fmt.Errorf("BIAggregationFunction: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// ValueByDiscriminator decodes the union into the member selected by "type".
+func (u BIAggregationFunction) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := u.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "best":
+		return u.AsBIAggregationFunctionBest()
+	case "worst":
+		return u.AsBIAggregationFunctionWorst()
+	case "count_ok":
+		return u.AsBIAggregationFunctionCountOK()
+	}
+	return nil, fmt.Errorf("BIAggregationFunction: unknown type %q", discriminator)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u BIAggregationFunction) MarshalJSON() ([]byte, error) {
+	if len(u.union) == 0 {
+		return []byte("null"), nil
+	}
+	return u.union, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting unknown "type" values.
+func (u *BIAggregationFunction) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		u.union = nil
+		return nil
+	}
+	discriminator, err := unionDiscriminator(data, "type")
+	if err != nil {
+		return fmt.Errorf("BIAggregationFunction: %w", err)
+	}
+	switch discriminator {
+	case "best", "worst", "count_ok":
+	default:
+		return fmt.Errorf("BIAggregationFunction: unknown type %q", discriminator)
+	}
+	u.union = append(json.RawMessage(nil), data...)
+	return nil
 }
 
 // BIAggregationFunctionBest represents a CheckMK API type.
@@ -482,8 +1093,8 @@ type BIAggregationFunctionBest struct {
 
 // BIAggregationFunctionCountOK represents a CheckMK API type.
 type BIAggregationFunctionCountOK struct {
-	LevelsOk map[string]interface{} `json:"levels_ok"`
-	LevelsWarn map[string]interface{} `json:"levels_warn"`
+	LevelsOk BIAggregationFunctionCountSettings `json:"levels_ok"`
+	LevelsWarn BIAggregationFunctionCountSettings `json:"levels_warn"`
 	Type interface{} `json:"type"`
 }
 
@@ -543,7 +1154,7 @@ type BIAllHostsChoice struct {
 
 // BICallARuleAction represents a CheckMK API type.
 type BICallARuleAction struct {
-	Params interface{} `json:"params"`
+	Params BIParams `json:"params"`
 	RuleId string `json:"rule_id"`
 	Type interface{} `json:"type"`
 }
@@ -555,7 +1166,7 @@ type BIEmptySearch struct {
 
 // BIFixedArgumentsSearch represents a CheckMK API type.
 type BIFixedArgumentsSearch struct {
-	Arguments []map[string]interface{} `json:"arguments"`
+	Arguments []BIFixedArgumentsSearchToken `json:"arguments"`
 	Type interface{} `json:"type"`
 }
 
@@ -571,8 +1182,109 @@ type BIHostAliasRegexChoice struct {
 	Type interface{} `json:"type"`
 }
 
-// BIHostChoice represents a CheckMK API type.
+// BIHostChoice is a union of BIAllHostsChoice, BIHostNameRegexChoice, BIHostAliasRegexChoice, selected by the "type" property.
 type BIHostChoice struct {
+	union json.RawMessage
+}
+
+// Discriminator returns the value of the "type" property.
+func (u BIHostChoice) Discriminator() (string, error) {
+	return unionDiscriminator(u.union, "type")
+}
+
+// AsBIAllHostsChoice returns the union data as a BIAllHostsChoice.
+func (u BIHostChoice) AsBIAllHostsChoice() (BIAllHostsChoice, error) {
+	var v BIAllHostsChoice
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromBIAllHostsChoice sets the union to v, with "type" set to "all_hosts".
+func (u *BIHostChoice) FromBIAllHostsChoice(v BIAllHostsChoice) error {
+	data, err := unionFrom(v, "type", "all_hosts")
+	if err != nil {
+		return fmt.Errorf("BIHostChoice: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsBIHostNameRegexChoice returns the union data as a BIHostNameRegexChoice.
+func (u BIHostChoice) AsBIHostNameRegexChoice() (BIHostNameRegexChoice, error) {
+	var v BIHostNameRegexChoice
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromBIHostNameRegexChoice sets the union to v, with "type" set to "host_name_regex".
+func (u *BIHostChoice) FromBIHostNameRegexChoice(v BIHostNameRegexChoice) error {
+	data, err := unionFrom(v, "type", "host_name_regex")
+	if err != nil {
+		return fmt.Errorf("BIHostChoice: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsBIHostAliasRegexChoice returns the union data as a BIHostAliasRegexChoice.
+func (u BIHostChoice) AsBIHostAliasRegexChoice() (BIHostAliasRegexChoice, error) {
+	var v BIHostAliasRegexChoice
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromBIHostAliasRegexChoice sets the union to v, with "type" set to "host_alias_regex".
+func (u *BIHostChoice) FromBIHostAliasRegexChoice(v BIHostAliasRegexChoice) error {
+	data, err := unionFrom(v, "type", "host_alias_regex")
+	if err != nil {
+		return fmt.Errorf("BIHostChoice: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// ValueByDiscriminator decodes the union into the member selected by "type".
+func (u BIHostChoice) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := u.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "all_hosts":
+		return u.AsBIAllHostsChoice()
+	case "host_name_regex":
+		return u.AsBIHostNameRegexChoice()
+	case "host_alias_regex":
+		return u.AsBIHostAliasRegexChoice()
+	}
+	return nil, fmt.Errorf("BIHostChoice: unknown type %q", discriminator)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u BIHostChoice) MarshalJSON() ([]byte, error) {
+	if len(u.union) == 0 {
+		return []byte("null"), nil
+	}
+	return u.union, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting unknown "type" values.
+func (u *BIHostChoice) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		u.union = nil
+		return nil
+	}
+	discriminator, err := unionDiscriminator(data, "type")
+	if err != nil {
+		return fmt.Errorf("BIHostChoice: %w", err)
+	}
+	switch discriminator {
+	case "all_hosts", "host_name_regex", "host_alias_regex":
+	default:
+		return fmt.Errorf("BIHostChoice: unknown type %q", discriminator)
+	}
+	u.union = append(json.RawMessage(nil), data...)
+	return nil
 }
 
 // BIHostNameRegexChoice represents a CheckMK API type.
@@ -583,8 +1295,8 @@ type BIHostNameRegexChoice struct {
 
 // BIHostSearch represents a CheckMK API type.
 type BIHostSearch struct {
-	Conditions map[string]interface{} `json:"conditions"`
-	ReferTo map[string]interface{} `json:"refer_to"`
+	Conditions HostConditions `json:"conditions"`
+	ReferTo ReferTo `json:"refer_to"`
 	Type interface{} `json:"type"`
 }
 
@@ -592,10 +1304,10 @@ type BIHostSearch struct {
 type BINodeGenerator struct {
 	// Nested dictionary
 	// Example: map[host_regex: type:state_of_host]
-	Action interface{} `json:"action"`
+	Action BIAction `json:"action"`
 	// Nested dictionary
 	// Example: map[type:empty]
-	Search interface{} `json:"search"`
+	Search BISearch `json:"search"`
 }
 
 // BINodeVisBlockStyle represents a CheckMK API type.
@@ -612,7 +1324,7 @@ type BINodeVisForceStyle struct {
 
 // BINodeVisHierarchyStyle represents a CheckMK API type.
 type BINodeVisHierarchyStyle struct {
-	StyleConfig map[string]interface{} `json:"style_config"`
+	StyleConfig BINodeVisHierarchyStyleConfig `json:"style_config"`
 	Type interface{} `json:"type"`
 }
 
@@ -623,8 +1335,147 @@ type BINodeVisHierarchyStyleConfig struct {
 	Rotation int `json:"rotation"`
 }
 
-// BINodeVisLayoutStyle represents a CheckMK API type.
+// BINodeVisLayoutStyle is a union of BINodeVisNoneStyle, BINodeVisBlockStyle, BINodeVisHierarchyStyle, BINodeVisRadialStyle, BINodeVisForceStyle, selected by the "type" property.
 type BINodeVisLayoutStyle struct {
+	union json.RawMessage
+}
+
+// Discriminator returns the value of the "type" property.
+func (u BINodeVisLayoutStyle) Discriminator() (string, error) {
+	return unionDiscriminator(u.union, "type")
+}
+
+// AsBINodeVisNoneStyle returns the union data as a BINodeVisNoneStyle.
+func (u BINodeVisLayoutStyle) AsBINodeVisNoneStyle() (BINodeVisNoneStyle, error) {
+	var v BINodeVisNoneStyle
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromBINodeVisNoneStyle sets the union to v, with "type" set to "none".
+func (u *BINodeVisLayoutStyle) FromBINodeVisNoneStyle(v BINodeVisNoneStyle) error {
+	data, err := unionFrom(v, "type", "none")
+	if err != nil {
+		return fmt.Errorf("BINodeVisLayoutStyle: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsBINodeVisBlockStyle returns the union data as a BINodeVisBlockStyle.
+func (u BINodeVisLayoutStyle) AsBINodeVisBlockStyle() (BINodeVisBlockStyle, error) {
+	var v BINodeVisBlockStyle
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromBINodeVisBlockStyle sets the union to v, with "type" set to "block".
+func (u *BINodeVisLayoutStyle) FromBINodeVisBlockStyle(v BINodeVisBlockStyle) error {
+	data, err := unionFrom(v, "type", "block")
+	if err != nil {
+		return fmt.Errorf("BINodeVisLayoutStyle: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsBINodeVisHierarchyStyle returns the union data as a BINodeVisHierarchyStyle.
+func (u BINodeVisLayoutStyle) AsBINodeVisHierarchyStyle() (BINodeVisHierarchyStyle, error) {
+	var v BINodeVisHierarchyStyle
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromBINodeVisHierarchyStyle sets the union to v, with "type" set to "hierarchy".
+func (u *BINodeVisLayoutStyle) FromBINodeVisHierarchyStyle(v BINodeVisHierarchyStyle) error {
+	data, err := unionFrom(v, "type", "hierarchy")
+	if err != nil {
+		return fmt.Errorf("BINodeVisLayoutStyle: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsBINodeVisRadialStyle returns the union data as a BINodeVisRadialStyle.
+func (u BINodeVisLayoutStyle) AsBINodeVisRadialStyle() (BINodeVisRadialStyle, error) {
+	var v BINodeVisRadialStyle
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromBINodeVisRadialStyle sets the union to v, with "type" set to "radial".
+func (u *BINodeVisLayoutStyle) FromBINodeVisRadialStyle(v BINodeVisRadialStyle) error {
+	data, err := unionFrom(v, "type", "radial")
+	if err != nil {
+		return fmt.Errorf("BINodeVisLayoutStyle: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsBINodeVisForceStyle returns the union data as a BINodeVisForceStyle.
+func (u BINodeVisLayoutStyle) AsBINodeVisForceStyle() (BINodeVisForceStyle, error) {
+	var v BINodeVisForceStyle
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromBINodeVisForceStyle sets the union to v, with "type" set to "force".
+func (u *BINodeVisLayoutStyle) FromBINodeVisForceStyle(v BINodeVisForceStyle) error {
+	data, err := unionFrom(v, "type", "force")
+	if err != nil {
+		return fmt.Errorf("BINodeVisLayoutStyle: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// ValueByDiscriminator decodes the union into the member selected by "type".
+func (u BINodeVisLayoutStyle) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := u.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "none":
+		return u.AsBINodeVisNoneStyle()
+	case "block":
+		return u.AsBINodeVisBlockStyle()
+	case "hierarchy":
+		return u.AsBINodeVisHierarchyStyle()
+	case "radial":
+		return u.AsBINodeVisRadialStyle()
+	case "force":
+		return u.AsBINodeVisForceStyle()
+	}
+	return nil, fmt.Errorf("BINodeVisLayoutStyle: unknown type %q", discriminator)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u BINodeVisLayoutStyle) MarshalJSON() ([]byte, error) {
+	if len(u.union) == 0 {
+		return []byte("null"), nil
+	}
+	return u.union, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting unknown "type" values.
+func (u *BINodeVisLayoutStyle) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		u.union = nil
+		return nil
+	}
+	discriminator, err := unionDiscriminator(data, "type")
+	if err != nil {
+		return fmt.Errorf("BINodeVisLayoutStyle: %w", err)
+	}
+	switch discriminator {
+	case "none", "block", "hierarchy", "radial", "force":
+	default:
+		return fmt.Errorf("BINodeVisLayoutStyle: unknown type %q", discriminator)
+	}
+	u.union = append(json.RawMessage(nil), data...)
+	return nil
 }
 
 // BINodeVisNoneStyle represents a CheckMK API type.
@@ -635,7 +1486,7 @@ type BINodeVisNoneStyle struct {
 
 // BINodeVisRadialStyle represents a CheckMK API type.
 type BINodeVisRadialStyle struct {
-	StyleConfig map[string]interface{} `json:"style_config"`
+	StyleConfig BINodeVisRadialStyleConfig `json:"style_config"`
 	Type interface{} `json:"type"`
 }
 
@@ -673,28 +1524,28 @@ type BIRuleComputationOptions struct {
 type BIRuleEndpoint struct {
 	// Nested dictionary
 	// Example: map[count:1 restrict_state:2 type:best]
-	AggregationFunction interface{} `json:"aggregation_function"`
+	AggregationFunction BIAggregationFunction `json:"aggregation_function"`
 	// Nested dictionary
 	// Example: map[disabled:false]
-	ComputationOptions interface{} `json:"computation_options"`
+	ComputationOptions BIRuleComputationOptions `json:"computation_options"`
 	// The unique rule id
 	// Example: rule1
 	Id string `json:"id"`
 	// Nested dictionary
 	// Example: map[style_config:map[] type:block]
-	NodeVisualization interface{} `json:"node_visualization"`
+	NodeVisualization BINodeVisLayoutStyle `json:"node_visualization"`
 	// A list of nodes for for this rule
 	// Example: []
-	Nodes []map[string]interface{} `json:"nodes"`
+	Nodes []BINodeGenerator `json:"nodes"`
 	// The identifier of the BI pack.
 	// Example: pack1
 	PackId string `json:"pack_id"`
 	// Nested dictionary
 	// Example: map[arguments:[foo bar]]
-	Params interface{} `json:"params"`
+	Params BIParams `json:"params"`
 	// Nested dictionary
 	// Example: map[comment: docu_url: icon: state_messages:map[] title:]
-	Properties interface{} `json:"properties"`
+	Properties BIRuleProperties `json:"properties"`
 }
 
 // BIRuleProperties represents a CheckMK API type.
@@ -706,13 +1557,133 @@ type BIRuleProperties struct {
 	Title string `json:"title"`
 }
 
-// BISearch represents a CheckMK API type.
+// BISearch is a union of BIEmptySearch, BIHostSearch, BIServiceSearch, BIFixedArgumentsSearch, selected by the "type" property.
 type BISearch struct {
+	union json.RawMessage
+}
+
+// Discriminator returns the value of the "type" property.
+func (u BISearch) Discriminator() (string, error) {
+	return unionDiscriminator(u.union, "type")
+}
+
+// AsBIEmptySearch returns the union data as a BIEmptySearch.
+func (u BISearch) AsBIEmptySearch() (BIEmptySearch, error) {
+	var v BIEmptySearch
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromBIEmptySearch sets the union to v, with "type" set to "empty".
+func (u *BISearch) FromBIEmptySearch(v BIEmptySearch) error {
+	data, err := unionFrom(v, "type", "empty")
+	if err != nil {
+		return fmt.Errorf("BISearch: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsBIHostSearch returns the union data as a BIHostSearch.
+func (u BISearch) AsBIHostSearch() (BIHostSearch, error) {
+	var v BIHostSearch
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromBIHostSearch sets the union to v, with "type" set to "host_search".
+func (u *BISearch) FromBIHostSearch(v BIHostSearch) error {
+	data, err := unionFrom(v, "type", "host_search")
+	if err != nil {
+		return fmt.Errorf("BISearch: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsBIServiceSearch returns the union data as a BIServiceSearch.
+func (u BISearch) AsBIServiceSearch() (BIServiceSearch, error) {
+	var v BIServiceSearch
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromBIServiceSearch sets the union to v, with "type" set to "service_search".
+func (u *BISearch) FromBIServiceSearch(v BIServiceSearch) error {
+	data, err := unionFrom(v, "type", "service_search")
+	if err != nil {
+		return fmt.Errorf("BISearch: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsBIFixedArgumentsSearch returns the union data as a BIFixedArgumentsSearch.
+func (u BISearch) AsBIFixedArgumentsSearch() (BIFixedArgumentsSearch, error) {
+	var v BIFixedArgumentsSearch
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromBIFixedArgumentsSearch sets the union to v, with "type" set to "fixed_arguments".
+func (u *BISearch) FromBIFixedArgumentsSearch(v BIFixedArgumentsSearch) error {
+	data, err := unionFrom(v, "type", "fixed_arguments")
+	if err != nil {
+		return fmt.Errorf("BISearch: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// ValueByDiscriminator decodes the union into the member selected by "type".
+func (u BISearch) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := u.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "empty":
+		return u.AsBIEmptySearch()
+	case "host_search":
+		return u.AsBIHostSearch()
+	case "service_search":
+		return u.AsBIServiceSearch()
+	case "fixed_arguments":
+		return u.AsBIFixedArgumentsSearch()
+	}
+	return nil, fmt.Errorf("BISearch: unknown type %q", discriminator)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u BISearch) MarshalJSON() ([]byte, error) {
+	if len(u.union) == 0 {
+		return []byte("null"), nil
+	}
+	return u.union, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting unknown "type" values.
+func (u *BISearch) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		u.union = nil
+		return nil
+	}
+	discriminator, err := unionDiscriminator(data, "type")
+	if err != nil {
+		return fmt.Errorf("BISearch: %w", err)
+	}
+	switch discriminator {
+	case "empty", "host_search", "service_search", "fixed_arguments":
+	default:
+		return fmt.Errorf("BISearch: unknown type %q", discriminator)
+	}
+	u.union = append(json.RawMessage(nil), data...)
+	return nil
 }
 
 // BIServiceSearch represents a CheckMK API type.
 type BIServiceSearch struct {
-	Conditions map[string]interface{} `json:"conditions"`
+	Conditions ServiceConditions `json:"conditions"`
 	Type interface{} `json:"type"`
 }
 
@@ -742,7 +1713,7 @@ type BackgroundJobStatus struct {
 	Active bool `json:"active"`
 	// Logs related to the background job.
 	// Example: map[progress:[progress1] result:[result1]]
-	Logs interface{} `json:"logs"`
+	Logs JobLogs `json:"logs"`
 	// This field indicates the current state of the background job.
 	// Example: initialized
 	State BackgroundJobStatusState `json:"state"`
@@ -752,22 +1723,22 @@ type BackgroundJobStatus struct {
 type BaseUserAttributes struct {
 	// Enforce password change attribute for the user
 	// Example: map[auth_type:password enforce_password_change:false]
-	AuthOption interface{} `json:"auth_option,omitempty"`
+	AuthOption *AuthOption1 `json:"auth_option,omitempty"`
 	// The names of the sites that this user is authorized to handle
 	AuthorizedSites []string `json:"authorized_sites,omitempty"`
 	// Contact settings for the user
-	ContactOptions interface{} `json:"contact_options,omitempty"`
+	ContactOptions *ConcreteUserContactOption `json:"contact_options,omitempty"`
 	// The contact groups that this user is a member of
 	Contactgroups []string `json:"contactgroups,omitempty"`
 	// This field indicates if the user is allowed to login to the monitoring.
 	DisableLogin bool `json:"disable_login,omitempty"`
-	DisableNotifications map[string]interface{} `json:"disable_notifications,omitempty"`
+	DisableNotifications *ConcreteDisabledNotifications `json:"disable_notifications,omitempty"`
 	// The alias or full name of the user.
 	Fullname string `json:"fullname"`
 	// Idle timeout for the user. Per default, the global configuration is used.
 	// Example: map[option:global]
-	IdleTimeout interface{} `json:"idle_timeout,omitempty"`
-	InterfaceOptions map[string]interface{} `json:"interface_options,omitempty"`
+	IdleTimeout *UserIdleOption `json:"idle_timeout,omitempty"`
+	InterfaceOptions *ConcreteUserInterfaceAttributes `json:"interface_options,omitempty"`
 	// The language used by the user in the user interface
 	Language string `json:"language,omitempty"`
 	PagerAddress string `json:"pager_address,omitempty"`
@@ -822,7 +1793,7 @@ type BinaryExpr struct {
 type BulkCreateHost struct {
 	// A list of host entries.
 	// Example: [map[attributes:map[] folder:/ host_name:example.com]]
-	Entries []map[string]interface{} `json:"entries"`
+	Entries []CreateHost `json:"entries"`
 }
 
 // BulkDeleteContactGroup represents a CheckMK API type.
@@ -878,7 +1849,7 @@ type BulkHostActionWithFailedHosts struct {
 	// Example: The resource could not be found.
 	Detail string `json:"detail"`
 	// Details for which hosts have failed
-	Ext interface{} `json:"ext,omitempty"`
+	Ext *FailedHosts `json:"ext,omitempty"`
 	// Detailed error messages on all fields failing validation.
 	Fields map[string]interface{} `json:"fields,omitempty"`
 	// The HTTP status code.
@@ -893,25 +1864,107 @@ type BulkHostActionWithFailedHosts struct {
 type BulkInputContactGroup struct {
 	// A collection of contact group entries.
 	// Example: [map[alias:Not on Sundays name:OnCall]]
-	Entries []map[string]interface{} `json:"entries"`
+	Entries []InputContactGroup `json:"entries"`
 }
 
 // BulkInputHostGroup represents a CheckMK API type.
 type BulkInputHostGroup struct {
 	// A list of host group entries.
 	// Example: [map[alias:Windows Servers name:windows]]
-	Entries []map[string]interface{} `json:"entries"`
+	Entries []InputHostGroup `json:"entries"`
 }
 
 // BulkInputServiceGroup represents a CheckMK API type.
 type BulkInputServiceGroup struct {
 	// A list of service group entries.
 	// Example: [map[alias:Environment Sensors name:environment]]
-	Entries []map[string]interface{} `json:"entries"`
+	Entries []InputServiceGroup `json:"entries"`
 }
 
-// BulkNotificationsOneOf represents a CheckMK API type.
+// BulkNotificationsOneOf is a union of Checkbox, BulkNotificationsWithGraphs, selected by the "state" property.
 type BulkNotificationsOneOf struct {
+	union json.RawMessage
+}
+
+// Discriminator returns the value of the "state" property.
+func (u BulkNotificationsOneOf) Discriminator() (string, error) {
+	return unionDiscriminator(u.union, "state")
+}
+
+// AsCheckbox returns the union data as a Checkbox.
+func (u BulkNotificationsOneOf) AsCheckbox() (Checkbox, error) {
+	var v Checkbox
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromCheckbox sets the union to v, with "state" set to "disabled".
+func (u *BulkNotificationsOneOf) FromCheckbox(v Checkbox) error {
+	data, err := unionFrom(v, "state", "disabled")
+	if err != nil {
+		return fmt.Errorf("BulkNotificationsOneOf: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsBulkNotificationsWithGraphs returns the union data as a BulkNotificationsWithGraphs.
+func (u BulkNotificationsOneOf) AsBulkNotificationsWithGraphs() (BulkNotificationsWithGraphs, error) {
+	var v BulkNotificationsWithGraphs
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromBulkNotificationsWithGraphs sets the union to v, with "state" set to "enabled".
+func (u *BulkNotificationsOneOf) FromBulkNotificationsWithGraphs(v BulkNotificationsWithGraphs) error {
+	data, err := unionFrom(v, "state", "enabled")
+	if err != nil {
+		return fmt.Errorf("BulkNotificationsOneOf: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// ValueByDiscriminator decodes the union into the member selected by "state".
+func (u BulkNotificationsOneOf) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := u.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "disabled":
+		return u.AsCheckbox()
+	case "enabled":
+		return u.AsBulkNotificationsWithGraphs()
+	}
+	return nil, fmt.Errorf("BulkNotificationsOneOf: unknown state %q", discriminator)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u BulkNotificationsOneOf) MarshalJSON() ([]byte, error) {
+	if len(u.union) == 0 {
+		return []byte("null"), nil
+	}
+	return u.union, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting unknown "state" values.
+func (u *BulkNotificationsOneOf) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		u.union = nil
+		return nil
+	}
+	discriminator, err := unionDiscriminator(data, "state")
+	if err != nil {
+		return fmt.Errorf("BulkNotificationsOneOf: %w", err)
+	}
+	switch discriminator {
+	case "disabled", "enabled":
+	default:
+		return fmt.Errorf("BulkNotificationsOneOf: unknown state %q", discriminator)
+	}
+	u.union = append(json.RawMessage(nil), data...)
+	return nil
 }
 
 // BulkNotificationsWithGraphs represents a CheckMK API type.
@@ -929,52 +1982,52 @@ type BulkOutsideTimePeriodValue struct {
 	// To enable or disable this field
 	// Example: enabled
 	State BulkOutsideTimePeriodValueState `json:"state"`
-	Value map[string]interface{} `json:"value,omitempty"`
+	Value *NotificationBulkingCommonAttributes `json:"value,omitempty"`
 }
 
 // BulkUpdateContactGroup represents a CheckMK API type.
 type BulkUpdateContactGroup struct {
 	// A list of contact group entries.
 	// Example: [map[attributes:map[alias:Not on Sundays] name:OnCall]]
-	Entries []map[string]interface{} `json:"entries"`
+	Entries []UpdateContactGroup `json:"entries"`
 }
 
 // BulkUpdateFolder represents a CheckMK API type.
 type BulkUpdateFolder struct {
 	// A list of folder entries.
 	// Example: [map[remove_attributes:[tag_foobar]]]
-	Entries []map[string]interface{} `json:"entries"`
+	Entries []UpdateFolderEntry `json:"entries"`
 }
 
 // BulkUpdateHost represents a CheckMK API type.
 type BulkUpdateHost struct {
 	// A list of host entries.
 	// Example: [map[attributes:map[] host_name:example.com]]
-	Entries []map[string]interface{} `json:"entries"`
+	Entries []UpdateHostEntry `json:"entries"`
 }
 
 // BulkUpdateHostGroup represents a CheckMK API type.
 type BulkUpdateHostGroup struct {
 	// A list of host group entries.
 	// Example: [map[attributes:map[alias:Windows Servers] name:windows]]
-	Entries []map[string]interface{} `json:"entries"`
+	Entries []UpdateHostGroup `json:"entries"`
 }
 
 // BulkUpdateServiceGroup represents a CheckMK API type.
 type BulkUpdateServiceGroup struct {
 	// A list of service group entries.
 	// Example: [map[attributes:map[alias:Windows Servers] name:windows]]
-	Entries []map[string]interface{} `json:"entries"`
+	Entries []UpdateServiceGroup `json:"entries"`
 }
 
 // CaseParams represents a CheckMK API type.
 type CaseParams struct {
-	HostDescription map[string]interface{} `json:"host_description,omitempty"`
-	HostShortDescription map[string]interface{} `json:"host_short_description,omitempty"`
-	Priority map[string]interface{} `json:"priority,omitempty"`
-	ServiceDescription map[string]interface{} `json:"service_description,omitempty"`
-	ServiceShortDescription map[string]interface{} `json:"service_short_description,omitempty"`
-	StateRecovery map[string]interface{} `json:"state_recovery,omitempty"`
+	HostDescription *StrValueOneOf `json:"host_description,omitempty"`
+	HostShortDescription *StrValueOneOf `json:"host_short_description,omitempty"`
+	Priority *PriorityOneOf `json:"priority,omitempty"`
+	ServiceDescription *StrValueOneOf `json:"service_description,omitempty"`
+	ServiceShortDescription *StrValueOneOf `json:"service_short_description,omitempty"`
+	StateRecovery *StateRecoveryOneOf `json:"state_recovery,omitempty"`
 }
 
 // ChangeEventState represents a CheckMK API type.
@@ -987,8 +2040,90 @@ type ChangeEventState struct {
 	SiteId string `json:"site_id"`
 }
 
-// ChangeEventStateSelector represents a CheckMK API type.
+// ChangeEventStateSelector is a union of ChangeStateWithQuery, ChangeStateWithParams, selected by the "filter_type" property.
 type ChangeEventStateSelector struct {
+	union json.RawMessage
+}
+
+// Discriminator returns the value of the "filter_type" property.
+func (u ChangeEventStateSelector) Discriminator() (string, error) {
+	return unionDiscriminator(u.union, "filter_type")
+}
+
+// AsChangeStateWithQuery returns the union data as a ChangeStateWithQuery.
+func (u ChangeEventStateSelector) AsChangeStateWithQuery() (ChangeStateWithQuery, error) {
+	var v ChangeStateWithQuery
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromChangeStateWithQuery sets the union to v, with "filter_type" set to "query".
+func (u *ChangeEventStateSelector) FromChangeStateWithQuery(v ChangeStateWithQuery) error {
+	data, err := unionFrom(v, "filter_type", "query")
+	if err != nil {
+		return fmt.Errorf("ChangeEventStateSelector: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsChangeStateWithParams returns the union data as a ChangeStateWithParams.
+func (u ChangeEventStateSelector) AsChangeStateWithParams() (ChangeStateWithParams, error) {
+	var v ChangeStateWithParams
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromChangeStateWithParams sets the union to v, with "filter_type" set to "params".
+func (u *ChangeEventStateSelector) FromChangeStateWithParams(v ChangeStateWithParams) error {
+	data, err := unionFrom(v, "filter_type", "params")
+	if err != nil {
+		return fmt.Errorf("ChangeEventStateSelector: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// ValueByDiscriminator decodes the union into the member selected by "filter_type".
+func (u ChangeEventStateSelector) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := u.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "query":
+		return u.AsChangeStateWithQuery()
+	case "params":
+		return u.AsChangeStateWithParams()
+	}
+	return nil, fmt.Errorf("ChangeEventStateSelector: unknown filter_type %q", discriminator)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u ChangeEventStateSelector) MarshalJSON() ([]byte, error) {
+	if len(u.union) == 0 {
+		return []byte("null"), nil
+	}
+	return u.union, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting unknown "filter_type" values.
+func (u *ChangeEventStateSelector) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		u.union = nil
+		return nil
+	}
+	discriminator, err := unionDiscriminator(data, "filter_type")
+	if err != nil {
+		return fmt.Errorf("ChangeEventStateSelector: %w", err)
+	}
+	switch discriminator {
+	case "query", "params":
+	default:
+		return fmt.Errorf("ChangeEventStateSelector: unknown filter_type %q", discriminator)
+	}
+	u.union = append(json.RawMessage(nil), data...)
+	return nil
 }
 
 // ChangeStateWithParams represents a CheckMK API type.
@@ -996,7 +2131,7 @@ type ChangeStateWithParams struct {
 	// The way you would like to filter events.
 	// Example: params
 	FilterType ChangeStateWithParamsFilterType `json:"filter_type"`
-	Filters map[string]interface{} `json:"filters"`
+	Filters FilterParams `json:"filters"`
 	// The state
 	// Example: ok
 	NewState ChangeStateWithParamsNewState `json:"new_state"`
@@ -1015,7 +2150,7 @@ type ChangeStateWithQuery struct {
 	NewState ChangeStateWithQueryNewState `json:"new_state"`
 	// An query expression of the Livestatus 'eventconsoleevents' table in nested dictionary form. If you want to use multiple expressions, nest them with the AND/OR operators.
 	// Example: {"op": "=", "left": "eventconsoleevents.event_host", "right": "test_host"}
-	Query interface{} `json:"query"`
+	Query Expr `json:"query"`
 	// An existing site id
 	// Example: heute
 	SiteId string `json:"site_id,omitempty"`
@@ -1074,7 +2209,7 @@ type CheckMKURLPrefixValue struct {
 	// Example: enabled
 	State CheckMKURLPrefixValueState `json:"state"`
 	// If you use Automatic HTTP/s, the URL prefix for host and service links within the notification is filled automatically. If you specify an URL prefix here, then several parts of the notification are armed with hyperlinks to your Check_MK GUI. In both cases, the recipient of the notification can di...
-	Value interface{} `json:"value,omitempty"`
+	Value *ManualOrAutomaticSelector `json:"value,omitempty"`
 }
 
 // Checkbox represents a CheckMK API type.
@@ -1090,7 +2225,7 @@ type CheckboxEventConsoleAlerts struct {
 	// Example: enabled
 	State CheckboxEventConsoleAlertsState `json:"state"`
 	// The Event Console can have events create notifications in Check_MK. These notifications will be processed by the rule based notification system of Check_MK. This matching option helps you distinguishing and also gives you access to special event fields
-	Value interface{} `json:"value"`
+	Value MatchTypeSelector `json:"value"`
 }
 
 // CheckboxHostEventType represents a CheckMK API type.
@@ -1099,7 +2234,7 @@ type CheckboxHostEventType struct {
 	// Example: enabled
 	State CheckboxHostEventTypeState `json:"state"`
 	// Select the host event types and transitions this rule should handle. Note: If you activate this option and do not also specify service event types then this rule will never hold for service notifications! Note: You can only match on event types created by the core.
-	Value interface{} `json:"value"`
+	Value HostEventType `json:"value"`
 }
 
 // CheckboxLabel represents a CheckMK API type.
@@ -1115,7 +2250,7 @@ type CheckboxMatchHostTags struct {
 	State CheckboxMatchHostTagsState `json:"state"`
 	// A list of tag groups or aux tags with conditions
 	// Example: [map[operator:is tag_group_id:agent tag_id:checkmk-agent tag_type:tag_group] map[operator:is_set tag_id:snmp tag_type:aux_tag]]
-	Value []map[string]interface{} `json:"value"`
+	Value []TagTypeSelector `json:"value"`
 }
 
 // CheckboxMatchHostTags1 represents a CheckMK API type.
@@ -1123,7 +2258,7 @@ type CheckboxMatchHostTags1 struct {
 	// To enable or disable this field
 	// Example: enabled
 	State CheckboxMatchHostTags1State `json:"state"`
-	Value []map[string]interface{} `json:"value,omitempty"`
+	Value []MatchHostTags `json:"value,omitempty"`
 }
 
 // CheckboxOpsGeniePriorityValue represents a CheckMK API type.
@@ -1139,7 +2274,7 @@ type CheckboxRestrictNotificationNumbers struct {
 	// To enable or disable this field
 	// Example: enabled
 	State CheckboxRestrictNotificationNumbersState `json:"state"`
-	Value map[string]interface{} `json:"value"`
+	Value FromToNotificationNumbers `json:"value"`
 }
 
 // CheckboxServiceEventType represents a CheckMK API type.
@@ -1148,7 +2283,7 @@ type CheckboxServiceEventType struct {
 	// Example: enabled
 	State CheckboxServiceEventTypeState `json:"state"`
 	// Select the service event types and transitions this rule should handle. Note: If you activate this option and do not also specify host event types then this rule will never hold for host notifications! Note: You can only match on event types created by the core
-	Value interface{} `json:"value"`
+	Value ServiceEventType `json:"value"`
 }
 
 // CheckboxSortOrderValue represents a CheckMK API type.
@@ -1174,7 +2309,7 @@ type CheckboxThrottlePeriodicNotifcations struct {
 	// To enable or disable this field
 	// Example: enabled
 	State CheckboxThrottlePeriodicNotifcationsState `json:"state"`
-	Value map[string]interface{} `json:"value"`
+	Value ThrottlePeriodicNotifications `json:"value"`
 }
 
 // CheckboxWithFolderStr represents a CheckMK API type.
@@ -1192,7 +2327,7 @@ type CheckboxWithFromToServiceLevels struct {
 	// Example: enabled
 	State CheckboxWithFromToServiceLevelsState `json:"state"`
 	// Host or service must be in the following service level to get notification
-	Value interface{} `json:"value"`
+	Value FromToServiceLevels `json:"value"`
 }
 
 // CheckboxWithListOfCheckTypes represents a CheckMK API type.
@@ -1257,7 +2392,7 @@ type CheckboxWithListOfLabels struct {
 	// Example: enabled
 	State CheckboxWithListOfLabelsState `json:"state"`
 	// A list of key, value label pairs
-	Value []map[string]interface{} `json:"value"`
+	Value []CheckboxLabel `json:"value"`
 }
 
 // CheckboxWithListOfRuleIds represents a CheckMK API type.
@@ -1282,7 +2417,7 @@ type CheckboxWithListOfServiceGroupsRegex struct {
 	// Example: enabled
 	State CheckboxWithListOfServiceGroupsRegexState `json:"state"`
 	// The service group alias must not match one of the following regular expressions. For host events this condition is simply ignored. The text entered here is handled as a regular expression pattern. The pattern is applied as infix search. Add a leading ^ to make it match from the beginning and/or a...
-	Value interface{} `json:"value"`
+	Value ServiceGroupsRegex `json:"value"`
 }
 
 // CheckboxWithListOfSites represents a CheckMK API type.
@@ -1308,7 +2443,7 @@ type CheckboxWithManagementTypeStateCaseValues struct {
 	// To enable or disable this field
 	// Example: enabled
 	State CheckboxWithManagementTypeStateCaseValuesState `json:"state"`
-	Value map[string]interface{} `json:"value,omitempty"`
+	Value *ManagementTypeCaseStates `json:"value,omitempty"`
 }
 
 // CheckboxWithManagementTypeStateIncedentValues represents a CheckMK API type.
@@ -1316,7 +2451,7 @@ type CheckboxWithManagementTypeStateIncedentValues struct {
 	// To enable or disable this field
 	// Example: enabled
 	State CheckboxWithManagementTypeStateIncedentValuesState `json:"state"`
-	Value map[string]interface{} `json:"value,omitempty"`
+	Value *ManagementTypeIncedentStates `json:"value,omitempty"`
 }
 
 // CheckboxWithMgmtTypePriorityValue represents a CheckMK API type.
@@ -1356,7 +2491,7 @@ type CheckboxWithSysLogPriority struct {
 	// To enable or disable this field
 	// Example: enabled
 	State CheckboxWithSysLogPriorityState `json:"state"`
-	Value map[string]interface{} `json:"value,omitempty"`
+	Value *SysLogToFromPriorities `json:"value,omitempty"`
 }
 
 // CheckboxWithTimePeriod represents a CheckMK API type.
@@ -1376,8 +2511,8 @@ type Child struct {
 
 // ChildWith represents a CheckMK API type.
 type ChildWith struct {
-	Conditions map[string]interface{} `json:"conditions"`
-	HostChoice interface{} `json:"host_choice"`
+	Conditions HostConditions `json:"conditions"`
+	HostChoice BIHostChoice `json:"host_choice"`
 }
 
 // CiscoExplicitWebhookUrl represents a CheckMK API type.
@@ -1394,21 +2529,103 @@ type CiscoPasswordStore struct {
 	StoreId string `json:"store_id"`
 }
 
-// CiscoUrlOrStoreSelector represents a CheckMK API type.
+// CiscoUrlOrStoreSelector is a union of CiscoExplicitWebhookUrl, CiscoPasswordStore, selected by the "option" property.
 type CiscoUrlOrStoreSelector struct {
+	union json.RawMessage
+}
+
+// Discriminator returns the value of the "option" property.
+func (u CiscoUrlOrStoreSelector) Discriminator() (string, error) {
+	return unionDiscriminator(u.union, "option")
+}
+
+// AsCiscoExplicitWebhookUrl returns the union data as a CiscoExplicitWebhookUrl.
+func (u CiscoUrlOrStoreSelector) AsCiscoExplicitWebhookUrl() (CiscoExplicitWebhookUrl, error) {
+	var v CiscoExplicitWebhookUrl
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromCiscoExplicitWebhookUrl sets the union to v, with "option" set to "explicit".
+func (u *CiscoUrlOrStoreSelector) FromCiscoExplicitWebhookUrl(v CiscoExplicitWebhookUrl) error {
+	data, err := unionFrom(v, "option", "explicit")
+	if err != nil {
+		return fmt.Errorf("CiscoUrlOrStoreSelector: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsCiscoPasswordStore returns the union data as a CiscoPasswordStore.
+func (u CiscoUrlOrStoreSelector) AsCiscoPasswordStore() (CiscoPasswordStore, error) {
+	var v CiscoPasswordStore
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromCiscoPasswordStore sets the union to v, with "option" set to "store".
+func (u *CiscoUrlOrStoreSelector) FromCiscoPasswordStore(v CiscoPasswordStore) error {
+	data, err := unionFrom(v, "option", "store")
+	if err != nil {
+		return fmt.Errorf("CiscoUrlOrStoreSelector: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// ValueByDiscriminator decodes the union into the member selected by "option".
+func (u CiscoUrlOrStoreSelector) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := u.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "explicit":
+		return u.AsCiscoExplicitWebhookUrl()
+	case "store":
+		return u.AsCiscoPasswordStore()
+	}
+	return nil, fmt.Errorf("CiscoUrlOrStoreSelector: unknown option %q", discriminator)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u CiscoUrlOrStoreSelector) MarshalJSON() ([]byte, error) {
+	if len(u.union) == 0 {
+		return []byte("null"), nil
+	}
+	return u.union, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting unknown "option" values.
+func (u *CiscoUrlOrStoreSelector) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		u.union = nil
+		return nil
+	}
+	discriminator, err := unionDiscriminator(data, "option")
+	if err != nil {
+		return fmt.Errorf("CiscoUrlOrStoreSelector: %w", err)
+	}
+	switch discriminator {
+	case "explicit", "store":
+	default:
+		return fmt.Errorf("CiscoUrlOrStoreSelector: unknown option %q", discriminator)
+	}
+	u.union = append(json.RawMessage(nil), data...)
+	return nil
 }
 
 // CiscoWebexPluginCreate represents a CheckMK API type.
 type CiscoWebexPluginCreate struct {
 	// Ignore unverified HTTPS request warnings. Use with caution.
-	DisableSslCertVerification interface{} `json:"disable_ssl_cert_verification"`
+	DisableSslCertVerification Checkbox `json:"disable_ssl_cert_verification"`
 	// Use the proxy settings from the environment variables. The variables NO_PROXY, HTTP_PROXY and HTTPS_PROXY are taken into account during execution.
-	HttpProxy interface{} `json:"http_proxy"`
+	HttpProxy HttpProxyOneOf `json:"http_proxy"`
 	// The plugin name.
 	// Example: mail
 	PluginName CiscoWebexPluginCreatePluginName `json:"plugin_name"`
-	UrlPrefixForLinksToCheckmk map[string]interface{} `json:"url_prefix_for_links_to_checkmk"`
-	WebhookUrl map[string]interface{} `json:"webhook_url,omitempty"`
+	UrlPrefixForLinksToCheckmk UrlPrefixOneOf `json:"url_prefix_for_links_to_checkmk"`
+	WebhookUrl *CiscoUrlOrStoreSelector `json:"webhook_url,omitempty"`
 }
 
 // ClusterCreateAttribute represents a CheckMK API type.
@@ -1420,7 +2637,7 @@ type ClusterCreateAttribute struct {
 	// Add a comment or describe this host
 	Alias string `json:"alias,omitempty"`
 	// Only members of the contact groups listed here have Setup permission for the host/folder. Optionally, you can make these contact groups automatically monitor contacts. The assignment of hosts to contact groups can also be defined by <a href='wato.py?mode=edit_ruleset&varname=host_contactgroups'>r...
-	Contactgroups interface{} `json:"contactgroups,omitempty"`
+	Contactgroups *HostContactGroup `json:"contactgroups,omitempty"`
 	// Whether or not the last bulk discovery failed. It is set to True once it fails and unset in case a later discovery succeeds.
 	// Example: false
 	InventoryFailed bool `json:"inventory_failed,omitempty"`
@@ -1433,23 +2650,23 @@ type ClusterCreateAttribute struct {
 	// Name of host attributes which are locked in the UI.
 	LockedAttributes []string `json:"locked_attributes,omitempty"`
 	// Identity of the entity which locked the locked_attributes. The identity is built out of the Site ID, the program name and the connection ID.
-	LockedBy interface{} `json:"locked_by,omitempty"`
+	LockedBy *LockedBy `json:"locked_by,omitempty"`
 	// Address (IPv4, IPv6 or hostname) under which the management board can be reached.
 	ManagementAddress string `json:"management_address,omitempty"`
 	// IPMI credentials
-	ManagementIpmiCredentials interface{} `json:"management_ipmi_credentials,omitempty"`
+	ManagementIpmiCredentials *IPMIParameters `json:"management_ipmi_credentials,omitempty"`
 	// The protocol used to connect to the management board. Valid options are: * `none` - No management board * `snmp` - Connect using SNMP * `ipmi` - Connect using IPMI
 	ManagementProtocol ClusterCreateAttributeManagementProtocol `json:"management_protocol,omitempty"`
 	// SNMP credentials
-	ManagementSnmpCommunity interface{} `json:"management_snmp_community,omitempty"`
+	ManagementSnmpCommunity *SNMPCredentials `json:"management_snmp_community,omitempty"`
 	// Configuration for automatic network scan. Pings will besent to each IP address in the configured ranges to checkif a host is up or down. Each found host will be added tothe folder by it's hostname (if possible) or IP address.
-	NetworkScan interface{} `json:"network_scan,omitempty"`
+	NetworkScan *NetworkScan `json:"network_scan,omitempty"`
 	// A list of parents of this host.
 	Parents []string `json:"parents,omitempty"`
 	// The site that should monitor this host.
 	Site string `json:"site,omitempty"`
 	// The SNMP access configuration. A configured SNMP v1/v2 community here will have precedence over any configured SNMP community rule. For this attribute to take effect, the attribute `tag_snmp_ds` needs to be set first.
-	SnmpCommunity interface{} `json:"snmp_community,omitempty"`
+	SnmpCommunity *SNMPCredentials `json:"snmp_community,omitempty"`
 	// Choices: * `"ip-v4-only"`: IPv4 only * `"ip-v6-only"`: IPv6 only * `"ip-v4v6"`: IPv4/IPv6 dual-stack * `"no-ip"`: No IP
 	TagAddressFamily ClusterCreateAttributeTagAddressFamily `json:"tag_address_family,omitempty"`
 	// Choices: * `"cmk-agent"`: API integrations if configured, else Checkmk agent * `"all-agents"`: Configured API integrations and Checkmk agent * `"special-agents"`: Configured API integrations, no Checkmk agent * `"no-agent"`: No API integrations, no Checkmk agent
@@ -1464,8 +2681,71 @@ type ClusterCreateAttribute struct {
 	TagSnmpDs ClusterCreateAttributeTagSnmpDs `json:"tag_snmp_ds,omitempty"`
 }
 
-// CollectionItem represents a CheckMK API type.
+// CollectionItem is a union of Link, selected by the "domainType" property.
 type CollectionItem struct {
+	union json.RawMessage
+}
+
+// Discriminator returns the value of the "domainType" property.
+func (u CollectionItem) Discriminator() (string, error) {
+	return unionDiscriminator(u.union, "domainType")
+}
+
+// AsLink returns the union data as a Link.
+func (u CollectionItem) AsLink() (Link, error) {
+	var v Link
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromLink sets the union to v, with "domainType" set to "link".
+func (u *CollectionItem) FromLink(v Link) error {
+	data, err := unionFrom(v, "domainType", "link")
+	if err != nil {
+		return fmt.Errorf("CollectionItem: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// ValueByDiscriminator decodes the union into the member selected by "domainType".
+func (u CollectionItem) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := u.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "link":
+		return u.AsLink()
+	}
+	return nil, fmt.Errorf("CollectionItem: unknown domainType %q", discriminator)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u CollectionItem) MarshalJSON() ([]byte, error) {
+	if len(u.union) == 0 {
+		return []byte("null"), nil
+	}
+	return u.union, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting unknown "domainType" values.
+func (u *CollectionItem) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		u.union = nil
+		return nil
+	}
+	discriminator, err := unionDiscriminator(data, "domainType")
+	if err != nil {
+		return fmt.Errorf("CollectionItem: %w", err)
+	}
+	switch discriminator {
+	case "link":
+	default:
+		return fmt.Errorf("CollectionItem: unknown domainType %q", discriminator)
+	}
+	u.union = append(json.RawMessage(nil), data...)
+	return nil
 }
 
 // CommentAttributes represents a CheckMK API type.
@@ -1500,11 +2780,11 @@ type CommentCollection struct {
 	// The name of this collection.
 	Id string `json:"id,omitempty"`
 	// list of links to other resources.
-	Links []map[string]interface{} `json:"links"`
+	Links []Link `json:"links"`
 	// A human readable title of this object. Can be used for user interfaces.
 	Title string `json:"title,omitempty"`
 	// A list of comment objects.
-	Value []map[string]interface{} `json:"value,omitempty"`
+	Value []CommentObject `json:"value,omitempty"`
 }
 
 // CommentObject represents a CheckMK API type.
@@ -1512,11 +2792,11 @@ type CommentObject struct {
 	// The domain type of the object.
 	DomainType interface{} `json:"domainType,omitempty"`
 	// The attributes of a service/host comment.
-	Extensions interface{} `json:"extensions,omitempty"`
+	Extensions *CommentAttributes `json:"extensions,omitempty"`
 	// The unique identifier for this domain-object type.
 	Id string `json:"id,omitempty"`
 	// list of links to other resources.
-	Links []map[string]interface{} `json:"links"`
+	Links []Link `json:"links"`
 	// The container for external resources, like linked foreign objects or actions.
 	Members map[string]interface{} `json:"members,omitempty"`
 	// A human readable title of this object. Can be used for user interfaces.
@@ -1528,7 +2808,7 @@ type ConcreteDisabledNotifications struct {
 	// Option if all notifications should be temporarily disabled
 	Disable bool `json:"disable,omitempty"`
 	// A custom timerange during which notifications are disabled
-	Timerange interface{} `json:"timerange,omitempty"`
+	Timerange *DateTimeRange `json:"timerange,omitempty"`
 }
 
 // ConcreteHostTagGroup represents a CheckMK API type.
@@ -1536,11 +2816,11 @@ type ConcreteHostTagGroup struct {
 	// The domain type of the object.
 	DomainType interface{} `json:"domainType"`
 	// Additional fields for objects of this type.
-	Extensions interface{} `json:"extensions,omitempty"`
+	Extensions *HostTagExtensions `json:"extensions,omitempty"`
 	// The unique identifier for this domain-object type.
 	Id string `json:"id,omitempty"`
 	// list of links to other resources.
-	Links []map[string]interface{} `json:"links"`
+	Links []Link `json:"links"`
 	// The container for external resources, like linked foreign objects or actions.
 	Members map[string]interface{} `json:"members,omitempty"`
 	// A human readable title of this object. Can be used for user interfaces.
@@ -1552,7 +2832,7 @@ type ConcreteTimePeriodException struct {
 	// The date of the time period exception.8601 profile
 	// Example: 2020-01-01
 	Date string `json:"date,omitempty"`
-	TimeRanges []map[string]interface{} `json:"time_ranges,omitempty"`
+	TimeRanges []ConcreteTimeRange `json:"time_ranges,omitempty"`
 }
 
 // ConcreteTimeRange represents a CheckMK API type.
@@ -1569,7 +2849,7 @@ type ConcreteTimeRange struct {
 type ConcreteTimeRangeActive struct {
 	// The day for which the time ranges are specified
 	Day ConcreteTimeRangeActiveDay `json:"day,omitempty"`
-	TimeRanges []map[string]interface{} `json:"time_ranges,omitempty"`
+	TimeRanges []ConcreteTimeRange `json:"time_ranges,omitempty"`
 }
 
 // ConcreteUserContactOption represents a CheckMK API type.
@@ -1602,31 +2882,31 @@ type ConcreteUserInterfaceAttributes struct {
 
 // ConditionsAttributes represents a CheckMK API type.
 type ConditionsAttributes struct {
-	EventConsoleAlerts map[string]interface{} `json:"event_console_alerts,omitempty"`
-	MatchCheckTypes map[string]interface{} `json:"match_check_types,omitempty"`
-	MatchContactGroups map[string]interface{} `json:"match_contact_groups,omitempty"`
-	MatchExcludeHosts map[string]interface{} `json:"match_exclude_hosts,omitempty"`
-	MatchExcludeServiceGroups map[string]interface{} `json:"match_exclude_service_groups,omitempty"`
-	MatchExcludeServiceGroupsRegex map[string]interface{} `json:"match_exclude_service_groups_regex,omitempty"`
-	MatchExcludeServices map[string]interface{} `json:"match_exclude_services,omitempty"`
-	MatchFolder map[string]interface{} `json:"match_folder,omitempty"`
-	MatchHostEventType map[string]interface{} `json:"match_host_event_type,omitempty"`
-	MatchHostGroups map[string]interface{} `json:"match_host_groups,omitempty"`
-	MatchHostLabels map[string]interface{} `json:"match_host_labels,omitempty"`
-	MatchHostTags map[string]interface{} `json:"match_host_tags,omitempty"`
-	MatchHosts map[string]interface{} `json:"match_hosts,omitempty"`
-	MatchNotificationComment map[string]interface{} `json:"match_notification_comment,omitempty"`
-	MatchOnlyDuringTimePeriod map[string]interface{} `json:"match_only_during_time_period,omitempty"`
-	MatchPluginOutput map[string]interface{} `json:"match_plugin_output,omitempty"`
-	MatchServiceEventType map[string]interface{} `json:"match_service_event_type,omitempty"`
-	MatchServiceGroups map[string]interface{} `json:"match_service_groups,omitempty"`
-	MatchServiceGroupsRegex map[string]interface{} `json:"match_service_groups_regex,omitempty"`
-	MatchServiceLabels map[string]interface{} `json:"match_service_labels,omitempty"`
-	MatchServiceLevels map[string]interface{} `json:"match_service_levels,omitempty"`
-	MatchServices map[string]interface{} `json:"match_services,omitempty"`
-	MatchSites map[string]interface{} `json:"match_sites,omitempty"`
-	RestrictToNotificationNumbers map[string]interface{} `json:"restrict_to_notification_numbers,omitempty"`
-	ThrottlePeriodicNotifications map[string]interface{} `json:"throttle_periodic_notifications,omitempty"`
+	EventConsoleAlerts *MatchEventConsoleAlertsResponse `json:"event_console_alerts,omitempty"`
+	MatchCheckTypes *CheckboxWithListOfStr `json:"match_check_types,omitempty"`
+	MatchContactGroups *CheckboxWithListOfStr `json:"match_contact_groups,omitempty"`
+	MatchExcludeHosts *CheckboxWithListOfStr `json:"match_exclude_hosts,omitempty"`
+	MatchExcludeServiceGroups *CheckboxWithListOfStr `json:"match_exclude_service_groups,omitempty"`
+	MatchExcludeServiceGroupsRegex *CheckboxWithListOfServiceGroupsRegex `json:"match_exclude_service_groups_regex,omitempty"`
+	MatchExcludeServices *CheckboxWithListOfStr `json:"match_exclude_services,omitempty"`
+	MatchFolder *CheckboxWithFolderStr `json:"match_folder,omitempty"`
+	MatchHostEventType *CheckboxHostEventType `json:"match_host_event_type,omitempty"`
+	MatchHostGroups *CheckboxWithListOfStr `json:"match_host_groups,omitempty"`
+	MatchHostLabels *CheckboxWithListOfLabels `json:"match_host_labels,omitempty"`
+	MatchHostTags *CheckboxMatchHostTags1 `json:"match_host_tags,omitempty"`
+	MatchHosts *CheckboxWithListOfStr `json:"match_hosts,omitempty"`
+	MatchNotificationComment *CheckboxWithStrValue `json:"match_notification_comment,omitempty"`
+	MatchOnlyDuringTimePeriod *CheckboxWithStrValue `json:"match_only_during_time_period,omitempty"`
+	MatchPluginOutput *CheckboxWithStrValue `json:"match_plugin_output,omitempty"`
+	MatchServiceEventType *CheckboxServiceEventType `json:"match_service_event_type,omitempty"`
+	MatchServiceGroups *CheckboxWithListOfStr `json:"match_service_groups,omitempty"`
+	MatchServiceGroupsRegex *CheckboxWithListOfServiceGroupsRegex `json:"match_service_groups_regex,omitempty"`
+	MatchServiceLabels *CheckboxWithListOfLabels `json:"match_service_labels,omitempty"`
+	MatchServiceLevels *CheckboxWithFromToServiceLevels `json:"match_service_levels,omitempty"`
+	MatchServices *CheckboxWithListOfStr `json:"match_services,omitempty"`
+	MatchSites *CheckboxWithListOfStr `json:"match_sites,omitempty"`
+	RestrictToNotificationNumbers *CheckboxRestrictNotificationNumbers `json:"restrict_to_notification_numbers,omitempty"`
+	ThrottlePeriodicNotifications *CheckboxThrottlePeriodicNotifcations `json:"throttle_periodic_notifications,omitempty"`
 }
 
 // ConfigurationConnectionAttributes represents a CheckMK API type.
@@ -1653,7 +2933,7 @@ type ConfigurationConnectionAttributes struct {
 	// Example: http://remote_site_1/check_mk/
 	UrlOfRemoteSite string `json:"url_of_remote_site"`
 	// By default the users are synchronized automatically in the interval configured in the connection. For example the LDAP connector synchronizes the users every five minutes by default. The interval can be changed for each connection individually in the connection settings. Please note that the sync...
-	UserSync interface{} `json:"user_sync"`
+	UserSync UserSyncAttributes `json:"user_sync"`
 }
 
 // ConfigurationConnectionAttributes1 represents a CheckMK API type.
@@ -1680,7 +2960,7 @@ type ConfigurationConnectionAttributes1 struct {
 	// Example: http://remote_site_1/check_mk/
 	UrlOfRemoteSite string `json:"url_of_remote_site,omitempty"`
 	// By default the users are synchronized automatically in the interval configured in the connection. For example the LDAP connector synchronizes the users every five minutes by default. The interval can be changed for each connection individually in the connection settings. Please note that the sync...
-	UserSync interface{} `json:"user_sync"`
+	UserSync UserSyncAttributes1 `json:"user_sync"`
 }
 
 // ConnectionMode represents a CheckMK API type.
@@ -1698,7 +2978,7 @@ type ContactGroup struct {
 	// The unique identifier for this domain-object type.
 	Id string `json:"id,omitempty"`
 	// list of links to other resources.
-	Links []map[string]interface{} `json:"links"`
+	Links []Link `json:"links"`
 	// The container for external resources, like linked foreign objects or actions.
 	Members map[string]interface{} `json:"members,omitempty"`
 	// A human readable title of this object. Can be used for user interfaces.
@@ -1714,35 +2994,35 @@ type ContactGroupCollection struct {
 	// The name of this collection.
 	Id string `json:"id,omitempty"`
 	// list of links to other resources.
-	Links []map[string]interface{} `json:"links"`
+	Links []Link `json:"links"`
 	// A human readable title of this object. Can be used for user interfaces.
 	Title string `json:"title,omitempty"`
 	// A list of contact group objects.
-	Value []map[string]interface{} `json:"value,omitempty"`
+	Value []ContactGroup `json:"value,omitempty"`
 }
 
 // ContactSelection represents a CheckMK API type.
 type ContactSelection struct {
-	AllContactsOfTheNotifiedObject map[string]interface{} `json:"all_contacts_of_the_notified_object"`
-	AllUsers map[string]interface{} `json:"all_users"`
-	AllUsersWithAnEmailAddress map[string]interface{} `json:"all_users_with_an_email_address"`
-	ExplicitEmailAddresses map[string]interface{} `json:"explicit_email_addresses"`
-	MembersOfContactGroups map[string]interface{} `json:"members_of_contact_groups"`
-	RestrictByContactGroups map[string]interface{} `json:"restrict_by_contact_groups"`
-	RestrictByCustomMacros map[string]interface{} `json:"restrict_by_custom_macros"`
-	TheFollowingUsers map[string]interface{} `json:"the_following_users"`
+	AllContactsOfTheNotifiedObject Checkbox `json:"all_contacts_of_the_notified_object"`
+	AllUsers Checkbox `json:"all_users"`
+	AllUsersWithAnEmailAddress Checkbox `json:"all_users_with_an_email_address"`
+	ExplicitEmailAddresses ExplicitEmailAddressesCheckbox `json:"explicit_email_addresses"`
+	MembersOfContactGroups ListOfContactGroupsCheckbox `json:"members_of_contact_groups"`
+	RestrictByContactGroups ListOfContactGroupsCheckbox `json:"restrict_by_contact_groups"`
+	RestrictByCustomMacros CustomMacrosCheckbox `json:"restrict_by_custom_macros"`
+	TheFollowingUsers TheFollowingUsers `json:"the_following_users"`
 }
 
 // ContactSelectionAttributes represents a CheckMK API type.
 type ContactSelectionAttributes struct {
-	AllContactsOfTheNotifiedObject map[string]interface{} `json:"all_contacts_of_the_notified_object,omitempty"`
-	AllUsers map[string]interface{} `json:"all_users,omitempty"`
-	AllUsersWithAnEmailAddress map[string]interface{} `json:"all_users_with_an_email_address,omitempty"`
-	ExplicitEmailAddresses map[string]interface{} `json:"explicit_email_addresses,omitempty"`
-	MembersOfContactGroups map[string]interface{} `json:"members_of_contact_groups,omitempty"`
-	RestrictByContactGroups map[string]interface{} `json:"restrict_by_contact_groups,omitempty"`
-	RestrictByCustomMacros map[string]interface{} `json:"restrict_by_custom_macros,omitempty"`
-	TheFollowingUsers map[string]interface{} `json:"the_following_users,omitempty"`
+	AllContactsOfTheNotifiedObject *Checkbox `json:"all_contacts_of_the_notified_object,omitempty"`
+	AllUsers *Checkbox `json:"all_users,omitempty"`
+	AllUsersWithAnEmailAddress *Checkbox `json:"all_users_with_an_email_address,omitempty"`
+	ExplicitEmailAddresses *CheckboxWithListOfStr `json:"explicit_email_addresses,omitempty"`
+	MembersOfContactGroups *CheckboxWithListOfStr `json:"members_of_contact_groups,omitempty"`
+	RestrictByContactGroups *CheckboxWithListOfStr `json:"restrict_by_contact_groups,omitempty"`
+	RestrictByCustomMacros *MatchCustomMacros `json:"restrict_by_custom_macros,omitempty"`
+	TheFollowingUsers *CheckboxWithListOfStr `json:"the_following_users,omitempty"`
 }
 
 // CreateClusterHost represents a CheckMK API type.
@@ -1865,7 +3145,7 @@ type CreateHostQueryComment struct {
 	Persistent bool `json:"persistent,omitempty"`
 	// An query expression of the Livestatus 'hosts' table in nested dictionary form. If you want to use multiple expressions, nest them with the AND/OR operators.
 	// Example: {"op": "and", "expr": [{"op": "=", "left": "name", "right": "example.com"}, {"op": "!=", "left": "state", "right": "0"}]}
-	Query interface{} `json:"query,omitempty"`
+	Query *Expr `json:"query,omitempty"`
 }
 
 // CreateHostQueryDowntime represents a CheckMK API type.
@@ -1882,7 +3162,7 @@ type CreateHostQueryDowntime struct {
 	EndTime string `json:"end_time"`
 	// An query expression of the Livestatus 'hosts' table in nested dictionary form. If you want to use multiple expressions, nest them with the AND/OR operators.
 	// Example: {"op": "and", "expr": [{"op": "=", "left": "name", "right": "example.com"}, {"op": "!=", "left": "state", "right": "0"}]}
-	Query interface{} `json:"query"`
+	Query Expr `json:"query"`
 	// The recurring mode of the new downtime. Available modes are: * fixed * hour * day * week * second_week * fourth_week * weekday_start * weekday_end * day_of_month This only works when using the Enterprise Editions. Defaults to 'fixed'.
 	// Example: hour
 	Recur CreateHostQueryDowntimeRecur `json:"recur,omitempty"`
@@ -1891,12 +3171,195 @@ type CreateHostQueryDowntime struct {
 	StartTime string `json:"start_time"`
 }
 
-// CreateHostRelatedComment represents a CheckMK API type.
+// CreateHostRelatedComment is a union of CreateHostComment, CreateHostQueryComment, selected by the "comment_type" property.
 type CreateHostRelatedComment struct {
-}
-
-// CreateHostRelatedDowntime represents a CheckMK API type.
+	union json.RawMessage
+}
+
+// Discriminator returns the value of the "comment_type" property.
+func (u CreateHostRelatedComment) Discriminator() (string, error) {
+	return unionDiscriminator(u.union, "comment_type")
+}
+
+// AsCreateHostComment returns the union data as a CreateHostComment.
+func (u CreateHostRelatedComment) AsCreateHostComment() (CreateHostComment, error) {
+	var v CreateHostComment
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromCreateHostComment sets the union to v, with "comment_type" set to "host".
+func (u *CreateHostRelatedComment) FromCreateHostComment(v CreateHostComment) error {
+	data, err := unionFrom(v, "comment_type", "host")
+	if err != nil {
+		return fmt.Errorf("CreateHostRelatedComment: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsCreateHostQueryComment returns the union data as a CreateHostQueryComment.
+func (u CreateHostRelatedComment) AsCreateHostQueryComment() (CreateHostQueryComment, error) {
+	var v CreateHostQueryComment
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromCreateHostQueryComment sets the union to v, with "comment_type" set to "host_by_query".
+func (u *CreateHostRelatedComment) FromCreateHostQueryComment(v CreateHostQueryComment) error {
+	data, err := unionFrom(v, "comment_type", "host_by_query")
+	if err != nil {
+		return fmt.Errorf("CreateHostRelatedComment: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// ValueByDiscriminator decodes the union into the member selected by "comment_type".
+func (u CreateHostRelatedComment) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := u.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "host":
+		return u.AsCreateHostComment()
+	case "host_by_query":
+		return u.AsCreateHostQueryComment()
+	}
+	return nil, fmt.Errorf("CreateHostRelatedComment: unknown comment_type %q", discriminator)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u CreateHostRelatedComment) MarshalJSON() ([]byte, error) {
+	if len(u.union) == 0 {
+		return []byte("null"), nil
+	}
+	return u.union, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting unknown "comment_type" values.
+func (u *CreateHostRelatedComment) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		u.union = nil
+		return nil
+	}
+	discriminator, err := unionDiscriminator(data, "comment_type")
+	if err != nil {
+		return fmt.Errorf("CreateHostRelatedComment: %w", err)
+	}
+	switch discriminator {
+	case "host", "host_by_query":
+	default:
+		return fmt.Errorf("CreateHostRelatedComment: unknown comment_type %q", discriminator)
+	}
+	u.union = append(json.RawMessage(nil), data...)
+	return nil
+}
+
+// CreateHostRelatedDowntime is a union of CreateHostDowntime, CreateHostGroupDowntime, CreateHostQueryDowntime, selected by the "downtime_type" property.
 type CreateHostRelatedDowntime struct {
+	union json.RawMessage
+}
+
+// Discriminator returns the value of the "downtime_type" property.
+func (u CreateHostRelatedDowntime) Discriminator() (string, error) {
+	return unionDiscriminator(u.union, "downtime_type")
+}
+
+// AsCreateHostDowntime returns the union data as a CreateHostDowntime.
+func (u CreateHostRelatedDowntime) AsCreateHostDowntime() (CreateHostDowntime, error) {
+	var v CreateHostDowntime
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromCreateHostDowntime sets the union to v, with "downtime_type" set to "host".
+func (u *CreateHostRelatedDowntime) FromCreateHostDowntime(v CreateHostDowntime) error {
+	data, err := unionFrom(v, "downtime_type", "host")
+	if err != nil {
+		return fmt.Errorf("CreateHostRelatedDowntime: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsCreateHostGroupDowntime returns the union data as a CreateHostGroupDowntime.
+func (u CreateHostRelatedDowntime) AsCreateHostGroupDowntime() (CreateHostGroupDowntime, error) {
+	var v CreateHostGroupDowntime
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromCreateHostGroupDowntime sets the union to v, with "downtime_type" set to "hostgroup".
+func (u *CreateHostRelatedDowntime) FromCreateHostGroupDowntime(v CreateHostGroupDowntime) error {
+	data, err := unionFrom(v, "downtime_type", "hostgroup")
+	if err != nil {
+		return fmt.Errorf("CreateHostRelatedDowntime: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsCreateHostQueryDowntime returns the union data as a CreateHostQueryDowntime.
+func (u CreateHostRelatedDowntime) AsCreateHostQueryDowntime() (CreateHostQueryDowntime, error) {
+	var v CreateHostQueryDowntime
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromCreateHostQueryDowntime sets the union to v, with "downtime_type" set to "host_by_query".
+func (u *CreateHostRelatedDowntime) FromCreateHostQueryDowntime(v CreateHostQueryDowntime) error {
+	data, err := unionFrom(v, "downtime_type", "host_by_query")
+	if err != nil {
+		return fmt.Errorf("CreateHostRelatedDowntime: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// ValueByDiscriminator decodes the union into the member selected by "downtime_type".
+func (u CreateHostRelatedDowntime) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := u.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "host":
+		return u.AsCreateHostDowntime()
+	case "hostgroup":
+		return u.AsCreateHostGroupDowntime()
+	case "host_by_query":
+		return u.AsCreateHostQueryDowntime()
+	}
+	return nil, fmt.Errorf("CreateHostRelatedDowntime: unknown downtime_type %q", discriminator)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u CreateHostRelatedDowntime) MarshalJSON() ([]byte, error) {
+	if len(u.union) == 0 {
+		return []byte("null"), nil
+	}
+	return u.union, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting unknown "downtime_type" values.
+func (u *CreateHostRelatedDowntime) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		u.union = nil
+		return nil
+	}
+	discriminator, err := unionDiscriminator(data, "downtime_type")
+	if err != nil {
+		return fmt.Errorf("CreateHostRelatedDowntime: %w", err)
+	}
+	switch discriminator {
+	case "host", "hostgroup", "host_by_query":
+	default:
+		return fmt.Errorf("CreateHostRelatedDowntime: unknown downtime_type %q", discriminator)
+	}
+	u.union = append(json.RawMessage(nil), data...)
+	return nil
 }
 
 // CreateServiceComment represents a CheckMK API type.
@@ -1978,7 +3441,7 @@ type CreateServiceQueryComment struct {
 	Persistent bool `json:"persistent,omitempty"`
 	// An query expression of the Livestatus 'services' table in nested dictionary form. If you want to use multiple expressions, nest them with the AND/OR operators.
 	// Example: {"op": "and", "expr": [{"op": "=", "left": "name", "right": "example.com"}, {"op": "!=", "left": "state", "right": "0"}]}
-	Query interface{} `json:"query"`
+	Query Expr `json:"query"`
 }
 
 // CreateServiceQueryDowntime represents a CheckMK API type.
@@ -1995,7 +3458,7 @@ type CreateServiceQueryDowntime struct {
 	EndTime string `json:"end_time"`
 	// An query expression of the Livestatus 'services' table in nested dictionary form. If you want to use multiple expressions, nest them with the AND/OR operators.
 	// Example: {"op": "and", "expr": [{"op": "=", "left": "name", "right": "example.com"}, {"op": "!=", "left": "state", "right": "0"}]}
-	Query interface{} `json:"query"`
+	Query Expr `json:"query"`
 	// The recurring mode of the new downtime. Available modes are: * fixed * hour * day * week * second_week * fourth_week * weekday_start * weekday_end * day_of_month This only works when using the Enterprise Editions. Defaults to 'fixed'.
 	// Example: hour
 	Recur CreateServiceQueryDowntimeRecur `json:"recur,omitempty"`
@@ -2004,25 +3467,208 @@ type CreateServiceQueryDowntime struct {
 	StartTime string `json:"start_time"`
 }
 
-// CreateServiceRelatedComment represents a CheckMK API type.
+// CreateServiceRelatedComment is a union of CreateServiceComment, CreateServiceQueryComment, selected by the "comment_type" property.
 type CreateServiceRelatedComment struct {
-}
-
-// CreateServiceRelatedDowntime represents a CheckMK API type.
+	union json.RawMessage
+}
+
+// Discriminator returns the value of the "comment_type" property.
+func (u CreateServiceRelatedComment) Discriminator() (string, error) {
+	return unionDiscriminator(u.union, "comment_type")
+}
+
+// AsCreateServiceComment returns the union data as a CreateServiceComment.
+func (u CreateServiceRelatedComment) AsCreateServiceComment() (CreateServiceComment, error) {
+	var v CreateServiceComment
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromCreateServiceComment sets the union to v, with "comment_type" set to "service".
+func (u *CreateServiceRelatedComment) FromCreateServiceComment(v CreateServiceComment) error {
+	data, err := unionFrom(v, "comment_type", "service")
+	if err != nil {
+		return fmt.Errorf("CreateServiceRelatedComment: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsCreateServiceQueryComment returns the union data as a CreateServiceQueryComment.
+func (u CreateServiceRelatedComment) AsCreateServiceQueryComment() (CreateServiceQueryComment, error) {
+	var v CreateServiceQueryComment
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromCreateServiceQueryComment sets the union to v, with "comment_type" set to "service_by_query".
+func (u *CreateServiceRelatedComment) FromCreateServiceQueryComment(v CreateServiceQueryComment) error {
+	data, err := unionFrom(v, "comment_type", "service_by_query")
+	if err != nil {
+		return fmt.Errorf("CreateServiceRelatedComment: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// ValueByDiscriminator decodes the union into the member selected by "comment_type".
+func (u CreateServiceRelatedComment) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := u.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "service":
+		return u.AsCreateServiceComment()
+	case "service_by_query":
+		return u.AsCreateServiceQueryComment()
+	}
+	return nil, fmt.Errorf("CreateServiceRelatedComment: unknown comment_type %q", discriminator)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u CreateServiceRelatedComment) MarshalJSON() ([]byte, error) {
+	if len(u.union) == 0 {
+		return []byte("null"), nil
+	}
+	return u.union, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting unknown "comment_type" values.
+func (u *CreateServiceRelatedComment) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		u.union = nil
+		return nil
+	}
+	discriminator, err := unionDiscriminator(data, "comment_type")
+	if err != nil {
+		return fmt.Errorf("CreateServiceRelatedComment: %w", err)
+	}
+	switch discriminator {
+	case "service", "service_by_query":
+	default:
+		return fmt.Errorf("CreateServiceRelatedComment: unknown comment_type %q", discriminator)
+	}
+	u.union = append(json.RawMessage(nil), data...)
+	return nil
+}
+
+// CreateServiceRelatedDowntime is a union of CreateServiceDowntime, CreateServiceGroupDowntime, CreateServiceQueryDowntime, selected by the "downtime_type" property.
 type CreateServiceRelatedDowntime struct {
+	union json.RawMessage
+}
+
+// Discriminator returns the value of the "downtime_type" property.
+func (u CreateServiceRelatedDowntime) Discriminator() (string, error) {
+	return unionDiscriminator(u.union, "downtime_type")
+}
+
+// AsCreateServiceDowntime returns the union data as a CreateServiceDowntime.
+func (u CreateServiceRelatedDowntime) AsCreateServiceDowntime() (CreateServiceDowntime, error) {
+	var v CreateServiceDowntime
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromCreateServiceDowntime sets the union to v, with "downtime_type" set to "service".
+func (u *CreateServiceRelatedDowntime) FromCreateServiceDowntime(v CreateServiceDowntime) error {
+	data, err := unionFrom(v, "downtime_type", "service")
+	if err != nil {
+		return fmt.Errorf("CreateServiceRelatedDowntime: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsCreateServiceGroupDowntime returns the union data as a CreateServiceGroupDowntime.
+func (u CreateServiceRelatedDowntime) AsCreateServiceGroupDowntime() (CreateServiceGroupDowntime, error) {
+	var v CreateServiceGroupDowntime
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromCreateServiceGroupDowntime sets the union to v, with "downtime_type" set to "servicegroup".
+func (u *CreateServiceRelatedDowntime) FromCreateServiceGroupDowntime(v CreateServiceGroupDowntime) error {
+	data, err := unionFrom(v, "downtime_type", "servicegroup")
+	if err != nil {
+		return fmt.Errorf("CreateServiceRelatedDowntime: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsCreateServiceQueryDowntime returns the union data as a CreateServiceQueryDowntime.
+func (u CreateServiceRelatedDowntime) AsCreateServiceQueryDowntime() (CreateServiceQueryDowntime, error) {
+	var v CreateServiceQueryDowntime
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromCreateServiceQueryDowntime sets the union to v, with "downtime_type" set to "service_by_query".
+func (u *CreateServiceRelatedDowntime) FromCreateServiceQueryDowntime(v CreateServiceQueryDowntime) error {
+	data, err := unionFrom(v, "downtime_type", "service_by_query")
+	if err != nil {
+		return fmt.Errorf("CreateServiceRelatedDowntime: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// ValueByDiscriminator decodes the union into the member selected by "downtime_type".
+func (u CreateServiceRelatedDowntime) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := u.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "service":
+		return u.AsCreateServiceDowntime()
+	case "servicegroup":
+		return u.AsCreateServiceGroupDowntime()
+	case "service_by_query":
+		return u.AsCreateServiceQueryDowntime()
+	}
+	return nil, fmt.Errorf("CreateServiceRelatedDowntime: unknown downtime_type %q", discriminator)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u CreateServiceRelatedDowntime) MarshalJSON() ([]byte, error) {
+	if len(u.union) == 0 {
+		return []byte("null"), nil
+	}
+	return u.union, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting unknown "downtime_type" values.
+func (u *CreateServiceRelatedDowntime) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		u.union = nil
+		return nil
+	}
+	discriminator, err := unionDiscriminator(data, "downtime_type")
+	if err != nil {
+		return fmt.Errorf("CreateServiceRelatedDowntime: %w", err)
+	}
+	switch discriminator {
+	case "service", "servicegroup", "service_by_query":
+	default:
+		return fmt.Errorf("CreateServiceRelatedDowntime: unknown downtime_type %q", discriminator)
+	}
+	u.union = append(json.RawMessage(nil), data...)
+	return nil
 }
 
 // CreateTimePeriod represents a CheckMK API type.
 type CreateTimePeriod struct {
 	// The list of active time ranges.
 	// Example: [map[day:monday time_ranges:[map[end:14:00:00 start:12:00:00]]]]
-	ActiveTimeRanges []map[string]interface{} `json:"active_time_ranges"`
+	ActiveTimeRanges []TimeRangeActive `json:"active_time_ranges"`
 	// An alias for the time period.
 	// Example: alias
 	Alias string `json:"alias"`
 	// A list of additional time ranges to be added.
 	// Example: [map[date:2020-01-01 time_ranges:[map[end:18:00:00 start:14:00:00]]]]
-	Exceptions []map[string]interface{} `json:"exceptions,omitempty"`
+	Exceptions []TimePeriodException `json:"exceptions,omitempty"`
 	// A list of time period names whose periods are excluded.
 	// Example: [name]
 	Exclude []string `json:"exclude,omitempty"`
@@ -2035,27 +3681,27 @@ type CreateTimePeriod struct {
 type CreateUser struct {
 	// Authentication option for the user
 	// Example: map[auth_type:password password:password]
-	AuthOption interface{} `json:"auth_option,omitempty"`
+	AuthOption *AuthOption `json:"auth_option,omitempty"`
 	// The names of the sites the user is authorized to handle.
 	// Example: [heute]
 	AuthorizedSites []string `json:"authorized_sites,omitempty"`
 	// Contact settings for the user
 	// Example: map[email:user@example.com]
-	ContactOptions interface{} `json:"contact_options,omitempty"`
+	ContactOptions *UserContactOption `json:"contact_options,omitempty"`
 	// Assign the user to one or multiple contact groups. If no contact group is specified then no monitoring contact will be created for the user.
 	// Example: [all]
 	Contactgroups []string `json:"contactgroups,omitempty"`
 	// The user can be blocked from login but will remain part of the site. The disabling does not affect notification and alerts.
 	// Example: false
 	DisableLogin bool `json:"disable_login,omitempty"`
-	DisableNotifications interface{} `json:"disable_notifications,omitempty"`
+	DisableNotifications *DisabledNotifications `json:"disable_notifications,omitempty"`
 	// The alias or full name of the user
 	// Example: Mathias Kettner
 	Fullname string `json:"fullname"`
 	// Idle timeout for the user. Per default, the global configuration is used.
 	// Example: map[option:global]
-	IdleTimeout interface{} `json:"idle_timeout,omitempty"`
-	InterfaceOptions interface{} `json:"interface_options,omitempty"`
+	IdleTimeout *IdleOption `json:"idle_timeout,omitempty"`
+	InterfaceOptions *UserInterfaceAttributes `json:"interface_options,omitempty"`
 	// Configure the language to be used by the user in the user interface. Omitting this will configure the default language.
 	// Example: en
 	Language CreateUserLanguage `json:"language,omitempty"`
@@ -2098,8 +3744,90 @@ type CustomMacro struct {
 	MatchRegex string `json:"match_regex"`
 }
 
-// CustomMacrosCheckbox represents a CheckMK API type.
+// CustomMacrosCheckbox is a union of Checkbox, MatchCustomMacros, selected by the "state" property.
 type CustomMacrosCheckbox struct {
+	union json.RawMessage
+}
+
+// Discriminator returns the value of the "state" property.
+func (u CustomMacrosCheckbox) Discriminator() (string, error) {
+	return unionDiscriminator(u.union, "state")
+}
+
+// AsCheckbox returns the union data as a Checkbox.
+func (u CustomMacrosCheckbox) AsCheckbox() (Checkbox, error) {
+	var v Checkbox
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromCheckbox sets the union to v, with "state" set to "disabled".
+func (u *CustomMacrosCheckbox) FromCheckbox(v Checkbox) error {
+	data, err := unionFrom(v, "state", "disabled")
+	if err != nil {
+		return fmt.Errorf("CustomMacrosCheckbox: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsMatchCustomMacros returns the union data as a MatchCustomMacros.
+func (u CustomMacrosCheckbox) AsMatchCustomMacros() (MatchCustomMacros, error) {
+	var v MatchCustomMacros
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromMatchCustomMacros sets the union to v, with "state" set to "enabled".
+func (u *CustomMacrosCheckbox) FromMatchCustomMacros(v MatchCustomMacros) error {
+	data, err := unionFrom(v, "state", "enabled")
+	if err != nil {
+		return fmt.Errorf("CustomMacrosCheckbox: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// ValueByDiscriminator decodes the union into the member selected by "state".
+func (u CustomMacrosCheckbox) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := u.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "disabled":
+		return u.AsCheckbox()
+	case "enabled":
+		return u.AsMatchCustomMacros()
+	}
+	return nil, fmt.Errorf("CustomMacrosCheckbox: unknown state %q", discriminator)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u CustomMacrosCheckbox) MarshalJSON() ([]byte, error) {
+	if len(u.union) == 0 {
+		return []byte("null"), nil
+	}
+	return u.union, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting unknown "state" values.
+func (u *CustomMacrosCheckbox) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		u.union = nil
+		return nil
+	}
+	discriminator, err := unionDiscriminator(data, "state")
+	if err != nil {
+		return fmt.Errorf("CustomMacrosCheckbox: %w", err)
+	}
+	switch discriminator {
+	case "disabled", "enabled":
+	default:
+		return fmt.Errorf("CustomMacrosCheckbox: unknown state %q", discriminator)
+	}
+	u.union = append(json.RawMessage(nil), data...)
+	return nil
 }
 
 // CustomPlugin represents a CheckMK API type.
@@ -2114,7 +3842,7 @@ type CustomPluginWithParams struct {
 	// Create notifications with parameters or cancel previous notifications
 	// Example: cancel_previous_notifications
 	Option CustomPluginWithParamsOption `json:"option,omitempty"`
-	PluginParams map[string]interface{} `json:"plugin_params"`
+	PluginParams CustomPlugin `json:"plugin_params"`
 }
 
 // CustomTimeRange represents a CheckMK API type.
@@ -2154,8 +3882,109 @@ type DeleteCommentById struct {
 	SiteId string `json:"site_id"`
 }
 
-// DeleteComments represents a CheckMK API type.
+// DeleteComments is a union of DeleteCommentById, DeleteCommentsByQuery, DeleteCommentsByParams, selected by the "delete_type" property.
 type DeleteComments struct {
+	union json.RawMessage
+}
+
+// Discriminator returns the value of the "delete_type" property.
+func (u DeleteComments) Discriminator() (string, error) {
+	return unionDiscriminator(u.union, "delete_type")
+}
+
+// AsDeleteCommentById returns the union data as a DeleteCommentById.
+func (u DeleteComments) AsDeleteCommentById() (DeleteCommentById, error) {
+	var v DeleteCommentById
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromDeleteCommentById sets the union to v, with "delete_type" set to "by_id".
+func (u *DeleteComments) FromDeleteCommentById(v DeleteCommentById) error {
+	data, err := unionFrom(v, "delete_type", "by_id")
+	if err != nil {
+		return fmt.Errorf("DeleteComments: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsDeleteCommentsByQuery returns the union data as a DeleteCommentsByQuery.
+func (u DeleteComments) AsDeleteCommentsByQuery() (DeleteCommentsByQuery, error) {
+	var v DeleteCommentsByQuery
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromDeleteCommentsByQuery sets the union to v, with "delete_type" set to "query".
+func (u *DeleteComments) FromDeleteCommentsByQuery(v DeleteCommentsByQuery) error {
+	data, err := unionFrom(v, "delete_type", "query")
+	if err != nil {
+		return fmt.Errorf("DeleteComments: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsDeleteCommentsByParams returns the union data as a DeleteCommentsByParams.
+func (u DeleteComments) AsDeleteCommentsByParams() (DeleteCommentsByParams, error) {
+	var v DeleteCommentsByParams
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromDeleteCommentsByParams sets the union to v, with "delete_type" set to "params".
+func (u *DeleteComments) FromDeleteCommentsByParams(v DeleteCommentsByParams) error {
+	data, err := unionFrom(v, "delete_type", "params")
+	if err != nil {
+		return fmt.Errorf("DeleteComments: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// ValueByDiscriminator decodes the union into the member selected by "delete_type".
+func (u DeleteComments) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := u.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "by_id":
+		return u.AsDeleteCommentById()
+	case "query":
+		return u.AsDeleteCommentsByQuery()
+	case "params":
+		return u.AsDeleteCommentsByParams()
+	}
+	return nil, fmt.Errorf("DeleteComments: unknown delete_type %q", discriminator)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u DeleteComments) MarshalJSON() ([]byte, error) {
+	if len(u.union) == 0 {
+		return []byte("null"), nil
+	}
+	return u.union, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting unknown "delete_type" values.
+func (u *DeleteComments) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		u.union = nil
+		return nil
+	}
+	discriminator, err := unionDiscriminator(data, "delete_type")
+	if err != nil {
+		return fmt.Errorf("DeleteComments: %w", err)
+	}
+	switch discriminator {
+	case "by_id", "query", "params":
+	default:
+		return fmt.Errorf("DeleteComments: unknown delete_type %q", discriminator)
+	}
+	u.union = append(json.RawMessage(nil), data...)
+	return nil
 }
 
 // DeleteCommentsByParams represents a CheckMK API type.
@@ -2181,14 +4010,115 @@ type DeleteCommentsByQuery struct {
 	DeleteType DeleteCommentsByQueryDeleteType `json:"delete_type"`
 	// An query expression of the Livestatus 'comments' table in nested dictionary form. If you want to use multiple expressions, nest them with the AND/OR operators.
 	// Example: {"op": "and", "expr": [{"op": "=", "left": "name", "right": "example.com"}, {"op": "!=", "left": "state", "right": "0"}]}
-	Query interface{} `json:"query,omitempty"`
+	Query *Expr `json:"query,omitempty"`
 	// The ID of an existing site
 	// Example: production
 	SiteId string `json:"site_id"`
 }
 
-// DeleteDowntime represents a CheckMK API type.
+// DeleteDowntime is a union of DeleteDowntimeById, DeleteDowntimeByName, DeleteDowntimeByQuery, selected by the "delete_type" property.
 type DeleteDowntime struct {
+	union json.RawMessage
+}
+
+// Discriminator returns the value of the "delete_type" property.
+func (u DeleteDowntime) Discriminator() (string, error) {
+	return unionDiscriminator(u.union, "delete_type")
+}
+
+// AsDeleteDowntimeById returns the union data as a DeleteDowntimeById.
+func (u DeleteDowntime) AsDeleteDowntimeById() (DeleteDowntimeById, error) {
+	var v DeleteDowntimeById
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromDeleteDowntimeById sets the union to v, with "delete_type" set to "by_id".
+func (u *DeleteDowntime) FromDeleteDowntimeById(v DeleteDowntimeById) error {
+	data, err := unionFrom(v, "delete_type", "by_id")
+	if err != nil {
+		return fmt.Errorf("DeleteDowntime: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsDeleteDowntimeByName returns the union data as a DeleteDowntimeByName.
+func (u DeleteDowntime) AsDeleteDowntimeByName() (DeleteDowntimeByName, error) {
+	var v DeleteDowntimeByName
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromDeleteDowntimeByName sets the union to v, with "delete_type" set to "params".
+func (u *DeleteDowntime) FromDeleteDowntimeByName(v DeleteDowntimeByName) error {
+	data, err := unionFrom(v, "delete_type", "params")
+	if err != nil {
+		return fmt.Errorf("DeleteDowntime: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsDeleteDowntimeByQuery returns the union data as a DeleteDowntimeByQuery.
+func (u DeleteDowntime) AsDeleteDowntimeByQuery() (DeleteDowntimeByQuery, error) {
+	var v DeleteDowntimeByQuery
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromDeleteDowntimeByQuery sets the union to v, with "delete_type" set to "query".
+func (u *DeleteDowntime) FromDeleteDowntimeByQuery(v DeleteDowntimeByQuery) error {
+	data, err := unionFrom(v, "delete_type", "query")
+	if err != nil {
+		return fmt.Errorf("DeleteDowntime: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// ValueByDiscriminator decodes the union into the member selected by "delete_type".
+func (u DeleteDowntime) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := u.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "by_id":
+		return u.AsDeleteDowntimeById()
+	case "params":
+		return u.AsDeleteDowntimeByName()
+	case "query":
+		return u.AsDeleteDowntimeByQuery()
+	}
+	return nil, fmt.Errorf("DeleteDowntime: unknown delete_type %q", discriminator)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u DeleteDowntime) MarshalJSON() ([]byte, error) {
+	if len(u.union) == 0 {
+		return []byte("null"), nil
+	}
+	return u.union, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting unknown "delete_type" values.
+func (u *DeleteDowntime) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		u.union = nil
+		return nil
+	}
+	discriminator, err := unionDiscriminator(data, "delete_type")
+	if err != nil {
+		return fmt.Errorf("DeleteDowntime: %w", err)
+	}
+	switch discriminator {
+	case "by_id", "params", "query":
+	default:
+		return fmt.Errorf("DeleteDowntime: unknown delete_type %q", discriminator)
+	}
+	u.union = append(json.RawMessage(nil), data...)
+	return nil
 }
 
 // DeleteDowntimeById represents a CheckMK API type.
@@ -2224,11 +4154,112 @@ type DeleteDowntimeByQuery struct {
 	DeleteType DeleteDowntimeByQueryDeleteType `json:"delete_type"`
 	// An query expression of the Livestatus 'downtimes' table in nested dictionary form. If you want to use multiple expressions, nest them with the AND/OR operators.
 	// Example: {"op": "and", "expr": [{"op": "=", "left": "name", "right": "example.com"}, {"op": "!=", "left": "state", "right": "0"}]}
-	Query interface{} `json:"query"`
+	Query Expr `json:"query"`
 }
 
-// DeleteECEvents represents a CheckMK API type.
+// DeleteECEvents is a union of FilterById, FilterByQuery, FilterByParams, selected by the "filter_type" property.
 type DeleteECEvents struct {
+	union json.RawMessage
+}
+
+// Discriminator returns the value of the "filter_type" property.
+func (u DeleteECEvents) Discriminator() (string, error) {
+	return unionDiscriminator(u.union, "filter_type")
+}
+
+// AsFilterById returns the union data as a FilterById.
+func (u DeleteECEvents) AsFilterById() (FilterById, error) {
+	var v FilterById
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromFilterById sets the union to v, with "filter_type" set to "by_id".
+func (u *DeleteECEvents) FromFilterById(v FilterById) error {
+	data, err := unionFrom(v, "filter_type", "by_id")
+	if err != nil {
+		return fmt.Errorf("DeleteECEvents: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsFilterByQuery returns the union data as a FilterByQuery.
+func (u DeleteECEvents) AsFilterByQuery() (FilterByQuery, error) {
+	var v FilterByQuery
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromFilterByQuery sets the union to v, with "filter_type" set to "query".
+func (u *DeleteECEvents) FromFilterByQuery(v FilterByQuery) error {
+	data, err := unionFrom(v, "filter_type", "query")
+	if err != nil {
+		return fmt.Errorf("DeleteECEvents: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsFilterByParams returns the union data as a FilterByParams.
+func (u DeleteECEvents) AsFilterByParams() (FilterByParams, error) {
+	var v FilterByParams
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromFilterByParams sets the union to v, with "filter_type" set to "params".
+func (u *DeleteECEvents) FromFilterByParams(v FilterByParams) error {
+	data, err := unionFrom(v, "filter_type", "params")
+	if err != nil {
+		return fmt.Errorf("DeleteECEvents: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// ValueByDiscriminator decodes the union into the member selected by "filter_type".
+func (u DeleteECEvents) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := u.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "by_id":
+		return u.AsFilterById()
+	case "query":
+		return u.AsFilterByQuery()
+	case "params":
+		return u.AsFilterByParams()
+	}
+	return nil, fmt.Errorf("DeleteECEvents: unknown filter_type %q", discriminator)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u DeleteECEvents) MarshalJSON() ([]byte, error) {
+	if len(u.union) == 0 {
+		return []byte("null"), nil
+	}
+	return u.union, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting unknown "filter_type" values.
+func (u *DeleteECEvents) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		u.union = nil
+		return nil
+	}
+	discriminator, err := unionDiscriminator(data, "filter_type")
+	if err != nil {
+		return fmt.Errorf("DeleteECEvents: %w", err)
+	}
+	switch discriminator {
+	case "by_id", "query", "params":
+	default:
+		return fmt.Errorf("DeleteECEvents: unknown filter_type %q", discriminator)
+	}
+	u.union = append(json.RawMessage(nil), data...)
+	return nil
 }
 
 // DirectMapping represents a CheckMK API type.
@@ -2246,7 +4277,7 @@ type DisabledNotifications struct {
 	Disable bool `json:"disable,omitempty"`
 	// A custom timerange during which notifications are disabled
 	// Example: map[end_time:2017-07-21T18:32:28Z start_time:2017-07-21T17:32:28Z]
-	Timerange interface{} `json:"timerange,omitempty"`
+	Timerange *CustomTimeRange `json:"timerange,omitempty"`
 }
 
 // DiscoverServices represents a CheckMK API type.
@@ -2271,11 +4302,11 @@ type DiscoveryBackgroundJobStatusObject struct {
 	// The domain type of the object
 	DomainType interface{} `json:"domainType,omitempty"`
 	// The attributes of the background job
-	Extensions interface{} `json:"extensions,omitempty"`
+	Extensions *BackgroundJobStatus `json:"extensions,omitempty"`
 	// The unique identifier for this domain-object type.
 	Id string `json:"id,omitempty"`
 	// list of links to other resources.
-	Links []map[string]interface{} `json:"links"`
+	Links []Link `json:"links"`
 	// The container for external resources, like linked foreign objects or actions.
 	Members map[string]interface{} `json:"members,omitempty"`
 	// A human readable title of this object. Can be used for user interfaces.
@@ -2291,7 +4322,7 @@ type DomainObject struct {
 	// The unique identifier for this domain-object type.
 	Id string `json:"id,omitempty"`
 	// list of links to other resources.
-	Links []map[string]interface{} `json:"links"`
+	Links []Link `json:"links"`
 	// The container for external resources, like linked foreign objects or actions.
 	Members map[string]interface{} `json:"members,omitempty"`
 	// A human readable title of this object. Can be used for user interfaces.
@@ -2307,11 +4338,11 @@ type DomainObjectCollection struct {
 	// The name of this collection.
 	Id string `json:"id,omitempty"`
 	// list of links to other resources.
-	Links []map[string]interface{} `json:"links"`
+	Links []Link `json:"links"`
 	// A human readable title of this object. Can be used for user interfaces.
 	Title string `json:"title,omitempty"`
 	// The collection itself. Each entry in here is part of the collection.
-	Value []map[string]interface{} `json:"value,omitempty"`
+	Value []CollectionItem `json:"value,omitempty"`
 }
 
 // DowntimeAttributes represents a CheckMK API type.
@@ -2351,11 +4382,11 @@ type DowntimeCollection struct {
 	// The name of this collection.
 	Id string `json:"id,omitempty"`
 	// list of links to other resources.
-	Links []map[string]interface{} `json:"links"`
+	Links []Link `json:"links"`
 	// A human readable title of this object. Can be used for user interfaces.
 	Title string `json:"title,omitempty"`
 	// A list of downtime objects.
-	Value []map[string]interface{} `json:"value,omitempty"`
+	Value []DowntimeObject `json:"value,omitempty"`
 }
 
 // DowntimeObject represents a CheckMK API type.
@@ -2363,11 +4394,11 @@ type DowntimeObject struct {
 	// The domain type of the object.
 	DomainType interface{} `json:"domainType,omitempty"`
 	// The attributes of a downtime.
-	Extensions interface{} `json:"extensions,omitempty"`
+	Extensions *DowntimeAttributes `json:"extensions,omitempty"`
 	// The unique identifier for this domain-object type.
 	Id string `json:"id,omitempty"`
 	// list of links to other resources.
-	Links []map[string]interface{} `json:"links"`
+	Links []Link `json:"links"`
 	// The container for external resources, like linked foreign objects or actions.
 	Members map[string]interface{} `json:"members,omitempty"`
 	// A human readable title of this object. Can be used for user interfaces.
@@ -2428,11 +4459,11 @@ type ECEventResponse struct {
 	DomainType interface{} `json:"domainType,omitempty"`
 	// The configuration attributes of a site.
 	// Example: map[application:app_1 comment:example_comment contact:Mr Monitor count:1 facility:kern first:Oct 26 2022 07:51:25 host:host_1 ipaddress:127.0.0.1 last:Oct 21 2022 09:11:12 phase:open priority:warning rule_id:rule_1 service_level:gold state:okay text:Sample message text.]
-	Extensions interface{} `json:"extensions,omitempty"`
+	Extensions *ECEventAttributes `json:"extensions,omitempty"`
 	// The unique identifier for this domain-object type.
 	Id string `json:"id,omitempty"`
 	// list of links to other resources.
-	Links []map[string]interface{} `json:"links"`
+	Links []Link `json:"links"`
 	// The container for external resources, like linked foreign objects or actions.
 	Members map[string]interface{} `json:"members,omitempty"`
 	// A human readable title of this object. Can be used for user interfaces.
@@ -2461,17 +4492,181 @@ type EmailAndDisplayName struct {
 	DisplayName string `json:"display_name,omitempty"`
 }
 
-// EmailInfoOneOf represents a CheckMK API type.
+// EmailInfoOneOf is a union of Checkbox, CheckboxWithListOfEmailInfoStrs, selected by the "state" property.
 type EmailInfoOneOf struct {
-}
-
-// EnableSyncOneOf represents a CheckMK API type.
+	union json.RawMessage
+}
+
+// Discriminator returns the value of the "state" property.
+func (u EmailInfoOneOf) Discriminator() (string, error) {
+	return unionDiscriminator(u.union, "state")
+}
+
+// AsCheckbox returns the union data as a Checkbox.
+func (u EmailInfoOneOf) AsCheckbox() (Checkbox, error) {
+	var v Checkbox
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromCheckbox sets the union to v, with "state" set to "disabled".
+func (u *EmailInfoOneOf) FromCheckbox(v Checkbox) error {
+	data, err := unionFrom(v, "state", "disabled")
+	if err != nil {
+		return fmt.Errorf("EmailInfoOneOf: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsCheckboxWithListOfEmailInfoStrs returns the union data as a CheckboxWithListOfEmailInfoStrs.
+func (u EmailInfoOneOf) AsCheckboxWithListOfEmailInfoStrs() (CheckboxWithListOfEmailInfoStrs, error) {
+	var v CheckboxWithListOfEmailInfoStrs
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromCheckboxWithListOfEmailInfoStrs sets the union to v, with "state" set to "enabled".
+func (u *EmailInfoOneOf) FromCheckboxWithListOfEmailInfoStrs(v CheckboxWithListOfEmailInfoStrs) error {
+	data, err := unionFrom(v, "state", "enabled")
+	if err != nil {
+		return fmt.Errorf("EmailInfoOneOf: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// ValueByDiscriminator decodes the union into the member selected by "state".
+func (u EmailInfoOneOf) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := u.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "disabled":
+		return u.AsCheckbox()
+	case "enabled":
+		return u.AsCheckboxWithListOfEmailInfoStrs()
+	}
+	return nil, fmt.Errorf("EmailInfoOneOf: unknown state %q", discriminator)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u EmailInfoOneOf) MarshalJSON() ([]byte, error) {
+	if len(u.union) == 0 {
+		return []byte("null"), nil
+	}
+	return u.union, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting unknown "state" values.
+func (u *EmailInfoOneOf) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		u.union = nil
+		return nil
+	}
+	discriminator, err := unionDiscriminator(data, "state")
+	if err != nil {
+		return fmt.Errorf("EmailInfoOneOf: %w", err)
+	}
+	switch discriminator {
+	case "disabled", "enabled":
+	default:
+		return fmt.Errorf("EmailInfoOneOf: unknown state %q", discriminator)
+	}
+	u.union = append(json.RawMessage(nil), data...)
+	return nil
+}
+
+// EnableSyncOneOf is a union of Checkbox, EnableSynchronousDeliveryViaSMTPValue, selected by the "state" property.
 type EnableSyncOneOf struct {
+	union json.RawMessage
+}
+
+// Discriminator returns the value of the "state" property.
+func (u EnableSyncOneOf) Discriminator() (string, error) {
+	return unionDiscriminator(u.union, "state")
+}
+
+// AsCheckbox returns the union data as a Checkbox.
+func (u EnableSyncOneOf) AsCheckbox() (Checkbox, error) {
+	var v Checkbox
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromCheckbox sets the union to v, with "state" set to "disabled".
+func (u *EnableSyncOneOf) FromCheckbox(v Checkbox) error {
+	data, err := unionFrom(v, "state", "disabled")
+	if err != nil {
+		return fmt.Errorf("EnableSyncOneOf: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsEnableSynchronousDeliveryViaSMTPValue returns the union data as a EnableSynchronousDeliveryViaSMTPValue.
+func (u EnableSyncOneOf) AsEnableSynchronousDeliveryViaSMTPValue() (EnableSynchronousDeliveryViaSMTPValue, error) {
+	var v EnableSynchronousDeliveryViaSMTPValue
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromEnableSynchronousDeliveryViaSMTPValue sets the union to v, with "state" set to "enabled".
+func (u *EnableSyncOneOf) FromEnableSynchronousDeliveryViaSMTPValue(v EnableSynchronousDeliveryViaSMTPValue) error {
+	data, err := unionFrom(v, "state", "enabled")
+	if err != nil {
+		return fmt.Errorf("EnableSyncOneOf: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// ValueByDiscriminator decodes the union into the member selected by "state".
+func (u EnableSyncOneOf) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := u.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "disabled":
+		return u.AsCheckbox()
+	case "enabled":
+		return u.AsEnableSynchronousDeliveryViaSMTPValue()
+	}
+	return nil, fmt.Errorf("EnableSyncOneOf: unknown state %q", discriminator)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u EnableSyncOneOf) MarshalJSON() ([]byte, error) {
+	if len(u.union) == 0 {
+		return []byte("null"), nil
+	}
+	return u.union, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting unknown "state" values.
+func (u *EnableSyncOneOf) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		u.union = nil
+		return nil
+	}
+	discriminator, err := unionDiscriminator(data, "state")
+	if err != nil {
+		return fmt.Errorf("EnableSyncOneOf: %w", err)
+	}
+	switch discriminator {
+	case "disabled", "enabled":
+	default:
+		return fmt.Errorf("EnableSyncOneOf: unknown state %q", discriminator)
+	}
+	u.union = append(json.RawMessage(nil), data...)
+	return nil
 }
 
 // EnableSynchronousDeliveryViaSMTP represents a CheckMK API type.
 type EnableSynchronousDeliveryViaSMTP struct {
-	Auth map[string]interface{} `json:"auth,omitempty"`
+	Auth *AuthenticationValue `json:"auth,omitempty"`
 	Encryption string `json:"encryption,omitempty"`
 	Port int `json:"port,omitempty"`
 	Smarthosts []string `json:"smarthosts,omitempty"`
@@ -2482,13 +4677,13 @@ type EnableSynchronousDeliveryViaSMTPValue struct {
 	// To enable or disable this field
 	// Example: enabled
 	State EnableSynchronousDeliveryViaSMTPValueState `json:"state"`
-	Value map[string]interface{} `json:"value,omitempty"`
+	Value *EnableSynchronousDeliveryViaSMTP `json:"value,omitempty"`
 }
 
 // EventConsoleAlertAttributes represents a CheckMK API type.
 type EventConsoleAlertAttributes struct {
 	MatchType EventConsoleAlertAttributesMatchType `json:"match_type"`
-	Values map[string]interface{} `json:"values"`
+	Values EventConsoleAlertAttrsCreate `json:"values"`
 }
 
 // EventConsoleAlertAttributesBase represents a CheckMK API type.
@@ -2498,22 +4693,104 @@ type EventConsoleAlertAttributesBase struct {
 
 // EventConsoleAlertAttrsCreate represents a CheckMK API type.
 type EventConsoleAlertAttrsCreate struct {
-	MatchEventComment map[string]interface{} `json:"match_event_comment,omitempty"`
-	MatchRuleIds map[string]interface{} `json:"match_rule_ids,omitempty"`
-	MatchSyslogFacility map[string]interface{} `json:"match_syslog_facility,omitempty"`
-	MatchSyslogPriority map[string]interface{} `json:"match_syslog_priority,omitempty"`
+	MatchEventComment *StrValueOneOf `json:"match_event_comment,omitempty"`
+	MatchRuleIds *MatchRuleIdsOneOf `json:"match_rule_ids,omitempty"`
+	MatchSyslogFacility *MatchSysLogFacOneOf `json:"match_syslog_facility,omitempty"`
+	MatchSyslogPriority *MatchSysLogPriOneOf `json:"match_syslog_priority,omitempty"`
 }
 
 // EventConsoleAlertAttrsResponse represents a CheckMK API type.
 type EventConsoleAlertAttrsResponse struct {
-	MatchEventComment map[string]interface{} `json:"match_event_comment,omitempty"`
-	MatchRuleIds map[string]interface{} `json:"match_rule_ids,omitempty"`
-	MatchSyslogFacility map[string]interface{} `json:"match_syslog_facility,omitempty"`
-	MatchSyslogPriority map[string]interface{} `json:"match_syslog_priority,omitempty"`
+	MatchEventComment *CheckboxWithStrValue `json:"match_event_comment,omitempty"`
+	MatchRuleIds *CheckboxWithListOfStr `json:"match_rule_ids,omitempty"`
+	MatchSyslogFacility *CheckboxWithStrValue `json:"match_syslog_facility,omitempty"`
+	MatchSyslogPriority *CheckboxWithSysLogPriority `json:"match_syslog_priority,omitempty"`
 }
 
-// EventConsoleAlertCheckbox represents a CheckMK API type.
+// EventConsoleAlertCheckbox is a union of Checkbox, CheckboxEventConsoleAlerts, selected by the "state" property.
 type EventConsoleAlertCheckbox struct {
+	union json.RawMessage
+}
+
+// Discriminator returns the value of the "state" property.
+func (u EventConsoleAlertCheckbox) Discriminator() (string, error) {
+	return unionDiscriminator(u.union, "state")
+}
+
+// AsCheckbox returns the union data as a Checkbox.
+func (u EventConsoleAlertCheckbox) AsCheckbox() (Checkbox, error) {
+	var v Checkbox
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromCheckbox sets the union to v, with "state" set to "disabled".
+func (u *EventConsoleAlertCheckbox) FromCheckbox(v Checkbox) error {
+	data, err := unionFrom(v, "state", "disabled")
+	if err != nil {
+		return fmt.Errorf("EventConsoleAlertCheckbox: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsCheckboxEventConsoleAlerts returns the union data as a CheckboxEventConsoleAlerts.
+func (u EventConsoleAlertCheckbox) AsCheckboxEventConsoleAlerts() (CheckboxEventConsoleAlerts, error) {
+	var v CheckboxEventConsoleAlerts
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromCheckboxEventConsoleAlerts sets the union to v, with "state" set to "enabled".
+func (u *EventConsoleAlertCheckbox) FromCheckboxEventConsoleAlerts(v CheckboxEventConsoleAlerts) error {
+	data, err := unionFrom(v, "state", "enabled")
+	if err != nil {
+		return fmt.Errorf("EventConsoleAlertCheckbox: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// ValueByDiscriminator decodes the union into the member selected by "state".
+func (u EventConsoleAlertCheckbox) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := u.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "disabled":
+		return u.AsCheckbox()
+	case "enabled":
+		return u.AsCheckboxEventConsoleAlerts()
+	}
+	return nil, fmt.Errorf("EventConsoleAlertCheckbox: unknown state %q", discriminator)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u EventConsoleAlertCheckbox) MarshalJSON() ([]byte, error) {
+	if len(u.union) == 0 {
+		return []byte("null"), nil
+	}
+	return u.union, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting unknown "state" values.
+func (u *EventConsoleAlertCheckbox) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		u.union = nil
+		return nil
+	}
+	discriminator, err := unionDiscriminator(data, "state")
+	if err != nil {
+		return fmt.Errorf("EventConsoleAlertCheckbox: %w", err)
+	}
+	switch discriminator {
+	case "disabled", "enabled":
+	default:
+		return fmt.Errorf("EventConsoleAlertCheckbox: unknown state %q", discriminator)
+	}
+	u.union = append(json.RawMessage(nil), data...)
+	return nil
 }
 
 // EventConsoleAlertsResponse represents a CheckMK API type.
@@ -2522,7 +4799,7 @@ type EventConsoleAlertsResponse struct {
 	// To enable or disable this field
 	// Example: enabled
 	State EventConsoleAlertsResponseState `json:"state"`
-	Values map[string]interface{} `json:"values,omitempty"`
+	Values *EventConsoleAlertAttrsResponse `json:"values,omitempty"`
 }
 
 // EventConsoleResponseCollection represents a CheckMK API type.
@@ -2534,20 +4811,203 @@ type EventConsoleResponseCollection struct {
 	// The name of this collection.
 	Id string `json:"id,omitempty"`
 	// list of links to other resources.
-	Links []map[string]interface{} `json:"links"`
+	Links []Link `json:"links"`
 	// A human readable title of this object. Can be used for user interfaces.
 	Title string `json:"title,omitempty"`
 	// A list of site configuration objects.
 	// Example: [map[domainType:event_console extensions:map[application:app_1 comment:example_comment contact:Mr Monitor count:1 facility:kern first:Oct 26 2022 07:51:25 host:host_1 ipaddress:127.0.0.1 last:Oct 21 2022 09:11:12 phase:open priority:warning rule_id:rule_1 service_level:gold state:okay text:Sample...
-	Value []map[string]interface{} `json:"value,omitempty"`
+	Value []ECEventResponse `json:"value,omitempty"`
 }
 
-// ExplicitEmailAddressesCheckbox represents a CheckMK API type.
+// ExplicitEmailAddressesCheckbox is a union of Checkbox, CheckboxWithListOfEmailAddresses, selected by the "state" property.
 type ExplicitEmailAddressesCheckbox struct {
-}
-
-// Expr represents a CheckMK API type.
+	union json.RawMessage
+}
+
+// Discriminator returns the value of the "state" property.
+func (u ExplicitEmailAddressesCheckbox) Discriminator() (string, error) {
+	return unionDiscriminator(u.union, "state")
+}
+
+// AsCheckbox returns the union data as a Checkbox.
+func (u ExplicitEmailAddressesCheckbox) AsCheckbox() (Checkbox, error) {
+	var v Checkbox
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromCheckbox sets the union to v, with "state" set to "disabled".
+func (u *ExplicitEmailAddressesCheckbox) FromCheckbox(v Checkbox) error {
+	data, err := unionFrom(v, "state", "disabled")
+	if err != nil {
+		return fmt.Errorf("ExplicitEmailAddressesCheckbox: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsCheckboxWithListOfEmailAddresses returns the union data as a CheckboxWithListOfEmailAddresses.
+func (u ExplicitEmailAddressesCheckbox) AsCheckboxWithListOfEmailAddresses() (CheckboxWithListOfEmailAddresses, error) {
+	var v CheckboxWithListOfEmailAddresses
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromCheckboxWithListOfEmailAddresses sets the union to v, with "state" set to "enabled".
+func (u *ExplicitEmailAddressesCheckbox) FromCheckboxWithListOfEmailAddresses(v CheckboxWithListOfEmailAddresses) error {
+	data, err := unionFrom(v, "state", "enabled")
+	if err != nil {
+		return fmt.Errorf("ExplicitEmailAddressesCheckbox: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// ValueByDiscriminator decodes the union into the member selected by "state".
+func (u ExplicitEmailAddressesCheckbox) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := u.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "disabled":
+		return u.AsCheckbox()
+	case "enabled":
+		return u.AsCheckboxWithListOfEmailAddresses()
+	}
+	return nil, fmt.Errorf("ExplicitEmailAddressesCheckbox: unknown state %q", discriminator)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u ExplicitEmailAddressesCheckbox) MarshalJSON() ([]byte, error) {
+	if len(u.union) == 0 {
+		return []byte("null"), nil
+	}
+	return u.union, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting unknown "state" values.
+func (u *ExplicitEmailAddressesCheckbox) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		u.union = nil
+		return nil
+	}
+	discriminator, err := unionDiscriminator(data, "state")
+	if err != nil {
+		return fmt.Errorf("ExplicitEmailAddressesCheckbox: %w", err)
+	}
+	switch discriminator {
+	case "disabled", "enabled":
+	default:
+		return fmt.Errorf("ExplicitEmailAddressesCheckbox: unknown state %q", discriminator)
+	}
+	u.union = append(json.RawMessage(nil), data...)
+	return nil
+}
+
+// Expr is a union of LogicalExpr, NotExpr, BinaryExpr, selected by the "op" property.
 type Expr struct {
+	union json.RawMessage
+}
+
+// Discriminator returns the value of the "op" property.
+func (u Expr) Discriminator() (string, error) {
+	return unionDiscriminator(u.union, "op")
+}
+
+// AsLogicalExpr returns the union data as a LogicalExpr.
+func (u Expr) AsLogicalExpr() (LogicalExpr, error) {
+	var v LogicalExpr
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromLogicalExpr sets the union to v, whose "op" must be one of "and", "or".
+func (u *Expr) FromLogicalExpr(v LogicalExpr) error {
+	data, err := unionFrom(v, "op", "and", "or")
+	if err != nil {
+		return fmt.Errorf("Expr: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsNotExpr returns the union data as a NotExpr.
+func (u Expr) AsNotExpr() (NotExpr, error) {
+	var v NotExpr
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromNotExpr sets the union to v, with "op" set to "not".
+func (u *Expr) FromNotExpr(v NotExpr) error {
+	data, err := unionFrom(v, "op", "not")
+	if err != nil {
+		return fmt.Errorf("Expr: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsBinaryExpr returns the union data as a BinaryExpr.
+func (u Expr) AsBinaryExpr() (BinaryExpr, error) {
+	var v BinaryExpr
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromBinaryExpr sets the union to v, whose "op" must be one of "!<", "!<=", "!=", "!>", "!>=", "!~", "!~~", "<", "<=", "=", ">", ">=", "~", "~~".
+func (u *Expr) FromBinaryExpr(v BinaryExpr) error {
+	data, err := unionFrom(v, "op", "!<", "!<=", "!=", "!>", "!>=", "!~", "!~~", "<", "<=", "=", ">", ">=", "~", "~~")
+	if err != nil {
+		return fmt.Errorf("Expr: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// ValueByDiscriminator decodes the union into the member selected by "op".
+func (u Expr) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := u.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "and", "or":
+		return u.AsLogicalExpr()
+	case "not":
+		return u.AsNotExpr()
+	case "!<", "!<=", "!=", "!>", "!>=", "!~", "!~~", "<", "<=", "=", ">", ">=", "~", "~~":
+		return u.AsBinaryExpr()
+	}
+	return nil, fmt.Errorf("Expr: unknown op %q", discriminator)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u Expr) MarshalJSON() ([]byte, error) {
+	if len(u.union) == 0 {
+		return []byte("null"), nil
+	}
+	return u.union, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting unknown "op" values.
+func (u *Expr) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		u.union = nil
+		return nil
+	}
+	discriminator, err := unionDiscriminator(data, "op")
+	if err != nil {
+		return fmt.Errorf("Expr: %w", err)
+	}
+	switch discriminator {
+	case "and", "or", "not", "!<", "!<=", "!=", "!>", "!>=", "!~", "!~~", "<", "<=", "=", ">", ">=", "~", "~~":
+	default:
+		return fmt.Errorf("Expr: unknown op %q", discriminator)
+	}
+	u.union = append(json.RawMessage(nil), data...)
+	return nil
 }
 
 // FailedHosts represents a CheckMK API type.
@@ -2555,7 +5015,7 @@ type FailedHosts struct {
 	// Detailed error messages on hosts failing the action
 	FailedHosts map[string]interface{} `json:"failed_hosts,omitempty"`
 	// The list of succeeded host objects
-	SucceededHosts interface{} `json:"succeeded_hosts,omitempty"`
+	SucceededHosts *HostConfigCollection `json:"succeeded_hosts,omitempty"`
 }
 
 // FilterById represents a CheckMK API type.
@@ -2576,7 +5036,7 @@ type FilterByParams struct {
 	// The way you would like to filter events.
 	// Example: by_id
 	FilterType FilterByParamsFilterType `json:"filter_type"`
-	Filters map[string]interface{} `json:"filters"`
+	Filters FilterParams `json:"filters"`
 	// An existing site id
 	// Example: heute
 	SiteId string `json:"site_id"`
@@ -2589,7 +5049,7 @@ type FilterByQuery struct {
 	FilterType FilterByQueryFilterType `json:"filter_type"`
 	// An query expression of the Livestatus 'eventconsoleevents' table in nested dictionary form. If you want to use multiple expressions, nest them with the AND/OR operators.
 	// Example: {"op": "=", "left": "eventconsoleevents.event_host", "right": "test_host"}
-	Query interface{} `json:"query"`
+	Query Expr `json:"query"`
 	// An existing site id
 	// Example: heute
 	SiteId string `json:"site_id"`
@@ -2629,13 +5089,13 @@ type Folder struct {
 	// The domain type of the object.
 	DomainType interface{} `json:"domainType,omitempty"`
 	// Data and Meta-Data of this object.
-	Extensions interface{} `json:"extensions,omitempty"`
+	Extensions *FolderExtensions `json:"extensions,omitempty"`
 	// The full path of the folder, tilde-separated.
 	Id string `json:"id,omitempty"`
 	// list of links to other resources.
-	Links []map[string]interface{} `json:"links"`
+	Links []Link `json:"links"`
 	// Specific collections or actions applicable to this object.
-	Members interface{} `json:"members,omitempty"`
+	Members *FolderMembers `json:"members,omitempty"`
 	// The human readable title for this folder.
 	Title string `json:"title,omitempty"`
 }
@@ -2649,33 +5109,33 @@ type FolderCollection struct {
 	// The name of this collection.
 	Id string `json:"id,omitempty"`
 	// list of links to other resources.
-	Links []map[string]interface{} `json:"links"`
+	Links []Link `json:"links"`
 	// A human readable title of this object. Can be used for user interfaces.
 	Title string `json:"title,omitempty"`
 	// A list of folder objects.
-	Value []map[string]interface{} `json:"value,omitempty"`
+	Value []Folder `json:"value,omitempty"`
 }
 
 // FolderCreateAttribute represents a CheckMK API type.
 type FolderCreateAttribute struct {
 	// Only members of the contact groups listed here have Setup permission for the host/folder. Optionally, you can make these contact groups automatically monitor contacts. The assignment of hosts to contact groups can also be defined by <a href='wato.py?mode=edit_ruleset&varname=host_contactgroups'>r...
-	Contactgroups interface{} `json:"contactgroups,omitempty"`
+	Contactgroups *HostContactGroup `json:"contactgroups,omitempty"`
 	// Labels allow you to flexibly group your hosts in order to refer to them later at other places in Checkmk, e.g. in rule chains.<br><b>Label format:</b> key:value<br><br>Checkmk does not perform any validation on the labels you use.
 	Labels map[string]interface{} `json:"labels,omitempty"`
 	// IPMI credentials
-	ManagementIpmiCredentials interface{} `json:"management_ipmi_credentials,omitempty"`
+	ManagementIpmiCredentials *IPMIParameters `json:"management_ipmi_credentials,omitempty"`
 	// The protocol used to connect to the management board. Valid options are: * `none` - No management board * `snmp` - Connect using SNMP * `ipmi` - Connect using IPMI
 	ManagementProtocol FolderCreateAttributeManagementProtocol `json:"management_protocol,omitempty"`
 	// SNMP credentials
-	ManagementSnmpCommunity interface{} `json:"management_snmp_community,omitempty"`
+	ManagementSnmpCommunity *SNMPCredentials `json:"management_snmp_community,omitempty"`
 	// Configuration for automatic network scan. Pings will besent to each IP address in the configured ranges to checkif a host is up or down. Each found host will be added tothe folder by it's hostname (if possible) or IP address.
-	NetworkScan interface{} `json:"network_scan,omitempty"`
+	NetworkScan *NetworkScan `json:"network_scan,omitempty"`
 	// A list of parents of this host.
 	Parents []string `json:"parents,omitempty"`
 	// The site that should monitor this host.
 	Site string `json:"site,omitempty"`
 	// The SNMP access configuration. A configured SNMP v1/v2 community here will have precedence over any configured SNMP community rule. For this attribute to take effect, the attribute `tag_snmp_ds` needs to be set first.
-	SnmpCommunity interface{} `json:"snmp_community,omitempty"`
+	SnmpCommunity *SNMPCredentials `json:"snmp_community,omitempty"`
 	// Choices: * `"ip-v4-only"`: IPv4 only * `"ip-v6-only"`: IPv6 only * `"ip-v4v6"`: IPv4/IPv6 dual-stack * `"no-ip"`: No IP
 	TagAddressFamily FolderCreateAttributeTagAddressFamily `json:"tag_address_family,omitempty"`
 	// Choices: * `"cmk-agent"`: API integrations if configured, else Checkmk agent * `"all-agents"`: Configured API integrations and Checkmk agent * `"special-agents"`: Configured API integrations, no Checkmk agent * `"no-agent"`: No API integrations, no Checkmk agent
@@ -2701,31 +5161,31 @@ type FolderExtensions struct {
 // FolderMembers represents a CheckMK API type.
 type FolderMembers struct {
 	// A list of links pointing to the actual host-resources.
-	Hosts interface{} `json:"hosts,omitempty"`
+	Hosts *ObjectCollectionMember `json:"hosts,omitempty"`
 	// An action which triggers the move of this folder to another folder.
-	Move interface{} `json:"move,omitempty"`
+	Move *ObjectActionMember `json:"move,omitempty"`
 }
 
 // FolderUpdateAttribute represents a CheckMK API type.
 type FolderUpdateAttribute struct {
 	// Only members of the contact groups listed here have Setup permission for the host/folder. Optionally, you can make these contact groups automatically monitor contacts. The assignment of hosts to contact groups can also be defined by <a href='wato.py?mode=edit_ruleset&varname=host_contactgroups'>r...
-	Contactgroups interface{} `json:"contactgroups,omitempty"`
+	Contactgroups *HostContactGroup `json:"contactgroups,omitempty"`
 	// Labels allow you to flexibly group your hosts in order to refer to them later at other places in Checkmk, e.g. in rule chains.<br><b>Label format:</b> key:value<br><br>Checkmk does not perform any validation on the labels you use.
 	Labels map[string]interface{} `json:"labels,omitempty"`
 	// IPMI credentials
-	ManagementIpmiCredentials interface{} `json:"management_ipmi_credentials,omitempty"`
+	ManagementIpmiCredentials *IPMIParameters `json:"management_ipmi_credentials,omitempty"`
 	// The protocol used to connect to the management board. Valid options are: * `none` - No management board * `snmp` - Connect using SNMP * `ipmi` - Connect using IPMI
 	ManagementProtocol FolderUpdateAttributeManagementProtocol `json:"management_protocol,omitempty"`
 	// SNMP credentials
-	ManagementSnmpCommunity interface{} `json:"management_snmp_community,omitempty"`
+	ManagementSnmpCommunity *SNMPCredentials `json:"management_snmp_community,omitempty"`
 	// Configuration for automatic network scan. Pings will besent to each IP address in the configured ranges to checkif a host is up or down. Each found host will be added tothe folder by it's hostname (if possible) or IP address.
-	NetworkScan interface{} `json:"network_scan,omitempty"`
+	NetworkScan *NetworkScan `json:"network_scan,omitempty"`
 	// A list of parents of this host.
 	Parents []string `json:"parents,omitempty"`
 	// The site that should monitor this host.
 	Site string `json:"site,omitempty"`
 	// The SNMP access configuration. A configured SNMP v1/v2 community here will have precedence over any configured SNMP community rule. For this attribute to take effect, the attribute `tag_snmp_ds` needs to be set first.
-	SnmpCommunity interface{} `json:"snmp_community,omitempty"`
+	SnmpCommunity *SNMPCredentials `json:"snmp_community,omitempty"`
 	// Choices: * `"ip-v4-only"`: IPv4 only * `"ip-v6-only"`: IPv6 only * `"ip-v4v6"`: IPv4/IPv6 dual-stack * `"no-ip"`: No IP
 	TagAddressFamily FolderUpdateAttributeTagAddressFamily `json:"tag_address_family,omitempty"`
 	// Choices: * `"cmk-agent"`: API integrations if configured, else Checkmk agent * `"all-agents"`: Configured API integrations and Checkmk agent * `"special-agents"`: Configured API integrations, no Checkmk agent * `"no-agent"`: No API integrations, no Checkmk agent
@@ -2743,27 +5203,27 @@ type FolderUpdateAttribute struct {
 // FolderViewAttribute represents a CheckMK API type.
 type FolderViewAttribute struct {
 	// Only members of the contact groups listed here have Setup permission for the host/folder. Optionally, you can make these contact groups automatically monitor contacts. The assignment of hosts to contact groups can also be defined by <a href='wato.py?mode=edit_ruleset&varname=host_contactgroups'>r...
-	Contactgroups interface{} `json:"contactgroups,omitempty"`
+	Contactgroups *HostContactGroup `json:"contactgroups,omitempty"`
 	// Labels allow you to flexibly group your hosts in order to refer to them later at other places in Checkmk, e.g. in rule chains.<br><b>Label format:</b> key:value<br><br>Checkmk does not perform any validation on the labels you use.
 	Labels map[string]interface{} `json:"labels,omitempty"`
 	// IPMI credentials
-	ManagementIpmiCredentials interface{} `json:"management_ipmi_credentials,omitempty"`
+	ManagementIpmiCredentials *IPMIParameters `json:"management_ipmi_credentials,omitempty"`
 	// The protocol used to connect to the management board. Valid options are: * `none` - No management board * `snmp` - Connect using SNMP * `ipmi` - Connect using IPMI
 	ManagementProtocol FolderViewAttributeManagementProtocol `json:"management_protocol,omitempty"`
 	// SNMP credentials
-	ManagementSnmpCommunity interface{} `json:"management_snmp_community,omitempty"`
+	ManagementSnmpCommunity *SNMPCredentials `json:"management_snmp_community,omitempty"`
 	// Read only access to configured metadata.
-	MetaData interface{} `json:"meta_data,omitempty"`
+	MetaData *MetaData `json:"meta_data,omitempty"`
 	// Configuration for automatic network scan. Pings will besent to each IP address in the configured ranges to checkif a host is up or down. Each found host will be added tothe folder by it's hostname (if possible) or IP address.
-	NetworkScan interface{} `json:"network_scan,omitempty"`
+	NetworkScan *NetworkScan `json:"network_scan,omitempty"`
 	// Read only access to the network scan result
-	NetworkScanResult interface{} `json:"network_scan_result,omitempty"`
+	NetworkScanResult *NetworkScanResult `json:"network_scan_result,omitempty"`
 	// A list of parents of this host.
 	Parents []string `json:"parents,omitempty"`
 	// The site that should monitor this host.
 	Site string `json:"site,omitempty"`
 	// The SNMP access configuration. A configured SNMP v1/v2 community here will have precedence over any configured SNMP community rule. For this attribute to take effect, the attribute `tag_snmp_ds` needs to be set first.
-	SnmpCommunity interface{} `json:"snmp_community,omitempty"`
+	SnmpCommunity *SNMPCredentials `json:"snmp_community,omitempty"`
 	TagAddressFamily string `json:"tag_address_family,omitempty"`
 	TagAgent string `json:"tag_agent,omitempty"`
 	TagCriticality string `json:"tag_criticality,omitempty"`
@@ -2773,8 +5233,90 @@ type FolderViewAttribute struct {
 	TagSnmpDs string `json:"tag_snmp_ds,omitempty"`
 }
 
-// FromDetailsOneOf represents a CheckMK API type.
+// FromDetailsOneOf is a union of Checkbox, FromEmailAndNameCheckbox, selected by the "state" property.
 type FromDetailsOneOf struct {
+	union json.RawMessage
+}
+
+// Discriminator returns the value of the "state" property.
+func (u FromDetailsOneOf) Discriminator() (string, error) {
+	return unionDiscriminator(u.union, "state")
+}
+
+// AsCheckbox returns the union data as a Checkbox.
+func (u FromDetailsOneOf) AsCheckbox() (Checkbox, error) {
+	var v Checkbox
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromCheckbox sets the union to v, with "state" set to "disabled".
+func (u *FromDetailsOneOf) FromCheckbox(v Checkbox) error {
+	data, err := unionFrom(v, "state", "disabled")
+	if err != nil {
+		return fmt.Errorf("FromDetailsOneOf: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsFromEmailAndNameCheckbox returns the union data as a FromEmailAndNameCheckbox.
+func (u FromDetailsOneOf) AsFromEmailAndNameCheckbox() (FromEmailAndNameCheckbox, error) {
+	var v FromEmailAndNameCheckbox
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromFromEmailAndNameCheckbox sets the union to v, with "state" set to "enabled".
+func (u *FromDetailsOneOf) FromFromEmailAndNameCheckbox(v FromEmailAndNameCheckbox) error {
+	data, err := unionFrom(v, "state", "enabled")
+	if err != nil {
+		return fmt.Errorf("FromDetailsOneOf: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// ValueByDiscriminator decodes the union into the member selected by "state".
+func (u FromDetailsOneOf) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := u.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "disabled":
+		return u.AsCheckbox()
+	case "enabled":
+		return u.AsFromEmailAndNameCheckbox()
+	}
+	return nil, fmt.Errorf("FromDetailsOneOf: unknown state %q", discriminator)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u FromDetailsOneOf) MarshalJSON() ([]byte, error) {
+	if len(u.union) == 0 {
+		return []byte("null"), nil
+	}
+	return u.union, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting unknown "state" values.
+func (u *FromDetailsOneOf) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		u.union = nil
+		return nil
+	}
+	discriminator, err := unionDiscriminator(data, "state")
+	if err != nil {
+		return fmt.Errorf("FromDetailsOneOf: %w", err)
+	}
+	switch discriminator {
+	case "disabled", "enabled":
+	default:
+		return fmt.Errorf("FromDetailsOneOf: unknown state %q", discriminator)
+	}
+	u.union = append(json.RawMessage(nil), data...)
+	return nil
 }
 
 // FromEmailAndNameCheckbox represents a CheckMK API type.
@@ -2783,7 +5325,7 @@ type FromEmailAndNameCheckbox struct {
 	// Example: enabled
 	State FromEmailAndNameCheckboxState `json:"state"`
 	// The email address and visible name used in the From header of notifications messages. If no email address is specified the default address is OMD_SITE@FQDN is used. If the environment variable OMD_SITE is not set it defaults to checkmk
-	Value interface{} `json:"value,omitempty"`
+	Value *EmailAndDisplayName `json:"value,omitempty"`
 }
 
 // FromToNotificationNumbers represents a CheckMK API type.
@@ -2806,8 +5348,90 @@ type FromToServiceLevels struct {
 	ToLevel int `json:"to_level"`
 }
 
-// Get represents a CheckMK API type.
+// Get is a union of GetGraph, GetMetric, selected by the "type" property.
 type Get struct {
+	union json.RawMessage
+}
+
+// Discriminator returns the value of the "type" property.
+func (u Get) Discriminator() (string, error) {
+	return unionDiscriminator(u.union, "type")
+}
+
+// AsGetGraph returns the union data as a GetGraph.
+func (u Get) AsGetGraph() (GetGraph, error) {
+	var v GetGraph
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromGetGraph sets the union to v, with "type" set to "predefined_graph".
+func (u *Get) FromGetGraph(v GetGraph) error {
+	data, err := unionFrom(v, "type", "predefined_graph")
+	if err != nil {
+		return fmt.Errorf("Get: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsGetMetric returns the union data as a GetMetric.
+func (u Get) AsGetMetric() (GetMetric, error) {
+	var v GetMetric
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromGetMetric sets the union to v, with "type" set to "single_metric".
+func (u *Get) FromGetMetric(v GetMetric) error {
+	data, err := unionFrom(v, "type", "single_metric")
+	if err != nil {
+		return fmt.Errorf("Get: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// ValueByDiscriminator decodes the union into the member selected by "type".
+func (u Get) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := u.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "predefined_graph":
+		return u.AsGetGraph()
+	case "single_metric":
+		return u.AsGetMetric()
+	}
+	return nil, fmt.Errorf("Get: unknown type %q", discriminator)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u Get) MarshalJSON() ([]byte, error) {
+	if len(u.union) == 0 {
+		return []byte("null"), nil
+	}
+	return u.union, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting unknown "type" values.
+func (u *Get) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		u.union = nil
+		return nil
+	}
+	discriminator, err := unionDiscriminator(data, "type")
+	if err != nil {
+		return fmt.Errorf("Get: %w", err)
+	}
+	switch discriminator {
+	case "predefined_graph", "single_metric":
+	default:
+		return fmt.Errorf("Get: unknown type %q", discriminator)
+	}
+	u.union = append(json.RawMessage(nil), data...)
+	return nil
 }
 
 // GetGraph represents a CheckMK API type.
@@ -2829,7 +5453,7 @@ type GetGraph struct {
 	Site string `json:"site,omitempty"`
 	// The time range from which to source the metrics.
 	// Example: map[end:2026-01-01 14:12:30.224746 start:2026-01-01 13:57:30.224744]
-	TimeRange interface{} `json:"time_range"`
+	TimeRange TimeRange `json:"time_range"`
 	// Specify whether you want to receive a single metric (via metric_id), or a predefined graph containing multiple metrics (via graph_id).
 	// Example: single_metric
 	Type GetGraphType `json:"type"`
@@ -2854,7 +5478,7 @@ type GetMetric struct {
 	Site string `json:"site,omitempty"`
 	// The time range from which to source the metrics.
 	// Example: map[end:2026-01-01 14:12:30.224746 start:2026-01-01 13:57:30.224744]
-	TimeRange interface{} `json:"time_range"`
+	TimeRange TimeRange `json:"time_range"`
 	// Specify whether you want to receive a single metric (via metric_id), or a predefined graph containing multiple metrics (via graph_id).
 	// Example: single_metric
 	Type GetMetricType `json:"type"`
@@ -2864,13 +5488,13 @@ type GetMetric struct {
 type GraphCollection struct {
 	// The actual graph data.
 	// Example: [map[color:#ffffff data_points:[1 2 3 1] line_type:area title:RAM used]]
-	Metrics []map[string]interface{} `json:"metrics"`
+	Metrics []Metric `json:"metrics"`
 	// The interval between two samples in seconds.
 	// Example: 60
 	Step int `json:"step"`
 	// The time range withing the samples of the response lie.
 	// Example: map[time_range:map[end:1970-01-01T00:00:30Z start:1970-01-01T00:00:00Z]]
-	TimeRange interface{} `json:"time_range"`
+	TimeRange TimeRange `json:"time_range"`
 }
 
 // GraphsPerNotification represents a CheckMK API type.
@@ -2883,28 +5507,110 @@ type GraphsPerNotification struct {
 	Value int `json:"value"`
 }
 
-// GraphsPerNotificationOneOf represents a CheckMK API type.
+// GraphsPerNotificationOneOf is a union of Checkbox, GraphsPerNotification, selected by the "state" property.
 type GraphsPerNotificationOneOf struct {
+	union json.RawMessage
+}
+
+// Discriminator returns the value of the "state" property.
+func (u GraphsPerNotificationOneOf) Discriminator() (string, error) {
+	return unionDiscriminator(u.union, "state")
+}
+
+// AsCheckbox returns the union data as a Checkbox.
+func (u GraphsPerNotificationOneOf) AsCheckbox() (Checkbox, error) {
+	var v Checkbox
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromCheckbox sets the union to v, with "state" set to "disabled".
+func (u *GraphsPerNotificationOneOf) FromCheckbox(v Checkbox) error {
+	data, err := unionFrom(v, "state", "disabled")
+	if err != nil {
+		return fmt.Errorf("GraphsPerNotificationOneOf: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsGraphsPerNotification returns the union data as a GraphsPerNotification.
+func (u GraphsPerNotificationOneOf) AsGraphsPerNotification() (GraphsPerNotification, error) {
+	var v GraphsPerNotification
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromGraphsPerNotification sets the union to v, with "state" set to "enabled".
+func (u *GraphsPerNotificationOneOf) FromGraphsPerNotification(v GraphsPerNotification) error {
+	data, err := unionFrom(v, "state", "enabled")
+	if err != nil {
+		return fmt.Errorf("GraphsPerNotificationOneOf: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// ValueByDiscriminator decodes the union into the member selected by "state".
+func (u GraphsPerNotificationOneOf) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := u.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "disabled":
+		return u.AsCheckbox()
+	case "enabled":
+		return u.AsGraphsPerNotification()
+	}
+	return nil, fmt.Errorf("GraphsPerNotificationOneOf: unknown state %q", discriminator)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u GraphsPerNotificationOneOf) MarshalJSON() ([]byte, error) {
+	if len(u.union) == 0 {
+		return []byte("null"), nil
+	}
+	return u.union, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting unknown "state" values.
+func (u *GraphsPerNotificationOneOf) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		u.union = nil
+		return nil
+	}
+	discriminator, err := unionDiscriminator(data, "state")
+	if err != nil {
+		return fmt.Errorf("GraphsPerNotificationOneOf: %w", err)
+	}
+	switch discriminator {
+	case "disabled", "enabled":
+	default:
+		return fmt.Errorf("GraphsPerNotificationOneOf: unknown state %q", discriminator)
+	}
+	u.union = append(json.RawMessage(nil), data...)
+	return nil
 }
 
 // HTMLMailPluginCreate represents a CheckMK API type.
 type HTMLMailPluginCreate struct {
-	BulkNotificationsWithGraphs map[string]interface{} `json:"bulk_notifications_with_graphs"`
-	DisplayGraphsAmongEachOther map[string]interface{} `json:"display_graphs_among_each_other"`
-	EnableSyncSmtp map[string]interface{} `json:"enable_sync_smtp"`
-	FromDetails map[string]interface{} `json:"from_details"`
-	GraphsPerNotification map[string]interface{} `json:"graphs_per_notification"`
-	InfoToBeDisplayedInTheEmailBody map[string]interface{} `json:"info_to_be_displayed_in_the_email_body"`
-	InsertHtmlSectionBetweenBodyAndTable map[string]interface{} `json:"insert_html_section_between_body_and_table"`
+	BulkNotificationsWithGraphs BulkNotificationsOneOf `json:"bulk_notifications_with_graphs"`
+	DisplayGraphsAmongEachOther Checkbox `json:"display_graphs_among_each_other"`
+	EnableSyncSmtp EnableSyncOneOf `json:"enable_sync_smtp"`
+	FromDetails FromDetailsOneOf `json:"from_details"`
+	GraphsPerNotification GraphsPerNotificationOneOf `json:"graphs_per_notification"`
+	InfoToBeDisplayedInTheEmailBody EmailInfoOneOf `json:"info_to_be_displayed_in_the_email_body"`
+	InsertHtmlSectionBetweenBodyAndTable InsertHtmlOneOf `json:"insert_html_section_between_body_and_table"`
 	// The plugin name.
 	// Example: mail
 	PluginName HTMLMailPluginCreatePluginName `json:"plugin_name"`
-	ReplyTo map[string]interface{} `json:"reply_to"`
-	SendSeparateNotificationToEveryRecipient map[string]interface{} `json:"send_separate_notification_to_every_recipient"`
-	SortOrderForBulkNotificaions map[string]interface{} `json:"sort_order_for_bulk_notificaions"`
-	SubjectForHostNotifications map[string]interface{} `json:"subject_for_host_notifications"`
-	SubjectForServiceNotifications map[string]interface{} `json:"subject_for_service_notifications"`
-	UrlPrefixForLinksToCheckmk map[string]interface{} `json:"url_prefix_for_links_to_checkmk"`
+	ReplyTo ReplyToOneOf `json:"reply_to"`
+	SendSeparateNotificationToEveryRecipient Checkbox `json:"send_separate_notification_to_every_recipient"`
+	SortOrderForBulkNotificaions SortOrderOneOf `json:"sort_order_for_bulk_notificaions"`
+	SubjectForHostNotifications SubjectHostOneOf `json:"subject_for_host_notifications"`
+	SubjectForServiceNotifications SubjectServiceOneOf `json:"subject_for_service_notifications"`
+	UrlPrefixForLinksToCheckmk UrlPrefixOneOf `json:"url_prefix_for_links_to_checkmk"`
 }
 
 // Heartbeat represents a CheckMK API type.
@@ -2934,7 +5640,7 @@ type Host struct {
 
 // HostConditions represents a CheckMK API type.
 type HostConditions struct {
-	HostChoice interface{} `json:"host_choice"`
+	HostChoice BIHostChoice `json:"host_choice"`
 	HostFolder string `json:"host_folder"`
 	HostLabels map[string]interface{} `json:"host_labels"`
 	HostTags map[string]interface{} `json:"host_tags"`
@@ -2945,13 +5651,13 @@ type HostConfig struct {
 	// The domain type of the object.
 	DomainType interface{} `json:"domainType"`
 	// All the data and metadata of this host.
-	Extensions interface{} `json:"extensions,omitempty"`
+	Extensions *HostExtensions `json:"extensions,omitempty"`
 	// The unique identifier for this domain-object type.
 	Id string `json:"id,omitempty"`
 	// list of links to other resources.
-	Links []map[string]interface{} `json:"links"`
+	Links []Link `json:"links"`
 	// All the members of the host object.
-	Members interface{} `json:"members,omitempty"`
+	Members *HostMembers `json:"members,omitempty"`
 	// A human readable title of this object. Can be used for user interfaces.
 	Title string `json:"title,omitempty"`
 }
@@ -2965,11 +5671,11 @@ type HostConfigCollection struct {
 	// The name of this collection.
 	Id string `json:"id,omitempty"`
 	// list of links to other resources.
-	Links []map[string]interface{} `json:"links"`
+	Links []Link `json:"links"`
 	// A human readable title of this object. Can be used for user interfaces.
 	Title string `json:"title,omitempty"`
 	// A list of host objects.
-	Value []map[string]interface{} `json:"value,omitempty"`
+	Value []HostConfig `json:"value,omitempty"`
 }
 
 // HostConfigSchemaInternal represents a CheckMK API type.
@@ -3003,7 +5709,7 @@ type HostCreateAttribute struct {
 	// Add a comment or describe this host
 	Alias string `json:"alias,omitempty"`
 	// Only members of the contact groups listed here have Setup permission for the host/folder. Optionally, you can make these contact groups automatically monitor contacts. The assignment of hosts to contact groups can also be defined by <a href='wato.py?mode=edit_ruleset&varname=host_contactgroups'>r...
-	Contactgroups interface{} `json:"contactgroups,omitempty"`
+	Contactgroups *HostContactGroup `json:"contactgroups,omitempty"`
 	// Whether or not the last bulk discovery failed. It is set to True once it fails and unset in case a later discovery succeeds.
 	// Example: false
 	InventoryFailed bool `json:"inventory_failed,omitempty"`
@@ -3016,23 +5722,23 @@ type HostCreateAttribute struct {
 	// Name of host attributes which are locked in the UI.
 	LockedAttributes []string `json:"locked_attributes,omitempty"`
 	// Identity of the entity which locked the locked_attributes. The identity is built out of the Site ID, the program name and the connection ID.
-	LockedBy interface{} `json:"locked_by,omitempty"`
+	LockedBy *LockedBy `json:"locked_by,omitempty"`
 	// Address (IPv4, IPv6 or hostname) under which the management board can be reached.
 	ManagementAddress string `json:"management_address,omitempty"`
 	// IPMI credentials
-	ManagementIpmiCredentials interface{} `json:"management_ipmi_credentials,omitempty"`
+	ManagementIpmiCredentials *IPMIParameters `json:"management_ipmi_credentials,omitempty"`
 	// The protocol used to connect to the management board. Valid options are: * `none` - No management board * `snmp` - Connect using SNMP * `ipmi` - Connect using IPMI
 	ManagementProtocol HostCreateAttributeManagementProtocol `json:"management_protocol,omitempty"`
 	// SNMP credentials
-	ManagementSnmpCommunity interface{} `json:"management_snmp_community,omitempty"`
+	ManagementSnmpCommunity *SNMPCredentials `json:"management_snmp_community,omitempty"`
 	// Configuration for automatic network scan. Pings will besent to each IP address in the configured ranges to checkif a host is up or down. Each found host will be added tothe folder by it's hostname (if possible) or IP address.
-	NetworkScan interface{} `json:"network_scan,omitempty"`
+	NetworkScan *NetworkScan `json:"network_scan,omitempty"`
 	// A list of parents of this host.
 	Parents []string `json:"parents,omitempty"`
 	// The site that should monitor this host.
 	Site string `json:"site,omitempty"`
 	// The SNMP access configuration. A configured SNMP v1/v2 community here will have precedence over any configured SNMP community rule. For this attribute to take effect, the attribute `tag_snmp_ds` needs to be set first.
-	SnmpCommunity interface{} `json:"snmp_community,omitempty"`
+	SnmpCommunity *SNMPCredentials `json:"snmp_community,omitempty"`
 	// Choices: * `"ip-v4-only"`: IPv4 only * `"ip-v6-only"`: IPv6 only * `"ip-v4v6"`: IPv4/IPv6 dual-stack * `"no-ip"`: No IP
 	TagAddressFamily HostCreateAttributeTagAddressFamily `json:"tag_address_family,omitempty"`
 	// Choices: * `"cmk-agent"`: API integrations if configured, else Checkmk agent * `"all-agents"`: Configured API integrations and Checkmk agent * `"special-agents"`: Configured API integrations, no Checkmk agent * `"no-agent"`: No API integrations, no Checkmk agent
@@ -3074,7 +5780,7 @@ type HostExtensions struct {
 	ClusterNodes []string `json:"cluster_nodes,omitempty"`
 	// All attributes of this host and all parent folders.
 	// Example: map[tag_snmp_ds:<nil>]
-	EffectiveAttributes interface{} `json:"effective_attributes,omitempty"`
+	EffectiveAttributes *HostExtensionsEffectiveAttributes `json:"effective_attributes,omitempty"`
 	// The folder, in which this host resides. Path delimiters can be either `~`, `/` or `\`. Please use the one most appropriate for your quoting/escaping needs. A good default choice is `~`.
 	Folder string `json:"folder,omitempty"`
 	// If this is a cluster host, i.e. a container for other hosts.
@@ -3092,7 +5798,7 @@ type HostExtensionsEffectiveAttributes struct {
 	// Add a comment or describe this host
 	Alias string `json:"alias,omitempty"`
 	// Only members of the contact groups listed here have Setup permission for the host/folder. Optionally, you can make these contact groups automatically monitor contacts. The assignment of hosts to contact groups can also be defined by <a href='wato.py?mode=edit_ruleset&varname=host_contactgroups'>r...
-	Contactgroups interface{} `json:"contactgroups,omitempty"`
+	Contactgroups *HostContactGroup `json:"contactgroups,omitempty"`
 	// Whether or not the last bulk discovery failed. It is set to True once it fails and unset in case a later discovery succeeds.
 	// Example: false
 	InventoryFailed bool `json:"inventory_failed,omitempty"`
@@ -3105,27 +5811,27 @@ type HostExtensionsEffectiveAttributes struct {
 	// Name of host attributes which are locked in the UI.
 	LockedAttributes []string `json:"locked_attributes,omitempty"`
 	// Identity of the entity which locked the locked_attributes. The identity is built out of the Site ID, the program name and the connection ID.
-	LockedBy interface{} `json:"locked_by,omitempty"`
+	LockedBy *LockedBy `json:"locked_by,omitempty"`
 	// Address (IPv4, IPv6 or hostname) under which the management board can be reached.
 	ManagementAddress string `json:"management_address,omitempty"`
 	// IPMI credentials
-	ManagementIpmiCredentials interface{} `json:"management_ipmi_credentials,omitempty"`
+	ManagementIpmiCredentials *IPMIParameters `json:"management_ipmi_credentials,omitempty"`
 	// The protocol used to connect to the management board. Valid options are: * `none` - No management board * `snmp` - Connect using SNMP * `ipmi` - Connect using IPMI
 	ManagementProtocol HostExtensionsEffectiveAttributesManagementProtocol `json:"management_protocol,omitempty"`
 	// SNMP credentials
-	ManagementSnmpCommunity interface{} `json:"management_snmp_community,omitempty"`
+	ManagementSnmpCommunity *SNMPCredentials `json:"management_snmp_community,omitempty"`
 	// Read only access to configured metadata.
-	MetaData interface{} `json:"meta_data,omitempty"`
+	MetaData *MetaData `json:"meta_data,omitempty"`
 	// Configuration for automatic network scan. Pings will besent to each IP address in the configured ranges to checkif a host is up or down. Each found host will be added tothe folder by it's hostname (if possible) or IP address.
-	NetworkScan interface{} `json:"network_scan,omitempty"`
+	NetworkScan *NetworkScan `json:"network_scan,omitempty"`
 	// Read only access to the network scan result
-	NetworkScanResult interface{} `json:"network_scan_result,omitempty"`
+	NetworkScanResult *NetworkScanResult `json:"network_scan_result,omitempty"`
 	// A list of parents of this host.
 	Parents []string `json:"parents,omitempty"`
 	// The site that should monitor this host.
 	Site string `json:"site,omitempty"`
 	// The SNMP access configuration. A configured SNMP v1/v2 community here will have precedence over any configured SNMP community rule. For this attribute to take effect, the attribute `tag_snmp_ds` needs to be set first.
-	SnmpCommunity interface{} `json:"snmp_community,omitempty"`
+	SnmpCommunity *SNMPCredentials `json:"snmp_community,omitempty"`
 	TagAddressFamily string `json:"tag_address_family,omitempty"`
 	TagAgent string `json:"tag_agent,omitempty"`
 	TagCriticality string `json:"tag_criticality,omitempty"`
@@ -3144,7 +5850,7 @@ type HostGroup struct {
 	// The unique identifier for this domain-object type.
 	Id string `json:"id,omitempty"`
 	// list of links to other resources.
-	Links []map[string]interface{} `json:"links"`
+	Links []Link `json:"links"`
 	// The container for external resources, like linked foreign objects or actions.
 	Members map[string]interface{} `json:"members,omitempty"`
 	// A human readable title of this object. Can be used for user interfaces.
@@ -3160,17 +5866,17 @@ type HostGroupCollection struct {
 	// The name of this collection.
 	Id string `json:"id,omitempty"`
 	// list of links to other resources.
-	Links []map[string]interface{} `json:"links"`
+	Links []Link `json:"links"`
 	// A human readable title of this object. Can be used for user interfaces.
 	Title string `json:"title,omitempty"`
 	// A list of host group objects.
-	Value []map[string]interface{} `json:"value,omitempty"`
+	Value []HostGroup `json:"value,omitempty"`
 }
 
 // HostMembers represents a CheckMK API type.
 type HostMembers struct {
 	// The folder in which this host resides. It is represented by a hexadecimal identifier which is it's 'primary key'. The folder can be accessed via the `self`-link provided in the links array.
-	FolderConfig interface{} `json:"folder_config,omitempty"`
+	FolderConfig *Folder `json:"folder_config,omitempty"`
 }
 
 // HostOrServiceCondition represents a CheckMK API type.
@@ -3209,7 +5915,7 @@ type HostTagExtensions struct {
 	// A help description for the tag group
 	Help string `json:"help,omitempty"`
 	// The list of tags in this group.
-	Tags []map[string]interface{} `json:"tags,omitempty"`
+	Tags []HostTag1 `json:"tags,omitempty"`
 	// The topic this host tag group is organized in.
 	Topic string `json:"topic,omitempty"`
 }
@@ -3223,11 +5929,11 @@ type HostTagGroupCollection struct {
 	// The name of this collection.
 	Id string `json:"id,omitempty"`
 	// list of links to other resources.
-	Links []map[string]interface{} `json:"links"`
+	Links []Link `json:"links"`
 	// A human readable title of this object. Can be used for user interfaces.
 	Title string `json:"title,omitempty"`
 	// A list of host tag group objects.
-	Value []map[string]interface{} `json:"value,omitempty"`
+	Value []ConcreteHostTagGroup `json:"value,omitempty"`
 }
 
 // HostUpdateAttribute represents a CheckMK API type.
@@ -3239,7 +5945,7 @@ type HostUpdateAttribute struct {
 	// Add a comment or describe this host
 	Alias string `json:"alias,omitempty"`
 	// Only members of the contact groups listed here have Setup permission for the host/folder. Optionally, you can make these contact groups automatically monitor contacts. The assignment of hosts to contact groups can also be defined by <a href='wato.py?mode=edit_ruleset&varname=host_contactgroups'>r...
-	Contactgroups interface{} `json:"contactgroups,omitempty"`
+	Contactgroups *HostContactGroup `json:"contactgroups,omitempty"`
 	// Whether or not the last bulk discovery failed. It is set to True once it fails and unset in case a later discovery succeeds.
 	// Example: false
 	InventoryFailed bool `json:"inventory_failed,omitempty"`
@@ -3252,23 +5958,23 @@ type HostUpdateAttribute struct {
 	// Name of host attributes which are locked in the UI.
 	LockedAttributes []string `json:"locked_attributes,omitempty"`
 	// Identity of the entity which locked the locked_attributes. The identity is built out of the Site ID, the program name and the connection ID.
-	LockedBy interface{} `json:"locked_by,omitempty"`
+	LockedBy *LockedBy `json:"locked_by,omitempty"`
 	// Address (IPv4, IPv6 or hostname) under which the management board can be reached.
 	ManagementAddress string `json:"management_address,omitempty"`
 	// IPMI credentials
-	ManagementIpmiCredentials interface{} `json:"management_ipmi_credentials,omitempty"`
+	ManagementIpmiCredentials *IPMIParameters `json:"management_ipmi_credentials,omitempty"`
 	// The protocol used to connect to the management board. Valid options are: * `none` - No management board * `snmp` - Connect using SNMP * `ipmi` - Connect using IPMI
 	ManagementProtocol HostUpdateAttributeManagementProtocol `json:"management_protocol,omitempty"`
 	// SNMP credentials
-	ManagementSnmpCommunity interface{} `json:"management_snmp_community,omitempty"`
+	ManagementSnmpCommunity *SNMPCredentials `json:"management_snmp_community,omitempty"`
 	// Configuration for automatic network scan. Pings will besent to each IP address in the configured ranges to checkif a host is up or down. Each found host will be added tothe folder by it's hostname (if possible) or IP address.
-	NetworkScan interface{} `json:"network_scan,omitempty"`
+	NetworkScan *NetworkScan `json:"network_scan,omitempty"`
 	// A list of parents of this host.
 	Parents []string `json:"parents,omitempty"`
 	// The site that should monitor this host.
 	Site string `json:"site,omitempty"`
 	// The SNMP access configuration. A configured SNMP v1/v2 community here will have precedence over any configured SNMP community rule. For this attribute to take effect, the attribute `tag_snmp_ds` needs to be set first.
-	SnmpCommunity interface{} `json:"snmp_community,omitempty"`
+	SnmpCommunity *SNMPCredentials `json:"snmp_community,omitempty"`
 	// Choices: * `"ip-v4-only"`: IPv4 only * `"ip-v6-only"`: IPv6 only * `"ip-v4v6"`: IPv4/IPv6 dual-stack * `"no-ip"`: No IP
 	TagAddressFamily HostUpdateAttributeTagAddressFamily `json:"tag_address_family,omitempty"`
 	// Choices: * `"cmk-agent"`: API integrations if configured, else Checkmk agent * `"all-agents"`: Configured API integrations and Checkmk agent * `"special-agents"`: Configured API integrations, no Checkmk agent * `"no-agent"`: No API integrations, no Checkmk agent
@@ -3292,7 +5998,7 @@ type HostViewAttribute struct {
 	// Add a comment or describe this host
 	Alias string `json:"alias,omitempty"`
 	// Only members of the contact groups listed here have Setup permission for the host/folder. Optionally, you can make these contact groups automatically monitor contacts. The assignment of hosts to contact groups can also be defined by <a href='wato.py?mode=edit_ruleset&varname=host_contactgroups'>r...
-	Contactgroups interface{} `json:"contactgroups,omitempty"`
+	Contactgroups *HostContactGroup `json:"contactgroups,omitempty"`
 	// Whether or not the last bulk discovery failed. It is set to True once it fails and unset in case a later discovery succeeds.
 	// Example: false
 	InventoryFailed bool `json:"inventory_failed,omitempty"`
@@ -3305,27 +6011,27 @@ type HostViewAttribute struct {
 	// Name of host attributes which are locked in the UI.
 	LockedAttributes []string `json:"locked_attributes,omitempty"`
 	// Identity of the entity which locked the locked_attributes. The identity is built out of the Site ID, the program name and the connection ID.
-	LockedBy interface{} `json:"locked_by,omitempty"`
+	LockedBy *LockedBy `json:"locked_by,omitempty"`
 	// Address (IPv4, IPv6 or hostname) under which the management board can be reached.
 	ManagementAddress string `json:"management_address,omitempty"`
 	// IPMI credentials
-	ManagementIpmiCredentials interface{} `json:"management_ipmi_credentials,omitempty"`
+	ManagementIpmiCredentials *IPMIParameters `json:"management_ipmi_credentials,omitempty"`
 	// The protocol used to connect to the management board. Valid options are: * `none` - No management board * `snmp` - Connect using SNMP * `ipmi` - Connect using IPMI
 	ManagementProtocol HostViewAttributeManagementProtocol `json:"management_protocol,omitempty"`
 	// SNMP credentials
-	ManagementSnmpCommunity interface{} `json:"management_snmp_community,omitempty"`
+	ManagementSnmpCommunity *SNMPCredentials `json:"management_snmp_community,omitempty"`
 	// Read only access to configured metadata.
-	MetaData interface{} `json:"meta_data,omitempty"`
+	MetaData *MetaData `json:"meta_data,omitempty"`
 	// Configuration for automatic network scan. Pings will besent to each IP address in the configured ranges to checkif a host is up or down. Each found host will be added tothe folder by it's hostname (if possible) or IP address.
-	NetworkScan interface{} `json:"network_scan,omitempty"`
+	NetworkScan *NetworkScan `json:"network_scan,omitempty"`
 	// Read only access to the network scan result
-	NetworkScanResult interface{} `json:"network_scan_result,omitempty"`
+	NetworkScanResult *NetworkScanResult `json:"network_scan_result,omitempty"`
 	// A list of parents of this host.
 	Parents []string `json:"parents,omitempty"`
 	// The site that should monitor this host.
 	Site string `json:"site,omitempty"`
 	// The SNMP access configuration. A configured SNMP v1/v2 community here will have precedence over any configured SNMP community rule. For this attribute to take effect, the attribute `tag_snmp_ds` needs to be set first.
-	SnmpCommunity interface{} `json:"snmp_community,omitempty"`
+	SnmpCommunity *SNMPCredentials `json:"snmp_community,omitempty"`
 	TagAddressFamily string `json:"tag_address_family,omitempty"`
 	TagAgent string `json:"tag_agent,omitempty"`
 	TagCriticality string `json:"tag_criticality,omitempty"`
@@ -3351,8 +6057,90 @@ type HttpProxy struct {
 	Url string `json:"url,omitempty"`
 }
 
-// HttpProxyOneOf represents a CheckMK API type.
+// HttpProxyOneOf is a union of Checkbox, HttpProxyValue, selected by the "state" property.
 type HttpProxyOneOf struct {
+	union json.RawMessage
+}
+
+// Discriminator returns the value of the "state" property.
+func (u HttpProxyOneOf) Discriminator() (string, error) {
+	return unionDiscriminator(u.union, "state")
+}
+
+// AsCheckbox returns the union data as a Checkbox.
+func (u HttpProxyOneOf) AsCheckbox() (Checkbox, error) {
+	var v Checkbox
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromCheckbox sets the union to v, with "state" set to "disabled".
+func (u *HttpProxyOneOf) FromCheckbox(v Checkbox) error {
+	data, err := unionFrom(v, "state", "disabled")
+	if err != nil {
+		return fmt.Errorf("HttpProxyOneOf: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsHttpProxyValue returns the union data as a HttpProxyValue.
+func (u HttpProxyOneOf) AsHttpProxyValue() (HttpProxyValue, error) {
+	var v HttpProxyValue
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromHttpProxyValue sets the union to v, with "state" set to "enabled".
+func (u *HttpProxyOneOf) FromHttpProxyValue(v HttpProxyValue) error {
+	data, err := unionFrom(v, "state", "enabled")
+	if err != nil {
+		return fmt.Errorf("HttpProxyOneOf: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// ValueByDiscriminator decodes the union into the member selected by "state".
+func (u HttpProxyOneOf) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := u.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "disabled":
+		return u.AsCheckbox()
+	case "enabled":
+		return u.AsHttpProxyValue()
+	}
+	return nil, fmt.Errorf("HttpProxyOneOf: unknown state %q", discriminator)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u HttpProxyOneOf) MarshalJSON() ([]byte, error) {
+	if len(u.union) == 0 {
+		return []byte("null"), nil
+	}
+	return u.union, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting unknown "state" values.
+func (u *HttpProxyOneOf) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		u.union = nil
+		return nil
+	}
+	discriminator, err := unionDiscriminator(data, "state")
+	if err != nil {
+		return fmt.Errorf("HttpProxyOneOf: %w", err)
+	}
+	switch discriminator {
+	case "disabled", "enabled":
+	default:
+		return fmt.Errorf("HttpProxyOneOf: unknown state %q", discriminator)
+	}
+	u.union = append(json.RawMessage(nil), data...)
+	return nil
 }
 
 // HttpProxyValue represents a CheckMK API type.
@@ -3361,11 +6149,93 @@ type HttpProxyValue struct {
 	// Example: enabled
 	State HttpProxyValueState `json:"state"`
 	// Use the proxy settings from the environment variables. The variables NO_PROXY, HTTP_PROXY and HTTPS_PROXY are taken into account during execution. Have a look at the python requests module documentation for further information. Note that these variables must be defined as a site-user in ~/etc/env...
-	Value interface{} `json:"value,omitempty"`
+	Value *HttpProxy `json:"value,omitempty"`
 }
 
-// IPAddressOneOf represents a CheckMK API type.
+// IPAddressOneOf is a union of Checkbox, CheckBoxIPAddressValue, selected by the "state" property.
 type IPAddressOneOf struct {
+	union json.RawMessage
+}
+
+// Discriminator returns the value of the "state" property.
+func (u IPAddressOneOf) Discriminator() (string, error) {
+	return unionDiscriminator(u.union, "state")
+}
+
+// AsCheckbox returns the union data as a Checkbox.
+func (u IPAddressOneOf) AsCheckbox() (Checkbox, error) {
+	var v Checkbox
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromCheckbox sets the union to v, with "state" set to "disabled".
+func (u *IPAddressOneOf) FromCheckbox(v Checkbox) error {
+	data, err := unionFrom(v, "state", "disabled")
+	if err != nil {
+		return fmt.Errorf("IPAddressOneOf: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsCheckBoxIPAddressValue returns the union data as a CheckBoxIPAddressValue.
+func (u IPAddressOneOf) AsCheckBoxIPAddressValue() (CheckBoxIPAddressValue, error) {
+	var v CheckBoxIPAddressValue
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromCheckBoxIPAddressValue sets the union to v, with "state" set to "enabled".
+func (u *IPAddressOneOf) FromCheckBoxIPAddressValue(v CheckBoxIPAddressValue) error {
+	data, err := unionFrom(v, "state", "enabled")
+	if err != nil {
+		return fmt.Errorf("IPAddressOneOf: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// ValueByDiscriminator decodes the union into the member selected by "state".
+func (u IPAddressOneOf) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := u.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "disabled":
+		return u.AsCheckbox()
+	case "enabled":
+		return u.AsCheckBoxIPAddressValue()
+	}
+	return nil, fmt.Errorf("IPAddressOneOf: unknown state %q", discriminator)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u IPAddressOneOf) MarshalJSON() ([]byte, error) {
+	if len(u.union) == 0 {
+		return []byte("null"), nil
+	}
+	return u.union, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting unknown "state" values.
+func (u *IPAddressOneOf) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		u.union = nil
+		return nil
+	}
+	discriminator, err := unionDiscriminator(data, "state")
+	if err != nil {
+		return fmt.Errorf("IPAddressOneOf: %w", err)
+	}
+	switch discriminator {
+	case "disabled", "enabled":
+	default:
+		return fmt.Errorf("IPAddressOneOf: unknown state %q", discriminator)
+	}
+	u.union = append(json.RawMessage(nil), data...)
+	return nil
 }
 
 // IPAddressRange represents a CheckMK API type.
@@ -3399,8 +6269,128 @@ type IPNetwork struct {
 	Type interface{} `json:"type,omitempty"`
 }
 
-// IPRangeWithRegexp represents a CheckMK API type.
+// IPRangeWithRegexp is a union of IPAddressRange, IPNetwork, IPAddresses, IPRegexp, selected by the "type" property.
 type IPRangeWithRegexp struct {
+	union json.RawMessage
+}
+
+// Discriminator returns the value of the "type" property.
+func (u IPRangeWithRegexp) Discriminator() (string, error) {
+	return unionDiscriminator(u.union, "type")
+}
+
+// AsIPAddressRange returns the union data as a IPAddressRange.
+func (u IPRangeWithRegexp) AsIPAddressRange() (IPAddressRange, error) {
+	var v IPAddressRange
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromIPAddressRange sets the union to v, with "type" set to "address_range".
+func (u *IPRangeWithRegexp) FromIPAddressRange(v IPAddressRange) error {
+	data, err := unionFrom(v, "type", "address_range")
+	if err != nil {
+		return fmt.Errorf("IPRangeWithRegexp: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsIPNetwork returns the union data as a IPNetwork.
+func (u IPRangeWithRegexp) AsIPNetwork() (IPNetwork, error) {
+	var v IPNetwork
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromIPNetwork sets the union to v, with "type" set to "network_range".
+func (u *IPRangeWithRegexp) FromIPNetwork(v IPNetwork) error {
+	data, err := unionFrom(v, "type", "network_range")
+	if err != nil {
+		return fmt.Errorf("IPRangeWithRegexp: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsIPAddresses returns the union data as a IPAddresses.
+func (u IPRangeWithRegexp) AsIPAddresses() (IPAddresses, error) {
+	var v IPAddresses
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromIPAddresses sets the union to v, with "type" set to "explicit_addresses".
+func (u *IPRangeWithRegexp) FromIPAddresses(v IPAddresses) error {
+	data, err := unionFrom(v, "type", "explicit_addresses")
+	if err != nil {
+		return fmt.Errorf("IPRangeWithRegexp: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsIPRegexp returns the union data as a IPRegexp.
+func (u IPRangeWithRegexp) AsIPRegexp() (IPRegexp, error) {
+	var v IPRegexp
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromIPRegexp sets the union to v, with "type" set to "exclude_by_regexp".
+func (u *IPRangeWithRegexp) FromIPRegexp(v IPRegexp) error {
+	data, err := unionFrom(v, "type", "exclude_by_regexp")
+	if err != nil {
+		return fmt.Errorf("IPRangeWithRegexp: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// ValueByDiscriminator decodes the union into the member selected by "type".
+func (u IPRangeWithRegexp) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := u.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "address_range":
+		return u.AsIPAddressRange()
+	case "network_range":
+		return u.AsIPNetwork()
+	case "explicit_addresses":
+		return u.AsIPAddresses()
+	case "exclude_by_regexp":
+		return u.AsIPRegexp()
+	}
+	return nil, fmt.Errorf("IPRangeWithRegexp: unknown type %q", discriminator)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u IPRangeWithRegexp) MarshalJSON() ([]byte, error) {
+	if len(u.union) == 0 {
+		return []byte("null"), nil
+	}
+	return u.union, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting unknown "type" values.
+func (u *IPRangeWithRegexp) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		u.union = nil
+		return nil
+	}
+	discriminator, err := unionDiscriminator(data, "type")
+	if err != nil {
+		return fmt.Errorf("IPRangeWithRegexp: %w", err)
+	}
+	switch discriminator {
+	case "address_range", "network_range", "explicit_addresses", "exclude_by_regexp":
+	default:
+		return fmt.Errorf("IPRangeWithRegexp: unknown type %q", discriminator)
+	}
+	u.union = append(json.RawMessage(nil), data...)
+	return nil
 }
 
 // IPRegexp represents a CheckMK API type.
@@ -3427,8 +6417,90 @@ type IlertAPIKey struct {
 	Option IlertAPIKeyOption `json:"option"`
 }
 
-// IlertKeyOrStoreSelector represents a CheckMK API type.
+// IlertKeyOrStoreSelector is a union of IlertAPIKey, IlertPasswordStoreID, selected by the "option" property.
 type IlertKeyOrStoreSelector struct {
+	union json.RawMessage
+}
+
+// Discriminator returns the value of the "option" property.
+func (u IlertKeyOrStoreSelector) Discriminator() (string, error) {
+	return unionDiscriminator(u.union, "option")
+}
+
+// AsIlertAPIKey returns the union data as a IlertAPIKey.
+func (u IlertKeyOrStoreSelector) AsIlertAPIKey() (IlertAPIKey, error) {
+	var v IlertAPIKey
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromIlertAPIKey sets the union to v, with "option" set to "explicit".
+func (u *IlertKeyOrStoreSelector) FromIlertAPIKey(v IlertAPIKey) error {
+	data, err := unionFrom(v, "option", "explicit")
+	if err != nil {
+		return fmt.Errorf("IlertKeyOrStoreSelector: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsIlertPasswordStoreID returns the union data as a IlertPasswordStoreID.
+func (u IlertKeyOrStoreSelector) AsIlertPasswordStoreID() (IlertPasswordStoreID, error) {
+	var v IlertPasswordStoreID
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromIlertPasswordStoreID sets the union to v, with "option" set to "store".
+func (u *IlertKeyOrStoreSelector) FromIlertPasswordStoreID(v IlertPasswordStoreID) error {
+	data, err := unionFrom(v, "option", "store")
+	if err != nil {
+		return fmt.Errorf("IlertKeyOrStoreSelector: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// ValueByDiscriminator decodes the union into the member selected by "option".
+func (u IlertKeyOrStoreSelector) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := u.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "explicit":
+		return u.AsIlertAPIKey()
+	case "store":
+		return u.AsIlertPasswordStoreID()
+	}
+	return nil, fmt.Errorf("IlertKeyOrStoreSelector: unknown option %q", discriminator)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u IlertKeyOrStoreSelector) MarshalJSON() ([]byte, error) {
+	if len(u.union) == 0 {
+		return []byte("null"), nil
+	}
+	return u.union, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting unknown "option" values.
+func (u *IlertKeyOrStoreSelector) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		u.union = nil
+		return nil
+	}
+	discriminator, err := unionDiscriminator(data, "option")
+	if err != nil {
+		return fmt.Errorf("IlertKeyOrStoreSelector: %w", err)
+	}
+	switch discriminator {
+	case "explicit", "store":
+	default:
+		return fmt.Errorf("IlertKeyOrStoreSelector: unknown option %q", discriminator)
+	}
+	u.union = append(json.RawMessage(nil), data...)
+	return nil
 }
 
 // IlertPasswordStoreID represents a CheckMK API type.
@@ -3441,7 +6513,7 @@ type IlertPasswordStoreID struct {
 
 // IlertPluginCreate represents a CheckMK API type.
 type IlertPluginCreate struct {
-	ApiKey map[string]interface{} `json:"api_key"`
+	ApiKey IlertKeyOrStoreSelector `json:"api_key"`
 	// A custom summary for host alerts
 	// Example: $NOTIFICATIONTYPE$ Host Alert: $HOSTNAME$ is $HOSTSTATE$ - $HOSTOUTPUT$
 	CustomSummaryForHostAlerts string `json:"custom_summary_for_host_alerts"`
@@ -3449,16 +6521,16 @@ type IlertPluginCreate struct {
 	// Example: $NOTIFICATIONTYPE$ Service Alert: $HOSTALIAS$/$SERVICEDESC$ is $SERVICESTATE$ - $SERVICEOUTPUT$
 	CustomSummaryForServiceAlerts string `json:"custom_summary_for_service_alerts"`
 	// Ignore unverified HTTPS request warnings. Use with caution.
-	DisableSslCertVerification interface{} `json:"disable_ssl_cert_verification"`
+	DisableSslCertVerification Checkbox `json:"disable_ssl_cert_verification"`
 	// Use the proxy settings from the environment variables. The variables NO_PROXY, HTTP_PROXY and HTTPS_PROXY are taken into account during execution.
-	HttpProxy interface{} `json:"http_proxy"`
+	HttpProxy HttpProxyOneOf `json:"http_proxy"`
 	// HIGH - with escalation, LOW - without escalation
 	// Example: HIGH
 	NotificationPriority IlertPluginCreateNotificationPriority `json:"notification_priority"`
 	// The plugin name.
 	// Example: mail
 	PluginName IlertPluginCreatePluginName `json:"plugin_name"`
-	UrlPrefixForLinksToCheckmk map[string]interface{} `json:"url_prefix_for_links_to_checkmk"`
+	UrlPrefixForLinksToCheckmk UrlPrefixOneOf `json:"url_prefix_for_links_to_checkmk"`
 }
 
 // IncidentParams represents a CheckMK API type.
@@ -3466,15 +6538,15 @@ type IncidentParams struct {
 	// Caller is the user on behalf of whom the incident is being reported within ServiceNow.
 	// Example: Alice
 	Caller string `json:"caller"`
-	HostDescription map[string]interface{} `json:"host_description,omitempty"`
-	HostShortDescription map[string]interface{} `json:"host_short_description,omitempty"`
-	Impact map[string]interface{} `json:"impact,omitempty"`
-	ServiceDescription map[string]interface{} `json:"service_description,omitempty"`
-	ServiceShortDescription map[string]interface{} `json:"service_short_description,omitempty"`
-	StateAcknowledgement map[string]interface{} `json:"state_acknowledgement,omitempty"`
-	StateDowntime map[string]interface{} `json:"state_downtime,omitempty"`
-	StateRecovery map[string]interface{} `json:"state_recovery,omitempty"`
-	Urgency map[string]interface{} `json:"urgency,omitempty"`
+	HostDescription *StrValueOneOf `json:"host_description,omitempty"`
+	HostShortDescription *StrValueOneOf `json:"host_short_description,omitempty"`
+	Impact *StrValueOneOf `json:"impact,omitempty"`
+	ServiceDescription *StrValueOneOf `json:"service_description,omitempty"`
+	ServiceShortDescription *StrValueOneOf `json:"service_short_description,omitempty"`
+	StateAcknowledgement *TypeStateOneOf `json:"state_acknowledgement,omitempty"`
+	StateDowntime *TypeStateOneOf `json:"state_downtime,omitempty"`
+	StateRecovery *TypeStateOneOf `json:"state_recovery,omitempty"`
+	Urgency *TypeUrgencyOneOf `json:"urgency,omitempty"`
 }
 
 // InputContactGroup represents a CheckMK API type.
@@ -3507,7 +6579,7 @@ type InputHostTagGroup struct {
 	Ident string `json:"ident"`
 	// A list of host tags belonging to the host tag group
 	// Example: [map[ident:pod title:Pod]]
-	Tags []map[string]interface{} `json:"tags"`
+	Tags []HostTag `json:"tags"`
 	// A title for the host tag
 	// Example: Kubernetes
 	Title string `json:"title"`
@@ -3545,13 +6617,13 @@ type InputPassword struct {
 type InputRuleObject struct {
 	// Conditions.
 	// Example: map[]
-	Conditions interface{} `json:"conditions,omitempty"`
+	Conditions *RuleConditions1 `json:"conditions,omitempty"`
 	// The path name of the folder. Path delimiters can be either `~`, `/` or `\`. Please use the one most appropriate for your quoting/escaping needs. A good default choice is `~`.
 	// Example: ~hosts~linux
 	Folder string `json:"folder"`
 	// Configuration values for rules.
 	// Example: map[disabled:false]
-	Properties interface{} `json:"properties,omitempty"`
+	Properties *RuleProperties1 `json:"properties,omitempty"`
 	// Name of rule set.
 	// Example: host_label_rules
 	Ruleset string `json:"ruleset"`
@@ -3570,8 +6642,90 @@ type InputServiceGroup struct {
 	Name string `json:"name"`
 }
 
-// InsertHtmlOneOf represents a CheckMK API type.
+// InsertHtmlOneOf is a union of Checkbox, HtmlSectionBetweenBodyAndTableCheckbox, selected by the "state" property.
 type InsertHtmlOneOf struct {
+	union json.RawMessage
+}
+
+// Discriminator returns the value of the "state" property.
+func (u InsertHtmlOneOf) Discriminator() (string, error) {
+	return unionDiscriminator(u.union, "state")
+}
+
+// AsCheckbox returns the union data as a Checkbox.
+func (u InsertHtmlOneOf) AsCheckbox() (Checkbox, error) {
+	var v Checkbox
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromCheckbox sets the union to v, with "state" set to "disabled".
+func (u *InsertHtmlOneOf) FromCheckbox(v Checkbox) error {
+	data, err := unionFrom(v, "state", "disabled")
+	if err != nil {
+		return fmt.Errorf("InsertHtmlOneOf: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsHtmlSectionBetweenBodyAndTableCheckbox returns the union data as a HtmlSectionBetweenBodyAndTableCheckbox.
+func (u InsertHtmlOneOf) AsHtmlSectionBetweenBodyAndTableCheckbox() (HtmlSectionBetweenBodyAndTableCheckbox, error) {
+	var v HtmlSectionBetweenBodyAndTableCheckbox
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromHtmlSectionBetweenBodyAndTableCheckbox sets the union to v, with "state" set to "enabled".
+func (u *InsertHtmlOneOf) FromHtmlSectionBetweenBodyAndTableCheckbox(v HtmlSectionBetweenBodyAndTableCheckbox) error {
+	data, err := unionFrom(v, "state", "enabled")
+	if err != nil {
+		return fmt.Errorf("InsertHtmlOneOf: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// ValueByDiscriminator decodes the union into the member selected by "state".
+func (u InsertHtmlOneOf) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := u.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "disabled":
+		return u.AsCheckbox()
+	case "enabled":
+		return u.AsHtmlSectionBetweenBodyAndTableCheckbox()
+	}
+	return nil, fmt.Errorf("InsertHtmlOneOf: unknown state %q", discriminator)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u InsertHtmlOneOf) MarshalJSON() ([]byte, error) {
+	if len(u.union) == 0 {
+		return []byte("null"), nil
+	}
+	return u.union, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting unknown "state" values.
+func (u *InsertHtmlOneOf) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		u.union = nil
+		return nil
+	}
+	discriminator, err := unionDiscriminator(data, "state")
+	if err != nil {
+		return fmt.Errorf("InsertHtmlOneOf: %w", err)
+	}
+	switch discriminator {
+	case "disabled", "enabled":
+	default:
+		return fmt.Errorf("InsertHtmlOneOf: unknown state %q", discriminator)
+	}
+	u.union = append(json.RawMessage(nil), data...)
+	return nil
 }
 
 // InstalledVersions represents a CheckMK API type.
@@ -3599,22 +6753,22 @@ type InstalledVersions struct {
 // JiraPluginCreate represents a CheckMK API type.
 type JiraPluginCreate struct {
 	// Ignore unverified HTTPS request warnings. Use with caution.
-	DisableSslCertVerification interface{} `json:"disable_ssl_cert_verification"`
+	DisableSslCertVerification Checkbox `json:"disable_ssl_cert_verification"`
 	// The numerical JIRA custom field ID for host problems
 	HostCustomId string `json:"host_custom_id"`
 	// Here you are allowed to use all macros that are defined in the notification context
-	HostSummary interface{} `json:"host_summary"`
+	HostSummary StrValueOneOf `json:"host_summary"`
 	// The numerical JIRA issue type ID. If not set, it will be retrieved from a custom user attribute named jiraissuetype. If that is not set, the notification will fail
 	IssueTypeId string `json:"issue_type_id"`
 	// Configure the JIRA URL here
 	// Example: http://jira_url_example.com
 	JiraUrl string `json:"jira_url,omitempty"`
 	// Here you can set a custom label for new issues. If not set, 'monitoring' will be used
-	Label interface{} `json:"label"`
+	Label StrValueOneOf `json:"label"`
 	// Configure the base URL for the Monitoring Web-GUI here. Include the site name. Used for link to check_mk out of jira
 	MonitoringUrl string `json:"monitoring_url"`
 	// Here you can configure timeout settings.
-	OptionalTimeout interface{} `json:"optional_timeout"`
+	OptionalTimeout StrValueOneOf `json:"optional_timeout"`
 	// The password entered here is stored in plain text within the monitoring site. This usually needed because the monitoring process needs to have access to the unencrypted password because it needs to submit it to authenticate with remote systems
 	// Example: example_pass_123&*
 	Password string `json:"password"`
@@ -3622,17 +6776,17 @@ type JiraPluginCreate struct {
 	// Example: mail
 	PluginName JiraPluginCreatePluginName `json:"plugin_name"`
 	// The numerical JIRA priority ID. If not set, it will be retrieved from a custom user attribute named jirapriority. If that is not set, the standard priority will be used
-	PriorityId interface{} `json:"priority_id"`
+	PriorityId StrValueOneOf `json:"priority_id"`
 	// The numerical JIRA project ID. If not set, it will be retrieved from a custom user attribute named jiraproject. If that is not set, the notification will fail
 	ProjectId string `json:"project_id"`
 	// The numerical JIRA resolution transition ID. 11 - 'To Do', 21 - 'In Progress', 31 - 'Done'
-	ResolutionId interface{} `json:"resolution_id"`
+	ResolutionId StrValueOneOf `json:"resolution_id"`
 	// The numerical JIRA custom field ID for service problems
 	ServiceCustomId string `json:"service_custom_id"`
 	// Here you are allowed to use all macros that are defined in the notification context
-	ServiceSummary interface{} `json:"service_summary"`
+	ServiceSummary StrValueOneOf `json:"service_summary"`
 	// The numerical ID of the JIRA custom field for sites. Please use this option if you have multiple sites in a distributed setup which send their notifications to the same JIRA instance
-	SiteCustomId interface{} `json:"site_custom_id"`
+	SiteCustomId StrValueOneOf `json:"site_custom_id"`
 	// Configure the user name here
 	// Example: username_a
 	Username string `json:"username"`
@@ -3685,12 +6839,176 @@ type LinkHostUUID struct {
 	Uuid string `json:"uuid"`
 }
 
-// ListOfContactGroupsCheckbox represents a CheckMK API type.
+// ListOfContactGroupsCheckbox is a union of Checkbox, CheckboxWithListOfContactGroups, selected by the "state" property.
 type ListOfContactGroupsCheckbox struct {
-}
-
-// ListOfStrOneOf represents a CheckMK API type.
+	union json.RawMessage
+}
+
+// Discriminator returns the value of the "state" property.
+func (u ListOfContactGroupsCheckbox) Discriminator() (string, error) {
+	return unionDiscriminator(u.union, "state")
+}
+
+// AsCheckbox returns the union data as a Checkbox.
+func (u ListOfContactGroupsCheckbox) AsCheckbox() (Checkbox, error) {
+	var v Checkbox
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromCheckbox sets the union to v, with "state" set to "disabled".
+func (u *ListOfContactGroupsCheckbox) FromCheckbox(v Checkbox) error {
+	data, err := unionFrom(v, "state", "disabled")
+	if err != nil {
+		return fmt.Errorf("ListOfContactGroupsCheckbox: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsCheckboxWithListOfContactGroups returns the union data as a CheckboxWithListOfContactGroups.
+func (u ListOfContactGroupsCheckbox) AsCheckboxWithListOfContactGroups() (CheckboxWithListOfContactGroups, error) {
+	var v CheckboxWithListOfContactGroups
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromCheckboxWithListOfContactGroups sets the union to v, with "state" set to "enabled".
+func (u *ListOfContactGroupsCheckbox) FromCheckboxWithListOfContactGroups(v CheckboxWithListOfContactGroups) error {
+	data, err := unionFrom(v, "state", "enabled")
+	if err != nil {
+		return fmt.Errorf("ListOfContactGroupsCheckbox: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// ValueByDiscriminator decodes the union into the member selected by "state".
+func (u ListOfContactGroupsCheckbox) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := u.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "disabled":
+		return u.AsCheckbox()
+	case "enabled":
+		return u.AsCheckboxWithListOfContactGroups()
+	}
+	return nil, fmt.Errorf("ListOfContactGroupsCheckbox: unknown state %q", discriminator)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u ListOfContactGroupsCheckbox) MarshalJSON() ([]byte, error) {
+	if len(u.union) == 0 {
+		return []byte("null"), nil
+	}
+	return u.union, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting unknown "state" values.
+func (u *ListOfContactGroupsCheckbox) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		u.union = nil
+		return nil
+	}
+	discriminator, err := unionDiscriminator(data, "state")
+	if err != nil {
+		return fmt.Errorf("ListOfContactGroupsCheckbox: %w", err)
+	}
+	switch discriminator {
+	case "disabled", "enabled":
+	default:
+		return fmt.Errorf("ListOfContactGroupsCheckbox: unknown state %q", discriminator)
+	}
+	u.union = append(json.RawMessage(nil), data...)
+	return nil
+}
+
+// ListOfStrOneOf is a union of Checkbox, CheckboxWithListOfStr, selected by the "state" property.
 type ListOfStrOneOf struct {
+	union json.RawMessage
+}
+
+// Discriminator returns the value of the "state" property.
+func (u ListOfStrOneOf) Discriminator() (string, error) {
+	return unionDiscriminator(u.union, "state")
+}
+
+// AsCheckbox returns the union data as a Checkbox.
+func (u ListOfStrOneOf) AsCheckbox() (Checkbox, error) {
+	var v Checkbox
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromCheckbox sets the union to v, with "state" set to "disabled".
+func (u *ListOfStrOneOf) FromCheckbox(v Checkbox) error {
+	data, err := unionFrom(v, "state", "disabled")
+	if err != nil {
+		return fmt.Errorf("ListOfStrOneOf: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsCheckboxWithListOfStr returns the union data as a CheckboxWithListOfStr.
+func (u ListOfStrOneOf) AsCheckboxWithListOfStr() (CheckboxWithListOfStr, error) {
+	var v CheckboxWithListOfStr
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromCheckboxWithListOfStr sets the union to v, with "state" set to "enabled".
+func (u *ListOfStrOneOf) FromCheckboxWithListOfStr(v CheckboxWithListOfStr) error {
+	data, err := unionFrom(v, "state", "enabled")
+	if err != nil {
+		return fmt.Errorf("ListOfStrOneOf: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// ValueByDiscriminator decodes the union into the member selected by "state".
+func (u ListOfStrOneOf) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := u.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "disabled":
+		return u.AsCheckbox()
+	case "enabled":
+		return u.AsCheckboxWithListOfStr()
+	}
+	return nil, fmt.Errorf("ListOfStrOneOf: unknown state %q", discriminator)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u ListOfStrOneOf) MarshalJSON() ([]byte, error) {
+	if len(u.union) == 0 {
+		return []byte("null"), nil
+	}
+	return u.union, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting unknown "state" values.
+func (u *ListOfStrOneOf) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		u.union = nil
+		return nil
+	}
+	discriminator, err := unionDiscriminator(data, "state")
+	if err != nil {
+		return fmt.Errorf("ListOfStrOneOf: %w", err)
+	}
+	switch discriminator {
+	case "disabled", "enabled":
+	default:
+		return fmt.Errorf("ListOfStrOneOf: unknown state %q", discriminator)
+	}
+	u.union = append(json.RawMessage(nil), data...)
+	return nil
 }
 
 // LockedBy represents a CheckMK API type.
@@ -3705,7 +7023,7 @@ type LockedBy struct {
 
 // LogicalExpr represents a CheckMK API type.
 type LogicalExpr struct {
-	Expr []interface{} `json:"expr,omitempty"`
+	Expr []Expr `json:"expr,omitempty"`
 	// The operator.
 	Op string `json:"op,omitempty"`
 }
@@ -3719,26 +7037,26 @@ type MSTeamsExplicitWebhookUrl struct {
 // MSTeamsPluginCreate represents a CheckMK API type.
 type MSTeamsPluginCreate struct {
 	// Enable/disable if we show affected host groups in the created message
-	AffectedHostGroups interface{} `json:"affected_host_groups"`
+	AffectedHostGroups Checkbox `json:"affected_host_groups"`
 	// Enable/disable the details for host notifications
-	HostDetails interface{} `json:"host_details"`
+	HostDetails StrValueOneOf `json:"host_details"`
 	// Enable/disable the summary for host notifications
-	HostSummary interface{} `json:"host_summary"`
+	HostSummary StrValueOneOf `json:"host_summary"`
 	// Enable/disable the title for host notifications
-	HostTitle interface{} `json:"host_title"`
+	HostTitle StrValueOneOf `json:"host_title"`
 	// Use the proxy settings from the environment variables. The variables NO_PROXY, HTTP_PROXY and HTTPS_PROXY are taken into account during execution.
-	HttpProxy interface{} `json:"http_proxy"`
+	HttpProxy HttpProxyOneOf `json:"http_proxy"`
 	// The plugin name.
 	// Example: mail
 	PluginName MSTeamsPluginCreatePluginName `json:"plugin_name"`
 	// Enable/disable the details for service notifications
-	ServiceDetails interface{} `json:"service_details"`
+	ServiceDetails StrValueOneOf `json:"service_details"`
 	// Enable/disable the summary for service notifications
-	ServiceSummary interface{} `json:"service_summary"`
+	ServiceSummary StrValueOneOf `json:"service_summary"`
 	// Enable/disable the title for service notifications
-	ServiceTitle interface{} `json:"service_title"`
-	UrlPrefixForLinksToCheckmk map[string]interface{} `json:"url_prefix_for_links_to_checkmk"`
-	WebhookUrl map[string]interface{} `json:"webhook_url"`
+	ServiceTitle StrValueOneOf `json:"service_title"`
+	UrlPrefixForLinksToCheckmk UrlPrefixOneOf `json:"url_prefix_for_links_to_checkmk"`
+	WebhookUrl MSTeamsUrlOrStoreSelector `json:"webhook_url"`
 }
 
 // MSTeamsURLResponse represents a CheckMK API type.
@@ -3750,8 +7068,90 @@ type MSTeamsURLResponse struct {
 	Url string `json:"url,omitempty"`
 }
 
-// MSTeamsUrlOrStoreSelector represents a CheckMK API type.
+// MSTeamsUrlOrStoreSelector is a union of MSTeamsExplicitWebhookUrl, MSTeamsURLResponse, selected by the "option" property.
 type MSTeamsUrlOrStoreSelector struct {
+	union json.RawMessage
+}
+
+// Discriminator returns the value of the "option" property.
+func (u MSTeamsUrlOrStoreSelector) Discriminator() (string, error) {
+	return unionDiscriminator(u.union, "option")
+}
+
+// AsMSTeamsExplicitWebhookUrl returns the union data as a MSTeamsExplicitWebhookUrl.
+func (u MSTeamsUrlOrStoreSelector) AsMSTeamsExplicitWebhookUrl() (MSTeamsExplicitWebhookUrl, error) {
+	var v MSTeamsExplicitWebhookUrl
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromMSTeamsExplicitWebhookUrl sets the union to v, with "option" set to "explicit".
+func (u *MSTeamsUrlOrStoreSelector) FromMSTeamsExplicitWebhookUrl(v MSTeamsExplicitWebhookUrl) error {
+	data, err := unionFrom(v, "option", "explicit")
+	if err != nil {
+		return fmt.Errorf("MSTeamsUrlOrStoreSelector: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsMSTeamsURLResponse returns the union data as a MSTeamsURLResponse.
+func (u MSTeamsUrlOrStoreSelector) AsMSTeamsURLResponse() (MSTeamsURLResponse, error) {
+	var v MSTeamsURLResponse
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromMSTeamsURLResponse sets the union to v, with "option" set to "store".
+func (u *MSTeamsUrlOrStoreSelector) FromMSTeamsURLResponse(v MSTeamsURLResponse) error {
+	data, err := unionFrom(v, "option", "store")
+	if err != nil {
+		return fmt.Errorf("MSTeamsUrlOrStoreSelector: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// ValueByDiscriminator decodes the union into the member selected by "option".
+func (u MSTeamsUrlOrStoreSelector) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := u.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "explicit":
+		return u.AsMSTeamsExplicitWebhookUrl()
+	case "store":
+		return u.AsMSTeamsURLResponse()
+	}
+	return nil, fmt.Errorf("MSTeamsUrlOrStoreSelector: unknown option %q", discriminator)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u MSTeamsUrlOrStoreSelector) MarshalJSON() ([]byte, error) {
+	if len(u.union) == 0 {
+		return []byte("null"), nil
+	}
+	return u.union, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting unknown "option" values.
+func (u *MSTeamsUrlOrStoreSelector) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		u.union = nil
+		return nil
+	}
+	discriminator, err := unionDiscriminator(data, "option")
+	if err != nil {
+		return fmt.Errorf("MSTeamsUrlOrStoreSelector: %w", err)
+	}
+	switch discriminator {
+	case "explicit", "store":
+	default:
+		return fmt.Errorf("MSTeamsUrlOrStoreSelector: unknown option %q", discriminator)
+	}
+	u.union = append(json.RawMessage(nil), data...)
+	return nil
 }
 
 // ManagementTypeCaseStates represents a CheckMK API type.
@@ -3768,16 +7168,262 @@ type ManagementTypeIncedentStates struct {
 	StartPredefined ManagementTypeIncedentStatesStartPredefined `json:"start_predefined,omitempty"`
 }
 
-// ManualOrAutomaticSelector represents a CheckMK API type.
+// ManualOrAutomaticSelector is a union of CheckMKURLPrefixAuto, CheckMKURLPrefixManual, selected by the "option" property.
 type ManualOrAutomaticSelector struct {
-}
-
-// MatchCheckTypesCheckbox represents a CheckMK API type.
+	union json.RawMessage
+}
+
+// Discriminator returns the value of the "option" property.
+func (u ManualOrAutomaticSelector) Discriminator() (string, error) {
+	return unionDiscriminator(u.union, "option")
+}
+
+// AsCheckMKURLPrefixAuto returns the union data as a CheckMKURLPrefixAuto.
+func (u ManualOrAutomaticSelector) AsCheckMKURLPrefixAuto() (CheckMKURLPrefixAuto, error) {
+	var v CheckMKURLPrefixAuto
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromCheckMKURLPrefixAuto sets the union to v, with "option" set to "automatic".
+func (u *ManualOrAutomaticSelector) FromCheckMKURLPrefixAuto(v CheckMKURLPrefixAuto) error {
+	data, err := unionFrom(v, "option", "automatic")
+	if err != nil {
+		return fmt.Errorf("ManualOrAutomaticSelector: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsCheckMKURLPrefixManual returns the union data as a CheckMKURLPrefixManual.
+func (u ManualOrAutomaticSelector) AsCheckMKURLPrefixManual() (CheckMKURLPrefixManual, error) {
+	var v CheckMKURLPrefixManual
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromCheckMKURLPrefixManual sets the union to v, with "option" set to "manual".
+func (u *ManualOrAutomaticSelector) FromCheckMKURLPrefixManual(v CheckMKURLPrefixManual) error {
+	data, err := unionFrom(v, "option", "manual")
+	if err != nil {
+		return fmt.Errorf("ManualOrAutomaticSelector: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// ValueByDiscriminator decodes the union into the member selected by "option".
+func (u ManualOrAutomaticSelector) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := u.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "automatic":
+		return u.AsCheckMKURLPrefixAuto()
+	case "manual":
+		return u.AsCheckMKURLPrefixManual()
+	}
+	return nil, fmt.Errorf("ManualOrAutomaticSelector: unknown option %q", discriminator)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u ManualOrAutomaticSelector) MarshalJSON() ([]byte, error) {
+	if len(u.union) == 0 {
+		return []byte("null"), nil
+	}
+	return u.union, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting unknown "option" values.
+func (u *ManualOrAutomaticSelector) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		u.union = nil
+		return nil
+	}
+	discriminator, err := unionDiscriminator(data, "option")
+	if err != nil {
+		return fmt.Errorf("ManualOrAutomaticSelector: %w", err)
+	}
+	switch discriminator {
+	case "automatic", "manual":
+	default:
+		return fmt.Errorf("ManualOrAutomaticSelector: unknown option %q", discriminator)
+	}
+	u.union = append(json.RawMessage(nil), data...)
+	return nil
+}
+
+// MatchCheckTypesCheckbox is a union of Checkbox, CheckboxWithListOfCheckTypes, selected by the "state" property.
 type MatchCheckTypesCheckbox struct {
-}
-
-// MatchContactGroupsCheckbox represents a CheckMK API type.
+	union json.RawMessage
+}
+
+// Discriminator returns the value of the "state" property.
+func (u MatchCheckTypesCheckbox) Discriminator() (string, error) {
+	return unionDiscriminator(u.union, "state")
+}
+
+// AsCheckbox returns the union data as a Checkbox.
+func (u MatchCheckTypesCheckbox) AsCheckbox() (Checkbox, error) {
+	var v Checkbox
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromCheckbox sets the union to v, with "state" set to "disabled".
+func (u *MatchCheckTypesCheckbox) FromCheckbox(v Checkbox) error {
+	data, err := unionFrom(v, "state", "disabled")
+	if err != nil {
+		return fmt.Errorf("MatchCheckTypesCheckbox: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsCheckboxWithListOfCheckTypes returns the union data as a CheckboxWithListOfCheckTypes.
+func (u MatchCheckTypesCheckbox) AsCheckboxWithListOfCheckTypes() (CheckboxWithListOfCheckTypes, error) {
+	var v CheckboxWithListOfCheckTypes
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromCheckboxWithListOfCheckTypes sets the union to v, with "state" set to "enabled".
+func (u *MatchCheckTypesCheckbox) FromCheckboxWithListOfCheckTypes(v CheckboxWithListOfCheckTypes) error {
+	data, err := unionFrom(v, "state", "enabled")
+	if err != nil {
+		return fmt.Errorf("MatchCheckTypesCheckbox: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// ValueByDiscriminator decodes the union into the member selected by "state".
+func (u MatchCheckTypesCheckbox) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := u.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "disabled":
+		return u.AsCheckbox()
+	case "enabled":
+		return u.AsCheckboxWithListOfCheckTypes()
+	}
+	return nil, fmt.Errorf("MatchCheckTypesCheckbox: unknown state %q", discriminator)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u MatchCheckTypesCheckbox) MarshalJSON() ([]byte, error) {
+	if len(u.union) == 0 {
+		return []byte("null"), nil
+	}
+	return u.union, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting unknown "state" values.
+func (u *MatchCheckTypesCheckbox) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		u.union = nil
+		return nil
+	}
+	discriminator, err := unionDiscriminator(data, "state")
+	if err != nil {
+		return fmt.Errorf("MatchCheckTypesCheckbox: %w", err)
+	}
+	switch discriminator {
+	case "disabled", "enabled":
+	default:
+		return fmt.Errorf("MatchCheckTypesCheckbox: unknown state %q", discriminator)
+	}
+	u.union = append(json.RawMessage(nil), data...)
+	return nil
+}
+
+// MatchContactGroupsCheckbox is a union of Checkbox, CheckboxWithListOfContactGroups, selected by the "state" property.
 type MatchContactGroupsCheckbox struct {
+	union json.RawMessage
+}
+
+// Discriminator returns the value of the "state" property.
+func (u MatchContactGroupsCheckbox) Discriminator() (string, error) {
+	return unionDiscriminator(u.union, "state")
+}
+
+// AsCheckbox returns the union data as a Checkbox.
+func (u MatchContactGroupsCheckbox) AsCheckbox() (Checkbox, error) {
+	var v Checkbox
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromCheckbox sets the union to v, with "state" set to "disabled".
+func (u *MatchContactGroupsCheckbox) FromCheckbox(v Checkbox) error {
+	data, err := unionFrom(v, "state", "disabled")
+	if err != nil {
+		return fmt.Errorf("MatchContactGroupsCheckbox: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsCheckboxWithListOfContactGroups returns the union data as a CheckboxWithListOfContactGroups.
+func (u MatchContactGroupsCheckbox) AsCheckboxWithListOfContactGroups() (CheckboxWithListOfContactGroups, error) {
+	var v CheckboxWithListOfContactGroups
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromCheckboxWithListOfContactGroups sets the union to v, with "state" set to "enabled".
+func (u *MatchContactGroupsCheckbox) FromCheckboxWithListOfContactGroups(v CheckboxWithListOfContactGroups) error {
+	data, err := unionFrom(v, "state", "enabled")
+	if err != nil {
+		return fmt.Errorf("MatchContactGroupsCheckbox: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// ValueByDiscriminator decodes the union into the member selected by "state".
+func (u MatchContactGroupsCheckbox) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := u.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "disabled":
+		return u.AsCheckbox()
+	case "enabled":
+		return u.AsCheckboxWithListOfContactGroups()
+	}
+	return nil, fmt.Errorf("MatchContactGroupsCheckbox: unknown state %q", discriminator)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u MatchContactGroupsCheckbox) MarshalJSON() ([]byte, error) {
+	if len(u.union) == 0 {
+		return []byte("null"), nil
+	}
+	return u.union, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting unknown "state" values.
+func (u *MatchContactGroupsCheckbox) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		u.union = nil
+		return nil
+	}
+	discriminator, err := unionDiscriminator(data, "state")
+	if err != nil {
+		return fmt.Errorf("MatchContactGroupsCheckbox: %w", err)
+	}
+	switch discriminator {
+	case "disabled", "enabled":
+	default:
+		return fmt.Errorf("MatchContactGroupsCheckbox: unknown state %q", discriminator)
+	}
+	u.union = append(json.RawMessage(nil), data...)
+	return nil
 }
 
 // MatchCustomMacros represents a CheckMK API type.
@@ -3785,7 +7431,7 @@ type MatchCustomMacros struct {
 	// To enable or disable this field
 	// Example: enabled
 	State MatchCustomMacrosState `json:"state"`
-	Value []map[string]interface{} `json:"value,omitempty"`
+	Value []CustomMacro `json:"value,omitempty"`
 }
 
 // MatchEventConsoleAlertsResponse represents a CheckMK API type.
@@ -3793,19 +7439,265 @@ type MatchEventConsoleAlertsResponse struct {
 	// To enable or disable this field
 	// Example: enabled
 	State MatchEventConsoleAlertsResponseState `json:"state"`
-	Value map[string]interface{} `json:"value,omitempty"`
+	Value *EventConsoleAlertsResponse `json:"value,omitempty"`
 }
 
-// MatchFolderCheckbox represents a CheckMK API type.
+// MatchFolderCheckbox is a union of Checkbox, CheckboxWithFolderStr, selected by the "state" property.
 type MatchFolderCheckbox struct {
-}
-
-// MatchHostEventTypeCheckbox represents a CheckMK API type.
+	union json.RawMessage
+}
+
+// Discriminator returns the value of the "state" property.
+func (u MatchFolderCheckbox) Discriminator() (string, error) {
+	return unionDiscriminator(u.union, "state")
+}
+
+// AsCheckbox returns the union data as a Checkbox.
+func (u MatchFolderCheckbox) AsCheckbox() (Checkbox, error) {
+	var v Checkbox
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromCheckbox sets the union to v, with "state" set to "disabled".
+func (u *MatchFolderCheckbox) FromCheckbox(v Checkbox) error {
+	data, err := unionFrom(v, "state", "disabled")
+	if err != nil {
+		return fmt.Errorf("MatchFolderCheckbox: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsCheckboxWithFolderStr returns the union data as a CheckboxWithFolderStr.
+func (u MatchFolderCheckbox) AsCheckboxWithFolderStr() (CheckboxWithFolderStr, error) {
+	var v CheckboxWithFolderStr
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromCheckboxWithFolderStr sets the union to v, with "state" set to "enabled".
+func (u *MatchFolderCheckbox) FromCheckboxWithFolderStr(v CheckboxWithFolderStr) error {
+	data, err := unionFrom(v, "state", "enabled")
+	if err != nil {
+		return fmt.Errorf("MatchFolderCheckbox: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// ValueByDiscriminator decodes the union into the member selected by "state".
+func (u MatchFolderCheckbox) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := u.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "disabled":
+		return u.AsCheckbox()
+	case "enabled":
+		return u.AsCheckboxWithFolderStr()
+	}
+	return nil, fmt.Errorf("MatchFolderCheckbox: unknown state %q", discriminator)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u MatchFolderCheckbox) MarshalJSON() ([]byte, error) {
+	if len(u.union) == 0 {
+		return []byte("null"), nil
+	}
+	return u.union, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting unknown "state" values.
+func (u *MatchFolderCheckbox) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		u.union = nil
+		return nil
+	}
+	discriminator, err := unionDiscriminator(data, "state")
+	if err != nil {
+		return fmt.Errorf("MatchFolderCheckbox: %w", err)
+	}
+	switch discriminator {
+	case "disabled", "enabled":
+	default:
+		return fmt.Errorf("MatchFolderCheckbox: unknown state %q", discriminator)
+	}
+	u.union = append(json.RawMessage(nil), data...)
+	return nil
+}
+
+// MatchHostEventTypeCheckbox is a union of Checkbox, CheckboxHostEventType, selected by the "state" property.
 type MatchHostEventTypeCheckbox struct {
-}
-
-// MatchHostGroupsCheckbox represents a CheckMK API type.
+	union json.RawMessage
+}
+
+// Discriminator returns the value of the "state" property.
+func (u MatchHostEventTypeCheckbox) Discriminator() (string, error) {
+	return unionDiscriminator(u.union, "state")
+}
+
+// AsCheckbox returns the union data as a Checkbox.
+func (u MatchHostEventTypeCheckbox) AsCheckbox() (Checkbox, error) {
+	var v Checkbox
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromCheckbox sets the union to v, with "state" set to "disabled".
+func (u *MatchHostEventTypeCheckbox) FromCheckbox(v Checkbox) error {
+	data, err := unionFrom(v, "state", "disabled")
+	if err != nil {
+		return fmt.Errorf("MatchHostEventTypeCheckbox: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsCheckboxHostEventType returns the union data as a CheckboxHostEventType.
+func (u MatchHostEventTypeCheckbox) AsCheckboxHostEventType() (CheckboxHostEventType, error) {
+	var v CheckboxHostEventType
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromCheckboxHostEventType sets the union to v, with "state" set to "enabled".
+func (u *MatchHostEventTypeCheckbox) FromCheckboxHostEventType(v CheckboxHostEventType) error {
+	data, err := unionFrom(v, "state", "enabled")
+	if err != nil {
+		return fmt.Errorf("MatchHostEventTypeCheckbox: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// ValueByDiscriminator decodes the union into the member selected by "state".
+func (u MatchHostEventTypeCheckbox) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := u.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "disabled":
+		return u.AsCheckbox()
+	case "enabled":
+		return u.AsCheckboxHostEventType()
+	}
+	return nil, fmt.Errorf("MatchHostEventTypeCheckbox: unknown state %q", discriminator)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u MatchHostEventTypeCheckbox) MarshalJSON() ([]byte, error) {
+	if len(u.union) == 0 {
+		return []byte("null"), nil
+	}
+	return u.union, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting unknown "state" values.
+func (u *MatchHostEventTypeCheckbox) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		u.union = nil
+		return nil
+	}
+	discriminator, err := unionDiscriminator(data, "state")
+	if err != nil {
+		return fmt.Errorf("MatchHostEventTypeCheckbox: %w", err)
+	}
+	switch discriminator {
+	case "disabled", "enabled":
+	default:
+		return fmt.Errorf("MatchHostEventTypeCheckbox: unknown state %q", discriminator)
+	}
+	u.union = append(json.RawMessage(nil), data...)
+	return nil
+}
+
+// MatchHostGroupsCheckbox is a union of Checkbox, CheckboxWithListOfHostGroups, selected by the "state" property.
 type MatchHostGroupsCheckbox struct {
+	union json.RawMessage
+}
+
+// Discriminator returns the value of the "state" property.
+func (u MatchHostGroupsCheckbox) Discriminator() (string, error) {
+	return unionDiscriminator(u.union, "state")
+}
+
+// AsCheckbox returns the union data as a Checkbox.
+func (u MatchHostGroupsCheckbox) AsCheckbox() (Checkbox, error) {
+	var v Checkbox
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromCheckbox sets the union to v, with "state" set to "disabled".
+func (u *MatchHostGroupsCheckbox) FromCheckbox(v Checkbox) error {
+	data, err := unionFrom(v, "state", "disabled")
+	if err != nil {
+		return fmt.Errorf("MatchHostGroupsCheckbox: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsCheckboxWithListOfHostGroups returns the union data as a CheckboxWithListOfHostGroups.
+func (u MatchHostGroupsCheckbox) AsCheckboxWithListOfHostGroups() (CheckboxWithListOfHostGroups, error) {
+	var v CheckboxWithListOfHostGroups
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromCheckboxWithListOfHostGroups sets the union to v, with "state" set to "enabled".
+func (u *MatchHostGroupsCheckbox) FromCheckboxWithListOfHostGroups(v CheckboxWithListOfHostGroups) error {
+	data, err := unionFrom(v, "state", "enabled")
+	if err != nil {
+		return fmt.Errorf("MatchHostGroupsCheckbox: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// ValueByDiscriminator decodes the union into the member selected by "state".
+func (u MatchHostGroupsCheckbox) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := u.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "disabled":
+		return u.AsCheckbox()
+	case "enabled":
+		return u.AsCheckboxWithListOfHostGroups()
+	}
+	return nil, fmt.Errorf("MatchHostGroupsCheckbox: unknown state %q", discriminator)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u MatchHostGroupsCheckbox) MarshalJSON() ([]byte, error) {
+	if len(u.union) == 0 {
+		return []byte("null"), nil
+	}
+	return u.union, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting unknown "state" values.
+func (u *MatchHostGroupsCheckbox) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		u.union = nil
+		return nil
+	}
+	discriminator, err := unionDiscriminator(data, "state")
+	if err != nil {
+		return fmt.Errorf("MatchHostGroupsCheckbox: %w", err)
+	}
+	switch discriminator {
+	case "disabled", "enabled":
+	default:
+		return fmt.Errorf("MatchHostGroupsCheckbox: unknown state %q", discriminator)
+	}
+	u.union = append(json.RawMessage(nil), data...)
+	return nil
 }
 
 // MatchHostTags represents a CheckMK API type.
@@ -3823,60 +7715,1208 @@ type MatchHostTags struct {
 	TagType string `json:"tag_type,omitempty"`
 }
 
-// MatchHostTagsCheckbox represents a CheckMK API type.
+// MatchHostTagsCheckbox is a union of Checkbox, CheckboxMatchHostTags, selected by the "state" property.
 type MatchHostTagsCheckbox struct {
-}
-
-// MatchHostsCheckbox represents a CheckMK API type.
+	union json.RawMessage
+}
+
+// Discriminator returns the value of the "state" property.
+func (u MatchHostTagsCheckbox) Discriminator() (string, error) {
+	return unionDiscriminator(u.union, "state")
+}
+
+// AsCheckbox returns the union data as a Checkbox.
+func (u MatchHostTagsCheckbox) AsCheckbox() (Checkbox, error) {
+	var v Checkbox
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromCheckbox sets the union to v, with "state" set to "disabled".
+func (u *MatchHostTagsCheckbox) FromCheckbox(v Checkbox) error {
+	data, err := unionFrom(v, "state", "disabled")
+	if err != nil {
+		return fmt.Errorf("MatchHostTagsCheckbox: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsCheckboxMatchHostTags returns the union data as a CheckboxMatchHostTags.
+func (u MatchHostTagsCheckbox) AsCheckboxMatchHostTags() (CheckboxMatchHostTags, error) {
+	var v CheckboxMatchHostTags
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromCheckboxMatchHostTags sets the union to v, with "state" set to "enabled".
+func (u *MatchHostTagsCheckbox) FromCheckboxMatchHostTags(v CheckboxMatchHostTags) error {
+	data, err := unionFrom(v, "state", "enabled")
+	if err != nil {
+		return fmt.Errorf("MatchHostTagsCheckbox: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// ValueByDiscriminator decodes the union into the member selected by "state".
+func (u MatchHostTagsCheckbox) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := u.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "disabled":
+		return u.AsCheckbox()
+	case "enabled":
+		return u.AsCheckboxMatchHostTags()
+	}
+	return nil, fmt.Errorf("MatchHostTagsCheckbox: unknown state %q", discriminator)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u MatchHostTagsCheckbox) MarshalJSON() ([]byte, error) {
+	if len(u.union) == 0 {
+		return []byte("null"), nil
+	}
+	return u.union, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting unknown "state" values.
+func (u *MatchHostTagsCheckbox) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		u.union = nil
+		return nil
+	}
+	discriminator, err := unionDiscriminator(data, "state")
+	if err != nil {
+		return fmt.Errorf("MatchHostTagsCheckbox: %w", err)
+	}
+	switch discriminator {
+	case "disabled", "enabled":
+	default:
+		return fmt.Errorf("MatchHostTagsCheckbox: unknown state %q", discriminator)
+	}
+	u.union = append(json.RawMessage(nil), data...)
+	return nil
+}
+
+// MatchHostsCheckbox is a union of Checkbox, CheckboxWithListOfHosts, selected by the "state" property.
 type MatchHostsCheckbox struct {
-}
-
-// MatchLabelsCheckbox represents a CheckMK API type.
+	union json.RawMessage
+}
+
+// Discriminator returns the value of the "state" property.
+func (u MatchHostsCheckbox) Discriminator() (string, error) {
+	return unionDiscriminator(u.union, "state")
+}
+
+// AsCheckbox returns the union data as a Checkbox.
+func (u MatchHostsCheckbox) AsCheckbox() (Checkbox, error) {
+	var v Checkbox
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromCheckbox sets the union to v, with "state" set to "disabled".
+func (u *MatchHostsCheckbox) FromCheckbox(v Checkbox) error {
+	data, err := unionFrom(v, "state", "disabled")
+	if err != nil {
+		return fmt.Errorf("MatchHostsCheckbox: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsCheckboxWithListOfHosts returns the union data as a CheckboxWithListOfHosts.
+func (u MatchHostsCheckbox) AsCheckboxWithListOfHosts() (CheckboxWithListOfHosts, error) {
+	var v CheckboxWithListOfHosts
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromCheckboxWithListOfHosts sets the union to v, with "state" set to "enabled".
+func (u *MatchHostsCheckbox) FromCheckboxWithListOfHosts(v CheckboxWithListOfHosts) error {
+	data, err := unionFrom(v, "state", "enabled")
+	if err != nil {
+		return fmt.Errorf("MatchHostsCheckbox: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// ValueByDiscriminator decodes the union into the member selected by "state".
+func (u MatchHostsCheckbox) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := u.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "disabled":
+		return u.AsCheckbox()
+	case "enabled":
+		return u.AsCheckboxWithListOfHosts()
+	}
+	return nil, fmt.Errorf("MatchHostsCheckbox: unknown state %q", discriminator)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u MatchHostsCheckbox) MarshalJSON() ([]byte, error) {
+	if len(u.union) == 0 {
+		return []byte("null"), nil
+	}
+	return u.union, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting unknown "state" values.
+func (u *MatchHostsCheckbox) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		u.union = nil
+		return nil
+	}
+	discriminator, err := unionDiscriminator(data, "state")
+	if err != nil {
+		return fmt.Errorf("MatchHostsCheckbox: %w", err)
+	}
+	switch discriminator {
+	case "disabled", "enabled":
+	default:
+		return fmt.Errorf("MatchHostsCheckbox: unknown state %q", discriminator)
+	}
+	u.union = append(json.RawMessage(nil), data...)
+	return nil
+}
+
+// MatchLabelsCheckbox is a union of Checkbox, CheckboxWithListOfLabels, selected by the "state" property.
 type MatchLabelsCheckbox struct {
-}
-
-// MatchRuleIdsOneOf represents a CheckMK API type.
+	union json.RawMessage
+}
+
+// Discriminator returns the value of the "state" property.
+func (u MatchLabelsCheckbox) Discriminator() (string, error) {
+	return unionDiscriminator(u.union, "state")
+}
+
+// AsCheckbox returns the union data as a Checkbox.
+func (u MatchLabelsCheckbox) AsCheckbox() (Checkbox, error) {
+	var v Checkbox
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromCheckbox sets the union to v, with "state" set to "disabled".
+func (u *MatchLabelsCheckbox) FromCheckbox(v Checkbox) error {
+	data, err := unionFrom(v, "state", "disabled")
+	if err != nil {
+		return fmt.Errorf("MatchLabelsCheckbox: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsCheckboxWithListOfLabels returns the union data as a CheckboxWithListOfLabels.
+func (u MatchLabelsCheckbox) AsCheckboxWithListOfLabels() (CheckboxWithListOfLabels, error) {
+	var v CheckboxWithListOfLabels
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromCheckboxWithListOfLabels sets the union to v, with "state" set to "enabled".
+func (u *MatchLabelsCheckbox) FromCheckboxWithListOfLabels(v CheckboxWithListOfLabels) error {
+	data, err := unionFrom(v, "state", "enabled")
+	if err != nil {
+		return fmt.Errorf("MatchLabelsCheckbox: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// ValueByDiscriminator decodes the union into the member selected by "state".
+func (u MatchLabelsCheckbox) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := u.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "disabled":
+		return u.AsCheckbox()
+	case "enabled":
+		return u.AsCheckboxWithListOfLabels()
+	}
+	return nil, fmt.Errorf("MatchLabelsCheckbox: unknown state %q", discriminator)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u MatchLabelsCheckbox) MarshalJSON() ([]byte, error) {
+	if len(u.union) == 0 {
+		return []byte("null"), nil
+	}
+	return u.union, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting unknown "state" values.
+func (u *MatchLabelsCheckbox) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		u.union = nil
+		return nil
+	}
+	discriminator, err := unionDiscriminator(data, "state")
+	if err != nil {
+		return fmt.Errorf("MatchLabelsCheckbox: %w", err)
+	}
+	switch discriminator {
+	case "disabled", "enabled":
+	default:
+		return fmt.Errorf("MatchLabelsCheckbox: unknown state %q", discriminator)
+	}
+	u.union = append(json.RawMessage(nil), data...)
+	return nil
+}
+
+// MatchRuleIdsOneOf is a union of Checkbox, CheckboxWithListOfRuleIds, selected by the "state" property.
 type MatchRuleIdsOneOf struct {
-}
-
-// MatchServiceEventTypeCheckbox represents a CheckMK API type.
+	union json.RawMessage
+}
+
+// Discriminator returns the value of the "state" property.
+func (u MatchRuleIdsOneOf) Discriminator() (string, error) {
+	return unionDiscriminator(u.union, "state")
+}
+
+// AsCheckbox returns the union data as a Checkbox.
+func (u MatchRuleIdsOneOf) AsCheckbox() (Checkbox, error) {
+	var v Checkbox
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromCheckbox sets the union to v, with "state" set to "disabled".
+func (u *MatchRuleIdsOneOf) FromCheckbox(v Checkbox) error {
+	data, err := unionFrom(v, "state", "disabled")
+	if err != nil {
+		return fmt.Errorf("MatchRuleIdsOneOf: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsCheckboxWithListOfRuleIds returns the union data as a CheckboxWithListOfRuleIds.
+func (u MatchRuleIdsOneOf) AsCheckboxWithListOfRuleIds() (CheckboxWithListOfRuleIds, error) {
+	var v CheckboxWithListOfRuleIds
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromCheckboxWithListOfRuleIds sets the union to v, with "state" set to "enabled".
+func (u *MatchRuleIdsOneOf) FromCheckboxWithListOfRuleIds(v CheckboxWithListOfRuleIds) error {
+	data, err := unionFrom(v, "state", "enabled")
+	if err != nil {
+		return fmt.Errorf("MatchRuleIdsOneOf: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// ValueByDiscriminator decodes the union into the member selected by "state".
+func (u MatchRuleIdsOneOf) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := u.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "disabled":
+		return u.AsCheckbox()
+	case "enabled":
+		return u.AsCheckboxWithListOfRuleIds()
+	}
+	return nil, fmt.Errorf("MatchRuleIdsOneOf: unknown state %q", discriminator)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u MatchRuleIdsOneOf) MarshalJSON() ([]byte, error) {
+	if len(u.union) == 0 {
+		return []byte("null"), nil
+	}
+	return u.union, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting unknown "state" values.
+func (u *MatchRuleIdsOneOf) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		u.union = nil
+		return nil
+	}
+	discriminator, err := unionDiscriminator(data, "state")
+	if err != nil {
+		return fmt.Errorf("MatchRuleIdsOneOf: %w", err)
+	}
+	switch discriminator {
+	case "disabled", "enabled":
+	default:
+		return fmt.Errorf("MatchRuleIdsOneOf: unknown state %q", discriminator)
+	}
+	u.union = append(json.RawMessage(nil), data...)
+	return nil
+}
+
+// MatchServiceEventTypeCheckbox is a union of Checkbox, CheckboxServiceEventType, selected by the "state" property.
 type MatchServiceEventTypeCheckbox struct {
-}
-
-// MatchServiceGroupRegexCheckbox represents a CheckMK API type.
+	union json.RawMessage
+}
+
+// Discriminator returns the value of the "state" property.
+func (u MatchServiceEventTypeCheckbox) Discriminator() (string, error) {
+	return unionDiscriminator(u.union, "state")
+}
+
+// AsCheckbox returns the union data as a Checkbox.
+func (u MatchServiceEventTypeCheckbox) AsCheckbox() (Checkbox, error) {
+	var v Checkbox
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromCheckbox sets the union to v, with "state" set to "disabled".
+func (u *MatchServiceEventTypeCheckbox) FromCheckbox(v Checkbox) error {
+	data, err := unionFrom(v, "state", "disabled")
+	if err != nil {
+		return fmt.Errorf("MatchServiceEventTypeCheckbox: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsCheckboxServiceEventType returns the union data as a CheckboxServiceEventType.
+func (u MatchServiceEventTypeCheckbox) AsCheckboxServiceEventType() (CheckboxServiceEventType, error) {
+	var v CheckboxServiceEventType
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromCheckboxServiceEventType sets the union to v, with "state" set to "enabled".
+func (u *MatchServiceEventTypeCheckbox) FromCheckboxServiceEventType(v CheckboxServiceEventType) error {
+	data, err := unionFrom(v, "state", "enabled")
+	if err != nil {
+		return fmt.Errorf("MatchServiceEventTypeCheckbox: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// ValueByDiscriminator decodes the union into the member selected by "state".
+func (u MatchServiceEventTypeCheckbox) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := u.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "disabled":
+		return u.AsCheckbox()
+	case "enabled":
+		return u.AsCheckboxServiceEventType()
+	}
+	return nil, fmt.Errorf("MatchServiceEventTypeCheckbox: unknown state %q", discriminator)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u MatchServiceEventTypeCheckbox) MarshalJSON() ([]byte, error) {
+	if len(u.union) == 0 {
+		return []byte("null"), nil
+	}
+	return u.union, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting unknown "state" values.
+func (u *MatchServiceEventTypeCheckbox) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		u.union = nil
+		return nil
+	}
+	discriminator, err := unionDiscriminator(data, "state")
+	if err != nil {
+		return fmt.Errorf("MatchServiceEventTypeCheckbox: %w", err)
+	}
+	switch discriminator {
+	case "disabled", "enabled":
+	default:
+		return fmt.Errorf("MatchServiceEventTypeCheckbox: unknown state %q", discriminator)
+	}
+	u.union = append(json.RawMessage(nil), data...)
+	return nil
+}
+
+// MatchServiceGroupRegexCheckbox is a union of Checkbox, CheckboxWithListOfServiceGroupsRegex, selected by the "state" property.
 type MatchServiceGroupRegexCheckbox struct {
-}
-
-// MatchServiceGroupsCheckbox represents a CheckMK API type.
+	union json.RawMessage
+}
+
+// Discriminator returns the value of the "state" property.
+func (u MatchServiceGroupRegexCheckbox) Discriminator() (string, error) {
+	return unionDiscriminator(u.union, "state")
+}
+
+// AsCheckbox returns the union data as a Checkbox.
+func (u MatchServiceGroupRegexCheckbox) AsCheckbox() (Checkbox, error) {
+	var v Checkbox
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromCheckbox sets the union to v, with "state" set to "disabled".
+func (u *MatchServiceGroupRegexCheckbox) FromCheckbox(v Checkbox) error {
+	data, err := unionFrom(v, "state", "disabled")
+	if err != nil {
+		return fmt.Errorf("MatchServiceGroupRegexCheckbox: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsCheckboxWithListOfServiceGroupsRegex returns the union data as a CheckboxWithListOfServiceGroupsRegex.
+func (u MatchServiceGroupRegexCheckbox) AsCheckboxWithListOfServiceGroupsRegex() (CheckboxWithListOfServiceGroupsRegex, error) {
+	var v CheckboxWithListOfServiceGroupsRegex
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromCheckboxWithListOfServiceGroupsRegex sets the union to v, with "state" set to "enabled".
+func (u *MatchServiceGroupRegexCheckbox) FromCheckboxWithListOfServiceGroupsRegex(v CheckboxWithListOfServiceGroupsRegex) error {
+	data, err := unionFrom(v, "state", "enabled")
+	if err != nil {
+		return fmt.Errorf("MatchServiceGroupRegexCheckbox: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// ValueByDiscriminator decodes the union into the member selected by "state".
+func (u MatchServiceGroupRegexCheckbox) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := u.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "disabled":
+		return u.AsCheckbox()
+	case "enabled":
+		return u.AsCheckboxWithListOfServiceGroupsRegex()
+	}
+	return nil, fmt.Errorf("MatchServiceGroupRegexCheckbox: unknown state %q", discriminator)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u MatchServiceGroupRegexCheckbox) MarshalJSON() ([]byte, error) {
+	if len(u.union) == 0 {
+		return []byte("null"), nil
+	}
+	return u.union, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting unknown "state" values.
+func (u *MatchServiceGroupRegexCheckbox) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		u.union = nil
+		return nil
+	}
+	discriminator, err := unionDiscriminator(data, "state")
+	if err != nil {
+		return fmt.Errorf("MatchServiceGroupRegexCheckbox: %w", err)
+	}
+	switch discriminator {
+	case "disabled", "enabled":
+	default:
+		return fmt.Errorf("MatchServiceGroupRegexCheckbox: unknown state %q", discriminator)
+	}
+	u.union = append(json.RawMessage(nil), data...)
+	return nil
+}
+
+// MatchServiceGroupsCheckbox is a union of Checkbox, CheckboxWithListOfServiceGroups, selected by the "state" property.
 type MatchServiceGroupsCheckbox struct {
-}
-
-// MatchServiceLevelsCheckbox represents a CheckMK API type.
+	union json.RawMessage
+}
+
+// Discriminator returns the value of the "state" property.
+func (u MatchServiceGroupsCheckbox) Discriminator() (string, error) {
+	return unionDiscriminator(u.union, "state")
+}
+
+// AsCheckbox returns the union data as a Checkbox.
+func (u MatchServiceGroupsCheckbox) AsCheckbox() (Checkbox, error) {
+	var v Checkbox
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromCheckbox sets the union to v, with "state" set to "disabled".
+func (u *MatchServiceGroupsCheckbox) FromCheckbox(v Checkbox) error {
+	data, err := unionFrom(v, "state", "disabled")
+	if err != nil {
+		return fmt.Errorf("MatchServiceGroupsCheckbox: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsCheckboxWithListOfServiceGroups returns the union data as a CheckboxWithListOfServiceGroups.
+func (u MatchServiceGroupsCheckbox) AsCheckboxWithListOfServiceGroups() (CheckboxWithListOfServiceGroups, error) {
+	var v CheckboxWithListOfServiceGroups
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromCheckboxWithListOfServiceGroups sets the union to v, with "state" set to "enabled".
+func (u *MatchServiceGroupsCheckbox) FromCheckboxWithListOfServiceGroups(v CheckboxWithListOfServiceGroups) error {
+	data, err := unionFrom(v, "state", "enabled")
+	if err != nil {
+		return fmt.Errorf("MatchServiceGroupsCheckbox: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// ValueByDiscriminator decodes the union into the member selected by "state".
+func (u MatchServiceGroupsCheckbox) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := u.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "disabled":
+		return u.AsCheckbox()
+	case "enabled":
+		return u.AsCheckboxWithListOfServiceGroups()
+	}
+	return nil, fmt.Errorf("MatchServiceGroupsCheckbox: unknown state %q", discriminator)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u MatchServiceGroupsCheckbox) MarshalJSON() ([]byte, error) {
+	if len(u.union) == 0 {
+		return []byte("null"), nil
+	}
+	return u.union, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting unknown "state" values.
+func (u *MatchServiceGroupsCheckbox) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		u.union = nil
+		return nil
+	}
+	discriminator, err := unionDiscriminator(data, "state")
+	if err != nil {
+		return fmt.Errorf("MatchServiceGroupsCheckbox: %w", err)
+	}
+	switch discriminator {
+	case "disabled", "enabled":
+	default:
+		return fmt.Errorf("MatchServiceGroupsCheckbox: unknown state %q", discriminator)
+	}
+	u.union = append(json.RawMessage(nil), data...)
+	return nil
+}
+
+// MatchServiceLevelsCheckbox is a union of Checkbox, CheckboxWithFromToServiceLevels, selected by the "state" property.
 type MatchServiceLevelsCheckbox struct {
-}
-
-// MatchServicesCheckbox represents a CheckMK API type.
+	union json.RawMessage
+}
+
+// Discriminator returns the value of the "state" property.
+func (u MatchServiceLevelsCheckbox) Discriminator() (string, error) {
+	return unionDiscriminator(u.union, "state")
+}
+
+// AsCheckbox returns the union data as a Checkbox.
+func (u MatchServiceLevelsCheckbox) AsCheckbox() (Checkbox, error) {
+	var v Checkbox
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromCheckbox sets the union to v, with "state" set to "disabled".
+func (u *MatchServiceLevelsCheckbox) FromCheckbox(v Checkbox) error {
+	data, err := unionFrom(v, "state", "disabled")
+	if err != nil {
+		return fmt.Errorf("MatchServiceLevelsCheckbox: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsCheckboxWithFromToServiceLevels returns the union data as a CheckboxWithFromToServiceLevels.
+func (u MatchServiceLevelsCheckbox) AsCheckboxWithFromToServiceLevels() (CheckboxWithFromToServiceLevels, error) {
+	var v CheckboxWithFromToServiceLevels
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromCheckboxWithFromToServiceLevels sets the union to v, with "state" set to "enabled".
+func (u *MatchServiceLevelsCheckbox) FromCheckboxWithFromToServiceLevels(v CheckboxWithFromToServiceLevels) error {
+	data, err := unionFrom(v, "state", "enabled")
+	if err != nil {
+		return fmt.Errorf("MatchServiceLevelsCheckbox: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// ValueByDiscriminator decodes the union into the member selected by "state".
+func (u MatchServiceLevelsCheckbox) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := u.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "disabled":
+		return u.AsCheckbox()
+	case "enabled":
+		return u.AsCheckboxWithFromToServiceLevels()
+	}
+	return nil, fmt.Errorf("MatchServiceLevelsCheckbox: unknown state %q", discriminator)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u MatchServiceLevelsCheckbox) MarshalJSON() ([]byte, error) {
+	if len(u.union) == 0 {
+		return []byte("null"), nil
+	}
+	return u.union, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting unknown "state" values.
+func (u *MatchServiceLevelsCheckbox) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		u.union = nil
+		return nil
+	}
+	discriminator, err := unionDiscriminator(data, "state")
+	if err != nil {
+		return fmt.Errorf("MatchServiceLevelsCheckbox: %w", err)
+	}
+	switch discriminator {
+	case "disabled", "enabled":
+	default:
+		return fmt.Errorf("MatchServiceLevelsCheckbox: unknown state %q", discriminator)
+	}
+	u.union = append(json.RawMessage(nil), data...)
+	return nil
+}
+
+// MatchServicesCheckbox is a union of Checkbox, CheckboxWithListOfStr, selected by the "state" property.
 type MatchServicesCheckbox struct {
-}
-
-// MatchSitesCheckbox represents a CheckMK API type.
+	union json.RawMessage
+}
+
+// Discriminator returns the value of the "state" property.
+func (u MatchServicesCheckbox) Discriminator() (string, error) {
+	return unionDiscriminator(u.union, "state")
+}
+
+// AsCheckbox returns the union data as a Checkbox.
+func (u MatchServicesCheckbox) AsCheckbox() (Checkbox, error) {
+	var v Checkbox
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromCheckbox sets the union to v, with "state" set to "disabled".
+func (u *MatchServicesCheckbox) FromCheckbox(v Checkbox) error {
+	data, err := unionFrom(v, "state", "disabled")
+	if err != nil {
+		return fmt.Errorf("MatchServicesCheckbox: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsCheckboxWithListOfStr returns the union data as a CheckboxWithListOfStr.
+func (u MatchServicesCheckbox) AsCheckboxWithListOfStr() (CheckboxWithListOfStr, error) {
+	var v CheckboxWithListOfStr
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromCheckboxWithListOfStr sets the union to v, with "state" set to "enabled".
+func (u *MatchServicesCheckbox) FromCheckboxWithListOfStr(v CheckboxWithListOfStr) error {
+	data, err := unionFrom(v, "state", "enabled")
+	if err != nil {
+		return fmt.Errorf("MatchServicesCheckbox: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// ValueByDiscriminator decodes the union into the member selected by "state".
+func (u MatchServicesCheckbox) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := u.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "disabled":
+		return u.AsCheckbox()
+	case "enabled":
+		return u.AsCheckboxWithListOfStr()
+	}
+	return nil, fmt.Errorf("MatchServicesCheckbox: unknown state %q", discriminator)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u MatchServicesCheckbox) MarshalJSON() ([]byte, error) {
+	if len(u.union) == 0 {
+		return []byte("null"), nil
+	}
+	return u.union, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting unknown "state" values.
+func (u *MatchServicesCheckbox) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		u.union = nil
+		return nil
+	}
+	discriminator, err := unionDiscriminator(data, "state")
+	if err != nil {
+		return fmt.Errorf("MatchServicesCheckbox: %w", err)
+	}
+	switch discriminator {
+	case "disabled", "enabled":
+	default:
+		return fmt.Errorf("MatchServicesCheckbox: unknown state %q", discriminator)
+	}
+	u.union = append(json.RawMessage(nil), data...)
+	return nil
+}
+
+// MatchSitesCheckbox is a union of Checkbox, CheckboxWithListOfSites, selected by the "state" property.
 type MatchSitesCheckbox struct {
-}
-
-// MatchSysLogFacOneOf represents a CheckMK API type.
+	union json.RawMessage
+}
+
+// Discriminator returns the value of the "state" property.
+func (u MatchSitesCheckbox) Discriminator() (string, error) {
+	return unionDiscriminator(u.union, "state")
+}
+
+// AsCheckbox returns the union data as a Checkbox.
+func (u MatchSitesCheckbox) AsCheckbox() (Checkbox, error) {
+	var v Checkbox
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromCheckbox sets the union to v, with "state" set to "disabled".
+func (u *MatchSitesCheckbox) FromCheckbox(v Checkbox) error {
+	data, err := unionFrom(v, "state", "disabled")
+	if err != nil {
+		return fmt.Errorf("MatchSitesCheckbox: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsCheckboxWithListOfSites returns the union data as a CheckboxWithListOfSites.
+func (u MatchSitesCheckbox) AsCheckboxWithListOfSites() (CheckboxWithListOfSites, error) {
+	var v CheckboxWithListOfSites
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromCheckboxWithListOfSites sets the union to v, with "state" set to "enabled".
+func (u *MatchSitesCheckbox) FromCheckboxWithListOfSites(v CheckboxWithListOfSites) error {
+	data, err := unionFrom(v, "state", "enabled")
+	if err != nil {
+		return fmt.Errorf("MatchSitesCheckbox: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// ValueByDiscriminator decodes the union into the member selected by "state".
+func (u MatchSitesCheckbox) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := u.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "disabled":
+		return u.AsCheckbox()
+	case "enabled":
+		return u.AsCheckboxWithListOfSites()
+	}
+	return nil, fmt.Errorf("MatchSitesCheckbox: unknown state %q", discriminator)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u MatchSitesCheckbox) MarshalJSON() ([]byte, error) {
+	if len(u.union) == 0 {
+		return []byte("null"), nil
+	}
+	return u.union, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting unknown "state" values.
+func (u *MatchSitesCheckbox) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		u.union = nil
+		return nil
+	}
+	discriminator, err := unionDiscriminator(data, "state")
+	if err != nil {
+		return fmt.Errorf("MatchSitesCheckbox: %w", err)
+	}
+	switch discriminator {
+	case "disabled", "enabled":
+	default:
+		return fmt.Errorf("MatchSitesCheckbox: unknown state %q", discriminator)
+	}
+	u.union = append(json.RawMessage(nil), data...)
+	return nil
+}
+
+// MatchSysLogFacOneOf is a union of Checkbox, CheckboxWithSysLogFacility, selected by the "state" property.
 type MatchSysLogFacOneOf struct {
-}
-
-// MatchSysLogPriOneOf represents a CheckMK API type.
+	union json.RawMessage
+}
+
+// Discriminator returns the value of the "state" property.
+func (u MatchSysLogFacOneOf) Discriminator() (string, error) {
+	return unionDiscriminator(u.union, "state")
+}
+
+// AsCheckbox returns the union data as a Checkbox.
+func (u MatchSysLogFacOneOf) AsCheckbox() (Checkbox, error) {
+	var v Checkbox
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromCheckbox sets the union to v, with "state" set to "disabled".
+func (u *MatchSysLogFacOneOf) FromCheckbox(v Checkbox) error {
+	data, err := unionFrom(v, "state", "disabled")
+	if err != nil {
+		return fmt.Errorf("MatchSysLogFacOneOf: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsCheckboxWithSysLogFacility returns the union data as a CheckboxWithSysLogFacility.
+func (u MatchSysLogFacOneOf) AsCheckboxWithSysLogFacility() (CheckboxWithSysLogFacility, error) {
+	var v CheckboxWithSysLogFacility
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromCheckboxWithSysLogFacility sets the union to v, with "state" set to "enabled".
+func (u *MatchSysLogFacOneOf) FromCheckboxWithSysLogFacility(v CheckboxWithSysLogFacility) error {
+	data, err := unionFrom(v, "state", "enabled")
+	if err != nil {
+		return fmt.Errorf("MatchSysLogFacOneOf: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// ValueByDiscriminator decodes the union into the member selected by "state".
+func (u MatchSysLogFacOneOf) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := u.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "disabled":
+		return u.AsCheckbox()
+	case "enabled":
+		return u.AsCheckboxWithSysLogFacility()
+	}
+	return nil, fmt.Errorf("MatchSysLogFacOneOf: unknown state %q", discriminator)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u MatchSysLogFacOneOf) MarshalJSON() ([]byte, error) {
+	if len(u.union) == 0 {
+		return []byte("null"), nil
+	}
+	return u.union, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting unknown "state" values.
+func (u *MatchSysLogFacOneOf) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		u.union = nil
+		return nil
+	}
+	discriminator, err := unionDiscriminator(data, "state")
+	if err != nil {
+		return fmt.Errorf("MatchSysLogFacOneOf: %w", err)
+	}
+	switch discriminator {
+	case "disabled", "enabled":
+	default:
+		return fmt.Errorf("MatchSysLogFacOneOf: unknown state %q", discriminator)
+	}
+	u.union = append(json.RawMessage(nil), data...)
+	return nil
+}
+
+// MatchSysLogPriOneOf is a union of Checkbox, CheckboxWithSysLogPriority, selected by the "state" property.
 type MatchSysLogPriOneOf struct {
-}
-
-// MatchTimePeriodCheckbox represents a CheckMK API type.
+	union json.RawMessage
+}
+
+// Discriminator returns the value of the "state" property.
+func (u MatchSysLogPriOneOf) Discriminator() (string, error) {
+	return unionDiscriminator(u.union, "state")
+}
+
+// AsCheckbox returns the union data as a Checkbox.
+func (u MatchSysLogPriOneOf) AsCheckbox() (Checkbox, error) {
+	var v Checkbox
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromCheckbox sets the union to v, with "state" set to "disabled".
+func (u *MatchSysLogPriOneOf) FromCheckbox(v Checkbox) error {
+	data, err := unionFrom(v, "state", "disabled")
+	if err != nil {
+		return fmt.Errorf("MatchSysLogPriOneOf: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsCheckboxWithSysLogPriority returns the union data as a CheckboxWithSysLogPriority.
+func (u MatchSysLogPriOneOf) AsCheckboxWithSysLogPriority() (CheckboxWithSysLogPriority, error) {
+	var v CheckboxWithSysLogPriority
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromCheckboxWithSysLogPriority sets the union to v, with "state" set to "enabled".
+func (u *MatchSysLogPriOneOf) FromCheckboxWithSysLogPriority(v CheckboxWithSysLogPriority) error {
+	data, err := unionFrom(v, "state", "enabled")
+	if err != nil {
+		return fmt.Errorf("MatchSysLogPriOneOf: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// ValueByDiscriminator decodes the union into the member selected by "state".
+func (u MatchSysLogPriOneOf) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := u.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "disabled":
+		return u.AsCheckbox()
+	case "enabled":
+		return u.AsCheckboxWithSysLogPriority()
+	}
+	return nil, fmt.Errorf("MatchSysLogPriOneOf: unknown state %q", discriminator)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u MatchSysLogPriOneOf) MarshalJSON() ([]byte, error) {
+	if len(u.union) == 0 {
+		return []byte("null"), nil
+	}
+	return u.union, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting unknown "state" values.
+func (u *MatchSysLogPriOneOf) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		u.union = nil
+		return nil
+	}
+	discriminator, err := unionDiscriminator(data, "state")
+	if err != nil {
+		return fmt.Errorf("MatchSysLogPriOneOf: %w", err)
+	}
+	switch discriminator {
+	case "disabled", "enabled":
+	default:
+		return fmt.Errorf("MatchSysLogPriOneOf: unknown state %q", discriminator)
+	}
+	u.union = append(json.RawMessage(nil), data...)
+	return nil
+}
+
+// MatchTimePeriodCheckbox is a union of Checkbox, CheckboxWithTimePeriod, selected by the "state" property.
 type MatchTimePeriodCheckbox struct {
-}
-
-// MatchTypeSelector represents a CheckMK API type.
+	union json.RawMessage
+}
+
+// Discriminator returns the value of the "state" property.
+func (u MatchTimePeriodCheckbox) Discriminator() (string, error) {
+	return unionDiscriminator(u.union, "state")
+}
+
+// AsCheckbox returns the union data as a Checkbox.
+func (u MatchTimePeriodCheckbox) AsCheckbox() (Checkbox, error) {
+	var v Checkbox
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromCheckbox sets the union to v, with "state" set to "disabled".
+func (u *MatchTimePeriodCheckbox) FromCheckbox(v Checkbox) error {
+	data, err := unionFrom(v, "state", "disabled")
+	if err != nil {
+		return fmt.Errorf("MatchTimePeriodCheckbox: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsCheckboxWithTimePeriod returns the union data as a CheckboxWithTimePeriod.
+func (u MatchTimePeriodCheckbox) AsCheckboxWithTimePeriod() (CheckboxWithTimePeriod, error) {
+	var v CheckboxWithTimePeriod
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromCheckboxWithTimePeriod sets the union to v, with "state" set to "enabled".
+func (u *MatchTimePeriodCheckbox) FromCheckboxWithTimePeriod(v CheckboxWithTimePeriod) error {
+	data, err := unionFrom(v, "state", "enabled")
+	if err != nil {
+		return fmt.Errorf("MatchTimePeriodCheckbox: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// ValueByDiscriminator decodes the union into the member selected by "state".
+func (u MatchTimePeriodCheckbox) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := u.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "disabled":
+		return u.AsCheckbox()
+	case "enabled":
+		return u.AsCheckboxWithTimePeriod()
+	}
+	return nil, fmt.Errorf("MatchTimePeriodCheckbox: unknown state %q", discriminator)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u MatchTimePeriodCheckbox) MarshalJSON() ([]byte, error) {
+	if len(u.union) == 0 {
+		return []byte("null"), nil
+	}
+	return u.union, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting unknown "state" values.
+func (u *MatchTimePeriodCheckbox) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		u.union = nil
+		return nil
+	}
+	discriminator, err := unionDiscriminator(data, "state")
+	if err != nil {
+		return fmt.Errorf("MatchTimePeriodCheckbox: %w", err)
+	}
+	switch discriminator {
+	case "disabled", "enabled":
+	default:
+		return fmt.Errorf("MatchTimePeriodCheckbox: unknown state %q", discriminator)
+	}
+	u.union = append(json.RawMessage(nil), data...)
+	return nil
+}
+
+// MatchTypeSelector is a union of EventConsoleAlertAttributes, EventConsoleAlertAttributesBase, selected by the "match_type" property.
 type MatchTypeSelector struct {
+	union json.RawMessage
+}
+
+// Discriminator returns the value of the "match_type" property.
+func (u MatchTypeSelector) Discriminator() (string, error) {
+	return unionDiscriminator(u.union, "match_type")
+}
+
+// AsEventConsoleAlertAttributes returns the union data as a EventConsoleAlertAttributes.
+func (u MatchTypeSelector) AsEventConsoleAlertAttributes() (EventConsoleAlertAttributes, error) {
+	var v EventConsoleAlertAttributes
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromEventConsoleAlertAttributes sets the union to v, with "match_type" set to "match_only_event_console_alerts".
+func (u *MatchTypeSelector) FromEventConsoleAlertAttributes(v EventConsoleAlertAttributes) error {
+	data, err := unionFrom(v, "match_type", "match_only_event_console_alerts")
+	if err != nil {
+		return fmt.Errorf("MatchTypeSelector: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsEventConsoleAlertAttributesBase returns the union data as a EventConsoleAlertAttributesBase.
+func (u MatchTypeSelector) AsEventConsoleAlertAttributesBase() (EventConsoleAlertAttributesBase, error) {
+	var v EventConsoleAlertAttributesBase
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromEventConsoleAlertAttributesBase sets the union to v, with "match_type" set to "do_not_match_event_console_alerts".
+func (u *MatchTypeSelector) FromEventConsoleAlertAttributesBase(v EventConsoleAlertAttributesBase) error {
+	data, err := unionFrom(v, "match_type", "do_not_match_event_console_alerts")
+	if err != nil {
+		return fmt.Errorf("MatchTypeSelector: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// ValueByDiscriminator decodes the union into the member selected by "match_type".
+func (u MatchTypeSelector) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := u.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "match_only_event_console_alerts":
+		return u.AsEventConsoleAlertAttributes()
+	case "do_not_match_event_console_alerts":
+		return u.AsEventConsoleAlertAttributesBase()
+	}
+	return nil, fmt.Errorf("MatchTypeSelector: unknown match_type %q", discriminator)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u MatchTypeSelector) MarshalJSON() ([]byte, error) {
+	if len(u.union) == 0 {
+		return []byte("null"), nil
+	}
+	return u.union, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting unknown "match_type" values.
+func (u *MatchTypeSelector) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		u.union = nil
+		return nil
+	}
+	discriminator, err := unionDiscriminator(data, "match_type")
+	if err != nil {
+		return fmt.Errorf("MatchTypeSelector: %w", err)
+	}
+	switch discriminator {
+	case "match_only_event_console_alerts", "do_not_match_event_console_alerts":
+	default:
+		return fmt.Errorf("MatchTypeSelector: unknown match_type %q", discriminator)
+	}
+	u.union = append(json.RawMessage(nil), data...)
+	return nil
 }
 
 // MetaData represents a CheckMK API type.
@@ -3908,26 +8948,108 @@ type Metric struct {
 // MgmntTypeCaseParams represents a CheckMK API type.
 type MgmntTypeCaseParams struct {
 	Option MgmntTypeCaseParamsOption `json:"option"`
-	Params map[string]interface{} `json:"params,omitempty"`
+	Params *CaseParams `json:"params,omitempty"`
 }
 
 // MgmntTypeIncidentParams represents a CheckMK API type.
 type MgmntTypeIncidentParams struct {
 	Option MgmntTypeIncidentParamsOption `json:"option"`
-	Params map[string]interface{} `json:"params,omitempty"`
+	Params *IncidentParams `json:"params,omitempty"`
 }
 
-// MgmntTypeSelector represents a CheckMK API type.
+// MgmntTypeSelector is a union of MgmntTypeCaseParams, MgmntTypeIncidentParams, selected by the "option" property.
 type MgmntTypeSelector struct {
+	union json.RawMessage
+}
+
+// Discriminator returns the value of the "option" property.
+func (u MgmntTypeSelector) Discriminator() (string, error) {
+	return unionDiscriminator(u.union, "option")
+}
+
+// AsMgmntTypeCaseParams returns the union data as a MgmntTypeCaseParams.
+func (u MgmntTypeSelector) AsMgmntTypeCaseParams() (MgmntTypeCaseParams, error) {
+	var v MgmntTypeCaseParams
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromMgmntTypeCaseParams sets the union to v, with "option" set to "case".
+func (u *MgmntTypeSelector) FromMgmntTypeCaseParams(v MgmntTypeCaseParams) error {
+	data, err := unionFrom(v, "option", "case")
+	if err != nil {
+		return fmt.Errorf("MgmntTypeSelector: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsMgmntTypeIncidentParams returns the union data as a MgmntTypeIncidentParams.
+func (u MgmntTypeSelector) AsMgmntTypeIncidentParams() (MgmntTypeIncidentParams, error) {
+	var v MgmntTypeIncidentParams
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromMgmntTypeIncidentParams sets the union to v, with "option" set to "incident".
+func (u *MgmntTypeSelector) FromMgmntTypeIncidentParams(v MgmntTypeIncidentParams) error {
+	data, err := unionFrom(v, "option", "incident")
+	if err != nil {
+		return fmt.Errorf("MgmntTypeSelector: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// ValueByDiscriminator decodes the union into the member selected by "option".
+func (u MgmntTypeSelector) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := u.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "case":
+		return u.AsMgmntTypeCaseParams()
+	case "incident":
+		return u.AsMgmntTypeIncidentParams()
+	}
+	return nil, fmt.Errorf("MgmntTypeSelector: unknown option %q", discriminator)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u MgmntTypeSelector) MarshalJSON() ([]byte, error) {
+	if len(u.union) == 0 {
+		return []byte("null"), nil
+	}
+	return u.union, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting unknown "option" values.
+func (u *MgmntTypeSelector) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		u.union = nil
+		return nil
+	}
+	discriminator, err := unionDiscriminator(data, "option")
+	if err != nil {
+		return fmt.Errorf("MgmntTypeSelector: %w", err)
+	}
+	switch discriminator {
+	case "case", "incident":
+	default:
+		return fmt.Errorf("MgmntTypeSelector: unknown option %q", discriminator)
+	}
+	u.union = append(json.RawMessage(nil), data...)
+	return nil
 }
 
 // MkEventDPluginCreate represents a CheckMK API type.
 type MkEventDPluginCreate struct {
-	IpAddressOfRemoteEventConsole map[string]interface{} `json:"ip_address_of_remote_event_console"`
+	IpAddressOfRemoteEventConsole IPAddressOneOf `json:"ip_address_of_remote_event_console"`
 	// The plugin name.
 	// Example: mail
 	PluginName MkEventDPluginCreatePluginName `json:"plugin_name"`
-	SyslogFacilityToUse map[string]interface{} `json:"syslog_facility_to_use"`
+	SyslogFacilityToUse SysLogFacilityOneOf `json:"syslog_facility_to_use"`
 }
 
 // MoveFolder represents a CheckMK API type.
@@ -3944,8 +9066,90 @@ type MoveHost struct {
 	TargetFolder string `json:"target_folder"`
 }
 
-// MoveRuleTo represents a CheckMK API type.
+// MoveRuleTo is a union of MoveToFolder, MoveToSpecificRule, selected by the "position" property.
 type MoveRuleTo struct {
+	union json.RawMessage
+}
+
+// Discriminator returns the value of the "position" property.
+func (u MoveRuleTo) Discriminator() (string, error) {
+	return unionDiscriminator(u.union, "position")
+}
+
+// AsMoveToFolder returns the union data as a MoveToFolder.
+func (u MoveRuleTo) AsMoveToFolder() (MoveToFolder, error) {
+	var v MoveToFolder
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromMoveToFolder sets the union to v, whose "position" must be one of "bottom_of_folder", "top_of_folder".
+func (u *MoveRuleTo) FromMoveToFolder(v MoveToFolder) error {
+	data, err := unionFrom(v, "position", "bottom_of_folder", "top_of_folder")
+	if err != nil {
+		return fmt.Errorf("MoveRuleTo: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsMoveToSpecificRule returns the union data as a MoveToSpecificRule.
+func (u MoveRuleTo) AsMoveToSpecificRule() (MoveToSpecificRule, error) {
+	var v MoveToSpecificRule
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromMoveToSpecificRule sets the union to v, whose "position" must be one of "after_specific_rule", "before_specific_rule".
+func (u *MoveRuleTo) FromMoveToSpecificRule(v MoveToSpecificRule) error {
+	data, err := unionFrom(v, "position", "after_specific_rule", "before_specific_rule")
+	if err != nil {
+		return fmt.Errorf("MoveRuleTo: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// ValueByDiscriminator decodes the union into the member selected by "position".
+func (u MoveRuleTo) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := u.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "bottom_of_folder", "top_of_folder":
+		return u.AsMoveToFolder()
+	case "after_specific_rule", "before_specific_rule":
+		return u.AsMoveToSpecificRule()
+	}
+	return nil, fmt.Errorf("MoveRuleTo: unknown position %q", discriminator)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u MoveRuleTo) MarshalJSON() ([]byte, error) {
+	if len(u.union) == 0 {
+		return []byte("null"), nil
+	}
+	return u.union, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting unknown "position" values.
+func (u *MoveRuleTo) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		u.union = nil
+		return nil
+	}
+	discriminator, err := unionDiscriminator(data, "position")
+	if err != nil {
+		return fmt.Errorf("MoveRuleTo: %w", err)
+	}
+	switch discriminator {
+	case "bottom_of_folder", "top_of_folder", "after_specific_rule", "before_specific_rule":
+	default:
+		return fmt.Errorf("MoveRuleTo: unknown position %q", discriminator)
+	}
+	u.union = append(json.RawMessage(nil), data...)
+	return nil
 }
 
 // MoveToFolder represents a CheckMK API type.
@@ -3971,9 +9175,9 @@ type MoveToSpecificRule struct {
 // NetworkScan represents a CheckMK API type.
 type NetworkScan struct {
 	// IPv4 addresses to include.
-	Addresses []map[string]interface{} `json:"addresses"`
+	Addresses []IPRangeWithRegexp `json:"addresses"`
 	// IPv4 addresses to exclude.
-	ExcludeAddresses []map[string]interface{} `json:"exclude_addresses,omitempty"`
+	ExcludeAddresses []IPRangeWithRegexp `json:"exclude_addresses,omitempty"`
 	// Set the maximum number of concurrent pings sent to target IP addresses.
 	MaxParallelPings int `json:"max_parallel_pings,omitempty"`
 	// Execute the network scan in the Checkmk user context of the chosen user. This user needs the permission to add new hosts to this folder.
@@ -3985,8 +9189,8 @@ type NetworkScan struct {
 	// Specify which criticality tag to set on the host created by the network scan. This field is required if the criticality tag group exists, otherwise it as to be omitted.
 	TagCriticality string `json:"tag_criticality,omitempty"`
 	// Only execute the discovery during this time range each day..
-	TimeAllowed []map[string]interface{} `json:"time_allowed"`
-	TranslateNames map[string]interface{} `json:"translate_names,omitempty"`
+	TimeAllowed []TimeAllowedRange `json:"time_allowed"`
+	TranslateNames *TranslateNames `json:"translate_names,omitempty"`
 }
 
 // NetworkScanResult represents a CheckMK API type.
@@ -4004,18 +9208,100 @@ type NetworkScanResult struct {
 // NotExpr represents a CheckMK API type.
 type NotExpr struct {
 	// The query expression to negate.
-	Expr interface{} `json:"expr,omitempty"`
+	Expr *Expr `json:"expr,omitempty"`
 	// The operator. In this case `not`.
 	Op string `json:"op,omitempty"`
 }
 
-// NotificationBulk represents a CheckMK API type.
+// NotificationBulk is a union of Checkbox, NotificationBulkingValue, selected by the "state" property.
 type NotificationBulk struct {
+	union json.RawMessage
+}
+
+// Discriminator returns the value of the "state" property.
+func (u NotificationBulk) Discriminator() (string, error) {
+	return unionDiscriminator(u.union, "state")
+}
+
+// AsCheckbox returns the union data as a Checkbox.
+func (u NotificationBulk) AsCheckbox() (Checkbox, error) {
+	var v Checkbox
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromCheckbox sets the union to v, with "state" set to "disabled".
+func (u *NotificationBulk) FromCheckbox(v Checkbox) error {
+	data, err := unionFrom(v, "state", "disabled")
+	if err != nil {
+		return fmt.Errorf("NotificationBulk: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsNotificationBulkingValue returns the union data as a NotificationBulkingValue.
+func (u NotificationBulk) AsNotificationBulkingValue() (NotificationBulkingValue, error) {
+	var v NotificationBulkingValue
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromNotificationBulkingValue sets the union to v, with "state" set to "enabled".
+func (u *NotificationBulk) FromNotificationBulkingValue(v NotificationBulkingValue) error {
+	data, err := unionFrom(v, "state", "enabled")
+	if err != nil {
+		return fmt.Errorf("NotificationBulk: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// ValueByDiscriminator decodes the union into the member selected by "state".
+func (u NotificationBulk) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := u.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "disabled":
+		return u.AsCheckbox()
+	case "enabled":
+		return u.AsNotificationBulkingValue()
+	}
+	return nil, fmt.Errorf("NotificationBulk: unknown state %q", discriminator)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u NotificationBulk) MarshalJSON() ([]byte, error) {
+	if len(u.union) == 0 {
+		return []byte("null"), nil
+	}
+	return u.union, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting unknown "state" values.
+func (u *NotificationBulk) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		u.union = nil
+		return nil
+	}
+	discriminator, err := unionDiscriminator(data, "state")
+	if err != nil {
+		return fmt.Errorf("NotificationBulk: %w", err)
+	}
+	switch discriminator {
+	case "disabled", "enabled":
+	default:
+		return fmt.Errorf("NotificationBulk: unknown state %q", discriminator)
+	}
+	u.union = append(json.RawMessage(nil), data...)
+	return nil
 }
 
 // NotificationBulking represents a CheckMK API type.
 type NotificationBulking struct {
-	BulkOutsideTimeperiod map[string]interface{} `json:"bulk_outside_timeperiod"`
+	BulkOutsideTimeperiod BulkOutsideTimePeriodValue `json:"bulk_outside_timeperiod"`
 	// At most that many Notifications are kept back for bulking. A value of 1 essentially turns off notification bulking.
 	// Example: 1000
 	MaxBulkSize int `json:"max_bulk_size"`
@@ -4024,7 +9310,7 @@ type NotificationBulking struct {
 	// To enable or disable this field
 	// Example: enabled
 	State NotificationBulkingState `json:"state"`
-	SubjectForBulkNotifications map[string]interface{} `json:"subject_for_bulk_notifications,omitempty"`
+	SubjectForBulkNotifications *CheckboxWithStrValue `json:"subject_for_bulk_notifications,omitempty"`
 	// Notifications are kept back for bulking at most for this time (seconds)
 	// Example: 60
 	TimeHorizon int `json:"time_horizon"`
@@ -4038,7 +9324,7 @@ type NotificationBulkingAlways struct {
 	MaxBulkSize int `json:"max_bulk_size"`
 	NotificationBulksBasedOn []NotificationBulkingAlwaysNotificationBulksBasedOn `json:"notification_bulks_based_on"`
 	NotificationBulksBasedOnCustomMacros []string `json:"notification_bulks_based_on_custom_macros,omitempty"`
-	SubjectForBulkNotifications map[string]interface{} `json:"subject_for_bulk_notifications"`
+	SubjectForBulkNotifications StrValueOneOf `json:"subject_for_bulk_notifications"`
 	// Notifications are kept back for bulking at most for this time (seconds)
 	// Example: 60
 	TimeHorizon int `json:"time_horizon"`
@@ -4049,7 +9335,7 @@ type NotificationBulkingCheckbox struct {
 	// To enable or disable this field
 	// Example: enabled
 	State NotificationBulkingCheckboxState `json:"state"`
-	Value map[string]interface{} `json:"value"`
+	Value WhenToBulk `json:"value"`
 }
 
 // NotificationBulkingCommonAttributes represents a CheckMK API type.
@@ -4062,7 +9348,7 @@ type NotificationBulkingCommonAttributes struct {
 	// To enable or disable this field
 	// Example: enabled
 	State NotificationBulkingCommonAttributesState `json:"state"`
-	SubjectForBulkNotifications map[string]interface{} `json:"subject_for_bulk_notifications,omitempty"`
+	SubjectForBulkNotifications *CheckboxWithStrValue `json:"subject_for_bulk_notifications,omitempty"`
 	// Notifications are kept back for bulking at most for this time (seconds)
 	// Example: 60
 	TimeHorizon int `json:"time_horizon"`
@@ -4070,13 +9356,13 @@ type NotificationBulkingCommonAttributes struct {
 
 // NotificationBulkingTimePeriod represents a CheckMK API type.
 type NotificationBulkingTimePeriod struct {
-	BulkOutsideTimeperiod map[string]interface{} `json:"bulk_outside_timeperiod"`
+	BulkOutsideTimeperiod TimePeriodOneOf `json:"bulk_outside_timeperiod"`
 	// At most that many Notifications are kept back for bulking. A value of 1 essentially turns off notification bulking.
 	// Example: 1000
 	MaxBulkSize int `json:"max_bulk_size"`
 	NotificationBulksBasedOn []NotificationBulkingTimePeriodNotificationBulksBasedOn `json:"notification_bulks_based_on"`
 	NotificationBulksBasedOnCustomMacros []string `json:"notification_bulks_based_on_custom_macros,omitempty"`
-	SubjectForBulkNotifications map[string]interface{} `json:"subject_for_bulk_notifications"`
+	SubjectForBulkNotifications StrValueOneOf `json:"subject_for_bulk_notifications"`
 	TimePeriod string `json:"time_period"`
 }
 
@@ -4085,35 +9371,117 @@ type NotificationBulkingValue struct {
 	// To enable or disable this field
 	// Example: enabled
 	State NotificationBulkingValueState `json:"state"`
-	Value map[string]interface{} `json:"value,omitempty"`
+	Value *NotificationBulkingWhenToBulkSelector `json:"value,omitempty"`
 }
 
-// NotificationBulkingWhenToBulkSelector represents a CheckMK API type.
+// NotificationBulkingWhenToBulkSelector is a union of AlwaysBulk, TimePeriod, selected by the "when_to_bulk" property.
 type NotificationBulkingWhenToBulkSelector struct {
+	union json.RawMessage
+}
+
+// Discriminator returns the value of the "when_to_bulk" property.
+func (u NotificationBulkingWhenToBulkSelector) Discriminator() (string, error) {
+	return unionDiscriminator(u.union, "when_to_bulk")
+}
+
+// AsAlwaysBulk returns the union data as a AlwaysBulk.
+func (u NotificationBulkingWhenToBulkSelector) AsAlwaysBulk() (AlwaysBulk, error) {
+	var v AlwaysBulk
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromAlwaysBulk sets the union to v, with "when_to_bulk" set to "always".
+func (u *NotificationBulkingWhenToBulkSelector) FromAlwaysBulk(v AlwaysBulk) error {
+	data, err := unionFrom(v, "when_to_bulk", "always")
+	if err != nil {
+		return fmt.Errorf("NotificationBulkingWhenToBulkSelector: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsTimePeriod returns the union data as a TimePeriod.
+func (u NotificationBulkingWhenToBulkSelector) AsTimePeriod() (TimePeriod, error) {
+	var v TimePeriod
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromTimePeriod sets the union to v, with "when_to_bulk" set to "timeperiod".
+func (u *NotificationBulkingWhenToBulkSelector) FromTimePeriod(v TimePeriod) error {
+	data, err := unionFrom(v, "when_to_bulk", "timeperiod")
+	if err != nil {
+		return fmt.Errorf("NotificationBulkingWhenToBulkSelector: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// ValueByDiscriminator decodes the union into the member selected by "when_to_bulk".
+func (u NotificationBulkingWhenToBulkSelector) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := u.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "always":
+		return u.AsAlwaysBulk()
+	case "timeperiod":
+		return u.AsTimePeriod()
+	}
+	return nil, fmt.Errorf("NotificationBulkingWhenToBulkSelector: unknown when_to_bulk %q", discriminator)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u NotificationBulkingWhenToBulkSelector) MarshalJSON() ([]byte, error) {
+	if len(u.union) == 0 {
+		return []byte("null"), nil
+	}
+	return u.union, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting unknown "when_to_bulk" values.
+func (u *NotificationBulkingWhenToBulkSelector) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		u.union = nil
+		return nil
+	}
+	discriminator, err := unionDiscriminator(data, "when_to_bulk")
+	if err != nil {
+		return fmt.Errorf("NotificationBulkingWhenToBulkSelector: %w", err)
+	}
+	switch discriminator {
+	case "always", "timeperiod":
+	default:
+		return fmt.Errorf("NotificationBulkingWhenToBulkSelector: unknown when_to_bulk %q", discriminator)
+	}
+	u.union = append(json.RawMessage(nil), data...)
+	return nil
 }
 
 // NotificationPlugin represents a CheckMK API type.
 type NotificationPlugin struct {
-	NotificationBulking map[string]interface{} `json:"notification_bulking,omitempty"`
-	NotifyPlugin map[string]interface{} `json:"notify_plugin,omitempty"`
+	NotificationBulking *NotificationBulkingCheckbox `json:"notification_bulking,omitempty"`
+	NotifyPlugin *PluginBase1 `json:"notify_plugin,omitempty"`
 }
 
 // NotificationRuleAttributes represents a CheckMK API type.
 type NotificationRuleAttributes struct {
-	Conditions map[string]interface{} `json:"conditions,omitempty"`
-	ContactSelection map[string]interface{} `json:"contact_selection,omitempty"`
-	NotificationMethod map[string]interface{} `json:"notification_method,omitempty"`
-	RuleProperties map[string]interface{} `json:"rule_properties,omitempty"`
+	Conditions *ConditionsAttributes `json:"conditions,omitempty"`
+	ContactSelection *ContactSelectionAttributes `json:"contact_selection,omitempty"`
+	NotificationMethod *NotificationPlugin `json:"notification_method,omitempty"`
+	RuleProperties *RulePropertiesAttributes `json:"rule_properties,omitempty"`
 }
 
 // NotificationRuleConfig represents a CheckMK API type.
 type NotificationRuleConfig struct {
-	RuleConfig map[string]interface{} `json:"rule_config,omitempty"`
+	RuleConfig *NotificationRuleAttributes `json:"rule_config,omitempty"`
 }
 
 // NotificationRuleRequest represents a CheckMK API type.
 type NotificationRuleRequest struct {
-	RuleConfig interface{} `json:"rule_config"`
+	RuleConfig RuleNotification `json:"rule_config"`
 }
 
 // NotificationRuleResponse represents a CheckMK API type.
@@ -4122,11 +9490,11 @@ type NotificationRuleResponse struct {
 	DomainType interface{} `json:"domainType,omitempty"`
 	// The configuration attributes of a notification rule.
 	// Example: map[rule_config:map[conditions:map[event_console_alerts:map[state:disabled] match_check_types:map[state:disabled] match_contact_groups:map[state:disabled] match_exclude_hosts:map[state:disabled] match_exclude_service_groups:map[state:disabled] match_exclude_service_groups_regex:map[state:disabled...
-	Extensions interface{} `json:"extensions,omitempty"`
+	Extensions *NotificationRuleConfig `json:"extensions,omitempty"`
 	// The unique identifier for this domain-object type.
 	Id string `json:"id,omitempty"`
 	// list of links to other resources.
-	Links []map[string]interface{} `json:"links"`
+	Links []Link `json:"links"`
 	// The container for external resources, like linked foreign objects or actions.
 	Members map[string]interface{} `json:"members,omitempty"`
 	// A human readable title of this object. Can be used for user interfaces.
@@ -4142,12 +9510,12 @@ type NotificationRuleResponseCollection struct {
 	// The name of this collection.
 	Id string `json:"id,omitempty"`
 	// list of links to other resources.
-	Links []map[string]interface{} `json:"links"`
+	Links []Link `json:"links"`
 	// A human readable title of this object. Can be used for user interfaces.
 	Title string `json:"title,omitempty"`
 	// A list of notification rule objects.
 	// Example: [map[domainType:rule_notifications extensions:map[rule_config:map[conditions:map[event_console_alerts:map[state:disabled] match_check_types:map[state:disabled] match_contact_groups:map[state:disabled] match_exclude_hosts:map[state:disabled] match_exclude_service_groups:map[state:disabled] match_e...
-	Value []map[string]interface{} `json:"value,omitempty"`
+	Value []NotificationRuleResponse `json:"value,omitempty"`
 }
 
 // ObjectActionMember represents a CheckMK API type.
@@ -4159,7 +9527,7 @@ type ObjectActionMember struct {
 	// Example: invalid
 	InvalidReason string `json:"invalidReason,omitempty"`
 	// list of links to other resources.
-	Links []map[string]interface{} `json:"links"`
+	Links []Link `json:"links"`
 	MemberType interface{} `json:"memberType,omitempty"`
 	Name string `json:"name,omitempty"`
 	Parameters map[string]interface{} `json:"parameters,omitempty"`
@@ -4178,12 +9546,12 @@ type ObjectCollectionMember struct {
 	// Example: invalid
 	InvalidReason string `json:"invalidReason,omitempty"`
 	// list of links to other resources.
-	Links []map[string]interface{} `json:"links"`
+	Links []Link `json:"links"`
 	MemberType interface{} `json:"memberType,omitempty"`
 	Name string `json:"name,omitempty"`
 	// A human readable title of this object. Can be used for user interfaces.
 	Title string `json:"title,omitempty"`
-	Value []map[string]interface{} `json:"value,omitempty"`
+	Value []Link `json:"value,omitempty"`
 	// Provides the reason why a SET OF proposed values for properties or arguments is invalid.
 	XRoInvalidReason string `json:"x-ro-invalidReason,omitempty"`
 }
@@ -4195,7 +9563,7 @@ type ObjectProperty struct {
 	// The unique name of this property, local to this domain type.
 	Id string `json:"id,omitempty"`
 	// list of links to other resources.
-	Links []map[string]interface{} `json:"links"`
+	Links []Link `json:"links"`
 	// The value of the property. In this case a list.
 	Value []string `json:"value,omitempty"`
 }
@@ -4209,40 +9577,122 @@ type OpsGenieExplicitKey struct {
 // OpsGeniePluginCreate represents a CheckMK API type.
 type OpsGeniePluginCreate struct {
 	// Custom actions that will be available for the alert. You may paste a text from your clipboard which contains several parts separated by ';' characters into the last input field. The text will then be split by these separators and the single parts are added into dedicated input fields
-	Actions interface{} `json:"actions"`
-	ApiKey map[string]interface{} `json:"api_key"`
+	Actions ListOfStrOneOf `json:"actions"`
+	ApiKey OpsGenisStoreOrExplicitKeySelector `json:"api_key"`
 	// Description field of host alert that is generally used to provide a detailed information about the alert
-	DescForHostAlerts interface{} `json:"desc_for_host_alerts"`
+	DescForHostAlerts StrValueOneOf `json:"desc_for_host_alerts"`
 	// Description field of service alert that is generally used to provide a detailed information about the alert
-	DescForServiceAlerts interface{} `json:"desc_for_service_alerts"`
+	DescForServiceAlerts StrValueOneOf `json:"desc_for_service_alerts"`
 	// If you have an european account, please set the domain of your opsgenie. Specify an absolute URL like https://api.eu.opsgenie.com
-	Domain interface{} `json:"domain"`
+	Domain StrValueOneOf `json:"domain"`
 	// Is used to specify which domain the alert is related to
-	Entity interface{} `json:"entity"`
+	Entity StrValueOneOf `json:"entity"`
 	// Use the proxy settings from the environment variables. The variables NO_PROXY, HTTP_PROXY and HTTPS_PROXY are taken into account during execution.
-	HttpProxy interface{} `json:"http_proxy"`
-	MessageForHostAlerts interface{} `json:"message_for_host_alerts"`
-	MessageForServiceAlerts interface{} `json:"message_for_service_alerts"`
+	HttpProxy HttpProxyOneOf `json:"http_proxy"`
+	MessageForHostAlerts StrValueOneOf `json:"message_for_host_alerts"`
+	MessageForServiceAlerts StrValueOneOf `json:"message_for_service_alerts"`
 	// Additional note that will be added while closing the alert
-	NoteWhileClosing interface{} `json:"note_while_closing"`
+	NoteWhileClosing StrValueOneOf `json:"note_while_closing"`
 	// Additional note that will be added while creating the alert
-	NoteWhileCreating interface{} `json:"note_while_creating"`
+	NoteWhileCreating StrValueOneOf `json:"note_while_creating"`
 	// Sets the user of the alert. Display name of the request owner
-	Owner interface{} `json:"owner"`
+	Owner StrValueOneOf `json:"owner"`
 	// The plugin name.
 	// Example: mail
 	PluginName OpsGeniePluginCreatePluginName `json:"plugin_name"`
-	Priority map[string]interface{} `json:"priority"`
+	Priority OpsGeniePriorityOneOf `json:"priority"`
 	// Team names which will be responsible for the alert. If the API Key belongs to a team integration, this field will be overwritten with the owner team. You may paste a text from your clipboard which contains several parts separated by ';' characters into the last input field. The text will then be ...
-	ResponsibleTeams interface{} `json:"responsible_teams"`
+	ResponsibleTeams ListOfStrOneOf `json:"responsible_teams"`
 	// Source field of the alert. Default value is IP address of the incoming request
-	Source interface{} `json:"source"`
+	Source StrValueOneOf `json:"source"`
 	// Tags of the alert. You may paste a text from your clipboard which contains several parts separated by ';' characters into the last input field. The text will then be split by these separators and the single parts are added into dedicated input fields
-	Tags interface{} `json:"tags"`
+	Tags ListOfStrOneOf `json:"tags"`
 }
 
-// OpsGeniePriorityOneOf represents a CheckMK API type.
+// OpsGeniePriorityOneOf is a union of Checkbox, CheckboxOpsGeniePriorityValue, selected by the "state" property.
 type OpsGeniePriorityOneOf struct {
+	union json.RawMessage
+}
+
+// Discriminator returns the value of the "state" property.
+func (u OpsGeniePriorityOneOf) Discriminator() (string, error) {
+	return unionDiscriminator(u.union, "state")
+}
+
+// AsCheckbox returns the union data as a Checkbox.
+func (u OpsGeniePriorityOneOf) AsCheckbox() (Checkbox, error) {
+	var v Checkbox
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromCheckbox sets the union to v, with "state" set to "disabled".
+func (u *OpsGeniePriorityOneOf) FromCheckbox(v Checkbox) error {
+	data, err := unionFrom(v, "state", "disabled")
+	if err != nil {
+		return fmt.Errorf("OpsGeniePriorityOneOf: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsCheckboxOpsGeniePriorityValue returns the union data as a CheckboxOpsGeniePriorityValue.
+func (u OpsGeniePriorityOneOf) AsCheckboxOpsGeniePriorityValue() (CheckboxOpsGeniePriorityValue, error) {
+	var v CheckboxOpsGeniePriorityValue
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromCheckboxOpsGeniePriorityValue sets the union to v, with "state" set to "enabled".
+func (u *OpsGeniePriorityOneOf) FromCheckboxOpsGeniePriorityValue(v CheckboxOpsGeniePriorityValue) error {
+	data, err := unionFrom(v, "state", "enabled")
+	if err != nil {
+		return fmt.Errorf("OpsGeniePriorityOneOf: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// ValueByDiscriminator decodes the union into the member selected by "state".
+func (u OpsGeniePriorityOneOf) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := u.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "disabled":
+		return u.AsCheckbox()
+	case "enabled":
+		return u.AsCheckboxOpsGeniePriorityValue()
+	}
+	return nil, fmt.Errorf("OpsGeniePriorityOneOf: unknown state %q", discriminator)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u OpsGeniePriorityOneOf) MarshalJSON() ([]byte, error) {
+	if len(u.union) == 0 {
+		return []byte("null"), nil
+	}
+	return u.union, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting unknown "state" values.
+func (u *OpsGeniePriorityOneOf) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		u.union = nil
+		return nil
+	}
+	discriminator, err := unionDiscriminator(data, "state")
+	if err != nil {
+		return fmt.Errorf("OpsGeniePriorityOneOf: %w", err)
+	}
+	switch discriminator {
+	case "disabled", "enabled":
+	default:
+		return fmt.Errorf("OpsGeniePriorityOneOf: unknown state %q", discriminator)
+	}
+	u.union = append(json.RawMessage(nil), data...)
+	return nil
 }
 
 // OpsGenieStoreID represents a CheckMK API type.
@@ -4253,8 +9703,90 @@ type OpsGenieStoreID struct {
 	StoreId string `json:"store_id"`
 }
 
-// OpsGenisStoreOrExplicitKeySelector represents a CheckMK API type.
+// OpsGenisStoreOrExplicitKeySelector is a union of OpsGenieExplicitKey, OpsGenieStoreID, selected by the "option" property.
 type OpsGenisStoreOrExplicitKeySelector struct {
+	union json.RawMessage
+}
+
+// Discriminator returns the value of the "option" property.
+func (u OpsGenisStoreOrExplicitKeySelector) Discriminator() (string, error) {
+	return unionDiscriminator(u.union, "option")
+}
+
+// AsOpsGenieExplicitKey returns the union data as a OpsGenieExplicitKey.
+func (u OpsGenisStoreOrExplicitKeySelector) AsOpsGenieExplicitKey() (OpsGenieExplicitKey, error) {
+	var v OpsGenieExplicitKey
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromOpsGenieExplicitKey sets the union to v, with "option" set to "explicit".
+func (u *OpsGenisStoreOrExplicitKeySelector) FromOpsGenieExplicitKey(v OpsGenieExplicitKey) error {
+	data, err := unionFrom(v, "option", "explicit")
+	if err != nil {
+		return fmt.Errorf("OpsGenisStoreOrExplicitKeySelector: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsOpsGenieStoreID returns the union data as a OpsGenieStoreID.
+func (u OpsGenisStoreOrExplicitKeySelector) AsOpsGenieStoreID() (OpsGenieStoreID, error) {
+	var v OpsGenieStoreID
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromOpsGenieStoreID sets the union to v, with "option" set to "store".
+func (u *OpsGenisStoreOrExplicitKeySelector) FromOpsGenieStoreID(v OpsGenieStoreID) error {
+	data, err := unionFrom(v, "option", "store")
+	if err != nil {
+		return fmt.Errorf("OpsGenisStoreOrExplicitKeySelector: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// ValueByDiscriminator decodes the union into the member selected by "option".
+func (u OpsGenisStoreOrExplicitKeySelector) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := u.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "explicit":
+		return u.AsOpsGenieExplicitKey()
+	case "store":
+		return u.AsOpsGenieStoreID()
+	}
+	return nil, fmt.Errorf("OpsGenisStoreOrExplicitKeySelector: unknown option %q", discriminator)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u OpsGenisStoreOrExplicitKeySelector) MarshalJSON() ([]byte, error) {
+	if len(u.union) == 0 {
+		return []byte("null"), nil
+	}
+	return u.union, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting unknown "option" values.
+func (u *OpsGenisStoreOrExplicitKeySelector) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		u.union = nil
+		return nil
+	}
+	discriminator, err := unionDiscriminator(data, "option")
+	if err != nil {
+		return fmt.Errorf("OpsGenisStoreOrExplicitKeySelector: %w", err)
+	}
+	switch discriminator {
+	case "explicit", "store":
+	default:
+		return fmt.Errorf("OpsGenisStoreOrExplicitKeySelector: unknown option %q", discriminator)
+	}
+	u.union = append(json.RawMessage(nil), data...)
+	return nil
 }
 
 // OutsideTimeperiodValue represents a CheckMK API type.
@@ -4262,7 +9794,7 @@ type OutsideTimeperiodValue struct {
 	// To enable or disable this field
 	// Example: enabled
 	State OutsideTimeperiodValueState `json:"state"`
-	Value map[string]interface{} `json:"value,omitempty"`
+	Value *NotificationBulkingAlways `json:"value,omitempty"`
 }
 
 // PagerDutyAPIKeyStoreID represents a CheckMK API type.
@@ -4282,18 +9814,100 @@ type PagerDutyExplicitKey struct {
 // PagerDutyPluginCreate represents a CheckMK API type.
 type PagerDutyPluginCreate struct {
 	// Ignore unverified HTTPS request warnings. Use with caution.
-	DisableSslCertVerification interface{} `json:"disable_ssl_cert_verification"`
+	DisableSslCertVerification Checkbox `json:"disable_ssl_cert_verification"`
 	// Use the proxy settings from the environment variables. The variables NO_PROXY, HTTP_PROXY and HTTPS_PROXY are taken into account during execution.
-	HttpProxy interface{} `json:"http_proxy"`
-	IntegrationKey map[string]interface{} `json:"integration_key"`
+	HttpProxy HttpProxyOneOf `json:"http_proxy"`
+	IntegrationKey PagerDutyStoreOrIntegrationKeySelector `json:"integration_key"`
 	// The plugin name.
 	// Example: mail
 	PluginName PagerDutyPluginCreatePluginName `json:"plugin_name"`
-	UrlPrefixForLinksToCheckmk map[string]interface{} `json:"url_prefix_for_links_to_checkmk"`
+	UrlPrefixForLinksToCheckmk UrlPrefixOneOf `json:"url_prefix_for_links_to_checkmk"`
 }
 
-// PagerDutyStoreOrIntegrationKeySelector represents a CheckMK API type.
+// PagerDutyStoreOrIntegrationKeySelector is a union of PagerDutyExplicitKey, PagerDutyAPIKeyStoreID, selected by the "option" property.
 type PagerDutyStoreOrIntegrationKeySelector struct {
+	union json.RawMessage
+}
+
+// Discriminator returns the value of the "option" property.
+func (u PagerDutyStoreOrIntegrationKeySelector) Discriminator() (string, error) {
+	return unionDiscriminator(u.union, "option")
+}
+
+// AsPagerDutyExplicitKey returns the union data as a PagerDutyExplicitKey.
+func (u PagerDutyStoreOrIntegrationKeySelector) AsPagerDutyExplicitKey() (PagerDutyExplicitKey, error) {
+	var v PagerDutyExplicitKey
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromPagerDutyExplicitKey sets the union to v, with "option" set to "explicit".
+func (u *PagerDutyStoreOrIntegrationKeySelector) FromPagerDutyExplicitKey(v PagerDutyExplicitKey) error {
+	data, err := unionFrom(v, "option", "explicit")
+	if err != nil {
+		return fmt.Errorf("PagerDutyStoreOrIntegrationKeySelector: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsPagerDutyAPIKeyStoreID returns the union data as a PagerDutyAPIKeyStoreID.
+func (u PagerDutyStoreOrIntegrationKeySelector) AsPagerDutyAPIKeyStoreID() (PagerDutyAPIKeyStoreID, error) {
+	var v PagerDutyAPIKeyStoreID
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromPagerDutyAPIKeyStoreID sets the union to v, with "option" set to "store".
+func (u *PagerDutyStoreOrIntegrationKeySelector) FromPagerDutyAPIKeyStoreID(v PagerDutyAPIKeyStoreID) error {
+	data, err := unionFrom(v, "option", "store")
+	if err != nil {
+		return fmt.Errorf("PagerDutyStoreOrIntegrationKeySelector: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// ValueByDiscriminator decodes the union into the member selected by "option".
+func (u PagerDutyStoreOrIntegrationKeySelector) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := u.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "explicit":
+		return u.AsPagerDutyExplicitKey()
+	case "store":
+		return u.AsPagerDutyAPIKeyStoreID()
+	}
+	return nil, fmt.Errorf("PagerDutyStoreOrIntegrationKeySelector: unknown option %q", discriminator)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u PagerDutyStoreOrIntegrationKeySelector) MarshalJSON() ([]byte, error) {
+	if len(u.union) == 0 {
+		return []byte("null"), nil
+	}
+	return u.union, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting unknown "option" values.
+func (u *PagerDutyStoreOrIntegrationKeySelector) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		u.union = nil
+		return nil
+	}
+	discriminator, err := unionDiscriminator(data, "option")
+	if err != nil {
+		return fmt.Errorf("PagerDutyStoreOrIntegrationKeySelector: %w", err)
+	}
+	switch discriminator {
+	case "explicit", "store":
+	default:
+		return fmt.Errorf("PagerDutyStoreOrIntegrationKeySelector: unknown option %q", discriminator)
+	}
+	u.union = append(json.RawMessage(nil), data...)
+	return nil
 }
 
 // Parent represents a CheckMK API type.
@@ -4310,11 +9924,11 @@ type PasswordCollection struct {
 	// The name of this collection.
 	Id string `json:"id,omitempty"`
 	// list of links to other resources.
-	Links []map[string]interface{} `json:"links"`
+	Links []Link `json:"links"`
 	// A human readable title of this object. Can be used for user interfaces.
 	Title string `json:"title,omitempty"`
 	// A list of password objects.
-	Value []map[string]interface{} `json:"value,omitempty"`
+	Value []PasswordObject `json:"value,omitempty"`
 }
 
 // PasswordExtension represents a CheckMK API type.
@@ -4344,11 +9958,11 @@ type PasswordObject struct {
 	// The type of the domain-object.
 	DomainType interface{} `json:"domainType,omitempty"`
 	// All the attributes of the domain object.
-	Extensions interface{} `json:"extensions,omitempty"`
+	Extensions *PasswordExtension `json:"extensions,omitempty"`
 	// The unique identifier for this domain-object type.
 	Id string `json:"id,omitempty"`
 	// list of links to other resources.
-	Links []map[string]interface{} `json:"links"`
+	Links []Link `json:"links"`
 	// The container for external resources, like linked foreign objects or actions.
 	Members map[string]interface{} `json:"members,omitempty"`
 	// A human readable title of this object. Can be used for user interfaces.
@@ -4362,10 +9976,10 @@ type PendingChangesCollection struct {
 	// Additional attributes alongside the collection.
 	Extensions map[string]interface{} `json:"extensions,omitempty"`
 	// list of links to other resources.
-	Links []map[string]interface{} `json:"links"`
+	Links []Link `json:"links"`
 	// The changes that are pending
 	// Example: [map[action_name:create-host id:da5430a5-6d0a-48ae-9efd-0563482a3b36 text:Created new host foobar. time:2023-01-20T16:31:51.362057+00:00 user_id:cmkadmin] map[action_name:edit-host id:4ba28393-567e-4a9a-9368-e600d28c2a7e text:Modified host foobar. time:2023-01-20T16:32:12.362057+00:00 user_id:cmk...
-	Value []map[string]interface{} `json:"value,omitempty"`
+	Value []ChangesFields `json:"value,omitempty"`
 }
 
 // PluginBase represents a CheckMK API type.
@@ -4373,7 +9987,7 @@ type PluginBase struct {
 	// Create notifications with parameters or cancel previous notifications
 	// Example: cancel_previous_notifications
 	Option PluginBaseOption `json:"option,omitempty"`
-	PluginParams map[string]interface{} `json:"plugin_params"`
+	PluginParams PluginName `json:"plugin_params"`
 }
 
 // PluginBase1 represents a CheckMK API type.
@@ -4388,12 +10002,480 @@ type PluginName struct {
 	PluginName PluginNamePluginName `json:"plugin_name"`
 }
 
-// PluginOptionsSelector represents a CheckMK API type.
+// PluginOptionsSelector is a union of PluginBase, PluginWithParams, CustomPluginWithParams, selected by the "option" property.
 type PluginOptionsSelector struct {
+	union json.RawMessage
+}
+
+// Discriminator returns the value of the "option" property.
+func (u PluginOptionsSelector) Discriminator() (string, error) {
+	return unionDiscriminator(u.union, "option")
+}
+
+// AsPluginBase returns the union data as a PluginBase.
+func (u PluginOptionsSelector) AsPluginBase() (PluginBase, error) {
+	var v PluginBase
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromPluginBase sets the union to v, with "option" set to "cancel_previous_notifications".
+func (u *PluginOptionsSelector) FromPluginBase(v PluginBase) error {
+	data, err := unionFrom(v, "option", "cancel_previous_notifications")
+	if err != nil {
+		return fmt.Errorf("PluginOptionsSelector: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsPluginWithParams returns the union data as a PluginWithParams.
+func (u PluginOptionsSelector) AsPluginWithParams() (PluginWithParams, error) {
+	var v PluginWithParams
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromPluginWithParams sets the union to v, with "option" set to "create_notification_with_the_following_parameters".
+func (u *PluginOptionsSelector) FromPluginWithParams(v PluginWithParams) error {
+	data, err := unionFrom(v, "option", "create_notification_with_the_following_parameters")
+	if err != nil {
+		return fmt.Errorf("PluginOptionsSelector: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsCustomPluginWithParams returns the union data as a CustomPluginWithParams.
+func (u PluginOptionsSelector) AsCustomPluginWithParams() (CustomPluginWithParams, error) {
+	var v CustomPluginWithParams
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromCustomPluginWithParams sets the union to v, with "option" set to "create_notification_with_custom_parameters".
+func (u *PluginOptionsSelector) FromCustomPluginWithParams(v CustomPluginWithParams) error {
+	data, err := unionFrom(v, "option", "create_notification_with_custom_parameters")
+	if err != nil {
+		return fmt.Errorf("PluginOptionsSelector: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// ValueByDiscriminator decodes the union into the member selected by "option".
+func (u PluginOptionsSelector) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := u.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "cancel_previous_notifications":
+		return u.AsPluginBase()
+	case "create_notification_with_the_following_parameters":
+		return u.AsPluginWithParams()
+	case "create_notification_with_custom_parameters":
+		return u.AsCustomPluginWithParams()
+	}
+	return nil, fmt.Errorf("PluginOptionsSelector: unknown option %q", discriminator)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u PluginOptionsSelector) MarshalJSON() ([]byte, error) {
+	if len(u.union) == 0 {
+		return []byte("null"), nil
+	}
+	return u.union, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting unknown "option" values.
+func (u *PluginOptionsSelector) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		u.union = nil
+		return nil
+	}
+	discriminator, err := unionDiscriminator(data, "option")
+	if err != nil {
+		return fmt.Errorf("PluginOptionsSelector: %w", err)
+	}
+	switch discriminator {
+	case "cancel_previous_notifications", "create_notification_with_the_following_parameters", "create_notification_with_custom_parameters":
+	default:
+		return fmt.Errorf("PluginOptionsSelector: unknown option %q", discriminator)
+	}
+	u.union = append(json.RawMessage(nil), data...)
+	return nil
+}
+
+// PluginSelector is a union of AsciiMailPluginCreate, HTMLMailPluginCreate, CiscoWebexPluginCreate, MkEventDPluginCreate, IlertPluginCreate, JiraPluginCreate, OpsGeniePluginCreate, PagerDutyPluginCreate, PushOverPluginCreate, ServiceNowPluginCreate, Signl4PluginCreate, SlackPluginCreate, SMSAPIPluginCreate, SMSPluginBase, SpectrumPluginBase, VictoropsPluginCreate, MSTeamsPluginCreate, selected by the "plugin_name" property.
+type PluginSelector struct {
+	union json.RawMessage
 }
 
-// PluginSelector represents a CheckMK API type.
-type PluginSelector struct {
+// Discriminator returns the value of the "plugin_name" property.
+func (u PluginSelector) Discriminator() (string, error) {
+	return unionDiscriminator(u.union, "plugin_name")
+}
+
+// AsAsciiMailPluginCreate returns the union data as a AsciiMailPluginCreate.
+func (u PluginSelector) AsAsciiMailPluginCreate() (AsciiMailPluginCreate, error) {
+	var v AsciiMailPluginCreate
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromAsciiMailPluginCreate sets the union to v, with "plugin_name" set to "asciimail".
+func (u *PluginSelector) FromAsciiMailPluginCreate(v AsciiMailPluginCreate) error {
+	data, err := unionFrom(v, "plugin_name", "asciimail")
+	if err != nil {
+		return fmt.Errorf("PluginSelector: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsHTMLMailPluginCreate returns the union data as a HTMLMailPluginCreate.
+func (u PluginSelector) AsHTMLMailPluginCreate() (HTMLMailPluginCreate, error) {
+	var v HTMLMailPluginCreate
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromHTMLMailPluginCreate sets the union to v, with "plugin_name" set to "mail".
+func (u *PluginSelector) FromHTMLMailPluginCreate(v HTMLMailPluginCreate) error {
+	data, err := unionFrom(v, "plugin_name", "mail")
+	if err != nil {
+		return fmt.Errorf("PluginSelector: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsCiscoWebexPluginCreate returns the union data as a CiscoWebexPluginCreate.
+func (u PluginSelector) AsCiscoWebexPluginCreate() (CiscoWebexPluginCreate, error) {
+	var v CiscoWebexPluginCreate
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromCiscoWebexPluginCreate sets the union to v, with "plugin_name" set to "cisco_webex_teams".
+func (u *PluginSelector) FromCiscoWebexPluginCreate(v CiscoWebexPluginCreate) error {
+	data, err := unionFrom(v, "plugin_name", "cisco_webex_teams")
+	if err != nil {
+		return fmt.Errorf("PluginSelector: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsMkEventDPluginCreate returns the union data as a MkEventDPluginCreate.
+func (u PluginSelector) AsMkEventDPluginCreate() (MkEventDPluginCreate, error) {
+	var v MkEventDPluginCreate
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromMkEventDPluginCreate sets the union to v, with "plugin_name" set to "mkeventd".
+func (u *PluginSelector) FromMkEventDPluginCreate(v MkEventDPluginCreate) error {
+	data, err := unionFrom(v, "plugin_name", "mkeventd")
+	if err != nil {
+		return fmt.Errorf("PluginSelector: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsIlertPluginCreate returns the union data as a IlertPluginCreate.
+func (u PluginSelector) AsIlertPluginCreate() (IlertPluginCreate, error) {
+	var v IlertPluginCreate
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromIlertPluginCreate sets the union to v, with "plugin_name" set to "ilert".
+func (u *PluginSelector) FromIlertPluginCreate(v IlertPluginCreate) error {
+	data, err := unionFrom(v, "plugin_name", "ilert")
+	if err != nil {
+		return fmt.Errorf("PluginSelector: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsJiraPluginCreate returns the union data as a JiraPluginCreate.
+func (u PluginSelector) AsJiraPluginCreate() (JiraPluginCreate, error) {
+	var v JiraPluginCreate
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromJiraPluginCreate sets the union to v, with "plugin_name" set to "jira_issues".
+func (u *PluginSelector) FromJiraPluginCreate(v JiraPluginCreate) error {
+	data, err := unionFrom(v, "plugin_name", "jira_issues")
+	if err != nil {
+		return fmt.Errorf("PluginSelector: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsOpsGeniePluginCreate returns the union data as a OpsGeniePluginCreate.
+func (u PluginSelector) AsOpsGeniePluginCreate() (OpsGeniePluginCreate, error) {
+	var v OpsGeniePluginCreate
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromOpsGeniePluginCreate sets the union to v, with "plugin_name" set to "opsgenie_issues".
+func (u *PluginSelector) FromOpsGeniePluginCreate(v OpsGeniePluginCreate) error {
+	data, err := unionFrom(v, "plugin_name", "opsgenie_issues")
+	if err != nil {
+		return fmt.Errorf("PluginSelector: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsPagerDutyPluginCreate returns the union data as a PagerDutyPluginCreate.
+func (u PluginSelector) AsPagerDutyPluginCreate() (PagerDutyPluginCreate, error) {
+	var v PagerDutyPluginCreate
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromPagerDutyPluginCreate sets the union to v, with "plugin_name" set to "pagerduty".
+func (u *PluginSelector) FromPagerDutyPluginCreate(v PagerDutyPluginCreate) error {
+	data, err := unionFrom(v, "plugin_name", "pagerduty")
+	if err != nil {
+		return fmt.Errorf("PluginSelector: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsPushOverPluginCreate returns the union data as a PushOverPluginCreate.
+func (u PluginSelector) AsPushOverPluginCreate() (PushOverPluginCreate, error) {
+	var v PushOverPluginCreate
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromPushOverPluginCreate sets the union to v, with "plugin_name" set to "pushover".
+func (u *PluginSelector) FromPushOverPluginCreate(v PushOverPluginCreate) error {
+	data, err := unionFrom(v, "plugin_name", "pushover")
+	if err != nil {
+		return fmt.Errorf("PluginSelector: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsServiceNowPluginCreate returns the union data as a ServiceNowPluginCreate.
+func (u PluginSelector) AsServiceNowPluginCreate() (ServiceNowPluginCreate, error) {
+	var v ServiceNowPluginCreate
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromServiceNowPluginCreate sets the union to v, with "plugin_name" set to "servicenow".
+func (u *PluginSelector) FromServiceNowPluginCreate(v ServiceNowPluginCreate) error {
+	data, err := unionFrom(v, "plugin_name", "servicenow")
+	if err != nil {
+		return fmt.Errorf("PluginSelector: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsSignl4PluginCreate returns the union data as a Signl4PluginCreate.
+func (u PluginSelector) AsSignl4PluginCreate() (Signl4PluginCreate, error) {
+	var v Signl4PluginCreate
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromSignl4PluginCreate sets the union to v, with "plugin_name" set to "signl4".
+func (u *PluginSelector) FromSignl4PluginCreate(v Signl4PluginCreate) error {
+	data, err := unionFrom(v, "plugin_name", "signl4")
+	if err != nil {
+		return fmt.Errorf("PluginSelector: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsSlackPluginCreate returns the union data as a SlackPluginCreate.
+func (u PluginSelector) AsSlackPluginCreate() (SlackPluginCreate, error) {
+	var v SlackPluginCreate
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromSlackPluginCreate sets the union to v, with "plugin_name" set to "slack".
+func (u *PluginSelector) FromSlackPluginCreate(v SlackPluginCreate) error {
+	data, err := unionFrom(v, "plugin_name", "slack")
+	if err != nil {
+		return fmt.Errorf("PluginSelector: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsSMSAPIPluginCreate returns the union data as a SMSAPIPluginCreate.
+func (u PluginSelector) AsSMSAPIPluginCreate() (SMSAPIPluginCreate, error) {
+	var v SMSAPIPluginCreate
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromSMSAPIPluginCreate sets the union to v, with "plugin_name" set to "sms_api".
+func (u *PluginSelector) FromSMSAPIPluginCreate(v SMSAPIPluginCreate) error {
+	data, err := unionFrom(v, "plugin_name", "sms_api")
+	if err != nil {
+		return fmt.Errorf("PluginSelector: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsSMSPluginBase returns the union data as a SMSPluginBase.
+func (u PluginSelector) AsSMSPluginBase() (SMSPluginBase, error) {
+	var v SMSPluginBase
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromSMSPluginBase sets the union to v, with "plugin_name" set to "sms".
+func (u *PluginSelector) FromSMSPluginBase(v SMSPluginBase) error {
+	data, err := unionFrom(v, "plugin_name", "sms")
+	if err != nil {
+		return fmt.Errorf("PluginSelector: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsSpectrumPluginBase returns the union data as a SpectrumPluginBase.
+func (u PluginSelector) AsSpectrumPluginBase() (SpectrumPluginBase, error) {
+	var v SpectrumPluginBase
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromSpectrumPluginBase sets the union to v, with "plugin_name" set to "spectrum".
+func (u *PluginSelector) FromSpectrumPluginBase(v SpectrumPluginBase) error {
+	data, err := unionFrom(v, "plugin_name", "spectrum")
+	if err != nil {
+		return fmt.Errorf("PluginSelector: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsVictoropsPluginCreate returns the union data as a VictoropsPluginCreate.
+func (u PluginSelector) AsVictoropsPluginCreate() (VictoropsPluginCreate, error) {
+	var v VictoropsPluginCreate
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromVictoropsPluginCreate sets the union to v, with "plugin_name" set to "victorops".
+func (u *PluginSelector) FromVictoropsPluginCreate(v VictoropsPluginCreate) error {
+	data, err := unionFrom(v, "plugin_name", "victorops")
+	if err != nil {
+		return fmt.Errorf("PluginSelector: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsMSTeamsPluginCreate returns the union data as a MSTeamsPluginCreate.
+func (u PluginSelector) AsMSTeamsPluginCreate() (MSTeamsPluginCreate, error) {
+	var v MSTeamsPluginCreate
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromMSTeamsPluginCreate sets the union to v, with "plugin_name" set to "msteams".
+func (u *PluginSelector) FromMSTeamsPluginCreate(v MSTeamsPluginCreate) error {
+	data, err := unionFrom(v, "plugin_name", "msteams")
+	if err != nil {
+		return fmt.Errorf("PluginSelector: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// ValueByDiscriminator decodes the union into the member selected by "plugin_name".
+func (u PluginSelector) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := u.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "asciimail":
+		return u.AsAsciiMailPluginCreate()
+	case "mail":
+		return u.AsHTMLMailPluginCreate()
+	case "cisco_webex_teams":
+		return u.AsCiscoWebexPluginCreate()
+	case "mkeventd":
+		return u.AsMkEventDPluginCreate()
+	case "ilert":
+		return u.AsIlertPluginCreate()
+	case "jira_issues":
+		return u.AsJiraPluginCreate()
+	case "opsgenie_issues":
+		return u.AsOpsGeniePluginCreate()
+	case "pagerduty":
+		return u.AsPagerDutyPluginCreate()
+	case "pushover":
+		return u.AsPushOverPluginCreate()
+	case "servicenow":
+		return u.AsServiceNowPluginCreate()
+	case "signl4":
+		return u.AsSignl4PluginCreate()
+	case "slack":
+		return u.AsSlackPluginCreate()
+	case "sms_api":
+		return u.AsSMSAPIPluginCreate()
+	case "sms":
+		return u.AsSMSPluginBase()
+	case "spectrum":
+		return u.AsSpectrumPluginBase()
+	case "victorops":
+		return u.AsVictoropsPluginCreate()
+	case "msteams":
+		return u.AsMSTeamsPluginCreate()
+	}
+	return nil, fmt.Errorf("PluginSelector: unknown plugin_name %q", discriminator)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u PluginSelector) MarshalJSON() ([]byte, error) {
+	if len(u.union) == 0 {
+		return []byte("null"), nil
+	}
+	return u.union, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting unknown "plugin_name" values.
+func (u *PluginSelector) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		u.union = nil
+		return nil
+	}
+	discriminator, err := unionDiscriminator(data, "plugin_name")
+	if err != nil {
+		return fmt.Errorf("PluginSelector: %w", err)
+	}
+	switch discriminator {
+	case "asciimail", "mail", "cisco_webex_teams", "mkeventd", "ilert", "jira_issues", "opsgenie_issues", "pagerduty", "pushover", "servicenow", "signl4", "slack", "sms_api", "sms", "spectrum", "victorops", "msteams":
+	default:
+		return fmt.Errorf("PluginSelector: unknown plugin_name %q", discriminator)
+	}
+	u.union = append(json.RawMessage(nil), data...)
+	return nil
 }
 
 // PluginWithParams represents a CheckMK API type.
@@ -4401,11 +10483,93 @@ type PluginWithParams struct {
 	// Create notifications with parameters or cancel previous notifications
 	// Example: cancel_previous_notifications
 	Option PluginWithParamsOption `json:"option,omitempty"`
-	PluginParams map[string]interface{} `json:"plugin_params"`
+	PluginParams PluginSelector `json:"plugin_params"`
 }
 
-// PriorityOneOf represents a CheckMK API type.
+// PriorityOneOf is a union of Checkbox, CheckboxWithMgmtTypePriorityValue, selected by the "state" property.
 type PriorityOneOf struct {
+	union json.RawMessage
+}
+
+// Discriminator returns the value of the "state" property.
+func (u PriorityOneOf) Discriminator() (string, error) {
+	return unionDiscriminator(u.union, "state")
+}
+
+// AsCheckbox returns the union data as a Checkbox.
+func (u PriorityOneOf) AsCheckbox() (Checkbox, error) {
+	var v Checkbox
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromCheckbox sets the union to v, with "state" set to "disabled".
+func (u *PriorityOneOf) FromCheckbox(v Checkbox) error {
+	data, err := unionFrom(v, "state", "disabled")
+	if err != nil {
+		return fmt.Errorf("PriorityOneOf: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsCheckboxWithMgmtTypePriorityValue returns the union data as a CheckboxWithMgmtTypePriorityValue.
+func (u PriorityOneOf) AsCheckboxWithMgmtTypePriorityValue() (CheckboxWithMgmtTypePriorityValue, error) {
+	var v CheckboxWithMgmtTypePriorityValue
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromCheckboxWithMgmtTypePriorityValue sets the union to v, with "state" set to "enabled".
+func (u *PriorityOneOf) FromCheckboxWithMgmtTypePriorityValue(v CheckboxWithMgmtTypePriorityValue) error {
+	data, err := unionFrom(v, "state", "enabled")
+	if err != nil {
+		return fmt.Errorf("PriorityOneOf: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// ValueByDiscriminator decodes the union into the member selected by "state".
+func (u PriorityOneOf) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := u.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "disabled":
+		return u.AsCheckbox()
+	case "enabled":
+		return u.AsCheckboxWithMgmtTypePriorityValue()
+	}
+	return nil, fmt.Errorf("PriorityOneOf: unknown state %q", discriminator)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u PriorityOneOf) MarshalJSON() ([]byte, error) {
+	if len(u.union) == 0 {
+		return []byte("null"), nil
+	}
+	return u.union, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting unknown "state" values.
+func (u *PriorityOneOf) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		u.union = nil
+		return nil
+	}
+	discriminator, err := unionDiscriminator(data, "state")
+	if err != nil {
+		return fmt.Errorf("PriorityOneOf: %w", err)
+	}
+	switch discriminator {
+	case "disabled", "enabled":
+	default:
+		return fmt.Errorf("PriorityOneOf: unknown state %q", discriminator)
+	}
+	u.union = append(json.RawMessage(nil), data...)
+	return nil
 }
 
 // ProxyAttributes represents a CheckMK API type.
@@ -4414,9 +10578,9 @@ type ProxyAttributes struct {
 	// Example: true
 	GlobalSettings bool `json:"global_settings"`
 	// The live status proxy daemon parameters.
-	Params interface{} `json:"params,omitempty"`
+	Params *ProxyParams `json:"params,omitempty"`
 	// Allow access via TCP configuration.
-	Tcp interface{} `json:"tcp,omitempty"`
+	Tcp *ProxyTcp `json:"tcp,omitempty"`
 	// Use livestatus daemon with direct connection or with livestatus proxy.
 	// Example: true
 	UseLivestatusDaemon ProxyAttributesUseLivestatusDaemon `json:"use_livestatus_daemon"`
@@ -4428,16 +10592,98 @@ type ProxyAttributes1 struct {
 	// Example: true
 	GlobalSettings bool `json:"global_settings,omitempty"`
 	// The live status proxy daemon parameters.
-	Params interface{} `json:"params,omitempty"`
+	Params *ProxyParams1 `json:"params,omitempty"`
 	// Allow access via TCP configuration.
-	Tcp interface{} `json:"tcp,omitempty"`
+	Tcp *ProxyTcp1 `json:"tcp,omitempty"`
 	// Use livestatus daemon with direct connection or with livestatus proxy.
 	// Example: true
 	UseLivestatusDaemon string `json:"use_livestatus_daemon"`
 }
 
-// ProxyOrDirect represents a CheckMK API type.
+// ProxyOrDirect is a union of UseLiveStatusDaemon, ProxyAttributes, selected by the "use_livestatus_daemon" property.
 type ProxyOrDirect struct {
+	union json.RawMessage
+}
+
+// Discriminator returns the value of the "use_livestatus_daemon" property.
+func (u ProxyOrDirect) Discriminator() (string, error) {
+	return unionDiscriminator(u.union, "use_livestatus_daemon")
+}
+
+// AsUseLiveStatusDaemon returns the union data as a UseLiveStatusDaemon.
+func (u ProxyOrDirect) AsUseLiveStatusDaemon() (UseLiveStatusDaemon, error) {
+	var v UseLiveStatusDaemon
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromUseLiveStatusDaemon sets the union to v, with "use_livestatus_daemon" set to "direct".
+func (u *ProxyOrDirect) FromUseLiveStatusDaemon(v UseLiveStatusDaemon) error {
+	data, err := unionFrom(v, "use_livestatus_daemon", "direct")
+	if err != nil {
+		return fmt.Errorf("ProxyOrDirect: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsProxyAttributes returns the union data as a ProxyAttributes.
+func (u ProxyOrDirect) AsProxyAttributes() (ProxyAttributes, error) {
+	var v ProxyAttributes
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromProxyAttributes sets the union to v, with "use_livestatus_daemon" set to "with_proxy".
+func (u *ProxyOrDirect) FromProxyAttributes(v ProxyAttributes) error {
+	data, err := unionFrom(v, "use_livestatus_daemon", "with_proxy")
+	if err != nil {
+		return fmt.Errorf("ProxyOrDirect: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// ValueByDiscriminator decodes the union into the member selected by "use_livestatus_daemon".
+func (u ProxyOrDirect) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := u.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "direct":
+		return u.AsUseLiveStatusDaemon()
+	case "with_proxy":
+		return u.AsProxyAttributes()
+	}
+	return nil, fmt.Errorf("ProxyOrDirect: unknown use_livestatus_daemon %q", discriminator)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u ProxyOrDirect) MarshalJSON() ([]byte, error) {
+	if len(u.union) == 0 {
+		return []byte("null"), nil
+	}
+	return u.union, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting unknown "use_livestatus_daemon" values.
+func (u *ProxyOrDirect) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		u.union = nil
+		return nil
+	}
+	discriminator, err := unionDiscriminator(data, "use_livestatus_daemon")
+	if err != nil {
+		return fmt.Errorf("ProxyOrDirect: %w", err)
+	}
+	switch discriminator {
+	case "direct", "with_proxy":
+	default:
+		return fmt.Errorf("ProxyOrDirect: unknown use_livestatus_daemon %q", discriminator)
+	}
+	u.union = append(json.RawMessage(nil), data...)
+	return nil
 }
 
 // ProxyParams represents a CheckMK API type.
@@ -4455,7 +10701,7 @@ type ProxyParams struct {
 	// Example: 4
 	ConnectRetry float64 `json:"connect_retry,omitempty"`
 	// The heartbeat interval and timeout configuration.
-	Heartbeat interface{} `json:"heartbeat,omitempty"`
+	Heartbeat *Heartbeat `json:"heartbeat,omitempty"`
 	// The total query timeout.
 	// Example: 120
 	QueryTimeout float64 `json:"query_timeout,omitempty"`
@@ -4476,7 +10722,7 @@ type ProxyParams1 struct {
 	// Example: 4
 	ConnectRetry float64 `json:"connect_retry,omitempty"`
 	// The heartbeat interval and timeout configuration.
-	Heartbeat interface{} `json:"heartbeat,omitempty"`
+	Heartbeat *Heartbeat1 `json:"heartbeat,omitempty"`
 	// The total query timeout.
 	// Example: 120
 	QueryTimeout float64 `json:"query_timeout,omitempty"`
@@ -4508,8 +10754,90 @@ type ProxyTcp1 struct {
 	Tls bool `json:"tls,omitempty"`
 }
 
-// PushOverOneOf represents a CheckMK API type.
+// PushOverOneOf is a union of Checkbox, PushOverPriority, selected by the "state" property.
 type PushOverOneOf struct {
+	union json.RawMessage
+}
+
+// Discriminator returns the value of the "state" property.
+func (u PushOverOneOf) Discriminator() (string, error) {
+	return unionDiscriminator(u.union, "state")
+}
+
+// AsCheckbox returns the union data as a Checkbox.
+func (u PushOverOneOf) AsCheckbox() (Checkbox, error) {
+	var v Checkbox
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromCheckbox sets the union to v, with "state" set to "disabled".
+func (u *PushOverOneOf) FromCheckbox(v Checkbox) error {
+	data, err := unionFrom(v, "state", "disabled")
+	if err != nil {
+		return fmt.Errorf("PushOverOneOf: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsPushOverPriority returns the union data as a PushOverPriority.
+func (u PushOverOneOf) AsPushOverPriority() (PushOverPriority, error) {
+	var v PushOverPriority
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromPushOverPriority sets the union to v, with "state" set to "enabled".
+func (u *PushOverOneOf) FromPushOverPriority(v PushOverPriority) error {
+	data, err := unionFrom(v, "state", "enabled")
+	if err != nil {
+		return fmt.Errorf("PushOverOneOf: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// ValueByDiscriminator decodes the union into the member selected by "state".
+func (u PushOverOneOf) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := u.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "disabled":
+		return u.AsCheckbox()
+	case "enabled":
+		return u.AsPushOverPriority()
+	}
+	return nil, fmt.Errorf("PushOverOneOf: unknown state %q", discriminator)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u PushOverOneOf) MarshalJSON() ([]byte, error) {
+	if len(u.union) == 0 {
+		return []byte("null"), nil
+	}
+	return u.union, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting unknown "state" values.
+func (u *PushOverOneOf) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		u.union = nil
+		return nil
+	}
+	discriminator, err := unionDiscriminator(data, "state")
+	if err != nil {
+		return fmt.Errorf("PushOverOneOf: %w", err)
+	}
+	switch discriminator {
+	case "disabled", "enabled":
+	default:
+		return fmt.Errorf("PushOverOneOf: unknown state %q", discriminator)
+	}
+	u.union = append(json.RawMessage(nil), data...)
+	return nil
 }
 
 // PushOverPluginCreate represents a CheckMK API type.
@@ -4518,14 +10846,14 @@ type PushOverPluginCreate struct {
 	// Example: azGDORePK8gMaC0QOYAMyEEuzJnyUi
 	ApiKey string `json:"api_key"`
 	// Use the proxy settings from the environment variables. The variables NO_PROXY, HTTP_PROXY and HTTPS_PROXY are taken into account during execution.
-	HttpProxy interface{} `json:"http_proxy"`
+	HttpProxy HttpProxyOneOf `json:"http_proxy"`
 	// The plugin name.
 	// Example: mail
 	PluginName PushOverPluginCreatePluginName `json:"plugin_name"`
-	Priority map[string]interface{} `json:"priority"`
-	Sound map[string]interface{} `json:"sound"`
+	Priority PushOverOneOf `json:"priority"`
+	Sound SoundsOneOf `json:"sound"`
 	// If you specify an URL prefix here, then several parts of the email body are armed with hyperlinks to your Check_MK GUI, so that the recipient of the email can directly visit the host or service in question in Check_MK. Specify an absolute URL including the .../check_mk/
-	UrlPrefixForLinksToCheckmk interface{} `json:"url_prefix_for_links_to_checkmk"`
+	UrlPrefixForLinksToCheckmk StrValueOneOf `json:"url_prefix_for_links_to_checkmk"`
 	// Configure the user or group to receive the notifications by providing the user or group key here. The key can be obtained from the Pushover website.
 	// Example: azGDORePK8gMaC0QOYAMyEEuzJnyUi
 	UserGroupKey string `json:"user_group_key"`
@@ -4541,8 +10869,128 @@ type PushOverPriority struct {
 	Value PushOverPriorityValue `json:"value"`
 }
 
-// ReferTo represents a CheckMK API type.
+// ReferTo is a union of Host, Parent, Child, ChildWith, selected by the "type" property.
 type ReferTo struct {
+	union json.RawMessage
+}
+
+// Discriminator returns the value of the "type" property.
+func (u ReferTo) Discriminator() (string, error) {
+	return unionDiscriminator(u.union, "type")
+}
+
+// AsHost returns the union data as a Host.
+func (u ReferTo) AsHost() (Host, error) {
+	var v Host
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromHost sets the union to v, with "type" set to "host".
+func (u *ReferTo) FromHost(v Host) error {
+	data, err := unionFrom(v, "type", "host")
+	if err != nil {
+		return fmt.Errorf("ReferTo: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsParent returns the union data as a Parent.
+func (u ReferTo) AsParent() (Parent, error) {
+	var v Parent
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromParent sets the union to v, with "type" set to "parent".
+func (u *ReferTo) FromParent(v Parent) error {
+	data, err := unionFrom(v, "type", "parent")
+	if err != nil {
+		return fmt.Errorf("ReferTo: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsChild returns the union data as a Child.
+func (u ReferTo) AsChild() (Child, error) {
+	var v Child
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromChild sets the union to v, with "type" set to "child".
+func (u *ReferTo) FromChild(v Child) error {
+	data, err := unionFrom(v, "type", "child")
+	if err != nil {
+		return fmt.Errorf("ReferTo: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsChildWith returns the union data as a ChildWith.
+func (u ReferTo) AsChildWith() (ChildWith, error) {
+	var v ChildWith
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromChildWith sets the union to v, with "type" set to "child_with".
+func (u *ReferTo) FromChildWith(v ChildWith) error {
+	data, err := unionFrom(v, "type", "child_with")
+	if err != nil {
+		return fmt.Errorf("ReferTo: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// ValueByDiscriminator decodes the union into the member selected by "type".
+func (u ReferTo) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := u.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "host":
+		return u.AsHost()
+	case "parent":
+		return u.AsParent()
+	case "child":
+		return u.AsChild()
+	case "child_with":
+		return u.AsChildWith()
+	}
+	return nil, fmt.Errorf("ReferTo: unknown type %q", discriminator)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u ReferTo) MarshalJSON() ([]byte, error) {
+	if len(u.union) == 0 {
+		return []byte("null"), nil
+	}
+	return u.union, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting unknown "type" values.
+func (u *ReferTo) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		u.union = nil
+		return nil
+	}
+	discriminator, err := unionDiscriminator(data, "type")
+	if err != nil {
+		return fmt.Errorf("ReferTo: %w", err)
+	}
+	switch discriminator {
+	case "host", "parent", "child", "child_with":
+	default:
+		return fmt.Errorf("ReferTo: unknown type %q", discriminator)
+	}
+	u.union = append(json.RawMessage(nil), data...)
+	return nil
 }
 
 // RegexpRewrites represents a CheckMK API type.
@@ -4567,12 +11015,176 @@ type RenameHost struct {
 	NewName string `json:"new_name"`
 }
 
-// ReplyToOneOf represents a CheckMK API type.
+// ReplyToOneOf is a union of Checkbox, ToEmailAndNameCheckbox, selected by the "state" property.
 type ReplyToOneOf struct {
-}
-
-// RestrictNotificationNumCheckbox represents a CheckMK API type.
+	union json.RawMessage
+}
+
+// Discriminator returns the value of the "state" property.
+func (u ReplyToOneOf) Discriminator() (string, error) {
+	return unionDiscriminator(u.union, "state")
+}
+
+// AsCheckbox returns the union data as a Checkbox.
+func (u ReplyToOneOf) AsCheckbox() (Checkbox, error) {
+	var v Checkbox
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromCheckbox sets the union to v, with "state" set to "disabled".
+func (u *ReplyToOneOf) FromCheckbox(v Checkbox) error {
+	data, err := unionFrom(v, "state", "disabled")
+	if err != nil {
+		return fmt.Errorf("ReplyToOneOf: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsToEmailAndNameCheckbox returns the union data as a ToEmailAndNameCheckbox.
+func (u ReplyToOneOf) AsToEmailAndNameCheckbox() (ToEmailAndNameCheckbox, error) {
+	var v ToEmailAndNameCheckbox
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromToEmailAndNameCheckbox sets the union to v, with "state" set to "enabled".
+func (u *ReplyToOneOf) FromToEmailAndNameCheckbox(v ToEmailAndNameCheckbox) error {
+	data, err := unionFrom(v, "state", "enabled")
+	if err != nil {
+		return fmt.Errorf("ReplyToOneOf: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// ValueByDiscriminator decodes the union into the member selected by "state".
+func (u ReplyToOneOf) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := u.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "disabled":
+		return u.AsCheckbox()
+	case "enabled":
+		return u.AsToEmailAndNameCheckbox()
+	}
+	return nil, fmt.Errorf("ReplyToOneOf: unknown state %q", discriminator)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u ReplyToOneOf) MarshalJSON() ([]byte, error) {
+	if len(u.union) == 0 {
+		return []byte("null"), nil
+	}
+	return u.union, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting unknown "state" values.
+func (u *ReplyToOneOf) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		u.union = nil
+		return nil
+	}
+	discriminator, err := unionDiscriminator(data, "state")
+	if err != nil {
+		return fmt.Errorf("ReplyToOneOf: %w", err)
+	}
+	switch discriminator {
+	case "disabled", "enabled":
+	default:
+		return fmt.Errorf("ReplyToOneOf: unknown state %q", discriminator)
+	}
+	u.union = append(json.RawMessage(nil), data...)
+	return nil
+}
+
+// RestrictNotificationNumCheckbox is a union of Checkbox, CheckboxRestrictNotificationNumbers, selected by the "state" property.
 type RestrictNotificationNumCheckbox struct {
+	union json.RawMessage
+}
+
+// Discriminator returns the value of the "state" property.
+func (u RestrictNotificationNumCheckbox) Discriminator() (string, error) {
+	return unionDiscriminator(u.union, "state")
+}
+
+// AsCheckbox returns the union data as a Checkbox.
+func (u RestrictNotificationNumCheckbox) AsCheckbox() (Checkbox, error) {
+	var v Checkbox
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromCheckbox sets the union to v, with "state" set to "disabled".
+func (u *RestrictNotificationNumCheckbox) FromCheckbox(v Checkbox) error {
+	data, err := unionFrom(v, "state", "disabled")
+	if err != nil {
+		return fmt.Errorf("RestrictNotificationNumCheckbox: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsCheckboxRestrictNotificationNumbers returns the union data as a CheckboxRestrictNotificationNumbers.
+func (u RestrictNotificationNumCheckbox) AsCheckboxRestrictNotificationNumbers() (CheckboxRestrictNotificationNumbers, error) {
+	var v CheckboxRestrictNotificationNumbers
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromCheckboxRestrictNotificationNumbers sets the union to v, with "state" set to "enabled".
+func (u *RestrictNotificationNumCheckbox) FromCheckboxRestrictNotificationNumbers(v CheckboxRestrictNotificationNumbers) error {
+	data, err := unionFrom(v, "state", "enabled")
+	if err != nil {
+		return fmt.Errorf("RestrictNotificationNumCheckbox: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// ValueByDiscriminator decodes the union into the member selected by "state".
+func (u RestrictNotificationNumCheckbox) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := u.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "disabled":
+		return u.AsCheckbox()
+	case "enabled":
+		return u.AsCheckboxRestrictNotificationNumbers()
+	}
+	return nil, fmt.Errorf("RestrictNotificationNumCheckbox: unknown state %q", discriminator)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u RestrictNotificationNumCheckbox) MarshalJSON() ([]byte, error) {
+	if len(u.union) == 0 {
+		return []byte("null"), nil
+	}
+	return u.union, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting unknown "state" values.
+func (u *RestrictNotificationNumCheckbox) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		u.union = nil
+		return nil
+	}
+	discriminator, err := unionDiscriminator(data, "state")
+	if err != nil {
+		return fmt.Errorf("RestrictNotificationNumCheckbox: %w", err)
+	}
+	switch discriminator {
+	case "disabled", "enabled":
+	default:
+		return fmt.Errorf("RestrictNotificationNumCheckbox: unknown state %q", discriminator)
+	}
+	u.union = append(json.RawMessage(nil), data...)
+	return nil
 }
 
 // RuleCollection represents a CheckMK API type.
@@ -4584,65 +11196,65 @@ type RuleCollection struct {
 	// The name of this collection.
 	Id string `json:"id,omitempty"`
 	// list of links to other resources.
-	Links []map[string]interface{} `json:"links"`
+	Links []Link `json:"links"`
 	// A human readable title of this object. Can be used for user interfaces.
 	Title string `json:"title,omitempty"`
 	// The collection itself. Each entry in here is part of the collection.
-	Value []map[string]interface{} `json:"value,omitempty"`
+	Value []RuleObject `json:"value,omitempty"`
 }
 
 // RuleConditions represents a CheckMK API type.
 type RuleConditions struct {
-	EventConsoleAlerts map[string]interface{} `json:"event_console_alerts"`
-	MatchCheckTypes map[string]interface{} `json:"match_check_types"`
-	MatchContactGroups map[string]interface{} `json:"match_contact_groups"`
-	MatchExcludeHosts map[string]interface{} `json:"match_exclude_hosts"`
-	MatchExcludeServiceGroups map[string]interface{} `json:"match_exclude_service_groups"`
-	MatchExcludeServiceGroupsRegex map[string]interface{} `json:"match_exclude_service_groups_regex"`
-	MatchExcludeServices map[string]interface{} `json:"match_exclude_services"`
-	MatchFolder map[string]interface{} `json:"match_folder"`
-	MatchHostEventType map[string]interface{} `json:"match_host_event_type"`
-	MatchHostGroups map[string]interface{} `json:"match_host_groups"`
-	MatchHostLabels map[string]interface{} `json:"match_host_labels"`
-	MatchHostTags map[string]interface{} `json:"match_host_tags"`
-	MatchHosts map[string]interface{} `json:"match_hosts"`
-	MatchNotificationComment map[string]interface{} `json:"match_notification_comment"`
-	MatchOnlyDuringTimePeriod map[string]interface{} `json:"match_only_during_time_period"`
-	MatchPluginOutput map[string]interface{} `json:"match_plugin_output"`
-	MatchServiceEventType map[string]interface{} `json:"match_service_event_type"`
-	MatchServiceGroups map[string]interface{} `json:"match_service_groups"`
-	MatchServiceGroupsRegex map[string]interface{} `json:"match_service_groups_regex"`
-	MatchServiceLabels map[string]interface{} `json:"match_service_labels"`
-	MatchServiceLevels map[string]interface{} `json:"match_service_levels"`
-	MatchServices map[string]interface{} `json:"match_services"`
-	MatchSites map[string]interface{} `json:"match_sites"`
-	RestrictToNotificationNumbers map[string]interface{} `json:"restrict_to_notification_numbers"`
-	ThrottlePeriodicNotifications map[string]interface{} `json:"throttle_periodic_notifications"`
+	EventConsoleAlerts EventConsoleAlertCheckbox `json:"event_console_alerts"`
+	MatchCheckTypes MatchCheckTypesCheckbox `json:"match_check_types"`
+	MatchContactGroups MatchContactGroupsCheckbox `json:"match_contact_groups"`
+	MatchExcludeHosts MatchHostsCheckbox `json:"match_exclude_hosts"`
+	MatchExcludeServiceGroups MatchServiceGroupsCheckbox `json:"match_exclude_service_groups"`
+	MatchExcludeServiceGroupsRegex MatchServiceGroupRegexCheckbox `json:"match_exclude_service_groups_regex"`
+	MatchExcludeServices MatchServicesCheckbox `json:"match_exclude_services"`
+	MatchFolder MatchFolderCheckbox `json:"match_folder"`
+	MatchHostEventType MatchHostEventTypeCheckbox `json:"match_host_event_type"`
+	MatchHostGroups MatchHostGroupsCheckbox `json:"match_host_groups"`
+	MatchHostLabels MatchLabelsCheckbox `json:"match_host_labels"`
+	MatchHostTags MatchHostTagsCheckbox `json:"match_host_tags"`
+	MatchHosts MatchHostsCheckbox `json:"match_hosts"`
+	MatchNotificationComment StringCheckbox `json:"match_notification_comment"`
+	MatchOnlyDuringTimePeriod MatchTimePeriodCheckbox `json:"match_only_during_time_period"`
+	MatchPluginOutput StringCheckbox `json:"match_plugin_output"`
+	MatchServiceEventType MatchServiceEventTypeCheckbox `json:"match_service_event_type"`
+	MatchServiceGroups MatchServiceGroupsCheckbox `json:"match_service_groups"`
+	MatchServiceGroupsRegex MatchServiceGroupRegexCheckbox `json:"match_service_groups_regex"`
+	MatchServiceLabels MatchLabelsCheckbox `json:"match_service_labels"`
+	MatchServiceLevels MatchServiceLevelsCheckbox `json:"match_service_levels"`
+	MatchServices MatchServicesCheckbox `json:"match_services"`
+	MatchSites MatchSitesCheckbox `json:"match_sites"`
+	RestrictToNotificationNumbers RestrictNotificationNumCheckbox `json:"restrict_to_notification_numbers"`
+	ThrottlePeriodicNotifications ThorttlePeriodicNotificationsCheckbox `json:"throttle_periodic_notifications"`
 }
 
 // RuleConditions1 represents a CheckMK API type.
 type RuleConditions1 struct {
 	// Further restrict this rule by applying host label conditions.
 	// Example: [map[key:os operator:is value:windows]]
-	HostLabels []map[string]interface{} `json:"host_labels,omitempty"`
+	HostLabels []LabelCondition `json:"host_labels,omitempty"`
 	// Here you can enter a list of explicit host names that the rule should or should not apply to. Leave this option disabled if you want the rule to apply for all hosts specified by the given tags. The names that you enter here are compared with case sensitive exact matching. Alternatively you can us...
 	// Example: map[match_on:[host1 host2] operator:one_of]
-	HostName interface{} `json:"host_name,omitempty"`
+	HostName *HostOrServiceCondition `json:"host_name,omitempty"`
 	// The rule will only be applied to hosts fulfilling all the host tag conditions listed here, even if they appear in the list of explicit host names.
 	// Example: [map[key:criticality operator:is value:prod]]
-	HostTags []map[string]interface{} `json:"host_tags,omitempty"`
+	HostTags []TagCondition `json:"host_tags,omitempty"`
 	// Specify a list of service patterns this rule shall apply to. * The patterns must match the beginning of the service in question. * Adding a `$` to the end forces an exact match. * Pattern use regular expressions. e.g. a `.*` will match an arbitrary text. * The text entered here is handled as a re...
 	// Example: map[match_on:[foo1 bar2] operator:none_of]
-	ServiceDescription interface{} `json:"service_description,omitempty"`
+	ServiceDescription *HostOrServiceCondition `json:"service_description,omitempty"`
 	// Restrict the application of the rule, by checking against service label conditions.
 	// Example: [map[key:os operator:is value:windows]]
-	ServiceLabels []map[string]interface{} `json:"service_labels,omitempty"`
+	ServiceLabels []LabelCondition `json:"service_labels,omitempty"`
 }
 
 // RuleExtensions represents a CheckMK API type.
 type RuleExtensions struct {
 	// Conditions.
-	Conditions interface{} `json:"conditions,omitempty"`
+	Conditions *RuleConditions1 `json:"conditions,omitempty"`
 	// The path name of the folder. Path delimiters can be either `~`, `/` or `\`. Please use the one most appropriate for your quoting/escaping needs. A good default choice is `~`.
 	// Example: ~router
 	Folder string `json:"folder"`
@@ -4650,7 +11262,7 @@ type RuleExtensions struct {
 	FolderIndex int `json:"folder_index,omitempty"`
 	// Property values of this rule.
 	// Example: map[]
-	Properties interface{} `json:"properties,omitempty"`
+	Properties *RuleProperties1 `json:"properties,omitempty"`
 	// The name of the ruleset.
 	Ruleset string `json:"ruleset,omitempty"`
 	// The raw parameter value for this rule.
@@ -4660,16 +11272,16 @@ type RuleExtensions struct {
 
 // RuleNotification represents a CheckMK API type.
 type RuleNotification struct {
-	Conditions map[string]interface{} `json:"conditions"`
-	ContactSelection map[string]interface{} `json:"contact_selection"`
-	NotificationMethod map[string]interface{} `json:"notification_method"`
-	RuleProperties map[string]interface{} `json:"rule_properties"`
+	Conditions RuleConditions `json:"conditions"`
+	ContactSelection ContactSelection `json:"contact_selection"`
+	NotificationMethod RuleNotificationMethod `json:"notification_method"`
+	RuleProperties RuleProperties `json:"rule_properties"`
 }
 
 // RuleNotificationMethod represents a CheckMK API type.
 type RuleNotificationMethod struct {
-	NotificationBulking map[string]interface{} `json:"notification_bulking,omitempty"`
-	NotifyPlugin map[string]interface{} `json:"notify_plugin"`
+	NotificationBulking *NotificationBulk `json:"notification_bulking,omitempty"`
+	NotifyPlugin PluginOptionsSelector `json:"notify_plugin"`
 }
 
 // RuleObject represents a CheckMK API type.
@@ -4678,11 +11290,11 @@ type RuleObject struct {
 	// Example: rule
 	DomainType interface{} `json:"domainType,omitempty"`
 	// Attributes specific to rule objects.
-	Extensions interface{} `json:"extensions,omitempty"`
+	Extensions *RuleExtensions `json:"extensions,omitempty"`
 	// The unique identifier for this domain-object type.
 	Id string `json:"id,omitempty"`
 	// list of links to other resources.
-	Links []map[string]interface{} `json:"links"`
+	Links []Link `json:"links"`
 	// The container for external resources, like linked foreign objects or actions.
 	Members map[string]interface{} `json:"members,omitempty"`
 	// A human readable title of this object. Can be used for user interfaces.
@@ -4693,7 +11305,7 @@ type RuleObject struct {
 type RuleProperties struct {
 	// If you set this option then users are allowed to deactivate notifications that are created by this rule.
 	// Example: map[state:enabled]
-	AllowUsersToDeactivate interface{} `json:"allow_users_to_deactivate"`
+	AllowUsersToDeactivate Checkbox `json:"allow_users_to_deactivate"`
 	// An optional comment that may be used to explain the purpose of this object.
 	// Example: An example comment
 	Comment string `json:"comment"`
@@ -4702,7 +11314,7 @@ type RuleProperties struct {
 	Description string `json:"description"`
 	// Disabled rules are kept in the configuration but are not applied.
 	// Example: map[state:enabled]
-	DoNotApplyThisRule interface{} `json:"do_not_apply_this_rule"`
+	DoNotApplyThisRule Checkbox `json:"do_not_apply_this_rule"`
 	// An optional URL pointing to documentation or any other page. This will be displayed as an icon and open a new page when clicked.
 	// Example: http://link/to/documentation
 	DocumentationUrl string `json:"documentation_url"`
@@ -4728,7 +11340,7 @@ type RuleProperties1 struct {
 type RulePropertiesAttributes struct {
 	// If you set this option then users are allowed to deactivate notifications that are created by this rule.
 	// Example: map[state:enabled]
-	AllowUsersToDeactivate interface{} `json:"allow_users_to_deactivate"`
+	AllowUsersToDeactivate Checkbox `json:"allow_users_to_deactivate"`
 	// An optional comment that may be used to explain the purpose of this object.
 	// Example: An example comment
 	Comment string `json:"comment"`
@@ -4737,7 +11349,7 @@ type RulePropertiesAttributes struct {
 	Description string `json:"description"`
 	// Disabled rules are kept in the configuration but are not applied.
 	// Example: map[state:enabled]
-	DoNotApplyThisRule interface{} `json:"do_not_apply_this_rule"`
+	DoNotApplyThisRule Checkbox `json:"do_not_apply_this_rule"`
 	// An optional URL pointing to documentation or any other page. This will be displayed as an icon and open a new page when clicked.
 	// Example: http://link/to/documentation
 	DocumentationUrl string `json:"documentation_url"`
@@ -4753,11 +11365,11 @@ type RulesetCollection struct {
 	// The name of this collection.
 	Id string `json:"id,omitempty"`
 	// list of links to other resources.
-	Links []map[string]interface{} `json:"links"`
+	Links []Link `json:"links"`
 	// A human readable title of this object. Can be used for user interfaces.
 	Title string `json:"title,omitempty"`
 	// The collection itself. Each entry in here is part of the collection.
-	Value []map[string]interface{} `json:"value,omitempty"`
+	Value []CollectionItem `json:"value,omitempty"`
 }
 
 // RulesetExtensions represents a CheckMK API type.
@@ -4779,11 +11391,11 @@ type RulesetObject struct {
 	// Example: ruleset
 	DomainType interface{} `json:"domainType,omitempty"`
 	// Specific attributes related to rulesets.
-	Extensions interface{} `json:"extensions,omitempty"`
+	Extensions *RulesetExtensions `json:"extensions,omitempty"`
 	// The unique identifier for this domain-object type.
 	Id string `json:"id,omitempty"`
 	// list of links to other resources.
-	Links []map[string]interface{} `json:"links"`
+	Links []Link `json:"links"`
 	// The container for external resources, like linked foreign objects or actions.
 	Members map[string]interface{} `json:"members,omitempty"`
 	// A human readable title of this object. Can be used for user interfaces.
@@ -4804,16 +11416,98 @@ type SMSAPIPStoreID struct {
 	StoreId string `json:"store_id"`
 }
 
-// SMSAPIPasswordSelector represents a CheckMK API type.
+// SMSAPIPasswordSelector is a union of SMSAPIExplicitPassword, SMSAPIPStoreID, selected by the "option" property.
 type SMSAPIPasswordSelector struct {
+	union json.RawMessage
+}
+
+// Discriminator returns the value of the "option" property.
+func (u SMSAPIPasswordSelector) Discriminator() (string, error) {
+	return unionDiscriminator(u.union, "option")
+}
+
+// AsSMSAPIExplicitPassword returns the union data as a SMSAPIExplicitPassword.
+func (u SMSAPIPasswordSelector) AsSMSAPIExplicitPassword() (SMSAPIExplicitPassword, error) {
+	var v SMSAPIExplicitPassword
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromSMSAPIExplicitPassword sets the union to v, with "option" set to "explicit".
+func (u *SMSAPIPasswordSelector) FromSMSAPIExplicitPassword(v SMSAPIExplicitPassword) error {
+	data, err := unionFrom(v, "option", "explicit")
+	if err != nil {
+		return fmt.Errorf("SMSAPIPasswordSelector: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsSMSAPIPStoreID returns the union data as a SMSAPIPStoreID.
+func (u SMSAPIPasswordSelector) AsSMSAPIPStoreID() (SMSAPIPStoreID, error) {
+	var v SMSAPIPStoreID
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromSMSAPIPStoreID sets the union to v, with "option" set to "store".
+func (u *SMSAPIPasswordSelector) FromSMSAPIPStoreID(v SMSAPIPStoreID) error {
+	data, err := unionFrom(v, "option", "store")
+	if err != nil {
+		return fmt.Errorf("SMSAPIPasswordSelector: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// ValueByDiscriminator decodes the union into the member selected by "option".
+func (u SMSAPIPasswordSelector) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := u.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "explicit":
+		return u.AsSMSAPIExplicitPassword()
+	case "store":
+		return u.AsSMSAPIPStoreID()
+	}
+	return nil, fmt.Errorf("SMSAPIPasswordSelector: unknown option %q", discriminator)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u SMSAPIPasswordSelector) MarshalJSON() ([]byte, error) {
+	if len(u.union) == 0 {
+		return []byte("null"), nil
+	}
+	return u.union, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting unknown "option" values.
+func (u *SMSAPIPasswordSelector) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		u.union = nil
+		return nil
+	}
+	discriminator, err := unionDiscriminator(data, "option")
+	if err != nil {
+		return fmt.Errorf("SMSAPIPasswordSelector: %w", err)
+	}
+	switch discriminator {
+	case "explicit", "store":
+	default:
+		return fmt.Errorf("SMSAPIPasswordSelector: unknown option %q", discriminator)
+	}
+	u.union = append(json.RawMessage(nil), data...)
+	return nil
 }
 
 // SMSAPIPluginCreate represents a CheckMK API type.
 type SMSAPIPluginCreate struct {
 	// Ignore unverified HTTPS request warnings. Use with caution.
-	DisableSslCertVerification interface{} `json:"disable_ssl_cert_verification"`
+	DisableSslCertVerification Checkbox `json:"disable_ssl_cert_verification"`
 	// Use the proxy settings from the environment variables. The variables NO_PROXY, HTTP_PROXY and HTTPS_PROXY are taken into account during execution.
-	HttpProxy interface{} `json:"http_proxy"`
+	HttpProxy HttpProxyOneOf `json:"http_proxy"`
 	// Choose what modem is used. Currently supported is only Teltonika-TRB140.
 	// Example: trb140
 	ModemType SMSAPIPluginCreateModemType `json:"modem_type"`
@@ -4826,7 +11520,7 @@ type SMSAPIPluginCreate struct {
 	// Here you can configure timeout settings
 	// Example: 10
 	Timeout string `json:"timeout"`
-	UserPassword map[string]interface{} `json:"user_password"`
+	UserPassword SMSAPIPasswordSelector `json:"user_password"`
 	// Configure the user name here
 	// Example: username_a
 	Username string `json:"username"`
@@ -4849,8 +11543,128 @@ type SNMPCommunity struct {
 	Type interface{} `json:"type,omitempty"`
 }
 
-// SNMPCredentials represents a CheckMK API type.
+// SNMPCredentials is a union of SNMPCommunity, SNMPv3NoAuthNoPrivacy, SNMPv3AuthNoPrivacy, SNMPv3AuthPrivacy, selected by the "type" property.
 type SNMPCredentials struct {
+	union json.RawMessage
+}
+
+// Discriminator returns the value of the "type" property.
+func (u SNMPCredentials) Discriminator() (string, error) {
+	return unionDiscriminator(u.union, "type")
+}
+
+// AsSNMPCommunity returns the union data as a SNMPCommunity.
+func (u SNMPCredentials) AsSNMPCommunity() (SNMPCommunity, error) {
+	var v SNMPCommunity
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromSNMPCommunity sets the union to v, with "type" set to "v1_v2_community".
+func (u *SNMPCredentials) FromSNMPCommunity(v SNMPCommunity) error {
+	data, err := unionFrom(v, "type", "v1_v2_community")
+	if err != nil {
+		return fmt.Errorf("SNMPCredentials: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsSNMPv3NoAuthNoPrivacy returns the union data as a SNMPv3NoAuthNoPrivacy.
+func (u SNMPCredentials) AsSNMPv3NoAuthNoPrivacy() (SNMPv3NoAuthNoPrivacy, error) {
+	var v SNMPv3NoAuthNoPrivacy
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromSNMPv3NoAuthNoPrivacy sets the union to v, with "type" set to "v3_no_auth_no_privacy".
+func (u *SNMPCredentials) FromSNMPv3NoAuthNoPrivacy(v SNMPv3NoAuthNoPrivacy) error {
+	data, err := unionFrom(v, "type", "v3_no_auth_no_privacy")
+	if err != nil {
+		return fmt.Errorf("SNMPCredentials: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsSNMPv3AuthNoPrivacy returns the union data as a SNMPv3AuthNoPrivacy.
+func (u SNMPCredentials) AsSNMPv3AuthNoPrivacy() (SNMPv3AuthNoPrivacy, error) {
+	var v SNMPv3AuthNoPrivacy
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromSNMPv3AuthNoPrivacy sets the union to v, with "type" set to "v3_auth_no_privacy".
+func (u *SNMPCredentials) FromSNMPv3AuthNoPrivacy(v SNMPv3AuthNoPrivacy) error {
+	data, err := unionFrom(v, "type", "v3_auth_no_privacy")
+	if err != nil {
+		return fmt.Errorf("SNMPCredentials: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsSNMPv3AuthPrivacy returns the union data as a SNMPv3AuthPrivacy.
+func (u SNMPCredentials) AsSNMPv3AuthPrivacy() (SNMPv3AuthPrivacy, error) {
+	var v SNMPv3AuthPrivacy
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromSNMPv3AuthPrivacy sets the union to v, with "type" set to "v3_auth_privacy".
+func (u *SNMPCredentials) FromSNMPv3AuthPrivacy(v SNMPv3AuthPrivacy) error {
+	data, err := unionFrom(v, "type", "v3_auth_privacy")
+	if err != nil {
+		return fmt.Errorf("SNMPCredentials: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// ValueByDiscriminator decodes the union into the member selected by "type".
+func (u SNMPCredentials) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := u.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "v1_v2_community":
+		return u.AsSNMPCommunity()
+	case "v3_no_auth_no_privacy":
+		return u.AsSNMPv3NoAuthNoPrivacy()
+	case "v3_auth_no_privacy":
+		return u.AsSNMPv3AuthNoPrivacy()
+	case "v3_auth_privacy":
+		return u.AsSNMPv3AuthPrivacy()
+	}
+	return nil, fmt.Errorf("SNMPCredentials: unknown type %q", discriminator)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u SNMPCredentials) MarshalJSON() ([]byte, error) {
+	if len(u.union) == 0 {
+		return []byte("null"), nil
+	}
+	return u.union, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting unknown "type" values.
+func (u *SNMPCredentials) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		u.union = nil
+		return nil
+	}
+	discriminator, err := unionDiscriminator(data, "type")
+	if err != nil {
+		return fmt.Errorf("SNMPCredentials: %w", err)
+	}
+	switch discriminator {
+	case "v1_v2_community", "v3_no_auth_no_privacy", "v3_auth_no_privacy", "v3_auth_privacy":
+	default:
+		return fmt.Errorf("SNMPCredentials: unknown type %q", discriminator)
+	}
+	u.union = append(json.RawMessage(nil), data...)
+	return nil
 }
 
 // SNMPv3AuthNoPrivacy represents a CheckMK API type.
@@ -4891,7 +11705,7 @@ type SNMPv3NoAuthNoPrivacy struct {
 
 // ServiceConditions represents a CheckMK API type.
 type ServiceConditions struct {
-	HostChoice interface{} `json:"host_choice"`
+	HostChoice BIHostChoice `json:"host_choice"`
 	HostFolder string `json:"host_folder"`
 	HostLabels map[string]interface{} `json:"host_labels"`
 	HostTags map[string]interface{} `json:"host_tags"`
@@ -4934,7 +11748,7 @@ type ServiceGroup struct {
 	// The unique identifier for this domain-object type.
 	Id string `json:"id,omitempty"`
 	// list of links to other resources.
-	Links []map[string]interface{} `json:"links"`
+	Links []Link `json:"links"`
 	// The container for external resources, like linked foreign objects or actions.
 	Members map[string]interface{} `json:"members,omitempty"`
 	// A human readable title of this object. Can be used for user interfaces.
@@ -4950,11 +11764,11 @@ type ServiceGroupCollection struct {
 	// The name of this collection.
 	Id string `json:"id,omitempty"`
 	// list of links to other resources.
-	Links []map[string]interface{} `json:"links"`
+	Links []Link `json:"links"`
 	// A human readable title of this object. Can be used for user interfaces.
 	Title string `json:"title,omitempty"`
 	// A list of service group objects.
-	Value []map[string]interface{} `json:"value,omitempty"`
+	Value []ServiceGroup `json:"value,omitempty"`
 }
 
 // ServiceGroupsRegex represents a CheckMK API type.
@@ -4971,8 +11785,90 @@ type ServiceNowExplicitPassword struct {
 	Password string `json:"password"`
 }
 
-// ServiceNowPasswordSelector represents a CheckMK API type.
+// ServiceNowPasswordSelector is a union of ServiceNowExplicitPassword, ServiceNowPasswordStoreID, selected by the "option" property.
 type ServiceNowPasswordSelector struct {
+	union json.RawMessage
+}
+
+// Discriminator returns the value of the "option" property.
+func (u ServiceNowPasswordSelector) Discriminator() (string, error) {
+	return unionDiscriminator(u.union, "option")
+}
+
+// AsServiceNowExplicitPassword returns the union data as a ServiceNowExplicitPassword.
+func (u ServiceNowPasswordSelector) AsServiceNowExplicitPassword() (ServiceNowExplicitPassword, error) {
+	var v ServiceNowExplicitPassword
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromServiceNowExplicitPassword sets the union to v, with "option" set to "explicit".
+func (u *ServiceNowPasswordSelector) FromServiceNowExplicitPassword(v ServiceNowExplicitPassword) error {
+	data, err := unionFrom(v, "option", "explicit")
+	if err != nil {
+		return fmt.Errorf("ServiceNowPasswordSelector: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsServiceNowPasswordStoreID returns the union data as a ServiceNowPasswordStoreID.
+func (u ServiceNowPasswordSelector) AsServiceNowPasswordStoreID() (ServiceNowPasswordStoreID, error) {
+	var v ServiceNowPasswordStoreID
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromServiceNowPasswordStoreID sets the union to v, with "option" set to "store".
+func (u *ServiceNowPasswordSelector) FromServiceNowPasswordStoreID(v ServiceNowPasswordStoreID) error {
+	data, err := unionFrom(v, "option", "store")
+	if err != nil {
+		return fmt.Errorf("ServiceNowPasswordSelector: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// ValueByDiscriminator decodes the union into the member selected by "option".
+func (u ServiceNowPasswordSelector) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := u.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "explicit":
+		return u.AsServiceNowExplicitPassword()
+	case "store":
+		return u.AsServiceNowPasswordStoreID()
+	}
+	return nil, fmt.Errorf("ServiceNowPasswordSelector: unknown option %q", discriminator)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u ServiceNowPasswordSelector) MarshalJSON() ([]byte, error) {
+	if len(u.union) == 0 {
+		return []byte("null"), nil
+	}
+	return u.union, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting unknown "option" values.
+func (u *ServiceNowPasswordSelector) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		u.union = nil
+		return nil
+	}
+	discriminator, err := unionDiscriminator(data, "option")
+	if err != nil {
+		return fmt.Errorf("ServiceNowPasswordSelector: %w", err)
+	}
+	switch discriminator {
+	case "explicit", "store":
+	default:
+		return fmt.Errorf("ServiceNowPasswordSelector: unknown option %q", discriminator)
+	}
+	u.union = append(json.RawMessage(nil), data...)
+	return nil
 }
 
 // ServiceNowPasswordStoreID represents a CheckMK API type.
@@ -4986,24 +11882,106 @@ type ServiceNowPasswordStoreID struct {
 // ServiceNowPluginCreate represents a CheckMK API type.
 type ServiceNowPluginCreate struct {
 	// Use the proxy settings from the environment variables. The variables NO_PROXY, HTTP_PROXY and HTTPS_PROXY are taken into account during execution.
-	HttpProxy interface{} `json:"http_proxy"`
-	ManagementType map[string]interface{} `json:"management_type,omitempty"`
-	OptionalTimeout map[string]interface{} `json:"optional_timeout"`
+	HttpProxy HttpProxyOneOf `json:"http_proxy"`
+	ManagementType *MgmntTypeSelector `json:"management_type,omitempty"`
+	OptionalTimeout StrValueOneOf `json:"optional_timeout"`
 	// The plugin name.
 	// Example: mail
 	PluginName ServiceNowPluginCreatePluginName `json:"plugin_name"`
 	// Configure your ServiceNow URL here
 	// Example: https://myservicenow.com
 	ServicenowUrl string `json:"servicenow_url"`
-	UseSiteIdPrefix map[string]interface{} `json:"use_site_id_prefix"`
-	UserPassword map[string]interface{} `json:"user_password"`
+	UseSiteIdPrefix SiteIDPrefixOneOf `json:"use_site_id_prefix"`
+	UserPassword ServiceNowPasswordSelector `json:"user_password"`
 	// Configure the user name here
 	// Example: username_a
 	Username string `json:"username"`
 }
 
-// SignL4ExplicitOrStoreSelector represents a CheckMK API type.
+// SignL4ExplicitOrStoreSelector is a union of SignL4TeamSecret, SignL4TeamSecretStoreID, selected by the "option" property.
 type SignL4ExplicitOrStoreSelector struct {
+	union json.RawMessage
+}
+
+// Discriminator returns the value of the "option" property.
+func (u SignL4ExplicitOrStoreSelector) Discriminator() (string, error) {
+	return unionDiscriminator(u.union, "option")
+}
+
+// AsSignL4TeamSecret returns the union data as a SignL4TeamSecret.
+func (u SignL4ExplicitOrStoreSelector) AsSignL4TeamSecret() (SignL4TeamSecret, error) {
+	var v SignL4TeamSecret
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromSignL4TeamSecret sets the union to v, with "option" set to "explicit".
+func (u *SignL4ExplicitOrStoreSelector) FromSignL4TeamSecret(v SignL4TeamSecret) error {
+	data, err := unionFrom(v, "option", "explicit")
+	if err != nil {
+		return fmt.Errorf("SignL4ExplicitOrStoreSelector: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsSignL4TeamSecretStoreID returns the union data as a SignL4TeamSecretStoreID.
+func (u SignL4ExplicitOrStoreSelector) AsSignL4TeamSecretStoreID() (SignL4TeamSecretStoreID, error) {
+	var v SignL4TeamSecretStoreID
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromSignL4TeamSecretStoreID sets the union to v, with "option" set to "store".
+func (u *SignL4ExplicitOrStoreSelector) FromSignL4TeamSecretStoreID(v SignL4TeamSecretStoreID) error {
+	data, err := unionFrom(v, "option", "store")
+	if err != nil {
+		return fmt.Errorf("SignL4ExplicitOrStoreSelector: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// ValueByDiscriminator decodes the union into the member selected by "option".
+func (u SignL4ExplicitOrStoreSelector) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := u.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "explicit":
+		return u.AsSignL4TeamSecret()
+	case "store":
+		return u.AsSignL4TeamSecretStoreID()
+	}
+	return nil, fmt.Errorf("SignL4ExplicitOrStoreSelector: unknown option %q", discriminator)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u SignL4ExplicitOrStoreSelector) MarshalJSON() ([]byte, error) {
+	if len(u.union) == 0 {
+		return []byte("null"), nil
+	}
+	return u.union, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting unknown "option" values.
+func (u *SignL4ExplicitOrStoreSelector) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		u.union = nil
+		return nil
+	}
+	discriminator, err := unionDiscriminator(data, "option")
+	if err != nil {
+		return fmt.Errorf("SignL4ExplicitOrStoreSelector: %w", err)
+	}
+	switch discriminator {
+	case "explicit", "store":
+	default:
+		return fmt.Errorf("SignL4ExplicitOrStoreSelector: unknown option %q", discriminator)
+	}
+	u.union = append(json.RawMessage(nil), data...)
+	return nil
 }
 
 // SignL4TeamSecret represents a CheckMK API type.
@@ -5023,58 +12001,58 @@ type SignL4TeamSecretStoreID struct {
 // Signl4PluginCreate represents a CheckMK API type.
 type Signl4PluginCreate struct {
 	// Ignore unverified HTTPS request warnings. Use with caution.
-	DisableSslCertVerification interface{} `json:"disable_ssl_cert_verification"`
+	DisableSslCertVerification Checkbox `json:"disable_ssl_cert_verification"`
 	// Use the proxy settings from the environment variables. The variables NO_PROXY, HTTP_PROXY and HTTPS_PROXY are taken into account during execution.
-	HttpProxy interface{} `json:"http_proxy"`
+	HttpProxy HttpProxyOneOf `json:"http_proxy"`
 	// The plugin name.
 	// Example: mail
 	PluginName Signl4PluginCreatePluginName `json:"plugin_name"`
-	TeamSecret map[string]interface{} `json:"team_secret"`
-	UrlPrefixForLinksToCheckmk map[string]interface{} `json:"url_prefix_for_links_to_checkmk"`
+	TeamSecret SignL4ExplicitOrStoreSelector `json:"team_secret"`
+	UrlPrefixForLinksToCheckmk UrlPrefixOneOf `json:"url_prefix_for_links_to_checkmk"`
 }
 
 // SiteConfigAttributes represents a CheckMK API type.
 type SiteConfigAttributes struct {
-	BasicSettings map[string]interface{} `json:"basic_settings,omitempty"`
-	ConfigurationConnection map[string]interface{} `json:"configuration_connection,omitempty"`
+	BasicSettings *BasicSettingsAttributes `json:"basic_settings,omitempty"`
+	ConfigurationConnection *ConfigurationConnectionAttributes1 `json:"configuration_connection,omitempty"`
 	// The shared secret used by the central site to authenticate with the remote site for configuring Checkmk.
 	// Example: secret
 	Secret string `json:"secret,omitempty"`
-	StatusConnection map[string]interface{} `json:"status_connection,omitempty"`
+	StatusConnection *StatusConnectionAttributes1 `json:"status_connection,omitempty"`
 }
 
 // SiteConfigAttributesCreate represents a CheckMK API type.
 type SiteConfigAttributesCreate struct {
-	BasicSettings map[string]interface{} `json:"basic_settings"`
-	ConfigurationConnection map[string]interface{} `json:"configuration_connection"`
+	BasicSettings BasicSettingsAttributesCreate `json:"basic_settings"`
+	ConfigurationConnection ConfigurationConnectionAttributes `json:"configuration_connection"`
 	// The shared secret used by the central site to authenticate with the remote site for configuring Checkmk.
 	// Example: secret
 	Secret string `json:"secret,omitempty"`
-	StatusConnection map[string]interface{} `json:"status_connection"`
+	StatusConnection StatusConnectionAttributes `json:"status_connection"`
 }
 
 // SiteConfigAttributesUpdate represents a CheckMK API type.
 type SiteConfigAttributesUpdate struct {
-	BasicSettings map[string]interface{} `json:"basic_settings"`
-	ConfigurationConnection map[string]interface{} `json:"configuration_connection"`
+	BasicSettings BasicSettingsAttributesUpdate `json:"basic_settings"`
+	ConfigurationConnection ConfigurationConnectionAttributes `json:"configuration_connection"`
 	// The shared secret used by the central site to authenticate with the remote site for configuring Checkmk.
 	// Example: secret
 	Secret string `json:"secret,omitempty"`
-	StatusConnection map[string]interface{} `json:"status_connection"`
+	StatusConnection StatusConnectionAttributes `json:"status_connection"`
 }
 
 // SiteConnectionRequestCreate represents a CheckMK API type.
 type SiteConnectionRequestCreate struct {
 	// A site's connection.
 	// Example: map[basic_settings:map[alias:Die remote site 1 site_id:site_id_1] configuration_connection:map[direct_login_to_web_gui_allowed:true disable_remote_configuration:true enable_replication:true ignore_tls_errors:false replicate_event_console:true replicate_extensions:true url_of_remote_site:http://lo...
-	SiteConfig interface{} `json:"site_config"`
+	SiteConfig SiteConfigAttributesCreate `json:"site_config"`
 }
 
 // SiteConnectionRequestUpdate represents a CheckMK API type.
 type SiteConnectionRequestUpdate struct {
 	// A site's connection.
 	// Example: map[basic_settings:map[alias:Die remote site 1 site_id:site_id_1] configuration_connection:map[direct_login_to_web_gui_allowed:true disable_remote_configuration:true enable_replication:true ignore_tls_errors:false replicate_event_console:true replicate_extensions:true url_of_remote_site:http://lo...
-	SiteConfig interface{} `json:"site_config"`
+	SiteConfig SiteConfigAttributesUpdate `json:"site_config"`
 }
 
 // SiteConnectionResponse represents a CheckMK API type.
@@ -5083,11 +12061,11 @@ type SiteConnectionResponse struct {
 	DomainType interface{} `json:"domainType,omitempty"`
 	// The configuration attributes of a site.
 	// Example: map[basic_settings:map[alias:Die remote site 1 site_id:site_id_1] configuration_connection:map[direct_login_to_web_gui_allowed:true disable_remote_configuration:true enable_replication:true ignore_tls_errors:false replicate_event_console:true replicate_extensions:true url_of_remote_site:http://lo...
-	Extensions interface{} `json:"extensions,omitempty"`
+	Extensions *SiteConfigAttributes `json:"extensions,omitempty"`
 	// The unique identifier for this domain-object type.
 	Id string `json:"id,omitempty"`
 	// list of links to other resources.
-	Links []map[string]interface{} `json:"links"`
+	Links []Link `json:"links"`
 	// The container for external resources, like linked foreign objects or actions.
 	Members map[string]interface{} `json:"members,omitempty"`
 	// A human readable title of this object. Can be used for user interfaces.
@@ -5103,16 +12081,98 @@ type SiteConnectionResponseCollection struct {
 	// The name of this collection.
 	Id string `json:"id,omitempty"`
 	// list of links to other resources.
-	Links []map[string]interface{} `json:"links"`
+	Links []Link `json:"links"`
 	// A human readable title of this object. Can be used for user interfaces.
 	Title string `json:"title,omitempty"`
 	// A list of site configuration objects.
 	// Example: [map[domainType:site_connection extensions:map[basic_settings:map[alias:Die remote site 1 site_id:site_id_1] configuration_connection:map[direct_login_to_web_gui_allowed:true disable_remote_configuration:true enable_replication:true ignore_tls_errors:false replicate_event_console:true replicate_e...
-	Value []map[string]interface{} `json:"value,omitempty"`
+	Value []SiteConnectionResponse `json:"value,omitempty"`
 }
 
-// SiteIDPrefixOneOf represents a CheckMK API type.
+// SiteIDPrefixOneOf is a union of Checkbox, CheckBoxUseSiteIDPrefix, selected by the "state" property.
 type SiteIDPrefixOneOf struct {
+	union json.RawMessage
+}
+
+// Discriminator returns the value of the "state" property.
+func (u SiteIDPrefixOneOf) Discriminator() (string, error) {
+	return unionDiscriminator(u.union, "state")
+}
+
+// AsCheckbox returns the union data as a Checkbox.
+func (u SiteIDPrefixOneOf) AsCheckbox() (Checkbox, error) {
+	var v Checkbox
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromCheckbox sets the union to v, with "state" set to "disabled".
+func (u *SiteIDPrefixOneOf) FromCheckbox(v Checkbox) error {
+	data, err := unionFrom(v, "state", "disabled")
+	if err != nil {
+		return fmt.Errorf("SiteIDPrefixOneOf: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsCheckBoxUseSiteIDPrefix returns the union data as a CheckBoxUseSiteIDPrefix.
+func (u SiteIDPrefixOneOf) AsCheckBoxUseSiteIDPrefix() (CheckBoxUseSiteIDPrefix, error) {
+	var v CheckBoxUseSiteIDPrefix
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromCheckBoxUseSiteIDPrefix sets the union to v, with "state" set to "enabled".
+func (u *SiteIDPrefixOneOf) FromCheckBoxUseSiteIDPrefix(v CheckBoxUseSiteIDPrefix) error {
+	data, err := unionFrom(v, "state", "enabled")
+	if err != nil {
+		return fmt.Errorf("SiteIDPrefixOneOf: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// ValueByDiscriminator decodes the union into the member selected by "state".
+func (u SiteIDPrefixOneOf) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := u.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "disabled":
+		return u.AsCheckbox()
+	case "enabled":
+		return u.AsCheckBoxUseSiteIDPrefix()
+	}
+	return nil, fmt.Errorf("SiteIDPrefixOneOf: unknown state %q", discriminator)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u SiteIDPrefixOneOf) MarshalJSON() ([]byte, error) {
+	if len(u.union) == 0 {
+		return []byte("null"), nil
+	}
+	return u.union, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting unknown "state" values.
+func (u *SiteIDPrefixOneOf) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		u.union = nil
+		return nil
+	}
+	discriminator, err := unionDiscriminator(data, "state")
+	if err != nil {
+		return fmt.Errorf("SiteIDPrefixOneOf: %w", err)
+	}
+	switch discriminator {
+	case "disabled", "enabled":
+	default:
+		return fmt.Errorf("SiteIDPrefixOneOf: unknown state %q", discriminator)
+	}
+	u.union = append(json.RawMessage(nil), data...)
+	return nil
 }
 
 // SiteLoginRequest represents a CheckMK API type.
@@ -5128,18 +12188,100 @@ type SiteLoginRequest struct {
 // SlackPluginCreate represents a CheckMK API type.
 type SlackPluginCreate struct {
 	// Ignore unverified HTTPS request warnings. Use with caution.
-	DisableSslCertVerification interface{} `json:"disable_ssl_cert_verification"`
+	DisableSslCertVerification Checkbox `json:"disable_ssl_cert_verification"`
 	// Use the proxy settings from the environment variables. The variables NO_PROXY, HTTP_PROXY and HTTPS_PROXY are taken into account during execution.
-	HttpProxy interface{} `json:"http_proxy"`
+	HttpProxy HttpProxyOneOf `json:"http_proxy"`
 	// The plugin name.
 	// Example: mail
 	PluginName SlackPluginCreatePluginName `json:"plugin_name"`
-	UrlPrefixForLinksToCheckmk map[string]interface{} `json:"url_prefix_for_links_to_checkmk"`
-	WebhookUrl map[string]interface{} `json:"webhook_url"`
+	UrlPrefixForLinksToCheckmk UrlPrefixOneOf `json:"url_prefix_for_links_to_checkmk"`
+	WebhookUrl SlackStoreOrExplicitURLSelector `json:"webhook_url"`
 }
 
-// SlackStoreOrExplicitURLSelector represents a CheckMK API type.
+// SlackStoreOrExplicitURLSelector is a union of SlackWebhookURL, SlackWebhookStore, selected by the "option" property.
 type SlackStoreOrExplicitURLSelector struct {
+	union json.RawMessage
+}
+
+// Discriminator returns the value of the "option" property.
+func (u SlackStoreOrExplicitURLSelector) Discriminator() (string, error) {
+	return unionDiscriminator(u.union, "option")
+}
+
+// AsSlackWebhookURL returns the union data as a SlackWebhookURL.
+func (u SlackStoreOrExplicitURLSelector) AsSlackWebhookURL() (SlackWebhookURL, error) {
+	var v SlackWebhookURL
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromSlackWebhookURL sets the union to v, with "option" set to "explicit".
+func (u *SlackStoreOrExplicitURLSelector) FromSlackWebhookURL(v SlackWebhookURL) error {
+	data, err := unionFrom(v, "option", "explicit")
+	if err != nil {
+		return fmt.Errorf("SlackStoreOrExplicitURLSelector: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsSlackWebhookStore returns the union data as a SlackWebhookStore.
+func (u SlackStoreOrExplicitURLSelector) AsSlackWebhookStore() (SlackWebhookStore, error) {
+	var v SlackWebhookStore
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromSlackWebhookStore sets the union to v, with "option" set to "store".
+func (u *SlackStoreOrExplicitURLSelector) FromSlackWebhookStore(v SlackWebhookStore) error {
+	data, err := unionFrom(v, "option", "store")
+	if err != nil {
+		return fmt.Errorf("SlackStoreOrExplicitURLSelector: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// ValueByDiscriminator decodes the union into the member selected by "option".
+func (u SlackStoreOrExplicitURLSelector) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := u.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "explicit":
+		return u.AsSlackWebhookURL()
+	case "store":
+		return u.AsSlackWebhookStore()
+	}
+	return nil, fmt.Errorf("SlackStoreOrExplicitURLSelector: unknown option %q", discriminator)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u SlackStoreOrExplicitURLSelector) MarshalJSON() ([]byte, error) {
+	if len(u.union) == 0 {
+		return []byte("null"), nil
+	}
+	return u.union, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting unknown "option" values.
+func (u *SlackStoreOrExplicitURLSelector) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		u.union = nil
+		return nil
+	}
+	discriminator, err := unionDiscriminator(data, "option")
+	if err != nil {
+		return fmt.Errorf("SlackStoreOrExplicitURLSelector: %w", err)
+	}
+	switch discriminator {
+	case "explicit", "store":
+	default:
+		return fmt.Errorf("SlackStoreOrExplicitURLSelector: unknown option %q", discriminator)
+	}
+	u.union = append(json.RawMessage(nil), data...)
+	return nil
 }
 
 // SlackWebhookStore represents a CheckMK API type.
@@ -5156,8 +12298,128 @@ type SlackWebhookURL struct {
 	Url string `json:"url"`
 }
 
-// SocketAttributes represents a CheckMK API type.
+// SocketAttributes is a union of SocketIP4, SocketIP6, SocketUnixAttributes, SocketType, selected by the "socket_type" property.
 type SocketAttributes struct {
+	union json.RawMessage
+}
+
+// Discriminator returns the value of the "socket_type" property.
+func (u SocketAttributes) Discriminator() (string, error) {
+	return unionDiscriminator(u.union, "socket_type")
+}
+
+// AsSocketIP4 returns the union data as a SocketIP4.
+func (u SocketAttributes) AsSocketIP4() (SocketIP4, error) {
+	var v SocketIP4
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromSocketIP4 sets the union to v, with "socket_type" set to "tcp".
+func (u *SocketAttributes) FromSocketIP4(v SocketIP4) error {
+	data, err := unionFrom(v, "socket_type", "tcp")
+	if err != nil {
+		return fmt.Errorf("SocketAttributes: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsSocketIP6 returns the union data as a SocketIP6.
+func (u SocketAttributes) AsSocketIP6() (SocketIP6, error) {
+	var v SocketIP6
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromSocketIP6 sets the union to v, with "socket_type" set to "tcp6".
+func (u *SocketAttributes) FromSocketIP6(v SocketIP6) error {
+	data, err := unionFrom(v, "socket_type", "tcp6")
+	if err != nil {
+		return fmt.Errorf("SocketAttributes: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsSocketUnixAttributes returns the union data as a SocketUnixAttributes.
+func (u SocketAttributes) AsSocketUnixAttributes() (SocketUnixAttributes, error) {
+	var v SocketUnixAttributes
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromSocketUnixAttributes sets the union to v, with "socket_type" set to "unix".
+func (u *SocketAttributes) FromSocketUnixAttributes(v SocketUnixAttributes) error {
+	data, err := unionFrom(v, "socket_type", "unix")
+	if err != nil {
+		return fmt.Errorf("SocketAttributes: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsSocketType returns the union data as a SocketType.
+func (u SocketAttributes) AsSocketType() (SocketType, error) {
+	var v SocketType
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromSocketType sets the union to v, with "socket_type" set to "local".
+func (u *SocketAttributes) FromSocketType(v SocketType) error {
+	data, err := unionFrom(v, "socket_type", "local")
+	if err != nil {
+		return fmt.Errorf("SocketAttributes: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// ValueByDiscriminator decodes the union into the member selected by "socket_type".
+func (u SocketAttributes) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := u.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "tcp":
+		return u.AsSocketIP4()
+	case "tcp6":
+		return u.AsSocketIP6()
+	case "unix":
+		return u.AsSocketUnixAttributes()
+	case "local":
+		return u.AsSocketType()
+	}
+	return nil, fmt.Errorf("SocketAttributes: unknown socket_type %q", discriminator)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u SocketAttributes) MarshalJSON() ([]byte, error) {
+	if len(u.union) == 0 {
+		return []byte("null"), nil
+	}
+	return u.union, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting unknown "socket_type" values.
+func (u *SocketAttributes) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		u.union = nil
+		return nil
+	}
+	discriminator, err := unionDiscriminator(data, "socket_type")
+	if err != nil {
+		return fmt.Errorf("SocketAttributes: %w", err)
+	}
+	switch discriminator {
+	case "tcp", "tcp6", "unix", "local":
+	default:
+		return fmt.Errorf("SocketAttributes: unknown socket_type %q", discriminator)
+	}
+	u.union = append(json.RawMessage(nil), data...)
+	return nil
 }
 
 // SocketAttributes1 represents a CheckMK API type.
@@ -5237,8 +12499,90 @@ type SocketUnixAttributes struct {
 	SocketType SocketUnixAttributesSocketType `json:"socket_type"`
 }
 
-// SortOrderOneOf represents a CheckMK API type.
+// SortOrderOneOf is a union of Checkbox, CheckboxSortOrderValue, selected by the "state" property.
 type SortOrderOneOf struct {
+	union json.RawMessage
+}
+
+// Discriminator returns the value of the "state" property.
+func (u SortOrderOneOf) Discriminator() (string, error) {
+	return unionDiscriminator(u.union, "state")
+}
+
+// AsCheckbox returns the union data as a Checkbox.
+func (u SortOrderOneOf) AsCheckbox() (Checkbox, error) {
+	var v Checkbox
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromCheckbox sets the union to v, with "state" set to "disabled".
+func (u *SortOrderOneOf) FromCheckbox(v Checkbox) error {
+	data, err := unionFrom(v, "state", "disabled")
+	if err != nil {
+		return fmt.Errorf("SortOrderOneOf: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsCheckboxSortOrderValue returns the union data as a CheckboxSortOrderValue.
+func (u SortOrderOneOf) AsCheckboxSortOrderValue() (CheckboxSortOrderValue, error) {
+	var v CheckboxSortOrderValue
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromCheckboxSortOrderValue sets the union to v, with "state" set to "enabled".
+func (u *SortOrderOneOf) FromCheckboxSortOrderValue(v CheckboxSortOrderValue) error {
+	data, err := unionFrom(v, "state", "enabled")
+	if err != nil {
+		return fmt.Errorf("SortOrderOneOf: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// ValueByDiscriminator decodes the union into the member selected by "state".
+func (u SortOrderOneOf) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := u.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "disabled":
+		return u.AsCheckbox()
+	case "enabled":
+		return u.AsCheckboxSortOrderValue()
+	}
+	return nil, fmt.Errorf("SortOrderOneOf: unknown state %q", discriminator)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u SortOrderOneOf) MarshalJSON() ([]byte, error) {
+	if len(u.union) == 0 {
+		return []byte("null"), nil
+	}
+	return u.union, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting unknown "state" values.
+func (u *SortOrderOneOf) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		u.union = nil
+		return nil
+	}
+	discriminator, err := unionDiscriminator(data, "state")
+	if err != nil {
+		return fmt.Errorf("SortOrderOneOf: %w", err)
+	}
+	switch discriminator {
+	case "disabled", "enabled":
+	default:
+		return fmt.Errorf("SortOrderOneOf: unknown state %q", discriminator)
+	}
+	u.union = append(json.RawMessage(nil), data...)
+	return nil
 }
 
 // Sounds represents a CheckMK API type.
@@ -5251,8 +12595,90 @@ type Sounds struct {
 	Value SoundsValue `json:"value"`
 }
 
-// SoundsOneOf represents a CheckMK API type.
+// SoundsOneOf is a union of Checkbox, Sounds, selected by the "state" property.
 type SoundsOneOf struct {
+	union json.RawMessage
+}
+
+// Discriminator returns the value of the "state" property.
+func (u SoundsOneOf) Discriminator() (string, error) {
+	return unionDiscriminator(u.union, "state")
+}
+
+// AsCheckbox returns the union data as a Checkbox.
+func (u SoundsOneOf) AsCheckbox() (Checkbox, error) {
+	var v Checkbox
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromCheckbox sets the union to v, with "state" set to "disabled".
+func (u *SoundsOneOf) FromCheckbox(v Checkbox) error {
+	data, err := unionFrom(v, "state", "disabled")
+	if err != nil {
+		return fmt.Errorf("SoundsOneOf: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsSounds returns the union data as a Sounds.
+func (u SoundsOneOf) AsSounds() (Sounds, error) {
+	var v Sounds
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromSounds sets the union to v, with "state" set to "enabled".
+func (u *SoundsOneOf) FromSounds(v Sounds) error {
+	data, err := unionFrom(v, "state", "enabled")
+	if err != nil {
+		return fmt.Errorf("SoundsOneOf: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// ValueByDiscriminator decodes the union into the member selected by "state".
+func (u SoundsOneOf) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := u.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "disabled":
+		return u.AsCheckbox()
+	case "enabled":
+		return u.AsSounds()
+	}
+	return nil, fmt.Errorf("SoundsOneOf: unknown state %q", discriminator)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u SoundsOneOf) MarshalJSON() ([]byte, error) {
+	if len(u.union) == 0 {
+		return []byte("null"), nil
+	}
+	return u.union, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting unknown "state" values.
+func (u *SoundsOneOf) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		u.union = nil
+		return nil
+	}
+	discriminator, err := unionDiscriminator(data, "state")
+	if err != nil {
+		return fmt.Errorf("SoundsOneOf: %w", err)
+	}
+	switch discriminator {
+	case "disabled", "enabled":
+	default:
+		return fmt.Errorf("SoundsOneOf: unknown state %q", discriminator)
+	}
+	u.union = append(json.RawMessage(nil), data...)
+	return nil
 }
 
 // SpectrumPluginBase represents a CheckMK API type.
@@ -5270,8 +12696,90 @@ type SpectrumPluginBase struct {
 	SnmpCommunity string `json:"snmp_community"`
 }
 
-// SplunkRESTEndpointSelector represents a CheckMK API type.
+// SplunkRESTEndpointSelector is a union of SplunkURLExplicit, SplunkStoreID, selected by the "option" property.
 type SplunkRESTEndpointSelector struct {
+	union json.RawMessage
+}
+
+// Discriminator returns the value of the "option" property.
+func (u SplunkRESTEndpointSelector) Discriminator() (string, error) {
+	return unionDiscriminator(u.union, "option")
+}
+
+// AsSplunkURLExplicit returns the union data as a SplunkURLExplicit.
+func (u SplunkRESTEndpointSelector) AsSplunkURLExplicit() (SplunkURLExplicit, error) {
+	var v SplunkURLExplicit
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromSplunkURLExplicit sets the union to v, with "option" set to "explicit".
+func (u *SplunkRESTEndpointSelector) FromSplunkURLExplicit(v SplunkURLExplicit) error {
+	data, err := unionFrom(v, "option", "explicit")
+	if err != nil {
+		return fmt.Errorf("SplunkRESTEndpointSelector: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsSplunkStoreID returns the union data as a SplunkStoreID.
+func (u SplunkRESTEndpointSelector) AsSplunkStoreID() (SplunkStoreID, error) {
+	var v SplunkStoreID
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromSplunkStoreID sets the union to v, with "option" set to "store".
+func (u *SplunkRESTEndpointSelector) FromSplunkStoreID(v SplunkStoreID) error {
+	data, err := unionFrom(v, "option", "store")
+	if err != nil {
+		return fmt.Errorf("SplunkRESTEndpointSelector: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// ValueByDiscriminator decodes the union into the member selected by "option".
+func (u SplunkRESTEndpointSelector) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := u.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "explicit":
+		return u.AsSplunkURLExplicit()
+	case "store":
+		return u.AsSplunkStoreID()
+	}
+	return nil, fmt.Errorf("SplunkRESTEndpointSelector: unknown option %q", discriminator)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u SplunkRESTEndpointSelector) MarshalJSON() ([]byte, error) {
+	if len(u.union) == 0 {
+		return []byte("null"), nil
+	}
+	return u.union, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting unknown "option" values.
+func (u *SplunkRESTEndpointSelector) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		u.union = nil
+		return nil
+	}
+	discriminator, err := unionDiscriminator(data, "option")
+	if err != nil {
+		return fmt.Errorf("SplunkRESTEndpointSelector: %w", err)
+	}
+	switch discriminator {
+	case "explicit", "store":
+	default:
+		return fmt.Errorf("SplunkRESTEndpointSelector: unknown option %q", discriminator)
+	}
+	u.union = append(json.RawMessage(nil), data...)
+	return nil
 }
 
 // SplunkStoreID represents a CheckMK API type.
@@ -5290,8 +12798,90 @@ type SplunkURLExplicit struct {
 	Url string `json:"url"`
 }
 
-// StateRecoveryOneOf represents a CheckMK API type.
+// StateRecoveryOneOf is a union of Checkbox, CheckboxWithManagementTypeStateCaseValues, selected by the "state" property.
 type StateRecoveryOneOf struct {
+	union json.RawMessage
+}
+
+// Discriminator returns the value of the "state" property.
+func (u StateRecoveryOneOf) Discriminator() (string, error) {
+	return unionDiscriminator(u.union, "state")
+}
+
+// AsCheckbox returns the union data as a Checkbox.
+func (u StateRecoveryOneOf) AsCheckbox() (Checkbox, error) {
+	var v Checkbox
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromCheckbox sets the union to v, with "state" set to "disabled".
+func (u *StateRecoveryOneOf) FromCheckbox(v Checkbox) error {
+	data, err := unionFrom(v, "state", "disabled")
+	if err != nil {
+		return fmt.Errorf("StateRecoveryOneOf: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsCheckboxWithManagementTypeStateCaseValues returns the union data as a CheckboxWithManagementTypeStateCaseValues.
+func (u StateRecoveryOneOf) AsCheckboxWithManagementTypeStateCaseValues() (CheckboxWithManagementTypeStateCaseValues, error) {
+	var v CheckboxWithManagementTypeStateCaseValues
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromCheckboxWithManagementTypeStateCaseValues sets the union to v, with "state" set to "enabled".
+func (u *StateRecoveryOneOf) FromCheckboxWithManagementTypeStateCaseValues(v CheckboxWithManagementTypeStateCaseValues) error {
+	data, err := unionFrom(v, "state", "enabled")
+	if err != nil {
+		return fmt.Errorf("StateRecoveryOneOf: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// ValueByDiscriminator decodes the union into the member selected by "state".
+func (u StateRecoveryOneOf) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := u.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "disabled":
+		return u.AsCheckbox()
+	case "enabled":
+		return u.AsCheckboxWithManagementTypeStateCaseValues()
+	}
+	return nil, fmt.Errorf("StateRecoveryOneOf: unknown state %q", discriminator)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u StateRecoveryOneOf) MarshalJSON() ([]byte, error) {
+	if len(u.union) == 0 {
+		return []byte("null"), nil
+	}
+	return u.union, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting unknown "state" values.
+func (u *StateRecoveryOneOf) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		u.union = nil
+		return nil
+	}
+	discriminator, err := unionDiscriminator(data, "state")
+	if err != nil {
+		return fmt.Errorf("StateRecoveryOneOf: %w", err)
+	}
+	switch discriminator {
+	case "disabled", "enabled":
+	default:
+		return fmt.Errorf("StateRecoveryOneOf: unknown state %q", discriminator)
+	}
+	u.union = append(json.RawMessage(nil), data...)
+	return nil
 }
 
 // StatusConnectionAttributes represents a CheckMK API type.
@@ -5300,7 +12890,7 @@ type StatusConnectionAttributes struct {
 	// Example: 2
 	ConnectTimeout int `json:"connect_timeout"`
 	// When connecting to remote site please make sure that Livestatus over TCP is activated there. You can use UNIX sockets to connect to foreign sites on localhost.
-	Connection interface{} `json:"connection"`
+	Connection SocketAttributes `json:"connection"`
 	// If you disable a connection, then no data of this site will be shown in the status GUI. The replication is not affected by this, however.
 	// Example: false
 	DisableInStatusGui bool `json:"disable_in_status_gui,omitempty"`
@@ -5308,9 +12898,9 @@ type StatusConnectionAttributes struct {
 	// Example: false
 	PersistentConnection bool `json:"persistent_connection,omitempty"`
 	// The Livestatus proxy daemon configuration attributes.
-	Proxy interface{} `json:"proxy"`
+	Proxy ProxyOrDirect `json:"proxy"`
 	// By specifying a status host for each non-local connection you prevent Multisite from running into timeouts when remote sites do not respond.
-	StatusHost interface{} `json:"status_host"`
+	StatusHost StatusHostSet `json:"status_host"`
 	// The URL prefix will be prepended to links of addons like NagVis when a link to such applications points to a host or service on that site.
 	// Example: /remote_1/
 	UrlPrefix string `json:"url_prefix,omitempty"`
@@ -5322,7 +12912,7 @@ type StatusConnectionAttributes1 struct {
 	// Example: 2
 	ConnectTimeout int `json:"connect_timeout"`
 	// When connecting to remote site please make sure that Livestatus over TCP is activated there. You can use UNIX sockets to connect to foreign sites on localhost.
-	Connection interface{} `json:"connection"`
+	Connection SocketAttributes1 `json:"connection"`
 	// If you disable a connection, then no data of this site will be shown in the status GUI. The replication is not affected by this, however.
 	// Example: false
 	DisableInStatusGui bool `json:"disable_in_status_gui,omitempty"`
@@ -5330,9 +12920,9 @@ type StatusConnectionAttributes1 struct {
 	// Example: true
 	PersistentConnection bool `json:"persistent_connection,omitempty"`
 	// The Livestatus proxy daemon configuration attributes.
-	Proxy interface{} `json:"proxy"`
+	Proxy ProxyAttributes1 `json:"proxy"`
 	// By specifying a status host for each non-local connection you prevent Multisite from running into timeouts when remote sites do not respond.
-	StatusHost interface{} `json:"status_host,omitempty"`
+	StatusHost *StatusHostAttributes `json:"status_host,omitempty"`
 	// The URL prefix will be prepended to links of addons like NagVis when a link to such applications points to a host or service on that site.
 	// Example: /remote_1/
 	UrlPrefix string `json:"url_prefix,omitempty"`
@@ -5371,16 +12961,262 @@ type StatusHostAttributesSet struct {
 	StatusHostSet StatusHostAttributesSetStatusHostSet `json:"status_host_set"`
 }
 
-// StatusHostSet represents a CheckMK API type.
+// StatusHostSet is a union of StatusHostAttributesSet, StatusHostAttributesBase, selected by the "status_host_set" property.
 type StatusHostSet struct {
-}
-
-// StrValueOneOf represents a CheckMK API type.
+	union json.RawMessage
+}
+
+// Discriminator returns the value of the "status_host_set" property.
+func (u StatusHostSet) Discriminator() (string, error) {
+	return unionDiscriminator(u.union, "status_host_set")
+}
+
+// AsStatusHostAttributesSet returns the union data as a StatusHostAttributesSet.
+func (u StatusHostSet) AsStatusHostAttributesSet() (StatusHostAttributesSet, error) {
+	var v StatusHostAttributesSet
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromStatusHostAttributesSet sets the union to v, with "status_host_set" set to "enabled".
+func (u *StatusHostSet) FromStatusHostAttributesSet(v StatusHostAttributesSet) error {
+	data, err := unionFrom(v, "status_host_set", "enabled")
+	if err != nil {
+		return fmt.Errorf("StatusHostSet: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsStatusHostAttributesBase returns the union data as a StatusHostAttributesBase.
+func (u StatusHostSet) AsStatusHostAttributesBase() (StatusHostAttributesBase, error) {
+	var v StatusHostAttributesBase
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromStatusHostAttributesBase sets the union to v, with "status_host_set" set to "disabled".
+func (u *StatusHostSet) FromStatusHostAttributesBase(v StatusHostAttributesBase) error {
+	data, err := unionFrom(v, "status_host_set", "disabled")
+	if err != nil {
+		return fmt.Errorf("StatusHostSet: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// ValueByDiscriminator decodes the union into the member selected by "status_host_set".
+func (u StatusHostSet) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := u.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "enabled":
+		return u.AsStatusHostAttributesSet()
+	case "disabled":
+		return u.AsStatusHostAttributesBase()
+	}
+	return nil, fmt.Errorf("StatusHostSet: unknown status_host_set %q", discriminator)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u StatusHostSet) MarshalJSON() ([]byte, error) {
+	if len(u.union) == 0 {
+		return []byte("null"), nil
+	}
+	return u.union, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting unknown "status_host_set" values.
+func (u *StatusHostSet) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		u.union = nil
+		return nil
+	}
+	discriminator, err := unionDiscriminator(data, "status_host_set")
+	if err != nil {
+		return fmt.Errorf("StatusHostSet: %w", err)
+	}
+	switch discriminator {
+	case "enabled", "disabled":
+	default:
+		return fmt.Errorf("StatusHostSet: unknown status_host_set %q", discriminator)
+	}
+	u.union = append(json.RawMessage(nil), data...)
+	return nil
+}
+
+// StrValueOneOf is a union of Checkbox, CheckboxWithStrValue, selected by the "state" property.
 type StrValueOneOf struct {
-}
-
-// StringCheckbox represents a CheckMK API type.
+	union json.RawMessage
+}
+
+// Discriminator returns the value of the "state" property.
+func (u StrValueOneOf) Discriminator() (string, error) {
+	return unionDiscriminator(u.union, "state")
+}
+
+// AsCheckbox returns the union data as a Checkbox.
+func (u StrValueOneOf) AsCheckbox() (Checkbox, error) {
+	var v Checkbox
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromCheckbox sets the union to v, with "state" set to "disabled".
+func (u *StrValueOneOf) FromCheckbox(v Checkbox) error {
+	data, err := unionFrom(v, "state", "disabled")
+	if err != nil {
+		return fmt.Errorf("StrValueOneOf: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsCheckboxWithStrValue returns the union data as a CheckboxWithStrValue.
+func (u StrValueOneOf) AsCheckboxWithStrValue() (CheckboxWithStrValue, error) {
+	var v CheckboxWithStrValue
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromCheckboxWithStrValue sets the union to v, with "state" set to "enabled".
+func (u *StrValueOneOf) FromCheckboxWithStrValue(v CheckboxWithStrValue) error {
+	data, err := unionFrom(v, "state", "enabled")
+	if err != nil {
+		return fmt.Errorf("StrValueOneOf: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// ValueByDiscriminator decodes the union into the member selected by "state".
+func (u StrValueOneOf) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := u.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "disabled":
+		return u.AsCheckbox()
+	case "enabled":
+		return u.AsCheckboxWithStrValue()
+	}
+	return nil, fmt.Errorf("StrValueOneOf: unknown state %q", discriminator)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u StrValueOneOf) MarshalJSON() ([]byte, error) {
+	if len(u.union) == 0 {
+		return []byte("null"), nil
+	}
+	return u.union, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting unknown "state" values.
+func (u *StrValueOneOf) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		u.union = nil
+		return nil
+	}
+	discriminator, err := unionDiscriminator(data, "state")
+	if err != nil {
+		return fmt.Errorf("StrValueOneOf: %w", err)
+	}
+	switch discriminator {
+	case "disabled", "enabled":
+	default:
+		return fmt.Errorf("StrValueOneOf: unknown state %q", discriminator)
+	}
+	u.union = append(json.RawMessage(nil), data...)
+	return nil
+}
+
+// StringCheckbox is a union of Checkbox, CheckboxWithStrValue, selected by the "state" property.
 type StringCheckbox struct {
+	union json.RawMessage
+}
+
+// Discriminator returns the value of the "state" property.
+func (u StringCheckbox) Discriminator() (string, error) {
+	return unionDiscriminator(u.union, "state")
+}
+
+// AsCheckbox returns the union data as a Checkbox.
+func (u StringCheckbox) AsCheckbox() (Checkbox, error) {
+	var v Checkbox
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromCheckbox sets the union to v, with "state" set to "disabled".
+func (u *StringCheckbox) FromCheckbox(v Checkbox) error {
+	data, err := unionFrom(v, "state", "disabled")
+	if err != nil {
+		return fmt.Errorf("StringCheckbox: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsCheckboxWithStrValue returns the union data as a CheckboxWithStrValue.
+func (u StringCheckbox) AsCheckboxWithStrValue() (CheckboxWithStrValue, error) {
+	var v CheckboxWithStrValue
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromCheckboxWithStrValue sets the union to v, with "state" set to "enabled".
+func (u *StringCheckbox) FromCheckboxWithStrValue(v CheckboxWithStrValue) error {
+	data, err := unionFrom(v, "state", "enabled")
+	if err != nil {
+		return fmt.Errorf("StringCheckbox: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// ValueByDiscriminator decodes the union into the member selected by "state".
+func (u StringCheckbox) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := u.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "disabled":
+		return u.AsCheckbox()
+	case "enabled":
+		return u.AsCheckboxWithStrValue()
+	}
+	return nil, fmt.Errorf("StringCheckbox: unknown state %q", discriminator)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u StringCheckbox) MarshalJSON() ([]byte, error) {
+	if len(u.union) == 0 {
+		return []byte("null"), nil
+	}
+	return u.union, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting unknown "state" values.
+func (u *StringCheckbox) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		u.union = nil
+		return nil
+	}
+	discriminator, err := unionDiscriminator(data, "state")
+	if err != nil {
+		return fmt.Errorf("StringCheckbox: %w", err)
+	}
+	switch discriminator {
+	case "disabled", "enabled":
+	default:
+		return fmt.Errorf("StringCheckbox: unknown state %q", discriminator)
+	}
+	u.union = append(json.RawMessage(nil), data...)
+	return nil
 }
 
 // SubjectForHostNotificationsCheckbox represents a CheckMK API type.
@@ -5403,16 +13239,262 @@ type SubjectForServiceNotificationsCheckbox struct {
 	Value string `json:"value,omitempty"`
 }
 
-// SubjectHostOneOf represents a CheckMK API type.
+// SubjectHostOneOf is a union of Checkbox, SubjectForHostNotificationsCheckbox, selected by the "state" property.
 type SubjectHostOneOf struct {
-}
-
-// SubjectServiceOneOf represents a CheckMK API type.
+	union json.RawMessage
+}
+
+// Discriminator returns the value of the "state" property.
+func (u SubjectHostOneOf) Discriminator() (string, error) {
+	return unionDiscriminator(u.union, "state")
+}
+
+// AsCheckbox returns the union data as a Checkbox.
+func (u SubjectHostOneOf) AsCheckbox() (Checkbox, error) {
+	var v Checkbox
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromCheckbox sets the union to v, with "state" set to "disabled".
+func (u *SubjectHostOneOf) FromCheckbox(v Checkbox) error {
+	data, err := unionFrom(v, "state", "disabled")
+	if err != nil {
+		return fmt.Errorf("SubjectHostOneOf: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsSubjectForHostNotificationsCheckbox returns the union data as a SubjectForHostNotificationsCheckbox.
+func (u SubjectHostOneOf) AsSubjectForHostNotificationsCheckbox() (SubjectForHostNotificationsCheckbox, error) {
+	var v SubjectForHostNotificationsCheckbox
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromSubjectForHostNotificationsCheckbox sets the union to v, with "state" set to "enabled".
+func (u *SubjectHostOneOf) FromSubjectForHostNotificationsCheckbox(v SubjectForHostNotificationsCheckbox) error {
+	data, err := unionFrom(v, "state", "enabled")
+	if err != nil {
+		return fmt.Errorf("SubjectHostOneOf: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// ValueByDiscriminator decodes the union into the member selected by "state".
+func (u SubjectHostOneOf) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := u.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "disabled":
+		return u.AsCheckbox()
+	case "enabled":
+		return u.AsSubjectForHostNotificationsCheckbox()
+	}
+	return nil, fmt.Errorf("SubjectHostOneOf: unknown state %q", discriminator)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u SubjectHostOneOf) MarshalJSON() ([]byte, error) {
+	if len(u.union) == 0 {
+		return []byte("null"), nil
+	}
+	return u.union, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting unknown "state" values.
+func (u *SubjectHostOneOf) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		u.union = nil
+		return nil
+	}
+	discriminator, err := unionDiscriminator(data, "state")
+	if err != nil {
+		return fmt.Errorf("SubjectHostOneOf: %w", err)
+	}
+	switch discriminator {
+	case "disabled", "enabled":
+	default:
+		return fmt.Errorf("SubjectHostOneOf: unknown state %q", discriminator)
+	}
+	u.union = append(json.RawMessage(nil), data...)
+	return nil
+}
+
+// SubjectServiceOneOf is a union of Checkbox, SubjectForServiceNotificationsCheckbox, selected by the "state" property.
 type SubjectServiceOneOf struct {
-}
-
-// SysLogFacilityOneOf represents a CheckMK API type.
+	union json.RawMessage
+}
+
+// Discriminator returns the value of the "state" property.
+func (u SubjectServiceOneOf) Discriminator() (string, error) {
+	return unionDiscriminator(u.union, "state")
+}
+
+// AsCheckbox returns the union data as a Checkbox.
+func (u SubjectServiceOneOf) AsCheckbox() (Checkbox, error) {
+	var v Checkbox
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromCheckbox sets the union to v, with "state" set to "disabled".
+func (u *SubjectServiceOneOf) FromCheckbox(v Checkbox) error {
+	data, err := unionFrom(v, "state", "disabled")
+	if err != nil {
+		return fmt.Errorf("SubjectServiceOneOf: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsSubjectForServiceNotificationsCheckbox returns the union data as a SubjectForServiceNotificationsCheckbox.
+func (u SubjectServiceOneOf) AsSubjectForServiceNotificationsCheckbox() (SubjectForServiceNotificationsCheckbox, error) {
+	var v SubjectForServiceNotificationsCheckbox
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromSubjectForServiceNotificationsCheckbox sets the union to v, with "state" set to "enabled".
+func (u *SubjectServiceOneOf) FromSubjectForServiceNotificationsCheckbox(v SubjectForServiceNotificationsCheckbox) error {
+	data, err := unionFrom(v, "state", "enabled")
+	if err != nil {
+		return fmt.Errorf("SubjectServiceOneOf: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// ValueByDiscriminator decodes the union into the member selected by "state".
+func (u SubjectServiceOneOf) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := u.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "disabled":
+		return u.AsCheckbox()
+	case "enabled":
+		return u.AsSubjectForServiceNotificationsCheckbox()
+	}
+	return nil, fmt.Errorf("SubjectServiceOneOf: unknown state %q", discriminator)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u SubjectServiceOneOf) MarshalJSON() ([]byte, error) {
+	if len(u.union) == 0 {
+		return []byte("null"), nil
+	}
+	return u.union, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting unknown "state" values.
+func (u *SubjectServiceOneOf) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		u.union = nil
+		return nil
+	}
+	discriminator, err := unionDiscriminator(data, "state")
+	if err != nil {
+		return fmt.Errorf("SubjectServiceOneOf: %w", err)
+	}
+	switch discriminator {
+	case "disabled", "enabled":
+	default:
+		return fmt.Errorf("SubjectServiceOneOf: unknown state %q", discriminator)
+	}
+	u.union = append(json.RawMessage(nil), data...)
+	return nil
+}
+
+// SysLogFacilityOneOf is a union of Checkbox, CheckboxSysLogFacilityToUseValue, selected by the "state" property.
 type SysLogFacilityOneOf struct {
+	union json.RawMessage
+}
+
+// Discriminator returns the value of the "state" property.
+func (u SysLogFacilityOneOf) Discriminator() (string, error) {
+	return unionDiscriminator(u.union, "state")
+}
+
+// AsCheckbox returns the union data as a Checkbox.
+func (u SysLogFacilityOneOf) AsCheckbox() (Checkbox, error) {
+	var v Checkbox
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromCheckbox sets the union to v, with "state" set to "disabled".
+func (u *SysLogFacilityOneOf) FromCheckbox(v Checkbox) error {
+	data, err := unionFrom(v, "state", "disabled")
+	if err != nil {
+		return fmt.Errorf("SysLogFacilityOneOf: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsCheckboxSysLogFacilityToUseValue returns the union data as a CheckboxSysLogFacilityToUseValue.
+func (u SysLogFacilityOneOf) AsCheckboxSysLogFacilityToUseValue() (CheckboxSysLogFacilityToUseValue, error) {
+	var v CheckboxSysLogFacilityToUseValue
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromCheckboxSysLogFacilityToUseValue sets the union to v, with "state" set to "enabled".
+func (u *SysLogFacilityOneOf) FromCheckboxSysLogFacilityToUseValue(v CheckboxSysLogFacilityToUseValue) error {
+	data, err := unionFrom(v, "state", "enabled")
+	if err != nil {
+		return fmt.Errorf("SysLogFacilityOneOf: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// ValueByDiscriminator decodes the union into the member selected by "state".
+func (u SysLogFacilityOneOf) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := u.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "disabled":
+		return u.AsCheckbox()
+	case "enabled":
+		return u.AsCheckboxSysLogFacilityToUseValue()
+	}
+	return nil, fmt.Errorf("SysLogFacilityOneOf: unknown state %q", discriminator)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u SysLogFacilityOneOf) MarshalJSON() ([]byte, error) {
+	if len(u.union) == 0 {
+		return []byte("null"), nil
+	}
+	return u.union, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting unknown "state" values.
+func (u *SysLogFacilityOneOf) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		u.union = nil
+		return nil
+	}
+	discriminator, err := unionDiscriminator(data, "state")
+	if err != nil {
+		return fmt.Errorf("SysLogFacilityOneOf: %w", err)
+	}
+	switch discriminator {
+	case "disabled", "enabled":
+	default:
+		return fmt.Errorf("SysLogFacilityOneOf: unknown state %q", discriminator)
+	}
+	u.union = append(json.RawMessage(nil), data...)
+	return nil
 }
 
 // SysLogToFromPriorities represents a CheckMK API type.
@@ -5421,8 +13503,90 @@ type SysLogToFromPriorities struct {
 	ToPriority SysLogToFromPrioritiesToPriority `json:"to_priority"`
 }
 
-// TagCondition represents a CheckMK API type.
+// TagCondition is a union of TagConditionScalarSchemaBase, TagConditionConditionSchemaBase, selected by the "operator" property.
 type TagCondition struct {
+	union json.RawMessage
+}
+
+// Discriminator returns the value of the "operator" property.
+func (u TagCondition) Discriminator() (string, error) {
+	return unionDiscriminator(u.union, "operator")
+}
+
+// AsTagConditionScalarSchemaBase returns the union data as a TagConditionScalarSchemaBase.
+func (u TagCondition) AsTagConditionScalarSchemaBase() (TagConditionScalarSchemaBase, error) {
+	var v TagConditionScalarSchemaBase
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromTagConditionScalarSchemaBase sets the union to v, whose "operator" must be one of "is", "is_not".
+func (u *TagCondition) FromTagConditionScalarSchemaBase(v TagConditionScalarSchemaBase) error {
+	data, err := unionFrom(v, "operator", "is", "is_not")
+	if err != nil {
+		return fmt.Errorf("TagCondition: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsTagConditionConditionSchemaBase returns the union data as a TagConditionConditionSchemaBase.
+func (u TagCondition) AsTagConditionConditionSchemaBase() (TagConditionConditionSchemaBase, error) {
+	var v TagConditionConditionSchemaBase
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromTagConditionConditionSchemaBase sets the union to v, whose "operator" must be one of "none_of", "one_of".
+func (u *TagCondition) FromTagConditionConditionSchemaBase(v TagConditionConditionSchemaBase) error {
+	data, err := unionFrom(v, "operator", "none_of", "one_of")
+	if err != nil {
+		return fmt.Errorf("TagCondition: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// ValueByDiscriminator decodes the union into the member selected by "operator".
+func (u TagCondition) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := u.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "is", "is_not":
+		return u.AsTagConditionScalarSchemaBase()
+	case "none_of", "one_of":
+		return u.AsTagConditionConditionSchemaBase()
+	}
+	return nil, fmt.Errorf("TagCondition: unknown operator %q", discriminator)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u TagCondition) MarshalJSON() ([]byte, error) {
+	if len(u.union) == 0 {
+		return []byte("null"), nil
+	}
+	return u.union, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting unknown "operator" values.
+func (u *TagCondition) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		u.union = nil
+		return nil
+	}
+	discriminator, err := unionDiscriminator(data, "operator")
+	if err != nil {
+		return fmt.Errorf("TagCondition: %w", err)
+	}
+	switch discriminator {
+	case "is", "is_not", "none_of", "one_of":
+	default:
+		return fmt.Errorf("TagCondition: unknown operator %q", discriminator)
+	}
+	u.union = append(json.RawMessage(nil), data...)
+	return nil
 }
 
 // TagConditionConditionSchemaBase represents a CheckMK API type.
@@ -5461,16 +13625,262 @@ type TagGroupTag struct {
 	TagType TagGroupTagTagType `json:"tag_type"`
 }
 
-// TagTypeSelector represents a CheckMK API type.
+// TagTypeSelector is a union of AuxHostTag, TagGroupTag, selected by the "tag_type" property.
 type TagTypeSelector struct {
-}
-
-// TheFollowingUsers represents a CheckMK API type.
+	union json.RawMessage
+}
+
+// Discriminator returns the value of the "tag_type" property.
+func (u TagTypeSelector) Discriminator() (string, error) {
+	return unionDiscriminator(u.union, "tag_type")
+}
+
+// AsAuxHostTag returns the union data as a AuxHostTag.
+func (u TagTypeSelector) AsAuxHostTag() (AuxHostTag, error) {
+	var v AuxHostTag
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromAuxHostTag sets the union to v, with "tag_type" set to "aux_tag".
+func (u *TagTypeSelector) FromAuxHostTag(v AuxHostTag) error {
+	data, err := unionFrom(v, "tag_type", "aux_tag")
+	if err != nil {
+		return fmt.Errorf("TagTypeSelector: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsTagGroupTag returns the union data as a TagGroupTag.
+func (u TagTypeSelector) AsTagGroupTag() (TagGroupTag, error) {
+	var v TagGroupTag
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromTagGroupTag sets the union to v, with "tag_type" set to "tag_group".
+func (u *TagTypeSelector) FromTagGroupTag(v TagGroupTag) error {
+	data, err := unionFrom(v, "tag_type", "tag_group")
+	if err != nil {
+		return fmt.Errorf("TagTypeSelector: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// ValueByDiscriminator decodes the union into the member selected by "tag_type".
+func (u TagTypeSelector) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := u.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "aux_tag":
+		return u.AsAuxHostTag()
+	case "tag_group":
+		return u.AsTagGroupTag()
+	}
+	return nil, fmt.Errorf("TagTypeSelector: unknown tag_type %q", discriminator)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u TagTypeSelector) MarshalJSON() ([]byte, error) {
+	if len(u.union) == 0 {
+		return []byte("null"), nil
+	}
+	return u.union, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting unknown "tag_type" values.
+func (u *TagTypeSelector) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		u.union = nil
+		return nil
+	}
+	discriminator, err := unionDiscriminator(data, "tag_type")
+	if err != nil {
+		return fmt.Errorf("TagTypeSelector: %w", err)
+	}
+	switch discriminator {
+	case "aux_tag", "tag_group":
+	default:
+		return fmt.Errorf("TagTypeSelector: unknown tag_type %q", discriminator)
+	}
+	u.union = append(json.RawMessage(nil), data...)
+	return nil
+}
+
+// TheFollowingUsers is a union of Checkbox, CheckboxWithListOfStr, selected by the "state" property.
 type TheFollowingUsers struct {
-}
-
-// ThorttlePeriodicNotificationsCheckbox represents a CheckMK API type.
+	union json.RawMessage
+}
+
+// Discriminator returns the value of the "state" property.
+func (u TheFollowingUsers) Discriminator() (string, error) {
+	return unionDiscriminator(u.union, "state")
+}
+
+// AsCheckbox returns the union data as a Checkbox.
+func (u TheFollowingUsers) AsCheckbox() (Checkbox, error) {
+	var v Checkbox
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromCheckbox sets the union to v, with "state" set to "disabled".
+func (u *TheFollowingUsers) FromCheckbox(v Checkbox) error {
+	data, err := unionFrom(v, "state", "disabled")
+	if err != nil {
+		return fmt.Errorf("TheFollowingUsers: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsCheckboxWithListOfStr returns the union data as a CheckboxWithListOfStr.
+func (u TheFollowingUsers) AsCheckboxWithListOfStr() (CheckboxWithListOfStr, error) {
+	var v CheckboxWithListOfStr
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromCheckboxWithListOfStr sets the union to v, with "state" set to "enabled".
+func (u *TheFollowingUsers) FromCheckboxWithListOfStr(v CheckboxWithListOfStr) error {
+	data, err := unionFrom(v, "state", "enabled")
+	if err != nil {
+		return fmt.Errorf("TheFollowingUsers: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// ValueByDiscriminator decodes the union into the member selected by "state".
+func (u TheFollowingUsers) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := u.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "disabled":
+		return u.AsCheckbox()
+	case "enabled":
+		return u.AsCheckboxWithListOfStr()
+	}
+	return nil, fmt.Errorf("TheFollowingUsers: unknown state %q", discriminator)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u TheFollowingUsers) MarshalJSON() ([]byte, error) {
+	if len(u.union) == 0 {
+		return []byte("null"), nil
+	}
+	return u.union, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting unknown "state" values.
+func (u *TheFollowingUsers) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		u.union = nil
+		return nil
+	}
+	discriminator, err := unionDiscriminator(data, "state")
+	if err != nil {
+		return fmt.Errorf("TheFollowingUsers: %w", err)
+	}
+	switch discriminator {
+	case "disabled", "enabled":
+	default:
+		return fmt.Errorf("TheFollowingUsers: unknown state %q", discriminator)
+	}
+	u.union = append(json.RawMessage(nil), data...)
+	return nil
+}
+
+// ThorttlePeriodicNotificationsCheckbox is a union of Checkbox, CheckboxThrottlePeriodicNotifcations, selected by the "state" property.
 type ThorttlePeriodicNotificationsCheckbox struct {
+	union json.RawMessage
+}
+
+// Discriminator returns the value of the "state" property.
+func (u ThorttlePeriodicNotificationsCheckbox) Discriminator() (string, error) {
+	return unionDiscriminator(u.union, "state")
+}
+
+// AsCheckbox returns the union data as a Checkbox.
+func (u ThorttlePeriodicNotificationsCheckbox) AsCheckbox() (Checkbox, error) {
+	var v Checkbox
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromCheckbox sets the union to v, with "state" set to "disabled".
+func (u *ThorttlePeriodicNotificationsCheckbox) FromCheckbox(v Checkbox) error {
+	data, err := unionFrom(v, "state", "disabled")
+	if err != nil {
+		return fmt.Errorf("ThorttlePeriodicNotificationsCheckbox: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsCheckboxThrottlePeriodicNotifcations returns the union data as a CheckboxThrottlePeriodicNotifcations.
+func (u ThorttlePeriodicNotificationsCheckbox) AsCheckboxThrottlePeriodicNotifcations() (CheckboxThrottlePeriodicNotifcations, error) {
+	var v CheckboxThrottlePeriodicNotifcations
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromCheckboxThrottlePeriodicNotifcations sets the union to v, with "state" set to "enabled".
+func (u *ThorttlePeriodicNotificationsCheckbox) FromCheckboxThrottlePeriodicNotifcations(v CheckboxThrottlePeriodicNotifcations) error {
+	data, err := unionFrom(v, "state", "enabled")
+	if err != nil {
+		return fmt.Errorf("ThorttlePeriodicNotificationsCheckbox: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// ValueByDiscriminator decodes the union into the member selected by "state".
+func (u ThorttlePeriodicNotificationsCheckbox) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := u.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "disabled":
+		return u.AsCheckbox()
+	case "enabled":
+		return u.AsCheckboxThrottlePeriodicNotifcations()
+	}
+	return nil, fmt.Errorf("ThorttlePeriodicNotificationsCheckbox: unknown state %q", discriminator)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u ThorttlePeriodicNotificationsCheckbox) MarshalJSON() ([]byte, error) {
+	if len(u.union) == 0 {
+		return []byte("null"), nil
+	}
+	return u.union, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting unknown "state" values.
+func (u *ThorttlePeriodicNotificationsCheckbox) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		u.union = nil
+		return nil
+	}
+	discriminator, err := unionDiscriminator(data, "state")
+	if err != nil {
+		return fmt.Errorf("ThorttlePeriodicNotificationsCheckbox: %w", err)
+	}
+	switch discriminator {
+	case "disabled", "enabled":
+	default:
+		return fmt.Errorf("ThorttlePeriodicNotificationsCheckbox: unknown state %q", discriminator)
+	}
+	u.union = append(json.RawMessage(nil), data...)
+	return nil
 }
 
 // ThrottlePeriodicNotifications represents a CheckMK API type.
@@ -5493,7 +13903,7 @@ type TimeAllowedRange struct {
 
 // TimePeriod represents a CheckMK API type.
 type TimePeriod struct {
-	Params map[string]interface{} `json:"params"`
+	Params NotificationBulkingTimePeriod `json:"params"`
 	// Bulking can always happen or during a set time period
 	// Example: always
 	WhenToBulk TimePeriodWhenToBulk `json:"when_to_bulk"`
@@ -5503,13 +13913,13 @@ type TimePeriod struct {
 type TimePeriodAttrsResponse struct {
 	// The days for which time ranges were specified
 	// Example: map[day:all time_ranges:[map[end:14:00 start:12:00]]]
-	ActiveTimeRanges []map[string]interface{} `json:"active_time_ranges,omitempty"`
+	ActiveTimeRanges []ConcreteTimeRangeActive `json:"active_time_ranges,omitempty"`
 	// The alias of the time period
 	// Example: alias
 	Alias string `json:"alias,omitempty"`
 	// Specific day exclusions with their list of time ranges
 	// Example: [map[date:2020-01-01 time_ranges:[map[end:18:00 start:14:00]]]]
-	Exceptions []map[string]interface{} `json:"exceptions,omitempty"`
+	Exceptions []ConcreteTimePeriodException `json:"exceptions,omitempty"`
 	// The collection of time period names whose periods are excluded
 	// Example: [time_period_1 time_period_2 time_period_3]
 	Exclude []string `json:"exclude,omitempty"`
@@ -5520,11 +13930,93 @@ type TimePeriodException struct {
 	// The date of the time period exception.8601 profile
 	// Example: 2020-01-01
 	Date string `json:"date"`
-	TimeRanges []map[string]interface{} `json:"time_ranges,omitempty"`
+	TimeRanges []TimeRange1 `json:"time_ranges,omitempty"`
 }
 
-// TimePeriodOneOf represents a CheckMK API type.
+// TimePeriodOneOf is a union of Checkbox, OutsideTimeperiodValue, selected by the "state" property.
 type TimePeriodOneOf struct {
+	union json.RawMessage
+}
+
+// Discriminator returns the value of the "state" property.
+func (u TimePeriodOneOf) Discriminator() (string, error) {
+	return unionDiscriminator(u.union, "state")
+}
+
+// AsCheckbox returns the union data as a Checkbox.
+func (u TimePeriodOneOf) AsCheckbox() (Checkbox, error) {
+	var v Checkbox
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromCheckbox sets the union to v, with "state" set to "disabled".
+func (u *TimePeriodOneOf) FromCheckbox(v Checkbox) error {
+	data, err := unionFrom(v, "state", "disabled")
+	if err != nil {
+		return fmt.Errorf("TimePeriodOneOf: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsOutsideTimeperiodValue returns the union data as a OutsideTimeperiodValue.
+func (u TimePeriodOneOf) AsOutsideTimeperiodValue() (OutsideTimeperiodValue, error) {
+	var v OutsideTimeperiodValue
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromOutsideTimeperiodValue sets the union to v, with "state" set to "enabled".
+func (u *TimePeriodOneOf) FromOutsideTimeperiodValue(v OutsideTimeperiodValue) error {
+	data, err := unionFrom(v, "state", "enabled")
+	if err != nil {
+		return fmt.Errorf("TimePeriodOneOf: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// ValueByDiscriminator decodes the union into the member selected by "state".
+func (u TimePeriodOneOf) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := u.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "disabled":
+		return u.AsCheckbox()
+	case "enabled":
+		return u.AsOutsideTimeperiodValue()
+	}
+	return nil, fmt.Errorf("TimePeriodOneOf: unknown state %q", discriminator)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u TimePeriodOneOf) MarshalJSON() ([]byte, error) {
+	if len(u.union) == 0 {
+		return []byte("null"), nil
+	}
+	return u.union, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting unknown "state" values.
+func (u *TimePeriodOneOf) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		u.union = nil
+		return nil
+	}
+	discriminator, err := unionDiscriminator(data, "state")
+	if err != nil {
+		return fmt.Errorf("TimePeriodOneOf: %w", err)
+	}
+	switch discriminator {
+	case "disabled", "enabled":
+	default:
+		return fmt.Errorf("TimePeriodOneOf: unknown state %q", discriminator)
+	}
+	u.union = append(json.RawMessage(nil), data...)
+	return nil
 }
 
 // TimePeriodResponse represents a CheckMK API type.
@@ -5533,12 +14025,12 @@ type TimePeriodResponse struct {
 	DomainType interface{} `json:"domainType,omitempty"`
 	// The time period attributes.
 	// Example: map[active_time_ranges:[map[day:monday time_ranges:[map[end:15:00 start:12:00]]]] alias:holidays exceptions:[map[date:2023-01-01 time_ranges:[map[end:13:30 start:12:30]]]] exclude:[time_period_1 time_period_2 time_period_3]]
-	Extensions interface{} `json:"extensions,omitempty"`
+	Extensions *TimePeriodAttrsResponse `json:"extensions,omitempty"`
 	// The unique identifier for this time period.
 	// Example: time_period_name
 	Id string `json:"id,omitempty"`
 	// list of links to other resources.
-	Links []map[string]interface{} `json:"links"`
+	Links []Link `json:"links"`
 	// The container for external resources, like linked foreign objects or actions.
 	Members map[string]interface{} `json:"members,omitempty"`
 	// The time period name.
@@ -5555,12 +14047,12 @@ type TimePeriodResponseCollection struct {
 	// The name of this collection.
 	Id string `json:"id,omitempty"`
 	// list of links to other resources.
-	Links []map[string]interface{} `json:"links"`
+	Links []Link `json:"links"`
 	// A human readable title of this object. Can be used for user interfaces.
 	Title string `json:"title,omitempty"`
 	// A list of time period objects.
 	// Example: [map[domainType:time_period extensions:map[active_time_ranges:[map[day:monday time_ranges:[map[end:15:00 start:12:00]]]] alias:holidays exceptions:[map[date:2023-01-01 time_ranges:[map[end:13:30 start:12:30]]]] exclude:[time_period_1 time_period_2 time_period_3]] id:time_period links:[] members:m...
-	Value []map[string]interface{} `json:"value,omitempty"`
+	Value []TimePeriodResponse `json:"value,omitempty"`
 }
 
 // TimeRange represents a CheckMK API type.
@@ -5587,7 +14079,7 @@ type TimeRange1 struct {
 type TimeRangeActive struct {
 	// The day for which time ranges are to be specified. The 'all' option allows to specify time ranges for all days.
 	Day TimeRangeActiveDay `json:"day,omitempty"`
-	TimeRanges []map[string]interface{} `json:"time_ranges,omitempty"`
+	TimeRanges []TimeRange1 `json:"time_ranges,omitempty"`
 }
 
 // ToEmailAndNameCheckbox represents a CheckMK API type.
@@ -5596,7 +14088,7 @@ type ToEmailAndNameCheckbox struct {
 	// Example: enabled
 	State ToEmailAndNameCheckboxState `json:"state"`
 	// The email address and visible name used in the Reply-To header of notifications messages
-	Value interface{} `json:"value,omitempty"`
+	Value *EmailAndDisplayName `json:"value,omitempty"`
 }
 
 // TranslateNames represents a CheckMK API type.
@@ -5606,17 +14098,181 @@ type TranslateNames struct {
 	// Drop the rest of the domain, only keep the hostname. Will not affect IP addresses. Examples: * `192.168.0.1` -> `192.168.0.1` * `foobar.example.com` -> `foobar` * `example.com` -> `example` * `example` -> `example` This will be executed **after**: * `convert_case`
 	DropDomain bool `json:"drop_domain,omitempty"`
 	// Replace one value with another. These will be executed **after**: * `convert_case` * `drop_domain` * `regexp_rewrites`
-	HostnameReplacement []map[string]interface{} `json:"hostname_replacement,omitempty"`
+	HostnameReplacement []DirectMapping `json:"hostname_replacement,omitempty"`
 	// Rewrite discovered hostnames with multiple regular expressions. The replacements will be done one after another in the order they appear in the list. If not anchored at the end by a `$` character, the regexpwill be anchored at the end implicitly by adding a `$` character. These will be executed *...
-	RegexpRewrites []map[string]interface{} `json:"regexp_rewrites,omitempty"`
+	RegexpRewrites []RegexpRewrites `json:"regexp_rewrites,omitempty"`
 }
 
-// TypeStateOneOf represents a CheckMK API type.
+// TypeStateOneOf is a union of Checkbox, CheckboxWithManagementTypeStateIncedentValues, selected by the "state" property.
 type TypeStateOneOf struct {
-}
-
-// TypeUrgencyOneOf represents a CheckMK API type.
+	union json.RawMessage
+}
+
+// Discriminator returns the value of the "state" property.
+func (u TypeStateOneOf) Discriminator() (string, error) {
+	return unionDiscriminator(u.union, "state")
+}
+
+// AsCheckbox returns the union data as a Checkbox.
+func (u TypeStateOneOf) AsCheckbox() (Checkbox, error) {
+	var v Checkbox
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromCheckbox sets the union to v, with "state" set to "disabled".
+func (u *TypeStateOneOf) FromCheckbox(v Checkbox) error {
+	data, err := unionFrom(v, "state", "disabled")
+	if err != nil {
+		return fmt.Errorf("TypeStateOneOf: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsCheckboxWithManagementTypeStateIncedentValues returns the union data as a CheckboxWithManagementTypeStateIncedentValues.
+func (u TypeStateOneOf) AsCheckboxWithManagementTypeStateIncedentValues() (CheckboxWithManagementTypeStateIncedentValues, error) {
+	var v CheckboxWithManagementTypeStateIncedentValues
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromCheckboxWithManagementTypeStateIncedentValues sets the union to v, with "state" set to "enabled".
+func (u *TypeStateOneOf) FromCheckboxWithManagementTypeStateIncedentValues(v CheckboxWithManagementTypeStateIncedentValues) error {
+	data, err := unionFrom(v, "state", "enabled")
+	if err != nil {
+		return fmt.Errorf("TypeStateOneOf: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// ValueByDiscriminator decodes the union into the member selected by "state".
+func (u TypeStateOneOf) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := u.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "disabled":
+		return u.AsCheckbox()
+	case "enabled":
+		return u.AsCheckboxWithManagementTypeStateIncedentValues()
+	}
+	return nil, fmt.Errorf("TypeStateOneOf: unknown state %q", discriminator)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u TypeStateOneOf) MarshalJSON() ([]byte, error) {
+	if len(u.union) == 0 {
+		return []byte("null"), nil
+	}
+	return u.union, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting unknown "state" values.
+func (u *TypeStateOneOf) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		u.union = nil
+		return nil
+	}
+	discriminator, err := unionDiscriminator(data, "state")
+	if err != nil {
+		return fmt.Errorf("TypeStateOneOf: %w", err)
+	}
+	switch discriminator {
+	case "disabled", "enabled":
+	default:
+		return fmt.Errorf("TypeStateOneOf: unknown state %q", discriminator)
+	}
+	u.union = append(json.RawMessage(nil), data...)
+	return nil
+}
+
+// TypeUrgencyOneOf is a union of Checkbox, CheckboxWithMgmtTypeUrgencyValue, selected by the "state" property.
 type TypeUrgencyOneOf struct {
+	union json.RawMessage
+}
+
+// Discriminator returns the value of the "state" property.
+func (u TypeUrgencyOneOf) Discriminator() (string, error) {
+	return unionDiscriminator(u.union, "state")
+}
+
+// AsCheckbox returns the union data as a Checkbox.
+func (u TypeUrgencyOneOf) AsCheckbox() (Checkbox, error) {
+	var v Checkbox
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromCheckbox sets the union to v, with "state" set to "disabled".
+func (u *TypeUrgencyOneOf) FromCheckbox(v Checkbox) error {
+	data, err := unionFrom(v, "state", "disabled")
+	if err != nil {
+		return fmt.Errorf("TypeUrgencyOneOf: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsCheckboxWithMgmtTypeUrgencyValue returns the union data as a CheckboxWithMgmtTypeUrgencyValue.
+func (u TypeUrgencyOneOf) AsCheckboxWithMgmtTypeUrgencyValue() (CheckboxWithMgmtTypeUrgencyValue, error) {
+	var v CheckboxWithMgmtTypeUrgencyValue
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromCheckboxWithMgmtTypeUrgencyValue sets the union to v, with "state" set to "enabled".
+func (u *TypeUrgencyOneOf) FromCheckboxWithMgmtTypeUrgencyValue(v CheckboxWithMgmtTypeUrgencyValue) error {
+	data, err := unionFrom(v, "state", "enabled")
+	if err != nil {
+		return fmt.Errorf("TypeUrgencyOneOf: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// ValueByDiscriminator decodes the union into the member selected by "state".
+func (u TypeUrgencyOneOf) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := u.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "disabled":
+		return u.AsCheckbox()
+	case "enabled":
+		return u.AsCheckboxWithMgmtTypeUrgencyValue()
+	}
+	return nil, fmt.Errorf("TypeUrgencyOneOf: unknown state %q", discriminator)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u TypeUrgencyOneOf) MarshalJSON() ([]byte, error) {
+	if len(u.union) == 0 {
+		return []byte("null"), nil
+	}
+	return u.union, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting unknown "state" values.
+func (u *TypeUrgencyOneOf) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		u.union = nil
+		return nil
+	}
+	discriminator, err := unionDiscriminator(data, "state")
+	if err != nil {
+		return fmt.Errorf("TypeUrgencyOneOf: %w", err)
+	}
+	switch discriminator {
+	case "disabled", "enabled":
+	default:
+		return fmt.Errorf("TypeUrgencyOneOf: unknown state %q", discriminator)
+	}
+	u.union = append(json.RawMessage(nil), data...)
+	return nil
 }
 
 // UpdateAndAcknowledeEventSiteIDRequired represents a CheckMK API type.
@@ -5654,8 +14310,109 @@ type UpdateAndAcknowledgeFilter struct {
 	SiteId string `json:"site_id,omitempty"`
 }
 
-// UpdateAndAcknowledgeSelector represents a CheckMK API type.
+// UpdateAndAcknowledgeSelector is a union of UpdateAndAcknowledgeWithQuery, UpdateAndAcknowledgeWithParams, UpdateAndAcknowledgeFilter, selected by the "filter_type" property.
 type UpdateAndAcknowledgeSelector struct {
+	union json.RawMessage
+}
+
+// Discriminator returns the value of the "filter_type" property.
+func (u UpdateAndAcknowledgeSelector) Discriminator() (string, error) {
+	return unionDiscriminator(u.union, "filter_type")
+}
+
+// AsUpdateAndAcknowledgeWithQuery returns the union data as a UpdateAndAcknowledgeWithQuery.
+func (u UpdateAndAcknowledgeSelector) AsUpdateAndAcknowledgeWithQuery() (UpdateAndAcknowledgeWithQuery, error) {
+	var v UpdateAndAcknowledgeWithQuery
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromUpdateAndAcknowledgeWithQuery sets the union to v, with "filter_type" set to "query".
+func (u *UpdateAndAcknowledgeSelector) FromUpdateAndAcknowledgeWithQuery(v UpdateAndAcknowledgeWithQuery) error {
+	data, err := unionFrom(v, "filter_type", "query")
+	if err != nil {
+		return fmt.Errorf("UpdateAndAcknowledgeSelector: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsUpdateAndAcknowledgeWithParams returns the union data as a UpdateAndAcknowledgeWithParams.
+func (u UpdateAndAcknowledgeSelector) AsUpdateAndAcknowledgeWithParams() (UpdateAndAcknowledgeWithParams, error) {
+	var v UpdateAndAcknowledgeWithParams
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromUpdateAndAcknowledgeWithParams sets the union to v, with "filter_type" set to "params".
+func (u *UpdateAndAcknowledgeSelector) FromUpdateAndAcknowledgeWithParams(v UpdateAndAcknowledgeWithParams) error {
+	data, err := unionFrom(v, "filter_type", "params")
+	if err != nil {
+		return fmt.Errorf("UpdateAndAcknowledgeSelector: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsUpdateAndAcknowledgeFilter returns the union data as a UpdateAndAcknowledgeFilter.
+func (u UpdateAndAcknowledgeSelector) AsUpdateAndAcknowledgeFilter() (UpdateAndAcknowledgeFilter, error) {
+	var v UpdateAndAcknowledgeFilter
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromUpdateAndAcknowledgeFilter sets the union to v, with "filter_type" set to "all".
+func (u *UpdateAndAcknowledgeSelector) FromUpdateAndAcknowledgeFilter(v UpdateAndAcknowledgeFilter) error {
+	data, err := unionFrom(v, "filter_type", "all")
+	if err != nil {
+		return fmt.Errorf("UpdateAndAcknowledgeSelector: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// ValueByDiscriminator decodes the union into the member selected by "filter_type".
+func (u UpdateAndAcknowledgeSelector) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := u.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "query":
+		return u.AsUpdateAndAcknowledgeWithQuery()
+	case "params":
+		return u.AsUpdateAndAcknowledgeWithParams()
+	case "all":
+		return u.AsUpdateAndAcknowledgeFilter()
+	}
+	return nil, fmt.Errorf("UpdateAndAcknowledgeSelector: unknown filter_type %q", discriminator)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u UpdateAndAcknowledgeSelector) MarshalJSON() ([]byte, error) {
+	if len(u.union) == 0 {
+		return []byte("null"), nil
+	}
+	return u.union, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting unknown "filter_type" values.
+func (u *UpdateAndAcknowledgeSelector) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		u.union = nil
+		return nil
+	}
+	discriminator, err := unionDiscriminator(data, "filter_type")
+	if err != nil {
+		return fmt.Errorf("UpdateAndAcknowledgeSelector: %w", err)
+	}
+	switch discriminator {
+	case "query", "params", "all":
+	default:
+		return fmt.Errorf("UpdateAndAcknowledgeSelector: unknown filter_type %q", discriminator)
+	}
+	u.union = append(json.RawMessage(nil), data...)
+	return nil
 }
 
 // UpdateAndAcknowledgeWithParams represents a CheckMK API type.
@@ -5669,7 +14426,7 @@ type UpdateAndAcknowledgeWithParams struct {
 	// The way you would like to filter events.
 	// Example: all
 	FilterType UpdateAndAcknowledgeWithParamsFilterType `json:"filter_type"`
-	Filters map[string]interface{} `json:"filters"`
+	Filters FilterParamsUpdateAndAcknowledge `json:"filters"`
 	// To change the phase of an event
 	// Example: ack
 	Phase UpdateAndAcknowledgeWithParamsPhase `json:"phase,omitempty"`
@@ -5694,7 +14451,7 @@ type UpdateAndAcknowledgeWithQuery struct {
 	Phase UpdateAndAcknowledgeWithQueryPhase `json:"phase,omitempty"`
 	// An query expression of the Livestatus 'eventconsoleevents' table in nested dictionary form. If you want to use multiple expressions, nest them with the AND/OR operators.
 	// Example: {"op": "=", "left": "eventconsoleevents.event_host", "right": "test_host"}
-	Query interface{} `json:"query"`
+	Query Expr `json:"query"`
 	// An existing site id
 	// Example: heute
 	SiteId string `json:"site_id,omitempty"`
@@ -5702,7 +14459,7 @@ type UpdateAndAcknowledgeWithQuery struct {
 
 // UpdateContactGroup represents a CheckMK API type.
 type UpdateContactGroup struct {
-	Attributes map[string]interface{} `json:"attributes,omitempty"`
+	Attributes *UpdateGroup `json:"attributes,omitempty"`
 	// The name of the contact group.
 	// Example: OnCall
 	Name string `json:"name"`
@@ -5784,7 +14541,7 @@ type UpdateHostEntry struct {
 
 // UpdateHostGroup represents a CheckMK API type.
 type UpdateHostGroup struct {
-	Attributes map[string]interface{} `json:"attributes,omitempty"`
+	Attributes *UpdateGroup1 `json:"attributes,omitempty"`
 	// The name of the host group.
 	// Example: windows
 	Name string `json:"name"`
@@ -5800,7 +14557,7 @@ type UpdateHostTagGroup struct {
 	Repair bool `json:"repair,omitempty"`
 	// A list of host tags belonging to the host tag group
 	// Example: [map[ident:pod title:Pod]]
-	Tags []map[string]interface{} `json:"tags,omitempty"`
+	Tags []HostTag `json:"tags,omitempty"`
 	// A title for the host tag
 	// Example: Kubernetes
 	Title string `json:"title,omitempty"`
@@ -5842,10 +14599,10 @@ type UpdatePassword struct {
 type UpdateRuleObject struct {
 	// Conditions.
 	// Example: map[]
-	Conditions interface{} `json:"conditions,omitempty"`
+	Conditions *RuleConditions1 `json:"conditions,omitempty"`
 	// Configuration values for rules.
 	// Example: map[disabled:false]
-	Properties interface{} `json:"properties,omitempty"`
+	Properties *RuleProperties1 `json:"properties,omitempty"`
 	// The raw parameter value for this rule. To create the correct structure, for now use the 'export for API' menu item in the Rule Editor of the GUI. The value is expected to be a valid Python type.
 	// Example: {'cmk/os_family': 'linux'}
 	ValueRaw string `json:"value_raw,omitempty"`
@@ -5853,7 +14610,7 @@ type UpdateRuleObject struct {
 
 // UpdateServiceGroup represents a CheckMK API type.
 type UpdateServiceGroup struct {
-	Attributes map[string]interface{} `json:"attributes,omitempty"`
+	Attributes *UpdateGroup2 `json:"attributes,omitempty"`
 	// The name of the service group.
 	// Example: windows
 	Name string `json:"name"`
@@ -5863,13 +14620,13 @@ type UpdateServiceGroup struct {
 type UpdateTimePeriod struct {
 	// The list of active time ranges which replaces the existing list of time ranges
 	// Example: [map[day:monday time_ranges:[map[end:14:00:00 start:12:00:00]]]]
-	ActiveTimeRanges []map[string]interface{} `json:"active_time_ranges,omitempty"`
+	ActiveTimeRanges []TimeRangeActive `json:"active_time_ranges,omitempty"`
 	// An alias for the time period
 	// Example: new_alias
 	Alias string `json:"alias,omitempty"`
 	// A list of additional time ranges to be added.
 	// Example: [map[date:2020-01-01 time_ranges:[map[end:18:00:00 start:14:00:00]]]]
-	Exceptions []map[string]interface{} `json:"exceptions,omitempty"`
+	Exceptions []TimePeriodException `json:"exceptions,omitempty"`
 	// A list of time period names whose periods are excluded.
 	// Example: [time_name]
 	Exclude []string `json:"exclude,omitempty"`
@@ -5879,27 +14636,27 @@ type UpdateTimePeriod struct {
 type UpdateUser struct {
 	// Authentication option for the user
 	// Example: map[auth_type:password password:password]
-	AuthOption interface{} `json:"auth_option,omitempty"`
+	AuthOption *AuthUpdateOption `json:"auth_option,omitempty"`
 	// The names of the sites the user is authorized to handle. Specifying 'all' will grant the user access to all sites.
 	// Example: [heute]
 	AuthorizedSites []string `json:"authorized_sites,omitempty"`
 	// Contact settings for the user
 	// Example: map[email:user@example.com]
-	ContactOptions interface{} `json:"contact_options,omitempty"`
+	ContactOptions *UserContactOption `json:"contact_options,omitempty"`
 	// Assign the user to one or multiple contact groups. If no contact group is specified then no monitoring contact will be created for the user.
 	// Example: [all]
 	Contactgroups []string `json:"contactgroups,omitempty"`
 	// The user can be blocked from login but will remain part of the site. The disabling does not affect notification and alerts.
 	// Example: false
 	DisableLogin bool `json:"disable_login,omitempty"`
-	DisableNotifications interface{} `json:"disable_notifications,omitempty"`
+	DisableNotifications *DisabledNotifications `json:"disable_notifications,omitempty"`
 	// The alias or full name of the user
 	// Example: Mathias Kettner
 	Fullname string `json:"fullname,omitempty"`
 	// Idle timeout for the user
 	// Example: map[]
-	IdleTimeout interface{} `json:"idle_timeout,omitempty"`
-	InterfaceOptions interface{} `json:"interface_options,omitempty"`
+	IdleTimeout *IdleOption `json:"idle_timeout,omitempty"`
+	InterfaceOptions *UserInterfaceUpdateAttributes `json:"interface_options,omitempty"`
 	// Configure the language to be used by the user in the user interface. Omitting this will configure the default language
 	// Example: en
 	Language UpdateUserLanguage `json:"language,omitempty"`
@@ -5912,8 +14669,90 @@ type UpdateUser struct {
 	TemperatureUnit UpdateUserTemperatureUnit `json:"temperature_unit,omitempty"`
 }
 
-// UrlPrefixOneOf represents a CheckMK API type.
+// UrlPrefixOneOf is a union of Checkbox, CheckMKURLPrefixValue, selected by the "state" property.
 type UrlPrefixOneOf struct {
+	union json.RawMessage
+}
+
+// Discriminator returns the value of the "state" property.
+func (u UrlPrefixOneOf) Discriminator() (string, error) {
+	return unionDiscriminator(u.union, "state")
+}
+
+// AsCheckbox returns the union data as a Checkbox.
+func (u UrlPrefixOneOf) AsCheckbox() (Checkbox, error) {
+	var v Checkbox
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromCheckbox sets the union to v, with "state" set to "disabled".
+func (u *UrlPrefixOneOf) FromCheckbox(v Checkbox) error {
+	data, err := unionFrom(v, "state", "disabled")
+	if err != nil {
+		return fmt.Errorf("UrlPrefixOneOf: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsCheckMKURLPrefixValue returns the union data as a CheckMKURLPrefixValue.
+func (u UrlPrefixOneOf) AsCheckMKURLPrefixValue() (CheckMKURLPrefixValue, error) {
+	var v CheckMKURLPrefixValue
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromCheckMKURLPrefixValue sets the union to v, with "state" set to "enabled".
+func (u *UrlPrefixOneOf) FromCheckMKURLPrefixValue(v CheckMKURLPrefixValue) error {
+	data, err := unionFrom(v, "state", "enabled")
+	if err != nil {
+		return fmt.Errorf("UrlPrefixOneOf: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// ValueByDiscriminator decodes the union into the member selected by "state".
+func (u UrlPrefixOneOf) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := u.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "disabled":
+		return u.AsCheckbox()
+	case "enabled":
+		return u.AsCheckMKURLPrefixValue()
+	}
+	return nil, fmt.Errorf("UrlPrefixOneOf: unknown state %q", discriminator)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u UrlPrefixOneOf) MarshalJSON() ([]byte, error) {
+	if len(u.union) == 0 {
+		return []byte("null"), nil
+	}
+	return u.union, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting unknown "state" values.
+func (u *UrlPrefixOneOf) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		u.union = nil
+		return nil
+	}
+	discriminator, err := unionDiscriminator(data, "state")
+	if err != nil {
+		return fmt.Errorf("UrlPrefixOneOf: %w", err)
+	}
+	switch discriminator {
+	case "disabled", "enabled":
+	default:
+		return fmt.Errorf("UrlPrefixOneOf: unknown state %q", discriminator)
+	}
+	u.union = append(json.RawMessage(nil), data...)
+	return nil
 }
 
 // UseLiveStatusDaemon represents a CheckMK API type.
@@ -5932,11 +14771,11 @@ type UserCollection struct {
 	// The name of this collection.
 	Id string `json:"id,omitempty"`
 	// list of links to other resources.
-	Links []map[string]interface{} `json:"links"`
+	Links []Link `json:"links"`
 	// A human readable title of this object. Can be used for user interfaces.
 	Title string `json:"title,omitempty"`
 	// A list of user objects.
-	Value []map[string]interface{} `json:"value,omitempty"`
+	Value []UserObject `json:"value,omitempty"`
 }
 
 // UserContactOption represents a CheckMK API type.
@@ -5996,7 +14835,7 @@ type UserObject struct {
 	// The unique identifier for this domain-object type.
 	Id string `json:"id,omitempty"`
 	// list of links to other resources.
-	Links []map[string]interface{} `json:"links"`
+	Links []Link `json:"links"`
 	// The container for external resources, like linked foreign objects or actions.
 	Members map[string]interface{} `json:"members,omitempty"`
 	// A human readable title of this object. Can be used for user interfaces.
@@ -6024,11 +14863,11 @@ type UserRoleCollection struct {
 	// The name of this collection.
 	Id string `json:"id,omitempty"`
 	// list of links to other resources.
-	Links []map[string]interface{} `json:"links"`
+	Links []Link `json:"links"`
 	// A human readable title of this object. Can be used for user interfaces.
 	Title string `json:"title,omitempty"`
 	// A list of user role objects.
-	Value []map[string]interface{} `json:"value,omitempty"`
+	Value []UserRoleObject `json:"value,omitempty"`
 }
 
 // UserRoleObject represents a CheckMK API type.
@@ -6036,19 +14875,101 @@ type UserRoleObject struct {
 	// The domain type of the object.
 	DomainType interface{} `json:"domainType,omitempty"`
 	// All the attributes of a user role.
-	Extensions interface{} `json:"extensions,omitempty"`
+	Extensions *UserRoleAttributes `json:"extensions,omitempty"`
 	// The unique identifier for this domain-object type.
 	Id string `json:"id,omitempty"`
 	// list of links to other resources.
-	Links []map[string]interface{} `json:"links"`
+	Links []Link `json:"links"`
 	// The container for external resources, like linked foreign objects or actions.
 	Members map[string]interface{} `json:"members,omitempty"`
 	// A human readable title of this object. Can be used for user interfaces.
 	Title string `json:"title,omitempty"`
 }
 
-// UserSyncAttributes represents a CheckMK API type.
+// UserSyncAttributes is a union of UserSyncWithLdapConnection, UserSyncBase, selected by the "sync_with_ldap_connections" property.
 type UserSyncAttributes struct {
+	union json.RawMessage
+}
+
+// Discriminator returns the value of the "sync_with_ldap_connections" property.
+func (u UserSyncAttributes) Discriminator() (string, error) {
+	return unionDiscriminator(u.union, "sync_with_ldap_connections")
+}
+
+// AsUserSyncWithLdapConnection returns the union data as a UserSyncWithLdapConnection.
+func (u UserSyncAttributes) AsUserSyncWithLdapConnection() (UserSyncWithLdapConnection, error) {
+	var v UserSyncWithLdapConnection
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromUserSyncWithLdapConnection sets the union to v, with "sync_with_ldap_connections" set to "ldap".
+func (u *UserSyncAttributes) FromUserSyncWithLdapConnection(v UserSyncWithLdapConnection) error {
+	data, err := unionFrom(v, "sync_with_ldap_connections", "ldap")
+	if err != nil {
+		return fmt.Errorf("UserSyncAttributes: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// AsUserSyncBase returns the union data as a UserSyncBase.
+func (u UserSyncAttributes) AsUserSyncBase() (UserSyncBase, error) {
+	var v UserSyncBase
+	err := json.Unmarshal(u.union, &v)
+	return v, err
+}
+
+// FromUserSyncBase sets the union to v, whose "sync_with_ldap_connections" must be one of "all", "disabled".
+func (u *UserSyncAttributes) FromUserSyncBase(v UserSyncBase) error {
+	data, err := unionFrom(v, "sync_with_ldap_connections", "all", "disabled")
+	if err != nil {
+		return fmt.Errorf("UserSyncAttributes: %w", err)
+	}
+	u.union = data
+	return nil
+}
+
+// ValueByDiscriminator decodes the union into the member selected by "sync_with_ldap_connections".
+func (u UserSyncAttributes) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := u.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "ldap":
+		return u.AsUserSyncWithLdapConnection()
+	case "all", "disabled":
+		return u.AsUserSyncBase()
+	}
+	return nil, fmt.Errorf("UserSyncAttributes: unknown sync_with_ldap_connections %q", discriminator)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u UserSyncAttributes) MarshalJSON() ([]byte, error) {
+	if len(u.union) == 0 {
+		return []byte("null"), nil
+	}
+	return u.union, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting unknown "sync_with_ldap_connections" values.
+func (u *UserSyncAttributes) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		u.union = nil
+		return nil
+	}
+	discriminator, err := unionDiscriminator(data, "sync_with_ldap_connections")
+	if err != nil {
+		return fmt.Errorf("UserSyncAttributes: %w", err)
+	}
+	switch discriminator {
+	case "ldap", "all", "disabled":
+	default:
+		return fmt.Errorf("UserSyncAttributes: unknown sync_with_ldap_connections %q", discriminator)
+	}
+	u.union = append(json.RawMessage(nil), data...)
+	return nil
 }
 
 // UserSyncAttributes1 represents a CheckMK API type.
@@ -6081,19 +15002,19 @@ type UserSyncWithLdapConnection struct {
 // VictoropsPluginCreate represents a CheckMK API type.
 type VictoropsPluginCreate struct {
 	// Ignore unverified HTTPS request warnings. Use with caution.
-	DisableSslCertVerification interface{} `json:"disable_ssl_cert_verification"`
+	DisableSslCertVerification Checkbox `json:"disable_ssl_cert_verification"`
 	// Use the proxy settings from the environment variables. The variables NO_PROXY, HTTP_PROXY and HTTPS_PROXY are taken into account during execution.
-	HttpProxy interface{} `json:"http_proxy"`
+	HttpProxy HttpProxyOneOf `json:"http_proxy"`
 	// The plugin name.
 	// Example: mail
 	PluginName VictoropsPluginCreatePluginName `json:"plugin_name"`
-	SplunkOnCallRestEndpoint map[string]interface{} `json:"splunk_on_call_rest_endpoint"`
-	UrlPrefixForLinksToCheckmk map[string]interface{} `json:"url_prefix_for_links_to_checkmk"`
+	SplunkOnCallRestEndpoint SplunkRESTEndpointSelector `json:"splunk_on_call_rest_endpoint"`
+	UrlPrefixForLinksToCheckmk UrlPrefixOneOf `json:"url_prefix_for_links_to_checkmk"`
 }
 
 // WhenToBulk represents a CheckMK API type.
 type WhenToBulk struct {
-	Params map[string]interface{} `json:"params"`
+	Params NotificationBulking `json:"params"`
 	// Bulking can always happen or during a set time period
 	// Example: always
 	WhenToBulk WhenToBulkWhenToBulk `json:"when_to_bulk"`
@@ -6112,3 +15033,46 @@ type X509ReqPEMUUID struct {
 	Csr string `json:"csr"`
 }
 
+// unionDiscriminator reads a string discriminator property from a JSON object.
+func unionDiscriminator(data json.RawMessage, property string) (string, error) {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return "", err
+	}
+	raw, ok := fields[property]
+	if !ok {
+		return "", fmt.Errorf("missing discriminator %q", property)
+	}
+	var value string
+	if err := json.Unmarshal(raw, &value); err != nil {
+		return "", fmt.Errorf("discriminator %q: %w", property, err)
+	}
+	return value, nil
+}
+
+// unionFrom encodes a union member, setting its discriminator property.
+// A member selected by several values must already carry one of them.
+func unionFrom(v interface{}, property string, values ...string) (json.RawMessage, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+	if len(values) == 1 {
+		fields[property], _ = json.Marshal(values[0])
+		return json.Marshal(fields)
+	}
+	current, err := unionDiscriminator(data, property)
+	if err != nil {
+		return nil, err
+	}
+	for _, value := range values {
+		if current == value {
+			return data, nil
+		}
+	}
+	return nil, fmt.Errorf("discriminator %q must be one of %q, got %q", property, values, current)
+}
@@ -2,13 +2,15 @@
 
 // Code generated by openapi-gen from CheckMK 1.0. DO NOT EDIT.
 //
-// Field metadata including descriptions and types
+// Field metadata including descriptions, types and constraints
 //
 // Source: metadata.gen.go
 // Schemas: All (unfiltered)
 
 package p1
 
+import "github.com/BlackMesaLTD/checkmk-api-spec/generated/go/openapi"
+
 // FieldDescriptions maps schema names to field descriptions.
 // Use for generating documentation or user-facing error messages.
 var FieldDescriptions = map[string]map[string]string{
@@ -3431,7 +3433,7 @@ var FieldTypes = map[string]map[string]string{
 		"comment": "string",
 		"notify": "boolean",
 		"persistent": "boolean",
-		"query": "",
+		"query": "object",
 		"sticky": "boolean",
 	},
 	"AcknowledgeServiceGroupProblem": {
@@ -3447,7 +3449,7 @@ var FieldTypes = map[string]map[string]string{
 		"comment": "string",
 		"notify": "boolean",
 		"persistent": "boolean",
-		"query": "",
+		"query": "object",
 		"sticky": "boolean",
 	},
 	"AcknowledgeSpecificServiceProblem": {
@@ -3460,7 +3462,7 @@ var FieldTypes = map[string]map[string]string{
 		"sticky": "boolean",
 	},
 	"Action": {
-		"button": "",
+		"button": "object",
 		"id": "string",
 		"load_wait_label": "string",
 	},
@@ -3493,7 +3495,7 @@ var FieldTypes = map[string]map[string]string{
 	},
 	"ActivationRunResponse": {
 		"domainType": "",
-		"extensions": "",
+		"extensions": "object",
 		"id": "string",
 		"links": "array",
 		"members": "object",
@@ -3510,7 +3512,7 @@ var FieldTypes = map[string]map[string]string{
 	},
 	"ActivationStatusResponse": {
 		"domainType": "",
-		"extensions": "",
+		"extensions": "object",
 		"id": "string",
 		"links": "array",
 		"members": "object",
@@ -3781,7 +3783,7 @@ var FieldTypes = map[string]map[string]string{
 		"subject_for_service_notifications": "object",
 	},
 	"AuditLogEntry": {
-		"extensions": "",
+		"extensions": "object",
 		"title": "string",
 	},
 	"AuditLogEntryCollection": {
@@ -3864,7 +3866,7 @@ var FieldTypes = map[string]map[string]string{
 	},
 	"AuxTagResponse": {
 		"domainType": "",
-		"extensions": "",
+		"extensions": "object",
 		"id": "string",
 		"links": "array",
 		"members": "object",
@@ -3885,13 +3887,13 @@ var FieldTypes = map[string]map[string]string{
 		"use_hard_states": "boolean",
 	},
 	"BIAggregationEndpoint": {
-		"aggregation_visualization": "",
+		"aggregation_visualization": "object",
 		"comment": "string",
-		"computation_options": "",
+		"computation_options": "object",
 		"customer": "string",
-		"groups": "",
+		"groups": "object",
 		"id": "string",
-		"node": "",
+		"node": "object",
 		"pack_id": "string",
 	},
 	"BIAggregationFunctionBest": {
@@ -3900,8 +3902,8 @@ var FieldTypes = map[string]map[string]string{
 		"type": "",
 	},
 	"BIAggregationFunctionCountOK": {
-		"levels_ok": "",
-		"levels_warn": "",
+		"levels_ok": "object",
+		"levels_warn": "object",
 		"type": "",
 	},
 	"BIAggregationFunctionCountSettings": {
@@ -3935,7 +3937,7 @@ var FieldTypes = map[string]map[string]string{
 		"type": "",
 	},
 	"BICallARuleAction": {
-		"params": "",
+		"params": "object",
 		"rule_id": "string",
 		"type": "",
 	},
@@ -3959,13 +3961,13 @@ var FieldTypes = map[string]map[string]string{
 		"type": "",
 	},
 	"BIHostSearch": {
-		"conditions": "",
-		"refer_to": "",
+		"conditions": "object",
+		"refer_to": "object",
 		"type": "",
 	},
 	"BINodeGenerator": {
-		"action": "",
-		"search": "",
+		"action": "object",
+		"search": "object",
 	},
 	"BINodeVisBlockStyle": {
 		"style_config": "",
@@ -3976,7 +3978,7 @@ var FieldTypes = map[string]map[string]string{
 		"type": "",
 	},
 	"BINodeVisHierarchyStyle": {
-		"style_config": "",
+		"style_config": "object",
 		"type": "",
 	},
 	"BINodeVisHierarchyStyleConfig": {
@@ -3989,7 +3991,7 @@ var FieldTypes = map[string]map[string]string{
 		"type": "",
 	},
 	"BINodeVisRadialStyle": {
-		"style_config": "",
+		"style_config": "object",
 		"type": "",
 	},
 	"BINodeVisRadialStyleConfig": {
@@ -4009,14 +4011,14 @@ var FieldTypes = map[string]map[string]string{
 		"disabled": "boolean",
 	},
 	"BIRuleEndpoint": {
-		"aggregation_function": "",
-		"computation_options": "",
+		"aggregation_function": "object",
+		"computation_options": "object",
 		"id": "string",
-		"node_visualization": "",
+		"node_visualization": "object",
 		"nodes": "array",
 		"pack_id": "string",
-		"params": "",
-		"properties": "",
+		"params": "object",
+		"properties": "object",
 	},
 	"BIRuleProperties": {
 		"comment": "string",
@@ -4026,7 +4028,7 @@ var FieldTypes = map[string]map[string]string{
 		"title": "string",
 	},
 	"BIServiceSearch": {
-		"conditions": "",
+		"conditions": "object",
 		"type": "",
 	},
 	"BIStateOfHostAction": {
@@ -4049,42 +4051,42 @@ var FieldTypes = map[string]map[string]string{
 	"BackgroundJobSnapshot": {
 		"active": "boolean",
 		"site_id": "string",
-		"status": "",
+		"status": "object",
 	},
 	"BackgroundJobSnapshotObject": {
 		"domainType": "",
-		"extensions": "",
+		"extensions": "object",
 		"id": "string",
 		"links": "array",
 		"members": "object",
 		"title": "string",
 	},
 	"BackgroundJobStatus": {
-		"log_info": "",
+		"log_info": "object",
 		"state": "string",
 	},
 	"BackgroundJobStatus1": {
 		"active": "boolean",
-		"logs": "",
+		"logs": "object",
 		"state": "string",
 	},
 	"BackgroundJobStatusObject": {
 		"domainType": "",
-		"extensions": "",
+		"extensions": "object",
 		"id": "string",
 		"links": "array",
 		"members": "object",
 		"title": "string",
 	},
 	"BaseUserAttributes": {
-		"auth_option": "",
+		"auth_option": "object",
 		"authorized_sites": "array",
-		"contact_options": "",
+		"contact_options": "object",
 		"contactgroups": "array",
 		"disable_login": "boolean",
 		"disable_notifications": "object",
 		"fullname": "string",
-		"idle_timeout": "",
+		"idle_timeout": "object",
 		"interface_options": "object",
 		"language": "string",
 		"pager_address": "string",
@@ -4131,24 +4133,24 @@ var FieldTypes = map[string]map[string]string{
 		"type": "",
 	},
 	"BrokerConnectionConfig": {
-		"connectee": "",
-		"connecter": "",
+		"connectee": "object",
+		"connecter": "object",
 	},
 	"BrokerConnectionExtension": {
-		"connectee": "",
-		"connecter": "",
+		"connectee": "object",
+		"connecter": "object",
 		"id": "string",
 	},
 	"BrokerConnectionRequestCreate": {
-		"connection_config": "",
+		"connection_config": "object",
 		"connection_id": "string",
 	},
 	"BrokerConnectionRequestUpdate": {
-		"connection_config": "",
+		"connection_config": "object",
 	},
 	"BrokerConnectionResponse": {
 		"domainType": "",
-		"extensions": "",
+		"extensions": "object",
 		"id": "string",
 		"links": "array",
 		"members": "object",
@@ -4182,7 +4184,7 @@ var FieldTypes = map[string]map[string]string{
 		"do_full_scan": "boolean",
 		"hostnames": "array",
 		"ignore_errors": "boolean",
-		"options": "",
+		"options": "object",
 	},
 	"BulkDiscoveryOptions": {
 		"monitor_undecided_services": "boolean",
@@ -4193,7 +4195,7 @@ var FieldTypes = map[string]map[string]string{
 	},
 	"BulkHostActionWithFailedHosts": {
 		"detail": "string",
-		"ext": "",
+		"ext": "object",
 		"fields": "object",
 		"status": "integer",
 		"title": "string",
@@ -4251,7 +4253,7 @@ var FieldTypes = map[string]map[string]string{
 	"ChangeStateWithQuery": {
 		"filter_type": "string",
 		"new_state": "string",
-		"query": "",
+		"query": "object",
 		"site_id": "string",
 	},
 	"ChangesFields": {
@@ -4279,22 +4281,22 @@ var FieldTypes = map[string]map[string]string{
 	},
 	"CheckMKURLPrefixValue": {
 		"state": "string",
-		"value": "",
+		"value": "object",
 	},
 	"Checkbox": {
 		"state": "string",
 	},
 	"CheckboxEventConsoleAlerts": {
 		"state": "string",
-		"value": "",
+		"value": "object",
 	},
 	"CheckboxHostEventType": {
 		"state": "string",
-		"value": "",
+		"value": "object",
 	},
 	"CheckboxHostEventTypeOutput": {
 		"state": "string",
-		"value": "",
+		"value": "object",
 	},
 	"CheckboxLabel": {
 		"key": "string",
@@ -4329,11 +4331,11 @@ var FieldTypes = map[string]map[string]string{
 	},
 	"CheckboxServiceEventType": {
 		"state": "string",
-		"value": "",
+		"value": "object",
 	},
 	"CheckboxServiceEventTypeOutput": {
 		"state": "string",
-		"value": "",
+		"value": "object",
 	},
 	"CheckboxSortOrderValue": {
 		"state": "string",
@@ -4361,11 +4363,11 @@ var FieldTypes = map[string]map[string]string{
 	},
 	"CheckboxWithFromToServiceLevels": {
 		"state": "string",
-		"value": "",
+		"value": "object",
 	},
 	"CheckboxWithFromToServiceLevelsOutput": {
 		"state": "string",
-		"value": "",
+		"value": "object",
 	},
 	"CheckboxWithListOfCheckTypes": {
 		"state": "string",
@@ -4409,11 +4411,11 @@ var FieldTypes = map[string]map[string]string{
 	},
 	"CheckboxWithListOfServiceGroupsRegex": {
 		"state": "string",
-		"value": "",
+		"value": "object",
 	},
 	"CheckboxWithListOfServiceGroupsRegexOutput": {
 		"state": "string",
-		"value": "",
+		"value": "object",
 	},
 	"CheckboxWithListOfSites": {
 		"state": "string",
@@ -4471,8 +4473,8 @@ var FieldTypes = map[string]map[string]string{
 		"type": "",
 	},
 	"ChildWith": {
-		"conditions": "",
-		"host_choice": "",
+		"conditions": "object",
+		"host_choice": "object",
 		"type": "",
 	},
 	"Choice": {
@@ -4488,8 +4490,8 @@ var FieldTypes = map[string]map[string]string{
 		"store_id": "string",
 	},
 	"CiscoWebexPluginCreate": {
-		"disable_ssl_cert_verification": "",
-		"http_proxy": "",
+		"disable_ssl_cert_verification": "object",
+		"http_proxy": "object",
 		"plugin_name": "string",
 		"url_prefix_for_links_to_checkmk": "object",
 		"webhook_url": "object",
@@ -4498,21 +4500,21 @@ var FieldTypes = map[string]map[string]string{
 		"additional_ipv4addresses": "array",
 		"additional_ipv6addresses": "array",
 		"alias": "string",
-		"contactgroups": "",
+		"contactgroups": "object",
 		"inventory_failed": "boolean",
 		"ipaddress": "string",
 		"ipv6address": "string",
 		"labels": "object",
 		"locked_attributes": "array",
-		"locked_by": "",
+		"locked_by": "object",
 		"management_address": "string",
 		"management_ipmi_credentials": "",
 		"management_protocol": "string",
 		"management_snmp_community": "",
-		"network_scan": "",
+		"network_scan": "object",
 		"parents": "array",
 		"site": "string",
-		"snmp_community": "",
+		"snmp_community": "object",
 		"tag_address_family": "string",
 		"tag_agent": "string",
 		"tag_criticality": "string",
@@ -4542,7 +4544,7 @@ var FieldTypes = map[string]map[string]string{
 	},
 	"CommentObject": {
 		"domainType": "",
-		"extensions": "",
+		"extensions": "object",
 		"id": "string",
 		"links": "array",
 		"members": "object",
@@ -4550,11 +4552,11 @@ var FieldTypes = map[string]map[string]string{
 	},
 	"ConcreteDisabledNotifications": {
 		"disable": "boolean",
-		"timerange": "",
+		"timerange": "object",
 	},
 	"ConcreteHostTagGroup": {
 		"domainType": "",
-		"extensions": "",
+		"extensions": "object",
 		"id": "string",
 		"links": "array",
 		"members": "object",
@@ -4586,9 +4588,9 @@ var FieldTypes = map[string]map[string]string{
 	},
 	"Conditions": {
 		"host_label_groups": "array",
-		"host_name": "",
+		"host_name": "object",
 		"host_tags": "array",
-		"service_description": "",
+		"service_description": "object",
 		"service_label_groups": "array",
 	},
 	"ConditionsAttributes": {
@@ -4630,7 +4632,7 @@ var FieldTypes = map[string]map[string]string{
 		"replicate_event_console": "boolean",
 		"replicate_extensions": "boolean",
 		"url_of_remote_site": "string",
-		"user_sync": "",
+		"user_sync": "object",
 	},
 	"ConfigurationConnectionWithReplicationAttributes": {
 		"direct_login_to_web_gui_allowed": "boolean",
@@ -4641,7 +4643,7 @@ var FieldTypes = map[string]map[string]string{
 		"replicate_event_console": "boolean",
 		"replicate_extensions": "boolean",
 		"url_of_remote_site": "string",
-		"user_sync": "",
+		"user_sync": "object",
 	},
 	"ConfigurationConnectionWithoutReplicationAttributes": {
 		"enable_replication": "",
@@ -4654,7 +4656,7 @@ var FieldTypes = map[string]map[string]string{
 	},
 	"ContactGroup": {
 		"domainType": "",
-		"extensions": "",
+		"extensions": "object",
 		"id": "string",
 		"links": "array",
 		"members": "object",
@@ -4669,7 +4671,7 @@ var FieldTypes = map[string]map[string]string{
 		"value": "array",
 	},
 	"ContactGroupExtensions": {
-		"inventory_paths": "",
+		"inventory_paths": "object",
 	},
 	"ContactSelection": {
 		"all_contacts_of_the_notified_object": "object",
@@ -4741,14 +4743,14 @@ var FieldTypes = map[string]map[string]string{
 		"comment": "string",
 		"comment_type": "string",
 		"persistent": "boolean",
-		"query": "",
+		"query": "object",
 	},
 	"CreateHostQueryDowntime": {
 		"comment": "string",
 		"downtime_type": "string",
 		"duration": "integer",
 		"end_time": "string",
-		"query": "",
+		"query": "object",
 		"recur": "string",
 		"start_time": "string",
 	},
@@ -4791,14 +4793,14 @@ var FieldTypes = map[string]map[string]string{
 		"comment": "string",
 		"comment_type": "string",
 		"persistent": "boolean",
-		"query": "",
+		"query": "object",
 	},
 	"CreateServiceQueryDowntime": {
 		"comment": "string",
 		"downtime_type": "string",
 		"duration": "integer",
 		"end_time": "string",
-		"query": "",
+		"query": "object",
 		"recur": "string",
 		"start_time": "string",
 	},
@@ -4810,15 +4812,15 @@ var FieldTypes = map[string]map[string]string{
 		"name": "string",
 	},
 	"CreateUser": {
-		"auth_option": "",
+		"auth_option": "object",
 		"authorized_sites": "array",
-		"contact_options": "",
+		"contact_options": "object",
 		"contactgroups": "array",
 		"disable_login": "boolean",
-		"disable_notifications": "",
+		"disable_notifications": "object",
 		"fullname": "string",
-		"idle_timeout": "",
-		"interface_options": "",
+		"idle_timeout": "object",
+		"interface_options": "object",
 		"language": "string",
 		"pager_address": "string",
 		"roles": "array",
@@ -4861,7 +4863,7 @@ var FieldTypes = map[string]map[string]string{
 	},
 	"DeleteCommentsByQuery": {
 		"delete_type": "string",
-		"query": "",
+		"query": "object",
 	},
 	"DeleteDowntimeByHostGroup": {
 		"delete_type": "string",
@@ -4879,7 +4881,7 @@ var FieldTypes = map[string]map[string]string{
 	},
 	"DeleteDowntimeByQuery": {
 		"delete_type": "string",
-		"query": "",
+		"query": "object",
 	},
 	"DeleteDowntimeByServiceGroup": {
 		"delete_type": "string",
@@ -4904,7 +4906,7 @@ var FieldTypes = map[string]map[string]string{
 	},
 	"DisabledNotifications": {
 		"disable": "boolean",
-		"timerange": "",
+		"timerange": "object",
 	},
 	"DiscoverServices": {
 		"host_name": "string",
@@ -4936,7 +4938,7 @@ var FieldTypes = map[string]map[string]string{
 	},
 	"DowntimeObject": {
 		"domainType": "",
-		"extensions": "",
+		"extensions": "object",
 		"id": "string",
 		"links": "array",
 		"members": "object",
@@ -4962,7 +4964,7 @@ var FieldTypes = map[string]map[string]string{
 	},
 	"ECEventResponse": {
 		"domainType": "",
-		"extensions": "",
+		"extensions": "object",
 		"id": "string",
 		"links": "array",
 		"members": "object",
@@ -4970,7 +4972,7 @@ var FieldTypes = map[string]map[string]string{
 	},
 	"EditConfigurationEntityResponse": {
 		"domainType": "",
-		"extensions": "",
+		"extensions": "object",
 		"id": "string",
 		"links": "array",
 		"members": "object",
@@ -5039,7 +5041,7 @@ var FieldTypes = map[string]map[string]string{
 	},
 	"FailedHosts": {
 		"failed_hosts": "object",
-		"succeeded_hosts": "",
+		"succeeded_hosts": "object",
 	},
 	"FilterById": {
 		"event_id": "integer",
@@ -5052,7 +5054,7 @@ var FieldTypes = map[string]map[string]string{
 	},
 	"FilterByQuery": {
 		"filter_type": "string",
-		"query": "",
+		"query": "object",
 	},
 	"FilterParams": {
 		"application": "string",
@@ -5067,10 +5069,10 @@ var FieldTypes = map[string]map[string]string{
 	},
 	"Folder": {
 		"domainType": "",
-		"extensions": "",
+		"extensions": "object",
 		"id": "string",
 		"links": "array",
-		"members": "",
+		"members": "object",
 		"title": "string",
 	},
 	"FolderCollection": {
@@ -5082,15 +5084,15 @@ var FieldTypes = map[string]map[string]string{
 		"value": "array",
 	},
 	"FolderCreateAttribute": {
-		"contactgroups": "",
+		"contactgroups": "object",
 		"labels": "object",
 		"management_ipmi_credentials": "",
 		"management_protocol": "string",
 		"management_snmp_community": "",
-		"network_scan": "",
+		"network_scan": "object",
 		"parents": "array",
 		"site": "string",
-		"snmp_community": "",
+		"snmp_community": "object",
 		"tag_address_family": "string",
 		"tag_agent": "string",
 		"tag_criticality": "string",
@@ -5103,19 +5105,19 @@ var FieldTypes = map[string]map[string]string{
 		"path": "string",
 	},
 	"FolderMembers": {
-		"hosts": "",
-		"move": "",
+		"hosts": "object",
+		"move": "object",
 	},
 	"FolderUpdateAttribute": {
-		"contactgroups": "",
+		"contactgroups": "object",
 		"labels": "object",
 		"management_ipmi_credentials": "",
 		"management_protocol": "string",
 		"management_snmp_community": "",
-		"network_scan": "",
+		"network_scan": "object",
 		"parents": "array",
 		"site": "string",
-		"snmp_community": "",
+		"snmp_community": "object",
 		"tag_address_family": "string",
 		"tag_agent": "string",
 		"tag_criticality": "string",
@@ -5124,17 +5126,17 @@ var FieldTypes = map[string]map[string]string{
 		"tag_snmp_ds": "string",
 	},
 	"FolderViewAttribute": {
-		"contactgroups": "",
+		"contactgroups": "object",
 		"labels": "object",
 		"management_ipmi_credentials": "",
 		"management_protocol": "string",
 		"management_snmp_community": "",
-		"meta_data": "",
-		"network_scan": "",
-		"network_scan_result": "",
+		"meta_data": "object",
+		"network_scan": "object",
+		"network_scan_result": "object",
 		"parents": "array",
 		"site": "string",
-		"snmp_community": "",
+		"snmp_community": "object",
 		"tag_address_family": "string",
 		"tag_agent": "string",
 		"tag_criticality": "string",
@@ -5144,7 +5146,7 @@ var FieldTypes = map[string]map[string]string{
 	},
 	"FromEmailAndNameCheckbox": {
 		"state": "string",
-		"value": "",
+		"value": "object",
 	},
 	"FromToNotificationNumbers": {
 		"beginning_from": "integer",
@@ -5175,7 +5177,7 @@ var FieldTypes = map[string]map[string]string{
 		"reduce": "string",
 		"service_description": "string",
 		"site": "string",
-		"time_range": "",
+		"time_range": "object",
 		"type": "string",
 	},
 	"GetMetric": {
@@ -5184,13 +5186,13 @@ var FieldTypes = map[string]map[string]string{
 		"reduce": "string",
 		"service_description": "string",
 		"site": "string",
-		"time_range": "",
+		"time_range": "object",
 		"type": "string",
 	},
 	"GraphCollection": {
 		"metrics": "array",
 		"step": "integer",
-		"time_range": "",
+		"time_range": "object",
 	},
 	"GraphsPerNotification": {
 		"state": "string",
@@ -5224,17 +5226,17 @@ var FieldTypes = map[string]map[string]string{
 		"type": "",
 	},
 	"HostConditions": {
-		"host_choice": "",
+		"host_choice": "object",
 		"host_folder": "string",
 		"host_label_groups": "array",
 		"host_tags": "object",
 	},
 	"HostConfig": {
 		"domainType": "",
-		"extensions": "",
+		"extensions": "object",
 		"id": "string",
 		"links": "array",
-		"members": "",
+		"members": "object",
 		"title": "string",
 	},
 	"HostConfigCollection": {
@@ -5260,21 +5262,21 @@ var FieldTypes = map[string]map[string]string{
 		"additional_ipv4addresses": "array",
 		"additional_ipv6addresses": "array",
 		"alias": "string",
-		"contactgroups": "",
+		"contactgroups": "object",
 		"inventory_failed": "boolean",
 		"ipaddress": "string",
 		"ipv6address": "string",
 		"labels": "object",
 		"locked_attributes": "array",
-		"locked_by": "",
+		"locked_by": "object",
 		"management_address": "string",
 		"management_ipmi_credentials": "",
 		"management_protocol": "string",
 		"management_snmp_community": "",
-		"network_scan": "",
+		"network_scan": "object",
 		"parents": "array",
 		"site": "string",
-		"snmp_community": "",
+		"snmp_community": "object",
 		"tag_address_family": "string",
 		"tag_agent": "string",
 		"tag_criticality": "string",
@@ -5337,23 +5339,23 @@ var FieldTypes = map[string]map[string]string{
 		"additional_ipv4addresses": "array",
 		"additional_ipv6addresses": "array",
 		"alias": "string",
-		"contactgroups": "",
+		"contactgroups": "object",
 		"inventory_failed": "boolean",
 		"ipaddress": "string",
 		"ipv6address": "string",
 		"labels": "object",
 		"locked_attributes": "array",
-		"locked_by": "",
+		"locked_by": "object",
 		"management_address": "string",
 		"management_ipmi_credentials": "",
 		"management_protocol": "string",
 		"management_snmp_community": "",
-		"meta_data": "",
-		"network_scan": "",
-		"network_scan_result": "",
+		"meta_data": "object",
+		"network_scan": "object",
+		"network_scan_result": "object",
 		"parents": "array",
 		"site": "string",
-		"snmp_community": "",
+		"snmp_community": "object",
 		"tag_address_family": "string",
 		"tag_agent": "string",
 		"tag_criticality": "string",
@@ -5364,7 +5366,7 @@ var FieldTypes = map[string]map[string]string{
 	},
 	"HostGroup": {
 		"domainType": "",
-		"extensions": "",
+		"extensions": "object",
 		"id": "string",
 		"links": "array",
 		"members": "object",
@@ -5379,7 +5381,7 @@ var FieldTypes = map[string]map[string]string{
 		"value": "array",
 	},
 	"HostMembers": {
-		"folder_config": "",
+		"folder_config": "object",
 	},
 	"HostOrServiceCondition": {
 		"match_on": "array",
@@ -5412,21 +5414,21 @@ var FieldTypes = map[string]map[string]string{
 		"additional_ipv4addresses": "array",
 		"additional_ipv6addresses": "array",
 		"alias": "string",
-		"contactgroups": "",
+		"contactgroups": "object",
 		"inventory_failed": "boolean",
 		"ipaddress": "string",
 		"ipv6address": "string",
 		"labels": "object",
 		"locked_attributes": "array",
-		"locked_by": "",
+		"locked_by": "object",
 		"management_address": "string",
 		"management_ipmi_credentials": "",
 		"management_protocol": "string",
 		"management_snmp_community": "",
-		"network_scan": "",
+		"network_scan": "object",
 		"parents": "array",
 		"site": "string",
-		"snmp_community": "",
+		"snmp_community": "object",
 		"tag_address_family": "string",
 		"tag_agent": "string",
 		"tag_criticality": "string",
@@ -5439,23 +5441,23 @@ var FieldTypes = map[string]map[string]string{
 		"additional_ipv4addresses": "array",
 		"additional_ipv6addresses": "array",
 		"alias": "string",
-		"contactgroups": "",
+		"contactgroups": "object",
 		"inventory_failed": "boolean",
 		"ipaddress": "string",
 		"ipv6address": "string",
 		"labels": "object",
 		"locked_attributes": "array",
-		"locked_by": "",
+		"locked_by": "object",
 		"management_address": "string",
 		"management_ipmi_credentials": "",
 		"management_protocol": "string",
 		"management_snmp_community": "",
-		"meta_data": "",
-		"network_scan": "",
-		"network_scan_result": "",
+		"meta_data": "object",
+		"network_scan": "object",
+		"network_scan_result": "object",
 		"parents": "array",
 		"site": "string",
-		"snmp_community": "",
+		"snmp_community": "object",
 		"tag_address_family": "string",
 		"tag_agent": "string",
 		"tag_criticality": "string",
@@ -5481,7 +5483,7 @@ var FieldTypes = map[string]map[string]string{
 	},
 	"HttpProxyValue": {
 		"state": "string",
-		"value": "",
+		"value": "object",
 	},
 	"IPAddressRange": {
 		"from_address": "string",
@@ -5520,8 +5522,8 @@ var FieldTypes = map[string]map[string]string{
 		"api_key": "object",
 		"custom_summary_for_host_alerts": "string",
 		"custom_summary_for_service_alerts": "string",
-		"disable_ssl_cert_verification": "",
-		"http_proxy": "",
+		"disable_ssl_cert_verification": "object",
+		"http_proxy": "object",
 		"notification_priority": "string",
 		"plugin_name": "string",
 		"url_prefix_for_links_to_checkmk": "object",
@@ -5541,15 +5543,15 @@ var FieldTypes = map[string]map[string]string{
 	"InputConditions": {
 		"host_label_groups": "array",
 		"host_labels": "array",
-		"host_name": "",
+		"host_name": "object",
 		"host_tags": "array",
-		"service_description": "",
+		"service_description": "object",
 		"service_label_groups": "array",
 		"service_labels": "array",
 	},
 	"InputContactGroup": {
 		"alias": "string",
-		"inventory_paths": "",
+		"inventory_paths": "object",
 		"name": "string",
 	},
 	"InputHostGroup": {
@@ -5574,9 +5576,9 @@ var FieldTypes = map[string]map[string]string{
 		"title": "string",
 	},
 	"InputRuleObject": {
-		"conditions": "",
+		"conditions": "object",
 		"folder": "string",
-		"properties": "",
+		"properties": "object",
 		"ruleset": "string",
 		"value_raw": "string",
 	},
@@ -5599,9 +5601,9 @@ var FieldTypes = map[string]map[string]string{
 		"type": "",
 	},
 	"InventoryPathSpecificPath": {
-		"attributes": "",
-		"columns": "",
-		"nodes": "",
+		"attributes": "object",
+		"columns": "object",
+		"nodes": "object",
 		"path": "string",
 	},
 	"InventoryPathSpecificPaths": {
@@ -5609,23 +5611,23 @@ var FieldTypes = map[string]map[string]string{
 		"type": "",
 	},
 	"JiraPluginCreate": {
-		"auth": "",
-		"disable_ssl_cert_verification": "",
-		"graphs_per_notification": "",
+		"auth": "object",
+		"disable_ssl_cert_verification": "object",
+		"graphs_per_notification": "object",
 		"host_custom_id": "string",
-		"host_summary": "",
+		"host_summary": "object",
 		"issue_type_id": "string",
 		"jira_url": "string",
-		"label": "",
+		"label": "object",
 		"monitoring_url": "string",
-		"optional_timeout": "",
+		"optional_timeout": "object",
 		"plugin_name": "string",
-		"priority_id": "",
+		"priority_id": "object",
 		"project_id": "string",
-		"resolution_id": "",
+		"resolution_id": "object",
 		"service_custom_id": "string",
-		"service_summary": "",
-		"site_custom_id": "",
+		"service_summary": "object",
+		"site_custom_id": "object",
 	},
 	"JobLogs": {
 		"progress": "array",
@@ -5655,15 +5657,15 @@ var FieldTypes = map[string]map[string]string{
 		"state": "",
 	},
 	"LDAPConnection": {
-		"bind_credentials": "",
-		"connect_timeout": "",
-		"connection_suffix": "",
-		"directory_type": "",
-		"ldap_version": "",
-		"page_size": "",
-		"response_timeout": "",
+		"bind_credentials": "object",
+		"connect_timeout": "object",
+		"connection_suffix": "object",
+		"directory_type": "object",
+		"ldap_version": "object",
+		"page_size": "object",
+		"response_timeout": "object",
 		"ssl_encryption": "string",
-		"tcp_port": "",
+		"tcp_port": "object",
 	},
 	"LDAPConnectionConfig": {
 		"general_properties": "object",
@@ -5674,35 +5676,35 @@ var FieldTypes = map[string]map[string]string{
 		"users": "object",
 	},
 	"LDAPConnectionConfigCreateRequest": {
-		"general_properties": "",
-		"groups": "",
-		"ldap_connection": "",
-		"other": "",
-		"sync_plugins": "",
-		"users": "",
+		"general_properties": "object",
+		"groups": "object",
+		"ldap_connection": "object",
+		"other": "object",
+		"sync_plugins": "object",
+		"users": "object",
 	},
 	"LDAPConnectionConfigUpdateRequest": {
-		"general_properties": "",
-		"groups": "",
-		"ldap_connection": "",
-		"other": "",
-		"sync_plugins": "",
-		"users": "",
+		"general_properties": "object",
+		"groups": "object",
+		"ldap_connection": "object",
+		"other": "object",
+		"sync_plugins": "object",
+		"users": "object",
 	},
 	"LDAPConnectionCreateRequest": {
-		"bind_credentials": "",
-		"connect_timeout": "",
-		"connection_suffix": "",
-		"directory_type": "",
-		"ldap_version": "",
-		"page_size": "",
-		"response_timeout": "",
+		"bind_credentials": "object",
+		"connect_timeout": "object",
+		"connection_suffix": "object",
+		"directory_type": "object",
+		"ldap_version": "object",
+		"page_size": "object",
+		"response_timeout": "object",
 		"ssl_encryption": "string",
-		"tcp_port": "",
+		"tcp_port": "object",
 	},
 	"LDAPConnectionResponse": {
 		"domainType": "",
-		"extensions": "",
+		"extensions": "object",
 		"id": "string",
 		"links": "array",
 		"members": "object",
@@ -5729,15 +5731,15 @@ var FieldTypes = map[string]map[string]string{
 		"suffix": "string",
 	},
 	"LDAPConnectionUpdateRequest": {
-		"bind_credentials": "",
-		"connect_timeout": "",
-		"connection_suffix": "",
-		"directory_type": "",
-		"ldap_version": "",
-		"page_size": "",
-		"response_timeout": "",
+		"bind_credentials": "object",
+		"connect_timeout": "object",
+		"connection_suffix": "object",
+		"directory_type": "object",
+		"ldap_version": "object",
+		"page_size": "object",
+		"response_timeout": "object",
 		"ssl_encryption": "string",
-		"tcp_port": "",
+		"tcp_port": "object",
 	},
 	"LDAPContactGroupMembership": {
 		"handle_nested": "boolean",
@@ -5751,7 +5753,7 @@ var FieldTypes = map[string]map[string]string{
 		"type": "string",
 	},
 	"LDAPDisableNotificationsValue": {
-		"custom_time_range": "",
+		"custom_time_range": "object",
 		"temporarily_disable_all_notifications": "boolean",
 	},
 	"LDAPFromToFields": {
@@ -5790,14 +5792,14 @@ var FieldTypes = map[string]map[string]string{
 	},
 	"LDAPGroups": {
 		"group_base_dn": "string",
-		"member_attribute": "",
-		"search_filter": "",
+		"member_attribute": "object",
+		"search_filter": "object",
 		"search_scope": "string",
 	},
 	"LDAPGroupsRequest": {
 		"group_base_dn": "string",
-		"member_attribute": "",
-		"search_filter": "",
+		"member_attribute": "object",
+		"search_filter": "object",
 		"search_scope": "string",
 	},
 	"LDAPGroupsToAttributes": {
@@ -5827,7 +5829,7 @@ var FieldTypes = map[string]map[string]string{
 	"LDAPGroupsToSyncDisableNotifications": {
 		"attribute_to_set": "string",
 		"group_cn": "string",
-		"value": "",
+		"value": "object",
 	},
 	"LDAPMemberAttributeRequest": {
 		"attribute": "string",
@@ -5838,10 +5840,10 @@ var FieldTypes = map[string]map[string]string{
 		"state": "string",
 	},
 	"LDAPOther": {
-		"sync_interval": "",
+		"sync_interval": "object",
 	},
 	"LDAPOtherRequest": {
-		"sync_interval": "",
+		"sync_interval": "object",
 	},
 	"LDAPPageSize": {
 		"size": "integer",
@@ -5906,7 +5908,7 @@ var FieldTypes = map[string]map[string]string{
 	"LDAPSyncPluginGroupDisableNotificationsRequest": {
 		"attribute_to_set": "string",
 		"group_cn": "string",
-		"value": "",
+		"value": "object",
 	},
 	"LDAPSyncPluginGroupsToAttributesRequest": {
 		"groups_to_sync": "array",
@@ -5915,7 +5917,7 @@ var FieldTypes = map[string]map[string]string{
 		"sync_from_other_connections": "array",
 	},
 	"LDAPSyncPluginGroupsValueRequest": {
-		"custom_time_range": "",
+		"custom_time_range": "object",
 		"temporarily_disable_all_notifications": "boolean",
 	},
 	"LDAPSyncPluginMenuIcons": {
@@ -5960,40 +5962,40 @@ var FieldTypes = map[string]map[string]string{
 		"state": "string",
 	},
 	"LDAPSyncPluginsRequestWithCustomAttributes": {
-		"alias": "",
-		"authentication_expiration": "",
-		"contact_group_membership": "",
-		"disable_notifications": "",
-		"email_address": "",
-		"groups_to_custom_user_attributes": "",
-		"groups_to_roles": "",
-		"mega_menu_icons": "",
-		"navigation_bar_icons": "",
-		"pager": "",
-		"show_mode": "",
-		"start_url": "",
-		"temperature_unit": "",
-		"ui_sidebar_position": "",
-		"ui_theme": "",
-		"visibility_of_hosts_or_services": "",
+		"alias": "object",
+		"authentication_expiration": "object",
+		"contact_group_membership": "object",
+		"disable_notifications": "object",
+		"email_address": "object",
+		"groups_to_custom_user_attributes": "object",
+		"groups_to_roles": "object",
+		"mega_menu_icons": "object",
+		"navigation_bar_icons": "object",
+		"pager": "object",
+		"show_mode": "object",
+		"start_url": "object",
+		"temperature_unit": "object",
+		"ui_sidebar_position": "object",
+		"ui_theme": "object",
+		"visibility_of_hosts_or_services": "object",
 	},
 	"LDAPSyncPluginsWithCustomAttributes": {
-		"alias": "",
-		"authentication_expiration": "",
-		"contact_group_membership": "",
-		"disable_notifications": "",
-		"email_address": "",
-		"groups_to_custom_user_attributes": "",
-		"groups_to_roles": "",
-		"mega_menu_icons": "",
-		"navigation_bar_icons": "",
-		"pager": "",
-		"show_mode": "",
-		"start_url": "",
-		"temperature_unit": "",
-		"ui_sidebar_position": "",
-		"ui_theme": "",
-		"visibility_of_hosts_or_services": "",
+		"alias": "object",
+		"authentication_expiration": "object",
+		"contact_group_membership": "object",
+		"disable_notifications": "object",
+		"email_address": "object",
+		"groups_to_custom_user_attributes": "object",
+		"groups_to_roles": "object",
+		"mega_menu_icons": "object",
+		"navigation_bar_icons": "object",
+		"pager": "object",
+		"show_mode": "object",
+		"start_url": "object",
+		"temperature_unit": "object",
+		"ui_sidebar_position": "object",
+		"ui_theme": "object",
+		"visibility_of_hosts_or_services": "object",
 	},
 	"LDAPTCPPort": {
 		"port": "integer",
@@ -6029,22 +6031,22 @@ var FieldTypes = map[string]map[string]string{
 	},
 	"LDAPUsers": {
 		"create_users": "string",
-		"filter_group": "",
-		"search_filter": "",
+		"filter_group": "object",
+		"search_filter": "object",
 		"search_scope": "string",
 		"umlauts_in_user_ids": "string",
 		"user_base_dn": "string",
-		"user_id_attribute": "",
+		"user_id_attribute": "object",
 		"user_id_case": "string",
 	},
 	"LDAPUsersRequest": {
 		"create_users": "string",
-		"filter_group": "",
-		"search_filter": "",
+		"filter_group": "object",
+		"search_filter": "object",
 		"search_scope": "string",
 		"umlauts_in_user_ids": "string",
 		"user_base_dn": "string",
-		"user_id_attribute": "",
+		"user_id_attribute": "object",
 		"user_id_case": "string",
 	},
 	"LDAPVersion": {
@@ -6106,15 +6108,15 @@ var FieldTypes = map[string]map[string]string{
 		"url": "string",
 	},
 	"MSTeamsPluginCreate": {
-		"affected_host_groups": "",
-		"host_details": "",
-		"host_summary": "",
-		"host_title": "",
-		"http_proxy": "",
+		"affected_host_groups": "object",
+		"host_details": "object",
+		"host_summary": "object",
+		"host_title": "object",
+		"http_proxy": "object",
 		"plugin_name": "string",
-		"service_details": "",
-		"service_summary": "",
-		"service_title": "",
+		"service_details": "object",
+		"service_summary": "object",
+		"service_title": "object",
 		"url_prefix_for_links_to_checkmk": "object",
 		"webhook_url": "object",
 	},
@@ -6171,33 +6173,33 @@ var FieldTypes = map[string]map[string]string{
 	},
 	"ModifyDowntimeByHostGroup": {
 		"comment": "string",
-		"end_time": "",
+		"end_time": "object",
 		"hostgroup_name": "string",
 		"modify_type": "string",
 	},
 	"ModifyDowntimeById": {
 		"comment": "string",
 		"downtime_id": "string",
-		"end_time": "",
+		"end_time": "object",
 		"modify_type": "string",
 		"site_id": "string",
 	},
 	"ModifyDowntimeByName": {
 		"comment": "string",
-		"end_time": "",
+		"end_time": "object",
 		"host_name": "string",
 		"modify_type": "string",
 		"service_descriptions": "array",
 	},
 	"ModifyDowntimeByQuery": {
 		"comment": "string",
-		"end_time": "",
+		"end_time": "object",
 		"modify_type": "string",
-		"query": "",
+		"query": "object",
 	},
 	"ModifyDowntimeByServiceGroup": {
 		"comment": "string",
-		"end_time": "",
+		"end_time": "object",
 		"modify_type": "string",
 		"servicegroup_name": "string",
 	},
@@ -6247,7 +6249,7 @@ var FieldTypes = map[string]map[string]string{
 		"type": "",
 	},
 	"NotExpr": {
-		"expr": "",
+		"expr": "object",
 		"op": "string",
 	},
 	"NotificationBulking": {
@@ -6305,11 +6307,11 @@ var FieldTypes = map[string]map[string]string{
 		"rule_config": "object",
 	},
 	"NotificationRuleRequest": {
-		"rule_config": "",
+		"rule_config": "object",
 	},
 	"NotificationRuleResponse": {
 		"domainType": "",
-		"extensions": "",
+		"extensions": "object",
 		"id": "string",
 		"links": "array",
 		"members": "object",
@@ -6356,25 +6358,25 @@ var FieldTypes = map[string]map[string]string{
 		"option": "string",
 	},
 	"OpsGeniePluginCreate": {
-		"actions": "",
+		"actions": "object",
 		"api_key": "object",
-		"desc_for_host_alerts": "",
-		"desc_for_service_alerts": "",
-		"disable_ssl_cert_verification": "",
-		"domain": "",
-		"entity": "",
-		"extra_properties": "",
-		"http_proxy": "",
-		"message_for_host_alerts": "",
-		"message_for_service_alerts": "",
-		"note_while_closing": "",
-		"note_while_creating": "",
-		"owner": "",
+		"desc_for_host_alerts": "object",
+		"desc_for_service_alerts": "object",
+		"disable_ssl_cert_verification": "object",
+		"domain": "object",
+		"entity": "object",
+		"extra_properties": "object",
+		"http_proxy": "object",
+		"message_for_host_alerts": "object",
+		"message_for_service_alerts": "object",
+		"note_while_closing": "object",
+		"note_while_creating": "object",
+		"owner": "object",
 		"plugin_name": "string",
 		"priority": "object",
-		"responsible_teams": "",
-		"source": "",
-		"tags": "",
+		"responsible_teams": "object",
+		"source": "object",
+		"tags": "object",
 	},
 	"OpsGenieStoreID": {
 		"option": "string",
@@ -6393,8 +6395,8 @@ var FieldTypes = map[string]map[string]string{
 		"option": "string",
 	},
 	"PagerDutyPluginCreate": {
-		"disable_ssl_cert_verification": "",
-		"http_proxy": "",
+		"disable_ssl_cert_verification": "object",
+		"http_proxy": "object",
 		"integration_key": "object",
 		"plugin_name": "string",
 		"url_prefix_for_links_to_checkmk": "object",
@@ -6413,10 +6415,10 @@ var FieldTypes = map[string]map[string]string{
 		"type": "",
 	},
 	"ParentScan": {
-		"configuration": "",
-		"gateway_hosts": "",
+		"configuration": "object",
+		"gateway_hosts": "object",
 		"host_names": "array",
-		"performance": "",
+		"performance": "object",
 	},
 	"PasswordCollection": {
 		"domainType": "",
@@ -6435,7 +6437,7 @@ var FieldTypes = map[string]map[string]string{
 	},
 	"PasswordObject": {
 		"domainType": "",
-		"extensions": "",
+		"extensions": "object",
 		"id": "string",
 		"links": "array",
 		"members": "object",
@@ -6476,14 +6478,14 @@ var FieldTypes = map[string]map[string]string{
 	},
 	"ProxyAttributes": {
 		"global_settings": "boolean",
-		"params": "",
-		"tcp": "",
+		"params": "object",
+		"tcp": "object",
 		"use_livestatus_daemon": "string",
 	},
 	"ProxyAttributesOutput": {
 		"global_settings": "boolean",
-		"params": "",
-		"tcp": "",
+		"params": "object",
+		"tcp": "object",
 		"use_livestatus_daemon": "string",
 	},
 	"ProxyParams": {
@@ -6491,7 +6493,7 @@ var FieldTypes = map[string]map[string]string{
 		"channel_timeout": "number",
 		"channels": "integer",
 		"connect_retry": "number",
-		"heartbeat": "",
+		"heartbeat": "object",
 		"query_timeout": "number",
 	},
 	"ProxyParamsOutput": {
@@ -6499,7 +6501,7 @@ var FieldTypes = map[string]map[string]string{
 		"channel_timeout": "number",
 		"channels": "integer",
 		"connect_retry": "number",
-		"heartbeat": "",
+		"heartbeat": "object",
 		"query_timeout": "number",
 	},
 	"ProxyTCPOutput": {
@@ -6514,7 +6516,7 @@ var FieldTypes = map[string]map[string]string{
 	},
 	"PushOverPluginCreate": {
 		"api_key": "string",
-		"http_proxy": "",
+		"http_proxy": "object",
 		"plugin_name": "string",
 		"priority": "object",
 		"sound": "object",
@@ -6523,7 +6525,7 @@ var FieldTypes = map[string]map[string]string{
 	},
 	"PushOverPriority": {
 		"state": "string",
-		"value": "",
+		"value": "object",
 	},
 	"PushOverPriorityBase": {
 		"level": "string",
@@ -6546,7 +6548,7 @@ var FieldTypes = map[string]map[string]string{
 	},
 	"QuickSetupCompleteResponse": {
 		"all_stage_errors": "array",
-		"background_job_exception": "",
+		"background_job_exception": "object",
 		"redirect_url": "string",
 	},
 	"QuickSetupCompleteStageResponse": {
@@ -6567,7 +6569,7 @@ var FieldTypes = map[string]map[string]string{
 		"overviews": "array",
 		"prev_button": "",
 		"quick_setup_id": "string",
-		"stage": "",
+		"stage": "object",
 	},
 	"QuickSetupOverviewResponse": {
 		"actions": "array",
@@ -6582,7 +6584,7 @@ var FieldTypes = map[string]map[string]string{
 		"stages": "array",
 	},
 	"QuickSetupStageActionResponse": {
-		"background_job_exception": "",
+		"background_job_exception": "object",
 		"stage_recap": "array",
 		"validation_errors": "",
 	},
@@ -6609,7 +6611,7 @@ var FieldTypes = map[string]map[string]string{
 		"new_name": "string",
 	},
 	"Request": {
-		"parameters": "",
+		"parameters": "object",
 		"value": "string",
 	},
 	"Response": {
@@ -6661,10 +6663,10 @@ var FieldTypes = map[string]map[string]string{
 		"throttle_periodic_notifications": "object",
 	},
 	"RuleExtensions": {
-		"conditions": "",
+		"conditions": "object",
 		"folder": "string",
 		"folder_index": "integer",
-		"properties": "",
+		"properties": "object",
 		"ruleset": "string",
 		"value_raw": "string",
 	},
@@ -6680,24 +6682,24 @@ var FieldTypes = map[string]map[string]string{
 	},
 	"RuleObject": {
 		"domainType": "",
-		"extensions": "",
+		"extensions": "object",
 		"id": "string",
 		"links": "array",
 		"members": "object",
 		"title": "string",
 	},
 	"RuleProperties": {
-		"allow_users_to_deactivate": "",
+		"allow_users_to_deactivate": "object",
 		"comment": "string",
 		"description": "string",
-		"do_not_apply_this_rule": "",
+		"do_not_apply_this_rule": "object",
 		"documentation_url": "string",
 	},
 	"RulePropertiesAttributes": {
-		"allow_users_to_deactivate": "",
+		"allow_users_to_deactivate": "object",
 		"comment": "string",
 		"description": "string",
-		"do_not_apply_this_rule": "",
+		"do_not_apply_this_rule": "object",
 		"documentation_url": "string",
 	},
 	"RulesetCollection": {
@@ -6715,7 +6717,7 @@ var FieldTypes = map[string]map[string]string{
 	},
 	"RulesetObject": {
 		"domainType": "",
-		"extensions": "",
+		"extensions": "object",
 		"id": "string",
 		"links": "array",
 		"members": "object",
@@ -6730,8 +6732,8 @@ var FieldTypes = map[string]map[string]string{
 		"store_id": "string",
 	},
 	"SMSAPIPluginCreate": {
-		"disable_ssl_cert_verification": "",
-		"http_proxy": "",
+		"disable_ssl_cert_verification": "object",
+		"http_proxy": "object",
 		"modem_type": "string",
 		"modem_url": "string",
 		"plugin_name": "string",
@@ -6766,7 +6768,7 @@ var FieldTypes = map[string]map[string]string{
 		"type": "",
 	},
 	"ServiceConditions": {
-		"host_choice": "",
+		"host_choice": "object",
 		"host_folder": "string",
 		"host_label_groups": "array",
 		"host_tags": "object",
@@ -6834,7 +6836,7 @@ var FieldTypes = map[string]map[string]string{
 	},
 	"ServiceGroup": {
 		"domainType": "",
-		"extensions": "",
+		"extensions": "object",
 		"id": "string",
 		"links": "array",
 		"members": "object",
@@ -6857,8 +6859,8 @@ var FieldTypes = map[string]map[string]string{
 		"regex_list": "array",
 	},
 	"ServiceNowPluginCreate": {
-		"auth": "",
-		"http_proxy": "",
+		"auth": "object",
+		"http_proxy": "object",
 		"management_type": "object",
 		"optional_timeout": "object",
 		"plugin_name": "string",
@@ -6867,13 +6869,13 @@ var FieldTypes = map[string]map[string]string{
 	},
 	"ServiceParameters": {
 		"columns": "array",
-		"query": "",
+		"query": "object",
 		"sites": "array",
 	},
 	"ServiceParametersWithHost": {
 		"columns": "array",
 		"host_name": "string",
-		"query": "",
+		"query": "object",
 		"sites": "array",
 	},
 	"SignL4TeamSecret": {
@@ -6885,8 +6887,8 @@ var FieldTypes = map[string]map[string]string{
 		"store_id": "string",
 	},
 	"Signl4PluginCreate": {
-		"disable_ssl_cert_verification": "",
-		"http_proxy": "",
+		"disable_ssl_cert_verification": "object",
+		"http_proxy": "object",
 		"plugin_name": "string",
 		"team_secret": "object",
 		"url_prefix_for_links_to_checkmk": "object",
@@ -6910,14 +6912,14 @@ var FieldTypes = map[string]map[string]string{
 		"status_connection": "object",
 	},
 	"SiteConnectionRequestCreate": {
-		"site_config": "",
+		"site_config": "object",
 	},
 	"SiteConnectionRequestUpdate": {
-		"site_config": "",
+		"site_config": "object",
 	},
 	"SiteConnectionResponse": {
 		"domainType": "",
-		"extensions": "",
+		"extensions": "object",
 		"id": "string",
 		"links": "array",
 		"members": "object",
@@ -6939,8 +6941,8 @@ var FieldTypes = map[string]map[string]string{
 		"username": "string",
 	},
 	"SlackPluginCreate": {
-		"disable_ssl_cert_verification": "",
-		"http_proxy": "",
+		"disable_ssl_cert_verification": "object",
+		"http_proxy": "object",
 		"plugin_name": "string",
 		"url_prefix_for_links_to_checkmk": "object",
 		"webhook_url": "object",
@@ -7002,20 +7004,20 @@ var FieldTypes = map[string]map[string]string{
 	},
 	"StatusConnectionAttributes": {
 		"connect_timeout": "integer",
-		"connection": "",
+		"connection": "object",
 		"disable_in_status_gui": "boolean",
 		"persistent_connection": "boolean",
-		"proxy": "",
-		"status_host": "",
+		"proxy": "object",
+		"status_host": "object",
 		"url_prefix": "string",
 	},
 	"StatusConnectionAttributesOutput": {
 		"connect_timeout": "integer",
-		"connection": "",
+		"connection": "object",
 		"disable_in_status_gui": "boolean",
 		"persistent_connection": "boolean",
-		"proxy": "",
-		"status_host": "",
+		"proxy": "object",
+		"status_host": "object",
 		"url_prefix": "string",
 	},
 	"StatusHostAttributes": {
@@ -7114,7 +7116,7 @@ var FieldTypes = map[string]map[string]string{
 	},
 	"TimePeriodResponse": {
 		"domainType": "",
-		"extensions": "",
+		"extensions": "object",
 		"id": "string",
 		"links": "array",
 		"members": "object",
@@ -7142,7 +7144,7 @@ var FieldTypes = map[string]map[string]string{
 	},
 	"ToEmailAndNameCheckbox": {
 		"state": "string",
-		"value": "",
+		"value": "object",
 	},
 	"TranslateNames": {
 		"convert_case": "string",
@@ -7176,7 +7178,7 @@ var FieldTypes = map[string]map[string]string{
 		"change_contact": "string",
 		"filter_type": "string",
 		"phase": "string",
-		"query": "",
+		"query": "object",
 		"site_id": "string",
 	},
 	"UpdateConfigurationEntity": {
@@ -7191,7 +7193,7 @@ var FieldTypes = map[string]map[string]string{
 	},
 	"UpdateContactGroupAttributes": {
 		"alias": "string",
-		"inventory_paths": "",
+		"inventory_paths": "object",
 	},
 	"UpdateDiscoveryPhase": {
 		"check_type": "string",
@@ -7241,8 +7243,8 @@ var FieldTypes = map[string]map[string]string{
 		"title": "string",
 	},
 	"UpdateRuleObject": {
-		"conditions": "",
-		"properties": "",
+		"conditions": "object",
+		"properties": "object",
 		"value_raw": "string",
 	},
 	"UpdateServiceGroup": {
@@ -7259,15 +7261,15 @@ var FieldTypes = map[string]map[string]string{
 		"exclude": "array",
 	},
 	"UpdateUser": {
-		"auth_option": "",
+		"auth_option": "object",
 		"authorized_sites": "array",
-		"contact_options": "",
+		"contact_options": "object",
 		"contactgroups": "array",
 		"disable_login": "boolean",
-		"disable_notifications": "",
+		"disable_notifications": "object",
 		"fullname": "string",
-		"idle_timeout": "",
-		"interface_options": "",
+		"idle_timeout": "object",
+		"interface_options": "object",
 		"language": "string",
 		"pager_address": "string",
 		"roles": "array",
@@ -7335,7 +7337,7 @@ var FieldTypes = map[string]map[string]string{
 	},
 	"UserRoleObject": {
 		"domainType": "",
-		"extensions": "",
+		"extensions": "object",
 		"id": "string",
 		"links": "array",
 		"members": "object",
@@ -7358,8 +7360,8 @@ var FieldTypes = map[string]map[string]string{
 		"message": "string",
 	},
 	"VictoropsPluginCreate": {
-		"disable_ssl_cert_verification": "",
-		"http_proxy": "",
+		"disable_ssl_cert_verification": "object",
+		"http_proxy": "object",
 		"plugin_name": "string",
 		"splunk_on_call_rest_endpoint": "object",
 		"url_prefix_for_links_to_checkmk": "object",
@@ -7376,6 +7378,1006 @@ var FieldTypes = map[string]map[string]string{
 	},
 }
 
+// FieldConstraints maps schema names to the validation keywords and
+// defaults of their fields. Fields without any are omitted.
+// Use GetFieldConstraints() for safe lookup.
+var FieldConstraints = map[string]map[string]*openapi.FieldConstraints{
+	"AcknowledgeHostGroupProblem": {
+		"notify": {Default: true},
+		"persistent": {Default: false},
+		"sticky": {Default: true},
+	},
+	"AcknowledgeHostProblem": {
+		"host_name": {Pattern: "^[-0-9a-zA-Z_.]+\\Z"},
+		"notify": {Default: true},
+		"persistent": {Default: false},
+		"sticky": {Default: true},
+	},
+	"AcknowledgeHostQueryProblem": {
+		"notify": {Default: true},
+		"persistent": {Default: false},
+		"sticky": {Default: true},
+	},
+	"AcknowledgeServiceGroupProblem": {
+		"notify": {Default: true},
+		"persistent": {Default: false},
+		"sticky": {Default: true},
+	},
+	"AcknowledgeServiceQueryProblem": {
+		"notify": {Default: true},
+		"persistent": {Default: false},
+		"sticky": {Default: true},
+	},
+	"AcknowledgeSpecificServiceProblem": {
+		"host_name": {Pattern: "^[-0-9a-zA-Z_.]+\\Z"},
+		"notify": {Default: true},
+		"persistent": {Default: false},
+		"sticky": {Default: true},
+	},
+	"ActivateChanges": {
+		"force_foreign_changes": {Default: false},
+		"redirect": {Default: false},
+	},
+	"ActivationExtensionFields": {
+		"time_started": {Format: "date-time"},
+	},
+	"ActivationExtensionStatusFields": {
+		"time_started": {Format: "date-time"},
+	},
+	"ActivationRunCollection": {
+		"domainType": {Default: "activation_run"},
+	},
+	"ActivationRunResponse": {
+		"domainType": {Default: "activation_run"},
+		"id": {Format: "uuid"},
+	},
+	"ActivationSiteStatus": {
+		"end_time": {Format: "date-time"},
+		"start_time": {Format: "date-time"},
+	},
+	"ActivationStatusResponse": {
+		"domainType": {Default: "activation_run"},
+		"id": {Format: "uuid"},
+	},
+	"AuthPassword": {
+		"enforce_password_change": {Default: false},
+		"password": {MinLength: openapi.Int(1)},
+	},
+	"AuthSecret": {
+		"store_automation_secret": {Default: false},
+	},
+	"AuthUpdatePassword": {
+		"password": {MinLength: openapi.Int(1)},
+	},
+	"AuthUpdateSecret": {
+		"store_automation_secret": {Default: false},
+	},
+	"Authentication": {
+		"method": {Default: "plaintext"},
+		"password": {Default: ""},
+		"user": {Default: ""},
+	},
+	"AuxTag": {
+		"tag_id": {Pattern: "^[-0-9a-zA-Z_]+\\Z"},
+	},
+	"AuxTagAttrsCreate": {
+		"aux_tag_id": {Pattern: "^[-0-9a-zA-Z_]+\\Z"},
+		"help": {Default: ""},
+		"title": {MinLength: openapi.Int(1)},
+		"topic": {MinLength: openapi.Int(1)},
+	},
+	"AuxTagAttrsResponse": {
+		"topic": {MinLength: openapi.Int(1)},
+	},
+	"AuxTagAttrsUpdate": {
+		"title": {MinLength: openapi.Int(1)},
+		"topic": {MinLength: openapi.Int(1)},
+	},
+	"AuxTagOutput": {
+		"tag_id": {Pattern: "^[-0-9a-zA-Z_]+\\Z"},
+		"tag_type": {Default: "aux_tag"},
+	},
+	"AuxTagResponse": {
+		"domainType": {Default: "aux_tag"},
+	},
+	"AuxTagResponseCollection": {
+		"domainType": {Default: "aux_tag"},
+		"id": {Default: "all"},
+	},
+	"BIAggregationFunctionBest": {
+		"type": {Default: "best"},
+	},
+	"BIAggregationFunctionCountOK": {
+		"type": {Default: "count_ok"},
+	},
+	"BIAggregationFunctionWorst": {
+		"type": {Default: "worst"},
+	},
+	"BIAllHostsChoice": {
+		"type": {Default: "all_hosts"},
+	},
+	"BICallARuleAction": {
+		"type": {Default: "call_a_rule"},
+	},
+	"BIEmptySearch": {
+		"type": {Default: "empty"},
+	},
+	"BIFixedArgumentsSearch": {
+		"type": {Default: "fixed_arguments"},
+	},
+	"BIHostAliasRegexChoice": {
+		"type": {Default: "host_alias_regex"},
+	},
+	"BIHostNameRegexChoice": {
+		"type": {Default: "host_name_regex"},
+	},
+	"BIHostSearch": {
+		"type": {Default: "host_search"},
+	},
+	"BINodeVisBlockStyle": {
+		"style_config": {Default: map[string]interface{}{}},
+		"type": {Default: "block"},
+	},
+	"BINodeVisForceStyle": {
+		"style_config": {Default: map[string]interface{}{}},
+		"type": {Default: "force"},
+	},
+	"BINodeVisHierarchyStyle": {
+		"type": {Default: "hierarchy"},
+	},
+	"BINodeVisNoneStyle": {
+		"style_config": {Default: map[string]interface{}{}},
+		"type": {Default: "none"},
+	},
+	"BINodeVisRadialStyle": {
+		"type": {Default: "radial"},
+	},
+	"BIServiceSearch": {
+		"type": {Default: "service_search"},
+	},
+	"BIStateOfHostAction": {
+		"type": {Default: "state_of_host"},
+	},
+	"BIStateOfRemainingServicesAction": {
+		"type": {Default: "state_of_remaining_services"},
+	},
+	"BIStateOfServiceAction": {
+		"type": {Default: "state_of_service"},
+	},
+	"BackgroundJobSnapshotObject": {
+		"domainType": {Default: "background_job"},
+	},
+	"BackgroundJobStatusObject": {
+		"domainType": {Default: "parent_scan"},
+	},
+	"BinaryExpr": {
+		"left": {Pattern: "^([a-z]+\\.)?[_a-z]+$"},
+	},
+	"BindCredentialsExplicitPasswordRequest": {
+		"bind_dn": {Default: ""},
+		"state": {Default: "enabled"},
+		"type": {Default: "explicit"},
+	},
+	"BindCredentialsStoreIdRequest": {
+		"bind_dn": {Default: ""},
+		"state": {Default: "enabled"},
+		"type": {Default: "store"},
+	},
+	"BrokerConnectionRequestCreate": {
+		"connection_id": {Pattern: "^[-a-z0-9A-Z_]+$"},
+	},
+	"BrokerConnectionResponse": {
+		"domainType": {Default: "broker_connection"},
+	},
+	"BrokerConnectionResponseCollection": {
+		"domainType": {Default: "broker_connection"},
+		"id": {Default: "all"},
+	},
+	"BulkDeleteHost": {
+		"entries": {MinLength: openapi.Int(1)},
+	},
+	"BulkDiscovery": {
+		"bulk_size": {Default: float64(10)},
+		"do_full_scan": {Default: true},
+		"ignore_errors": {Default: true},
+	},
+	"BulkDiscoveryOptions": {
+		"monitor_undecided_services": {Default: false},
+		"remove_vanished_services": {Default: false},
+		"update_host_labels": {Default: false},
+		"update_service_labels": {Default: false},
+		"update_service_parameters": {Default: false},
+	},
+	"ChangesFields": {
+		"id": {Format: "uuid"},
+		"time": {Format: "date-time"},
+	},
+	"CheckboxWithFolderStr": {
+		"value": {Pattern: "^(?:(?:[~\\\\\\/]|(?:[~\\\\\\/][-_ a-zA-Z0-9.]+)+[~\\\\\\/]?)|[0-9a-fA-F]{32})$"},
+	},
+	"CheckboxWithFolderStrOutput": {
+		"value": {Pattern: "^(?:(?:[~\\\\\\/]|(?:[~\\\\\\/][-_ a-zA-Z0-9.]+)+[~\\\\\\/]?)|[0-9a-fA-F]{32})$"},
+	},
+	"Child": {
+		"type": {Default: "child"},
+	},
+	"ChildWith": {
+		"type": {Default: "child_with"},
+	},
+	"CiscoExplicitWebhookUrl": {
+		"url": {Format: "url"},
+	},
+	"CommentCollection": {
+		"domainType": {Default: "comment"},
+		"id": {Default: "all"},
+	},
+	"CommentObject": {
+		"domainType": {Default: "comment"},
+	},
+	"ConcreteHostTagGroup": {
+		"domainType": {Default: "host_tag_group"},
+	},
+	"ConcreteTimePeriodException": {
+		"date": {Format: "date"},
+	},
+	"ConcreteTimeRange": {
+		"end": {Format: "time"},
+		"start": {Format: "time"},
+	},
+	"ConcreteUserInterfaceAttributes": {
+		"contextual_help_icon": {Default: "show_icon"},
+	},
+	"Configuration": {
+		"force_explicit_parents": {Default: false},
+	},
+	"ConfigurationConnectionWithReplicationAttributes": {
+		"enable_replication": {Default: true},
+	},
+	"ConfigurationConnectionWithoutReplicationAttributes": {
+		"enable_replication": {Default: false},
+	},
+	"ContactGroup": {
+		"domainType": {Default: "contact_group_config"},
+	},
+	"ContactGroupCollection": {
+		"domainType": {Default: "contact_group_config"},
+		"id": {Default: "all"},
+	},
+	"CreateClusterHost": {
+		"folder": {Pattern: "^(?:(?:[~\\\\\\/]|(?:[~\\\\\\/][-\\w]+)+[~\\\\\\/]?)|[0-9a-fA-F]{32})$"},
+		"host_name": {Pattern: "^[-0-9a-zA-Z_.]+\\Z"},
+	},
+	"CreateFolder": {
+		"name": {Pattern: "^[-\\w]*\\Z", MinLength: openapi.Int(1)},
+		"parent": {Pattern: "^(?:(?:[~\\\\\\/]|(?:[~\\\\\\/][-\\w]+)+[~\\\\\\/]?)|[0-9a-fA-F]{32})$"},
+	},
+	"CreateHost": {
+		"folder": {Pattern: "^(?:(?:[~\\\\\\/]|(?:[~\\\\\\/][-\\w]+)+[~\\\\\\/]?)|[0-9a-fA-F]{32})$"},
+		"host_name": {Pattern: "^[-0-9a-zA-Z_.]+\\Z"},
+	},
+	"CreateHostComment": {
+		"host_name": {Pattern: "^[-0-9a-zA-Z_.]+\\Z"},
+		"persistent": {Default: false},
+	},
+	"CreateHostDowntime": {
+		"duration": {Default: float64(0)},
+		"end_time": {Format: "iso8601"},
+		"host_name": {Pattern: "^[-0-9a-zA-Z_.]+\\Z"},
+		"recur": {Default: "fixed"},
+		"start_time": {Format: "iso8601"},
+	},
+	"CreateHostGroupDowntime": {
+		"duration": {Default: float64(0)},
+		"end_time": {Format: "iso8601"},
+		"recur": {Default: "fixed"},
+		"start_time": {Format: "iso8601"},
+	},
+	"CreateHostQueryComment": {
+		"persistent": {Default: false},
+	},
+	"CreateHostQueryDowntime": {
+		"duration": {Default: float64(0)},
+		"end_time": {Format: "iso8601"},
+		"recur": {Default: "fixed"},
+		"start_time": {Format: "iso8601"},
+	},
+	"CreateInFolder": {
+		"folder": {Pattern: "^(?:(?:[~\\\\\\/]|(?:[~\\\\\\/][-_ a-zA-Z0-9.]+)+[~\\\\\\/]?)|[0-9a-fA-F]{32})$"},
+		"hosts_alias": {Default: "Created by parent scan"},
+	},
+	"CreateInHostLocation": {
+		"hosts_alias": {Default: "Created by parent scan"},
+	},
+	"CreateServiceComment": {
+		"host_name": {Pattern: "^[-0-9a-zA-Z_.]+\\Z"},
+		"persistent": {Default: false},
+	},
+	"CreateServiceDowntime": {
+		"duration": {Default: float64(0)},
+		"end_time": {Format: "iso8601"},
+		"host_name": {Pattern: "^[-0-9a-zA-Z_.]+\\Z"},
+		"recur": {Default: "fixed"},
+		"start_time": {Format: "iso8601"},
+	},
+	"CreateServiceGroupDowntime": {
+		"duration": {Default: float64(0)},
+		"end_time": {Format: "iso8601"},
+		"recur": {Default: "fixed"},
+		"start_time": {Format: "iso8601"},
+	},
+	"CreateServiceQueryComment": {
+		"persistent": {Default: false},
+	},
+	"CreateServiceQueryDowntime": {
+		"duration": {Default: float64(0)},
+		"end_time": {Format: "iso8601"},
+		"recur": {Default: "fixed"},
+		"start_time": {Format: "iso8601"},
+	},
+	"CreateTimePeriod": {
+		"name": {Pattern: "^[-a-z0-9A-Z_]+\\Z"},
+	},
+	"CreateUser": {
+		"authorized_sites": {Default: []interface{}{"all"}},
+		"disable_login": {Default: false},
+		"interface_options": {Default: map[string]interface{}{"contextual_help_icon": "show_icon", "interface_theme": "default", "mega_menu_icons": "topic", "navigation_bar_icons": "hide", "show_mode": "default", "sidebar_position": "right"}},
+		"pager_address": {Default: ""},
+	},
+	"CustomPluginWithParams": {
+		"option": {Default: "create_notification_with_custom_parameters"},
+	},
+	"DateTimeRange": {
+		"end_time": {Format: "iso8601"},
+		"start_time": {Format: "date-time"},
+	},
+	"DeleteCommentsByParams": {
+		"host_name": {Pattern: "^[-0-9a-zA-Z_.]+\\Z"},
+	},
+	"DeleteDowntimeByName": {
+		"host_name": {Pattern: "^[-0-9a-zA-Z_.]+\\Z"},
+	},
+	"DirectoryTypeManualRequest": {
+		"failover_servers": {Default: []interface{}{}},
+	},
+	"DisableNotificationCustomTimeRange": {
+		"end_time": {Format: "iso8601"},
+		"start_time": {Format: "iso8601"},
+	},
+	"DiscoverServices": {
+		"host_name": {Pattern: "^[-0-9a-zA-Z_.]+\\Z"},
+		"mode": {Default: "fix_all"},
+	},
+	"DomainObjectCollection": {
+		"id": {Default: "all"},
+	},
+	"DowntimeCollection": {
+		"domainType": {Default: "downtime"},
+		"id": {Default: "all"},
+	},
+	"DowntimeObject": {
+		"domainType": {Default: "downtime"},
+	},
+	"ECEventAttributes": {
+		"first": {Format: "date-time"},
+		"host": {Pattern: "^[-0-9a-zA-Z_.]+\\Z"},
+		"last": {Format: "date-time"},
+	},
+	"ECEventResponse": {
+		"domainType": {Default: "event_console"},
+	},
+	"EditConfigurationEntityResponse": {
+		"domainType": {Default: "configuration_entity"},
+	},
+	"EnableSynchronousDeliveryViaSMTP": {
+		"smarthosts": {Default: []interface{}{}},
+	},
+	"EventConsoleResponseCollection": {
+		"domainType": {Default: "event_console"},
+		"id": {Default: "all"},
+	},
+	"FilterParams": {
+		"host": {Pattern: "^[-0-9a-zA-Z_.]+\\Z"},
+	},
+	"FilterParamsUpdateAndAcknowledge": {
+		"host": {Pattern: "^[-0-9a-zA-Z_.]+\\Z"},
+	},
+	"Folder": {
+		"domainType": {Default: "folder_config"},
+	},
+	"FolderCollection": {
+		"domainType": {Default: "folder_config"},
+		"id": {Default: "all"},
+	},
+	"GetGraph": {
+		"graph_id": {Pattern: "^\\w[_\\-\\w\\d\\.]*$"},
+		"host_name": {Pattern: "^[-0-9a-zA-Z_.]+\\Z"},
+		"reduce": {Default: "average"},
+	},
+	"GetMetric": {
+		"host_name": {Pattern: "^[-0-9a-zA-Z_.]+\\Z"},
+		"metric_id": {Pattern: "^\\w[_\\-\\w\\d\\.]*$"},
+		"reduce": {Default: "average"},
+	},
+	"Heartbeat": {
+		"interval": {Minimum: openapi.Float(1)},
+	},
+	"Host": {
+		"type": {Default: "host"},
+	},
+	"HostConfig": {
+		"domainType": {Default: "host_config"},
+	},
+	"HostConfigCollection": {
+		"domainType": {Default: "host_config"},
+		"id": {Default: "all"},
+	},
+	"HostContactGroup": {
+		"recurse_perms": {Default: false},
+		"recurse_use": {Default: false},
+		"use": {Default: false},
+		"use_for_services": {Default: false},
+	},
+	"HostDowntimeAttributes": {
+		"end_time": {Format: "date-time"},
+		"host_name": {Pattern: "^[-0-9a-zA-Z_.]+\\Z"},
+		"is_service": {Default: false},
+		"start_time": {Format: "date-time"},
+	},
+	"HostExtensions": {
+		"folder": {Pattern: "^(?:(?:[~\\\\\\/]|(?:[~\\\\\\/][-_ a-zA-Z0-9.]+)+[~\\\\\\/]?)|[0-9a-fA-F]{32})$"},
+	},
+	"HostGroup": {
+		"domainType": {Default: "host_group_config"},
+	},
+	"HostGroupCollection": {
+		"domainType": {Default: "host_group_config"},
+		"id": {Default: "all"},
+	},
+	"HostTagGroupCollection": {
+		"domainType": {Default: "host_tag_group"},
+		"id": {Default: "all"},
+	},
+	"IPAddressRange": {
+		"type": {Default: "ip_range"},
+	},
+	"IPAddresses": {
+		"type": {Default: "ip_list"},
+	},
+	"IPNetwork": {
+		"type": {Default: "ip_network"},
+	},
+	"IPRegexp": {
+		"type": {Default: "ip_regex_list"},
+	},
+	"IdleOption": {
+		"duration": {Default: float64(3600)},
+	},
+	"InputContactGroup": {
+		"name": {Pattern: "^(?!\\.\\.$|\\.$)[-a-zA-Z0-9_\\.]*\\Z"},
+	},
+	"InputHostGroup": {
+		"name": {Pattern: "^(?!\\.\\.$|\\.$)[-a-zA-Z0-9_\\.]*\\Z"},
+	},
+	"InputHostTagGroup": {
+		"help": {Default: ""},
+		"tags": {MinLength: openapi.Int(1)},
+	},
+	"InputPassword": {
+		"comment": {Default: ""},
+		"documentation_url": {Default: ""},
+		"password": {MinLength: openapi.Int(1)},
+	},
+	"InputRuleObject": {
+		"folder": {Pattern: "^(?:(?:[~\\\\\\/]|(?:[~\\\\\\/][-_ a-zA-Z0-9.]+)+[~\\\\\\/]?)|[0-9a-fA-F]{32})$"},
+	},
+	"InputServiceGroup": {
+		"name": {Pattern: "^(?!\\.\\.$|\\.$)[-a-zA-Z0-9_\\.]*\\Z"},
+	},
+	"InventoryPathAllowAll": {
+		"type": {Default: "allow_all"},
+	},
+	"InventoryPathForbidAll": {
+		"type": {Default: "forbid_all"},
+	},
+	"InventoryPathSpecificPaths": {
+		"type": {Default: "specific_paths"},
+	},
+	"LDAPCheckboxCustomTimeRangeEnabledRequest": {
+		"from_time": {Format: "date-time"},
+		"state": {Default: "enabled"},
+		"to_time": {Format: "date-time"},
+	},
+	"LDAPCheckboxDisabledRequest": {
+		"state": {Default: "disabled"},
+	},
+	"LDAPConnectTimeoutRequest": {
+		"seconds": {Default: float64(2)},
+		"state": {Default: "enabled"},
+	},
+	"LDAPConnectionConfigCreateRequest": {
+		"groups": {Default: map[string]interface{}{"group_base_dn": "", "member_attribute": map[string]interface{}{"state": "disabled"}, "search_filter": map[string]interface{}{"state": "disabled"}, "search_scope": "search_whole_subtree"}},
+		"other": {Default: map[string]interface{}{"sync_interval": map[string]interface{}{"days": float64(0), "hours": float64(0), "minutes": float64(5)}}},
+		"sync_plugins": {Default: map[string]interface{}{}},
+		"users": {Default: map[string]interface{}{"create_users": "on_login", "filter_group": map[string]interface{}{"state": "disabled"}, "search_filter": map[string]interface{}{"state": "disabled"}, "search_scope": "search_only_base_dn_entry", "umlauts_in_user_ids": "keep_umlauts", "user_base_dn": "", "user_id_attribute": map[string]interface{}{"state": "disabled"}, "user_id_case": "dont_convert_to_lowercase"}},
+	},
+	"LDAPConnectionConfigUpdateRequest": {
+		"groups": {Default: map[string]interface{}{"group_base_dn": "", "member_attribute": map[string]interface{}{"state": "disabled"}, "search_filter": map[string]interface{}{"state": "disabled"}, "search_scope": "search_whole_subtree"}},
+		"other": {Default: map[string]interface{}{"sync_interval": map[string]interface{}{"days": float64(0), "hours": float64(0), "minutes": float64(5)}}},
+		"sync_plugins": {Default: map[string]interface{}{}},
+		"users": {Default: map[string]interface{}{"create_users": "on_login", "filter_group": map[string]interface{}{"state": "disabled"}, "search_filter": map[string]interface{}{"state": "disabled"}, "search_scope": "search_only_base_dn_entry", "umlauts_in_user_ids": "keep_umlauts", "user_base_dn": "", "user_id_attribute": map[string]interface{}{"state": "disabled"}, "user_id_case": "dont_convert_to_lowercase"}},
+	},
+	"LDAPConnectionCreateRequest": {
+		"bind_credentials": {Default: map[string]interface{}{"state": "disabled"}},
+		"connect_timeout": {Default: map[string]interface{}{"state": "disabled"}},
+		"connection_suffix": {Default: map[string]interface{}{"state": "disabled"}},
+		"ldap_version": {Default: map[string]interface{}{"state": "disabled"}},
+		"page_size": {Default: map[string]interface{}{"state": "disabled"}},
+		"response_timeout": {Default: map[string]interface{}{"state": "disabled"}},
+		"ssl_encryption": {Default: "disable_ssl"},
+		"tcp_port": {Default: map[string]interface{}{"state": "disabled"}},
+	},
+	"LDAPConnectionResponse": {
+		"domainType": {Default: "ldap_connection"},
+	},
+	"LDAPConnectionResponseCollection": {
+		"domainType": {Default: "ldap_connection"},
+		"id": {Default: "all"},
+	},
+	"LDAPConnectionSuffixCreateRequest": {
+		"state": {Default: "enabled"},
+	},
+	"LDAPConnectionSuffixUpdateRequest": {
+		"state": {Default: "enabled"},
+	},
+	"LDAPConnectionUpdateRequest": {
+		"bind_credentials": {Default: map[string]interface{}{"state": "disabled"}},
+		"connect_timeout": {Default: map[string]interface{}{"state": "disabled"}},
+		"connection_suffix": {Default: map[string]interface{}{"state": "disabled"}},
+		"ldap_version": {Default: map[string]interface{}{"state": "disabled"}},
+		"page_size": {Default: map[string]interface{}{"state": "disabled"}},
+		"response_timeout": {Default: map[string]interface{}{"state": "disabled"}},
+		"ssl_encryption": {Default: "disable_ssl"},
+		"tcp_port": {Default: map[string]interface{}{"state": "disabled"}},
+	},
+	"LDAPFromToFields": {
+		"from_time": {Format: "date-time"},
+		"to_time": {Format: "date-time"},
+	},
+	"LDAPGeneralPropertiesCreateRequest": {
+		"comment": {Default: ""},
+		"description": {Default: ""},
+		"documentation_url": {Default: ""},
+		"rule_activation": {Default: "activated"},
+	},
+	"LDAPGeneralPropertiesUpdateRequest": {
+		"comment": {Default: ""},
+		"description": {Default: ""},
+		"documentation_url": {Default: ""},
+		"rule_activation": {Default: "activated"},
+	},
+	"LDAPGroupSearchFilterRequest": {
+		"state": {Default: "enabled"},
+	},
+	"LDAPGroupsRequest": {
+		"group_base_dn": {Default: ""},
+		"member_attribute": {Default: map[string]interface{}{"state": "disabled"}},
+		"search_filter": {Default: map[string]interface{}{"state": "disabled"}},
+		"search_scope": {Default: "search_whole_subtree"},
+	},
+	"LDAPGroupsToRolesRequestWithCustomRoles": {
+		"handle_nested": {Default: false},
+		"state": {Default: "enabled"},
+	},
+	"LDAPMemberAttributeRequest": {
+		"state": {Default: "enabled"},
+	},
+	"LDAPOtherRequest": {
+		"sync_interval": {Default: map[string]interface{}{"days": float64(0), "hours": float64(0), "minutes": float64(5)}},
+	},
+	"LDAPPageSizeRequest": {
+		"size": {Default: float64(1000)},
+		"state": {Default: "enabled"},
+	},
+	"LDAPResponseTimeoutRequest": {
+		"seconds": {Default: float64(5)},
+		"state": {Default: "enabled"},
+	},
+	"LDAPRoleElementRequest": {
+		"search_in": {Default: "this_connection"},
+	},
+	"LDAPSyncIntervalRequest": {
+		"days": {Default: float64(0)},
+		"hours": {Default: float64(0)},
+		"minutes": {Default: float64(5)},
+	},
+	"LDAPSyncPluginAttributeRequest": {
+		"state": {Default: "enabled"},
+	},
+	"LDAPSyncPluginGroupsToAttributesRequest": {
+		"groups_to_sync": {Default: []interface{}{}},
+		"handle_nested": {Default: false},
+		"state": {Default: "enabled"},
+		"sync_from_other_connections": {Default: []interface{}{}},
+	},
+	"LDAPSyncPluginGroupsValueRequest": {
+		"temporarily_disable_all_notifications": {Default: false},
+	},
+	"LDAPSyncPluginNestedOtherConnectionsRequest": {
+		"handle_nested": {Default: false},
+		"state": {Default: "enabled"},
+		"sync_from_other_connections": {Default: []interface{}{}},
+	},
+	"LDAPSyncPluginsRequestWithCustomAttributes": {
+		"alias": {Default: map[string]interface{}{"state": "disabled"}},
+		"authentication_expiration": {Default: map[string]interface{}{"state": "disabled"}},
+		"contact_group_membership": {Default: map[string]interface{}{"state": "disabled"}},
+		"disable_notifications": {Default: map[string]interface{}{"state": "disabled"}},
+		"email_address": {Default: map[string]interface{}{"state": "disabled"}},
+		"groups_to_custom_user_attributes": {Default: map[string]interface{}{"state": "disabled"}},
+		"groups_to_roles": {Default: map[string]interface{}{"state": "disabled"}},
+		"mega_menu_icons": {Default: map[string]interface{}{"state": "disabled"}},
+		"navigation_bar_icons": {Default: map[string]interface{}{"state": "disabled"}},
+		"pager": {Default: map[string]interface{}{"state": "disabled"}},
+		"show_mode": {Default: map[string]interface{}{"state": "disabled"}},
+		"start_url": {Default: map[string]interface{}{"state": "disabled"}},
+		"temperature_unit": {Default: map[string]interface{}{"state": "disabled"}},
+		"ui_sidebar_position": {Default: map[string]interface{}{"state": "disabled"}},
+		"ui_theme": {Default: map[string]interface{}{"state": "disabled"}},
+		"visibility_of_hosts_or_services": {Default: map[string]interface{}{"state": "disabled"}},
+	},
+	"LDAPTCPPortRequest": {
+		"port": {Default: float64(389)},
+		"state": {Default: "enabled"},
+	},
+	"LDAPUserGroupFilterRequest": {
+		"state": {Default: "enabled"},
+	},
+	"LDAPUserIDAttributeRequest": {
+		"state": {Default: "enabled"},
+	},
+	"LDAPUserSearchFilterRequest": {
+		"state": {Default: "enabled"},
+	},
+	"LDAPUsersRequest": {
+		"create_users": {Default: "on_login"},
+		"filter_group": {Default: map[string]interface{}{"state": "disabled"}},
+		"search_filter": {Default: map[string]interface{}{"state": "disabled"}},
+		"search_scope": {Default: "search_whole_subtree"},
+		"umlauts_in_user_ids": {Default: "keep_umlauts"},
+		"user_base_dn": {Default: ""},
+		"user_id_attribute": {Default: map[string]interface{}{"state": "disabled"}},
+		"user_id_case": {Default: "dont_convert_to_lowercase"},
+	},
+	"LDAPVersionRequest": {
+		"state": {Default: "enabled"},
+		"version": {Default: float64(3)},
+	},
+	"LabelGroupCondition": {
+		"operator": {Default: "and"},
+	},
+	"LabelGroupCondition1": {
+		"label_group": {MinLength: openapi.Int(1)},
+		"operator": {Default: "and"},
+	},
+	"Link": {
+		"domainType": {Default: "link"},
+	},
+	"LinkHostUUID": {
+		"uuid": {Format: "uuid"},
+	},
+	"ListOfExtraProperties": {
+		"value": {Default: []interface{}{"abstime", "address", "longoutput"}},
+	},
+	"MSTeamsExplicitWebhookUrl": {
+		"url": {Format: "url"},
+	},
+	"ManagementTypeCaseStates": {
+		"start_integer": {Minimum: openapi.Float(0)},
+	},
+	"ManagementTypeIncedentStates": {
+		"end_integer": {Minimum: openapi.Float(0)},
+		"start_integer": {Minimum: openapi.Float(0)},
+	},
+	"MetaData": {
+		"created_at": {Format: "date-time"},
+		"updated_at": {Format: "date-time"},
+	},
+	"ModifyDowntimeByName": {
+		"host_name": {Pattern: "^[-0-9a-zA-Z_.]+\\Z"},
+	},
+	"ModifyEndTimeByDatetime": {
+		"value": {Format: "iso8601"},
+	},
+	"MoveFolder": {
+		"destination": {Pattern: "^(?:(?:[~\\\\\\/]|(?:[~\\\\\\/][-_ a-zA-Z0-9.]+)+[~\\\\\\/]?)|[0-9a-fA-F]{32})$"},
+	},
+	"MoveHost": {
+		"target_folder": {Pattern: "^(?:(?:[~\\\\\\/]|(?:[~\\\\\\/][-_ a-zA-Z0-9.]+)+[~\\\\\\/]?)|[0-9a-fA-F]{32})$"},
+	},
+	"MoveToFolder": {
+		"folder": {Pattern: "^(?:(?:[~\\\\\\/]|(?:[~\\\\\\/][-_ a-zA-Z0-9.]+)+[~\\\\\\/]?)|[0-9a-fA-F]{32})$"},
+	},
+	"NetworkScan": {
+		"max_parallel_pings": {Minimum: openapi.Float(1), Maximum: openapi.Float(200), Default: float64(100)},
+		"scan_interval": {Minimum: openapi.Float(3600), Default: float64(86400)},
+		"set_ip_address": {Default: true},
+	},
+	"NetworkScanResult": {
+		"end": {Format: "date-time"},
+		"start": {Format: "date-time"},
+	},
+	"NoRestriction": {
+		"type": {Default: "no_restriction"},
+	},
+	"NotificationRuleResponse": {
+		"domainType": {Default: "rule_notifications"},
+	},
+	"NotificationRuleResponseCollection": {
+		"domainType": {Default: "rule_notifications"},
+		"id": {Default: "all"},
+	},
+	"ObjectActionMember": {
+		"memberType": {Default: "action"},
+	},
+	"ObjectCollectionMember": {
+		"memberType": {Default: "collection"},
+	},
+	"Parent": {
+		"type": {Default: "parent"},
+	},
+	"PasswordCollection": {
+		"domainType": {Default: "password"},
+		"id": {Default: "all"},
+	},
+	"PasswordObject": {
+		"domainType": {Default: "password"},
+	},
+	"PendingChangesCollection": {
+		"domainType": {Default: "activation_run"},
+	},
+	"PerformanceSettings": {
+		"hop_probes": {Default: float64(2)},
+		"max_gateway_distance": {Default: float64(10)},
+		"ping_probes": {Default: float64(5)},
+		"responses_timeout": {Default: float64(8)},
+	},
+	"PluginWithParams": {
+		"option": {Default: "create_notification_with_the_following_parameters"},
+	},
+	"PluginWithoutParams": {
+		"option": {Default: "cancel_previous_notifications"},
+	},
+	"Properties": {
+		"disabled": {Default: false},
+		"documentation_url": {Format: "url"},
+	},
+	"ProxyParams": {
+		"channels": {Minimum: openapi.Float(2), Maximum: openapi.Float(50)},
+	},
+	"ProxyTCPOutput": {
+		"port": {Minimum: openapi.Float(1), Maximum: openapi.Float(65535)},
+	},
+	"ProxyTcp": {
+		"port": {Minimum: openapi.Float(1), Maximum: openapi.Float(65535)},
+	},
+	"PushOverPluginCreate": {
+		"api_key": {Pattern: "^[a-zA-Z0-9]{30,40}$"},
+		"user_group_key": {Pattern: "^[a-zA-Z0-9]{30,40}$"},
+	},
+	"PushOverPriorityEmergency": {
+		"receipt": {Pattern: "^[a-zA-Z0-9]{30,40}$"},
+	},
+	"RegexpRewrites": {
+		"replace_with": {MaxLength: openapi.Int(30)},
+		"search": {MaxLength: openapi.Int(30)},
+	},
+	"RegisterHost": {
+		"uuid": {Format: "uuid"},
+	},
+	"RenameHost": {
+		"new_name": {Pattern: "^[-0-9a-zA-Z_.]+\\Z"},
+	},
+	"Request": {
+		"value": {Default: ""},
+	},
+	"RestrictAll": {
+		"type": {Default: "restrict_all"},
+	},
+	"RestrictValues": {
+		"type": {Default: "restrict_values"},
+		"values": {MinLength: openapi.Int(1)},
+	},
+	"RuleCollection": {
+		"domainType": {Default: "rule"},
+		"id": {Default: "all"},
+	},
+	"RuleExtensions": {
+		"folder": {Pattern: "^(?:(?:[~\\\\\\/]|(?:[~\\\\\\/][-_ a-zA-Z0-9.]+)+[~\\\\\\/]?)|[0-9a-fA-F]{32})$"},
+	},
+	"RuleObject": {
+		"domainType": {Default: "rule"},
+	},
+	"RulesetCollection": {
+		"domainType": {Default: "ruleset"},
+		"id": {Default: "all"},
+	},
+	"RulesetExtensions": {
+		"folder": {Pattern: "^(?:(?:[~\\\\\\/]|(?:[~\\\\\\/][-_ a-zA-Z0-9.]+)+[~\\\\\\/]?)|[0-9a-fA-F]{32})$"},
+	},
+	"RulesetObject": {
+		"domainType": {Default: "ruleset"},
+	},
+	"SMSAPIPluginCreate": {
+		"modem_url": {Format: "url"},
+	},
+	"SNMPCommunity": {
+		"type": {Default: "v1_v2_community"},
+	},
+	"SNMPv3AuthNoPrivacy": {
+		"auth_password": {MinLength: openapi.Int(8)},
+		"type": {Default: "authNoPriv"},
+	},
+	"SNMPv3AuthPrivacy": {
+		"auth_password": {MinLength: openapi.Int(8)},
+		"privacy_password": {MinLength: openapi.Int(8)},
+		"type": {Default: "authPriv"},
+	},
+	"SNMPv3NoAuthNoPrivacy": {
+		"type": {Default: "noAuthNoPriv"},
+	},
+	"ServiceDowntimeAttributes": {
+		"end_time": {Format: "date-time"},
+		"host_name": {Pattern: "^[-0-9a-zA-Z_.]+\\Z"},
+		"is_service": {Default: true},
+		"start_time": {Format: "date-time"},
+	},
+	"ServiceGroup": {
+		"domainType": {Default: "service_group_config"},
+	},
+	"ServiceGroupCollection": {
+		"domainType": {Default: "service_group_config"},
+		"id": {Default: "all"},
+	},
+	"ServiceParameters": {
+		"columns": {Default: []interface{}{"host_name", "description"}},
+		"sites": {Default: []interface{}{}},
+	},
+	"ServiceParametersWithHost": {
+		"columns": {Default: []interface{}{"host_name", "description"}},
+		"host_name": {Pattern: "^[-0-9a-zA-Z_.]+\\Z"},
+		"sites": {Default: []interface{}{}},
+	},
+	"SiteConnectionResponse": {
+		"domainType": {Default: "site_connection"},
+	},
+	"SiteConnectionResponseCollection": {
+		"domainType": {Default: "site_connection"},
+		"id": {Default: "all"},
+	},
+	"SlackWebhookURL": {
+		"url": {Format: "url"},
+	},
+	"SocketIP4": {
+		"port": {Minimum: openapi.Float(1), Maximum: openapi.Float(65535)},
+	},
+	"SocketIP6": {
+		"port": {Minimum: openapi.Float(1), Maximum: openapi.Float(65535)},
+	},
+	"SplunkURLExplicit": {
+		"url": {Format: "url"},
+	},
+	"StatusConnectionAttributes": {
+		"disable_in_status_gui": {Default: false},
+		"persistent_connection": {Default: false},
+		"url_prefix": {Default: ""},
+	},
+	"StatusHostAttributesSet": {
+		"host": {Pattern: "^[-0-9a-zA-Z_.]+\\Z"},
+	},
+	"TagGroupIsNotOrIs": {
+		"tag_id": {Pattern: "^[-0-9a-zA-Z_]+\\Z"},
+	},
+	"TagGroupIsNotOrIsOutput": {
+		"tag_id": {Pattern: "^[-0-9a-zA-Z_]+\\Z"},
+	},
+	"TimeAllowedRange": {
+		"end": {Pattern: "^\\d\\d:\\d\\d(:\\d\\d)?$"},
+		"start": {Pattern: "^\\d\\d:\\d\\d(:\\d\\d)?$"},
+	},
+	"TimePeriodException": {
+		"date": {Format: "date"},
+		"time_ranges": {Default: []interface{}{}},
+	},
+	"TimePeriodResponse": {
+		"domainType": {Default: "time_period"},
+	},
+	"TimePeriodResponseCollection": {
+		"domainType": {Default: "time_period"},
+		"id": {Default: "all"},
+	},
+	"TimeRange": {
+		"end": {Format: "date-time"},
+		"start": {Format: "date-time"},
+	},
+	"TimeRange1": {
+		"end": {Format: "time"},
+		"start": {Format: "time"},
+	},
+	"TimeRangeActive": {
+		"day": {Default: "all"},
+		"time_ranges": {Default: []interface{}{map[string]interface{}{"end": "23:59:00", "start": "00:00:00"}}},
+	},
+	"TranslateNames": {
+		"convert_case": {Default: "nop"},
+	},
+	"UpdateAndAcknowledeEventSiteIDRequired": {
+		"phase": {Default: "ack"},
+	},
+	"UpdateAndAcknowledgeFilter": {
+		"phase": {Default: "ack"},
+	},
+	"UpdateAndAcknowledgeWithParams": {
+		"phase": {Default: "ack"},
+	},
+	"UpdateAndAcknowledgeWithQuery": {
+		"phase": {Default: "ack"},
+	},
+	"UpdateFolder": {
+		"title": {MinLength: openapi.Int(1)},
+	},
+	"UpdateFolderEntry": {
+		"folder": {Pattern: "^(?:(?:[~\\\\\\/]|(?:[~\\\\\\/][-\\w]+)+[~\\\\\\/]?)|[0-9a-fA-F]{32})$"},
+		"title": {MinLength: openapi.Int(1)},
+	},
+	"UpdateHostEntry": {
+		"host_name": {Pattern: "^[-0-9a-zA-Z_.]+\\Z"},
+	},
+	"UpdateHostTagGroup": {
+		"repair": {Default: false},
+		"tags": {MinLength: openapi.Int(1)},
+	},
+	"UpdatePassword": {
+		"password": {MinLength: openapi.Int(1)},
+	},
+	"UserCollection": {
+		"domainType": {Default: "user_config"},
+		"id": {Default: "all"},
+	},
+	"UserContactOption": {
+		"fallback_contact": {Default: false},
+	},
+	"UserInterfaceAttributes": {
+		"contextual_help_icon": {Default: "show_icon"},
+		"interface_theme": {Default: "default"},
+		"mega_menu_icons": {Default: "topic"},
+		"navigation_bar_icons": {Default: "hide"},
+		"show_mode": {Default: "default"},
+		"sidebar_position": {Default: "right"},
+	},
+	"UserInterfaceUpdateAttributes": {
+		"contextual_help_icon": {Default: "show_icon"},
+	},
+	"UserObject": {
+		"domainType": {Default: "user_config"},
+	},
+	"UserRoleCollection": {
+		"domainType": {Default: "user_role"},
+		"id": {Default: "all"},
+	},
+	"UserRoleObject": {
+		"domainType": {Default: "user_role"},
+	},
+}
+
+// GetFieldConstraints returns the validation keywords and default of a field.
+// Returns nil if the field has none or is not found.
+func GetFieldConstraints(schemaName, fieldName string) *openapi.FieldConstraints {
+	if fields, ok := FieldConstraints[schemaName]; ok {
+		return fields[fieldName]
+	}
+	return nil
+}
+
 // GetFieldDescription returns the description for a field in a schema.
 // Returns empty string if not found.
 func GetFieldDescription(schemaName, fieldName string) string {